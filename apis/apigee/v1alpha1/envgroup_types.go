@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// EnvGroupParameters define the desired state of an Apigee environment group.
+// https://cloud.google.com/apigee/docs/reference/apis/apigee/rest/v1/organizations.envgroups
+// The name of the environment group is determined by the value of the
+// `crossplane.io/external-name` annotation.
+type EnvGroupParameters struct {
+	// Organization: Name of the Apigee organization the environment group
+	// belongs to.
+	// +optional
+	// +immutable
+	Organization *string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization and retrieves its name
+	// +optional
+	// +immutable
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// Hostnames: Host names for this environment group.
+	// +kubebuilder:validation:MinItems=1
+	Hostnames []string `json:"hostnames"`
+}
+
+// EnvGroupObservation is used to show the observed state of the EnvGroup.
+type EnvGroupObservation struct {
+	// CreatedAt: The time at which the environment group was created as
+	// milliseconds since epoch.
+	CreatedAt int64 `json:"createdAt,omitempty"`
+
+	// LastModifiedAt: The time at which the environment group was last
+	// updated as milliseconds since epoch.
+	LastModifiedAt int64 `json:"lastModifiedAt,omitempty"`
+
+	// State: State of the environment group.
+	State string `json:"state,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// EnvGroupSpec defines the desired state of an EnvGroup.
+type EnvGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvGroupParameters `json:"forProvider"`
+}
+
+// EnvGroupStatus represents the observed state of an EnvGroup.
+type EnvGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvGroupObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvGroup is a managed resource that represents an Apigee environment group,
+// which routes requests for its host names to the environments attached to
+// it.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type EnvGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvGroupSpec   `json:"spec"`
+	Status EnvGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvGroupList contains a list of EnvGroup.
+type EnvGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnvGroup `json:"items"`
+}
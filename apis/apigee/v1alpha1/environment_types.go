@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// EnvironmentParameters define the desired state of an Apigee environment.
+// https://cloud.google.com/apigee/docs/reference/apis/apigee/rest/v1/organizations.environments
+// The name of the environment is determined by the value of the
+// `crossplane.io/external-name` annotation.
+type EnvironmentParameters struct {
+	// Organization: Name of the Apigee organization the environment belongs
+	// to.
+	// +optional
+	// +immutable
+	Organization *string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization and retrieves its name
+	// +optional
+	// +immutable
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// DisplayName: Display name for this environment.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description: Description of the environment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DeploymentType: Deployment type supported by the environment.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=PROXY;ARCHIVE
+	DeploymentType *string `json:"deploymentType,omitempty"`
+
+	// APIProxyType: API Proxy type supported by the environment.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=PROGRAMMABLE;CONFIGURABLE
+	APIProxyType *string `json:"apiProxyType,omitempty"`
+
+	// Properties: Key-value pairs that may be used for customizing the
+	// environment.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// EnvironmentObservation is used to show the observed state of the
+// Environment.
+type EnvironmentObservation struct {
+	// CreatedAt: Creation time of this environment as milliseconds since
+	// epoch.
+	CreatedAt int64 `json:"createdAt,omitempty"`
+
+	// LastModifiedAt: Last modification time of this environment as
+	// milliseconds since epoch.
+	LastModifiedAt int64 `json:"lastModifiedAt,omitempty"`
+
+	// State: State of the environment.
+	State string `json:"state,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// EnvironmentSpec defines the desired state of an Environment.
+type EnvironmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvironmentParameters `json:"forProvider"`
+}
+
+// EnvironmentStatus represents the observed state of an Environment.
+type EnvironmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvironmentObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Environment is a managed resource that represents an Apigee environment.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environment.
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
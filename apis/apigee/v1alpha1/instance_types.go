@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// InstanceParameters define the desired state of an Apigee runtime instance.
+// https://cloud.google.com/apigee/docs/reference/apis/apigee/rest/v1/organizations.instances
+// The name of the instance is determined by the value of the
+// `crossplane.io/external-name` annotation.
+type InstanceParameters struct {
+	// Organization: Name of the Apigee organization the instance belongs
+	// to.
+	// +optional
+	// +immutable
+	Organization *string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization and retrieves its name
+	// +optional
+	// +immutable
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// Location: Compute Engine region where the instance resides, e.g.
+	// us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// DisplayName: Display name for the instance.
+	// +optional
+	// +immutable
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description: Description of the instance.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// IPRange: Comma-separated list of CIDR blocks of length 22 and/or 28
+	// used to create the Apigee instance, e.g. 10.0.0.0/22,10.1.0.0/28.
+	// The blocks must be within a private services access range allocated
+	// to the organization's authorized network. If unset, Apigee picks free
+	// blocks from those ranges.
+	// +optional
+	// +immutable
+	IPRange *string `json:"ipRange,omitempty"`
+
+	// PeeringCIDRRange: Size of the CIDR block range that will be reserved
+	// by the instance, e.g. SLASH_22. Ignored when ipRange is set.
+	// +optional
+	// +immutable
+	PeeringCIDRRange *string `json:"peeringCidrRange,omitempty"`
+
+	// DiskEncryptionKeyName: Cloud KMS key name used for encrypting the
+	// instance's disks, in the format
+	// projects/*/locations/*/keyRings/*/cryptoKeys/*.
+	// +optional
+	// +immutable
+	DiskEncryptionKeyName *string `json:"diskEncryptionKeyName,omitempty"`
+
+	// DiskEncryptionKeyRef references a CryptoKey and retrieves its name
+	// +optional
+	// +immutable
+	DiskEncryptionKeyRef *xpv1.Reference `json:"diskEncryptionKeyRef,omitempty"`
+
+	// DiskEncryptionKeySelector selects a reference to a CryptoKey
+	// +optional
+	DiskEncryptionKeySelector *xpv1.Selector `json:"diskEncryptionKeySelector,omitempty"`
+
+	// ConsumerAcceptList: Project IDs or numbers that are allowed to
+	// connect to the instance's service attachment through Private Service
+	// Connect.
+	// +optional
+	ConsumerAcceptList []string `json:"consumerAcceptList,omitempty"`
+}
+
+// InstanceObservation is used to show the observed state of the Instance.
+type InstanceObservation struct {
+	// Host: Internal hostname or IP address of the Apigee endpoint used by
+	// clients to connect to the service.
+	Host string `json:"host,omitempty"`
+
+	// Port: Port number of the exposed Apigee endpoint.
+	Port string `json:"port,omitempty"`
+
+	// ServiceAttachment: Resource name of the service attachment created
+	// for the instance in the format
+	// projects/*/regions/*/serviceAttachments/*.
+	ServiceAttachment string `json:"serviceAttachment,omitempty"`
+
+	// RuntimeVersion: Version of the runtime system running in the
+	// instance.
+	RuntimeVersion string `json:"runtimeVersion,omitempty"`
+
+	// CreatedAt: Time the instance was created in milliseconds since
+	// epoch.
+	CreatedAt int64 `json:"createdAt,omitempty"`
+
+	// LastModifiedAt: Time the instance was last modified in milliseconds
+	// since epoch.
+	LastModifiedAt int64 `json:"lastModifiedAt,omitempty"`
+
+	// State: State of the instance.
+	State string `json:"state,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// InstanceSpec defines the desired state of an Instance.
+type InstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceParameters `json:"forProvider"`
+}
+
+// InstanceStatus represents the observed state of an Instance.
+type InstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Instance is a managed resource that represents an Apigee runtime instance.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="HOST",type="string",JSONPath=".status.atProvider.host"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instance.
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Known Apigee resource states. Organizations, environments, environment
+// groups and instances share the same lifecycle.
+const (
+	StateCreating = "CREATING"
+	StateActive   = "ACTIVE"
+	StateDeleting = "DELETING"
+	StateUpdating = "UPDATING"
+)
+
+// OrganizationParameters define the desired state of an Apigee organization.
+// https://cloud.google.com/apigee/docs/reference/apis/apigee/rest/v1/organizations
+// An Apigee organization is attached to, and named after, the GCP project of
+// the ProviderConfig. The `crossplane.io/external-name` annotation is set to
+// the project ID once the organization is created; set it beforehand to
+// import an existing organization.
+type OrganizationParameters struct {
+	// AnalyticsRegion: Primary GCP region for analytics data storage, e.g.
+	// us-central1. Required for the CLOUD runtime type.
+	// +optional
+	// +immutable
+	AnalyticsRegion *string `json:"analyticsRegion,omitempty"`
+
+	// RuntimeType: Runtime type of the Apigee organization.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=CLOUD;HYBRID
+	// +kubebuilder:default=CLOUD
+	RuntimeType *string `json:"runtimeType,omitempty"`
+
+	// AuthorizedNetwork: Name of the VPC network that is peered with the
+	// Apigee runtime instances through private services access, e.g.
+	// default. Valid only for the CLOUD runtime type.
+	// +optional
+	// +immutable
+	AuthorizedNetwork *string `json:"authorizedNetwork,omitempty"`
+
+	// AuthorizedNetworkRef references a Network and retrieves its name
+	// +optional
+	// +immutable
+	AuthorizedNetworkRef *xpv1.Reference `json:"authorizedNetworkRef,omitempty"`
+
+	// AuthorizedNetworkSelector selects a reference to a Network
+	// +optional
+	AuthorizedNetworkSelector *xpv1.Selector `json:"authorizedNetworkSelector,omitempty"`
+
+	// BillingType: Billing type of the Apigee organization.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=EVALUATION;SUBSCRIPTION;PAYG
+	BillingType *string `json:"billingType,omitempty"`
+
+	// RuntimeDatabaseEncryptionKeyName: Cloud KMS key name used for
+	// encrypting the data that is stored and replicated across runtime
+	// instances, in the format
+	// projects/*/locations/*/keyRings/*/cryptoKeys/*.
+	// +optional
+	// +immutable
+	RuntimeDatabaseEncryptionKeyName *string `json:"runtimeDatabaseEncryptionKeyName,omitempty"`
+
+	// RuntimeDatabaseEncryptionKeyRef references a CryptoKey and retrieves
+	// its name
+	// +optional
+	// +immutable
+	RuntimeDatabaseEncryptionKeyRef *xpv1.Reference `json:"runtimeDatabaseEncryptionKeyRef,omitempty"`
+
+	// RuntimeDatabaseEncryptionKeySelector selects a reference to a
+	// CryptoKey
+	// +optional
+	RuntimeDatabaseEncryptionKeySelector *xpv1.Selector `json:"runtimeDatabaseEncryptionKeySelector,omitempty"`
+
+	// DisplayName: Display name for the Apigee organization.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description: Description of the Apigee organization.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Properties: Properties defined in the Apigee organization profile.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// Retention: How long organization data is retained after deletion of
+	// a paid organization. Evaluation organizations are deleted
+	// immediately.
+	// +optional
+	// +kubebuilder:validation:Enum=DELETION_RETENTION_UNSPECIFIED;MINIMUM
+	Retention *string `json:"retention,omitempty"`
+}
+
+// OrganizationObservation is used to show the observed state of the
+// Organization.
+type OrganizationObservation struct {
+	// Name: Name of the Apigee organization.
+	Name string `json:"name,omitempty"`
+
+	// ProjectID: Project ID associated with the Apigee organization.
+	ProjectID string `json:"projectId,omitempty"`
+
+	// ApigeeProjectID: Apigee Project ID associated with the organization.
+	// Use this project to allowlist Apigee in the Service Attachment when
+	// using private service connect with Apigee.
+	ApigeeProjectID string `json:"apigeeProjectId,omitempty"`
+
+	// CACertificate: Base64-encoded public certificate for the root CA of
+	// the Apigee organization. Valid only for the HYBRID runtime type.
+	CACertificate string `json:"caCertificate,omitempty"`
+
+	// CreatedAt: Time that the Apigee organization was created in
+	// milliseconds since epoch.
+	CreatedAt int64 `json:"createdAt,omitempty"`
+
+	// LastModifiedAt: Time that the Apigee organization was last modified
+	// in milliseconds since epoch.
+	LastModifiedAt int64 `json:"lastModifiedAt,omitempty"`
+
+	// Environments: List of environments in the Apigee organization.
+	Environments []string `json:"environments,omitempty"`
+
+	// State: State of the organization.
+	State string `json:"state,omitempty"`
+
+	// SubscriptionType: Subscription type of the Apigee organization.
+	SubscriptionType string `json:"subscriptionType,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// OrganizationSpec defines the desired state of an Organization.
+type OrganizationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationParameters `json:"forProvider"`
+}
+
+// OrganizationStatus represents the observed state of an Organization.
+type OrganizationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Organization is a managed resource that represents an Apigee organization
+// attached to a GCP project.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Organization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSpec   `json:"spec"`
+	Status OrganizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationList contains a list of Organization.
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Organization `json:"items"`
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	kmsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
+)
+
+// ResolveReferences of this Organization
+func (mg *Organization) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.authorizedNetwork
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AuthorizedNetwork),
+		Reference:    mg.Spec.ForProvider.AuthorizedNetworkRef,
+		Selector:     mg.Spec.ForProvider.AuthorizedNetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.authorizedNetwork")
+	}
+	mg.Spec.ForProvider.AuthorizedNetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AuthorizedNetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.runtimeDatabaseEncryptionKeyName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RuntimeDatabaseEncryptionKeyName),
+		Reference:    mg.Spec.ForProvider.RuntimeDatabaseEncryptionKeyRef,
+		Selector:     mg.Spec.ForProvider.RuntimeDatabaseEncryptionKeySelector,
+		To:           reference.To{Managed: &kmsv1alpha1.CryptoKey{}, List: &kmsv1alpha1.CryptoKeyList{}},
+		Extract:      kmsv1alpha1.CryptoKeyRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.runtimeDatabaseEncryptionKeyName")
+	}
+	mg.Spec.ForProvider.RuntimeDatabaseEncryptionKeyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RuntimeDatabaseEncryptionKeyRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Environment
+func (mg *Environment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.organization
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Organization),
+		Reference:    mg.Spec.ForProvider.OrganizationRef,
+		Selector:     mg.Spec.ForProvider.OrganizationSelector,
+		To:           reference.To{Managed: &Organization{}, List: &OrganizationList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.organization")
+	}
+	mg.Spec.ForProvider.Organization = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.OrganizationRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this EnvGroup
+func (mg *EnvGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.organization
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Organization),
+		Reference:    mg.Spec.ForProvider.OrganizationRef,
+		Selector:     mg.Spec.ForProvider.OrganizationSelector,
+		To:           reference.To{Managed: &Organization{}, List: &OrganizationList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.organization")
+	}
+	mg.Spec.ForProvider.Organization = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.OrganizationRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Instance
+func (mg *Instance) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.organization
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Organization),
+		Reference:    mg.Spec.ForProvider.OrganizationRef,
+		Selector:     mg.Spec.ForProvider.OrganizationSelector,
+		To:           reference.To{Managed: &Organization{}, List: &OrganizationList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.organization")
+	}
+	mg.Spec.ForProvider.Organization = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.OrganizationRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.diskEncryptionKeyName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DiskEncryptionKeyName),
+		Reference:    mg.Spec.ForProvider.DiskEncryptionKeyRef,
+		Selector:     mg.Spec.ForProvider.DiskEncryptionKeySelector,
+		To:           reference.To{Managed: &kmsv1alpha1.CryptoKey{}, List: &kmsv1alpha1.CryptoKeyList{}},
+		Extract:      kmsv1alpha1.CryptoKeyRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.diskEncryptionKeyName")
+	}
+	mg.Spec.ForProvider.DiskEncryptionKeyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DiskEncryptionKeyRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Types of data policies.
+const (
+	DataPolicyTypeColumnLevelSecurity = "COLUMN_LEVEL_SECURITY_POLICY"
+	DataPolicyTypeDataMasking         = "DATA_MASKING_POLICY"
+)
+
+// DataPolicyParameters define the desired state of a BigQuery data policy
+// of the provider's project.
+// https://cloud.google.com/bigquery/docs/reference/bigquerydatapolicy/rest/v1/projects.locations.dataPolicies
+// The ID of the data policy is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// +kubebuilder:validation:XValidation:rule="self.dataPolicyType != 'DATA_MASKING_POLICY' || has(self.dataMaskingPolicy)",message="dataMaskingPolicy is required for data policies of type DATA_MASKING_POLICY"
+type DataPolicyParameters struct {
+	// Location: The location of the data policy, e.g. "us". It must match
+	// the location of the taxonomy of its policy tag.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// DataPolicyType: Type of the data policy.
+	// +immutable
+	// +kubebuilder:validation:Enum=COLUMN_LEVEL_SECURITY_POLICY;DATA_MASKING_POLICY
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dataPolicyType is immutable"
+	DataPolicyType string `json:"dataPolicyType"`
+
+	// PolicyTag: The resource name of the Data Catalog policy tag the data
+	// policy is bound to, in the format
+	// `projects/{project}/locations/{location}/taxonomies/{taxonomy}/policyTags/{id}`.
+	// +optional
+	PolicyTag *string `json:"policyTag,omitempty"`
+
+	// PolicyTagRef references a PolicyTag and retrieves its resource name.
+	// +optional
+	PolicyTagRef *xpv1.Reference `json:"policyTagRef,omitempty"`
+
+	// PolicyTagSelector selects a reference to a PolicyTag.
+	// +optional
+	PolicyTagSelector *xpv1.Selector `json:"policyTagSelector,omitempty"`
+
+	// DataMaskingPolicy: The masking rule applied to the columns tagged
+	// with the policy tag. Required for data policies of type
+	// DATA_MASKING_POLICY.
+	// +optional
+	DataMaskingPolicy *DataMaskingPolicy `json:"dataMaskingPolicy,omitempty"`
+}
+
+// DataMaskingPolicy describes how the values of masked columns are returned.
+type DataMaskingPolicy struct {
+	// PredefinedExpression: A predefined masking expression. SHA256 returns
+	// the hash of the value, ALWAYS_NULL returns NULL and
+	// DEFAULT_MASKING_VALUE returns the default value of the column's type.
+	// +kubebuilder:validation:Enum=SHA256;ALWAYS_NULL;DEFAULT_MASKING_VALUE
+	PredefinedExpression string `json:"predefinedExpression"`
+}
+
+// DataPolicyObservation is used to show the observed state of the
+// DataPolicy.
+type DataPolicyObservation struct {
+	// Name: The resource name of the data policy in the format
+	// `projects/{project_number}/locations/{location}/dataPolicies/{id}`.
+	Name string `json:"name,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// DataPolicySpec defines the desired state of a DataPolicy.
+type DataPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DataPolicyParameters `json:"forProvider"`
+}
+
+// DataPolicyStatus represents the observed state of a DataPolicy.
+type DataPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DataPolicyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataPolicy is a managed resource that represents a BigQuery data policy,
+// which binds column-level security or data masking rules to a Data Catalog
+// policy tag.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.dataPolicyType"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type DataPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataPolicySpec   `json:"spec"`
+	Status DataPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataPolicyList contains a list of DataPolicy types
+type DataPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataPolicy `json:"items"`
+}
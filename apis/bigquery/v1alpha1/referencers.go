@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	datacatalogv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/datacatalog/v1alpha1"
+)
+
+// ResolveReferences of this DataPolicy
+func (mg *DataPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.policyTag
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PolicyTag),
+		Reference:    mg.Spec.ForProvider.PolicyTagRef,
+		Selector:     mg.Spec.ForProvider.PolicyTagSelector,
+		To:           reference.To{Managed: &datacatalogv1alpha1.PolicyTag{}, List: &datacatalogv1alpha1.PolicyTagList{}},
+		Extract:      datacatalogv1alpha1.PolicyTagRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.policyTag")
+	}
+	mg.Spec.ForProvider.PolicyTag = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PolicyTagRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,191 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataMaskingPolicy) DeepCopyInto(out *DataMaskingPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMaskingPolicy.
+func (in *DataMaskingPolicy) DeepCopy() *DataMaskingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DataMaskingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPolicy) DeepCopyInto(out *DataPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPolicy.
+func (in *DataPolicy) DeepCopy() *DataPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPolicyList) DeepCopyInto(out *DataPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPolicyList.
+func (in *DataPolicyList) DeepCopy() *DataPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPolicyObservation) DeepCopyInto(out *DataPolicyObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPolicyObservation.
+func (in *DataPolicyObservation) DeepCopy() *DataPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPolicyParameters) DeepCopyInto(out *DataPolicyParameters) {
+	*out = *in
+	if in.PolicyTag != nil {
+		in, out := &in.PolicyTag, &out.PolicyTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.PolicyTagRef != nil {
+		in, out := &in.PolicyTagRef, &out.PolicyTagRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyTagSelector != nil {
+		in, out := &in.PolicyTagSelector, &out.PolicyTagSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataMaskingPolicy != nil {
+		in, out := &in.DataMaskingPolicy, &out.DataMaskingPolicy
+		*out = new(DataMaskingPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPolicyParameters.
+func (in *DataPolicyParameters) DeepCopy() *DataPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPolicySpec) DeepCopyInto(out *DataPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPolicySpec.
+func (in *DataPolicySpec) DeepCopy() *DataPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPolicyStatus) DeepCopyInto(out *DataPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPolicyStatus.
+func (in *DataPolicyStatus) DeepCopy() *DataPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// GetLastOperation of this RedisCluster.
+func (mg *RedisCluster) GetLastOperation() *gcpv1beta1.LastOperation {
+	return mg.Status.AtProvider.LastOperation
+}
+
+// SetLastOperation of this RedisCluster.
+func (mg *RedisCluster) SetLastOperation(o *gcpv1beta1.LastOperation) {
+	mg.Status.AtProvider.LastOperation = o
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// RedisClusterParameters define the desired state of a Google Cloud
+// Memorystore for Redis Cluster. Most fields map directly to a Cluster:
+// https://cloud.google.com/memorystore/docs/cluster/reference/rest/v1/projects.locations.clusters#Cluster
+type RedisClusterParameters struct {
+	// Region in which to create this Redis cluster.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region"`
+
+	// ShardCount: Number of shards for the Redis cluster. Shards can be
+	// added or removed in place.
+	// +kubebuilder:validation:Minimum=1
+	ShardCount int64 `json:"shardCount"`
+
+	// ReplicaCount: The number of replica nodes per shard. Defaults to 0,
+	// i.e. no replicas.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ReplicaCount *int64 `json:"replicaCount,omitempty"`
+
+	// NodeType: The type of a Redis node in the cluster, which determines
+	// the underlying machine type of the node.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=REDIS_SHARED_CORE_NANO;REDIS_HIGHMEM_MEDIUM;REDIS_HIGHMEM_XLARGE;REDIS_STANDARD_SMALL
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="nodeType is immutable"
+	NodeType *string `json:"nodeType,omitempty"`
+
+	// PscConfigs: Each PscConfig configures the consumer network where IPs
+	// are reserved for client access to the cluster through Private
+	// Service Connect. Currently only one PscConfig is supported.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1
+	PscConfigs []PscConfig `json:"pscConfigs"`
+
+	// AuthorizationMode: The authorization mode of the Redis cluster. If
+	// not provided, auth is disabled for the cluster.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=AUTH_MODE_IAM_AUTH;AUTH_MODE_DISABLED
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="authorizationMode is immutable"
+	AuthorizationMode *string `json:"authorizationMode,omitempty"`
+
+	// TransitEncryptionMode: The in-transit encryption for the Redis
+	// cluster. If not provided, encryption is disabled for the cluster.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=TRANSIT_ENCRYPTION_MODE_DISABLED;TRANSIT_ENCRYPTION_MODE_SERVER_AUTHENTICATION
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="transitEncryptionMode is immutable"
+	TransitEncryptionMode *string `json:"transitEncryptionMode,omitempty"`
+
+	// RedisConfigs: Key/Value pairs of customer overrides for mutable
+	// Redis configs, e.g. `maxmemory-policy`.
+	// +optional
+	RedisConfigs map[string]string `json:"redisConfigs,omitempty"`
+
+	// DeletionProtectionEnabled: Deleting the cluster fails while this is
+	// set to true.
+	// +optional
+	DeletionProtectionEnabled *bool `json:"deletionProtectionEnabled,omitempty"`
+}
+
+// PscConfig configures the consumer network of a Private Service Connect
+// attachment of a Redis cluster.
+type PscConfig struct {
+	// Network: The network where the IP address of the discovery endpoint
+	// is reserved, in the form of
+	// projects/{network_project}/global/networks/{network_id}.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	// +immutable
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+}
+
+// DiscoveryEndpoint is an endpoint that Redis clients connect to in order to
+// discover the topology of the cluster.
+type DiscoveryEndpoint struct {
+	// Address of the exposed Redis endpoint used by clients to connect to
+	// the cluster. The address could be either IP or hostname.
+	Address string `json:"address,omitempty"`
+
+	// Port number of the exposed Redis endpoint.
+	Port int64 `json:"port,omitempty"`
+
+	// Network the endpoint is created and accessed from.
+	Network string `json:"network,omitempty"`
+}
+
+// PscConnection details the consumer resources of a Private Service Connect
+// connection of a Redis cluster.
+type PscConnection struct {
+	// PscConnectionID: The PSC connection id of the forwarding rule
+	// connected to the service attachment.
+	PscConnectionID string `json:"pscConnectionId,omitempty"`
+
+	// Address: The IP allocated on the consumer network for the PSC
+	// forwarding rule.
+	Address string `json:"address,omitempty"`
+
+	// ForwardingRule: The URI of the consumer side forwarding rule.
+	ForwardingRule string `json:"forwardingRule,omitempty"`
+
+	// ProjectID: The consumer project the forwarding rule is created in.
+	ProjectID string `json:"projectId,omitempty"`
+
+	// Network: The consumer network where the IP address resides.
+	Network string `json:"network,omitempty"`
+}
+
+// RedisClusterObservation is used to show the observed state of the
+// RedisCluster resource on GCP.
+type RedisClusterObservation struct {
+	// Name: Unique name of the resource in this scope including project and
+	// location using the form:
+	//     `projects/{project_id}/locations/{location_id}/clusters/{cluster_id}`
+	Name string `json:"name,omitempty"`
+
+	// UID: System assigned, unique identifier for the cluster.
+	UID string `json:"uid,omitempty"`
+
+	// CreateTime: The time the cluster was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// State: The current state of this cluster.
+	//
+	// Possible values:
+	//   "STATE_UNSPECIFIED" - Not set.
+	//   "CREATING" - Redis cluster is being created.
+	//   "ACTIVE" - Redis cluster has been created and is fully usable.
+	//   "UPDATING" - Redis cluster configuration is being updated.
+	//   "DELETING" - Redis cluster is being deleted.
+	State string `json:"state,omitempty"`
+
+	// SizeGB: Redis memory size in GB for the entire cluster, rounded up to
+	// the next integer.
+	SizeGB int64 `json:"sizeGb,omitempty"`
+
+	// DiscoveryEndpoints: Endpoints created on each given network, for
+	// Redis clients to connect to the cluster.
+	DiscoveryEndpoints []DiscoveryEndpoint `json:"discoveryEndpoints,omitempty"`
+
+	// PscConnections: PSC connections for discovery of the cluster topology
+	// and accessing the cluster.
+	PscConnections []PscConnection `json:"pscConnections,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// A RedisClusterSpec defines the desired state of a RedisCluster.
+type RedisClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RedisClusterParameters `json:"forProvider"`
+}
+
+// A RedisClusterStatus represents the observed state of a RedisCluster.
+type RedisClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RedisClusterObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RedisCluster is a managed resource that represents a Google Cloud
+// Memorystore for Redis Cluster, i.e. Redis in cluster mode. It is distinct
+// from a CloudMemorystoreInstance, which is a standalone Redis instance.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="SHARDS",type="integer",JSONPath=".spec.forProvider.shardCount"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type RedisCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedisClusterSpec   `json:"spec"`
+	Status RedisClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedisClusterList contains a list of RedisCluster
+type RedisClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisCluster `json:"items"`
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // CloudMemorystoreInstanceParameters define the desired state of an Google
@@ -233,6 +235,10 @@ type CloudMemorystoreInstanceObservation struct {
 	// ServerCaCerts: Output only. List of server CA certificates for the
 	// instance.
 	ServerCaCerts []ServerCACertsObservation `json:"serverCaCerts,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // A CloudMemorystoreInstanceSpec defines the desired state of a
@@ -247,6 +253,11 @@ type CloudMemorystoreInstanceSpec struct {
 type CloudMemorystoreInstanceStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          CloudMemorystoreInstanceObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -256,8 +267,10 @@ type CloudMemorystoreInstanceStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
 // +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.redisVersion"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type CloudMemorystoreInstance struct {
@@ -22,6 +22,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	apisv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -96,6 +97,11 @@ func (in *CloudMemorystoreInstanceObservation) DeepCopyInto(out *CloudMemorystor
 		*out = make([]ServerCACertsObservation, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudMemorystoreInstanceObservation.
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// FeedParameters define the desired state of a Cloud Asset Inventory feed of
+// the provider's project.
+// https://cloud.google.com/asset-inventory/docs/reference/rest/v1/feeds
+// The name of the feed (ie the `feedId` parameter of the Create call) is
+// determined by the value of the `crossplane.io/external-name` annotation.
+type FeedParameters struct {
+	// AssetNames: A list of the full names of the assets to receive updates.
+	// For example:
+	// `//compute.googleapis.com/projects/my_project_123/zones/zone1/instances/instance1`.
+	// At least one of AssetNames and AssetTypes must be set.
+	// +optional
+	AssetNames []string `json:"assetNames,omitempty"`
+
+	// AssetTypes: A list of types of the assets to receive updates, e.g.
+	// `compute.googleapis.com/Disk`. Regular expressions are also supported.
+	// At least one of AssetNames and AssetTypes must be set.
+	// +optional
+	AssetTypes []string `json:"assetTypes,omitempty"`
+
+	// ContentType: Asset content type. If not specified, no content but the
+	// asset name and type will be returned.
+	// +optional
+	// +kubebuilder:validation:Enum=RESOURCE;IAM_POLICY;ORG_POLICY;ACCESS_POLICY;OS_INVENTORY;RELATIONSHIP
+	ContentType *string `json:"contentType,omitempty"`
+
+	// RelationshipTypes: A list of relationship types to output, e.g.
+	// `INSTANCE_TO_INSTANCEGROUP`. Only used when ContentType is
+	// RELATIONSHIP.
+	// +optional
+	RelationshipTypes []string `json:"relationshipTypes,omitempty"`
+
+	// Condition: A condition which determines whether an asset update
+	// should be published, written in Common Expression Language.
+	// +optional
+	Condition *iamv1alpha1.Expr `json:"condition,omitempty"`
+
+	// FeedOutputConfig: Feed output configuration defining where the asset
+	// updates are published to.
+	FeedOutputConfig FeedOutputConfig `json:"feedOutputConfig"`
+}
+
+// FeedOutputConfig defines where the asset updates of a feed are published
+// to.
+type FeedOutputConfig struct {
+	// PubsubDestination: Destination on Pub/Sub.
+	PubsubDestination PubsubDestination `json:"pubsubDestination"`
+}
+
+// PubsubDestination is a Pub/Sub destination of a feed.
+type PubsubDestination struct {
+	// Topic: The Pub/Sub topic asset updates are published to. Either the
+	// name of a topic in the provider's project or its full name, i.e.
+	// projects/{project}/topics/{topic}.
+	// +optional
+	Topic *string `json:"topic,omitempty"`
+
+	// TopicRef references a Topic and retrieves its name
+	// +optional
+	TopicRef *xpv1.Reference `json:"topicRef,omitempty"`
+
+	// TopicSelector selects a reference to a Topic
+	// +optional
+	TopicSelector *xpv1.Selector `json:"topicSelector,omitempty"`
+}
+
+// FeedObservation is used to show the observed state of the Feed.
+type FeedObservation struct {
+	// Name: The resource name of the feed in the format
+	// `projects/{project_number}/feeds/{feed_id}`.
+	Name string `json:"name,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// FeedSpec defines the desired state of a Feed.
+type FeedSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FeedParameters `json:"forProvider"`
+}
+
+// FeedStatus represents the observed state of a Feed.
+type FeedStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FeedObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Feed is a managed resource that represents a Cloud Asset Inventory feed,
+// which publishes updates of the assets of the provider's project to Pub/Sub.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="CONTENT-TYPE",type="string",JSONPath=".spec.forProvider.contentType"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Feed struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FeedSpec   `json:"spec"`
+	Status FeedStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FeedList contains a list of Feed types
+type FeedList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Feed `json:"items"`
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pubsubv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+// ResolveReferences of this Feed
+func (mg *Feed) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.feedOutputConfig.pubsubDestination.topic
+	dst := &mg.Spec.ForProvider.FeedOutputConfig.PubsubDestination
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(dst.Topic),
+		Reference:    dst.TopicRef,
+		Selector:     dst.TopicSelector,
+		To:           reference.To{Managed: &pubsubv1alpha1.Topic{}, List: &pubsubv1alpha1.TopicList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.feedOutputConfig.pubsubDestination.topic")
+	}
+	dst.Topic = reference.ToPtrValue(rsp.ResolvedValue)
+	dst.TopicRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,229 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Feed) DeepCopyInto(out *Feed) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Feed.
+func (in *Feed) DeepCopy() *Feed {
+	if in == nil {
+		return nil
+	}
+	out := new(Feed)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Feed) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeedList) DeepCopyInto(out *FeedList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Feed, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeedList.
+func (in *FeedList) DeepCopy() *FeedList {
+	if in == nil {
+		return nil
+	}
+	out := new(FeedList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FeedList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeedObservation) DeepCopyInto(out *FeedObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeedObservation.
+func (in *FeedObservation) DeepCopy() *FeedObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FeedObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeedOutputConfig) DeepCopyInto(out *FeedOutputConfig) {
+	*out = *in
+	in.PubsubDestination.DeepCopyInto(&out.PubsubDestination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeedOutputConfig.
+func (in *FeedOutputConfig) DeepCopy() *FeedOutputConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FeedOutputConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeedParameters) DeepCopyInto(out *FeedParameters) {
+	*out = *in
+	if in.AssetNames != nil {
+		in, out := &in.AssetNames, &out.AssetNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AssetTypes != nil {
+		in, out := &in.AssetTypes, &out.AssetTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.RelationshipTypes != nil {
+		in, out := &in.RelationshipTypes, &out.RelationshipTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(iamv1alpha1.Expr)
+		(*in).DeepCopyInto(*out)
+	}
+	in.FeedOutputConfig.DeepCopyInto(&out.FeedOutputConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeedParameters.
+func (in *FeedParameters) DeepCopy() *FeedParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FeedParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeedSpec) DeepCopyInto(out *FeedSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeedSpec.
+func (in *FeedSpec) DeepCopy() *FeedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FeedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeedStatus) DeepCopyInto(out *FeedStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeedStatus.
+func (in *FeedStatus) DeepCopy() *FeedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FeedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PubsubDestination) DeepCopyInto(out *PubsubDestination) {
+	*out = *in
+	if in.Topic != nil {
+		in, out := &in.Topic, &out.Topic
+		*out = new(string)
+		**out = **in
+	}
+	if in.TopicRef != nil {
+		in, out := &in.TopicRef, &out.TopicRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopicSelector != nil {
+		in, out := &in.TopicSelector, &out.TopicSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PubsubDestination.
+func (in *PubsubDestination) DeepCopy() *PubsubDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(PubsubDestination)
+	in.DeepCopyInto(out)
+	return out
+}
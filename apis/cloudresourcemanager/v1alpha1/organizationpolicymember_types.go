@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// OrganizationPolicyMemberParameters defines parameters for a desired
+// membership of an organization IAM policy.
+type OrganizationPolicyMemberParameters struct {
+	// Organization: The numeric ID of the organization whose IAM policy the
+	// member is bound in, e.g. `123456789012`. The `organizations/` prefix
+	// may be included.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="organization is immutable"
+	Organization string `json:"organization"`
+
+	PolicyMember `json:",inline"`
+}
+
+// OrganizationPolicyMemberSpec defines the desired state of a
+// OrganizationPolicyMember.
+type OrganizationPolicyMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationPolicyMemberParameters `json:"forProvider"`
+}
+
+// OrganizationPolicyMemberObservation represents the observed state of a
+// OrganizationPolicyMember.
+type OrganizationPolicyMemberObservation struct {
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// BoundMembers: The members the role was last bound to by this
+	// provider. Members that are no longer declared are unbound.
+	BoundMembers []string `json:"boundMembers,omitempty"`
+}
+
+// OrganizationPolicyMemberStatus represents the observed state of a
+// OrganizationPolicyMember.
+type OrganizationPolicyMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationPolicyMemberObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationPolicyMember is a managed resource that represents membership
+// of a Google Cloud organization IAM policy. Other members of the policy are
+// left untouched.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ORGANIZATION",type="string",JSONPath=".spec.forProvider.organization"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="MEMBER",type="string",JSONPath=".spec.forProvider.member"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type OrganizationPolicyMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationPolicyMemberSpec   `json:"spec"`
+	Status OrganizationPolicyMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationPolicyMemberList contains a list of OrganizationPolicyMember types
+type OrganizationPolicyMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationPolicyMember `json:"items"`
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+// PolicyMember is the membership of an IAM policy of a Resource Manager
+// resource, i.e. a project, folder or organization: a role that is bound to
+// one or more members, optionally under a condition.
+// +kubebuilder:validation:XValidation:rule="has(self.role) || has(self.roleRef) || has(self.roleSelector)",message="one of role, roleRef or roleSelector is required"
+type PolicyMember struct {
+	// Role: Role that is assigned to `members`.
+	// For example, `roles/viewer`, `roles/editor`, or `roles/owner`.
+	// Custom roles are given by their relative resource name, e.g.
+	// `organizations/123456789012/roles/reader`.
+	// +optional
+	// +immutable
+	Role string `json:"role,omitempty"`
+
+	// RoleRef references a CustomRole and retrieves its relative resource
+	// name. Custom roles of a project can only be bound in the policy of
+	// that project, and custom roles of an organization only in policies
+	// within that organization.
+	// +optional
+	// +immutable
+	RoleRef *xpv1.Reference `json:"roleRef,omitempty"`
+
+	// RoleSelector selects a reference to a CustomRole.
+	// +optional
+	// +immutable
+	RoleSelector *xpv1.Selector `json:"roleSelector,omitempty"`
+
+	// Condition: The IAM condition under which the role is bound to the
+	// member. The member is bound in the binding of the role that has the
+	// same condition, so the same role may be bound to the member once per
+	// condition.
+	// +optional
+	// +immutable
+	Condition *iamv1alpha1.Expr `json:"condition,omitempty"`
+
+	// Member: Specifies the identity requesting access for a Cloud
+	// Platform resource, for example `user:alice@example.com`,
+	// `serviceAccount:my-app@my-project.iam.gserviceaccount.com`,
+	// `group:admins@example.com` or `domain:example.com`.
+	// +optional
+	Member *string `json:"member,omitempty"`
+
+	// Members: Specifies a list of identities that are granted the role in
+	// addition to Member. Members take the same values as Member.
+	// +optional
+	Members []string `json:"members,omitempty"`
+
+	// ServiceAccountMemberRef is reference to ServiceAccount used to set
+	// the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberRef *xpv1.Reference `json:"serviceAccountMemberRef,omitempty"`
+
+	// ServiceAccountMemberSelector selects reference to ServiceAccount used
+	// to set the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberSelector *xpv1.Selector `json:"serviceAccountMemberSelector,omitempty"`
+
+	// ServiceAccountMembersRefs are references to ServiceAccounts whose
+	// members, each as `serviceAccount:<email>`, are added to the Members.
+	// +optional
+	// +immutable
+	ServiceAccountMembersRefs []xpv1.Reference `json:"serviceAccountMembersRefs,omitempty"`
+
+	// ServiceAccountMembersSelector selects references to ServiceAccounts
+	// whose members are added to the Members.
+	// +optional
+	// +immutable
+	ServiceAccountMembersSelector *xpv1.Selector `json:"serviceAccountMembersSelector,omitempty"`
+}
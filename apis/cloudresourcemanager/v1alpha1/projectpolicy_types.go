@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// ProjectPolicyParameters defines parameters for a desired project IAM
+// policy.
+type ProjectPolicyParameters struct {
+	// Project: The ID of the project whose IAM policy is managed. Defaults
+	// to the project of the ProviderConfig.
+	// +optional
+	// +immutable
+	Project *string `json:"project,omitempty"`
+
+	// Policy: An Identity and Access Management (IAM) policy, which
+	// specifies access controls for Google Cloud resources. Its bindings
+	// replace all bindings of the project's IAM policy, so any binding
+	// that is not declared here is removed. The audit configs of the
+	// project are left untouched.
+	Policy iamv1alpha1.Policy `json:"policy"`
+}
+
+// ProjectPolicyObservation is used to show the observed state of the
+// ProjectPolicy resource on GCP.
+type ProjectPolicyObservation struct {
+	// Version: Specifies the format of the policy.
+	Version int64 `json:"version,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// ProjectPolicySpec defines the desired state of a ProjectPolicy.
+type ProjectPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectPolicyParameters `json:"forProvider"`
+}
+
+// ProjectPolicyStatus represents the observed state of a ProjectPolicy.
+type ProjectPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectPolicyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectPolicy is a managed resource that represents the authoritative IAM
+// policy of a Google Cloud project. Deleting it removes only the members of
+// the bindings it declares, leaving any other bindings of the project in
+// place.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.project"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ProjectPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectPolicySpec   `json:"spec"`
+	Status ProjectPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectPolicyList contains a list of ProjectPolicy types
+type ProjectPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectPolicy `json:"items"`
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// ProjectPolicyMemberParameters defines parameters for a desired membership
+// of a project IAM policy.
+type ProjectPolicyMemberParameters struct {
+	// Project: The ID of the project whose IAM policy the member is bound
+	// in. Defaults to the project of the ProviderConfig.
+	// +optional
+	// +immutable
+	Project *string `json:"project,omitempty"`
+
+	PolicyMember `json:",inline"`
+}
+
+// ProjectPolicyMemberSpec defines the desired state of a
+// ProjectPolicyMember.
+type ProjectPolicyMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectPolicyMemberParameters `json:"forProvider"`
+}
+
+// ProjectPolicyMemberObservation represents the observed state of a
+// ProjectPolicyMember.
+type ProjectPolicyMemberObservation struct {
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// BoundMembers: The members the role was last bound to by this
+	// provider. Members that are no longer declared are unbound.
+	BoundMembers []string `json:"boundMembers,omitempty"`
+}
+
+// ProjectPolicyMemberStatus represents the observed state of a
+// ProjectPolicyMember.
+type ProjectPolicyMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectPolicyMemberObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectPolicyMember is a managed resource that represents membership of a
+// Google Cloud project IAM policy. Other members of the policy are left
+// untouched.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.project"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="MEMBER",type="string",JSONPath=".spec.forProvider.member"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ProjectPolicyMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectPolicyMemberSpec   `json:"spec"`
+	Status ProjectPolicyMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectPolicyMemberList contains a list of ProjectPolicyMember types
+type ProjectPolicyMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectPolicyMember `json:"items"`
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// ResolveReferences of this ProjectPolicy
+func (in *ProjectPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.ForProvider.Policy.Bindings[*].Members
+	for i := range in.Spec.ForProvider.Policy.Bindings {
+		mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: in.Spec.ForProvider.Policy.Bindings[i].Members,
+			References:    in.Spec.ForProvider.Policy.Bindings[i].ServiceAccountMemberRefs,
+			Selector:      in.Spec.ForProvider.Policy.Bindings[i].ServiceAccountMemberSelector,
+			To:            reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+			Extract:       iamv1alpha1.ServiceAccountMemberName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.Policy.Bindings[%d].Members", i)
+		}
+		in.Spec.ForProvider.Policy.Bindings[i].Members = mrsp.ResolvedValues
+		in.Spec.ForProvider.Policy.Bindings[i].ServiceAccountMemberRefs = mrsp.ResolvedReferences
+	}
+
+	return nil
+}
+
+// ResolveReferences of this ProjectPolicyMember
+func (in *ProjectPolicyMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return in.Spec.ForProvider.PolicyMember.resolveReferences(ctx, reference.NewAPIResolver(c, in), gcpv1beta1.ScopeProject, reference.FromPtrValue(in.Spec.ForProvider.Project))
+}
+
+// ResolveReferences of this FolderPolicyMember
+func (in *FolderPolicyMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return in.Spec.ForProvider.PolicyMember.resolveReferences(ctx, reference.NewAPIResolver(c, in), gcpv1beta1.ScopeFolder, in.Spec.ForProvider.Folder)
+}
+
+// ResolveReferences of this OrganizationPolicyMember
+func (in *OrganizationPolicyMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return in.Spec.ForProvider.PolicyMember.resolveReferences(ctx, reference.NewAPIResolver(c, in), gcpv1beta1.ScopeOrganization, in.Spec.ForProvider.Organization)
+}
+
+// resolveReferences resolves the references of a member of the IAM policy of
+// the project, folder or organization of the supplied scope and ID. The ID
+// may be empty if it is not known, e.g. for the project of the ProviderConfig.
+func (pm *PolicyMember) resolveReferences(ctx context.Context, r *reference.APIResolver, scope, id string) error {
+	// Resolve spec.forProvider.role
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: pm.Role,
+		Reference:    pm.RoleRef,
+		Selector:     pm.RoleSelector,
+		To:           reference.To{Managed: &iamv1alpha1.CustomRole{}, List: &iamv1alpha1.CustomRoleList{}},
+		Extract:      iamv1alpha1.CustomRoleName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.role")
+	}
+	pm.Role = rsp.ResolvedValue
+	pm.RoleRef = rsp.ResolvedReference
+	if err := checkRoleScope(pm.Role, scope, id); err != nil {
+		return errors.Wrap(err, "spec.forProvider.role")
+	}
+
+	// Resolve spec.forProvider.member
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(pm.Member),
+		Reference:    pm.ServiceAccountMemberRef,
+		Selector:     pm.ServiceAccountMemberSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.member")
+	}
+	pm.Member = reference.ToPtrValue(rsp.ResolvedValue)
+	pm.ServiceAccountMemberRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.members. Members resolved from references are
+	// added to the literal ones, so the references are resolved even when
+	// members are already set.
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		References: pm.ServiceAccountMembersRefs,
+		Selector:   pm.ServiceAccountMembersSelector,
+		To:         reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:    iamv1alpha1.ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.members")
+	}
+	pm.Members = iamv1alpha1.MergeMembers(pm.Members, mrsp.ResolvedValues)
+	pm.ServiceAccountMembersRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// checkRoleScope returns an error if the supplied role cannot be bound in the
+// IAM policy of the project, folder or organization of the supplied scope and
+// ID. Custom roles of a project can only be bound in the policy of that
+// project. Custom roles of an organization can be bound in policies within
+// that organization, but only the policy of the organization itself is known
+// to be within it. Predefined roles, e.g. roles/viewer, can be bound anywhere.
+func checkRoleScope(role, scope, id string) error {
+	rs := gcpv1beta1.ScopeOf(role)
+	switch {
+	case rs == "":
+		return nil
+	case rs == gcpv1beta1.ScopeProject && scope != gcpv1beta1.ScopeProject:
+		return errors.Errorf("custom role %s of a project cannot be bound in the policy of a %s", role, strings.ToLower(scope))
+	case rs == scope && id != "" && gcpv1beta1.ScopedName(scope, id) != gcpv1beta1.ScopedName(rs, gcpv1beta1.ScopeID(role)):
+		return errors.Errorf("custom role %s cannot be bound in the policy of %s", role, gcpv1beta1.ScopedName(scope, id))
+	}
+	return nil
+}
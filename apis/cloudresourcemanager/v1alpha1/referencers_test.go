@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+const (
+	testProjectRole      = "projects/my-project/roles/reader"
+	testOrganizationRole = "organizations/5678/roles/reader"
+)
+
+func TestCheckRoleScope(t *testing.T) {
+	cases := map[string]struct {
+		role    string
+		scope   string
+		id      string
+		wantErr bool
+	}{
+		"PredefinedRole":              {role: "roles/viewer", scope: gcpv1beta1.ScopeFolder, id: "1234"},
+		"ProjectRoleInSameProject":    {role: testProjectRole, scope: gcpv1beta1.ScopeProject, id: "my-project"},
+		"ProjectRoleInDefaultProject": {role: testProjectRole, scope: gcpv1beta1.ScopeProject},
+		"ProjectRoleInOtherProject":   {role: testProjectRole, scope: gcpv1beta1.ScopeProject, id: "other-project", wantErr: true},
+		"ProjectRoleInFolder":         {role: testProjectRole, scope: gcpv1beta1.ScopeFolder, id: "1234", wantErr: true},
+		"OrganizationRoleInSameOrg":   {role: testOrganizationRole, scope: gcpv1beta1.ScopeOrganization, id: "organizations/5678"},
+		"OrganizationRoleInOtherOrg":  {role: testOrganizationRole, scope: gcpv1beta1.ScopeOrganization, id: "9999", wantErr: true},
+		"OrganizationRoleInFolder":    {role: testOrganizationRole, scope: gcpv1beta1.ScopeFolder, id: "1234"},
+		"OrganizationRoleInProject":   {role: testOrganizationRole, scope: gcpv1beta1.ScopeProject, id: "my-project"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := checkRoleScope(tc.role, tc.scope, tc.id)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("checkRoleScope(...): want error %t, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestOrganizationPolicyMemberResolveReferences(t *testing.T) {
+	scheme, err := iamv1alpha1.SchemeBuilder.Build()
+	if err != nil {
+		t.Fatalf("Failed to build scheme: %s", err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(&iamv1alpha1.CustomRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "reader"},
+		Status: iamv1alpha1.CustomRoleStatus{
+			AtProvider: iamv1alpha1.CustomRoleObservation{Name: testOrganizationRole},
+		},
+	}).Build()
+
+	cases := map[string]struct {
+		organization string
+		wantRole     string
+		wantErr      bool
+	}{
+		"SameOrganization": {
+			organization: "5678",
+			wantRole:     testOrganizationRole,
+		},
+		"OtherOrganization": {
+			organization: "9999",
+			wantErr:      true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pm := &OrganizationPolicyMember{Spec: OrganizationPolicyMemberSpec{ForProvider: OrganizationPolicyMemberParameters{
+				Organization: tc.organization,
+				PolicyMember: PolicyMember{RoleRef: &xpv1.Reference{Name: "reader"}, Member: &testMember},
+			}}}
+			err := pm.ResolveReferences(context.Background(), c)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ResolveReferences(...): want error %t, got %v", tc.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.wantRole, pm.Spec.ForProvider.Role); diff != "" {
+				t.Errorf("ResolveReferences(...): -want role, +got role:\n%s", diff)
+			}
+		})
+	}
+}
+
+var testMember = "user:alice@example.com"
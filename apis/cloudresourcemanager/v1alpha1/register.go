@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "cloudresourcemanager.gcp.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// FolderPolicyMember type metadata.
+var (
+	FolderPolicyMemberKind             = reflect.TypeOf(FolderPolicyMember{}).Name()
+	FolderPolicyMemberGroupKind        = schema.GroupKind{Group: Group, Kind: FolderPolicyMemberKind}.String()
+	FolderPolicyMemberKindAPIVersion   = FolderPolicyMemberKind + "." + SchemeGroupVersion.String()
+	FolderPolicyMemberGroupVersionKind = SchemeGroupVersion.WithKind(FolderPolicyMemberKind)
+)
+
+// OrganizationPolicyMember type metadata.
+var (
+	OrganizationPolicyMemberKind             = reflect.TypeOf(OrganizationPolicyMember{}).Name()
+	OrganizationPolicyMemberGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationPolicyMemberKind}.String()
+	OrganizationPolicyMemberKindAPIVersion   = OrganizationPolicyMemberKind + "." + SchemeGroupVersion.String()
+	OrganizationPolicyMemberGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationPolicyMemberKind)
+)
+
+// ProjectPolicy type metadata.
+var (
+	ProjectPolicyKind             = reflect.TypeOf(ProjectPolicy{}).Name()
+	ProjectPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectPolicyKind}.String()
+	ProjectPolicyKindAPIVersion   = ProjectPolicyKind + "." + SchemeGroupVersion.String()
+	ProjectPolicyGroupVersionKind = SchemeGroupVersion.WithKind(ProjectPolicyKind)
+)
+
+// ProjectPolicyMember type metadata.
+var (
+	ProjectPolicyMemberKind             = reflect.TypeOf(ProjectPolicyMember{}).Name()
+	ProjectPolicyMemberGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectPolicyMemberKind}.String()
+	ProjectPolicyMemberKindAPIVersion   = ProjectPolicyMemberKind + "." + SchemeGroupVersion.String()
+	ProjectPolicyMemberGroupVersionKind = SchemeGroupVersion.WithKind(ProjectPolicyMemberKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&FolderPolicyMember{}, &FolderPolicyMemberList{})
+	SchemeBuilder.Register(&OrganizationPolicyMember{}, &OrganizationPolicyMemberList{})
+	SchemeBuilder.Register(&ProjectPolicy{}, &ProjectPolicyList{})
+	SchemeBuilder.Register(&ProjectPolicyMember{}, &ProjectPolicyMemberList{})
+}
@@ -0,0 +1,632 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FolderPolicyMember) DeepCopyInto(out *FolderPolicyMember) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FolderPolicyMember.
+func (in *FolderPolicyMember) DeepCopy() *FolderPolicyMember {
+	if in == nil {
+		return nil
+	}
+	out := new(FolderPolicyMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FolderPolicyMember) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FolderPolicyMemberList) DeepCopyInto(out *FolderPolicyMemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FolderPolicyMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FolderPolicyMemberList.
+func (in *FolderPolicyMemberList) DeepCopy() *FolderPolicyMemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(FolderPolicyMemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FolderPolicyMemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FolderPolicyMemberObservation) DeepCopyInto(out *FolderPolicyMemberObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BoundMembers != nil {
+		in, out := &in.BoundMembers, &out.BoundMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FolderPolicyMemberObservation.
+func (in *FolderPolicyMemberObservation) DeepCopy() *FolderPolicyMemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FolderPolicyMemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FolderPolicyMemberParameters) DeepCopyInto(out *FolderPolicyMemberParameters) {
+	*out = *in
+	in.PolicyMember.DeepCopyInto(&out.PolicyMember)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FolderPolicyMemberParameters.
+func (in *FolderPolicyMemberParameters) DeepCopy() *FolderPolicyMemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FolderPolicyMemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FolderPolicyMemberSpec) DeepCopyInto(out *FolderPolicyMemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FolderPolicyMemberSpec.
+func (in *FolderPolicyMemberSpec) DeepCopy() *FolderPolicyMemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FolderPolicyMemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FolderPolicyMemberStatus) DeepCopyInto(out *FolderPolicyMemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FolderPolicyMemberStatus.
+func (in *FolderPolicyMemberStatus) DeepCopy() *FolderPolicyMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FolderPolicyMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPolicyMember) DeepCopyInto(out *OrganizationPolicyMember) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPolicyMember.
+func (in *OrganizationPolicyMember) DeepCopy() *OrganizationPolicyMember {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPolicyMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationPolicyMember) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPolicyMemberList) DeepCopyInto(out *OrganizationPolicyMemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationPolicyMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPolicyMemberList.
+func (in *OrganizationPolicyMemberList) DeepCopy() *OrganizationPolicyMemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPolicyMemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationPolicyMemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPolicyMemberObservation) DeepCopyInto(out *OrganizationPolicyMemberObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BoundMembers != nil {
+		in, out := &in.BoundMembers, &out.BoundMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPolicyMemberObservation.
+func (in *OrganizationPolicyMemberObservation) DeepCopy() *OrganizationPolicyMemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPolicyMemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPolicyMemberParameters) DeepCopyInto(out *OrganizationPolicyMemberParameters) {
+	*out = *in
+	in.PolicyMember.DeepCopyInto(&out.PolicyMember)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPolicyMemberParameters.
+func (in *OrganizationPolicyMemberParameters) DeepCopy() *OrganizationPolicyMemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPolicyMemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPolicyMemberSpec) DeepCopyInto(out *OrganizationPolicyMemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPolicyMemberSpec.
+func (in *OrganizationPolicyMemberSpec) DeepCopy() *OrganizationPolicyMemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPolicyMemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationPolicyMemberStatus) DeepCopyInto(out *OrganizationPolicyMemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationPolicyMemberStatus.
+func (in *OrganizationPolicyMemberStatus) DeepCopy() *OrganizationPolicyMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationPolicyMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyMember) DeepCopyInto(out *PolicyMember) {
+	*out = *in
+	if in.RoleRef != nil {
+		in, out := &in.RoleRef, &out.RoleRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleSelector != nil {
+		in, out := &in.RoleSelector, &out.RoleSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(iamv1alpha1.Expr)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Member != nil {
+		in, out := &in.Member, &out.Member
+		*out = new(string)
+		**out = **in
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccountMemberRef != nil {
+		in, out := &in.ServiceAccountMemberRef, &out.ServiceAccountMemberRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountMemberSelector != nil {
+		in, out := &in.ServiceAccountMemberSelector, &out.ServiceAccountMemberSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountMembersRefs != nil {
+		in, out := &in.ServiceAccountMembersRefs, &out.ServiceAccountMembersRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceAccountMembersSelector != nil {
+		in, out := &in.ServiceAccountMembersSelector, &out.ServiceAccountMembersSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyMember.
+func (in *PolicyMember) DeepCopy() *PolicyMember {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicy) DeepCopyInto(out *ProjectPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicy.
+func (in *ProjectPolicy) DeepCopy() *ProjectPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyList) DeepCopyInto(out *ProjectPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyList.
+func (in *ProjectPolicyList) DeepCopy() *ProjectPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyMember) DeepCopyInto(out *ProjectPolicyMember) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyMember.
+func (in *ProjectPolicyMember) DeepCopy() *ProjectPolicyMember {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectPolicyMember) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyMemberList) DeepCopyInto(out *ProjectPolicyMemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectPolicyMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyMemberList.
+func (in *ProjectPolicyMemberList) DeepCopy() *ProjectPolicyMemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyMemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectPolicyMemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyMemberObservation) DeepCopyInto(out *ProjectPolicyMemberObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BoundMembers != nil {
+		in, out := &in.BoundMembers, &out.BoundMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyMemberObservation.
+func (in *ProjectPolicyMemberObservation) DeepCopy() *ProjectPolicyMemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyMemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyMemberParameters) DeepCopyInto(out *ProjectPolicyMemberParameters) {
+	*out = *in
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	in.PolicyMember.DeepCopyInto(&out.PolicyMember)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyMemberParameters.
+func (in *ProjectPolicyMemberParameters) DeepCopy() *ProjectPolicyMemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyMemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyMemberSpec) DeepCopyInto(out *ProjectPolicyMemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyMemberSpec.
+func (in *ProjectPolicyMemberSpec) DeepCopy() *ProjectPolicyMemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyMemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyMemberStatus) DeepCopyInto(out *ProjectPolicyMemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyMemberStatus.
+func (in *ProjectPolicyMemberStatus) DeepCopy() *ProjectPolicyMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyObservation) DeepCopyInto(out *ProjectPolicyObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyObservation.
+func (in *ProjectPolicyObservation) DeepCopy() *ProjectPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyParameters) DeepCopyInto(out *ProjectPolicyParameters) {
+	*out = *in
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	in.Policy.DeepCopyInto(&out.Policy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyParameters.
+func (in *ProjectPolicyParameters) DeepCopy() *ProjectPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicySpec) DeepCopyInto(out *ProjectPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicySpec.
+func (in *ProjectPolicySpec) DeepCopy() *ProjectPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPolicyStatus) DeepCopyInto(out *ProjectPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPolicyStatus.
+func (in *ProjectPolicyStatus) DeepCopy() *ProjectPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// AutoscalerParameters define the desired state of a Google Compute Engine
+// zonal or regional autoscaler. Most fields map directly to an Autoscaler:
+// https://cloud.google.com/compute/docs/reference/rest/v1/autoscalers
+type AutoscalerParameters struct {
+	// Zone: The zone where a zonal autoscaler is located. Exactly one of
+	// zone or region must be set, matching the location of the target.
+	// +optional
+	// +immutable
+	Zone *string `json:"zone,omitempty"`
+
+	// Region: The region where a regional autoscaler is located. Exactly
+	// one of zone or region must be set, matching the location of the
+	// target.
+	// +optional
+	// +immutable
+	Region *string `json:"region,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Target: URL of the managed instance group that this autoscaler will
+	// scale.
+	// +optional
+	// +immutable
+	Target *string `json:"target,omitempty"`
+
+	// TargetRef references an InstanceGroupManager and retrieves its URL
+	// +optional
+	// +immutable
+	TargetRef *xpv1.Reference `json:"targetRef,omitempty"`
+
+	// TargetSelector selects a reference to an InstanceGroupManager
+	// +optional
+	TargetSelector *xpv1.Selector `json:"targetSelector,omitempty"`
+
+	// AutoscalingPolicy: The configuration parameters for the autoscaling
+	// algorithm.
+	AutoscalingPolicy AutoscalingPolicy `json:"autoscalingPolicy"`
+}
+
+// AutoscalingPolicy is the autoscaling configuration of an Autoscaler.
+type AutoscalingPolicy struct {
+	// MinNumReplicas: The minimum number of replicas that the autoscaler
+	// can scale in to.
+	// +optional
+	MinNumReplicas *int64 `json:"minNumReplicas,omitempty"`
+
+	// MaxNumReplicas: The maximum number of instances that the autoscaler
+	// can scale out to.
+	MaxNumReplicas int64 `json:"maxNumReplicas"`
+
+	// CoolDownPeriodSec: The number of seconds that the autoscaler waits
+	// before it starts collecting information from a new instance.
+	// +optional
+	CoolDownPeriodSec *int64 `json:"coolDownPeriodSec,omitempty"`
+
+	// Mode: Defines the operating mode for this policy.
+	// +optional
+	// +kubebuilder:validation:Enum=OFF;ON;ONLY_SCALE_OUT;ONLY_UP
+	Mode *string `json:"mode,omitempty"`
+
+	// CPUUtilization: Defines the CPU utilization policy that allows the
+	// autoscaler to scale based on the average CPU utilization of a managed
+	// instance group.
+	// +optional
+	CPUUtilization *AutoscalingPolicyCPUUtilization `json:"cpuUtilization,omitempty"`
+
+	// LoadBalancingUtilization: Configuration parameters of autoscaling
+	// based on load balancer.
+	// +optional
+	LoadBalancingUtilization *AutoscalingPolicyLoadBalancingUtilization `json:"loadBalancingUtilization,omitempty"`
+
+	// CustomMetricUtilizations: Configuration parameters of autoscaling
+	// based on a custom metric.
+	// +optional
+	CustomMetricUtilizations []AutoscalingPolicyCustomMetricUtilization `json:"customMetricUtilizations,omitempty"`
+
+	// ScalingSchedules: Scaling schedules defined for an autoscaler,
+	// keyed by schedule name. Multiple schedules can be set on an
+	// autoscaler, and they can overlap.
+	// +optional
+	ScalingSchedules map[string]AutoscalingPolicyScalingSchedule `json:"scalingSchedules,omitempty"`
+}
+
+// AutoscalingPolicyCPUUtilization configures autoscaling based on CPU
+// utilization.
+type AutoscalingPolicyCPUUtilization struct {
+	// UtilizationTarget: The target CPU utilization that the autoscaler
+	// maintains, as a decimal number between 0 and 1, e.g. "0.6".
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	UtilizationTarget string `json:"utilizationTarget"`
+
+	// PredictiveMethod: Indicates whether predictive autoscaling based on
+	// CPU metric is enabled.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;OPTIMIZE_AVAILABILITY
+	PredictiveMethod *string `json:"predictiveMethod,omitempty"`
+}
+
+// AutoscalingPolicyLoadBalancingUtilization configures autoscaling based on
+// the serving capacity of a load balancer.
+type AutoscalingPolicyLoadBalancingUtilization struct {
+	// UtilizationTarget: Fraction of backend capacity utilization (set in
+	// HTTP(S) load balancing configuration) that the autoscaler maintains,
+	// as a decimal number between 0 and 1, e.g. "0.8".
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	UtilizationTarget string `json:"utilizationTarget"`
+}
+
+// AutoscalingPolicyCustomMetricUtilization configures autoscaling based on a
+// Cloud Monitoring metric.
+type AutoscalingPolicyCustomMetricUtilization struct {
+	// Metric: The identifier (type) of the Stackdriver Monitoring metric.
+	Metric string `json:"metric"`
+
+	// Filter: A filter string, compatible with a Stackdriver Monitoring
+	// filter string for TimeSeries.list API call. This filter is used to
+	// select a specific TimeSeries for the purpose of autoscaling and to
+	// determine whether the metric is exporting per-instance or per-group
+	// data.
+	// +optional
+	Filter *string `json:"filter,omitempty"`
+
+	// SingleInstanceAssignment: If scaling is based on a per-group metric
+	// value that represents the total amount of work to be done or resource
+	// usage, set this value to an amount assigned for a single instance of
+	// the scaled group, as a decimal number, e.g. "100".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]*\.?[0-9]+$`
+	SingleInstanceAssignment *string `json:"singleInstanceAssignment,omitempty"`
+
+	// UtilizationTarget: The target value of the metric that autoscaler
+	// maintains, as a decimal number, e.g. "0.5".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]*\.?[0-9]+$`
+	UtilizationTarget *string `json:"utilizationTarget,omitempty"`
+
+	// UtilizationTargetType: Defines how target utilization value is
+	// expressed for a Stackdriver Monitoring metric.
+	// +optional
+	// +kubebuilder:validation:Enum=DELTA_PER_MINUTE;DELTA_PER_SECOND;GAUGE
+	UtilizationTargetType *string `json:"utilizationTargetType,omitempty"`
+}
+
+// AutoscalingPolicyScalingSchedule is a schedule that sets the minimum number
+// of replicas of the autoscaled group for a period of time.
+type AutoscalingPolicyScalingSchedule struct {
+	// MinRequiredReplicas: The minimum number of VM instances that the
+	// autoscaler will recommend in time intervals starting according to
+	// schedule.
+	MinRequiredReplicas int64 `json:"minRequiredReplicas"`
+
+	// Schedule: The start timestamps of time intervals when this scaling
+	// schedule is to provide a scaling signal, in extended cron format.
+	Schedule string `json:"schedule"`
+
+	// DurationSec: The duration of time intervals, in seconds, for which
+	// this scaling schedule is to run. The minimum allowed value is 300.
+	// +kubebuilder:validation:Minimum=300
+	DurationSec int64 `json:"durationSec"`
+
+	// TimeZone: The time zone to use when interpreting the schedule, as a
+	// name from the tz database, e.g. "America/New_York". Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Description: A description of a scaling schedule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Disabled: A boolean value that specifies whether a scaling schedule
+	// can influence autoscaler recommendations.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+}
+
+// AutoscalerStatusDetails is a status message of an Autoscaler.
+type AutoscalerStatusDetails struct {
+	// Message: The status message.
+	Message string `json:"message,omitempty"`
+
+	// Type: The type of error, warning, or notice returned.
+	Type string `json:"type,omitempty"`
+}
+
+// An AutoscalerObservation represents the observed state of a Google Compute
+// Engine autoscaler.
+type AutoscalerObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// RecommendedSize: Target recommended MIG size (number of instances)
+	// computed by autoscaler.
+	RecommendedSize int64 `json:"recommendedSize,omitempty"`
+
+	// Status: The status of the autoscaler configuration.
+	Status string `json:"status,omitempty"`
+
+	// StatusDetails: Human-readable details about the current state of the
+	// autoscaler.
+	StatusDetails []AutoscalerStatusDetails `json:"statusDetails,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// An AutoscalerSpec defines the desired state of an Autoscaler.
+type AutoscalerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AutoscalerParameters `json:"forProvider"`
+}
+
+// An AutoscalerStatus represents the observed state of an Autoscaler.
+type AutoscalerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AutoscalerObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Autoscaler is a managed resource that represents a Google Compute Engine
+// zonal or regional autoscaler. It scales the managed instance group it
+// targets.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="RECOMMENDED",type="integer",JSONPath=".status.atProvider.recommendedSize"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Autoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoscalerSpec   `json:"spec"`
+	Status AutoscalerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoscalerList contains a list of Autoscaler.
+type AutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Autoscaler `json:"items"`
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// BackendServiceParameters define the desired state of a Google Compute
+// Engine global backend service. Only the load balancing scheme of a backend
+// service cannot be changed once it was created.
+// https://cloud.google.com/compute/docs/reference/rest/v1/backendServices
+type BackendServiceParameters struct {
+	// Description: An optional description of the backend service.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// LoadBalancingScheme: The kind of load balancers the backend service
+	// is used by, i.e. EXTERNAL, EXTERNAL_MANAGED, INTERNAL_MANAGED or
+	// INTERNAL_SELF_MANAGED for Traffic Director. Defaults to EXTERNAL.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=EXTERNAL;EXTERNAL_MANAGED;INTERNAL_MANAGED;INTERNAL_SELF_MANAGED
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="loadBalancingScheme is immutable"
+	LoadBalancingScheme *string `json:"loadBalancingScheme,omitempty"`
+
+	// Protocol: The protocol the backend service uses to talk to its
+	// backends, i.e. HTTP, HTTPS, HTTP2, TCP, SSL or GRPC. Defaults to HTTP.
+	// +optional
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;HTTP2;TCP;SSL;GRPC
+	Protocol *string `json:"protocol,omitempty"`
+
+	// PortName: The named port of the backend instance groups traffic is
+	// sent to. Defaults to http.
+	// +optional
+	PortName *string `json:"portName,omitempty"`
+
+	// TimeoutSec: How long, in seconds, to wait for a backend to respond.
+	// Defaults to 30.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSec *int64 `json:"timeoutSec,omitempty"`
+
+	// ConnectionDrainingTimeoutSec: How long, in seconds, existing
+	// connections to a backend that is removed are kept open. Defaults to
+	// 300.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	ConnectionDrainingTimeoutSec *int64 `json:"connectionDrainingTimeoutSec,omitempty"`
+
+	// SessionAffinity: How requests of the same client are sent to the same
+	// backend, e.g. NONE, CLIENT_IP or GENERATED_COOKIE. Defaults to NONE.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;CLIENT_IP;CLIENT_IP_NO_DESTINATION;CLIENT_IP_PORT_PROTO;CLIENT_IP_PROTO;GENERATED_COOKIE;HEADER_FIELD;HTTP_COOKIE
+	SessionAffinity *string `json:"sessionAffinity,omitempty"`
+
+	// AffinityCookieTTLSec: The lifetime, in seconds, of the cookies used
+	// for session affinity. Cookies last as long as the browser session if
+	// it is 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	AffinityCookieTTLSec *int64 `json:"affinityCookieTtlSec,omitempty"`
+
+	// EnableCDN: Whether Cloud CDN caches the responses of the backend
+	// service. Only supported by external HTTP(S) load balancers.
+	// +optional
+	EnableCDN *bool `json:"enableCdn,omitempty"`
+
+	// IAP: The Identity-Aware Proxy settings of the backend service.
+	// +optional
+	IAP *BackendServiceIAP `json:"iap,omitempty"`
+
+	// HealthChecks: The URLs of the health checks of the backends, e.g.
+	// projects/my-project/global/healthChecks/my-check. At most one health
+	// check is supported.
+	// +optional
+	// +kubebuilder:validation:MaxItems=1
+	HealthChecks []string `json:"healthChecks,omitempty"`
+
+	// HealthCheckRefs references HealthChecks to retrieve their URLs.
+	// +optional
+	HealthCheckRefs []xpv1.Reference `json:"healthCheckRefs,omitempty"`
+
+	// HealthCheckSelector selects references to HealthChecks to retrieve
+	// their URLs.
+	// +optional
+	HealthCheckSelector *xpv1.Selector `json:"healthCheckSelector,omitempty"`
+
+	// Backends: The instance groups and network endpoint groups that serve
+	// the requests of the backend service.
+	// +optional
+	Backends []Backend `json:"backends,omitempty"`
+}
+
+// BackendServiceIAP configures the Identity-Aware Proxy of a backend service.
+// IAP uses a Google-managed OAuth client.
+type BackendServiceIAP struct {
+	// Enabled: Whether requests to the backend service are authorized by
+	// Identity-Aware Proxy.
+	Enabled bool `json:"enabled"`
+}
+
+// A Backend is an instance group or network endpoint group that serves the
+// requests of a backend service.
+// +kubebuilder:validation:XValidation:rule="!has(self.instanceGroupManagerRef) || !has(self.networkEndpointGroupRef)",message="at most one of instanceGroupManagerRef or networkEndpointGroupRef may be set"
+type Backend struct {
+	// Group: The URL of the instance group or network endpoint group, e.g.
+	// projects/my-project/zones/us-central1-a/instanceGroups/my-group.
+	// +optional
+	Group *string `json:"group,omitempty"`
+
+	// InstanceGroupManagerRef references an InstanceGroupManager to
+	// retrieve the URL of its instance group.
+	// +optional
+	InstanceGroupManagerRef *xpv1.Reference `json:"instanceGroupManagerRef,omitempty"`
+
+	// InstanceGroupManagerSelector selects a reference to an
+	// InstanceGroupManager to retrieve the URL of its instance group.
+	// +optional
+	InstanceGroupManagerSelector *xpv1.Selector `json:"instanceGroupManagerSelector,omitempty"`
+
+	// NetworkEndpointGroupRef references a NetworkEndpointGroup to retrieve
+	// its URL.
+	// +optional
+	NetworkEndpointGroupRef *xpv1.Reference `json:"networkEndpointGroupRef,omitempty"`
+
+	// NetworkEndpointGroupSelector selects a reference to a
+	// NetworkEndpointGroup to retrieve its URL.
+	// +optional
+	NetworkEndpointGroupSelector *xpv1.Selector `json:"networkEndpointGroupSelector,omitempty"`
+
+	// Description: An optional description of the backend.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// BalancingMode: How the capacity of the backend is measured, i.e.
+	// UTILIZATION, RATE or CONNECTION. Defaults to UTILIZATION for instance
+	// groups.
+	// +optional
+	// +kubebuilder:validation:Enum=UTILIZATION;RATE;CONNECTION
+	BalancingMode *string `json:"balancingMode,omitempty"`
+
+	// CapacityScaler: The share of the capacity of the backend that is
+	// used, as a decimal number between 0 and 1, e.g. "0.5". A backend is
+	// drained if it is "0". Defaults to "1".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	CapacityScaler *string `json:"capacityScaler,omitempty"`
+
+	// MaxUtilization: The target CPU utilization of the backend in
+	// UTILIZATION balancing mode, as a decimal number between 0 and 1,
+	// e.g. "0.8".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	MaxUtilization *string `json:"maxUtilization,omitempty"`
+
+	// MaxRatePerInstance: The target number of requests per second of
+	// every instance in RATE balancing mode, as a decimal number, e.g.
+	// "100".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	MaxRatePerInstance *string `json:"maxRatePerInstance,omitempty"`
+
+	// MaxRatePerEndpoint: The target number of requests per second of
+	// every endpoint in RATE balancing mode, as a decimal number, e.g.
+	// "100".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	MaxRatePerEndpoint *string `json:"maxRatePerEndpoint,omitempty"`
+
+	// MaxConnectionsPerInstance: The target number of connections of every
+	// instance in CONNECTION balancing mode.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnectionsPerInstance *int64 `json:"maxConnectionsPerInstance,omitempty"`
+
+	// MaxConnectionsPerEndpoint: The target number of connections of every
+	// endpoint in CONNECTION balancing mode.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnectionsPerEndpoint *int64 `json:"maxConnectionsPerEndpoint,omitempty"`
+}
+
+// BackendServiceObservation is used to show the observed state of the
+// BackendService.
+type BackendServiceObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Fingerprint: A hash of the backend service, used for optimistic
+	// locking when it is updated.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// BackendServiceSpec defines the desired state of a BackendService.
+type BackendServiceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BackendServiceParameters `json:"forProvider"`
+}
+
+// BackendServiceStatus represents the observed state of a BackendService.
+type BackendServiceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BackendServiceObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackendService is a managed resource that represents a Google Compute
+// Engine global backend service, which load balancers and Traffic Director
+// routes send requests to. The external name of the resource is the name of
+// the backend service.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SCHEME",type="string",JSONPath=".spec.forProvider.loadBalancingScheme"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type BackendService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackendServiceSpec   `json:"spec"`
+	Status BackendServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackendServiceList contains a list of BackendService types
+type BackendServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackendService `json:"items"`
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Disk statuses.
+const (
+	DiskStatusCreating  = "CREATING"
+	DiskStatusRestoring = "RESTORING"
+	DiskStatusReady     = "READY"
+	DiskStatusFailed    = "FAILED"
+	DiskStatusDeleting  = "DELETING"
+)
+
+// DiskParameters define the desired state of a Google Compute Engine zonal or
+// regional persistent disk. Labels and resource policies can be changed at any
+// time, and the disk can be resized while it is attached to a running
+// instance. Disks can only grow, never shrink.
+// https://cloud.google.com/compute/docs/reference/rest/v1/disks
+type DiskParameters struct {
+	// Zone: The zone of a zonal disk. Exactly one of zone or region must
+	// be set.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zone is immutable"
+	Zone *string `json:"zone,omitempty"`
+
+	// Region: The region of a regional disk. Exactly one of zone or region
+	// must be set.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region *string `json:"region,omitempty"`
+
+	// ReplicaZones: The two zones of the region a regional disk is
+	// replicated to.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:MaxItems=2
+	ReplicaZones []string `json:"replicaZones,omitempty"`
+
+	// Description: An optional description of the disk.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Type: The disk type of the disk, e.g. pd-balanced or pd-ssd.
+	// Defaults to pd-standard.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type *string `json:"type,omitempty"`
+
+	// SizeGB: The size of the disk in GB. It defaults to the size of the
+	// source image or snapshot, if any. The disk is resized online when
+	// this is increased. It cannot be decreased.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self >= oldSelf",message="sizeGb cannot be decreased"
+	SizeGB *int64 `json:"sizeGb,omitempty"`
+
+	// SourceImage: The image the disk is created from, e.g.
+	// projects/debian-cloud/global/images/family/debian-12.
+	// +optional
+	// +immutable
+	SourceImage *string `json:"sourceImage,omitempty"`
+
+	// SourceSnapshot: The snapshot the disk is created from, e.g.
+	// global/snapshots/my-snapshot.
+	// +optional
+	// +immutable
+	SourceSnapshot *string `json:"sourceSnapshot,omitempty"`
+
+	// Labels: The labels applied to the disk.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// ResourcePolicies: The URLs of the resource policies, e.g. snapshot
+	// schedules, attached to the disk. They must be in the region of the
+	// disk. Policies can be attached and detached at any time.
+	// +optional
+	ResourcePolicies []string `json:"resourcePolicies,omitempty"`
+
+	// ResourcePolicyRefs references ResourcePolicies to retrieve their
+	// URLs.
+	// +optional
+	ResourcePolicyRefs []xpv1.Reference `json:"resourcePolicyRefs,omitempty"`
+
+	// ResourcePolicySelector selects references to ResourcePolicies to
+	// retrieve their URLs.
+	// +optional
+	ResourcePolicySelector *xpv1.Selector `json:"resourcePolicySelector,omitempty"`
+}
+
+// DiskObservation is used to show the observed state of the Disk.
+type DiskObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the disk, i.e. CREATING, RESTORING, FAILED,
+	// READY or DELETING.
+	Status string `json:"status,omitempty"`
+
+	// SizeGB: The size of the disk in GB.
+	SizeGB int64 `json:"sizeGb,omitempty"`
+
+	// Users: The URLs of the instances the disk is attached to.
+	Users []string `json:"users,omitempty"`
+
+	// LastAttachTimestamp: Last attach timestamp in RFC3339 text format.
+	LastAttachTimestamp string `json:"lastAttachTimestamp,omitempty"`
+
+	// LastDetachTimestamp: Last detach timestamp in RFC3339 text format.
+	LastDetachTimestamp string `json:"lastDetachTimestamp,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// DiskSpec defines the desired state of a Disk.
+type DiskSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DiskParameters `json:"forProvider"`
+}
+
+// DiskStatus represents the observed state of a Disk.
+type DiskStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DiskObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Disk is a managed resource that represents a zonal or regional Google
+// Compute Engine persistent disk. The external name of the resource is the
+// name of the disk.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".status.atProvider.sizeGb"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Disk struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DiskSpec   `json:"spec"`
+	Status DiskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DiskList contains a list of Disk types
+type DiskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Disk `json:"items"`
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// GetFailureReason of this Firewall.
+func (mg *Firewall) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this Firewall.
+func (mg *Firewall) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this InstanceGroupManager.
+func (mg *InstanceGroupManager) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this InstanceGroupManager.
+func (mg *InstanceGroupManager) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this NetworkEndpointGroup.
+func (mg *NetworkEndpointGroup) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this NetworkEndpointGroup.
+func (mg *NetworkEndpointGroup) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this Router.
+func (mg *Router) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this Router.
+func (mg *Router) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this ImageImport.
+func (mg *ImageImport) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this ImageImport.
+func (mg *ImageImport) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this InstanceTemplate.
+func (mg *InstanceTemplate) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this InstanceTemplate.
+func (mg *InstanceTemplate) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this Autoscaler.
+func (mg *Autoscaler) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this Autoscaler.
+func (mg *Autoscaler) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this PacketMirroring.
+func (mg *PacketMirroring) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this PacketMirroring.
+func (mg *PacketMirroring) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this Route.
+func (mg *Route) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this Route.
+func (mg *Route) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this PolicyBasedRoute.
+func (mg *PolicyBasedRoute) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this PolicyBasedRoute.
+func (mg *PolicyBasedRoute) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this Instance.
+func (mg *Instance) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this Instance.
+func (mg *Instance) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this Disk.
+func (mg *Disk) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this Disk.
+func (mg *Disk) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this ResourcePolicy.
+func (mg *ResourcePolicy) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this ResourcePolicy.
+func (mg *ResourcePolicy) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this Snapshot.
+func (mg *Snapshot) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this Snapshot.
+func (mg *Snapshot) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this RouterNAT.
+func (mg *RouterNAT) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this RouterNAT.
+func (mg *RouterNAT) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this RouterPeer.
+func (mg *RouterPeer) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this RouterPeer.
+func (mg *RouterPeer) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this HealthCheck.
+func (mg *HealthCheck) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this HealthCheck.
+func (mg *HealthCheck) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this BackendService.
+func (mg *BackendService) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this BackendService.
+func (mg *BackendService) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
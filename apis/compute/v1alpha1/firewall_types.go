@@ -20,19 +20,20 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // FirewallParameters define the desired state of a Google Compute Engine
 // Firewall rule. Most fields map directly to a Firewall:
 // https://cloud.google.com/compute/docs/reference/rest/v1/firewalls/
 type FirewallParameters struct {
-	// Description: An optional description of this resource. Provide this
-	// field when you create the resource.
+	// Description: An optional description of this resource.
 	// +optional
-	// +immutable
 	Description *string `json:"description,omitempty"`
 
-	// Network: URL of the network resource for this firewall rule. If not
+	// Network: URL of the network resource for this firewall rule. It
+	// cannot be changed once the rule was created. If not
 	// specified when creating a firewall rule, the default network is
 	// used:
 	// global/networks/default
@@ -213,6 +214,14 @@ type FirewallLogConfig struct {
 	// Enable: This field denotes whether to enable logging for a particular
 	// firewall rule.
 	Enable bool `json:"enable"`
+
+	// Metadata: This field can only be specified if logging is enabled for
+	// this firewall rule. It denotes whether to include or exclude metadata,
+	// such as the VM instance details, in the logs. Defaults to
+	// INCLUDE_ALL_METADATA.
+	// +optional
+	// +kubebuilder:validation:Enum=INCLUDE_ALL_METADATA;EXCLUDE_ALL_METADATA
+	Metadata *string `json:"metadata,omitempty"`
 }
 
 // A FirewallObservation represents the observed state of a Google Compute Engine
@@ -228,6 +237,20 @@ type FirewallObservation struct {
 
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
+
+	// LastHitTime: Time in RFC3339 text format at which the rule last
+	// matched traffic, according to its firewall rules logs. Only reported
+	// when the provider runs with firewall hit observation enabled and
+	// logging is enabled for the rule.
+	LastHitTime string `json:"lastHitTime,omitempty"`
+
+	// LastHitCheckTime: Time in RFC3339 text format at which the firewall
+	// rules logs were last queried for hits.
+	LastHitCheckTime string `json:"lastHitCheckTime,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // A FirewallSpec defines the desired state of a Firewall.
@@ -240,6 +263,11 @@ type FirewallSpec struct {
 type FirewallStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          FirewallObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -247,6 +275,11 @@ type FirewallStatus struct {
 // A Firewall is a managed resource that represents a Google Compute Engine Firewall
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NETWORK",type="string",JSONPath=".spec.forProvider.network"
+// +kubebuilder:printcolumn:name="DIRECTION",type="string",JSONPath=".spec.forProvider.direction"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type Firewall struct {
@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// HealthCheck types.
+const (
+	HealthCheckTypeTCP   = "TCP"
+	HealthCheckTypeHTTP  = "HTTP"
+	HealthCheckTypeHTTPS = "HTTPS"
+	HealthCheckTypeGRPC  = "GRPC"
+)
+
+// HealthCheckParameters define the desired state of a Google Compute Engine
+// global health check. Exactly one of the TCP, HTTP, HTTPS or gRPC health
+// checks must be set. All fields can be changed while backend services use
+// the health check.
+// https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks
+// +kubebuilder:validation:XValidation:rule="[has(self.tcpHealthCheck), has(self.httpHealthCheck), has(self.httpsHealthCheck), has(self.grpcHealthCheck)].filter(x, x).size() == 1",message="exactly one of tcpHealthCheck, httpHealthCheck, httpsHealthCheck or grpcHealthCheck must be set"
+type HealthCheckParameters struct {
+	// Description: An optional description of the health check.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// CheckIntervalSec: How often, in seconds, to probe. Defaults to 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	CheckIntervalSec *int64 `json:"checkIntervalSec,omitempty"`
+
+	// TimeoutSec: How long, in seconds, to wait for a response before a
+	// probe fails. It must not be greater than checkIntervalSec. Defaults
+	// to 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSec *int64 `json:"timeoutSec,omitempty"`
+
+	// HealthyThreshold: The number of consecutive successful probes after
+	// which an unhealthy backend is considered healthy. Defaults to 2.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	HealthyThreshold *int64 `json:"healthyThreshold,omitempty"`
+
+	// UnhealthyThreshold: The number of consecutive failed probes after
+	// which a healthy backend is considered unhealthy. Defaults to 2.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	UnhealthyThreshold *int64 `json:"unhealthyThreshold,omitempty"`
+
+	// TCPHealthCheck: Probes backends by opening TCP connections.
+	// +optional
+	TCPHealthCheck *TCPHealthCheck `json:"tcpHealthCheck,omitempty"`
+
+	// HTTPHealthCheck: Probes backends by sending HTTP requests.
+	// +optional
+	HTTPHealthCheck *HTTPHealthCheck `json:"httpHealthCheck,omitempty"`
+
+	// HTTPSHealthCheck: Probes backends by sending HTTPS requests.
+	// +optional
+	HTTPSHealthCheck *HTTPHealthCheck `json:"httpsHealthCheck,omitempty"`
+
+	// GRPCHealthCheck: Probes backends by calling the gRPC health checking
+	// protocol.
+	// +optional
+	GRPCHealthCheck *GRPCHealthCheck `json:"grpcHealthCheck,omitempty"`
+}
+
+// HealthCheckPort configures the port a health check probes.
+type HealthCheckPort struct {
+	// Port: The port to probe. Defaults to 80 for TCP and HTTP, and to 443
+	// for HTTPS health checks.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int64 `json:"port,omitempty"`
+
+	// PortName: The named port of the instance groups to probe.
+	// +optional
+	PortName *string `json:"portName,omitempty"`
+
+	// PortSpecification: How the port is selected, i.e. USE_FIXED_PORT,
+	// USE_NAMED_PORT or USE_SERVING_PORT to probe the port each backend
+	// serves on.
+	// +optional
+	// +kubebuilder:validation:Enum=USE_FIXED_PORT;USE_NAMED_PORT;USE_SERVING_PORT
+	PortSpecification *string `json:"portSpecification,omitempty"`
+}
+
+// A TCPHealthCheck probes backends by opening TCP connections.
+type TCPHealthCheck struct {
+	HealthCheckPort `json:",inline"`
+
+	// Request: The data sent once the connection is established.
+	// +optional
+	Request *string `json:"request,omitempty"`
+
+	// Response: The data a backend must respond with to pass the probe.
+	// +optional
+	Response *string `json:"response,omitempty"`
+
+	// ProxyHeader: The proxy header sent to the backend, i.e. NONE or
+	// PROXY_V1. Defaults to NONE.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;PROXY_V1
+	ProxyHeader *string `json:"proxyHeader,omitempty"`
+}
+
+// An HTTPHealthCheck probes backends by sending HTTP or HTTPS requests.
+type HTTPHealthCheck struct {
+	HealthCheckPort `json:",inline"`
+
+	// Host: The value of the Host header of the requests. Defaults to the IP
+	// address of the backend.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// RequestPath: The path of the requests. Defaults to /.
+	// +optional
+	RequestPath *string `json:"requestPath,omitempty"`
+
+	// Response: A string the beginning of the response body must match to
+	// pass the probe. Any response with status 200 passes if it is unset.
+	// +optional
+	Response *string `json:"response,omitempty"`
+
+	// ProxyHeader: The proxy header sent to the backend, i.e. NONE or
+	// PROXY_V1. Defaults to NONE.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;PROXY_V1
+	ProxyHeader *string `json:"proxyHeader,omitempty"`
+}
+
+// A GRPCHealthCheck probes backends by calling the gRPC health checking
+// protocol.
+type GRPCHealthCheck struct {
+	HealthCheckPort `json:",inline"`
+
+	// GRPCServiceName: The name of the service whose health is checked.
+	// The health of the whole server is checked if it is unset.
+	// +optional
+	GRPCServiceName *string `json:"grpcServiceName,omitempty"`
+}
+
+// HealthCheckObservation is used to show the observed state of the
+// HealthCheck.
+type HealthCheckObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Type: The type of the health check, i.e. TCP, HTTP, HTTPS or GRPC.
+	Type string `json:"type,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// HealthCheckSpec defines the desired state of a HealthCheck.
+type HealthCheckSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HealthCheckParameters `json:"forProvider"`
+}
+
+// HealthCheckStatus represents the observed state of a HealthCheck.
+type HealthCheckStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HealthCheckObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HealthCheck is a managed resource that represents a Google Compute Engine
+// global health check, which backend services use to probe their backends.
+// The external name of the resource is the name of the health check.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type HealthCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HealthCheckSpec   `json:"spec"`
+	Status HealthCheckStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HealthCheckList contains a list of HealthCheck types
+type HealthCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HealthCheck `json:"items"`
+}
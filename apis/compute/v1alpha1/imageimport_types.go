@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// ImageImportParameters define the desired state of a Google Compute Engine
+// image that is imported from a virtual disk file. The import is run by the
+// image import tool on Cloud Build, like `gcloud compute images import`:
+// https://cloud.google.com/compute/docs/import/importing-virtual-disks
+type ImageImportParameters struct {
+	// SourceFile: The Cloud Storage URI of the virtual disk file to import,
+	// e.g. gs://my-bucket/my-image.vmdk. VMDK, VHD, VHDX, VDI, QCOW2 and raw
+	// disk files are supported.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^gs://.+`
+	SourceFile string `json:"sourceFile"`
+
+	// OS: The operating system of the imported disk, e.g. debian-11 or
+	// windows-2019, used to translate it into a bootable image. Either os or
+	// dataDisk must be set.
+	// https://cloud.google.com/compute/docs/import/os-versions
+	// +optional
+	// +immutable
+	OS *string `json:"os,omitempty"`
+
+	// DataDisk: Import the disk as a data disk image without translating
+	// it. Either os or dataDisk must be set.
+	// +optional
+	// +immutable
+	DataDisk *bool `json:"dataDisk,omitempty"`
+
+	// Family: The name of the image family to add the image to.
+	// +optional
+	// +immutable
+	Family *string `json:"family,omitempty"`
+
+	// Description: An optional description of the image.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Zone: The zone in which the temporary import resources are created.
+	// Defaults to a zone chosen by the image import tool.
+	// +optional
+	// +immutable
+	Zone *string `json:"zone,omitempty"`
+
+	// Network: The name of the network used by the temporary import
+	// instance. Defaults to the default network.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// Subnet: The name of the subnetwork used by the temporary import
+	// instance. It must be set if the network is in custom subnet mode.
+	// +optional
+	// +immutable
+	Subnet *string `json:"subnet,omitempty"`
+
+	// Timeout: The maximum duration of the import. Defaults to 2 hours.
+	// +optional
+	// +immutable
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ImageImportObservation is used to show the observed state of the
+// ImageImport.
+type ImageImportObservation struct {
+	// BuildID: The ID of the Cloud Build build that imports the image.
+	BuildID string `json:"buildId,omitempty"`
+
+	// BuildStatus: The status of the import build, e.g. QUEUED, WORKING,
+	// SUCCESS or FAILURE.
+	BuildStatus string `json:"buildStatus,omitempty"`
+
+	// BuildStatusDetail: A human readable explanation of the build status.
+	BuildStatusDetail string `json:"buildStatusDetail,omitempty"`
+
+	// LogURL: The URL of the logs of the import build in the Google Cloud
+	// console.
+	LogURL string `json:"logUrl,omitempty"`
+
+	// StartTime: The time at which the import build started.
+	StartTime string `json:"startTime,omitempty"`
+
+	// FinishTime: The time at which the import build finished.
+	FinishTime string `json:"finishTime,omitempty"`
+
+	// SelfLink: The URL of the imported image.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the imported image, e.g. PENDING or READY.
+	Status string `json:"status,omitempty"`
+
+	// DiskSizeGb: The size of the image when restored onto a persistent
+	// disk, in GB.
+	DiskSizeGb int64 `json:"diskSizeGb,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// ImageImportSpec defines the desired state of an ImageImport.
+type ImageImportSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImageImportParameters `json:"forProvider"`
+}
+
+// ImageImportStatus represents the observed state of an ImageImport.
+type ImageImportStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ImageImportObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageImport is a managed resource that represents a Google Compute Engine
+// image imported from a virtual disk file in Cloud Storage. The external
+// name of the resource is the name of the image.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="BUILD",type="string",JSONPath=".status.atProvider.buildStatus"
+// +kubebuilder:printcolumn:name="STARTED",type="string",JSONPath=".status.atProvider.startTime",priority=1
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ImageImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageImportSpec   `json:"spec"`
+	Status ImageImportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageImportList contains a list of ImageImport types
+type ImageImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageImport `json:"items"`
+}
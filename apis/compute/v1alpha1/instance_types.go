@@ -0,0 +1,327 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Instance statuses.
+const (
+	InstanceStatusProvisioning = "PROVISIONING"
+	InstanceStatusStaging      = "STAGING"
+	InstanceStatusRunning      = "RUNNING"
+	InstanceStatusTerminated   = "TERMINATED"
+)
+
+// Instance connection details.
+const (
+	InstanceInternalIPKey = "internalIP"
+	InstanceExternalIPKey = "externalIP"
+
+	InstanceInternalIPv6Key = "internalIPv6"
+	InstanceExternalIPv6Key = "externalIPv6"
+)
+
+// InstanceParameters define the desired state of a Google Compute Engine
+// instance. Labels and metadata can be changed at any time. The machine type
+// can only be changed while the instance is stopped.
+type InstanceParameters struct {
+	// Zone: The zone of the instance.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zone is immutable"
+	Zone string `json:"zone"`
+
+	// Description: An optional description of the instance.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// MachineType: The machine type of the instance, e.g. e2-standard-2.
+	// It can only be changed while the instance is stopped.
+	MachineType string `json:"machineType"`
+
+	// Disks: The disks attached to the instance. Exactly one of them must be
+	// the boot disk.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	Disks []InstanceDisk `json:"disks"`
+
+	// NetworkInterfaces: The network interfaces of the instance.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	NetworkInterfaces []NetworkInterface `json:"networkInterfaces"`
+
+	// ServiceAccounts: The service account, and its scopes, that the
+	// instance runs as. Only one service account is supported.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:MaxItems=1
+	ServiceAccounts []InstanceServiceAccount `json:"serviceAccounts,omitempty"`
+
+	// Labels: The labels applied to the instance.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Metadata: The metadata key/value pairs of the instance, e.g.
+	// startup-script.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Tags: The network tags of the instance.
+	// +optional
+	// +immutable
+	Tags []string `json:"tags,omitempty"`
+
+	// Scheduling: The scheduling options of the instance.
+	// +optional
+	// +immutable
+	Scheduling *Scheduling `json:"scheduling,omitempty"`
+
+	// ConfidentialInstanceConfig: The Confidential VM configuration of the
+	// instance.
+	// +optional
+	// +immutable
+	ConfidentialInstanceConfig *ConfidentialInstanceConfig `json:"confidentialInstanceConfig,omitempty"`
+
+	// ShieldedInstanceConfig: The Shielded VM configuration of the
+	// instance. Fields that are not set default to the configuration the
+	// instance was created with.
+	// +optional
+	// +immutable
+	ShieldedInstanceConfig *ShieldedInstanceConfig `json:"shieldedInstanceConfig,omitempty"`
+}
+
+// An InstanceDisk is a disk attached to an instance. Either an existing disk
+// is attached by setting source, or a new disk is created with the instance.
+type InstanceDisk struct {
+	AttachedDisk `json:",inline"`
+
+	// Source: The URL of an existing disk to attach, e.g.
+	// projects/my-project/zones/us-central1-a/disks/my-disk. The fields
+	// describing a new disk are ignored if it is set.
+	// +optional
+	Source *string `json:"source,omitempty"`
+
+	// Mode: The mode in which the disk is attached.
+	// +optional
+	// +kubebuilder:validation:Enum=READ_WRITE;READ_ONLY
+	Mode *string `json:"mode,omitempty"`
+
+	// SourceSnapshot: The URL of the snapshot a new disk is created from,
+	// e.g. global/snapshots/my-snapshot. It is ignored if source is set.
+	// +optional
+	SourceSnapshot *string `json:"sourceSnapshot,omitempty"`
+
+	// DiskEncryptionKey: The key a new disk is encrypted with, or the key
+	// an existing disk is encrypted with. Disks are encrypted with a key
+	// managed by Google if none is set.
+	// +optional
+	DiskEncryptionKey *DiskEncryptionKey `json:"diskEncryptionKey,omitempty"`
+
+	// SourceImageEncryptionKey: The key the source image of a new disk is
+	// encrypted with, if it is encrypted with a customer-supplied key.
+	// +optional
+	SourceImageEncryptionKey *DiskEncryptionKey `json:"sourceImageEncryptionKey,omitempty"`
+
+	// SourceSnapshotEncryptionKey: The key the source snapshot of a new
+	// disk is encrypted with, if it is encrypted with a customer-supplied
+	// key.
+	// +optional
+	SourceSnapshotEncryptionKey *DiskEncryptionKey `json:"sourceSnapshotEncryptionKey,omitempty"`
+}
+
+// A DiskEncryptionKey is either a customer-supplied encryption key (CSEK)
+// read from a Secret, or a customer-managed Cloud KMS key (CMEK). Exactly one
+// of rawKeySecretRef, rsaEncryptedKeySecretRef and kmsKeyName must be set.
+// Customer-supplied keys are sent to Compute Engine only when the disk is
+// created, and are never written to the status of the resource.
+type DiskEncryptionKey struct {
+	// RawKeySecretRef selects the key of a Secret that holds a 256-bit
+	// customer-supplied encryption key, encoded in RFC 4648 base64.
+	// +optional
+	RawKeySecretRef *xpv1.SecretKeySelector `json:"rawKeySecretRef,omitempty"`
+
+	// RSAEncryptedKeySecretRef selects the key of a Secret that holds a
+	// 256-bit customer-supplied encryption key, wrapped with the public
+	// key certificate of Compute Engine and encoded in RFC 4648 base64.
+	// +optional
+	RSAEncryptedKeySecretRef *xpv1.SecretKeySelector `json:"rsaEncryptedKeySecretRef,omitempty"`
+
+	// KMSKeyName: The name of the Cloud KMS key, in the form
+	// projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>.
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// KMSKeyNameRef references a CryptoKey to retrieve its name.
+	// +optional
+	KMSKeyNameRef *xpv1.Reference `json:"kmsKeyNameRef,omitempty"`
+
+	// KMSKeyNameSelector selects a reference to a CryptoKey to retrieve its
+	// name.
+	// +optional
+	KMSKeyNameSelector *xpv1.Selector `json:"kmsKeyNameSelector,omitempty"`
+
+	// KMSKeyServiceAccount: The service account used for the encryption
+	// request of the Cloud KMS key. Defaults to the Compute Engine service
+	// agent.
+	// +optional
+	KMSKeyServiceAccount *string `json:"kmsKeyServiceAccount,omitempty"`
+}
+
+// Scheduling are the scheduling options of an instance.
+type Scheduling struct {
+	// AutomaticRestart: Whether the instance is restarted when it is
+	// terminated by Compute Engine, rather than by a user.
+	// +optional
+	AutomaticRestart *bool `json:"automaticRestart,omitempty"`
+
+	// OnHostMaintenance: What happens to the instance during host
+	// maintenance. Preemptible and Spot instances must be terminated.
+	// +optional
+	// +kubebuilder:validation:Enum=MIGRATE;TERMINATE
+	OnHostMaintenance *string `json:"onHostMaintenance,omitempty"`
+
+	// Preemptible: Whether the instance is preemptible.
+	// +optional
+	Preemptible *bool `json:"preemptible,omitempty"`
+
+	// ProvisioningModel: The provisioning model of the instance.
+	// +optional
+	// +kubebuilder:validation:Enum=STANDARD;SPOT
+	ProvisioningModel *string `json:"provisioningModel,omitempty"`
+}
+
+// InstanceObservation is used to show the observed state of the Instance.
+type InstanceObservation struct {
+	// ID: The unique identifier of the instance.
+	ID uint64 `json:"id,omitempty"`
+
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// SelfLink: The URL of the instance.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the instance, e.g. RUNNING or TERMINATED. A
+	// stopped instance is TERMINATED.
+	Status string `json:"status,omitempty"`
+
+	// MachineType: The URL of the machine type of the instance.
+	MachineType string `json:"machineType,omitempty"`
+
+	// InternalIP: The internal IP address of the first network interface.
+	InternalIP string `json:"internalIP,omitempty"`
+
+	// ExternalIP: The external IP address of the first network interface,
+	// if it has one.
+	ExternalIP string `json:"externalIP,omitempty"`
+
+	// InternalIPv6: The internal IPv6 address of the first network
+	// interface, if it is a dual-stack interface.
+	InternalIPv6 string `json:"internalIPv6,omitempty"`
+
+	// ExternalIPv6: The first address of the external IPv6 range of the
+	// first network interface, if it has one.
+	ExternalIPv6 string `json:"externalIPv6,omitempty"`
+
+	// Disks: The observed disks of the instance.
+	Disks []InstanceDiskObservation `json:"disks,omitempty"`
+
+	// NetworkInterfaces: The observed network interfaces of the instance,
+	// including their alias IP ranges.
+	NetworkInterfaces []NetworkInterfaceObservation `json:"networkInterfaces,omitempty"`
+
+	// InternalDNSName: The internal zonal DNS name of the instance, by
+	// which other VMs of its VPC network can resolve it.
+	InternalDNSName string `json:"internalDNSName,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// InstanceDiskObservation is the observed state of a disk of an instance.
+type InstanceDiskObservation struct {
+	// DeviceName: The device name of the disk.
+	DeviceName string `json:"deviceName,omitempty"`
+
+	// Source: The URL of the disk.
+	Source string `json:"source,omitempty"`
+
+	// KMSKeyName: The Cloud KMS key the disk is encrypted with, if any.
+	KMSKeyName string `json:"kmsKeyName,omitempty"`
+
+	// EncryptionKeySHA256: The SHA-256 hash of the customer-supplied key the
+	// disk is encrypted with, if any, encoded in RFC 4648 base64.
+	EncryptionKeySHA256 string `json:"encryptionKeySha256,omitempty"`
+}
+
+// InstanceSpec defines the desired state of an Instance.
+type InstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceParameters `json:"forProvider"`
+}
+
+// InstanceStatus represents the observed state of an Instance.
+type InstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Instance is a managed resource that represents a zonal Google Compute
+// Engine instance. The external name of the resource is the name of the
+// instance. Its internal and external IP addresses are published as
+// connection details.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".spec.forProvider.zone"
+// +kubebuilder:printcolumn:name="MACHINE-TYPE",type="string",JSONPath=".spec.forProvider.machineType",priority=1
+// +kubebuilder:printcolumn:name="INTERNAL-IP",type="string",JSONPath=".status.atProvider.internalIP",priority=1
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instance types
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
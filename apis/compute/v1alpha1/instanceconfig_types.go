@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// ConfidentialInstanceConfig configures a Confidential VM, whose memory is
+// encrypted while it is in use. Confidential VMs require an N2D or C2D
+// machine type and an onHostMaintenance policy of TERMINATE. It cannot be
+// changed on an existing VM.
+type ConfidentialInstanceConfig struct {
+	// EnableConfidentialCompute: Defines whether the instance should have
+	// confidential compute enabled.
+	// +immutable
+	EnableConfidentialCompute bool `json:"enableConfidentialCompute"`
+}
+
+// ShieldedInstanceConfig configures a Shielded VM, whose boot is verified.
+// It cannot be changed on an existing VM.
+type ShieldedInstanceConfig struct {
+	// EnableSecureBoot: Defines whether the instance has Secure Boot
+	// enabled. Disabled by default.
+	// +immutable
+	// +optional
+	EnableSecureBoot *bool `json:"enableSecureBoot,omitempty"`
+
+	// EnableVtpm: Defines whether the instance has the vTPM enabled.
+	// Enabled by default.
+	// +immutable
+	// +optional
+	EnableVtpm *bool `json:"enableVtpm,omitempty"`
+
+	// EnableIntegrityMonitoring: Defines whether the instance has integrity
+	// monitoring enabled. Enabled by default.
+	// +immutable
+	// +optional
+	EnableIntegrityMonitoring *bool `json:"enableIntegrityMonitoring,omitempty"`
+}
+
+// AliasIPRange is an alias IP range attached to a network interface of a VM.
+type AliasIPRange struct {
+	// IPCidrRange: The IP alias ranges to allocate for this interface, e.g.
+	// 10.2.3.0/24.
+	IPCidrRange string `json:"ipCidrRange,omitempty"`
+
+	// SubnetworkRangeName: The name of a subnetwork secondary IP range from
+	// which to allocate the IP alias range. Empty if the range is allocated
+	// from the primary range of the subnetwork.
+	SubnetworkRangeName string `json:"subnetworkRangeName,omitempty"`
+}
+
+// AccessConfigObservation is the observed state of an access config of a
+// network interface, i.e. its external IP address.
+type AccessConfigObservation struct {
+	// Name: The name of this access configuration.
+	Name string `json:"name,omitempty"`
+
+	// NatIP: The external IP address of the interface.
+	NatIP string `json:"natIP,omitempty"`
+
+	// NetworkTier: The networking tier of the external IP address, i.e.
+	// PREMIUM or STANDARD.
+	NetworkTier string `json:"networkTier,omitempty"`
+}
+
+// NetworkInterfaceObservation is the observed state of a network interface
+// of a VM.
+type NetworkInterfaceObservation struct {
+	// Name: The name of the network interface, e.g. nic0.
+	Name string `json:"name,omitempty"`
+
+	// Network: URL of the VPC network the interface is attached to.
+	Network string `json:"network,omitempty"`
+
+	// Subnetwork: URL of the subnetwork the interface is attached to.
+	Subnetwork string `json:"subnetwork,omitempty"`
+
+	// NetworkIP: The internal IPv4 address of the interface.
+	NetworkIP string `json:"networkIP,omitempty"`
+
+	// IPv6Address: The internal IPv6 address of the interface, if any.
+	IPv6Address string `json:"ipv6Address,omitempty"`
+
+	// StackType: The stack type of the interface, i.e. IPV4_ONLY or
+	// IPV4_IPV6.
+	StackType string `json:"stackType,omitempty"`
+
+	// AliasIPRanges: The alias IP ranges attached to the interface.
+	AliasIPRanges []AliasIPRange `json:"aliasIpRanges,omitempty"`
+
+	// AccessConfigs: The external IP addresses of the interface.
+	AccessConfigs []AccessConfigObservation `json:"accessConfigs,omitempty"`
+}
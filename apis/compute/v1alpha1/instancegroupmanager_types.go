@@ -0,0 +1,427 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// InstanceGroupManagerParameters define the desired state of a Google Compute
+// Engine zonal or regional managed instance group. Most fields map directly to
+// an InstanceGroupManager:
+// https://cloud.google.com/compute/docs/reference/rest/v1/instanceGroupManagers
+type InstanceGroupManagerParameters struct {
+	// Zone: The zone where a zonal managed instance group is located.
+	// Exactly one of zone or region must be set.
+	// +optional
+	// +immutable
+	Zone *string `json:"zone,omitempty"`
+
+	// Region: The region where a regional managed instance group is
+	// located. Exactly one of zone or region must be set.
+	// +optional
+	// +immutable
+	Region *string `json:"region,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// BaseInstanceName: The base instance name to use for instances in
+	// this group. The value must be 1-58 characters long. Instances are
+	// named by appending a hyphen and a random four-character string to
+	// the base instance name.
+	// +immutable
+	BaseInstanceName string `json:"baseInstanceName"`
+
+	// InstanceTemplate: The URL of the instance template that is specified
+	// for this managed instance group. The group uses this template to
+	// create all new instances in the managed instance group.
+	// +optional
+	InstanceTemplate string `json:"instanceTemplate,omitempty"`
+
+	// InstanceTemplateRef references an InstanceTemplate and retrieves its
+	// URL.
+	// +optional
+	InstanceTemplateRef *xpv1.Reference `json:"instanceTemplateRef,omitempty"`
+
+	// InstanceTemplateSelector selects a reference to an InstanceTemplate.
+	// +optional
+	InstanceTemplateSelector *xpv1.Selector `json:"instanceTemplateSelector,omitempty"`
+
+	// TargetSize: The target number of running instances for this managed
+	// instance group.
+	// +optional
+	TargetSize *int64 `json:"targetSize,omitempty"`
+
+	// TargetPools: The URLs for all TargetPool resources to which instances
+	// in the instanceGroup field are added.
+	// +optional
+	TargetPools []string `json:"targetPools,omitempty"`
+
+	// NamedPorts: Named ports configured for the instance group of this
+	// managed instance group, e.g. to be used by a backend service.
+	// +optional
+	NamedPorts []NamedPort `json:"namedPorts,omitempty"`
+
+	// AutoHealingPolicies: The autohealing policy for this managed instance
+	// group. You can specify only one value.
+	// +optional
+	// +kubebuilder:validation:MaxItems=1
+	AutoHealingPolicies []AutoHealingPolicy `json:"autoHealingPolicies,omitempty"`
+
+	// UpdatePolicy: The update policy for this managed instance group. It
+	// determines how instances are updated when the instance template
+	// changes.
+	// +optional
+	UpdatePolicy *UpdatePolicy `json:"updatePolicy,omitempty"`
+
+	// StatefulPolicy: Stateful configuration for this instance group
+	// manager. Disks listed here are preserved across instance
+	// recreation, autohealing and updates.
+	// +optional
+	StatefulPolicy *StatefulPolicy `json:"statefulPolicy,omitempty"`
+
+	// PerInstanceConfigs: Per-instance configurations that preserve state,
+	// such as specific disks or metadata, for named instances of this
+	// group. When set, the controller creates, updates and deletes
+	// per-instance configs so that they match this list. Leave unset to
+	// manage per-instance configs out of band.
+	// +optional
+	PerInstanceConfigs []PerInstanceConfig `json:"perInstanceConfigs,omitempty"`
+
+	// SpotTerminationPolicy: How the controller handles instances of this
+	// group that were preempted, i.e. Spot or preemptible instances that
+	// Compute Engine stopped or terminated. When set, preempted instances
+	// are reported in status.atProvider.preemptedInstances.
+	// +optional
+	SpotTerminationPolicy *SpotTerminationPolicy `json:"spotTerminationPolicy,omitempty"`
+}
+
+// NamedPort is a named port of an instance group.
+type NamedPort struct {
+	// Name: The name for this named port. The name must be 1-63 characters
+	// long, and comply with RFC1035.
+	Name string `json:"name"`
+
+	// Port: The port number, which can be a value between 1 and 65535.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+}
+
+// AutoHealingPolicy configures how a managed instance group recreates
+// unhealthy instances.
+type AutoHealingPolicy struct {
+	// HealthCheck: The URL for the health check that signals autohealing.
+	HealthCheck string `json:"healthCheck"`
+
+	// InitialDelaySec: The number of seconds that the managed instance
+	// group waits before it applies autohealing policies to new instances
+	// or recently recreated instances. This initial delay allows instances
+	// to initialize and run their startup scripts before the instance group
+	// determines that they are UNHEALTHY. The default delay is 300 seconds.
+	// +optional
+	InitialDelaySec *int64 `json:"initialDelaySec,omitempty"`
+}
+
+// UpdatePolicy configures how a managed instance group rolls out changes to
+// its instances.
+type UpdatePolicy struct {
+	// Type: The type of update process. You can specify either PROACTIVE so
+	// that the instance group manager proactively executes actions in order
+	// to bring instances to their target versions or OPPORTUNISTIC so that
+	// no action is proactively executed but the update will be performed as
+	// part of other actions (for example, resizes or recreateInstances
+	// calls).
+	// +optional
+	// +kubebuilder:validation:Enum=OPPORTUNISTIC;PROACTIVE
+	Type *string `json:"type,omitempty"`
+
+	// InstanceRedistributionType: The instance redistribution policy for
+	// regional managed instance groups. Valid values are PROACTIVE, where
+	// the group attempts to maintain an even distribution of VM instances
+	// across zones in the region, and NONE, where proactive redistribution
+	// is disabled.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;PROACTIVE
+	InstanceRedistributionType *string `json:"instanceRedistributionType,omitempty"`
+
+	// MinimalAction: Minimal action to be taken on an instance. Use this
+	// option to minimize disruption as much as possible or to apply a more
+	// disruptive action than is necessary.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;REFRESH;REPLACE;RESTART
+	MinimalAction *string `json:"minimalAction,omitempty"`
+
+	// MostDisruptiveAllowedAction: Most disruptive action that is allowed
+	// to be taken on an instance. You can specify either NONE to forbid any
+	// actions, REFRESH to avoid restarting the VM and to limit disruption as
+	// much as possible, RESTART to allow actions that can be applied without
+	// instance replacing or REPLACE to allow all possible actions.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;REFRESH;REPLACE;RESTART
+	MostDisruptiveAllowedAction *string `json:"mostDisruptiveAllowedAction,omitempty"`
+
+	// ReplacementMethod: What action should be used to replace instances.
+	// +optional
+	// +kubebuilder:validation:Enum=RECREATE;SUBSTITUTE
+	ReplacementMethod *string `json:"replacementMethod,omitempty"`
+
+	// MaxSurge: The maximum number of instances that can be created above
+	// the specified targetSize during the update process.
+	// +optional
+	MaxSurge *FixedOrPercent `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable: The maximum number of instances that can be
+	// unavailable during the update process.
+	// +optional
+	MaxUnavailable *FixedOrPercent `json:"maxUnavailable,omitempty"`
+}
+
+// FixedOrPercent is a number of instances, either fixed or as a percentage of
+// the target size of a managed instance group. Exactly one of fixed or percent
+// should be set.
+type FixedOrPercent struct {
+	// Fixed: Specifies a fixed number of VM instances.
+	// +optional
+	Fixed *int64 `json:"fixed,omitempty"`
+
+	// Percent: Specifies a percentage of instances between 0 to 100%,
+	// inclusive.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent *int64 `json:"percent,omitempty"`
+}
+
+// SpotTerminationPolicy configures the handling of preempted instances of a
+// managed instance group.
+type SpotTerminationPolicy struct {
+	// AutoRecreate: Whether the controller recreates preempted instances,
+	// rather than relying solely on the autohealing policy of the group.
+	// +optional
+	AutoRecreate bool `json:"autoRecreate,omitempty"`
+}
+
+// StatefulPolicy configures the state that is preserved for every instance of
+// a managed instance group.
+type StatefulPolicy struct {
+	// PreservedState: The state that is preserved for all instances.
+	// +optional
+	PreservedState *StatefulPolicyPreservedState `json:"preservedState,omitempty"`
+}
+
+// StatefulPolicyPreservedState is the configuration of preserved resources.
+type StatefulPolicyPreservedState struct {
+	// Disks: Disks created on the instances that will be preserved on
+	// instance delete, update, etc. This map is keyed with the device names
+	// of the disks.
+	// +optional
+	Disks map[string]StatefulPolicyPreservedStateDiskDevice `json:"disks,omitempty"`
+}
+
+// StatefulPolicyPreservedStateDiskDevice configures a preserved disk device.
+type StatefulPolicyPreservedStateDiskDevice struct {
+	// AutoDelete: These stateful disks will never be deleted during
+	// autohealing, update or VM instance recreate operations. This flag is
+	// used to configure if the disk should be deleted after it is no longer
+	// used by the group, e.g. when the given instance or the whole group is
+	// deleted.
+	//
+	// Possible values:
+	//   "NEVER"
+	//   "ON_PERMANENT_INSTANCE_DELETION"
+	// +optional
+	// +kubebuilder:validation:Enum=NEVER;ON_PERMANENT_INSTANCE_DELETION
+	AutoDelete *string `json:"autoDelete,omitempty"`
+}
+
+// PerInstanceConfig is the stateful configuration of a single named instance
+// of a managed instance group.
+type PerInstanceConfig struct {
+	// Name: The name of a per-instance configuration and its corresponding
+	// instance. Serves as a merge key during UpdatePerInstanceConfigs
+	// operations, that is, if a per-instance configuration with the same
+	// name exists then it will be updated, otherwise a new one will be
+	// created for the VM instance with the same name.
+	Name string `json:"name"`
+
+	// PreservedState: The intended preserved state for the given instance.
+	// Does not contain preserved state generated from a stateful policy.
+	// +optional
+	PreservedState *PreservedState `json:"preservedState,omitempty"`
+}
+
+// PreservedState is the intended preserved state of a single instance.
+type PreservedState struct {
+	// Disks: Preserved disks defined for this instance. This map is keyed
+	// with the device names of the disks.
+	// +optional
+	Disks map[string]PreservedStatePreservedDisk `json:"disks,omitempty"`
+
+	// Metadata: Preserved metadata defined for this instance.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// PreservedStatePreservedDisk is a disk preserved for a single instance.
+type PreservedStatePreservedDisk struct {
+	// AutoDelete: These stateful disks will never be deleted during
+	// autohealing, update, instance recreate operations. This flag is used
+	// to configure if the disk should be deleted after it is no longer used
+	// by the group, e.g. when the given instance or the whole MIG is
+	// deleted.
+	//
+	// Possible values:
+	//   "NEVER"
+	//   "ON_PERMANENT_INSTANCE_DELETION"
+	// +optional
+	// +kubebuilder:validation:Enum=NEVER;ON_PERMANENT_INSTANCE_DELETION
+	AutoDelete *string `json:"autoDelete,omitempty"`
+
+	// Mode: The mode in which to attach this disk, either READ_WRITE or
+	// READ_ONLY. If not specified, the default is to attach the disk in
+	// READ_WRITE mode.
+	// +optional
+	// +kubebuilder:validation:Enum=READ_ONLY;READ_WRITE
+	Mode *string `json:"mode,omitempty"`
+
+	// Source: The URL of the disk resource that is stateful and should be
+	// attached to the VM instance.
+	Source string `json:"source"`
+}
+
+// InstanceGroupManagerStatusStateful reports the stateful status of a managed
+// instance group.
+type InstanceGroupManagerStatusStateful struct {
+	// HasStatefulConfig: A bit indicating whether the managed instance
+	// group has stateful configuration, that is, if you have configured any
+	// items in a stateful policy or in per-instance configs.
+	HasStatefulConfig bool `json:"hasStatefulConfig,omitempty"`
+
+	// PerInstanceConfigsEffective: A bit indicating if all of the group's
+	// per-instance configurations (listed in the output of a
+	// listPerInstanceConfigs API call) have status EFFECTIVE or there are
+	// no per-instance-configs.
+	PerInstanceConfigsEffective bool `json:"perInstanceConfigsEffective,omitempty"`
+}
+
+// PerInstanceConfigObservation is the observed state of a per-instance
+// config.
+type PerInstanceConfigObservation struct {
+	// Name: The name of the per-instance config.
+	Name string `json:"name"`
+
+	// Status: The status of applying this per-instance configuration on
+	// the corresponding managed instance.
+	Status string `json:"status,omitempty"`
+}
+
+// An InstanceGroupManagerObservation represents the observed state of a Google
+// Compute Engine managed instance group.
+type InstanceGroupManagerObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text
+	// format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// Fingerprint: Fingerprint of this resource.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// ID: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// InstanceGroup: The URL of the Instance Group resource.
+	InstanceGroup string `json:"instanceGroup,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// IsStable: A bit indicating whether the managed instance group is in
+	// a stable state.
+	IsStable bool `json:"isStable,omitempty"`
+
+	// Stateful: Stateful status of the given Instance Group Manager.
+	Stateful *InstanceGroupManagerStatusStateful `json:"stateful,omitempty"`
+
+	// PerInstanceConfigs: The observed per-instance configs of this group.
+	// Only reported when per-instance configs are managed by this resource.
+	PerInstanceConfigs []PerInstanceConfigObservation `json:"perInstanceConfigs,omitempty"`
+
+	// PreemptedInstances: The URLs of the instances of this group that were
+	// preempted and have not been recreated yet. Only reported when a spot
+	// termination policy is set.
+	PreemptedInstances []string `json:"preemptedInstances,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// An InstanceGroupManagerSpec defines the desired state of an
+// InstanceGroupManager.
+type InstanceGroupManagerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceGroupManagerParameters `json:"forProvider"`
+}
+
+// An InstanceGroupManagerStatus represents the observed state of an
+// InstanceGroupManager.
+type InstanceGroupManagerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceGroupManagerObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceGroupManager is a managed resource that represents a Google
+// Compute Engine zonal or regional managed instance group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STABLE",type="boolean",JSONPath=".status.atProvider.isStable"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type InstanceGroupManager struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceGroupManagerSpec   `json:"spec"`
+	Status InstanceGroupManagerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceGroupManagerList contains a list of InstanceGroupManager.
+type InstanceGroupManagerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceGroupManager `json:"items"`
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// InstanceTemplateParameters define the desired state of a Google Compute
+// Engine instance template. Instance templates cannot be changed once
+// created; roll out changes by creating a new template and pointing the
+// instance group manager at it. Changes made after creation are reported by
+// the UpToDate condition rather than applied.
+type InstanceTemplateParameters struct {
+	// Description: An optional description of the instance template.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// MachineType: The machine type of the instances, e.g. e2-standard-2.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="machineType is immutable"
+	MachineType string `json:"machineType"`
+
+	// Disks: The disks attached to the instances. Exactly one of them must
+	// be the boot disk.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	Disks []AttachedDisk `json:"disks"`
+
+	// NetworkInterfaces: The network interfaces of the instances.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	NetworkInterfaces []NetworkInterface `json:"networkInterfaces"`
+
+	// ServiceAccounts: The service accounts, and their scopes, that the
+	// instances run as. Only one service account is supported.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:MaxItems=1
+	ServiceAccounts []InstanceServiceAccount `json:"serviceAccounts,omitempty"`
+
+	// Labels: The labels applied to the instances.
+	// +optional
+	// +immutable
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Metadata: The metadata key/value pairs of the instances, e.g.
+	// startup-script.
+	// +optional
+	// +immutable
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Tags: The network tags of the instances.
+	// +optional
+	// +immutable
+	Tags []string `json:"tags,omitempty"`
+
+	// Scheduling: The scheduling options of the instances.
+	// +optional
+	// +immutable
+	Scheduling *Scheduling `json:"scheduling,omitempty"`
+
+	// ConfidentialInstanceConfig: The Confidential VM configuration of the
+	// instances.
+	// +optional
+	// +immutable
+	ConfidentialInstanceConfig *ConfidentialInstanceConfig `json:"confidentialInstanceConfig,omitempty"`
+
+	// ShieldedInstanceConfig: The Shielded VM configuration of the
+	// instances.
+	// +optional
+	// +immutable
+	ShieldedInstanceConfig *ShieldedInstanceConfig `json:"shieldedInstanceConfig,omitempty"`
+}
+
+// An AttachedDisk is a disk attached to the instances created from an
+// instance template. New disks are created for every instance.
+type AttachedDisk struct {
+	// Boot: Whether the disk is the boot disk of the instances.
+	// +optional
+	Boot *bool `json:"boot,omitempty"`
+
+	// AutoDelete: Whether the disk is deleted when the instance is deleted.
+	// +optional
+	AutoDelete *bool `json:"autoDelete,omitempty"`
+
+	// DeviceName: The device name of the disk inside the instances.
+	// +optional
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// DiskSizeGb: The size of the disk in GB. Defaults to the size of the
+	// source image.
+	// +optional
+	DiskSizeGb *int64 `json:"diskSizeGb,omitempty"`
+
+	// DiskType: The type of the disk, e.g. pd-balanced or pd-ssd.
+	// +optional
+	DiskType *string `json:"diskType,omitempty"`
+
+	// SourceImage: The URL of the image the disk is created from, e.g.
+	// projects/debian-cloud/global/images/debian-12-bookworm-v20240515.
+	// +optional
+	SourceImage *string `json:"sourceImage,omitempty"`
+
+	// SourceImageFamily: The image family the disk is created from, either
+	// the name of a family of the provider's project or the URL of a family
+	// of another project, e.g. projects/debian-cloud/global/images/family/debian-12.
+	// The latest image of the family that is not deprecated is resolved when
+	// the template is created, and recorded in the status of the template.
+	// It is ignored if sourceImage is set.
+	// +optional
+	SourceImageFamily *string `json:"sourceImageFamily,omitempty"`
+
+	// SourceImageFamilyRef references an ImageImport to retrieve its
+	// image family.
+	// +optional
+	SourceImageFamilyRef *xpv1.Reference `json:"sourceImageFamilyRef,omitempty"`
+
+	// SourceImageFamilySelector selects a reference to an ImageImport to
+	// retrieve its image family.
+	// +optional
+	SourceImageFamilySelector *xpv1.Selector `json:"sourceImageFamilySelector,omitempty"`
+}
+
+// A NetworkInterface is a network interface of the instances created from an
+// instance template.
+type NetworkInterface struct {
+	// Network: The URL of the network the interface is connected to.
+	// Defaults to the default network.
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its URL.
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network to retrieve its URL.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork: The URL of the subnetwork the interface is connected to.
+	// It must be set if the network is in custom subnet mode.
+	// +optional
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork to retrieve its URL.
+	// +optional
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork to retrieve
+	// its URL.
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// AccessConfigs: The external IP configurations of the interface. The
+	// instances have no external IP address if none is set.
+	// +optional
+	// +kubebuilder:validation:MaxItems=1
+	AccessConfigs []AccessConfig `json:"accessConfigs,omitempty"`
+
+	// StackType: The IP stack of the interface, i.e. IPV4_ONLY or
+	// IPV4_IPV6. Dual-stack interfaces must be connected to a subnetwork
+	// with a stack type of IPV4_IPV6. Defaults to IPV4_ONLY.
+	// +optional
+	// +kubebuilder:validation:Enum=IPV4_ONLY;IPV4_IPV6
+	StackType *string `json:"stackType,omitempty"`
+
+	// IPv6AccessConfigs: The external IPv6 configurations of the interface.
+	// They can only be set on dual-stack interfaces connected to a
+	// subnetwork with an ipv6AccessType of EXTERNAL.
+	// +optional
+	// +kubebuilder:validation:MaxItems=1
+	IPv6AccessConfigs []IPv6AccessConfig `json:"ipv6AccessConfigs,omitempty"`
+}
+
+// An AccessConfig gives a network interface an external IP address.
+type AccessConfig struct {
+	// Name: The name of the access config.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// NatIP: A static external IP address. An ephemeral address is
+	// assigned if none is set.
+	// +optional
+	NatIP *string `json:"natIP,omitempty"`
+}
+
+// An IPv6AccessConfig gives a dual-stack network interface an external IPv6
+// address range.
+type IPv6AccessConfig struct {
+	// Name: The name of the access config.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// ExternalIPv6: A static external IPv6 address, reserved by an Address
+	// with an ipv6EndpointType of VM. An ephemeral range is assigned if none
+	// is set.
+	// +optional
+	ExternalIPv6 *string `json:"externalIPv6,omitempty"`
+
+	// ExternalIPv6PrefixLength: The prefix length of the static external
+	// IPv6 range. Only 96 is supported.
+	// +optional
+	ExternalIPv6PrefixLength *int64 `json:"externalIPv6PrefixLength,omitempty"`
+}
+
+// An InstanceServiceAccount is a service account the instances run as.
+type InstanceServiceAccount struct {
+	// Email: The email address of the service account.
+	Email string `json:"email"`
+
+	// Scopes: The OAuth scopes granted to the instances, e.g.
+	// https://www.googleapis.com/auth/cloud-platform.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// InstanceTemplateObservation is used to show the observed state of the
+// InstanceTemplate.
+type InstanceTemplateObservation struct {
+	// ID: The unique identifier of the instance template.
+	ID uint64 `json:"id,omitempty"`
+
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// SelfLink: The URL of the instance template.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Disks: The observed disks of the instance template, in the order of
+	// spec.forProvider.disks.
+	Disks []AttachedDiskObservation `json:"disks,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// AttachedDiskObservation is the observed state of a disk of an instance
+// template.
+type AttachedDiskObservation struct {
+	// DeviceName: The device name of the disk.
+	DeviceName string `json:"deviceName,omitempty"`
+
+	// SourceImage: The URL of the image the disk is created from. For disks
+	// with a source image family this is the image the family was resolved
+	// to when the template was created.
+	SourceImage string `json:"sourceImage,omitempty"`
+}
+
+// InstanceTemplateSpec defines the desired state of an InstanceTemplate.
+type InstanceTemplateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceTemplateParameters `json:"forProvider"`
+}
+
+// InstanceTemplateStatus represents the observed state of an
+// InstanceTemplate.
+type InstanceTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceTemplateObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceTemplate is a managed resource that represents a global Google
+// Compute Engine instance template. The external name of the resource is the
+// name of the template.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="MACHINE-TYPE",type="string",JSONPath=".spec.forProvider.machineType"
+// +kubebuilder:printcolumn:name="UP-TO-DATE",type="string",JSONPath=".status.conditions[?(@.type=='UpToDate')].status"
+// +kubebuilder:printcolumn:name="IMAGE",type="string",JSONPath=".status.atProvider.disks[0].sourceImage",priority=1
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type InstanceTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceTemplateSpec   `json:"spec"`
+	Status InstanceTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceTemplateList contains a list of InstanceTemplate types
+type InstanceTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceTemplate `json:"items"`
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Network endpoint types supported by a NetworkEndpointGroup.
+const (
+	NetworkEndpointTypeGCEVMIPPort         = "GCE_VM_IP_PORT"
+	NetworkEndpointTypeNonGCPPrivateIPPort = "NON_GCP_PRIVATE_IP_PORT"
+	NetworkEndpointTypeServerless          = "SERVERLESS"
+)
+
+// NetworkEndpointGroupParameters define the desired state of a Google Compute
+// Engine NetworkEndpointGroup. Most fields map directly to a
+// NetworkEndpointGroup:
+// https://cloud.google.com/compute/docs/reference/rest/v1/networkEndpointGroups
+type NetworkEndpointGroupParameters struct {
+	// Zone: The zone where a zonal network endpoint group is located. Zonal
+	// groups back VM and hybrid (NON_GCP_PRIVATE_IP_PORT) endpoints. Exactly
+	// one of zone or region must be set.
+	// +optional
+	// +immutable
+	Zone *string `json:"zone,omitempty"`
+
+	// Region: The region where a regional network endpoint group is located.
+	// Regional groups back serverless endpoints such as Cloud Run, App
+	// Engine and Cloud Functions. Exactly one of zone or region must be set.
+	// +optional
+	// +immutable
+	Region *string `json:"region,omitempty"`
+
+	// Description: An optional description of this resource. Provide this
+	// property when you create the resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// NetworkEndpointType: Type of network endpoints in this network
+	// endpoint group.
+	//
+	// Possible values:
+	//   "GCE_VM_IP_PORT"
+	//   "NON_GCP_PRIVATE_IP_PORT"
+	//   "SERVERLESS"
+	// +kubebuilder:validation:Enum=GCE_VM_IP_PORT;NON_GCP_PRIVATE_IP_PORT;SERVERLESS
+	// +immutable
+	NetworkEndpointType string `json:"networkEndpointType"`
+
+	// DefaultPort: The default port used if the port number is not
+	// specified in the network endpoint.
+	// +optional
+	// +immutable
+	DefaultPort *int64 `json:"defaultPort,omitempty"`
+
+	// Network: The URL of the network to which all network endpoints in the
+	// NEG belong. Uses "default" project network if unspecified.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	// +immutable
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork: Optional URL of the subnetwork to which all network
+	// endpoints in the NEG belong.
+	// +optional
+	// +immutable
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork and retrieves its URI
+	// +optional
+	// +immutable
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork
+	// +optional
+	// +immutable
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// AppEngine: Only valid when networkEndpointType is "SERVERLESS". Only
+	// one of cloudRun, appEngine or cloudFunction may be set.
+	// +optional
+	// +immutable
+	AppEngine *NetworkEndpointGroupAppEngine `json:"appEngine,omitempty"`
+
+	// CloudFunction: Only valid when networkEndpointType is "SERVERLESS".
+	// Only one of cloudRun, appEngine or cloudFunction may be set.
+	// +optional
+	// +immutable
+	CloudFunction *NetworkEndpointGroupCloudFunction `json:"cloudFunction,omitempty"`
+
+	// CloudRun: Only valid when networkEndpointType is "SERVERLESS". Only
+	// one of cloudRun, appEngine or cloudFunction may be set.
+	// +optional
+	// +immutable
+	CloudRun *NetworkEndpointGroupCloudRun `json:"cloudRun,omitempty"`
+
+	// NetworkEndpoints: The endpoints attached to a zonal network endpoint
+	// group. When set, the controller attaches missing endpoints and detaches
+	// any endpoint that is not listed. Leave unset to manage the endpoints
+	// out of band, e.g. by GKE.
+	// +optional
+	NetworkEndpoints []NetworkEndpoint `json:"networkEndpoints,omitempty"`
+}
+
+// NetworkEndpointGroupAppEngine configures an App Engine backed serverless
+// network endpoint group.
+type NetworkEndpointGroupAppEngine struct {
+	// Service: Optional serving service. The service name is case-sensitive
+	// and must be 1-63 characters long.
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// URLMask: A template to parse service and version fields from a
+	// request URL.
+	// +optional
+	URLMask *string `json:"urlMask,omitempty"`
+
+	// Version: Optional serving version. The version name is
+	// case-sensitive and must be 1-100 characters long.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// NetworkEndpointGroupCloudFunction configures a Cloud Functions backed
+// serverless network endpoint group.
+type NetworkEndpointGroupCloudFunction struct {
+	// Function: A user-defined name of the Cloud Function. The function
+	// name is case-sensitive and must be 1-63 characters long.
+	// +optional
+	Function *string `json:"function,omitempty"`
+
+	// URLMask: A template to parse function field from a request URL.
+	// +optional
+	URLMask *string `json:"urlMask,omitempty"`
+}
+
+// NetworkEndpointGroupCloudRun configures a Cloud Run backed serverless
+// network endpoint group.
+type NetworkEndpointGroupCloudRun struct {
+	// Service: Cloud Run service is the main resource of Cloud Run. The
+	// service must be 1-63 characters long.
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// Tag: Optional Cloud Run tag represents the "named-revision" to
+	// provide additional fine-grained traffic routing information.
+	// +optional
+	Tag *string `json:"tag,omitempty"`
+
+	// URLMask: A template to parse service and tag fields from a request
+	// URL.
+	// +optional
+	URLMask *string `json:"urlMask,omitempty"`
+}
+
+// A NetworkEndpoint is a single endpoint of a zonal network endpoint group.
+type NetworkEndpoint struct {
+	// Fqdn: Optional fully qualified domain name of network endpoint.
+	// +optional
+	Fqdn *string `json:"fqdn,omitempty"`
+
+	// Instance: The name for a specific VM instance that the IP address
+	// belongs to. Required for GCE_VM_IP_PORT endpoints.
+	// +optional
+	Instance *string `json:"instance,omitempty"`
+
+	// IPAddress: Optional IPv4 address of network endpoint. For hybrid
+	// endpoints this is the address of the on-premises or other cloud
+	// backend.
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// Port: Optional port number of network endpoint. If not specified,
+	// the defaultPort for the network endpoint group will be used.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+}
+
+// A NetworkEndpointGroupObservation represents the observed state of a Google
+// Compute Engine NetworkEndpointGroup.
+type NetworkEndpointGroupObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text
+	// format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Size: Number of network endpoints in the network endpoint group.
+	Size int64 `json:"size,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// A NetworkEndpointGroupSpec defines the desired state of a
+// NetworkEndpointGroup.
+type NetworkEndpointGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkEndpointGroupParameters `json:"forProvider"`
+}
+
+// A NetworkEndpointGroupStatus represents the observed state of a
+// NetworkEndpointGroup.
+type NetworkEndpointGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkEndpointGroupObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkEndpointGroup is a managed resource that represents a Google
+// Compute Engine zonal or regional (serverless) network endpoint group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.networkEndpointType"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type NetworkEndpointGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkEndpointGroupSpec   `json:"spec"`
+	Status NetworkEndpointGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkEndpointGroupList contains a list of NetworkEndpointGroup.
+type NetworkEndpointGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkEndpointGroup `json:"items"`
+}
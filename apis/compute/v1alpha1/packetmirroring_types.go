@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// PacketMirroringParameters define the desired state of a Google Compute
+// Engine packet mirroring policy. Most fields map directly to a
+// PacketMirroring:
+// https://cloud.google.com/compute/docs/reference/rest/v1/packetMirrorings
+type PacketMirroringParameters struct {
+	// Region: The region where the packet mirroring policy resides. It
+	// must be the region of the collector.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Network: URL of the mirrored VPC network. Only packets in this
+	// network will be mirrored.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// CollectorILB: URL of the forwarding rule of the internal load
+	// balancer that is the destination of mirrored packets.
+	// +optional
+	CollectorILB *string `json:"collectorIlb,omitempty"`
+
+	// IDSEndpointRef references a Cloud IDS Endpoint and retrieves the URL
+	// of its forwarding rule as the collector.
+	// +optional
+	IDSEndpointRef *xpv1.Reference `json:"idsEndpointRef,omitempty"`
+
+	// IDSEndpointSelector selects a reference to a Cloud IDS Endpoint
+	// +optional
+	IDSEndpointSelector *xpv1.Selector `json:"idsEndpointSelector,omitempty"`
+
+	// MirroredResources: The subnetworks, instances and network tags whose
+	// traffic is mirrored.
+	MirroredResources PacketMirroringMirroredResources `json:"mirroredResources"`
+
+	// Filter: Filter for mirrored traffic. If unspecified, all traffic is
+	// mirrored.
+	// +optional
+	Filter *PacketMirroringFilter `json:"filter,omitempty"`
+
+	// Enable: Whether the mirroring policy is enforced. Defaults to true.
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// Priority: The priority of applying this configuration. The policy
+	// with the lowest priority value wins when more than one applies to an
+	// instance. Defaults to 1000.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Priority *int64 `json:"priority,omitempty"`
+}
+
+// PacketMirroringMirroredResources selects the resources whose traffic is
+// mirrored.
+type PacketMirroringMirroredResources struct {
+	// Subnetworks: URLs of subnetworks whose instances are mirrored.
+	// +optional
+	Subnetworks []string `json:"subnetworks,omitempty"`
+
+	// SubnetworkRefs references Subnetworks and retrieves their URIs
+	// +optional
+	SubnetworkRefs []xpv1.Reference `json:"subnetworkRefs,omitempty"`
+
+	// SubnetworkSelector selects references to Subnetworks
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// Instances: URLs of instances whose traffic is mirrored.
+	// +optional
+	Instances []string `json:"instances,omitempty"`
+
+	// Tags: Network tags of instances whose traffic is mirrored.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// PacketMirroringFilter restricts the traffic that is mirrored.
+type PacketMirroringFilter struct {
+	// IPProtocols: Protocols that apply as filter on mirrored traffic, e.g.
+	// tcp, udp or icmp. If unspecified, all protocols are mirrored.
+	// +optional
+	IPProtocols []string `json:"ipProtocols,omitempty"`
+
+	// CIDRRanges: IP CIDR ranges that apply as filter on the source
+	// (ingress) or destination (egress) IP of mirrored traffic.
+	// +optional
+	CIDRRanges []string `json:"cidrRanges,omitempty"`
+
+	// Direction: Direction of traffic to mirror. Defaults to BOTH.
+	// +optional
+	// +kubebuilder:validation:Enum=BOTH;EGRESS;INGRESS
+	Direction *string `json:"direction,omitempty"`
+}
+
+// A PacketMirroringObservation represents the observed state of a Google
+// Compute Engine packet mirroring policy.
+type PacketMirroringObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// A PacketMirroringSpec defines the desired state of a PacketMirroring.
+type PacketMirroringSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PacketMirroringParameters `json:"forProvider"`
+}
+
+// A PacketMirroringStatus represents the observed state of a PacketMirroring.
+type PacketMirroringStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PacketMirroringObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PacketMirroring is a managed resource that represents a Google Compute
+// Engine packet mirroring policy, e.g. to send traffic to a Cloud IDS
+// Endpoint.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type PacketMirroring struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PacketMirroringSpec   `json:"spec"`
+	Status PacketMirroringStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PacketMirroringList contains a list of PacketMirroring.
+type PacketMirroringList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PacketMirroring `json:"items"`
+}
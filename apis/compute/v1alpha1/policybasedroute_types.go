@@ -0,0 +1,227 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// PolicyBasedRouteParameters define the desired state of a Google Cloud
+// Network Connectivity PolicyBasedRoute. Most fields map directly to a
+// PolicyBasedRoute:
+// https://cloud.google.com/network-connectivity/docs/reference/networkconnectivity/rest/v1/projects.locations.global.policyBasedRoutes
+// Policy-based routes cannot be modified once created, so all fields are
+// immutable.
+type PolicyBasedRouteParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Labels: User-defined labels.
+	// +optional
+	// +immutable
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Network: URL of the network that this route applies to, for example
+	// projects/my-project/global/networks/my-network.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	// +immutable
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Priority: The priority of this policy-based route. Priority is used
+	// to break ties in cases where there are more than one matching
+	// policy-based routes found. In cases where multiple policy-based
+	// routes are matched, the one with the lowest-numbered priority value
+	// wins. The default value is 1000. The priority value must be from 1 to
+	// 65535, inclusive.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	// +immutable
+	Priority *int64 `json:"priority,omitempty"`
+
+	// Filter: The filter to match L4 traffic.
+	// +immutable
+	Filter PolicyBasedRouteFilter `json:"filter"`
+
+	// VirtualMachine: Optional. VM instances to which this policy-based
+	// route applies to. Exactly one of virtualMachine and
+	// interconnectAttachment may be set; if neither is set the route
+	// applies to all VM instances in the network.
+	// +optional
+	// +immutable
+	VirtualMachine *PolicyBasedRouteVirtualMachine `json:"virtualMachine,omitempty"`
+
+	// InterconnectAttachment: Optional. The interconnect attachments to
+	// which this route applies to.
+	// +optional
+	// +immutable
+	InterconnectAttachment *PolicyBasedRouteInterconnectAttachment `json:"interconnectAttachment,omitempty"`
+
+	// NextHopILBIP: Optional. The IP address of a global access enabled L4
+	// ILB that is the next hop for matching packets, for example the
+	// frontend of a pool of network virtual appliances.
+	// +optional
+	// +immutable
+	NextHopILBIP *string `json:"nextHopIlbIp,omitempty"`
+
+	// NextHopOtherRoutes: Optional. Other routes that will be referenced to
+	// determine the next hop of the packet. Exactly one of nextHopIlbIp and
+	// nextHopOtherRoutes must be set.
+	//
+	// Possible values:
+	//   "DEFAULT_ROUTING" - Use the routes from the default routing tables
+	// (system-generated routes, custom routes, peering route) to determine
+	// the next hop.
+	// +kubebuilder:validation:Enum=DEFAULT_ROUTING
+	// +optional
+	// +immutable
+	NextHopOtherRoutes *string `json:"nextHopOtherRoutes,omitempty"`
+}
+
+// PolicyBasedRouteFilter is the filter matching L4 traffic of a
+// PolicyBasedRoute.
+type PolicyBasedRouteFilter struct {
+	// IPProtocol: Optional. The IP protocol that this policy-based route
+	// applies to. Valid values are 'TCP', 'UDP', and 'ALL'. Default is
+	// 'ALL'.
+	// +kubebuilder:validation:Enum=TCP;UDP;ALL
+	// +optional
+	IPProtocol *string `json:"ipProtocol,omitempty"`
+
+	// SrcRange: Optional. The source IP range of outgoing packets that this
+	// policy-based route applies to. Default is "0.0.0.0/0" if protocol
+	// version is IPv4.
+	// +optional
+	SrcRange *string `json:"srcRange,omitempty"`
+
+	// DestRange: Optional. The destination IP range of outgoing packets
+	// that this policy-based route applies to. Default is "0.0.0.0/0" if
+	// protocol version is IPv4.
+	// +optional
+	DestRange *string `json:"destRange,omitempty"`
+
+	// ProtocolVersion: Internet protocol versions this policy-based route
+	// applies to. For this version, only IPV4 is supported.
+	// +kubebuilder:validation:Enum=IPV4
+	// +kubebuilder:default=IPV4
+	// +optional
+	ProtocolVersion *string `json:"protocolVersion,omitempty"`
+}
+
+// PolicyBasedRouteVirtualMachine selects the VM instances a
+// PolicyBasedRoute applies to.
+type PolicyBasedRouteVirtualMachine struct {
+	// Tags: A list of VM instance tags to which this policy-based route
+	// applies to. VM instances that have ANY of tags specified here will
+	// install this PBR.
+	Tags []string `json:"tags"`
+}
+
+// PolicyBasedRouteInterconnectAttachment selects the interconnect
+// attachments a PolicyBasedRoute applies to.
+type PolicyBasedRouteInterconnectAttachment struct {
+	// Region: Cloud region to install this policy-based route on
+	// interconnect attachment. Use `all` to install it on all interconnect
+	// attachments.
+	Region string `json:"region"`
+}
+
+// A PolicyBasedRouteObservation represents the observed state of a Google
+// Cloud Network Connectivity PolicyBasedRoute.
+type PolicyBasedRouteObservation struct {
+	// CreateTime: Time when the policy-based route was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime: Time when the policy-based route was updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// SelfLink: Server-defined fully-qualified URL for this resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Warnings: Informational warning messages, for example when the
+	// policy-based route is not applied because its next hop is
+	// unreachable.
+	Warnings []string `json:"warnings,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// A PolicyBasedRouteSpec defines the desired state of a PolicyBasedRoute.
+type PolicyBasedRouteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyBasedRouteParameters `json:"forProvider"`
+}
+
+// A PolicyBasedRouteStatus represents the observed state of a
+// PolicyBasedRoute.
+type PolicyBasedRouteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicyBasedRouteObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PolicyBasedRoute is a managed resource that represents a Google Cloud
+// Network Connectivity policy-based route, which steers traffic matching a
+// filter to a next hop such as a network virtual appliance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NEXT-HOP",type="string",JSONPath=".spec.forProvider.nextHopIlbIp"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type PolicyBasedRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicyBasedRouteSpec   `json:"spec"`
+	Status PolicyBasedRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyBasedRouteList contains a list of PolicyBasedRoute.
+type PolicyBasedRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyBasedRoute `json:"items"`
+}
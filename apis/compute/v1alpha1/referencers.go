@@ -18,14 +18,18 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	idsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
+	kmsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
 )
 
 // ResolveReferences of this Firewall
@@ -69,3 +73,580 @@ func (mg *Router) ResolveReferences(ctx context.Context, c client.Reader) error
 
 	return nil
 }
+
+// ResolveReferences of this NetworkEndpointGroup
+func (mg *NetworkEndpointGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
+		Reference:    mg.Spec.ForProvider.NetworkRef,
+		Selector:     mg.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      v1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetwork
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Subnetwork),
+		Reference:    mg.Spec.ForProvider.SubnetworkRef,
+		Selector:     mg.Spec.ForProvider.SubnetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+		Extract:      v1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetwork")
+	}
+	mg.Spec.ForProvider.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SubnetworkRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// InstanceGroupManagerURL extracts the partially qualified URL of an
+// InstanceGroupManager.
+func InstanceGroupManagerURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		igm, ok := mg.(*InstanceGroupManager)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(igm.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// InstanceTemplateURL extracts the partially qualified URL of an
+// InstanceTemplate.
+func InstanceTemplateURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		it, ok := mg.(*InstanceTemplate)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(it.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// ResolveReferences of this InstanceGroupManager
+func (mg *InstanceGroupManager) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.instanceTemplate
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.InstanceTemplate,
+		Reference:    mg.Spec.ForProvider.InstanceTemplateRef,
+		Selector:     mg.Spec.ForProvider.InstanceTemplateSelector,
+		To:           reference.To{Managed: &InstanceTemplate{}, List: &InstanceTemplateList{}},
+		Extract:      InstanceTemplateURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.instanceTemplate")
+	}
+	mg.Spec.ForProvider.InstanceTemplate = rsp.ResolvedValue
+	mg.Spec.ForProvider.InstanceTemplateRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Autoscaler
+func (mg *Autoscaler) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.target
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Target),
+		Reference:    mg.Spec.ForProvider.TargetRef,
+		Selector:     mg.Spec.ForProvider.TargetSelector,
+		To:           reference.To{Managed: &InstanceGroupManager{}, List: &InstanceGroupManagerList{}},
+		Extract:      InstanceGroupManagerURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.target")
+	}
+	mg.Spec.ForProvider.Target = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TargetRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this PacketMirroring
+func (mg *PacketMirroring) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
+		Reference:    mg.Spec.ForProvider.NetworkRef,
+		Selector:     mg.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      v1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.collectorIlb
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CollectorILB),
+		Reference:    mg.Spec.ForProvider.IDSEndpointRef,
+		Selector:     mg.Spec.ForProvider.IDSEndpointSelector,
+		To:           reference.To{Managed: &idsv1alpha1.Endpoint{}, List: &idsv1alpha1.EndpointList{}},
+		Extract:      idsv1alpha1.EndpointForwardingRule(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.collectorIlb")
+	}
+	mg.Spec.ForProvider.CollectorILB = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IDSEndpointRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.mirroredResources.subnetworks
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.MirroredResources.Subnetworks,
+		References:    mg.Spec.ForProvider.MirroredResources.SubnetworkRefs,
+		Selector:      mg.Spec.ForProvider.MirroredResources.SubnetworkSelector,
+		To:            reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+		Extract:       v1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.mirroredResources.subnetworks")
+	}
+	mg.Spec.ForProvider.MirroredResources.Subnetworks = mrsp.ResolvedValues
+	mg.Spec.ForProvider.MirroredResources.SubnetworkRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this Route
+func (mg *Route) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
+		Reference:    mg.Spec.ForProvider.NetworkRef,
+		Selector:     mg.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      v1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this PolicyBasedRoute
+func (mg *PolicyBasedRoute) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
+		Reference:    mg.Spec.ForProvider.NetworkRef,
+		Selector:     mg.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      v1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ImageImportFamily extracts the image family of an ImageImport.
+func ImageImportFamily() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		ii, ok := mg.(*ImageImport)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(ii.Spec.ForProvider.Family)
+	}
+}
+
+// ResolveReferences of this InstanceTemplate
+func (mg *InstanceTemplate) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.disks[*].sourceImageFamily
+	for i := range mg.Spec.ForProvider.Disks {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Disks[i].SourceImageFamily),
+			Reference:    mg.Spec.ForProvider.Disks[i].SourceImageFamilyRef,
+			Selector:     mg.Spec.ForProvider.Disks[i].SourceImageFamilySelector,
+			To:           reference.To{Managed: &ImageImport{}, List: &ImageImportList{}},
+			Extract:      ImageImportFamily(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.disks[%d].sourceImageFamily", i)
+		}
+		mg.Spec.ForProvider.Disks[i].SourceImageFamily = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Disks[i].SourceImageFamilyRef = rsp.ResolvedReference
+	}
+
+	for i := range mg.Spec.ForProvider.NetworkInterfaces {
+		// Resolve spec.forProvider.networkInterfaces[*].network
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NetworkInterfaces[i].Network),
+			Reference:    mg.Spec.ForProvider.NetworkInterfaces[i].NetworkRef,
+			Selector:     mg.Spec.ForProvider.NetworkInterfaces[i].NetworkSelector,
+			To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+			Extract:      v1beta1.NetworkURL(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.networkInterfaces[%d].network", i)
+		}
+		mg.Spec.ForProvider.NetworkInterfaces[i].Network = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.NetworkInterfaces[i].NetworkRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.networkInterfaces[*].subnetwork
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NetworkInterfaces[i].Subnetwork),
+			Reference:    mg.Spec.ForProvider.NetworkInterfaces[i].SubnetworkRef,
+			Selector:     mg.Spec.ForProvider.NetworkInterfaces[i].SubnetworkSelector,
+			To:           reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+			Extract:      v1beta1.SubnetworkURL(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.networkInterfaces[%d].subnetwork", i)
+		}
+		mg.Spec.ForProvider.NetworkInterfaces[i].Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.NetworkInterfaces[i].SubnetworkRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this Instance
+func (mg *Instance) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.disks[*].sourceImageFamily
+	for i := range mg.Spec.ForProvider.Disks {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Disks[i].SourceImageFamily),
+			Reference:    mg.Spec.ForProvider.Disks[i].SourceImageFamilyRef,
+			Selector:     mg.Spec.ForProvider.Disks[i].SourceImageFamilySelector,
+			To:           reference.To{Managed: &ImageImport{}, List: &ImageImportList{}},
+			Extract:      ImageImportFamily(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.disks[%d].sourceImageFamily", i)
+		}
+		mg.Spec.ForProvider.Disks[i].SourceImageFamily = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Disks[i].SourceImageFamilyRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.disks[*].*EncryptionKey.kmsKeyName
+		d := &mg.Spec.ForProvider.Disks[i]
+		keys := []struct {
+			field string
+			key   *DiskEncryptionKey
+		}{
+			{field: "diskEncryptionKey", key: d.DiskEncryptionKey},
+			{field: "sourceImageEncryptionKey", key: d.SourceImageEncryptionKey},
+			{field: "sourceSnapshotEncryptionKey", key: d.SourceSnapshotEncryptionKey},
+		}
+		for _, dk := range keys {
+			k := dk.key
+			if k == nil {
+				continue
+			}
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(k.KMSKeyName),
+				Reference:    k.KMSKeyNameRef,
+				Selector:     k.KMSKeyNameSelector,
+				To:           reference.To{Managed: &kmsv1alpha1.CryptoKey{}, List: &kmsv1alpha1.CryptoKeyList{}},
+				Extract:      kmsv1alpha1.CryptoKeyRRN(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.disks[%d].%s.kmsKeyName", i, dk.field)
+			}
+			k.KMSKeyName = reference.ToPtrValue(rsp.ResolvedValue)
+			k.KMSKeyNameRef = rsp.ResolvedReference
+		}
+	}
+
+	for i := range mg.Spec.ForProvider.NetworkInterfaces {
+		// Resolve spec.forProvider.networkInterfaces[*].network
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NetworkInterfaces[i].Network),
+			Reference:    mg.Spec.ForProvider.NetworkInterfaces[i].NetworkRef,
+			Selector:     mg.Spec.ForProvider.NetworkInterfaces[i].NetworkSelector,
+			To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+			Extract:      v1beta1.NetworkURL(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.networkInterfaces[%d].network", i)
+		}
+		mg.Spec.ForProvider.NetworkInterfaces[i].Network = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.NetworkInterfaces[i].NetworkRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.networkInterfaces[*].subnetwork
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NetworkInterfaces[i].Subnetwork),
+			Reference:    mg.Spec.ForProvider.NetworkInterfaces[i].SubnetworkRef,
+			Selector:     mg.Spec.ForProvider.NetworkInterfaces[i].SubnetworkSelector,
+			To:           reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+			Extract:      v1beta1.SubnetworkURL(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.networkInterfaces[%d].subnetwork", i)
+		}
+		mg.Spec.ForProvider.NetworkInterfaces[i].Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.NetworkInterfaces[i].SubnetworkRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// DiskURL extracts the partially qualified URL of a Disk.
+func DiskURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		d, ok := mg.(*Disk)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(d.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// ResourcePolicyURL extracts the partially qualified URL of a
+// ResourcePolicy.
+func ResourcePolicyURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		rp, ok := mg.(*ResourcePolicy)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(rp.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// ResolveReferences of this Disk
+func (mg *Disk) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.resourcePolicies
+	rsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ResourcePolicies,
+		References:    mg.Spec.ForProvider.ResourcePolicyRefs,
+		Selector:      mg.Spec.ForProvider.ResourcePolicySelector,
+		To:            reference.To{Managed: &ResourcePolicy{}, List: &ResourcePolicyList{}},
+		Extract:       ResourcePolicyURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourcePolicies")
+	}
+	mg.Spec.ForProvider.ResourcePolicies = rsp.ResolvedValues
+	mg.Spec.ForProvider.ResourcePolicyRefs = rsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this Snapshot
+func (mg *Snapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.sourceDisk
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceDisk),
+		Reference:    mg.Spec.ForProvider.SourceDiskRef,
+		Selector:     mg.Spec.ForProvider.SourceDiskSelector,
+		To:           reference.To{Managed: &Disk{}, List: &DiskList{}},
+		Extract:      DiskURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceDisk")
+	}
+	mg.Spec.ForProvider.SourceDisk = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceDiskRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RouterNAT
+func (mg *RouterNAT) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.router
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Router),
+		Reference:    mg.Spec.ForProvider.RouterRef,
+		Selector:     mg.Spec.ForProvider.RouterSelector,
+		To:           reference.To{Managed: &Router{}, List: &RouterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.router")
+	}
+	mg.Spec.ForProvider.Router = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RouterRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.natIps
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.NATIPs,
+		References:    mg.Spec.ForProvider.NATIPRefs,
+		Selector:      mg.Spec.ForProvider.NATIPSelector,
+		To:            reference.To{Managed: &v1beta1.Address{}, List: &v1beta1.AddressList{}},
+		Extract:       v1beta1.AddressURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.natIps")
+	}
+	mg.Spec.ForProvider.NATIPs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.NATIPRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.subnetworks[*].subnetwork
+	for i := range mg.Spec.ForProvider.Subnetworks {
+		sn := &mg.Spec.ForProvider.Subnetworks[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(sn.Subnetwork),
+			Reference:    sn.SubnetworkRef,
+			Selector:     sn.SubnetworkSelector,
+			To:           reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+			Extract:      v1beta1.SubnetworkURL(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.subnetworks[%d].subnetwork", i)
+		}
+		sn.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+		sn.SubnetworkRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this RouterPeer
+func (mg *RouterPeer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.router
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Router),
+		Reference:    mg.Spec.ForProvider.RouterRef,
+		Selector:     mg.Spec.ForProvider.RouterSelector,
+		To:           reference.To{Managed: &Router{}, List: &RouterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.router")
+	}
+	mg.Spec.ForProvider.Router = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RouterRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// NetworkEndpointGroupURL extracts the partially qualified URL of a
+// NetworkEndpointGroup.
+func NetworkEndpointGroupURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		neg, ok := mg.(*NetworkEndpointGroup)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(neg.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// InstanceGroupURL extracts the partially qualified URL of the instance group
+// of an InstanceGroupManager.
+func InstanceGroupURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		igm, ok := mg.(*InstanceGroupManager)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(igm.Status.AtProvider.InstanceGroup, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// HealthCheckURL extracts the partially qualified URL of a HealthCheck.
+func HealthCheckURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		hc, ok := mg.(*HealthCheck)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(hc.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// BackendServiceURL extracts the partially qualified URL of a
+// BackendService.
+func BackendServiceURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		bs, ok := mg.(*BackendService)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(bs.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// ResolveReferences of this BackendService
+func (mg *BackendService) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.healthChecks
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.HealthChecks,
+		References:    mg.Spec.ForProvider.HealthCheckRefs,
+		Selector:      mg.Spec.ForProvider.HealthCheckSelector,
+		To:            reference.To{Managed: &HealthCheck{}, List: &HealthCheckList{}},
+		Extract:       HealthCheckURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.healthChecks")
+	}
+	mg.Spec.ForProvider.HealthChecks = mrsp.ResolvedValues
+	mg.Spec.ForProvider.HealthCheckRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.backends[*].group from either an
+	// InstanceGroupManager or a NetworkEndpointGroup.
+	for i := range mg.Spec.ForProvider.Backends {
+		b := &mg.Spec.ForProvider.Backends[i]
+		if b.InstanceGroupManagerRef != nil || b.InstanceGroupManagerSelector != nil {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(b.Group),
+				Reference:    b.InstanceGroupManagerRef,
+				Selector:     b.InstanceGroupManagerSelector,
+				To:           reference.To{Managed: &InstanceGroupManager{}, List: &InstanceGroupManagerList{}},
+				Extract:      InstanceGroupURL(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.backends[%d].group", i)
+			}
+			b.Group = reference.ToPtrValue(rsp.ResolvedValue)
+			b.InstanceGroupManagerRef = rsp.ResolvedReference
+			continue
+		}
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(b.Group),
+			Reference:    b.NetworkEndpointGroupRef,
+			Selector:     b.NetworkEndpointGroupSelector,
+			To:           reference.To{Managed: &NetworkEndpointGroup{}, List: &NetworkEndpointGroupList{}},
+			Extract:      NetworkEndpointGroupURL(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.backends[%d].group", i)
+		}
+		b.Group = reference.ToPtrValue(rsp.ResolvedValue)
+		b.NetworkEndpointGroupRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -53,7 +53,151 @@ var (
 	RouterGroupVersionKind = SchemeGroupVersion.WithKind(RouterKind)
 )
 
+// NetworkEndpointGroup type metadata.
+var (
+	NetworkEndpointGroupKind             = reflect.TypeOf(NetworkEndpointGroup{}).Name()
+	NetworkEndpointGroupGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkEndpointGroupKind}.String()
+	NetworkEndpointGroupKindAPIVersion   = NetworkEndpointGroupKind + "." + SchemeGroupVersion.String()
+	NetworkEndpointGroupGroupVersionKind = SchemeGroupVersion.WithKind(NetworkEndpointGroupKind)
+)
+
+// InstanceGroupManager type metadata.
+var (
+	InstanceGroupManagerKind             = reflect.TypeOf(InstanceGroupManager{}).Name()
+	InstanceGroupManagerGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceGroupManagerKind}.String()
+	InstanceGroupManagerKindAPIVersion   = InstanceGroupManagerKind + "." + SchemeGroupVersion.String()
+	InstanceGroupManagerGroupVersionKind = SchemeGroupVersion.WithKind(InstanceGroupManagerKind)
+)
+
+// ImageImport type metadata.
+var (
+	ImageImportKind             = reflect.TypeOf(ImageImport{}).Name()
+	ImageImportGroupKind        = schema.GroupKind{Group: Group, Kind: ImageImportKind}.String()
+	ImageImportKindAPIVersion   = ImageImportKind + "." + SchemeGroupVersion.String()
+	ImageImportGroupVersionKind = SchemeGroupVersion.WithKind(ImageImportKind)
+)
+
+// InstanceTemplate type metadata.
+var (
+	InstanceTemplateKind             = reflect.TypeOf(InstanceTemplate{}).Name()
+	InstanceTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceTemplateKind}.String()
+	InstanceTemplateKindAPIVersion   = InstanceTemplateKind + "." + SchemeGroupVersion.String()
+	InstanceTemplateGroupVersionKind = SchemeGroupVersion.WithKind(InstanceTemplateKind)
+)
+
+// Autoscaler type metadata.
+var (
+	AutoscalerKind             = reflect.TypeOf(Autoscaler{}).Name()
+	AutoscalerGroupKind        = schema.GroupKind{Group: Group, Kind: AutoscalerKind}.String()
+	AutoscalerKindAPIVersion   = AutoscalerKind + "." + SchemeGroupVersion.String()
+	AutoscalerGroupVersionKind = SchemeGroupVersion.WithKind(AutoscalerKind)
+)
+
+// PacketMirroring type metadata.
+var (
+	PacketMirroringKind             = reflect.TypeOf(PacketMirroring{}).Name()
+	PacketMirroringGroupKind        = schema.GroupKind{Group: Group, Kind: PacketMirroringKind}.String()
+	PacketMirroringKindAPIVersion   = PacketMirroringKind + "." + SchemeGroupVersion.String()
+	PacketMirroringGroupVersionKind = SchemeGroupVersion.WithKind(PacketMirroringKind)
+)
+
+// Route type metadata.
+var (
+	RouteKind             = reflect.TypeOf(Route{}).Name()
+	RouteGroupKind        = schema.GroupKind{Group: Group, Kind: RouteKind}.String()
+	RouteKindAPIVersion   = RouteKind + "." + SchemeGroupVersion.String()
+	RouteGroupVersionKind = SchemeGroupVersion.WithKind(RouteKind)
+)
+
+// PolicyBasedRoute type metadata.
+var (
+	PolicyBasedRouteKind             = reflect.TypeOf(PolicyBasedRoute{}).Name()
+	PolicyBasedRouteGroupKind        = schema.GroupKind{Group: Group, Kind: PolicyBasedRouteKind}.String()
+	PolicyBasedRouteKindAPIVersion   = PolicyBasedRouteKind + "." + SchemeGroupVersion.String()
+	PolicyBasedRouteGroupVersionKind = SchemeGroupVersion.WithKind(PolicyBasedRouteKind)
+)
+
+// Instance type metadata.
+var (
+	InstanceKind             = reflect.TypeOf(Instance{}).Name()
+	InstanceGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceKind}.String()
+	InstanceKindAPIVersion   = InstanceKind + "." + SchemeGroupVersion.String()
+	InstanceGroupVersionKind = SchemeGroupVersion.WithKind(InstanceKind)
+)
+
+// Disk type metadata.
+var (
+	DiskKind             = reflect.TypeOf(Disk{}).Name()
+	DiskGroupKind        = schema.GroupKind{Group: Group, Kind: DiskKind}.String()
+	DiskKindAPIVersion   = DiskKind + "." + SchemeGroupVersion.String()
+	DiskGroupVersionKind = SchemeGroupVersion.WithKind(DiskKind)
+)
+
+// ResourcePolicy type metadata.
+var (
+	ResourcePolicyKind             = reflect.TypeOf(ResourcePolicy{}).Name()
+	ResourcePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: ResourcePolicyKind}.String()
+	ResourcePolicyKindAPIVersion   = ResourcePolicyKind + "." + SchemeGroupVersion.String()
+	ResourcePolicyGroupVersionKind = SchemeGroupVersion.WithKind(ResourcePolicyKind)
+)
+
+// Snapshot type metadata.
+var (
+	SnapshotKind             = reflect.TypeOf(Snapshot{}).Name()
+	SnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+	SnapshotKindAPIVersion   = SnapshotKind + "." + SchemeGroupVersion.String()
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
+// RouterNAT type metadata.
+var (
+	RouterNATKind             = reflect.TypeOf(RouterNAT{}).Name()
+	RouterNATGroupKind        = schema.GroupKind{Group: Group, Kind: RouterNATKind}.String()
+	RouterNATKindAPIVersion   = RouterNATKind + "." + SchemeGroupVersion.String()
+	RouterNATGroupVersionKind = SchemeGroupVersion.WithKind(RouterNATKind)
+)
+
+// RouterPeer type metadata.
+var (
+	RouterPeerKind             = reflect.TypeOf(RouterPeer{}).Name()
+	RouterPeerGroupKind        = schema.GroupKind{Group: Group, Kind: RouterPeerKind}.String()
+	RouterPeerKindAPIVersion   = RouterPeerKind + "." + SchemeGroupVersion.String()
+	RouterPeerGroupVersionKind = SchemeGroupVersion.WithKind(RouterPeerKind)
+)
+
+// HealthCheck type metadata.
+var (
+	HealthCheckKind             = reflect.TypeOf(HealthCheck{}).Name()
+	HealthCheckGroupKind        = schema.GroupKind{Group: Group, Kind: HealthCheckKind}.String()
+	HealthCheckKindAPIVersion   = HealthCheckKind + "." + SchemeGroupVersion.String()
+	HealthCheckGroupVersionKind = SchemeGroupVersion.WithKind(HealthCheckKind)
+)
+
+// BackendService type metadata.
+var (
+	BackendServiceKind             = reflect.TypeOf(BackendService{}).Name()
+	BackendServiceGroupKind        = schema.GroupKind{Group: Group, Kind: BackendServiceKind}.String()
+	BackendServiceKindAPIVersion   = BackendServiceKind + "." + SchemeGroupVersion.String()
+	BackendServiceGroupVersionKind = SchemeGroupVersion.WithKind(BackendServiceKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Firewall{}, &FirewallList{})
 	SchemeBuilder.Register(&Router{}, &RouterList{})
+	SchemeBuilder.Register(&NetworkEndpointGroup{}, &NetworkEndpointGroupList{})
+	SchemeBuilder.Register(&InstanceGroupManager{}, &InstanceGroupManagerList{})
+	SchemeBuilder.Register(&ImageImport{}, &ImageImportList{})
+	SchemeBuilder.Register(&InstanceTemplate{}, &InstanceTemplateList{})
+	SchemeBuilder.Register(&Autoscaler{}, &AutoscalerList{})
+	SchemeBuilder.Register(&PacketMirroring{}, &PacketMirroringList{})
+	SchemeBuilder.Register(&Route{}, &RouteList{})
+	SchemeBuilder.Register(&PolicyBasedRoute{}, &PolicyBasedRouteList{})
+	SchemeBuilder.Register(&Instance{}, &InstanceList{})
+	SchemeBuilder.Register(&Disk{}, &DiskList{})
+	SchemeBuilder.Register(&ResourcePolicy{}, &ResourcePolicyList{})
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
+	SchemeBuilder.Register(&RouterNAT{}, &RouterNATList{})
+	SchemeBuilder.Register(&RouterPeer{}, &RouterPeerList{})
+	SchemeBuilder.Register(&HealthCheck{}, &HealthCheckList{})
+	SchemeBuilder.Register(&BackendService{}, &BackendServiceList{})
 }
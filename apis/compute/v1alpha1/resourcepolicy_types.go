@@ -0,0 +1,235 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// ResourcePolicy statuses.
+const (
+	ResourcePolicyStatusCreating = "CREATING"
+	ResourcePolicyStatusReady    = "READY"
+	ResourcePolicyStatusDeleting = "DELETING"
+	ResourcePolicyStatusInvalid  = "INVALID"
+	ResourcePolicyStatusExpired  = "EXPIRED"
+)
+
+// ResourcePolicyParameters define the desired state of a Google Compute
+// Engine resource policy. Only snapshot schedule policies are supported. The
+// schedule, retention policy and snapshot properties of a policy can be
+// changed while it is attached to disks.
+// https://cloud.google.com/compute/docs/reference/rest/v1/resourcePolicies
+type ResourcePolicyParameters struct {
+	// Region: The region of the resource policy. Disks can only use policies
+	// of their own region.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region"`
+
+	// Description: An optional description of the resource policy.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// SnapshotSchedulePolicy: A policy that periodically creates snapshots
+	// of the disks it is attached to.
+	SnapshotSchedulePolicy SnapshotSchedulePolicy `json:"snapshotSchedulePolicy"`
+}
+
+// A SnapshotSchedulePolicy periodically creates snapshots of the disks it is
+// attached to.
+type SnapshotSchedulePolicy struct {
+	// Schedule: When snapshots are created.
+	Schedule SnapshotSchedule `json:"schedule"`
+
+	// RetentionPolicy: How long snapshots created by the policy are kept.
+	// +optional
+	RetentionPolicy *SnapshotRetentionPolicy `json:"retentionPolicy,omitempty"`
+
+	// SnapshotProperties: The properties of the snapshots created by the
+	// policy.
+	// +optional
+	SnapshotProperties *SnapshotProperties `json:"snapshotProperties,omitempty"`
+}
+
+// A SnapshotSchedule configures when snapshots are created. Exactly one of
+// hourly, daily or weekly schedule must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.hourlySchedule), has(self.dailySchedule), has(self.weeklySchedule)].filter(x, x).size() == 1",message="exactly one of hourlySchedule, dailySchedule or weeklySchedule must be set"
+type SnapshotSchedule struct {
+	// HourlySchedule: Creates a snapshot every few hours.
+	// +optional
+	HourlySchedule *HourlyCycle `json:"hourlySchedule,omitempty"`
+
+	// DailySchedule: Creates a snapshot every few days.
+	// +optional
+	DailySchedule *DailyCycle `json:"dailySchedule,omitempty"`
+
+	// WeeklySchedule: Creates snapshots on some days of the week.
+	// +optional
+	WeeklySchedule *WeeklyCycle `json:"weeklySchedule,omitempty"`
+}
+
+// An HourlyCycle creates a snapshot every few hours.
+type HourlyCycle struct {
+	// HoursInCycle: The number of hours between snapshots.
+	// +kubebuilder:validation:Minimum=1
+	HoursInCycle int64 `json:"hoursInCycle"`
+
+	// StartTime: The time of the first snapshot of a day, in UTC and in
+	// HH:MM format. The minutes must be 00.
+	StartTime string `json:"startTime"`
+}
+
+// A DailyCycle creates a snapshot every few days.
+type DailyCycle struct {
+	// DaysInCycle: The number of days between snapshots. Only 1 is
+	// supported.
+	// +kubebuilder:validation:Minimum=1
+	DaysInCycle int64 `json:"daysInCycle"`
+
+	// StartTime: The time of the snapshot, in UTC and in HH:MM format. The
+	// minutes must be 00.
+	StartTime string `json:"startTime"`
+}
+
+// A WeeklyCycle creates snapshots on some days of the week.
+type WeeklyCycle struct {
+	// DayOfWeeks: The days of the week snapshots are created on.
+	// +kubebuilder:validation:MinItems=1
+	DayOfWeeks []DayOfWeek `json:"dayOfWeeks"`
+}
+
+// A DayOfWeek is a day of the week a snapshot is created on.
+type DayOfWeek struct {
+	// Day: The day of the week, e.g. MONDAY.
+	// +kubebuilder:validation:Enum=MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY;SUNDAY
+	Day string `json:"day"`
+
+	// StartTime: The time of the snapshot, in UTC and in HH:MM format. The
+	// minutes must be 00.
+	StartTime string `json:"startTime"`
+}
+
+// A SnapshotRetentionPolicy configures how long snapshots created by a
+// snapshot schedule policy are kept.
+type SnapshotRetentionPolicy struct {
+	// MaxRetentionDays: The number of days snapshots are kept for.
+	// +kubebuilder:validation:Minimum=1
+	MaxRetentionDays int64 `json:"maxRetentionDays"`
+
+	// OnSourceDiskDelete: What happens to the snapshots when their disk is
+	// deleted, i.e. KEEP_AUTO_SNAPSHOTS or APPLY_RETENTION_POLICY. Defaults
+	// to KEEP_AUTO_SNAPSHOTS.
+	// +optional
+	// +kubebuilder:validation:Enum=KEEP_AUTO_SNAPSHOTS;APPLY_RETENTION_POLICY
+	OnSourceDiskDelete *string `json:"onSourceDiskDelete,omitempty"`
+}
+
+// SnapshotProperties are the properties of the snapshots created by a
+// snapshot schedule policy.
+type SnapshotProperties struct {
+	// Labels: The labels applied to the snapshots.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// StorageLocations: The Cloud Storage multi-region or region the
+	// snapshots are stored in, e.g. us. At most one location is supported.
+	// +optional
+	// +kubebuilder:validation:MaxItems=1
+	StorageLocations []string `json:"storageLocations,omitempty"`
+
+	// GuestFlush: Whether the guest file systems are flushed before
+	// snapshots are created, i.e. application consistent snapshots are
+	// created. Only supported by some operating systems.
+	// +optional
+	GuestFlush *bool `json:"guestFlush,omitempty"`
+}
+
+// ResourcePolicyObservation is used to show the observed state of the
+// ResourcePolicy.
+type ResourcePolicyObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the resource policy, i.e. CREATING, READY,
+	// DELETING, INVALID or EXPIRED.
+	Status string `json:"status,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// ResourcePolicySpec defines the desired state of a ResourcePolicy.
+type ResourcePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ResourcePolicyParameters `json:"forProvider"`
+}
+
+// ResourcePolicyStatus represents the observed state of a ResourcePolicy.
+type ResourcePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ResourcePolicyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourcePolicy is a managed resource that represents a Google Compute
+// Engine snapshot schedule policy. Disks use it by referencing it in their
+// resourcePolicies. The external name of the resource is the name of the
+// policy.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ResourcePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourcePolicySpec   `json:"spec"`
+	Status ResourcePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourcePolicyList contains a list of ResourcePolicy types
+type ResourcePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourcePolicy `json:"items"`
+}
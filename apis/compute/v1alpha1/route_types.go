@@ -0,0 +1,191 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// RouteParameters define the desired state of a Google Compute Engine Route.
+// Most fields map directly to a Route:
+// https://cloud.google.com/compute/docs/reference/rest/v1/routes/
+// Routes cannot be modified once created, so all fields are immutable.
+type RouteParameters struct {
+	// Description: An optional description of this resource. Provide this
+	// field when you create the resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Network: Fully-qualified URL of the network that this route applies
+	// to.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	// +immutable
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// DestRange: The destination range of outgoing packets that this route
+	// applies to. Both IPv4 and IPv6 are supported.
+	// +immutable
+	DestRange string `json:"destRange"`
+
+	// Priority: The priority of this route. Priority is used to break ties
+	// in cases where there is more than one matching route of equal prefix
+	// length. In cases where multiple routes have equal prefix length, the
+	// one with the lowest-numbered priority value wins. The default value
+	// is `1000`.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	// +immutable
+	Priority *int64 `json:"priority,omitempty"`
+
+	// Tags: A list of instance tags to which this route applies. If empty,
+	// the route applies to all instances in the network.
+	// +optional
+	// +immutable
+	Tags []string `json:"tags,omitempty"`
+
+	// NextHopGateway: The URL to a gateway that should handle matching
+	// packets. You can only specify the internet gateway using a full or
+	// partial valid URL:
+	// projects/project/global/gateways/default-internet-gateway
+	// +optional
+	// +immutable
+	NextHopGateway *string `json:"nextHopGateway,omitempty"`
+
+	// NextHopInstance: The URL to an instance that should handle matching
+	// packets. You can specify this as a full or partial URL. For example:
+	// https://www.googleapis.com/compute/v1/projects/project/zones/zone/instances/
+	// +optional
+	// +immutable
+	NextHopInstance *string `json:"nextHopInstance,omitempty"`
+
+	// NextHopIP: The network IP address of an instance that should handle
+	// matching packets.
+	// +optional
+	// +immutable
+	NextHopIP *string `json:"nextHopIp,omitempty"`
+
+	// NextHopILB: The URL to a forwarding rule of type
+	// loadBalancingScheme=INTERNAL that should handle matching packets, or
+	// the IP address of the forwarding rule.
+	// +optional
+	// +immutable
+	NextHopILB *string `json:"nextHopIlb,omitempty"`
+
+	// NextHopVpnTunnel: The URL to a VpnTunnel that should handle matching
+	// packets.
+	// +optional
+	// +immutable
+	NextHopVpnTunnel *string `json:"nextHopVpnTunnel,omitempty"`
+}
+
+// A RouteObservation represents the observed state of a Google Compute
+// Engine Route.
+type RouteObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text
+	// format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// NextHopNetwork: The URL of the local network if it should handle
+	// matching packets.
+	NextHopNetwork string `json:"nextHopNetwork,omitempty"`
+
+	// RouteStatus: The status of the route, either `ACTIVE` or `DROPPED`.
+	// A route is dropped when its next hop cannot handle traffic, for
+	// example because the next hop instance is stopped.
+	RouteStatus string `json:"routeStatus,omitempty"`
+
+	// RouteType: The type of this route, which can be one of `TRANSIT`,
+	// `SUBNET`, `BGP` or `STATIC`.
+	RouteType string `json:"routeType,omitempty"`
+
+	// Warnings: Informational warning messages, for example when the next
+	// hop instance of the route does not exist.
+	Warnings []string `json:"warnings,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// A RouteSpec defines the desired state of a Route.
+type RouteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RouteParameters `json:"forProvider"`
+}
+
+// A RouteStatus represents the observed state of a Route.
+type RouteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RouteObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Route is a managed resource that represents a Google Compute Engine Route
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DEST-RANGE",type="string",JSONPath=".spec.forProvider.destRange"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.routeStatus"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Route struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouteSpec   `json:"spec"`
+	Status RouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouteList contains a list of Route.
+type RouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Route `json:"items"`
+}
@@ -16,6 +16,8 @@ package v1alpha1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // RouterParameters define the desired state of a Google Compute Engine
@@ -55,7 +57,9 @@ type RouterParameters struct {
 	// BgpPeers: BGP information that must be configured into the routing
 	// stack to establish BGP peering. This information must specify the
 	// peer ASN and either the interface name, IP address, or peer IP
-	// address. Please refer to RFC4273.
+	// address. Please refer to RFC4273. If set, it replaces all BGP peers
+	// of the router. Leave it unset to manage the BGP peers of the router
+	// with RouterPeer resources instead.
 	// +optional
 	BgpPeers []*RouterBgpPeer `json:"bgpPeers,omitempty"`
 
@@ -68,11 +72,15 @@ type RouterParameters struct {
 
 	// Interfaces: Router interfaces. Each interface requires either one
 	// linked resource, (for example, linkedVpnTunnel), or IP address and IP
-	// address range (for example, ipRange), or both.
+	// address range (for example, ipRange), or both. If set, it replaces
+	// all interfaces of the router. Leave it unset if the interfaces of
+	// the router are managed with RouterPeer resources.
 	// +optional
 	Interfaces []*RouterInterface `json:"interfaces,omitempty"`
 
-	// Nats: A list of NAT services created in this router.
+	// Nats: A list of NAT services created in this router. If set, it
+	// replaces all NATs of the router. Leave it unset to manage the NATs
+	// of the router with RouterNAT resources instead.
 	// +optional
 	Nats []*RouterNat `json:"nats,omitempty"`
 }
@@ -394,6 +402,10 @@ type RouterObservation struct {
 
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // A RouterSpec defines the desired state of a Router.
@@ -406,6 +418,11 @@ type RouterSpec struct {
 type RouterStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          RouterObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -413,6 +430,11 @@ type RouterStatus struct {
 // A Router is a managed resource that represents a Google Compute Engine Router
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="NETWORK",type="string",JSONPath=".spec.forProvider.network"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type Router struct {
@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// NAT IP allocation options of a RouterNAT.
+const (
+	NATIPAllocateAutoOnly   = "AUTO_ONLY"
+	NATIPAllocateManualOnly = "MANUAL_ONLY"
+)
+
+// RouterNATParameters define the desired state of a Cloud NAT gateway, which
+// is configured as one of the NATs of a Cloud Router.
+// https://cloud.google.com/compute/docs/reference/rest/v1/routers
+type RouterNATParameters struct {
+	// Region: The region of the router.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region"`
+
+	// Router: The name of the Cloud Router the NAT is configured on.
+	// +optional
+	// +immutable
+	Router *string `json:"router,omitempty"`
+
+	// RouterRef references a Router and retrieves its name.
+	// +optional
+	// +immutable
+	RouterRef *xpv1.Reference `json:"routerRef,omitempty"`
+
+	// RouterSelector selects a reference to a Router.
+	// +optional
+	// +immutable
+	RouterSelector *xpv1.Selector `json:"routerSelector,omitempty"`
+
+	// NATIPAllocateOption: How external IP addresses are allocated to the
+	// NAT.
+	// - AUTO_ONLY: NAT IPs are allocated by Google Cloud; natIps must be
+	// empty.
+	// - MANUAL_ONLY: Only the NAT IPs in natIps are used. When there are
+	// not enough of them, new VMs cannot use the NAT.
+	// +kubebuilder:validation:Enum=AUTO_ONLY;MANUAL_ONLY
+	NATIPAllocateOption string `json:"natIpAllocateOption"`
+
+	// NATIPs: The URLs of the static external addresses used by the NAT
+	// when natIpAllocateOption is MANUAL_ONLY.
+	// +optional
+	NATIPs []string `json:"natIps,omitempty"`
+
+	// NATIPRefs references Addresses and retrieves their URLs.
+	// +optional
+	NATIPRefs []xpv1.Reference `json:"natIpRefs,omitempty"`
+
+	// NATIPSelector selects references to Addresses.
+	// +optional
+	NATIPSelector *xpv1.Selector `json:"natIpSelector,omitempty"`
+
+	// DrainNATIPs: The URLs of NAT IPs that are being drained. Drained IPs
+	// must have been assigned to the NAT before; they keep serving
+	// existing connections but are not used for new ones.
+	// +optional
+	DrainNATIPs []string `json:"drainNatIps,omitempty"`
+
+	// SourceSubnetworkIPRangesToNAT: Which subnetwork ranges of the
+	// router's network can use the NAT.
+	// - ALL_SUBNETWORKS_ALL_IP_RANGES: All ranges of all subnetworks.
+	// - ALL_SUBNETWORKS_ALL_PRIMARY_IP_RANGES: The primary ranges of all
+	// subnetworks.
+	// - LIST_OF_SUBNETWORKS: The subnetworks listed in subnetworks.
+	// +kubebuilder:validation:Enum=ALL_SUBNETWORKS_ALL_IP_RANGES;ALL_SUBNETWORKS_ALL_PRIMARY_IP_RANGES;LIST_OF_SUBNETWORKS
+	SourceSubnetworkIPRangesToNAT string `json:"sourceSubnetworkIpRangesToNat"`
+
+	// Subnetworks: The subnetworks that can use the NAT when
+	// sourceSubnetworkIpRangesToNat is LIST_OF_SUBNETWORKS.
+	// +optional
+	Subnetworks []RouterNATSubnetwork `json:"subnetworks,omitempty"`
+
+	// MinPortsPerVM: Minimum number of ports allocated to a VM from this
+	// NAT. It is rounded up to the nearest power of 2. Defaults to 64.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=65536
+	MinPortsPerVM *int64 `json:"minPortsPerVm,omitempty"`
+
+	// EnableEndpointIndependentMapping: Whether a VM uses the same NAT IP
+	// and port for all destinations.
+	// +optional
+	EnableEndpointIndependentMapping *bool `json:"enableEndpointIndependentMapping,omitempty"`
+
+	// ICMPIdleTimeoutSec: Timeout in seconds for ICMP connections. Defaults
+	// to 30.
+	// +optional
+	ICMPIdleTimeoutSec *int64 `json:"icmpIdleTimeoutSec,omitempty"`
+
+	// UDPIdleTimeoutSec: Timeout in seconds for UDP connections. Defaults
+	// to 30.
+	// +optional
+	UDPIdleTimeoutSec *int64 `json:"udpIdleTimeoutSec,omitempty"`
+
+	// TCPEstablishedIdleTimeoutSec: Timeout in seconds for established TCP
+	// connections. Defaults to 1200.
+	// +optional
+	TCPEstablishedIdleTimeoutSec *int64 `json:"tcpEstablishedIdleTimeoutSec,omitempty"`
+
+	// TCPTransitoryIdleTimeoutSec: Timeout in seconds for transitory TCP
+	// connections. Defaults to 30.
+	// +optional
+	TCPTransitoryIdleTimeoutSec *int64 `json:"tcpTransitoryIdleTimeoutSec,omitempty"`
+
+	// LogConfig: Configures logging of the NAT.
+	// +optional
+	LogConfig *RouterNatLogConfig `json:"logConfig,omitempty"`
+}
+
+// A RouterNATSubnetwork selects a subnetwork that can use a NAT.
+type RouterNATSubnetwork struct {
+	// Subnetwork: The URL of the subnetwork.
+	// +optional
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork and retrieves its URL.
+	// +optional
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork.
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// SourceIPRangesToNAT: Which ranges of the subnetwork can use the NAT.
+	// Defaults to ALL_IP_RANGES. The only valid combination of several
+	// values is PRIMARY_IP_RANGE and LIST_OF_SECONDARY_IP_RANGES.
+	// +optional
+	SourceIPRangesToNAT []string `json:"sourceIpRangesToNat,omitempty"`
+
+	// SecondaryIPRangeNames: The secondary ranges of the subnetwork that
+	// can use the NAT, if sourceIpRangesToNat contains
+	// LIST_OF_SECONDARY_IP_RANGES.
+	// +optional
+	SecondaryIPRangeNames []string `json:"secondaryIpRangeNames,omitempty"`
+}
+
+// A RouterNATObservation reflects the observed state of a RouterNAT.
+type RouterNATObservation struct {
+	// AutoAllocatedNATIPs: The NAT IPs allocated by Google Cloud when
+	// natIpAllocateOption is AUTO_ONLY.
+	AutoAllocatedNATIPs []string `json:"autoAllocatedNatIps,omitempty"`
+
+	// UserAllocatedNATIPs: The NAT IPs assigned from natIps that are in
+	// use.
+	UserAllocatedNATIPs []string `json:"userAllocatedNatIps,omitempty"`
+
+	// MinExtraNATIPsNeeded: The number of additional NAT IPs needed so
+	// that all VMs can use the NAT, when natIpAllocateOption is
+	// MANUAL_ONLY.
+	MinExtraNATIPsNeeded int64 `json:"minExtraNatIpsNeeded,omitempty"`
+
+	// NumVMEndpointsWithNATMappings: The number of VM endpoints that use
+	// the NAT.
+	NumVMEndpointsWithNATMappings int64 `json:"numVmEndpointsWithNatMappings,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// A RouterNATSpec defines the desired state of a RouterNAT.
+type RouterNATSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RouterNATParameters `json:"forProvider"`
+}
+
+// A RouterNATStatus represents the observed state of a RouterNAT.
+type RouterNATStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RouterNATObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RouterNAT is a managed resource that represents a Cloud NAT gateway
+// configured on a Cloud Router. The external name of the resource is the name
+// of the NAT. The NATs of a router that are managed by RouterNATs must not
+// also be listed in the nats of the Router resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ROUTER",type="string",JSONPath=".spec.forProvider.router"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type RouterNAT struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouterNATSpec   `json:"spec"`
+	Status RouterNATStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouterNATList contains a list of RouterNAT.
+type RouterNATList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RouterNAT `json:"items"`
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// BGP session statuses of a RouterPeer.
+const (
+	RouterPeerStatusUp   = "UP"
+	RouterPeerStatusDown = "DOWN"
+)
+
+// RouterPeerParameters define the desired state of a BGP peer of a Cloud
+// Router, and optionally of the router interface the peer uses.
+// https://cloud.google.com/compute/docs/reference/rest/v1/routers
+type RouterPeerParameters struct {
+	// Region: The region of the router.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region"`
+
+	// Router: The name of the Cloud Router the BGP peer is configured on.
+	// +optional
+	// +immutable
+	Router *string `json:"router,omitempty"`
+
+	// RouterRef references a Router and retrieves its name.
+	// +optional
+	// +immutable
+	RouterRef *xpv1.Reference `json:"routerRef,omitempty"`
+
+	// RouterSelector selects a reference to a Router.
+	// +optional
+	// +immutable
+	RouterSelector *xpv1.Selector `json:"routerSelector,omitempty"`
+
+	// InterfaceName: The name of the router interface the BGP peer is
+	// associated with.
+	InterfaceName string `json:"interfaceName"`
+
+	// Interface: The router interface the BGP peer is associated with. If
+	// set, the interface named interfaceName is created, updated and
+	// deleted together with the BGP peer. Leave it unset if the interface
+	// is managed otherwise, e.g. as one of the interfaces of the Router.
+	// +optional
+	Interface *RouterPeerInterface `json:"interface,omitempty"`
+
+	// PeerASN: The BGP Autonomous System Number (ASN) of the peer.
+	PeerASN int64 `json:"peerAsn"`
+
+	// PeerIPAddress: The IP address of the peer, outside of Google Cloud.
+	// +optional
+	PeerIPAddress *string `json:"peerIpAddress,omitempty"`
+
+	// IPAddress: The IP address of the router interface inside of Google
+	// Cloud. Defaults to the address in the IP range of the interface.
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// AdvertiseMode: Whether the routes advertised to the peer are the
+	// default routes of the router or custom ones, i.e. DEFAULT or CUSTOM.
+	// +optional
+	// +kubebuilder:validation:Enum=CUSTOM;DEFAULT
+	AdvertiseMode *string `json:"advertiseMode,omitempty"`
+
+	// AdvertisedGroups: The prefix groups advertised to the peer in custom
+	// mode, overriding those of the router.
+	// +optional
+	AdvertisedGroups []string `json:"advertisedGroups,omitempty"`
+
+	// AdvertisedIPRanges: The IP ranges advertised to the peer in custom
+	// mode, overriding those of the router.
+	// +optional
+	AdvertisedIPRanges []RouterAdvertisedIpRange `json:"advertisedIpRanges,omitempty"`
+
+	// AdvertisedRoutePriority: The priority of the routes advertised to the
+	// peer. Routes with lower priority values win.
+	// +optional
+	AdvertisedRoutePriority *int64 `json:"advertisedRoutePriority,omitempty"`
+
+	// Enable: Whether the BGP session with the peer is established.
+	// Defaults to true.
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// EnableIPv6: Whether IPv6 routes are exchanged with the peer.
+	// +optional
+	EnableIPv6 *bool `json:"enableIpv6,omitempty"`
+}
+
+// A RouterPeerInterface is the router interface a BGP peer is associated
+// with. It must be linked to a VPN tunnel or an Interconnect attachment.
+type RouterPeerInterface struct {
+	// IPRange: The link-local IP address and range of the interface, e.g.
+	// 169.254.0.1/30.
+	// +optional
+	IPRange *string `json:"ipRange,omitempty"`
+
+	// LinkedVPNTunnel: The URL of the VPN tunnel the interface is linked
+	// to. It must be in the region of the router.
+	// +optional
+	LinkedVPNTunnel *string `json:"linkedVpnTunnel,omitempty"`
+
+	// LinkedInterconnectAttachment: The URL of the Interconnect attachment
+	// the interface is linked to. It must be in the region of the router.
+	// +optional
+	LinkedInterconnectAttachment *string `json:"linkedInterconnectAttachment,omitempty"`
+}
+
+// A RouterPeerObservation reflects the observed state of a RouterPeer.
+type RouterPeerObservation struct {
+	// Status: The status of the BGP session, i.e. UP, DOWN or UNKNOWN.
+	Status string `json:"status,omitempty"`
+
+	// StatusReason: Why the BGP session is down, if it is.
+	StatusReason string `json:"statusReason,omitempty"`
+
+	// State: The BGP state of the session, e.g. Established.
+	State string `json:"state,omitempty"`
+
+	// Uptime: How long the BGP session has been up, e.g. 1 hours 2 minutes.
+	Uptime string `json:"uptime,omitempty"`
+
+	// NumLearnedRoutes: The number of routes learned from the peer.
+	NumLearnedRoutes int64 `json:"numLearnedRoutes,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// A RouterPeerSpec defines the desired state of a RouterPeer.
+type RouterPeerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RouterPeerParameters `json:"forProvider"`
+}
+
+// A RouterPeerStatus represents the observed state of a RouterPeer.
+type RouterPeerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RouterPeerObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RouterPeer is a managed resource that represents a BGP peer of a Cloud
+// Router, used to exchange routes over a VPN tunnel or an Interconnect
+// attachment. The external name of the resource is the name of the peer. The
+// peers and interfaces of a router that are managed by RouterPeers must not
+// also be listed in the bgpPeers and interfaces of the Router resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ROUTER",type="string",JSONPath=".spec.forProvider.router"
+// +kubebuilder:printcolumn:name="BGP",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type RouterPeer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouterPeerSpec   `json:"spec"`
+	Status RouterPeerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouterPeerList contains a list of RouterPeer.
+type RouterPeerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RouterPeer `json:"items"`
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Snapshot statuses.
+const (
+	SnapshotStatusCreating  = "CREATING"
+	SnapshotStatusUploading = "UPLOADING"
+	SnapshotStatusReady     = "READY"
+	SnapshotStatusFailed    = "FAILED"
+	SnapshotStatusDeleting  = "DELETING"
+)
+
+// SnapshotParameters define the desired state of a Google Compute Engine
+// snapshot of a persistent disk. Only the labels of a snapshot can be changed
+// once it was created.
+// https://cloud.google.com/compute/docs/reference/rest/v1/snapshots
+type SnapshotParameters struct {
+	// SourceDisk: The URL of the disk the snapshot is created from, e.g.
+	// projects/my-project/zones/us-central1-a/disks/my-disk.
+	// +optional
+	// +immutable
+	SourceDisk *string `json:"sourceDisk,omitempty"`
+
+	// SourceDiskRef references a Disk to retrieve its URL.
+	// +optional
+	// +immutable
+	SourceDiskRef *xpv1.Reference `json:"sourceDiskRef,omitempty"`
+
+	// SourceDiskSelector selects a reference to a Disk to retrieve its URL.
+	// +optional
+	// +immutable
+	SourceDiskSelector *xpv1.Selector `json:"sourceDiskSelector,omitempty"`
+
+	// Description: An optional description of the snapshot.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// SnapshotType: The type of the snapshot, i.e. STANDARD or ARCHIVE.
+	// Defaults to STANDARD.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=STANDARD;ARCHIVE
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="snapshotType is immutable"
+	SnapshotType *string `json:"snapshotType,omitempty"`
+
+	// StorageLocations: The Cloud Storage multi-region or region the
+	// snapshot is stored in, e.g. us. Defaults to the multi-region closest
+	// to the source disk. At most one location is supported.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:MaxItems=1
+	StorageLocations []string `json:"storageLocations,omitempty"`
+
+	// Labels: The labels applied to the snapshot.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// SnapshotObservation is used to show the observed state of the Snapshot.
+type SnapshotObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the snapshot, i.e. CREATING, UPLOADING, READY,
+	// FAILED or DELETING.
+	Status string `json:"status,omitempty"`
+
+	// SourceDiskID: The ID of the disk the snapshot was created from.
+	SourceDiskID string `json:"sourceDiskId,omitempty"`
+
+	// DiskSizeGB: The size of the source disk in GB.
+	DiskSizeGB int64 `json:"diskSizeGb,omitempty"`
+
+	// StorageBytes: The size of the snapshot in bytes.
+	StorageBytes int64 `json:"storageBytes,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// SnapshotSpec defines the desired state of a Snapshot.
+type SnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnapshotParameters `json:"forProvider"`
+}
+
+// SnapshotStatus represents the observed state of a Snapshot.
+type SnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Snapshot is a managed resource that represents a Google Compute Engine
+// snapshot of a persistent disk. The external name of the resource is the
+// name of the snapshot.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshot types
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
@@ -22,103 +22,183 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Firewall) DeepCopyInto(out *Firewall) {
+func (in *AccessConfig) DeepCopyInto(out *AccessConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NatIP != nil {
+		in, out := &in.NatIP, &out.NatIP
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Firewall.
-func (in *Firewall) DeepCopy() *Firewall {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessConfig.
+func (in *AccessConfig) DeepCopy() *AccessConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Firewall)
+	out := new(AccessConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Firewall) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessConfigObservation) DeepCopyInto(out *AccessConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessConfigObservation.
+func (in *AccessConfigObservation) DeepCopy() *AccessConfigObservation {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(AccessConfigObservation)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallAllowed) DeepCopyInto(out *FirewallAllowed) {
+func (in *AliasIPRange) DeepCopyInto(out *AliasIPRange) {
 	*out = *in
-	if in.Ports != nil {
-		in, out := &in.Ports, &out.Ports
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasIPRange.
+func (in *AliasIPRange) DeepCopy() *AliasIPRange {
+	if in == nil {
+		return nil
 	}
+	out := new(AliasIPRange)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallAllowed.
-func (in *FirewallAllowed) DeepCopy() *FirewallAllowed {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttachedDisk) DeepCopyInto(out *AttachedDisk) {
+	*out = *in
+	if in.Boot != nil {
+		in, out := &in.Boot, &out.Boot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoDelete != nil {
+		in, out := &in.AutoDelete, &out.AutoDelete
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeviceName != nil {
+		in, out := &in.DeviceName, &out.DeviceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiskSizeGb != nil {
+		in, out := &in.DiskSizeGb, &out.DiskSizeGb
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DiskType != nil {
+		in, out := &in.DiskType, &out.DiskType
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceImage != nil {
+		in, out := &in.SourceImage, &out.SourceImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceImageFamily != nil {
+		in, out := &in.SourceImageFamily, &out.SourceImageFamily
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceImageFamilyRef != nil {
+		in, out := &in.SourceImageFamilyRef, &out.SourceImageFamilyRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceImageFamilySelector != nil {
+		in, out := &in.SourceImageFamilySelector, &out.SourceImageFamilySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachedDisk.
+func (in *AttachedDisk) DeepCopy() *AttachedDisk {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallAllowed)
+	out := new(AttachedDisk)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallDenied) DeepCopyInto(out *FirewallDenied) {
+func (in *AttachedDiskObservation) DeepCopyInto(out *AttachedDiskObservation) {
 	*out = *in
-	if in.Ports != nil {
-		in, out := &in.Ports, &out.Ports
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachedDiskObservation.
+func (in *AttachedDiskObservation) DeepCopy() *AttachedDiskObservation {
+	if in == nil {
+		return nil
 	}
+	out := new(AttachedDiskObservation)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallDenied.
-func (in *FirewallDenied) DeepCopy() *FirewallDenied {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoHealingPolicy) DeepCopyInto(out *AutoHealingPolicy) {
+	*out = *in
+	if in.InitialDelaySec != nil {
+		in, out := &in.InitialDelaySec, &out.InitialDelaySec
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoHealingPolicy.
+func (in *AutoHealingPolicy) DeepCopy() *AutoHealingPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallDenied)
+	out := new(AutoHealingPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallList) DeepCopyInto(out *FirewallList) {
+func (in *Autoscaler) DeepCopyInto(out *Autoscaler) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Firewall, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallList.
-func (in *FirewallList) DeepCopy() *FirewallList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Autoscaler.
+func (in *Autoscaler) DeepCopy() *Autoscaler {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallList)
+	out := new(Autoscaler)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *FirewallList) DeepCopyObject() runtime.Object {
+func (in *Autoscaler) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -126,636 +206,5174 @@ func (in *FirewallList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallLogConfig) DeepCopyInto(out *FirewallLogConfig) {
+func (in *AutoscalerList) DeepCopyInto(out *AutoscalerList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Autoscaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallLogConfig.
-func (in *FirewallLogConfig) DeepCopy() *FirewallLogConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerList.
+func (in *AutoscalerList) DeepCopy() *AutoscalerList {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallLogConfig)
+	out := new(AutoscalerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoscalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallObservation) DeepCopyInto(out *FirewallObservation) {
+func (in *AutoscalerObservation) DeepCopyInto(out *AutoscalerObservation) {
 	*out = *in
+	if in.StatusDetails != nil {
+		in, out := &in.StatusDetails, &out.StatusDetails
+		*out = make([]AutoscalerStatusDetails, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallObservation.
-func (in *FirewallObservation) DeepCopy() *FirewallObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerObservation.
+func (in *AutoscalerObservation) DeepCopy() *AutoscalerObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallObservation)
+	out := new(AutoscalerObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallParameters) DeepCopyInto(out *FirewallParameters) {
+func (in *AutoscalerParameters) DeepCopyInto(out *AutoscalerParameters) {
 	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
 		*out = new(string)
 		**out = **in
 	}
-	if in.NetworkRef != nil {
-		in, out := &in.NetworkRef, &out.NetworkRef
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NetworkSelector != nil {
-		in, out := &in.NetworkSelector, &out.NetworkSelector
+	if in.TargetSelector != nil {
+		in, out := &in.TargetSelector, &out.TargetSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Priority != nil {
-		in, out := &in.Priority, &out.Priority
-		*out = new(int64)
-		**out = **in
-	}
-	if in.SourceRanges != nil {
-		in, out := &in.SourceRanges, &out.SourceRanges
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.DestinationRanges != nil {
-		in, out := &in.DestinationRanges, &out.DestinationRanges
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SourceTags != nil {
-		in, out := &in.SourceTags, &out.SourceTags
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.TargetTags != nil {
-		in, out := &in.TargetTags, &out.TargetTags
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SourceServiceAccounts != nil {
-		in, out := &in.SourceServiceAccounts, &out.SourceServiceAccounts
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.TargetServiceAccounts != nil {
-		in, out := &in.TargetServiceAccounts, &out.TargetServiceAccounts
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Allowed != nil {
-		in, out := &in.Allowed, &out.Allowed
-		*out = make([]*FirewallAllowed, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(FirewallAllowed)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Denied != nil {
-		in, out := &in.Denied, &out.Denied
-		*out = make([]*FirewallDenied, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(FirewallDenied)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Direction != nil {
-		in, out := &in.Direction, &out.Direction
-		*out = new(string)
-		**out = **in
-	}
-	if in.Disabled != nil {
-		in, out := &in.Disabled, &out.Disabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.LogConfig != nil {
-		in, out := &in.LogConfig, &out.LogConfig
-		*out = new(FirewallLogConfig)
-		**out = **in
-	}
+	in.AutoscalingPolicy.DeepCopyInto(&out.AutoscalingPolicy)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallParameters.
-func (in *FirewallParameters) DeepCopy() *FirewallParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerParameters.
+func (in *AutoscalerParameters) DeepCopy() *AutoscalerParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallParameters)
+	out := new(AutoscalerParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallSpec) DeepCopyInto(out *FirewallSpec) {
+func (in *AutoscalerSpec) DeepCopyInto(out *AutoscalerSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallSpec.
-func (in *FirewallSpec) DeepCopy() *FirewallSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerSpec.
+func (in *AutoscalerSpec) DeepCopy() *AutoscalerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallSpec)
+	out := new(AutoscalerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FirewallStatus) DeepCopyInto(out *FirewallStatus) {
+func (in *AutoscalerStatus) DeepCopyInto(out *AutoscalerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallStatus.
-func (in *FirewallStatus) DeepCopy() *FirewallStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerStatus.
+func (in *AutoscalerStatus) DeepCopy() *AutoscalerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FirewallStatus)
+	out := new(AutoscalerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Router) DeepCopyInto(out *Router) {
+func (in *AutoscalerStatusDetails) DeepCopyInto(out *AutoscalerStatusDetails) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Router.
-func (in *Router) DeepCopy() *Router {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerStatusDetails.
+func (in *AutoscalerStatusDetails) DeepCopy() *AutoscalerStatusDetails {
 	if in == nil {
 		return nil
 	}
-	out := new(Router)
+	out := new(AutoscalerStatusDetails)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Router) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterAdvertisedIpRange) DeepCopyInto(out *RouterAdvertisedIpRange) {
+func (in *AutoscalingPolicy) DeepCopyInto(out *AutoscalingPolicy) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
+	if in.MinNumReplicas != nil {
+		in, out := &in.MinNumReplicas, &out.MinNumReplicas
+		*out = new(int64)
 		**out = **in
 	}
-}
+	if in.CoolDownPeriodSec != nil {
+		in, out := &in.CoolDownPeriodSec, &out.CoolDownPeriodSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(AutoscalingPolicyCPUUtilization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancingUtilization != nil {
+		in, out := &in.LoadBalancingUtilization, &out.LoadBalancingUtilization
+		*out = new(AutoscalingPolicyLoadBalancingUtilization)
+		**out = **in
+	}
+	if in.CustomMetricUtilizations != nil {
+		in, out := &in.CustomMetricUtilizations, &out.CustomMetricUtilizations
+		*out = make([]AutoscalingPolicyCustomMetricUtilization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScalingSchedules != nil {
+		in, out := &in.ScalingSchedules, &out.ScalingSchedules
+		*out = make(map[string]AutoscalingPolicyScalingSchedule, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterAdvertisedIpRange.
-func (in *RouterAdvertisedIpRange) DeepCopy() *RouterAdvertisedIpRange {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicy.
+func (in *AutoscalingPolicy) DeepCopy() *AutoscalingPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterAdvertisedIpRange)
+	out := new(AutoscalingPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterBgp) DeepCopyInto(out *RouterBgp) {
+func (in *AutoscalingPolicyCPUUtilization) DeepCopyInto(out *AutoscalingPolicyCPUUtilization) {
 	*out = *in
-	if in.AdvertiseMode != nil {
-		in, out := &in.AdvertiseMode, &out.AdvertiseMode
+	if in.PredictiveMethod != nil {
+		in, out := &in.PredictiveMethod, &out.PredictiveMethod
 		*out = new(string)
 		**out = **in
 	}
-	if in.AdvertisedGroups != nil {
-		in, out := &in.AdvertisedGroups, &out.AdvertisedGroups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AdvertisedIpRanges != nil {
-		in, out := &in.AdvertisedIpRanges, &out.AdvertisedIpRanges
-		*out = make([]*RouterAdvertisedIpRange, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterAdvertisedIpRange)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Asn != nil {
-		in, out := &in.Asn, &out.Asn
-		*out = new(int64)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterBgp.
-func (in *RouterBgp) DeepCopy() *RouterBgp {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyCPUUtilization.
+func (in *AutoscalingPolicyCPUUtilization) DeepCopy() *AutoscalingPolicyCPUUtilization {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterBgp)
+	out := new(AutoscalingPolicyCPUUtilization)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterBgpPeer) DeepCopyInto(out *RouterBgpPeer) {
+func (in *AutoscalingPolicyCustomMetricUtilization) DeepCopyInto(out *AutoscalingPolicyCustomMetricUtilization) {
 	*out = *in
-	if in.AdvertiseMode != nil {
-		in, out := &in.AdvertiseMode, &out.AdvertiseMode
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
 		*out = new(string)
 		**out = **in
 	}
-	if in.AdvertisedGroups != nil {
-		in, out := &in.AdvertisedGroups, &out.AdvertisedGroups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AdvertisedIpRanges != nil {
-		in, out := &in.AdvertisedIpRanges, &out.AdvertisedIpRanges
-		*out = make([]*RouterAdvertisedIpRange, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterAdvertisedIpRange)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.AdvertisedRoutePriority != nil {
-		in, out := &in.AdvertisedRoutePriority, &out.AdvertisedRoutePriority
-		*out = new(int64)
-		**out = **in
-	}
-	if in.InterfaceName != nil {
-		in, out := &in.InterfaceName, &out.InterfaceName
+	if in.SingleInstanceAssignment != nil {
+		in, out := &in.SingleInstanceAssignment, &out.SingleInstanceAssignment
 		*out = new(string)
 		**out = **in
 	}
-	if in.IpAddress != nil {
-		in, out := &in.IpAddress, &out.IpAddress
+	if in.UtilizationTarget != nil {
+		in, out := &in.UtilizationTarget, &out.UtilizationTarget
 		*out = new(string)
 		**out = **in
 	}
-	if in.PeerIpAddress != nil {
-		in, out := &in.PeerIpAddress, &out.PeerIpAddress
+	if in.UtilizationTargetType != nil {
+		in, out := &in.UtilizationTargetType, &out.UtilizationTargetType
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterBgpPeer.
-func (in *RouterBgpPeer) DeepCopy() *RouterBgpPeer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyCustomMetricUtilization.
+func (in *AutoscalingPolicyCustomMetricUtilization) DeepCopy() *AutoscalingPolicyCustomMetricUtilization {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterBgpPeer)
+	out := new(AutoscalingPolicyCustomMetricUtilization)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterInterface) DeepCopyInto(out *RouterInterface) {
+func (in *AutoscalingPolicyLoadBalancingUtilization) DeepCopyInto(out *AutoscalingPolicyLoadBalancingUtilization) {
 	*out = *in
-	if in.IpRange != nil {
-		in, out := &in.IpRange, &out.IpRange
-		*out = new(string)
-		**out = **in
-	}
-	if in.LinkedInterconnectAttachment != nil {
-		in, out := &in.LinkedInterconnectAttachment, &out.LinkedInterconnectAttachment
-		*out = new(string)
-		**out = **in
-	}
-	if in.LinkedVpnTunnel != nil {
-		in, out := &in.LinkedVpnTunnel, &out.LinkedVpnTunnel
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterInterface.
-func (in *RouterInterface) DeepCopy() *RouterInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyLoadBalancingUtilization.
+func (in *AutoscalingPolicyLoadBalancingUtilization) DeepCopy() *AutoscalingPolicyLoadBalancingUtilization {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterInterface)
+	out := new(AutoscalingPolicyLoadBalancingUtilization)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterList) DeepCopyInto(out *RouterList) {
+func (in *AutoscalingPolicyScalingSchedule) DeepCopyInto(out *AutoscalingPolicyScalingSchedule) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Router, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterList.
-func (in *RouterList) DeepCopy() *RouterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyScalingSchedule.
+func (in *AutoscalingPolicyScalingSchedule) DeepCopy() *AutoscalingPolicyScalingSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterList)
+	out := new(AutoscalingPolicyScalingSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouterList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterNat) DeepCopyInto(out *RouterNat) {
+func (in *Backend) DeepCopyInto(out *Backend) {
 	*out = *in
-	if in.DrainNatIps != nil {
-		in, out := &in.DrainNatIps, &out.DrainNatIps
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.EnableEndpointIndependentMapping != nil {
-		in, out := &in.EnableEndpointIndependentMapping, &out.EnableEndpointIndependentMapping
-		*out = new(bool)
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
 		**out = **in
 	}
-	if in.IcmpIdleTimeoutSec != nil {
-		in, out := &in.IcmpIdleTimeoutSec, &out.IcmpIdleTimeoutSec
-		*out = new(int64)
-		**out = **in
+	if in.InstanceGroupManagerRef != nil {
+		in, out := &in.InstanceGroupManagerRef, &out.InstanceGroupManagerRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.LogConfig != nil {
-		in, out := &in.LogConfig, &out.LogConfig
-		*out = new(RouterNatLogConfig)
+	if in.InstanceGroupManagerSelector != nil {
+		in, out := &in.InstanceGroupManagerSelector, &out.InstanceGroupManagerSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.MinPortsPerVm != nil {
-		in, out := &in.MinPortsPerVm, &out.MinPortsPerVm
-		*out = new(int64)
+	if in.NetworkEndpointGroupRef != nil {
+		in, out := &in.NetworkEndpointGroupRef, &out.NetworkEndpointGroupRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkEndpointGroupSelector != nil {
+		in, out := &in.NetworkEndpointGroupSelector, &out.NetworkEndpointGroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.BalancingMode != nil {
+		in, out := &in.BalancingMode, &out.BalancingMode
 		*out = new(string)
 		**out = **in
 	}
-	if in.NatIps != nil {
-		in, out := &in.NatIps, &out.NatIps
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.CapacityScaler != nil {
+		in, out := &in.CapacityScaler, &out.CapacityScaler
+		*out = new(string)
+		**out = **in
 	}
-	if in.Subnetworks != nil {
-		in, out := &in.Subnetworks, &out.Subnetworks
-		*out = make([]*RouterNatSubnetworkToNat, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterNatSubnetworkToNat)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.MaxUtilization != nil {
+		in, out := &in.MaxUtilization, &out.MaxUtilization
+		*out = new(string)
+		**out = **in
 	}
-	if in.TcpEstablishedIdleTimeoutSec != nil {
-		in, out := &in.TcpEstablishedIdleTimeoutSec, &out.TcpEstablishedIdleTimeoutSec
-		*out = new(int64)
+	if in.MaxRatePerInstance != nil {
+		in, out := &in.MaxRatePerInstance, &out.MaxRatePerInstance
+		*out = new(string)
 		**out = **in
 	}
-	if in.TcpTransitoryIdleTimeoutSec != nil {
-		in, out := &in.TcpTransitoryIdleTimeoutSec, &out.TcpTransitoryIdleTimeoutSec
+	if in.MaxRatePerEndpoint != nil {
+		in, out := &in.MaxRatePerEndpoint, &out.MaxRatePerEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxConnectionsPerInstance != nil {
+		in, out := &in.MaxConnectionsPerInstance, &out.MaxConnectionsPerInstance
 		*out = new(int64)
 		**out = **in
 	}
-	if in.UdpIdleTimeoutSec != nil {
-		in, out := &in.UdpIdleTimeoutSec, &out.UdpIdleTimeoutSec
+	if in.MaxConnectionsPerEndpoint != nil {
+		in, out := &in.MaxConnectionsPerEndpoint, &out.MaxConnectionsPerEndpoint
 		*out = new(int64)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNat.
-func (in *RouterNat) DeepCopy() *RouterNat {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backend.
+func (in *Backend) DeepCopy() *Backend {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterNat)
+	out := new(Backend)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterNatLogConfig) DeepCopyInto(out *RouterNatLogConfig) {
+func (in *BackendService) DeepCopyInto(out *BackendService) {
 	*out = *in
-	if in.Enable != nil {
-		in, out := &in.Enable, &out.Enable
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Filter != nil {
-		in, out := &in.Filter, &out.Filter
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNatLogConfig.
-func (in *RouterNatLogConfig) DeepCopy() *RouterNatLogConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendService.
+func (in *BackendService) DeepCopy() *BackendService {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterNatLogConfig)
+	out := new(BackendService)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackendService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterNatSubnetworkToNat) DeepCopyInto(out *RouterNatSubnetworkToNat) {
+func (in *BackendServiceIAP) DeepCopyInto(out *BackendServiceIAP) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.SecondaryIpRangeNames != nil {
-		in, out := &in.SecondaryIpRangeNames, &out.SecondaryIpRangeNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SourceIpRangesToNat != nil {
-		in, out := &in.SourceIpRangesToNat, &out.SourceIpRangesToNat
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNatSubnetworkToNat.
-func (in *RouterNatSubnetworkToNat) DeepCopy() *RouterNatSubnetworkToNat {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendServiceIAP.
+func (in *BackendServiceIAP) DeepCopy() *BackendServiceIAP {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterNatSubnetworkToNat)
+	out := new(BackendServiceIAP)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterObservation) DeepCopyInto(out *RouterObservation) {
+func (in *BackendServiceList) DeepCopyInto(out *BackendServiceList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackendService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterObservation.
-func (in *RouterObservation) DeepCopy() *RouterObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendServiceList.
+func (in *BackendServiceList) DeepCopy() *BackendServiceList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterObservation)
+	out := new(BackendServiceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterParameters) DeepCopyInto(out *RouterParameters) {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackendServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendServiceObservation) DeepCopyInto(out *BackendServiceObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendServiceObservation.
+func (in *BackendServiceObservation) DeepCopy() *BackendServiceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendServiceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendServiceParameters) DeepCopyInto(out *BackendServiceParameters) {
 	*out = *in
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
+	if in.LoadBalancingScheme != nil {
+		in, out := &in.LoadBalancingScheme, &out.LoadBalancingScheme
 		*out = new(string)
 		**out = **in
 	}
-	if in.NetworkRef != nil {
-		in, out := &in.NetworkRef, &out.NetworkRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
 	}
-	if in.NetworkSelector != nil {
-		in, out := &in.NetworkSelector, &out.NetworkSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+	if in.PortName != nil {
+		in, out := &in.PortName, &out.PortName
+		*out = new(string)
+		**out = **in
 	}
-	if in.Bgp != nil {
-		in, out := &in.Bgp, &out.Bgp
-		*out = new(RouterBgp)
-		(*in).DeepCopyInto(*out)
+	if in.TimeoutSec != nil {
+		in, out := &in.TimeoutSec, &out.TimeoutSec
+		*out = new(int64)
+		**out = **in
 	}
-	if in.BgpPeers != nil {
-		in, out := &in.BgpPeers, &out.BgpPeers
-		*out = make([]*RouterBgpPeer, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterBgpPeer)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.ConnectionDrainingTimeoutSec != nil {
+		in, out := &in.ConnectionDrainingTimeoutSec, &out.ConnectionDrainingTimeoutSec
+		*out = new(int64)
+		**out = **in
 	}
-	if in.EncryptedInterconnectRouter != nil {
-		in, out := &in.EncryptedInterconnectRouter, &out.EncryptedInterconnectRouter
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(string)
+		**out = **in
+	}
+	if in.AffinityCookieTTLSec != nil {
+		in, out := &in.AffinityCookieTTLSec, &out.AffinityCookieTTLSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EnableCDN != nil {
+		in, out := &in.EnableCDN, &out.EnableCDN
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Interfaces != nil {
-		in, out := &in.Interfaces, &out.Interfaces
-		*out = make([]*RouterInterface, len(*in))
+	if in.IAP != nil {
+		in, out := &in.IAP, &out.IAP
+		*out = new(BackendServiceIAP)
+		**out = **in
+	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthCheckRefs != nil {
+		in, out := &in.HealthCheckRefs, &out.HealthCheckRefs
+		*out = make([]v1.Reference, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterInterface)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Nats != nil {
-		in, out := &in.Nats, &out.Nats
-		*out = make([]*RouterNat, len(*in))
+	if in.HealthCheckSelector != nil {
+		in, out := &in.HealthCheckSelector, &out.HealthCheckSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]Backend, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouterNat)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterParameters.
-func (in *RouterParameters) DeepCopy() *RouterParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendServiceParameters.
+func (in *BackendServiceParameters) DeepCopy() *BackendServiceParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterParameters)
+	out := new(BackendServiceParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterSpec) DeepCopyInto(out *RouterSpec) {
+func (in *BackendServiceSpec) DeepCopyInto(out *BackendServiceSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterSpec.
-func (in *RouterSpec) DeepCopy() *RouterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendServiceSpec.
+func (in *BackendServiceSpec) DeepCopy() *BackendServiceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterSpec)
+	out := new(BackendServiceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouterStatus) DeepCopyInto(out *RouterStatus) {
+func (in *BackendServiceStatus) DeepCopyInto(out *BackendServiceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterStatus.
-func (in *RouterStatus) DeepCopy() *RouterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendServiceStatus.
+func (in *BackendServiceStatus) DeepCopy() *BackendServiceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RouterStatus)
+	out := new(BackendServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfidentialInstanceConfig) DeepCopyInto(out *ConfidentialInstanceConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfidentialInstanceConfig.
+func (in *ConfidentialInstanceConfig) DeepCopy() *ConfidentialInstanceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfidentialInstanceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DailyCycle) DeepCopyInto(out *DailyCycle) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DailyCycle.
+func (in *DailyCycle) DeepCopy() *DailyCycle {
+	if in == nil {
+		return nil
+	}
+	out := new(DailyCycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DayOfWeek) DeepCopyInto(out *DayOfWeek) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DayOfWeek.
+func (in *DayOfWeek) DeepCopy() *DayOfWeek {
+	if in == nil {
+		return nil
+	}
+	out := new(DayOfWeek)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Disk) DeepCopyInto(out *Disk) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Disk.
+func (in *Disk) DeepCopy() *Disk {
+	if in == nil {
+		return nil
+	}
+	out := new(Disk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Disk) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryptionKey) DeepCopyInto(out *DiskEncryptionKey) {
+	*out = *in
+	if in.RawKeySecretRef != nil {
+		in, out := &in.RawKeySecretRef, &out.RawKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.RSAEncryptedKeySecretRef != nil {
+		in, out := &in.RSAEncryptedKeySecretRef, &out.RSAEncryptedKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.KMSKeyName != nil {
+		in, out := &in.KMSKeyName, &out.KMSKeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyNameRef != nil {
+		in, out := &in.KMSKeyNameRef, &out.KMSKeyNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSKeyNameSelector != nil {
+		in, out := &in.KMSKeyNameSelector, &out.KMSKeyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSKeyServiceAccount != nil {
+		in, out := &in.KMSKeyServiceAccount, &out.KMSKeyServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryptionKey.
+func (in *DiskEncryptionKey) DeepCopy() *DiskEncryptionKey {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskEncryptionKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskList) DeepCopyInto(out *DiskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Disk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskList.
+func (in *DiskList) DeepCopy() *DiskList {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskObservation) DeepCopyInto(out *DiskObservation) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskObservation.
+func (in *DiskObservation) DeepCopy() *DiskObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskParameters) DeepCopyInto(out *DiskParameters) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicaZones != nil {
+		in, out := &in.ReplicaZones, &out.ReplicaZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.SizeGB != nil {
+		in, out := &in.SizeGB, &out.SizeGB
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SourceImage != nil {
+		in, out := &in.SourceImage, &out.SourceImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceSnapshot != nil {
+		in, out := &in.SourceSnapshot, &out.SourceSnapshot
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourcePolicies != nil {
+		in, out := &in.ResourcePolicies, &out.ResourcePolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourcePolicyRefs != nil {
+		in, out := &in.ResourcePolicyRefs, &out.ResourcePolicyRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourcePolicySelector != nil {
+		in, out := &in.ResourcePolicySelector, &out.ResourcePolicySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskParameters.
+func (in *DiskParameters) DeepCopy() *DiskParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSpec) DeepCopyInto(out *DiskSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSpec.
+func (in *DiskSpec) DeepCopy() *DiskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskStatus) DeepCopyInto(out *DiskStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskStatus.
+func (in *DiskStatus) DeepCopy() *DiskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Firewall) DeepCopyInto(out *Firewall) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Firewall.
+func (in *Firewall) DeepCopy() *Firewall {
+	if in == nil {
+		return nil
+	}
+	out := new(Firewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Firewall) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallAllowed) DeepCopyInto(out *FirewallAllowed) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallAllowed.
+func (in *FirewallAllowed) DeepCopy() *FirewallAllowed {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallAllowed)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallDenied) DeepCopyInto(out *FirewallDenied) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallDenied.
+func (in *FirewallDenied) DeepCopy() *FirewallDenied {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallDenied)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallList) DeepCopyInto(out *FirewallList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Firewall, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallList.
+func (in *FirewallList) DeepCopy() *FirewallList {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallLogConfig) DeepCopyInto(out *FirewallLogConfig) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallLogConfig.
+func (in *FirewallLogConfig) DeepCopy() *FirewallLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallObservation) DeepCopyInto(out *FirewallObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallObservation.
+func (in *FirewallObservation) DeepCopy() *FirewallObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallParameters) DeepCopyInto(out *FirewallParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SourceRanges != nil {
+		in, out := &in.SourceRanges, &out.SourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationRanges != nil {
+		in, out := &in.DestinationRanges, &out.DestinationRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceTags != nil {
+		in, out := &in.SourceTags, &out.SourceTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetTags != nil {
+		in, out := &in.TargetTags, &out.TargetTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceServiceAccounts != nil {
+		in, out := &in.SourceServiceAccounts, &out.SourceServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetServiceAccounts != nil {
+		in, out := &in.TargetServiceAccounts, &out.TargetServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Allowed != nil {
+		in, out := &in.Allowed, &out.Allowed
+		*out = make([]*FirewallAllowed, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(FirewallAllowed)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Denied != nil {
+		in, out := &in.Denied, &out.Denied
+		*out = make([]*FirewallDenied, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(FirewallDenied)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LogConfig != nil {
+		in, out := &in.LogConfig, &out.LogConfig
+		*out = new(FirewallLogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallParameters.
+func (in *FirewallParameters) DeepCopy() *FirewallParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallSpec) DeepCopyInto(out *FirewallSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallSpec.
+func (in *FirewallSpec) DeepCopy() *FirewallSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallStatus) DeepCopyInto(out *FirewallStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallStatus.
+func (in *FirewallStatus) DeepCopy() *FirewallStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedOrPercent) DeepCopyInto(out *FixedOrPercent) {
+	*out = *in
+	if in.Fixed != nil {
+		in, out := &in.Fixed, &out.Fixed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedOrPercent.
+func (in *FixedOrPercent) DeepCopy() *FixedOrPercent {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedOrPercent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCHealthCheck) DeepCopyInto(out *GRPCHealthCheck) {
+	*out = *in
+	in.HealthCheckPort.DeepCopyInto(&out.HealthCheckPort)
+	if in.GRPCServiceName != nil {
+		in, out := &in.GRPCServiceName, &out.GRPCServiceName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCHealthCheck.
+func (in *GRPCHealthCheck) DeepCopy() *GRPCHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHealthCheck) DeepCopyInto(out *HTTPHealthCheck) {
+	*out = *in
+	in.HealthCheckPort.DeepCopyInto(&out.HealthCheckPort)
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestPath != nil {
+		in, out := &in.RequestPath, &out.RequestPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyHeader != nil {
+		in, out := &in.ProxyHeader, &out.ProxyHeader
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHealthCheck.
+func (in *HTTPHealthCheck) DeepCopy() *HTTPHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HealthCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckList) DeepCopyInto(out *HealthCheckList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckList.
+func (in *HealthCheckList) DeepCopy() *HealthCheckList {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HealthCheckList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckObservation) DeepCopyInto(out *HealthCheckObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckObservation.
+func (in *HealthCheckObservation) DeepCopy() *HealthCheckObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckParameters) DeepCopyInto(out *HealthCheckParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.CheckIntervalSec != nil {
+		in, out := &in.CheckIntervalSec, &out.CheckIntervalSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TimeoutSec != nil {
+		in, out := &in.TimeoutSec, &out.TimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HealthyThreshold != nil {
+		in, out := &in.HealthyThreshold, &out.HealthyThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UnhealthyThreshold != nil {
+		in, out := &in.UnhealthyThreshold, &out.UnhealthyThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TCPHealthCheck != nil {
+		in, out := &in.TCPHealthCheck, &out.TCPHealthCheck
+		*out = new(TCPHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPHealthCheck != nil {
+		in, out := &in.HTTPHealthCheck, &out.HTTPHealthCheck
+		*out = new(HTTPHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPSHealthCheck != nil {
+		in, out := &in.HTTPSHealthCheck, &out.HTTPSHealthCheck
+		*out = new(HTTPHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPCHealthCheck != nil {
+		in, out := &in.GRPCHealthCheck, &out.GRPCHealthCheck
+		*out = new(GRPCHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckParameters.
+func (in *HealthCheckParameters) DeepCopy() *HealthCheckParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckPort) DeepCopyInto(out *HealthCheckPort) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PortName != nil {
+		in, out := &in.PortName, &out.PortName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PortSpecification != nil {
+		in, out := &in.PortSpecification, &out.PortSpecification
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckPort.
+func (in *HealthCheckPort) DeepCopy() *HealthCheckPort {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckSpec) DeepCopyInto(out *HealthCheckSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckSpec.
+func (in *HealthCheckSpec) DeepCopy() *HealthCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckStatus) DeepCopyInto(out *HealthCheckStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckStatus.
+func (in *HealthCheckStatus) DeepCopy() *HealthCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HourlyCycle) DeepCopyInto(out *HourlyCycle) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HourlyCycle.
+func (in *HourlyCycle) DeepCopy() *HourlyCycle {
+	if in == nil {
+		return nil
+	}
+	out := new(HourlyCycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6AccessConfig) DeepCopyInto(out *IPv6AccessConfig) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalIPv6 != nil {
+		in, out := &in.ExternalIPv6, &out.ExternalIPv6
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalIPv6PrefixLength != nil {
+		in, out := &in.ExternalIPv6PrefixLength, &out.ExternalIPv6PrefixLength
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6AccessConfig.
+func (in *IPv6AccessConfig) DeepCopy() *IPv6AccessConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6AccessConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImport) DeepCopyInto(out *ImageImport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImport.
+func (in *ImageImport) DeepCopy() *ImageImport {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageImport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImportList) DeepCopyInto(out *ImageImportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImportList.
+func (in *ImageImportList) DeepCopy() *ImageImportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageImportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImportObservation) DeepCopyInto(out *ImageImportObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImportObservation.
+func (in *ImageImportObservation) DeepCopy() *ImageImportObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImportObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImportParameters) DeepCopyInto(out *ImageImportParameters) {
+	*out = *in
+	if in.OS != nil {
+		in, out := &in.OS, &out.OS
+		*out = new(string)
+		**out = **in
+	}
+	if in.DataDisk != nil {
+		in, out := &in.DataDisk, &out.DataDisk
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Family != nil {
+		in, out := &in.Family, &out.Family
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImportParameters.
+func (in *ImageImportParameters) DeepCopy() *ImageImportParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImportParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImportSpec) DeepCopyInto(out *ImageImportSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImportSpec.
+func (in *ImageImportSpec) DeepCopy() *ImageImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageImportStatus) DeepCopyInto(out *ImageImportStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageImportStatus.
+func (in *ImageImportStatus) DeepCopy() *ImageImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
+func (in *Instance) DeepCopy() *Instance {
+	if in == nil {
+		return nil
+	}
+	out := new(Instance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Instance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceDisk) DeepCopyInto(out *InstanceDisk) {
+	*out = *in
+	in.AttachedDisk.DeepCopyInto(&out.AttachedDisk)
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceSnapshot != nil {
+		in, out := &in.SourceSnapshot, &out.SourceSnapshot
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiskEncryptionKey != nil {
+		in, out := &in.DiskEncryptionKey, &out.DiskEncryptionKey
+		*out = new(DiskEncryptionKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceImageEncryptionKey != nil {
+		in, out := &in.SourceImageEncryptionKey, &out.SourceImageEncryptionKey
+		*out = new(DiskEncryptionKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceSnapshotEncryptionKey != nil {
+		in, out := &in.SourceSnapshotEncryptionKey, &out.SourceSnapshotEncryptionKey
+		*out = new(DiskEncryptionKey)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceDisk.
+func (in *InstanceDisk) DeepCopy() *InstanceDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceDiskObservation) DeepCopyInto(out *InstanceDiskObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceDiskObservation.
+func (in *InstanceDiskObservation) DeepCopy() *InstanceDiskObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceDiskObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroupManager) DeepCopyInto(out *InstanceGroupManager) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroupManager.
+func (in *InstanceGroupManager) DeepCopy() *InstanceGroupManager {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroupManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceGroupManager) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroupManagerList) DeepCopyInto(out *InstanceGroupManagerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceGroupManager, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroupManagerList.
+func (in *InstanceGroupManagerList) DeepCopy() *InstanceGroupManagerList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroupManagerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceGroupManagerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroupManagerObservation) DeepCopyInto(out *InstanceGroupManagerObservation) {
+	*out = *in
+	if in.Stateful != nil {
+		in, out := &in.Stateful, &out.Stateful
+		*out = new(InstanceGroupManagerStatusStateful)
+		**out = **in
+	}
+	if in.PerInstanceConfigs != nil {
+		in, out := &in.PerInstanceConfigs, &out.PerInstanceConfigs
+		*out = make([]PerInstanceConfigObservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreemptedInstances != nil {
+		in, out := &in.PreemptedInstances, &out.PreemptedInstances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroupManagerObservation.
+func (in *InstanceGroupManagerObservation) DeepCopy() *InstanceGroupManagerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroupManagerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroupManagerParameters) DeepCopyInto(out *InstanceGroupManagerParameters) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceTemplateRef != nil {
+		in, out := &in.InstanceTemplateRef, &out.InstanceTemplateRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceTemplateSelector != nil {
+		in, out := &in.InstanceTemplateSelector, &out.InstanceTemplateSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetSize != nil {
+		in, out := &in.TargetSize, &out.TargetSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetPools != nil {
+		in, out := &in.TargetPools, &out.TargetPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamedPorts != nil {
+		in, out := &in.NamedPorts, &out.NamedPorts
+		*out = make([]NamedPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoHealingPolicies != nil {
+		in, out := &in.AutoHealingPolicies, &out.AutoHealingPolicies
+		*out = make([]AutoHealingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpdatePolicy != nil {
+		in, out := &in.UpdatePolicy, &out.UpdatePolicy
+		*out = new(UpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StatefulPolicy != nil {
+		in, out := &in.StatefulPolicy, &out.StatefulPolicy
+		*out = new(StatefulPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PerInstanceConfigs != nil {
+		in, out := &in.PerInstanceConfigs, &out.PerInstanceConfigs
+		*out = make([]PerInstanceConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SpotTerminationPolicy != nil {
+		in, out := &in.SpotTerminationPolicy, &out.SpotTerminationPolicy
+		*out = new(SpotTerminationPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroupManagerParameters.
+func (in *InstanceGroupManagerParameters) DeepCopy() *InstanceGroupManagerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroupManagerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroupManagerSpec) DeepCopyInto(out *InstanceGroupManagerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroupManagerSpec.
+func (in *InstanceGroupManagerSpec) DeepCopy() *InstanceGroupManagerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroupManagerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroupManagerStatus) DeepCopyInto(out *InstanceGroupManagerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroupManagerStatus.
+func (in *InstanceGroupManagerStatus) DeepCopy() *InstanceGroupManagerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroupManagerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroupManagerStatusStateful) DeepCopyInto(out *InstanceGroupManagerStatusStateful) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroupManagerStatusStateful.
+func (in *InstanceGroupManagerStatusStateful) DeepCopy() *InstanceGroupManagerStatusStateful {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroupManagerStatusStateful)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceList) DeepCopyInto(out *InstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Instance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceList.
+func (in *InstanceList) DeepCopy() *InstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceObservation) DeepCopyInto(out *InstanceObservation) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]InstanceDiskObservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]NetworkInterfaceObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceObservation.
+func (in *InstanceObservation) DeepCopy() *InstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]InstanceDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]InstanceServiceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(Scheduling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfidentialInstanceConfig != nil {
+		in, out := &in.ConfidentialInstanceConfig, &out.ConfidentialInstanceConfig
+		*out = new(ConfidentialInstanceConfig)
+		**out = **in
+	}
+	if in.ShieldedInstanceConfig != nil {
+		in, out := &in.ShieldedInstanceConfig, &out.ShieldedInstanceConfig
+		*out = new(ShieldedInstanceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceParameters.
+func (in *InstanceParameters) DeepCopy() *InstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceServiceAccount) DeepCopyInto(out *InstanceServiceAccount) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceServiceAccount.
+func (in *InstanceServiceAccount) DeepCopy() *InstanceServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
+func (in *InstanceSpec) DeepCopy() *InstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
+func (in *InstanceStatus) DeepCopy() *InstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplate) DeepCopyInto(out *InstanceTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplate.
+func (in *InstanceTemplate) DeepCopy() *InstanceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplateList) DeepCopyInto(out *InstanceTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplateList.
+func (in *InstanceTemplateList) DeepCopy() *InstanceTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplateObservation) DeepCopyInto(out *InstanceTemplateObservation) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]AttachedDiskObservation, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplateObservation.
+func (in *InstanceTemplateObservation) DeepCopy() *InstanceTemplateObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplateObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplateParameters) DeepCopyInto(out *InstanceTemplateParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]AttachedDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]InstanceServiceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(Scheduling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfidentialInstanceConfig != nil {
+		in, out := &in.ConfidentialInstanceConfig, &out.ConfidentialInstanceConfig
+		*out = new(ConfidentialInstanceConfig)
+		**out = **in
+	}
+	if in.ShieldedInstanceConfig != nil {
+		in, out := &in.ShieldedInstanceConfig, &out.ShieldedInstanceConfig
+		*out = new(ShieldedInstanceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplateParameters.
+func (in *InstanceTemplateParameters) DeepCopy() *InstanceTemplateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplateSpec) DeepCopyInto(out *InstanceTemplateSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplateSpec.
+func (in *InstanceTemplateSpec) DeepCopy() *InstanceTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceTemplateStatus) DeepCopyInto(out *InstanceTemplateStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceTemplateStatus.
+func (in *InstanceTemplateStatus) DeepCopy() *InstanceTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedPort) DeepCopyInto(out *NamedPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedPort.
+func (in *NamedPort) DeepCopy() *NamedPort {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpoint) DeepCopyInto(out *NetworkEndpoint) {
+	*out = *in
+	if in.Fqdn != nil {
+		in, out := &in.Fqdn, &out.Fqdn
+		*out = new(string)
+		**out = **in
+	}
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpoint.
+func (in *NetworkEndpoint) DeepCopy() *NetworkEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroup) DeepCopyInto(out *NetworkEndpointGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroup.
+func (in *NetworkEndpointGroup) DeepCopy() *NetworkEndpointGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkEndpointGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupAppEngine) DeepCopyInto(out *NetworkEndpointGroupAppEngine) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(string)
+		**out = **in
+	}
+	if in.URLMask != nil {
+		in, out := &in.URLMask, &out.URLMask
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupAppEngine.
+func (in *NetworkEndpointGroupAppEngine) DeepCopy() *NetworkEndpointGroupAppEngine {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupAppEngine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupCloudFunction) DeepCopyInto(out *NetworkEndpointGroupCloudFunction) {
+	*out = *in
+	if in.Function != nil {
+		in, out := &in.Function, &out.Function
+		*out = new(string)
+		**out = **in
+	}
+	if in.URLMask != nil {
+		in, out := &in.URLMask, &out.URLMask
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupCloudFunction.
+func (in *NetworkEndpointGroupCloudFunction) DeepCopy() *NetworkEndpointGroupCloudFunction {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupCloudFunction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupCloudRun) DeepCopyInto(out *NetworkEndpointGroupCloudRun) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(string)
+		**out = **in
+	}
+	if in.URLMask != nil {
+		in, out := &in.URLMask, &out.URLMask
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupCloudRun.
+func (in *NetworkEndpointGroupCloudRun) DeepCopy() *NetworkEndpointGroupCloudRun {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupCloudRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupList) DeepCopyInto(out *NetworkEndpointGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkEndpointGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupList.
+func (in *NetworkEndpointGroupList) DeepCopy() *NetworkEndpointGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkEndpointGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupObservation) DeepCopyInto(out *NetworkEndpointGroupObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupObservation.
+func (in *NetworkEndpointGroupObservation) DeepCopy() *NetworkEndpointGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupParameters) DeepCopyInto(out *NetworkEndpointGroupParameters) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultPort != nil {
+		in, out := &in.DefaultPort, &out.DefaultPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnetwork != nil {
+		in, out := &in.Subnetwork, &out.Subnetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetworkRef != nil {
+		in, out := &in.SubnetworkRef, &out.SubnetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetworkSelector != nil {
+		in, out := &in.SubnetworkSelector, &out.SubnetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppEngine != nil {
+		in, out := &in.AppEngine, &out.AppEngine
+		*out = new(NetworkEndpointGroupAppEngine)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudFunction != nil {
+		in, out := &in.CloudFunction, &out.CloudFunction
+		*out = new(NetworkEndpointGroupCloudFunction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudRun != nil {
+		in, out := &in.CloudRun, &out.CloudRun
+		*out = new(NetworkEndpointGroupCloudRun)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkEndpoints != nil {
+		in, out := &in.NetworkEndpoints, &out.NetworkEndpoints
+		*out = make([]NetworkEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupParameters.
+func (in *NetworkEndpointGroupParameters) DeepCopy() *NetworkEndpointGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupSpec) DeepCopyInto(out *NetworkEndpointGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupSpec.
+func (in *NetworkEndpointGroupSpec) DeepCopy() *NetworkEndpointGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupStatus) DeepCopyInto(out *NetworkEndpointGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupStatus.
+func (in *NetworkEndpointGroupStatus) DeepCopy() *NetworkEndpointGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+	*out = *in
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnetwork != nil {
+		in, out := &in.Subnetwork, &out.Subnetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetworkRef != nil {
+		in, out := &in.SubnetworkRef, &out.SubnetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetworkSelector != nil {
+		in, out := &in.SubnetworkSelector, &out.SubnetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessConfigs != nil {
+		in, out := &in.AccessConfigs, &out.AccessConfigs
+		*out = make([]AccessConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StackType != nil {
+		in, out := &in.StackType, &out.StackType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6AccessConfigs != nil {
+		in, out := &in.IPv6AccessConfigs, &out.IPv6AccessConfigs
+		*out = make([]IPv6AccessConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
+func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceObservation) DeepCopyInto(out *NetworkInterfaceObservation) {
+	*out = *in
+	if in.AliasIPRanges != nil {
+		in, out := &in.AliasIPRanges, &out.AliasIPRanges
+		*out = make([]AliasIPRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessConfigs != nil {
+		in, out := &in.AccessConfigs, &out.AccessConfigs
+		*out = make([]AccessConfigObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceObservation.
+func (in *NetworkInterfaceObservation) DeepCopy() *NetworkInterfaceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroring) DeepCopyInto(out *PacketMirroring) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroring.
+func (in *PacketMirroring) DeepCopy() *PacketMirroring {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketMirroring) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringFilter) DeepCopyInto(out *PacketMirroringFilter) {
+	*out = *in
+	if in.IPProtocols != nil {
+		in, out := &in.IPProtocols, &out.IPProtocols
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRRanges != nil {
+		in, out := &in.CIDRRanges, &out.CIDRRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringFilter.
+func (in *PacketMirroringFilter) DeepCopy() *PacketMirroringFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringList) DeepCopyInto(out *PacketMirroringList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PacketMirroring, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringList.
+func (in *PacketMirroringList) DeepCopy() *PacketMirroringList {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketMirroringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringMirroredResources) DeepCopyInto(out *PacketMirroringMirroredResources) {
+	*out = *in
+	if in.Subnetworks != nil {
+		in, out := &in.Subnetworks, &out.Subnetworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetworkRefs != nil {
+		in, out := &in.SubnetworkRefs, &out.SubnetworkRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SubnetworkSelector != nil {
+		in, out := &in.SubnetworkSelector, &out.SubnetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringMirroredResources.
+func (in *PacketMirroringMirroredResources) DeepCopy() *PacketMirroringMirroredResources {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringMirroredResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringObservation) DeepCopyInto(out *PacketMirroringObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringObservation.
+func (in *PacketMirroringObservation) DeepCopy() *PacketMirroringObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringParameters) DeepCopyInto(out *PacketMirroringParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CollectorILB != nil {
+		in, out := &in.CollectorILB, &out.CollectorILB
+		*out = new(string)
+		**out = **in
+	}
+	if in.IDSEndpointRef != nil {
+		in, out := &in.IDSEndpointRef, &out.IDSEndpointRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IDSEndpointSelector != nil {
+		in, out := &in.IDSEndpointSelector, &out.IDSEndpointSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.MirroredResources.DeepCopyInto(&out.MirroredResources)
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(PacketMirroringFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringParameters.
+func (in *PacketMirroringParameters) DeepCopy() *PacketMirroringParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringSpec) DeepCopyInto(out *PacketMirroringSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringSpec.
+func (in *PacketMirroringSpec) DeepCopy() *PacketMirroringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringStatus) DeepCopyInto(out *PacketMirroringStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringStatus.
+func (in *PacketMirroringStatus) DeepCopy() *PacketMirroringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PerInstanceConfig) DeepCopyInto(out *PerInstanceConfig) {
+	*out = *in
+	if in.PreservedState != nil {
+		in, out := &in.PreservedState, &out.PreservedState
+		*out = new(PreservedState)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PerInstanceConfig.
+func (in *PerInstanceConfig) DeepCopy() *PerInstanceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PerInstanceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PerInstanceConfigObservation) DeepCopyInto(out *PerInstanceConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PerInstanceConfigObservation.
+func (in *PerInstanceConfigObservation) DeepCopy() *PerInstanceConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PerInstanceConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBasedRoute) DeepCopyInto(out *PolicyBasedRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBasedRoute.
+func (in *PolicyBasedRoute) DeepCopy() *PolicyBasedRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBasedRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyBasedRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBasedRouteFilter) DeepCopyInto(out *PolicyBasedRouteFilter) {
+	*out = *in
+	if in.IPProtocol != nil {
+		in, out := &in.IPProtocol, &out.IPProtocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.SrcRange != nil {
+		in, out := &in.SrcRange, &out.SrcRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestRange != nil {
+		in, out := &in.DestRange, &out.DestRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProtocolVersion != nil {
+		in, out := &in.ProtocolVersion, &out.ProtocolVersion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBasedRouteFilter.
+func (in *PolicyBasedRouteFilter) DeepCopy() *PolicyBasedRouteFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBasedRouteFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBasedRouteInterconnectAttachment) DeepCopyInto(out *PolicyBasedRouteInterconnectAttachment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBasedRouteInterconnectAttachment.
+func (in *PolicyBasedRouteInterconnectAttachment) DeepCopy() *PolicyBasedRouteInterconnectAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBasedRouteInterconnectAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBasedRouteList) DeepCopyInto(out *PolicyBasedRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PolicyBasedRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBasedRouteList.
+func (in *PolicyBasedRouteList) DeepCopy() *PolicyBasedRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBasedRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyBasedRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBasedRouteObservation) DeepCopyInto(out *PolicyBasedRouteObservation) {
+	*out = *in
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBasedRouteObservation.
+func (in *PolicyBasedRouteObservation) DeepCopy() *PolicyBasedRouteObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBasedRouteObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBasedRouteParameters) DeepCopyInto(out *PolicyBasedRouteParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	in.Filter.DeepCopyInto(&out.Filter)
+	if in.VirtualMachine != nil {
+		in, out := &in.VirtualMachine, &out.VirtualMachine
+		*out = new(PolicyBasedRouteVirtualMachine)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InterconnectAttachment != nil {
+		in, out := &in.InterconnectAttachment, &out.InterconnectAttachment
+		*out = new(PolicyBasedRouteInterconnectAttachment)
+		**out = **in
+	}
+	if in.NextHopILBIP != nil {
+		in, out := &in.NextHopILBIP, &out.NextHopILBIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.NextHopOtherRoutes != nil {
+		in, out := &in.NextHopOtherRoutes, &out.NextHopOtherRoutes
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBasedRouteParameters.
+func (in *PolicyBasedRouteParameters) DeepCopy() *PolicyBasedRouteParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBasedRouteParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBasedRouteSpec) DeepCopyInto(out *PolicyBasedRouteSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBasedRouteSpec.
+func (in *PolicyBasedRouteSpec) DeepCopy() *PolicyBasedRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBasedRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBasedRouteStatus) DeepCopyInto(out *PolicyBasedRouteStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBasedRouteStatus.
+func (in *PolicyBasedRouteStatus) DeepCopy() *PolicyBasedRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBasedRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBasedRouteVirtualMachine) DeepCopyInto(out *PolicyBasedRouteVirtualMachine) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBasedRouteVirtualMachine.
+func (in *PolicyBasedRouteVirtualMachine) DeepCopy() *PolicyBasedRouteVirtualMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBasedRouteVirtualMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreservedState) DeepCopyInto(out *PreservedState) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make(map[string]PreservedStatePreservedDisk, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreservedState.
+func (in *PreservedState) DeepCopy() *PreservedState {
+	if in == nil {
+		return nil
+	}
+	out := new(PreservedState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreservedStatePreservedDisk) DeepCopyInto(out *PreservedStatePreservedDisk) {
+	*out = *in
+	if in.AutoDelete != nil {
+		in, out := &in.AutoDelete, &out.AutoDelete
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreservedStatePreservedDisk.
+func (in *PreservedStatePreservedDisk) DeepCopy() *PreservedStatePreservedDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(PreservedStatePreservedDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicy) DeepCopyInto(out *ResourcePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicy.
+func (in *ResourcePolicy) DeepCopy() *ResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyList) DeepCopyInto(out *ResourcePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourcePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyList.
+func (in *ResourcePolicyList) DeepCopy() *ResourcePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyObservation) DeepCopyInto(out *ResourcePolicyObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyObservation.
+func (in *ResourcePolicyObservation) DeepCopy() *ResourcePolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyParameters) DeepCopyInto(out *ResourcePolicyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	in.SnapshotSchedulePolicy.DeepCopyInto(&out.SnapshotSchedulePolicy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyParameters.
+func (in *ResourcePolicyParameters) DeepCopy() *ResourcePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicySpec) DeepCopyInto(out *ResourcePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicySpec.
+func (in *ResourcePolicySpec) DeepCopy() *ResourcePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyStatus) DeepCopyInto(out *ResourcePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyStatus.
+func (in *ResourcePolicyStatus) DeepCopy() *ResourcePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Route) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteList) DeepCopyInto(out *RouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
+func (in *RouteList) DeepCopy() *RouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteObservation) DeepCopyInto(out *RouteObservation) {
+	*out = *in
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteObservation.
+func (in *RouteObservation) DeepCopy() *RouteObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteParameters) DeepCopyInto(out *RouteParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextHopGateway != nil {
+		in, out := &in.NextHopGateway, &out.NextHopGateway
+		*out = new(string)
+		**out = **in
+	}
+	if in.NextHopInstance != nil {
+		in, out := &in.NextHopInstance, &out.NextHopInstance
+		*out = new(string)
+		**out = **in
+	}
+	if in.NextHopIP != nil {
+		in, out := &in.NextHopIP, &out.NextHopIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.NextHopILB != nil {
+		in, out := &in.NextHopILB, &out.NextHopILB
+		*out = new(string)
+		**out = **in
+	}
+	if in.NextHopVpnTunnel != nil {
+		in, out := &in.NextHopVpnTunnel, &out.NextHopVpnTunnel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteParameters.
+func (in *RouteParameters) DeepCopy() *RouteParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
+func (in *RouteSpec) DeepCopy() *RouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteStatus.
+func (in *RouteStatus) DeepCopy() *RouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Router) DeepCopyInto(out *Router) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Router.
+func (in *Router) DeepCopy() *Router {
+	if in == nil {
+		return nil
+	}
+	out := new(Router)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Router) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterAdvertisedIpRange) DeepCopyInto(out *RouterAdvertisedIpRange) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterAdvertisedIpRange.
+func (in *RouterAdvertisedIpRange) DeepCopy() *RouterAdvertisedIpRange {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterAdvertisedIpRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterBgp) DeepCopyInto(out *RouterBgp) {
+	*out = *in
+	if in.AdvertiseMode != nil {
+		in, out := &in.AdvertiseMode, &out.AdvertiseMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertisedGroups != nil {
+		in, out := &in.AdvertisedGroups, &out.AdvertisedGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdvertisedIpRanges != nil {
+		in, out := &in.AdvertisedIpRanges, &out.AdvertisedIpRanges
+		*out = make([]*RouterAdvertisedIpRange, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterAdvertisedIpRange)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Asn != nil {
+		in, out := &in.Asn, &out.Asn
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterBgp.
+func (in *RouterBgp) DeepCopy() *RouterBgp {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterBgp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterBgpPeer) DeepCopyInto(out *RouterBgpPeer) {
+	*out = *in
+	if in.AdvertiseMode != nil {
+		in, out := &in.AdvertiseMode, &out.AdvertiseMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertisedGroups != nil {
+		in, out := &in.AdvertisedGroups, &out.AdvertisedGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdvertisedIpRanges != nil {
+		in, out := &in.AdvertisedIpRanges, &out.AdvertisedIpRanges
+		*out = make([]*RouterAdvertisedIpRange, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterAdvertisedIpRange)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AdvertisedRoutePriority != nil {
+		in, out := &in.AdvertisedRoutePriority, &out.AdvertisedRoutePriority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.InterfaceName != nil {
+		in, out := &in.InterfaceName, &out.InterfaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.IpAddress != nil {
+		in, out := &in.IpAddress, &out.IpAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerIpAddress != nil {
+		in, out := &in.PeerIpAddress, &out.PeerIpAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterBgpPeer.
+func (in *RouterBgpPeer) DeepCopy() *RouterBgpPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterBgpPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterInterface) DeepCopyInto(out *RouterInterface) {
+	*out = *in
+	if in.IpRange != nil {
+		in, out := &in.IpRange, &out.IpRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.LinkedInterconnectAttachment != nil {
+		in, out := &in.LinkedInterconnectAttachment, &out.LinkedInterconnectAttachment
+		*out = new(string)
+		**out = **in
+	}
+	if in.LinkedVpnTunnel != nil {
+		in, out := &in.LinkedVpnTunnel, &out.LinkedVpnTunnel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterInterface.
+func (in *RouterInterface) DeepCopy() *RouterInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterList) DeepCopyInto(out *RouterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Router, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterList.
+func (in *RouterList) DeepCopy() *RouterList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNAT) DeepCopyInto(out *RouterNAT) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNAT.
+func (in *RouterNAT) DeepCopy() *RouterNAT {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNAT)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouterNAT) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNATList) DeepCopyInto(out *RouterNATList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RouterNAT, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNATList.
+func (in *RouterNATList) DeepCopy() *RouterNATList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNATList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouterNATList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNATObservation) DeepCopyInto(out *RouterNATObservation) {
+	*out = *in
+	if in.AutoAllocatedNATIPs != nil {
+		in, out := &in.AutoAllocatedNATIPs, &out.AutoAllocatedNATIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserAllocatedNATIPs != nil {
+		in, out := &in.UserAllocatedNATIPs, &out.UserAllocatedNATIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNATObservation.
+func (in *RouterNATObservation) DeepCopy() *RouterNATObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNATObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNATParameters) DeepCopyInto(out *RouterNATParameters) {
+	*out = *in
+	if in.Router != nil {
+		in, out := &in.Router, &out.Router
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouterRef != nil {
+		in, out := &in.RouterRef, &out.RouterRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouterSelector != nil {
+		in, out := &in.RouterSelector, &out.RouterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NATIPs != nil {
+		in, out := &in.NATIPs, &out.NATIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NATIPRefs != nil {
+		in, out := &in.NATIPRefs, &out.NATIPRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NATIPSelector != nil {
+		in, out := &in.NATIPSelector, &out.NATIPSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DrainNATIPs != nil {
+		in, out := &in.DrainNATIPs, &out.DrainNATIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnetworks != nil {
+		in, out := &in.Subnetworks, &out.Subnetworks
+		*out = make([]RouterNATSubnetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MinPortsPerVM != nil {
+		in, out := &in.MinPortsPerVM, &out.MinPortsPerVM
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EnableEndpointIndependentMapping != nil {
+		in, out := &in.EnableEndpointIndependentMapping, &out.EnableEndpointIndependentMapping
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ICMPIdleTimeoutSec != nil {
+		in, out := &in.ICMPIdleTimeoutSec, &out.ICMPIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UDPIdleTimeoutSec != nil {
+		in, out := &in.UDPIdleTimeoutSec, &out.UDPIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TCPEstablishedIdleTimeoutSec != nil {
+		in, out := &in.TCPEstablishedIdleTimeoutSec, &out.TCPEstablishedIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TCPTransitoryIdleTimeoutSec != nil {
+		in, out := &in.TCPTransitoryIdleTimeoutSec, &out.TCPTransitoryIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LogConfig != nil {
+		in, out := &in.LogConfig, &out.LogConfig
+		*out = new(RouterNatLogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNATParameters.
+func (in *RouterNATParameters) DeepCopy() *RouterNATParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNATParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNATSpec) DeepCopyInto(out *RouterNATSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNATSpec.
+func (in *RouterNATSpec) DeepCopy() *RouterNATSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNATSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNATStatus) DeepCopyInto(out *RouterNATStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNATStatus.
+func (in *RouterNATStatus) DeepCopy() *RouterNATStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNATStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNATSubnetwork) DeepCopyInto(out *RouterNATSubnetwork) {
+	*out = *in
+	if in.Subnetwork != nil {
+		in, out := &in.Subnetwork, &out.Subnetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetworkRef != nil {
+		in, out := &in.SubnetworkRef, &out.SubnetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetworkSelector != nil {
+		in, out := &in.SubnetworkSelector, &out.SubnetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceIPRangesToNAT != nil {
+		in, out := &in.SourceIPRangesToNAT, &out.SourceIPRangesToNAT
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecondaryIPRangeNames != nil {
+		in, out := &in.SecondaryIPRangeNames, &out.SecondaryIPRangeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNATSubnetwork.
+func (in *RouterNATSubnetwork) DeepCopy() *RouterNATSubnetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNATSubnetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNat) DeepCopyInto(out *RouterNat) {
+	*out = *in
+	if in.DrainNatIps != nil {
+		in, out := &in.DrainNatIps, &out.DrainNatIps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnableEndpointIndependentMapping != nil {
+		in, out := &in.EnableEndpointIndependentMapping, &out.EnableEndpointIndependentMapping
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IcmpIdleTimeoutSec != nil {
+		in, out := &in.IcmpIdleTimeoutSec, &out.IcmpIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LogConfig != nil {
+		in, out := &in.LogConfig, &out.LogConfig
+		*out = new(RouterNatLogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinPortsPerVm != nil {
+		in, out := &in.MinPortsPerVm, &out.MinPortsPerVm
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NatIps != nil {
+		in, out := &in.NatIps, &out.NatIps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnetworks != nil {
+		in, out := &in.Subnetworks, &out.Subnetworks
+		*out = make([]*RouterNatSubnetworkToNat, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterNatSubnetworkToNat)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TcpEstablishedIdleTimeoutSec != nil {
+		in, out := &in.TcpEstablishedIdleTimeoutSec, &out.TcpEstablishedIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TcpTransitoryIdleTimeoutSec != nil {
+		in, out := &in.TcpTransitoryIdleTimeoutSec, &out.TcpTransitoryIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UdpIdleTimeoutSec != nil {
+		in, out := &in.UdpIdleTimeoutSec, &out.UdpIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNat.
+func (in *RouterNat) DeepCopy() *RouterNat {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNatLogConfig) DeepCopyInto(out *RouterNatLogConfig) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNatLogConfig.
+func (in *RouterNatLogConfig) DeepCopy() *RouterNatLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNatLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNatSubnetworkToNat) DeepCopyInto(out *RouterNatSubnetworkToNat) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryIpRangeNames != nil {
+		in, out := &in.SecondaryIpRangeNames, &out.SecondaryIpRangeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceIpRangesToNat != nil {
+		in, out := &in.SourceIpRangesToNat, &out.SourceIpRangesToNat
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNatSubnetworkToNat.
+func (in *RouterNatSubnetworkToNat) DeepCopy() *RouterNatSubnetworkToNat {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNatSubnetworkToNat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterObservation) DeepCopyInto(out *RouterObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterObservation.
+func (in *RouterObservation) DeepCopy() *RouterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterParameters) DeepCopyInto(out *RouterParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bgp != nil {
+		in, out := &in.Bgp, &out.Bgp
+		*out = new(RouterBgp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BgpPeers != nil {
+		in, out := &in.BgpPeers, &out.BgpPeers
+		*out = make([]*RouterBgpPeer, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterBgpPeer)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.EncryptedInterconnectRouter != nil {
+		in, out := &in.EncryptedInterconnectRouter, &out.EncryptedInterconnectRouter
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]*RouterInterface, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterInterface)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Nats != nil {
+		in, out := &in.Nats, &out.Nats
+		*out = make([]*RouterNat, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouterNat)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterParameters.
+func (in *RouterParameters) DeepCopy() *RouterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterPeer) DeepCopyInto(out *RouterPeer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterPeer.
+func (in *RouterPeer) DeepCopy() *RouterPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouterPeer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterPeerInterface) DeepCopyInto(out *RouterPeerInterface) {
+	*out = *in
+	if in.IPRange != nil {
+		in, out := &in.IPRange, &out.IPRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.LinkedVPNTunnel != nil {
+		in, out := &in.LinkedVPNTunnel, &out.LinkedVPNTunnel
+		*out = new(string)
+		**out = **in
+	}
+	if in.LinkedInterconnectAttachment != nil {
+		in, out := &in.LinkedInterconnectAttachment, &out.LinkedInterconnectAttachment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterPeerInterface.
+func (in *RouterPeerInterface) DeepCopy() *RouterPeerInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterPeerInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterPeerList) DeepCopyInto(out *RouterPeerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RouterPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterPeerList.
+func (in *RouterPeerList) DeepCopy() *RouterPeerList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterPeerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouterPeerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterPeerObservation) DeepCopyInto(out *RouterPeerObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterPeerObservation.
+func (in *RouterPeerObservation) DeepCopy() *RouterPeerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterPeerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterPeerParameters) DeepCopyInto(out *RouterPeerParameters) {
+	*out = *in
+	if in.Router != nil {
+		in, out := &in.Router, &out.Router
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouterRef != nil {
+		in, out := &in.RouterRef, &out.RouterRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RouterSelector != nil {
+		in, out := &in.RouterSelector, &out.RouterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interface != nil {
+		in, out := &in.Interface, &out.Interface
+		*out = new(RouterPeerInterface)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PeerIPAddress != nil {
+		in, out := &in.PeerIPAddress, &out.PeerIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertiseMode != nil {
+		in, out := &in.AdvertiseMode, &out.AdvertiseMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertisedGroups != nil {
+		in, out := &in.AdvertisedGroups, &out.AdvertisedGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdvertisedIPRanges != nil {
+		in, out := &in.AdvertisedIPRanges, &out.AdvertisedIPRanges
+		*out = make([]RouterAdvertisedIpRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdvertisedRoutePriority != nil {
+		in, out := &in.AdvertisedRoutePriority, &out.AdvertisedRoutePriority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableIPv6 != nil {
+		in, out := &in.EnableIPv6, &out.EnableIPv6
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterPeerParameters.
+func (in *RouterPeerParameters) DeepCopy() *RouterPeerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterPeerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterPeerSpec) DeepCopyInto(out *RouterPeerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterPeerSpec.
+func (in *RouterPeerSpec) DeepCopy() *RouterPeerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterPeerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterPeerStatus) DeepCopyInto(out *RouterPeerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterPeerStatus.
+func (in *RouterPeerStatus) DeepCopy() *RouterPeerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterPeerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterSpec) DeepCopyInto(out *RouterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterSpec.
+func (in *RouterSpec) DeepCopy() *RouterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterStatus) DeepCopyInto(out *RouterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterStatus.
+func (in *RouterStatus) DeepCopy() *RouterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Scheduling) DeepCopyInto(out *Scheduling) {
+	*out = *in
+	if in.AutomaticRestart != nil {
+		in, out := &in.AutomaticRestart, &out.AutomaticRestart
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnHostMaintenance != nil {
+		in, out := &in.OnHostMaintenance, &out.OnHostMaintenance
+		*out = new(string)
+		**out = **in
+	}
+	if in.Preemptible != nil {
+		in, out := &in.Preemptible, &out.Preemptible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProvisioningModel != nil {
+		in, out := &in.ProvisioningModel, &out.ProvisioningModel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scheduling.
+func (in *Scheduling) DeepCopy() *Scheduling {
+	if in == nil {
+		return nil
+	}
+	out := new(Scheduling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShieldedInstanceConfig) DeepCopyInto(out *ShieldedInstanceConfig) {
+	*out = *in
+	if in.EnableSecureBoot != nil {
+		in, out := &in.EnableSecureBoot, &out.EnableSecureBoot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableVtpm != nil {
+		in, out := &in.EnableVtpm, &out.EnableVtpm
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableIntegrityMonitoring != nil {
+		in, out := &in.EnableIntegrityMonitoring, &out.EnableIntegrityMonitoring
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShieldedInstanceConfig.
+func (in *ShieldedInstanceConfig) DeepCopy() *ShieldedInstanceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ShieldedInstanceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Snapshot) DeepCopyInto(out *Snapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snapshot.
+func (in *Snapshot) DeepCopy() *Snapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(Snapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Snapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotList) DeepCopyInto(out *SnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Snapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotList.
+func (in *SnapshotList) DeepCopy() *SnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotObservation) DeepCopyInto(out *SnapshotObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotObservation.
+func (in *SnapshotObservation) DeepCopy() *SnapshotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotParameters) DeepCopyInto(out *SnapshotParameters) {
+	*out = *in
+	if in.SourceDisk != nil {
+		in, out := &in.SourceDisk, &out.SourceDisk
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDiskRef != nil {
+		in, out := &in.SourceDiskRef, &out.SourceDiskRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceDiskSelector != nil {
+		in, out := &in.SourceDiskSelector, &out.SourceDiskSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotType != nil {
+		in, out := &in.SnapshotType, &out.SnapshotType
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageLocations != nil {
+		in, out := &in.StorageLocations, &out.StorageLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotParameters.
+func (in *SnapshotParameters) DeepCopy() *SnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotProperties) DeepCopyInto(out *SnapshotProperties) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StorageLocations != nil {
+		in, out := &in.StorageLocations, &out.StorageLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GuestFlush != nil {
+		in, out := &in.GuestFlush, &out.GuestFlush
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotProperties.
+func (in *SnapshotProperties) DeepCopy() *SnapshotProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotRetentionPolicy) DeepCopyInto(out *SnapshotRetentionPolicy) {
+	*out = *in
+	if in.OnSourceDiskDelete != nil {
+		in, out := &in.OnSourceDiskDelete, &out.OnSourceDiskDelete
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotRetentionPolicy.
+func (in *SnapshotRetentionPolicy) DeepCopy() *SnapshotRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSchedule) DeepCopyInto(out *SnapshotSchedule) {
+	*out = *in
+	if in.HourlySchedule != nil {
+		in, out := &in.HourlySchedule, &out.HourlySchedule
+		*out = new(HourlyCycle)
+		**out = **in
+	}
+	if in.DailySchedule != nil {
+		in, out := &in.DailySchedule, &out.DailySchedule
+		*out = new(DailyCycle)
+		**out = **in
+	}
+	if in.WeeklySchedule != nil {
+		in, out := &in.WeeklySchedule, &out.WeeklySchedule
+		*out = new(WeeklyCycle)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSchedule.
+func (in *SnapshotSchedule) DeepCopy() *SnapshotSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSchedulePolicy) DeepCopyInto(out *SnapshotSchedulePolicy) {
+	*out = *in
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(SnapshotRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotProperties != nil {
+		in, out := &in.SnapshotProperties, &out.SnapshotProperties
+		*out = new(SnapshotProperties)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSchedulePolicy.
+func (in *SnapshotSchedulePolicy) DeepCopy() *SnapshotSchedulePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSchedulePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSpec) DeepCopyInto(out *SnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSpec.
+func (in *SnapshotSpec) DeepCopy() *SnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotStatus) DeepCopyInto(out *SnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotStatus.
+func (in *SnapshotStatus) DeepCopy() *SnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotTerminationPolicy) DeepCopyInto(out *SpotTerminationPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotTerminationPolicy.
+func (in *SpotTerminationPolicy) DeepCopy() *SpotTerminationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotTerminationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatefulPolicy) DeepCopyInto(out *StatefulPolicy) {
+	*out = *in
+	if in.PreservedState != nil {
+		in, out := &in.PreservedState, &out.PreservedState
+		*out = new(StatefulPolicyPreservedState)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatefulPolicy.
+func (in *StatefulPolicy) DeepCopy() *StatefulPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StatefulPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatefulPolicyPreservedState) DeepCopyInto(out *StatefulPolicyPreservedState) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make(map[string]StatefulPolicyPreservedStateDiskDevice, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatefulPolicyPreservedState.
+func (in *StatefulPolicyPreservedState) DeepCopy() *StatefulPolicyPreservedState {
+	if in == nil {
+		return nil
+	}
+	out := new(StatefulPolicyPreservedState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatefulPolicyPreservedStateDiskDevice) DeepCopyInto(out *StatefulPolicyPreservedStateDiskDevice) {
+	*out = *in
+	if in.AutoDelete != nil {
+		in, out := &in.AutoDelete, &out.AutoDelete
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatefulPolicyPreservedStateDiskDevice.
+func (in *StatefulPolicyPreservedStateDiskDevice) DeepCopy() *StatefulPolicyPreservedStateDiskDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(StatefulPolicyPreservedStateDiskDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPHealthCheck) DeepCopyInto(out *TCPHealthCheck) {
+	*out = *in
+	in.HealthCheckPort.DeepCopyInto(&out.HealthCheckPort)
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(string)
+		**out = **in
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyHeader != nil {
+		in, out := &in.ProxyHeader, &out.ProxyHeader
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPHealthCheck.
+func (in *TCPHealthCheck) DeepCopy() *TCPHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdatePolicy) DeepCopyInto(out *UpdatePolicy) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceRedistributionType != nil {
+		in, out := &in.InstanceRedistributionType, &out.InstanceRedistributionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinimalAction != nil {
+		in, out := &in.MinimalAction, &out.MinimalAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.MostDisruptiveAllowedAction != nil {
+		in, out := &in.MostDisruptiveAllowedAction, &out.MostDisruptiveAllowedAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplacementMethod != nil {
+		in, out := &in.ReplacementMethod, &out.ReplacementMethod
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(FixedOrPercent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(FixedOrPercent)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdatePolicy.
+func (in *UpdatePolicy) DeepCopy() *UpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeeklyCycle) DeepCopyInto(out *WeeklyCycle) {
+	*out = *in
+	if in.DayOfWeeks != nil {
+		in, out := &in.DayOfWeeks, &out.DayOfWeeks
+		*out = make([]DayOfWeek, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeeklyCycle.
+func (in *WeeklyCycle) DeepCopy() *WeeklyCycle {
+	if in == nil {
+		return nil
+	}
+	out := new(WeeklyCycle)
 	in.DeepCopyInto(out)
 	return out
 }
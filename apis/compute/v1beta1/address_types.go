@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // AddressParameters define the desired state of a Google Compute Engine
@@ -60,6 +62,15 @@ type AddressParameters struct {
 	// +kubebuilder:validation:Enum=IPV6;IPV4;UNSPECIFIED_VERSION
 	IPVersion *string `json:"ipVersion,omitempty"`
 
+	// IPv6EndpointType: The kind of endpoint an external IPv6 address is
+	// reserved for, i.e. VM for the IPv6 access configs of instances or
+	// NETLB for external passthrough network load balancers. It must be set
+	// when reserving a regional external IPv6 address from a subnetwork.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=VM;NETLB
+	IPv6EndpointType *string `json:"ipv6EndpointType,omitempty"`
+
 	// Region: An optional region in which to create the address.
 	// +immutable
 	// +kubebuilder:validation:Required
@@ -82,6 +93,13 @@ type AddressParameters struct {
 	// +immutable
 	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
 
+	// NetworkTier: The networking tier of an external address, i.e.
+	// PREMIUM or STANDARD. Defaults to PREMIUM.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=PREMIUM;STANDARD
+	NetworkTier *string `json:"networkTier,omitempty"`
+
 	// PrefixLength: The prefix length if the resource represents an IP
 	// range.
 	// +optional
@@ -118,7 +136,7 @@ type AddressParameters struct {
 	//   "PRIVATE_SERVICE_CONNECT"
 	// +optional
 	// +immutable
-	// +kubebuilder:validation:Enum=DNS_RESOLVER;GCE_ENDPOINT;NAT_AUTO;VPC_PEERING
+	// +kubebuilder:validation:Enum=DNS_RESOLVER;GCE_ENDPOINT;NAT_AUTO;VPC_PEERING;IPSEC_INTERCONNECT;SHARED_LOADBALANCER_VIP
 	Purpose *string `json:"purpose,omitempty"`
 
 	// Subnetwork: The URL of the subnetwork in which to reserve the
@@ -151,6 +169,11 @@ type AddressObservation struct {
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
 
+	// Address: The IP address, or the first address of the IP range,
+	// allocated to the resource. It is also published as the address key
+	// of the connection details.
+	Address string `json:"address,omitempty"`
+
 	// Status of the address, which can be one of RESERVING, RESERVED, or
 	// IN_USE. An address that is RESERVING is currently in the process of being
 	// reserved. A RESERVED address is currently reserved and available to use.
@@ -165,6 +188,10 @@ type AddressObservation struct {
 
 	// Users that are using this address.
 	Users []string `json:"users,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // A AddressSpec defines the desired state of anAddress.
@@ -177,13 +204,21 @@ type AddressSpec struct {
 type AddressStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          AddressObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // An Address is a managed resource that represents a Google Compute Engine Address.
-// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".spec.forProvider.address"
-// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.address"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // Known Address statuses.
@@ -29,6 +31,10 @@ const (
 	StatusReserving = "RESERVING"
 )
 
+// AddressKey is the connection detail key of the IP address allocated to an
+// Address or GlobalAddress.
+const AddressKey = "address"
+
 // GlobalAddressParameters define the desired state of a Google Compute Engine
 // Global Address. Most fields map directly to an Address:
 // https://cloud.google.com/compute/docs/reference/rest/v1/globalAddresses
@@ -99,15 +105,18 @@ type GlobalAddressParameters struct {
 	// networks.
 	// - `NAT_AUTO` for addresses that are external IP addresses
 	// automatically reserved for Cloud NAT.
+	// - `PRIVATE_SERVICE_CONNECT` for internal addresses used by Private
+	// Service Connect endpoints for Google APIs.
 	//
 	// Possible values:
 	//   "DNS_RESOLVER"
 	//   "GCE_ENDPOINT"
 	//   "NAT_AUTO"
 	//   "VPC_PEERING"
+	//   "PRIVATE_SERVICE_CONNECT"
 	// +optional
 	// +immutable
-	// +kubebuilder:validation:Enum=DNS_RESOLVER;GCE_ENDPOINT;NAT_AUTO;VPC_PEERING
+	// +kubebuilder:validation:Enum=DNS_RESOLVER;GCE_ENDPOINT;NAT_AUTO;VPC_PEERING;PRIVATE_SERVICE_CONNECT
 	Purpose *string `json:"purpose,omitempty"`
 
 	// Subnetwork: The URL of the subnetwork in which to reserve the
@@ -140,6 +149,11 @@ type GlobalAddressObservation struct {
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
 
+	// Address: The IP address, or the first address of the IP range,
+	// allocated to the resource. It is also published as the address key
+	// of the connection details.
+	Address string `json:"address,omitempty"`
+
 	// Status of the address, which can be one of RESERVING, RESERVED, or
 	// IN_USE. An address that is RESERVING is currently in the process of being
 	// reserved. A RESERVED address is currently reserved and available to use.
@@ -154,6 +168,16 @@ type GlobalAddressObservation struct {
 
 	// Users that are using this address.
 	Users []string `json:"users,omitempty"`
+
+	// Utilization: The number of addresses of the range allocated by an
+	// address of purpose VPC_PEERING that are used by the subnetworks of
+	// service producers. It is recorded periodically.
+	// +optional
+	Utilization *gcpv1beta1.Utilization `json:"utilization,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // A GlobalAddressSpec defines the desired state of a GlobalAddress.
@@ -166,13 +190,21 @@ type GlobalAddressSpec struct {
 type GlobalAddressStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          GlobalAddressObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // A GlobalAddress is a managed resource that represents a Google Compute Engine
 // Global Address.
-// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".spec.forProvider.address"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.address"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // NetworkParameters define the desired state of a Google Compute Engine VPC
@@ -51,6 +53,19 @@ type NetworkParameters struct {
 	// routing behavior to enforce.
 	// +optional
 	RoutingConfig *NetworkRoutingConfig `json:"routingConfig,omitempty"`
+
+	// EnableULAInternalIPv6: Whether a unique local address (ULA) IPv6
+	// range is assigned to the network. It must be set before subnetworks
+	// with an ipv6AccessType of INTERNAL can be created in the network.
+	// +optional
+	EnableULAInternalIPv6 *bool `json:"enableUlaInternalIpv6,omitempty"`
+
+	// InternalIPv6Range: The /48 ULA IPv6 range of the network, from
+	// fd20::/20. Google Cloud picks a range if none is set and
+	// enableUlaInternalIpv6 is true.
+	// +optional
+	// +immutable
+	InternalIPv6Range *string `json:"internalIpv6Range,omitempty"`
 }
 
 // A NetworkObservation represents the observed state of a Google Compute Engine
@@ -77,6 +92,10 @@ type NetworkObservation struct {
 	// Subnetworks: Server-defined fully-qualified URLs for
 	// all subnetworks in this VPC network.
 	Subnetworks []string `json:"subnetworks,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // A NetworkPeering represents the observed state of a Google Compute Engine
@@ -151,6 +170,11 @@ type NetworkSpec struct {
 type NetworkStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          NetworkObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -159,6 +183,10 @@ type NetworkStatus struct {
 // Network.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ROUTING-MODE",type="string",JSONPath=".spec.forProvider.routingConfig.routingMode"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type Network struct {
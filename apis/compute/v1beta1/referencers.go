@@ -50,6 +50,40 @@ func SubnetworkURL() reference.ExtractValueFn {
 	}
 }
 
+// AddressURL extracts the partially qualified URL of an Address.
+func AddressURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		a, ok := mg.(*Address)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(a.Status.AtProvider.SelfLink, ComputeURIPrefix)
+	}
+}
+
+// AddressIP extracts the IP address allocated to an Address, e.g. to use it
+// in the records of a DNS record set.
+func AddressIP() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		a, ok := mg.(*Address)
+		if !ok {
+			return ""
+		}
+		return a.Status.AtProvider.Address
+	}
+}
+
+// GlobalAddressIP extracts the IP address allocated to a GlobalAddress.
+func GlobalAddressIP() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		a, ok := mg.(*GlobalAddress)
+		if !ok {
+			return ""
+		}
+		return a.Status.AtProvider.Address
+	}
+}
+
 // ResolveReferences of this GlobalAddress
 func (mg *GlobalAddress) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // SubnetworkParameters define the desired state of a Google Compute Engine VPC
@@ -85,6 +87,22 @@ type SubnetworkParameters struct {
 	// field can be updated with a patch request.
 	// +optional
 	SecondaryIPRanges []*SubnetworkSecondaryRange `json:"secondaryIpRanges,omitempty"`
+
+	// StackType: The stack type of the subnetwork, i.e. IPV4_ONLY or
+	// IPV4_IPV6. An IPv4 subnetwork can be changed to a dual-stack
+	// subnetwork, in which case ipv6AccessType must be set too. Defaults to
+	// IPV4_ONLY.
+	// +optional
+	// +kubebuilder:validation:Enum=IPV4_ONLY;IPV4_IPV6
+	StackType *string `json:"stackType,omitempty"`
+
+	// IPv6AccessType: Whether the IPv6 range of a dual-stack subnetwork is
+	// reachable from the internet, i.e. EXTERNAL, or only from within the
+	// network, i.e. INTERNAL. Internal IPv6 ranges require the network to
+	// have enableUlaInternalIpv6 set.
+	// +optional
+	// +kubebuilder:validation:Enum=EXTERNAL;INTERNAL
+	IPv6AccessType *string `json:"ipv6AccessType,omitempty"`
 }
 
 // A SubnetworkObservation represents the observed state of a Google Compute
@@ -114,6 +132,30 @@ type SubnetworkObservation struct {
 
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
+
+	// IPv6CidrRange: The internal IPv6 range of a dual-stack subnetwork,
+	// assigned by Google Cloud.
+	IPv6CidrRange string `json:"ipv6CidrRange,omitempty"`
+
+	// ExternalIPv6Prefix: The external IPv6 range of a dual-stack
+	// subnetwork with an ipv6AccessType of EXTERNAL, assigned by Google
+	// Cloud.
+	ExternalIPv6Prefix string `json:"externalIpv6Prefix,omitempty"`
+
+	// InternalIPv6Prefix: The internal IPv6 range of a dual-stack
+	// subnetwork with an ipv6AccessType of INTERNAL, assigned by Google
+	// Cloud.
+	InternalIPv6Prefix string `json:"internalIpv6Prefix,omitempty"`
+
+	// Utilization: The number of addresses of the primary IPv4 range of the
+	// subnetwork that are used by internal addresses, instances and
+	// forwarding rules. It is recorded periodically.
+	// +optional
+	Utilization *gcpv1beta1.Utilization `json:"utilization,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // A SubnetworkSecondaryRange defines the state of a Google Compute Engine
@@ -143,6 +185,11 @@ type SubnetworkSpec struct {
 type SubnetworkStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          SubnetworkObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -151,6 +198,11 @@ type SubnetworkStatus struct {
 // VPC Subnetwork.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="CIDR",type="string",JSONPath=".spec.forProvider.ipCidrRange"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type Subnetwork struct {
@@ -22,6 +22,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	apisv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -93,6 +94,11 @@ func (in *AddressObservation) DeepCopyInto(out *AddressObservation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressObservation.
@@ -128,6 +134,11 @@ func (in *AddressParameters) DeepCopyInto(out *AddressParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv6EndpointType != nil {
+		in, out := &in.IPv6EndpointType, &out.IPv6EndpointType
+		*out = new(string)
+		**out = **in
+	}
 	if in.Network != nil {
 		in, out := &in.Network, &out.Network
 		*out = new(string)
@@ -143,6 +154,11 @@ func (in *AddressParameters) DeepCopyInto(out *AddressParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NetworkTier != nil {
+		in, out := &in.NetworkTier, &out.NetworkTier
+		*out = new(string)
+		**out = **in
+	}
 	if in.PrefixLength != nil {
 		in, out := &in.PrefixLength, &out.PrefixLength
 		*out = new(int64)
@@ -281,6 +297,16 @@ func (in *GlobalAddressObservation) DeepCopyInto(out *GlobalAddressObservation)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Utilization != nil {
+		in, out := &in.Utilization, &out.Utilization
+		*out = new(apisv1beta1.Utilization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalAddressObservation.
@@ -480,6 +506,11 @@ func (in *NetworkObservation) DeepCopyInto(out *NetworkObservation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkObservation.
@@ -510,6 +541,16 @@ func (in *NetworkParameters) DeepCopyInto(out *NetworkParameters) {
 		*out = new(NetworkRoutingConfig)
 		**out = **in
 	}
+	if in.EnableULAInternalIPv6 != nil {
+		in, out := &in.EnableULAInternalIPv6, &out.EnableULAInternalIPv6
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InternalIPv6Range != nil {
+		in, out := &in.InternalIPv6Range, &out.InternalIPv6Range
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkParameters.
@@ -648,6 +689,16 @@ func (in *SubnetworkList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetworkObservation) DeepCopyInto(out *SubnetworkObservation) {
 	*out = *in
+	if in.Utilization != nil {
+		in, out := &in.Utilization, &out.Utilization
+		*out = new(apisv1beta1.Utilization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetworkObservation.
@@ -704,6 +755,16 @@ func (in *SubnetworkParameters) DeepCopyInto(out *SubnetworkParameters) {
 			}
 		}
 	}
+	if in.StackType != nil {
+		in, out := &in.StackType, &out.StackType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6AccessType != nil {
+		in, out := &in.IPv6AccessType, &out.IPv6AccessType
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetworkParameters.
@@ -752,7 +813,7 @@ func (in *SubnetworkSpec) DeepCopy() *SubnetworkSpec {
 func (in *SubnetworkStatus) DeepCopyInto(out *SubnetworkStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetworkStatus.
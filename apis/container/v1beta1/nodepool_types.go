@@ -23,6 +23,7 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // NodePool states.
@@ -90,6 +91,10 @@ type NodePoolObservation struct {
 	// status of this
 	// node pool instance, if available.
 	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // NodePoolParameters define the desired state of a Google Kubernetes Engine
@@ -224,6 +229,14 @@ type NodeConfig struct {
 	// +optional
 	BootDiskKmsKey *string `json:"bootDiskKmsKey,omitempty"`
 
+	// ConfidentialNodes: Confidential nodes config. All the nodes in the
+	// node pool will be Confidential VM once enabled. It cannot be changed
+	// once the node pool is created.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="confidentialNodes is immutable"
+	ConfidentialNodes *v1beta2.ConfidentialNodes `json:"confidentialNodes,omitempty"`
+
 	// DiskSizeGb: Size of the disk attached to each node, specified in
 	// GB.
 	// The smallest allowed disk size is 10GB.
@@ -241,6 +254,15 @@ type NodeConfig struct {
 	// +optional
 	DiskType *string `json:"diskType,omitempty"`
 
+	// GcfsConfig: Google Container File System (image streaming) configs.
+	// +optional
+	GcfsConfig *v1beta2.GcfsConfig `json:"gcfsConfig,omitempty"`
+
+	// Gvnic: Enable or disable gVNIC in the node pool. Changing it recreates
+	// the nodes of the node pool.
+	// +optional
+	Gvnic *v1beta2.VirtualNIC `json:"gvnic,omitempty"`
+
 	// ImageType: The image type to use for this node. Note that for a given
 	// image type,
 	// the latest version of it will be used.
@@ -265,7 +287,6 @@ type NodeConfig struct {
 	// see:
 	// https://kubernetes.io/docs/concepts/overview/working-with-objects
 	// /labels/
-	// +immutable
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
 
@@ -425,7 +446,6 @@ type NodeConfig struct {
 	// the client during cluster or node pool creation. Each tag within the
 	// list
 	// must comply with RFC1035.
-	// +immutable
 	// +optional
 	Tags []string `json:"tags,omitempty"`
 
@@ -435,7 +455,6 @@ type NodeConfig struct {
 	// see:
 	// https://kubernetes.io/docs/concepts/configuration/taint-and-toler
 	// ation/
-	// +immutable
 	// +optional
 	Taints []*NodeTaint `json:"taints,omitempty"`
 
@@ -642,12 +661,24 @@ type AutoUpgradeOptions struct {
 type NodePoolSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       NodePoolParameters `json:"forProvider"`
+
+	// PermissionPreflight verifies that the GKE and Compute Engine service
+	// agents and the node service account have the IAM roles they need on
+	// the project before the node pool is created. Missing grants are
+	// reported in a Blocked condition instead of attempting the create.
+	// +optional
+	PermissionPreflight *bool `json:"permissionPreflight,omitempty"`
 }
 
 // A NodePoolStatus represents the observed state of a NodePool.
 type NodePoolStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          NodePoolObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -657,8 +688,11 @@ type NodePoolStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
 // +kubebuilder:printcolumn:name="CLUSTER-REF",type="string",JSONPath=".spec.forProvider.clusterRef.name"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.version"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type NodePool struct {
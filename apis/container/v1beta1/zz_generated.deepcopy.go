@@ -23,6 +23,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	apisv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -98,6 +99,11 @@ func (in *NodeConfig) DeepCopyInto(out *NodeConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ConfidentialNodes != nil {
+		in, out := &in.ConfidentialNodes, &out.ConfidentialNodes
+		*out = new(v1beta2.ConfidentialNodes)
+		**out = **in
+	}
 	if in.DiskSizeGb != nil {
 		in, out := &in.DiskSizeGb, &out.DiskSizeGb
 		*out = new(int64)
@@ -108,6 +114,16 @@ func (in *NodeConfig) DeepCopyInto(out *NodeConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.GcfsConfig != nil {
+		in, out := &in.GcfsConfig, &out.GcfsConfig
+		*out = new(v1beta2.GcfsConfig)
+		**out = **in
+	}
+	if in.Gvnic != nil {
+		in, out := &in.Gvnic, &out.Gvnic
+		*out = new(v1beta2.VirtualNIC)
+		**out = **in
+	}
 	if in.ImageType != nil {
 		in, out := &in.ImageType, &out.ImageType
 		*out = new(string)
@@ -413,6 +429,11 @@ func (in *NodePoolObservation) DeepCopyInto(out *NodePoolObservation) {
 		*out = new(NodeManagementStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolObservation.
@@ -495,6 +516,11 @@ func (in *NodePoolSpec) DeepCopyInto(out *NodePoolSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.PermissionPreflight != nil {
+		in, out := &in.PermissionPreflight, &out.PermissionPreflight
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolSpec.
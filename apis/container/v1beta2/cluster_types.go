@@ -21,6 +21,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // Cluster states.
@@ -97,8 +99,14 @@ type ClusterParameters struct {
 	// ConfidentialNodes: Configuration of Confidential Nodes
 	// +optional
 	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="confidentialNodes is immutable"
 	ConfidentialNodes *ConfidentialNodes `json:"confidentialNodes,omitempty"`
 
+	// CostManagementConfig: Configuration for the fine-grained cost
+	// management feature, i.e. GKE cost allocation.
+	// +optional
+	CostManagementConfig *CostManagementConfig `json:"costManagementConfig,omitempty"`
+
 	// DatabaseEncryption: Configuration of etcd encryption.
 	// +optional
 	DatabaseEncryption *DatabaseEncryption `json:"databaseEncryption,omitempty"`
@@ -263,6 +271,12 @@ type ClusterParameters struct {
 	// +optional
 	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
 
+	// NodePoolDefaults: Default NodePool settings for the entire cluster.
+	// These settings are overridden if specified on the specific NodePool
+	// object.
+	// +optional
+	NodePoolDefaults *NodePoolDefaults `json:"nodePoolDefaults,omitempty"`
+
 	// NotificationConfig: Notification configuration of the cluster.
 	NotificationConfig *NotificationConfig `json:"notificationConfig,omitempty"`
 
@@ -459,6 +473,10 @@ type ClusterObservation struct {
 	// resides.
 	// This field is deprecated, use location instead.
 	Zone string `json:"zone,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // AddonsConfig is configuration for the addons that can be automatically
@@ -865,6 +883,35 @@ type ReleaseChannel struct {
 	Channel string `json:"channel"`
 }
 
+// NodePoolDefaults contains defaults for a node pool created in a cluster.
+type NodePoolDefaults struct {
+	// NodeConfigDefaults: Subset of NodeConfig message that has defaults.
+	// +optional
+	NodeConfigDefaults *NodeConfigDefaults `json:"nodeConfigDefaults,omitempty"`
+}
+
+// NodeConfigDefaults is the subset of NodeConfig that may be defaulted for
+// all node pools of a cluster.
+type NodeConfigDefaults struct {
+	// GcfsConfig: GCFS (Google Container File System, also known as Riptide)
+	// options. Enabling it turns on image streaming for new node pools.
+	// +optional
+	GcfsConfig *GcfsConfig `json:"gcfsConfig,omitempty"`
+}
+
+// GcfsConfig contains configurations of Google Container File System (image
+// streaming).
+type GcfsConfig struct {
+	// Enabled: Whether to use GCFS.
+	Enabled bool `json:"enabled"`
+}
+
+// VirtualNIC contains configuration for the Google Virtual NIC (gVNIC).
+type VirtualNIC struct {
+	// Enabled: Whether gVNIC features are enabled in the node pool.
+	Enabled bool `json:"enabled"`
+}
+
 // NotificationConfig is the configuration of notifications.
 type NotificationConfig struct {
 	// Pubsub: Notification config for Pub/Sub.
@@ -1431,6 +1478,13 @@ type ConsumptionMeteringConfig struct {
 	Enabled bool `json:"enabled"`
 }
 
+// CostManagementConfig is configuration for fine-grained cost management
+// feature.
+type CostManagementConfig struct {
+	// Enabled: Whether the feature is enabled or not.
+	Enabled bool `json:"enabled"`
+}
+
 // VerticalPodAutoscaling contains global,
 // per-cluster information
 // required by Vertical Pod Autoscaler to automatically adjust
@@ -1835,12 +1889,24 @@ type AutoUpgradeOptionsClusterStatus struct {
 type ClusterSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ClusterParameters `json:"forProvider"`
+
+	// PermissionPreflight verifies that the GKE and Compute Engine service
+	// agents have the IAM roles they need on the project before the cluster
+	// is created. Missing grants are reported in a Blocked condition instead
+	// of attempting the create.
+	// +optional
+	PermissionPreflight *bool `json:"permissionPreflight,omitempty"`
 }
 
 // A ClusterStatus represents the observed state of a Cluster.
 type ClusterStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          ClusterObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -1850,9 +1916,12 @@ type ClusterStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
 // +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
 // +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.currentMasterVersion"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type Cluster struct {
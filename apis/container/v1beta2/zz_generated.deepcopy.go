@@ -22,6 +22,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -441,6 +442,11 @@ func (in *ClusterObservation) DeepCopyInto(out *ClusterObservation) {
 			}
 		}
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservation.
@@ -491,6 +497,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(ConfidentialNodes)
 		**out = **in
 	}
+	if in.CostManagementConfig != nil {
+		in, out := &in.CostManagementConfig, &out.CostManagementConfig
+		*out = new(CostManagementConfig)
+		**out = **in
+	}
 	if in.DatabaseEncryption != nil {
 		in, out := &in.DatabaseEncryption, &out.DatabaseEncryption
 		*out = new(DatabaseEncryption)
@@ -591,6 +602,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(NetworkPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodePoolDefaults != nil {
+		in, out := &in.NodePoolDefaults, &out.NodePoolDefaults
+		*out = new(NodePoolDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NotificationConfig != nil {
 		in, out := &in.NotificationConfig, &out.NotificationConfig
 		*out = new(NotificationConfig)
@@ -660,6 +676,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.PermissionPreflight != nil {
+		in, out := &in.PermissionPreflight, &out.PermissionPreflight
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -734,6 +755,21 @@ func (in *ConsumptionMeteringConfig) DeepCopy() *ConsumptionMeteringConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostManagementConfig) DeepCopyInto(out *CostManagementConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostManagementConfig.
+func (in *CostManagementConfig) DeepCopy() *CostManagementConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CostManagementConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSCacheConfig) DeepCopyInto(out *DNSCacheConfig) {
 	*out = *in
@@ -864,6 +900,21 @@ func (in *GCEPersistentDiskCSIDriverConfig) DeepCopy() *GCEPersistentDiskCSIDriv
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GcfsConfig) DeepCopyInto(out *GcfsConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GcfsConfig.
+func (in *GcfsConfig) DeepCopy() *GcfsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GcfsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPLoadBalancing) DeepCopyInto(out *HTTPLoadBalancing) {
 	*out = *in
@@ -1306,6 +1357,26 @@ func (in *NodeConfigClusterStatus) DeepCopy() *NodeConfigClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConfigDefaults) DeepCopyInto(out *NodeConfigDefaults) {
+	*out = *in
+	if in.GcfsConfig != nil {
+		in, out := &in.GcfsConfig, &out.GcfsConfig
+		*out = new(GcfsConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConfigDefaults.
+func (in *NodeConfigDefaults) DeepCopy() *NodeConfigDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeConfigDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeManagement) DeepCopyInto(out *NodeManagement) {
 	*out = *in
@@ -1422,6 +1493,26 @@ func (in *NodePoolClusterStatus) DeepCopy() *NodePoolClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolDefaults) DeepCopyInto(out *NodePoolDefaults) {
+	*out = *in
+	if in.NodeConfigDefaults != nil {
+		in, out := &in.NodeConfigDefaults, &out.NodeConfigDefaults
+		*out = new(NodeConfigDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolDefaults.
+func (in *NodePoolDefaults) DeepCopy() *NodePoolDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeTaintClusterStatus) DeepCopyInto(out *NodeTaintClusterStatus) {
 	*out = *in
@@ -1758,6 +1849,21 @@ func (in *VerticalPodAutoscaling) DeepCopy() *VerticalPodAutoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNIC) DeepCopyInto(out *VirtualNIC) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNIC.
+func (in *VirtualNIC) DeepCopy() *VirtualNIC {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNIC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadIdentityConfig) DeepCopyInto(out *WorkloadIdentityConfig) {
 	*out = *in
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Cloud SQL user types.
+const (
+	CloudSQLUserTypeBuiltIn                = "BUILT_IN"
+	CloudSQLUserTypeCloudIAMUser           = "CLOUD_IAM_USER"
+	CloudSQLUserTypeCloudIAMServiceAccount = "CLOUD_IAM_SERVICE_ACCOUNT"
+)
+
+// CloudSQLInstanceUserRole is the role IAM users and service accounts need
+// in order to log in to Cloud SQL instances with IAM database
+// authentication.
+const CloudSQLInstanceUserRole = "roles/cloudsql.instanceUser"
+
+// CloudSQLUserParameters define the desired state of a Google Cloud SQL user.
+// https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1beta4/users
+type CloudSQLUserParameters struct {
+	// Instance: The name of the Cloud SQL instance of the user.
+	// +optional
+	// +immutable
+	Instance *string `json:"instance,omitempty"`
+
+	// InstanceRef references a CloudSQLInstance and retrieves its name.
+	// +optional
+	// +immutable
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects a reference to a CloudSQLInstance.
+	// +optional
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// Type: The type of the user. BUILT_IN users log in with a generated
+	// password that is written to the connection secret, CLOUD_IAM_USER and
+	// CLOUD_IAM_SERVICE_ACCOUNT users log in with IAM database
+	// authentication, which must be enabled on the instance.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=BUILT_IN;CLOUD_IAM_USER;CLOUD_IAM_SERVICE_ACCOUNT
+	// +kubebuilder:default=BUILT_IN
+	Type *string `json:"type,omitempty"`
+
+	// Host: The host from which a MySQL user can connect. Not supported by
+	// PostgreSQL instances.
+	// +optional
+	// +immutable
+	Host *string `json:"host,omitempty"`
+
+	// Email: The email address of the IAM user or service account of a
+	// CLOUD_IAM_USER or CLOUD_IAM_SERVICE_ACCOUNT user. The name of the
+	// user is derived from it as required by the database engine of the
+	// instance, e.g. without the .gserviceaccount.com suffix of service
+	// accounts for PostgreSQL.
+	// +optional
+	// +immutable
+	Email *string `json:"email,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount and retrieves its email
+	// address.
+	// +optional
+	// +immutable
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// GrantInstanceUserRole binds the roles/cloudsql.instanceUser role to
+	// the IAM user or service account in the IAM policy of the project of
+	// the instance, so that it can log in. The role is unbound when this is
+	// unset or the CloudSQLUser is deleted. Other bindings of the role are
+	// left untouched.
+	// +optional
+	GrantInstanceUserRole *bool `json:"grantInstanceUserRole,omitempty"`
+}
+
+// CloudSQLUserObservation is used to show the observed state of a
+// CloudSQLUser.
+type CloudSQLUserObservation struct {
+	// Name: The name of the user in the Cloud SQL instance.
+	Name string `json:"name,omitempty"`
+
+	// Type: The type of the user.
+	Type string `json:"type,omitempty"`
+
+	// Host: The host from which a MySQL user can connect.
+	Host string `json:"host,omitempty"`
+
+	// BoundMembers are the members the roles/cloudsql.instanceUser role
+	// was last bound to by this CloudSQLUser.
+	BoundMembers []string `json:"boundMembers,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// CloudSQLUserSpec defines the desired state of a CloudSQLUser.
+type CloudSQLUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudSQLUserParameters `json:"forProvider"`
+}
+
+// CloudSQLUserStatus represents the observed state of a CloudSQLUser.
+type CloudSQLUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudSQLUserObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudSQLUser is a managed resource that represents a user of a Google
+// Cloud SQL instance. Its external name is the name of the user, which is
+// derived from the email address of IAM users and service accounts.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instance"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CloudSQLUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudSQLUserSpec   `json:"spec"`
+	Status CloudSQLUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudSQLUserList contains a list of CloudSQLUser types
+type CloudSQLUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudSQLUser `json:"items"`
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // CloudSQL instance states
@@ -41,6 +43,26 @@ const (
 	CloudSQLSecretServerCACertificateSha1FingerprintKey  = "serverCACertificateSha1Fingerprint"
 
 	CloudSQLSecretConnectionName = "connectionName"
+
+	CloudSQLSecretInstanceConnectionNameKey = "instanceConnectionName"
+	CloudSQLSecretClientCertificateKey      = "clientCert"
+	CloudSQLSecretClientKeyKey              = "clientKey"
+)
+
+// Connection details formats.
+const (
+	// ConnectionDetailsFormatDefault publishes the IP addresses of the
+	// instance as its endpoint.
+	ConnectionDetailsFormatDefault = "Default"
+
+	// ConnectionDetailsFormatCloudSQLProxy publishes the instance connection
+	// name and a localhost endpoint, for applications that connect through a
+	// cloud-sql-proxy sidecar.
+	ConnectionDetailsFormatCloudSQLProxy = "CloudSQLProxy"
+
+	// CloudSQLProxyEndpoint is the address a cloud-sql-proxy sidecar listens
+	// on by default.
+	CloudSQLProxyEndpoint = "127.0.0.1"
 )
 
 // CloudSQL version prefixes.
@@ -51,6 +73,8 @@ const (
 	PostgresqlDBVersionPrefix = "POSTGRES"
 	PostgresqlDefaultUser     = "postgres"
 
+	SqlserverDBVersionPrefix = "SQLSERVER"
+
 	PrivateIPType = "PRIVATE"
 	PublicIPType  = "PRIMARY"
 
@@ -58,6 +82,12 @@ const (
 	PublicIPKey  = "publicIP"
 )
 
+// Database flags that enable IAM database authentication.
+const (
+	MysqlIAMAuthenticationFlag      = "cloudsql_iam_authentication"
+	PostgresqlIAMAuthenticationFlag = "cloudsql.iam_authentication"
+)
+
 // CloudSQLInstanceParameters define the desired state of a Google CloudSQL
 // instance. Most of its fields are direct mirror of GCP DatabaseInstance object.
 // See https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1beta4/instances#DatabaseInstance
@@ -135,6 +165,14 @@ type CloudSQLInstanceParameters struct {
 	// the suspension.
 	// +optional
 	SuspensionReason []string `json:"suspensionReason,omitempty"`
+
+	// Failover declares the instance as a member of an active/standby pair
+	// of instances in different regions. The standby is a cross-region read
+	// replica of the active instance, i.e. its masterInstanceName is the
+	// name of the active instance. Setting promote on the standby promotes
+	// it to a standalone instance.
+	// +optional
+	Failover *gcpv1beta1.FailoverSpec `json:"failover,omitempty"`
 }
 
 // Settings is Cloud SQL database instance settings.
@@ -221,6 +259,16 @@ type Settings struct {
 	// +optional
 	DatabaseFlags []*DatabaseFlags `json:"databaseFlags,omitempty"`
 
+	// IAMAuthentication sets the database flag that enables or disables IAM
+	// database authentication, cloudsql_iam_authentication for MySQL and
+	// cloudsql.iam_authentication for PostgreSQL. It takes precedence over
+	// the same flag in DatabaseFlags, and is not supported for SQL Server
+	// instances. IAM users and service accounts must still be added to the
+	// instance and granted the roles/cloudsql.instanceUser role in order to
+	// log in, e.g. by a CloudSQLUser.
+	// +optional
+	IAMAuthentication *bool `json:"iamAuthentication,omitempty"`
+
 	// BackupConfiguration is the daily backup configuration for the instance.
 	// +optional
 	BackupConfiguration *BackupConfiguration `json:"backupConfiguration,omitempty"`
@@ -506,6 +554,19 @@ type CloudSQLInstanceObservation struct {
 	// properly. During update, use the most recent settingsVersion value
 	// for this instance and do not try to update this value.
 	SettingsVersion int64 `json:"settingsVersion,omitempty"`
+
+	// Failover: The observed role of the instance in its active/standby
+	// pair, if it is a member of one.
+	Failover *gcpv1beta1.FailoverStatus `json:"failover,omitempty"`
+
+	// Utilization: The number of bytes of the data disk of the instance
+	// that are used. It is recorded periodically.
+	// +optional
+	Utilization *gcpv1beta1.Utilization `json:"utilization,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // IPMapping is database instance IP Mapping.
@@ -558,16 +619,47 @@ type DatabaseInstanceFailoverReplicaStatus struct {
 	Available bool `json:"available"`
 }
 
+// ConnectionDetailsConfig configures the connection details that are
+// published for a CloudSQLInstance.
+type ConnectionDetailsConfig struct {
+	// Format of the published connection details. Default publishes the IP
+	// addresses of the instance. CloudSQLProxy additionally publishes the
+	// instance connection name and points the endpoint and port at a
+	// cloud-sql-proxy sidecar listening on localhost.
+	// +kubebuilder:validation:Enum=Default;CloudSQLProxy
+	// +kubebuilder:default=Default
+	// +optional
+	Format *string `json:"format,omitempty"`
+
+	// GenerateClientCertificate requests that a client certificate is
+	// created for the instance and published together with its private key.
+	// The certificate is replaced if its private key is missing from the
+	// connection secret, since the key cannot be retrieved again. Only
+	// honoured when Format is CloudSQLProxy.
+	// +optional
+	GenerateClientCertificate *bool `json:"generateClientCertificate,omitempty"`
+}
+
 // A CloudSQLInstanceSpec defines the desired state of a CloudSQLInstance.
 type CloudSQLInstanceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CloudSQLInstanceParameters `json:"forProvider"`
+
+	// ConnectionDetails configures the connection details published for
+	// this instance.
+	// +optional
+	ConnectionDetails *ConnectionDetailsConfig `json:"connectionDetails,omitempty"`
 }
 
 // A CloudSQLInstanceStatus represents the observed state of a CloudSQLInstance.
 type CloudSQLInstanceStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          CloudSQLInstanceObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -577,8 +669,10 @@ type CloudSQLInstanceStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
 // +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.databaseVersion"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type CloudSQLInstance struct {
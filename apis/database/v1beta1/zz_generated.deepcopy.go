@@ -22,6 +22,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	apisv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -214,6 +215,21 @@ func (in *CloudSQLInstanceObservation) DeepCopyInto(out *CloudSQLInstanceObserva
 			}
 		}
 	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(apisv1beta1.FailoverStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Utilization != nil {
+		in, out := &in.Utilization, &out.Utilization
+		*out = new(apisv1beta1.Utilization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceObservation.
@@ -280,6 +296,11 @@ func (in *CloudSQLInstanceParameters) DeepCopyInto(out *CloudSQLInstanceParamete
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(apisv1beta1.FailoverSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceParameters.
@@ -297,6 +318,11 @@ func (in *CloudSQLInstanceSpec) DeepCopyInto(out *CloudSQLInstanceSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetails != nil {
+		in, out := &in.ConnectionDetails, &out.ConnectionDetails
+		*out = new(ConnectionDetailsConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceSpec.
@@ -326,6 +352,31 @@ func (in *CloudSQLInstanceStatus) DeepCopy() *CloudSQLInstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionDetailsConfig) DeepCopyInto(out *ConnectionDetailsConfig) {
+	*out = *in
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(string)
+		**out = **in
+	}
+	if in.GenerateClientCertificate != nil {
+		in, out := &in.GenerateClientCertificate, &out.GenerateClientCertificate
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionDetailsConfig.
+func (in *ConnectionDetailsConfig) DeepCopy() *ConnectionDetailsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionDetailsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseFlags) DeepCopyInto(out *DatabaseFlags) {
 	*out = *in
@@ -598,6 +649,11 @@ func (in *Settings) DeepCopyInto(out *Settings) {
 			}
 		}
 	}
+	if in.IAMAuthentication != nil {
+		in, out := &in.IAMAuthentication, &out.IAMAuthentication
+		*out = new(bool)
+		**out = **in
+	}
 	if in.BackupConfiguration != nil {
 		in, out := &in.BackupConfiguration, &out.BackupConfiguration
 		*out = new(BackupConfiguration)
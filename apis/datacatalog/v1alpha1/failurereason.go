@@ -0,0 +1,37 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// GetFailureReason of this Taxonomy.
+func (mg *Taxonomy) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this Taxonomy.
+func (mg *Taxonomy) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this PolicyTag.
+func (mg *PolicyTag) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this PolicyTag.
+func (mg *PolicyTag) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// PolicyTagParameters define the desired state of a Data Catalog policy tag.
+// https://cloud.google.com/data-catalog/docs/reference/rest/v1/projects.locations.taxonomies.policyTags
+// The ID of the policy tag is assigned by Data Catalog and stored in the
+// `crossplane.io/external-name` annotation once it has been created.
+type PolicyTagParameters struct {
+	// Taxonomy: The resource name of the taxonomy the policy tag belongs to
+	// in the format `projects/{project}/locations/{location}/taxonomies/{id}`.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="taxonomy is immutable"
+	Taxonomy *string `json:"taxonomy,omitempty"`
+
+	// TaxonomyRef references a Taxonomy and retrieves its resource name.
+	// +optional
+	TaxonomyRef *xpv1.Reference `json:"taxonomyRef,omitempty"`
+
+	// TaxonomySelector selects a reference to a Taxonomy.
+	// +optional
+	TaxonomySelector *xpv1.Selector `json:"taxonomySelector,omitempty"`
+
+	// ParentPolicyTag: The resource name of the parent policy tag. Policy
+	// tags without a parent are at the top of the taxonomy's hierarchy.
+	// +optional
+	ParentPolicyTag *string `json:"parentPolicyTag,omitempty"`
+
+	// ParentPolicyTagRef references a PolicyTag and retrieves its resource
+	// name.
+	// +optional
+	ParentPolicyTagRef *xpv1.Reference `json:"parentPolicyTagRef,omitempty"`
+
+	// ParentPolicyTagSelector selects a reference to a PolicyTag.
+	// +optional
+	ParentPolicyTagSelector *xpv1.Selector `json:"parentPolicyTagSelector,omitempty"`
+
+	// DisplayName: User-defined name of the policy tag. It must be unique
+	// within the taxonomy, and at most 200 bytes long.
+	DisplayName string `json:"displayName"`
+
+	// Description: Description of the policy tag. At most 2000 bytes long.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// PolicyTagObservation is used to show the observed state of the PolicyTag.
+type PolicyTagObservation struct {
+	// Name: The resource name of the policy tag in the format
+	// `projects/{project}/locations/{location}/taxonomies/{taxonomy}/policyTags/{id}`.
+	// It is the value BigQuery tables and data policies refer to the
+	// policy tag by.
+	Name string `json:"name,omitempty"`
+
+	// ChildPolicyTags: Resource names of child policy tags of this policy
+	// tag.
+	ChildPolicyTags []string `json:"childPolicyTags,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// PolicyTagSpec defines the desired state of a PolicyTag.
+type PolicyTagSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyTagParameters `json:"forProvider"`
+}
+
+// PolicyTagStatus represents the observed state of a PolicyTag.
+type PolicyTagStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicyTagObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyTag is a managed resource that represents a Data Catalog policy tag,
+// which can be applied to BigQuery columns to restrict access to them.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type PolicyTag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicyTagSpec   `json:"spec"`
+	Status PolicyTagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyTagList contains a list of PolicyTag types
+type PolicyTagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyTag `json:"items"`
+}
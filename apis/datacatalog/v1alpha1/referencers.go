@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TaxonomyRRN extracts the relative resource name of a Taxonomy, which is how
+// policy tags refer to it.
+func TaxonomyRRN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		t, ok := mg.(*Taxonomy)
+		if !ok {
+			return ""
+		}
+		return t.Status.AtProvider.Name
+	}
+}
+
+// PolicyTagRRN extracts the relative resource name of a PolicyTag, which is
+// how child policy tags and BigQuery data policies refer to it.
+func PolicyTagRRN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		t, ok := mg.(*PolicyTag)
+		if !ok {
+			return ""
+		}
+		return t.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this PolicyTag
+func (mg *PolicyTag) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.taxonomy
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Taxonomy),
+		Reference:    mg.Spec.ForProvider.TaxonomyRef,
+		Selector:     mg.Spec.ForProvider.TaxonomySelector,
+		To:           reference.To{Managed: &Taxonomy{}, List: &TaxonomyList{}},
+		Extract:      TaxonomyRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.taxonomy")
+	}
+	mg.Spec.ForProvider.Taxonomy = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TaxonomyRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.parentPolicyTag
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ParentPolicyTag),
+		Reference:    mg.Spec.ForProvider.ParentPolicyTagRef,
+		Selector:     mg.Spec.ForProvider.ParentPolicyTagSelector,
+		To:           reference.To{Managed: &PolicyTag{}, List: &PolicyTagList{}},
+		Extract:      PolicyTagRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parentPolicyTag")
+	}
+	mg.Spec.ForProvider.ParentPolicyTag = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ParentPolicyTagRef = rsp.ResolvedReference
+
+	return nil
+}
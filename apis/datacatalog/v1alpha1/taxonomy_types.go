@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// PolicyTypeFineGrainedAccessControl enforces fine-grained access control on
+// the columns of BigQuery tables that are tagged with the policy tags of a
+// taxonomy.
+const PolicyTypeFineGrainedAccessControl = "FINE_GRAINED_ACCESS_CONTROL"
+
+// TaxonomyParameters define the desired state of a Data Catalog policy tag
+// taxonomy of the provider's project.
+// https://cloud.google.com/data-catalog/docs/reference/rest/v1/projects.locations.taxonomies
+// The ID of the taxonomy is assigned by Data Catalog and stored in the
+// `crossplane.io/external-name` annotation once it has been created.
+type TaxonomyParameters struct {
+	// Location: The location of the taxonomy, e.g. "us". Policy tags of a
+	// taxonomy can only be applied to BigQuery tables of the same location.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// DisplayName: User-defined name of the taxonomy. It must be unique
+	// within the project and location, and at most 200 bytes long.
+	DisplayName string `json:"displayName"`
+
+	// Description: Description of the taxonomy. At most 2000 bytes long.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// ActivatedPolicyTypes: A list of policy types that are activated for
+	// the taxonomy. Policy tags only restrict access to BigQuery columns
+	// when FINE_GRAINED_ACCESS_CONTROL is activated.
+	// +optional
+	ActivatedPolicyTypes []TaxonomyPolicyType `json:"activatedPolicyTypes,omitempty"`
+}
+
+// TaxonomyPolicyType is a policy type that can be activated for a taxonomy.
+// +kubebuilder:validation:Enum=FINE_GRAINED_ACCESS_CONTROL
+type TaxonomyPolicyType string
+
+// TaxonomyObservation is used to show the observed state of the Taxonomy.
+type TaxonomyObservation struct {
+	// Name: The resource name of the taxonomy in the format
+	// `projects/{project}/locations/{location}/taxonomies/{id}`. It is the
+	// value policy tags refer to the taxonomy by.
+	Name string `json:"name,omitempty"`
+
+	// PolicyTagCount: Number of policy tags in the taxonomy.
+	PolicyTagCount int64 `json:"policyTagCount,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// TaxonomySpec defines the desired state of a Taxonomy.
+type TaxonomySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TaxonomyParameters `json:"forProvider"`
+}
+
+// TaxonomyStatus represents the observed state of a Taxonomy.
+type TaxonomyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TaxonomyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Taxonomy is a managed resource that represents a Data Catalog policy tag
+// taxonomy, a hierarchy of policy tags used for column-level security of
+// BigQuery tables.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Taxonomy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaxonomySpec   `json:"spec"`
+	Status TaxonomyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaxonomyList contains a list of Taxonomy types
+type TaxonomyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Taxonomy `json:"items"`
+}
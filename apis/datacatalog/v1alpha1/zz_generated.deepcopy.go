@@ -0,0 +1,334 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTag) DeepCopyInto(out *PolicyTag) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTag.
+func (in *PolicyTag) DeepCopy() *PolicyTag {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyTag) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTagList) DeepCopyInto(out *PolicyTagList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PolicyTag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTagList.
+func (in *PolicyTagList) DeepCopy() *PolicyTagList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTagList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyTagList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTagObservation) DeepCopyInto(out *PolicyTagObservation) {
+	*out = *in
+	if in.ChildPolicyTags != nil {
+		in, out := &in.ChildPolicyTags, &out.ChildPolicyTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTagObservation.
+func (in *PolicyTagObservation) DeepCopy() *PolicyTagObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTagObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTagParameters) DeepCopyInto(out *PolicyTagParameters) {
+	*out = *in
+	if in.Taxonomy != nil {
+		in, out := &in.Taxonomy, &out.Taxonomy
+		*out = new(string)
+		**out = **in
+	}
+	if in.TaxonomyRef != nil {
+		in, out := &in.TaxonomyRef, &out.TaxonomyRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TaxonomySelector != nil {
+		in, out := &in.TaxonomySelector, &out.TaxonomySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentPolicyTag != nil {
+		in, out := &in.ParentPolicyTag, &out.ParentPolicyTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentPolicyTagRef != nil {
+		in, out := &in.ParentPolicyTagRef, &out.ParentPolicyTagRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentPolicyTagSelector != nil {
+		in, out := &in.ParentPolicyTagSelector, &out.ParentPolicyTagSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTagParameters.
+func (in *PolicyTagParameters) DeepCopy() *PolicyTagParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTagParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTagSpec) DeepCopyInto(out *PolicyTagSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTagSpec.
+func (in *PolicyTagSpec) DeepCopy() *PolicyTagSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTagSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTagStatus) DeepCopyInto(out *PolicyTagStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTagStatus.
+func (in *PolicyTagStatus) DeepCopy() *PolicyTagStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTagStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Taxonomy) DeepCopyInto(out *Taxonomy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Taxonomy.
+func (in *Taxonomy) DeepCopy() *Taxonomy {
+	if in == nil {
+		return nil
+	}
+	out := new(Taxonomy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Taxonomy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxonomyList) DeepCopyInto(out *TaxonomyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Taxonomy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxonomyList.
+func (in *TaxonomyList) DeepCopy() *TaxonomyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxonomyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TaxonomyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxonomyObservation) DeepCopyInto(out *TaxonomyObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxonomyObservation.
+func (in *TaxonomyObservation) DeepCopy() *TaxonomyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxonomyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxonomyParameters) DeepCopyInto(out *TaxonomyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActivatedPolicyTypes != nil {
+		in, out := &in.ActivatedPolicyTypes, &out.ActivatedPolicyTypes
+		*out = make([]TaxonomyPolicyType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxonomyParameters.
+func (in *TaxonomyParameters) DeepCopy() *TaxonomyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxonomyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxonomySpec) DeepCopyInto(out *TaxonomySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxonomySpec.
+func (in *TaxonomySpec) DeepCopy() *TaxonomySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxonomySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxonomyStatus) DeepCopyInto(out *TaxonomyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxonomyStatus.
+func (in *TaxonomyStatus) DeepCopy() *TaxonomyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxonomyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// AutoscalingPolicyParameters define the desired state of a Dataproc
+// autoscaling policy of the provider's project.
+// https://cloud.google.com/dataproc/docs/reference/rest/v1/projects.regions.autoscalingPolicies
+// The ID of the autoscaling policy is determined by the value of the
+// `crossplane.io/external-name` annotation.
+type AutoscalingPolicyParameters struct {
+	// Region: The region of the autoscaling policy. Clusters can only use
+	// autoscaling policies of their own region.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	Region string `json:"region"`
+
+	// BasicAlgorithm: The algorithm that decides when and how much to scale
+	// clusters using this policy.
+	BasicAlgorithm BasicAutoscalingAlgorithm `json:"basicAlgorithm"`
+
+	// WorkerConfig: Describes how the autoscaler will operate for primary
+	// workers.
+	WorkerConfig InstanceGroupAutoscalingPolicyConfig `json:"workerConfig"`
+
+	// SecondaryWorkerConfig: Describes how the autoscaler will operate for
+	// secondary workers.
+	// +optional
+	SecondaryWorkerConfig *InstanceGroupAutoscalingPolicyConfig `json:"secondaryWorkerConfig,omitempty"`
+
+	// Labels: The labels to associate with this autoscaling policy.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// BasicAutoscalingAlgorithm describes the basic autoscaling algorithm.
+type BasicAutoscalingAlgorithm struct {
+	// CooldownPeriod: Duration between scaling events, e.g. "120s". A
+	// scaling period starts after the update operation from the previous
+	// event has completed. Bounds: [2m, 1d]. Defaults to 2m.
+	// +optional
+	CooldownPeriod *string `json:"cooldownPeriod,omitempty"`
+
+	// YarnConfig: YARN autoscaling configuration.
+	YarnConfig BasicYarnAutoscalingConfig `json:"yarnConfig"`
+}
+
+// BasicYarnAutoscalingConfig describes the basic YARN autoscaling
+// configuration.
+type BasicYarnAutoscalingConfig struct {
+	// GracefulDecommissionTimeout: Timeout for YARN graceful decommissioning
+	// of Node Managers, e.g. "3600s". Specifies the duration to wait for jobs
+	// to complete before forcefully removing workers and potentially
+	// interrupting jobs. Only applicable to downscaling operations. Bounds:
+	// [0s, 1d].
+	GracefulDecommissionTimeout string `json:"gracefulDecommissionTimeout"`
+
+	// ScaleUpFactor: Fraction of average YARN pending memory in the last
+	// cooldown period for which to add workers, as a decimal number between
+	// 0 and 1, e.g. "0.5".
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	ScaleUpFactor string `json:"scaleUpFactor"`
+
+	// ScaleDownFactor: Fraction of average YARN pending memory in the last
+	// cooldown period for which to remove workers, as a decimal number
+	// between 0 and 1, e.g. "1.0".
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	ScaleDownFactor string `json:"scaleDownFactor"`
+
+	// ScaleUpMinWorkerFraction: Minimum scale-up threshold as a fraction of
+	// total cluster size before scaling occurs, as a decimal number between
+	// 0 and 1. Defaults to 0, i.e. any recommended change is made.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	ScaleUpMinWorkerFraction *string `json:"scaleUpMinWorkerFraction,omitempty"`
+
+	// ScaleDownMinWorkerFraction: Minimum scale-down threshold as a fraction
+	// of total cluster size before scaling occurs, as a decimal number
+	// between 0 and 1. Defaults to 0, i.e. any recommended change is made.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	ScaleDownMinWorkerFraction *string `json:"scaleDownMinWorkerFraction,omitempty"`
+}
+
+// InstanceGroupAutoscalingPolicyConfig bounds the size of an instance group
+// of a cluster using the autoscaling policy.
+type InstanceGroupAutoscalingPolicyConfig struct {
+	// MinInstances: Minimum number of instances for this group. Defaults to
+	// 2 for primary workers and 0 for secondary workers.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinInstances *int64 `json:"minInstances,omitempty"`
+
+	// MaxInstances: Maximum number of instances for this group.
+	// +kubebuilder:validation:Minimum=0
+	MaxInstances int64 `json:"maxInstances"`
+
+	// Weight: Weight for the instance group, which is used to determine the
+	// fraction of total workers in the cluster from this instance group.
+	// Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// AutoscalingPolicyObservation is used to show the observed state of the
+// AutoscalingPolicy.
+type AutoscalingPolicyObservation struct {
+	// Name: The resource name of the autoscaling policy in the format
+	// `projects/{project}/regions/{region}/autoscalingPolicies/{id}`. It is
+	// the value clusters refer to the policy by.
+	Name string `json:"name,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// AutoscalingPolicySpec defines the desired state of an AutoscalingPolicy.
+type AutoscalingPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AutoscalingPolicyParameters `json:"forProvider"`
+}
+
+// AutoscalingPolicyStatus represents the observed state of an
+// AutoscalingPolicy.
+type AutoscalingPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AutoscalingPolicyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoscalingPolicy is a managed resource that represents a Dataproc
+// autoscaling policy, which can be shared by the clusters of its region.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type AutoscalingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoscalingPolicySpec   `json:"spec"`
+	Status AutoscalingPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoscalingPolicyList contains a list of AutoscalingPolicy types
+type AutoscalingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AutoscalingPolicy `json:"items"`
+}
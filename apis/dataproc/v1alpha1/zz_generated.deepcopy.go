@@ -0,0 +1,240 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicy) DeepCopyInto(out *AutoscalingPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicy.
+func (in *AutoscalingPolicy) DeepCopy() *AutoscalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoscalingPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicyList) DeepCopyInto(out *AutoscalingPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AutoscalingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyList.
+func (in *AutoscalingPolicyList) DeepCopy() *AutoscalingPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoscalingPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicyObservation) DeepCopyInto(out *AutoscalingPolicyObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyObservation.
+func (in *AutoscalingPolicyObservation) DeepCopy() *AutoscalingPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicyParameters) DeepCopyInto(out *AutoscalingPolicyParameters) {
+	*out = *in
+	in.BasicAlgorithm.DeepCopyInto(&out.BasicAlgorithm)
+	in.WorkerConfig.DeepCopyInto(&out.WorkerConfig)
+	if in.SecondaryWorkerConfig != nil {
+		in, out := &in.SecondaryWorkerConfig, &out.SecondaryWorkerConfig
+		*out = new(InstanceGroupAutoscalingPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyParameters.
+func (in *AutoscalingPolicyParameters) DeepCopy() *AutoscalingPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicySpec) DeepCopyInto(out *AutoscalingPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicySpec.
+func (in *AutoscalingPolicySpec) DeepCopy() *AutoscalingPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicyStatus) DeepCopyInto(out *AutoscalingPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyStatus.
+func (in *AutoscalingPolicyStatus) DeepCopy() *AutoscalingPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BasicAutoscalingAlgorithm) DeepCopyInto(out *BasicAutoscalingAlgorithm) {
+	*out = *in
+	if in.CooldownPeriod != nil {
+		in, out := &in.CooldownPeriod, &out.CooldownPeriod
+		*out = new(string)
+		**out = **in
+	}
+	in.YarnConfig.DeepCopyInto(&out.YarnConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicAutoscalingAlgorithm.
+func (in *BasicAutoscalingAlgorithm) DeepCopy() *BasicAutoscalingAlgorithm {
+	if in == nil {
+		return nil
+	}
+	out := new(BasicAutoscalingAlgorithm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BasicYarnAutoscalingConfig) DeepCopyInto(out *BasicYarnAutoscalingConfig) {
+	*out = *in
+	if in.ScaleUpMinWorkerFraction != nil {
+		in, out := &in.ScaleUpMinWorkerFraction, &out.ScaleUpMinWorkerFraction
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownMinWorkerFraction != nil {
+		in, out := &in.ScaleDownMinWorkerFraction, &out.ScaleDownMinWorkerFraction
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasicYarnAutoscalingConfig.
+func (in *BasicYarnAutoscalingConfig) DeepCopy() *BasicYarnAutoscalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BasicYarnAutoscalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroupAutoscalingPolicyConfig) DeepCopyInto(out *InstanceGroupAutoscalingPolicyConfig) {
+	*out = *in
+	if in.MinInstances != nil {
+		in, out := &in.MinInstances, &out.MinInstances
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroupAutoscalingPolicyConfig.
+func (in *InstanceGroupAutoscalingPolicyConfig) DeepCopy() *InstanceGroupAutoscalingPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroupAutoscalingPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
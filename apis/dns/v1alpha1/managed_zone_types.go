@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// DNSSEC states of a ManagedZone.
+const (
+	DNSSECStateOff      = "off"
+	DNSSECStateOn       = "on"
+	DNSSECStateTransfer = "transfer"
+)
+
+// Types of a DNSSEC key.
+const (
+	DNSKeyTypeKeySigning  = "keySigning"
+	DNSKeyTypeZoneSigning = "zoneSigning"
+)
+
+// ManagedZoneParameters define the desired state of a ManagedZone.
+type ManagedZoneParameters struct {
+	// DNSName: The DNS name of this managed zone, for instance "example.com.".
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dnsName is immutable"
+	DNSName string `json:"dnsName"`
+
+	// Description: A mutable string of at most 1024 characters associated with this resource for the user's convenience.
+	// Has no effect on the managed zone's function.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Visibility: The zone's visibility: public zones are exposed to the Internet,
+	// while private zones are visible only to Virtual Private Cloud resources.
+	// Defaults to public.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=public;private
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="visibility is immutable"
+	Visibility *string `json:"visibility,omitempty"`
+
+	// PrivateVisibilityConfig: For privately visible zones, the set of Virtual Private Cloud resources that the zone is visible from.
+	// +optional
+	PrivateVisibilityConfig *ManagedZonePrivateVisibilityConfig `json:"privateVisibilityConfig,omitempty"`
+
+	// DNSSECConfig: DNSSEC configuration. Only public zones can be signed.
+	// +optional
+	DNSSECConfig *ManagedZoneDNSSECConfig `json:"dnssecConfig,omitempty"`
+
+	// Labels: User labels.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// A ManagedZonePrivateVisibilityConfig lists the networks a private zone is
+// visible from.
+type ManagedZonePrivateVisibilityConfig struct {
+	// Networks: The list of VPC networks that can see this zone.
+	Networks []PolicyNetwork `json:"networks"`
+}
+
+// A ManagedZoneDNSSECConfig configures whether and how a zone is signed.
+type ManagedZoneDNSSECConfig struct {
+	// State: Specifies whether DNSSEC is enabled, and what mode it is in. Possible values:
+	// "off" - DNSSEC is disabled; the zone is not signed.
+	// "on" - DNSSEC is enabled; the zone is signed and fully managed.
+	// "transfer" - DNSSEC is enabled, but in a "transfer" mode.
+	// +kubebuilder:validation:Enum=off;on;transfer
+	State string `json:"state"`
+
+	// NonExistence: Specifies the mechanism for authenticated denial-of-existence responses, i.e. nsec or nsec3.
+	// Can only be changed while the state is off.
+	// +optional
+	// +kubebuilder:validation:Enum=nsec;nsec3
+	NonExistence *string `json:"nonExistence,omitempty"`
+
+	// DefaultKeySpecs: Specifies parameters for generating initial keys for this zone.
+	// Can only be changed while the state is off.
+	// +optional
+	DefaultKeySpecs []DNSKeySpec `json:"defaultKeySpecs,omitempty"`
+}
+
+// A DNSKeySpec specifies the parameters used to generate a DNSSEC key.
+type DNSKeySpec struct {
+	// KeyType: Specifies whether this is a key signing key (keySigning) or a zone signing key (zoneSigning).
+	// +kubebuilder:validation:Enum=keySigning;zoneSigning
+	KeyType string `json:"keyType"`
+
+	// Algorithm: String mnemonic specifying the DNSSEC algorithm of this key.
+	// +kubebuilder:validation:Enum=rsasha1;rsasha256;rsasha512;ecdsap256sha256;ecdsap384sha384
+	Algorithm string `json:"algorithm"`
+
+	// KeyLength: Length of the keys in bits.
+	KeyLength int64 `json:"keyLength"`
+}
+
+// A DNSKeyObservation is the observed state of a DNSSEC key of a signed zone.
+type DNSKeyObservation struct {
+	// ID: Unique identifier of the key.
+	ID string `json:"id"`
+
+	// Type: Either keySigning or zoneSigning.
+	Type string `json:"type"`
+
+	// Algorithm: The DNSSEC algorithm of this key.
+	Algorithm string `json:"algorithm"`
+
+	// KeyTag: The key tag used in a parent zone's DS record to point at this key.
+	KeyTag int64 `json:"keyTag"`
+
+	// IsActive: Active keys are used to sign subsequent changes to the zone.
+	IsActive bool `json:"isActive"`
+
+	// DSRecords: The data of the DS records that point at this key, one per digest type,
+	// e.g. "2371 13 2 1F987CC6583E92DF0890718C42". Only set for key signing keys.
+	// +optional
+	DSRecords []string `json:"dsRecords,omitempty"`
+}
+
+// ManagedZoneObservation is used to show the observed state of the ManagedZone.
+type ManagedZoneObservation struct {
+	// ID: Unique identifier for the resource; defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// CreationTime: The time that this resource was created on the server.
+	CreationTime string `json:"creationTime,omitempty"`
+
+	// NameServers: Delegate your managed zone to these virtual name servers.
+	NameServers []string `json:"nameServers,omitempty"`
+
+	// DNSKeys: The DNSSEC keys of the zone, if it is signed.
+	DNSKeys []DNSKeyObservation `json:"dnsKeys,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// ManagedZoneSpec defines the desired state of a ManagedZone.
+type ManagedZoneSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ManagedZoneParameters `json:"forProvider"`
+}
+
+// ManagedZoneStatus represents the observed state of a ManagedZone.
+type ManagedZoneStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ManagedZoneObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ManagedZone is a container for DNS records of the same DNS name suffix.
+// When DNSSEC is enabled, the DS records of its active key signing keys are
+// published as connection details, so that delegation from the parent zone
+// can be automated.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DNS NAME",type="string",JSONPath=".spec.forProvider.dnsName"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ManagedZone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedZoneSpec   `json:"spec"`
+	Status ManagedZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedZoneList contains a list of ManagedZone
+type ManagedZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedZone `json:"items"`
+}
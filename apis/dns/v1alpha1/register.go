@@ -53,7 +53,16 @@ var (
 	PolicyGroupVersionKind = SchemeGroupVersion.WithKind(PolicyKind)
 )
 
+// ManagedZone type metadata.
+var (
+	ManagedZoneKind             = reflect.TypeOf(ManagedZone{}).Name()
+	ManagedZoneGroupKind        = schema.GroupKind{Group: Group, Kind: ManagedZoneKind}.String()
+	ManagedZoneKindAPIVersion   = ManagedZoneKind + "." + SchemeGroupVersion.String()
+	ManagedZoneGroupVersionKind = SchemeGroupVersion.WithKind(ManagedZoneKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ResourceRecordSet{}, &ResourceRecordSetList{},
-		&Policy{}, &PolicyList{})
+		&Policy{}, &PolicyList{},
+		&ManagedZone{}, &ManagedZoneList{})
 }
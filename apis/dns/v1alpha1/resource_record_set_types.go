@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // ResourceRecordSetParameters define the desired state of a ResourceRecordSet
@@ -49,7 +51,11 @@ type ResourceRecordSetParameters struct {
 }
 
 // ResourceRecordSetObservation is used to show the observed state of the ResourceRecordSet
-type ResourceRecordSetObservation struct{}
+type ResourceRecordSetObservation struct {
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
 
 // ResourceRecordSetSpec defines the desired state of a ResourceRecordSet.
 type ResourceRecordSetSpec struct {
@@ -61,6 +67,11 @@ type ResourceRecordSetSpec struct {
 type ResourceRecordSetStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          ResourceRecordSetObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -69,7 +80,10 @@ type ResourceRecordSetStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="DNS NAME",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp},shortName=rrs
 type ResourceRecordSet struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -22,9 +22,257 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSKeyObservation) DeepCopyInto(out *DNSKeyObservation) {
+	*out = *in
+	if in.DSRecords != nil {
+		in, out := &in.DSRecords, &out.DSRecords
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSKeyObservation.
+func (in *DNSKeyObservation) DeepCopy() *DNSKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSKeySpec) DeepCopyInto(out *DNSKeySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSKeySpec.
+func (in *DNSKeySpec) DeepCopy() *DNSKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZone) DeepCopyInto(out *ManagedZone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZone.
+func (in *ManagedZone) DeepCopy() *ManagedZone {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedZone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneDNSSECConfig) DeepCopyInto(out *ManagedZoneDNSSECConfig) {
+	*out = *in
+	if in.NonExistence != nil {
+		in, out := &in.NonExistence, &out.NonExistence
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultKeySpecs != nil {
+		in, out := &in.DefaultKeySpecs, &out.DefaultKeySpecs
+		*out = make([]DNSKeySpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneDNSSECConfig.
+func (in *ManagedZoneDNSSECConfig) DeepCopy() *ManagedZoneDNSSECConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneDNSSECConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneList) DeepCopyInto(out *ManagedZoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneList.
+func (in *ManagedZoneList) DeepCopy() *ManagedZoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedZoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneObservation) DeepCopyInto(out *ManagedZoneObservation) {
+	*out = *in
+	if in.NameServers != nil {
+		in, out := &in.NameServers, &out.NameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSKeys != nil {
+		in, out := &in.DNSKeys, &out.DNSKeys
+		*out = make([]DNSKeyObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneObservation.
+func (in *ManagedZoneObservation) DeepCopy() *ManagedZoneObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneParameters) DeepCopyInto(out *ManagedZoneParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateVisibilityConfig != nil {
+		in, out := &in.PrivateVisibilityConfig, &out.PrivateVisibilityConfig
+		*out = new(ManagedZonePrivateVisibilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSSECConfig != nil {
+		in, out := &in.DNSSECConfig, &out.DNSSECConfig
+		*out = new(ManagedZoneDNSSECConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneParameters.
+func (in *ManagedZoneParameters) DeepCopy() *ManagedZoneParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZonePrivateVisibilityConfig) DeepCopyInto(out *ManagedZonePrivateVisibilityConfig) {
+	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]PolicyNetwork, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZonePrivateVisibilityConfig.
+func (in *ManagedZonePrivateVisibilityConfig) DeepCopy() *ManagedZonePrivateVisibilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZonePrivateVisibilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneSpec) DeepCopyInto(out *ManagedZoneSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneSpec.
+func (in *ManagedZoneSpec) DeepCopy() *ManagedZoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneStatus) DeepCopyInto(out *ManagedZoneStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneStatus.
+func (in *ManagedZoneStatus) DeepCopy() *ManagedZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Policy) DeepCopyInto(out *Policy) {
 	*out = *in
@@ -149,6 +397,11 @@ func (in *PolicyObservation) DeepCopyInto(out *PolicyObservation) {
 		*out = new(uint64)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyObservation.
@@ -296,6 +549,11 @@ func (in *ResourceRecordSetList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRecordSetObservation) DeepCopyInto(out *ResourceRecordSetObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetObservation.
@@ -354,7 +612,7 @@ func (in *ResourceRecordSetSpec) DeepCopy() *ResourceRecordSetSpec {
 func (in *ResourceRecordSetStatus) DeepCopyInto(out *ResourceRecordSetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetStatus.
@@ -20,20 +20,31 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	apigeev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	bigqueryv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	cachev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/cache/v1alpha1"
 	cachev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/cache/v1beta1"
+	cloudassetv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/cloudasset/v1alpha1"
+	cloudresourcemanagerv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
 	computev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
 	computev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	containerv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
 	containerv1beta2 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	databasev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
 	databasev1beta1 "github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	datacatalogv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/datacatalog/v1alpha1"
+	dataprocv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/dataproc/v1alpha1"
 	dnsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/dns/v1alpha1"
 	iam "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	idsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
 	kms "github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
+	networkservicesv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
 	pubsub "github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
 	registry "github.com/crossplane-contrib/provider-gcp/apis/registry/v1alpha1"
 	servicenetworkingv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/servicenetworking/v1beta1"
 	storagev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
 	storagev1alpha3 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+	transferv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/transfer/v1alpha1"
 	gcpv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcpv1alpha3 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha3"
 	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
@@ -45,18 +56,29 @@ func init() {
 		gcpv1alpha1.SchemeBuilder.AddToScheme,
 		gcpv1alpha3.SchemeBuilder.AddToScheme,
 		gcpv1beta1.SchemeBuilder.AddToScheme,
+		apigeev1alpha1.SchemeBuilder.AddToScheme,
+		bigqueryv1alpha1.SchemeBuilder.AddToScheme,
+		cachev1alpha1.SchemeBuilder.AddToScheme,
 		cachev1beta1.SchemeBuilder.AddToScheme,
+		cloudassetv1alpha1.SchemeBuilder.AddToScheme,
+		cloudresourcemanagerv1alpha1.SchemeBuilder.AddToScheme,
 		computev1alpha1.SchemeBuilder.AddToScheme,
 		computev1beta1.SchemeBuilder.AddToScheme,
 		containerv1beta2.SchemeBuilder.AddToScheme,
 		containerv1beta1.SchemeBuilder.AddToScheme,
+		databasev1alpha1.SchemeBuilder.AddToScheme,
 		databasev1beta1.SchemeBuilder.AddToScheme,
+		datacatalogv1alpha1.SchemeBuilder.AddToScheme,
+		dataprocv1alpha1.SchemeBuilder.AddToScheme,
 		iam.SchemeBuilder.AddToScheme,
+		idsv1alpha1.SchemeBuilder.AddToScheme,
 		kms.SchemeBuilder.AddToScheme,
+		networkservicesv1alpha1.SchemeBuilder.AddToScheme,
 		pubsub.SchemeBuilder.AddToScheme,
 		servicenetworkingv1beta1.SchemeBuilder.AddToScheme,
 		storagev1alpha1.SchemeBuilder.AddToScheme,
 		storagev1alpha3.SchemeBuilder.AddToScheme,
+		transferv1alpha1.SchemeBuilder.AddToScheme,
 		dnsv1alpha1.SchemeBuilder.AddToScheme,
 		registry.SchemeBuilder.AddToScheme,
 	)
@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Custom role launch stages.
+const (
+	CustomRoleStageAlpha      = "ALPHA"
+	CustomRoleStageBeta       = "BETA"
+	CustomRoleStageGA         = "GA"
+	CustomRoleStageDeprecated = "DEPRECATED"
+	CustomRoleStageDisabled   = "DISABLED"
+	CustomRoleStageEAP        = "EAP"
+)
+
+// CustomRoleParameters defines parameters for a desired IAM custom role
+// https://cloud.google.com/iam/docs/reference/rest/v1/projects.roles
+// The role ID is determined by the value of the `crossplane.io/external-name`
+// annotation. Role IDs may only contain letters, digits, underscores and
+// periods, so the annotation must be set whenever metadata.name contains a
+// hyphen.
+// +kubebuilder:validation:XValidation:rule="!has(self.scope) || (self.scope == 'Organization') == has(self.organization)",message="organization must be set if and only if scope is Organization"
+type CustomRoleParameters struct {
+	// Scope is the level of the resource hierarchy the role is created at,
+	// i.e. Project or Organization. Defaults to Organization if organization
+	// is set, and to Project otherwise. Roles of a project can only be bound
+	// in the IAM policies of that project.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=Project;Organization
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="scope is immutable"
+	Scope *string `json:"scope,omitempty"`
+
+	// Organization is the numeric ID of the organization the role is created
+	// in. The role is created in the project of the ProviderConfig if it is
+	// not set.
+	// +optional
+	// +immutable
+	Organization *string `json:"organization,omitempty"`
+
+	// Title is a human-readable title for the role, typically limited to
+	// 100 UTF-8 bytes.
+	// +optional
+	Title *string `json:"title,omitempty"`
+
+	// Description is a human-readable description for the role.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Permissions are the names of the permissions this role grants when
+	// bound in an IAM policy, e.g. storage.buckets.get.
+	// +kubebuilder:validation:MinItems=1
+	Permissions []string `json:"permissions"`
+
+	// Stage is the launch stage of the role. A role is typically promoted
+	// from ALPHA to BETA to GA and retired through DEPRECATED, which warns
+	// those who bind it, and DISABLED, which makes it grant no permissions
+	// while keeping its bindings. The stage may be changed in place in any
+	// direction.
+	// +optional
+	// +kubebuilder:validation:Enum=ALPHA;BETA;GA;DEPRECATED;DISABLED;EAP
+	Stage *string `json:"stage,omitempty"`
+
+	// DisableOnDelete moves the role to the DISABLED stage instead of
+	// deleting it when this CustomRole is deleted with the Delete deletion
+	// policy. A disabled role keeps its ID and the bindings that refer to it
+	// but grants no permissions, and unlike a deleted role it is never
+	// purged. The Orphan deletion policy leaves the role untouched either
+	// way.
+	// +optional
+	DisableOnDelete *bool `json:"disableOnDelete,omitempty"`
+}
+
+// CustomRoleObservation is used to show the observed state of the
+// CustomRole resource on GCP.
+type CustomRoleObservation struct {
+	// Name is the relative resource name of the role, i.e.
+	// projects/{project}/roles/{role} or organizations/{organization}/roles/{role}.
+	Name string `json:"name,omitempty"`
+
+	// Stage is the current launch stage of the role.
+	Stage string `json:"stage,omitempty"`
+
+	// Deleted is true if the role was deleted but may still be undeleted.
+	// A deleted role is kept for 7 days, during which recreating this
+	// CustomRole undeletes it.
+	Deleted bool `json:"deleted,omitempty"`
+
+	// Etag of the role as last observed.
+	Etag string `json:"etag,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// CustomRoleSpec defines the desired state of a CustomRole.
+type CustomRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CustomRoleParameters `json:"forProvider"`
+}
+
+// CustomRoleStatus represents the observed state of a CustomRole.
+type CustomRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CustomRoleObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomRole is a managed resource that represents a Google IAM custom role
+// in a project or an organization.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TITLE",type="string",JSONPath=".spec.forProvider.title"
+// +kubebuilder:printcolumn:name="STAGE",type="string",JSONPath=".status.atProvider.stage"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CustomRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomRoleSpec   `json:"spec"`
+	Status CustomRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomRoleList contains a list of CustomRole types
+type CustomRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomRole `json:"items"`
+}
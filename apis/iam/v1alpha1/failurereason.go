@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// GetFailureReason of this CustomRole.
+func (mg *CustomRole) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this CustomRole.
+func (mg *CustomRole) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this ServiceAccount.
+func (mg *ServiceAccount) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this ServiceAccount.
+func (mg *ServiceAccount) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this ServiceAccountKey.
+func (mg *ServiceAccountKey) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this ServiceAccountKey.
+func (mg *ServiceAccountKey) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this ServiceAccountPolicy.
+func (mg *ServiceAccountPolicy) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this ServiceAccountPolicy.
+func (mg *ServiceAccountPolicy) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this ServiceAccountPolicyMember.
+func (mg *ServiceAccountPolicyMember) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this ServiceAccountPolicyMember.
+func (mg *ServiceAccountPolicyMember) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this ServiceAccountToken.
+func (mg *ServiceAccountToken) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this ServiceAccountToken.
+func (mg *ServiceAccountToken) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
@@ -295,6 +295,9 @@ type Binding struct {
 
 	// Role: Role that is assigned to `members`.
 	// For example, `roles/viewer`, `roles/editor`, or `roles/owner`.
+	// Custom roles are named `projects/{project}/roles/{role}` or
+	// `organizations/{organization}/roles/{role}`.
+	// +kubebuilder:validation:Pattern=`^(roles|(projects|organizations)/[^/]+/roles)/[a-zA-Z0-9_.]+$`
 	Role string `json:"role"`
 }
 
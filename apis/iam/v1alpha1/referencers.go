@@ -24,8 +24,11 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // ServiceAccountReferer defines a reference to a ServiceAccount either via its RRN,
@@ -79,6 +82,58 @@ func ServiceAccountMemberName() reference.ExtractValueFn {
 	}
 }
 
+// MergeMembers returns the supplied members followed by the resolved ones
+// that are not already among them.
+func MergeMembers(members, resolved []string) []string {
+	out := members
+	for _, m := range resolved {
+		found := false
+		for _, e := range out {
+			if e == m {
+				found = true
+				break
+			}
+		}
+		if !found {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// ServiceAccountEmail returns the email address of a given ServiceAccount
+// Object.
+func ServiceAccountEmail() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		n, ok := mg.(*ServiceAccount)
+		if !ok {
+			return ""
+		}
+		return n.Status.AtProvider.Email
+	}
+}
+
+// CustomRoleName extracts the relative resource name of a CustomRole, i.e.
+// projects/{project}/roles/{role} or organizations/{organization}/roles/{role},
+// so that it can be bound in IAM policies. The name of a role of a project is
+// only known once the role was observed, because the project is the one of its
+// ProviderConfig.
+func CustomRoleName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*CustomRole)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.Name != "" {
+			return r.Status.AtProvider.Name
+		}
+		if org := r.Spec.ForProvider.Organization; org != nil && *org != "" {
+			return gcpv1beta1.ScopedName(gcpv1beta1.ScopeOrganization, *org) + "/roles/" + meta.GetExternalName(r)
+		}
+		return ""
+	}
+}
+
 func (sar *ServiceAccountReferer) resolveReferences(ctx context.Context, resolver *reference.APIResolver) error {
 	// Resolve spec.forProvider.serviceAccount
 	rsp, err := resolver.Resolve(ctx, reference.ResolutionRequest{
@@ -104,6 +159,11 @@ func (in *ServiceAccountKey) ResolveReferences(ctx context.Context, c client.Rea
 	return errors.Wrap(in.Spec.ForProvider.resolveReferences(ctx, reference.NewAPIResolver(c, in)), "spec.forProvider.serviceAccount")
 }
 
+// ResolveReferences of this ServiceAccountToken
+func (in *ServiceAccountToken) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return errors.Wrap(in.Spec.ForProvider.resolveReferences(ctx, reference.NewAPIResolver(c, in)), "spec.forProvider.serviceAccount")
+}
+
 // ResolveReferences of this ServiceAccountPolicy
 func (in *ServiceAccountPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, in)
@@ -130,3 +190,43 @@ func (in *ServiceAccountPolicy) ResolveReferences(ctx context.Context, c client.
 
 	return nil
 }
+
+// ResolveReferences of this ServiceAccountPolicyMember
+func (in *ServiceAccountPolicyMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	if err := in.Spec.ForProvider.resolveReferences(ctx, r); err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceAccount")
+	}
+
+	// Resolve spec.forProvider.member
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Member),
+		Reference:    in.Spec.ForProvider.ServiceAccountMemberRef,
+		Selector:     in.Spec.ForProvider.ServiceAccountMemberSelector,
+		To:           reference.To{Managed: &ServiceAccount{}, List: &ServiceAccountList{}},
+		Extract:      ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.member")
+	}
+	in.Spec.ForProvider.Member = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceAccountMemberRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.members. Members resolved from references are
+	// added to the literal ones, so the references are resolved even when
+	// members are already set.
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		References: in.Spec.ForProvider.ServiceAccountMembersRefs,
+		Selector:   in.Spec.ForProvider.ServiceAccountMembersSelector,
+		To:         reference.To{Managed: &ServiceAccount{}, List: &ServiceAccountList{}},
+		Extract:    ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.members")
+	}
+	in.Spec.ForProvider.Members = MergeMembers(in.Spec.ForProvider.Members, mrsp.ResolvedValues)
+	in.Spec.ForProvider.ServiceAccountMembersRefs = mrsp.ResolvedReferences
+
+	return nil
+}
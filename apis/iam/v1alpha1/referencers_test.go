@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
@@ -37,6 +38,8 @@ const (
 	rrnTestServiceAccount = "projects/key-test-project/serviceAccounts/" + testEmail
 )
 
+var testOrganization = "123456789012"
+
 func TestServiceAccountMemberName(t *testing.T) {
 	testCases := map[string]struct {
 		mg   resource.Managed
@@ -78,6 +81,45 @@ func TestServiceAccountMemberName(t *testing.T) {
 	}
 }
 
+func TestCustomRoleName(t *testing.T) {
+	testCases := map[string]struct {
+		mg   resource.Managed
+		want string
+	}{
+		"NotCustomRole": {
+			mg:   &ServiceAccount{},
+			want: "",
+		},
+		"Observed": {
+			mg: &CustomRole{
+				Status: CustomRoleStatus{AtProvider: CustomRoleObservation{Name: "projects/test-project/roles/reader"}},
+			},
+			want: "projects/test-project/roles/reader",
+		},
+		"OrganizationNotObserved": {
+			mg: &CustomRole{
+				ObjectMeta: v1.ObjectMeta{Name: "reader", Annotations: map[string]string{meta.AnnotationKeyExternalName: "reader"}},
+				Spec:       CustomRoleSpec{ForProvider: CustomRoleParameters{Organization: &testOrganization}},
+			},
+			want: "organizations/123456789012/roles/reader",
+		},
+		"ProjectNotObserved": {
+			mg: &CustomRole{
+				ObjectMeta: v1.ObjectMeta{Name: "reader"},
+			},
+			want: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, CustomRoleName()(tc.mg)); diff != "" {
+				t.Errorf("CustomRoleName(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestServiceAccountKey_ResolveReferences(t *testing.T) {
 	type args struct {
 		saKey *ServiceAccountKey
@@ -170,6 +212,91 @@ func TestServiceAccountKey_ResolveReferences(t *testing.T) {
 	}
 }
 
+func TestServiceAccountPolicyMember_ResolveReferences(t *testing.T) {
+	testSaRrn := rrnTestServiceAccount
+	testSaMember := "serviceAccount:" + testEmail
+	scheme, err := SchemeBuilder.Build()
+	if err != nil {
+		t.Fatalf("Failed to build scheme: %s", err)
+	}
+	testClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(&ServiceAccount{
+		ObjectMeta: v1.ObjectMeta{
+			Name:   nameTestSa,
+			Labels: map[string]string{labelTest: valueTest},
+		},
+		Status: ServiceAccountStatus{
+			AtProvider: ServiceAccountObservation{Name: rrnTestServiceAccount, Email: testEmail},
+		},
+	}).Build()
+
+	testCases := map[string]struct {
+		params          ServiceAccountPolicyMemberParameters
+		expectedMember  *string
+		expectedMembers []string
+	}{
+		"NoOpMembers": {
+			params: ServiceAccountPolicyMemberParameters{
+				Member:  &testSaRrn,
+				Members: []string{"group:test@example.com"},
+			},
+			expectedMember:  &testSaRrn,
+			expectedMembers: []string{"group:test@example.com"},
+		},
+		"ResolveMembersByName": {
+			params: ServiceAccountPolicyMemberParameters{
+				ServiceAccountMemberRef:   &xpv1.Reference{Name: nameTestSa},
+				ServiceAccountMembersRefs: []xpv1.Reference{{Name: nameTestSa}},
+			},
+			expectedMember:  &testSaMember,
+			expectedMembers: []string{testSaMember},
+		},
+		"ResolveMembersWithLiteralMembers": {
+			params: ServiceAccountPolicyMemberParameters{
+				Member:                    &testSaRrn,
+				Members:                   []string{"group:test@example.com"},
+				ServiceAccountMembersRefs: []xpv1.Reference{{Name: nameTestSa}},
+			},
+			expectedMember:  &testSaRrn,
+			expectedMembers: []string{"group:test@example.com", testSaMember},
+		},
+		"ResolveMembersAlreadyResolved": {
+			params: ServiceAccountPolicyMemberParameters{
+				Member:                    &testSaRrn,
+				Members:                   []string{"group:test@example.com", testSaMember},
+				ServiceAccountMembersRefs: []xpv1.Reference{{Name: nameTestSa}},
+			},
+			expectedMember:  &testSaRrn,
+			expectedMembers: []string{"group:test@example.com", testSaMember},
+		},
+		"ResolveMembersBySelector": {
+			params: ServiceAccountPolicyMemberParameters{
+				Member: &testSaRrn,
+				ServiceAccountMembersSelector: &xpv1.Selector{
+					MatchLabels: map[string]string{labelTest: valueTest},
+				},
+			},
+			expectedMember:  &testSaRrn,
+			expectedMembers: []string{testSaMember},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.params.ServiceAccount = &testSaRrn
+			mg := &ServiceAccountPolicyMember{Spec: ServiceAccountPolicyMemberSpec{ForProvider: tc.params}}
+			if err := mg.ResolveReferences(context.Background(), testClient); err != nil {
+				t.Fatalf("Unexpected %s error: %s", name, err)
+			}
+			if diff := cmp.Diff(tc.expectedMember, mg.Spec.ForProvider.Member); diff != "" {
+				t.Fatalf("Expected %s member differs from actual member, -expected +got: %s", name, diff)
+			}
+			if diff := cmp.Diff(tc.expectedMembers, mg.Spec.ForProvider.Members); diff != "" {
+				t.Fatalf("Expected %s members differ from actual members, -expected +got: %s", name, diff)
+			}
+		})
+	}
+}
+
 func TestServiceAccountRRN(t *testing.T) {
 	testCases := map[string]struct {
 		mg   resource.Managed
@@ -37,6 +37,14 @@ var (
 	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
 )
 
+// CustomRole type metadata.
+var (
+	CustomRoleKind             = reflect.TypeOf(CustomRole{}).Name()
+	CustomRoleGroupKind        = schema.GroupKind{Group: Group, Kind: CustomRoleKind}.String()
+	CustomRoleKindAPIVersion   = CustomRoleKind + "." + SchemeGroupVersion.String()
+	CustomRoleGroupVersionKind = SchemeGroupVersion.WithKind(CustomRoleKind)
+)
+
 // ServiceAccount type metadata.
 var (
 	ServiceAccountKind             = reflect.TypeOf(ServiceAccount{}).Name()
@@ -61,8 +69,27 @@ var (
 	ServiceAccountPolicyGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountPolicyKind)
 )
 
+// ServiceAccountPolicyMember type metadata.
+var (
+	ServiceAccountPolicyMemberKind             = reflect.TypeOf(ServiceAccountPolicyMember{}).Name()
+	ServiceAccountPolicyMemberGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceAccountPolicyMemberKind}.String()
+	ServiceAccountPolicyMemberKindAPIVersion   = ServiceAccountPolicyMemberKind + "." + SchemeGroupVersion.String()
+	ServiceAccountPolicyMemberGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountPolicyMemberKind)
+)
+
+// ServiceAccountToken type metadata.
+var (
+	ServiceAccountTokenKind             = reflect.TypeOf(ServiceAccountToken{}).Name()
+	ServiceAccountTokenGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceAccountTokenKind}.String()
+	ServiceAccountTokenKindAPIVersion   = ServiceAccountTokenKind + "." + SchemeGroupVersion.String()
+	ServiceAccountTokenGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountTokenKind)
+)
+
 func init() {
-	SchemeBuilder.Register(&ServiceAccount{}, &ServiceAccountList{},
+	SchemeBuilder.Register(&CustomRole{}, &CustomRoleList{},
+		&ServiceAccount{}, &ServiceAccountList{},
 		&ServiceAccountKey{}, &ServiceAccountKeyList{},
-		&ServiceAccountPolicy{}, &ServiceAccountPolicyList{})
+		&ServiceAccountPolicy{}, &ServiceAccountPolicyList{},
+		&ServiceAccountPolicyMember{}, &ServiceAccountPolicyMemberList{},
+		&ServiceAccountToken{}, &ServiceAccountTokenList{})
 }
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // ServiceAccountParameters defines parameters for a desired IAM ServiceAccount
@@ -69,6 +71,10 @@ type ServiceAccountObservation struct {
 	// Disabled is a bool indicating if the service account is disabled.
 	// The field is currently in alpha phase.
 	Disabled bool `json:"disabled,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // ServiceAccountSpec defines the desired state of a
@@ -83,6 +89,11 @@ type ServiceAccountSpec struct {
 type ServiceAccountStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          ServiceAccountObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -91,10 +102,12 @@ type ServiceAccountStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="DISPLAYNAME",type="string",JSONPath=".spec.forProvider.displayName"
 // +kubebuilder:printcolumn:name="EMAIL",type="string",JSONPath=".status.atProvider.email"
 // +kubebuilder:printcolumn:name="DISABLED",type="boolean",JSONPath=".status.atProvider.disabled"
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type ServiceAccount struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // ServiceAccountKeyParameters defines parameters for a desired IAM ServiceAccountKey
@@ -44,8 +46,23 @@ type ServiceAccountKeyParameters struct {
 	//   "TYPE_GOOGLE_CREDENTIALS_FILE" - Google Credentials File format.
 	// +optional
 	// +immutable
+	// +kubebuilder:validation:Enum=TYPE_UNSPECIFIED;TYPE_PKCS12_FILE;TYPE_GOOGLE_CREDENTIALS_FILE
 	PrivateKeyType *string `json:"privateKeyType,omitempty"`
 
+	// PrivateKeySecretKey is the key of the connection secret the private
+	// key is written to, i.e. the credentials file or PKCS #12 file, e.g.
+	// credentials.json or key.p12. Defaults to privateKey.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	PrivateKeySecretKey *string `json:"privateKeySecretKey,omitempty"`
+
+	// PrivateKeyOnly omits everything but the private key from the
+	// connection secret, so that mounting the secret as a volume yields
+	// only the key file.
+	// +optional
+	PrivateKeyOnly bool `json:"privateKeyOnly,omitempty"`
+
 	// PublicKeyType is an optional specification of the output format for the associated public key.
 	// The default value is TYPE_RAW_PUBLIC_KEY.
 	// Possible values:
@@ -56,6 +73,21 @@ type ServiceAccountKeyParameters struct {
 	// +kubebuilder:default=TYPE_RAW_PUBLIC_KEY
 	PublicKeyType *string `json:"publicKeyType,omitempty"`
 
+	// RotationPeriod is the age after which the key is rotated: a new key is
+	// created, the connection secret is updated with it and the previous key
+	// is deleted once RotationGracePeriod has passed. A key that expires is
+	// rotated RotationGracePeriod before it expires at the latest. The key is
+	// not rotated while the previous key is in its grace period, nor at all
+	// when RotationPeriod is unset.
+	// +optional
+	RotationPeriod *metav1.Duration `json:"rotationPeriod,omitempty"`
+
+	// RotationGracePeriod is how long the previous key is kept after a
+	// rotation, giving consumers of the connection secret time to pick up
+	// the new key. Defaults to 24h.
+	// +optional
+	RotationGracePeriod *metav1.Duration `json:"rotationGracePeriod,omitempty"`
+
 	// ServiceAccountRef is a reference to a ServiceAccount which this policy is associated with
 	ServiceAccountReferer `json:",inline"`
 }
@@ -99,6 +131,17 @@ type ServiceAccountKeyObservation struct {
 	//   "USER_MANAGED" - User-managed key (managed and rotated by the user).
 	//   "SYSTEM_MANAGED" - System-managed key (managed and rotated by Google).
 	KeyType string `json:"keyType,omitempty"`
+
+	// PreviousKeyID is the key id of the key that was replaced by the last
+	// rotation, while it is kept for its grace period.
+	PreviousKeyID string `json:"previousKeyId,omitempty"`
+
+	// PreviousKeyDeleteTime is the time the previous key is deleted at.
+	PreviousKeyDeleteTime *metav1.Time `json:"previousKeyDeleteTime,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // ServiceAccountKeySpec defines the desired state of a ServiceAccountKey.
@@ -111,6 +154,11 @@ type ServiceAccountKeySpec struct {
 type ServiceAccountKeyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          ServiceAccountKeyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -119,9 +167,12 @@ type ServiceAccountKeyStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="KEY_ID",type="string",JSONPath=".status.atProvider.keyId"
 // +kubebuilder:printcolumn:name="CREATED_AT",type="string",JSONPath=".status.atProvider.validAfterTime"
 // +kubebuilder:printcolumn:name="EXPIRES_AT",type="boolean",JSONPath=".status.atProvider.validBeforeTime"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type ServiceAccountKey struct {
 	metav1.TypeMeta   `json:",inline"`
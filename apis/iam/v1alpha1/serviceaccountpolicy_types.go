@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // ServiceAccountPolicyParameters defines parameters for a desired IAM ServiceAccountPolicy
@@ -30,6 +32,13 @@ type ServiceAccountPolicyParameters struct {
 	// Policy: An Identity and Access Management (IAM) policy, which
 	// specifies access controls for Google Cloud resources.
 	Policy Policy `json:"policy"`
+
+	// AllowPolicyTakeover allows this ServiceAccountPolicy to replace an
+	// existing policy of the service account that has bindings it does not
+	// declare. Unless it is set, such a ServiceAccountPolicy is held in a
+	// non-ready state rather than removing those bindings.
+	// +optional
+	AllowPolicyTakeover bool `json:"allowPolicyTakeover,omitempty"`
 }
 
 // ServiceAccountPolicySpec defines the desired state of a
@@ -39,10 +48,29 @@ type ServiceAccountPolicySpec struct {
 	ForProvider       ServiceAccountPolicyParameters `json:"forProvider"`
 }
 
+// ServiceAccountPolicyObservation represents the observed state of a
+// ServiceAccountPolicy.
+type ServiceAccountPolicyObservation struct {
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// PolicyOwned is true once this ServiceAccountPolicy has set the policy
+	// of the service account or found it up to date. An owned policy is kept
+	// in sync without requiring allowPolicyTakeover.
+	PolicyOwned bool `json:"policyOwned,omitempty"`
+}
+
 // ServiceAccountPolicyStatus represents the observed state of a
 // ServiceAccountPolicy.
 type ServiceAccountPolicyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceAccountPolicyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -51,7 +79,10 @@ type ServiceAccountPolicyStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SERVICE-ACCOUNT",type="string",JSONPath=".spec.forProvider.serviceAccount"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type ServiceAccountPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// ServiceAccountPolicyMemberParameters defines parameters for a desired
+// membership of a ServiceAccount IAM policy, e.g. a
+// `roles/iam.workloadIdentityUser` binding that lets a Kubernetes service
+// account impersonate the ServiceAccount through GKE Workload Identity.
+type ServiceAccountPolicyMemberParameters struct {
+	// ServiceAccountRef is a reference to a ServiceAccount whose IAM policy
+	// the member is bound in.
+	ServiceAccountReferer `json:",inline"`
+
+	// Role: Role that is assigned to `members`.
+	// For example, `roles/iam.workloadIdentityUser` or
+	// `roles/iam.serviceAccountUser`.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="role is immutable"
+	Role string `json:"role"`
+
+	// Condition: The IAM condition under which the role is bound to the
+	// member. The member is bound in the binding of the role that has the
+	// same condition, so the same role may be bound to the member once per
+	// condition.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="condition is immutable"
+	Condition *Expr `json:"condition,omitempty"`
+
+	// Member: Specifies the identity requesting access for a Cloud
+	// Platform resource, for example
+	// `serviceAccount:my-project.svc.id.goog[my-namespace/my-ksa]` for a
+	// Kubernetes service account using Workload Identity.
+	// +optional
+	// +immutable
+	Member *string `json:"member,omitempty"`
+
+	// Members: Specifies a list of identities that are granted the role in
+	// addition to Member. Members take the same values as Member.
+	// +optional
+	// +immutable
+	Members []string `json:"members,omitempty"`
+
+	// ServiceAccountMemberRef is reference to ServiceAccount used to set
+	// the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberRef *xpv1.Reference `json:"serviceAccountMemberRef,omitempty"`
+
+	// ServiceAccountMemberSelector selects reference to ServiceAccount used
+	// to set the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberSelector *xpv1.Selector `json:"serviceAccountMemberSelector,omitempty"`
+
+	// ServiceAccountMembersRefs are references to ServiceAccounts whose
+	// members, each as `serviceAccount:<email>`, are added to the Members.
+	// +optional
+	// +immutable
+	ServiceAccountMembersRefs []xpv1.Reference `json:"serviceAccountMembersRefs,omitempty"`
+
+	// ServiceAccountMembersSelector selects references to ServiceAccounts
+	// whose members are added to the Members.
+	// +optional
+	// +immutable
+	ServiceAccountMembersSelector *xpv1.Selector `json:"serviceAccountMembersSelector,omitempty"`
+}
+
+// ServiceAccountPolicyMemberSpec defines the desired state of a
+// ServiceAccountPolicyMember.
+type ServiceAccountPolicyMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceAccountPolicyMemberParameters `json:"forProvider"`
+}
+
+// ServiceAccountPolicyMemberObservation represents the observed state of a
+// ServiceAccountPolicyMember.
+type ServiceAccountPolicyMemberObservation struct {
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// ServiceAccountPolicyMemberStatus represents the observed state of a
+// ServiceAccountPolicyMember.
+type ServiceAccountPolicyMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceAccountPolicyMemberObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountPolicyMember is a managed resource that represents membership
+// of a Google IAM ServiceAccount policy. Other members of the policy are left
+// untouched.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SERVICE-ACCOUNT",type="string",JSONPath=".spec.forProvider.serviceAccount"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="MEMBER",type="string",JSONPath=".spec.forProvider.member"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ServiceAccountPolicyMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceAccountPolicyMemberSpec   `json:"spec"`
+	Status ServiceAccountPolicyMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountPolicyMemberList contains a list of ServiceAccountPolicyMember
+// types
+type ServiceAccountPolicyMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAccountPolicyMember `json:"items"`
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Token types of a ServiceAccountToken.
+const (
+	TokenTypeAccessToken = "AccessToken"
+	TokenTypeIDToken     = "IDToken"
+)
+
+// ServiceAccountTokenParameters defines parameters for a desired short-lived
+// token of a service account.
+// https://cloud.google.com/iam/docs/reference/credentials/rest/v1/projects.serviceAccounts
+type ServiceAccountTokenParameters struct {
+	// TokenType is the type of token to generate, either an OAuth 2.0 access
+	// token or an OpenID Connect ID token.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=AccessToken;IDToken
+	// +kubebuilder:default=AccessToken
+	TokenType *string `json:"tokenType,omitempty"`
+
+	// Scopes is the list of OAuth scopes granted to an access token, e.g.
+	// https://www.googleapis.com/auth/cloud-platform. Required for access
+	// tokens.
+	// +optional
+	// +immutable
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Lifetime is the desired lifetime of an access token in seconds, e.g.
+	// 3600s. Defaults to one hour. ID tokens are always valid for one hour.
+	// +optional
+	// +immutable
+	Lifetime *string `json:"lifetime,omitempty"`
+
+	// Audience is the audience of an ID token, i.e. the URL of the service
+	// it is intended for. Required for ID tokens.
+	// +optional
+	// +immutable
+	Audience *string `json:"audience,omitempty"`
+
+	// IncludeEmail includes the email and email_verified claims of the
+	// service account in an ID token.
+	// +optional
+	// +immutable
+	IncludeEmail *bool `json:"includeEmail,omitempty"`
+
+	// Delegates is the chain of service accounts, in the form
+	// projects/-/serviceAccounts/{ACCOUNT_EMAIL_OR_UNIQUEID}, through which
+	// the provider's identity is delegated the ability to impersonate the
+	// target service account.
+	// +optional
+	// +immutable
+	Delegates []string `json:"delegates,omitempty"`
+
+	// RefreshBefore is how long before its expiry the token is replaced by
+	// a new one. It should be longer than the poll interval of the provider.
+	// Defaults to 10 minutes.
+	// +optional
+	RefreshBefore *metav1.Duration `json:"refreshBefore,omitempty"`
+
+	// ServiceAccountRef is a reference to the ServiceAccount the token is
+	// generated for.
+	ServiceAccountReferer `json:",inline"`
+}
+
+// ServiceAccountTokenObservation is used to show the observed state of the
+// ServiceAccountToken.
+type ServiceAccountTokenObservation struct {
+	// ExpireTime is the time at which the current token expires, in RFC3339
+	// UTC "Zulu" format.
+	ExpireTime string `json:"expireTime,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// ServiceAccountTokenSpec defines the desired state of a ServiceAccountToken.
+type ServiceAccountTokenSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceAccountTokenParameters `json:"forProvider"`
+}
+
+// ServiceAccountTokenStatus represents the observed state of a
+// ServiceAccountToken.
+type ServiceAccountTokenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceAccountTokenObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountToken is a managed resource that represents a short-lived
+// access or ID token of a Google IAM Service Account. The token is published
+// to the connection secret of the resource and replaced before it expires.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="EXPIRES_AT",type="string",JSONPath=".status.atProvider.expireTime"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ServiceAccountToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceAccountTokenSpec   `json:"spec"`
+	Status ServiceAccountTokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountTokenList contains a list of ServiceAccountToken types
+type ServiceAccountTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAccountToken `json:"items"`
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Known Endpoint states.
+const (
+	EndpointStateCreating = "CREATING"
+	EndpointStateReady    = "READY"
+	EndpointStateDeleting = "DELETING"
+	EndpointStateUpdating = "UPDATING"
+)
+
+// EndpointParameters define the desired state of a Cloud IDS endpoint.
+// https://cloud.google.com/intrusion-detection-system/docs/reference/rest/v1/projects.locations.endpoints
+// The name of the endpoint (ie the `endpointId` parameter of the Create call)
+// is determined by the value of the `crossplane.io/external-name` annotation.
+// Cloud IDS endpoints cannot be changed after they are created.
+type EndpointParameters struct {
+	// Location: The zone of the endpoint, e.g. us-central1-a. Traffic can
+	// only be mirrored to the endpoint from its region.
+	// +immutable
+	Location string `json:"location"`
+
+	// Network: The URL of the VPC network the endpoint is attached to, e.g.
+	// projects/my-project/global/networks/my-network. The network must have
+	// a private services access connection to servicenetworking.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Severity: Lowest threat severity that this endpoint will alert on.
+	// +immutable
+	// +kubebuilder:validation:Enum=INFORMATIONAL;LOW;MEDIUM;HIGH;CRITICAL
+	Severity string `json:"severity"`
+
+	// Description: User-provided description of the endpoint.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Labels: The labels of the endpoint.
+	// +optional
+	// +immutable
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// TrafficLogs: Whether the endpoint should report traffic logs in
+	// addition to threat logs.
+	// +optional
+	// +immutable
+	TrafficLogs *bool `json:"trafficLogs,omitempty"`
+}
+
+// EndpointObservation is used to show the observed state of the Endpoint.
+type EndpointObservation struct {
+	// Name: The resource name of the endpoint in the format
+	// `projects/*/locations/*/endpoints/*`.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime: The create time timestamp.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime: The update time timestamp.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// EndpointForwardingRule: The fully qualified URL of the endpoint's
+	// internal load balancer forwarding rule. It is used as the collector
+	// of packet mirroring policies.
+	EndpointForwardingRule string `json:"endpointForwardingRule,omitempty"`
+
+	// EndpointIP: The IP address of the IDS Endpoint's ILB.
+	EndpointIP string `json:"endpointIp,omitempty"`
+
+	// State: Current state of the endpoint.
+	State string `json:"state,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// EndpointSpec defines the desired state of an Endpoint.
+type EndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EndpointParameters `json:"forProvider"`
+}
+
+// EndpointStatus represents the observed state of an Endpoint.
+type EndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EndpointObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Endpoint is a managed resource that represents a Cloud IDS endpoint. Traffic
+// is sent to the endpoint by a PacketMirroring whose collector is the
+// endpoint's forwarding rule.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="SEVERITY",type="string",JSONPath=".spec.forProvider.severity"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Endpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EndpointSpec   `json:"spec"`
+	Status EndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EndpointList contains a list of Endpoint types
+type EndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Endpoint `json:"items"`
+}
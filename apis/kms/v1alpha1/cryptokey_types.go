@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // CryptoKeyParameters defines parameters for a desired KMS CryptoKey
@@ -40,6 +42,26 @@ type CryptoKeyParameters struct {
 	// +optional
 	KeyRingSelector *xpv1.Selector `json:"keyRingSelector,omitempty"`
 
+	// CryptoKeyBackend: The resource name of the backend environment where
+	// the key material for all CryptoKeyVersions of this CryptoKey reside
+	// and where all related cryptographic operations are performed. Only
+	// applicable if the protection level of the CryptoKeyVersions is
+	// EXTERNAL_VPC, with the resource name in the format
+	// `projects/*/locations/*/ekmConnections/*`.
+	// +optional
+	// +immutable
+	CryptoKeyBackend *string `json:"cryptoKeyBackend,omitempty"`
+
+	// CryptoKeyBackendRef references an EkmConnection and retrieves its
+	// resource name.
+	// +optional
+	// +immutable
+	CryptoKeyBackendRef *xpv1.Reference `json:"cryptoKeyBackendRef,omitempty"`
+
+	// CryptoKeyBackendSelector selects a reference to an EkmConnection.
+	// +optional
+	CryptoKeyBackendSelector *xpv1.Selector `json:"cryptoKeyBackendSelector,omitempty"`
+
 	// Labels: Labels with user-defined metadata. For more information,
 	// see
 	// [Labeling Keys](/kms/docs/labeling-keys).
@@ -150,6 +172,10 @@ type CryptoKeyObservation struct {
 	// ENCRYPT_DECRYPT may have a
 	// primary. For other keys, this field will be omitted.
 	Primary *CryptoKeyVersion `json:"primary,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // A CryptoKeyVersion represents an individual cryptographic key, and the
@@ -372,8 +398,14 @@ type CryptoKeyVersionTemplate struct {
 	// Module.
 	//   "EXTERNAL" - Crypto operations are performed by an external key
 	// manager.
+	//   "EXTERNAL_VPC" - Crypto operations are performed in an EKM-over-VPC
+	// backend, set in CryptoKeyBackend.
+	//
+	// CryptoKeys with the EXTERNAL or EXTERNAL_VPC protection level are
+	// created without an initial CryptoKeyVersion, because their versions
+	// must refer to a key in the external key manager.
 	// +optional
-	// +kubebuilder:validation:Enum=SOFTWARE;HSM;EXTERNAL
+	// +kubebuilder:validation:Enum=SOFTWARE;HSM;EXTERNAL;EXTERNAL_VPC
 	ProtectionLevel *string `json:"protectionLevel,omitempty"`
 }
 
@@ -419,6 +451,11 @@ type CryptoKeySpec struct {
 type CryptoKeyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          CryptoKeyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -427,8 +464,10 @@ type CryptoKeyStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="PURPOSE",type="string",JSONPath=".spec.forProvider.purpose"
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type CryptoKey struct {
 	metav1.TypeMeta   `json:",inline"`
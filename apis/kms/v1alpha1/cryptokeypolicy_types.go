@@ -22,6 +22,7 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // CryptoKeyPolicyParameters defines parameters for a desired KMS CryptoKeyPolicy
@@ -53,10 +54,24 @@ type CryptoKeyPolicySpec struct {
 	ForProvider       CryptoKeyPolicyParameters `json:"forProvider"`
 }
 
+// CryptoKeyPolicyObservation represents the observed state of a
+// CryptoKeyPolicy.
+type CryptoKeyPolicyObservation struct {
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
 // CryptoKeyPolicyStatus represents the observed state of a
 // CryptoKeyPolicy.
 type CryptoKeyPolicyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CryptoKeyPolicyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -65,7 +80,10 @@ type CryptoKeyPolicyStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="CRYPTO-KEY",type="string",JSONPath=".spec.forProvider.cryptoKey"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type CryptoKeyPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
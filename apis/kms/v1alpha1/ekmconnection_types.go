@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// EkmConnectionParameters defines parameters for a desired Cloud EKM
+// connection to an external key manager that is reached over a VPC network.
+// https://cloud.google.com/kms/docs/reference/rest/v1/projects.locations.ekmConnections
+// The name of the connection (ie the `ekmConnectionId` parameter of the
+// Create call) is determined by the value of the `crossplane.io/external-name`
+// annotation. Unless overridden by the user, this annotation is automatically
+// populated with the value of the `metadata.name` attribute.
+type EkmConnectionParameters struct {
+	// The location for the EkmConnection. It must be the location of the
+	// CryptoKeys that use it.
+	// +immutable
+	Location string `json:"location"`
+
+	// ServiceResolvers: A list of ServiceResolvers where the EKM can be
+	// reached. There should be one ServiceResolver per EKM replica.
+	// Currently, only a single ServiceResolver is supported.
+	// +kubebuilder:validation:MinItems=1
+	ServiceResolvers []ServiceResolver `json:"serviceResolvers"`
+}
+
+// A ServiceResolver represents an EKM replica that can be reached within an
+// EkmConnection.
+type ServiceResolver struct {
+	// ServiceDirectoryService: The resource name of the Service Directory
+	// service pointing to an EKM replica, in the format
+	// `projects/*/locations/*/namespaces/*/services/*`.
+	ServiceDirectoryService string `json:"serviceDirectoryService"`
+
+	// EndpointFilter: The filter applied to the endpoints of the resolved
+	// service. If no filter is specified, all endpoints will be considered.
+	// +optional
+	EndpointFilter *string `json:"endpointFilter,omitempty"`
+
+	// Hostname: The hostname of the EKM replica used at TLS and HTTP
+	// layers.
+	Hostname string `json:"hostname"`
+
+	// ServerCertificates: A list of leaf server certificates used to
+	// authenticate HTTPS connections to the EKM replica.
+	// +kubebuilder:validation:MinItems=1
+	ServerCertificates []Certificate `json:"serverCertificates"`
+}
+
+// A Certificate represents an X.509 certificate used to authenticate HTTPS
+// connections to EKM replicas.
+type Certificate struct {
+	// RawDer: The raw certificate bytes in DER format, base64 encoded.
+	RawDer string `json:"rawDer"`
+}
+
+// EkmConnectionObservation is used to show the observed state of the
+// EkmConnection resource on GCP. All fields in this structure should only
+// be populated from GCP responses; any changes made to the k8s resource outside
+// of the crossplane gcp controller will be ignored and overwritten.
+type EkmConnectionObservation struct {
+	// CreateTime: Output only. The time at which the EkmConnection was
+	// created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// Name: Output only. The resource name for the EkmConnection in the
+	// format `projects/*/locations/*/ekmConnections/*`.
+	Name string `json:"name,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// EkmConnectionSpec defines the desired state of an EkmConnection.
+type EkmConnectionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EkmConnectionParameters `json:"forProvider"`
+}
+
+// EkmConnectionStatus represents the observed state of an EkmConnection.
+type EkmConnectionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EkmConnectionObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EkmConnection is a managed resource that represents a Google KMS
+// EkmConnection, used by CryptoKeys with the EXTERNAL_VPC protection level.
+// EkmConnections cannot be deleted; deleting the managed resource leaves the
+// EkmConnection in place.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type EkmConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EkmConnectionSpec   `json:"spec"`
+	Status EkmConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EkmConnectionList contains a list of EkmConnection types
+type EkmConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EkmConnection `json:"items"`
+}
@@ -59,9 +59,35 @@ func (in *CryptoKey) ResolveReferences(ctx context.Context, c client.Reader) err
 	in.Spec.ForProvider.KeyRing = reference.ToPtrValue(rsp.ResolvedValue)
 	in.Spec.ForProvider.KeyRingRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.cryptoKeyBackend
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.CryptoKeyBackend),
+		Reference:    in.Spec.ForProvider.CryptoKeyBackendRef,
+		Selector:     in.Spec.ForProvider.CryptoKeyBackendSelector,
+		To:           reference.To{Managed: &EkmConnection{}, List: &EkmConnectionList{}},
+		Extract:      EkmConnectionRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.cryptoKeyBackend")
+	}
+
+	in.Spec.ForProvider.CryptoKeyBackend = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.CryptoKeyBackendRef = rsp.ResolvedReference
+
 	return nil
 }
 
+// EkmConnectionRRN extracts the relative resource name of an EkmConnection.
+func EkmConnectionRRN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		n, ok := mg.(*EkmConnection)
+		if !ok {
+			return ""
+		}
+		return n.Status.AtProvider.Name
+	}
+}
+
 // CryptoKeyRRN extracts the partially qualified URL of a Network.
 func CryptoKeyRRN() reference.ExtractValueFn {
 	return func(mg resource.Managed) string {
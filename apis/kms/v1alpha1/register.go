@@ -61,6 +61,14 @@ var (
 	CryptoKeyPolicyGroupVersionKind = SchemeGroupVersion.WithKind(CryptoKeyPolicyKind)
 )
 
+// EkmConnection type metadata.
+var (
+	EkmConnectionKind             = reflect.TypeOf(EkmConnection{}).Name()
+	EkmConnectionGroupKind        = schema.GroupKind{Group: Group, Kind: EkmConnectionKind}.String()
+	EkmConnectionKindAPIVersion   = EkmConnectionKind + "." + SchemeGroupVersion.String()
+	EkmConnectionGroupVersionKind = SchemeGroupVersion.WithKind(EkmConnectionKind)
+)
+
 func init() {
-	SchemeBuilder.Register(&KeyRing{}, &KeyRingList{}, &CryptoKey{}, &CryptoKeyList{}, &CryptoKeyPolicy{}, &CryptoKeyPolicyList{})
+	SchemeBuilder.Register(&KeyRing{}, &KeyRingList{}, &CryptoKey{}, &CryptoKeyList{}, &CryptoKeyPolicy{}, &CryptoKeyPolicyList{}, &EkmConnection{}, &EkmConnectionList{})
 }
@@ -22,10 +22,26 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Certificate) DeepCopyInto(out *Certificate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Certificate.
+func (in *Certificate) DeepCopy() *Certificate {
+	if in == nil {
+		return nil
+	}
+	out := new(Certificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CryptoKey) DeepCopyInto(out *CryptoKey) {
 	*out = *in
@@ -93,6 +109,11 @@ func (in *CryptoKeyObservation) DeepCopyInto(out *CryptoKeyObservation) {
 		*out = new(CryptoKeyVersion)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptoKeyObservation.
@@ -123,6 +144,21 @@ func (in *CryptoKeyParameters) DeepCopyInto(out *CryptoKeyParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CryptoKeyBackend != nil {
+		in, out := &in.CryptoKeyBackend, &out.CryptoKeyBackend
+		*out = new(string)
+		**out = **in
+	}
+	if in.CryptoKeyBackendRef != nil {
+		in, out := &in.CryptoKeyBackendRef, &out.CryptoKeyBackendRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CryptoKeyBackendSelector != nil {
+		in, out := &in.CryptoKeyBackendSelector, &out.CryptoKeyBackendSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
@@ -216,6 +252,26 @@ func (in *CryptoKeyPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CryptoKeyPolicyObservation) DeepCopyInto(out *CryptoKeyPolicyObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptoKeyPolicyObservation.
+func (in *CryptoKeyPolicyObservation) DeepCopy() *CryptoKeyPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CryptoKeyPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CryptoKeyPolicyParameters) DeepCopyInto(out *CryptoKeyPolicyParameters) {
 	*out = *in
@@ -268,6 +324,7 @@ func (in *CryptoKeyPolicySpec) DeepCopy() *CryptoKeyPolicySpec {
 func (in *CryptoKeyPolicyStatus) DeepCopyInto(out *CryptoKeyPolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptoKeyPolicyStatus.
@@ -364,6 +421,141 @@ func (in *CryptoKeyVersionTemplate) DeepCopy() *CryptoKeyVersionTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EkmConnection) DeepCopyInto(out *EkmConnection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EkmConnection.
+func (in *EkmConnection) DeepCopy() *EkmConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(EkmConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EkmConnection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EkmConnectionList) DeepCopyInto(out *EkmConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EkmConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EkmConnectionList.
+func (in *EkmConnectionList) DeepCopy() *EkmConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(EkmConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EkmConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EkmConnectionObservation) DeepCopyInto(out *EkmConnectionObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EkmConnectionObservation.
+func (in *EkmConnectionObservation) DeepCopy() *EkmConnectionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EkmConnectionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EkmConnectionParameters) DeepCopyInto(out *EkmConnectionParameters) {
+	*out = *in
+	if in.ServiceResolvers != nil {
+		in, out := &in.ServiceResolvers, &out.ServiceResolvers
+		*out = make([]ServiceResolver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EkmConnectionParameters.
+func (in *EkmConnectionParameters) DeepCopy() *EkmConnectionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EkmConnectionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EkmConnectionSpec) DeepCopyInto(out *EkmConnectionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EkmConnectionSpec.
+func (in *EkmConnectionSpec) DeepCopy() *EkmConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EkmConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EkmConnectionStatus) DeepCopyInto(out *EkmConnectionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EkmConnectionStatus.
+func (in *EkmConnectionStatus) DeepCopy() *EkmConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EkmConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalProtectionLevelOptions) DeepCopyInto(out *ExternalProtectionLevelOptions) {
 	*out = *in
@@ -456,6 +648,11 @@ func (in *KeyRingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyRingObservation) DeepCopyInto(out *KeyRingObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRingObservation.
@@ -504,7 +701,7 @@ func (in *KeyRingSpec) DeepCopy() *KeyRingSpec {
 func (in *KeyRingStatus) DeepCopyInto(out *KeyRingStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRingStatus.
@@ -516,3 +713,28 @@ func (in *KeyRingStatus) DeepCopy() *KeyRingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceResolver) DeepCopyInto(out *ServiceResolver) {
+	*out = *in
+	if in.EndpointFilter != nil {
+		in, out := &in.EndpointFilter, &out.EndpointFilter
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerCertificates != nil {
+		in, out := &in.ServerCertificates, &out.ServerCertificates
+		*out = make([]Certificate, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceResolver.
+func (in *ServiceResolver) DeepCopy() *ServiceResolver {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceResolver)
+	in.DeepCopyInto(out)
+	return out
+}
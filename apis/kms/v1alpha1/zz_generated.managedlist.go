@@ -38,6 +38,15 @@ func (l *CryptoKeyPolicyList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this EkmConnectionList.
+func (l *EkmConnectionList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this KeyRingList.
 func (l *KeyRingList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Gateway types.
+const (
+	GatewayTypeOpenMesh         = "OPEN_MESH"
+	GatewayTypeSecureWebGateway = "SECURE_WEB_GATEWAY"
+)
+
+// GatewayParameters define the desired state of a Network Services gateway.
+// https://cloud.google.com/traffic-director/docs/reference/network-services/rest/v1/projects.locations.gateways
+type GatewayParameters struct {
+	// Location: The location of the gateway. OPEN_MESH gateways are global,
+	// SECURE_WEB_GATEWAY gateways are regional, e.g. us-central1.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	Location string `json:"location"`
+
+	// Type: The type of the gateway.
+	// - OPEN_MESH: A gateway of Envoy proxies managed by the user that
+	// receive the routes that reference the gateway.
+	// - SECURE_WEB_GATEWAY: A Secure Web Proxy managed by Google Cloud.
+	// +immutable
+	// +kubebuilder:validation:Enum=OPEN_MESH;SECURE_WEB_GATEWAY
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type string `json:"type"`
+
+	// Ports: The ports the gateway listens on. OPEN_MESH gateways support
+	// up to 50 ports, SECURE_WEB_GATEWAY gateways exactly one.
+	// +kubebuilder:validation:MinItems=1
+	Ports []int64 `json:"ports"`
+
+	// Scope: The scope of an OPEN_MESH gateway. Envoy proxies configured
+	// with the same scope receive the configuration of all gateways of that
+	// scope.
+	// +optional
+	// +immutable
+	Scope *string `json:"scope,omitempty"`
+
+	// Addresses: The IP addresses a SECURE_WEB_GATEWAY gateway listens
+	// on. Google Cloud allocates one if none is set.
+	// +optional
+	// +immutable
+	Addresses []string `json:"addresses,omitempty"`
+
+	// Network: The URL of the VPC network of a SECURE_WEB_GATEWAY gateway,
+	// e.g. projects/my-project/global/networks/my-network.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI.
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork: The URL of the subnetwork a SECURE_WEB_GATEWAY gateway
+	// allocates its addresses from.
+	// +optional
+	// +immutable
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork and retrieves its URI.
+	// +optional
+	// +immutable
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork.
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// ServerTLSPolicy: The resource name of the ServerTlsPolicy that
+	// secures the traffic an OPEN_MESH gateway receives, e.g.
+	// projects/my-project/locations/global/serverTlsPolicies/my-policy.
+	// +optional
+	ServerTLSPolicy *string `json:"serverTlsPolicy,omitempty"`
+
+	// CertificateURLs: The URLs of the certificates a SECURE_WEB_GATEWAY
+	// gateway uses to inspect TLS traffic.
+	// +optional
+	CertificateURLs []string `json:"certificateUrls,omitempty"`
+
+	// GatewaySecurityPolicy: The resource name of the
+	// GatewaySecurityPolicy that a SECURE_WEB_GATEWAY gateway enforces.
+	// +optional
+	GatewaySecurityPolicy *string `json:"gatewaySecurityPolicy,omitempty"`
+
+	// Description: A free-text description of the gateway.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels: The labels of the gateway.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// GatewayObservation is used to show the observed state of the Gateway.
+type GatewayObservation struct {
+	// Name: The resource name of the gateway in the format
+	// `projects/*/locations/*/gateways/*`. Routes refer to the gateway by
+	// this name.
+	Name string `json:"name,omitempty"`
+
+	// SelfLink: Server-defined URL of the gateway.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// CreateTime: The create time timestamp.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime: The update time timestamp.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// Addresses: The IP addresses the gateway listens on.
+	Addresses []string `json:"addresses,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// GatewaySpec defines the desired state of a Gateway.
+type GatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GatewayParameters `json:"forProvider"`
+}
+
+// GatewayStatus represents the observed state of a Gateway.
+type GatewayStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GatewayObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Gateway is a managed resource that represents a Network Services gateway,
+// i.e. an ingress gateway of Envoy proxies for Traffic Director or a Secure
+// Web Proxy. The external name of the resource is the name of the gateway.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewaySpec   `json:"spec"`
+	Status GatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayList contains a list of Gateway types
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Gateway `json:"items"`
+}
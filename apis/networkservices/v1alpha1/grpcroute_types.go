@@ -0,0 +1,228 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// GRPCRouteParameters define the desired state of a Traffic Director gRPC
+// route. Routes are always global.
+// https://cloud.google.com/traffic-director/docs/reference/network-services/rest/v1/projects.locations.grpcRoutes
+type GRPCRouteParameters struct {
+	// Description: A free-text description of the route.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels: The labels of the route.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Hostnames: The hosts of the gRPC target URIs the route matches, e.g.
+	// helloworld.example.com for the target xds:///helloworld.example.com.
+	// +kubebuilder:validation:MinItems=1
+	Hostnames []string `json:"hostnames"`
+
+	// Meshes: The resource names of the meshes the route is attached to,
+	// e.g. projects/my-project/locations/global/meshes/my-mesh.
+	// +optional
+	Meshes []string `json:"meshes,omitempty"`
+
+	// MeshRefs references Meshes and retrieves their resource names.
+	// +optional
+	MeshRefs []xpv1.Reference `json:"meshRefs,omitempty"`
+
+	// MeshSelector selects references to Meshes.
+	// +optional
+	MeshSelector *xpv1.Selector `json:"meshSelector,omitempty"`
+
+	// Gateways: The resource names of the gateways the route is attached
+	// to, e.g. projects/my-project/locations/global/gateways/my-gateway.
+	// +optional
+	Gateways []string `json:"gateways,omitempty"`
+
+	// GatewayRefs references Gateways and retrieves their resource names.
+	// +optional
+	GatewayRefs []xpv1.Reference `json:"gatewayRefs,omitempty"`
+
+	// GatewaySelector selects references to Gateways.
+	// +optional
+	GatewaySelector *xpv1.Selector `json:"gatewaySelector,omitempty"`
+
+	// Rules: The rules that route requests. The first rule that matches a
+	// request is applied.
+	// +kubebuilder:validation:MinItems=1
+	Rules []GRPCRouteRule `json:"rules"`
+}
+
+// A GRPCRouteRule routes the requests it matches.
+type GRPCRouteRule struct {
+	// Matches: The requests the rule matches. A request matches the rule
+	// if it matches any of the matches. The rule matches all requests if
+	// no match is set.
+	// +optional
+	Matches []GRPCRouteMatch `json:"matches,omitempty"`
+
+	// Action: How matched requests are routed.
+	Action GRPCRouteAction `json:"action"`
+}
+
+// A GRPCRouteMatch matches requests by their method and headers.
+type GRPCRouteMatch struct {
+	// Method: The method of the request must match this.
+	// +optional
+	Method *GRPCRouteMethodMatch `json:"method,omitempty"`
+
+	// Headers: The headers of the request must match all of these.
+	// +optional
+	Headers []GRPCRouteHeaderMatch `json:"headers,omitempty"`
+}
+
+// A GRPCRouteMethodMatch matches the service and method of a request.
+type GRPCRouteMethodMatch struct {
+	// Type: How the service and method are matched, i.e. EXACT or
+	// REGULAR_EXPRESSION. Defaults to EXACT.
+	// +optional
+	// +kubebuilder:validation:Enum=EXACT;REGULAR_EXPRESSION
+	Type *string `json:"type,omitempty"`
+
+	// GRPCService: The name of the service, e.g. helloworld.Greeter.
+	GRPCService string `json:"grpcService"`
+
+	// GRPCMethod: The name of the method, e.g. SayHello.
+	GRPCMethod string `json:"grpcMethod"`
+
+	// CaseSensitive: Whether the service and method are matched case
+	// sensitively. Defaults to true.
+	// +optional
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+}
+
+// A GRPCRouteHeaderMatch matches a header, i.e. metadata entry, of a request.
+type GRPCRouteHeaderMatch struct {
+	// Type: How the value is matched, i.e. EXACT or REGULAR_EXPRESSION.
+	// Defaults to EXACT.
+	// +optional
+	// +kubebuilder:validation:Enum=EXACT;REGULAR_EXPRESSION
+	Type *string `json:"type,omitempty"`
+
+	// Key: The key of the header.
+	Key string `json:"key"`
+
+	// Value: The value of the header.
+	Value string `json:"value"`
+}
+
+// A GRPCRouteAction configures how requests are routed.
+type GRPCRouteAction struct {
+	// Destinations: The backend services requests are sent to, weighted
+	// by their weights.
+	// +optional
+	Destinations []RouteDestination `json:"destinations,omitempty"`
+
+	// Timeout: The timeout of a request, including retries, e.g. 30s.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+
+	// RetryPolicy: When failed requests are retried.
+	// +optional
+	RetryPolicy *GRPCRouteRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// A GRPCRouteRetryPolicy configures when failed requests are retried.
+type GRPCRouteRetryPolicy struct {
+	// NumRetries: The number of times a request is retried.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	NumRetries *int64 `json:"numRetries,omitempty"`
+
+	// RetryConditions: The gRPC status codes a request is retried on, e.g.
+	// unavailable, cancelled, deadline-exceeded or resource-exhausted.
+	// +optional
+	RetryConditions []string `json:"retryConditions,omitempty"`
+}
+
+// GRPCRouteObservation is used to show the observed state of the GRPCRoute.
+type GRPCRouteObservation struct {
+	// Name: The resource name of the route in the format
+	// `projects/*/locations/global/grpcRoutes/*`.
+	Name string `json:"name,omitempty"`
+
+	// SelfLink: Server-defined URL of the route.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// CreateTime: The create time timestamp.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime: The update time timestamp.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// GRPCRouteSpec defines the desired state of a GRPCRoute.
+type GRPCRouteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GRPCRouteParameters `json:"forProvider"`
+}
+
+// GRPCRouteStatus represents the observed state of a GRPCRoute.
+type GRPCRouteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GRPCRouteObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GRPCRoute is a managed resource that represents a Traffic Director gRPC
+// route. It routes the requests of proxyless gRPC clients and Envoy proxies
+// of the meshes and gateways it is attached to to backend services. The
+// external name of the resource is the name of the route.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type GRPCRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GRPCRouteSpec   `json:"spec"`
+	Status GRPCRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GRPCRouteList contains a list of GRPCRoute types
+type GRPCRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCRoute `json:"items"`
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// HTTPRouteParameters define the desired state of a Traffic Director HTTP
+// route. Routes are always global.
+// https://cloud.google.com/traffic-director/docs/reference/network-services/rest/v1/projects.locations.httpRoutes
+type HTTPRouteParameters struct {
+	// Description: A free-text description of the route.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels: The labels of the route.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Hostnames: The hosts, i.e. values of the Host header, the route
+	// matches, e.g. example.com or *.example.com.
+	// +kubebuilder:validation:MinItems=1
+	Hostnames []string `json:"hostnames"`
+
+	// Meshes: The resource names of the meshes the route is attached to,
+	// e.g. projects/my-project/locations/global/meshes/my-mesh.
+	// +optional
+	Meshes []string `json:"meshes,omitempty"`
+
+	// MeshRefs references Meshes and retrieves their resource names.
+	// +optional
+	MeshRefs []xpv1.Reference `json:"meshRefs,omitempty"`
+
+	// MeshSelector selects references to Meshes.
+	// +optional
+	MeshSelector *xpv1.Selector `json:"meshSelector,omitempty"`
+
+	// Gateways: The resource names of the gateways the route is attached
+	// to, e.g. projects/my-project/locations/global/gateways/my-gateway.
+	// +optional
+	Gateways []string `json:"gateways,omitempty"`
+
+	// GatewayRefs references Gateways and retrieves their resource names.
+	// +optional
+	GatewayRefs []xpv1.Reference `json:"gatewayRefs,omitempty"`
+
+	// GatewaySelector selects references to Gateways.
+	// +optional
+	GatewaySelector *xpv1.Selector `json:"gatewaySelector,omitempty"`
+
+	// Rules: The rules that route requests. The first rule that matches a
+	// request is applied.
+	// +kubebuilder:validation:MinItems=1
+	Rules []HTTPRouteRule `json:"rules"`
+}
+
+// An HTTPRouteRule routes the requests it matches.
+type HTTPRouteRule struct {
+	// Matches: The requests the rule matches. A request matches the rule
+	// if it matches any of the matches. The rule matches all requests if
+	// no match is set.
+	// +optional
+	Matches []HTTPRouteMatch `json:"matches,omitempty"`
+
+	// Action: How matched requests are routed.
+	Action HTTPRouteAction `json:"action"`
+}
+
+// An HTTPRouteMatch matches requests by their path and headers. At most one
+// of fullPathMatch, prefixMatch and regexMatch can be set.
+type HTTPRouteMatch struct {
+	// FullPathMatch: The path of the request, without query parameters,
+	// must equal this value.
+	// +optional
+	FullPathMatch *string `json:"fullPathMatch,omitempty"`
+
+	// PrefixMatch: The path of the request must start with this value.
+	// +optional
+	PrefixMatch *string `json:"prefixMatch,omitempty"`
+
+	// RegexMatch: The path of the request must match this RE2 regular
+	// expression.
+	// +optional
+	RegexMatch *string `json:"regexMatch,omitempty"`
+
+	// IgnoreCase: Whether the path is matched case-insensitively.
+	// +optional
+	IgnoreCase *bool `json:"ignoreCase,omitempty"`
+
+	// Headers: The headers of the request must match all of these.
+	// +optional
+	Headers []HTTPRouteHeaderMatch `json:"headers,omitempty"`
+}
+
+// An HTTPRouteHeaderMatch matches a header of a request. Exactly one of
+// exactMatch, prefixMatch, suffixMatch, regexMatch and presentMatch must be
+// set.
+type HTTPRouteHeaderMatch struct {
+	// Header: The name of the header.
+	Header string `json:"header"`
+
+	// ExactMatch: The value of the header must equal this value.
+	// +optional
+	ExactMatch *string `json:"exactMatch,omitempty"`
+
+	// PrefixMatch: The value of the header must start with this value.
+	// +optional
+	PrefixMatch *string `json:"prefixMatch,omitempty"`
+
+	// SuffixMatch: The value of the header must end with this value.
+	// +optional
+	SuffixMatch *string `json:"suffixMatch,omitempty"`
+
+	// RegexMatch: The value of the header must match this RE2 regular
+	// expression.
+	// +optional
+	RegexMatch *string `json:"regexMatch,omitempty"`
+
+	// PresentMatch: Whether the header must be present, regardless of its
+	// value.
+	// +optional
+	PresentMatch *bool `json:"presentMatch,omitempty"`
+
+	// InvertMatch: Whether the result of the match is inverted.
+	// +optional
+	InvertMatch *bool `json:"invertMatch,omitempty"`
+}
+
+// An HTTPRouteAction configures how requests are routed.
+type HTTPRouteAction struct {
+	// Destinations: The backend services requests are sent to, weighted
+	// by their weights.
+	// +optional
+	Destinations []RouteDestination `json:"destinations,omitempty"`
+
+	// Timeout: The timeout of a request, including retries, e.g. 30s.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+
+	// RetryPolicy: When failed requests are retried.
+	// +optional
+	RetryPolicy *HTTPRouteRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// URLRewrite: How the URL of requests is rewritten before they are
+	// sent to a destination.
+	// +optional
+	URLRewrite *HTTPRouteURLRewrite `json:"urlRewrite,omitempty"`
+}
+
+// A RouteDestination is a backend service requests are routed to.
+type RouteDestination struct {
+	// ServiceName: The URL of the backend service, e.g.
+	// projects/my-project/global/backendServices/my-service. The backend
+	// service must use the INTERNAL_SELF_MANAGED load balancing scheme.
+	ServiceName string `json:"serviceName"`
+
+	// Weight: The share of requests sent to the backend service,
+	// relative to the weights of the other destinations. Requests are
+	// shared evenly if no weights are set.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// An HTTPRouteRetryPolicy configures when failed requests are retried.
+type HTTPRouteRetryPolicy struct {
+	// NumRetries: The number of times a request is retried. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	NumRetries *int64 `json:"numRetries,omitempty"`
+
+	// PerTryTimeout: The timeout of every try, e.g. 5s.
+	// +optional
+	PerTryTimeout *string `json:"perTryTimeout,omitempty"`
+
+	// RetryConditions: The conditions in which requests are retried, e.g.
+	// 5xx, gateway-error, connect-failure or retriable-4xx.
+	// +optional
+	RetryConditions []string `json:"retryConditions,omitempty"`
+}
+
+// An HTTPRouteURLRewrite rewrites the URL of requests.
+type HTTPRouteURLRewrite struct {
+	// PathPrefixRewrite: The value the matched prefix of the path is
+	// replaced with.
+	// +optional
+	PathPrefixRewrite *string `json:"pathPrefixRewrite,omitempty"`
+
+	// HostRewrite: The value the host of the request is replaced with.
+	// +optional
+	HostRewrite *string `json:"hostRewrite,omitempty"`
+}
+
+// HTTPRouteObservation is used to show the observed state of the HTTPRoute.
+type HTTPRouteObservation struct {
+	// Name: The resource name of the route in the format
+	// `projects/*/locations/global/httpRoutes/*`.
+	Name string `json:"name,omitempty"`
+
+	// SelfLink: Server-defined URL of the route.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// CreateTime: The create time timestamp.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime: The update time timestamp.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// HTTPRouteSpec defines the desired state of an HTTPRoute.
+type HTTPRouteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HTTPRouteParameters `json:"forProvider"`
+}
+
+// HTTPRouteStatus represents the observed state of an HTTPRoute.
+type HTTPRouteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HTTPRouteObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HTTPRoute is a managed resource that represents a Traffic Director HTTP
+// route. It routes the HTTP requests of the meshes and gateways it is
+// attached to to backend services. The external name of the resource is the
+// name of the route.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HTTPRouteSpec   `json:"spec"`
+	Status HTTPRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HTTPRouteList contains a list of HTTPRoute types
+type HTTPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTTPRoute `json:"items"`
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// MeshParameters define the desired state of a Traffic Director mesh. Meshes
+// are always global.
+// https://cloud.google.com/traffic-director/docs/reference/network-services/rest/v1/projects.locations.meshes
+type MeshParameters struct {
+	// Description: A free-text description of the mesh.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels: The labels of the mesh.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// InterceptionPort: The port Envoy sidecar proxies listen on for
+	// traffic redirected to them, e.g. by iptables. Defaults to 15001. Not
+	// used by proxyless gRPC clients.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	InterceptionPort *int64 `json:"interceptionPort,omitempty"`
+
+	// EnvoyHeaders: Whether Envoy proxies add debug headers, i.e. NONE or
+	// DEBUG_HEADERS. Defaults to NONE.
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;DEBUG_HEADERS
+	EnvoyHeaders *string `json:"envoyHeaders,omitempty"`
+}
+
+// MeshObservation is used to show the observed state of the Mesh.
+type MeshObservation struct {
+	// Name: The resource name of the mesh in the format
+	// `projects/*/locations/global/meshes/*`. Routes refer to the mesh by
+	// this name.
+	Name string `json:"name,omitempty"`
+
+	// SelfLink: Server-defined URL of the mesh.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// CreateTime: The create time timestamp.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime: The update time timestamp.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// MeshSpec defines the desired state of a Mesh.
+type MeshSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MeshParameters `json:"forProvider"`
+}
+
+// MeshStatus represents the observed state of a Mesh.
+type MeshStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MeshObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Mesh is a managed resource that represents a Traffic Director mesh. Envoy
+// sidecar proxies and proxyless gRPC clients that join the mesh receive the
+// HTTPRoutes and GRPCRoutes that reference it. The external name of the
+// resource is the name of the mesh.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Mesh struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MeshSpec   `json:"spec"`
+	Status MeshStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MeshList contains a list of Mesh types
+type MeshList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Mesh `json:"items"`
+}
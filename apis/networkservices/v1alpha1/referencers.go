@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+)
+
+// MeshName extracts the resource name of a Mesh.
+func MeshName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		m, ok := mg.(*Mesh)
+		if !ok {
+			return ""
+		}
+		return m.Status.AtProvider.Name
+	}
+}
+
+// GatewayName extracts the resource name of a Gateway.
+func GatewayName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		g, ok := mg.(*Gateway)
+		if !ok {
+			return ""
+		}
+		return g.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Gateway
+func (mg *Gateway) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
+		Reference:    mg.Spec.ForProvider.NetworkRef,
+		Selector:     mg.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      v1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetwork
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Subnetwork),
+		Reference:    mg.Spec.ForProvider.SubnetworkRef,
+		Selector:     mg.Spec.ForProvider.SubnetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+		Extract:      v1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetwork")
+	}
+	mg.Spec.ForProvider.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SubnetworkRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this HTTPRoute
+func (mg *HTTPRoute) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.meshes
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Meshes,
+		References:    mg.Spec.ForProvider.MeshRefs,
+		Selector:      mg.Spec.ForProvider.MeshSelector,
+		To:            reference.To{Managed: &Mesh{}, List: &MeshList{}},
+		Extract:       MeshName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.meshes")
+	}
+	mg.Spec.ForProvider.Meshes = mrsp.ResolvedValues
+	mg.Spec.ForProvider.MeshRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.gateways
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Gateways,
+		References:    mg.Spec.ForProvider.GatewayRefs,
+		Selector:      mg.Spec.ForProvider.GatewaySelector,
+		To:            reference.To{Managed: &Gateway{}, List: &GatewayList{}},
+		Extract:       GatewayName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.gateways")
+	}
+	mg.Spec.ForProvider.Gateways = mrsp.ResolvedValues
+	mg.Spec.ForProvider.GatewayRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this GRPCRoute
+func (mg *GRPCRoute) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.meshes
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Meshes,
+		References:    mg.Spec.ForProvider.MeshRefs,
+		Selector:      mg.Spec.ForProvider.MeshSelector,
+		To:            reference.To{Managed: &Mesh{}, List: &MeshList{}},
+		Extract:       MeshName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.meshes")
+	}
+	mg.Spec.ForProvider.Meshes = mrsp.ResolvedValues
+	mg.Spec.ForProvider.MeshRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.gateways
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.Gateways,
+		References:    mg.Spec.ForProvider.GatewayRefs,
+		Selector:      mg.Spec.ForProvider.GatewaySelector,
+		To:            reference.To{Managed: &Gateway{}, List: &GatewayList{}},
+		Extract:       GatewayName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.gateways")
+	}
+	mg.Spec.ForProvider.Gateways = mrsp.ResolvedValues
+	mg.Spec.ForProvider.GatewayRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "networkservices.gcp.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Mesh type metadata.
+var (
+	MeshKind             = reflect.TypeOf(Mesh{}).Name()
+	MeshGroupKind        = schema.GroupKind{Group: Group, Kind: MeshKind}.String()
+	MeshKindAPIVersion   = MeshKind + "." + SchemeGroupVersion.String()
+	MeshGroupVersionKind = SchemeGroupVersion.WithKind(MeshKind)
+)
+
+// Gateway type metadata.
+var (
+	GatewayKind             = reflect.TypeOf(Gateway{}).Name()
+	GatewayGroupKind        = schema.GroupKind{Group: Group, Kind: GatewayKind}.String()
+	GatewayKindAPIVersion   = GatewayKind + "." + SchemeGroupVersion.String()
+	GatewayGroupVersionKind = SchemeGroupVersion.WithKind(GatewayKind)
+)
+
+// HTTPRoute type metadata.
+var (
+	HTTPRouteKind             = reflect.TypeOf(HTTPRoute{}).Name()
+	HTTPRouteGroupKind        = schema.GroupKind{Group: Group, Kind: HTTPRouteKind}.String()
+	HTTPRouteKindAPIVersion   = HTTPRouteKind + "." + SchemeGroupVersion.String()
+	HTTPRouteGroupVersionKind = SchemeGroupVersion.WithKind(HTTPRouteKind)
+)
+
+// GRPCRoute type metadata.
+var (
+	GRPCRouteKind             = reflect.TypeOf(GRPCRoute{}).Name()
+	GRPCRouteGroupKind        = schema.GroupKind{Group: Group, Kind: GRPCRouteKind}.String()
+	GRPCRouteKindAPIVersion   = GRPCRouteKind + "." + SchemeGroupVersion.String()
+	GRPCRouteGroupVersionKind = SchemeGroupVersion.WithKind(GRPCRouteKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Mesh{}, &MeshList{})
+	SchemeBuilder.Register(&Gateway{}, &GatewayList{})
+	SchemeBuilder.Register(&HTTPRoute{}, &HTTPRouteList{})
+	SchemeBuilder.Register(&GRPCRoute{}, &GRPCRouteList{})
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+)
+
+// ResolveReferences of this Subscription
+func (in *Subscription) ResolveReferences(ctx context.Context, c client.Reader) error {
+	dlp := in.Spec.ForProvider.DeadLetterPolicy
+	if dlp == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.deadLetterPolicy.deadLetterTopic
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: dlp.DeadLetterTopic,
+		Reference:    dlp.DeadLetterTopicRef,
+		Selector:     dlp.DeadLetterTopicSelector,
+		To:           reference.To{Managed: &Topic{}, List: &TopicList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.deadLetterPolicy.deadLetterTopic")
+	}
+	dlp.DeadLetterTopic = rsp.ResolvedValue
+	dlp.DeadLetterTopicRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -20,6 +20,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // SubscriptionParameters defines parameters for a desired Subscription.
@@ -113,10 +115,28 @@ type DeadLetterPolicy struct {
 	// should be published. Format is `projects/{project}/topics/{topic}`.
 	DeadLetterTopic string `json:"deadLetterTopic,omitempty"`
 
+	// DeadLetterTopicRef references a Topic to retrieve its name.
+	// +optional
+	DeadLetterTopicRef *xpv1.Reference `json:"deadLetterTopicRef,omitempty"`
+
+	// DeadLetterTopicSelector selects a reference to a Topic to retrieve its
+	// name.
+	// +optional
+	DeadLetterTopicSelector *xpv1.Selector `json:"deadLetterTopicSelector,omitempty"`
+
 	// MaxDeliveryAttempts is the maximum number of delivery attempts for any
 	// message. The value must be between 5 and 100.
 	// +optional
 	MaxDeliveryAttempts int64 `json:"maxDeliveryAttempts,omitempty"`
+
+	// GrantServiceAgentPermissions grants the Pub/Sub service agent of the
+	// project the permissions dead lettering needs: the publisher role on the
+	// dead letter topic and the subscriber role on this subscription. It only
+	// takes effect when the dead letter topic is a Topic referenced through
+	// DeadLetterTopicRef or DeadLetterTopicSelector. The grants are not
+	// revoked when the subscription is deleted.
+	// +optional
+	GrantServiceAgentPermissions *bool `json:"grantServiceAgentPermissions,omitempty"`
 }
 
 // ExpirationPolicy contains configuration for resource expiration.
@@ -173,9 +193,23 @@ type SubscriptionSpec struct {
 	ForProvider       SubscriptionParameters `json:"forProvider"`
 }
 
+// SubscriptionObservation represents the observed state of a
+// Subscription.
+type SubscriptionObservation struct {
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
 // SubscriptionStatus represents the observed state of a Subscription.
 type SubscriptionStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SubscriptionObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -184,7 +218,11 @@ type SubscriptionStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TOPIC",type="string",JSONPath=".spec.forProvider.topic"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type Subscription struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
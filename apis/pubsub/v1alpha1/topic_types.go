@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // Keys used in connection secret.
@@ -73,6 +75,67 @@ type TopicParameters struct {
 	// KmsKeyNameSelector allows you to use selector constraints to select a
 	// KMS Key.
 	KmsKeyNameSelector *xpv1.Selector `json:"kmsKeyNameSelector,omitempty"`
+
+	// IngestionDataSourceSettings configure the topic to import messages
+	// from a data source outside of Google Cloud. At most one source may be
+	// set.
+	// +optional
+	IngestionDataSourceSettings *IngestionDataSourceSettings `json:"ingestionDataSourceSettings,omitempty"`
+}
+
+// IngestionDataSourceSettings contains the settings for ingestion from a data
+// source into a topic.
+type IngestionDataSourceSettings struct {
+	// AWSKinesis configures ingestion from an Amazon Kinesis Data Stream.
+	// +optional
+	AWSKinesis *AWSKinesis `json:"awsKinesis,omitempty"`
+
+	// AWSMSK configures ingestion from an Amazon Managed Streaming for
+	// Apache Kafka (MSK) cluster.
+	// +optional
+	AWSMSK *AWSMSK `json:"awsMsk,omitempty"`
+}
+
+// AWSKinesis contains the settings for ingestion from Amazon Kinesis Data
+// Streams.
+type AWSKinesis struct {
+	// StreamARN is the Kinesis stream ARN to ingest data from.
+	StreamARN string `json:"streamArn"`
+
+	// ConsumerARN is the Kinesis consumer ARN used for ingestion in Enhanced
+	// Fan-Out mode. The consumer must already be created and ready to be
+	// used.
+	ConsumerARN string `json:"consumerArn"`
+
+	// AWSRoleARN is the AWS role ARN to be used for Federated Identity
+	// authentication with Kinesis.
+	AWSRoleARN string `json:"awsRoleArn"`
+
+	// GCPServiceAccount is the email of the GCP service account to be used
+	// for Federated Identity authentication with Kinesis. The Pub/Sub
+	// service agent must have the iam.serviceAccounts.getOpenIdToken
+	// permission on it.
+	GCPServiceAccount string `json:"gcpServiceAccount"`
+}
+
+// AWSMSK contains the settings for ingestion from Amazon Managed Streaming
+// for Apache Kafka.
+type AWSMSK struct {
+	// ClusterARN is the ARN of the MSK cluster to ingest data from.
+	ClusterARN string `json:"clusterArn"`
+
+	// Topic is the name of the MSK topic to ingest data from.
+	Topic string `json:"topic"`
+
+	// AWSRoleARN is the AWS role ARN to be used for Federated Identity
+	// authentication with MSK.
+	AWSRoleARN string `json:"awsRoleArn"`
+
+	// GCPServiceAccount is the email of the GCP service account to be used
+	// for Federated Identity authentication with MSK. The Pub/Sub service
+	// agent must have the iam.serviceAccounts.getOpenIdToken permission on
+	// it.
+	GCPServiceAccount string `json:"gcpServiceAccount"`
 }
 
 // MessageStoragePolicy contains configuration for message storage policy.
@@ -93,10 +156,33 @@ type TopicSpec struct {
 	ForProvider       TopicParameters `json:"forProvider"`
 }
 
+// TopicObservation represents the observed state of a
+// Topic.
+type TopicObservation struct {
+	// State is the state of the topic, e.g. ACTIVE or
+	// INGESTION_RESOURCE_ERROR. It is only reported for topics that ingest
+	// from a data source.
+	State string `json:"state,omitempty"`
+
+	// IngestionState is the state of the ingestion from the configured data
+	// source, e.g. ACTIVE or KINESIS_PERMISSION_DENIED.
+	IngestionState string `json:"ingestionState,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
 // TopicStatus represents the observed state of a
 // Topic.
 type TopicStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TopicObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -105,6 +191,10 @@ type TopicStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="INGESTION",type="string",JSONPath=".status.atProvider.ingestionState",priority=1
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type Topic struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -22,13 +22,59 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKinesis) DeepCopyInto(out *AWSKinesis) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKinesis.
+func (in *AWSKinesis) DeepCopy() *AWSKinesis {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKinesis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSMSK) DeepCopyInto(out *AWSMSK) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMSK.
+func (in *AWSMSK) DeepCopy() *AWSMSK {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSMSK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeadLetterPolicy) DeepCopyInto(out *DeadLetterPolicy) {
 	*out = *in
+	if in.DeadLetterTopicRef != nil {
+		in, out := &in.DeadLetterTopicRef, &out.DeadLetterTopicRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadLetterTopicSelector != nil {
+		in, out := &in.DeadLetterTopicSelector, &out.DeadLetterTopicSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GrantServiceAgentPermissions != nil {
+		in, out := &in.GrantServiceAgentPermissions, &out.GrantServiceAgentPermissions
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetterPolicy.
@@ -56,6 +102,31 @@ func (in *ExpirationPolicy) DeepCopy() *ExpirationPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngestionDataSourceSettings) DeepCopyInto(out *IngestionDataSourceSettings) {
+	*out = *in
+	if in.AWSKinesis != nil {
+		in, out := &in.AWSKinesis, &out.AWSKinesis
+		*out = new(AWSKinesis)
+		**out = **in
+	}
+	if in.AWSMSK != nil {
+		in, out := &in.AWSMSK, &out.AWSMSK
+		*out = new(AWSMSK)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngestionDataSourceSettings.
+func (in *IngestionDataSourceSettings) DeepCopy() *IngestionDataSourceSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(IngestionDataSourceSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MessageStoragePolicy) DeepCopyInto(out *MessageStoragePolicy) {
 	*out = *in
@@ -192,13 +263,33 @@ func (in *SubscriptionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionObservation) DeepCopyInto(out *SubscriptionObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionObservation.
+func (in *SubscriptionObservation) DeepCopy() *SubscriptionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubscriptionParameters) DeepCopyInto(out *SubscriptionParameters) {
 	*out = *in
 	if in.DeadLetterPolicy != nil {
 		in, out := &in.DeadLetterPolicy, &out.DeadLetterPolicy
 		*out = new(DeadLetterPolicy)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ExpirationPolicy != nil {
 		in, out := &in.ExpirationPolicy, &out.ExpirationPolicy
@@ -255,6 +346,7 @@ func (in *SubscriptionSpec) DeepCopy() *SubscriptionSpec {
 func (in *SubscriptionStatus) DeepCopyInto(out *SubscriptionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionStatus.
@@ -326,6 +418,26 @@ func (in *TopicList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicObservation) DeepCopyInto(out *TopicObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicObservation.
+func (in *TopicObservation) DeepCopy() *TopicObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopicParameters) DeepCopyInto(out *TopicParameters) {
 	*out = *in
@@ -361,6 +473,11 @@ func (in *TopicParameters) DeepCopyInto(out *TopicParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IngestionDataSourceSettings != nil {
+		in, out := &in.IngestionDataSourceSettings, &out.IngestionDataSourceSettings
+		*out = new(IngestionDataSourceSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicParameters.
@@ -394,6 +511,7 @@ func (in *TopicSpec) DeepCopy() *TopicSpec {
 func (in *TopicStatus) DeepCopyInto(out *TopicStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicStatus.
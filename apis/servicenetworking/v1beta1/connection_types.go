@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // ConnectionParameters define the desired state of a Google Cloud Service
@@ -73,6 +75,10 @@ type ConnectionObservation struct {
 	// Service: The name of the peering service that's associated with this
 	// connection, in the following format: `services/{service name}`.
 	Service string `json:"service,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
 }
 
 // A ConnectionSpec defines the desired state of a Connection.
@@ -85,12 +91,22 @@ type ConnectionSpec struct {
 type ConnectionStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          ConnectionObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // A Connection is a managed resource that represents a Google Cloud Service
 // Networking Connection.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NETWORK",type="string",JSONPath=".spec.forProvider.network"
+// +kubebuilder:printcolumn:name="PEERING",type="string",JSONPath=".status.atProvider.peering"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
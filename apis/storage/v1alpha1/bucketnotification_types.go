@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Payload formats of a BucketNotification.
+const (
+	PayloadFormatJSONAPIV1 = "JSON_API_V1"
+	PayloadFormatNone      = "NONE"
+)
+
+// BucketNotificationParameters define the desired state of a Google Cloud
+// Storage Pub/Sub notification configuration. Notification configurations
+// cannot be changed once they are created.
+// https://cloud.google.com/storage/docs/json_api/v1/notifications
+type BucketNotificationParameters struct {
+	// Bucket: The name of the bucket whose changes are published.
+	// +optional
+	// +immutable
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket and retrieves its name
+	// +optional
+	// +immutable
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// UserProject is the project to be billed for requests made to the
+	// bucket. It must be set to manage resources of buckets that have
+	// Requester Pays enabled.
+	// +optional
+	UserProject *string `json:"userProject,omitempty"`
+
+	// Topic: The Pub/Sub topic notifications are published to. Either the
+	// name of a topic in the provider's project or its full name, i.e.
+	// projects/{project}/topics/{topic}.
+	// +optional
+	// +immutable
+	Topic *string `json:"topic,omitempty"`
+
+	// TopicRef references a Topic and retrieves its name
+	// +optional
+	// +immutable
+	TopicRef *xpv1.Reference `json:"topicRef,omitempty"`
+
+	// TopicSelector selects a reference to a Topic
+	// +optional
+	TopicSelector *xpv1.Selector `json:"topicSelector,omitempty"`
+
+	// EventTypes: If present, only send notifications about the listed
+	// event types. If empty, notifications are sent for all event types.
+	// +optional
+	// +immutable
+	EventTypes []string `json:"eventTypes,omitempty"`
+
+	// ObjectNamePrefix: If present, only send notifications about objects
+	// whose names begin with this prefix.
+	// +optional
+	// +immutable
+	ObjectNamePrefix *string `json:"objectNamePrefix,omitempty"`
+
+	// PayloadFormat: The desired content of the notification payload.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=JSON_API_V1;NONE
+	// +kubebuilder:default=JSON_API_V1
+	PayloadFormat string `json:"payloadFormat,omitempty"`
+
+	// CustomAttributes: Additional attributes attached to each Pub/Sub
+	// message published for this notification configuration.
+	// +optional
+	// +immutable
+	CustomAttributes map[string]string `json:"customAttributes,omitempty"`
+
+	// GrantPublisher: Whether to grant the Cloud Storage service agent of
+	// the provider's project the roles/pubsub.publisher role on the topic.
+	// Notifications are only delivered once the service agent may publish
+	// to the topic. The grant is restored if it is removed, and retained
+	// when this notification configuration is deleted, since other
+	// notification configurations may rely on it.
+	// +optional
+	GrantPublisher *bool `json:"grantPublisher,omitempty"`
+}
+
+// BucketNotificationObservation is used to show the observed state of the
+// BucketNotification.
+type BucketNotificationObservation struct {
+	// Topic: The full name of the Pub/Sub topic notifications are published
+	// to, i.e. //pubsub.googleapis.com/projects/{project}/topics/{topic}.
+	Topic string `json:"topic,omitempty"`
+
+	// Etag: HTTP 1.1 Entity tag of the notification configuration.
+	Etag string `json:"etag,omitempty"`
+
+	// SelfLink: The canonical URL of the notification configuration.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// ServiceAgent: The email address of the Cloud Storage service agent
+	// that publishes notifications. Only reported when grantPublisher is
+	// set.
+	ServiceAgent string `json:"serviceAgent,omitempty"`
+
+	// PublisherGranted: Whether the service agent is granted the
+	// roles/pubsub.publisher role on the topic. Only reported when
+	// grantPublisher is set.
+	PublisherGranted bool `json:"publisherGranted,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// BucketNotificationSpec defines the desired state of a BucketNotification.
+type BucketNotificationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BucketNotificationParameters `json:"forProvider"`
+}
+
+// BucketNotificationStatus represents the observed state of a
+// BucketNotification.
+type BucketNotificationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BucketNotificationObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketNotification is a managed resource that represents a Google Cloud
+// Storage Pub/Sub notification configuration. Its external name is the ID
+// GCP assigns to the notification configuration.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type BucketNotification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketNotificationSpec   `json:"spec"`
+	Status BucketNotificationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketNotificationList contains a list of BucketNotification types
+type BucketNotificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketNotification `json:"items"`
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// BucketObjectParameters define the desired state of a Google Cloud Storage
+// object. The name of the object is the external name of the BucketObject.
+// https://cloud.google.com/storage/docs/json_api/v1/objects
+type BucketObjectParameters struct {
+	// Bucket: The name of the bucket containing this object.
+	// +optional
+	// +immutable
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket and retrieves its name.
+	// +optional
+	// +immutable
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// UserProject is the project to be billed for requests made to the
+	// bucket. It must be set to manage objects in buckets that have
+	// Requester Pays enabled.
+	// +optional
+	UserProject *string `json:"userProject,omitempty"`
+
+	// Content of the object. Exactly one of Content and ContentFrom must be
+	// set.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentFrom is a ConfigMap or Secret key the content of the object is
+	// read from. Exactly one of Content and ContentFrom must be set.
+	// +optional
+	ContentFrom *BucketObjectContentSource `json:"contentFrom,omitempty"`
+
+	// ContentType: Content-Type of the object data. If an object is stored
+	// without a Content-Type, it is served as application/octet-stream.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// CacheControl: Cache-Control directive for the object data.
+	// +optional
+	CacheControl *string `json:"cacheControl,omitempty"`
+
+	// Metadata: User-provided metadata, in key/value pairs.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// BucketObjectContentSource selects the key of a ConfigMap or a Secret the
+// content of a BucketObject is read from. Exactly one of ConfigMapKeyRef and
+// SecretKeyRef must be set.
+type BucketObjectContentSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects a key of a Secret.
+	// +optional
+	SecretKeyRef *xpv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// BucketObjectObservation is used to show the observed state of the
+// BucketObject.
+type BucketObjectObservation struct {
+	// Generation: The content generation of this object.
+	Generation int64 `json:"generation,omitempty"`
+
+	// MD5Hash: MD5 hash of the data, encoded using base64.
+	MD5Hash string `json:"md5Hash,omitempty"`
+
+	// Size: Content-Length of the data in bytes.
+	Size int64 `json:"size,omitempty"`
+
+	// MediaLink: Media download link.
+	MediaLink string `json:"mediaLink,omitempty"`
+
+	// SelfLink: The link to this object.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Updated: The modification time of the object metadata in RFC 3339
+	// format.
+	Updated string `json:"updated,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// BucketObjectSpec defines the desired state of a BucketObject.
+type BucketObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BucketObjectParameters `json:"forProvider"`
+}
+
+// BucketObjectStatus represents the observed state of a BucketObject.
+type BucketObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BucketObjectObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketObject is a managed resource that represents a small Google Cloud
+// Storage object, such as a bootstrap file or a startup script, whose content
+// is kept in sync with an inline string or a ConfigMap or Secret key.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".status.atProvider.size"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type BucketObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketObjectSpec   `json:"spec"`
+	Status BucketObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketObjectList contains a list of BucketObject types
+type BucketObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketObject `json:"items"`
+}
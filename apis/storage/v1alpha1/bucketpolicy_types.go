@@ -22,6 +22,18 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Modes in which a BucketPolicy manages the IAM policy of its bucket.
+const (
+	// PolicyModeAuthoritative replaces all bindings of the IAM policy with
+	// the declared ones.
+	PolicyModeAuthoritative = "Authoritative"
+
+	// PolicyModeMerge adds the declared bindings to the IAM policy, keeping
+	// bindings that are managed elsewhere.
+	PolicyModeMerge = "Merge"
 )
 
 // BucketPolicyParameters defines parameters for a desired KMS BucketPolicy
@@ -40,12 +52,35 @@ type BucketPolicyParameters struct {
 	// +optional
 	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
 
+	// UserProject is the project to be billed for requests made to the
+	// bucket. It must be set to manage resources of buckets that have
+	// Requester Pays enabled.
+	// +optional
+	UserProject *string `json:"userProject,omitempty"`
+
 	// TODO(negz): I don't think we should be reusing iamv1alpha1.Policy
 	// below. It appears to have fields (e.g. AuditConfigs) that we never
 	// use. This will be misleading to users when they show up in the
 	// OpenAPI documentation for this resource.
 	// https://github.com/crossplane-contrib/provider-gcp/issues/367
 
+	// Mode determines how the declared policy is applied to the IAM policy
+	// of the bucket. In Authoritative mode the bindings of the bucket are
+	// replaced with the declared ones. In Merge mode the declared bindings
+	// are added to the bucket's existing bindings, leaving bindings that are
+	// managed elsewhere untouched.
+	// +optional
+	// +kubebuilder:validation:Enum=Authoritative;Merge
+	// +kubebuilder:default=Authoritative
+	Mode *string `json:"mode,omitempty"`
+
+	// AllowPolicyTakeover allows an Authoritative BucketPolicy to replace an
+	// existing policy of the bucket that has bindings it does not declare.
+	// Unless it is set, such a BucketPolicy is held in a non-ready state
+	// rather than removing those bindings.
+	// +optional
+	AllowPolicyTakeover bool `json:"allowPolicyTakeover,omitempty"`
+
 	// Policy: An Identity and Access Management (IAM) policy, which
 	// specifies access controls for Google Cloud resources.
 	Policy iamv1alpha1.Policy `json:"policy"`
@@ -85,6 +120,15 @@ type BucketPolicyObservation struct {
 	// policy may
 	// specify any valid version or leave the field unset.
 	Version int64 `json:"version,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// PolicyOwned is true once this BucketPolicy has set the policy of the
+	// bucket or found it up to date. An owned policy is kept in sync without
+	// requiring allowPolicyTakeover.
+	PolicyOwned bool `json:"policyOwned,omitempty"`
 }
 
 // BucketPolicySpec defines the desired state of a
@@ -99,16 +143,25 @@ type BucketPolicySpec struct {
 type BucketPolicyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          BucketPolicyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 
 // BucketPolicy is a managed resource that represents a Google Cloud Storage
-// Bucket IAM Policy.
+// Bucket IAM Policy. Deleting it removes only the members of the bindings it
+// declares, leaving any other bindings of the bucket in place.
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type BucketPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
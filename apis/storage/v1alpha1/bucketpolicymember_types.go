@@ -20,9 +20,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // BucketPolicyMemberParameters defines parameters for a desired KMS BucketPolicyMember
+// +kubebuilder:validation:XValidation:rule="!(has(self.condition) && has(self.objectPrefixCondition))",message="at most one of condition or objectPrefixCondition may be set"
 type BucketPolicyMemberParameters struct {
 	// Bucket: The RRN of the Bucket to which this BucketPolicyMember belongs.
 	// +optional
@@ -38,11 +42,34 @@ type BucketPolicyMemberParameters struct {
 	// +optional
 	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
 
+	// UserProject is the project to be billed for requests made to the
+	// bucket. It must be set to manage resources of buckets that have
+	// Requester Pays enabled.
+	// +optional
+	UserProject *string `json:"userProject,omitempty"`
+
 	// Role: Role that is assigned to `members`.
 	// For example, `roles/viewer`, `roles/editor`, or `roles/owner`.
 	// +immutable
 	Role string `json:"role"`
 
+	// Condition: The IAM condition under which the role is bound to the
+	// member. The member is bound in the binding of the role that has the
+	// same condition, so the same role may be bound to the member once per
+	// condition.
+	// +optional
+	// +immutable
+	Condition *iamv1alpha1.Expr `json:"condition,omitempty"`
+
+	// ObjectPrefixCondition: Binds the role to the member only for the
+	// objects whose names start with one of the supplied prefixes, e.g. to
+	// grant access to a folder of a shared bucket. The IAM condition is
+	// generated from the prefixes, so it must not be set as well. Like all
+	// IAM conditions it requires uniform bucket-level access.
+	// +optional
+	// +immutable
+	ObjectPrefixCondition *ObjectPrefixCondition `json:"objectPrefixCondition,omitempty"`
+
 	// Member: Specifies the identity requesting access for a Cloud
 	// Platform resource.
 	// `member` can have the following values:
@@ -109,9 +136,15 @@ type BucketPolicyMemberParameters struct {
 	//
 	//
 	// +optional
-	// +immutable
 	Member *string `json:"member,omitempty"`
 
+	// Members: Specifies a list of identities that are granted the role in
+	// addition to Member, so that one BucketPolicyMember can bind a role to
+	// a group of principals. Members take the same values as Member.
+	// Members that are removed are unbound from the role.
+	// +optional
+	Members []string `json:"members,omitempty"`
+
 	// ServiceAccountMemberRef is reference to ServiceAccount used to set
 	// the Member.
 	// +optional
@@ -123,6 +156,38 @@ type BucketPolicyMemberParameters struct {
 	// +optional
 	// +immutable
 	ServiceAccountMemberSelector *xpv1.Selector `json:"serviceAccountMemberSelector,omitempty"`
+
+	// ServiceAccountMembersRefs are references to ServiceAccounts whose
+	// members, each as `serviceAccount:<email>`, are added to the Members.
+	// +optional
+	// +immutable
+	ServiceAccountMembersRefs []xpv1.Reference `json:"serviceAccountMembersRefs,omitempty"`
+
+	// ServiceAccountMembersSelector selects references to ServiceAccounts
+	// whose members are added to the Members.
+	// +optional
+	// +immutable
+	ServiceAccountMembersSelector *xpv1.Selector `json:"serviceAccountMembersSelector,omitempty"`
+}
+
+// An ObjectPrefixCondition limits a role binding to the objects of the bucket
+// whose names start with one of its prefixes. Note that listing the objects of
+// a bucket is not permitted on objects, so it is not granted by such a binding.
+type ObjectPrefixCondition struct {
+	// Prefixes: The prefixes of the names of the objects the role is bound
+	// for, e.g. reports/2023/.
+	// +kubebuilder:validation:MinItems=1
+	Prefixes []string `json:"prefixes"`
+
+	// Title: The title of the generated IAM condition. Defaults to
+	// "Object prefixes".
+	// +optional
+	// +kubebuilder:validation:MaxLength=100
+	Title *string `json:"title,omitempty"`
+
+	// Description: The description of the generated IAM condition.
+	// +optional
+	Description *string `json:"description,omitempty"`
 }
 
 // BucketPolicyMemberSpec defines the desired state of a
@@ -132,10 +197,28 @@ type BucketPolicyMemberSpec struct {
 	ForProvider       BucketPolicyMemberParameters `json:"forProvider"`
 }
 
+// BucketPolicyMemberObservation represents the observed state of a
+// BucketPolicyMember.
+type BucketPolicyMemberObservation struct {
+	// BoundMembers: The members the role was last bound to by this
+	// provider. Members that are no longer declared are unbound.
+	BoundMembers []string `json:"boundMembers,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
 // BucketPolicyMemberStatus represents the observed state of a
 // BucketPolicyMember.
 type BucketPolicyMemberStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BucketPolicyMemberObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -145,7 +228,12 @@ type BucketPolicyMemberStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="MEMBER",type="string",JSONPath=".spec.forProvider.member"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type BucketPolicyMember struct {
 	metav1.TypeMeta   `json:",inline"`
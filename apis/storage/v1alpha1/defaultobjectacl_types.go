@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// DefaultObjectACLParameters define the desired state of a single entry of
+// the default object access-control list of a Google Cloud Storage bucket.
+// The default object ACL is applied to objects created in the bucket without
+// an explicit ACL.
+// https://cloud.google.com/storage/docs/json_api/v1/defaultObjectAccessControls
+type DefaultObjectACLParameters struct {
+	// Bucket: The name of the bucket.
+	// +optional
+	// +immutable
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket and retrieves its name
+	// +optional
+	// +immutable
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// UserProject is the project to be billed for requests made to the
+	// bucket. It must be set to manage resources of buckets that have
+	// Requester Pays enabled.
+	// +optional
+	UserProject *string `json:"userProject,omitempty"`
+
+	// Entity: The entity holding the permission, in one of the following
+	// forms:
+	// - user-userId
+	// - user-email
+	// - group-groupId
+	// - group-email
+	// - domain-domain
+	// - project-team-projectId
+	// - allUsers
+	// - allAuthenticatedUsers
+	// Examples:
+	// - The user liz@example.com would be user-liz@example.com.
+	// - The group example@googlegroups.com would be
+	// group-example@googlegroups.com.
+	// - To refer to all members of the Google Apps for Business domain
+	// example.com, the entity would be domain-example.com.
+	// +immutable
+	Entity string `json:"entity"`
+
+	// Role: The access permission for the entity.
+	// +kubebuilder:validation:Enum=OWNER;READER
+	Role string `json:"role"`
+}
+
+// DefaultObjectACLObservation is used to show the observed state of the
+// DefaultObjectACL.
+type DefaultObjectACLObservation struct {
+	// Domain: The domain associated with the entity, if any.
+	Domain string `json:"domain,omitempty"`
+
+	// Email: The email address associated with the entity, if any.
+	Email string `json:"email,omitempty"`
+
+	// EntityID: The ID for the entity, if any.
+	EntityID string `json:"entityId,omitempty"`
+
+	// Etag: HTTP 1.1 Entity tag for the access-control entry.
+	Etag string `json:"etag,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// DefaultObjectACLSpec defines the desired state of a DefaultObjectACL.
+type DefaultObjectACLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DefaultObjectACLParameters `json:"forProvider"`
+}
+
+// DefaultObjectACLStatus represents the observed state of a DefaultObjectACL.
+type DefaultObjectACLStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DefaultObjectACLObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DefaultObjectACL is a managed resource that represents a single entry of
+// the default object access-control list of a Google Cloud Storage bucket.
+// ACLs only take effect on buckets that do not use uniform bucket-level
+// access.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ENTITY",type="string",JSONPath=".spec.forProvider.entity"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type DefaultObjectACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DefaultObjectACLSpec   `json:"spec"`
+	Status DefaultObjectACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DefaultObjectACLList contains a list of DefaultObjectACL types
+type DefaultObjectACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DefaultObjectACL `json:"items"`
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// GetFailureReason of this BucketPolicy.
+func (mg *BucketPolicy) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this BucketPolicy.
+func (mg *BucketPolicy) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this BucketPolicyMember.
+func (mg *BucketPolicyMember) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this BucketPolicyMember.
+func (mg *BucketPolicyMember) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this SignedURL.
+func (mg *SignedURL) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this SignedURL.
+func (mg *SignedURL) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this ReportConfig.
+func (mg *ReportConfig) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this ReportConfig.
+func (mg *ReportConfig) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this BucketNotification.
+func (mg *BucketNotification) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this BucketNotification.
+func (mg *BucketNotification) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this BucketACL.
+func (mg *BucketACL) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this BucketACL.
+func (mg *BucketACL) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this DefaultObjectACL.
+func (mg *DefaultObjectACL) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this DefaultObjectACL.
+func (mg *DefaultObjectACL) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this BucketObject.
+func (mg *BucketObject) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this BucketObject.
+func (mg *BucketObject) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+
+// GetFailureReason of this HMACKey.
+func (mg *HMACKey) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this HMACKey.
+func (mg *HMACKey) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// HMAC key states.
+const (
+	HMACKeyStateActive   = "ACTIVE"
+	HMACKeyStateInactive = "INACTIVE"
+	HMACKeyStateDeleted  = "DELETED"
+)
+
+// HMACKeyParameters define the desired state of a Google Cloud Storage HMAC
+// key.
+// https://cloud.google.com/storage/docs/json_api/v1/projects/hmacKeys
+type HMACKeyParameters struct {
+	// ServiceAccountEmail: The email address of the key's associated
+	// service account.
+	// +optional
+	// +immutable
+	ServiceAccountEmail *string `json:"serviceAccountEmail,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount and retrieves its email
+	// address.
+	// +optional
+	// +immutable
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// State: The state of the key. Inactive keys cannot be used to
+	// authenticate requests.
+	// +optional
+	// +kubebuilder:validation:Enum=ACTIVE;INACTIVE
+	// +kubebuilder:default=ACTIVE
+	State *string `json:"state,omitempty"`
+}
+
+// HMACKeyObservation is used to show the observed state of the HMACKey.
+type HMACKeyObservation struct {
+	// AccessID: The ID of the HMAC Key.
+	AccessID string `json:"accessId,omitempty"`
+
+	// ID: The ID of the HMAC key, including the Project ID and the Access
+	// ID.
+	ID string `json:"id,omitempty"`
+
+	// ServiceAccountEmail: The email address of the key's associated
+	// service account.
+	ServiceAccountEmail string `json:"serviceAccountEmail,omitempty"`
+
+	// State: The state of the key.
+	State string `json:"state,omitempty"`
+
+	// TimeCreated: The creation time of the HMAC key in RFC 3339 format.
+	TimeCreated string `json:"timeCreated,omitempty"`
+
+	// Updated: The last modification time of the HMAC key metadata in RFC
+	// 3339 format.
+	Updated string `json:"updated,omitempty"`
+
+	// Etag: HTTP 1.1 Entity tag for the HMAC key.
+	Etag string `json:"etag,omitempty"`
+
+	// SelfLink: The link to this resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// HMACKeySpec defines the desired state of an HMACKey.
+type HMACKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HMACKeyParameters `json:"forProvider"`
+}
+
+// HMACKeyStatus represents the observed state of an HMACKey.
+type HMACKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HMACKeyObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HMACKey is a managed resource that represents a Google Cloud Storage HMAC
+// key of a service account. The access ID and secret of the key are written
+// to its connection secret; the secret is only available when the key is
+// created.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ACCESS_ID",type="string",JSONPath=".status.atProvider.accessId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type HMACKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HMACKeySpec   `json:"spec"`
+	Status HMACKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HMACKeyList contains a list of HMACKey types
+type HMACKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HMACKey `json:"items"`
+}
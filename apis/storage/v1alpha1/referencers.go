@@ -25,6 +25,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	pubsubv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
 	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
 )
 
@@ -97,5 +98,195 @@ func (in *BucketPolicyMember) ResolveReferences(ctx context.Context, c client.Re
 	in.Spec.ForProvider.Member = reference.ToPtrValue(rsp.ResolvedValue)
 	in.Spec.ForProvider.ServiceAccountMemberRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.members. Members resolved from references are
+	// added to the literal ones, so the references are resolved even when
+	// members are already set.
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		References: in.Spec.ForProvider.ServiceAccountMembersRefs,
+		Selector:   in.Spec.ForProvider.ServiceAccountMembersSelector,
+		To:         reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:    iamv1alpha1.ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.members")
+	}
+	in.Spec.ForProvider.Members = iamv1alpha1.MergeMembers(in.Spec.ForProvider.Members, mrsp.ResolvedValues)
+	in.Spec.ForProvider.ServiceAccountMembersRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this SignedURL
+func (in *SignedURL) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Bucket),
+		Reference:    in.Spec.ForProvider.BucketRef,
+		Selector:     in.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &v1alpha3.Bucket{}, List: &v1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	in.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ReportConfig
+func (in *ReportConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.sourceBucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.SourceBucket),
+		Reference:    in.Spec.ForProvider.SourceBucketRef,
+		Selector:     in.Spec.ForProvider.SourceBucketSelector,
+		To:           reference.To{Managed: &v1alpha3.Bucket{}, List: &v1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceBucket")
+	}
+	in.Spec.ForProvider.SourceBucket = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.SourceBucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destinationBucket
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.DestinationBucket),
+		Reference:    in.Spec.ForProvider.DestinationBucketRef,
+		Selector:     in.Spec.ForProvider.DestinationBucketSelector,
+		To:           reference.To{Managed: &v1alpha3.Bucket{}, List: &v1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destinationBucket")
+	}
+	in.Spec.ForProvider.DestinationBucket = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.DestinationBucketRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this BucketNotification
+func (in *BucketNotification) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Bucket),
+		Reference:    in.Spec.ForProvider.BucketRef,
+		Selector:     in.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &v1alpha3.Bucket{}, List: &v1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	in.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.topic
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Topic),
+		Reference:    in.Spec.ForProvider.TopicRef,
+		Selector:     in.Spec.ForProvider.TopicSelector,
+		To:           reference.To{Managed: &pubsubv1alpha1.Topic{}, List: &pubsubv1alpha1.TopicList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.topic")
+	}
+	in.Spec.ForProvider.Topic = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.TopicRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this BucketACL
+func (in *BucketACL) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Bucket),
+		Reference:    in.Spec.ForProvider.BucketRef,
+		Selector:     in.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &v1alpha3.Bucket{}, List: &v1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	in.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this DefaultObjectACL
+func (in *DefaultObjectACL) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Bucket),
+		Reference:    in.Spec.ForProvider.BucketRef,
+		Selector:     in.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &v1alpha3.Bucket{}, List: &v1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	in.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this HMACKey
+func (in *HMACKey) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.serviceAccountEmail
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.ServiceAccountEmail),
+		Reference:    in.Spec.ForProvider.ServiceAccountRef,
+		Selector:     in.Spec.ForProvider.ServiceAccountSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountEmail(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceAccountEmail")
+	}
+	in.Spec.ForProvider.ServiceAccountEmail = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceAccountRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this BucketObject
+func (in *BucketObject) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Bucket),
+		Reference:    in.Spec.ForProvider.BucketRef,
+		Selector:     in.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &v1alpha3.Bucket{}, List: &v1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	in.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
 	return nil
 }
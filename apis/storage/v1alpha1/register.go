@@ -53,6 +53,62 @@ var (
 	BucketPolicyMemberGroupVersionKind = SchemeGroupVersion.WithKind(BucketPolicyMemberKind)
 )
 
+// SignedURL type metadata.
+var (
+	SignedURLKind             = reflect.TypeOf(SignedURL{}).Name()
+	SignedURLGroupKind        = schema.GroupKind{Group: Group, Kind: SignedURLKind}.String()
+	SignedURLKindAPIVersion   = SignedURLKind + "." + SchemeGroupVersion.String()
+	SignedURLGroupVersionKind = SchemeGroupVersion.WithKind(SignedURLKind)
+)
+
+// ReportConfig type metadata.
+var (
+	ReportConfigKind             = reflect.TypeOf(ReportConfig{}).Name()
+	ReportConfigGroupKind        = schema.GroupKind{Group: Group, Kind: ReportConfigKind}.String()
+	ReportConfigKindAPIVersion   = ReportConfigKind + "." + SchemeGroupVersion.String()
+	ReportConfigGroupVersionKind = SchemeGroupVersion.WithKind(ReportConfigKind)
+)
+
+// BucketNotification type metadata.
+var (
+	BucketNotificationKind             = reflect.TypeOf(BucketNotification{}).Name()
+	BucketNotificationGroupKind        = schema.GroupKind{Group: Group, Kind: BucketNotificationKind}.String()
+	BucketNotificationKindAPIVersion   = BucketNotificationKind + "." + SchemeGroupVersion.String()
+	BucketNotificationGroupVersionKind = SchemeGroupVersion.WithKind(BucketNotificationKind)
+)
+
+// BucketACL type metadata.
+var (
+	BucketACLKind             = reflect.TypeOf(BucketACL{}).Name()
+	BucketACLGroupKind        = schema.GroupKind{Group: Group, Kind: BucketACLKind}.String()
+	BucketACLKindAPIVersion   = BucketACLKind + "." + SchemeGroupVersion.String()
+	BucketACLGroupVersionKind = SchemeGroupVersion.WithKind(BucketACLKind)
+)
+
+// DefaultObjectACL type metadata.
+var (
+	DefaultObjectACLKind             = reflect.TypeOf(DefaultObjectACL{}).Name()
+	DefaultObjectACLGroupKind        = schema.GroupKind{Group: Group, Kind: DefaultObjectACLKind}.String()
+	DefaultObjectACLKindAPIVersion   = DefaultObjectACLKind + "." + SchemeGroupVersion.String()
+	DefaultObjectACLGroupVersionKind = SchemeGroupVersion.WithKind(DefaultObjectACLKind)
+)
+
+// HMACKey type metadata.
+var (
+	HMACKeyKind             = reflect.TypeOf(HMACKey{}).Name()
+	HMACKeyGroupKind        = schema.GroupKind{Group: Group, Kind: HMACKeyKind}.String()
+	HMACKeyKindAPIVersion   = HMACKeyKind + "." + SchemeGroupVersion.String()
+	HMACKeyGroupVersionKind = SchemeGroupVersion.WithKind(HMACKeyKind)
+)
+
+// BucketObject type metadata.
+var (
+	BucketObjectKind             = reflect.TypeOf(BucketObject{}).Name()
+	BucketObjectGroupKind        = schema.GroupKind{Group: Group, Kind: BucketObjectKind}.String()
+	BucketObjectKindAPIVersion   = BucketObjectKind + "." + SchemeGroupVersion.String()
+	BucketObjectGroupVersionKind = SchemeGroupVersion.WithKind(BucketObjectKind)
+)
+
 func init() {
-	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{}, &BucketPolicyMember{}, &BucketPolicyMemberList{})
+	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{}, &BucketPolicyMember{}, &BucketPolicyMemberList{}, &SignedURL{}, &SignedURLList{}, &ReportConfig{}, &ReportConfigList{}, &BucketNotification{}, &BucketNotificationList{}, &BucketACL{}, &BucketACLList{}, &DefaultObjectACL{}, &DefaultObjectACLList{}, &HMACKey{}, &HMACKeyList{}, &BucketObject{}, &BucketObjectList{})
 }
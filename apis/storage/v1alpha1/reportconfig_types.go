@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// ReportConfigParameters define the desired state of a Storage Insights
+// inventory report config. Most fields map directly to a ReportConfig:
+// https://cloud.google.com/storage/docs/insights/reference/rest/v1/projects.locations.reportConfigs
+type ReportConfigParameters struct {
+	// Location: The location of the report config, e.g. us-central1. It
+	// must be the location of the source and destination buckets.
+	// +immutable
+	Location string `json:"location"`
+
+	// DisplayName: A user provided name for the report config.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// FrequencyOptions: How often and for which period inventory reports
+	// are generated.
+	FrequencyOptions FrequencyOptions `json:"frequencyOptions"`
+
+	// CSVOptions: Options for reports in CSV format. Exactly one of
+	// csvOptions or parquetOptions should be set.
+	// +optional
+	CSVOptions *CSVOptions `json:"csvOptions,omitempty"`
+
+	// ParquetOptions: Options for reports in Apache Parquet format.
+	// +optional
+	ParquetOptions *ParquetOptions `json:"parquetOptions,omitempty"`
+
+	// MetadataFields: The object metadata fields included in the report,
+	// e.g. project, bucket, name, size, storageClass and updated.
+	// +kubebuilder:validation:MinItems=1
+	MetadataFields []string `json:"metadataFields"`
+
+	// SourceBucket: The name of the bucket whose objects are reported.
+	// +optional
+	// +immutable
+	SourceBucket *string `json:"sourceBucket,omitempty"`
+
+	// SourceBucketRef references a Bucket and retrieves its name
+	// +optional
+	// +immutable
+	SourceBucketRef *xpv1.Reference `json:"sourceBucketRef,omitempty"`
+
+	// SourceBucketSelector selects a reference to a Bucket
+	// +optional
+	SourceBucketSelector *xpv1.Selector `json:"sourceBucketSelector,omitempty"`
+
+	// DestinationBucket: The name of the bucket reports are written to.
+	// +optional
+	DestinationBucket *string `json:"destinationBucket,omitempty"`
+
+	// DestinationBucketRef references a Bucket and retrieves its name
+	// +optional
+	DestinationBucketRef *xpv1.Reference `json:"destinationBucketRef,omitempty"`
+
+	// DestinationBucketSelector selects a reference to a Bucket
+	// +optional
+	DestinationBucketSelector *xpv1.Selector `json:"destinationBucketSelector,omitempty"`
+
+	// DestinationPath: The path within the destination bucket reports are
+	// written to.
+	// +optional
+	DestinationPath *string `json:"destinationPath,omitempty"`
+
+	// Labels: Labels as key value pairs.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// FrequencyOptions configure how often inventory reports are generated.
+type FrequencyOptions struct {
+	// Frequency: How often reports are generated.
+	// +kubebuilder:validation:Enum=DAILY;WEEKLY
+	Frequency string `json:"frequency"`
+
+	// StartDate: The date from which reports are generated.
+	StartDate Date `json:"startDate"`
+
+	// EndDate: The date after which reports are no longer generated.
+	EndDate Date `json:"endDate"`
+}
+
+// A Date is a whole calendar date.
+type Date struct {
+	// Year of the date.
+	Year int64 `json:"year"`
+
+	// Month of the year, from 1 to 12.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=12
+	Month int64 `json:"month"`
+
+	// Day of the month, from 1 to 31.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=31
+	Day int64 `json:"day"`
+}
+
+// CSVOptions configure reports in CSV format.
+type CSVOptions struct {
+	// Delimiter: The delimiter used to separate the fields.
+	// +optional
+	Delimiter *string `json:"delimiter,omitempty"`
+
+	// HeaderRequired: Whether the report includes a header row.
+	// +optional
+	HeaderRequired *bool `json:"headerRequired,omitempty"`
+
+	// RecordSeparator: The character used to separate the records.
+	// +optional
+	RecordSeparator *string `json:"recordSeparator,omitempty"`
+}
+
+// ParquetOptions configure reports in Apache Parquet format. There are
+// currently no options.
+type ParquetOptions struct{}
+
+// ReportConfigObservation is used to show the observed state of the
+// ReportConfig.
+type ReportConfigObservation struct {
+	// Name: The full name of the report config, i.e.
+	// projects/{project}/locations/{location}/reportConfigs/{id}.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime: The time at which the report config was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime: The time at which the report config was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// ReportConfigSpec defines the desired state of a ReportConfig.
+type ReportConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReportConfigParameters `json:"forProvider"`
+}
+
+// ReportConfigStatus represents the observed state of a ReportConfig.
+type ReportConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReportConfigObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReportConfig is a managed resource that represents a Google Cloud Storage
+// Insights inventory report config.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="FREQUENCY",type="string",JSONPath=".spec.forProvider.frequencyOptions.frequency"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ReportConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReportConfigSpec   `json:"spec"`
+	Status ReportConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReportConfigList contains a list of ReportConfig types
+type ReportConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReportConfig `json:"items"`
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// SignedURLParameters defines parameters for a desired V4 signed URL.
+// https://cloud.google.com/storage/docs/access-control/signed-urls
+type SignedURLParameters struct {
+	// Bucket: The name of the Bucket that contains the object.
+	// +optional
+	// +immutable
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket and retrieves its name
+	// +optional
+	// +immutable
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Object: The name of the object the URL grants access to. The object
+	// does not need to exist, e.g. for URLs used to upload it.
+	// +immutable
+	Object string `json:"object"`
+
+	// Method: The HTTP method the URL can be used with.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=GET;HEAD;PUT;POST;DELETE
+	// +kubebuilder:default=GET
+	Method *string `json:"method,omitempty"`
+
+	// ContentType: The Content-Type header the client must send when
+	// using the URL, e.g. to restrict the type of uploaded objects.
+	// +optional
+	// +immutable
+	ContentType *string `json:"contentType,omitempty"`
+
+	// TTL: How long each URL is valid for. V4 signed URLs are valid for at
+	// most 7 days. Defaults to one hour.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// RefreshBefore: How long before its expiry the URL is replaced by a
+	// new one. It should be longer than the poll interval of the provider.
+	// Defaults to 10 minutes.
+	// +optional
+	RefreshBefore *metav1.Duration `json:"refreshBefore,omitempty"`
+}
+
+// SignedURLObservation is used to show the observed state of the SignedURL.
+type SignedURLObservation struct {
+	// ExpireTime is the time at which the current URL expires, in RFC3339
+	// UTC "Zulu" format.
+	ExpireTime string `json:"expireTime,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// SignedURLSpec defines the desired state of a SignedURL.
+type SignedURLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SignedURLParameters `json:"forProvider"`
+}
+
+// SignedURLStatus represents the observed state of a SignedURL.
+type SignedURLStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SignedURLObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SignedURL is a managed resource that represents a V4 signed URL for an
+// object of a Google Cloud Storage bucket. The URL is published to the
+// connection secret of the resource and replaced before it expires.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="METHOD",type="string",JSONPath=".spec.forProvider.method"
+// +kubebuilder:printcolumn:name="EXPIRES_AT",type="string",JSONPath=".status.atProvider.expireTime"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type SignedURL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SignedURLSpec   `json:"spec"`
+	Status SignedURLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SignedURLList contains a list of SignedURL types
+type SignedURLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SignedURL `json:"items"`
+}
@@ -22,12 +22,15 @@ limitations under the License.
 package v1alpha1
 
 import (
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicy) DeepCopyInto(out *BucketPolicy) {
+func (in *BucketACL) DeepCopyInto(out *BucketACL) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -35,18 +38,18 @@ func (in *BucketPolicy) DeepCopyInto(out *BucketPolicy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicy.
-func (in *BucketPolicy) DeepCopy() *BucketPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketACL.
+func (in *BucketACL) DeepCopy() *BucketACL {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicy)
+	out := new(BucketACL)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BucketPolicy) DeepCopyObject() runtime.Object {
+func (in *BucketACL) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -54,31 +57,31 @@ func (in *BucketPolicy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicyList) DeepCopyInto(out *BucketPolicyList) {
+func (in *BucketACLList) DeepCopyInto(out *BucketACLList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]BucketPolicy, len(*in))
+		*out = make([]BucketACL, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyList.
-func (in *BucketPolicyList) DeepCopy() *BucketPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketACLList.
+func (in *BucketACLList) DeepCopy() *BucketACLList {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicyList)
+	out := new(BucketACLList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BucketPolicyList) DeepCopyObject() runtime.Object {
+func (in *BucketACLList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -86,7 +89,96 @@ func (in *BucketPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicyMember) DeepCopyInto(out *BucketPolicyMember) {
+func (in *BucketACLObservation) DeepCopyInto(out *BucketACLObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketACLObservation.
+func (in *BucketACLObservation) DeepCopy() *BucketACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketACLParameters) DeepCopyInto(out *BucketACLParameters) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserProject != nil {
+		in, out := &in.UserProject, &out.UserProject
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketACLParameters.
+func (in *BucketACLParameters) DeepCopy() *BucketACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketACLSpec) DeepCopyInto(out *BucketACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketACLSpec.
+func (in *BucketACLSpec) DeepCopy() *BucketACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketACLStatus) DeepCopyInto(out *BucketACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketACLStatus.
+func (in *BucketACLStatus) DeepCopy() *BucketACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketNotification) DeepCopyInto(out *BucketNotification) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -94,18 +186,18 @@ func (in *BucketPolicyMember) DeepCopyInto(out *BucketPolicyMember) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMember.
-func (in *BucketPolicyMember) DeepCopy() *BucketPolicyMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketNotification.
+func (in *BucketNotification) DeepCopy() *BucketNotification {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicyMember)
+	out := new(BucketNotification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BucketPolicyMember) DeepCopyObject() runtime.Object {
+func (in *BucketNotification) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -113,31 +205,31 @@ func (in *BucketPolicyMember) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicyMemberList) DeepCopyInto(out *BucketPolicyMemberList) {
+func (in *BucketNotificationList) DeepCopyInto(out *BucketNotificationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]BucketPolicyMember, len(*in))
+		*out = make([]BucketNotification, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMemberList.
-func (in *BucketPolicyMemberList) DeepCopy() *BucketPolicyMemberList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketNotificationList.
+func (in *BucketNotificationList) DeepCopy() *BucketNotificationList {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicyMemberList)
+	out := new(BucketNotificationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BucketPolicyMemberList) DeepCopyObject() runtime.Object {
+func (in *BucketNotificationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -145,7 +237,27 @@ func (in *BucketPolicyMemberList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicyMemberParameters) DeepCopyInto(out *BucketPolicyMemberParameters) {
+func (in *BucketNotificationObservation) DeepCopyInto(out *BucketNotificationObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketNotificationObservation.
+func (in *BucketNotificationObservation) DeepCopy() *BucketNotificationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketNotificationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketNotificationParameters) DeepCopyInto(out *BucketNotificationParameters) {
 	*out = *in
 	if in.Bucket != nil {
 		in, out := &in.Bucket, &out.Bucket
@@ -162,83 +274,200 @@ func (in *BucketPolicyMemberParameters) DeepCopyInto(out *BucketPolicyMemberPara
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Member != nil {
-		in, out := &in.Member, &out.Member
+	if in.UserProject != nil {
+		in, out := &in.UserProject, &out.UserProject
 		*out = new(string)
 		**out = **in
 	}
-	if in.ServiceAccountMemberRef != nil {
-		in, out := &in.ServiceAccountMemberRef, &out.ServiceAccountMemberRef
+	if in.Topic != nil {
+		in, out := &in.Topic, &out.Topic
+		*out = new(string)
+		**out = **in
+	}
+	if in.TopicRef != nil {
+		in, out := &in.TopicRef, &out.TopicRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ServiceAccountMemberSelector != nil {
-		in, out := &in.ServiceAccountMemberSelector, &out.ServiceAccountMemberSelector
+	if in.TopicSelector != nil {
+		in, out := &in.TopicSelector, &out.TopicSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObjectNamePrefix != nil {
+		in, out := &in.ObjectNamePrefix, &out.ObjectNamePrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomAttributes != nil {
+		in, out := &in.CustomAttributes, &out.CustomAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GrantPublisher != nil {
+		in, out := &in.GrantPublisher, &out.GrantPublisher
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMemberParameters.
-func (in *BucketPolicyMemberParameters) DeepCopy() *BucketPolicyMemberParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketNotificationParameters.
+func (in *BucketNotificationParameters) DeepCopy() *BucketNotificationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicyMemberParameters)
+	out := new(BucketNotificationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicyMemberSpec) DeepCopyInto(out *BucketPolicyMemberSpec) {
+func (in *BucketNotificationSpec) DeepCopyInto(out *BucketNotificationSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMemberSpec.
-func (in *BucketPolicyMemberSpec) DeepCopy() *BucketPolicyMemberSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketNotificationSpec.
+func (in *BucketNotificationSpec) DeepCopy() *BucketNotificationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicyMemberSpec)
+	out := new(BucketNotificationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicyMemberStatus) DeepCopyInto(out *BucketPolicyMemberStatus) {
+func (in *BucketNotificationStatus) DeepCopyInto(out *BucketNotificationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMemberStatus.
-func (in *BucketPolicyMemberStatus) DeepCopy() *BucketPolicyMemberStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketNotificationStatus.
+func (in *BucketNotificationStatus) DeepCopy() *BucketNotificationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicyMemberStatus)
+	out := new(BucketNotificationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicyObservation) DeepCopyInto(out *BucketPolicyObservation) {
+func (in *BucketObject) DeepCopyInto(out *BucketObject) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyObservation.
-func (in *BucketPolicyObservation) DeepCopy() *BucketPolicyObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObject.
+func (in *BucketObject) DeepCopy() *BucketObject {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicyObservation)
+	out := new(BucketObject)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketObject) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicyParameters) DeepCopyInto(out *BucketPolicyParameters) {
+func (in *BucketObjectContentSource) DeepCopyInto(out *BucketObjectContentSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObjectContentSource.
+func (in *BucketObjectContentSource) DeepCopy() *BucketObjectContentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketObjectContentSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketObjectList) DeepCopyInto(out *BucketObjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BucketObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObjectList.
+func (in *BucketObjectList) DeepCopy() *BucketObjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketObjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketObjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketObjectObservation) DeepCopyInto(out *BucketObjectObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObjectObservation.
+func (in *BucketObjectObservation) DeepCopy() *BucketObjectObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketObjectObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketObjectParameters) DeepCopyInto(out *BucketObjectParameters) {
 	*out = *in
 	if in.Bucket != nil {
 		in, out := &in.Bucket, &out.Bucket
@@ -255,49 +484,1201 @@ func (in *BucketPolicyParameters) DeepCopyInto(out *BucketPolicyParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	in.Policy.DeepCopyInto(&out.Policy)
+	if in.UserProject != nil {
+		in, out := &in.UserProject, &out.UserProject
+		*out = new(string)
+		**out = **in
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentFrom != nil {
+		in, out := &in.ContentFrom, &out.ContentFrom
+		*out = new(BucketObjectContentSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheControl != nil {
+		in, out := &in.CacheControl, &out.CacheControl
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyParameters.
-func (in *BucketPolicyParameters) DeepCopy() *BucketPolicyParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObjectParameters.
+func (in *BucketObjectParameters) DeepCopy() *BucketObjectParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicyParameters)
+	out := new(BucketObjectParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicySpec) DeepCopyInto(out *BucketPolicySpec) {
+func (in *BucketObjectSpec) DeepCopyInto(out *BucketObjectSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicySpec.
-func (in *BucketPolicySpec) DeepCopy() *BucketPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObjectSpec.
+func (in *BucketObjectSpec) DeepCopy() *BucketObjectSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicySpec)
+	out := new(BucketObjectSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketPolicyStatus) DeepCopyInto(out *BucketPolicyStatus) {
+func (in *BucketObjectStatus) DeepCopyInto(out *BucketObjectStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyStatus.
-func (in *BucketPolicyStatus) DeepCopy() *BucketPolicyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObjectStatus.
+func (in *BucketObjectStatus) DeepCopy() *BucketObjectStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketPolicyStatus)
+	out := new(BucketObjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicy) DeepCopyInto(out *BucketPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicy.
+func (in *BucketPolicy) DeepCopy() *BucketPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicyList) DeepCopyInto(out *BucketPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BucketPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyList.
+func (in *BucketPolicyList) DeepCopy() *BucketPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicyMember) DeepCopyInto(out *BucketPolicyMember) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMember.
+func (in *BucketPolicyMember) DeepCopy() *BucketPolicyMember {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicyMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketPolicyMember) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicyMemberList) DeepCopyInto(out *BucketPolicyMemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BucketPolicyMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMemberList.
+func (in *BucketPolicyMemberList) DeepCopy() *BucketPolicyMemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicyMemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketPolicyMemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicyMemberObservation) DeepCopyInto(out *BucketPolicyMemberObservation) {
+	*out = *in
+	if in.BoundMembers != nil {
+		in, out := &in.BoundMembers, &out.BoundMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMemberObservation.
+func (in *BucketPolicyMemberObservation) DeepCopy() *BucketPolicyMemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicyMemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicyMemberParameters) DeepCopyInto(out *BucketPolicyMemberParameters) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserProject != nil {
+		in, out := &in.UserProject, &out.UserProject
+		*out = new(string)
+		**out = **in
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(iamv1alpha1.Expr)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectPrefixCondition != nil {
+		in, out := &in.ObjectPrefixCondition, &out.ObjectPrefixCondition
+		*out = new(ObjectPrefixCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Member != nil {
+		in, out := &in.Member, &out.Member
+		*out = new(string)
+		**out = **in
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccountMemberRef != nil {
+		in, out := &in.ServiceAccountMemberRef, &out.ServiceAccountMemberRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountMemberSelector != nil {
+		in, out := &in.ServiceAccountMemberSelector, &out.ServiceAccountMemberSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountMembersRefs != nil {
+		in, out := &in.ServiceAccountMembersRefs, &out.ServiceAccountMembersRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceAccountMembersSelector != nil {
+		in, out := &in.ServiceAccountMembersSelector, &out.ServiceAccountMembersSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMemberParameters.
+func (in *BucketPolicyMemberParameters) DeepCopy() *BucketPolicyMemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicyMemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicyMemberSpec) DeepCopyInto(out *BucketPolicyMemberSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMemberSpec.
+func (in *BucketPolicyMemberSpec) DeepCopy() *BucketPolicyMemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicyMemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicyMemberStatus) DeepCopyInto(out *BucketPolicyMemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyMemberStatus.
+func (in *BucketPolicyMemberStatus) DeepCopy() *BucketPolicyMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicyMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicyObservation) DeepCopyInto(out *BucketPolicyObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyObservation.
+func (in *BucketPolicyObservation) DeepCopy() *BucketPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicyParameters) DeepCopyInto(out *BucketPolicyParameters) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserProject != nil {
+		in, out := &in.UserProject, &out.UserProject
+		*out = new(string)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	in.Policy.DeepCopyInto(&out.Policy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyParameters.
+func (in *BucketPolicyParameters) DeepCopy() *BucketPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicySpec) DeepCopyInto(out *BucketPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicySpec.
+func (in *BucketPolicySpec) DeepCopy() *BucketPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPolicyStatus) DeepCopyInto(out *BucketPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyStatus.
+func (in *BucketPolicyStatus) DeepCopy() *BucketPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSVOptions) DeepCopyInto(out *CSVOptions) {
+	*out = *in
+	if in.Delimiter != nil {
+		in, out := &in.Delimiter, &out.Delimiter
+		*out = new(string)
+		**out = **in
+	}
+	if in.HeaderRequired != nil {
+		in, out := &in.HeaderRequired, &out.HeaderRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RecordSeparator != nil {
+		in, out := &in.RecordSeparator, &out.RecordSeparator
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSVOptions.
+func (in *CSVOptions) DeepCopy() *CSVOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CSVOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Date) DeepCopyInto(out *Date) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Date.
+func (in *Date) DeepCopy() *Date {
+	if in == nil {
+		return nil
+	}
+	out := new(Date)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultObjectACL) DeepCopyInto(out *DefaultObjectACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultObjectACL.
+func (in *DefaultObjectACL) DeepCopy() *DefaultObjectACL {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultObjectACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultObjectACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultObjectACLList) DeepCopyInto(out *DefaultObjectACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DefaultObjectACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultObjectACLList.
+func (in *DefaultObjectACLList) DeepCopy() *DefaultObjectACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultObjectACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultObjectACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultObjectACLObservation) DeepCopyInto(out *DefaultObjectACLObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultObjectACLObservation.
+func (in *DefaultObjectACLObservation) DeepCopy() *DefaultObjectACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultObjectACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultObjectACLParameters) DeepCopyInto(out *DefaultObjectACLParameters) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserProject != nil {
+		in, out := &in.UserProject, &out.UserProject
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultObjectACLParameters.
+func (in *DefaultObjectACLParameters) DeepCopy() *DefaultObjectACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultObjectACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultObjectACLSpec) DeepCopyInto(out *DefaultObjectACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultObjectACLSpec.
+func (in *DefaultObjectACLSpec) DeepCopy() *DefaultObjectACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultObjectACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultObjectACLStatus) DeepCopyInto(out *DefaultObjectACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultObjectACLStatus.
+func (in *DefaultObjectACLStatus) DeepCopy() *DefaultObjectACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultObjectACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrequencyOptions) DeepCopyInto(out *FrequencyOptions) {
+	*out = *in
+	out.StartDate = in.StartDate
+	out.EndDate = in.EndDate
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrequencyOptions.
+func (in *FrequencyOptions) DeepCopy() *FrequencyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(FrequencyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACKey) DeepCopyInto(out *HMACKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACKey.
+func (in *HMACKey) DeepCopy() *HMACKey {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HMACKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACKeyList) DeepCopyInto(out *HMACKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HMACKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACKeyList.
+func (in *HMACKeyList) DeepCopy() *HMACKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HMACKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACKeyObservation) DeepCopyInto(out *HMACKeyObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACKeyObservation.
+func (in *HMACKeyObservation) DeepCopy() *HMACKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACKeyParameters) DeepCopyInto(out *HMACKeyParameters) {
+	*out = *in
+	if in.ServiceAccountEmail != nil {
+		in, out := &in.ServiceAccountEmail, &out.ServiceAccountEmail
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountSelector != nil {
+		in, out := &in.ServiceAccountSelector, &out.ServiceAccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACKeyParameters.
+func (in *HMACKeyParameters) DeepCopy() *HMACKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACKeySpec) DeepCopyInto(out *HMACKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACKeySpec.
+func (in *HMACKeySpec) DeepCopy() *HMACKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACKeyStatus) DeepCopyInto(out *HMACKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACKeyStatus.
+func (in *HMACKeyStatus) DeepCopy() *HMACKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectPrefixCondition) DeepCopyInto(out *ObjectPrefixCondition) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectPrefixCondition.
+func (in *ObjectPrefixCondition) DeepCopy() *ObjectPrefixCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectPrefixCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParquetOptions) DeepCopyInto(out *ParquetOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParquetOptions.
+func (in *ParquetOptions) DeepCopy() *ParquetOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ParquetOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfig) DeepCopyInto(out *ReportConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfig.
+func (in *ReportConfig) DeepCopy() *ReportConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReportConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfigList) DeepCopyInto(out *ReportConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReportConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfigList.
+func (in *ReportConfigList) DeepCopy() *ReportConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReportConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfigObservation) DeepCopyInto(out *ReportConfigObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfigObservation.
+func (in *ReportConfigObservation) DeepCopy() *ReportConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfigParameters) DeepCopyInto(out *ReportConfigParameters) {
+	*out = *in
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	out.FrequencyOptions = in.FrequencyOptions
+	if in.CSVOptions != nil {
+		in, out := &in.CSVOptions, &out.CSVOptions
+		*out = new(CSVOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParquetOptions != nil {
+		in, out := &in.ParquetOptions, &out.ParquetOptions
+		*out = new(ParquetOptions)
+		**out = **in
+	}
+	if in.MetadataFields != nil {
+		in, out := &in.MetadataFields, &out.MetadataFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceBucket != nil {
+		in, out := &in.SourceBucket, &out.SourceBucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceBucketRef != nil {
+		in, out := &in.SourceBucketRef, &out.SourceBucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceBucketSelector != nil {
+		in, out := &in.SourceBucketSelector, &out.SourceBucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationBucket != nil {
+		in, out := &in.DestinationBucket, &out.DestinationBucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationBucketRef != nil {
+		in, out := &in.DestinationBucketRef, &out.DestinationBucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationBucketSelector != nil {
+		in, out := &in.DestinationBucketSelector, &out.DestinationBucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationPath != nil {
+		in, out := &in.DestinationPath, &out.DestinationPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfigParameters.
+func (in *ReportConfigParameters) DeepCopy() *ReportConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfigSpec) DeepCopyInto(out *ReportConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfigSpec.
+func (in *ReportConfigSpec) DeepCopy() *ReportConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportConfigStatus) DeepCopyInto(out *ReportConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportConfigStatus.
+func (in *ReportConfigStatus) DeepCopy() *ReportConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignedURL) DeepCopyInto(out *SignedURL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignedURL.
+func (in *SignedURL) DeepCopy() *SignedURL {
+	if in == nil {
+		return nil
+	}
+	out := new(SignedURL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SignedURL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignedURLList) DeepCopyInto(out *SignedURLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SignedURL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignedURLList.
+func (in *SignedURLList) DeepCopy() *SignedURLList {
+	if in == nil {
+		return nil
+	}
+	out := new(SignedURLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SignedURLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignedURLObservation) DeepCopyInto(out *SignedURLObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignedURLObservation.
+func (in *SignedURLObservation) DeepCopy() *SignedURLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SignedURLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignedURLParameters) DeepCopyInto(out *SignedURLParameters) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RefreshBefore != nil {
+		in, out := &in.RefreshBefore, &out.RefreshBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignedURLParameters.
+func (in *SignedURLParameters) DeepCopy() *SignedURLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SignedURLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignedURLSpec) DeepCopyInto(out *SignedURLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignedURLSpec.
+func (in *SignedURLSpec) DeepCopy() *SignedURLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SignedURLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignedURLStatus) DeepCopyInto(out *SignedURLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignedURLStatus.
+func (in *SignedURLStatus) DeepCopy() *SignedURLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SignedURLStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	kmsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
+)
+
+// ResolveReferences of this Bucket
+func (in *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.encryption.defaultKmsKeyName
+	if in.Spec.Encryption != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: in.Spec.Encryption.DefaultKMSKeyName,
+			Reference:    in.Spec.Encryption.DefaultKMSKeyNameRef,
+			Selector:     in.Spec.Encryption.DefaultKMSKeyNameSelector,
+			To:           reference.To{Managed: &kmsv1alpha1.CryptoKey{}, List: &kmsv1alpha1.CryptoKeyList{}},
+			Extract:      kmsv1alpha1.CryptoKeyRRN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.encryption.defaultKmsKeyName")
+		}
+		in.Spec.Encryption.DefaultKMSKeyName = rsp.ResolvedValue
+		in.Spec.Encryption.DefaultKMSKeyNameRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.logging.logBucket
+	if in.Spec.Logging != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: in.Spec.Logging.LogBucket,
+			Reference:    in.Spec.Logging.LogBucketRef,
+			Selector:     in.Spec.Logging.LogBucketSelector,
+			To:           reference.To{Managed: &Bucket{}, List: &BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.logging.logBucket")
+		}
+		in.Spec.Logging.LogBucket = rsp.ResolvedValue
+		in.Spec.Logging.LogBucketRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -23,6 +23,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 )
 
 // ProjectTeam is the project team associated with the entity, if any.
@@ -142,9 +144,11 @@ type LifecycleAction struct {
 
 	// Type is the type of action to take on matching objects.
 	//
-	// Acceptable values are "Delete" to delete matching objects and
+	// Acceptable values are "Delete" to delete matching objects,
 	// "SetStorageClass" to set the storage class defined in StorageClass on
-	// matching objects.
+	// matching objects and "AbortIncompleteMultipartUpload" to abort
+	// incomplete multipart uploads.
+	// +kubebuilder:validation:Enum=Delete;SetStorageClass;AbortIncompleteMultipartUpload
 	Type string `json:"type,omitempty"`
 }
 
@@ -178,9 +182,30 @@ type LifecycleCondition struct {
 	// +optional
 	CreatedBefore *metav1.Time `json:"createdBefore,omitempty"`
 
+	// CustomTimeBefore is the date in the Custom-Time metadata of the object.
+	//
+	// This condition is satisfied when the Custom-Time of an object is before
+	// midnight of the specified date in UTC.
+	// +optional
+	CustomTimeBefore *metav1.Time `json:"customTimeBefore,omitempty"`
+
+	// DaysSinceCustomTime is the number of days elapsed since the Custom-Time
+	// of the object.
+	// +optional
+	DaysSinceCustomTime int64 `json:"daysSinceCustomTime,omitempty"`
+
+	// DaysSinceNoncurrentTime is the number of days elapsed since the object
+	// became noncurrent. Relevant only for versioned objects.
+	// +optional
+	DaysSinceNoncurrentTime int64 `json:"daysSinceNoncurrentTime,omitempty"`
+
 	// Liveness specifies the object's liveness. Relevant only for versioned objects
 	Liveness storage.Liveness `json:"liveness,omitempty"`
 
+	// MatchesPrefix is the condition matching the object's name prefixes.
+	// +optional
+	MatchesPrefix []string `json:"matchesPrefix,omitempty"`
+
 	// MatchesStorageClasses is the condition matching the object's storage
 	// class.
 	//
@@ -188,6 +213,18 @@ type LifecycleCondition struct {
 	// "STANDARD", and "DURABLE_REDUCED_AVAILABILITY".
 	MatchesStorageClasses []string `json:"matchesStorageClasses,omitempty"`
 
+	// MatchesSuffix is the condition matching the object's name suffixes.
+	// +optional
+	MatchesSuffix []string `json:"matchesSuffix,omitempty"`
+
+	// NoncurrentTimeBefore is the date the object became noncurrent.
+	//
+	// This condition is satisfied when an object became noncurrent before
+	// midnight of the specified date in UTC. Relevant only for versioned
+	// objects.
+	// +optional
+	NoncurrentTimeBefore *metav1.Time `json:"noncurrentTimeBefore,omitempty"`
+
 	// NumNewerVersions is the condition matching objects with a number of newer versions.
 	//
 	// If the value is N, this condition is satisfied when there are at least N
@@ -196,31 +233,56 @@ type LifecycleCondition struct {
 	NumNewerVersions int64 `json:"numNewerVersions,omitempty"`
 }
 
+// newTime returns the supplied time, or nil if it is zero, so that unset
+// dates of a lifecycle condition compare equal to the dates returned by the
+// storage client.
+func newTime(t time.Time) *metav1.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &metav1.Time{Time: t}
+}
+
+// copyToTime returns the supplied time, or the zero time if it is nil.
+func copyToTime(t *metav1.Time) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	return t.Time
+}
+
 // NewLifecycleCondition creates a new instance of LifecycleCondition from the storage counterpart
 func NewLifecycleCondition(lc storage.LifecycleCondition) LifecycleCondition {
 	return LifecycleCondition{
-		AgeInDays:             lc.AgeInDays,
-		CreatedBefore:         &metav1.Time{Time: lc.CreatedBefore},
-		Liveness:              lc.Liveness,
-		MatchesStorageClasses: lc.MatchesStorageClasses,
-		NumNewerVersions:      lc.NumNewerVersions,
+		AgeInDays:               lc.AgeInDays,
+		CreatedBefore:           newTime(lc.CreatedBefore),
+		CustomTimeBefore:        newTime(lc.CustomTimeBefore),
+		DaysSinceCustomTime:     lc.DaysSinceCustomTime,
+		DaysSinceNoncurrentTime: lc.DaysSinceNoncurrentTime,
+		Liveness:                lc.Liveness,
+		MatchesPrefix:           lc.MatchesPrefix,
+		MatchesStorageClasses:   lc.MatchesStorageClasses,
+		MatchesSuffix:           lc.MatchesSuffix,
+		NoncurrentTimeBefore:    newTime(lc.NoncurrentTimeBefore),
+		NumNewerVersions:        lc.NumNewerVersions,
 	}
 }
 
 // CopyToLifecycleCondition create a copy in storage format
 func CopyToLifecycleCondition(lc LifecycleCondition) storage.LifecycleCondition {
-	slc := storage.LifecycleCondition{
-		AgeInDays:             lc.AgeInDays,
-		Liveness:              lc.Liveness,
-		MatchesStorageClasses: lc.MatchesStorageClasses,
-		NumNewerVersions:      lc.NumNewerVersions,
+	return storage.LifecycleCondition{
+		AgeInDays:               lc.AgeInDays,
+		CreatedBefore:           copyToTime(lc.CreatedBefore),
+		CustomTimeBefore:        copyToTime(lc.CustomTimeBefore),
+		DaysSinceCustomTime:     lc.DaysSinceCustomTime,
+		DaysSinceNoncurrentTime: lc.DaysSinceNoncurrentTime,
+		Liveness:                lc.Liveness,
+		MatchesPrefix:           lc.MatchesPrefix,
+		MatchesStorageClasses:   lc.MatchesStorageClasses,
+		MatchesSuffix:           lc.MatchesSuffix,
+		NoncurrentTimeBefore:    copyToTime(lc.NoncurrentTimeBefore),
+		NumNewerVersions:        lc.NumNewerVersions,
 	}
-
-	if !lc.CreatedBefore.IsZero() {
-		slc.CreatedBefore = lc.CreatedBefore.Time
-	}
-
-	return slc
 }
 
 // LifecycleRule is a lifecycle configuration rule.
@@ -255,7 +317,11 @@ func CopyToLifecyleRule(lr LifecycleRule) storage.LifecycleRule {
 
 // Lifecycle is the lifecycle configuration for objects in the bucket.
 type Lifecycle struct {
-	Rules []LifecycleRule `json:"rules,omitempty"`
+	// Rules are the lifecycle rules of the bucket. The rules of an existing
+	// bucket are adopted when they are omitted, while an empty list removes
+	// them.
+	// +optional
+	Rules []LifecycleRule `json:"rules"`
 }
 
 // NewLifecycle creates a new instance of Lifecycle from the storage counterpart
@@ -356,13 +422,54 @@ func NewRetentionPolicyStatus(r *storage.RetentionPolicy) *RetentionPolicyStatus
 	}
 }
 
+// SoftDeletePolicy retains deleted objects for a period during which they can
+// be restored.
+type SoftDeletePolicy struct {
+	// RetentionDurationSeconds is the duration in seconds that soft-deleted
+	// objects are retained for. It must be either 0, which disables soft
+	// delete, or between 7 and 90 days.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=7776000
+	RetentionDurationSeconds int64 `json:"retentionDurationSeconds"`
+}
+
+// NewSoftDeletePolicy creates a new instance of SoftDeletePolicy from the
+// storage counterpart
+func NewSoftDeletePolicy(p *storage.SoftDeletePolicy) *SoftDeletePolicy {
+	if p == nil {
+		return nil
+	}
+	return &SoftDeletePolicy{
+		RetentionDurationSeconds: int64(p.RetentionDuration.Seconds()),
+	}
+}
+
+// CopyToSoftDeletePolicy create a copy in storage format
+func CopyToSoftDeletePolicy(p *SoftDeletePolicy) *storage.SoftDeletePolicy {
+	if p == nil {
+		return nil
+	}
+	return &storage.SoftDeletePolicy{
+		RetentionDuration: time.Duration(p.RetentionDurationSeconds) * time.Second,
+	}
+}
+
 // BucketEncryption is a bucket's encryption configuration.
 type BucketEncryption struct {
 	// A Cloud KMS key name, in the form
 	// projects/P/locations/L/keyRings/R/cryptoKeys/K, that will be used to encrypt
 	// objects inserted into this bucket, if no encryption method is specified.
 	// The key's location must be the same as the bucket's.
+	// +optional
 	DefaultKMSKeyName string `json:"defaultKmsKeyName,omitempty"`
+
+	// DefaultKMSKeyNameRef references a CryptoKey and retrieves its name
+	// +optional
+	DefaultKMSKeyNameRef *xpv1.Reference `json:"defaultKmsKeyNameRef,omitempty"`
+
+	// DefaultKMSKeyNameSelector selects a reference to a CryptoKey
+	// +optional
+	DefaultKMSKeyNameSelector *xpv1.Selector `json:"defaultKmsKeyNameSelector,omitempty"`
 }
 
 // NewBucketEncryption creates a new instance of BucketEncryption from the storage counterpart
@@ -387,12 +494,22 @@ func CopyToBucketEncryption(e *BucketEncryption) *storage.BucketEncryption {
 
 // BucketLogging holds the bucket's logging configuration, which defines the
 // destination bucket and optional name prefix for the current bucket's
-// logs.
+// logs. An empty logging configuration disables access and storage logging
+// for the bucket.
 type BucketLogging struct {
 	// The destination bucket where the current bucket's logs
 	// should be placed.
+	// +optional
 	LogBucket string `json:"logBucket,omitempty"`
 
+	// LogBucketRef references a Bucket and retrieves its external name
+	// +optional
+	LogBucketRef *xpv1.Reference `json:"logBucketRef,omitempty"`
+
+	// LogBucketSelector selects a reference to a Bucket
+	// +optional
+	LogBucketSelector *xpv1.Selector `json:"logBucketSelector,omitempty"`
+
 	// A prefix for log object names.
 	LogObjectPrefix string `json:"logObjectPrefix,omitempty"`
 }
@@ -419,10 +536,41 @@ func CopyToBucketLogging(l *BucketLogging) *storage.BucketLogging {
 	}
 }
 
+// CustomPlacementConfig holds the bucket's custom placement configuration,
+// which defines the regions of a dual-region bucket.
+type CustomPlacementConfig struct {
+	// DataLocations is the pair of regions the bucket's data is replicated
+	// across, e.g. US-EAST1 and US-WEST1.
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=2
+	DataLocations []string `json:"dataLocations"`
+}
+
+// NewCustomPlacementConfig creates a new instance of CustomPlacementConfig
+// from the storage counterpart
+func NewCustomPlacementConfig(c *storage.CustomPlacementConfig) *CustomPlacementConfig {
+	if c == nil {
+		return nil
+	}
+	return &CustomPlacementConfig{
+		DataLocations: c.DataLocations,
+	}
+}
+
+// CopyToCustomPlacementConfig create a copy in storage format
+func CopyToCustomPlacementConfig(c *CustomPlacementConfig) *storage.CustomPlacementConfig {
+	if c == nil {
+		return nil
+	}
+	return &storage.CustomPlacementConfig{
+		DataLocations: c.DataLocations,
+	}
+}
+
 // CORS is the bucket's Cross-Origin Resource Sharing (CORS) configuration.
 type CORS struct {
 	// MaxAge is the value to return in the Access-Control-Max-Age
-	// header used in preflight responses.
+	// header used in preflight responses. It is stored in whole seconds.
 	MaxAge metav1.Duration `json:"maxAge,omitempty"`
 
 	// Methods is the list of HTTP methods on which to include CORS response
@@ -557,6 +705,75 @@ func CopyToBucketPolicyOnly(bp *BucketPolicyOnly) storage.BucketPolicyOnly {
 	}
 }
 
+// Public access prevention settings of a bucket.
+const (
+	PublicAccessPreventionEnforced  = "enforced"
+	PublicAccessPreventionInherited = "inherited"
+)
+
+// UniformBucketLevelAccess configures access checks to use only bucket-level
+// IAM policies.
+type UniformBucketLevelAccess struct {
+	// Enabled specifies whether access checks use only bucket-level IAM
+	// policies. Enabled may be disabled until the locked time.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// BucketIAMConfiguration is the bucket's IAM configuration.
+type BucketIAMConfiguration struct {
+	// UniformBucketLevelAccess configures access checks to use only
+	// bucket-level IAM policies. It takes precedence over BucketPolicyOnly
+	// when both are set.
+	// +optional
+	UniformBucketLevelAccess *UniformBucketLevelAccess `json:"uniformBucketLevelAccess,omitempty"`
+
+	// PublicAccessPrevention prevents public access to the bucket and its
+	// objects when set to "enforced". "inherited" defers to the
+	// organization policy of the project.
+	// +optional
+	// +kubebuilder:validation:Enum=enforced;inherited
+	PublicAccessPrevention *string `json:"publicAccessPrevention,omitempty"`
+}
+
+// NewBucketIAMConfiguration creates new instance based on the storage object
+func NewBucketIAMConfiguration(ba *storage.BucketAttrs) *BucketIAMConfiguration {
+	if !ba.UniformBucketLevelAccess.Enabled && ba.PublicAccessPrevention == storage.PublicAccessPreventionUnknown {
+		return nil
+	}
+	enabled := ba.UniformBucketLevelAccess.Enabled
+	c := &BucketIAMConfiguration{
+		UniformBucketLevelAccess: &UniformBucketLevelAccess{Enabled: &enabled},
+	}
+	if ba.PublicAccessPrevention != storage.PublicAccessPreventionUnknown {
+		pap := ba.PublicAccessPrevention.String()
+		c.PublicAccessPrevention = &pap
+	}
+	return c
+}
+
+// CopyToUniformBucketLevelAccess creates storage equivalent
+func CopyToUniformBucketLevelAccess(c *BucketIAMConfiguration) *storage.UniformBucketLevelAccess {
+	if c == nil || c.UniformBucketLevelAccess == nil || c.UniformBucketLevelAccess.Enabled == nil {
+		return nil
+	}
+	return &storage.UniformBucketLevelAccess{Enabled: *c.UniformBucketLevelAccess.Enabled}
+}
+
+// CopyToPublicAccessPrevention creates storage equivalent
+func CopyToPublicAccessPrevention(c *BucketIAMConfiguration) storage.PublicAccessPrevention {
+	if c == nil || c.PublicAccessPrevention == nil {
+		return storage.PublicAccessPreventionUnknown
+	}
+	switch *c.PublicAccessPrevention {
+	case PublicAccessPreventionEnforced:
+		return storage.PublicAccessPreventionEnforced
+	case PublicAccessPreventionInherited:
+		return storage.PublicAccessPreventionInherited
+	}
+	return storage.PublicAccessPreventionUnknown
+}
+
 // BucketUpdatableAttrs represents the subset of parameters of a Google Cloud
 // Storage bucket that may be updated.
 type BucketUpdatableAttrs struct {
@@ -564,8 +781,12 @@ type BucketUpdatableAttrs struct {
 	// policies.
 	BucketPolicyOnly *BucketPolicyOnly `json:"bucketPolicyOnly,omitempty"`
 
-	// The bucket's Cross-Origin Resource Sharing (CORS) configuration.
-	CORS []CORS `json:"cors,omitempty"`
+	// The bucket's Cross-Origin Resource Sharing (CORS) configuration. It
+	// replaces any CORS configuration that is made out of band. The CORS
+	// configuration of an existing bucket is adopted when it is omitted,
+	// while an empty list removes it.
+	// +optional
+	CORS []CORS `json:"cors"`
 
 	// DefaultEventBasedHold is the default value for event-based hold on
 	// newly created objects in this bucket. It defaults to false.
@@ -580,6 +801,11 @@ type BucketUpdatableAttrs struct {
 	// Lifecycle is the lifecycle configuration for objects in the bucket.
 	Lifecycle Lifecycle `json:"lifecycle,omitempty"`
 
+	// IAMConfiguration configures uniform bucket-level access and public
+	// access prevention for the bucket.
+	// +optional
+	IAMConfiguration *BucketIAMConfiguration `json:"iamConfiguration,omitempty"`
+
 	// The logging configuration.
 	Logging *BucketLogging `json:"logging,omitempty"`
 
@@ -612,6 +838,12 @@ type BucketUpdatableAttrs struct {
 	// subject to any SLA or deprecation policy.
 	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
 
+	// SoftDeletePolicy retains deleted objects so that they can be restored.
+	// GCS applies a default policy to new buckets, which is late initialized
+	// when none is specified.
+	// +optional
+	SoftDeletePolicy *SoftDeletePolicy `json:"softDeletePolicy,omitempty"`
+
 	// VersioningEnabled reports whether this bucket has versioning enabled.
 	VersioningEnabled bool `json:"versioningEnabled,omitempty"`
 
@@ -630,6 +862,7 @@ func NewBucketUpdatableAttrs(ba *storage.BucketAttrs) *BucketUpdatableAttrs {
 		CORS:                       NewCORSList(ba.CORS),
 		DefaultEventBasedHold:      ba.DefaultEventBasedHold,
 		Encryption:                 NewBucketEncryption(ba.Encryption),
+		IAMConfiguration:           NewBucketIAMConfiguration(ba),
 		Labels:                     ba.Labels,
 		Lifecycle:                  *NewLifecycle(ba.Lifecycle),
 		Logging:                    NewBucketLogging(ba.Logging),
@@ -637,6 +870,7 @@ func NewBucketUpdatableAttrs(ba *storage.BucketAttrs) *BucketUpdatableAttrs {
 		PredefinedDefaultObjectACL: ba.PredefinedDefaultObjectACL,
 		RequesterPays:              ba.RequesterPays,
 		RetentionPolicy:            NewRetentionPolicy(ba.RetentionPolicy),
+		SoftDeletePolicy:           NewSoftDeletePolicy(ba.SoftDeletePolicy),
 		VersioningEnabled:          ba.VersioningEnabled,
 		Website:                    NewBucketWebsite(ba.Website),
 	}
@@ -648,7 +882,7 @@ func CopyToBucketAttrs(ba *BucketUpdatableAttrs) *storage.BucketAttrs {
 		return nil
 	}
 
-	return &storage.BucketAttrs{
+	b := &storage.BucketAttrs{
 		BucketPolicyOnly:           CopyToBucketPolicyOnly(ba.BucketPolicyOnly),
 		CORS:                       CopyToCORSList(ba.CORS),
 		DefaultEventBasedHold:      ba.DefaultEventBasedHold,
@@ -658,11 +892,17 @@ func CopyToBucketAttrs(ba *BucketUpdatableAttrs) *storage.BucketAttrs {
 		Logging:                    CopyToBucketLogging(ba.Logging),
 		PredefinedACL:              ba.PredefinedACL,
 		PredefinedDefaultObjectACL: ba.PredefinedDefaultObjectACL,
+		PublicAccessPrevention:     CopyToPublicAccessPrevention(ba.IAMConfiguration),
 		RequesterPays:              ba.RequesterPays,
 		RetentionPolicy:            CopyToRetentionPolicy(ba.RetentionPolicy),
+		SoftDeletePolicy:           CopyToSoftDeletePolicy(ba.SoftDeletePolicy),
 		VersioningEnabled:          ba.VersioningEnabled,
 		Website:                    CopyToBucketWebsite(ba.Website),
 	}
+	if ubla := CopyToUniformBucketLevelAccess(ba.IAMConfiguration); ubla != nil {
+		b.UniformBucketLevelAccess = *ubla
+	}
+	return b
 }
 
 // CopyToBucketUpdateAttrs create a copy in storage format
@@ -670,17 +910,27 @@ func CopyToBucketUpdateAttrs(ba BucketUpdatableAttrs, labels map[string]string)
 	bucketPolicyOnly := CopyToBucketPolicyOnly(ba.BucketPolicyOnly)
 	lifecycle := CopyToLifecycle(ba.Lifecycle)
 
+	// The storage client leaves the CORS configuration of the bucket
+	// unchanged when given a nil list, while an empty one removes it.
+	cors := CopyToCORSList(ba.CORS)
+	if cors == nil {
+		cors = []storage.CORS{}
+	}
+
 	update := storage.BucketAttrsToUpdate{
 		BucketPolicyOnly:           &bucketPolicyOnly,
-		CORS:                       CopyToCORSList(ba.CORS),
+		CORS:                       cors,
 		DefaultEventBasedHold:      ba.DefaultEventBasedHold,
 		Encryption:                 CopyToBucketEncryption(ba.Encryption),
 		Lifecycle:                  &lifecycle,
 		Logging:                    CopyToBucketLogging(ba.Logging),
 		PredefinedACL:              ba.PredefinedACL,
 		PredefinedDefaultObjectACL: ba.PredefinedDefaultObjectACL,
+		PublicAccessPrevention:     CopyToPublicAccessPrevention(ba.IAMConfiguration),
 		RequesterPays:              ba.RequesterPays,
 		RetentionPolicy:            CopyToRetentionPolicy(ba.RetentionPolicy),
+		SoftDeletePolicy:           CopyToSoftDeletePolicy(ba.SoftDeletePolicy),
+		UniformBucketLevelAccess:   CopyToUniformBucketLevelAccess(ba.IAMConfiguration),
 		VersioningEnabled:          ba.VersioningEnabled,
 		Website:                    CopyToBucketWebsite(ba.Website),
 	}
@@ -710,8 +960,17 @@ type BucketSpecAttrs struct {
 	DefaultObjectACL []ACLRule `json:"defaultObjectAcl,omitempty"`
 
 	// Location is the location of the bucket. It defaults to "US".
+	// +optional
+	// +kubebuilder:default=US
 	Location string `json:"location,omitempty"`
 
+	// CustomPlacementConfig specifies the regions of a dual-region bucket,
+	// whose Location must then be the multi-region containing them, e.g. US.
+	// It can only be set when the bucket is created.
+	// +optional
+	// +immutable
+	CustomPlacementConfig *CustomPlacementConfig `json:"customPlacementConfig,omitempty"`
+
 	// StorageClass is the default storage class of the bucket. This defines
 	// how objects in the bucket are stored and determines the SLA
 	// and the cost of storage. Typical values are "MULTI_REGIONAL",
@@ -729,11 +988,12 @@ func NewBucketSpecAttrs(ba *storage.BucketAttrs) BucketSpecAttrs {
 		return BucketSpecAttrs{}
 	}
 	return BucketSpecAttrs{
-		BucketUpdatableAttrs: *NewBucketUpdatableAttrs(ba),
-		ACL:                  NewACLRules(ba.ACL),
-		DefaultObjectACL:     NewACLRules(ba.DefaultObjectACL),
-		Location:             ba.Location,
-		StorageClass:         ba.StorageClass,
+		BucketUpdatableAttrs:  *NewBucketUpdatableAttrs(ba),
+		ACL:                   NewACLRules(ba.ACL),
+		DefaultObjectACL:      NewACLRules(ba.DefaultObjectACL),
+		Location:              ba.Location,
+		CustomPlacementConfig: NewCustomPlacementConfig(ba.CustomPlacementConfig),
+		StorageClass:          ba.StorageClass,
 	}
 }
 
@@ -745,6 +1005,7 @@ func CopyBucketSpecAttrs(ba *BucketSpecAttrs) *storage.BucketAttrs {
 	b := CopyToBucketAttrs(&ba.BucketUpdatableAttrs)
 	b.ACL = CopyToACLRules(ba.ACL)
 	b.Location = ba.Location
+	b.CustomPlacementConfig = CopyToCustomPlacementConfig(ba.CustomPlacementConfig)
 	b.StorageClass = ba.StorageClass
 	return b
 }
@@ -789,19 +1050,141 @@ func NewBucketOutputAttrs(attrs *storage.BucketAttrs) BucketOutputAttrs {
 // https://cloud.google.com/storage/docs/json_api/v1/buckets#resource
 type BucketParameters struct {
 	BucketSpecAttrs `json:",inline"`
+
+	// UserProject is the project to be billed for requests made to the
+	// bucket. It must be set to manage resources of buckets that have
+	// Requester Pays enabled.
+	// +optional
+	UserProject *string `json:"userProject,omitempty"`
+
+	// PropagateLabels lists the keys of labels of this Bucket that are
+	// copied to the labels of the GCS bucket, e.g. to keep cost attribution
+	// labels in sync. Keys and values are converted to meet the requirements
+	// of GCS labels, e.g. app.kubernetes.io/team becomes
+	// app_kubernetes_io_team. The converted keys are owned by this Bucket's
+	// metadata: a label that is removed from the metadata is removed from
+	// the GCS bucket too.
+	// +optional
+	PropagateLabels []string `json:"propagateLabels,omitempty"`
+
+	// TagBindings are the Resource Manager tag values bound to the GCS
+	// bucket, either as the name of a TagValue, e.g. tagValues/123, or as
+	// its namespaced name, e.g. 123456789/environment/production. Tag
+	// values that were bound outside of this Bucket are left alone, but
+	// those bound by it are unbound when they are removed from this list.
+	// +optional
+	TagBindings []string `json:"tagBindings,omitempty"`
+
+	// LifecycleSimulation enables a dry run of the lifecycle rules of this
+	// Bucket. When set, the objects of the GCS bucket are sampled and
+	// status.atProvider.lifecycleSimulation reports how many of them each
+	// rule matches. This helps to gain confidence in aggressive delete
+	// rules before applying them. Objects are sampled again whenever the
+	// lifecycle rules or the sample size change, or the previous sample
+	// failed.
+	// +optional
+	LifecycleSimulation *LifecycleSimulation `json:"lifecycleSimulation,omitempty"`
+}
+
+// LifecycleSimulation configures the dry run of the lifecycle rules of a
+// Bucket.
+type LifecycleSimulation struct {
+	// SampleSize is the maximum number of objects, including noncurrent
+	// versions, that are listed to evaluate the lifecycle rules. Counts are
+	// exact when the bucket has no more objects than this.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +kubebuilder:default=1000
+	// +optional
+	SampleSize *int64 `json:"sampleSize,omitempty"`
+}
+
+// LifecycleSimulationStatus reports which lifecycle rules of a Bucket match
+// the sampled objects of the GCS bucket.
+type LifecycleSimulationStatus struct {
+	// SampledObjects is the number of objects, including noncurrent
+	// versions, that the lifecycle rules were evaluated against.
+	SampledObjects int64 `json:"sampledObjects"`
+
+	// Complete is true if all objects of the bucket were sampled, i.e. the
+	// counts of matching objects are exact rather than estimated from a
+	// sample.
+	Complete bool `json:"complete"`
+
+	// Rules reports the objects matched by each lifecycle rule, in the
+	// order the rules are declared in spec.lifecycle.rules.
+	// +optional
+	Rules []LifecycleRuleSimulation `json:"rules,omitempty"`
+
+	// SampleSize is the sample size the lifecycle rules were evaluated
+	// with.
+	// +optional
+	SampleSize int64 `json:"sampleSize,omitempty"`
+
+	// RulesHash identifies the lifecycle rules that were evaluated.
+	// +optional
+	RulesHash string `json:"rulesHash,omitempty"`
+
+	// Error is the reason the objects of the bucket could not be sampled
+	// the last time they were, if any. The results of the last successful
+	// simulation are kept.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// LifecycleRuleSimulation reports the sampled objects matched by a lifecycle
+// rule.
+type LifecycleRuleSimulation struct {
+	// Index of the rule in spec.lifecycle.rules.
+	Index int `json:"index"`
+
+	// ActionType is the type of action the rule takes on matching objects.
+	ActionType string `json:"actionType"`
+
+	// MatchingObjects is the number of sampled objects that the rule
+	// currently matches. Rules that abort incomplete multipart uploads
+	// never match objects.
+	MatchingObjects int64 `json:"matchingObjects"`
 }
 
 // A BucketSpec defines the desired state of a Bucket.
+// +kubebuilder:validation:XValidation:rule="has(oldSelf.customPlacementConfig) == has(self.customPlacementConfig) && (!has(self.customPlacementConfig) || self.customPlacementConfig == oldSelf.customPlacementConfig)",message="customPlacementConfig is immutable"
 type BucketSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	BucketParameters  `json:",inline"`
 }
 
+// A BucketObservation represents the observed state of a Bucket that is not
+// reported by its attributes.
+type BucketObservation struct {
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// TagBindings are the tag values of spec.tagBindings that were bound
+	// to the GCS bucket by this Bucket.
+	// +optional
+	TagBindings []string `json:"tagBindings,omitempty"`
+
+	// LifecycleSimulation reports which lifecycle rules match the sampled
+	// objects of the bucket. It is only reported while
+	// spec.lifecycleSimulation is set.
+	// +optional
+	LifecycleSimulation *LifecycleSimulationStatus `json:"lifecycleSimulation,omitempty"`
+}
+
 // A BucketStatus represents the observed state of a Bucket.
 type BucketStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 
 	BucketOutputAttrs `json:"attributes,omitempty"`
+
+	AtProvider BucketObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -810,8 +1193,10 @@ type BucketStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STORAGE_CLASS",type="string",JSONPath=".spec.storageClass"
 // +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.location"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type Bucket struct {
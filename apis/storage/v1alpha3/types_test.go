@@ -112,6 +112,86 @@ func TestCopyToBucketPolicyOnly(t *testing.T) {
 	}
 }
 
+func TestNewBucketIAMConfiguration(t *testing.T) {
+	enabled, disabled := true, false
+	enforced := PublicAccessPreventionEnforced
+	tests := []struct {
+		name string
+		args *storage.BucketAttrs
+		want *BucketIAMConfiguration
+	}{
+		{name: "Default", args: &storage.BucketAttrs{}, want: nil},
+		{
+			name: "UniformBucketLevelAccess",
+			args: &storage.BucketAttrs{UniformBucketLevelAccess: storage.UniformBucketLevelAccess{Enabled: true}},
+			want: &BucketIAMConfiguration{UniformBucketLevelAccess: &UniformBucketLevelAccess{Enabled: &enabled}},
+		},
+		{
+			name: "PublicAccessPrevention",
+			args: &storage.BucketAttrs{PublicAccessPrevention: storage.PublicAccessPreventionEnforced},
+			want: &BucketIAMConfiguration{
+				UniformBucketLevelAccess: &UniformBucketLevelAccess{Enabled: &disabled},
+				PublicAccessPrevention:   &enforced,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewBucketIAMConfiguration(tt.args)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("NewBucketIAMConfiguration() = %v, want %v\n%s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestCopyToUniformBucketLevelAccess(t *testing.T) {
+	enabled := true
+	tests := []struct {
+		name string
+		args *BucketIAMConfiguration
+		want *storage.UniformBucketLevelAccess
+	}{
+		{name: "Nil", args: nil, want: nil},
+		{name: "Unset", args: &BucketIAMConfiguration{UniformBucketLevelAccess: &UniformBucketLevelAccess{}}, want: nil},
+		{
+			name: "Values",
+			args: &BucketIAMConfiguration{UniformBucketLevelAccess: &UniformBucketLevelAccess{Enabled: &enabled}},
+			want: &storage.UniformBucketLevelAccess{Enabled: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CopyToUniformBucketLevelAccess(tt.args)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("CopyToUniformBucketLevelAccess() = %v, want %v\n%s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestCopyToPublicAccessPrevention(t *testing.T) {
+	enforced, inherited, unknown := PublicAccessPreventionEnforced, PublicAccessPreventionInherited, "unknown"
+	tests := []struct {
+		name string
+		args *BucketIAMConfiguration
+		want storage.PublicAccessPrevention
+	}{
+		{name: "Nil", args: nil, want: storage.PublicAccessPreventionUnknown},
+		{name: "Enforced", args: &BucketIAMConfiguration{PublicAccessPrevention: &enforced}, want: storage.PublicAccessPreventionEnforced},
+		{name: "Inherited", args: &BucketIAMConfiguration{PublicAccessPrevention: &inherited}, want: storage.PublicAccessPreventionInherited},
+		{name: "Unknown", args: &BucketIAMConfiguration{PublicAccessPrevention: &unknown}, want: storage.PublicAccessPreventionUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CopyToPublicAccessPrevention(tt.args)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("CopyToPublicAccessPrevention() = %v, want %v\n%s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
 func TestACLRule(t *testing.T) {
 	tests := []struct {
 		name string
@@ -226,21 +306,30 @@ func TestCopyToLifecyleAction(t *testing.T) {
 var (
 	now = time.Now()
 	cb  = metav1.NewTime(now.Add(24 * time.Hour))
+	ntb = metav1.NewTime(now.Add(48 * time.Hour))
 
 	testLifecycleCondition = LifecycleCondition{
-		AgeInDays:             10,
-		CreatedBefore:         &cb,
-		Liveness:              storage.Liveness(1),
-		MatchesStorageClasses: []string{"STANDARD"},
-		NumNewerVersions:      5,
+		AgeInDays:               10,
+		CreatedBefore:           &cb,
+		DaysSinceNoncurrentTime: 7,
+		Liveness:                storage.Liveness(1),
+		MatchesPrefix:           []string{"logs/"},
+		MatchesStorageClasses:   []string{"STANDARD"},
+		MatchesSuffix:           []string{".log"},
+		NoncurrentTimeBefore:    &ntb,
+		NumNewerVersions:        5,
 	}
 
 	testStorageLifecycleCondition = storage.LifecycleCondition{
-		AgeInDays:             10,
-		CreatedBefore:         now.Add(24 * time.Hour),
-		Liveness:              storage.Liveness(1),
-		MatchesStorageClasses: []string{"STANDARD"},
-		NumNewerVersions:      5,
+		AgeInDays:               10,
+		CreatedBefore:           now.Add(24 * time.Hour),
+		DaysSinceNoncurrentTime: 7,
+		Liveness:                storage.Liveness(1),
+		MatchesPrefix:           []string{"logs/"},
+		MatchesStorageClasses:   []string{"STANDARD"},
+		MatchesSuffix:           []string{".log"},
+		NoncurrentTimeBefore:    now.Add(48 * time.Hour),
+		NumNewerVersions:        5,
 	}
 )
 
@@ -251,6 +340,7 @@ func TestNewLifecycleCondition(t *testing.T) {
 		want LifecycleCondition
 	}{
 		{"Test", testStorageLifecycleCondition, testLifecycleCondition},
+		{"NoDates", storage.LifecycleCondition{AgeInDays: 10}, LifecycleCondition{AgeInDays: 10}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -269,6 +359,7 @@ func TestCopyToLifecycleCondition(t *testing.T) {
 		want storage.LifecycleCondition
 	}{
 		{"Test", testLifecycleCondition, testStorageLifecycleCondition},
+		{"NoDates", LifecycleCondition{AgeInDays: 10}, storage.LifecycleCondition{AgeInDays: 10}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -456,6 +547,54 @@ func TestNewRetentionPolicyStatus(t *testing.T) {
 	}
 }
 
+var (
+	testStorageSoftDeletePolicy = &storage.SoftDeletePolicy{
+		EffectiveTime:     now,
+		RetentionDuration: 7 * 24 * time.Hour,
+	}
+
+	testSoftDeletePolicy = &SoftDeletePolicy{RetentionDurationSeconds: 604800}
+)
+
+func TestNewSoftDeletePolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		args *storage.SoftDeletePolicy
+		want *SoftDeletePolicy
+	}{
+		{"Nil", nil, nil},
+		{"Val", testStorageSoftDeletePolicy, testSoftDeletePolicy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSoftDeletePolicy(tt.args)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("NewSoftDeletePolicy() = %v, want %v\n%s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestCopyToSoftDeletePolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		args *SoftDeletePolicy
+		want *storage.SoftDeletePolicy
+	}{
+		{"Nil", nil, nil},
+		{"Disabled", &SoftDeletePolicy{}, &storage.SoftDeletePolicy{}},
+		{"Val", testSoftDeletePolicy, &storage.SoftDeletePolicy{RetentionDuration: 7 * 24 * time.Hour}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CopyToSoftDeletePolicy(tt.args)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("CopyToSoftDeletePolicy() = %v, want %v\n%s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
 var (
 	testBucketEncryption        = &BucketEncryption{DefaultKMSKeyName: "test-kms"}
 	testStorageBucketEncryption = &storage.BucketEncryption{DefaultKMSKeyName: "test-kms"}
@@ -549,6 +688,54 @@ func TestCopyToBucketLogging(t *testing.T) {
 	}
 }
 
+var (
+	testCustomPlacementConfig = &CustomPlacementConfig{
+		DataLocations: []string{"US-EAST1", "US-WEST1"},
+	}
+
+	testStorageCustomPlacementConfig = &storage.CustomPlacementConfig{
+		DataLocations: []string{"US-EAST1", "US-WEST1"},
+	}
+)
+
+func TestNewCustomPlacementConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		args *storage.CustomPlacementConfig
+		want *CustomPlacementConfig
+	}{
+		{"Nil", nil, nil},
+		{"Val", testStorageCustomPlacementConfig, testCustomPlacementConfig},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewCustomPlacementConfig(tt.args)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("NewCustomPlacementConfig() = %v, want %v\n%s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
+func TestCopyToCustomPlacementConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		args *CustomPlacementConfig
+		want *storage.CustomPlacementConfig
+	}{
+		{"Nil", nil, nil},
+		{"Val", testCustomPlacementConfig, testStorageCustomPlacementConfig},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CopyToCustomPlacementConfig(tt.args)
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("CopyToCustomPlacementConfig() = %v, want %v\n%s", got, tt.want, diff)
+			}
+		})
+	}
+}
+
 var (
 	testCORS = CORS{
 		MaxAge:          metav1.Duration{Duration: 1 * time.Minute},
@@ -789,10 +976,28 @@ func TestCopyToBucketUpdateAttrs(t *testing.T) {
 			args: args{*testBucketUpdateAttrs, map[string]string{"application": "crossplane", "foo": "bar"}},
 			want: testStorageBucketAttrsToUpdate,
 		},
+		{
+			name: "NoCORS",
+			args: args{BucketUpdatableAttrs{}, nil},
+			want: storage.BucketAttrsToUpdate{
+				BucketPolicyOnly:      &storage.BucketPolicyOnly{},
+				CORS:                  []storage.CORS{},
+				DefaultEventBasedHold: false,
+				Lifecycle:             &storage.Lifecycle{},
+				RequesterPays:         false,
+				RetentionPolicy:       &storage.RetentionPolicy{},
+				VersioningEnabled:     false,
+			},
+		},
 	}
 	for _, tt := range tests {
-		tt.want.SetLabel("application", "crossplane")
-		tt.want.DeleteLabel("foo")
+		for k := range tt.args.labels {
+			if _, ok := tt.args.ba.Labels[k]; ok {
+				tt.want.SetLabel(k, tt.args.ba.Labels[k])
+				continue
+			}
+			tt.want.DeleteLabel(k)
+		}
 		t.Run(tt.name, func(t *testing.T) {
 			got := CopyToBucketUpdateAttrs(tt.args.ba, tt.args.labels)
 			if diff := cmp.Diff(got, tt.want, cmp.AllowUnexported(storage.BucketAttrsToUpdate{})); diff != "" {
@@ -804,11 +1009,12 @@ func TestCopyToBucketUpdateAttrs(t *testing.T) {
 
 var (
 	testBucketSpecAttrs = &BucketSpecAttrs{
-		BucketUpdatableAttrs: *testBucketUpdateAttrs,
-		ACL:                  []ACLRule{testACLRule},
-		DefaultObjectACL:     nil,
-		Location:             "US",
-		StorageClass:         "STANDARD",
+		BucketUpdatableAttrs:  *testBucketUpdateAttrs,
+		ACL:                   []ACLRule{testACLRule},
+		DefaultObjectACL:      nil,
+		Location:              "US",
+		CustomPlacementConfig: testCustomPlacementConfig,
+		StorageClass:          "STANDARD",
 	}
 
 	testStorageBucketAttrs2 = &storage.BucketAttrs{
@@ -820,6 +1026,7 @@ var (
 		Labels:                     map[string]string{"application": "crossplane"},
 		Lifecycle:                  testStorageLifecycle,
 		Location:                   "US",
+		CustomPlacementConfig:      testStorageCustomPlacementConfig,
 		Logging:                    testStorageBucketLogging,
 		PredefinedACL:              "test-predefined-acl",
 		PredefinedDefaultObjectACL: "test-predefined-default-object-acl",
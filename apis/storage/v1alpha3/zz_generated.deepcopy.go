@@ -22,6 +22,8 @@ limitations under the License.
 package v1alpha3
 
 import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -75,6 +77,16 @@ func (in *Bucket) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketEncryption) DeepCopyInto(out *BucketEncryption) {
 	*out = *in
+	if in.DefaultKMSKeyNameRef != nil {
+		in, out := &in.DefaultKMSKeyNameRef, &out.DefaultKMSKeyNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultKMSKeyNameSelector != nil {
+		in, out := &in.DefaultKMSKeyNameSelector, &out.DefaultKMSKeyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketEncryption.
@@ -87,6 +99,31 @@ func (in *BucketEncryption) DeepCopy() *BucketEncryption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketIAMConfiguration) DeepCopyInto(out *BucketIAMConfiguration) {
+	*out = *in
+	if in.UniformBucketLevelAccess != nil {
+		in, out := &in.UniformBucketLevelAccess, &out.UniformBucketLevelAccess
+		*out = new(UniformBucketLevelAccess)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicAccessPrevention != nil {
+		in, out := &in.PublicAccessPrevention, &out.PublicAccessPrevention
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketIAMConfiguration.
+func (in *BucketIAMConfiguration) DeepCopy() *BucketIAMConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketIAMConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketList) DeepCopyInto(out *BucketList) {
 	*out = *in
@@ -122,6 +159,16 @@ func (in *BucketList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketLogging) DeepCopyInto(out *BucketLogging) {
 	*out = *in
+	if in.LogBucketRef != nil {
+		in, out := &in.LogBucketRef, &out.LogBucketRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogBucketSelector != nil {
+		in, out := &in.LogBucketSelector, &out.LogBucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketLogging.
@@ -134,6 +181,36 @@ func (in *BucketLogging) DeepCopy() *BucketLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketObservation) DeepCopyInto(out *BucketObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TagBindings != nil {
+		in, out := &in.TagBindings, &out.TagBindings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LifecycleSimulation != nil {
+		in, out := &in.LifecycleSimulation, &out.LifecycleSimulation
+		*out = new(LifecycleSimulationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObservation.
+func (in *BucketObservation) DeepCopy() *BucketObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketOutputAttrs) DeepCopyInto(out *BucketOutputAttrs) {
 	*out = *in
@@ -167,6 +244,26 @@ func (in *BucketOutputAttrs) DeepCopy() *BucketOutputAttrs {
 func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 	*out = *in
 	in.BucketSpecAttrs.DeepCopyInto(&out.BucketSpecAttrs)
+	if in.UserProject != nil {
+		in, out := &in.UserProject, &out.UserProject
+		*out = new(string)
+		**out = **in
+	}
+	if in.PropagateLabels != nil {
+		in, out := &in.PropagateLabels, &out.PropagateLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TagBindings != nil {
+		in, out := &in.TagBindings, &out.TagBindings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LifecycleSimulation != nil {
+		in, out := &in.LifecycleSimulation, &out.LifecycleSimulation
+		*out = new(LifecycleSimulation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketParameters.
@@ -230,6 +327,11 @@ func (in *BucketSpecAttrs) DeepCopyInto(out *BucketSpecAttrs) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CustomPlacementConfig != nil {
+		in, out := &in.CustomPlacementConfig, &out.CustomPlacementConfig
+		*out = new(CustomPlacementConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketSpecAttrs.
@@ -247,6 +349,7 @@ func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.BucketOutputAttrs.DeepCopyInto(&out.BucketOutputAttrs)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
@@ -277,7 +380,7 @@ func (in *BucketUpdatableAttrs) DeepCopyInto(out *BucketUpdatableAttrs) {
 	if in.Encryption != nil {
 		in, out := &in.Encryption, &out.Encryption
 		*out = new(BucketEncryption)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
@@ -287,16 +390,26 @@ func (in *BucketUpdatableAttrs) DeepCopyInto(out *BucketUpdatableAttrs) {
 		}
 	}
 	in.Lifecycle.DeepCopyInto(&out.Lifecycle)
+	if in.IAMConfiguration != nil {
+		in, out := &in.IAMConfiguration, &out.IAMConfiguration
+		*out = new(BucketIAMConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Logging != nil {
 		in, out := &in.Logging, &out.Logging
 		*out = new(BucketLogging)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.RetentionPolicy != nil {
 		in, out := &in.RetentionPolicy, &out.RetentionPolicy
 		*out = new(RetentionPolicy)
 		**out = **in
 	}
+	if in.SoftDeletePolicy != nil {
+		in, out := &in.SoftDeletePolicy, &out.SoftDeletePolicy
+		*out = new(SoftDeletePolicy)
+		**out = **in
+	}
 	if in.Website != nil {
 		in, out := &in.Website, &out.Website
 		*out = new(BucketWebsite)
@@ -360,6 +473,26 @@ func (in *CORS) DeepCopy() *CORS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPlacementConfig) DeepCopyInto(out *CustomPlacementConfig) {
+	*out = *in
+	if in.DataLocations != nil {
+		in, out := &in.DataLocations, &out.DataLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPlacementConfig.
+func (in *CustomPlacementConfig) DeepCopy() *CustomPlacementConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPlacementConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
 	*out = *in
@@ -404,11 +537,29 @@ func (in *LifecycleCondition) DeepCopyInto(out *LifecycleCondition) {
 		in, out := &in.CreatedBefore, &out.CreatedBefore
 		*out = (*in).DeepCopy()
 	}
+	if in.CustomTimeBefore != nil {
+		in, out := &in.CustomTimeBefore, &out.CustomTimeBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.MatchesPrefix != nil {
+		in, out := &in.MatchesPrefix, &out.MatchesPrefix
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.MatchesStorageClasses != nil {
 		in, out := &in.MatchesStorageClasses, &out.MatchesStorageClasses
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MatchesSuffix != nil {
+		in, out := &in.MatchesSuffix, &out.MatchesSuffix
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NoncurrentTimeBefore != nil {
+		in, out := &in.NoncurrentTimeBefore, &out.NoncurrentTimeBefore
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleCondition.
@@ -438,6 +589,61 @@ func (in *LifecycleRule) DeepCopy() *LifecycleRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleRuleSimulation) DeepCopyInto(out *LifecycleRuleSimulation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRuleSimulation.
+func (in *LifecycleRuleSimulation) DeepCopy() *LifecycleRuleSimulation {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleRuleSimulation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleSimulation) DeepCopyInto(out *LifecycleSimulation) {
+	*out = *in
+	if in.SampleSize != nil {
+		in, out := &in.SampleSize, &out.SampleSize
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleSimulation.
+func (in *LifecycleSimulation) DeepCopy() *LifecycleSimulation {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleSimulation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleSimulationStatus) DeepCopyInto(out *LifecycleSimulationStatus) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]LifecycleRuleSimulation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleSimulationStatus.
+func (in *LifecycleSimulationStatus) DeepCopy() *LifecycleSimulationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleSimulationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectTeam) DeepCopyInto(out *ProjectTeam) {
 	*out = *in
@@ -483,3 +689,38 @@ func (in *RetentionPolicyStatus) DeepCopy() *RetentionPolicyStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SoftDeletePolicy) DeepCopyInto(out *SoftDeletePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftDeletePolicy.
+func (in *SoftDeletePolicy) DeepCopy() *SoftDeletePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SoftDeletePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UniformBucketLevelAccess) DeepCopyInto(out *UniformBucketLevelAccess) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UniformBucketLevelAccess.
+func (in *UniformBucketLevelAccess) DeepCopy() *UniformBucketLevelAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(UniformBucketLevelAccess)
+	in.DeepCopyInto(out)
+	return out
+}
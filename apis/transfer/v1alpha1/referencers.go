@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	storagev1alpha3 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+)
+
+// ResolveReferences of this TransferJob
+func (mg *TransferJob) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.transferSpec.gcsDataSink.bucketName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TransferSpec.GCSDataSink.BucketName),
+		Reference:    mg.Spec.ForProvider.TransferSpec.GCSDataSink.BucketNameRef,
+		Selector:     mg.Spec.ForProvider.TransferSpec.GCSDataSink.BucketNameSelector,
+		To:           reference.To{Managed: &storagev1alpha3.Bucket{}, List: &storagev1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transferSpec.gcsDataSink.bucketName")
+	}
+	mg.Spec.ForProvider.TransferSpec.GCSDataSink.BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TransferSpec.GCSDataSink.BucketNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.transferSpec.gcsDataSource.bucketName
+	if src := mg.Spec.ForProvider.TransferSpec.GCSDataSource; src != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(src.BucketName),
+			Reference:    src.BucketNameRef,
+			Selector:     src.BucketNameSelector,
+			To:           reference.To{Managed: &storagev1alpha3.Bucket{}, List: &storagev1alpha3.BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.transferSpec.gcsDataSource.bucketName")
+		}
+		src.BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+		src.BucketNameRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Transfer job statuses.
+const (
+	TransferJobStatusEnabled  = "ENABLED"
+	TransferJobStatusDisabled = "DISABLED"
+	TransferJobStatusDeleted  = "DELETED"
+)
+
+// TransferJobParameters define the desired state of a Storage Transfer
+// Service transfer job of the provider's project.
+// https://cloud.google.com/storage-transfer/docs/reference/rest/v1/transferJobs
+// The name of the transfer job (ie the part of its `name` following
+// `transferJobs/`) is determined by the value of the
+// `crossplane.io/external-name` annotation.
+type TransferJobParameters struct {
+	// Description: A description provided by the user for the job.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// TransferSpec: Transfer specification.
+	TransferSpec TransferSpec `json:"transferSpec"`
+
+	// Schedule: Specifies schedule for the transfer job. If unset, the job
+	// runs once, immediately after it is created. It can only be set when
+	// the job is created.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="schedule is immutable"
+	Schedule *Schedule `json:"schedule,omitempty"`
+
+	// Status: Status of the job. Disabled jobs do not start new transfer
+	// operations.
+	// +optional
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	Status *string `json:"status,omitempty"`
+}
+
+// TransferSpec configures where and how data is transferred. Exactly one data
+// source must be set.
+type TransferSpec struct {
+	// GCSDataSource: A Cloud Storage data source.
+	// +optional
+	GCSDataSource *GCSData `json:"gcsDataSource,omitempty"`
+
+	// AWSS3DataSource: An AWS S3 data source.
+	// +optional
+	AWSS3DataSource *AWSS3Data `json:"awsS3DataSource,omitempty"`
+
+	// HTTPDataSource: An HTTP URL data source.
+	// +optional
+	HTTPDataSource *HTTPData `json:"httpDataSource,omitempty"`
+
+	// GCSDataSink: A Cloud Storage data sink.
+	GCSDataSink GCSData `json:"gcsDataSink"`
+
+	// ObjectConditions: Only objects that satisfy these object conditions
+	// are included in the set of data source and data sink objects.
+	// +optional
+	ObjectConditions *ObjectConditions `json:"objectConditions,omitempty"`
+
+	// TransferOptions: If the option DeleteObjectsUniqueInSink is true and
+	// time-based object conditions such as 'last modification time' are
+	// specified, the request fails with an INVALID_ARGUMENT error.
+	// +optional
+	TransferOptions *TransferOptions `json:"transferOptions,omitempty"`
+}
+
+// GCSData is a Cloud Storage bucket and an optional path within it.
+type GCSData struct {
+	// BucketName: Cloud Storage bucket name.
+	// +optional
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references a Bucket and retrieves its external name
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// Path: Root path to transfer objects. Must be an empty string or full
+	// path name that ends with a '/'.
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
+// AWSS3Data is an AWS S3 bucket and an optional path within it.
+type AWSS3Data struct {
+	// BucketName: S3 Bucket name.
+	BucketName string `json:"bucketName"`
+
+	// Path: Root path to transfer objects. Must be an empty string or full
+	// path name that ends with a '/'.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// RoleArn: The Amazon Resource Name (ARN) of the role to support
+	// temporary credentials via `AssumeRoleWithWebIdentity`. When a role ARN
+	// is provided, Transfer Service fetches temporary credentials for the
+	// session using a `AssumeRoleWithWebIdentity` call for the provided
+	// role using the GoogleServiceAccount for this project.
+	// +optional
+	RoleArn *string `json:"roleArn,omitempty"`
+
+	// AWSAccessKey: Input only. AWS access key used to sign the API requests
+	// to the AWS S3 bucket. Either it or RoleArn must be set.
+	// +optional
+	AWSAccessKey *AWSAccessKey `json:"awsAccessKey,omitempty"`
+}
+
+// AWSAccessKey references the secrets holding an AWS access key.
+type AWSAccessKey struct {
+	// AccessKeyIDSecretRef references the secret key holding the AWS access
+	// key ID.
+	AccessKeyIDSecretRef xpv1.SecretKeySelector `json:"accessKeyIdSecretRef"`
+
+	// SecretAccessKeySecretRef references the secret key holding the AWS
+	// secret access key.
+	SecretAccessKeySecretRef xpv1.SecretKeySelector `json:"secretAccessKeySecretRef"`
+}
+
+// HTTPData is a list of objects to transfer from HTTP URLs.
+type HTTPData struct {
+	// ListURL: The URL that points to the file that stores the object list
+	// entries. This file must allow public access.
+	ListURL string `json:"listUrl"`
+}
+
+// ObjectConditions select the objects that are transferred.
+type ObjectConditions struct {
+	// IncludePrefixes: If you specify `includePrefixes`, Storage Transfer
+	// Service uses the items in the `includePrefixes` array to determine
+	// which objects to include in a transfer.
+	// +optional
+	IncludePrefixes []string `json:"includePrefixes,omitempty"`
+
+	// ExcludePrefixes: If you specify `excludePrefixes`, Storage Transfer
+	// Service uses the items in the `excludePrefixes` array to determine
+	// which objects to exclude from a transfer.
+	// +optional
+	ExcludePrefixes []string `json:"excludePrefixes,omitempty"`
+
+	// MinTimeElapsedSinceLastModification: Ensures that objects are not
+	// transferred until a specific minimum time has elapsed after the
+	// "last modification time", e.g. "3600s".
+	// +optional
+	MinTimeElapsedSinceLastModification *string `json:"minTimeElapsedSinceLastModification,omitempty"`
+
+	// MaxTimeElapsedSinceLastModification: Ensures that objects are not
+	// transferred if a specific maximum time has elapsed since the "last
+	// modification time", e.g. "86400s".
+	// +optional
+	MaxTimeElapsedSinceLastModification *string `json:"maxTimeElapsedSinceLastModification,omitempty"`
+
+	// LastModifiedSince: If specified, only objects with a "last
+	// modification time" on or after this RFC 3339 timestamp are
+	// transferred.
+	// +optional
+	LastModifiedSince *string `json:"lastModifiedSince,omitempty"`
+
+	// LastModifiedBefore: If specified, only objects with a "last
+	// modification time" before this RFC 3339 timestamp are transferred.
+	// +optional
+	LastModifiedBefore *string `json:"lastModifiedBefore,omitempty"`
+}
+
+// TransferOptions define the actions to be performed on objects in a transfer.
+type TransferOptions struct {
+	// OverwriteObjectsAlreadyExistingInSink: When to overwrite objects that
+	// already exist in the sink. The default is that only objects that are
+	// different from the source are overwritten.
+	// +optional
+	OverwriteObjectsAlreadyExistingInSink *bool `json:"overwriteObjectsAlreadyExistingInSink,omitempty"`
+
+	// OverwriteWhen: When to overwrite objects that already exist in the
+	// sink. If not set, overwrite behavior is determined by
+	// OverwriteObjectsAlreadyExistingInSink.
+	// +optional
+	// +kubebuilder:validation:Enum=DIFFERENT;NEVER;ALWAYS
+	OverwriteWhen *string `json:"overwriteWhen,omitempty"`
+
+	// DeleteObjectsUniqueInSink: Whether objects that exist only in the
+	// sink should be deleted. Mutually exclusive with
+	// DeleteObjectsFromSourceAfterTransfer.
+	// +optional
+	DeleteObjectsUniqueInSink *bool `json:"deleteObjectsUniqueInSink,omitempty"`
+
+	// DeleteObjectsFromSourceAfterTransfer: Whether objects should be
+	// deleted from the source after they are transferred to the sink.
+	// Mutually exclusive with DeleteObjectsUniqueInSink.
+	// +optional
+	DeleteObjectsFromSourceAfterTransfer *bool `json:"deleteObjectsFromSourceAfterTransfer,omitempty"`
+}
+
+// Schedule of a transfer job. Times are in UTC.
+type Schedule struct {
+	// ScheduleStartDate: The start date of a transfer. If it is in the
+	// past relative to the job's creation time, the transfer starts the day
+	// after the job is created.
+	ScheduleStartDate Date `json:"scheduleStartDate"`
+
+	// ScheduleEndDate: The last day a transfer runs. If unset, the job
+	// repeats indefinitely; if it equals ScheduleStartDate, the job runs
+	// once.
+	// +optional
+	ScheduleEndDate *Date `json:"scheduleEndDate,omitempty"`
+
+	// StartTimeOfDay: The time of day at which transfers are scheduled to
+	// start. If unset, transfers start at midnight UTC.
+	// +optional
+	StartTimeOfDay *TimeOfDay `json:"startTimeOfDay,omitempty"`
+
+	// EndTimeOfDay: The time of day after which no new transfers are
+	// started for a repeating job.
+	// +optional
+	EndTimeOfDay *TimeOfDay `json:"endTimeOfDay,omitempty"`
+
+	// RepeatInterval: Interval between the start of each scheduled
+	// transfer, e.g. "86400s". If unspecified, the default is 24 hours.
+	// +optional
+	RepeatInterval *string `json:"repeatInterval,omitempty"`
+}
+
+// Date is a whole calendar date.
+type Date struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9999
+	Year int64 `json:"year"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=12
+	Month int64 `json:"month"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=31
+	Day int64 `json:"day"`
+}
+
+// TimeOfDay is a time of day in UTC.
+type TimeOfDay struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	Hours int64 `json:"hours"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	Minutes int64 `json:"minutes,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=59
+	Seconds int64 `json:"seconds,omitempty"`
+}
+
+// TransferJobObservation is used to show the observed state of the
+// TransferJob.
+type TransferJobObservation struct {
+	// Name: The resource name of the transfer job in the format
+	// `transferJobs/{name}`.
+	Name string `json:"name,omitempty"`
+
+	// CreationTime: The time that the transfer job was created.
+	CreationTime string `json:"creationTime,omitempty"`
+
+	// LastModificationTime: The time that the transfer job was last
+	// modified.
+	LastModificationTime string `json:"lastModificationTime,omitempty"`
+
+	// LatestOperationName: The name of the most recently started transfer
+	// operation of this job.
+	LatestOperationName string `json:"latestOperationName,omitempty"`
+
+	// LastOperation: The last mutation made to the external resource by
+	// this provider.
+	LastOperation *gcpv1beta1.LastOperation `json:"lastOperation,omitempty"`
+}
+
+// TransferJobSpec defines the desired state of a TransferJob.
+type TransferJobSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransferJobParameters `json:"forProvider"`
+}
+
+// TransferJobStatus represents the observed state of a TransferJob.
+type TransferJobStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransferJobObservation `json:"atProvider,omitempty"`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransferJob is a managed resource that represents a Storage Transfer
+// Service transfer job, which copies data from Cloud Storage, AWS S3 or HTTP
+// sources into a Cloud Storage bucket.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SINK",type="string",JSONPath=".spec.forProvider.transferSpec.gcsDataSink.bucketName"
+// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type TransferJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransferJobSpec   `json:"spec"`
+	Status TransferJobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransferJobList contains a list of TransferJob types
+type TransferJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransferJob `json:"items"`
+}
@@ -0,0 +1,456 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSAccessKey) DeepCopyInto(out *AWSAccessKey) {
+	*out = *in
+	out.AccessKeyIDSecretRef = in.AccessKeyIDSecretRef
+	out.SecretAccessKeySecretRef = in.SecretAccessKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSAccessKey.
+func (in *AWSAccessKey) DeepCopy() *AWSAccessKey {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSAccessKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3Data) DeepCopyInto(out *AWSS3Data) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleArn != nil {
+		in, out := &in.RoleArn, &out.RoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AWSAccessKey != nil {
+		in, out := &in.AWSAccessKey, &out.AWSAccessKey
+		*out = new(AWSAccessKey)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3Data.
+func (in *AWSS3Data) DeepCopy() *AWSS3Data {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3Data)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Date) DeepCopyInto(out *Date) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Date.
+func (in *Date) DeepCopy() *Date {
+	if in == nil {
+		return nil
+	}
+	out := new(Date)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCSData) DeepCopyInto(out *GCSData) {
+	*out = *in
+	if in.BucketName != nil {
+		in, out := &in.BucketName, &out.BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketNameRef != nil {
+		in, out := &in.BucketNameRef, &out.BucketNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketNameSelector != nil {
+		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCSData.
+func (in *GCSData) DeepCopy() *GCSData {
+	if in == nil {
+		return nil
+	}
+	out := new(GCSData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPData) DeepCopyInto(out *HTTPData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPData.
+func (in *HTTPData) DeepCopy() *HTTPData {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectConditions) DeepCopyInto(out *ObjectConditions) {
+	*out = *in
+	if in.IncludePrefixes != nil {
+		in, out := &in.IncludePrefixes, &out.IncludePrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludePrefixes != nil {
+		in, out := &in.ExcludePrefixes, &out.ExcludePrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinTimeElapsedSinceLastModification != nil {
+		in, out := &in.MinTimeElapsedSinceLastModification, &out.MinTimeElapsedSinceLastModification
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxTimeElapsedSinceLastModification != nil {
+		in, out := &in.MaxTimeElapsedSinceLastModification, &out.MaxTimeElapsedSinceLastModification
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastModifiedSince != nil {
+		in, out := &in.LastModifiedSince, &out.LastModifiedSince
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastModifiedBefore != nil {
+		in, out := &in.LastModifiedBefore, &out.LastModifiedBefore
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectConditions.
+func (in *ObjectConditions) DeepCopy() *ObjectConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	out.ScheduleStartDate = in.ScheduleStartDate
+	if in.ScheduleEndDate != nil {
+		in, out := &in.ScheduleEndDate, &out.ScheduleEndDate
+		*out = new(Date)
+		**out = **in
+	}
+	if in.StartTimeOfDay != nil {
+		in, out := &in.StartTimeOfDay, &out.StartTimeOfDay
+		*out = new(TimeOfDay)
+		**out = **in
+	}
+	if in.EndTimeOfDay != nil {
+		in, out := &in.EndTimeOfDay, &out.EndTimeOfDay
+		*out = new(TimeOfDay)
+		**out = **in
+	}
+	if in.RepeatInterval != nil {
+		in, out := &in.RepeatInterval, &out.RepeatInterval
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeOfDay) DeepCopyInto(out *TimeOfDay) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeOfDay.
+func (in *TimeOfDay) DeepCopy() *TimeOfDay {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeOfDay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransferJob) DeepCopyInto(out *TransferJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferJob.
+func (in *TransferJob) DeepCopy() *TransferJob {
+	if in == nil {
+		return nil
+	}
+	out := new(TransferJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransferJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransferJobList) DeepCopyInto(out *TransferJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransferJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferJobList.
+func (in *TransferJobList) DeepCopy() *TransferJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransferJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransferJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransferJobObservation) DeepCopyInto(out *TransferJobObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferJobObservation.
+func (in *TransferJobObservation) DeepCopy() *TransferJobObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransferJobObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransferJobParameters) DeepCopyInto(out *TransferJobParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	in.TransferSpec.DeepCopyInto(&out.TransferSpec)
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(Schedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferJobParameters.
+func (in *TransferJobParameters) DeepCopy() *TransferJobParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransferJobParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransferJobSpec) DeepCopyInto(out *TransferJobSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferJobSpec.
+func (in *TransferJobSpec) DeepCopy() *TransferJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransferJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransferJobStatus) DeepCopyInto(out *TransferJobStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferJobStatus.
+func (in *TransferJobStatus) DeepCopy() *TransferJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransferJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransferOptions) DeepCopyInto(out *TransferOptions) {
+	*out = *in
+	if in.OverwriteObjectsAlreadyExistingInSink != nil {
+		in, out := &in.OverwriteObjectsAlreadyExistingInSink, &out.OverwriteObjectsAlreadyExistingInSink
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OverwriteWhen != nil {
+		in, out := &in.OverwriteWhen, &out.OverwriteWhen
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeleteObjectsUniqueInSink != nil {
+		in, out := &in.DeleteObjectsUniqueInSink, &out.DeleteObjectsUniqueInSink
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteObjectsFromSourceAfterTransfer != nil {
+		in, out := &in.DeleteObjectsFromSourceAfterTransfer, &out.DeleteObjectsFromSourceAfterTransfer
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferOptions.
+func (in *TransferOptions) DeepCopy() *TransferOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(TransferOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransferSpec) DeepCopyInto(out *TransferSpec) {
+	*out = *in
+	if in.GCSDataSource != nil {
+		in, out := &in.GCSDataSource, &out.GCSDataSource
+		*out = new(GCSData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSS3DataSource != nil {
+		in, out := &in.AWSS3DataSource, &out.AWSS3DataSource
+		*out = new(AWSS3Data)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPDataSource != nil {
+		in, out := &in.HTTPDataSource, &out.HTTPDataSource
+		*out = new(HTTPData)
+		**out = **in
+	}
+	in.GCSDataSink.DeepCopyInto(&out.GCSDataSink)
+	if in.ObjectConditions != nil {
+		in, out := &in.ObjectConditions, &out.ObjectConditions
+		*out = new(ObjectConditions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransferOptions != nil {
+		in, out := &in.TransferOptions, &out.TransferOptions
+		*out = new(TransferOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferSpec.
+func (in *TransferSpec) DeepCopy() *TransferSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransferSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DefaultProviderConfigSpec defines which ProviderConfig is used by the
+// managed resources it matches.
+type DefaultProviderConfigSpec struct {
+	// ProviderConfigReference is the ProviderConfig used by matching managed
+	// resources that omit their providerConfigRef.
+	ProviderConfigReference xpv1.Reference `json:"providerConfigRef"`
+
+	// Namespaces this default applies to. A managed resource is in the
+	// namespace of the claim it was composed for, if any. This default
+	// applies to managed resources in any namespace, including those not
+	// composed for a claim, if no namespaces are specified.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Selector selects the managed resources this default applies to by
+	// their labels. This default applies to managed resources with any
+	// labels if no selector is specified.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Priority of this default. When several defaults match a managed
+	// resource the one with the highest priority is used, and ties are
+	// broken by the lexically smallest name.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DefaultProviderConfig selects the ProviderConfig used by managed
+// resources that omit their providerConfigRef, based on their namespace and
+// labels. Such resources reference the ProviderConfig named "default" unless
+// a DefaultProviderConfig matches them.
+// +kubebuilder:printcolumn:name="CONFIG-NAME",type="string",JSONPath=".spec.providerConfigRef.name"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.priority"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="NAMESPACES",type="string",JSONPath=".spec.namespaces",priority=1
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,gcp}
+type DefaultProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DefaultProviderConfigSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// DefaultProviderConfigList contains a list of DefaultProviderConfig
+type DefaultProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DefaultProviderConfig `json:"items"`
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// Location classes of a member of an active/standby pair.
+const (
+	LocationClassPrimary   = "Primary"
+	LocationClassSecondary = "Secondary"
+)
+
+// Roles of a member of an active/standby pair.
+const (
+	FailoverRoleActive  = "Active"
+	FailoverRoleStandby = "Standby"
+)
+
+// A FailoverSpec declares a regional resource as a member of an
+// active/standby pair of resources in different regions, e.g. a CloudSQL
+// instance and its cross-region replica. Composites set the same FailoverSpec
+// on both members, and fail over by setting promote on the standby.
+type FailoverSpec struct {
+	// LocationClass is the class of the location of this member of the
+	// pair. The Primary member is the active one until the pair fails over.
+	// +kubebuilder:validation:Enum=Primary;Secondary
+	LocationClass string `json:"locationClass"`
+
+	// Promote this member to be the active member of the pair, if it is the
+	// standby. Promotion cannot be undone; the former active member must be
+	// recreated as a standby of the promoted one. Promote has no effect on
+	// the active member.
+	// +optional
+	Promote bool `json:"promote,omitempty"`
+}
+
+// A FailoverStatus reports the observed role of a member of an active/standby
+// pair.
+type FailoverStatus struct {
+	// LocationClass of this member of the pair, as declared in its spec.
+	LocationClass string `json:"locationClass,omitempty"`
+
+	// Role of this member of the pair, i.e. Active or Standby.
+	Role string `json:"role,omitempty"`
+
+	// FailedOver is true if the role of this member differs from the role
+	// implied by its location class, i.e. if the pair has failed over.
+	FailedOver bool `json:"failedOver,omitempty"`
+
+	// Peers are the names of the external resources this member replicates
+	// to if it is active, or from if it is the standby.
+	Peers []string `json:"peers,omitempty"`
+}
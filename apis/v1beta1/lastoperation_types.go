@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Verbs of a LastOperation.
+const (
+	OperationVerbCreate = "Create"
+	OperationVerbUpdate = "Update"
+	OperationVerbDelete = "Delete"
+)
+
+// A LastOperation records the last mutation the provider made to an external
+// resource, so that changes to a managed resource can be correlated with the
+// Google Cloud audit logs.
+type LastOperation struct {
+	// Verb is the kind of mutation, i.e. Create, Update or Delete.
+	Verb string `json:"verb"`
+
+	// Time at which the mutation was made.
+	Time metav1.Time `json:"time"`
+
+	// OperationID is the name of the long running Google Cloud operation
+	// started by the mutation, if any.
+	// +optional
+	OperationID string `json:"operationId,omitempty"`
+
+	// Requestor is the Google Cloud identity, e.g. the service account email,
+	// that made the mutation, if it is known.
+	// +optional
+	Requestor string `json:"requestor,omitempty"`
+}
+
+// A LastOperationRecorder is a managed resource that records the last mutation
+// made to its external resource.
+// +kubebuilder:object:generate=false
+type LastOperationRecorder interface {
+	GetLastOperation() *LastOperation
+	SetLastOperation(o *LastOperation)
+}
@@ -45,6 +45,14 @@ var (
 	ProviderConfigGroupVersionKind = SchemeGroupVersion.WithKind(ProviderConfigKind)
 )
 
+// DefaultProviderConfig type metadata.
+var (
+	DefaultProviderConfigKind             = reflect.TypeOf(DefaultProviderConfig{}).Name()
+	DefaultProviderConfigGroupKind        = schema.GroupKind{Group: Group, Kind: DefaultProviderConfigKind}.String()
+	DefaultProviderConfigKindAPIVersion   = DefaultProviderConfigKind + "." + SchemeGroupVersion.String()
+	DefaultProviderConfigGroupVersionKind = SchemeGroupVersion.WithKind(DefaultProviderConfigKind)
+)
+
 // ProviderConfigUsage type metadata.
 var (
 	ProviderConfigUsageKind             = reflect.TypeOf(ProviderConfigUsage{}).Name()
@@ -61,4 +69,5 @@ var (
 func init() {
 	SchemeBuilder.Register(&ProviderConfig{}, &ProviderConfigList{})
 	SchemeBuilder.Register(&ProviderConfigUsage{}, &ProviderConfigUsageList{})
+	SchemeBuilder.Register(&DefaultProviderConfig{}, &DefaultProviderConfigList{})
 }
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import "strings"
+
+// Scopes of the Google Cloud resource hierarchy. Resources such as IAM
+// policies and custom roles belong to a project, a folder or an organization.
+const (
+	ScopeProject      = "Project"
+	ScopeFolder       = "Folder"
+	ScopeOrganization = "Organization"
+)
+
+// scopePrefixes are the collection IDs of the relative resource names of the
+// resources of each scope.
+var scopePrefixes = map[string]string{
+	ScopeProject:      "projects/",
+	ScopeFolder:       "folders/",
+	ScopeOrganization: "organizations/",
+}
+
+// ScopedName returns the relative resource name of the project, folder or
+// organization of the supplied scope with the supplied ID, e.g. folders/1234.
+// IDs that already carry the prefix of the scope are returned as is.
+func ScopedName(scope, id string) string {
+	p := scopePrefixes[scope]
+	return p + strings.TrimPrefix(id, p)
+}
+
+// ScopeOf returns the scope of the supplied relative resource name, e.g.
+// Organization for organizations/1234/roles/reader, or an empty string if the
+// name does not belong to a project, folder or organization.
+func ScopeOf(name string) string {
+	for s, p := range scopePrefixes {
+		if strings.HasPrefix(name, p) {
+			return s
+		}
+	}
+	return ""
+}
+
+// ScopeID returns the ID of the project, folder or organization the supplied
+// relative resource name belongs to, e.g. 1234 for
+// organizations/1234/roles/reader, or an empty string if it belongs to none.
+func ScopeID(name string) string {
+	p, ok := scopePrefixes[ScopeOf(name)]
+	if !ok {
+		return ""
+	}
+	id, _, _ := strings.Cut(strings.TrimPrefix(name, p), "/")
+	return id
+}
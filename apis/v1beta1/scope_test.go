@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScopedName(t *testing.T) {
+	cases := map[string]struct {
+		scope string
+		id    string
+		want  string
+	}{
+		"Project":         {scope: ScopeProject, id: "my-project", want: "projects/my-project"},
+		"Folder":          {scope: ScopeFolder, id: "1234", want: "folders/1234"},
+		"Organization":    {scope: ScopeOrganization, id: "5678", want: "organizations/5678"},
+		"AlreadyPrefixed": {scope: ScopeFolder, id: "folders/1234", want: "folders/1234"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ScopedName(tc.scope, tc.id)); diff != "" {
+				t.Errorf("ScopedName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScopeOf(t *testing.T) {
+	cases := map[string]struct {
+		name      string
+		wantScope string
+		wantID    string
+	}{
+		"ProjectRole":      {name: "projects/my-project/roles/reader", wantScope: ScopeProject, wantID: "my-project"},
+		"OrganizationRole": {name: "organizations/5678/roles/reader", wantScope: ScopeOrganization, wantID: "5678"},
+		"Folder":           {name: "folders/1234", wantScope: ScopeFolder, wantID: "1234"},
+		"PredefinedRole":   {name: "roles/viewer"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantScope, ScopeOf(tc.name)); diff != "" {
+				t.Errorf("ScopeOf(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantID, ScopeID(tc.name)); diff != "" {
+				t.Errorf("ScopeID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Units of a Utilization.
+const (
+	UtilizationUnitIPAddresses = "IPAddresses"
+	UtilizationUnitBytes       = "Bytes"
+)
+
+// A Utilization records how much of the capacity of an external resource, e.g.
+// the IP addresses of a subnetwork or the storage of a database instance, is
+// used. It is recorded periodically rather than on every observation, and is
+// meant to drive capacity alerts, e.g. via kube-state-metrics.
+type Utilization struct {
+	// Unit of Used and Capacity, i.e. IPAddresses or Bytes.
+	Unit string `json:"unit"`
+
+	// Used is the amount of capacity that is in use.
+	Used int64 `json:"used"`
+
+	// Capacity is the total amount of capacity of the resource.
+	Capacity int64 `json:"capacity"`
+
+	// Percent of the capacity that is in use, rounded down.
+	Percent int64 `json:"percent"`
+
+	// ObservedTime is the time at which the utilization was recorded.
+	ObservedTime metav1.Time `json:"observedTime"`
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/address"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/firewall"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/globaladdress"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/subnetwork"
+)
+
+const errCopyObserved = "cannot copy external resource"
+
+// A kind of managed resource that can be imported from its external resource
+// and diffed against it.
+type kind struct {
+	// Pattern of the relative URL of the external resource, e.g.
+	// projects/{project}/global/networks/{name}.
+	Pattern string
+
+	// GroupVersionKind of the managed resource.
+	GroupVersionKind schema.GroupVersionKind
+
+	// New returns an empty managed resource of the kind.
+	New func() resource.Managed
+
+	// Import returns a managed resource whose spec is late-initialized from
+	// the external resource with the supplied URL variables.
+	Import func(ctx context.Context, s *compute.Service, v vars) (resource.Managed, error)
+
+	// Diff returns the differences between the external resource of the
+	// supplied managed resource and the one its spec describes, or an empty
+	// string if there are none.
+	Diff func(ctx context.Context, s *compute.Service, project string, mg resource.Managed) (string, error)
+}
+
+var kinds = []kind{
+	{
+		Pattern:          "projects/{project}/global/networks/{name}",
+		GroupVersionKind: v1beta1.NetworkGroupVersionKind,
+		New:              func() resource.Managed { return &v1beta1.Network{} },
+		Import: func(ctx context.Context, s *compute.Service, v vars) (resource.Managed, error) {
+			observed, err := s.Networks.Get(v["project"], v["name"]).Context(ctx).Do()
+			if err != nil {
+				return nil, err
+			}
+			cr := &v1beta1.Network{}
+			network.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+			return cr, nil
+		},
+		Diff: func(ctx context.Context, s *compute.Service, project string, mg resource.Managed) (string, error) {
+			cr := mg.(*v1beta1.Network)
+			observed, err := s.Networks.Get(project, meta.GetExternalName(cr)).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			c, err := copystructure.Copy(observed)
+			if err != nil {
+				return "", errors.Wrap(err, errCopyObserved)
+			}
+			desired := c.(*compute.Network)
+			network.GenerateNetwork(meta.GetExternalName(cr), cr.Spec.ForProvider, desired)
+			return diff(observed, desired), nil
+		},
+	},
+	{
+		Pattern:          "projects/{project}/regions/{region}/subnetworks/{name}",
+		GroupVersionKind: v1beta1.SubnetworkGroupVersionKind,
+		New:              func() resource.Managed { return &v1beta1.Subnetwork{} },
+		Import: func(ctx context.Context, s *compute.Service, v vars) (resource.Managed, error) {
+			observed, err := s.Subnetworks.Get(v["project"], v["region"], v["name"]).Context(ctx).Do()
+			if err != nil {
+				return nil, err
+			}
+			cr := &v1beta1.Subnetwork{}
+			cr.Spec.ForProvider.Region = v["region"]
+			subnetwork.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+			return cr, nil
+		},
+		Diff: func(ctx context.Context, s *compute.Service, project string, mg resource.Managed) (string, error) {
+			cr := mg.(*v1beta1.Subnetwork)
+			observed, err := s.Subnetworks.Get(project, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			c, err := copystructure.Copy(observed)
+			if err != nil {
+				return "", errors.Wrap(err, errCopyObserved)
+			}
+			desired := c.(*compute.Subnetwork)
+			subnetwork.GenerateSubnetwork(meta.GetExternalName(cr), cr.Spec.ForProvider, desired)
+			return diff(observed, desired), nil
+		},
+	},
+	{
+		Pattern:          "projects/{project}/regions/{region}/addresses/{name}",
+		GroupVersionKind: v1beta1.AddressGroupVersionKind,
+		New:              func() resource.Managed { return &v1beta1.Address{} },
+		Import: func(ctx context.Context, s *compute.Service, v vars) (resource.Managed, error) {
+			observed, err := s.Addresses.Get(v["project"], v["region"], v["name"]).Context(ctx).Do()
+			if err != nil {
+				return nil, err
+			}
+			cr := &v1beta1.Address{}
+			cr.Spec.ForProvider.Region = v["region"]
+			address.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+			return cr, nil
+		},
+		Diff: func(ctx context.Context, s *compute.Service, project string, mg resource.Managed) (string, error) {
+			cr := mg.(*v1beta1.Address)
+			observed, err := s.Addresses.Get(project, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			c, err := copystructure.Copy(observed)
+			if err != nil {
+				return "", errors.Wrap(err, errCopyObserved)
+			}
+			desired := c.(*compute.Address)
+			address.GenerateAddress(meta.GetExternalName(cr), cr.Spec.ForProvider, desired)
+			return diff(observed, desired), nil
+		},
+	},
+	{
+		Pattern:          "projects/{project}/global/addresses/{name}",
+		GroupVersionKind: v1beta1.GlobalAddressGroupVersionKind,
+		New:              func() resource.Managed { return &v1beta1.GlobalAddress{} },
+		Import: func(ctx context.Context, s *compute.Service, v vars) (resource.Managed, error) {
+			observed, err := s.GlobalAddresses.Get(v["project"], v["name"]).Context(ctx).Do()
+			if err != nil {
+				return nil, err
+			}
+			cr := &v1beta1.GlobalAddress{}
+			globaladdress.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+			return cr, nil
+		},
+		Diff: func(ctx context.Context, s *compute.Service, project string, mg resource.Managed) (string, error) {
+			cr := mg.(*v1beta1.GlobalAddress)
+			observed, err := s.GlobalAddresses.Get(project, meta.GetExternalName(cr)).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			c, err := copystructure.Copy(observed)
+			if err != nil {
+				return "", errors.Wrap(err, errCopyObserved)
+			}
+			desired := c.(*compute.Address)
+			globaladdress.GenerateGlobalAddress(meta.GetExternalName(cr), cr.Spec.ForProvider, desired)
+			return diff(observed, desired), nil
+		},
+	},
+	{
+		Pattern:          "projects/{project}/global/firewalls/{name}",
+		GroupVersionKind: v1alpha1.FirewallGroupVersionKind,
+		New:              func() resource.Managed { return &v1alpha1.Firewall{} },
+		Import: func(ctx context.Context, s *compute.Service, v vars) (resource.Managed, error) {
+			observed, err := s.Firewalls.Get(v["project"], v["name"]).Context(ctx).Do()
+			if err != nil {
+				return nil, err
+			}
+			cr := &v1alpha1.Firewall{}
+			firewall.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+			return cr, nil
+		},
+		Diff: func(ctx context.Context, s *compute.Service, project string, mg resource.Managed) (string, error) {
+			cr := mg.(*v1alpha1.Firewall)
+			observed, err := s.Firewalls.Get(project, meta.GetExternalName(cr)).Context(ctx).Do()
+			if err != nil {
+				return "", err
+			}
+			c, err := copystructure.Copy(observed)
+			if err != nil {
+				return "", errors.Wrap(err, errCopyObserved)
+			}
+			desired := c.(*compute.Firewall)
+			firewall.GenerateFirewall(meta.GetExternalName(cr), cr.Spec.ForProvider, desired)
+			return diff(observed, desired), nil
+		},
+	},
+}
+
+// kindForURL returns the kind of the external resource with the supplied
+// relative URL, and the variables of the URL.
+func kindForURL(rel string) (kind, vars, error) {
+	for _, k := range kinds {
+		if v, ok := match(k.Pattern, rel); ok {
+			return k, v, nil
+		}
+	}
+	return kind{}, nil, errors.Errorf("resources like %s are not supported", rel)
+}
+
+// kindFor returns the kind with the supplied GroupVersionKind.
+func kindFor(gvk schema.GroupVersionKind) (kind, error) {
+	for _, k := range kinds {
+		if k.GroupVersionKind == gvk {
+			return k, nil
+		}
+	}
+	return kind{}, errors.Errorf("kind %s is not supported", gvk)
+}
+
+// diff returns the differences between the supplied observed and desired
+// external resources, ignoring the fields the Go client of the API uses to
+// send zero values and differently qualified URLs.
+func diff(observed, desired any) string {
+	return cmp.Diff(observed, desired, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmp.FilterPath(func(p cmp.Path) bool {
+			sf, ok := p.Last().(cmp.StructField)
+			return ok && (sf.Name() == "ForceSendFields" || sf.Name() == "NullFields")
+		}, cmp.Ignore()))
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// gcpctl imports existing Google Cloud resources as managed resources and
+// shows how managed resources differ from their external resources. It eases
+// adopting many existing resources at once.
+//
+// For example, to write the managed resource of an existing network and
+// compare it with the network later on:
+//
+//	go run ./cmd/gcpctl import projects/example/global/networks/eg > eg.yaml
+//	go run ./cmd/gcpctl diff --project=example eg.yaml
+//
+// Resources are read with the application default credentials unless
+// --credentials is set.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+func main() {
+	var (
+		app         = kingpin.New(filepath.Base(os.Args[0]), "Import Google Cloud resources as managed resources and diff them.").DefaultEnvars()
+		credentials = app.Flag("credentials", "Path of a service account key file. Defaults to the application default credentials.").ExistingFile()
+
+		importCmd      = app.Command("import", "Write the managed resource of an existing Google Cloud resource to stdout.")
+		importURL      = importCmd.Arg("url", "URL of the resource, e.g. projects/example/global/networks/eg or its selfLink.").Required().String()
+		providerConfig = importCmd.Flag("provider-config", "Name of the ProviderConfig of the managed resource.").Default("default").String()
+		deletionPolicy = importCmd.Flag("deletion-policy", "Deletion policy of the managed resource.").Default(string(xpv1.DeletionOrphan)).Enum(string(xpv1.DeletionOrphan), string(xpv1.DeletionDelete))
+
+		diffCmd     = app.Command("diff", "Show how the external resource of a managed resource differs from its spec.")
+		diffFile    = diffCmd.Arg("file", "Path of the YAML of the managed resource, or - for stdin.").Required().String()
+		diffProject = diffCmd.Flag("project", "Project of the external resource, i.e. the one of the ProviderConfig of the managed resource.").Required().String()
+	)
+	cmd := kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	ctx := context.Background()
+	var opts []option.ClientOption
+	if *credentials != "" {
+		opts = append(opts, option.WithCredentialsFile(*credentials))
+	}
+	s, err := compute.NewService(ctx, opts...)
+	kingpin.FatalIfError(err, "Cannot create Compute Engine client")
+
+	switch cmd {
+	case importCmd.FullCommand():
+		out, err := importResource(ctx, s, *importURL, *providerConfig, xpv1.DeletionPolicy(*deletionPolicy))
+		kingpin.FatalIfError(err, "Cannot import resource")
+		_, err = os.Stdout.Write(out)
+		kingpin.FatalIfError(err, "Cannot write managed resource")
+	case diffCmd.FullCommand():
+		in, err := readFile(*diffFile)
+		kingpin.FatalIfError(err, "Cannot read managed resource")
+		d, err := diffResource(ctx, s, *diffProject, in)
+		kingpin.FatalIfError(err, "Cannot diff managed resource")
+		if d == "" {
+			fmt.Fprintln(os.Stderr, "external resource is up to date")
+			return
+		}
+		fmt.Fprintf(os.Stdout, "external resource differs (-observed +desired):\n%s", d)
+		os.Exit(1)
+	}
+}
+
+// importResource returns the YAML of a managed resource of the external
+// resource with the supplied URL. The external name of the managed resource
+// is the name of the external resource, so that the provider observes the
+// existing resource instead of creating a new one.
+func importResource(ctx context.Context, s *compute.Service, url, providerConfig string, policy xpv1.DeletionPolicy) ([]byte, error) {
+	rel, err := relativeURL(url)
+	if err != nil {
+		return nil, err
+	}
+	k, v, err := kindForURL(rel)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := k.Import(ctx, s, v)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get %s", rel)
+	}
+	cr.GetObjectKind().SetGroupVersionKind(k.GroupVersionKind)
+	cr.SetName(path.Base(rel))
+	meta.SetExternalName(cr, path.Base(rel))
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: providerConfig})
+	cr.SetDeletionPolicy(policy)
+	return toYAML(cr)
+}
+
+// diffResource returns the differences between the external resource of the
+// managed resource with the supplied YAML and the one its spec describes.
+func diffResource(ctx context.Context, s *compute.Service, project string, in []byte) (string, error) {
+	u := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(in, &u.Object); err != nil {
+		return "", errors.Wrap(err, "cannot parse managed resource")
+	}
+	k, err := kindFor(u.GroupVersionKind())
+	if err != nil {
+		return "", err
+	}
+	cr := k.New()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, cr); err != nil {
+		return "", errors.Wrapf(err, "cannot parse %s", k.GroupVersionKind.Kind)
+	}
+	d, err := k.Diff(ctx, s, project, cr)
+	return d, errors.Wrapf(err, "cannot get external resource of %s %s", k.GroupVersionKind.Kind, cr.GetName())
+}
+
+// toYAML returns the YAML of the supplied managed resource without its status
+// and the fields of its metadata that are set by the API server.
+func toYAML(cr resource.Managed) ([]byte, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cr)
+	if err != nil {
+		return nil, err
+	}
+	unstructured.RemoveNestedField(u, "status")
+	unstructured.RemoveNestedField(u, "metadata", "creationTimestamp")
+	out, err := yaml.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("---\n"), out...), nil
+}
+
+// readFile returns the content of the supplied file, or of stdin if it is -.
+func readFile(name string) ([]byte, error) {
+	if name == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(filepath.Clean(name))
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+const networkYAML = `---
+apiVersion: compute.gcp.crossplane.io/v1beta1
+kind: Network
+metadata:
+  annotations:
+    crossplane.io/external-name: eg
+  name: eg
+spec:
+  deletionPolicy: Orphan
+  forProvider:
+    description: An example network.
+    routingConfig:
+      routingMode: REGIONAL
+  providerConfigRef:
+    name: default
+`
+
+func newService(t *testing.T, h http.HandlerFunc) *compute.Service {
+	t.Helper()
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+	s, err := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func serveNetwork(t *testing.T, n *compute.Network) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff("/projects/example/global/networks/eg", r.URL.Path); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		if err := json.NewEncoder(w).Encode(n); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestImportResource(t *testing.T) {
+	observed := &compute.Network{
+		Name:          "eg",
+		Description:   "An example network.",
+		RoutingConfig: &compute.NetworkRoutingConfig{RoutingMode: "REGIONAL"},
+		SelfLink:      "https://www.googleapis.com/compute/v1/projects/example/global/networks/eg",
+	}
+
+	cases := map[string]struct {
+		url     string
+		want    string
+		wantErr bool
+	}{
+		"Network": {
+			url:  "https://www.googleapis.com/compute/v1/projects/example/global/networks/eg",
+			want: networkYAML,
+		},
+		"NotSupported": {
+			url:     "projects/example/global/images/eg",
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := newService(t, serveNetwork(t, observed))
+			got, err := importResource(context.Background(), s, tc.url, "default", xpv1.DeletionOrphan)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("importResource(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("importResource(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffResource(t *testing.T) {
+	cases := map[string]struct {
+		observed *compute.Network
+		in       string
+		wantDiff bool
+		wantErr  bool
+	}{
+		"UpToDate": {
+			observed: &compute.Network{
+				Name:          "eg",
+				Description:   "An example network.",
+				RoutingConfig: &compute.NetworkRoutingConfig{RoutingMode: "REGIONAL"},
+			},
+			in: networkYAML,
+		},
+		"RoutingModeDiffers": {
+			observed: &compute.Network{
+				Name:          "eg",
+				Description:   "An example network.",
+				RoutingConfig: &compute.NetworkRoutingConfig{RoutingMode: "GLOBAL"},
+			},
+			in:       networkYAML,
+			wantDiff: true,
+		},
+		"NotSupported": {
+			in:      "apiVersion: compute.gcp.crossplane.io/v1alpha1\nkind: Instance\n",
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := newService(t, serveNetwork(t, tc.observed))
+			got, err := diffResource(context.Background(), s, "example", []byte(tc.in))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("diffResource(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantDiff, got != ""); diff != "" {
+				t.Errorf("diffResource(...): -want diff, +got diff:\n%s\n%s", diff, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// vars are the variables of the URL of an external resource, e.g. its
+// project, region and name.
+type vars map[string]string
+
+// relativeURL returns the URL of an external resource relative to the API
+// version of its service, e.g. projects/example/global/networks/eg. Fully
+// qualified URLs like those of selfLinks, full resource names of Cloud Asset
+// Inventory like //compute.googleapis.com/projects/example/global/networks/eg
+// and relative URLs are accepted.
+func relativeURL(in string) (string, error) {
+	p := in
+	if u, err := url.Parse(in); err == nil && u.Host != "" {
+		p = u.Path
+	}
+	p = strings.Trim(p, "/")
+	if strings.HasPrefix(p, "projects/") {
+		return p, nil
+	}
+	if _, after, ok := strings.Cut(p, "/projects/"); ok {
+		return "projects/" + after, nil
+	}
+	return "", errors.Errorf("%s is not the URL of a resource of a project", in)
+}
+
+// match returns the variables of the supplied relative URL if it matches the
+// supplied pattern, e.g. projects/{project}/global/networks/{name}.
+func match(pattern, rel string) (vars, bool) {
+	ps := strings.Split(pattern, "/")
+	rs := strings.Split(rel, "/")
+	if len(ps) != len(rs) {
+		return nil, false
+	}
+	v := vars{}
+	for i, p := range ps {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			if rs[i] == "" {
+				return nil, false
+			}
+			v[strings.Trim(p, "{}")] = rs[i]
+			continue
+		}
+		if p != rs[i] {
+			return nil, false
+		}
+	}
+	return v, true
+}
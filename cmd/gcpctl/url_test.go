@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRelativeURL(t *testing.T) {
+	cases := map[string]struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		"Relative": {
+			in:   "projects/example/global/networks/eg",
+			want: "projects/example/global/networks/eg",
+		},
+		"SelfLink": {
+			in:   "https://www.googleapis.com/compute/v1/projects/example/regions/us-central1/addresses/eg",
+			want: "projects/example/regions/us-central1/addresses/eg",
+		},
+		"FullResourceName": {
+			in:   "//compute.googleapis.com/projects/example/global/firewalls/eg",
+			want: "projects/example/global/firewalls/eg",
+		},
+		"NotAProject": {
+			in:      "organizations/123/roles/eg",
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := relativeURL(tc.in)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("relativeURL(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("relativeURL(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	const pattern = "projects/{project}/regions/{region}/addresses/{name}"
+
+	cases := map[string]struct {
+		rel    string
+		want   vars
+		wantOK bool
+	}{
+		"Match": {
+			rel:    "projects/example/regions/us-central1/addresses/eg",
+			want:   vars{"project": "example", "region": "us-central1", "name": "eg"},
+			wantOK: true,
+		},
+		"OtherCollection": {
+			rel: "projects/example/regions/us-central1/subnetworks/eg",
+		},
+		"TooShort": {
+			rel: "projects/example/regions/us-central1/addresses",
+		},
+		"EmptyVariable": {
+			rel: "projects//regions/us-central1/addresses/eg",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := match(pattern, tc.rel)
+			if diff := cmp.Diff(tc.wantOK, ok); diff != "" {
+				t.Errorf("match(...): -want ok, +got ok:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("match(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errReadDocument  = "cannot read discovery document"
+	errParseDocument = "cannot parse discovery document"
+)
+
+// A Document is the subset of a Google API discovery document that is used
+// to generate types. See https://developers.google.com/discovery/v1/reference/apis
+type Document struct {
+	Name    string             `json:"name"`
+	Version string             `json:"version"`
+	Title   string             `json:"title"`
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// A Schema of a discovery document, i.e. a JSON schema.
+type Schema struct {
+	ID                   string             `json:"id"`
+	Type                 string             `json:"type"`
+	Description          string             `json:"description"`
+	Ref                  string             `json:"$ref"`
+	Format               string             `json:"format"`
+	Properties           map[string]*Schema `json:"properties"`
+	Items                *Schema            `json:"items"`
+	AdditionalProperties *Schema            `json:"additionalProperties"`
+	Enum                 []string           `json:"enum"`
+	ReadOnly             bool               `json:"readOnly"`
+}
+
+// OutputOnly returns true if the value of the schema is set by the API and
+// cannot be written. Discovery documents mark such properties with readOnly,
+// or only by starting their description with "Output only".
+func (s *Schema) OutputOnly() bool {
+	if s.ReadOnly {
+		return true
+	}
+	d := strings.ToLower(strings.TrimSpace(s.Description))
+	return strings.HasPrefix(d, "output only") || strings.HasPrefix(d, "[output only]") || strings.HasPrefix(d, "[output-only]")
+}
+
+// LoadDocument loads the discovery document at the supplied path, which may
+// be a local file or an http(s) URL.
+func LoadDocument(src string) (*Document, error) {
+	var r io.ReadCloser
+	if strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://") {
+		res, err := http.Get(src) //nolint:gosec // The URL is supplied by the user running the generator.
+		if err != nil {
+			return nil, errors.Wrap(err, errReadDocument)
+		}
+		if res.StatusCode != http.StatusOK {
+			_ = res.Body.Close()
+			return nil, errors.Errorf("%s: %s", errReadDocument, res.Status)
+		}
+		r = res.Body
+	} else {
+		f, err := os.Open(src) //nolint:gosec // The path is supplied by the user running the generator.
+		if err != nil {
+			return nil, errors.Wrap(err, errReadDocument)
+		}
+		r = f
+	}
+	defer r.Close() //nolint:errcheck // Only read from r.
+
+	d := &Document{}
+	if err := json.NewDecoder(r).Decode(d); err != nil {
+		return nil, errors.Wrap(err, errParseDocument)
+	}
+	return d, nil
+}
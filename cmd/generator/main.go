@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The generator scaffolds a new kind from a schema of a Google API discovery
+// document. It writes the parameters, observation and resource structs of the
+// kind to apis/<group>/<version>/<kind>_types.go, and the functions that
+// convert them to and from the types of the Go client of the API, including
+// IsUpToDate, to pkg/clients/<kind>/<kind>.go. Deepcopy and managed resource
+// methods are generated as usual by running go generate ./apis/...
+//
+// For example:
+//
+//	go run ./cmd/generator \
+//	  --discovery=https://pubsub.googleapis.com/$discovery/rest?version=v1 \
+//	  --schema=Snapshot --group=pubsub --api-package=google.golang.org/api/pubsub/v1
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func main() {
+	var (
+		app        = kingpin.New(filepath.Base(os.Args[0]), "Generate the types of a new kind from a Google API discovery document.").DefaultEnvars()
+		discovery  = app.Flag("discovery", "Path or URL of the discovery document.").Required().String()
+		schema     = app.Flag("schema", "Name of the schema of the external resource in the discovery document, e.g. Topic.").Required().String()
+		kind       = app.Flag("kind", "Kind of the generated managed resource. Defaults to the schema name.").String()
+		group      = app.Flag("group", "API group of the kind, i.e. the directory under apis, e.g. pubsub.").Required().String()
+		version    = app.Flag("version", "API version of the kind.").Default("v1alpha1").String()
+		apiPackage = app.Flag("api-package", "Import path of the Go client of the API, e.g. google.golang.org/api/pubsub/v1.").Required().String()
+		headerFile = app.Flag("header-file", "Path of the license header of generated files.").Default("hack/boilerplate.go.txt").String()
+		outputDir  = app.Flag("output-dir", "Root directory of the repository to write the generated files to.").Default(".").String()
+		force      = app.Flag("force", "Overwrite existing files.").Bool()
+		columns    = app.Flag("print-column", "Additional kubectl printer column of the kind as NAME=JSONPath, e.g. REGION=.spec.forProvider.region. May be repeated.").Strings()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	if *kind == "" {
+		*kind = *schema
+	}
+
+	doc, err := LoadDocument(*discovery)
+	kingpin.FatalIfError(err, "Cannot load discovery document")
+
+	pkg := strings.ToLower(*kind)
+	typesFile := filepath.Join(*outputDir, "apis", *group, *version, pkg+"_types.go")
+	reserved, err := typeNames(filepath.Dir(typesFile), filepath.Base(typesFile))
+	kingpin.FatalIfError(err, "Cannot parse package of kind")
+
+	m, err := Build(doc, *schema, *kind, reserved)
+	kingpin.FatalIfError(err, "Cannot build model")
+
+	header, err := os.ReadFile(filepath.Clean(*headerFile))
+	kingpin.FatalIfError(err, "Cannot read header file")
+
+	pointers, err := pointerFields(*apiPackage)
+	kingpin.FatalIfError(err, "Cannot parse Go client of the API")
+
+	cols, err := printColumns(*columns)
+	kingpin.FatalIfError(err, "Cannot parse printer columns")
+
+	r := &Renderer{
+		Header:        strings.Replace(string(header), "2019", strconv.Itoa(time.Now().Year()), 1),
+		Group:         *group,
+		Version:       *version,
+		APIPackage:    *apiPackage,
+		PointerFields: pointers,
+		PrintColumns:  cols,
+	}
+
+	clientFile := filepath.Join(*outputDir, "pkg", "clients", pkg, pkg+".go")
+	if !*force {
+		for _, f := range []string{typesFile, clientFile} {
+			if _, err := os.Stat(f); err == nil {
+				kingpin.Fatalf("%s already exists, use --force to overwrite it", f)
+			}
+		}
+	}
+
+	types, err := r.Types(m)
+	kingpin.FatalIfError(err, "Cannot render types")
+	client, err := r.Client(m)
+	kingpin.FatalIfError(err, "Cannot render client")
+
+	kingpin.FatalIfError(write(typesFile, types), "Cannot write types")
+	kingpin.FatalIfError(write(clientFile, client), "Cannot write client")
+
+	for _, s := range m.Skipped {
+		fmt.Fprintf(os.Stderr, "skipped %s\n", s)
+	}
+	fmt.Fprintf(os.Stderr, `generated %[1]s; to finish it:
+  - register %[1]s and %[1]sList in apis/%[2]s/%[3]s/register.go
+  - add GetLastOperation and SetLastOperation of %[1]s to apis/%[2]s/%[3]s/lastoperation.go
+  - run go generate ./apis/... to generate its deepcopy and managed resource methods
+  - wrap the ExternalConnecter of its controller with failure.WrapConnecter
+`, *kind, *group, *version)
+}
+
+// typeNames returns the names of the types declared in the package in the
+// supplied directory, except for those in the supplied file.
+func typeNames(dir, except string) (map[string]bool, error) {
+	names := map[string]bool{}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi fs.FileInfo) bool { return fi.Name() != except }, 0)
+	if errors.Is(err, fs.ErrNotExist) {
+		return names, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			for _, d := range f.Decls {
+				gd, ok := d.(*ast.GenDecl)
+				if !ok || gd.Tok != token.TYPE {
+					continue
+				}
+				for _, s := range gd.Specs {
+					names[s.(*ast.TypeSpec).Name.Name] = true
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
+// pointerFields returns the scalar fields of the structs of the Go client of
+// an API that are pointers, as Struct.Field. The Go client uses pointers for
+// a few fields whose zero value is meaningful, e.g. Settings.StorageAutoResize
+// of sqladmin.
+func pointerFields(pkg string) (map[string]bool, error) {
+	out, err := exec.Command("go", "list", "-f", "{{.Dir}}", pkg).Output() //nolint:gosec // The package is supplied by the user running the generator.
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot find package %s", pkg)
+	}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), strings.TrimSpace(string(out)), nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]bool{}
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				ts, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					return false
+				}
+				for _, fd := range st.Fields.List {
+					se, ok := fd.Type.(*ast.StarExpr)
+					if !ok {
+						continue
+					}
+					if _, ok := se.X.(*ast.Ident); !ok {
+						continue
+					}
+					for _, n := range fd.Names {
+						fields[ts.Name.Name+"."+n.Name] = true
+					}
+				}
+				return false
+			})
+		}
+	}
+	return fields, nil
+}
+
+// printColumns parses the supplied NAME=JSONPath printer columns.
+func printColumns(in []string) ([]PrintColumn, error) {
+	cols := make([]PrintColumn, 0, len(in))
+	for _, c := range in {
+		name, path, ok := strings.Cut(c, "=")
+		if !ok || name == "" || !strings.HasPrefix(path, ".") {
+			return nil, errors.Errorf("invalid printer column %q, want NAME=JSONPath", c)
+		}
+		cols = append(cols, PrintColumn{Name: strings.ToUpper(name), JSONPath: path})
+	}
+	return cols, nil
+}
+
+func write(file string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(file, data, 0o600)
+}
@@ -0,0 +1,345 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+const errNoSchema = "discovery document has no schema"
+
+// A Shape of a field.
+type Shape int
+
+// Shapes of a field.
+const (
+	ShapeScalar Shape = iota
+	ShapeObject
+	ShapeArray
+	ShapeMap
+)
+
+// A Field of a generated struct.
+type Field struct {
+	// Name of the field in the generated struct.
+	Name string
+
+	// APIName is the name of the field in the struct of the Go client of
+	// the API.
+	APIName string
+
+	// JSON name of the field, as in the discovery document.
+	JSON string
+
+	Description string
+	Shape       Shape
+
+	// Enum values of a string field.
+	Enum []string
+
+	// Type of the field, or of its elements if it is an array or map. It is
+	// the name of a generated struct if Struct is not nil.
+	Type string
+
+	// Struct is the generated struct of an object field, or of the
+	// elements of an array or map field.
+	Struct *Struct
+}
+
+// A Struct that is generated from a schema of a discovery document.
+type Struct struct {
+	// Name of the generated struct.
+	Name string
+
+	// APIName is the name of the struct in the Go client of the API.
+	APIName string
+
+	Description string
+	Fields      []Field
+}
+
+// A Model of the types that are generated for a kind.
+type Model struct {
+	Kind string
+
+	// Title of the API, e.g. Cloud Pub/Sub API.
+	Title string
+
+	// Resource is the struct of the external resource. Its fields are the
+	// writable fields of the resource, i.e. the parameters of the kind.
+	Resource *Struct
+
+	// Structs are the generated structs of the objects nested in the
+	// parameters, in the order they were found.
+	Structs []*Struct
+
+	// Observation fields are the output only fields of the resource.
+	Observation []Field
+
+	// HasName is true if the resource has a name field, which is set from
+	// the external name rather than the parameters.
+	HasName bool
+
+	// Skipped fields that cannot be generated, and why.
+	Skipped []string
+}
+
+type builder struct {
+	doc      *Document
+	model    *Model
+	structs  map[string]*Struct
+	visiting map[string]bool
+	reserved map[string]bool
+}
+
+// Build a Model of the kind that represents the supplied schema of the
+// supplied document. Generated structs are prefixed with the kind if their
+// name is one of the supplied reserved names, i.e. the names of the types that
+// already exist in the package of the kind.
+func Build(doc *Document, schema, kind string, reserved map[string]bool) (*Model, error) {
+	s, ok := doc.Schemas[schema]
+	if !ok {
+		return nil, errors.Errorf("%s %q", errNoSchema, schema)
+	}
+	b := &builder{
+		doc:      doc,
+		model:    &Model{Kind: kind, Title: doc.Title},
+		structs:  map[string]*Struct{},
+		visiting: map[string]bool{},
+		reserved: reserved,
+	}
+	b.visiting[schema] = true
+	b.model.Resource = &Struct{Name: kind + "Parameters", APIName: apiName(schema), Description: s.Description}
+	for _, p := range sortedProperties(s) {
+		ps := s.Properties[p]
+		switch {
+		case p == "name":
+			b.model.HasName = true
+		case ps.OutputOnly():
+			b.observe(schema, p, ps)
+		default:
+			if f, ok := b.field(schema, p, ps); ok {
+				b.model.Resource.Fields = append(b.model.Resource.Fields, f)
+			}
+		}
+	}
+	return b.model, nil
+}
+
+// observe adds an output only property of the resource to its observation.
+// Only scalars and arrays and maps of scalars are observed.
+func (b *builder) observe(parent, prop string, s *Schema) {
+	e := s
+	switch {
+	case s.Type == "array" && s.Items != nil:
+		e = s.Items
+	case s.Type == "object" && s.AdditionalProperties != nil:
+		e = s.AdditionalProperties
+	}
+	if e.Ref != "" || e.Type == "object" {
+		b.skip(parent, prop, "output only objects are not observed")
+		return
+	}
+	if f, ok := b.field(parent, prop, s); ok {
+		b.model.Observation = append(b.model.Observation, f)
+	}
+}
+
+// field returns the field that represents the supplied property of the
+// supplied parent schema, or false if the property cannot be represented.
+func (b *builder) field(parent, prop string, s *Schema) (Field, bool) {
+	f := Field{
+		Name:        fieldName(prop),
+		APIName:     apiName(prop),
+		JSON:        prop,
+		Description: s.Description,
+	}
+	switch {
+	case s.Type == "array":
+		f.Shape = ShapeArray
+		if s.Items == nil {
+			b.skip(parent, prop, "array has no items")
+			return f, false
+		}
+		return b.elem(f, parent, prop, s.Items)
+	case s.Type == "object" && s.AdditionalProperties != nil:
+		f.Shape = ShapeMap
+		if s.AdditionalProperties.Type == "string" {
+			// Maps of strings are transmitted as strings regardless of
+			// their format.
+			f.Type = "string"
+			return f, true
+		}
+		return b.elem(f, parent, prop, s.AdditionalProperties)
+	default:
+		return b.elem(f, parent, prop, s)
+	}
+}
+
+// elem completes the supplied field with the type of the supplied schema,
+// which is the schema of the field itself or of its elements.
+func (b *builder) elem(f Field, parent, prop string, s *Schema) (Field, bool) {
+	if s.Ref != "" {
+		rs, ok := b.doc.Schemas[s.Ref]
+		if !ok {
+			b.skip(parent, prop, fmt.Sprintf("unknown schema %q", s.Ref))
+			return f, false
+		}
+		if rs.Type != "object" || rs.Properties == nil {
+			b.skip(parent, prop, fmt.Sprintf("schema %q is not an object", s.Ref))
+			return f, false
+		}
+		return b.object(f, parent, prop, s.Ref, rs)
+	}
+	switch s.Type {
+	case "string":
+		f.Type, f.Enum = "string", s.Enum
+		switch s.Format {
+		case "int64":
+			f.Type = "int64"
+		case "int32", "uint32", "uint64":
+			b.skip(parent, prop, fmt.Sprintf("unsupported format %q", s.Format))
+			return f, false
+		}
+	case "integer":
+		f.Type = "int64"
+	case "boolean":
+		f.Type = "bool"
+	case "object":
+		if s.Properties == nil {
+			b.skip(parent, prop, "object has no properties")
+			return f, false
+		}
+		// Inline objects are named after the property that holds them, like
+		// the Go client of the API does.
+		return b.object(f, parent, prop, parent+"."+prop, s)
+	default:
+		// Floats are not allowed in CRDs, and any cannot be represented.
+		b.skip(parent, prop, fmt.Sprintf("unsupported type %q", s.Type))
+		return f, false
+	}
+	if f.Shape == ShapeArray || f.Shape == ShapeMap {
+		return f, true
+	}
+	f.Shape = ShapeScalar
+	return f, true
+}
+
+// object completes the supplied field with the struct of the supplied object
+// schema, generating the struct if necessary.
+func (b *builder) object(f Field, parent, prop, name string, s *Schema) (Field, bool) {
+	if b.visiting[name] {
+		b.skip(parent, prop, fmt.Sprintf("schema %q is recursive", name))
+		return f, false
+	}
+	if f.Shape != ShapeArray && f.Shape != ShapeMap {
+		f.Shape = ShapeObject
+	}
+	if st, ok := b.structs[name]; ok {
+		f.Type, f.Struct = st.Name, st
+		return f, true
+	}
+
+	st := &Struct{Name: apiName(name), APIName: apiName(name), Description: s.Description}
+	if b.reserved[st.Name] {
+		st.Name = b.model.Kind + st.Name
+	}
+	b.structs[name] = st
+	b.visiting[name] = true
+	for _, p := range sortedProperties(s) {
+		ps := s.Properties[p]
+		if ps.OutputOnly() {
+			continue
+		}
+		if pf, ok := b.field(name, p, ps); ok {
+			st.Fields = append(st.Fields, pf)
+		}
+	}
+	b.visiting[name] = false
+	b.model.Structs = append(b.model.Structs, st)
+	f.Type, f.Struct = st.Name, st
+	return f, true
+}
+
+func (b *builder) skip(parent, prop, reason string) {
+	b.model.Skipped = append(b.model.Skipped, fmt.Sprintf("%s.%s: %s", parent, prop, reason))
+}
+
+func sortedProperties(s *Schema) []string {
+	props := make([]string, 0, len(s.Properties))
+	for p := range s.Properties {
+		props = append(props, p)
+	}
+	sort.Strings(props)
+	return props
+}
+
+// apiName returns the name the Go client of an API uses for the supplied
+// schema or property name.
+func apiName(ident string) string {
+	var sb strings.Builder
+	up := true
+	for _, c := range ident {
+		if c == '-' || c == '.' || c == '$' || c == '/' || c == '_' {
+			up = true
+			continue
+		}
+		if up {
+			c = unicode.ToUpper(c)
+			up = false
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// initialisms that are spelled in upper case in generated field names.
+var initialisms = map[string]string{
+	"Id":   "ID",
+	"Ids":  "IDs",
+	"Ip":   "IP",
+	"Ips":  "IPs",
+	"Uri":  "URI",
+	"Uris": "URIs",
+	"Url":  "URL",
+	"Urls": "URLs",
+}
+
+// fieldName returns the name of the generated field for the supplied property
+// name, which is its API name with initialisms in upper case.
+func fieldName(prop string) string {
+	n := apiName(prop)
+	var sb strings.Builder
+	start := 0
+	for i := 1; i <= len(n); i++ {
+		if i < len(n) && !unicode.IsUpper(rune(n[i])) {
+			continue
+		}
+		w := n[start:i]
+		if r, ok := initialisms[w]; ok {
+			w = r
+		}
+		sb.WriteString(w)
+		start = i
+	}
+	return sb.String()
+}
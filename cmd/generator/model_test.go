@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+const testDocument = "testdata/widget.json"
+
+func TestBuild(t *testing.T) {
+	type want struct {
+		params      []string
+		structs     []string
+		observation []string
+		skipped     []string
+		err         error
+	}
+	cases := map[string]struct {
+		schema   string
+		reserved map[string]bool
+		want     want
+	}{
+		"NoSchema": {
+			schema: "Gadget",
+			want: want{
+				err: errors.Errorf("%s %q", errNoSchema, "Gadget"),
+			},
+		},
+		"Widget": {
+			schema: "Widget",
+			want: want{
+				params:      []string{"Config", "Description", "Enabled", "Labels", "NetworkURLs", "Rules", "SizeGb", "Tier"},
+				structs:     []string{"Tag", "WidgetConfig", "WidgetRules"},
+				observation: []string{"CreateTime", "SelfLink", "State"},
+				skipped: []string{
+					`WidgetConfig.parent: schema "WidgetConfig" is recursive`,
+					`Widget.id: unsupported format "uint64"`,
+					"Widget.status: output only objects are not observed",
+					`Widget.weight: unsupported type "number"`,
+				},
+			},
+		},
+		"ReservedNames": {
+			schema:   "Widget",
+			reserved: map[string]bool{"Tag": true},
+			want: want{
+				params:      []string{"Config", "Description", "Enabled", "Labels", "NetworkURLs", "Rules", "SizeGb", "Tier"},
+				structs:     []string{"WidgetTag", "WidgetConfig", "WidgetRules"},
+				observation: []string{"CreateTime", "SelfLink", "State"},
+				skipped: []string{
+					`WidgetConfig.parent: schema "WidgetConfig" is recursive`,
+					`Widget.id: unsupported format "uint64"`,
+					"Widget.status: output only objects are not observed",
+					`Widget.weight: unsupported type "number"`,
+				},
+			},
+		},
+	}
+
+	doc, err := LoadDocument(testDocument)
+	if err != nil {
+		t.Fatalf("LoadDocument(...): %s", err)
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m, err := Build(doc, tc.schema, "Widget", tc.reserved)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Build(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			got := want{skipped: m.Skipped}
+			for _, f := range m.Resource.Fields {
+				got.params = append(got.params, f.Name)
+			}
+			for _, s := range m.Structs {
+				got.structs = append(got.structs, s.Name)
+			}
+			for _, f := range m.Observation {
+				got.observation = append(got.observation, f.Name)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("Build(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	cases := map[string]struct {
+		ident string
+		api   string
+		field string
+	}{
+		"CamelCase":   {ident: "sizeGb", api: "SizeGb", field: "SizeGb"},
+		"Initialism":  {ident: "networkUrls", api: "NetworkUrls", field: "NetworkURLs"},
+		"LeadingWord": {ident: "ipAddress", api: "IpAddress", field: "IPAddress"},
+		"TrailingID":  {ident: "projectId", api: "ProjectId", field: "ProjectID"},
+		"NotAWord":    {ident: "ipv4Enabled", api: "Ipv4Enabled", field: "Ipv4Enabled"},
+		"Punctuation": {ident: "Widget.rules", api: "WidgetRules", field: "WidgetRules"},
+		"UpperCase":   {ident: "IPProtocol", api: "IPProtocol", field: "IPProtocol"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.api, apiName(tc.ident)); diff != "" {
+				t.Errorf("apiName(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.field, fieldName(tc.ident)); diff != "" {
+				t.Errorf("fieldName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	modulePath = "github.com/crossplane-contrib/provider-gcp"
+
+	errFormat = "cannot format generated code"
+
+	// commentWidth is the width at which generated comments are wrapped.
+	commentWidth = 77
+)
+
+// A Renderer renders the Go code of a Model.
+type Renderer struct {
+	// Header of the generated files, i.e. the license boilerplate.
+	Header string
+
+	// Group and Version of the API of the generated kind, e.g. pubsub and
+	// v1alpha1.
+	Group   string
+	Version string
+
+	// APIPackage is the import path of the Go client of the API, e.g.
+	// google.golang.org/api/pubsub/v1.
+	APIPackage string
+
+	// PointerFields are the scalar fields of the structs of the Go client of
+	// the API that are pointers, as Struct.Field.
+	PointerFields map[string]bool
+
+	// PrintColumns are printed by kubectl in addition to the columns every
+	// managed resource has.
+	PrintColumns []PrintColumn
+}
+
+// A PrintColumn is a kubectl printer column of the generated kind.
+type PrintColumn struct {
+	// Name of the column, e.g. REGION.
+	Name string
+
+	// JSONPath of the printed string field, e.g. .spec.forProvider.region.
+	JSONPath string
+}
+
+// printColumns returns the printer columns of the supplied model that are
+// specific to its kind, i.e. a STATE column if the external resource reports
+// its state, followed by the PrintColumns of the renderer.
+func (r *Renderer) printColumns(m *Model) []PrintColumn {
+	var cols []PrintColumn
+	for _, f := range m.Observation {
+		if f.JSON == "state" && f.Shape == ShapeScalar && f.Type == "string" {
+			cols = append(cols, PrintColumn{Name: "STATE", JSONPath: ".status.atProvider.state"})
+		}
+	}
+	return append(cols, r.PrintColumns...)
+}
+
+// apiAlias returns the name of the package of the Go client of the API,
+// which is named after the API rather than its version.
+func (r *Renderer) apiAlias() string {
+	return path.Base(path.Dir(r.APIPackage))
+}
+
+// Types renders the apis/<group>/<version>/<kind>_types.go file of the
+// supplied model.
+func (r *Renderer) Types(m *Model) ([]byte, error) {
+	w := &bytes.Buffer{}
+	fmt.Fprintf(w, "%s\n\npackage %s\n\n", strings.TrimSpace(r.Header), r.Version)
+	fmt.Fprintf(w, "import (\n")
+	fmt.Fprintf(w, "\tmetav1 %q\n\n", "k8s.io/apimachinery/pkg/apis/meta/v1")
+	fmt.Fprintf(w, "\txpv1 %q\n\n", "github.com/crossplane/crossplane-runtime/apis/common/v1")
+	fmt.Fprintf(w, "\tgcpv1beta1 %q\n", modulePath+"/apis/v1beta1")
+	fmt.Fprintf(w, ")\n\n")
+
+	fmt.Fprintf(w, "// %sParameters define the desired state of a %s.\n", m.Kind, m.Kind)
+	r.paramsStruct(w, m.Kind+"Parameters", m.Resource.Fields)
+	for _, s := range m.Structs {
+		writeComment(w, "", fmt.Sprintf("%s: %s", s.Name, firstSentence(s.Description, "The "+s.APIName+" of a "+m.Kind+".")))
+		r.paramsStruct(w, s.Name, s.Fields)
+	}
+
+	fmt.Fprintf(w, "// %sObservation is used to show the observed state of a %s.\n", m.Kind, m.Kind)
+	fmt.Fprintf(w, "type %sObservation struct {\n", m.Kind)
+	for _, f := range m.Observation {
+		writeComment(w, "\t", fmt.Sprintf("%s: %s", f.Name, f.Description))
+		fmt.Fprintf(w, "\t%s %s `json:\"%s,omitempty\"`\n\n", f.Name, observationType(f), f.JSON)
+	}
+	writeComment(w, "\t", "LastOperation: The last mutation made to the external resource by this provider.")
+	fmt.Fprintf(w, "\tLastOperation *gcpv1beta1.LastOperation `json:\"lastOperation,omitempty\"`\n")
+	fmt.Fprintf(w, "}\n\n")
+
+	cols := &strings.Builder{}
+	for _, c := range r.printColumns(m) {
+		fmt.Fprintf(cols, "// +kubebuilder:printcolumn:name=%q,type=\"string\",JSONPath=%q\n", c.Name, c.JSONPath)
+	}
+
+	fmt.Fprintf(w, `// %[1]sSpec defines the desired state of a %[1]s.
+type %[1]sSpec struct {
+	xpv1.ResourceSpec `+"`json:\",inline\"`"+`
+	ForProvider       %[1]sParameters `+"`json:\"forProvider\"`"+`
+}
+
+// %[1]sStatus represents the observed state of a %[1]s.
+type %[1]sStatus struct {
+	xpv1.ResourceStatus `+"`json:\",inline\"`"+`
+	AtProvider          %[1]sObservation `+"`json:\"atProvider,omitempty\"`"+`
+
+	// FailureReason is the reason of the last error the Google Cloud API
+	// returned for this resource, if the last reconcile failed.
+	// +optional
+	FailureReason string `+"`json:\"failureReason,omitempty\"`"+`
+}
+
+// +kubebuilder:object:root=true
+
+// A %[1]s is a managed resource that represents a %[2]s of the %[3]s.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+%[4]s// +kubebuilder:printcolumn:name="FAILURE",type="string",JSONPath=".status.failureReason",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type %[1]s struct {
+	metav1.TypeMeta   `+"`json:\",inline\"`"+`
+	metav1.ObjectMeta `+"`json:\"metadata,omitempty\"`"+`
+
+	Spec   %[1]sSpec   `+"`json:\"spec\"`"+`
+	Status %[1]sStatus `+"`json:\"status,omitempty\"`"+`
+}
+
+// +kubebuilder:object:root=true
+
+// %[1]sList contains a list of %[1]s types
+type %[1]sList struct {
+	metav1.TypeMeta `+"`json:\",inline\"`"+`
+	metav1.ListMeta `+"`json:\"metadata,omitempty\"`"+`
+	Items           []%[1]s `+"`json:\"items\"`"+`
+}
+
+// GetFailureReason of this %[1]s.
+func (mg *%[1]s) GetFailureReason() string {
+	return mg.Status.FailureReason
+}
+
+// SetFailureReason of this %[1]s.
+func (mg *%[1]s) SetFailureReason(r string) {
+	mg.Status.FailureReason = r
+}
+`, m.Kind, m.Resource.APIName, m.Title, cols.String())
+
+	return formatSource(w.Bytes())
+}
+
+func (r *Renderer) paramsStruct(w *bytes.Buffer, name string, fields []Field) {
+	fmt.Fprintf(w, "type %s struct {\n", name)
+	for _, f := range fields {
+		writeComment(w, "\t", fmt.Sprintf("%s: %s", f.Name, f.Description))
+		fmt.Fprintf(w, "\t// +optional\n")
+		if f.Shape == ShapeScalar && len(f.Enum) > 0 {
+			fmt.Fprintf(w, "\t// +kubebuilder:validation:Enum=%s\n", strings.Join(f.Enum, ";"))
+		}
+		fmt.Fprintf(w, "\t%s %s `json:\"%s,omitempty\"`\n\n", f.Name, parameterType(f), f.JSON)
+	}
+	fmt.Fprintf(w, "}\n\n")
+}
+
+// Client renders the pkg/clients/<kind>/<kind>.go file of the supplied
+// model, which converts between the generated types and the types of the Go
+// client of the API.
+func (r *Renderer) Client(m *Model) ([]byte, error) {
+	api := r.apiAlias()
+	v := r.Version
+	lateInit := r.lateInitFields(m.Resource)
+
+	w := &bytes.Buffer{}
+	fmt.Fprintf(w, "%s\n\npackage %s\n\n", strings.TrimSpace(r.Header), strings.ToLower(m.Kind))
+	fmt.Fprintf(w, "import (\n")
+	fmt.Fprintf(w, "\t%q\n\t%q\n\t%q\n\t%q\n\t%s %q\n\n", "github.com/google/go-cmp/cmp", "github.com/google/go-cmp/cmp/cmpopts", "github.com/mitchellh/copystructure", "github.com/pkg/errors", api, r.APIPackage)
+	fmt.Fprintf(w, "\t%q\n", path.Join(modulePath, "apis", r.Group, r.Version))
+	if len(lateInit) > 0 {
+		fmt.Fprintf(w, "\tgcp %q\n", modulePath+"/pkg/clients")
+	}
+	fmt.Fprintf(w, ")\n\n")
+	fmt.Fprintf(w, "const errCheckUpToDate = \"unable to determine if external resource is up to date\"\n\n")
+
+	writeComment(w, "", fmt.Sprintf("Generate%s populates the supplied %s with the desired state in the supplied %sParameters.", m.Kind, m.Resource.APIName, m.Kind))
+	fmt.Fprintf(w, "func Generate%s(name string, in %s.%sParameters, out *%s.%s) {\n", m.Kind, v, m.Kind, api, m.Resource.APIName)
+	if m.HasName {
+		fmt.Fprintf(w, "\tout.Name = name\n")
+	}
+	r.assignFields(w, api, m.Resource)
+	fmt.Fprintf(w, "}\n\n")
+
+	for _, s := range m.Structs {
+		fmt.Fprintf(w, "func generate%s(in *%s.%s) *%s.%s {\n", s.Name, v, s.Name, api, s.APIName)
+		fmt.Fprintf(w, "\tif in == nil {\n\t\treturn nil\n\t}\n")
+		fmt.Fprintf(w, "\tout := &%s.%s{}\n", api, s.APIName)
+		r.assignFields(w, api, s)
+		fmt.Fprintf(w, "\treturn out\n}\n\n")
+	}
+
+	writeComment(w, "", fmt.Sprintf("GenerateObservation produces %sObservation object from %s.", m.Kind, m.Resource.APIName))
+	fmt.Fprintf(w, "func GenerateObservation(observed %s.%s) %s.%sObservation {\n", api, m.Resource.APIName, v, m.Kind)
+	fmt.Fprintf(w, "\to := %s.%sObservation{\n", v, m.Kind)
+	for _, f := range m.Observation {
+		if !r.PointerFields[m.Resource.APIName+"."+f.APIName] {
+			fmt.Fprintf(w, "\t\t%s: observed.%s,\n", f.Name, f.APIName)
+		}
+	}
+	fmt.Fprintf(w, "\t}\n")
+	for _, f := range m.Observation {
+		if r.PointerFields[m.Resource.APIName+"."+f.APIName] {
+			fmt.Fprintf(w, "\tif observed.%s != nil {\n\t\to.%s = *observed.%s\n\t}\n", f.APIName, f.Name, f.APIName)
+		}
+	}
+	fmt.Fprintf(w, "\treturn o\n}\n\n")
+
+	writeComment(w, "", fmt.Sprintf("LateInitialize fills the empty fields of the supplied %sParameters with the observed values. Nested objects are not late initialized.", m.Kind))
+	fmt.Fprintf(w, "func LateInitialize(in *%s.%sParameters, observed *%s.%s) {\n", v, m.Kind, api, m.Resource.APIName)
+	for _, f := range lateInit {
+		fmt.Fprintf(w, "\tin.%s = gcp.%s(in.%s, observed.%s)\n", f.Name, lateInitFn(f), f.Name, f.APIName)
+	}
+	fmt.Fprintf(w, "}\n\n")
+
+	writeComment(w, "", "IsUpToDate checks whether current state is up-to-date compared to the given set of parameters.")
+	fmt.Fprintf(w, `func IsUpToDate(name string, in *%[1]s.%[2]sParameters, observed *%[3]s.%[4]s) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*%[3]s.%[4]s)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	Generate%[2]s(name, *in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty()), nil
+}
+`, v, m.Kind, api, m.Resource.APIName)
+
+	return formatSource(w.Bytes())
+}
+
+// assignFields writes the statements that set the fields of out, which is a
+// struct of the Go client of the API, from the fields of in, which is the
+// supplied generated struct.
+func (r *Renderer) assignFields(w *bytes.Buffer, api string, s *Struct) {
+	for _, f := range s.Fields {
+		switch {
+		case f.Shape == ShapeScalar && r.PointerFields[s.APIName+"."+f.APIName]:
+			fmt.Fprintf(w, "\tout.%s = in.%s\n", f.APIName, f.Name)
+		case f.Shape == ShapeScalar:
+			fmt.Fprintf(w, "\tif in.%s != nil {\n\t\tout.%s = *in.%s\n\t}\n", f.Name, f.APIName, f.Name)
+		case f.Shape == ShapeObject:
+			fmt.Fprintf(w, "\tout.%s = generate%s(in.%s)\n", f.APIName, f.Type, f.Name)
+		case f.Struct == nil:
+			fmt.Fprintf(w, "\tout.%s = in.%s\n", f.APIName, f.Name)
+		case f.Shape == ShapeArray:
+			fmt.Fprintf(w, "\tif in.%s != nil {\n", f.Name)
+			fmt.Fprintf(w, "\t\tout.%s = make([]*%s.%s, len(in.%s))\n", f.APIName, api, f.Struct.APIName, f.Name)
+			fmt.Fprintf(w, "\t\tfor i := range in.%s {\n\t\t\tout.%s[i] = generate%s(&in.%s[i])\n\t\t}\n\t}\n", f.Name, f.APIName, f.Type, f.Name)
+		case f.Shape == ShapeMap:
+			fmt.Fprintf(w, "\tif in.%s != nil {\n", f.Name)
+			fmt.Fprintf(w, "\t\tout.%s = make(map[string]%s.%s, len(in.%s))\n", f.APIName, api, f.Struct.APIName, f.Name)
+			fmt.Fprintf(w, "\t\tfor k := range in.%s {\n\t\t\tv := in.%s[k]\n\t\t\tout.%s[k] = *generate%s(&v)\n\t\t}\n\t}\n", f.Name, f.Name, f.APIName, f.Type)
+		}
+	}
+}
+
+// lateInitFields returns the fields of the supplied struct that have a late
+// initialization helper.
+func (r *Renderer) lateInitFields(s *Struct) []Field {
+	var out []Field
+	for _, f := range s.Fields {
+		if lateInitFn(f) != "" && !r.PointerFields[s.APIName+"."+f.APIName] {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func lateInitFn(f Field) string {
+	switch {
+	case f.Shape == ShapeScalar && f.Type == "string":
+		return "LateInitializeString"
+	case f.Shape == ShapeScalar && f.Type == "int64":
+		return "LateInitializeInt64"
+	case f.Shape == ShapeScalar && f.Type == "bool":
+		return "LateInitializeBool"
+	case f.Shape == ShapeArray && f.Type == "string" && f.Struct == nil:
+		return "LateInitializeStringSlice"
+	case f.Shape == ShapeMap && f.Type == "string" && f.Struct == nil:
+		return "LateInitializeStringMap"
+	}
+	return ""
+}
+
+func parameterType(f Field) string {
+	switch f.Shape {
+	case ShapeArray:
+		return "[]" + f.Type
+	case ShapeMap:
+		return "map[string]" + f.Type
+	default:
+		return "*" + f.Type
+	}
+}
+
+func observationType(f Field) string {
+	switch f.Shape {
+	case ShapeArray:
+		return "[]" + f.Type
+	case ShapeMap:
+		return "map[string]" + f.Type
+	default:
+		return f.Type
+	}
+}
+
+// writeComment writes the supplied text as a comment wrapped at
+// commentWidth.
+func writeComment(w *bytes.Buffer, indent, text string) {
+	line := indent + "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > commentWidth && line != indent+"//" {
+			fmt.Fprintln(w, line)
+			line = indent + "//"
+		}
+		line += " " + word
+	}
+	fmt.Fprintln(w, line)
+}
+
+// firstSentence returns the first sentence of the supplied description, or
+// def if it is empty.
+func firstSentence(d, def string) string {
+	d = strings.TrimSpace(d)
+	if d == "" {
+		return def
+	}
+	if i := strings.Index(d, ". "); i > 0 {
+		return d[:i+1]
+	}
+	return d
+}
+
+func formatSource(src []byte) ([]byte, error) {
+	out, err := format.Source(src)
+	return out, errors.Wrap(err, errFormat)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the generated code")
+
+const testHeader = `/*
+Copyright 2023 The Crossplane Authors.
+*/`
+
+func TestRender(t *testing.T) {
+	doc, err := LoadDocument(testDocument)
+	if err != nil {
+		t.Fatalf("LoadDocument(...): %s", err)
+	}
+	m, err := Build(doc, "Widget", "Widget", nil)
+	if err != nil {
+		t.Fatalf("Build(...): %s", err)
+	}
+	r := &Renderer{
+		Header:        testHeader,
+		Group:         "widgets",
+		Version:       "v1alpha1",
+		APIPackage:    "google.golang.org/api/widgets/v1",
+		PointerFields: map[string]bool{"WidgetConfig.Enabled": true},
+		PrintColumns:  []PrintColumn{{Name: "TIER", JSONPath: ".spec.forProvider.tier"}},
+	}
+
+	cases := map[string]struct {
+		render func(*Model) ([]byte, error)
+		golden string
+	}{
+		"Types": {
+			render: r.Types,
+			golden: "widget_types.go.golden",
+		},
+		"Client": {
+			render: r.Client,
+			golden: "widget.go.golden",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.render(m)
+			if err != nil {
+				t.Fatalf("%s(...): %s", name, err)
+			}
+			golden := filepath.Join("testdata", tc.golden)
+			if *update {
+				if err := os.WriteFile(golden, got, 0o600); err != nil {
+					t.Fatalf("cannot update %s: %s", golden, err)
+				}
+			}
+			want, err := os.ReadFile(golden) //nolint:gosec // The path is a constant.
+			if err != nil {
+				t.Fatalf("cannot read %s: %s", golden, err)
+			}
+			if diff := cmp.Diff(string(want), string(got)); diff != "" {
+				t.Errorf("%s(...): -want, +got:\n%s", name, diff)
+			}
+		})
+	}
+}
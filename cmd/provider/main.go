@@ -20,6 +20,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"gopkg.in/alecthomas/kingpin.v2"
@@ -27,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -41,6 +43,13 @@ import (
 
 	"github.com/crossplane-contrib/provider-gcp/apis"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/probe"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicaccess"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/utilization"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/controller"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
@@ -54,13 +63,43 @@ func main() {
 		syncInterval     = app.Flag("sync", "How often all resources will be double-checked for drift from the desired state.").Short('s').Default("1h").Duration()
 		pollInterval     = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Default("1m").Duration()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
+		maxReconciles    = app.Flag("max-concurrent-reconciles", "Maximum concurrent reconciles of the controller of a kind, overriding the global maximum reconcile rate, e.g. Instance.compute.gcp.crossplane.io=2. May be repeated.").PlaceHolder("KIND.GROUP=N").StringMap()
+
+		healthProbeAddr      = app.Flag("health-probe-bind-address", "The address the /healthz and /readyz probe endpoints bind to.").Default(":8081").Envar("HEALTH_PROBE_BIND_ADDRESS").String()
+		webhookTLSCertDir    = app.Flag("webhook-tls-cert-dir", "Path of the webhook TLS certificate and key. The provider is not ready until they can be loaded and are valid.").Envar("WEBHOOK_TLS_CERT_DIR").String()
+		reachabilityInterval = app.Flag("gcp-reachability-probe-interval", "How often /readyz checks that the Google Cloud APIs are reachable with the credentials of every ProviderConfig. Zero disables the check.").Default(probe.DefaultInterval.String()).Duration()
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		essTLSCertsPath            = app.Flag("ess-tls-cert-dir", "Path of ESS TLS certificates.").Envar("ESS_TLS_CERTS_DIR").String()
+
+		enableQuotaCircuitBreaker = app.Flag("enable-quota-circuit-breaker", "Enable pausing a controller's external calls after repeated quota exhausted errors.").Default("false").Envar("ENABLE_QUOTA_CIRCUIT_BREAKER").Bool()
+		quotaBreakerThreshold     = app.Flag("quota-circuit-breaker-threshold", "Number of consecutive quota exhausted errors after which a controller's external calls are paused.").Default(strconv.Itoa(breaker.DefaultThreshold)).Int()
+		quotaBreakerCooldown      = app.Flag("quota-circuit-breaker-cooldown", "How long a controller's external calls are paused after repeated quota exhausted errors.").Default(breaker.DefaultCooldown.String()).Duration()
+
+		enableCatalogValidation = app.Flag("enable-catalog-validation", "Enable validating regions, zones and machine types against the live Compute Engine catalog before creating resources.").Default("false").Envar("ENABLE_CATALOG_VALIDATION").Bool()
+
+		enableOrderedDeletion = app.Flag("enable-ordered-deletion", "Enable deleting managed resources after the resources that depend on them, e.g. bucket policy members before buckets, and rate limiting deletions. Reduces errors and quota spikes when many resources are deleted at once.").Default("false").Envar("ENABLE_ORDERED_DELETION").Bool()
+		orderedDeletionRate   = app.Flag("ordered-deletion-rate", "The maximum rate per second at which managed resources are deleted across all controllers when ordered deletion is enabled.").Default(strconv.FormatFloat(teardown.DefaultRate, 'f', -1, 64)).Float64()
+		orderedDeletionBurst  = app.Flag("ordered-deletion-burst", "The maximum number of managed resources deleted at once when ordered deletion is enabled.").Default(strconv.Itoa(teardown.DefaultBurst)).Int()
+
+		enableFirewallHitObservation = app.Flag("enable-firewall-hit-observation", "Enable reporting when Firewall rules with logging enabled last matched traffic, using Cloud Logging.").Default("false").Envar("ENABLE_FIREWALL_HIT_OBSERVATION").Bool()
+
+		enableUtilization   = app.Flag("enable-utilization", "Enable periodically recording the utilization of Subnetwork IP addresses, GlobalAddress ranges allocated for private services access and CloudSQLInstance storage in their status. Requires permission to list addresses, instances, forwarding rules and peering routes.").Default("false").Envar("ENABLE_UTILIZATION").Bool()
+		utilizationInterval = app.Flag("utilization-interval", "The minimum time between two recordings of the utilization of a resource when utilization recording is enabled.").Default(utilization.DefaultInterval.String()).Duration()
+
+		iamGracePeriod = app.Flag("iam-consistency-grace-period", "How long after creating service accounts, IAM policies and policy members not finding them, or not being permitted to read them, is treated as eventual consistency rather than as the resource being gone.").Default(consistency.DefaultGracePeriod.String()).Envar("IAM_CONSISTENCY_GRACE_PERIOD").Duration()
+
+		publicAccess = app.Flag("public-access", "Whether IAM policies and policy members may grant access to allUsers or allAuthenticatedUsers. RequireAnnotation allows it only for resources annotated with "+publicaccess.AnnotationKeyAllowPublicAccess+": \"true\".").Default(string(publicaccess.ModeAllow)).Envar("PUBLIC_ACCESS").Enum(string(publicaccess.ModeAllow), string(publicaccess.ModeBlock), string(publicaccess.ModeRequireAnnotation))
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
+	overrides, err := concurrency.Parse(*maxReconciles)
+	kingpin.FatalIfError(err, "Cannot parse maximum concurrent reconciles")
+	concurrency.SetOverrides(overrides)
+	publicaccess.SetMode(publicaccess.Mode(*publicAccess))
+	consistency.SetGracePeriod(*iamGracePeriod)
+
 	zl := zap.New(zap.UseDevMode(*debug))
 	log := logging.NewLogrLogger(zl.WithName("provider-gcp"))
 	if *debug {
@@ -74,7 +113,8 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
 	mgr, err := ctrl.NewManager(ratelimiter.LimitRESTConfig(cfg, *maxReconcileRate), ctrl.Options{
-		SyncPeriod: syncInterval,
+		SyncPeriod:             syncInterval,
+		HealthProbeBindAddress: *healthProbeAddr,
 
 		// controller-runtime uses both ConfigMaps and Leases for leader
 		// election by default. Leases expire after 15 seconds, with a
@@ -92,6 +132,15 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add GCP APIs to scheme")
 
+	kingpin.FatalIfError(mgr.AddHealthzCheck("ping", healthz.Ping), "Cannot add liveness check")
+	kingpin.FatalIfError(mgr.AddReadyzCheck("cache-sync", probe.CacheSynced(mgr.GetCache())), "Cannot add cache sync readiness check")
+	if *webhookTLSCertDir != "" {
+		kingpin.FatalIfError(mgr.AddReadyzCheck("webhook-certs", probe.Certificates(*webhookTLSCertDir, time.Now)), "Cannot add webhook certificates readiness check")
+	}
+	if *reachabilityInterval > 0 {
+		kingpin.FatalIfError(mgr.AddReadyzCheck("gcp-reachability", probe.NewReachability(mgr.GetClient(), *reachabilityInterval).Check), "Cannot add GCP reachability readiness check")
+	}
+
 	o := controller.Options{
 		Logger:                  log,
 		MaxConcurrentReconciles: *maxReconcileRate,
@@ -128,6 +177,33 @@ func main() {
 		})), "cannot create default store config")
 	}
 
+	if *enableQuotaCircuitBreaker {
+		o.Features.Enable(features.EnableAlphaQuotaCircuitBreaker)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaQuotaCircuitBreaker)
+		breaker.SetDefaults(*quotaBreakerThreshold, *quotaBreakerCooldown)
+	}
+
+	if *enableCatalogValidation {
+		o.Features.Enable(features.EnableAlphaCatalogValidation)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaCatalogValidation)
+	}
+
+	if *enableOrderedDeletion {
+		o.Features.Enable(features.EnableAlphaOrderedDeletion)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaOrderedDeletion)
+		teardown.SetDefaults(*orderedDeletionRate, *orderedDeletionBurst)
+	}
+
+	if *enableFirewallHitObservation {
+		o.Features.Enable(features.EnableAlphaFirewallHitObservation)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaFirewallHitObservation)
+	}
+
+	if *enableUtilization {
+		utilization.SetInterval(*utilizationInterval)
+		log.Info("Utilization recording enabled", "interval", *utilizationInterval)
+	}
+
 	kingpin.FatalIfError(gcp.Setup(mgr, o), "Cannot setup GCP controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
@@ -19,6 +19,8 @@ package address
 import (
 	compute "google.golang.org/api/compute/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 )
@@ -36,8 +38,10 @@ func GenerateAddress(name string, in v1beta1.AddressParameters, address *compute
 	address.AddressType = gcp.StringValue(in.AddressType)
 	address.Description = gcp.StringValue(in.Description)
 	address.IpVersion = gcp.StringValue(in.IPVersion)
+	address.Ipv6EndpointType = gcp.StringValue(in.IPv6EndpointType)
 	address.Name = name
 	address.Network = gcp.StringValue(in.Network)
+	address.NetworkTier = gcp.StringValue(in.NetworkTier)
 	address.PrefixLength = gcp.Int64Value(in.PrefixLength)
 	address.Purpose = gcp.StringValue(in.Purpose)
 	address.Subnetwork = gcp.StringValue(in.Subnetwork)
@@ -52,7 +56,9 @@ func LateInitializeSpec(p *v1beta1.AddressParameters, observed compute.Address)
 	p.AddressType = gcp.LateInitializeString(p.AddressType, observed.AddressType)
 	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
 	p.IPVersion = gcp.LateInitializeString(p.IPVersion, observed.IpVersion)
+	p.IPv6EndpointType = gcp.LateInitializeString(p.IPv6EndpointType, observed.Ipv6EndpointType)
 	p.Network = gcp.LateInitializeString(p.Network, observed.Network)
+	p.NetworkTier = gcp.LateInitializeString(p.NetworkTier, observed.NetworkTier)
 	p.PrefixLength = gcp.LateInitializeInt64(p.PrefixLength, observed.PrefixLength)
 	p.Purpose = gcp.LateInitializeString(p.Purpose, observed.Purpose)
 	p.Subnetwork = gcp.LateInitializeString(p.Subnetwork, observed.Subnetwork)
@@ -65,7 +71,18 @@ func GenerateAddressObservation(observed compute.Address) v1beta1.AddressObserva
 		CreationTimestamp: observed.CreationTimestamp,
 		ID:                observed.Id,
 		SelfLink:          observed.SelfLink,
+		Address:           observed.Address,
 		Status:            observed.Status,
 		Users:             observed.Users,
 	}
 }
+
+// GetConnectionDetails returns the IP address allocated to an Address as its
+// connection details, if one was allocated yet.
+func GetConnectionDetails(o v1beta1.AddressObservation) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if o.Address != "" {
+		cd[v1beta1.AddressKey] = []byte(o.Address)
+	}
+	return cd
+}
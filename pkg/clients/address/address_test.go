@@ -22,6 +22,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	compute "google.golang.org/api/compute/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 )
 
@@ -32,6 +34,7 @@ var (
 	addressType        = "coolType"
 	ipVersion          = "coolVersion"
 	network            = "coolNetwork"
+	networkTier        = "coolTier"
 	purpose            = "beingCool"
 	subnetwork         = "coolSubnet"
 	region             = "coolRegion"
@@ -50,6 +53,7 @@ func params(m ...func(*v1beta1.AddressParameters)) *v1beta1.AddressParameters {
 		Description:  &description,
 		IPVersion:    &ipVersion,
 		Network:      &network,
+		NetworkTier:  &networkTier,
 		PrefixLength: &prefixLength,
 		Purpose:      &purpose,
 		Subnetwork:   &subnetwork,
@@ -71,6 +75,7 @@ func address(m ...func(*compute.Address)) *compute.Address {
 		IpVersion:    ipVersion,
 		Name:         name,
 		Network:      network,
+		NetworkTier:  networkTier,
 		PrefixLength: prefixLength,
 		Purpose:      purpose,
 		Subnetwork:   subnetwork,
@@ -96,6 +101,7 @@ func addOutputFields(n *compute.Address) {
 func observation(m ...func(*v1beta1.AddressObservation)) *v1beta1.AddressObservation {
 	o := &v1beta1.AddressObservation{
 		Status:            v1beta1.StatusReserving,
+		Address:           addressIP,
 		CreationTimestamp: timestamp,
 		ID:                id,
 		SelfLink:          link,
@@ -225,3 +231,28 @@ func TestLateInitializeSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in   v1beta1.AddressObservation
+		want managed.ConnectionDetails
+	}{
+		"Allocated": {
+			in:   *observation(),
+			want: managed.ConnectionDetails{v1beta1.AddressKey: []byte(addressIP)},
+		},
+		"NotAllocated": {
+			in:   v1beta1.AddressObservation{Status: v1beta1.StatusReserving},
+			want: managed.ConnectionDetails{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetConnectionDetails(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
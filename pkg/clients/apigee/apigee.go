@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apigee contains utilities for Apigee organizations, environments,
+// environment groups and runtime instances.
+package apigee
+
+import (
+	"fmt"
+	"sort"
+
+	apigee "google.golang.org/api/apigee/v1"
+)
+
+const (
+	organizationNameFormat = "organizations/%s"
+	environmentNameFormat  = "organizations/%s/environments/%s"
+	envGroupNameFormat     = "organizations/%s/envgroups/%s"
+	instanceNameFormat     = "organizations/%s/instances/%s"
+)
+
+// GetOrganizationName builds the fully qualified name of an organization. It
+// is also the parent of the organization's environments, environment groups
+// and instances.
+func GetOrganizationName(org string) string {
+	return fmt.Sprintf(organizationNameFormat, org)
+}
+
+// GenerateProperties converts a map of properties into their Apigee
+// representation, sorted by name.
+func GenerateProperties(in map[string]string) *apigee.GoogleCloudApigeeV1Properties {
+	if len(in) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(in))
+	for n := range in {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	p := &apigee.GoogleCloudApigeeV1Properties{Property: make([]*apigee.GoogleCloudApigeeV1Property, len(names))}
+	for i, n := range names {
+		p.Property[i] = &apigee.GoogleCloudApigeeV1Property{Name: n, Value: in[n]}
+	}
+	return p
+}
+
+// PropertiesToMap converts Apigee properties into a map.
+func PropertiesToMap(in *apigee.GoogleCloudApigeeV1Properties) map[string]string {
+	if in == nil || len(in.Property) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(in.Property))
+	for _, p := range in.Property {
+		m[p.Name] = p.Value
+	}
+	return m
+}
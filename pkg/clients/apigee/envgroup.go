@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// EnvGroupUpdateMask is the update mask used when patching an environment
+// group. Host names are the only mutable field.
+const EnvGroupUpdateMask = "hostnames"
+
+// EnvGroupClient should be satisfied to conduct EnvGroup operations.
+type EnvGroupClient interface {
+	Create(parent string, group *apigee.GoogleCloudApigeeV1EnvironmentGroup) *apigee.OrganizationsEnvgroupsCreateCall
+	Get(name string) *apigee.OrganizationsEnvgroupsGetCall
+	Patch(name string, group *apigee.GoogleCloudApigeeV1EnvironmentGroup) *apigee.OrganizationsEnvgroupsPatchCall
+	Delete(name string) *apigee.OrganizationsEnvgroupsDeleteCall
+}
+
+// GetEnvGroupName builds the fully qualified name of an environment group.
+func GetEnvGroupName(p v1alpha1.EnvGroupParameters, name string) string {
+	return fmt.Sprintf(envGroupNameFormat, gcp.StringValue(p.Organization), name)
+}
+
+// GenerateEnvGroup generates *apigee.GoogleCloudApigeeV1EnvironmentGroup
+// instance from EnvGroupParameters.
+func GenerateEnvGroup(name string, in v1alpha1.EnvGroupParameters) *apigee.GoogleCloudApigeeV1EnvironmentGroup {
+	return &apigee.GoogleCloudApigeeV1EnvironmentGroup{
+		Name:      name,
+		Hostnames: in.Hostnames,
+	}
+}
+
+// GenerateEnvGroupObservation produces EnvGroupObservation object from
+// apigee.GoogleCloudApigeeV1EnvironmentGroup object.
+func GenerateEnvGroupObservation(in apigee.GoogleCloudApigeeV1EnvironmentGroup) v1alpha1.EnvGroupObservation {
+	return v1alpha1.EnvGroupObservation{
+		CreatedAt:      in.CreatedAt,
+		LastModifiedAt: in.LastModifiedAt,
+		State:          in.State,
+	}
+}
+
+// IsEnvGroupUpToDate returns true if the host names of the supplied
+// EnvGroupParameters match the observed environment group. The order of the
+// host names is not significant.
+func IsEnvGroupUpToDate(in v1alpha1.EnvGroupParameters, observed apigee.GoogleCloudApigeeV1EnvironmentGroup) bool {
+	return cmp.Equal(in.Hostnames, observed.Hostnames, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
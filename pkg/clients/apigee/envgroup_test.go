@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func TestGenerateEnvGroup(t *testing.T) {
+	in := v1alpha1.EnvGroupParameters{Organization: gcp.StringPtr(testOrg), Hostnames: []string{"api.example.com"}}
+	want := &apigee.GoogleCloudApigeeV1EnvironmentGroup{Name: "public", Hostnames: []string{"api.example.com"}}
+	if diff := cmp.Diff(want, GenerateEnvGroup("public", in)); diff != "" {
+		t.Errorf("GenerateEnvGroup(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("organizations/cool-project/envgroups/public", GetEnvGroupName(in, "public")); diff != "" {
+		t.Errorf("GetEnvGroupName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsEnvGroupUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       []string
+		observed []string
+		want     bool
+	}{
+		"SameOrder": {
+			in:       []string{"api.example.com", "api.example.org"},
+			observed: []string{"api.example.com", "api.example.org"},
+			want:     true,
+		},
+		"DifferentOrder": {
+			in:       []string{"api.example.org", "api.example.com"},
+			observed: []string{"api.example.com", "api.example.org"},
+			want:     true,
+		},
+		"HostnameAdded": {
+			in:       []string{"api.example.com", "api.example.org"},
+			observed: []string{"api.example.com"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEnvGroupUpToDate(v1alpha1.EnvGroupParameters{Hostnames: tc.in}, apigee.GoogleCloudApigeeV1EnvironmentGroup{Hostnames: tc.observed})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsEnvGroupUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
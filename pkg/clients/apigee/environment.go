@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// EnvironmentClient should be satisfied to conduct Environment operations.
+type EnvironmentClient interface {
+	Create(parent string, env *apigee.GoogleCloudApigeeV1Environment) *apigee.OrganizationsEnvironmentsCreateCall
+	Get(name string) *apigee.OrganizationsEnvironmentsGetCall
+	Update(name string, env *apigee.GoogleCloudApigeeV1Environment) *apigee.OrganizationsEnvironmentsUpdateCall
+	Delete(name string) *apigee.OrganizationsEnvironmentsDeleteCall
+}
+
+// GetEnvironmentName builds the fully qualified name of an environment.
+func GetEnvironmentName(p v1alpha1.EnvironmentParameters, name string) string {
+	return fmt.Sprintf(environmentNameFormat, gcp.StringValue(p.Organization), name)
+}
+
+// GenerateEnvironment generates *apigee.GoogleCloudApigeeV1Environment
+// instance from EnvironmentParameters.
+func GenerateEnvironment(name string, in v1alpha1.EnvironmentParameters) *apigee.GoogleCloudApigeeV1Environment {
+	return &apigee.GoogleCloudApigeeV1Environment{
+		Name:           name,
+		DisplayName:    gcp.StringValue(in.DisplayName),
+		Description:    gcp.StringValue(in.Description),
+		DeploymentType: gcp.StringValue(in.DeploymentType),
+		ApiProxyType:   gcp.StringValue(in.APIProxyType),
+		Properties:     GenerateProperties(in.Properties),
+	}
+}
+
+// GenerateEnvironmentObservation produces EnvironmentObservation object from
+// apigee.GoogleCloudApigeeV1Environment object.
+func GenerateEnvironmentObservation(in apigee.GoogleCloudApigeeV1Environment) v1alpha1.EnvironmentObservation {
+	return v1alpha1.EnvironmentObservation{
+		CreatedAt:      in.CreatedAt,
+		LastModifiedAt: in.LastModifiedAt,
+		State:          in.State,
+	}
+}
+
+// LateInitializeEnvironment fills unassigned fields with the values in
+// apigee.GoogleCloudApigeeV1Environment object.
+func LateInitializeEnvironment(spec *v1alpha1.EnvironmentParameters, in apigee.GoogleCloudApigeeV1Environment) {
+	spec.DisplayName = gcp.LateInitializeString(spec.DisplayName, in.DisplayName)
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.DeploymentType = gcp.LateInitializeString(spec.DeploymentType, in.DeploymentType)
+	spec.APIProxyType = gcp.LateInitializeString(spec.APIProxyType, in.ApiProxyType)
+	spec.Properties = gcp.LateInitializeStringMap(spec.Properties, PropertiesToMap(in.Properties))
+}
+
+// IsEnvironmentUpToDate returns true if the mutable fields of the supplied
+// EnvironmentParameters match the observed environment.
+func IsEnvironmentUpToDate(in v1alpha1.EnvironmentParameters, observed apigee.GoogleCloudApigeeV1Environment) bool {
+	return gcp.StringValue(in.DisplayName) == observed.DisplayName &&
+		gcp.StringValue(in.Description) == observed.Description &&
+		cmp.Equal(in.Properties, PropertiesToMap(observed.Properties), cmpopts.EquateEmpty())
+}
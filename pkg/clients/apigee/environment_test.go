@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testEnvironment = "prod"
+
+func environmentParams() *v1alpha1.EnvironmentParameters {
+	return &v1alpha1.EnvironmentParameters{
+		Organization:   gcp.StringPtr(testOrg),
+		DisplayName:    gcp.StringPtr("Production"),
+		DeploymentType: gcp.StringPtr("PROXY"),
+		APIProxyType:   gcp.StringPtr("PROGRAMMABLE"),
+		Properties:     map[string]string{"team": "payments"},
+	}
+}
+
+func environment() *apigee.GoogleCloudApigeeV1Environment {
+	return &apigee.GoogleCloudApigeeV1Environment{
+		Name:           testEnvironment,
+		DisplayName:    "Production",
+		DeploymentType: "PROXY",
+		ApiProxyType:   "PROGRAMMABLE",
+		Properties: &apigee.GoogleCloudApigeeV1Properties{Property: []*apigee.GoogleCloudApigeeV1Property{
+			{Name: "team", Value: "payments"},
+		}},
+	}
+}
+
+func TestGetEnvironmentName(t *testing.T) {
+	want := "organizations/cool-project/environments/prod"
+	if diff := cmp.Diff(want, GetEnvironmentName(*environmentParams(), testEnvironment)); diff != "" {
+		t.Errorf("GetEnvironmentName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEnvironment(t *testing.T) {
+	if diff := cmp.Diff(environment(), GenerateEnvironment(testEnvironment, *environmentParams())); diff != "" {
+		t.Errorf("GenerateEnvironment(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeEnvironment(t *testing.T) {
+	spec := &v1alpha1.EnvironmentParameters{Organization: gcp.StringPtr(testOrg)}
+	LateInitializeEnvironment(spec, *environment())
+	if diff := cmp.Diff(environmentParams(), spec); diff != "" {
+		t.Errorf("LateInitializeEnvironment(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsEnvironmentUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.EnvironmentParameters
+		observed *apigee.GoogleCloudApigeeV1Environment
+		want     bool
+	}{
+		"UpToDate": {
+			in:       environmentParams(),
+			observed: environment(),
+			want:     true,
+		},
+		"PropertyChanged": {
+			in: func() *v1alpha1.EnvironmentParameters {
+				p := environmentParams()
+				p.Properties["team"] = "identity"
+				return p
+			}(),
+			observed: environment(),
+		},
+		"DescriptionAdded": {
+			in: func() *v1alpha1.EnvironmentParameters {
+				p := environmentParams()
+				p.Description = gcp.StringPtr("serves production traffic")
+				return p
+			}(),
+			observed: environment(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEnvironmentUpToDate(*tc.in, *tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsEnvironmentUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
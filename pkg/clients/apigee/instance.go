@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigee "google.golang.org/api/apigee/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// InstanceUpdateMask is the update mask used when patching an instance. The
+// consumer accept list is the only mutable field.
+const InstanceUpdateMask = "consumerAcceptList"
+
+// InstanceClient should be satisfied to conduct Instance operations.
+type InstanceClient interface {
+	Create(parent string, instance *apigee.GoogleCloudApigeeV1Instance) *apigee.OrganizationsInstancesCreateCall
+	Get(name string) *apigee.OrganizationsInstancesGetCall
+	Patch(name string, instance *apigee.GoogleCloudApigeeV1Instance) *apigee.OrganizationsInstancesPatchCall
+	Delete(name string) *apigee.OrganizationsInstancesDeleteCall
+}
+
+// GetInstanceName builds the fully qualified name of an instance.
+func GetInstanceName(p v1alpha1.InstanceParameters, name string) string {
+	return fmt.Sprintf(instanceNameFormat, gcp.StringValue(p.Organization), name)
+}
+
+// GenerateInstance generates *apigee.GoogleCloudApigeeV1Instance instance from
+// InstanceParameters.
+func GenerateInstance(name string, in v1alpha1.InstanceParameters) *apigee.GoogleCloudApigeeV1Instance {
+	return &apigee.GoogleCloudApigeeV1Instance{
+		Name:                  name,
+		Location:              in.Location,
+		DisplayName:           gcp.StringValue(in.DisplayName),
+		Description:           gcp.StringValue(in.Description),
+		IpRange:               gcp.StringValue(in.IPRange),
+		PeeringCidrRange:      gcp.StringValue(in.PeeringCIDRRange),
+		DiskEncryptionKeyName: gcp.StringValue(in.DiskEncryptionKeyName),
+		ConsumerAcceptList:    in.ConsumerAcceptList,
+	}
+}
+
+// GenerateInstanceObservation produces InstanceObservation object from
+// apigee.GoogleCloudApigeeV1Instance object.
+func GenerateInstanceObservation(in apigee.GoogleCloudApigeeV1Instance) v1alpha1.InstanceObservation {
+	return v1alpha1.InstanceObservation{
+		Host:              in.Host,
+		Port:              in.Port,
+		ServiceAttachment: in.ServiceAttachment,
+		RuntimeVersion:    in.RuntimeVersion,
+		CreatedAt:         in.CreatedAt,
+		LastModifiedAt:    in.LastModifiedAt,
+		State:             in.State,
+	}
+}
+
+// LateInitializeInstance fills unassigned fields with the values in
+// apigee.GoogleCloudApigeeV1Instance object.
+func LateInitializeInstance(spec *v1alpha1.InstanceParameters, in apigee.GoogleCloudApigeeV1Instance) {
+	spec.DisplayName = gcp.LateInitializeString(spec.DisplayName, in.DisplayName)
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.PeeringCIDRRange = gcp.LateInitializeString(spec.PeeringCIDRRange, in.PeeringCidrRange)
+	spec.DiskEncryptionKeyName = gcp.LateInitializeString(spec.DiskEncryptionKeyName, in.DiskEncryptionKeyName)
+	spec.ConsumerAcceptList = gcp.LateInitializeStringSlice(spec.ConsumerAcceptList, in.ConsumerAcceptList)
+}
+
+// IsInstanceUpToDate returns true if the consumer accept list of the supplied
+// InstanceParameters matches the observed instance.
+func IsInstanceUpToDate(in v1alpha1.InstanceParameters, observed apigee.GoogleCloudApigeeV1Instance) bool {
+	return cmp.Equal(in.ConsumerAcceptList, observed.ConsumerAcceptList, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+// GetInstanceConnectionDetails returns the endpoint of the instance that
+// clients connect to.
+func GetInstanceConnectionDetails(in apigee.GoogleCloudApigeeV1Instance) managed.ConnectionDetails {
+	if in.Host == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(in.Host),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(in.Port),
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testInstance = "us-central1"
+
+func instanceParams() *v1alpha1.InstanceParameters {
+	return &v1alpha1.InstanceParameters{
+		Organization:          gcp.StringPtr(testOrg),
+		Location:              "us-central1",
+		IPRange:               gcp.StringPtr("10.87.8.0/22,10.87.12.0/28"),
+		DiskEncryptionKeyName: gcp.StringPtr(testKeyName),
+		ConsumerAcceptList:    []string{"cool-project", "other-project"},
+	}
+}
+
+func TestGenerateInstance(t *testing.T) {
+	want := &apigee.GoogleCloudApigeeV1Instance{
+		Name:                  testInstance,
+		Location:              "us-central1",
+		IpRange:               "10.87.8.0/22,10.87.12.0/28",
+		DiskEncryptionKeyName: testKeyName,
+		ConsumerAcceptList:    []string{"cool-project", "other-project"},
+	}
+	if diff := cmp.Diff(want, GenerateInstance(testInstance, *instanceParams())); diff != "" {
+		t.Errorf("GenerateInstance(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeInstance(t *testing.T) {
+	spec := &v1alpha1.InstanceParameters{Location: "us-central1"}
+	LateInitializeInstance(spec, apigee.GoogleCloudApigeeV1Instance{
+		PeeringCidrRange:   "SLASH_22",
+		IpRange:            "10.87.8.0/22,10.87.12.0/28",
+		ConsumerAcceptList: []string{"cool-project"},
+	})
+	want := &v1alpha1.InstanceParameters{
+		Location:           "us-central1",
+		PeeringCIDRRange:   gcp.StringPtr("SLASH_22"),
+		ConsumerAcceptList: []string{"cool-project"},
+	}
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("LateInitializeInstance(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsInstanceUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		observed []string
+		want     bool
+	}{
+		"UpToDate": {
+			observed: []string{"other-project", "cool-project"},
+			want:     true,
+		},
+		"ProjectMissing": {
+			observed: []string{"cool-project"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceUpToDate(*instanceParams(), apigee.GoogleCloudApigeeV1Instance{ConsumerAcceptList: tc.observed})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsInstanceUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetInstanceConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in   apigee.GoogleCloudApigeeV1Instance
+		want managed.ConnectionDetails
+	}{
+		"Provisioning": {
+			in: apigee.GoogleCloudApigeeV1Instance{State: v1alpha1.StateCreating},
+		},
+		"Active": {
+			in: apigee.GoogleCloudApigeeV1Instance{Host: "10.87.8.2", Port: "443"},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.87.8.2"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("443"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetInstanceConnectionDetails(tc.in)); diff != "" {
+				t.Errorf("GetInstanceConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
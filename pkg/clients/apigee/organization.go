@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// OrganizationClient should be satisfied to conduct Organization operations.
+type OrganizationClient interface {
+	Create(org *apigee.GoogleCloudApigeeV1Organization) *apigee.OrganizationsCreateCall
+	Get(name string) *apigee.OrganizationsGetCall
+	Update(name string, org *apigee.GoogleCloudApigeeV1Organization) *apigee.OrganizationsUpdateCall
+	Delete(name string) *apigee.OrganizationsDeleteCall
+}
+
+// GenerateOrganization generates *apigee.GoogleCloudApigeeV1Organization
+// instance from OrganizationParameters.
+func GenerateOrganization(in v1alpha1.OrganizationParameters) *apigee.GoogleCloudApigeeV1Organization {
+	return &apigee.GoogleCloudApigeeV1Organization{
+		AnalyticsRegion:                  gcp.StringValue(in.AnalyticsRegion),
+		RuntimeType:                      gcp.StringValue(in.RuntimeType),
+		AuthorizedNetwork:                gcp.StringValue(in.AuthorizedNetwork),
+		BillingType:                      gcp.StringValue(in.BillingType),
+		RuntimeDatabaseEncryptionKeyName: gcp.StringValue(in.RuntimeDatabaseEncryptionKeyName),
+		DisplayName:                      gcp.StringValue(in.DisplayName),
+		Description:                      gcp.StringValue(in.Description),
+		Properties:                       GenerateProperties(in.Properties),
+	}
+}
+
+// GenerateOrganizationObservation produces OrganizationObservation object from
+// apigee.GoogleCloudApigeeV1Organization object.
+func GenerateOrganizationObservation(in apigee.GoogleCloudApigeeV1Organization) v1alpha1.OrganizationObservation {
+	return v1alpha1.OrganizationObservation{
+		Name:             in.Name,
+		ProjectID:        in.ProjectId,
+		ApigeeProjectID:  in.ApigeeProjectId,
+		CACertificate:    in.CaCertificate,
+		CreatedAt:        in.CreatedAt,
+		LastModifiedAt:   in.LastModifiedAt,
+		Environments:     in.Environments,
+		State:            in.State,
+		SubscriptionType: in.SubscriptionType,
+	}
+}
+
+// LateInitializeOrganization fills unassigned fields with the values in
+// apigee.GoogleCloudApigeeV1Organization object.
+func LateInitializeOrganization(spec *v1alpha1.OrganizationParameters, in apigee.GoogleCloudApigeeV1Organization) {
+	spec.AnalyticsRegion = gcp.LateInitializeString(spec.AnalyticsRegion, in.AnalyticsRegion)
+	spec.RuntimeType = gcp.LateInitializeString(spec.RuntimeType, in.RuntimeType)
+	spec.AuthorizedNetwork = gcp.LateInitializeString(spec.AuthorizedNetwork, in.AuthorizedNetwork)
+	spec.BillingType = gcp.LateInitializeString(spec.BillingType, in.BillingType)
+	spec.RuntimeDatabaseEncryptionKeyName = gcp.LateInitializeString(spec.RuntimeDatabaseEncryptionKeyName, in.RuntimeDatabaseEncryptionKeyName)
+	spec.DisplayName = gcp.LateInitializeString(spec.DisplayName, in.DisplayName)
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Properties = gcp.LateInitializeStringMap(spec.Properties, PropertiesToMap(in.Properties))
+}
+
+// IsOrganizationUpToDate returns true if the mutable fields of the supplied
+// OrganizationParameters match the observed organization.
+func IsOrganizationUpToDate(in v1alpha1.OrganizationParameters, observed apigee.GoogleCloudApigeeV1Organization) bool {
+	return gcp.StringValue(in.DisplayName) == observed.DisplayName &&
+		gcp.StringValue(in.Description) == observed.Description &&
+		cmp.Equal(in.Properties, PropertiesToMap(observed.Properties), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testOrg     = "cool-project"
+	testKeyName = "projects/cool-project/locations/us-central1/keyRings/apigee/cryptoKeys/runtime"
+)
+
+func organizationParams(m ...func(*v1alpha1.OrganizationParameters)) *v1alpha1.OrganizationParameters {
+	p := &v1alpha1.OrganizationParameters{
+		AnalyticsRegion:                  gcp.StringPtr("us-central1"),
+		RuntimeType:                      gcp.StringPtr("CLOUD"),
+		AuthorizedNetwork:                gcp.StringPtr("default"),
+		BillingType:                      gcp.StringPtr("EVALUATION"),
+		RuntimeDatabaseEncryptionKeyName: gcp.StringPtr(testKeyName),
+		DisplayName:                      gcp.StringPtr("Cool API platform"),
+		Properties:                       map[string]string{"features.hybrid.enabled": "false", "features.mart.connect.enabled": "true"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func organization(m ...func(*apigee.GoogleCloudApigeeV1Organization)) *apigee.GoogleCloudApigeeV1Organization {
+	o := &apigee.GoogleCloudApigeeV1Organization{
+		AnalyticsRegion:                  "us-central1",
+		RuntimeType:                      "CLOUD",
+		AuthorizedNetwork:                "default",
+		BillingType:                      "EVALUATION",
+		RuntimeDatabaseEncryptionKeyName: testKeyName,
+		DisplayName:                      "Cool API platform",
+		Properties: &apigee.GoogleCloudApigeeV1Properties{Property: []*apigee.GoogleCloudApigeeV1Property{
+			{Name: "features.hybrid.enabled", Value: "false"},
+			{Name: "features.mart.connect.enabled", Value: "true"},
+		}},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestGenerateOrganization(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.OrganizationParameters
+		want *apigee.GoogleCloudApigeeV1Organization
+	}{
+		"Full": {
+			in:   organizationParams(),
+			want: organization(),
+		},
+		"NoProperties": {
+			in:   organizationParams(func(p *v1alpha1.OrganizationParameters) { p.Properties = nil }),
+			want: organization(func(o *apigee.GoogleCloudApigeeV1Organization) { o.Properties = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateOrganization(*tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateOrganization(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateOrganizationObservation(t *testing.T) {
+	in := organization(func(o *apigee.GoogleCloudApigeeV1Organization) {
+		o.Name = testOrg
+		o.ProjectId = testOrg
+		o.ApigeeProjectId = "tenant-project"
+		o.CreatedAt = 1672531200000
+		o.LastModifiedAt = 1672531300000
+		o.Environments = []string{"prod"}
+		o.State = v1alpha1.StateActive
+		o.SubscriptionType = "TRIAL"
+	})
+	want := v1alpha1.OrganizationObservation{
+		Name:             testOrg,
+		ProjectID:        testOrg,
+		ApigeeProjectID:  "tenant-project",
+		CreatedAt:        1672531200000,
+		LastModifiedAt:   1672531300000,
+		Environments:     []string{"prod"},
+		State:            v1alpha1.StateActive,
+		SubscriptionType: "TRIAL",
+	}
+	if diff := cmp.Diff(want, GenerateOrganizationObservation(*in)); diff != "" {
+		t.Errorf("GenerateOrganizationObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeOrganization(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.OrganizationParameters
+		in   apigee.GoogleCloudApigeeV1Organization
+		want *v1alpha1.OrganizationParameters
+	}{
+		"AllFilled": {
+			spec: &v1alpha1.OrganizationParameters{},
+			in:   *organization(),
+			want: organizationParams(),
+		},
+		"KeepSpecValues": {
+			spec: organizationParams(func(p *v1alpha1.OrganizationParameters) { p.DisplayName = gcp.StringPtr("mine") }),
+			in:   *organization(),
+			want: organizationParams(func(p *v1alpha1.OrganizationParameters) { p.DisplayName = gcp.StringPtr("mine") }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeOrganization(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeOrganization(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsOrganizationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.OrganizationParameters
+		observed *apigee.GoogleCloudApigeeV1Organization
+		want     bool
+	}{
+		"UpToDate": {
+			in: organizationParams(),
+			observed: organization(func(o *apigee.GoogleCloudApigeeV1Organization) {
+				o.State = v1alpha1.StateActive
+				o.Properties.Property[0], o.Properties.Property[1] = o.Properties.Property[1], o.Properties.Property[0]
+			}),
+			want: true,
+		},
+		"DisplayNameChanged": {
+			in:       organizationParams(func(p *v1alpha1.OrganizationParameters) { p.DisplayName = gcp.StringPtr("new") }),
+			observed: organization(),
+		},
+		"PropertyRemoved": {
+			in:       organizationParams(func(p *v1alpha1.OrganizationParameters) { p.Properties = nil }),
+			observed: organization(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsOrganizationUpToDate(*tc.in, *tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsOrganizationUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
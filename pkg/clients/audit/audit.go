@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records the last mutation the provider made to an external
+// resource in the status of its managed resource, so that changes can be
+// correlated with the Google Cloud audit logs.
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+type operationKey struct{}
+
+type operation struct {
+	id string
+}
+
+// RecordOperation records the name of the long running Google Cloud operation
+// started by the external call the supplied context was passed to. It is a
+// no-op if the call is not being audited.
+func RecordOperation(ctx context.Context, id string) {
+	if ctx == nil {
+		return
+	}
+	if op, ok := ctx.Value(operationKey{}).(*operation); ok {
+		op.id = id
+	}
+}
+
+// WrapConnecter returns an ExternalConnecter whose external clients record
+// every successful Create, Update and Delete call as the LastOperation of the
+// managed resource.
+func WrapConnecter(kube client.Client, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{kube: kube, wrapped: c, now: time.Now}
+}
+
+type connecter struct {
+	kube    client.Client
+	wrapped managed.ExternalConnecter
+	now     func() time.Time
+
+	// pending holds operations that may not have been persisted yet. The
+	// managed reconciler refreshes a managed resource from the API server
+	// after a successful Create, discarding any status set during the call,
+	// so the operation is restored on the next Observe.
+	pending sync.Map
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.wrapped.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{connecter: c, wrapped: e}, nil
+}
+
+type external struct {
+	*connecter
+	wrapped managed.ExternalClient
+}
+
+// Observe preserves the LastOperation of the managed resource, which most
+// external clients would otherwise overwrite along with the rest of
+// status.atProvider.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	r, ok := mg.(v1beta1.LastOperationRecorder)
+	if !ok {
+		return e.wrapped.Observe(ctx, mg)
+	}
+	last := r.GetLastOperation()
+	o, err := e.wrapped.Observe(ctx, mg)
+	if p, ok := e.pending.Load(mg.GetUID()); ok {
+		pending := p.(*v1beta1.LastOperation)
+		if last == nil || last.Time.Before(&pending.Time) {
+			last = pending
+		} else {
+			e.pending.Delete(mg.GetUID())
+		}
+	}
+	r.SetLastOperation(last)
+	return o, err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, op := withOperation(ctx)
+	c, err := e.wrapped.Create(ctx, mg)
+	if err == nil {
+		e.record(ctx, mg, v1beta1.OperationVerbCreate, op)
+	}
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	ctx, op := withOperation(ctx)
+	u, err := e.wrapped.Update(ctx, mg)
+	if err == nil {
+		e.record(ctx, mg, v1beta1.OperationVerbUpdate, op)
+	}
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	ctx, op := withOperation(ctx)
+	err := e.wrapped.Delete(ctx, mg)
+	if err == nil {
+		e.record(ctx, mg, v1beta1.OperationVerbDelete, op)
+	}
+	return err
+}
+
+func (e *external) record(ctx context.Context, mg resource.Managed, verb string, op *operation) {
+	r, ok := mg.(v1beta1.LastOperationRecorder)
+	if !ok {
+		return
+	}
+	last := &v1beta1.LastOperation{
+		Verb: verb,
+		// Status times are serialized with second precision.
+		Time:        metav1.NewTime(e.now().Truncate(time.Second)),
+		OperationID: op.id,
+		Requestor:   gcp.GetRequestor(ctx, e.kube, mg),
+	}
+	r.SetLastOperation(last)
+	if verb == v1beta1.OperationVerbDelete {
+		e.pending.Delete(mg.GetUID())
+		return
+	}
+	e.pending.Store(mg.GetUID(), last)
+}
+
+func withOperation(ctx context.Context) (context.Context, *operation) {
+	op := &operation{}
+	return context.WithValue(ctx, operationKey{}, op), op
+}
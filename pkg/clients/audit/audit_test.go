@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+const testOperation = "operation-1234"
+
+var errBoom = errors.New("boom")
+
+func newConnecter(create func(context.Context, resource.Managed) (managed.ExternalCreation, error)) *connecter {
+	c := WrapConnecter(&test.MockClient{}, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+				// Mimic controllers that overwrite status.atProvider.
+				mg.(*v1alpha1.Topic).Status.AtProvider = v1alpha1.TopicObservation{}
+				return managed.ExternalObservation{ResourceExists: true}, nil
+			},
+			CreateFn: create,
+		}, nil
+	})).(*connecter)
+	c.now = func() time.Time { return time.Unix(100, 5) }
+	return c
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		create func(context.Context, resource.Managed) (managed.ExternalCreation, error)
+		want   *v1beta1.LastOperation
+	}{
+		"Recorded": {
+			create: func(ctx context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+				RecordOperation(ctx, testOperation)
+				return managed.ExternalCreation{}, nil
+			},
+			want: &v1beta1.LastOperation{
+				Verb:        v1beta1.OperationVerbCreate,
+				Time:        metav1.NewTime(time.Unix(100, 0)),
+				OperationID: testOperation,
+			},
+		},
+		"NotRecordedOnError": {
+			create: func(ctx context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+				RecordOperation(ctx, testOperation)
+				return managed.ExternalCreation{}, errBoom
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.Topic{}
+			e, _ := newConnecter(tc.create).Connect(context.Background(), cr)
+			_, _ = e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, cr.GetLastOperation()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	c := newConnecter(func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+		return managed.ExternalCreation{}, nil
+	})
+	e, _ := c.Connect(context.Background(), &v1alpha1.Topic{})
+
+	cr := &v1alpha1.Topic{}
+	_, _ = e.Create(context.Background(), cr)
+	want := cr.GetLastOperation()
+
+	// The managed reconciler discards the status set by Create.
+	refreshed := &v1alpha1.Topic{}
+	_, _ = e.Observe(context.Background(), refreshed)
+	if diff := cmp.Diff(want, refreshed.GetLastOperation()); diff != "" {
+		t.Errorf("Observe(...): pending operation: -want, +got:\n%s", diff)
+	}
+
+	// Once persisted the operation is no longer pending, but is preserved.
+	_, _ = e.Observe(context.Background(), refreshed)
+	if _, ok := c.pending.Load(refreshed.GetUID()); ok {
+		t.Errorf("Observe(...): want persisted operation to no longer be pending")
+	}
+	if diff := cmp.Diff(want, refreshed.GetLastOperation()); diff != "" {
+		t.Errorf("Observe(...): persisted operation: -want, +got:\n%s", diff)
+	}
+}
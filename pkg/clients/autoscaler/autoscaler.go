@@ -0,0 +1,207 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	errCheckUpToDate     = "unable to determine if external resource is up to date"
+	errParseUtilization  = "cannot parse utilization target"
+	errParseAssignment   = "cannot parse single instance assignment"
+	fmtCustomMetricIndex = "customMetricUtilizations[%d]"
+)
+
+// GenerateAutoscaler takes an AutoscalerParameters and populates the supplied
+// *compute.Autoscaler. It assigns only the fields that are writable, i.e. not
+// labelled as [Output Only] in Google's reference. It returns an error if a
+// decimal value of the policy cannot be parsed.
+func GenerateAutoscaler(name string, in v1alpha1.AutoscalerParameters, as *compute.Autoscaler) error {
+	as.Name = name
+	as.Description = gcp.StringValue(in.Description)
+	as.Target = gcp.StringValue(in.Target)
+	as.Zone = gcp.StringValue(in.Zone)
+	as.Region = gcp.StringValue(in.Region)
+
+	p, err := GenerateAutoscalingPolicy(in.AutoscalingPolicy)
+	if err != nil {
+		return err
+	}
+	as.AutoscalingPolicy = p
+	return nil
+}
+
+// GenerateAutoscalingPolicy converts the supplied AutoscalingPolicy into its
+// Google Compute API representation.
+func GenerateAutoscalingPolicy(in v1alpha1.AutoscalingPolicy) (*compute.AutoscalingPolicy, error) {
+	out := &compute.AutoscalingPolicy{
+		MinNumReplicas:    gcp.Int64Value(in.MinNumReplicas),
+		MaxNumReplicas:    in.MaxNumReplicas,
+		CoolDownPeriodSec: gcp.Int64Value(in.CoolDownPeriodSec),
+		Mode:              gcp.StringValue(in.Mode),
+	}
+	if in.MinNumReplicas != nil {
+		// Zero is a valid minimum, e.g. for groups scaled by schedules.
+		out.ForceSendFields = []string{"MinNumReplicas"}
+	}
+	if in.CPUUtilization != nil {
+		t, err := strconv.ParseFloat(in.CPUUtilization.UtilizationTarget, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseUtilization)
+		}
+		out.CpuUtilization = &compute.AutoscalingPolicyCpuUtilization{
+			UtilizationTarget: t,
+			PredictiveMethod:  gcp.StringValue(in.CPUUtilization.PredictiveMethod),
+		}
+	}
+	if in.LoadBalancingUtilization != nil {
+		t, err := strconv.ParseFloat(in.LoadBalancingUtilization.UtilizationTarget, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseUtilization)
+		}
+		out.LoadBalancingUtilization = &compute.AutoscalingPolicyLoadBalancingUtilization{UtilizationTarget: t}
+	}
+	if in.CustomMetricUtilizations != nil {
+		out.CustomMetricUtilizations = make([]*compute.AutoscalingPolicyCustomMetricUtilization, len(in.CustomMetricUtilizations))
+		for i, m := range in.CustomMetricUtilizations {
+			cm, err := generateCustomMetricUtilization(m)
+			if err != nil {
+				return nil, errors.Wrapf(err, fmtCustomMetricIndex, i)
+			}
+			out.CustomMetricUtilizations[i] = cm
+		}
+	}
+	if in.ScalingSchedules != nil {
+		out.ScalingSchedules = make(map[string]compute.AutoscalingPolicyScalingSchedule, len(in.ScalingSchedules))
+		for name, s := range in.ScalingSchedules {
+			out.ScalingSchedules[name] = compute.AutoscalingPolicyScalingSchedule{
+				MinRequiredReplicas: s.MinRequiredReplicas,
+				Schedule:            s.Schedule,
+				DurationSec:         s.DurationSec,
+				TimeZone:            gcp.StringValue(s.TimeZone),
+				Description:         gcp.StringValue(s.Description),
+				Disabled:            gcp.BoolValue(s.Disabled),
+			}
+		}
+	}
+	return out, nil
+}
+
+func generateCustomMetricUtilization(in v1alpha1.AutoscalingPolicyCustomMetricUtilization) (*compute.AutoscalingPolicyCustomMetricUtilization, error) {
+	out := &compute.AutoscalingPolicyCustomMetricUtilization{
+		Metric:                in.Metric,
+		Filter:                gcp.StringValue(in.Filter),
+		UtilizationTargetType: gcp.StringValue(in.UtilizationTargetType),
+	}
+	if in.SingleInstanceAssignment != nil {
+		v, err := strconv.ParseFloat(*in.SingleInstanceAssignment, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseAssignment)
+		}
+		out.SingleInstanceAssignment = v
+	}
+	if in.UtilizationTarget != nil {
+		v, err := strconv.ParseFloat(*in.UtilizationTarget, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseUtilization)
+		}
+		out.UtilizationTarget = v
+	}
+	return out, nil
+}
+
+// GenerateAutoscalerObservation takes a compute.Autoscaler and returns
+// *AutoscalerObservation.
+func GenerateAutoscalerObservation(in compute.Autoscaler) v1alpha1.AutoscalerObservation {
+	o := v1alpha1.AutoscalerObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		RecommendedSize:   in.RecommendedSize,
+		Status:            in.Status,
+	}
+	for _, d := range in.StatusDetails {
+		if d == nil {
+			continue
+		}
+		o.StatusDetails = append(o.StatusDetails, v1alpha1.AutoscalerStatusDetails{Message: d.Message, Type: d.Type})
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.Autoscaler object.
+func LateInitializeSpec(spec *v1alpha1.AutoscalerParameters, in compute.Autoscaler) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	p := in.AutoscalingPolicy
+	if p == nil {
+		return
+	}
+	spec.AutoscalingPolicy.MinNumReplicas = gcp.LateInitializeInt64(spec.AutoscalingPolicy.MinNumReplicas, p.MinNumReplicas)
+	spec.AutoscalingPolicy.CoolDownPeriodSec = gcp.LateInitializeInt64(spec.AutoscalingPolicy.CoolDownPeriodSec, p.CoolDownPeriodSec)
+	spec.AutoscalingPolicy.Mode = gcp.LateInitializeString(spec.AutoscalingPolicy.Mode, p.Mode)
+
+	// GCP scales on CPU utilization when no other signal is configured.
+	if spec.AutoscalingPolicy.CPUUtilization == nil && p.CpuUtilization != nil {
+		spec.AutoscalingPolicy.CPUUtilization = &v1alpha1.AutoscalingPolicyCPUUtilization{
+			UtilizationTarget: strconv.FormatFloat(p.CpuUtilization.UtilizationTarget, 'f', -1, 64),
+		}
+	}
+	if c := spec.AutoscalingPolicy.CPUUtilization; c != nil && p.CpuUtilization != nil {
+		c.PredictiveMethod = gcp.LateInitializeString(c.PredictiveMethod, p.CpuUtilization.PredictiveMethod)
+	}
+	for name, s := range spec.AutoscalingPolicy.ScalingSchedules {
+		o, ok := p.ScalingSchedules[name]
+		if !ok {
+			continue
+		}
+		s.TimeZone = gcp.LateInitializeString(s.TimeZone, o.TimeZone)
+		s.Disabled = gcp.LateInitializeBool(s.Disabled, o.Disabled)
+		spec.AutoscalingPolicy.ScalingSchedules[name] = s
+	}
+}
+
+// IsUpToDate returns true if the supplied Kubernetes resource does not differ
+// from the supplied GCP resource. It considers only fields that can be
+// modified in place without deleting and recreating the autoscaler.
+func IsUpToDate(name string, in *v1alpha1.AutoscalerParameters, observed *compute.Autoscaler) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.Autoscaler)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	if err := GenerateAutoscaler(name, *in, desired); err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.Autoscaler{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.AutoscalingPolicy{}, "ForceSendFields", "NullFields", "ScaleInControl")), nil
+}
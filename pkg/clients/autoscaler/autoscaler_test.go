@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName   = "test-autoscaler"
+	testTarget = "projects/cool-project/zones/us-central1-a/instanceGroupManagers/test-mig"
+)
+
+func params(m ...func(*v1alpha1.AutoscalerParameters)) *v1alpha1.AutoscalerParameters {
+	p := &v1alpha1.AutoscalerParameters{
+		Zone:   gcp.StringPtr("us-central1-a"),
+		Target: gcp.StringPtr(testTarget),
+		AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+			MinNumReplicas: gcp.Int64Ptr(0),
+			MaxNumReplicas: 10,
+			LoadBalancingUtilization: &v1alpha1.AutoscalingPolicyLoadBalancingUtilization{
+				UtilizationTarget: "0.8",
+			},
+			CustomMetricUtilizations: []v1alpha1.AutoscalingPolicyCustomMetricUtilization{{
+				Metric:                   "pubsub.googleapis.com/subscription/num_undelivered_messages",
+				SingleInstanceAssignment: gcp.StringPtr("100"),
+			}},
+			ScalingSchedules: map[string]v1alpha1.AutoscalingPolicyScalingSchedule{
+				"business-hours": {
+					MinRequiredReplicas: 3,
+					Schedule:            "0 9 * * MON-FRI",
+					DurationSec:         36000,
+					TimeZone:            gcp.StringPtr("Europe/Berlin"),
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func autoscaler(m ...func(*compute.Autoscaler)) *compute.Autoscaler {
+	as := &compute.Autoscaler{
+		Name:   testName,
+		Zone:   "us-central1-a",
+		Target: testTarget,
+		AutoscalingPolicy: &compute.AutoscalingPolicy{
+			MinNumReplicas:           0,
+			MaxNumReplicas:           10,
+			LoadBalancingUtilization: &compute.AutoscalingPolicyLoadBalancingUtilization{UtilizationTarget: 0.8},
+			CustomMetricUtilizations: []*compute.AutoscalingPolicyCustomMetricUtilization{{
+				Metric:                   "pubsub.googleapis.com/subscription/num_undelivered_messages",
+				SingleInstanceAssignment: 100,
+			}},
+			ScalingSchedules: map[string]compute.AutoscalingPolicyScalingSchedule{
+				"business-hours": {
+					MinRequiredReplicas: 3,
+					Schedule:            "0 9 * * MON-FRI",
+					DurationSec:         36000,
+					TimeZone:            "Europe/Berlin",
+				},
+			},
+			ForceSendFields: []string{"MinNumReplicas"},
+		},
+	}
+	for _, f := range m {
+		f(as)
+	}
+	return as
+}
+
+func TestGenerateAutoscaler(t *testing.T) {
+	type want struct {
+		as  *compute.Autoscaler
+		err bool
+	}
+	cases := map[string]struct {
+		in   *v1alpha1.AutoscalerParameters
+		want want
+	}{
+		"Full": {
+			in:   params(),
+			want: want{as: autoscaler()},
+		},
+		"InvalidUtilization": {
+			in: params(func(p *v1alpha1.AutoscalerParameters) {
+				p.AutoscalingPolicy.LoadBalancingUtilization.UtilizationTarget = "high"
+			}),
+			want: want{err: true},
+		},
+		"InvalidCustomMetric": {
+			in: params(func(p *v1alpha1.AutoscalerParameters) {
+				p.AutoscalingPolicy.CustomMetricUtilizations[0].UtilizationTarget = gcp.StringPtr("lots")
+			}),
+			want: want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &compute.Autoscaler{}
+			err := GenerateAutoscaler(testName, *tc.in, got)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Fatalf("GenerateAutoscaler(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.as, got); diff != "" {
+				t.Errorf("GenerateAutoscaler(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.AutoscalerParameters
+		in   compute.Autoscaler
+		want *v1alpha1.AutoscalerParameters
+	}{
+		"DefaultCPUUtilization": {
+			spec: &v1alpha1.AutoscalerParameters{AutoscalingPolicy: v1alpha1.AutoscalingPolicy{MaxNumReplicas: 3}},
+			in: compute.Autoscaler{AutoscalingPolicy: &compute.AutoscalingPolicy{
+				MinNumReplicas:    1,
+				MaxNumReplicas:    3,
+				CoolDownPeriodSec: 60,
+				Mode:              "ON",
+				CpuUtilization:    &compute.AutoscalingPolicyCpuUtilization{UtilizationTarget: 0.6, PredictiveMethod: "NONE"},
+			}},
+			want: &v1alpha1.AutoscalerParameters{AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+				MinNumReplicas:    gcp.Int64Ptr(1),
+				MaxNumReplicas:    3,
+				CoolDownPeriodSec: gcp.Int64Ptr(60),
+				Mode:              gcp.StringPtr("ON"),
+				CPUUtilization:    &v1alpha1.AutoscalingPolicyCPUUtilization{UtilizationTarget: "0.6", PredictiveMethod: gcp.StringPtr("NONE")},
+			}},
+		},
+		"ScalingScheduleTimeZone": {
+			spec: &v1alpha1.AutoscalerParameters{AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+				ScalingSchedules: map[string]v1alpha1.AutoscalingPolicyScalingSchedule{"nightly": {Schedule: "0 0 * * *"}},
+			}},
+			in: compute.Autoscaler{AutoscalingPolicy: &compute.AutoscalingPolicy{
+				ScalingSchedules: map[string]compute.AutoscalingPolicyScalingSchedule{"nightly": {Schedule: "0 0 * * *", TimeZone: "UTC"}},
+			}},
+			want: &v1alpha1.AutoscalerParameters{AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+				ScalingSchedules: map[string]v1alpha1.AutoscalingPolicyScalingSchedule{"nightly": {
+					Schedule: "0 0 * * *",
+					TimeZone: gcp.StringPtr("UTC"),
+				}},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.AutoscalerParameters
+		observed *compute.Autoscaler
+		want     bool
+	}{
+		"UpToDate": {
+			in: params(),
+			observed: autoscaler(func(as *compute.Autoscaler) {
+				as.Target = "https://www.googleapis.com/compute/v1/" + testTarget
+				as.Status = "ACTIVE"
+				as.RecommendedSize = 3
+			}),
+			want: true,
+		},
+		"ScheduleChanged": {
+			in: params(),
+			observed: autoscaler(func(as *compute.Autoscaler) {
+				s := as.AutoscalingPolicy.ScalingSchedules["business-hours"]
+				s.MinRequiredReplicas = 1
+				as.AutoscalingPolicy.ScalingSchedules["business-hours"] = s
+			}),
+		},
+		"CustomMetricRemoved": {
+			in:       params(func(p *v1alpha1.AutoscalerParameters) { p.AutoscalingPolicy.CustomMetricUtilizations = nil }),
+			observed: autoscaler(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(testName, tc.in, tc.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalingpolicy
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/dataproc/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/dataproc/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFmt = "projects/%s/regions/%s"
+	nameFmt   = parentFmt + "/autoscalingPolicies/%s"
+
+	errFmtParseFraction = "cannot parse %s"
+)
+
+// Client should be satisfied to conduct AutoscalingPolicy operations.
+type Client interface {
+	Create(parent string, policy *dataproc.AutoscalingPolicy) *dataproc.ProjectsRegionsAutoscalingPoliciesCreateCall
+	Get(name string) *dataproc.ProjectsRegionsAutoscalingPoliciesGetCall
+	Update(name string, policy *dataproc.AutoscalingPolicy) *dataproc.ProjectsRegionsAutoscalingPoliciesUpdateCall
+	Delete(name string) *dataproc.ProjectsRegionsAutoscalingPoliciesDeleteCall
+}
+
+// GetParent builds the name of the region an autoscaling policy belongs to.
+func GetParent(projectID, region string) string {
+	return fmt.Sprintf(parentFmt, projectID, region)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of an autoscaling
+// policy.
+func GetFullyQualifiedName(projectID, region, id string) string {
+	return fmt.Sprintf(nameFmt, projectID, region, id)
+}
+
+// GenerateAutoscalingPolicy generates *dataproc.AutoscalingPolicy instance
+// from AutoscalingPolicyParameters. It returns an error if a decimal value of
+// the policy cannot be parsed.
+func GenerateAutoscalingPolicy(projectID, id string, in v1alpha1.AutoscalingPolicyParameters) (*dataproc.AutoscalingPolicy, error) {
+	alg, err := generateBasicAlgorithm(in.BasicAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	return &dataproc.AutoscalingPolicy{
+		Id:                    id,
+		Name:                  GetFullyQualifiedName(projectID, in.Region, id),
+		BasicAlgorithm:        alg,
+		WorkerConfig:          generateInstanceGroupConfig(&in.WorkerConfig),
+		SecondaryWorkerConfig: generateInstanceGroupConfig(in.SecondaryWorkerConfig),
+		Labels:                in.Labels,
+	}, nil
+}
+
+func generateBasicAlgorithm(in v1alpha1.BasicAutoscalingAlgorithm) (*dataproc.BasicAutoscalingAlgorithm, error) {
+	y := in.YarnConfig
+	yc := &dataproc.BasicYarnAutoscalingConfig{GracefulDecommissionTimeout: y.GracefulDecommissionTimeout}
+	for _, f := range []struct {
+		name string
+		in   *string
+		out  *float64
+	}{
+		{name: "scaleUpFactor", in: &y.ScaleUpFactor, out: &yc.ScaleUpFactor},
+		{name: "scaleDownFactor", in: &y.ScaleDownFactor, out: &yc.ScaleDownFactor},
+		{name: "scaleUpMinWorkerFraction", in: y.ScaleUpMinWorkerFraction, out: &yc.ScaleUpMinWorkerFraction},
+		{name: "scaleDownMinWorkerFraction", in: y.ScaleDownMinWorkerFraction, out: &yc.ScaleDownMinWorkerFraction},
+	} {
+		if f.in == nil {
+			continue
+		}
+		v, err := strconv.ParseFloat(*f.in, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtParseFraction, f.name)
+		}
+		*f.out = v
+	}
+	return &dataproc.BasicAutoscalingAlgorithm{
+		CooldownPeriod: gcp.StringValue(in.CooldownPeriod),
+		YarnConfig:     yc,
+	}, nil
+}
+
+func generateInstanceGroupConfig(in *v1alpha1.InstanceGroupAutoscalingPolicyConfig) *dataproc.InstanceGroupAutoscalingPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := &dataproc.InstanceGroupAutoscalingPolicyConfig{
+		MinInstances: gcp.Int64Value(in.MinInstances),
+		MaxInstances: in.MaxInstances,
+		Weight:       gcp.Int64Value(in.Weight),
+	}
+	if in.MinInstances != nil {
+		// Zero is a valid minimum, e.g. for secondary workers.
+		out.ForceSendFields = []string{"MinInstances"}
+	}
+	return out
+}
+
+// GenerateObservation produces AutoscalingPolicyObservation object from
+// dataproc.AutoscalingPolicy object.
+func GenerateObservation(in dataproc.AutoscalingPolicy) v1alpha1.AutoscalingPolicyObservation {
+	return v1alpha1.AutoscalingPolicyObservation{Name: in.Name}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// dataproc.AutoscalingPolicy object.
+func LateInitializeSpec(spec *v1alpha1.AutoscalingPolicyParameters, in dataproc.AutoscalingPolicy) {
+	if a := in.BasicAlgorithm; a != nil {
+		spec.BasicAlgorithm.CooldownPeriod = gcp.LateInitializeString(spec.BasicAlgorithm.CooldownPeriod, a.CooldownPeriod)
+	}
+	if w := in.WorkerConfig; w != nil {
+		spec.WorkerConfig.MinInstances = gcp.LateInitializeInt64(spec.WorkerConfig.MinInstances, w.MinInstances)
+		spec.WorkerConfig.Weight = gcp.LateInitializeInt64(spec.WorkerConfig.Weight, w.Weight)
+	}
+	if w := in.SecondaryWorkerConfig; w != nil && spec.SecondaryWorkerConfig != nil {
+		spec.SecondaryWorkerConfig.MinInstances = gcp.LateInitializeInt64(spec.SecondaryWorkerConfig.MinInstances, w.MinInstances)
+		spec.SecondaryWorkerConfig.Weight = gcp.LateInitializeInt64(spec.SecondaryWorkerConfig.Weight, w.Weight)
+	}
+}
+
+// IsUpToDate checks whether the observed autoscaling policy is up-to-date
+// compared to the given set of parameters.
+func IsUpToDate(projectID, id string, in v1alpha1.AutoscalingPolicyParameters, observed dataproc.AutoscalingPolicy) (bool, error) {
+	desired, err := GenerateAutoscalingPolicy(projectID, id, in)
+	if err != nil {
+		return true, err
+	}
+	return cmp.Equal(desired, &observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(dataproc.AutoscalingPolicy{}, "Id", "Name"),
+		ignoreClientFields), nil
+}
+
+// ignoreClientFields ignores the fields of the API types that are only used
+// by the API client rather than sent to or returned by the API.
+var ignoreClientFields = cmp.FilterPath(func(p cmp.Path) bool {
+	switch p.Last().String() {
+	case ".ServerResponse", ".ForceSendFields", ".NullFields":
+		return true
+	}
+	return false
+}, cmp.Ignore())
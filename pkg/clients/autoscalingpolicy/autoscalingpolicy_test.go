@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalingpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/dataproc/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/dataproc/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "my-project"
+	testID      = "my-policy"
+)
+
+func params(m ...func(*v1alpha1.AutoscalingPolicyParameters)) v1alpha1.AutoscalingPolicyParameters {
+	p := v1alpha1.AutoscalingPolicyParameters{
+		Region: "us-central1",
+		BasicAlgorithm: v1alpha1.BasicAutoscalingAlgorithm{
+			CooldownPeriod: gcp.StringPtr("240s"),
+			YarnConfig: v1alpha1.BasicYarnAutoscalingConfig{
+				GracefulDecommissionTimeout: "3600s",
+				ScaleUpFactor:               "0.5",
+				ScaleDownFactor:             "1",
+				ScaleUpMinWorkerFraction:    gcp.StringPtr("0.1"),
+			},
+		},
+		WorkerConfig: v1alpha1.InstanceGroupAutoscalingPolicyConfig{
+			MinInstances: gcp.Int64Ptr(2),
+			MaxInstances: 10,
+		},
+		SecondaryWorkerConfig: &v1alpha1.InstanceGroupAutoscalingPolicyConfig{
+			MinInstances: gcp.Int64Ptr(0),
+			MaxInstances: 50,
+			Weight:       gcp.Int64Ptr(2),
+		},
+		Labels: map[string]string{"team": "data"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func policy(m ...func(*dataproc.AutoscalingPolicy)) *dataproc.AutoscalingPolicy {
+	p := &dataproc.AutoscalingPolicy{
+		Id:   testID,
+		Name: "projects/my-project/regions/us-central1/autoscalingPolicies/my-policy",
+		BasicAlgorithm: &dataproc.BasicAutoscalingAlgorithm{
+			CooldownPeriod: "240s",
+			YarnConfig: &dataproc.BasicYarnAutoscalingConfig{
+				GracefulDecommissionTimeout: "3600s",
+				ScaleUpFactor:               0.5,
+				ScaleDownFactor:             1,
+				ScaleUpMinWorkerFraction:    0.1,
+			},
+		},
+		WorkerConfig: &dataproc.InstanceGroupAutoscalingPolicyConfig{
+			MinInstances:    2,
+			MaxInstances:    10,
+			ForceSendFields: []string{"MinInstances"},
+		},
+		SecondaryWorkerConfig: &dataproc.InstanceGroupAutoscalingPolicyConfig{
+			MaxInstances:    50,
+			Weight:          2,
+			ForceSendFields: []string{"MinInstances"},
+		},
+		Labels: map[string]string{"team": "data"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestGenerateAutoscalingPolicy(t *testing.T) {
+	got, err := GenerateAutoscalingPolicy(testProject, testID, params())
+	if err != nil {
+		t.Fatalf("GenerateAutoscalingPolicy(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(policy(), got); diff != "" {
+		t.Errorf("GenerateAutoscalingPolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	got := params(func(p *v1alpha1.AutoscalingPolicyParameters) {
+		p.BasicAlgorithm.CooldownPeriod = nil
+		p.WorkerConfig.Weight = nil
+	})
+	observed := policy(func(p *dataproc.AutoscalingPolicy) { p.WorkerConfig.Weight = 1 })
+	LateInitializeSpec(&got, *observed)
+
+	want := params(func(p *v1alpha1.AutoscalingPolicyParameters) { p.WorkerConfig.Weight = gcp.Int64Ptr(1) })
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.AutoscalingPolicyParameters
+		observed *dataproc.AutoscalingPolicy
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: policy(func(p *dataproc.AutoscalingPolicy) { p.WorkerConfig.ForceSendFields = nil }),
+			want:     true,
+		},
+		"WorkerBoundsChanged": {
+			in:       params(func(p *v1alpha1.AutoscalingPolicyParameters) { p.WorkerConfig.MaxInstances = 20 }),
+			observed: policy(),
+		},
+		"ScaleFactorChanged": {
+			in:       params(func(p *v1alpha1.AutoscalingPolicyParameters) { p.BasicAlgorithm.YarnConfig.ScaleDownFactor = "0.5" }),
+			observed: policy(),
+		},
+		"SecondaryWorkersRemoved": {
+			in:       params(func(p *v1alpha1.AutoscalingPolicyParameters) { p.SecondaryWorkerConfig = nil }),
+			observed: policy(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(testProject, testID, tc.in, *tc.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
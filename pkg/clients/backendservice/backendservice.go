@@ -0,0 +1,194 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backendservice
+
+import (
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+	errFmtParse      = "cannot parse %s of backend %d"
+)
+
+// GenerateBackendService takes a BackendServiceParameters and assigns its
+// values to the supplied *compute.BackendService. Fields of the backend
+// service that are not part of the parameters, e.g. its CDN policy or the
+// OAuth client of its IAP settings, are left untouched.
+func GenerateBackendService(name string, in v1alpha1.BackendServiceParameters, bs *compute.BackendService) error {
+	bs.Name = name
+	bs.Description = gcp.StringValue(in.Description)
+	bs.LoadBalancingScheme = gcp.StringValue(in.LoadBalancingScheme)
+	bs.Protocol = gcp.StringValue(in.Protocol)
+	bs.PortName = gcp.StringValue(in.PortName)
+	bs.TimeoutSec = gcp.Int64Value(in.TimeoutSec)
+	bs.SessionAffinity = gcp.StringValue(in.SessionAffinity)
+	bs.AffinityCookieTtlSec = gcp.Int64Value(in.AffinityCookieTTLSec)
+	bs.EnableCDN = gcp.BoolValue(in.EnableCDN)
+	bs.HealthChecks = in.HealthChecks
+
+	bs.ConnectionDraining = nil
+	if in.ConnectionDrainingTimeoutSec != nil {
+		bs.ConnectionDraining = &compute.ConnectionDraining{
+			DrainingTimeoutSec: *in.ConnectionDrainingTimeoutSec,
+			// Zero disables connection draining.
+			ForceSendFields: []string{"DrainingTimeoutSec"},
+		}
+	}
+
+	if in.IAP != nil {
+		if bs.Iap == nil {
+			bs.Iap = &compute.BackendServiceIAP{}
+		}
+		bs.Iap.Enabled = in.IAP.Enabled
+		bs.Iap.ForceSendFields = []string{"Enabled"}
+	}
+
+	bs.ForceSendFields = nil
+	if in.EnableCDN != nil {
+		bs.ForceSendFields = append(bs.ForceSendFields, "EnableCDN")
+	}
+
+	bs.Backends = nil
+	for i, b := range in.Backends {
+		out, err := generateBackend(i, b)
+		if err != nil {
+			return err
+		}
+		bs.Backends = append(bs.Backends, out)
+	}
+	return nil
+}
+
+func generateBackend(i int, in v1alpha1.Backend) (*compute.Backend, error) {
+	out := &compute.Backend{
+		Group:                     gcp.StringValue(in.Group),
+		Description:               gcp.StringValue(in.Description),
+		BalancingMode:             gcp.StringValue(in.BalancingMode),
+		MaxConnectionsPerInstance: gcp.Int64Value(in.MaxConnectionsPerInstance),
+		MaxConnectionsPerEndpoint: gcp.Int64Value(in.MaxConnectionsPerEndpoint),
+	}
+	for _, f := range []struct {
+		name  string
+		in    *string
+		out   *float64
+		force string
+	}{
+		{name: "capacityScaler", in: in.CapacityScaler, out: &out.CapacityScaler, force: "CapacityScaler"},
+		{name: "maxUtilization", in: in.MaxUtilization, out: &out.MaxUtilization},
+		{name: "maxRatePerInstance", in: in.MaxRatePerInstance, out: &out.MaxRatePerInstance},
+		{name: "maxRatePerEndpoint", in: in.MaxRatePerEndpoint, out: &out.MaxRatePerEndpoint},
+	} {
+		if f.in == nil {
+			continue
+		}
+		v, err := strconv.ParseFloat(*f.in, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtParse, f.name, i)
+		}
+		*f.out = v
+		if f.force != "" {
+			// A capacity scaler of zero drains the backend.
+			out.ForceSendFields = append(out.ForceSendFields, f.force)
+		}
+	}
+	return out, nil
+}
+
+// GenerateObservation produces BackendServiceObservation object from
+// *compute.BackendService object.
+func GenerateObservation(in compute.BackendService) v1alpha1.BackendServiceObservation {
+	return v1alpha1.BackendServiceObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Fingerprint:       in.Fingerprint,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.BackendService object. The backends are only late-initialized if
+// as many backends are specified as are observed.
+func LateInitializeSpec(p *v1alpha1.BackendServiceParameters, observed compute.BackendService) {
+	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
+	p.LoadBalancingScheme = gcp.LateInitializeString(p.LoadBalancingScheme, observed.LoadBalancingScheme)
+	p.Protocol = gcp.LateInitializeString(p.Protocol, observed.Protocol)
+	p.PortName = gcp.LateInitializeString(p.PortName, observed.PortName)
+	p.TimeoutSec = gcp.LateInitializeInt64(p.TimeoutSec, observed.TimeoutSec)
+	p.SessionAffinity = gcp.LateInitializeString(p.SessionAffinity, observed.SessionAffinity)
+	p.AffinityCookieTTLSec = gcp.LateInitializeInt64(p.AffinityCookieTTLSec, observed.AffinityCookieTtlSec)
+	p.EnableCDN = gcp.LateInitializeBool(p.EnableCDN, observed.EnableCDN)
+	p.HealthChecks = gcp.LateInitializeStringSlice(p.HealthChecks, observed.HealthChecks)
+	if p.ConnectionDrainingTimeoutSec == nil && observed.ConnectionDraining != nil {
+		p.ConnectionDrainingTimeoutSec = gcp.Int64Ptr(observed.ConnectionDraining.DrainingTimeoutSec)
+	}
+
+	if len(p.Backends) != len(observed.Backends) {
+		return
+	}
+	for i := range p.Backends {
+		b, o := &p.Backends[i], observed.Backends[i]
+		b.Description = gcp.LateInitializeString(b.Description, o.Description)
+		b.BalancingMode = gcp.LateInitializeString(b.BalancingMode, o.BalancingMode)
+		b.CapacityScaler = lateInitializeFloat(b.CapacityScaler, o.CapacityScaler)
+		b.MaxUtilization = lateInitializeFloat(b.MaxUtilization, o.MaxUtilization)
+		b.MaxRatePerInstance = lateInitializeFloat(b.MaxRatePerInstance, o.MaxRatePerInstance)
+		b.MaxRatePerEndpoint = lateInitializeFloat(b.MaxRatePerEndpoint, o.MaxRatePerEndpoint)
+		b.MaxConnectionsPerInstance = gcp.LateInitializeInt64(b.MaxConnectionsPerInstance, o.MaxConnectionsPerInstance)
+		b.MaxConnectionsPerEndpoint = gcp.LateInitializeInt64(b.MaxConnectionsPerEndpoint, o.MaxConnectionsPerEndpoint)
+	}
+}
+
+// lateInitializeFloat returns s if it is set, or else from formatted as a
+// decimal number if it is not zero.
+func lateInitializeFloat(s *string, from float64) *string {
+	if s != nil || from == 0 {
+		return s
+	}
+	return gcp.StringPtr(strconv.FormatFloat(from, 'f', -1, 64))
+}
+
+// IsUpToDate checks whether the observed backend service is up-to-date
+// compared to the given set of parameters.
+func IsUpToDate(name string, in v1alpha1.BackendServiceParameters, observed *compute.BackendService) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.BackendService)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	if err := GenerateBackendService(name, in, desired); err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.BackendService{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.Backend{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.ConnectionDraining{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.BackendServiceIAP{}, "ForceSendFields"),
+	), nil
+}
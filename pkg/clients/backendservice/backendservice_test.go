@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backendservice
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	hcURL  = "projects/p/global/healthChecks/hc"
+	igURL  = "projects/p/zones/us-central1-a/instanceGroups/ig"
+	negURL = "projects/p/zones/us-central1-a/networkEndpointGroups/neg"
+)
+
+func TestGenerateBackendService(t *testing.T) {
+	type want struct {
+		bs  *compute.BackendService
+		err error
+	}
+	cases := map[string]struct {
+		in       v1alpha1.BackendServiceParameters
+		existing *compute.BackendService
+		want     want
+	}{
+		"Full": {
+			in: v1alpha1.BackendServiceParameters{
+				LoadBalancingScheme:          gcp.StringPtr("EXTERNAL_MANAGED"),
+				Protocol:                     gcp.StringPtr("HTTP"),
+				TimeoutSec:                   gcp.Int64Ptr(30),
+				ConnectionDrainingTimeoutSec: gcp.Int64Ptr(0),
+				EnableCDN:                    gcp.BoolPtr(false),
+				HealthChecks:                 []string{hcURL},
+				Backends: []v1alpha1.Backend{{
+					Group:          gcp.StringPtr(igURL),
+					BalancingMode:  gcp.StringPtr("UTILIZATION"),
+					CapacityScaler: gcp.StringPtr("0"),
+					MaxUtilization: gcp.StringPtr("0.8"),
+				}},
+			},
+			existing: &compute.BackendService{},
+			want: want{bs: &compute.BackendService{
+				Name:                "bs",
+				LoadBalancingScheme: "EXTERNAL_MANAGED",
+				Protocol:            "HTTP",
+				TimeoutSec:          30,
+				ConnectionDraining:  &compute.ConnectionDraining{ForceSendFields: []string{"DrainingTimeoutSec"}},
+				HealthChecks:        []string{hcURL},
+				Backends: []*compute.Backend{{
+					Group:           igURL,
+					BalancingMode:   "UTILIZATION",
+					MaxUtilization:  0.8,
+					ForceSendFields: []string{"CapacityScaler"},
+				}},
+				ForceSendFields: []string{"EnableCDN"},
+			}},
+		},
+		"KeepsIAPClient": {
+			in: v1alpha1.BackendServiceParameters{IAP: &v1alpha1.BackendServiceIAP{Enabled: true}},
+			existing: &compute.BackendService{
+				Iap: &compute.BackendServiceIAP{Oauth2ClientId: "client"},
+			},
+			want: want{bs: &compute.BackendService{
+				Name: "bs",
+				Iap:  &compute.BackendServiceIAP{Enabled: true, Oauth2ClientId: "client", ForceSendFields: []string{"Enabled"}},
+			}},
+		},
+		"InvalidFloat": {
+			in: v1alpha1.BackendServiceParameters{
+				Backends: []v1alpha1.Backend{{Group: gcp.StringPtr(negURL), MaxRatePerEndpoint: gcp.StringPtr("fast")}},
+			},
+			existing: &compute.BackendService{},
+			want: want{
+				bs:  &compute.BackendService{Name: "bs"},
+				err: errors.Wrapf(&strconv.NumError{Func: "ParseFloat", Num: "fast", Err: strconv.ErrSyntax}, errFmtParse, "maxRatePerEndpoint", 0),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := GenerateBackendService("bs", tc.in, tc.existing)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GenerateBackendService(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.bs, tc.existing); diff != "" {
+				t.Errorf("GenerateBackendService(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	observed := compute.BackendService{
+		LoadBalancingScheme: "EXTERNAL",
+		Protocol:            "HTTP",
+		TimeoutSec:          30,
+		SessionAffinity:     "NONE",
+		ConnectionDraining:  &compute.ConnectionDraining{DrainingTimeoutSec: 300},
+		Backends: []*compute.Backend{{
+			Group:          igURL,
+			BalancingMode:  "UTILIZATION",
+			CapacityScaler: 1,
+			MaxUtilization: 0.8,
+		}},
+	}
+	got := &v1alpha1.BackendServiceParameters{
+		Backends: []v1alpha1.Backend{{Group: gcp.StringPtr(igURL)}},
+	}
+	want := &v1alpha1.BackendServiceParameters{
+		LoadBalancingScheme:          gcp.StringPtr("EXTERNAL"),
+		Protocol:                     gcp.StringPtr("HTTP"),
+		TimeoutSec:                   gcp.Int64Ptr(30),
+		SessionAffinity:              gcp.StringPtr("NONE"),
+		ConnectionDrainingTimeoutSec: gcp.Int64Ptr(300),
+		Backends: []v1alpha1.Backend{{
+			Group:          gcp.StringPtr(igURL),
+			BalancingMode:  gcp.StringPtr("UTILIZATION"),
+			CapacityScaler: gcp.StringPtr("1"),
+			MaxUtilization: gcp.StringPtr("0.8"),
+		}},
+	}
+	LateInitializeSpec(got, observed)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &compute.BackendService{
+		Name:         "bs",
+		Protocol:     "HTTP",
+		Fingerprint:  "fp",
+		HealthChecks: []string{"https://www.googleapis.com/compute/v1/" + hcURL},
+		Backends: []*compute.Backend{{
+			Group:          "https://www.googleapis.com/compute/v1/" + igURL,
+			CapacityScaler: 1,
+		}},
+	}
+	cases := map[string]struct {
+		in   v1alpha1.BackendServiceParameters
+		want bool
+	}{
+		"UpToDate": {
+			in: v1alpha1.BackendServiceParameters{
+				Protocol:     gcp.StringPtr("HTTP"),
+				HealthChecks: []string{hcURL},
+				Backends:     []v1alpha1.Backend{{Group: gcp.StringPtr(igURL), CapacityScaler: gcp.StringPtr("1.0")}},
+			},
+			want: true,
+		},
+		"BackendDrained": {
+			in: v1alpha1.BackendServiceParameters{
+				Protocol:     gcp.StringPtr("HTTP"),
+				HealthChecks: []string{hcURL},
+				Backends:     []v1alpha1.Backend{{Group: gcp.StringPtr(igURL), CapacityScaler: gcp.StringPtr("0")}},
+			},
+			want: false,
+		},
+		"BackendAdded": {
+			in: v1alpha1.BackendServiceParameters{
+				Protocol:     gcp.StringPtr("HTTP"),
+				HealthChecks: []string{hcURL},
+				Backends: []v1alpha1.Backend{
+					{Group: gcp.StringPtr(igURL), CapacityScaler: gcp.StringPtr("1")},
+					{Group: gcp.StringPtr(negURL)},
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate("bs", tc.in, observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
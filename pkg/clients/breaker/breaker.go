@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package breaker implements a circuit breaker that pauses a controller's
+// calls to the Google API once it has repeatedly been told that its quota is
+// exhausted, leaving the remaining quota to other controllers.
+package breaker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	// DefaultThreshold is the default number of consecutive quota errors
+	// after which a breaker opens.
+	DefaultThreshold = 5
+
+	// DefaultCooldown is the default duration for which an open breaker
+	// pauses external calls.
+	DefaultCooldown = 5 * time.Minute
+
+	errOpenFmt = "external calls paused until %s after %d consecutive quota exhausted errors"
+)
+
+var (
+	defaultsMu sync.RWMutex
+	threshold  = DefaultThreshold
+	cooldown   = DefaultCooldown
+)
+
+var (
+	open = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "provider_gcp_quota_circuit_breaker_open",
+		Help: "Whether the quota circuit breaker of a controller is open (1) or closed (0).",
+	}, []string{"controller"})
+
+	trips = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_gcp_quota_circuit_breaker_trips_total",
+		Help: "Number of times the quota circuit breaker of a controller has opened.",
+	}, []string{"controller"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(open, trips)
+}
+
+// SetDefaults configures the threshold and cooldown used by breakers that are
+// subsequently created by WrapConnecter.
+func SetDefaults(t int, c time.Duration) {
+	defaultsMu.Lock()
+	defer defaultsMu.Unlock()
+	threshold, cooldown = t, c
+}
+
+// A Breaker counts consecutive quota exhausted errors returned by the Google
+// API and opens once they reach a threshold. An open breaker refuses calls
+// until its cooldown has elapsed, after which a single further quota error
+// opens it again.
+type Breaker struct {
+	name      string
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+// An Option configures a Breaker.
+type Option func(*Breaker)
+
+// WithClock configures the function a Breaker uses to determine the current
+// time.
+func WithClock(now func() time.Time) Option {
+	return func(b *Breaker) {
+		b.now = now
+	}
+}
+
+// New returns a Breaker for the named controller.
+func New(name string, threshold int, cooldown time.Duration, o ...Option) *Breaker {
+	b := &Breaker{name: name, threshold: threshold, cooldown: cooldown, now: time.Now}
+	for _, fn := range o {
+		fn(b)
+	}
+	return b
+}
+
+// Allow returns an error if the breaker is open.
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.now().Before(b.openUntil) {
+		return errors.Errorf(errOpenFmt, b.openUntil.UTC().Format(time.RFC3339), b.threshold)
+	}
+	if !b.openUntil.IsZero() {
+		// The cooldown has elapsed. Let calls through, but open again on
+		// the next quota error rather than waiting for a full threshold.
+		b.openUntil = time.Time{}
+		b.failures = b.threshold - 1
+		open.WithLabelValues(b.name).Set(0)
+	}
+	return nil
+}
+
+// Record updates the breaker with the result of an external call.
+func (b *Breaker) Record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !gcp.IsErrorResourceExhausted(err) {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures < b.threshold {
+		return
+	}
+	b.failures = 0
+	b.openUntil = b.now().Add(b.cooldown)
+	open.WithLabelValues(b.name).Set(1)
+	trips.WithLabelValues(b.name).Inc()
+}
+
+// WrapConnecter returns an ExternalConnecter that guards the external clients
+// produced by the supplied one with a Breaker for the named controller. The
+// supplied ExternalConnecter is returned unchanged unless the quota circuit
+// breaker feature is enabled.
+func WrapConnecter(name string, o controller.Options, c managed.ExternalConnecter) managed.ExternalConnecter {
+	if o.Features == nil || !o.Features.Enabled(features.EnableAlphaQuotaCircuitBreaker) {
+		return c
+	}
+	defaultsMu.RLock()
+	defer defaultsMu.RUnlock()
+	return &connecter{breaker: New(name, threshold, cooldown), wrapped: c}
+}
+
+type connecter struct {
+	breaker *Breaker
+	wrapped managed.ExternalConnecter
+}
+
+// Connect refuses to produce an external client while the breaker is open.
+// The returned error is surfaced by the managed reconciler as the resource's
+// Synced condition.
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if err := c.breaker.Allow(); err != nil {
+		return nil, err
+	}
+	e, err := c.wrapped.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{breaker: c.breaker, wrapped: e}, nil
+}
+
+type external struct {
+	breaker *Breaker
+	wrapped managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.wrapped.Observe(ctx, mg)
+	e.breaker.Record(err)
+	return o, err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.wrapped.Create(ctx, mg)
+	e.breaker.Record(err)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.wrapped.Update(ctx, mg)
+	e.breaker.Record(err)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.wrapped.Delete(ctx, mg)
+	e.breaker.Record(err)
+	return err
+}
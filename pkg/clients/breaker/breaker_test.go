@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package breaker
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+var (
+	errQuota = errors.Wrap(&googleapi.Error{Code: http.StatusTooManyRequests}, "boom")
+	errOther = errors.New("boom")
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Unix(0, 0)
+	clock := func() time.Time { return now }
+
+	cases := map[string]struct {
+		results []error
+		elapsed time.Duration
+		open    bool
+	}{
+		"BelowThreshold": {
+			results: []error{errQuota, errQuota},
+		},
+		"NotConsecutive": {
+			results: []error{errQuota, errQuota, nil, errQuota},
+		},
+		"OtherErrorsIgnored": {
+			results: []error{errOther, errOther, errOther, errOther},
+		},
+		"Trips": {
+			results: []error{errQuota, errQuota, errQuota},
+			open:    true,
+		},
+		"CooldownElapsed": {
+			results: []error{errQuota, errQuota, errQuota},
+			elapsed: time.Minute,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now = time.Unix(0, 0)
+			b := New("test", 3, time.Minute, WithClock(clock))
+			for _, err := range tc.results {
+				b.Record(err)
+			}
+			now = now.Add(tc.elapsed)
+			if got := b.Allow() != nil; got != tc.open {
+				t.Errorf("Allow(): want open %t, got open %t", tc.open, got)
+			}
+		})
+	}
+}
+
+func TestBreakerReopensAfterCooldown(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := New("test", 3, time.Minute, WithClock(func() time.Time { return now }))
+	for i := 0; i < 3; i++ {
+		b.Record(errQuota)
+	}
+	now = now.Add(time.Minute)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow(): unexpected error after cooldown: %s", err)
+	}
+	b.Record(errQuota)
+	if err := b.Allow(); err == nil {
+		t.Errorf("Allow(): want error after a quota error following cooldown")
+	}
+}
+
+func TestWrapConnecter(t *testing.T) {
+	calls := 0
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		calls++
+		return managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{}, errQuota
+			},
+		}, nil
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		if _, ok := WrapConnecter("test", controller.Options{Features: &feature.Flags{}}, c).(managed.ExternalConnectorFn); !ok {
+			t.Errorf("WrapConnecter(...): want the supplied connecter when the feature is disabled")
+		}
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		f := &feature.Flags{}
+		f.Enable(features.EnableAlphaQuotaCircuitBreaker)
+		SetDefaults(2, time.Hour)
+		defer SetDefaults(DefaultThreshold, DefaultCooldown)
+
+		w := WrapConnecter("test", controller.Options{Features: f}, c)
+		for i := 0; i < 2; i++ {
+			e, err := w.Connect(context.Background(), &fake.Managed{})
+			if err != nil {
+				t.Fatalf("Connect(...): unexpected error: %s", err)
+			}
+			_, _ = e.Observe(context.Background(), &fake.Managed{})
+		}
+		if _, err := w.Connect(context.Background(), &fake.Managed{}); err == nil {
+			t.Errorf("Connect(...): want error once the breaker is open")
+		}
+		if calls != 2 {
+			t.Errorf("Connect(...): want 2 calls to the wrapped connecter, got %d", calls)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketacl
+
+import (
+	"google.golang.org/api/storage/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+)
+
+// Client should be satisfied to conduct BucketACL operations.
+type Client interface {
+	Get(bucket string, entity string) *storage.BucketAccessControlsGetCall
+	Insert(bucket string, bucketaccesscontrol *storage.BucketAccessControl) *storage.BucketAccessControlsInsertCall
+	Patch(bucket string, entity string, bucketaccesscontrol *storage.BucketAccessControl) *storage.BucketAccessControlsPatchCall
+	Delete(bucket string, entity string) *storage.BucketAccessControlsDeleteCall
+}
+
+// GenerateBucketAccessControl generates *storage.BucketAccessControl instance from
+// BucketACLParameters.
+func GenerateBucketAccessControl(in v1alpha1.BucketACLParameters) *storage.BucketAccessControl {
+	return &storage.BucketAccessControl{
+		Entity: in.Entity,
+		Role:   in.Role,
+	}
+}
+
+// GenerateObservation produces BucketACLObservation object from
+// storage.BucketAccessControl object.
+func GenerateObservation(in storage.BucketAccessControl) v1alpha1.BucketACLObservation {
+	return v1alpha1.BucketACLObservation{
+		Domain:   in.Domain,
+		Email:    in.Email,
+		EntityID: in.EntityId,
+		Etag:     in.Etag,
+	}
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters. Only the role of an entry can be changed.
+func IsUpToDate(in v1alpha1.BucketACLParameters, observed storage.BucketAccessControl) bool {
+	return in.Role == observed.Role
+}
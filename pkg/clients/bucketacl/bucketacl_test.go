@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketacl
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/storage/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+)
+
+func TestGenerateObservation(t *testing.T) {
+	in := storage.BucketAccessControl{
+		Domain:   "example.com",
+		Email:    "liz@example.com",
+		Entity:   "user-liz@example.com",
+		EntityId: "00b4903a97",
+		Etag:     "CAE=",
+		Role:     "READER",
+	}
+	want := v1alpha1.BucketACLObservation{
+		Domain:   "example.com",
+		Email:    "liz@example.com",
+		EntityID: "00b4903a97",
+		Etag:     "CAE=",
+	}
+	if diff := cmp.Diff(want, GenerateObservation(in)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.BucketACLParameters
+		observed storage.BucketAccessControl
+		want     bool
+	}{
+		"UpToDate": {
+			in:       v1alpha1.BucketACLParameters{Entity: "allUsers", Role: "READER"},
+			observed: storage.BucketAccessControl{Entity: "allUsers", Role: "READER", Etag: "CAE="},
+			want:     true,
+		},
+		"RoleChanged": {
+			in:       v1alpha1.BucketACLParameters{Entity: "allUsers", Role: "READER"},
+			observed: storage.BucketAccessControl{Entity: "allUsers", Role: "OWNER"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketnotification
+
+import (
+	"strings"
+
+	pubsub "google.golang.org/api/pubsub/v1"
+	"google.golang.org/api/storage/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
+)
+
+const (
+	// pubsubPrefix prefixes the topic names accepted by the notifications
+	// API.
+	pubsubPrefix = "//pubsub.googleapis.com/"
+
+	// PublisherRole is the role the Cloud Storage service agent needs on a
+	// topic to publish notifications to it.
+	PublisherRole = "roles/pubsub.publisher"
+)
+
+// Client should be satisfied to conduct BucketNotification operations.
+type Client interface {
+	Insert(bucket string, notification *storage.Notification) *storage.NotificationsInsertCall
+	Get(bucket string, notification string) *storage.NotificationsGetCall
+	Delete(bucket string, notification string) *storage.NotificationsDeleteCall
+}
+
+// TopicIAMClient should be satisfied to manage the IAM policy of the topic of
+// a BucketNotification.
+type TopicIAMClient interface {
+	GetIamPolicy(resource string) *pubsub.ProjectsTopicsGetIamPolicyCall
+	SetIamPolicy(resource string, req *pubsub.SetIamPolicyRequest) *pubsub.ProjectsTopicsSetIamPolicyCall
+}
+
+// GetTopicResource returns the fully qualified name of the given topic, i.e.
+// projects/{project}/topics/{topic}. Topics that are not fully qualified are
+// assumed to belong to the given project.
+func GetTopicResource(projectID, t string) string {
+	if strings.HasPrefix(t, "projects/") {
+		return t
+	}
+	return topic.GetFullyQualifiedName(projectID, t)
+}
+
+// GetTopicName returns the name of the given topic in the format expected by
+// the notifications API. Topics that are not fully qualified are assumed to
+// belong to the given project.
+func GetTopicName(projectID, t string) string {
+	return pubsubPrefix + GetTopicResource(projectID, t)
+}
+
+// ServiceAgentMember returns the IAM member of the Cloud Storage service agent
+// with the given email address.
+func ServiceAgentMember(email string) string {
+	return "serviceAccount:" + email
+}
+
+// HasPublisher returns true if the supplied topic IAM policy grants the given
+// member the publisher role unconditionally.
+func HasPublisher(p *pubsub.Policy, member string) bool {
+	for _, b := range p.Bindings {
+		if b.Role != PublisherRole || b.Condition != nil {
+			continue
+		}
+		for _, m := range b.Members {
+			if m == member {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AddPublisher grants the given member the publisher role in the supplied
+// topic IAM policy. It returns false if the policy already granted it.
+func AddPublisher(p *pubsub.Policy, member string) bool {
+	if HasPublisher(p, member) {
+		return false
+	}
+	for _, b := range p.Bindings {
+		if b.Role == PublisherRole && b.Condition == nil {
+			b.Members = append(b.Members, member)
+			return true
+		}
+	}
+	p.Bindings = append(p.Bindings, &pubsub.Binding{Role: PublisherRole, Members: []string{member}})
+	return true
+}
+
+// GenerateNotification generates *storage.Notification instance from
+// BucketNotificationParameters.
+func GenerateNotification(projectID string, in v1alpha1.BucketNotificationParameters) *storage.Notification {
+	return &storage.Notification{
+		Topic:            GetTopicName(projectID, gcp.StringValue(in.Topic)),
+		EventTypes:       in.EventTypes,
+		ObjectNamePrefix: gcp.StringValue(in.ObjectNamePrefix),
+		PayloadFormat:    in.PayloadFormat,
+		CustomAttributes: in.CustomAttributes,
+	}
+}
+
+// GenerateObservation produces BucketNotificationObservation object from
+// storage.Notification object.
+func GenerateObservation(in storage.Notification) v1alpha1.BucketNotificationObservation {
+	return v1alpha1.BucketNotificationObservation{
+		Topic:    in.Topic,
+		Etag:     in.Etag,
+		SelfLink: in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// storage.Notification object.
+func LateInitializeSpec(spec *v1alpha1.BucketNotificationParameters, in storage.Notification) {
+	spec.ObjectNamePrefix = gcp.LateInitializeString(spec.ObjectNamePrefix, in.ObjectNamePrefix)
+	if spec.PayloadFormat == "" {
+		spec.PayloadFormat = in.PayloadFormat
+	}
+}
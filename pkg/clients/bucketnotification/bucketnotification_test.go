@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketnotification
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/storage/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const project = "cool-project"
+
+func TestGetTopicName(t *testing.T) {
+	cases := map[string]struct {
+		topic string
+		want  string
+	}{
+		"TopicName": {
+			topic: "my-topic",
+			want:  "//pubsub.googleapis.com/projects/cool-project/topics/my-topic",
+		},
+		"FullyQualifiedName": {
+			topic: "projects/other-project/topics/my-topic",
+			want:  "//pubsub.googleapis.com/projects/other-project/topics/my-topic",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetTopicName(project, tc.topic)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetTopicName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNotification(t *testing.T) {
+	in := v1alpha1.BucketNotificationParameters{
+		Bucket:           gcp.StringPtr("my-bucket"),
+		Topic:            gcp.StringPtr("my-topic"),
+		EventTypes:       []string{"OBJECT_FINALIZE"},
+		ObjectNamePrefix: gcp.StringPtr("uploads/"),
+		PayloadFormat:    v1alpha1.PayloadFormatJSONAPIV1,
+		CustomAttributes: map[string]string{"team": "data"},
+	}
+	want := &storage.Notification{
+		Topic:            "//pubsub.googleapis.com/projects/cool-project/topics/my-topic",
+		EventTypes:       []string{"OBJECT_FINALIZE"},
+		ObjectNamePrefix: "uploads/",
+		PayloadFormat:    v1alpha1.PayloadFormatJSONAPIV1,
+		CustomAttributes: map[string]string{"team": "data"},
+	}
+	if diff := cmp.Diff(want, GenerateNotification(project, in)); diff != "" {
+		t.Errorf("GenerateNotification(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.BucketNotificationParameters
+		in   storage.Notification
+		want *v1alpha1.BucketNotificationParameters
+	}{
+		"Unset": {
+			spec: &v1alpha1.BucketNotificationParameters{},
+			in:   storage.Notification{ObjectNamePrefix: "uploads/", PayloadFormat: v1alpha1.PayloadFormatNone},
+			want: &v1alpha1.BucketNotificationParameters{
+				ObjectNamePrefix: gcp.StringPtr("uploads/"),
+				PayloadFormat:    v1alpha1.PayloadFormatNone,
+			},
+		},
+		"Set": {
+			spec: &v1alpha1.BucketNotificationParameters{PayloadFormat: v1alpha1.PayloadFormatJSONAPIV1},
+			in:   storage.Notification{PayloadFormat: v1alpha1.PayloadFormatNone},
+			want: &v1alpha1.BucketNotificationParameters{PayloadFormat: v1alpha1.PayloadFormatJSONAPIV1},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketobject
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // GCS reports the MD5 hash of object data.
+	"encoding/base64"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/storage/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// Error strings.
+const (
+	errNoContent       = "exactly one of content and contentFrom must be set"
+	errNoContentSource = "exactly one of configMapKeyRef and secretKeyRef must be set"
+	errGetConfigMap    = "cannot get ConfigMap with object content"
+	errGetSecret       = "cannot get Secret with object content"
+	errFmtKeyNotFound  = "key %q not found in %s %s/%s"
+	kindConfigMap      = "ConfigMap"
+	kindSecret         = "Secret"
+)
+
+// Client should be satisfied to conduct BucketObject operations.
+type Client interface {
+	Get(bucket string, object string) *storage.ObjectsGetCall
+	Insert(bucket string, object *storage.Object) *storage.ObjectsInsertCall
+	Patch(bucket string, object string, object2 *storage.Object) *storage.ObjectsPatchCall
+	Delete(bucket string, object string) *storage.ObjectsDeleteCall
+}
+
+// GetContent returns the content of the object described by the supplied
+// parameters, reading it from a ConfigMap or Secret if necessary.
+func GetContent(ctx context.Context, kube client.Reader, in v1alpha1.BucketObjectParameters) ([]byte, error) {
+	if (in.Content == nil) == (in.ContentFrom == nil) {
+		return nil, errors.New(errNoContent)
+	}
+	if in.Content != nil {
+		return []byte(*in.Content), nil
+	}
+
+	src := in.ContentFrom
+	switch {
+	case src.ConfigMapKeyRef != nil && src.SecretKeyRef == nil:
+		ref := src.ConfigMapKeyRef
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return nil, errors.Wrap(err, errGetConfigMap)
+		}
+		if v, ok := cm.Data[ref.Key]; ok {
+			return []byte(v), nil
+		}
+		if v, ok := cm.BinaryData[ref.Key]; ok {
+			return v, nil
+		}
+		return nil, errors.Errorf(errFmtKeyNotFound, ref.Key, kindConfigMap, ref.Namespace, ref.Name)
+	case src.SecretKeyRef != nil && src.ConfigMapKeyRef == nil:
+		ref := src.SecretKeyRef
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, errors.Wrap(err, errGetSecret)
+		}
+		v, ok := s.Data[ref.Key]
+		if !ok {
+			return nil, errors.Errorf(errFmtKeyNotFound, ref.Key, kindSecret, ref.Namespace, ref.Name)
+		}
+		return v, nil
+	}
+	return nil, errors.New(errNoContentSource)
+}
+
+// MD5Hash returns the base64 encoded MD5 hash of the supplied content, in the
+// form GCS reports it for objects.
+func MD5Hash(content []byte) string {
+	h := md5.Sum(content) //nolint:gosec // GCS reports the MD5 hash of object data.
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+// GenerateObject generates *storage.Object instance from
+// BucketObjectParameters.
+func GenerateObject(name string, in v1alpha1.BucketObjectParameters) *storage.Object {
+	return &storage.Object{
+		Name:         name,
+		ContentType:  gcp.StringValue(in.ContentType),
+		CacheControl: gcp.StringValue(in.CacheControl),
+		Metadata:     in.Metadata,
+	}
+}
+
+// GenerateObservation produces BucketObjectObservation object from
+// storage.Object object.
+func GenerateObservation(in storage.Object) v1alpha1.BucketObjectObservation {
+	return v1alpha1.BucketObjectObservation{
+		Generation: in.Generation,
+		MD5Hash:    in.Md5Hash,
+		Size:       int64(in.Size),
+		MediaLink:  in.MediaLink,
+		SelfLink:   in.SelfLink,
+		Updated:    in.Updated,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// storage.Object object.
+func LateInitializeSpec(spec *v1alpha1.BucketObjectParameters, in storage.Object) {
+	spec.ContentType = gcp.LateInitializeString(spec.ContentType, in.ContentType)
+	spec.CacheControl = gcp.LateInitializeString(spec.CacheControl, in.CacheControl)
+}
+
+// IsContentUpToDate returns true if the supplied content matches the data of
+// the observed object.
+func IsContentUpToDate(content []byte, observed storage.Object) bool {
+	return MD5Hash(content) == observed.Md5Hash
+}
+
+// IsUpToDate checks whether the metadata of the observed object is up-to-date
+// compared to the given set of parameters.
+func IsUpToDate(in v1alpha1.BucketObjectParameters, observed storage.Object) bool {
+	desired := GenerateObject(observed.Name, in)
+	return cmp.Equal(desired.ContentType, observed.ContentType) &&
+		cmp.Equal(desired.CacheControl, observed.CacheControl) &&
+		cmp.Equal(desired.Metadata, observed.Metadata, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketobject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/storage/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testContent   = "hello"
+	testKey       = "data"
+	testNamespace = "default"
+	testSource    = "content"
+)
+
+var errBoom = errors.New("boom")
+
+func TestGetContent(t *testing.T) {
+	configMapRef := &v1alpha1.BucketObjectContentSource{
+		ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Name: testSource, Namespace: testNamespace, Key: testKey},
+	}
+	secretRef := &v1alpha1.BucketObjectContentSource{
+		SecretKeyRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: testSource, Namespace: testNamespace},
+			Key:             testKey,
+		},
+	}
+	type args struct {
+		kube client.Reader
+		in   v1alpha1.BucketObjectParameters
+	}
+	type want struct {
+		out []byte
+		err error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Inline": {
+			args: args{in: v1alpha1.BucketObjectParameters{Content: gcp.StringPtr(testContent)}},
+			want: want{out: []byte(testContent)},
+		},
+		"Neither": {
+			args: args{in: v1alpha1.BucketObjectParameters{}},
+			want: want{err: errors.New(errNoContent)},
+		},
+		"Both": {
+			args: args{in: v1alpha1.BucketObjectParameters{Content: gcp.StringPtr(testContent), ContentFrom: configMapRef}},
+			want: want{err: errors.New(errNoContent)},
+		},
+		"EmptySource": {
+			args: args{in: v1alpha1.BucketObjectParameters{ContentFrom: &v1alpha1.BucketObjectContentSource{}}},
+			want: want{err: errors.New(errNoContentSource)},
+		},
+		"ConfigMapData": {
+			args: args{
+				kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{testKey: testContent}
+					return nil
+				}},
+				in: v1alpha1.BucketObjectParameters{ContentFrom: configMapRef},
+			},
+			want: want{out: []byte(testContent)},
+		},
+		"ConfigMapBinaryData": {
+			args: args{
+				kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.ConfigMap).BinaryData = map[string][]byte{testKey: []byte(testContent)}
+					return nil
+				}},
+				in: v1alpha1.BucketObjectParameters{ContentFrom: configMapRef},
+			},
+			want: want{out: []byte(testContent)},
+		},
+		"ConfigMapKeyNotFound": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				in:   v1alpha1.BucketObjectParameters{ContentFrom: configMapRef},
+			},
+			want: want{err: errors.Errorf(errFmtKeyNotFound, testKey, kindConfigMap, testNamespace, testSource)},
+		},
+		"ConfigMapGetFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				in:   v1alpha1.BucketObjectParameters{ContentFrom: configMapRef},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetConfigMap)},
+		},
+		"Secret": {
+			args: args{
+				kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{testKey: []byte(testContent)}
+					return nil
+				}},
+				in: v1alpha1.BucketObjectParameters{ContentFrom: secretRef},
+			},
+			want: want{out: []byte(testContent)},
+		},
+		"SecretKeyNotFound": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				in:   v1alpha1.BucketObjectParameters{ContentFrom: secretRef},
+			},
+			want: want{err: errors.Errorf(errFmtKeyNotFound, testKey, kindSecret, testNamespace, testSource)},
+		},
+		"SecretGetFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				in:   v1alpha1.BucketObjectParameters{ContentFrom: secretRef},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetSecret)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetContent(context.Background(), tc.args.kube, tc.args.in)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetContent(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.out, got); diff != "" {
+				t.Errorf("GetContent(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMD5Hash(t *testing.T) {
+	// echo -n hello | openssl dgst -md5 -binary | base64
+	want := "XUFAKrxLKna5cZ2REBfFkg=="
+	if diff := cmp.Diff(want, MD5Hash([]byte(testContent))); diff != "" {
+		t.Errorf("MD5Hash(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		in       v1alpha1.BucketObjectParameters
+		observed storage.Object
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				in:       v1alpha1.BucketObjectParameters{ContentType: gcp.StringPtr("text/plain")},
+				observed: storage.Object{ContentType: "text/plain", Metadata: map[string]string{}},
+			},
+			want: true,
+		},
+		"ContentTypeChanged": {
+			args: args{
+				in:       v1alpha1.BucketObjectParameters{ContentType: gcp.StringPtr("text/plain")},
+				observed: storage.Object{ContentType: "application/json"},
+			},
+		},
+		"MetadataChanged": {
+			args: args{
+				in:       v1alpha1.BucketObjectParameters{Metadata: map[string]string{"owner": "crossplane"}},
+				observed: storage.Object{},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.args.in, tc.args.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsContentUpToDate(t *testing.T) {
+	observed := storage.Object{Md5Hash: MD5Hash([]byte(testContent))}
+	if !IsContentUpToDate([]byte(testContent), observed) {
+		t.Error("IsContentUpToDate(...): want true for identical content")
+	}
+	if IsContentUpToDate([]byte("changed"), observed) {
+		t.Error("IsContentUpToDate(...): want false for changed content")
+	}
+}
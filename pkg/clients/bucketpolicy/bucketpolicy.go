@@ -17,20 +17,46 @@ limitations under the License.
 package bucketpolicy
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/mitchellh/copystructure"
 	"google.golang.org/api/storage/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 
 	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/takeover"
 )
 
 const errCheckUpToDate = "unable to determine if external resource is up to date"
 
+// DefaultObjectPrefixTitle is the title of the IAM conditions generated for
+// object prefixes that do not specify one.
+const DefaultObjectPrefixTitle = "Object prefixes"
+
+// ConflictBackoff bounds how often a read-modify-write of a bucket IAM policy
+// is retried when the policy was changed concurrently.
+var ConflictBackoff = wait.Backoff{
+	Steps:    4,
+	Duration: 50 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+// IsErrorConflict returns true if the supplied error indicates that the etag
+// of a policy no longer matched the policy when it was set, i.e. that someone
+// else modified it since it was read.
+func IsErrorConflict(err error) bool {
+	return gcp.IsErrorPreconditionFailed(err) || gcp.IsErrorAlreadyExists(err)
+}
+
 // Client should be satisfied to conduct Bucket Policy operations.
 type Client interface {
 	GetIamPolicy(bucket string) *storage.BucketsGetIamPolicyCall
@@ -38,18 +64,17 @@ type Client interface {
 }
 
 // GenerateBucketPolicyInstance generates *storage.Policy instance from BucketPolicyParameters.
+// In Merge mode the declared bindings are added to those of sp, otherwise they
+// replace them. The etag of sp is left untouched so that setting the generated
+// policy fails if the policy it was read from has been modified since.
 func GenerateBucketPolicyInstance(in v1alpha1.BucketPolicyParameters, sp *storage.Policy) {
+	if IsMerge(in) {
+		MergeBindings(in, sp)
+		return
+	}
 	sp.Bindings = make([]*storage.PolicyBindings, len(in.Policy.Bindings))
 	for i, v := range in.Policy.Bindings {
-		sp.Bindings[i] = &storage.PolicyBindings{}
-		if v.Condition != nil {
-			sp.Bindings[i].Condition = &storage.Expr{
-				Description: gcp.StringValue(v.Condition.Description),
-				Expression:  v.Condition.Expression,
-				Location:    gcp.StringValue(v.Condition.Location),
-				Title:       gcp.StringValue(v.Condition.Title),
-			}
-		}
+		sp.Bindings[i] = &storage.PolicyBindings{Condition: generateCondition(v.Condition)}
 		sp.Bindings[i].Members = make([]string, len(v.Members))
 		copy(sp.Bindings[i].Members, v.Members)
 		sp.Bindings[i].Role = v.Role
@@ -57,6 +82,28 @@ func GenerateBucketPolicyInstance(in v1alpha1.BucketPolicyParameters, sp *storag
 	sp.Version = iamv1alpha1.PolicyVersion
 }
 
+// IsMerge returns true if the declared bindings are to be merged into the IAM
+// policy of the bucket rather than replace its bindings.
+func IsMerge(in v1alpha1.BucketPolicyParameters) bool {
+	return gcp.StringValue(in.Mode) == v1alpha1.PolicyModeMerge
+}
+
+// MergeBindings adds the members of the bindings declared in
+// BucketPolicyParameters to *storage.Policy. Bindings and members that are not
+// declared are kept.
+// returns true if policy changed
+func MergeBindings(in v1alpha1.BucketPolicyParameters, sp *storage.Policy) bool {
+	sp.Version = iamv1alpha1.PolicyVersion
+	changed := false
+	for _, d := range in.Policy.Bindings {
+		cond := generateCondition(d.Condition)
+		for _, m := range d.Members {
+			changed = bindMember(sp, d.Role, cond, m) || changed
+		}
+	}
+	return changed
+}
+
 // IsUpToDate checks whether current state is up-to-date compared to the given
 // set of parameters.
 func IsUpToDate(in *v1alpha1.BucketPolicyParameters, observed *storage.Policy) (bool, error) {
@@ -76,7 +123,7 @@ func IsUpToDate(in *v1alpha1.BucketPolicyParameters, observed *storage.Policy) (
 func ArePoliciesSame(p1, p2 *storage.Policy) bool {
 	return cmp.Equal(p1, p2, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreFields(storage.Policy{}, "Version"),
-		cmpopts.SortSlices(func(i, j *storage.PolicyBindings) bool { return i.Role > j.Role }),
+		cmpopts.SortSlices(func(i, j *storage.PolicyBindings) bool { return bindingKey(i) > bindingKey(j) }),
 		cmpopts.SortSlices(func(i, j string) bool { return i > j }))
 }
 
@@ -85,51 +132,253 @@ func IsEmpty(in *storage.Policy) bool {
 	return in.Bindings == nil
 }
 
+// Bindings returns the bindings of the supplied policy for takeover checks.
+func Bindings(in *storage.Policy) []takeover.Binding {
+	out := make([]takeover.Binding, 0, len(in.Bindings))
+	for _, b := range in.Bindings {
+		out = append(out, takeover.Binding{Role: b.Role, Members: b.Members})
+	}
+	return out
+}
+
+// Members returns the members declared by BucketPolicyMemberParameters, i.e.
+// the union of Member and Members.
+func Members(in v1alpha1.BucketPolicyMemberParameters) []string {
+	members := make([]string, 0, len(in.Members)+1)
+	if in.Member != nil {
+		members = append(members, *in.Member)
+	}
+	for _, m := range in.Members {
+		if !contains(members, m) {
+			members = append(members, m)
+		}
+	}
+	return members
+}
+
+// StaleMembers returns the supplied members the role was bound to that are
+// no longer declared in BucketPolicyMemberParameters.
+func StaleMembers(in v1alpha1.BucketPolicyMemberParameters, bound []string) []string {
+	members := Members(in)
+	var stale []string
+	for _, m := range bound {
+		if !contains(members, m) {
+			stale = append(stale, m)
+		}
+	}
+	return stale
+}
+
+// OwnedMembers returns the members declared by BucketPolicyMemberParameters
+// followed by the supplied members the role was bound to that are no longer
+// declared. These are the members a BucketPolicyMember must unbind when it is
+// deleted.
+func OwnedMembers(in v1alpha1.BucketPolicyMemberParameters, bound []string) []string {
+	return append(Members(in), StaleMembers(in, bound)...)
+}
+
+// IsRoleBound returns true if the role declared in
+// BucketPolicyMemberParameters is bound to any of the supplied members.
+func IsRoleBound(in v1alpha1.BucketPolicyMemberParameters, members []string, sp *storage.Policy) bool {
+	cond := memberCondition(in)
+	for _, b := range sp.Bindings {
+		if b.Role != in.Role || !sameCondition(b.Condition, cond) {
+			continue
+		}
+		for _, m := range b.Members {
+			if contains(members, m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // BindRoleToMember updates *storage.Policy instance with BucketPolicyMemberParameters.
+// The role is bound to every declared member that it is not already bound to.
 // returns true if policy changed
 func BindRoleToMember(in v1alpha1.BucketPolicyMemberParameters, sp *storage.Policy) bool {
 	sp.Version = iamv1alpha1.PolicyVersion
+	cond := memberCondition(in)
+	changed := false
+	for _, m := range Members(in) {
+		changed = bindMember(sp, in.Role, cond, m) || changed
+	}
+	return changed
+}
+
+// bindMember binds the supplied role with the supplied condition to member.
+// returns true if policy changed
+func bindMember(sp *storage.Policy, role string, cond *storage.Expr, member string) bool {
 	for _, b := range sp.Bindings {
-		if b.Role == in.Role {
+		if b.Role == role && sameCondition(b.Condition, cond) {
 			for _, m := range b.Members {
-				if m == gcp.StringValue(in.Member) {
+				if m == member {
 					// role already bound to member, no change
 					return false
 				}
 			}
 			// role already exist, add member
-			b.Members = append(b.Members, gcp.StringValue(in.Member))
+			b.Members = append(b.Members, member)
 			return true
 		}
 	}
-	// role does not exist, add binding with role and member
+	// role does not exist with this condition, add binding with role,
+	// condition and member
 	sp.Bindings = append(sp.Bindings, &storage.PolicyBindings{
-		Role:    in.Role,
-		Members: []string{gcp.StringValue(in.Member)},
+		Role:      role,
+		Condition: cond,
+		Members:   []string{member},
 	})
 	return true
 }
 
 // UnbindRoleFromMember generates *storage.Policy instance from BucketPolicyMemberParameters.
+// Every declared member is removed from the binding of the role, other
+// members of the binding are kept.
 // returns true if bound (i.e. policy changed)
 func UnbindRoleFromMember(in v1alpha1.BucketPolicyMemberParameters, sp *storage.Policy) bool {
+	return UnbindRoleFromMembers(in, Members(in), sp)
+}
+
+// UnbindRoleFromMembers removes the supplied members from the binding of the
+// role declared in BucketPolicyMemberParameters, keeping its other members.
+// returns true if policy changed
+func UnbindRoleFromMembers(in v1alpha1.BucketPolicyMemberParameters, members []string, sp *storage.Policy) bool {
+	if len(members) == 0 {
+		return false
+	}
+	cond := memberCondition(in)
 	for _, b := range sp.Bindings {
-		if b.Role == in.Role {
-			ix := -1
-			for i, m := range b.Members {
-				if m == gcp.StringValue(in.Member) {
-					// found member binding in role
-					ix = i
-					break
+		if b.Role == in.Role && sameCondition(b.Condition, cond) {
+			kept := b.Members[:0]
+			for _, m := range b.Members {
+				if !contains(members, m) {
+					kept = append(kept, m)
 				}
 			}
-			if ix >= 0 {
-				// remove member located at index ix
-				b.Members = append(b.Members[:ix], b.Members[ix+1:]...)
-				return true
+			changed := len(kept) != len(b.Members)
+			b.Members = kept
+			return changed
+		}
+	}
+	return false
+}
+
+// HasBindings returns true if any member of the bindings declared in
+// BucketPolicyParameters is bound in *storage.Policy.
+func HasBindings(in v1alpha1.BucketPolicyParameters, sp *storage.Policy) bool {
+	for _, d := range in.Policy.Bindings {
+		cond := generateCondition(d.Condition)
+		for _, b := range sp.Bindings {
+			if b.Role != d.Role || !sameCondition(b.Condition, cond) {
+				continue
+			}
+			for _, m := range b.Members {
+				if contains(d.Members, m) {
+					return true
+				}
 			}
-			return false
 		}
 	}
 	return false
 }
+
+// RemoveBindings removes the members of the bindings declared in
+// BucketPolicyParameters from *storage.Policy, dropping bindings that are left
+// without members. Bindings and members that are not declared are kept.
+// returns true if policy changed
+func RemoveBindings(in v1alpha1.BucketPolicyParameters, sp *storage.Policy) bool {
+	changed := false
+	for _, d := range in.Policy.Bindings {
+		cond := generateCondition(d.Condition)
+		for _, b := range sp.Bindings {
+			if b.Role != d.Role || !sameCondition(b.Condition, cond) {
+				continue
+			}
+			members := b.Members[:0]
+			for _, m := range b.Members {
+				if !contains(d.Members, m) {
+					members = append(members, m)
+				}
+			}
+			changed = changed || len(members) != len(b.Members)
+			b.Members = members
+		}
+	}
+	bindings := sp.Bindings[:0]
+	for _, b := range sp.Bindings {
+		if len(b.Members) > 0 {
+			bindings = append(bindings, b)
+		}
+	}
+	sp.Bindings = bindings
+	return changed
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func generateCondition(in *iamv1alpha1.Expr) *storage.Expr {
+	if in == nil {
+		return nil
+	}
+	return &storage.Expr{
+		Description: gcp.StringValue(in.Description),
+		Expression:  in.Expression,
+		Location:    gcp.StringValue(in.Location),
+		Title:       gcp.StringValue(in.Title),
+	}
+}
+
+// memberCondition returns the IAM condition of the binding a
+// BucketPolicyMember binds its members in, generating it from the object
+// prefixes if any are specified.
+func memberCondition(in v1alpha1.BucketPolicyMemberParameters) *storage.Expr {
+	c := in.ObjectPrefixCondition
+	if c == nil {
+		return generateCondition(in.Condition)
+	}
+	title := DefaultObjectPrefixTitle
+	if c.Title != nil {
+		title = *c.Title
+	}
+	return &storage.Expr{
+		Title:       title,
+		Description: gcp.StringValue(c.Description),
+		Expression:  ObjectPrefixExpression(gcp.StringValue(in.Bucket), c.Prefixes),
+	}
+}
+
+// ObjectPrefixExpression returns the CEL expression of an IAM condition that
+// matches the objects of the supplied bucket whose names start with one of
+// the supplied prefixes.
+func ObjectPrefixExpression(bucket string, prefixes []string) string {
+	exprs := make([]string, len(prefixes))
+	for i, p := range prefixes {
+		exprs[i] = "resource.name.startsWith(" + strconv.Quote("projects/_/buckets/"+bucket+"/objects/"+p) + ")"
+	}
+	return strings.Join(exprs, " || ")
+}
+
+// sameCondition reports whether the supplied binding conditions are the same.
+// Bindings without a condition only match each other.
+func sameCondition(a, b *storage.Expr) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Expression == b.Expression && a.Title == b.Title && a.Description == b.Description && a.Location == b.Location
+}
+
+func bindingKey(b *storage.PolicyBindings) string {
+	if b.Condition == nil {
+		return b.Role
+	}
+	return b.Role + "/" + b.Condition.Title + "/" + b.Condition.Expression
+}
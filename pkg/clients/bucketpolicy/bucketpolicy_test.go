@@ -1,18 +1,34 @@
 package bucketpolicy
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/storage/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
 	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 )
 
 var (
-	testRole   = "roles/storage.objectAdmin"
-	testMember = "serviceAccount:perfect-test-sa@wesaas-playground.iam.gserviceaccount.com"
+	testRole      = "roles/storage.objectAdmin"
+	testMember    = "serviceAccount:perfect-test-sa@wesaas-playground.iam.gserviceaccount.com"
+	testGroup     = "group:admins@example.com"
+	testUser      = "user:alice@example.com"
+	testTitle     = "expires"
+	testCondition = &iamv1alpha1.Expr{
+		Title:      &testTitle,
+		Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`,
+	}
+	testStorageCondition = &storage.Expr{
+		Title:      testTitle,
+		Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`,
+	}
 )
 
 func TestBindRoleToMember(t *testing.T) {
@@ -161,6 +177,161 @@ func TestBindRoleToMember(t *testing.T) {
 				},
 			},
 		},
+		"ConditionalRoleAddedNextToUnconditional": {
+			args: args{
+				in: v1alpha1.BucketPolicyMemberParameters{
+					Role:      testRole,
+					Member:    &testMember,
+					Condition: testCondition,
+				},
+				ck: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{
+							Members: []string{
+								testMember,
+							},
+							Role: testRole,
+						},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+			want: want{
+				changed: true,
+				out: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{
+							Members: []string{
+								testMember,
+							},
+							Role: testRole,
+						},
+						{
+							Condition: testStorageCondition,
+							Members: []string{
+								testMember,
+							},
+							Role: testRole,
+						},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"ConditionalRoleAlreadyBoundToMember": {
+			args: args{
+				in: v1alpha1.BucketPolicyMemberParameters{
+					Role:      testRole,
+					Member:    &testMember,
+					Condition: testCondition,
+				},
+				ck: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{
+							Condition: testStorageCondition,
+							Members: []string{
+								testMember,
+							},
+							Role: testRole,
+						},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+			want: want{
+				changed: false,
+				out: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{
+							Condition: testStorageCondition,
+							Members: []string{
+								testMember,
+							},
+							Role: testRole,
+						},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"MultipleMembersPartiallyBound": {
+			args: args{
+				in: v1alpha1.BucketPolicyMemberParameters{
+					Role:    testRole,
+					Member:  &testMember,
+					Members: []string{testGroup, testMember, testUser},
+				},
+				ck: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{Members: []string{testGroup}, Role: testRole},
+					},
+				},
+			},
+			want: want{
+				changed: true,
+				out: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{Members: []string{testGroup, testMember, testUser}, Role: testRole},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"MultipleMembersAlreadyBound": {
+			args: args{
+				in: v1alpha1.BucketPolicyMemberParameters{
+					Role:    testRole,
+					Members: []string{testGroup, testUser},
+				},
+				ck: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{Members: []string{testUser, testMember, testGroup}, Role: testRole},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+			want: want{
+				changed: false,
+				out: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{Members: []string{testUser, testMember, testGroup}, Role: testRole},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"ObjectPrefixCondition": {
+			args: args{
+				in: v1alpha1.BucketPolicyMemberParameters{
+					Bucket:                gcp.StringPtr("shared"),
+					Role:                  testRole,
+					Member:                &testMember,
+					ObjectPrefixCondition: &v1alpha1.ObjectPrefixCondition{Prefixes: []string{"team-a/"}},
+				},
+				ck: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{Members: []string{testMember}, Role: testRole},
+					},
+				},
+			},
+			want: want{
+				changed: true,
+				out: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{Members: []string{testMember}, Role: testRole},
+						{
+							Members: []string{testMember},
+							Role:    testRole,
+							Condition: &storage.Expr{
+								Title:      DefaultObjectPrefixTitle,
+								Expression: `resource.name.startsWith("projects/_/buckets/shared/objects/team-a/")`,
+							},
+						},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -336,6 +507,76 @@ func TestUnbindRoleFromMember(t *testing.T) {
 				},
 			},
 		},
+		"OnlyConditionalRoleUnbound": {
+			args: args{
+				in: v1alpha1.BucketPolicyMemberParameters{
+					Role:      testRole,
+					Member:    &testMember,
+					Condition: testCondition,
+				},
+				ck: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{
+							Members: []string{
+								testMember,
+							},
+							Role: testRole,
+						},
+						{
+							Condition: testStorageCondition,
+							Members: []string{
+								testMember,
+							},
+							Role: testRole,
+						},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+			want: want{
+				changed: true,
+				out: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{
+							Members: []string{
+								testMember,
+							},
+							Role: testRole,
+						},
+						{
+							Condition: testStorageCondition,
+							Members:   []string{},
+							Role:      testRole,
+						},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"MultipleMembers": {
+			args: args{
+				in: v1alpha1.BucketPolicyMemberParameters{
+					Role:    testRole,
+					Member:  &testMember,
+					Members: []string{testGroup, testUser},
+				},
+				ck: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{Members: []string{testUser, "user:bob@example.com", testMember}, Role: testRole},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+			want: want{
+				changed: true,
+				out: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{Members: []string{"user:bob@example.com"}, Role: testRole},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -349,3 +590,416 @@ func TestUnbindRoleFromMember(t *testing.T) {
 		})
 	}
 }
+
+func TestMembers(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.BucketPolicyMemberParameters
+		want []string
+	}{
+		"None": {
+			want: []string{},
+		},
+		"Member": {
+			in:   v1alpha1.BucketPolicyMemberParameters{Member: &testMember},
+			want: []string{testMember},
+		},
+		"Union": {
+			in:   v1alpha1.BucketPolicyMemberParameters{Member: &testMember, Members: []string{testGroup, testMember, testGroup}},
+			want: []string{testMember, testGroup},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Members(tc.in)); diff != "" {
+				t.Errorf("Members(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectPrefixExpression(t *testing.T) {
+	cases := map[string]struct {
+		prefixes []string
+		want     string
+	}{
+		"OnePrefix": {
+			prefixes: []string{"reports/"},
+			want:     `resource.name.startsWith("projects/_/buckets/b/objects/reports/")`,
+		},
+		"ManyPrefixes": {
+			prefixes: []string{"a/", "b/"},
+			want:     `resource.name.startsWith("projects/_/buckets/b/objects/a/") || resource.name.startsWith("projects/_/buckets/b/objects/b/")`,
+		},
+		"Quoted": {
+			prefixes: []string{`say "hi"/`},
+			want:     `resource.name.startsWith("projects/_/buckets/b/objects/say \"hi\"/")`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ObjectPrefixExpression("b", tc.prefixes)); diff != "" {
+				t.Errorf("ObjectPrefixExpression(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStaleMembers(t *testing.T) {
+	in := v1alpha1.BucketPolicyMemberParameters{Member: &testMember, Members: []string{testGroup}}
+	cases := map[string]struct {
+		bound []string
+		want  []string
+	}{
+		"NoneBound": {},
+		"AllDeclared": {
+			bound: []string{testMember, testGroup},
+		},
+		"MemberRemoved": {
+			bound: []string{testMember, testUser, testGroup},
+			want:  []string{testUser},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, StaleMembers(in, tc.bound)); diff != "" {
+				t.Errorf("StaleMembers(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnedMembers(t *testing.T) {
+	in := v1alpha1.BucketPolicyMemberParameters{Role: testRole, Member: &testMember, Members: []string{testGroup}}
+	got := OwnedMembers(in, []string{testMember, testUser})
+	if diff := cmp.Diff([]string{testMember, testGroup, testUser}, got); diff != "" {
+		t.Errorf("OwnedMembers(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsRoleBound(t *testing.T) {
+	in := v1alpha1.BucketPolicyMemberParameters{Role: testRole, Member: &testMember}
+	cases := map[string]struct {
+		members []string
+		policy  *storage.Policy
+		want    bool
+	}{
+		"Bound": {
+			members: []string{testMember, testUser},
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testUser}},
+			}},
+			want: true,
+		},
+		"OtherRole": {
+			members: []string{testMember},
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: "roles/storage.objectViewer", Members: []string{testMember}},
+			}},
+			want: false,
+		},
+		"OtherCondition": {
+			members: []string{testMember},
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testMember}, Condition: testStorageCondition},
+			}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsRoleBound(in, tc.members, tc.policy)); diff != "" {
+				t.Errorf("IsRoleBound(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnbindRoleFromMembers(t *testing.T) {
+	in := v1alpha1.BucketPolicyMemberParameters{Role: testRole, Member: &testMember}
+	sp := &storage.Policy{
+		Bindings: []*storage.PolicyBindings{
+			{Members: []string{testMember, testUser, testGroup}, Role: testRole},
+		},
+	}
+	if !UnbindRoleFromMembers(in, []string{testUser}, sp) {
+		t.Errorf("UnbindRoleFromMembers(...): want changed")
+	}
+	want := &storage.Policy{
+		Bindings: []*storage.PolicyBindings{
+			{Members: []string{testMember, testGroup}, Role: testRole},
+		},
+	}
+	if diff := cmp.Diff(want, sp); diff != "" {
+		t.Errorf("UnbindRoleFromMembers(...): -want policy, +got policy: %s", diff)
+	}
+}
+
+func TestIsErrorConflict(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":                {},
+		"PreconditionFailed": {err: &googleapi.Error{Code: http.StatusPreconditionFailed}, want: true},
+		"Conflict":           {err: &googleapi.Error{Code: http.StatusConflict}, want: true},
+		"Wrapped":            {err: errors.Wrap(&googleapi.Error{Code: http.StatusPreconditionFailed}, "boom"), want: true},
+		"Other":              {err: &googleapi.Error{Code: http.StatusInternalServerError}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsErrorConflict(tc.err)); diff != "" {
+				t.Errorf("IsErrorConflict(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasBindings(t *testing.T) {
+	declared := v1alpha1.BucketPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+		{Role: testRole, Members: []string{testMember}},
+	}}}
+	cases := map[string]struct {
+		policy *storage.Policy
+		want   bool
+	}{
+		"DeclaredMemberBound": {
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testMember, testUser}},
+			}},
+			want: true,
+		},
+		"OnlyForeignBindings": {
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testUser}},
+				{Role: "roles/storage.legacyBucketOwner", Members: []string{testMember}},
+			}},
+			want: false,
+		},
+		"OtherCondition": {
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testMember}, Condition: testStorageCondition},
+			}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, HasBindings(declared, tc.policy)); diff != "" {
+				t.Errorf("HasBindings(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveBindings(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *storage.Policy
+	}
+	cases := map[string]struct {
+		in     v1alpha1.BucketPolicyParameters
+		policy *storage.Policy
+		want   want
+	}{
+		"KeepForeignBindings": {
+			in: v1alpha1.BucketPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testMember, "user:someone@example.com"}},
+				{Role: "roles/storage.objectViewer", Members: []string{testMember}},
+			}},
+			want: want{
+				changed: true,
+				policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+					{Role: testRole, Members: []string{"user:someone@example.com"}},
+					{Role: "roles/storage.objectViewer", Members: []string{testMember}},
+				}},
+			},
+		},
+		"DropEmptyBinding": {
+			in: v1alpha1.BucketPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testMember}},
+			}},
+			want: want{
+				changed: true,
+				policy:  &storage.Policy{Bindings: []*storage.PolicyBindings{}},
+			},
+		},
+		"KeepBindingWithOtherCondition": {
+			in: v1alpha1.BucketPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testMember}, Condition: testStorageCondition},
+			}},
+			want: want{
+				policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+					{Role: testRole, Members: []string{testMember}, Condition: testStorageCondition},
+				}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := RemoveBindings(tc.in, tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("RemoveBindings(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("RemoveBindings(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeBindings(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *storage.Policy
+	}
+	cases := map[string]struct {
+		in     v1alpha1.BucketPolicyParameters
+		policy *storage.Policy
+		want   want
+	}{
+		"AddToForeignBindings": {
+			in: v1alpha1.BucketPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+				{Role: testRole, Members: []string{testMember}},
+				{Role: "roles/storage.objectViewer", Members: []string{"allUsers"}, Condition: testCondition},
+			}}},
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{"user:someone@example.com"}},
+				{Role: "roles/storage.legacyBucketOwner", Members: []string{"projectOwner:my-project"}},
+			}},
+			want: want{
+				changed: true,
+				policy: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{Role: testRole, Members: []string{"user:someone@example.com", testMember}},
+						{Role: "roles/storage.legacyBucketOwner", Members: []string{"projectOwner:my-project"}},
+						{Role: "roles/storage.objectViewer", Members: []string{"allUsers"}, Condition: testStorageCondition},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"AlreadyMerged": {
+			in: v1alpha1.BucketPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			policy: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{"user:someone@example.com", testMember}},
+			}},
+			want: want{
+				policy: &storage.Policy{
+					Bindings: []*storage.PolicyBindings{
+						{Role: testRole, Members: []string{"user:someone@example.com", testMember}},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := MergeBindings(tc.in, tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("MergeBindings(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("MergeBindings(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	merge := v1alpha1.PolicyModeMerge
+	declared := iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+		{Role: testRole, Members: []string{testMember}},
+	}}
+	withForeign := &storage.Policy{Bindings: []*storage.PolicyBindings{
+		{Role: testRole, Members: []string{testMember}},
+		{Role: "roles/storage.objectViewer", Members: []string{"user:someone@example.com"}},
+	}}
+
+	cases := map[string]struct {
+		in       v1alpha1.BucketPolicyParameters
+		observed *storage.Policy
+		want     bool
+	}{
+		"AuthoritativeForeignBinding": {
+			in:       v1alpha1.BucketPolicyParameters{Policy: declared},
+			observed: withForeign,
+			want:     false,
+		},
+		"MergeForeignBinding": {
+			in:       v1alpha1.BucketPolicyParameters{Mode: &merge, Policy: declared},
+			observed: withForeign,
+			want:     true,
+		},
+		"MergeMissingMember": {
+			in: v1alpha1.BucketPolicyParameters{Mode: &merge, Policy: declared},
+			observed: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: "roles/storage.objectViewer", Members: []string{"user:someone@example.com"}},
+			}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(&tc.in, tc.observed)
+			if err != nil {
+				t.Errorf("IsUpToDate(...): unexpected error %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestArePoliciesSame(t *testing.T) {
+	otherCondition := &storage.Expr{Title: "logs", Expression: `resource.name.startsWith("projects/_/buckets/b/objects/logs/")`}
+
+	cases := map[string]struct {
+		p1   *storage.Policy
+		p2   *storage.Policy
+		want bool
+	}{
+		"ConditionalBindingsReordered": {
+			p1: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testMember}, Condition: testStorageCondition},
+				{Role: testRole, Members: []string{testUser}, Condition: otherCondition},
+			}},
+			p2: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testUser}, Condition: otherCondition},
+				{Role: testRole, Members: []string{testMember}, Condition: testStorageCondition},
+			}},
+			want: true,
+		},
+		"ConditionalBindingsDiffer": {
+			p1: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testMember}, Condition: testStorageCondition},
+				{Role: testRole, Members: []string{testUser}, Condition: otherCondition},
+			}},
+			p2: &storage.Policy{Bindings: []*storage.PolicyBindings{
+				{Role: testRole, Members: []string{testMember}, Condition: otherCondition},
+				{Role: testRole, Members: []string{testUser}, Condition: testStorageCondition},
+			}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ArePoliciesSame(tc.p1, tc.p2)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ArePoliciesSame(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketpolicy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+)
+
+// ReasonInvalidBindings indicates that a policy cannot be set because some
+// of its bindings are invalid.
+const ReasonInvalidBindings xpv1.ConditionReason = "InvalidBindings"
+
+const (
+	errFmtInvalidRole   = "binding %d: role %q must be roles/*, projects/*/roles/* or organizations/*/roles/*"
+	errFmtInvalidMember = "binding %d: member %q must be allUsers, allAuthenticatedUsers or start with one of %s"
+)
+
+// role matches predefined and custom IAM role names.
+var role = regexp.MustCompile(`^(roles|(projects|organizations)/[^/]+/roles)/[a-zA-Z0-9_.]+$`)
+
+// memberPrefixes are the prefixes of members that identify a principal.
+var memberPrefixes = []string{
+	"user:", "serviceAccount:", "group:", "domain:", "deleted:",
+	"principal://", "principalSet://",
+	"projectOwner:", "projectEditor:", "projectViewer:",
+}
+
+// ValidateBindings returns an error describing every binding of the supplied
+// parameters whose role or members are malformed.
+func ValidateBindings(in v1alpha1.BucketPolicyParameters) error {
+	var msgs []string
+	for i, b := range in.Policy.Bindings {
+		if !role.MatchString(b.Role) {
+			msgs = append(msgs, fmt.Sprintf(errFmtInvalidRole, i, b.Role))
+		}
+		for _, m := range b.Members {
+			if !validMember(m) {
+				msgs = append(msgs, fmt.Sprintf(errFmtInvalidMember, i, m, strings.Join(memberPrefixes, ", ")))
+			}
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+func validMember(m string) bool {
+	if m == "allUsers" || m == "allAuthenticatedUsers" {
+		return true
+	}
+	for _, p := range memberPrefixes {
+		if strings.HasPrefix(m, p) && len(m) > len(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// InvalidBindings returns a condition indicating that the policy cannot be
+// set because of the supplied validation error.
+func InvalidBindings(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonInvalidBindings,
+		Message:            err.Error(),
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketpolicy
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+)
+
+func withBindings(b ...*iamv1alpha1.Binding) v1alpha1.BucketPolicyParameters {
+	return v1alpha1.BucketPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: b}}
+}
+
+func TestValidateBindings(t *testing.T) {
+	prefixes := strings.Join(memberPrefixes, ", ")
+
+	cases := map[string]struct {
+		in   v1alpha1.BucketPolicyParameters
+		want error
+	}{
+		"NoBindings": {
+			in: withBindings(),
+		},
+		"Valid": {
+			in: withBindings(
+				&iamv1alpha1.Binding{Role: "roles/storage.objectViewer", Members: []string{"allUsers", "allAuthenticatedUsers"}},
+				&iamv1alpha1.Binding{Role: "projects/my-project/roles/bucketReader", Members: []string{
+					"user:alice@example.com",
+					"serviceAccount:sa@my-project.iam.gserviceaccount.com",
+					"group:admins@example.com",
+					"domain:example.com",
+					"projectViewer:my-project",
+				}},
+				&iamv1alpha1.Binding{Role: "organizations/123/roles/custom_role.v2", Members: []string{"deleted:user:bob@example.com?uid=1"}},
+			),
+		},
+		"InvalidRole": {
+			in:   withBindings(&iamv1alpha1.Binding{Role: "storage.admin", Members: []string{"allUsers"}}),
+			want: errors.New(fmt.Sprintf(errFmtInvalidRole, 0, "storage.admin")),
+		},
+		"InvalidMembers": {
+			in: withBindings(
+				&iamv1alpha1.Binding{Role: "roles/storage.admin", Members: []string{"alice@example.com", "user:"}},
+			),
+			want: errors.New(strings.Join([]string{
+				fmt.Sprintf(errFmtInvalidMember, 0, "alice@example.com", prefixes),
+				fmt.Sprintf(errFmtInvalidMember, 0, "user:", prefixes),
+			}, "; ")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateBindings(tc.in)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateBindings(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
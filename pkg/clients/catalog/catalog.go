@@ -0,0 +1,235 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package catalog validates regions, zones and machine types against the live
+// Google Compute Engine catalog, so that typos surface as actionable errors
+// rather than as opaque 404s from the Google API.
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const (
+	// DefaultTTL is the default duration for which catalog listings are
+	// cached.
+	DefaultTTL = time.Hour
+
+	// maxSuggestions is the maximum number of valid values listed in a
+	// validation error.
+	maxSuggestions = 5
+
+	errListRegions      = "cannot list compute regions"
+	errListZones        = "cannot list compute zones"
+	errListMachineTypes = "cannot list compute machine types"
+
+	errUnknownFmt = "%s %q is not available in project %q; did you mean one of: %s"
+)
+
+type unknownError struct {
+	msg string
+}
+
+func (e unknownError) Error() string {
+	return e.msg
+}
+
+// IsUnknown returns true if the supplied error indicates that a value does not
+// exist in the catalog, as opposed to the catalog being unavailable.
+func IsUnknown(err error) bool {
+	var u unknownError
+	return errors.As(err, &u)
+}
+
+// A Lister lists the entries of the Google Compute Engine catalog.
+type Lister interface {
+	Regions(ctx context.Context, project string) ([]string, error)
+	Zones(ctx context.Context, project string) ([]string, error)
+	MachineTypes(ctx context.Context, project, zone string) ([]string, error)
+}
+
+// NewComputeLister returns a Lister backed by the supplied compute service.
+func NewComputeLister(s *compute.Service) Lister {
+	return &computeLister{s: s}
+}
+
+type computeLister struct {
+	s *compute.Service
+}
+
+func (l *computeLister) Regions(ctx context.Context, project string) ([]string, error) {
+	var names []string
+	err := l.s.Regions.List(project).Fields("items/name", "nextPageToken").Pages(ctx, func(p *compute.RegionList) error {
+		for _, r := range p.Items {
+			names = append(names, r.Name)
+		}
+		return nil
+	})
+	return names, errors.Wrap(err, errListRegions)
+}
+
+func (l *computeLister) Zones(ctx context.Context, project string) ([]string, error) {
+	var names []string
+	err := l.s.Zones.List(project).Fields("items/name", "nextPageToken").Pages(ctx, func(p *compute.ZoneList) error {
+		for _, z := range p.Items {
+			names = append(names, z.Name)
+		}
+		return nil
+	})
+	return names, errors.Wrap(err, errListZones)
+}
+
+func (l *computeLister) MachineTypes(ctx context.Context, project, zone string) ([]string, error) {
+	var names []string
+	err := l.s.MachineTypes.List(project, zone).Fields("items/name", "nextPageToken").Pages(ctx, func(p *compute.MachineTypeList) error {
+		for _, m := range p.Items {
+			names = append(names, m.Name)
+		}
+		return nil
+	})
+	return names, errors.Wrap(err, errListMachineTypes)
+}
+
+type entry struct {
+	names   map[string]bool
+	expires time.Time
+}
+
+// A Validator validates values against a cached copy of the catalog.
+type Validator struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]entry
+}
+
+// NewValidator returns a Validator that caches catalog listings for the
+// supplied duration.
+func NewValidator(ttl time.Duration) *Validator {
+	return &Validator{ttl: ttl, now: time.Now, cache: map[string]entry{}}
+}
+
+// ValidateRegion returns an error if the supplied region, which may be a name
+// or a URL, does not exist in the supplied project.
+func (v *Validator) ValidateRegion(ctx context.Context, l Lister, project, region string) error {
+	names, err := v.get(ctx, "regions/"+project, func() ([]string, error) { return l.Regions(ctx, project) })
+	if err != nil {
+		return err
+	}
+	return check("region", project, path.Base(region), names)
+}
+
+// ValidateZone returns an error if the supplied zone, which may be a name or a
+// URL, does not exist in the supplied project.
+func (v *Validator) ValidateZone(ctx context.Context, l Lister, project, zone string) error {
+	names, err := v.get(ctx, "zones/"+project, func() ([]string, error) { return l.Zones(ctx, project) })
+	if err != nil {
+		return err
+	}
+	return check("zone", project, path.Base(zone), names)
+}
+
+// ValidateLocation returns an error if the supplied location is neither a
+// region nor a zone of the supplied project.
+func (v *Validator) ValidateLocation(ctx context.Context, l Lister, project, location string) error {
+	if v.ValidateZone(ctx, l, project, location) == nil {
+		return nil
+	}
+	return v.ValidateRegion(ctx, l, project, location)
+}
+
+// ValidateMachineType returns an error if the supplied machine type, which may
+// be a name or a URL, is not available in the supplied zone. Custom machine
+// types are not listed by the catalog and are always considered valid.
+func (v *Validator) ValidateMachineType(ctx context.Context, l Lister, project, zone, machineType string) error {
+	mt := path.Base(machineType)
+	if strings.Contains(mt, "custom-") {
+		return nil
+	}
+	zone = path.Base(zone)
+	names, err := v.get(ctx, "machineTypes/"+project+"/"+zone, func() ([]string, error) { return l.MachineTypes(ctx, project, zone) })
+	if err != nil {
+		return err
+	}
+	return check("machine type", project, mt, names)
+}
+
+func (v *Validator) get(_ context.Context, key string, list func() ([]string, error)) (map[string]bool, error) {
+	v.mu.Lock()
+	e, ok := v.cache[key]
+	v.mu.Unlock()
+	if ok && v.now().Before(e.expires) {
+		return e.names, nil
+	}
+	l, err := list()
+	if err != nil {
+		return nil, err
+	}
+	e = entry{names: make(map[string]bool, len(l)), expires: v.now().Add(v.ttl)}
+	for _, n := range l {
+		e.names[n] = true
+	}
+	v.mu.Lock()
+	v.cache[key] = e
+	v.mu.Unlock()
+	return e.names, nil
+}
+
+func check(kind, project, name string, names map[string]bool) error {
+	if names[name] {
+		return nil
+	}
+	return unknownError{msg: fmt.Sprintf(errUnknownFmt, kind, name, project, strings.Join(suggest(name, names), ", "))}
+}
+
+// suggest returns the valid names that share the longest prefix with the
+// supplied, invalid, name.
+func suggest(name string, names map[string]bool) []string {
+	all := make([]string, 0, len(names))
+	for n := range names {
+		all = append(all, n)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		pi, pj := commonPrefix(name, all[i]), commonPrefix(name, all[j])
+		if pi != pj {
+			return pi > pj
+		}
+		return all[i] < all[j]
+	})
+	if len(all) > maxSuggestions {
+		all = all[:maxSuggestions]
+	}
+	return all
+}
+
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
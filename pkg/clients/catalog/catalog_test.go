@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	containerv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testProject = "cool-project"
+
+var errBoom = errors.New("boom")
+
+type mockLister struct {
+	calls int
+	err   error
+}
+
+func (l *mockLister) Regions(_ context.Context, _ string) ([]string, error) {
+	l.calls++
+	return []string{"us-central1", "us-east1", "europe-west1"}, l.err
+}
+
+func (l *mockLister) Zones(_ context.Context, _ string) ([]string, error) {
+	l.calls++
+	return []string{"us-central1-a", "us-central1-b", "europe-west1-b"}, l.err
+}
+
+func (l *mockLister) MachineTypes(_ context.Context, _, _ string) ([]string, error) {
+	l.calls++
+	return []string{"e2-medium", "n2-standard-4"}, l.err
+}
+
+func TestValidate(t *testing.T) {
+	type want struct {
+		unknown bool
+		err     bool
+	}
+	cases := map[string]struct {
+		loc    Location
+		lister *mockLister
+		want   want
+	}{
+		"ValidRegion": {
+			loc:    Location{Region: "us-central1"},
+			lister: &mockLister{},
+		},
+		"ValidRegionURL": {
+			loc:    Location{Region: "https://www.googleapis.com/compute/v1/projects/p/regions/us-east1"},
+			lister: &mockLister{},
+		},
+		"UnknownRegion": {
+			loc:    Location{Region: "us-centrall"},
+			lister: &mockLister{},
+			want:   want{unknown: true, err: true},
+		},
+		"UnknownZone": {
+			loc:    Location{Zone: "us-central1-z"},
+			lister: &mockLister{},
+			want:   want{unknown: true, err: true},
+		},
+		"RegionalLocation": {
+			loc:    Location{Location: "europe-west1", MachineType: "not-validated"},
+			lister: &mockLister{},
+		},
+		"ZonalLocationMachineType": {
+			loc:    Location{Location: "us-central1-a", MachineType: "n2-standard-4"},
+			lister: &mockLister{},
+		},
+		"UnknownMachineType": {
+			loc:    Location{Zone: "us-central1-a", MachineType: "n2-standart-4"},
+			lister: &mockLister{},
+			want:   want{unknown: true, err: true},
+		},
+		"CustomMachineType": {
+			loc:    Location{Zone: "us-central1-a", MachineType: "custom-4-8192"},
+			lister: &mockLister{},
+		},
+		"CatalogUnavailable": {
+			loc:    Location{Region: "us-central1"},
+			lister: &mockLister{err: errBoom},
+			want:   want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewValidator(time.Hour).Validate(context.Background(), tc.lister, testProject, tc.loc)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("Validate(...): -want error, +got error:\n%s\n%v", diff, err)
+			}
+			if diff := cmp.Diff(tc.want.unknown, IsUnknown(err)); diff != "" {
+				t.Errorf("IsUnknown(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidatorCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	v := NewValidator(time.Hour)
+	v.now = func() time.Time { return now }
+	l := &mockLister{}
+
+	for i := 0; i < 3; i++ {
+		_ = v.ValidateRegion(context.Background(), l, testProject, "us-central1")
+	}
+	if l.calls != 1 {
+		t.Errorf("ValidateRegion(...): want 1 catalog listing, got %d", l.calls)
+	}
+
+	now = now.Add(time.Hour)
+	_ = v.ValidateRegion(context.Background(), l, testProject, "us-central1")
+	if l.calls != 2 {
+		t.Errorf("ValidateRegion(...): want catalog listing to expire, got %d listings", l.calls)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	err := check("region", testProject, "us-centrall", map[string]bool{"us-central1": true, "europe-west1": true})
+	want := `region "us-centrall" is not available in project "cool-project"; did you mean one of: us-central1, europe-west1`
+	if diff := cmp.Diff(want, err.Error()); diff != "" {
+		t.Errorf("check(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLocationOf(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want Location
+	}{
+		"Subnetwork": {
+			mg:   &v1beta1.Subnetwork{Spec: v1beta1.SubnetworkSpec{ForProvider: v1beta1.SubnetworkParameters{Region: "us-central1"}}},
+			want: Location{Region: "us-central1"},
+		},
+		"ZonalAutoscaler": {
+			mg:   &v1alpha1.Autoscaler{Spec: v1alpha1.AutoscalerSpec{ForProvider: v1alpha1.AutoscalerParameters{Zone: gcp.StringPtr("us-central1-a")}}},
+			want: Location{Zone: "us-central1-a"},
+		},
+		"Instance": {
+			mg:   &v1alpha1.Instance{Spec: v1alpha1.InstanceSpec{ForProvider: v1alpha1.InstanceParameters{Zone: "us-central1-a", MachineType: "e2-small"}}},
+			want: Location{Zone: "us-central1-a", MachineType: "e2-small"},
+		},
+		"ZonalNodePool": {
+			mg: &containerv1beta1.NodePool{Spec: containerv1beta1.NodePoolSpec{ForProvider: containerv1beta1.NodePoolParameters{
+				Cluster: "projects/p/zones/us-central1-a/clusters/c",
+				Config:  &containerv1beta1.NodeConfig{MachineType: gcp.StringPtr("e2-medium")},
+			}}},
+			want: Location{Location: "us-central1-a", MachineType: "e2-medium"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, LocationOf(tc.mg)); diff != "" {
+				t.Errorf("LocationOf(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"context"
+	"strings"
+
+	compute "google.golang.org/api/compute/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	containerv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// validator is shared by all controllers so that they share a single cached
+// copy of the catalog.
+var validator = NewValidator(DefaultTTL)
+
+// A Location is the set of catalog values a managed resource refers to. Empty
+// values are not validated.
+type Location struct {
+	Region string
+	Zone   string
+
+	// Either a region or a zone.
+	Location string
+
+	// MachineType is validated only when Zone is set.
+	MachineType string
+}
+
+// LocationOf returns the Location of the supplied managed resource.
+func LocationOf(mg resource.Managed) Location { // nolint:gocyclo
+	switch cr := mg.(type) {
+	case *v1beta1.Address:
+		return Location{Region: cr.Spec.ForProvider.Region}
+	case *v1beta1.Subnetwork:
+		return Location{Region: cr.Spec.ForProvider.Region}
+	case *v1alpha1.Router:
+		return Location{Region: cr.Spec.ForProvider.Region}
+	case *v1alpha1.RouterNAT:
+		return Location{Region: cr.Spec.ForProvider.Region}
+	case *v1alpha1.RouterPeer:
+		return Location{Region: cr.Spec.ForProvider.Region}
+	case *v1alpha1.NetworkEndpointGroup:
+		return Location{Region: gcp.StringValue(cr.Spec.ForProvider.Region), Zone: gcp.StringValue(cr.Spec.ForProvider.Zone)}
+	case *v1alpha1.InstanceGroupManager:
+		return Location{Region: gcp.StringValue(cr.Spec.ForProvider.Region), Zone: gcp.StringValue(cr.Spec.ForProvider.Zone)}
+	case *v1alpha1.Autoscaler:
+		return Location{Region: gcp.StringValue(cr.Spec.ForProvider.Region), Zone: gcp.StringValue(cr.Spec.ForProvider.Zone)}
+	case *v1alpha1.PacketMirroring:
+		return Location{Region: cr.Spec.ForProvider.Region}
+	case *v1alpha1.Instance:
+		return Location{Zone: cr.Spec.ForProvider.Zone, MachineType: cr.Spec.ForProvider.MachineType}
+	case *v1alpha1.Disk:
+		return Location{Region: gcp.StringValue(cr.Spec.ForProvider.Region), Zone: gcp.StringValue(cr.Spec.ForProvider.Zone)}
+	case *v1alpha1.ResourcePolicy:
+		return Location{Region: cr.Spec.ForProvider.Region}
+	case *v1alpha1.ImageImport:
+		return Location{Zone: gcp.StringValue(cr.Spec.ForProvider.Zone)}
+	case *v1beta2.Cluster:
+		return Location{Location: cr.Spec.ForProvider.Location}
+	case *containerv1beta1.NodePool:
+		l := Location{Location: clusterLocation(cr.Spec.ForProvider.Cluster)}
+		if cr.Spec.ForProvider.Config != nil {
+			l.MachineType = gcp.StringValue(cr.Spec.ForProvider.Config.MachineType)
+		}
+		return l
+	}
+	return Location{}
+}
+
+// clusterLocation returns the location segment of a GKE cluster resource
+// link, which uses either /locations/ or, for zonal clusters, /zones/.
+func clusterLocation(cluster string) string {
+	parts := strings.Split(strings.Trim(cluster, "/"), "/")
+	for i := 0; i+1 < len(parts); i++ {
+		if parts[i] == "locations" || parts[i] == "zones" {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// Validate returns an error if any value of the supplied Location is not
+// available in the supplied project.
+func (v *Validator) Validate(ctx context.Context, l Lister, project string, loc Location) error {
+	if loc.Region != "" {
+		if err := v.ValidateRegion(ctx, l, project, loc.Region); err != nil {
+			return err
+		}
+	}
+	if loc.Location != "" {
+		if err := v.ValidateLocation(ctx, l, project, loc.Location); err != nil {
+			return err
+		}
+		// Machine types are zonal, so they can only be checked when the
+		// location is a zone.
+		if v.ValidateZone(ctx, l, project, loc.Location) == nil {
+			loc.Zone = loc.Location
+		}
+	}
+	if loc.Zone != "" {
+		if err := v.ValidateZone(ctx, l, project, loc.Zone); err != nil {
+			return err
+		}
+		if loc.MachineType != "" {
+			return v.ValidateMachineType(ctx, l, project, loc.Zone, loc.MachineType)
+		}
+	}
+	return nil
+}
+
+// WrapConnecter returns an ExternalConnecter whose external clients validate
+// the Location of a managed resource against the catalog whenever the
+// resource is observed not to exist, before it is created. The supplied
+// ExternalConnecter is returned unchanged unless the catalog validation feature
+// is enabled.
+func WrapConnecter(o controller.Options, kube client.Client, c managed.ExternalConnecter) managed.ExternalConnecter {
+	if o.Features == nil || !o.Features.Enabled(features.EnableAlphaCatalogValidation) {
+		return c
+	}
+	return &connecter{kube: kube, wrapped: c}
+}
+
+type connecter struct {
+	kube    client.Client
+	wrapped managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.wrapped.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: e, kube: c.kube}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	kube client.Client
+}
+
+// Observe validates the Location of a managed resource that does not yet
+// exist. Values that are not in the catalog are reported as an error, which
+// the managed reconciler surfaces in the resource's Synced condition. Failing
+// to read the catalog is not an error; the resource is then created as usual.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil || o.ResourceExists || meta.WasDeleted(mg) {
+		return o, err
+	}
+	if err := e.validate(ctx, mg); IsUnknown(err) {
+		return managed.ExternalObservation{}, err
+	}
+	return o, nil
+}
+
+func (e *external) validate(ctx context.Context, mg resource.Managed) error {
+	loc := LocationOf(mg)
+	if loc == (Location{}) {
+		return nil
+	}
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, e.kube, mg)
+	if err != nil {
+		return err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	return validator.Validate(ctx, NewComputeLister(s), projectID, loc)
+}
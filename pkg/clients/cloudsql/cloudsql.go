@@ -18,7 +18,9 @@ package cloudsql
 
 import (
 	"strings"
+	"time"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -26,10 +28,18 @@ import (
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failover"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/utilization"
 )
 
-const errCheckUpToDate = "unable to determine if external resource is up to date"
+const (
+	errCheckUpToDate                = "unable to determine if external resource is up to date"
+	errIAMAuthenticationUnsupported = "iamAuthentication is only supported for MySQL and PostgreSQL instances"
+
+	instanceTypeReadReplica = "READ_REPLICA_INSTANCE"
+)
 
 // Cyclomatic complexity test is disabled for translation methods
 // because all they do is simple comparison & assignment without
@@ -40,8 +50,12 @@ const errCheckUpToDate = "unable to determine if external resource is up to date
 func GenerateDatabaseInstance(name string, in v1beta1.CloudSQLInstanceParameters, db *sqladmin.DatabaseInstance) { // nolint:gocyclo
 	db.DatabaseVersion = gcp.StringValue(in.DatabaseVersion)
 	db.GceZone = gcp.StringValue(in.GceZone)
-	db.InstanceType = gcp.StringValue(in.InstanceType)
-	db.MasterInstanceName = gcp.StringValue(in.MasterInstanceName)
+	// Promoting a replica changes its instance type and removes its master,
+	// so they are left as observed.
+	if !failover.Promoting(in.Failover) {
+		db.InstanceType = gcp.StringValue(in.InstanceType)
+		db.MasterInstanceName = gcp.StringValue(in.MasterInstanceName)
+	}
 	db.MaxDiskSize = gcp.Int64Value(in.MaxDiskSize)
 	db.Name = name
 	db.Region = in.Region
@@ -146,6 +160,51 @@ func GenerateDatabaseInstance(name string, in v1beta1.CloudSQLInstanceParameters
 			Value: val.Value,
 		}
 	}
+	if flag := IAMAuthenticationFlag(in); flag != "" && in.Settings.IAMAuthentication != nil {
+		db.Settings.DatabaseFlags = setDatabaseFlag(db.Settings.DatabaseFlags, flag, flagValue(*in.Settings.IAMAuthentication))
+	}
+}
+
+// IAMAuthenticationFlag returns the name of the database flag that enables
+// IAM database authentication for the database version of the supplied
+// instance, or an empty string if its database engine has no such flag.
+func IAMAuthenticationFlag(p v1beta1.CloudSQLInstanceParameters) string {
+	v := gcp.StringValue(p.DatabaseVersion)
+	switch {
+	case strings.HasPrefix(v, v1beta1.PostgresqlDBVersionPrefix):
+		return v1beta1.PostgresqlIAMAuthenticationFlag
+	case strings.HasPrefix(v, v1beta1.SqlserverDBVersionPrefix):
+		return ""
+	}
+	return v1beta1.MysqlIAMAuthenticationFlag
+}
+
+// ValidateIAMAuthentication returns an error if IAM database authentication
+// is configured for an instance whose database engine does not support it,
+// i.e. SQL Server.
+func ValidateIAMAuthentication(p v1beta1.CloudSQLInstanceParameters) error {
+	if p.Settings.IAMAuthentication != nil && IAMAuthenticationFlag(p) == "" {
+		return errors.New(errIAMAuthenticationUnsupported)
+	}
+	return nil
+}
+
+// setDatabaseFlag sets the supplied flag, replacing it if it is already set.
+func setDatabaseFlag(flags []*sqladmin.DatabaseFlags, name, value string) []*sqladmin.DatabaseFlags {
+	for _, f := range flags {
+		if f != nil && f.Name == name {
+			f.Value = value
+			return flags
+		}
+	}
+	return append(flags, &sqladmin.DatabaseFlags{Name: name, Value: value})
+}
+
+func flagValue(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
 }
 
 // GenerateObservation produces CloudSQLInstanceObservation object from *sqladmin.DatabaseInstance object.
@@ -182,6 +241,35 @@ func GenerateObservation(in sqladmin.DatabaseInstance) v1beta1.CloudSQLInstanceO
 	return o
 }
 
+// FailoverRole returns the role of the supplied instance in an active/standby
+// pair. Read replicas are standbys, all other instances are active.
+func FailoverRole(in sqladmin.DatabaseInstance) string {
+	if in.InstanceType == instanceTypeReadReplica || in.MasterInstanceName != "" {
+		return gcpv1beta1.FailoverRoleStandby
+	}
+	return gcpv1beta1.FailoverRoleActive
+}
+
+// FailoverPeers returns the names of the instances the supplied instance
+// replicates to or from.
+func FailoverPeers(in sqladmin.DatabaseInstance) []string {
+	if in.MasterInstanceName != "" {
+		// The master is reported as project:instance.
+		return []string{in.MasterInstanceName[strings.LastIndex(in.MasterInstanceName, ":")+1:]}
+	}
+	return in.ReplicaNames
+}
+
+// StorageUtilization returns the utilization of the data disk of the supplied
+// instance at the supplied time.
+func StorageUtilization(in sqladmin.DatabaseInstance, now time.Time) *gcpv1beta1.Utilization {
+	var capacity int64
+	if in.Settings != nil {
+		capacity = in.Settings.DataDiskSizeGb << 30
+	}
+	return utilization.New(gcpv1beta1.UtilizationUnitBytes, in.CurrentDiskSize, capacity, now)
+}
+
 // LateInitializeSpec fills unassigned fields with the values in sqladmin.DatabaseInstance object.
 func LateInitializeSpec(spec *v1beta1.CloudSQLInstanceParameters, in sqladmin.DatabaseInstance) { // nolint:gocyclo
 
@@ -365,3 +453,66 @@ func GetServerCACertificate(in sqladmin.DatabaseInstance) map[string][]byte {
 		v1beta1.CloudSQLSecretServerCACertificateSha1FingerprintKey:  []byte(in.ServerCaCert.Sha1Fingerprint),
 	}
 }
+
+// UsesCloudSQLProxy returns true if the connection details of the supplied
+// CloudSQLInstance should be formatted for a cloud-sql-proxy sidecar.
+func UsesCloudSQLProxy(cr *v1beta1.CloudSQLInstance) bool {
+	c := cr.Spec.ConnectionDetails
+	return c != nil && gcp.StringValue(c.Format) == v1beta1.ConnectionDetailsFormatCloudSQLProxy
+}
+
+// WantsClientCertificate returns true if a client certificate should be
+// generated and published for the supplied CloudSQLInstance.
+func WantsClientCertificate(cr *v1beta1.CloudSQLInstance) bool {
+	return UsesCloudSQLProxy(cr) && gcp.BoolValue(cr.Spec.ConnectionDetails.GenerateClientCertificate)
+}
+
+// ClientCertificateName returns the common name of the client certificate
+// generated for the instance with the supplied name.
+func ClientCertificateName(name string) string {
+	return name + "-cloudsql-proxy"
+}
+
+// FindClientCertificate returns the certificate with the supplied common
+// name in the supplied list, or nil if there is none.
+func FindClientCertificate(certs []*sqladmin.SslCert, commonName string) *sqladmin.SslCert {
+	for _, c := range certs {
+		if c != nil && c.CommonName == commonName {
+			return c
+		}
+	}
+	return nil
+}
+
+// DefaultPort returns the port the database engine of the supplied
+// instance listens on.
+func DefaultPort(p v1beta1.CloudSQLInstanceParameters) string {
+	if strings.HasPrefix(gcp.StringValue(p.DatabaseVersion), v1beta1.PostgresqlDBVersionPrefix) {
+		return "5432"
+	}
+	return "3306"
+}
+
+// GetProxyConnectionDetails returns the connection details an application
+// needs to connect to the supplied instance through a cloud-sql-proxy
+// sidecar.
+func GetProxyConnectionDetails(p v1beta1.CloudSQLInstanceParameters, in sqladmin.DatabaseInstance) map[string][]byte {
+	return map[string][]byte{
+		v1beta1.CloudSQLSecretInstanceConnectionNameKey: []byte(in.ConnectionName),
+		xpv1.ResourceCredentialsSecretEndpointKey:       []byte(v1beta1.CloudSQLProxyEndpoint),
+		xpv1.ResourceCredentialsSecretPortKey:           []byte(DefaultPort(p)),
+	}
+}
+
+// GetClientCertificate takes a newly created client certificate and returns
+// it in a form that can be embedded directly into a connection secret. The
+// private key is only ever returned when the certificate is created.
+func GetClientCertificate(in *sqladmin.SslCertDetail) map[string][]byte {
+	if in == nil || in.CertInfo == nil {
+		return nil
+	}
+	return map[string][]byte{
+		v1beta1.CloudSQLSecretClientCertificateKey: []byte(in.CertInfo.Cert),
+		v1beta1.CloudSQLSecretClientKeyKey:         []byte(in.CertPrivateKey),
+	}
+}
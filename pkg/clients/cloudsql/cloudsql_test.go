@@ -17,13 +17,22 @@ limitations under the License.
 package cloudsql
 
 import (
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	xptest "github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/test"
 )
 
 const (
@@ -278,6 +287,43 @@ func TestGenerateDatabaseInstance(t *testing.T) {
 				db.GceZone = ""
 			})},
 		},
+		"IAMAuthentication": {
+			args: args{
+				name: name,
+				params: *params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.DatabaseVersion = gcp.StringPtr("POSTGRES_14")
+					p.Settings.IAMAuthentication = gcp.BoolPtr(true)
+				})},
+			want: want{db: db(func(db *sqladmin.DatabaseInstance) {
+				db.DatabaseVersion = "POSTGRES_14"
+				db.Settings.DatabaseFlags = append(db.Settings.DatabaseFlags, &sqladmin.DatabaseFlags{
+					Name:  v1beta1.PostgresqlIAMAuthenticationFlag,
+					Value: "on",
+				})
+			})},
+		},
+		"IAMAuthenticationOverridesFlag": {
+			args: args{
+				name: name,
+				params: *params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.Settings.DatabaseFlags = []*v1beta1.DatabaseFlags{{Name: v1beta1.MysqlIAMAuthenticationFlag, Value: "on"}}
+					p.Settings.IAMAuthentication = gcp.BoolPtr(false)
+				})},
+			want: want{db: db(func(db *sqladmin.DatabaseInstance) {
+				db.Settings.DatabaseFlags = []*sqladmin.DatabaseFlags{{Name: v1beta1.MysqlIAMAuthenticationFlag, Value: "off"}}
+			})},
+		},
+		"IAMAuthenticationUnsupported": {
+			args: args{
+				name: name,
+				params: *params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.DatabaseVersion = gcp.StringPtr("SQLSERVER_2019_STANDARD")
+					p.Settings.IAMAuthentication = gcp.BoolPtr(true)
+				})},
+			want: want{db: db(func(db *sqladmin.DatabaseInstance) {
+				db.DatabaseVersion = "SQLSERVER_2019_STANDARD"
+			})},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -290,6 +336,44 @@ func TestGenerateDatabaseInstance(t *testing.T) {
 	}
 }
 
+func TestValidateIAMAuthentication(t *testing.T) {
+	cases := map[string]struct {
+		params v1beta1.CloudSQLInstanceParameters
+		want   error
+	}{
+		"NotSet": {
+			params: v1beta1.CloudSQLInstanceParameters{DatabaseVersion: gcp.StringPtr("SQLSERVER_2019_STANDARD")},
+		},
+		"MySQL": {
+			params: v1beta1.CloudSQLInstanceParameters{
+				DatabaseVersion: gcp.StringPtr("MYSQL_8_0"),
+				Settings:        v1beta1.Settings{IAMAuthentication: gcp.BoolPtr(true)},
+			},
+		},
+		"PostgreSQL": {
+			params: v1beta1.CloudSQLInstanceParameters{
+				DatabaseVersion: gcp.StringPtr("POSTGRES_14"),
+				Settings:        v1beta1.Settings{IAMAuthentication: gcp.BoolPtr(false)},
+			},
+		},
+		"SQLServer": {
+			params: v1beta1.CloudSQLInstanceParameters{
+				DatabaseVersion: gcp.StringPtr("SQLSERVER_2019_STANDARD"),
+				Settings:        v1beta1.Settings{IAMAuthentication: gcp.BoolPtr(false)},
+			},
+			want: errors.New(errIAMAuthenticationUnsupported),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateIAMAuthentication(tc.params)
+			if diff := cmp.Diff(tc.want, got, xptest.EquateErrors()); diff != "" {
+				t.Errorf("ValidateIAMAuthentication(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLateInitializeSpec(t *testing.T) {
 	type args struct {
 		db     *sqladmin.DatabaseInstance
@@ -462,6 +546,106 @@ func TestGetServerCACertificate(t *testing.T) {
 	}
 }
 
+func TestGetProxyConnectionDetails(t *testing.T) {
+	type args struct {
+		p  v1beta1.CloudSQLInstanceParameters
+		db sqladmin.DatabaseInstance
+	}
+
+	cases := map[string]struct {
+		args
+		want map[string][]byte
+	}{
+		"MySQL": {
+			args: args{db: sqladmin.DatabaseInstance{ConnectionName: "project:region:instance"}},
+			want: map[string][]byte{
+				v1beta1.CloudSQLSecretInstanceConnectionNameKey: []byte("project:region:instance"),
+				xpv1.ResourceCredentialsSecretEndpointKey:       []byte(v1beta1.CloudSQLProxyEndpoint),
+				xpv1.ResourceCredentialsSecretPortKey:           []byte("3306"),
+			},
+		},
+		"PostgreSQL": {
+			args: args{
+				p:  v1beta1.CloudSQLInstanceParameters{DatabaseVersion: gcp.StringPtr("POSTGRES_13")},
+				db: sqladmin.DatabaseInstance{ConnectionName: "project:region:instance"},
+			},
+			want: map[string][]byte{
+				v1beta1.CloudSQLSecretInstanceConnectionNameKey: []byte("project:region:instance"),
+				xpv1.ResourceCredentialsSecretEndpointKey:       []byte(v1beta1.CloudSQLProxyEndpoint),
+				xpv1.ResourceCredentialsSecretPortKey:           []byte("5432"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := GetProxyConnectionDetails(tc.args.p, tc.args.db)
+			if diff := cmp.Diff(tc.want, m); diff != "" {
+				t.Errorf("GetProxyConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetClientCertificate(t *testing.T) {
+	cases := map[string]struct {
+		in   *sqladmin.SslCertDetail
+		want map[string][]byte
+	}{
+		"NilCert": {},
+		"FullCert": {
+			in: &sqladmin.SslCertDetail{
+				CertInfo:       &sqladmin.SslCert{Cert: "my-cert"},
+				CertPrivateKey: "my-key",
+			},
+			want: map[string][]byte{
+				v1beta1.CloudSQLSecretClientCertificateKey: []byte("my-cert"),
+				v1beta1.CloudSQLSecretClientKeyKey:         []byte("my-key"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := GetClientCertificate(tc.in)
+			if diff := cmp.Diff(tc.want, m); diff != "" {
+				t.Errorf("GetClientCertificate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWantsClientCertificate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1beta1.ConnectionDetailsConfig
+		want bool
+	}{
+		"Unset": {},
+		"DefaultFormat": {
+			c: &v1beta1.ConnectionDetailsConfig{
+				Format:                    gcp.StringPtr(v1beta1.ConnectionDetailsFormatDefault),
+				GenerateClientCertificate: gcp.BoolPtr(true),
+			},
+		},
+		"CloudSQLProxy": {
+			c: &v1beta1.ConnectionDetailsConfig{
+				Format:                    gcp.StringPtr(v1beta1.ConnectionDetailsFormatCloudSQLProxy),
+				GenerateClientCertificate: gcp.BoolPtr(true),
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1beta1.CloudSQLInstance{Spec: v1beta1.CloudSQLInstanceSpec{ConnectionDetails: tc.c}}
+			if diff := cmp.Diff(tc.want, WantsClientCertificate(cr)); diff != "" {
+				t.Errorf("WantsClientCertificate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestIsUpToDate(t *testing.T) {
 	type args struct {
 		params *v1beta1.CloudSQLInstanceParameters
@@ -498,6 +682,18 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: want{upToDate: false, isErr: false},
 		},
+		"Promoted": {
+			args: args{
+				params: params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.Failover = &gcpv1beta1.FailoverSpec{LocationClass: gcpv1beta1.LocationClassSecondary, Promote: true}
+				}),
+				db: db(func(db *sqladmin.DatabaseInstance) {
+					db.MasterInstanceName = ""
+					db.InstanceType = "CLOUD_SQL_INSTANCE"
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -511,3 +707,67 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestFailover(t *testing.T) {
+	type want struct {
+		role  string
+		peers []string
+	}
+	cases := map[string]struct {
+		db   sqladmin.DatabaseInstance
+		want want
+	}{
+		"Active": {
+			db:   sqladmin.DatabaseInstance{InstanceType: "CLOUD_SQL_INSTANCE", ReplicaNames: []string{"standby"}},
+			want: want{role: gcpv1beta1.FailoverRoleActive, peers: []string{"standby"}},
+		},
+		"Standby": {
+			db:   sqladmin.DatabaseInstance{InstanceType: "READ_REPLICA_INSTANCE", MasterInstanceName: "my-project:active"},
+			want: want{role: gcpv1beta1.FailoverRoleStandby, peers: []string{"active"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want.role, FailoverRole(tc.db)); diff != "" {
+				t.Errorf("FailoverRole(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.peers, FailoverPeers(tc.db)); diff != "" {
+				t.Errorf("FailoverPeers(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStorageUtilization(t *testing.T) {
+	now := time.Unix(1000, 0)
+	in := sqladmin.DatabaseInstance{
+		CurrentDiskSize: 5 << 30,
+		Settings:        &sqladmin.Settings{DataDiskSizeGb: 20},
+	}
+	want := &gcpv1beta1.Utilization{
+		Unit:         gcpv1beta1.UtilizationUnitBytes,
+		Used:         5 << 30,
+		Capacity:     20 << 30,
+		Percent:      25,
+		ObservedTime: metav1.NewTime(now),
+	}
+	if diff := cmp.Diff(want, StorageUtilization(in, now)); diff != "" {
+		t.Errorf("StorageUtilization(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGolden(t *testing.T) {
+	test.RunGoldenCases(t, filepath.Join("testdata", "golden"), test.GoldenFuncs[v1beta1.CloudSQLInstanceParameters, sqladmin.DatabaseInstance]{
+		Generate: func(p *v1beta1.CloudSQLInstanceParameters) any {
+			db := &sqladmin.DatabaseInstance{}
+			GenerateDatabaseInstance(name, *p, db)
+			return db
+		},
+		LateInitialize: func(p *v1beta1.CloudSQLInstanceParameters, o *sqladmin.DatabaseInstance) {
+			LateInitializeSpec(p, *o)
+		},
+		IsUpToDate: func(p *v1beta1.CloudSQLInstanceParameters, o *sqladmin.DatabaseInstance) (bool, error) {
+			return IsUpToDate(name, p, o)
+		},
+	})
+}
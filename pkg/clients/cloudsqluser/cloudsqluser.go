@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqluser
+
+import (
+	"strings"
+
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	crmv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// serviceAccountDomain is the domain of the email addresses of service
+// accounts, which PostgreSQL user names must not include.
+const serviceAccountDomain = ".gserviceaccount.com"
+
+// Client should be satisfied to conduct CloudSQLUser operations.
+type Client interface {
+	Get(project string, instance string, name string) *sqladmin.UsersGetCall
+	Insert(project string, instance string, user *sqladmin.User) *sqladmin.UsersInsertCall
+	Delete(project string, instance string) *sqladmin.UsersDeleteCall
+}
+
+// IsIAMUser returns true if the supplied parameters declare a
+// CLOUD_IAM_USER or CLOUD_IAM_SERVICE_ACCOUNT user.
+func IsIAMUser(in v1alpha1.CloudSQLUserParameters) bool {
+	t := gcp.StringValue(in.Type)
+	return t == v1alpha1.CloudSQLUserTypeCloudIAMUser || t == v1alpha1.CloudSQLUserTypeCloudIAMServiceAccount
+}
+
+// UserName returns the name of the user declared by the supplied parameters
+// in an instance with the supplied database version. The name of IAM users
+// and service accounts is derived from their email address, dropping the
+// .gserviceaccount.com suffix of service accounts for PostgreSQL. The
+// supplied name is returned for built-in users.
+func UserName(name string, in v1alpha1.CloudSQLUserParameters, databaseVersion string) string {
+	if !IsIAMUser(in) || gcp.StringValue(in.Email) == "" {
+		return name
+	}
+	email := gcp.StringValue(in.Email)
+	if gcp.StringValue(in.Type) == v1alpha1.CloudSQLUserTypeCloudIAMServiceAccount && strings.HasPrefix(databaseVersion, v1beta1.PostgresqlDBVersionPrefix) {
+		return strings.TrimSuffix(email, serviceAccountDomain)
+	}
+	return email
+}
+
+// GenerateUser produces a *sqladmin.User with the supplied name and
+// password from CloudSQLUserParameters.
+func GenerateUser(name, password string, in v1alpha1.CloudSQLUserParameters) *sqladmin.User {
+	return &sqladmin.User{
+		Name:     name,
+		Password: password,
+		Type:     gcp.StringValue(in.Type),
+		Host:     gcp.StringValue(in.Host),
+	}
+}
+
+// GenerateObservation produces CloudSQLUserObservation object from
+// sqladmin.User object.
+func GenerateObservation(in sqladmin.User) v1alpha1.CloudSQLUserObservation {
+	return v1alpha1.CloudSQLUserObservation{
+		Name: in.Name,
+		Type: in.Type,
+		Host: in.Host,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in sqladmin.User
+// object.
+func LateInitializeSpec(spec *v1alpha1.CloudSQLUserParameters, in sqladmin.User) {
+	spec.Type = gcp.LateInitializeString(spec.Type, in.Type)
+	spec.Host = gcp.LateInitializeString(spec.Host, in.Host)
+}
+
+// Member returns the IAM policy member of the IAM user or service account
+// declared by the supplied parameters, or an empty string for built-in
+// users.
+func Member(in v1alpha1.CloudSQLUserParameters) string {
+	email := gcp.StringValue(in.Email)
+	if !IsIAMUser(in) || email == "" {
+		return ""
+	}
+	if gcp.StringValue(in.Type) == v1alpha1.CloudSQLUserTypeCloudIAMServiceAccount {
+		return "serviceAccount:" + email
+	}
+	return "user:" + email
+}
+
+// InstanceUserPolicyMember returns the PolicyMember that binds the
+// roles/cloudsql.instanceUser role to the IAM user or service account
+// declared by the supplied parameters. It declares no members unless
+// GrantInstanceUserRole is set, so that updating a policy with it unbinds
+// the members the role was previously bound to.
+func InstanceUserPolicyMember(in v1alpha1.CloudSQLUserParameters) crmv1alpha1.PolicyMember {
+	pm := crmv1alpha1.PolicyMember{Role: v1alpha1.CloudSQLInstanceUserRole}
+	if m := Member(in); m != "" && gcp.BoolValue(in.GrantInstanceUserRole) {
+		pm.Member = &m
+	}
+	return pm
+}
+
+// GetConnectionDetails returns the connection details of the user with the
+// supplied name. The password of built-in users is only known when they are
+// created.
+func GetConnectionDetails(name, password string) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey: []byte(name),
+	}
+	if password != "" {
+		cd[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(password)
+	}
+	return cd
+}
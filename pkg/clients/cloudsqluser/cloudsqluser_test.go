@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqluser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	crmv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName           = "example-user"
+	testUserEmail      = "jane@example.com"
+	testServiceAccount = "app@my-project.iam.gserviceaccount.com"
+)
+
+func params(userType, email string, grant bool) v1alpha1.CloudSQLUserParameters {
+	return v1alpha1.CloudSQLUserParameters{
+		Instance:              gcp.StringPtr("example-instance"),
+		Type:                  gcp.StringPtr(userType),
+		Email:                 gcp.StringPtr(email),
+		GrantInstanceUserRole: gcp.BoolPtr(grant),
+	}
+}
+
+func TestUserName(t *testing.T) {
+	cases := map[string]struct {
+		in              v1alpha1.CloudSQLUserParameters
+		databaseVersion string
+		want            string
+	}{
+		"BuiltIn": {
+			in:              v1alpha1.CloudSQLUserParameters{Type: gcp.StringPtr(v1alpha1.CloudSQLUserTypeBuiltIn)},
+			databaseVersion: "POSTGRES_14",
+			want:            testName,
+		},
+		"IAMUserWithoutEmail": {
+			in:              v1alpha1.CloudSQLUserParameters{Type: gcp.StringPtr(v1alpha1.CloudSQLUserTypeCloudIAMUser)},
+			databaseVersion: "POSTGRES_14",
+			want:            testName,
+		},
+		"IAMUser": {
+			in:              params(v1alpha1.CloudSQLUserTypeCloudIAMUser, testUserEmail, false),
+			databaseVersion: "POSTGRES_14",
+			want:            testUserEmail,
+		},
+		"PostgreSQLServiceAccount": {
+			in:              params(v1alpha1.CloudSQLUserTypeCloudIAMServiceAccount, testServiceAccount, false),
+			databaseVersion: "POSTGRES_14",
+			want:            "app@my-project.iam",
+		},
+		"MySQLServiceAccount": {
+			in:              params(v1alpha1.CloudSQLUserTypeCloudIAMServiceAccount, testServiceAccount, false),
+			databaseVersion: "MYSQL_8_0",
+			want:            testServiceAccount,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, UserName(testName, tc.in, tc.databaseVersion)); diff != "" {
+				t.Errorf("UserName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceUserPolicyMember(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.CloudSQLUserParameters
+		want crmv1alpha1.PolicyMember
+	}{
+		"BuiltIn": {
+			in:   params(v1alpha1.CloudSQLUserTypeBuiltIn, "", true),
+			want: crmv1alpha1.PolicyMember{Role: v1alpha1.CloudSQLInstanceUserRole},
+		},
+		"NotGranted": {
+			in:   params(v1alpha1.CloudSQLUserTypeCloudIAMUser, testUserEmail, false),
+			want: crmv1alpha1.PolicyMember{Role: v1alpha1.CloudSQLInstanceUserRole},
+		},
+		"IAMUser": {
+			in:   params(v1alpha1.CloudSQLUserTypeCloudIAMUser, testUserEmail, true),
+			want: crmv1alpha1.PolicyMember{Role: v1alpha1.CloudSQLInstanceUserRole, Member: gcp.StringPtr("user:" + testUserEmail)},
+		},
+		"ServiceAccount": {
+			in:   params(v1alpha1.CloudSQLUserTypeCloudIAMServiceAccount, testServiceAccount, true),
+			want: crmv1alpha1.PolicyMember{Role: v1alpha1.CloudSQLInstanceUserRole, Member: gcp.StringPtr("serviceAccount:" + testServiceAccount)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, InstanceUserPolicyMember(tc.in)); diff != "" {
+				t.Errorf("InstanceUserPolicyMember(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUser(t *testing.T) {
+	in := v1alpha1.CloudSQLUserParameters{
+		Type: gcp.StringPtr(v1alpha1.CloudSQLUserTypeBuiltIn),
+		Host: gcp.StringPtr("%"),
+	}
+	want := &sqladmin.User{Name: testName, Password: "s3cr3t", Type: v1alpha1.CloudSQLUserTypeBuiltIn, Host: "%"}
+	if diff := cmp.Diff(want, GenerateUser(testName, "s3cr3t", in)); diff != "" {
+		t.Errorf("GenerateUser(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		password string
+		want     managed.ConnectionDetails
+	}{
+		"Created": {
+			password: "s3cr3t",
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretUserKey:     []byte(testName),
+				xpv1.ResourceCredentialsSecretPasswordKey: []byte("s3cr3t"),
+			},
+		},
+		"NoPassword": {
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretUserKey: []byte(testName),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetConnectionDetails(testName, tc.password)); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
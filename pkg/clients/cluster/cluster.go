@@ -84,6 +84,7 @@ func GenerateCluster(name string, in v1beta2.ClusterParameters, cluster *contain
 	GenerateAuthenticatorGroupsConfig(in.AuthenticatorGroupsConfig, cluster)
 	GenerateAutoscaling(in.Autoscaling, cluster)
 	GenerateConfidentialNodes(in.ConfidentialNodes, cluster)
+	GenerateCostManagementConfig(in.CostManagementConfig, cluster)
 	GenerateBinaryAuthorization(in.BinaryAuthorization, cluster)
 	GenerateDatabaseEncryption(in.DatabaseEncryption, cluster)
 	GenerateDefaultMaxPodsConstraint(in.DefaultMaxPodsConstraint, cluster)
@@ -94,6 +95,7 @@ func GenerateCluster(name string, in v1beta2.ClusterParameters, cluster *contain
 	GenerateMasterAuthorizedNetworksConfig(in.MasterAuthorizedNetworksConfig, cluster)
 	GenerateNetworkConfig(in.NetworkConfig, cluster)
 	GenerateNetworkPolicy(in.NetworkPolicy, cluster)
+	GenerateNodePoolDefaults(in.NodePoolDefaults, cluster)
 	GenerateNotificationConfig(in.NotificationConfig, cluster)
 	GeneratePrivateClusterConfig(in.PrivateClusterConfig, cluster)
 	GenerateReleaseChannel(in.ReleaseChannel, cluster)
@@ -272,6 +274,16 @@ func GenerateConfidentialNodes(in *v1beta2.ConfidentialNodes, cluster *container
 	}
 }
 
+// GenerateCostManagementConfig generates *container.CostManagementConfig from *CostManagementConfig.
+func GenerateCostManagementConfig(in *v1beta2.CostManagementConfig, cluster *container.Cluster) {
+	if in != nil {
+		if cluster.CostManagementConfig == nil {
+			cluster.CostManagementConfig = &container.CostManagementConfig{}
+		}
+		cluster.CostManagementConfig.Enabled = in.Enabled
+	}
+}
+
 // GenerateDatabaseEncryption generates *container.DatabaseEncryption from *DatabaseEncryption.
 func GenerateDatabaseEncryption(in *v1beta2.DatabaseEncryption, cluster *container.Cluster) {
 	if in != nil {
@@ -439,6 +451,23 @@ func GenerateNetworkPolicy(in *v1beta2.NetworkPolicy, cluster *container.Cluster
 	}
 }
 
+// GenerateNodePoolDefaults generates *container.NodePoolDefaults from *NodePoolDefaults.
+func GenerateNodePoolDefaults(in *v1beta2.NodePoolDefaults, cluster *container.Cluster) {
+	if in != nil && in.NodeConfigDefaults != nil {
+		if cluster.NodePoolDefaults == nil {
+			cluster.NodePoolDefaults = &container.NodePoolDefaults{}
+		}
+		if cluster.NodePoolDefaults.NodeConfigDefaults == nil {
+			cluster.NodePoolDefaults.NodeConfigDefaults = &container.NodeConfigDefaults{}
+		}
+		if in.NodeConfigDefaults.GcfsConfig != nil {
+			cluster.NodePoolDefaults.NodeConfigDefaults.GcfsConfig = &container.GcfsConfig{
+				Enabled: in.NodeConfigDefaults.GcfsConfig.Enabled,
+			}
+		}
+	}
+}
+
 // GenerateNotificationConfig generates *container.NotificationConfig from *NotificationConfig.
 func GenerateNotificationConfig(in *v1beta2.NotificationConfig, cluster *container.Cluster) {
 	if in != nil {
@@ -775,6 +804,12 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 
 	spec.ClusterIpv4Cidr = gcp.LateInitializeString(spec.ClusterIpv4Cidr, in.ClusterIpv4Cidr)
 
+	if spec.CostManagementConfig == nil && in.CostManagementConfig != nil {
+		spec.CostManagementConfig = &v1beta2.CostManagementConfig{
+			Enabled: in.CostManagementConfig.Enabled,
+		}
+	}
+
 	if in.DatabaseEncryption != nil {
 		if spec.DatabaseEncryption == nil {
 			spec.DatabaseEncryption = &v1beta2.DatabaseEncryption{}
@@ -926,6 +961,20 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 		}
 	}
 
+	if in.NodePoolDefaults != nil && in.NodePoolDefaults.NodeConfigDefaults != nil && in.NodePoolDefaults.NodeConfigDefaults.GcfsConfig != nil {
+		if spec.NodePoolDefaults == nil {
+			spec.NodePoolDefaults = &v1beta2.NodePoolDefaults{}
+		}
+		if spec.NodePoolDefaults.NodeConfigDefaults == nil {
+			spec.NodePoolDefaults.NodeConfigDefaults = &v1beta2.NodeConfigDefaults{}
+		}
+		if spec.NodePoolDefaults.NodeConfigDefaults.GcfsConfig == nil {
+			spec.NodePoolDefaults.NodeConfigDefaults.GcfsConfig = &v1beta2.GcfsConfig{
+				Enabled: in.NodePoolDefaults.NodeConfigDefaults.GcfsConfig.Enabled,
+			}
+		}
+	}
+
 	if in.NotificationConfig != nil && in.NotificationConfig.Pubsub != nil && spec.NotificationConfig == nil {
 		if spec.NotificationConfig == nil {
 			spec.NotificationConfig = &v1beta2.NotificationConfig{
@@ -1019,6 +1068,20 @@ func newBinaryAuthorizationUpdateFn(in *v1beta2.BinaryAuthorization) UpdateFn {
 	}
 }
 
+// newCostManagementConfigUpdateFn returns a function that updates the CostManagementConfig of a cluster.
+func newCostManagementConfigUpdateFn(in *v1beta2.CostManagementConfig) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		out := &container.Cluster{}
+		GenerateCostManagementConfig(in, out)
+		update := &container.UpdateClusterRequest{
+			Update: &container.ClusterUpdate{
+				DesiredCostManagementConfig: out.CostManagementConfig,
+			},
+		}
+		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
+	}
+}
+
 // newDatabaseEncryptionUpdateFn returns a function that updates the DatabaseEncryption of a cluster.
 func newDatabaseEncryptionUpdateFn(in *v1beta2.DatabaseEncryption) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -1161,6 +1224,27 @@ func newNetworkPolicyUpdateFn(in *v1beta2.NetworkPolicy) UpdateFn {
 	}
 }
 
+// newNodePoolDefaultsUpdateFn returns a function that updates the image
+// streaming default of the node pools of a cluster.
+func newNodePoolDefaultsUpdateFn(in *v1beta2.NodePoolDefaults) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		out := &container.Cluster{}
+		GenerateNodePoolDefaults(in, out)
+		gcfs := &container.GcfsConfig{}
+		if out.NodePoolDefaults != nil && out.NodePoolDefaults.NodeConfigDefaults != nil && out.NodePoolDefaults.NodeConfigDefaults.GcfsConfig != nil {
+			gcfs = out.NodePoolDefaults.NodeConfigDefaults.GcfsConfig
+		}
+		// Disabling image streaming requires sending the false value.
+		gcfs.ForceSendFields = []string{"Enabled"}
+		update := &container.UpdateClusterRequest{
+			Update: &container.ClusterUpdate{
+				DesiredGcfsConfig: gcfs,
+			},
+		}
+		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
+	}
+}
+
 // newNotificationConfigUpdateFn returns a function that updates the NotificationConfig of a cluster.
 func newNotificationConfigUpdateFn(in *v1beta2.NotificationConfig) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -1316,6 +1400,9 @@ func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.
 	if !cmp.Equal(desired.BinaryAuthorization, observed.BinaryAuthorization, cmpopts.EquateEmpty()) {
 		return false, newBinaryAuthorizationUpdateFn(in.BinaryAuthorization), nil
 	}
+	if !cmp.Equal(desired.CostManagementConfig, observed.CostManagementConfig, cmpopts.EquateEmpty()) {
+		return false, newCostManagementConfigUpdateFn(in.CostManagementConfig), nil
+	}
 	if !cmp.Equal(desired.DatabaseEncryption, observed.DatabaseEncryption, cmpopts.EquateEmpty()) {
 		return false, newDatabaseEncryptionUpdateFn(in.DatabaseEncryption), nil
 	}
@@ -1355,6 +1442,9 @@ func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.
 	if !cmp.Equal(desired.NetworkPolicy, observed.NetworkPolicy, cmpopts.EquateEmpty()) {
 		return false, newNetworkPolicyUpdateFn(in.NetworkPolicy), nil
 	}
+	if !cmp.Equal(desired.NodePoolDefaults, observed.NodePoolDefaults, cmpopts.EquateEmpty()) {
+		return false, newNodePoolDefaultsUpdateFn(in.NodePoolDefaults), nil
+	}
 	if !cmp.Equal(desired.NotificationConfig, observed.NotificationConfig, cmpopts.EquateEmpty()) {
 		return false, newNotificationConfigUpdateFn(in.NotificationConfig), nil
 	}
@@ -650,6 +650,49 @@ func TestGenerateConfidentialNodes(t *testing.T) {
 	}
 }
 
+func TestGenerateCostManagementConfig(t *testing.T) {
+	type args struct {
+		cluster *container.Cluster
+		params  *v1beta2.ClusterParameters
+	}
+
+	tests := map[string]struct {
+		args args
+		want *container.Cluster
+	}{
+		"Successful": {
+			args: args{
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.CostManagementConfig = &v1beta2.CostManagementConfig{
+						Enabled: true,
+					}
+				}),
+			},
+			want: cluster(func(c *container.Cluster) {
+				c.CostManagementConfig = &container.CostManagementConfig{
+					Enabled: true,
+				}
+			}),
+		},
+		"SuccessfulNil": {
+			args: args{
+				cluster: cluster(),
+				params:  params(),
+			},
+			want: cluster(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			GenerateCostManagementConfig(tc.args.params.CostManagementConfig, tc.args.cluster)
+			if diff := cmp.Diff(tc.want.CostManagementConfig, tc.args.cluster.CostManagementConfig); diff != "" {
+				t.Errorf("GenerateCostManagementConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateDatabaseEncryption(t *testing.T) {
 	type args struct {
 		cluster *container.Cluster
@@ -1133,6 +1176,53 @@ func TestGenerateNetworkPolicy(t *testing.T) {
 	}
 }
 
+func TestGenerateNodePoolDefaults(t *testing.T) {
+	type args struct {
+		cluster *container.Cluster
+		params  *v1beta2.ClusterParameters
+	}
+
+	tests := map[string]struct {
+		args args
+		want *container.Cluster
+	}{
+		"Successful": {
+			args: args{
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NodePoolDefaults = &v1beta2.NodePoolDefaults{
+						NodeConfigDefaults: &v1beta2.NodeConfigDefaults{
+							GcfsConfig: &v1beta2.GcfsConfig{Enabled: true},
+						},
+					}
+				}),
+			},
+			want: cluster(func(c *container.Cluster) {
+				c.NodePoolDefaults = &container.NodePoolDefaults{
+					NodeConfigDefaults: &container.NodeConfigDefaults{
+						GcfsConfig: &container.GcfsConfig{Enabled: true},
+					},
+				}
+			}),
+		},
+		"SuccessfulNil": {
+			args: args{
+				cluster: cluster(),
+				params:  params(),
+			},
+			want: cluster(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			GenerateNodePoolDefaults(tc.args.params.NodePoolDefaults, tc.args.cluster)
+			if diff := cmp.Diff(tc.want.NodePoolDefaults, tc.args.cluster.NodePoolDefaults); diff != "" {
+				t.Errorf("GenerateNodePoolDefaults(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateNotificationConfig(t *testing.T) {
 	type args struct {
 		cluster *container.Cluster
@@ -1647,6 +1737,29 @@ func TestIsUpToDate(t *testing.T) {
 				isErr:    false,
 			},
 		},
+		"NeedsNodePoolDefaultsUpdate": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.NodePoolDefaults = &container.NodePoolDefaults{
+						NodeConfigDefaults: &container.NodeConfigDefaults{
+							GcfsConfig: &container.GcfsConfig{Enabled: false},
+						},
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.NodePoolDefaults = &v1beta2.NodePoolDefaults{
+						NodeConfigDefaults: &v1beta2.NodeConfigDefaults{
+							GcfsConfig: &v1beta2.GcfsConfig{Enabled: true},
+						},
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
 		"NoUpdateNotBootstrapNodePool": {
 			args: args{
 				name: name,
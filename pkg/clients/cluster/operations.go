@@ -0,0 +1,166 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	container "google.golang.org/api/container/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+)
+
+// Reasons of the events that report problems of GKE clusters.
+const (
+	ReasonOperationFailed  event.Reason = "OperationFailed"
+	ReasonOperationWarning event.Reason = "OperationWarning"
+	ReasonClusterCondition event.Reason = "ClusterCondition"
+)
+
+const operationDone = "DONE"
+
+// A Problem of a GKE cluster, i.e. an error or warning of one of its
+// operations or a condition of the cluster or its node pools, such as
+// exhausted IP ranges or quota.
+type Problem struct {
+	// Key identifies the problem, so that it is reported only once.
+	Key string
+
+	// Reason the problem is reported for.
+	Reason event.Reason
+
+	// Message describing the problem.
+	Message string
+}
+
+// Event returns a warning event that reports the problem.
+func (p Problem) Event() event.Event {
+	return event.Warning(p.Reason, errors.New(p.Message))
+}
+
+// IsClusterOperation returns true if the supplied operation targets the named
+// cluster, or one of its node pools or nodes.
+func IsClusterOperation(op *container.Operation, name string) bool {
+	target := "/clusters/" + name
+	return strings.HasSuffix(op.TargetLink, target) || strings.Contains(op.TargetLink, target+"/")
+}
+
+// OperationProblems returns the problems of the supplied operations of the
+// named cluster: the errors of failed operations, and the warnings of both
+// ongoing and finished ones.
+func OperationProblems(ops []*container.Operation, name string) []Problem {
+	var ps []Problem
+	for _, op := range ops {
+		if op == nil || !IsClusterOperation(op, name) {
+			continue
+		}
+		if op.Status == operationDone && op.Error != nil && op.Error.Message != "" {
+			ps = append(ps, Problem{
+				Key:     op.Name,
+				Reason:  ReasonOperationFailed,
+				Message: fmt.Sprintf("%s operation %s failed: %s", op.OperationType, op.Name, op.Error.Message),
+			})
+		}
+		for _, c := range append(append([]*container.StatusCondition{}, op.ClusterConditions...), op.NodepoolConditions...) {
+			if c == nil || c.Message == "" {
+				continue
+			}
+			ps = append(ps, Problem{
+				Key:     op.Name + "/" + c.Message,
+				Reason:  ReasonOperationWarning,
+				Message: fmt.Sprintf("%s operation %s: %s", op.OperationType, op.Name, c.Message),
+			})
+		}
+	}
+	return ps
+}
+
+// ConditionProblems returns the conditions of the supplied cluster and its
+// node pools as problems, e.g. node pools that cannot scale up.
+func ConditionProblems(c *container.Cluster) []Problem {
+	var ps []Problem
+	for _, cond := range c.Conditions {
+		if cond == nil || cond.Message == "" {
+			continue
+		}
+		ps = append(ps, Problem{Key: "cluster/" + cond.Message, Reason: ReasonClusterCondition, Message: cond.Message})
+	}
+	for _, np := range c.NodePools {
+		if np == nil {
+			continue
+		}
+		for _, cond := range np.Conditions {
+			if cond == nil || cond.Message == "" {
+				continue
+			}
+			ps = append(ps, Problem{
+				Key:     "nodePools/" + np.Name + "/" + cond.Message,
+				Reason:  ReasonClusterCondition,
+				Message: fmt.Sprintf("node pool %s: %s", np.Name, cond.Message),
+			})
+		}
+	}
+	return ps
+}
+
+// A Reporter remembers the problems of each cluster that were reported, so
+// that each problem is reported once for as long as it persists rather than
+// every time the cluster is observed. A problem that goes away is forgotten,
+// and reported again if it recurs.
+type Reporter struct {
+	mu       sync.Mutex
+	reported map[string]map[string]bool
+}
+
+// NewReporter returns a Reporter that has not reported any problems.
+func NewReporter() *Reporter {
+	return &Reporter{reported: map[string]map[string]bool{}}
+}
+
+// Unreported returns the supplied current problems of the identified cluster
+// that were not reported yet, and records them as reported.
+func (r *Reporter) Unreported(cluster string, current []Problem) []Problem {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	seen := make(map[string]bool, len(current))
+	var ps []Problem
+	for _, p := range current {
+		if seen[p.Key] {
+			continue
+		}
+		seen[p.Key] = true
+		if !r.reported[cluster][p.Key] {
+			ps = append(ps, p)
+		}
+	}
+	if len(seen) == 0 {
+		delete(r.reported, cluster)
+		return ps
+	}
+	r.reported[cluster] = seen
+	return ps
+}
+
+// Forget the problems reported for the identified cluster.
+func (r *Reporter) Forget(cluster string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.reported, cluster)
+}
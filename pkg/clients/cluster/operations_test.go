@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	container "google.golang.org/api/container/v1"
+)
+
+func TestIsClusterOperation(t *testing.T) {
+	const base = "https://container.googleapis.com/v1/projects/p/locations/l/clusters/"
+	cases := map[string]struct {
+		target string
+		want   bool
+	}{
+		"Cluster":      {target: base + "cool", want: true},
+		"NodePool":     {target: base + "cool/nodePools/np", want: true},
+		"OtherCluster": {target: base + "cool-2"},
+		"Suffix":       {target: base + "not-cool"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsClusterOperation(&container.Operation{TargetLink: tc.target}, "cool")); diff != "" {
+				t.Errorf("IsClusterOperation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOperationProblems(t *testing.T) {
+	target := "https://container.googleapis.com/v1/projects/p/locations/l/clusters/cool"
+	ops := []*container.Operation{
+		{Name: "running", OperationType: "UPGRADE_MASTER", Status: "RUNNING", TargetLink: target},
+		{Name: "failed", OperationType: "UPDATE_CLUSTER", Status: "DONE", TargetLink: target, Error: &container.Status{Message: "quota exceeded"}},
+		{Name: "warned", OperationType: "CREATE_CLUSTER", Status: "RUNNING", TargetLink: target, ClusterConditions: []*container.StatusCondition{{Message: "IP space exhausted"}, {}}},
+		nil,
+	}
+	want := []Problem{
+		{Key: "failed", Reason: ReasonOperationFailed, Message: "UPDATE_CLUSTER operation failed failed: quota exceeded"},
+		{Key: "warned/IP space exhausted", Reason: ReasonOperationWarning, Message: "CREATE_CLUSTER operation warned: IP space exhausted"},
+	}
+	if diff := cmp.Diff(want, OperationProblems(ops, "cool")); diff != "" {
+		t.Errorf("OperationProblems(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestConditionProblems(t *testing.T) {
+	c := &container.Cluster{
+		Conditions: []*container.StatusCondition{{Message: "quota exceeded"}},
+		NodePools: []*container.NodePool{
+			{Name: "np", Conditions: []*container.StatusCondition{{Message: "cannot scale up"}}},
+			nil,
+		},
+	}
+	want := []Problem{
+		{Key: "cluster/quota exceeded", Reason: ReasonClusterCondition, Message: "quota exceeded"},
+		{Key: "nodePools/np/cannot scale up", Reason: ReasonClusterCondition, Message: "node pool np: cannot scale up"},
+	}
+	if diff := cmp.Diff(want, ConditionProblems(c)); diff != "" {
+		t.Errorf("ConditionProblems(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestReporter(t *testing.T) {
+	a := Problem{Key: "a", Message: "a"}
+	b := Problem{Key: "b", Message: "b"}
+	r := NewReporter()
+
+	steps := []struct {
+		current []Problem
+		want    []Problem
+	}{
+		{current: []Problem{a, a}, want: []Problem{a}},
+		{current: []Problem{a, b}, want: []Problem{b}},
+		{current: []Problem{b}},
+		{current: []Problem{a, b}, want: []Problem{a}},
+		{},
+		{current: []Problem{b}, want: []Problem{b}},
+	}
+	for i, s := range steps {
+		if diff := cmp.Diff(s.want, r.Unreported("uid", s.current)); diff != "" {
+			t.Errorf("step %d: Unreported(...): -want, +got:\n%s", i, diff)
+		}
+	}
+
+	r.Forget("uid")
+	if diff := cmp.Diff([]Problem{b}, r.Unreported("uid", []Problem{b})); diff != "" {
+		t.Errorf("Unreported(...) after Forget(...): -want, +got:\n%s", diff)
+	}
+}
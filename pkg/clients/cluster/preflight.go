@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+const (
+	gkeServiceAgentFormat     = "serviceAccount:service-%d@container-engine-robot.iam.gserviceaccount.com"
+	computeServiceAgentFormat = "serviceAccount:service-%d@compute-system.iam.gserviceaccount.com"
+	defaultNodeSAFormat       = "%d-compute@developer.gserviceaccount.com"
+
+	// RoleGKEServiceAgent is the role the GKE service agent needs on the
+	// project in order to manage clusters.
+	RoleGKEServiceAgent = "roles/container.serviceAgent"
+
+	// RoleComputeServiceAgent is the role the Compute Engine service agent
+	// needs on the project in order to manage cluster nodes.
+	RoleComputeServiceAgent = "roles/compute.serviceAgent"
+
+	// RoleDefaultNodeServiceAccount is the predefined role that bundles the
+	// permissions node service accounts need.
+	RoleDefaultNodeServiceAccount = "roles/container.defaultNodeServiceAccount"
+
+	// ReasonBlocked indicates that a resource cannot be created because of
+	// missing prerequisites.
+	ReasonBlocked xpv1.ConditionReason = "Blocked"
+
+	// IAMPolicyVersion is the IAM policy version requested when reading the
+	// project policy, so that conditional bindings are returned as-is.
+	IAMPolicyVersion = 3
+
+	errFmtMissingGrants = "missing IAM grants: %s"
+)
+
+// nodeRoles are the roles a node service account needs for nodes to report
+// logs and metrics. Each of them is also satisfied by
+// RoleDefaultNodeServiceAccount.
+var nodeRoles = []string{
+	"roles/logging.logWriter",
+	"roles/monitoring.metricWriter",
+	"roles/monitoring.viewer",
+}
+
+// A Grant is an IAM role bound to a member on the project.
+type Grant struct {
+	Role   string
+	Member string
+
+	// Alternatives are roles that satisfy this grant when bound instead of
+	// Role.
+	Alternatives []string
+}
+
+// String returns a human readable representation of the grant.
+func (g Grant) String() string {
+	return fmt.Sprintf("%s for %s", g.Role, g.Member)
+}
+
+// ServiceAgentGrants returns the grants the GKE and Compute Engine service
+// agents of the project with the supplied number need.
+func ServiceAgentGrants(projectNumber int64) []Grant {
+	return []Grant{
+		{Role: RoleGKEServiceAgent, Member: fmt.Sprintf(gkeServiceAgentFormat, projectNumber)},
+		{Role: RoleComputeServiceAgent, Member: fmt.Sprintf(computeServiceAgentFormat, projectNumber)},
+	}
+}
+
+// NodeServiceAccountGrants returns the grants the supplied node service
+// account needs. The Compute Engine default service account of the project is
+// used if sa is empty or "default", as GKE does.
+func NodeServiceAccountGrants(projectNumber int64, sa string) []Grant {
+	if sa == "" || sa == "default" {
+		sa = fmt.Sprintf(defaultNodeSAFormat, projectNumber)
+	}
+	member := "serviceAccount:" + sa
+	grants := make([]Grant, len(nodeRoles))
+	for i, r := range nodeRoles {
+		grants[i] = Grant{Role: r, Member: member, Alternatives: []string{RoleDefaultNodeServiceAccount}}
+	}
+	return grants
+}
+
+// MissingGrants returns the required grants that are not bound in the
+// supplied project policy. Conditional bindings are not considered, since
+// they may not apply to the requests GKE makes.
+func MissingGrants(p *cloudresourcemanager.Policy, required []Grant) []Grant {
+	bound := map[string]map[string]bool{}
+	for _, b := range p.Bindings {
+		if b.Condition != nil {
+			continue
+		}
+		if bound[b.Role] == nil {
+			bound[b.Role] = map[string]bool{}
+		}
+		for _, m := range b.Members {
+			bound[b.Role][m] = true
+		}
+	}
+
+	var missing []Grant
+	for _, g := range required {
+		ok := bound[g.Role][g.Member]
+		for _, r := range g.Alternatives {
+			ok = ok || bound[r][g.Member]
+		}
+		if !ok {
+			missing = append(missing, g)
+		}
+	}
+	return missing
+}
+
+// MissingGrantsMessage returns a message listing the supplied missing grants
+// in a stable order.
+func MissingGrantsMessage(missing []Grant) string {
+	s := make([]string, len(missing))
+	for i, g := range missing {
+		s[i] = g.String()
+	}
+	sort.Strings(s)
+	return fmt.Sprintf(errFmtMissingGrants, strings.Join(s, ", "))
+}
+
+// Blocked returns a condition that indicates the resource cannot be created
+// until the supplied grants are made.
+func Blocked(missing []Grant) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonBlocked,
+		Message:            MissingGrantsMessage(missing),
+	}
+}
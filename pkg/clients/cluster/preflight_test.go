@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+const (
+	testProjectNumber = 1234
+	testNodeSA        = "serviceAccount:1234-compute@developer.gserviceaccount.com"
+	testGKEAgent      = "serviceAccount:service-1234@container-engine-robot.iam.gserviceaccount.com"
+)
+
+func TestNodeServiceAccountGrants(t *testing.T) {
+	cases := map[string]struct {
+		sa   string
+		want string
+	}{
+		"Empty":   {sa: "", want: testNodeSA},
+		"Default": {sa: "default", want: testNodeSA},
+		"Custom":  {sa: "nodes@example.iam.gserviceaccount.com", want: "serviceAccount:nodes@example.iam.gserviceaccount.com"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, g := range NodeServiceAccountGrants(testProjectNumber, tc.sa) {
+				if diff := cmp.Diff(tc.want, g.Member); diff != "" {
+					t.Errorf("NodeServiceAccountGrants(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMissingGrants(t *testing.T) {
+	agent := Grant{Role: RoleGKEServiceAgent, Member: testGKEAgent}
+	node := Grant{Role: "roles/logging.logWriter", Member: testNodeSA, Alternatives: []string{RoleDefaultNodeServiceAccount}}
+
+	type args struct {
+		p        *cloudresourcemanager.Policy
+		required []Grant
+	}
+	cases := map[string]struct {
+		args args
+		want []Grant
+	}{
+		"AllBound": {
+			args: args{
+				p: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+					{Role: RoleGKEServiceAgent, Members: []string{"user:admin@example.com", testGKEAgent}},
+					{Role: "roles/logging.logWriter", Members: []string{testNodeSA}},
+				}},
+				required: []Grant{agent, node},
+			},
+		},
+		"BoundThroughAlternative": {
+			args: args{
+				p: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+					{Role: RoleDefaultNodeServiceAccount, Members: []string{testNodeSA}},
+				}},
+				required: []Grant{node},
+			},
+		},
+		"ConditionalBindingIgnored": {
+			args: args{
+				p: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+					{
+						Role:      RoleGKEServiceAgent,
+						Members:   []string{testGKEAgent},
+						Condition: &cloudresourcemanager.Expr{Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`},
+					},
+				}},
+				required: []Grant{agent},
+			},
+			want: []Grant{agent},
+		},
+		"Missing": {
+			args: args{
+				p: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+					{Role: RoleGKEServiceAgent, Members: []string{testGKEAgent}},
+				}},
+				required: []Grant{agent, node},
+			},
+			want: []Grant{node},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := MissingGrants(tc.args.p, tc.args.required)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("MissingGrants(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
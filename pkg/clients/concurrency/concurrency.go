@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package concurrency configures the maximum number of concurrent reconciles
+// of individual controllers, so that controllers of heavyweight kinds can run
+// narrower than the global setting while cheap ones run wider.
+package concurrency
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpcontroller "github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const (
+	controllerPrefix = "managed/"
+
+	errParseFmt    = "cannot parse maximum concurrent reconciles %q of %s"
+	errNotPositive = "maximum concurrent reconciles of %s must be positive"
+)
+
+var (
+	overridesMu sync.RWMutex
+	overrides   = map[string]int{}
+)
+
+// Parse returns the maximum concurrent reconciles keyed by GroupKind, e.g.
+// Instance.compute.gcp.crossplane.io, from their string representation.
+// GroupKinds are matched case-insensitively.
+func Parse(in map[string]string) (map[string]int, error) {
+	out := make(map[string]int, len(in))
+	for gk, v := range in {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, errParseFmt, v, gk)
+		}
+		if n < 1 {
+			return nil, errors.Errorf(errNotPositive, gk)
+		}
+		out[strings.ToLower(gk)] = n
+	}
+	return out, nil
+}
+
+// SetOverrides configures the maximum concurrent reconciles, keyed by
+// GroupKind, used by controllers that are subsequently set up with
+// ForControllerRuntime.
+func SetOverrides(o map[string]int) {
+	overridesMu.Lock()
+	defer overridesMu.Unlock()
+	overrides = make(map[string]int, len(o))
+	for gk, n := range o {
+		overrides[strings.ToLower(gk)] = n
+	}
+}
+
+// ForControllerRuntime returns the controller-runtime options of the named
+// managed resource controller. The maximum concurrent reconciles of the
+// supplied options are used unless they are overridden for the controller's
+// GroupKind.
+func ForControllerRuntime(name string, o xpcontroller.Options) controller.Options {
+	co := o.ForControllerRuntime()
+
+	overridesMu.RLock()
+	defer overridesMu.RUnlock()
+	if n, ok := overrides[strings.TrimPrefix(name, controllerPrefix)]; ok {
+		co.MaxConcurrentReconciles = n
+	}
+	return co
+}
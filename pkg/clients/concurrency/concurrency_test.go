@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concurrency
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestParse(t *testing.T) {
+	_, errNaN := strconv.Atoi("wide")
+
+	type want struct {
+		out map[string]int
+		err error
+	}
+
+	cases := map[string]struct {
+		in   map[string]string
+		want want
+	}{
+		"Empty": {
+			want: want{out: map[string]int{}},
+		},
+		"Valid": {
+			in: map[string]string{
+				"Instance.compute.gcp.crossplane.io":           "2",
+				"BucketPolicyMember.storage.gcp.crossplane.io": "50",
+			},
+			want: want{out: map[string]int{
+				"instance.compute.gcp.crossplane.io":           2,
+				"bucketpolicymember.storage.gcp.crossplane.io": 50,
+			}},
+		},
+		"NotANumber": {
+			in:   map[string]string{"Instance.compute.gcp.crossplane.io": "wide"},
+			want: want{err: errors.Wrapf(errNaN, errParseFmt, "wide", "Instance.compute.gcp.crossplane.io")},
+		},
+		"NotPositive": {
+			in:   map[string]string{"Instance.compute.gcp.crossplane.io": "0"},
+			want: want{err: errors.Errorf(errNotPositive, "Instance.compute.gcp.crossplane.io")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out, err := Parse(tc.in)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Parse(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.out, out); diff != "" {
+				t.Errorf("Parse(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestForControllerRuntime(t *testing.T) {
+	SetOverrides(map[string]int{"Instance.compute.gcp.crossplane.io": 2})
+	defer SetOverrides(nil)
+
+	cases := map[string]struct {
+		name string
+		want int
+	}{
+		"Overridden": {
+			name: "managed/instance.compute.gcp.crossplane.io",
+			want: 2,
+		},
+		"NotOverridden": {
+			name: "managed/bucket.storage.gcp.crossplane.io",
+			want: 10,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ForControllerRuntime(tc.name, controller.Options{MaxConcurrentReconciles: 10})
+			if diff := cmp.Diff(tc.want, got.MaxConcurrentReconciles); diff != "" {
+				t.Errorf("ForControllerRuntime(...): -want, +got:\n%s", diff)
+			}
+			if got.RateLimiter == nil {
+				t.Errorf("ForControllerRuntime(...): want controller rate limiter, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package consistency tolerates the eventual consistency of Google Cloud IAM.
+// Service accounts and policy bindings may not be visible to reads, or may not
+// yet grant the permissions needed to read them, for a while after they were
+// created.
+package consistency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// DefaultGracePeriod is the default duration after the creation of an
+// external resource during which it may not be visible yet.
+const DefaultGracePeriod = 2 * time.Minute
+
+var (
+	gracePeriodMu sync.RWMutex
+	gracePeriod   = DefaultGracePeriod
+)
+
+// SetGracePeriod configures the duration returned by GracePeriod.
+func SetGracePeriod(d time.Duration) {
+	gracePeriodMu.Lock()
+	defer gracePeriodMu.Unlock()
+	gracePeriod = d
+}
+
+// GracePeriod returns the duration after the creation of an external resource
+// during which it may not be visible yet. Controllers whose connecters are
+// wrapped by WrapConnecter should use it as the creation grace period of
+// their managed reconciler, so that a resource that is not visible yet is
+// requeued instead of created again.
+func GracePeriod() time.Duration {
+	gracePeriodMu.RLock()
+	defer gracePeriodMu.RUnlock()
+	return gracePeriod
+}
+
+// IsPending returns true if the supplied error is a "not found" or
+// "forbidden" error that was returned within the grace period after the
+// external resource of the supplied managed resource was created.
+func IsPending(mg resource.Managed, err error) bool {
+	if !gcp.IsErrorNotFound(err) && !gcp.IsErrorForbidden(err) {
+		return false
+	}
+	return meta.ExternalCreateSucceededDuring(mg, GracePeriod())
+}
+
+// WrapConnecter returns an ExternalConnecter whose external clients observe
+// an external resource that cannot be read within the grace period after its
+// creation, because it is not found or reading it is forbidden, as not
+// existing instead of returning an error. Paired with a creation grace period
+// of the managed reconciler this makes the reconciler wait for the resource
+// to become visible rather than create it again.
+func WrapConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{wrapped: c}
+}
+
+type connecter struct {
+	wrapped managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.wrapped.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: e}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil && IsPending(mg, err) {
+		return managed.ExternalObservation{}, nil
+	}
+	return o, err
+}
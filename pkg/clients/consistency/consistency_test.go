@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consistency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func created(ago time.Duration) *fake.Managed {
+	mg := &fake.Managed{}
+	meta.SetExternalCreateSucceeded(mg, time.Now().Add(-ago))
+	return mg
+}
+
+func TestObserve(t *testing.T) {
+	errNotFound := &googleapi.Error{Code: http.StatusNotFound}
+	errForbidden := &googleapi.Error{Code: http.StatusForbidden}
+	errBoom := errors.New("boom")
+	exists := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		mg   resource.Managed
+		obs  managed.ExternalObservation
+		err  error
+		want want
+	}{
+		"Success": {
+			mg:   created(time.Second),
+			obs:  exists,
+			want: want{obs: exists},
+		},
+		"NotFoundAfterCreation": {
+			mg:  created(time.Second),
+			err: errors.Wrap(errNotFound, "cannot get"),
+		},
+		"ForbiddenAfterCreation": {
+			mg:  created(time.Second),
+			err: errForbidden,
+		},
+		"NotFoundAfterGracePeriod": {
+			mg:   created(DefaultGracePeriod + time.Minute),
+			err:  errNotFound,
+			want: want{err: errNotFound},
+		},
+		"NeverCreated": {
+			mg:   &fake.Managed{},
+			err:  errForbidden,
+			want: want{err: errForbidden},
+		},
+		"OtherError": {
+			mg:   created(time.Second),
+			err:  errBoom,
+			want: want{err: errBoom},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := WrapConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return tc.obs, tc.err
+					},
+				}, nil
+			}))
+			e, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %v", err)
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetGracePeriod(t *testing.T) {
+	defer SetGracePeriod(DefaultGracePeriod)
+	SetGracePeriod(time.Second)
+	if IsPending(created(time.Minute), &googleapi.Error{Code: http.StatusNotFound}) {
+		t.Errorf("IsPending(...): want false after a shorter grace period")
+	}
+}
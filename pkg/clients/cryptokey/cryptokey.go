@@ -32,6 +32,12 @@ import (
 
 const errCheckUpToDate = "unable to determine if external resource is up to date"
 
+// Protection levels of keys in external key managers.
+const (
+	ProtectionLevelExternal    = "EXTERNAL"
+	ProtectionLevelExternalVPC = "EXTERNAL_VPC"
+)
+
 // Client should be satisfied to conduct SA operations.
 type Client interface {
 	Create(parent string, cryptokey *cloudkms.CryptoKey) *cloudkms.ProjectsLocationsKeyRingsCryptoKeysCreateCall
@@ -41,6 +47,7 @@ type Client interface {
 
 // GenerateCryptoKeyInstance generates *kmsv1.CryptoKey instance from CryptoKeyParameters.
 func GenerateCryptoKeyInstance(in v1alpha1.CryptoKeyParameters, ck *cloudkms.CryptoKey) {
+	ck.CryptoKeyBackend = gcp.StringValue(in.CryptoKeyBackend)
 	ck.Labels = in.Labels
 	ck.Purpose = in.Purpose
 	ck.RotationPeriod = gcp.StringValue(in.RotationPeriod)
@@ -54,6 +61,20 @@ func GenerateCryptoKeyInstance(in v1alpha1.CryptoKeyParameters, ck *cloudkms.Cry
 	}
 }
 
+// IsExternal returns whether the versions of the CryptoKey are kept in an
+// external key manager. Such CryptoKeys are created without an initial
+// version, because their versions must refer to an external key.
+func IsExternal(in v1alpha1.CryptoKeyParameters) bool {
+	if in.VersionTemplate == nil {
+		return false
+	}
+	switch gcp.StringValue(in.VersionTemplate.ProtectionLevel) {
+	case ProtectionLevelExternal, ProtectionLevelExternalVPC:
+		return true
+	}
+	return false
+}
+
 // GenerateObservation produces CryptoKeyObservation object from cloudkms.CryptoKey object.
 func GenerateObservation(in cloudkms.CryptoKey) v1alpha1.CryptoKeyObservation { // nolint:gocyclo
 	o := v1alpha1.CryptoKeyObservation{
@@ -95,6 +116,7 @@ func GenerateObservation(in cloudkms.CryptoKey) v1alpha1.CryptoKeyObservation {
 // LateInitializeSpec fills unassigned fields with the values in cloudkms.CryptoKey object.
 func LateInitializeSpec(spec *v1alpha1.CryptoKeyParameters, in cloudkms.CryptoKey) {
 	spec.Labels = in.Labels
+	spec.CryptoKeyBackend = gcp.LateInitializeString(spec.CryptoKeyBackend, in.CryptoKeyBackend)
 	spec.RotationPeriod = gcp.LateInitializeString(spec.RotationPeriod, in.RotationPeriod)
 	spec.NextRotationTime = gcp.LateInitializeString(spec.NextRotationTime, in.NextRotationTime)
 	if in.VersionTemplate != nil {
@@ -103,3 +103,25 @@ func TestGenerateObservation(t *testing.T) {
 		})
 	}
 }
+
+func TestIsExternal(t *testing.T) {
+	level := func(l string) v1alpha1.CryptoKeyParameters {
+		return v1alpha1.CryptoKeyParameters{VersionTemplate: &v1alpha1.CryptoKeyVersionTemplate{ProtectionLevel: &l}}
+	}
+	cases := map[string]struct {
+		in   v1alpha1.CryptoKeyParameters
+		want bool
+	}{
+		"NoVersionTemplate": {},
+		"Software":          {in: level("SOFTWARE")},
+		"External":          {in: level(ProtectionLevelExternal), want: true},
+		"ExternalVPC":       {in: level(ProtectionLevelExternalVPC), want: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsExternal(tc.in)); diff != "" {
+				t.Errorf("IsExternal(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
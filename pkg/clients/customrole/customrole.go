@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customrole
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/iam/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// UpdateMask lists the fields of a role that are patched to match a
+// CustomRole.
+const UpdateMask = "title,description,includedPermissions,stage"
+
+// DisableMask lists the fields of a role that are patched to disable it.
+const DisableMask = "stage"
+
+// A Client manages custom roles of both projects and organizations, which the
+// IAM API serves through separate services.
+type Client interface {
+	Get(ctx context.Context, name string) (*iam.Role, error)
+	Create(ctx context.Context, parent string, req *iam.CreateRoleRequest) (*iam.Role, error)
+	Patch(ctx context.Context, name string, role *iam.Role, updateMask string) (*iam.Role, error)
+	Delete(ctx context.Context, name string) (*iam.Role, error)
+	Undelete(ctx context.Context, name string, req *iam.UndeleteRoleRequest) (*iam.Role, error)
+}
+
+// NewClient returns a Client backed by the supplied IAM service.
+func NewClient(s *iam.Service) Client {
+	return &roleClient{projects: s.Projects.Roles, organizations: s.Organizations.Roles}
+}
+
+type roleClient struct {
+	projects      *iam.ProjectsRolesService
+	organizations *iam.OrganizationsRolesService
+}
+
+func (c *roleClient) Get(ctx context.Context, name string) (*iam.Role, error) {
+	if isOrganization(name) {
+		return c.organizations.Get(name).Context(ctx).Do()
+	}
+	return c.projects.Get(name).Context(ctx).Do()
+}
+
+func (c *roleClient) Create(ctx context.Context, parent string, req *iam.CreateRoleRequest) (*iam.Role, error) {
+	if isOrganization(parent) {
+		return c.organizations.Create(parent, req).Context(ctx).Do()
+	}
+	return c.projects.Create(parent, req).Context(ctx).Do()
+}
+
+func (c *roleClient) Patch(ctx context.Context, name string, role *iam.Role, updateMask string) (*iam.Role, error) {
+	if isOrganization(name) {
+		return c.organizations.Patch(name, role).UpdateMask(updateMask).Context(ctx).Do()
+	}
+	return c.projects.Patch(name, role).UpdateMask(updateMask).Context(ctx).Do()
+}
+
+func (c *roleClient) Delete(ctx context.Context, name string) (*iam.Role, error) {
+	if isOrganization(name) {
+		return c.organizations.Delete(name).Context(ctx).Do()
+	}
+	return c.projects.Delete(name).Context(ctx).Do()
+}
+
+func (c *roleClient) Undelete(ctx context.Context, name string, req *iam.UndeleteRoleRequest) (*iam.Role, error) {
+	if isOrganization(name) {
+		return c.organizations.Undelete(name, req).Context(ctx).Do()
+	}
+	return c.projects.Undelete(name, req).Context(ctx).Do()
+}
+
+func isOrganization(name string) bool {
+	return gcpv1beta1.ScopeOf(name) == gcpv1beta1.ScopeOrganization
+}
+
+// GetScope returns the scope of the role of the supplied
+// CustomRoleParameters, i.e. Project or Organization.
+func GetScope(in v1alpha1.CustomRoleParameters) string {
+	if in.Scope != nil {
+		return *in.Scope
+	}
+	if gcp.StringValue(in.Organization) != "" {
+		return gcpv1beta1.ScopeOrganization
+	}
+	return gcpv1beta1.ScopeProject
+}
+
+// GetParent returns the relative resource name of the project or
+// organization the role of the supplied CustomRoleParameters belongs to.
+func GetParent(in v1alpha1.CustomRoleParameters, project string) string {
+	if GetScope(in) == gcpv1beta1.ScopeOrganization {
+		return gcpv1beta1.ScopedName(gcpv1beta1.ScopeOrganization, gcp.StringValue(in.Organization))
+	}
+	return gcpv1beta1.ScopedName(gcpv1beta1.ScopeProject, project)
+}
+
+// GetName returns the relative resource name of the role with the supplied
+// ID in the supplied parent.
+func GetName(parent, id string) string {
+	return parent + "/roles/" + id
+}
+
+// GenerateRole produces an *iam.Role from the supplied
+// CustomRoleParameters.
+func GenerateRole(in v1alpha1.CustomRoleParameters) *iam.Role {
+	r := &iam.Role{
+		Title:               gcp.StringValue(in.Title),
+		Description:         gcp.StringValue(in.Description),
+		IncludedPermissions: in.Permissions,
+		Stage:               gcp.StringValue(in.Stage),
+	}
+	// An empty title or description clears the one of the role on patch.
+	r.ForceSendFields = []string{"Title", "Description"}
+	return r
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// *iam.Role and reports whether any field was filled.
+func LateInitializeSpec(in *v1alpha1.CustomRoleParameters, observed *iam.Role) bool {
+	li := false
+	if in.Title == nil && observed.Title != "" {
+		in.Title = gcp.StringPtr(observed.Title)
+		li = true
+	}
+	if in.Description == nil && observed.Description != "" {
+		in.Description = gcp.StringPtr(observed.Description)
+		li = true
+	}
+	if in.Stage == nil && observed.Stage != "" {
+		in.Stage = gcp.StringPtr(observed.Stage)
+		li = true
+	}
+	return li
+}
+
+// IsUpToDate returns true if the supplied *iam.Role matches the supplied
+// CustomRoleParameters. The order of permissions is not significant.
+func IsUpToDate(in v1alpha1.CustomRoleParameters, observed *iam.Role) bool {
+	if gcp.StringValue(in.Title) != observed.Title ||
+		gcp.StringValue(in.Description) != observed.Description {
+		return false
+	}
+	if in.Stage != nil && *in.Stage != observed.Stage {
+		return false
+	}
+	return cmp.Equal(in.Permissions, observed.IncludedPermissions,
+		cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+// GenerateDisabledRole produces an *iam.Role that, patched with the
+// DisableMask, moves a role to the DISABLED stage.
+func GenerateDisabledRole() *iam.Role {
+	return &iam.Role{Stage: v1alpha1.CustomRoleStageDisabled}
+}
+
+// UpdateObservation updates the supplied CustomRoleObservation with the
+// supplied *iam.Role.
+func UpdateObservation(o *v1alpha1.CustomRoleObservation, observed *iam.Role) {
+	o.Name = observed.Name
+	o.Stage = observed.Stage
+	o.Deleted = observed.Deleted
+	o.Etag = observed.Etag
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customrole
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	project = "my-project"
+	org     = "123456789"
+)
+
+var permissions = []string{"storage.buckets.get", "storage.buckets.list"}
+
+func TestGetParent(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.CustomRoleParameters
+		want string
+	}{
+		"Project":      {want: "projects/" + project},
+		"Organization": {in: v1alpha1.CustomRoleParameters{Organization: gcp.StringPtr(org)}, want: "organizations/" + org},
+		"OrganizationScope": {
+			in:   v1alpha1.CustomRoleParameters{Scope: gcp.StringPtr(gcpv1beta1.ScopeOrganization), Organization: gcp.StringPtr("organizations/" + org)},
+			want: "organizations/" + org,
+		},
+		"ProjectScope": {in: v1alpha1.CustomRoleParameters{Scope: gcp.StringPtr(gcpv1beta1.ScopeProject)}, want: "projects/" + project},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetParent(tc.in, project)); diff != "" {
+				t.Errorf("GetParent(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		path string
+	}{
+		"Project":      {name: GetName("projects/"+project, "reader"), path: "/v1/projects/" + project + "/roles/reader"},
+		"Organization": {name: GetName("organizations/"+org, "reader"), path: "/v1/organizations/" + org + "/roles/reader"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.path, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&iam.Role{Name: tc.name})
+			}))
+			defer server.Close()
+			s, _ := iam.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			got, err := NewClient(s).Get(context.Background(), tc.name)
+			if err != nil {
+				t.Fatalf("Get(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.name, got.Name); diff != "" {
+				t.Errorf("Get(...): -want name, +got name:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRole(t *testing.T) {
+	in := v1alpha1.CustomRoleParameters{
+		Title:       gcp.StringPtr("Bucket Reader"),
+		Permissions: permissions,
+		Stage:       gcp.StringPtr(v1alpha1.CustomRoleStageBeta),
+	}
+	want := &iam.Role{
+		Title:               "Bucket Reader",
+		IncludedPermissions: permissions,
+		Stage:               v1alpha1.CustomRoleStageBeta,
+		ForceSendFields:     []string{"Title", "Description"},
+	}
+	if diff := cmp.Diff(want, GenerateRole(in)); diff != "" {
+		t.Errorf("GenerateRole(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type want struct {
+		in v1alpha1.CustomRoleParameters
+		li bool
+	}
+	observed := &iam.Role{Title: "Observed", Description: "Observed description", Stage: v1alpha1.CustomRoleStageAlpha}
+	cases := map[string]struct {
+		in   v1alpha1.CustomRoleParameters
+		want want
+	}{
+		"AllUnset": {
+			want: want{
+				in: v1alpha1.CustomRoleParameters{
+					Title:       gcp.StringPtr("Observed"),
+					Description: gcp.StringPtr("Observed description"),
+					Stage:       gcp.StringPtr(v1alpha1.CustomRoleStageAlpha),
+				},
+				li: true,
+			},
+		},
+		"AllSet": {
+			in: v1alpha1.CustomRoleParameters{
+				Title:       gcp.StringPtr("Title"),
+				Description: gcp.StringPtr(""),
+				Stage:       gcp.StringPtr(v1alpha1.CustomRoleStageGA),
+			},
+			want: want{
+				in: v1alpha1.CustomRoleParameters{
+					Title:       gcp.StringPtr("Title"),
+					Description: gcp.StringPtr(""),
+					Stage:       gcp.StringPtr(v1alpha1.CustomRoleStageGA),
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			li := LateInitializeSpec(&tc.in, observed)
+			if diff := cmp.Diff(tc.want, want{in: tc.in, li: li}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &iam.Role{
+		Title:               "Bucket Reader",
+		IncludedPermissions: []string{"storage.buckets.list", "storage.buckets.get"},
+		Stage:               v1alpha1.CustomRoleStageGA,
+	}
+	cases := map[string]struct {
+		in   v1alpha1.CustomRoleParameters
+		want bool
+	}{
+		"UpToDate": {
+			in:   v1alpha1.CustomRoleParameters{Title: gcp.StringPtr("Bucket Reader"), Permissions: permissions},
+			want: true,
+		},
+		"TitleChanged": {
+			in: v1alpha1.CustomRoleParameters{Title: gcp.StringPtr("Reader"), Permissions: permissions},
+		},
+		"PermissionRemoved": {
+			in: v1alpha1.CustomRoleParameters{Title: gcp.StringPtr("Bucket Reader"), Permissions: permissions[:1]},
+		},
+		"StageChanged": {
+			in: v1alpha1.CustomRoleParameters{Title: gcp.StringPtr("Bucket Reader"), Permissions: permissions, Stage: gcp.StringPtr(v1alpha1.CustomRoleStageDeprecated)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.in, observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datapolicy
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/bigquery/datapolicies/apiv1/datapoliciespb"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFmt = "projects/%s/locations/%s"
+	nameFmt   = parentFmt + "/dataPolicies/%s"
+)
+
+// Client should be satisfied to conduct DataPolicy operations.
+type Client interface {
+	CreateDataPolicy(ctx context.Context, req *datapoliciespb.CreateDataPolicyRequest, opts ...gax.CallOption) (*datapoliciespb.DataPolicy, error)
+	GetDataPolicy(ctx context.Context, req *datapoliciespb.GetDataPolicyRequest, opts ...gax.CallOption) (*datapoliciespb.DataPolicy, error)
+	UpdateDataPolicy(ctx context.Context, req *datapoliciespb.UpdateDataPolicyRequest, opts ...gax.CallOption) (*datapoliciespb.DataPolicy, error)
+	DeleteDataPolicy(ctx context.Context, req *datapoliciespb.DeleteDataPolicyRequest, opts ...gax.CallOption) error
+}
+
+// GetParent builds the name of the location a data policy belongs to.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFmt, projectID, location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a data policy.
+func GetFullyQualifiedName(projectID, location, id string) string {
+	return fmt.Sprintf(nameFmt, projectID, location, id)
+}
+
+// GenerateDataPolicy generates *datapoliciespb.DataPolicy instance from
+// DataPolicyParameters.
+func GenerateDataPolicy(projectID, id string, in v1alpha1.DataPolicyParameters) *datapoliciespb.DataPolicy {
+	dp := &datapoliciespb.DataPolicy{
+		Name:           GetFullyQualifiedName(projectID, in.Location, id),
+		DataPolicyId:   id,
+		DataPolicyType: datapoliciespb.DataPolicy_DataPolicyType(datapoliciespb.DataPolicy_DataPolicyType_value[in.DataPolicyType]),
+		MatchingLabel:  &datapoliciespb.DataPolicy_PolicyTag{PolicyTag: gcp.StringValue(in.PolicyTag)},
+	}
+	if m := in.DataMaskingPolicy; m != nil {
+		dp.Policy = &datapoliciespb.DataPolicy_DataMaskingPolicy{
+			DataMaskingPolicy: &datapoliciespb.DataMaskingPolicy{
+				MaskingExpression: &datapoliciespb.DataMaskingPolicy_PredefinedExpression_{
+					PredefinedExpression: datapoliciespb.DataMaskingPolicy_PredefinedExpression(datapoliciespb.DataMaskingPolicy_PredefinedExpression_value[m.PredefinedExpression]),
+				},
+			},
+		}
+	}
+	return dp
+}
+
+// GenerateUpdateMask returns the fields of a data policy with the supplied
+// parameters that are updated in place. The type of a data policy cannot be
+// updated.
+func GenerateUpdateMask(in v1alpha1.DataPolicyParameters) *fieldmaskpb.FieldMask {
+	m := &fieldmaskpb.FieldMask{Paths: []string{"policy_tag"}}
+	if in.DataMaskingPolicy != nil {
+		m.Paths = append(m.Paths, "data_masking_policy")
+	}
+	return m
+}
+
+// GenerateObservation produces DataPolicyObservation object from
+// *datapoliciespb.DataPolicy object.
+func GenerateObservation(in *datapoliciespb.DataPolicy) v1alpha1.DataPolicyObservation {
+	return v1alpha1.DataPolicyObservation{Name: in.GetName()}
+}
+
+// IsUpToDate checks whether the observed data policy is up-to-date compared
+// to the given set of parameters.
+func IsUpToDate(in v1alpha1.DataPolicyParameters, observed *datapoliciespb.DataPolicy) bool {
+	if observed.GetPolicyTag() != gcp.StringValue(in.PolicyTag) {
+		return false
+	}
+	if in.DataMaskingPolicy == nil {
+		return true
+	}
+	return observed.GetDataMaskingPolicy().GetPredefinedExpression().String() == in.DataMaskingPolicy.PredefinedExpression
+}
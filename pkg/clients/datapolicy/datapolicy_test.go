@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datapolicy
+
+import (
+	"testing"
+
+	"cloud.google.com/go/bigquery/datapolicies/apiv1/datapoliciespb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject   = "my-project"
+	testID        = "mask_email"
+	testPolicyTag = "projects/my-project/locations/us/taxonomies/123/policyTags/456"
+)
+
+func params(m ...func(*v1alpha1.DataPolicyParameters)) v1alpha1.DataPolicyParameters {
+	p := v1alpha1.DataPolicyParameters{
+		Location:          "us",
+		DataPolicyType:    v1alpha1.DataPolicyTypeDataMasking,
+		PolicyTag:         gcp.StringPtr(testPolicyTag),
+		DataMaskingPolicy: &v1alpha1.DataMaskingPolicy{PredefinedExpression: "SHA256"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func dataPolicy(expr datapoliciespb.DataMaskingPolicy_PredefinedExpression) *datapoliciespb.DataPolicy {
+	return &datapoliciespb.DataPolicy{
+		Name:           "projects/my-project/locations/us/dataPolicies/mask_email",
+		DataPolicyId:   testID,
+		DataPolicyType: datapoliciespb.DataPolicy_DATA_MASKING_POLICY,
+		MatchingLabel:  &datapoliciespb.DataPolicy_PolicyTag{PolicyTag: testPolicyTag},
+		Policy: &datapoliciespb.DataPolicy_DataMaskingPolicy{
+			DataMaskingPolicy: &datapoliciespb.DataMaskingPolicy{
+				MaskingExpression: &datapoliciespb.DataMaskingPolicy_PredefinedExpression_{PredefinedExpression: expr},
+			},
+		},
+	}
+}
+
+func TestGenerateDataPolicy(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.DataPolicyParameters
+		want *datapoliciespb.DataPolicy
+	}{
+		"DataMasking": {
+			in:   params(),
+			want: dataPolicy(datapoliciespb.DataMaskingPolicy_SHA256),
+		},
+		"ColumnLevelSecurity": {
+			in: params(func(p *v1alpha1.DataPolicyParameters) {
+				p.DataPolicyType = v1alpha1.DataPolicyTypeColumnLevelSecurity
+				p.DataMaskingPolicy = nil
+			}),
+			want: &datapoliciespb.DataPolicy{
+				Name:           "projects/my-project/locations/us/dataPolicies/mask_email",
+				DataPolicyId:   testID,
+				DataPolicyType: datapoliciespb.DataPolicy_COLUMN_LEVEL_SECURITY_POLICY,
+				MatchingLabel:  &datapoliciespb.DataPolicy_PolicyTag{PolicyTag: testPolicyTag},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateDataPolicy(testProject, testID, tc.in)
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("GenerateDataPolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.DataPolicyParameters
+		want *fieldmaskpb.FieldMask
+	}{
+		"DataMasking": {
+			in:   params(),
+			want: &fieldmaskpb.FieldMask{Paths: []string{"policy_tag", "data_masking_policy"}},
+		},
+		"ColumnLevelSecurity": {
+			in:   params(func(p *v1alpha1.DataPolicyParameters) { p.DataMaskingPolicy = nil }),
+			want: &fieldmaskpb.FieldMask{Paths: []string{"policy_tag"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(tc.in), protocmp.Transform()); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.DataPolicyParameters
+		observed *datapoliciespb.DataPolicy
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: dataPolicy(datapoliciespb.DataMaskingPolicy_SHA256),
+			want:     true,
+		},
+		"PolicyTagChanged": {
+			in:       params(func(p *v1alpha1.DataPolicyParameters) { p.PolicyTag = gcp.StringPtr(testPolicyTag + "7") }),
+			observed: dataPolicy(datapoliciespb.DataMaskingPolicy_SHA256),
+		},
+		"MaskingExpressionChanged": {
+			in:       params(),
+			observed: dataPolicy(datapoliciespb.DataMaskingPolicy_ALWAYS_NULL),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.in, tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
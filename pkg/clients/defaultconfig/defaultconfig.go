@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package defaultconfig selects the ProviderConfig of managed resources that
+// omit their providerConfigRef using DefaultProviderConfigs.
+package defaultconfig
+
+import (
+	"context"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+const (
+	// Name of the ProviderConfig referenced by managed resources that omit
+	// their providerConfigRef.
+	Name = "default"
+
+	// LabelKeyClaimNamespace is the label Crossplane sets on resources
+	// composed for a claim to the namespace of the claim.
+	LabelKeyClaimNamespace = "crossplane.io/claim-namespace"
+)
+
+const (
+	errList     = "cannot list DefaultProviderConfigs"
+	errSelector = "cannot parse selector of DefaultProviderConfig"
+	errUpdate   = "cannot update managed resource with default providerConfigRef"
+)
+
+// Namespace returns the namespace of the supplied managed resource, i.e. the
+// namespace of the claim it was composed for, or the empty string if it was
+// not composed for a claim.
+func Namespace(mg resource.Managed) string {
+	return mg.GetLabels()[LabelKeyClaimNamespace]
+}
+
+// Matches returns true if the supplied DefaultProviderConfig applies to the
+// supplied managed resource.
+func Matches(d v1beta1.DefaultProviderConfig, mg resource.Managed) (bool, error) {
+	if len(d.Spec.Namespaces) > 0 {
+		ns, found := Namespace(mg), false
+		for _, n := range d.Spec.Namespaces {
+			if n == ns {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	if d.Spec.Selector == nil {
+		return true, nil
+	}
+	s, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return false, errors.Wrap(err, errSelector)
+	}
+	return s.Matches(labels.Set(mg.GetLabels())), nil
+}
+
+// Select returns the DefaultProviderConfig with the highest priority of
+// those that apply to the supplied managed resource, breaking ties by the
+// lexically smallest name, or nil if none applies.
+func Select(ds []v1beta1.DefaultProviderConfig, mg resource.Managed) (*v1beta1.DefaultProviderConfig, error) {
+	ds = append([]v1beta1.DefaultProviderConfig(nil), ds...)
+	sort.SliceStable(ds, func(i, j int) bool {
+		if ds[i].Spec.Priority != ds[j].Spec.Priority {
+			return ds[i].Spec.Priority > ds[j].Spec.Priority
+		}
+		return ds[i].GetName() < ds[j].GetName()
+	})
+	for i := range ds {
+		ok, err := Matches(ds[i], mg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s", ds[i].GetName())
+		}
+		if ok {
+			return &ds[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Resolve sets the providerConfigRef of the supplied managed resource to the
+// ProviderConfig of the DefaultProviderConfig selected for it, if it omits
+// its providerConfigRef, i.e. references the ProviderConfig named "default".
+// The selected providerConfigRef is persisted so that the managed resource
+// keeps using it even if DefaultProviderConfigs are changed later.
+func Resolve(ctx context.Context, c client.Client, mg resource.Managed) error {
+	if ref := mg.GetProviderConfigReference(); ref == nil || ref.Name != Name {
+		return nil
+	}
+	l := &v1beta1.DefaultProviderConfigList{}
+	if err := c.List(ctx, l); err != nil {
+		return errors.Wrap(err, errList)
+	}
+	d, err := Select(l.Items, mg)
+	if err != nil || d == nil {
+		return err
+	}
+	if d.Spec.ProviderConfigReference.Name == Name {
+		return nil
+	}
+	ref := d.Spec.ProviderConfigReference
+	mg.SetProviderConfigReference(&xpv1.Reference{Name: ref.Name, Policy: ref.Policy})
+	return errors.Wrap(c.Update(ctx, mg), errUpdate)
+}
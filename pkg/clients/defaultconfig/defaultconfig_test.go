@@ -0,0 +1,202 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+var errBoom = errors.New("boom")
+
+func managed(pc string, labels map[string]string) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetLabels(labels)
+	if pc != "" {
+		mg.SetProviderConfigReference(&xpv1.Reference{Name: pc})
+	}
+	return mg
+}
+
+func dpc(name, pc string, priority int32, namespaces []string, selector *metav1.LabelSelector) v1beta1.DefaultProviderConfig {
+	return v1beta1.DefaultProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.DefaultProviderConfigSpec{
+			ProviderConfigReference: xpv1.Reference{Name: pc},
+			Namespaces:              namespaces,
+			Selector:                selector,
+			Priority:                priority,
+		},
+	}
+}
+
+func TestSelect(t *testing.T) {
+	team := map[string]string{LabelKeyClaimNamespace: "team-a", "tier": "prod"}
+	prod := &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "prod"}}
+	invalid := &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Bogus"}}}
+
+	type want struct {
+		name string
+		err  bool
+	}
+	cases := map[string]struct {
+		ds   []v1beta1.DefaultProviderConfig
+		mg   *fake.Managed
+		want want
+	}{
+		"NoDefaults": {
+			mg: managed(Name, team),
+		},
+		"ClusterWide": {
+			ds:   []v1beta1.DefaultProviderConfig{dpc("cluster", "shared", 0, nil, nil)},
+			mg:   managed(Name, nil),
+			want: want{name: "cluster"},
+		},
+		"OtherNamespace": {
+			ds: []v1beta1.DefaultProviderConfig{dpc("team-b", "b", 0, []string{"team-b"}, nil)},
+			mg: managed(Name, team),
+		},
+		"NamespaceWithoutClaim": {
+			ds: []v1beta1.DefaultProviderConfig{dpc("team-a", "a", 0, []string{"team-a"}, nil)},
+			mg: managed(Name, nil),
+		},
+		"HighestPriority": {
+			ds: []v1beta1.DefaultProviderConfig{
+				dpc("cluster", "shared", 0, nil, nil),
+				dpc("team-a", "a", 10, []string{"team-a"}, nil),
+				dpc("team-a-prod", "a-prod", 20, []string{"team-a"}, prod),
+			},
+			mg:   managed(Name, team),
+			want: want{name: "team-a-prod"},
+		},
+		"SelectorMismatch": {
+			ds: []v1beta1.DefaultProviderConfig{
+				dpc("cluster", "shared", 0, nil, nil),
+				dpc("team-a-prod", "a-prod", 20, []string{"team-a"}, prod),
+			},
+			mg:   managed(Name, map[string]string{LabelKeyClaimNamespace: "team-a"}),
+			want: want{name: "cluster"},
+		},
+		"TieBrokenByName": {
+			ds: []v1beta1.DefaultProviderConfig{
+				dpc("b", "b", 5, nil, nil),
+				dpc("a", "a", 5, nil, nil),
+			},
+			mg:   managed(Name, nil),
+			want: want{name: "a"},
+		},
+		"InvalidSelector": {
+			ds:   []v1beta1.DefaultProviderConfig{dpc("invalid", "a", 0, nil, invalid)},
+			mg:   managed(Name, nil),
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, err := Select(tc.ds, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("Select(...): -want error, +got error:\n%s\n%v", diff, err)
+			}
+			got := ""
+			if d != nil {
+				got = d.GetName()
+			}
+			if diff := cmp.Diff(tc.want.name, got); diff != "" {
+				t.Errorf("Select(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	list := func(ds ...v1beta1.DefaultProviderConfig) test.MockListFn {
+		return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*v1beta1.DefaultProviderConfigList).Items = ds
+			return nil
+		}
+	}
+
+	type want struct {
+		ref *xpv1.Reference
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		mg   *fake.Managed
+		want want
+	}{
+		"Explicit": {
+			kube: &test.MockClient{},
+			mg:   managed("team-a", nil),
+			want: want{ref: &xpv1.Reference{Name: "team-a"}},
+		},
+		"Omitted": {
+			kube: &test.MockClient{},
+			mg:   managed("", nil),
+		},
+		"ListError": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+			mg:   managed(Name, nil),
+			want: want{ref: &xpv1.Reference{Name: Name}, err: errors.Wrap(errBoom, errList)},
+		},
+		"NoMatch": {
+			kube: &test.MockClient{MockList: list()},
+			mg:   managed(Name, nil),
+			want: want{ref: &xpv1.Reference{Name: Name}},
+		},
+		"Selected": {
+			kube: &test.MockClient{
+				MockList:   list(dpc("cluster", "shared", 0, nil, nil)),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			mg:   managed(Name, nil),
+			want: want{ref: &xpv1.Reference{Name: "shared"}},
+		},
+		"UpdateError": {
+			kube: &test.MockClient{
+				MockList:   list(dpc("cluster", "shared", 0, nil, nil)),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			mg:   managed(Name, nil),
+			want: want{ref: &xpv1.Reference{Name: "shared"}, err: errors.Wrap(errBoom, errUpdate)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Resolve(context.Background(), tc.kube, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Resolve(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ref, tc.mg.GetProviderConfigReference()); diff != "" {
+				t.Errorf("Resolve(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
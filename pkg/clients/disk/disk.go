@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"path"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// TypeURL returns the partially qualified URL of the supplied disk type in
+// the zone or region of a disk. Disk types that are already URLs are returned
+// unchanged.
+func TypeURL(in v1alpha1.DiskParameters, diskType string) string {
+	if diskType == "" || strings.Contains(diskType, "/") {
+		return diskType
+	}
+	if in.Region != nil {
+		return "regions/" + *in.Region + "/diskTypes/" + diskType
+	}
+	return "zones/" + gcp.StringValue(in.Zone) + "/diskTypes/" + diskType
+}
+
+// ReplicaZoneURLs returns the partially qualified URLs of the replica zones of
+// a regional disk.
+func ReplicaZoneURLs(in []string) []string {
+	if in == nil {
+		return nil
+	}
+	out := make([]string, len(in))
+	for i, z := range in {
+		if strings.Contains(z, "/") {
+			out[i] = z
+			continue
+		}
+		out[i] = "zones/" + z
+	}
+	return out
+}
+
+// GenerateDisk takes a DiskParameters and returns *compute.Disk. It assigns
+// only the fields that are writable, i.e. not labelled as [Output Only] in
+// Google's reference.
+func GenerateDisk(name string, in v1alpha1.DiskParameters) *compute.Disk {
+	return &compute.Disk{
+		Name:             name,
+		Description:      gcp.StringValue(in.Description),
+		Type:             TypeURL(in, gcp.StringValue(in.Type)),
+		SizeGb:           gcp.Int64Value(in.SizeGB),
+		SourceImage:      gcp.StringValue(in.SourceImage),
+		SourceSnapshot:   gcp.StringValue(in.SourceSnapshot),
+		ReplicaZones:     ReplicaZoneURLs(in.ReplicaZones),
+		Labels:           in.Labels,
+		ResourcePolicies: in.ResourcePolicies,
+	}
+}
+
+// GenerateObservation produces DiskObservation object from compute.Disk
+// object.
+func GenerateObservation(in compute.Disk) v1alpha1.DiskObservation {
+	return v1alpha1.DiskObservation{
+		CreationTimestamp:   in.CreationTimestamp,
+		ID:                  in.Id,
+		SelfLink:            in.SelfLink,
+		Status:              in.Status,
+		SizeGB:              in.SizeGb,
+		Users:               in.Users,
+		LastAttachTimestamp: in.LastAttachTimestamp,
+		LastDetachTimestamp: in.LastDetachTimestamp,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in compute.Disk
+// object.
+func LateInitializeSpec(p *v1alpha1.DiskParameters, observed compute.Disk) {
+	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
+	if observed.Type != "" {
+		p.Type = gcp.LateInitializeString(p.Type, path.Base(observed.Type))
+	}
+	p.SizeGB = gcp.LateInitializeInt64(p.SizeGB, observed.SizeGb)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, observed.Labels)
+	if len(p.ResourcePolicies) == 0 {
+		for _, rp := range observed.ResourcePolicies {
+			p.ResourcePolicies = append(p.ResourcePolicies, strings.TrimPrefix(rp, v1beta1.ComputeURIPrefix))
+		}
+	}
+}
+
+// LabelsUpToDate returns true if the labels of the observed disk match the
+// desired ones.
+func LabelsUpToDate(in v1alpha1.DiskParameters, observed compute.Disk) bool {
+	return cmp.Equal(in.Labels, observed.Labels, cmpopts.EquateEmpty())
+}
+
+// SizeUpToDate returns true unless the desired size of the disk is larger
+// than its observed size. Disks cannot shrink, so a smaller desired size is
+// never acted upon.
+func SizeUpToDate(in v1alpha1.DiskParameters, observed compute.Disk) bool {
+	return gcp.Int64Value(in.SizeGB) <= observed.SizeGb
+}
+
+// ResourcePoliciesToAttach returns the desired resource policies that are
+// not attached to the observed disk. Policies are compared by name, because
+// they are always in the region of the disk.
+func ResourcePoliciesToAttach(in v1alpha1.DiskParameters, observed compute.Disk) []string {
+	return policiesNotIn(in.ResourcePolicies, observed.ResourcePolicies)
+}
+
+// ResourcePoliciesToDetach returns the resource policies attached to the
+// observed disk that are not desired.
+func ResourcePoliciesToDetach(in v1alpha1.DiskParameters, observed compute.Disk) []string {
+	return policiesNotIn(observed.ResourcePolicies, in.ResourcePolicies)
+}
+
+func policiesNotIn(policies, other []string) []string {
+	names := make(map[string]bool, len(other))
+	for _, o := range other {
+		names[path.Base(o)] = true
+	}
+	var out []string
+	for _, p := range policies {
+		if !names[path.Base(p)] {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// IsUpToDate returns true if the mutable fields of the observed disk match
+// the desired ones.
+func IsUpToDate(in v1alpha1.DiskParameters, observed compute.Disk) bool {
+	return LabelsUpToDate(in, observed) && SizeUpToDate(in, observed) &&
+		len(ResourcePoliciesToAttach(in, observed)) == 0 && len(ResourcePoliciesToDetach(in, observed)) == 0
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func TestGenerateDisk(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.DiskParameters
+		want *compute.Disk
+	}{
+		"Zonal": {
+			in: v1alpha1.DiskParameters{
+				Zone:        gcp.StringPtr("us-central1-a"),
+				Type:        gcp.StringPtr("pd-ssd"),
+				SizeGB:      gcp.Int64Ptr(50),
+				SourceImage: gcp.StringPtr("projects/debian-cloud/global/images/family/debian-12"),
+				Labels:      map[string]string{"team": "data"},
+			},
+			want: &compute.Disk{
+				Name:        "d",
+				Type:        "zones/us-central1-a/diskTypes/pd-ssd",
+				SizeGb:      50,
+				SourceImage: "projects/debian-cloud/global/images/family/debian-12",
+				Labels:      map[string]string{"team": "data"},
+			},
+		},
+		"Regional": {
+			in: v1alpha1.DiskParameters{
+				Region:         gcp.StringPtr("us-central1"),
+				ReplicaZones:   []string{"us-central1-a", "projects/p/zones/us-central1-b"},
+				Type:           gcp.StringPtr("pd-balanced"),
+				SourceSnapshot: gcp.StringPtr("global/snapshots/s"),
+			},
+			want: &compute.Disk{
+				Name:           "d",
+				Type:           "regions/us-central1/diskTypes/pd-balanced",
+				ReplicaZones:   []string{"zones/us-central1-a", "projects/p/zones/us-central1-b"},
+				SourceSnapshot: "global/snapshots/s",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateDisk("d", tc.in)); diff != "" {
+				t.Errorf("GenerateDisk(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	observed := compute.Disk{
+		Type:   "https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/diskTypes/pd-standard",
+		SizeGb: 10,
+		Labels: map[string]string{"l": "v"},
+	}
+	want := &v1alpha1.DiskParameters{
+		Zone:   gcp.StringPtr("us-central1-a"),
+		Type:   gcp.StringPtr("pd-standard"),
+		SizeGB: gcp.Int64Ptr(10),
+		Labels: map[string]string{"l": "v"},
+	}
+	got := &v1alpha1.DiskParameters{Zone: gcp.StringPtr("us-central1-a")}
+	LateInitializeSpec(got, observed)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := compute.Disk{SizeGb: 10, Labels: map[string]string{"l": "v"}}
+	cases := map[string]struct {
+		in   v1alpha1.DiskParameters
+		want bool
+	}{
+		"UpToDate": {
+			in:   v1alpha1.DiskParameters{SizeGB: gcp.Int64Ptr(10), Labels: map[string]string{"l": "v"}},
+			want: true,
+		},
+		"LabelsChanged": {
+			in:   v1alpha1.DiskParameters{SizeGB: gcp.Int64Ptr(10)},
+			want: false,
+		},
+		"Grown": {
+			in:   v1alpha1.DiskParameters{SizeGB: gcp.Int64Ptr(20), Labels: map[string]string{"l": "v"}},
+			want: false,
+		},
+		"Shrunk": {
+			in:   v1alpha1.DiskParameters{SizeGB: gcp.Int64Ptr(5), Labels: map[string]string{"l": "v"}},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.in, observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourcePolicies(t *testing.T) {
+	observed := compute.Disk{ResourcePolicies: []string{
+		"https://www.googleapis.com/compute/v1/projects/p/regions/us-central1/resourcePolicies/daily",
+		"https://www.googleapis.com/compute/v1/projects/p/regions/us-central1/resourcePolicies/weekly",
+	}}
+	in := v1alpha1.DiskParameters{ResourcePolicies: []string{
+		"projects/p/regions/us-central1/resourcePolicies/daily",
+		"projects/p/regions/us-central1/resourcePolicies/hourly",
+	}}
+
+	if diff := cmp.Diff([]string{"projects/p/regions/us-central1/resourcePolicies/hourly"}, ResourcePoliciesToAttach(in, observed)); diff != "" {
+		t.Errorf("ResourcePoliciesToAttach(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{observed.ResourcePolicies[1]}, ResourcePoliciesToDetach(in, observed)); diff != "" {
+		t.Errorf("ResourcePoliciesToDetach(...): -want, +got:\n%s", diff)
+	}
+	if IsUpToDate(in, observed) {
+		t.Errorf("IsUpToDate(...): want false when resource policies differ")
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	dns "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// Connection detail keys of a ManagedZone.
+const (
+	// ConnectionKeyNameServers is a comma separated list of the name servers
+	// the zone should be delegated to.
+	ConnectionKeyNameServers = "nameServers"
+
+	// ConnectionKeyDSRecord is the SHA-256 DS record of the active key
+	// signing key, which is what most registrars expect.
+	ConnectionKeyDSRecord = "dsRecord"
+
+	// ConnectionKeyDSRecords is a newline separated list of the DS records
+	// of all active key signing keys, one per digest type.
+	ConnectionKeyDSRecords = "dsRecords"
+)
+
+const digestSHA256 = "sha256"
+
+// dnssecAlgorithms are the numbers of the DNSSEC algorithms in DS records.
+// https://www.iana.org/assignments/dns-sec-alg-numbers
+var dnssecAlgorithms = map[string]int{
+	"rsasha1":         5,
+	"rsasha256":       8,
+	"rsasha512":       10,
+	"ecdsap256sha256": 13,
+	"ecdsap384sha384": 14,
+}
+
+// digestTypes are the numbers of the digest types in DS records.
+// https://www.iana.org/assignments/ds-rr-types
+var digestTypes = map[string]int{
+	"sha1":       1,
+	digestSHA256: 2,
+	"sha384":     4,
+}
+
+// GenerateManagedZone generates *dns.ManagedZone instance from
+// ManagedZoneParameters.
+func GenerateManagedZone(name string, in v1alpha1.ManagedZoneParameters) *dns.ManagedZone {
+	mz := &dns.ManagedZone{
+		Name:        name,
+		DnsName:     in.DNSName,
+		Description: gcp.StringValue(in.Description),
+		Visibility:  gcp.StringValue(in.Visibility),
+		Labels:      in.Labels,
+	}
+	if pv := in.PrivateVisibilityConfig; pv != nil {
+		mz.PrivateVisibilityConfig = &dns.ManagedZonePrivateVisibilityConfig{}
+		for _, n := range pv.Networks {
+			mz.PrivateVisibilityConfig.Networks = append(mz.PrivateVisibilityConfig.Networks, &dns.ManagedZonePrivateVisibilityConfigNetwork{NetworkUrl: n.NetworkURL})
+		}
+	}
+	if c := in.DNSSECConfig; c != nil {
+		mz.DnssecConfig = &dns.ManagedZoneDnsSecConfig{
+			State:        c.State,
+			NonExistence: gcp.StringValue(c.NonExistence),
+		}
+		for _, s := range c.DefaultKeySpecs {
+			mz.DnssecConfig.DefaultKeySpecs = append(mz.DnssecConfig.DefaultKeySpecs, &dns.DnsKeySpec{KeyType: s.KeyType, Algorithm: s.Algorithm, KeyLength: s.KeyLength})
+		}
+	}
+	return mz
+}
+
+// GenerateManagedZoneObservation produces ManagedZoneObservation from the
+// observed managed zone and its DNSSEC keys.
+func GenerateManagedZoneObservation(in dns.ManagedZone, keys []*dns.DnsKey) v1alpha1.ManagedZoneObservation {
+	o := v1alpha1.ManagedZoneObservation{
+		ID:           in.Id,
+		CreationTime: in.CreationTime,
+		NameServers:  in.NameServers,
+	}
+	for _, k := range keys {
+		o.DNSKeys = append(o.DNSKeys, v1alpha1.DNSKeyObservation{
+			ID:        k.Id,
+			Type:      k.Type,
+			Algorithm: k.Algorithm,
+			KeyTag:    k.KeyTag,
+			IsActive:  k.IsActive,
+			DSRecords: DSRecords(k),
+		})
+	}
+	return o
+}
+
+// DSRecords returns the data of the DS records that point at the supplied
+// key signing key, one per digest of the key, in the presentation format of
+// RFC 4034, e.g. "2371 13 2 1F987CC6583E92DF0890718C42". It returns nothing
+// for zone signing keys.
+func DSRecords(k *dns.DnsKey) []string {
+	var out []string
+	for _, d := range k.Digests {
+		if r, ok := dsRecord(k, d); ok {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func dsRecord(k *dns.DnsKey, d *dns.DnsKeyDigest) (string, bool) {
+	alg, ok := dnssecAlgorithms[k.Algorithm]
+	if !ok || k.Type != v1alpha1.DNSKeyTypeKeySigning {
+		return "", false
+	}
+	dt, ok := digestTypes[d.Type]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("%d %d %d %s", k.KeyTag, alg, dt, strings.ToUpper(d.Digest)), true
+}
+
+// GetManagedZoneConnectionDetails returns the name servers of the managed
+// zone and the DS records of its active key signing keys, which are needed to
+// delegate to the zone from its parent zone.
+func GetManagedZoneConnectionDetails(in dns.ManagedZone, keys []*dns.DnsKey) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if len(in.NameServers) > 0 {
+		cd[ConnectionKeyNameServers] = []byte(strings.Join(in.NameServers, ","))
+	}
+	var records []string
+	for _, k := range keys {
+		if !k.IsActive || k.Type != v1alpha1.DNSKeyTypeKeySigning {
+			continue
+		}
+		for _, d := range k.Digests {
+			r, ok := dsRecord(k, d)
+			if !ok {
+				continue
+			}
+			records = append(records, r)
+			if d.Type == digestSHA256 && cd[ConnectionKeyDSRecord] == nil {
+				cd[ConnectionKeyDSRecord] = []byte(r)
+			}
+		}
+	}
+	if len(records) > 0 {
+		cd[ConnectionKeyDSRecords] = []byte(strings.Join(records, "\n"))
+	}
+	return cd
+}
+
+// LateInitializeManagedZone fills unassigned fields with the values of the
+// observed managed zone.
+func LateInitializeManagedZone(p *v1alpha1.ManagedZoneParameters, observed dns.ManagedZone) {
+	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
+	p.Visibility = gcp.LateInitializeString(p.Visibility, observed.Visibility)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, observed.Labels)
+	o := observed.DnssecConfig
+	if o == nil || o.State == "" {
+		return
+	}
+	if p.DNSSECConfig == nil {
+		p.DNSSECConfig = &v1alpha1.ManagedZoneDNSSECConfig{State: o.State}
+	}
+	p.DNSSECConfig.NonExistence = gcp.LateInitializeString(p.DNSSECConfig.NonExistence, o.NonExistence)
+	if len(p.DNSSECConfig.DefaultKeySpecs) == 0 {
+		for _, s := range o.DefaultKeySpecs {
+			p.DNSSECConfig.DefaultKeySpecs = append(p.DNSSECConfig.DefaultKeySpecs, v1alpha1.DNSKeySpec{KeyType: s.KeyType, Algorithm: s.Algorithm, KeyLength: s.KeyLength})
+		}
+	}
+}
+
+// IsManagedZoneUpToDate returns true if the mutable fields of the observed
+// managed zone match the desired ones.
+func IsManagedZoneUpToDate(name string, in v1alpha1.ManagedZoneParameters, observed dns.ManagedZone) bool {
+	desired := GenerateManagedZone(name, in)
+	if desired.Description != observed.Description || !cmp.Equal(desired.Labels, observed.Labels, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if !cmp.Equal(desired.PrivateVisibilityConfig, observed.PrivateVisibilityConfig, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(dns.ManagedZonePrivateVisibilityConfig{}, "Kind", "GkeClusters"),
+		cmpopts.IgnoreFields(dns.ManagedZonePrivateVisibilityConfigNetwork{}, "Kind")) {
+		return false
+	}
+	if desired.DnssecConfig == nil {
+		return true
+	}
+	return observed.DnssecConfig != nil && desired.DnssecConfig.State == observed.DnssecConfig.State
+}
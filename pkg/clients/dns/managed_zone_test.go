@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func signingKeys() []*dns.DnsKey {
+	return []*dns.DnsKey{
+		{
+			Id:        "0",
+			Type:      v1alpha1.DNSKeyTypeKeySigning,
+			Algorithm: "rsasha256",
+			KeyTag:    2371,
+			IsActive:  true,
+			Digests: []*dns.DnsKeyDigest{
+				{Type: "sha1", Digest: "abcd"},
+				{Type: "sha256", Digest: "1f987cc6"},
+			},
+		},
+		{
+			Id:        "1",
+			Type:      v1alpha1.DNSKeyTypeZoneSigning,
+			Algorithm: "rsasha256",
+			KeyTag:    1234,
+			IsActive:  true,
+		},
+		{
+			Id:        "2",
+			Type:      v1alpha1.DNSKeyTypeKeySigning,
+			Algorithm: "rsasha256",
+			KeyTag:    4242,
+			Digests:   []*dns.DnsKeyDigest{{Type: "sha256", Digest: "ffff"}},
+		},
+	}
+}
+
+func TestDSRecords(t *testing.T) {
+	keys := signingKeys()
+	cases := map[string]struct {
+		key  *dns.DnsKey
+		want []string
+	}{
+		"KeySigning": {
+			key:  keys[0],
+			want: []string{"2371 8 1 ABCD", "2371 8 2 1F987CC6"},
+		},
+		"ZoneSigning": {
+			key: keys[1],
+		},
+		"UnknownAlgorithm": {
+			key: &dns.DnsKey{Type: v1alpha1.DNSKeyTypeKeySigning, Algorithm: "unknown", Digests: keys[0].Digests},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, DSRecords(tc.key)); diff != "" {
+				t.Errorf("DSRecords(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetManagedZoneConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		zone dns.ManagedZone
+		keys []*dns.DnsKey
+		want managed.ConnectionDetails
+	}{
+		"Unsigned": {
+			zone: dns.ManagedZone{NameServers: []string{"ns-1.example.", "ns-2.example."}},
+			want: managed.ConnectionDetails{
+				ConnectionKeyNameServers: []byte("ns-1.example.,ns-2.example."),
+			},
+		},
+		"Signed": {
+			zone: dns.ManagedZone{NameServers: []string{"ns-1.example."}},
+			keys: signingKeys(),
+			want: managed.ConnectionDetails{
+				ConnectionKeyNameServers: []byte("ns-1.example."),
+				ConnectionKeyDSRecord:    []byte("2371 8 2 1F987CC6"),
+				ConnectionKeyDSRecords:   []byte("2371 8 1 ABCD\n2371 8 2 1F987CC6"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetManagedZoneConnectionDetails(tc.zone, tc.keys)); diff != "" {
+				t.Errorf("GetManagedZoneConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeManagedZone(t *testing.T) {
+	observed := dns.ManagedZone{
+		Visibility: "public",
+		DnssecConfig: &dns.ManagedZoneDnsSecConfig{
+			State:           v1alpha1.DNSSECStateOn,
+			NonExistence:    "nsec3",
+			DefaultKeySpecs: []*dns.DnsKeySpec{{KeyType: v1alpha1.DNSKeyTypeKeySigning, Algorithm: "rsasha256", KeyLength: 2048}},
+		},
+	}
+	want := &v1alpha1.ManagedZoneParameters{
+		DNSName:    "example.com.",
+		Visibility: gcp.StringPtr("public"),
+		DNSSECConfig: &v1alpha1.ManagedZoneDNSSECConfig{
+			State:           v1alpha1.DNSSECStateOn,
+			NonExistence:    gcp.StringPtr("nsec3"),
+			DefaultKeySpecs: []v1alpha1.DNSKeySpec{{KeyType: v1alpha1.DNSKeyTypeKeySigning, Algorithm: "rsasha256", KeyLength: 2048}},
+		},
+	}
+	got := &v1alpha1.ManagedZoneParameters{
+		DNSName:      "example.com.",
+		DNSSECConfig: &v1alpha1.ManagedZoneDNSSECConfig{State: v1alpha1.DNSSECStateOn},
+	}
+	LateInitializeManagedZone(got, observed)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeManagedZone(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsManagedZoneUpToDate(t *testing.T) {
+	observed := dns.ManagedZone{
+		Description:  "zone",
+		DnssecConfig: &dns.ManagedZoneDnsSecConfig{State: v1alpha1.DNSSECStateOff},
+	}
+	cases := map[string]struct {
+		in   v1alpha1.ManagedZoneParameters
+		want bool
+	}{
+		"UpToDate": {
+			in:   v1alpha1.ManagedZoneParameters{Description: gcp.StringPtr("zone")},
+			want: true,
+		},
+		"DescriptionChanged": {
+			in:   v1alpha1.ManagedZoneParameters{Description: gcp.StringPtr("other")},
+			want: false,
+		},
+		"DNSSECEnabled": {
+			in: v1alpha1.ManagedZoneParameters{
+				Description:  gcp.StringPtr("zone"),
+				DNSSECConfig: &v1alpha1.ManagedZoneDNSSECConfig{State: v1alpha1.DNSSECStateOn},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsManagedZoneUpToDate("z", tc.in, observed)); diff != "" {
+				t.Errorf("IsManagedZoneUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ekmconnection
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/cloudkms/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// UpdateMask is the update mask used when patching an EkmConnection. Only its
+// service resolvers can be changed.
+const UpdateMask = "serviceResolvers"
+
+// Client should be satisfied to conduct EkmConnection operations.
+type Client interface {
+	Create(parent string, ekmconnection *cloudkms.EkmConnection) *cloudkms.ProjectsLocationsEkmConnectionsCreateCall
+	Get(name string) *cloudkms.ProjectsLocationsEkmConnectionsGetCall
+	Patch(name string, ekmconnection *cloudkms.EkmConnection) *cloudkms.ProjectsLocationsEkmConnectionsPatchCall
+}
+
+// GenerateEkmConnection generates *cloudkms.EkmConnection instance from
+// EkmConnectionParameters.
+func GenerateEkmConnection(in v1alpha1.EkmConnectionParameters) *cloudkms.EkmConnection {
+	ec := &cloudkms.EkmConnection{
+		ServiceResolvers: make([]*cloudkms.ServiceResolver, len(in.ServiceResolvers)),
+	}
+	for i, sr := range in.ServiceResolvers {
+		ec.ServiceResolvers[i] = &cloudkms.ServiceResolver{
+			EndpointFilter:          gcp.StringValue(sr.EndpointFilter),
+			Hostname:                sr.Hostname,
+			ServiceDirectoryService: sr.ServiceDirectoryService,
+			ServerCertificates:      make([]*cloudkms.Certificate, len(sr.ServerCertificates)),
+		}
+		for j, c := range sr.ServerCertificates {
+			ec.ServiceResolvers[i].ServerCertificates[j] = &cloudkms.Certificate{RawDer: c.RawDer}
+		}
+	}
+	return ec
+}
+
+// GenerateObservation produces EkmConnectionObservation object from
+// cloudkms.EkmConnection object.
+func GenerateObservation(in cloudkms.EkmConnection) v1alpha1.EkmConnectionObservation {
+	return v1alpha1.EkmConnectionObservation{
+		CreateTime: in.CreateTime,
+		Name:       in.Name,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// cloudkms.EkmConnection object.
+func LateInitializeSpec(spec *v1alpha1.EkmConnectionParameters, in cloudkms.EkmConnection) {
+	if len(spec.ServiceResolvers) != len(in.ServiceResolvers) {
+		return
+	}
+	for i, sr := range in.ServiceResolvers {
+		if sr != nil {
+			spec.ServiceResolvers[i].EndpointFilter = gcp.LateInitializeString(spec.ServiceResolvers[i].EndpointFilter, sr.EndpointFilter)
+		}
+	}
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters. Only the raw server certificates are compared, because
+// GCP adds the fields it parses from them.
+func IsUpToDate(in *v1alpha1.EkmConnectionParameters, observed *cloudkms.EkmConnection) bool {
+	desired := GenerateEkmConnection(*in)
+	return cmp.Equal(desired.ServiceResolvers, observed.ServiceResolvers, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(cloudkms.ServiceResolver{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudkms.Certificate{}, "Issuer", "NotAfterTime", "NotBeforeTime", "Parsed",
+			"SerialNumber", "Sha256Fingerprint", "Subject", "SubjectAlternativeDnsNames", "ForceSendFields", "NullFields"))
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ekmconnection
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudkms/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	service  = "projects/test-project/locations/us-central1/namespaces/ekm/services/ekm"
+	hostname = "ekm.example.com"
+	rawDer   = "MIIB"
+)
+
+func params(m ...func(*v1alpha1.EkmConnectionParameters)) *v1alpha1.EkmConnectionParameters {
+	p := &v1alpha1.EkmConnectionParameters{
+		Location: "us-central1",
+		ServiceResolvers: []v1alpha1.ServiceResolver{{
+			ServiceDirectoryService: service,
+			Hostname:                hostname,
+			ServerCertificates:      []v1alpha1.Certificate{{RawDer: rawDer}},
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func connection(m ...func(*cloudkms.EkmConnection)) *cloudkms.EkmConnection {
+	c := &cloudkms.EkmConnection{
+		Name: "projects/test-project/locations/us-central1/ekmConnections/test",
+		ServiceResolvers: []*cloudkms.ServiceResolver{{
+			ServiceDirectoryService: service,
+			Hostname:                hostname,
+			ServerCertificates: []*cloudkms.Certificate{{
+				RawDer:  rawDer,
+				Parsed:  true,
+				Issuer:  "CN=ekm",
+				Subject: "CN=ekm.example.com",
+			}},
+		}},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.EkmConnectionParameters
+		in   *cloudkms.EkmConnection
+		want *v1alpha1.EkmConnectionParameters
+	}{
+		"EndpointFilter": {
+			spec: params(),
+			in: connection(func(c *cloudkms.EkmConnection) {
+				c.ServiceResolvers[0].EndpointFilter = "port=443"
+			}),
+			want: params(func(p *v1alpha1.EkmConnectionParameters) {
+				p.ServiceResolvers[0].EndpointFilter = gcp.StringPtr("port=443")
+			}),
+		},
+		"DifferentResolvers": {
+			spec: params(),
+			in: connection(func(c *cloudkms.EkmConnection) {
+				c.ServiceResolvers = nil
+			}),
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, *tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.EkmConnectionParameters
+		observed *cloudkms.EkmConnection
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: connection(),
+			want:     true,
+		},
+		"HostnameChanged": {
+			in: params(func(p *v1alpha1.EkmConnectionParameters) {
+				p.ServiceResolvers[0].Hostname = "ekm2.example.com"
+			}),
+			observed: connection(),
+			want:     false,
+		},
+		"CertificateRotated": {
+			in: params(func(p *v1alpha1.EkmConnectionParameters) {
+				p.ServiceResolvers[0].ServerCertificates = []v1alpha1.Certificate{{RawDer: "MIIC"}}
+			}),
+			observed: connection(),
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.in, tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
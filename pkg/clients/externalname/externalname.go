@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externalname migrates external names of managed resources whose
+// format has changed, so that upgrading the provider neither orphans nor
+// recreates the external resources they refer to.
+package externalname
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyLegacyExternalName records the external name a managed
+// resource had before it was migrated to the current format.
+const AnnotationKeyLegacyExternalName = "gcp.crossplane.io/legacy-external-name"
+
+const errMigrate = "cannot migrate legacy external name"
+
+// A Converter detects whether the supplied external name is in a legacy
+// format and, if so, returns it in the current format.
+type Converter func(name string) (string, bool)
+
+// A Migrator is a managed.Initializer that converts external names in a
+// legacy format to the current one.
+type Migrator struct {
+	kube    client.Client
+	convert Converter
+}
+
+// NewMigrator returns a Migrator that converts external names with the
+// supplied Converter.
+func NewMigrator(kube client.Client, c Converter) *Migrator {
+	return &Migrator{kube: kube, convert: c}
+}
+
+// Initialize converts the external name of the supplied managed resource if it
+// is in a legacy format, recording the legacy external name in an annotation.
+func (m *Migrator) Initialize(ctx context.Context, mg resource.Managed) error {
+	legacy := meta.GetExternalName(mg)
+	if legacy == "" {
+		return nil
+	}
+	name, ok := m.convert(legacy)
+	if !ok || name == legacy {
+		return nil
+	}
+	meta.SetExternalName(mg, name)
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeyLegacyExternalName: legacy})
+	return errors.Wrap(m.kube.Update(ctx, mg), errMigrate)
+}
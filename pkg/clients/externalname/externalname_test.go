@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalname
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+// trimProject converts the legacy projects/*/things/name format to name.
+func trimProject(name string) (string, bool) {
+	if !strings.HasPrefix(name, "projects/") {
+		return name, false
+	}
+	return name[strings.LastIndex(name, "/")+1:], true
+}
+
+func managedWithName(name string, annotations map[string]string) *fake.Managed {
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+	if name != "" {
+		meta.SetExternalName(mg, name)
+	}
+	return mg
+}
+
+func TestInitialize(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NoExternalName": {
+			reason: "Resources without an external name should not be migrated",
+			mg:     managedWithName("", nil),
+			want:   want{mg: managedWithName("", nil)},
+		},
+		"CurrentFormat": {
+			reason: "External names in the current format should not be migrated",
+			mg:     managedWithName("thing", nil),
+			want:   want{mg: managedWithName("thing", nil)},
+		},
+		"Migrated": {
+			reason: "External names in a legacy format should be converted and the legacy name recorded",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     managedWithName("projects/p/things/thing", nil),
+			want: want{
+				mg: managedWithName("thing", map[string]string{AnnotationKeyLegacyExternalName: "projects/p/things/thing"}),
+			},
+		},
+		"UpdateFailed": {
+			reason: "Errors persisting a migrated external name should be returned",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     managedWithName("projects/p/things/thing", nil),
+			want: want{
+				mg:  managedWithName("thing", map[string]string{AnnotationKeyLegacyExternalName: "projects/p/things/thing"}),
+				err: errors.Wrap(errBoom, errMigrate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewMigrator(tc.kube, trimProject).Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
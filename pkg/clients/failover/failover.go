@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package failover implements the handling of active/standby pairs of
+// regional resources that is shared by the controllers of resources with a
+// FailoverSpec.
+//
+// A controller determines the observed role of its external resource, e.g.
+// from whether a CloudSQL instance is a replica, and reports it with
+// Observe. While NeedsPromotion returns true the resource is not up to date,
+// and the controller promotes it in its Update.
+package failover
+
+import (
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Observe returns the FailoverStatus of a member of an active/standby pair
+// with the supplied spec, observed role and peers. It returns nil if the
+// resource is not a member of a pair.
+func Observe(spec *v1beta1.FailoverSpec, role string, peers []string) *v1beta1.FailoverStatus {
+	if spec == nil {
+		return nil
+	}
+	return &v1beta1.FailoverStatus{
+		LocationClass: spec.LocationClass,
+		Role:          role,
+		FailedOver:    role != ExpectedRole(spec.LocationClass),
+		Peers:         peers,
+	}
+}
+
+// ExpectedRole returns the role of a member of a pair in the supplied location
+// class before the pair fails over.
+func ExpectedRole(locationClass string) string {
+	if locationClass == v1beta1.LocationClassSecondary {
+		return v1beta1.FailoverRoleStandby
+	}
+	return v1beta1.FailoverRoleActive
+}
+
+// NeedsPromotion returns true if the supplied spec asks to promote a member
+// of a pair that is observed to be the standby.
+func NeedsPromotion(spec *v1beta1.FailoverSpec, o *v1beta1.FailoverStatus) bool {
+	return spec != nil && spec.Promote && o != nil && o.Role == v1beta1.FailoverRoleStandby
+}
+
+// Promoting returns true if the supplied spec asks to promote a member of a
+// pair. Controllers ignore the differences in replication settings that the
+// promotion causes.
+func Promoting(spec *v1beta1.FailoverSpec) bool {
+	return spec != nil && spec.Promote
+}
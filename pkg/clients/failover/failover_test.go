@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		spec  *v1beta1.FailoverSpec
+		role  string
+		peers []string
+	}
+	cases := map[string]struct {
+		args args
+		want *v1beta1.FailoverStatus
+	}{
+		"NotAPairMember": {
+			args: args{role: v1beta1.FailoverRoleActive},
+		},
+		"PrimaryActive": {
+			args: args{
+				spec:  &v1beta1.FailoverSpec{LocationClass: v1beta1.LocationClassPrimary},
+				role:  v1beta1.FailoverRoleActive,
+				peers: []string{"standby"},
+			},
+			want: &v1beta1.FailoverStatus{LocationClass: v1beta1.LocationClassPrimary, Role: v1beta1.FailoverRoleActive, Peers: []string{"standby"}},
+		},
+		"SecondaryStandby": {
+			args: args{
+				spec: &v1beta1.FailoverSpec{LocationClass: v1beta1.LocationClassSecondary},
+				role: v1beta1.FailoverRoleStandby,
+			},
+			want: &v1beta1.FailoverStatus{LocationClass: v1beta1.LocationClassSecondary, Role: v1beta1.FailoverRoleStandby},
+		},
+		"SecondaryPromoted": {
+			args: args{
+				spec: &v1beta1.FailoverSpec{LocationClass: v1beta1.LocationClassSecondary, Promote: true},
+				role: v1beta1.FailoverRoleActive,
+			},
+			want: &v1beta1.FailoverStatus{LocationClass: v1beta1.LocationClassSecondary, Role: v1beta1.FailoverRoleActive, FailedOver: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Observe(tc.args.spec, tc.args.role, tc.args.peers)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeedsPromotion(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1beta1.FailoverSpec
+		o    *v1beta1.FailoverStatus
+		want bool
+	}{
+		"NotAPairMember": {},
+		"NotRequested": {
+			spec: &v1beta1.FailoverSpec{LocationClass: v1beta1.LocationClassSecondary},
+			o:    &v1beta1.FailoverStatus{Role: v1beta1.FailoverRoleStandby},
+		},
+		"Standby": {
+			spec: &v1beta1.FailoverSpec{LocationClass: v1beta1.LocationClassSecondary, Promote: true},
+			o:    &v1beta1.FailoverStatus{Role: v1beta1.FailoverRoleStandby},
+			want: true,
+		},
+		"AlreadyActive": {
+			spec: &v1beta1.FailoverSpec{LocationClass: v1beta1.LocationClassSecondary, Promote: true},
+			o:    &v1beta1.FailoverStatus{Role: v1beta1.FailoverRoleActive},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := NeedsPromotion(tc.spec, tc.o); got != tc.want {
+				t.Errorf("NeedsPromotion(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
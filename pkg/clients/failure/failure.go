@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package failure surfaces the reason of errors returned by the Google Cloud
+// API in the status of the managed resource they were returned for, so that
+// it is shown by kubectl get without describing events.
+package failure
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/googleapis/gax-go/v2/apierror"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/status"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// Extract returns the most specific reason and the message of the Google
+// Cloud API error in the chain of the supplied error. The reason is that of
+// the ErrorInfo details of the error if there are any, e.g.
+// IAM_PERMISSION_DENIED, and falls back to the reason of a JSON API error,
+// e.g. rateLimitExceeded, or the HTTP or gRPC status otherwise. It returns
+// false if the supplied error was not returned by the Google Cloud API.
+func Extract(err error) (reason, message string, ok bool) {
+	var herr *googleapi.Error
+	if errors.As(err, &herr) {
+		return httpReason(herr), herr.Message, true
+	}
+	var serr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &serr) {
+		st := serr.GRPCStatus()
+		if ae, ok := apierror.ParseError(st.Err(), false); ok && ae.Reason() != "" {
+			return ae.Reason(), st.Message(), true
+		}
+		return st.Code().String(), st.Message(), true
+	}
+	return "", "", false
+}
+
+func httpReason(err *googleapi.Error) string {
+	if ae, ok := apierror.ParseError(err, false); ok && ae.Reason() != "" {
+		return ae.Reason()
+	}
+	if len(err.Errors) > 0 && err.Errors[0].Reason != "" {
+		return err.Errors[0].Reason
+	}
+	return strings.ReplaceAll(http.StatusText(err.Code), " ", "")
+}
+
+// WrapConnecter returns an ExternalConnecter whose external clients record the
+// reason of Google Cloud API errors as the FailureReason of the managed
+// resource, and prefix the message of its Ready condition with it. Both are
+// cleared when the resource is next observed successfully. Note that the
+// managed reconciler resets the Ready condition when a Create or Delete call
+// fails, so only the FailureReason is reported for those.
+func WrapConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{wrapped: c}
+}
+
+type connecter struct {
+	wrapped managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.wrapped.Connect(ctx, mg)
+	if err != nil {
+		record(mg, err)
+		return nil, err
+	}
+	return &external{wrapped: e}, nil
+}
+
+type external struct {
+	wrapped managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	clearReason(mg)
+	o, err := e.wrapped.Observe(ctx, mg)
+	record(mg, err)
+	return o, err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.wrapped.Create(ctx, mg)
+	record(mg, err)
+	return c, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.wrapped.Update(ctx, mg)
+	record(mg, err)
+	return u, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.wrapped.Delete(ctx, mg)
+	record(mg, err)
+	return err
+}
+
+// record the reason of the supplied error, if it was returned by the Google
+// Cloud API. A Ready condition that has not been set yet is set to
+// Unavailable, so that the reason is shown before the resource first became
+// ready.
+func record(mg resource.Managed, err error) {
+	r, ok := mg.(v1beta1.FailureReasonRecorder)
+	if !ok || err == nil {
+		return
+	}
+	reason, message, ok := Extract(err)
+	if !ok || reason == "" {
+		return
+	}
+	r.SetFailureReason(reason)
+	c := mg.GetCondition(xpv1.TypeReady)
+	if c.Reason == "" {
+		c = xpv1.Unavailable()
+	}
+	mg.SetConditions(c.WithMessage(conditionMessage(reason, message)))
+}
+
+// clearReason clears a previously recorded reason, and the message of the
+// Ready condition if it was set by record.
+func clearReason(mg resource.Managed) {
+	r, ok := mg.(v1beta1.FailureReasonRecorder)
+	if !ok || r.GetFailureReason() == "" {
+		return
+	}
+	c := mg.GetCondition(xpv1.TypeReady)
+	if strings.HasPrefix(c.Message, conditionMessage(r.GetFailureReason(), "")) {
+		mg.SetConditions(c.WithMessage(""))
+	}
+	r.SetFailureReason("")
+}
+
+func conditionMessage(reason, message string) string {
+	return reason + ": " + message
+}
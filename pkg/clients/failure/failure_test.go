@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failure
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+var errBoom = errors.New("boom")
+
+func TestExtract(t *testing.T) {
+	withInfo, err := status.New(codes.PermissionDenied, "denied").WithDetails(&errdetails.ErrorInfo{Reason: "IAM_PERMISSION_DENIED"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	type want struct {
+		reason  string
+		message string
+		ok      bool
+	}
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"NotGoogleCloudAPIError": {
+			err: errBoom,
+		},
+		"HTTPErrorInfo": {
+			err: errors.Wrap(&googleapi.Error{
+				Code:    http.StatusForbidden,
+				Message: "denied",
+				Body:    `{"error":{"code":403,"message":"denied","details":[{"@type":"type.googleapis.com/google.rpc.ErrorInfo","reason":"IAM_PERMISSION_DENIED"}]}}`,
+			}, "cannot create"),
+			want: want{reason: "IAM_PERMISSION_DENIED", message: "denied", ok: true},
+		},
+		"HTTPErrorReason": {
+			err: &googleapi.Error{
+				Code:    http.StatusForbidden,
+				Message: "quota",
+				Errors:  []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}},
+			},
+			want: want{reason: "rateLimitExceeded", message: "quota", ok: true},
+		},
+		"HTTPStatus": {
+			err:  &googleapi.Error{Code: http.StatusServiceUnavailable, Message: "down"},
+			want: want{reason: "ServiceUnavailable", message: "down", ok: true},
+		},
+		"GRPCErrorInfo": {
+			err:  errors.Wrap(withInfo.Err(), "cannot create"),
+			want: want{reason: "IAM_PERMISSION_DENIED", message: "denied", ok: true},
+		},
+		"GRPCCode": {
+			err:  status.Error(codes.FailedPrecondition, "not ready"),
+			want: want{reason: "FailedPrecondition", message: "not ready", ok: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reason, message, ok := Extract(tc.err)
+			if diff := cmp.Diff(tc.want, want{reason: reason, message: message, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("Extract(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWrapConnecter(t *testing.T) {
+	errDenied := &googleapi.Error{Code: http.StatusForbidden, Message: "denied", Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+	topic := func(reason string, c ...xpv1.Condition) *v1alpha1.Topic {
+		t := &v1alpha1.Topic{}
+		t.Status.FailureReason = reason
+		t.SetConditions(c...)
+		return t
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		reason  string
+		mg      resource.Managed
+		observe error
+		update  error
+		want    want
+	}{
+		"Recorded": {
+			reason: "The reason of a Google Cloud API error should be recorded, and set the Ready condition to Unavailable if it was not set yet",
+			mg:     topic(""),
+			update: errors.Wrap(errDenied, "cannot update"),
+			want: want{
+				mg:  topic("forbidden", xpv1.Unavailable().WithMessage("forbidden: denied")),
+				err: errors.Wrap(errDenied, "cannot update"),
+			},
+		},
+		"ReadyConditionKept": {
+			reason: "The reason of a Google Cloud API error should be added to the message of an existing Ready condition",
+			mg:     topic("", xpv1.Available()),
+			update: errDenied,
+			want: want{
+				mg:  topic("forbidden", xpv1.Available().WithMessage("forbidden: denied")),
+				err: errDenied,
+			},
+		},
+		"OtherErrorNotRecorded": {
+			reason: "Errors that were not returned by the Google Cloud API should not be recorded",
+			mg:     topic("", xpv1.Available()),
+			update: errBoom,
+			want: want{
+				mg:  topic("", xpv1.Available()),
+				err: errBoom,
+			},
+		},
+		"Cleared": {
+			reason: "A recorded reason and the Ready condition message it set should be cleared when the resource is observed",
+			mg:     topic("forbidden", xpv1.Available().WithMessage("forbidden: denied")),
+			want: want{
+				mg: topic("", xpv1.Available()),
+			},
+		},
+		"OtherMessageKept": {
+			reason: "A Ready condition message that was not set for a recorded reason should be kept",
+			mg:     topic("forbidden", xpv1.Unavailable().WithMessage("instance is repairing")),
+			want: want{
+				mg: topic("", xpv1.Unavailable().WithMessage("instance is repairing")),
+			},
+		},
+		"ObserveFailed": {
+			reason:  "The reason of a failed observation should replace the recorded one",
+			mg:      topic("rateLimitExceeded", xpv1.Available().WithMessage("rateLimitExceeded: quota")),
+			observe: errDenied,
+			want: want{
+				mg:  topic("forbidden", xpv1.Available().WithMessage("forbidden: denied")),
+				err: errDenied,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := WrapConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{}, tc.observe
+					},
+					UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+						return managed.ExternalUpdate{}, tc.update
+					},
+				}, nil
+			}))
+			e, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = e.Observe(context.Background(), tc.mg)
+			if err == nil {
+				_, err = e.Update(context.Background(), tc.mg)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\n-want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package feed
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/cloudasset/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudasset/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
+)
+
+const (
+	parentFormat   = "projects/%s"
+	feedNameFormat = "projects/%s/feeds/%s"
+
+	// UpdateMask lists the fields of a feed that are updated in place.
+	UpdateMask = "assetNames,assetTypes,contentType,relationshipTypes,condition,feedOutputConfig"
+)
+
+// Client should be satisfied to conduct Feed operations.
+type Client interface {
+	Create(parent string, req *cloudasset.CreateFeedRequest) *cloudasset.FeedsCreateCall
+	Get(name string) *cloudasset.FeedsGetCall
+	Patch(name string, req *cloudasset.UpdateFeedRequest) *cloudasset.FeedsPatchCall
+	Delete(name string) *cloudasset.FeedsDeleteCall
+}
+
+// GetFullyQualifiedParent builds the fully qualified name of the parent of
+// a feed.
+func GetFullyQualifiedParent(project string) string {
+	return fmt.Sprintf(parentFormat, project)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a feed.
+func GetFullyQualifiedName(project string, name string) string {
+	return fmt.Sprintf(feedNameFormat, project, name)
+}
+
+// GetTopicName returns the full name of the given topic. Topics that are not
+// fully qualified are assumed to belong to the given project.
+func GetTopicName(projectID, t string) string {
+	if !strings.HasPrefix(t, "projects/") {
+		return topic.GetFullyQualifiedName(projectID, t)
+	}
+	return t
+}
+
+// GenerateFeed generates *cloudasset.Feed instance from FeedParameters.
+func GenerateFeed(projectID, name string, in v1alpha1.FeedParameters) *cloudasset.Feed {
+	f := &cloudasset.Feed{
+		Name:              GetFullyQualifiedName(projectID, name),
+		AssetNames:        in.AssetNames,
+		AssetTypes:        in.AssetTypes,
+		ContentType:       gcp.StringValue(in.ContentType),
+		RelationshipTypes: in.RelationshipTypes,
+		FeedOutputConfig: &cloudasset.FeedOutputConfig{
+			PubsubDestination: &cloudasset.PubsubDestination{
+				Topic: GetTopicName(projectID, gcp.StringValue(in.FeedOutputConfig.PubsubDestination.Topic)),
+			},
+		},
+	}
+	if c := in.Condition; c != nil {
+		f.Condition = &cloudasset.Expr{
+			Description: gcp.StringValue(c.Description),
+			Expression:  c.Expression,
+			Location:    gcp.StringValue(c.Location),
+			Title:       gcp.StringValue(c.Title),
+		}
+	}
+	return f
+}
+
+// GenerateObservation produces FeedObservation object from cloudasset.Feed
+// object.
+func GenerateObservation(in cloudasset.Feed) v1alpha1.FeedObservation {
+	return v1alpha1.FeedObservation{
+		Name: in.Name,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// cloudasset.Feed object.
+func LateInitializeSpec(spec *v1alpha1.FeedParameters, in cloudasset.Feed) {
+	spec.ContentType = gcp.LateInitializeString(spec.ContentType, in.ContentType)
+	if spec.Condition == nil && in.Condition != nil {
+		spec.Condition = &iamv1alpha1.Expr{
+			Description: gcp.LateInitializeString(nil, in.Condition.Description),
+			Expression:  in.Condition.Expression,
+			Location:    gcp.LateInitializeString(nil, in.Condition.Location),
+			Title:       gcp.LateInitializeString(nil, in.Condition.Title),
+		}
+	}
+}
+
+// IsUpToDate checks whether the observed feed is up-to-date compared to the
+// given set of parameters.
+func IsUpToDate(projectID, name string, in v1alpha1.FeedParameters, observed cloudasset.Feed) bool {
+	desired := GenerateFeed(projectID, name, in)
+	return cmp.Equal(desired, &observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(cloudasset.Feed{}, "Name", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudasset.FeedOutputConfig{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudasset.PubsubDestination{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudasset.Expr{}, "ForceSendFields", "NullFields"))
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package feed
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudasset/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudasset/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "my-project"
+	testFeed    = "my-feed"
+	testType    = "storage.googleapis.com/Bucket"
+)
+
+func params(m ...func(*v1alpha1.FeedParameters)) v1alpha1.FeedParameters {
+	p := v1alpha1.FeedParameters{
+		AssetTypes:  []string{testType},
+		ContentType: gcp.StringPtr("RESOURCE"),
+		Condition:   &iamv1alpha1.Expr{Expression: `temporal_asset.deleted == true`, Title: gcp.StringPtr("deleted")},
+		FeedOutputConfig: v1alpha1.FeedOutputConfig{
+			PubsubDestination: v1alpha1.PubsubDestination{Topic: gcp.StringPtr("updates")},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func observed(m ...func(*cloudasset.Feed)) cloudasset.Feed {
+	f := cloudasset.Feed{
+		Name:        "projects/123/feeds/" + testFeed,
+		AssetTypes:  []string{testType},
+		ContentType: "RESOURCE",
+		Condition:   &cloudasset.Expr{Expression: `temporal_asset.deleted == true`, Title: "deleted"},
+		FeedOutputConfig: &cloudasset.FeedOutputConfig{
+			PubsubDestination: &cloudasset.PubsubDestination{Topic: "projects/" + testProject + "/topics/updates"},
+		},
+	}
+	for _, fn := range m {
+		fn(&f)
+	}
+	return f
+}
+
+func TestGetTopicName(t *testing.T) {
+	cases := map[string]struct {
+		topic string
+		want  string
+	}{
+		"Short":          {topic: "updates", want: "projects/my-project/topics/updates"},
+		"FullyQualified": {topic: "projects/other/topics/updates", want: "projects/other/topics/updates"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetTopicName(testProject, tc.topic)); diff != "" {
+				t.Errorf("GetTopicName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	got := params(func(p *v1alpha1.FeedParameters) {
+		p.ContentType = nil
+		p.Condition = nil
+	})
+	LateInitializeSpec(&got, observed())
+	if diff := cmp.Diff(params(), got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.FeedParameters
+		observed cloudasset.Feed
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: observed(),
+			want:     true,
+		},
+		"AssetTypesChanged": {
+			in:       params(func(p *v1alpha1.FeedParameters) { p.AssetTypes = append(p.AssetTypes, "compute.googleapis.com/Disk") }),
+			observed: observed(),
+		},
+		"TopicChanged": {
+			in:       params(func(p *v1alpha1.FeedParameters) { p.FeedOutputConfig.PubsubDestination.Topic = gcp.StringPtr("other") }),
+			observed: observed(),
+		},
+		"ConditionChanged": {
+			in:       params(),
+			observed: observed(func(f *cloudasset.Feed) { f.Condition = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(testProject, testFeed, tc.in, tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,10 @@ limitations under the License.
 package firewall
 
 import (
+	"fmt"
+	"path"
+	"time"
+
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/mitchellh/copystructure"
@@ -30,9 +34,53 @@ import (
 
 const errCheckUpToDate = "unable to determine if external resource is up to date"
 
+const (
+	// HitLookback is how far back the firewall rules logs are searched for
+	// hits when a rule has not been checked before.
+	HitLookback = 30 * 24 * time.Hour
+
+	// HitCheckInterval is the minimum time between two queries of the
+	// firewall rules logs for the same rule.
+	HitCheckInterval = time.Hour
+
+	hitFilterFormat = `logName="projects/%s/logs/compute.googleapis.com%%2Ffirewall" AND jsonPayload.rule_details.reference="network:%s/firewall:%s" AND timestamp>="%s"`
+)
+
+// ReportsHits returns true if firewall rules logs are written for the supplied
+// firewall, so that its hits can be observed.
+func ReportsHits(in compute.Firewall) bool {
+	return in.LogConfig != nil && in.LogConfig.Enable
+}
+
+// NeedsHitCheck returns true if the firewall rules logs have not been queried
+// for hits within HitCheckInterval of the supplied time.
+func NeedsHitCheck(obs v1alpha1.FirewallObservation, now time.Time) bool {
+	t, err := time.Parse(time.RFC3339, obs.LastHitCheckTime)
+	return err != nil || now.Sub(t) >= HitCheckInterval
+}
+
+// HitSince returns the time from which the firewall rules logs should be
+// searched for hits, which is the last check if there was one within
+// HitLookback of the supplied time.
+func HitSince(obs v1alpha1.FirewallObservation, now time.Time) time.Time {
+	since := now.Add(-HitLookback)
+	if t, err := time.Parse(time.RFC3339, obs.LastHitCheckTime); err == nil && t.After(since) {
+		return t
+	}
+	return since
+}
+
+// HitFilter returns the Cloud Logging filter that matches the firewall rules
+// log entries of the supplied firewall written since the supplied time.
+func HitFilter(projectID string, in compute.Firewall, since time.Time) string {
+	return fmt.Sprintf(hitFilterFormat, projectID, path.Base(in.Network), in.Name, since.UTC().Format(time.RFC3339))
+}
+
 // GenerateFirewall takes a *FirewallParameters and returns *compute.Firewall.
 // It assigns only the fields that are writable, i.e. not labelled as [Output Only]
-// in Google's reference.
+// in Google's reference. Fields whose desired value is the zero value, such as
+// a rule that is no longer disabled or has priority 0, are always sent so
+// that patches apply them.
 func GenerateFirewall(name string, in v1alpha1.FirewallParameters, firewall *compute.Firewall) {
 	firewall.Name = name
 	firewall.Description = gcp.StringValue(in.Description)
@@ -46,6 +94,13 @@ func GenerateFirewall(name string, in v1alpha1.FirewallParameters, firewall *com
 	firewall.TargetServiceAccounts = in.TargetServiceAccounts
 	firewall.Direction = gcp.StringValue(in.Direction)
 	firewall.Disabled = gcp.BoolValue(in.Disabled)
+	firewall.ForceSendFields = nil
+	if in.Disabled != nil {
+		firewall.ForceSendFields = append(firewall.ForceSendFields, "Disabled")
+	}
+	if in.Priority != nil {
+		firewall.ForceSendFields = append(firewall.ForceSendFields, "Priority")
+	}
 	if in.Allowed != nil {
 		firewall.Allowed = make([]*compute.FirewallAllowed, len(in.Allowed))
 		for idx, rule := range in.Allowed {
@@ -68,7 +123,9 @@ func GenerateFirewall(name string, in v1alpha1.FirewallParameters, firewall *com
 
 	if in.LogConfig != nil {
 		firewall.LogConfig = &compute.FirewallLogConfig{
-			Enable: in.LogConfig.Enable,
+			Enable:          in.LogConfig.Enable,
+			Metadata:        gcp.StringValue(in.LogConfig.Metadata),
+			ForceSendFields: []string{"Enable"},
 		}
 	}
 }
@@ -102,6 +159,9 @@ func LateInitializeSpec(spec *v1alpha1.FirewallParameters, in compute.Firewall)
 			Enable: in.LogConfig.Enable,
 		}
 	}
+	if in.LogConfig != nil && spec.LogConfig.Enable {
+		spec.LogConfig.Metadata = gcp.LateInitializeString(spec.LogConfig.Metadata, in.LogConfig.Metadata)
+	}
 
 	if len(in.Allowed) != 0 && len(spec.Allowed) == 0 {
 		spec.Allowed = make([]*v1alpha1.FirewallAllowed, len(in.Allowed))
@@ -114,7 +174,7 @@ func LateInitializeSpec(spec *v1alpha1.FirewallParameters, in compute.Firewall)
 	}
 
 	if len(in.Denied) != 0 && len(spec.Denied) == 0 {
-		spec.Denied = make([]*v1alpha1.FirewallDenied, len(in.Allowed))
+		spec.Denied = make([]*v1alpha1.FirewallDenied, len(in.Denied))
 		for idx, rule := range in.Denied {
 			spec.Denied[idx] = &v1alpha1.FirewallDenied{
 				IPProtocol: rule.IPProtocol,
@@ -136,5 +196,7 @@ func IsUpToDate(name string, in *v1alpha1.FirewallParameters, observed *compute.
 		return true, errors.New(errCheckUpToDate)
 	}
 	GenerateFirewall(name, *in, desired)
-	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(), cmpopts.IgnoreFields(compute.Firewall{}, "ForceSendFields")), nil
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.Firewall{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.FirewallLogConfig{}, "ForceSendFields")), nil
 }
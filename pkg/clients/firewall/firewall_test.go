@@ -17,12 +17,15 @@ limitations under the License.
 package firewall
 
 import (
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/compute/v1"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/test"
 )
 
 const (
@@ -125,6 +128,7 @@ func TestGenerateFirewall(t *testing.T) {
 			},
 			want: firewall(func(n *compute.Firewall) {
 				n.Disabled = false
+				n.ForceSendFields = []string{"Priority"}
 			}),
 		},
 		"DisabledFalse": {
@@ -136,6 +140,7 @@ func TestGenerateFirewall(t *testing.T) {
 			},
 			want: firewall(func(n *compute.Firewall) {
 				n.Disabled = false
+				n.ForceSendFields = []string{"Disabled", "Priority"}
 			}),
 		},
 		"DisabledTrue": {
@@ -147,6 +152,19 @@ func TestGenerateFirewall(t *testing.T) {
 			},
 			want: firewall(func(n *compute.Firewall) {
 				n.Disabled = true
+				n.ForceSendFields = []string{"Disabled", "Priority"}
+			}),
+		},
+		"LoggingDisabled": {
+			args: args{
+				name: testName,
+				in: *params(func(p *v1alpha1.FirewallParameters) {
+					p.LogConfig = &v1alpha1.FirewallLogConfig{Enable: false}
+				}),
+			},
+			want: firewall(func(n *compute.Firewall) {
+				n.LogConfig = &compute.FirewallLogConfig{ForceSendFields: []string{"Enable"}}
+				n.ForceSendFields = []string{"Disabled", "Priority"}
 			}),
 		},
 	}
@@ -219,6 +237,24 @@ func TestLateInitializeSpec(t *testing.T) {
 				p.Direction = &testDirection
 			}),
 		},
+		"DeniedAndLogging": {
+			args: args{
+				spec: params(func(p *v1alpha1.FirewallParameters) {
+					p.Allowed = nil
+				}),
+				in: *firewall(func(n *compute.Firewall) {
+					n.Allowed = nil
+					n.Denied = []*compute.FirewallDenied{{IPProtocol: "tcp"}, {IPProtocol: "udp"}}
+					n.LogConfig = &compute.FirewallLogConfig{Enable: true, Metadata: "EXCLUDE_ALL_METADATA"}
+				}),
+			},
+			want: params(func(p *v1alpha1.FirewallParameters) {
+				p.Allowed = nil
+				p.Denied = []*v1alpha1.FirewallDenied{{IPProtocol: "tcp"}, {IPProtocol: "udp"}}
+				metadata := "EXCLUDE_ALL_METADATA"
+				p.LogConfig = &v1alpha1.FirewallLogConfig{Enable: true, Metadata: &metadata}
+			}),
+		},
 	}
 
 	for name, tc := range cases {
@@ -281,3 +317,64 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGolden(t *testing.T) {
+	test.RunGoldenCases(t, filepath.Join("testdata", "golden"), test.GoldenFuncs[v1alpha1.FirewallParameters, compute.Firewall]{
+		Generate: func(p *v1alpha1.FirewallParameters) any {
+			f := &compute.Firewall{}
+			GenerateFirewall(testName, *p, f)
+			return f
+		},
+		LateInitialize: func(p *v1alpha1.FirewallParameters, o *compute.Firewall) {
+			LateInitializeSpec(p, *o)
+		},
+		IsUpToDate: func(p *v1alpha1.FirewallParameters, o *compute.Firewall) (bool, error) {
+			return IsUpToDate(testName, p, o)
+		},
+	})
+}
+
+func TestNeedsHitCheck(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		checked string
+		want    bool
+	}{
+		"NeverChecked":    {checked: "", want: true},
+		"RecentlyChecked": {checked: "2023-03-01T11:30:00Z", want: false},
+		"CheckDue":        {checked: "2023-03-01T11:00:00Z", want: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsHitCheck(v1alpha1.FirewallObservation{LastHitCheckTime: tc.checked}, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NeedsHitCheck(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHitFilter(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		checked string
+		want    string
+	}{
+		"NeverChecked": {
+			want: `logName="projects/p/logs/compute.googleapis.com%2Ffirewall" AND jsonPayload.rule_details.reference="network:test-network/firewall:some-name" AND timestamp>="2023-01-30T12:00:00Z"`,
+		},
+		"CheckedBefore": {
+			checked: "2023-03-01T10:00:00Z",
+			want:    `logName="projects/p/logs/compute.googleapis.com%2Ffirewall" AND jsonPayload.rule_details.reference="network:test-network/firewall:some-name" AND timestamp>="2023-03-01T10:00:00Z"`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fw := compute.Firewall{Name: testName, Network: "projects/p/global/networks/" + testNetwork}
+			got := HitFilter("p", fw, HitSince(v1alpha1.FirewallObservation{LastHitCheckTime: tc.checked}, now))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("HitFilter(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat      = "projects/%s/locations/%s"
+	gatewayNameFormat = "projects/%s/locations/%s/gateways/%s"
+)
+
+// UpdateMask lists the fields of a gateway that are patched on update. The
+// type, scope, addresses and network of a gateway cannot be changed.
+const UpdateMask = "description,labels,ports,serverTlsPolicy,certificateUrls,gatewaySecurityPolicy"
+
+// Client should be satisfied to conduct Gateway operations.
+type Client interface {
+	Create(parent string, gateway *networkservices.Gateway) *networkservices.ProjectsLocationsGatewaysCreateCall
+	Get(name string) *networkservices.ProjectsLocationsGatewaysGetCall
+	Patch(name string, gateway *networkservices.Gateway) *networkservices.ProjectsLocationsGatewaysPatchCall
+	Delete(name string) *networkservices.ProjectsLocationsGatewaysDeleteCall
+}
+
+// GetFullyQualifiedParent builds the fully qualified name of the parent of a
+// gateway.
+func GetFullyQualifiedParent(project string, p v1alpha1.GatewayParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a gateway.
+func GetFullyQualifiedName(project string, p v1alpha1.GatewayParameters, name string) string {
+	return fmt.Sprintf(gatewayNameFormat, project, p.Location, name)
+}
+
+// GenerateGateway generates *networkservices.Gateway instance from
+// GatewayParameters.
+func GenerateGateway(in v1alpha1.GatewayParameters) *networkservices.Gateway {
+	return &networkservices.Gateway{
+		Type:                  in.Type,
+		Ports:                 in.Ports,
+		Scope:                 gcp.StringValue(in.Scope),
+		Addresses:             in.Addresses,
+		Network:               gcp.StringValue(in.Network),
+		Subnetwork:            gcp.StringValue(in.Subnetwork),
+		ServerTlsPolicy:       gcp.StringValue(in.ServerTLSPolicy),
+		CertificateUrls:       in.CertificateURLs,
+		GatewaySecurityPolicy: gcp.StringValue(in.GatewaySecurityPolicy),
+		Description:           gcp.StringValue(in.Description),
+		Labels:                in.Labels,
+	}
+}
+
+// GenerateObservation produces GatewayObservation object from
+// networkservices.Gateway object.
+func GenerateObservation(in networkservices.Gateway) v1alpha1.GatewayObservation {
+	return v1alpha1.GatewayObservation{
+		Name:       in.Name,
+		SelfLink:   in.SelfLink,
+		CreateTime: in.CreateTime,
+		UpdateTime: in.UpdateTime,
+		Addresses:  in.Addresses,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// networkservices.Gateway object.
+func LateInitializeSpec(spec *v1alpha1.GatewayParameters, in networkservices.Gateway) {
+	spec.Scope = gcp.LateInitializeString(spec.Scope, in.Scope)
+	spec.Addresses = gcp.LateInitializeStringSlice(spec.Addresses, in.Addresses)
+	spec.Network = gcp.LateInitializeString(spec.Network, in.Network)
+	spec.Subnetwork = gcp.LateInitializeString(spec.Subnetwork, in.Subnetwork)
+	spec.ServerTLSPolicy = gcp.LateInitializeString(spec.ServerTLSPolicy, in.ServerTlsPolicy)
+	spec.GatewaySecurityPolicy = gcp.LateInitializeString(spec.GatewaySecurityPolicy, in.GatewaySecurityPolicy)
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+}
+
+// IsUpToDate returns true if the fields of the observed gateway that can be
+// updated match the desired ones.
+func IsUpToDate(in v1alpha1.GatewayParameters, observed networkservices.Gateway) bool {
+	return cmp.Equal(GenerateGateway(in), &observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(networkservices.Gateway{}, "Name", "SelfLink", "CreateTime", "UpdateTime", "ServerResponse", "ForceSendFields", "NullFields",
+			"Type", "Scope", "Addresses", "Network", "Subnetwork", "IpVersion", "EnvoyHeaders"))
+}
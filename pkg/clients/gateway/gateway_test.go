@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func params() v1alpha1.GatewayParameters {
+	return v1alpha1.GatewayParameters{
+		Location: "global",
+		Type:     v1alpha1.GatewayTypeOpenMesh,
+		Ports:    []int64{80},
+		Scope:    gcp.StringPtr("ingress"),
+		Labels:   map[string]string{"team": "platform"},
+	}
+}
+
+func gateway() *networkservices.Gateway {
+	return &networkservices.Gateway{
+		Type:   v1alpha1.GatewayTypeOpenMesh,
+		Ports:  []int64{80},
+		Scope:  "ingress",
+		Labels: map[string]string{"team": "platform"},
+	}
+}
+
+func TestGetFullyQualifiedName(t *testing.T) {
+	p := params()
+	p.Location = "us-central1"
+	want := "projects/someProject/locations/us-central1/gateways/gw"
+	if diff := cmp.Diff(want, GetFullyQualifiedName("someProject", p, "gw")); diff != "" {
+		t.Errorf("GetFullyQualifiedName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateGateway(t *testing.T) {
+	if diff := cmp.Diff(gateway(), GenerateGateway(params())); diff != "" {
+		t.Errorf("GenerateGateway(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	observed := gateway()
+	observed.Addresses = []string{"10.0.0.2"}
+	got := params()
+	got.Scope = nil
+	LateInitializeSpec(&got, *observed)
+	want := params()
+	want.Addresses = []string{"10.0.0.2"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		observed func(g *networkservices.Gateway)
+		want     bool
+	}{
+		"UpToDate": {
+			observed: func(g *networkservices.Gateway) {
+				g.Name = GetFullyQualifiedName("someProject", params(), "gw")
+				g.Addresses = []string{"10.0.0.2"}
+			},
+			want: true,
+		},
+		"ImmutableFieldDiffers": {
+			observed: func(g *networkservices.Gateway) { g.Scope = "other" },
+			want:     true,
+		},
+		"PortsChanged": {
+			observed: func(g *networkservices.Gateway) { g.Ports = []int64{80, 443} },
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := gateway()
+			tc.observed(observed)
+			if diff := cmp.Diff(tc.want, IsUpToDate(params(), *observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
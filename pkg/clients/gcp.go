@@ -23,6 +23,7 @@ import (
 	"path"
 	"strings"
 
+	"cloud.google.com/go/compute/metadata"
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
@@ -41,14 +42,19 @@ import (
 	cmpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	"github.com/crossplane-contrib/provider-gcp/apis/v1alpha3"
 	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/defaultconfig"
 )
 
 const scopeCloudPlatform = "https://www.googleapis.com/auth/cloud-platform"
 
 // GetConnectionInfo returns the necessary connection information that is necessary
 // to use when the controller connects to GCP API in order to reconcile the managed
-// resource.
+// resource. Managed resources that omit their providerConfigRef use the
+// ProviderConfig selected for them by a DefaultProviderConfig, if any.
 func GetConnectionInfo(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts []option.ClientOption, err error) {
+	if err := defaultconfig.Resolve(ctx, c, mg); err != nil {
+		return "", nil, err
+	}
 	switch {
 	case mg.GetProviderConfigReference() != nil:
 		return UseProviderConfig(ctx, c, mg)
@@ -81,8 +87,6 @@ func UseProvider(ctx context.Context, c client.Client, mg resource.Managed) (pro
 
 // UseProviderConfig to return GCP authentication information.
 func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts []option.ClientOption, err error) {
-	opts = make([]option.ClientOption, 0)
-
 	pc := &v1beta1.ProviderConfig{}
 	t := resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{})
 	if err := t.Track(ctx, mg); err != nil {
@@ -91,6 +95,14 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return "", nil, err
 	}
+	return ProviderConfigConnectionInfo(ctx, c, pc)
+}
+
+// ProviderConfigConnectionInfo returns the GCP authentication information of
+// the supplied ProviderConfig. Unlike UseProviderConfig it does not track the
+// usage of the ProviderConfig.
+func ProviderConfigConnectionInfo(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (projectID string, opts []option.ClientOption, err error) {
+	opts = make([]option.ClientOption, 0)
 
 	if pc.Spec.ClientOptions != nil {
 		addClientOptions(pc.Spec.ClientOptions, &opts)
@@ -125,6 +137,56 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 	return pc.Spec.ProjectID, opts, nil
 }
 
+// GetRequestor returns the Google Cloud identity, typically a service account
+// email, that the controller uses to make calls on behalf of the supplied
+// managed resource. It returns the empty string if the identity cannot be
+// determined, for example when authenticating with an access token.
+func GetRequestor(ctx context.Context, c client.Client, mg resource.Managed) string {
+	switch {
+	case mg.GetProviderConfigReference() != nil:
+		pc := &v1beta1.ProviderConfig{}
+		if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+			return ""
+		}
+		if pc.Spec.Credentials.Source == xpv1.CredentialsSourceInjectedIdentity {
+			if !metadata.OnGCE() {
+				return ""
+			}
+			email, _ := metadata.Email("")
+			return email
+		}
+		data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		if err != nil {
+			return ""
+		}
+		return credentialsEmail(data)
+	case mg.GetProviderReference() != nil:
+		p := &v1alpha3.Provider{}
+		if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderReference().Name}, p); err != nil {
+			return ""
+		}
+		ref := p.Spec.CredentialsSecretRef
+		s := &v1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return ""
+		}
+		return credentialsEmail(s.Data[ref.Key])
+	}
+	return ""
+}
+
+// credentialsEmail returns the client email of the supplied JSON service
+// account key, or the empty string if it is not one.
+func credentialsEmail(b []byte) string {
+	key := struct {
+		ClientEmail string `json:"client_email"`
+	}{}
+	if err := json.Unmarshal(b, &key); err != nil {
+		return ""
+	}
+	return key.ClientEmail
+}
+
 func isJSON(b []byte) bool {
 	var js json.RawMessage
 	return json.Unmarshal(b, &js) == nil
@@ -180,6 +242,56 @@ func IsErrorForbidden(err error) bool {
 	return errors.As(err, &gErr) && gErr.Code == http.StatusForbidden
 }
 
+// IsErrorPreconditionFailed gets a value indicating whether the given error
+// represents a "precondition failed" response from the Google API
+func IsErrorPreconditionFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	var gErr *googleapi.Error
+	return errors.As(err, &gErr) && gErr.Code == http.StatusPreconditionFailed
+}
+
+// IsErrorResourceExhausted gets a value indicating whether the given error
+// represents a "resource exhausted" response, i.e. an exceeded quota or rate
+// limit, from the Google API. It works for both REST and gRPC clients.
+func IsErrorResourceExhausted(err error) bool {
+	if err == nil {
+		return false
+	}
+	var gErr *googleapi.Error
+	if errors.As(err, &gErr) {
+		return gErr.Code == http.StatusTooManyRequests
+	}
+	var sErr interface{ GRPCStatus() *status.Status }
+	return errors.As(err, &sErr) && sErr.GRPCStatus().Code() == codes.ResourceExhausted
+}
+
+// IsErrorUnavailable gets a value indicating whether the given error
+// represents a "service unavailable" response from the Google API, e.g. during
+// an outage of a region or zone. It works for both REST and gRPC clients.
+func IsErrorUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var gErr *googleapi.Error
+	if errors.As(err, &gErr) {
+		return gErr.Code == http.StatusServiceUnavailable
+	}
+	var sErr interface{ GRPCStatus() *status.Status }
+	return errors.As(err, &sErr) && sErr.GRPCStatus().Code() == codes.Unavailable
+}
+
+// UserProject returns the call options that bill a request to the supplied
+// user project, if any. Requests made to Requester Pays buckets must specify
+// a user project.
+func UserProject(p *string) []googleapi.CallOption {
+	if p == nil || *p == "" {
+		return nil
+	}
+	return []googleapi.CallOption{googleapi.QueryParameter("userProject", *p)}
+}
+
 // StringValue converts the supplied string pointer to a string, returning the
 // empty string if the pointer is nil.
 func StringValue(v *string) string {
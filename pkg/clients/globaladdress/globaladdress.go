@@ -17,10 +17,15 @@ limitations under the License.
 package globaladdress
 
 import (
+	"strconv"
+
 	compute "google.golang.org/api/compute/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/utilization"
 )
 
 // GenerateGlobalAddress converts the supplied GlobalAddressParameters into an
@@ -64,7 +69,52 @@ func GenerateGlobalAddressObservation(observed compute.Address) v1beta1.GlobalAd
 		CreationTimestamp: observed.CreationTimestamp,
 		ID:                observed.Id,
 		SelfLink:          observed.SelfLink,
+		Address:           observed.Address,
 		Status:            observed.Status,
 		Users:             observed.Users,
 	}
 }
+
+// GetConnectionDetails returns the IP address allocated to a GlobalAddress as
+// its connection details, if one was allocated yet.
+func GetConnectionDetails(o v1beta1.GlobalAddressObservation) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if o.Address != "" {
+		cd[v1beta1.AddressKey] = []byte(o.Address)
+	}
+	return cd
+}
+
+// PurposeVPCPeering is the purpose of global addresses that allocate an IP
+// range for private services access, e.g. for Cloud SQL or Memorystore.
+const PurposeVPCPeering = "VPC_PEERING"
+
+// AllocatedRange returns the IPv4 range, in CIDR notation, that the supplied
+// global address allocates for private services access. It returns an empty
+// string if the address does not allocate a range.
+func AllocatedRange(observed compute.Address) string {
+	if observed.Purpose != PurposeVPCPeering || observed.Address == "" || observed.PrefixLength == 0 {
+		return ""
+	}
+	return observed.Address + "/" + strconv.FormatInt(observed.PrefixLength, 10)
+}
+
+// UsedIPs returns the number of addresses of the supplied allocated range
+// that are used by the subnetworks of service producers, i.e. that are
+// covered by the supplied routes imported from peered networks.
+func UsedIPs(allocated string, routes []*compute.ExchangedPeeringRoute) (int64, error) {
+	seen := map[string]bool{}
+	var used int64
+	for _, r := range routes {
+		if seen[r.DestRange] || !utilization.RangeContains(allocated, r.DestRange) {
+			continue
+		}
+		seen[r.DestRange] = true
+		size, err := utilization.IPv4RangeSize(r.DestRange)
+		if err != nil {
+			return 0, err
+		}
+		used += size
+	}
+	return used, nil
+}
@@ -93,6 +93,7 @@ func addOutputFields(n *compute.Address) {
 func observation(m ...func(*v1beta1.GlobalAddressObservation)) *v1beta1.GlobalAddressObservation {
 	o := &v1beta1.GlobalAddressObservation{
 		Status:            v1beta1.StatusReserving,
+		Address:           addressIP,
 		CreationTimestamp: timestamp,
 		ID:                id,
 		SelfLink:          link,
@@ -222,3 +223,43 @@ func TestLateInitializeSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestAllocatedRange(t *testing.T) {
+	cases := map[string]struct {
+		in   compute.Address
+		want string
+	}{
+		"VPCPeering": {
+			in:   compute.Address{Purpose: PurposeVPCPeering, Address: "10.20.0.0", PrefixLength: 16},
+			want: "10.20.0.0/16",
+		},
+		"OtherPurpose": {
+			in:   compute.Address{Purpose: "PRIVATE_SERVICE_CONNECT", Address: "10.20.0.5"},
+			want: "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, AllocatedRange(tc.in)); diff != "" {
+				t.Errorf("AllocatedRange(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUsedIPs(t *testing.T) {
+	routes := []*compute.ExchangedPeeringRoute{
+		{DestRange: "10.20.0.0/24"},
+		{DestRange: "10.20.0.0/24"},
+		{DestRange: "10.20.4.0/22"},
+		{DestRange: "192.168.0.0/24"},
+	}
+	got, err := UsedIPs("10.20.0.0/16", routes)
+	if err != nil {
+		t.Fatalf("UsedIPs(...): %s", err)
+	}
+	if diff := cmp.Diff(int64(256+1024), got); diff != "" {
+		t.Errorf("UsedIPs(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcroute
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat    = "projects/%s/locations/global"
+	routeNameFormat = "projects/%s/locations/global/grpcRoutes/%s"
+)
+
+// UpdateMask lists the fields of a route that are patched on update.
+const UpdateMask = "description,labels,hostnames,meshes,gateways,rules"
+
+// Client should be satisfied to conduct GRPCRoute operations.
+type Client interface {
+	Create(parent string, route *networkservices.GrpcRoute) *networkservices.ProjectsLocationsGrpcRoutesCreateCall
+	Get(name string) *networkservices.ProjectsLocationsGrpcRoutesGetCall
+	Patch(name string, route *networkservices.GrpcRoute) *networkservices.ProjectsLocationsGrpcRoutesPatchCall
+	Delete(name string) *networkservices.ProjectsLocationsGrpcRoutesDeleteCall
+}
+
+// GetFullyQualifiedParent builds the fully qualified name of the parent of a
+// route.
+func GetFullyQualifiedParent(project string) string {
+	return fmt.Sprintf(parentFormat, project)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a route.
+func GetFullyQualifiedName(project, name string) string {
+	return fmt.Sprintf(routeNameFormat, project, name)
+}
+
+// GenerateGRPCRoute generates *networkservices.GrpcRoute instance from
+// GRPCRouteParameters.
+func GenerateGRPCRoute(in v1alpha1.GRPCRouteParameters) *networkservices.GrpcRoute {
+	r := &networkservices.GrpcRoute{
+		Description: gcp.StringValue(in.Description),
+		Labels:      in.Labels,
+		Hostnames:   in.Hostnames,
+		Meshes:      in.Meshes,
+		Gateways:    in.Gateways,
+	}
+	for _, rule := range in.Rules {
+		rr := &networkservices.GrpcRouteRouteRule{Action: generateAction(rule.Action)}
+		for _, m := range rule.Matches {
+			rr.Matches = append(rr.Matches, generateMatch(m))
+		}
+		r.Rules = append(r.Rules, rr)
+	}
+	return r
+}
+
+func generateMatch(in v1alpha1.GRPCRouteMatch) *networkservices.GrpcRouteRouteMatch {
+	m := &networkservices.GrpcRouteRouteMatch{}
+	if mm := in.Method; mm != nil {
+		m.Method = &networkservices.GrpcRouteMethodMatch{
+			Type:          gcp.StringValue(mm.Type),
+			GrpcService:   mm.GRPCService,
+			GrpcMethod:    mm.GRPCMethod,
+			CaseSensitive: gcp.BoolValue(mm.CaseSensitive),
+		}
+		// Methods are matched case sensitively unless explicitly disabled,
+		// so false must be sent.
+		if mm.CaseSensitive != nil {
+			m.Method.ForceSendFields = []string{"CaseSensitive"}
+		}
+	}
+	for _, h := range in.Headers {
+		m.Headers = append(m.Headers, &networkservices.GrpcRouteHeaderMatch{
+			Type:  gcp.StringValue(h.Type),
+			Key:   h.Key,
+			Value: h.Value,
+		})
+	}
+	return m
+}
+
+func generateAction(in v1alpha1.GRPCRouteAction) *networkservices.GrpcRouteRouteAction {
+	a := &networkservices.GrpcRouteRouteAction{Timeout: gcp.StringValue(in.Timeout)}
+	for _, d := range in.Destinations {
+		a.Destinations = append(a.Destinations, &networkservices.GrpcRouteDestination{
+			ServiceName: d.ServiceName,
+			Weight:      gcp.Int64Value(d.Weight),
+		})
+	}
+	if p := in.RetryPolicy; p != nil {
+		a.RetryPolicy = &networkservices.GrpcRouteRetryPolicy{
+			NumRetries:      gcp.Int64Value(p.NumRetries),
+			RetryConditions: p.RetryConditions,
+		}
+	}
+	return a
+}
+
+// GenerateObservation produces GRPCRouteObservation object from
+// networkservices.GrpcRoute object.
+func GenerateObservation(in networkservices.GrpcRoute) v1alpha1.GRPCRouteObservation {
+	return v1alpha1.GRPCRouteObservation{
+		Name:       in.Name,
+		SelfLink:   in.SelfLink,
+		CreateTime: in.CreateTime,
+		UpdateTime: in.UpdateTime,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// networkservices.GrpcRoute object.
+func LateInitializeSpec(spec *v1alpha1.GRPCRouteParameters, in networkservices.GrpcRoute) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+}
+
+// IsUpToDate returns true if the observed route matches the desired one.
+// Backend services are compared regardless of whether their URLs are fully
+// qualified.
+func IsUpToDate(in v1alpha1.GRPCRouteParameters, observed networkservices.GrpcRoute) bool {
+	return cmp.Equal(GenerateGRPCRoute(in), &observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(networkservices.GrpcRoute{}, "Name", "SelfLink", "CreateTime", "UpdateTime", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(networkservices.GrpcRouteMethodMatch{}, "ForceSendFields"))
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcroute
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	meshName       = "projects/someProject/locations/global/meshes/mesh"
+	backendService = "projects/someProject/global/backendServices/service"
+)
+
+func params() v1alpha1.GRPCRouteParameters {
+	return v1alpha1.GRPCRouteParameters{
+		Hostnames: []string{"helloworld.example.com"},
+		Meshes:    []string{meshName},
+		Rules: []v1alpha1.GRPCRouteRule{{
+			Matches: []v1alpha1.GRPCRouteMatch{{
+				Method:  &v1alpha1.GRPCRouteMethodMatch{GRPCService: "helloworld.Greeter", GRPCMethod: "SayHello", CaseSensitive: gcp.BoolPtr(false)},
+				Headers: []v1alpha1.GRPCRouteHeaderMatch{{Key: "x-canary", Value: "true"}},
+			}},
+			Action: v1alpha1.GRPCRouteAction{
+				Destinations: []v1alpha1.RouteDestination{{ServiceName: backendService}},
+				RetryPolicy:  &v1alpha1.GRPCRouteRetryPolicy{NumRetries: gcp.Int64Ptr(2), RetryConditions: []string{"unavailable"}},
+			},
+		}},
+	}
+}
+
+func route() *networkservices.GrpcRoute {
+	return &networkservices.GrpcRoute{
+		Hostnames: []string{"helloworld.example.com"},
+		Meshes:    []string{meshName},
+		Rules: []*networkservices.GrpcRouteRouteRule{{
+			Matches: []*networkservices.GrpcRouteRouteMatch{{
+				Method:  &networkservices.GrpcRouteMethodMatch{GrpcService: "helloworld.Greeter", GrpcMethod: "SayHello"},
+				Headers: []*networkservices.GrpcRouteHeaderMatch{{Key: "x-canary", Value: "true"}},
+			}},
+			Action: &networkservices.GrpcRouteRouteAction{
+				Destinations: []*networkservices.GrpcRouteDestination{{ServiceName: backendService}},
+				RetryPolicy:  &networkservices.GrpcRouteRetryPolicy{NumRetries: 2, RetryConditions: []string{"unavailable"}},
+			},
+		}},
+	}
+}
+
+func TestGenerateGRPCRoute(t *testing.T) {
+	want := route()
+	want.Rules[0].Matches[0].Method.ForceSendFields = []string{"CaseSensitive"}
+	if diff := cmp.Diff(want, GenerateGRPCRoute(params())); diff != "" {
+		t.Errorf("GenerateGRPCRoute(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		observed func(r *networkservices.GrpcRoute)
+		want     bool
+	}{
+		"UpToDate": {
+			observed: func(r *networkservices.GrpcRoute) { r.Name = GetFullyQualifiedName("someProject", "route") },
+			want:     true,
+		},
+		"FullyQualifiedBackendService": {
+			observed: func(r *networkservices.GrpcRoute) {
+				r.Rules[0].Action.Destinations[0].ServiceName = "https://www.googleapis.com/compute/v1/" + backendService
+			},
+			want: true,
+		},
+		"CaseSensitive": {
+			observed: func(r *networkservices.GrpcRoute) { r.Rules[0].Matches[0].Method.CaseSensitive = true },
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := route()
+			tc.observed(observed)
+			if diff := cmp.Diff(tc.want, IsUpToDate(params(), *observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const errCheckUpToDate = "unable to determine if external resource is up to date"
+
+// GenerateHealthCheck takes a HealthCheckParameters and assigns its values to
+// the supplied *compute.HealthCheck. The type of the health check is the one
+// of the check that is set.
+func GenerateHealthCheck(name string, in v1alpha1.HealthCheckParameters, hc *compute.HealthCheck) {
+	hc.Name = name
+	hc.Description = gcp.StringValue(in.Description)
+	hc.CheckIntervalSec = gcp.Int64Value(in.CheckIntervalSec)
+	hc.TimeoutSec = gcp.Int64Value(in.TimeoutSec)
+	hc.HealthyThreshold = gcp.Int64Value(in.HealthyThreshold)
+	hc.UnhealthyThreshold = gcp.Int64Value(in.UnhealthyThreshold)
+
+	hc.TcpHealthCheck = nil
+	hc.HttpHealthCheck = nil
+	hc.HttpsHealthCheck = nil
+	hc.GrpcHealthCheck = nil
+	switch {
+	case in.TCPHealthCheck != nil:
+		hc.Type = v1alpha1.HealthCheckTypeTCP
+		hc.TcpHealthCheck = &compute.TCPHealthCheck{
+			Port:              gcp.Int64Value(in.TCPHealthCheck.Port),
+			PortName:          gcp.StringValue(in.TCPHealthCheck.PortName),
+			PortSpecification: gcp.StringValue(in.TCPHealthCheck.PortSpecification),
+			Request:           gcp.StringValue(in.TCPHealthCheck.Request),
+			Response:          gcp.StringValue(in.TCPHealthCheck.Response),
+			ProxyHeader:       gcp.StringValue(in.TCPHealthCheck.ProxyHeader),
+		}
+	case in.HTTPHealthCheck != nil:
+		hc.Type = v1alpha1.HealthCheckTypeHTTP
+		hc.HttpHealthCheck = &compute.HTTPHealthCheck{
+			Port:              gcp.Int64Value(in.HTTPHealthCheck.Port),
+			PortName:          gcp.StringValue(in.HTTPHealthCheck.PortName),
+			PortSpecification: gcp.StringValue(in.HTTPHealthCheck.PortSpecification),
+			Host:              gcp.StringValue(in.HTTPHealthCheck.Host),
+			RequestPath:       gcp.StringValue(in.HTTPHealthCheck.RequestPath),
+			Response:          gcp.StringValue(in.HTTPHealthCheck.Response),
+			ProxyHeader:       gcp.StringValue(in.HTTPHealthCheck.ProxyHeader),
+		}
+	case in.HTTPSHealthCheck != nil:
+		hc.Type = v1alpha1.HealthCheckTypeHTTPS
+		hc.HttpsHealthCheck = &compute.HTTPSHealthCheck{
+			Port:              gcp.Int64Value(in.HTTPSHealthCheck.Port),
+			PortName:          gcp.StringValue(in.HTTPSHealthCheck.PortName),
+			PortSpecification: gcp.StringValue(in.HTTPSHealthCheck.PortSpecification),
+			Host:              gcp.StringValue(in.HTTPSHealthCheck.Host),
+			RequestPath:       gcp.StringValue(in.HTTPSHealthCheck.RequestPath),
+			Response:          gcp.StringValue(in.HTTPSHealthCheck.Response),
+			ProxyHeader:       gcp.StringValue(in.HTTPSHealthCheck.ProxyHeader),
+		}
+	case in.GRPCHealthCheck != nil:
+		hc.Type = v1alpha1.HealthCheckTypeGRPC
+		hc.GrpcHealthCheck = &compute.GRPCHealthCheck{
+			Port:              gcp.Int64Value(in.GRPCHealthCheck.Port),
+			PortName:          gcp.StringValue(in.GRPCHealthCheck.PortName),
+			PortSpecification: gcp.StringValue(in.GRPCHealthCheck.PortSpecification),
+			GrpcServiceName:   gcp.StringValue(in.GRPCHealthCheck.GRPCServiceName),
+		}
+	}
+}
+
+// GenerateObservation produces HealthCheckObservation object from
+// *compute.HealthCheck object.
+func GenerateObservation(in compute.HealthCheck) v1alpha1.HealthCheckObservation {
+	return v1alpha1.HealthCheckObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Type:              in.Type,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.HealthCheck object. Only the fields of the check that is set are
+// late-initialized.
+func LateInitializeSpec(p *v1alpha1.HealthCheckParameters, observed compute.HealthCheck) {
+	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
+	p.CheckIntervalSec = gcp.LateInitializeInt64(p.CheckIntervalSec, observed.CheckIntervalSec)
+	p.TimeoutSec = gcp.LateInitializeInt64(p.TimeoutSec, observed.TimeoutSec)
+	p.HealthyThreshold = gcp.LateInitializeInt64(p.HealthyThreshold, observed.HealthyThreshold)
+	p.UnhealthyThreshold = gcp.LateInitializeInt64(p.UnhealthyThreshold, observed.UnhealthyThreshold)
+
+	if p.TCPHealthCheck != nil && observed.TcpHealthCheck != nil {
+		o := observed.TcpHealthCheck
+		lateInitializePort(&p.TCPHealthCheck.HealthCheckPort, o.Port, o.PortSpecification)
+		p.TCPHealthCheck.ProxyHeader = gcp.LateInitializeString(p.TCPHealthCheck.ProxyHeader, o.ProxyHeader)
+	}
+	if p.HTTPHealthCheck != nil && observed.HttpHealthCheck != nil {
+		o := observed.HttpHealthCheck
+		lateInitializePort(&p.HTTPHealthCheck.HealthCheckPort, o.Port, o.PortSpecification)
+		p.HTTPHealthCheck.RequestPath = gcp.LateInitializeString(p.HTTPHealthCheck.RequestPath, o.RequestPath)
+		p.HTTPHealthCheck.ProxyHeader = gcp.LateInitializeString(p.HTTPHealthCheck.ProxyHeader, o.ProxyHeader)
+	}
+	if p.HTTPSHealthCheck != nil && observed.HttpsHealthCheck != nil {
+		o := observed.HttpsHealthCheck
+		lateInitializePort(&p.HTTPSHealthCheck.HealthCheckPort, o.Port, o.PortSpecification)
+		p.HTTPSHealthCheck.RequestPath = gcp.LateInitializeString(p.HTTPSHealthCheck.RequestPath, o.RequestPath)
+		p.HTTPSHealthCheck.ProxyHeader = gcp.LateInitializeString(p.HTTPSHealthCheck.ProxyHeader, o.ProxyHeader)
+	}
+	if p.GRPCHealthCheck != nil && observed.GrpcHealthCheck != nil {
+		o := observed.GrpcHealthCheck
+		lateInitializePort(&p.GRPCHealthCheck.HealthCheckPort, o.Port, o.PortSpecification)
+	}
+}
+
+func lateInitializePort(p *v1alpha1.HealthCheckPort, port int64, spec string) {
+	p.PortSpecification = gcp.LateInitializeString(p.PortSpecification, spec)
+	// The port of checks that probe a named or the serving port is unset.
+	if p.PortName == nil {
+		p.Port = gcp.LateInitializeInt64(p.Port, port)
+	}
+}
+
+// IsUpToDate checks whether the observed health check is up-to-date compared
+// to the given set of parameters.
+func IsUpToDate(name string, in v1alpha1.HealthCheckParameters, observed *compute.HealthCheck) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.HealthCheck)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateHealthCheck(name, in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(compute.HealthCheck{}, "ForceSendFields"),
+	), nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func TestGenerateHealthCheck(t *testing.T) {
+	in := v1alpha1.HealthCheckParameters{
+		CheckIntervalSec: gcp.Int64Ptr(10),
+		HTTPHealthCheck: &v1alpha1.HTTPHealthCheck{
+			HealthCheckPort: v1alpha1.HealthCheckPort{Port: gcp.Int64Ptr(8080)},
+			RequestPath:     gcp.StringPtr("/healthz"),
+		},
+	}
+	// The TCP check of a health check that used to be a TCP check is
+	// removed.
+	got := &compute.HealthCheck{Type: "TCP", TcpHealthCheck: &compute.TCPHealthCheck{Port: 80}}
+	GenerateHealthCheck("hc", in, got)
+	want := &compute.HealthCheck{
+		Name:             "hc",
+		Type:             "HTTP",
+		CheckIntervalSec: 10,
+		HttpHealthCheck:  &compute.HTTPHealthCheck{Port: 8080, RequestPath: "/healthz"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateHealthCheck(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	observed := compute.HealthCheck{
+		CheckIntervalSec:   5,
+		TimeoutSec:         5,
+		HealthyThreshold:   2,
+		UnhealthyThreshold: 2,
+		GrpcHealthCheck:    &compute.GRPCHealthCheck{PortSpecification: "USE_NAMED_PORT"},
+	}
+	got := &v1alpha1.HealthCheckParameters{
+		GRPCHealthCheck: &v1alpha1.GRPCHealthCheck{
+			HealthCheckPort: v1alpha1.HealthCheckPort{PortName: gcp.StringPtr("grpc")},
+		},
+	}
+	want := &v1alpha1.HealthCheckParameters{
+		CheckIntervalSec:   gcp.Int64Ptr(5),
+		TimeoutSec:         gcp.Int64Ptr(5),
+		HealthyThreshold:   gcp.Int64Ptr(2),
+		UnhealthyThreshold: gcp.Int64Ptr(2),
+		GRPCHealthCheck: &v1alpha1.GRPCHealthCheck{
+			HealthCheckPort: v1alpha1.HealthCheckPort{
+				PortName:          gcp.StringPtr("grpc"),
+				PortSpecification: gcp.StringPtr("USE_NAMED_PORT"),
+			},
+		},
+	}
+	LateInitializeSpec(got, observed)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &compute.HealthCheck{
+		Name:           "hc",
+		Type:           "TCP",
+		TimeoutSec:     5,
+		TcpHealthCheck: &compute.TCPHealthCheck{Port: 80},
+	}
+	cases := map[string]struct {
+		in   v1alpha1.HealthCheckParameters
+		want bool
+	}{
+		"UpToDate": {
+			in: v1alpha1.HealthCheckParameters{
+				TimeoutSec:     gcp.Int64Ptr(5),
+				TCPHealthCheck: &v1alpha1.TCPHealthCheck{HealthCheckPort: v1alpha1.HealthCheckPort{Port: gcp.Int64Ptr(80)}},
+			},
+			want: true,
+		},
+		"PortChanged": {
+			in: v1alpha1.HealthCheckParameters{
+				TimeoutSec:     gcp.Int64Ptr(5),
+				TCPHealthCheck: &v1alpha1.TCPHealthCheck{HealthCheckPort: v1alpha1.HealthCheckPort{Port: gcp.Int64Ptr(443)}},
+			},
+			want: false,
+		},
+		"TypeChanged": {
+			in: v1alpha1.HealthCheckParameters{
+				TimeoutSec:       gcp.Int64Ptr(5),
+				HTTPSHealthCheck: &v1alpha1.HTTPHealthCheck{HealthCheckPort: v1alpha1.HealthCheckPort{Port: gcp.Int64Ptr(80)}},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate("hc", tc.in, observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hierarchypolicy
+
+import (
+	"context"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	crmv2 "google.golang.org/api/cloudresourcemanager/v2"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/projectpolicy"
+)
+
+// A Client gets and sets the IAM policies of both folders and organizations,
+// which the Cloud Resource Manager API serves through different API versions.
+// Folder policies are converted to and from the v1 policy type that projects
+// and organizations use, so that all of them share the same bind, unbind and
+// bound member functions of the projectpolicy package.
+type Client interface {
+	GetIamPolicy(ctx context.Context, resource string) (*cloudresourcemanager.Policy, error)
+	SetIamPolicy(ctx context.Context, resource string, p *cloudresourcemanager.Policy) error
+}
+
+// NewClient returns a Client backed by the supplied v1 and v2 Cloud Resource
+// Manager services.
+func NewClient(v1 *cloudresourcemanager.Service, v2 *crmv2.Service) Client {
+	return &policyClient{organizations: v1.Organizations, folders: v2.Folders}
+}
+
+type policyClient struct {
+	organizations *cloudresourcemanager.OrganizationsService
+	folders       *crmv2.FoldersService
+}
+
+func (c *policyClient) GetIamPolicy(ctx context.Context, resource string) (*cloudresourcemanager.Policy, error) {
+	if !isFolder(resource) {
+		return c.organizations.GetIamPolicy(resource, projectpolicy.GetIamPolicyRequest()).Context(ctx).Do()
+	}
+	req := &crmv2.GetIamPolicyRequest{Options: &crmv2.GetPolicyOptions{RequestedPolicyVersion: iamv1alpha1.PolicyVersion}}
+	p, err := c.folders.GetIamPolicy(resource, req).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return fromFolderPolicy(p), nil
+}
+
+func (c *policyClient) SetIamPolicy(ctx context.Context, resource string, p *cloudresourcemanager.Policy) error {
+	if !isFolder(resource) {
+		_, err := c.organizations.SetIamPolicy(resource, &cloudresourcemanager.SetIamPolicyRequest{Policy: p}).Context(ctx).Do()
+		return err
+	}
+	_, err := c.folders.SetIamPolicy(resource, &crmv2.SetIamPolicyRequest{Policy: toFolderPolicy(p)}).Context(ctx).Do()
+	return err
+}
+
+func isFolder(resource string) bool {
+	return gcpv1beta1.ScopeOf(resource) == gcpv1beta1.ScopeFolder
+}
+
+// FolderName returns the relative resource name of the folder with the
+// supplied ID. IDs that already carry the folders/ prefix are returned as is.
+func FolderName(id string) string {
+	return gcpv1beta1.ScopedName(gcpv1beta1.ScopeFolder, id)
+}
+
+// OrganizationName returns the relative resource name of the organization
+// with the supplied ID. IDs that already carry the organizations/ prefix are
+// returned as is.
+func OrganizationName(id string) string {
+	return gcpv1beta1.ScopedName(gcpv1beta1.ScopeOrganization, id)
+}
+
+// fromFolderPolicy converts the bindings, etag and version of a folder
+// policy. Audit configs are not converted: setting a policy without an update
+// mask only updates its bindings and etag, so they are left as they are.
+func fromFolderPolicy(in *crmv2.Policy) *cloudresourcemanager.Policy {
+	p := &cloudresourcemanager.Policy{Etag: in.Etag, Version: in.Version}
+	for _, b := range in.Bindings {
+		ob := &cloudresourcemanager.Binding{Role: b.Role, Members: b.Members}
+		if b.Condition != nil {
+			ob.Condition = &cloudresourcemanager.Expr{
+				Description: b.Condition.Description,
+				Expression:  b.Condition.Expression,
+				Location:    b.Condition.Location,
+				Title:       b.Condition.Title,
+			}
+		}
+		p.Bindings = append(p.Bindings, ob)
+	}
+	return p
+}
+
+func toFolderPolicy(in *cloudresourcemanager.Policy) *crmv2.Policy {
+	p := &crmv2.Policy{Etag: in.Etag, Version: in.Version}
+	for _, b := range in.Bindings {
+		ob := &crmv2.Binding{Role: b.Role, Members: b.Members}
+		if b.Condition != nil {
+			ob.Condition = &crmv2.Expr{
+				Description: b.Condition.Description,
+				Expression:  b.Condition.Expression,
+				Location:    b.Condition.Location,
+				Title:       b.Condition.Title,
+			}
+		}
+		p.Bindings = append(p.Bindings, ob)
+	}
+	return p
+}
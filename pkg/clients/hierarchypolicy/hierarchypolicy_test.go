@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hierarchypolicy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	crmv2 "google.golang.org/api/cloudresourcemanager/v2"
+	"google.golang.org/api/option"
+)
+
+func TestNames(t *testing.T) {
+	cases := map[string]struct {
+		fn   func(string) string
+		id   string
+		want string
+	}{
+		"Folder":               {fn: FolderName, id: "123", want: "folders/123"},
+		"PrefixedFolder":       {fn: FolderName, id: "folders/123", want: "folders/123"},
+		"Organization":         {fn: OrganizationName, id: "456", want: "organizations/456"},
+		"PrefixedOrganization": {fn: OrganizationName, id: "organizations/456", want: "organizations/456"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.fn(tc.id)); diff != "" {
+				t.Errorf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyClient(t *testing.T) {
+	policy := &cloudresourcemanager.Policy{
+		Etag:    "etag",
+		Version: 3,
+		Bindings: []*cloudresourcemanager.Binding{
+			{Role: "roles/viewer", Members: []string{"user:a@example.com"}},
+			{
+				Role:      "roles/editor",
+				Members:   []string{"user:b@example.com"},
+				Condition: &cloudresourcemanager.Expr{Title: "t", Expression: "e"},
+			},
+		},
+	}
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		req := map[string]json.RawMessage{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.WriteHeader(http.StatusOK)
+		// Echo the policy that is set, and serve the test policy otherwise.
+		if p, ok := req["policy"]; ok {
+			_, _ = w.Write(p)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(policy)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	opts := []option.ClientOption{option.WithEndpoint(server.URL), option.WithoutAuthentication()}
+	v1, _ := cloudresourcemanager.NewService(ctx, opts...)
+	v2, _ := crmv2.NewService(ctx, opts...)
+	c := NewClient(v1, v2)
+
+	for _, resource := range []string{"folders/123", "organizations/456"} {
+		got, err := c.GetIamPolicy(ctx, resource)
+		if err != nil {
+			t.Fatalf("GetIamPolicy(%s): %v", resource, err)
+		}
+		if diff := cmp.Diff(policy, got, cmp.FilterPath(func(p cmp.Path) bool { return p.Last().String() == ".ServerResponse" }, cmp.Ignore())); diff != "" {
+			t.Errorf("GetIamPolicy(%s): -want, +got:\n%s", resource, diff)
+		}
+		if err := c.SetIamPolicy(ctx, resource, got); err != nil {
+			t.Fatalf("SetIamPolicy(%s): %v", resource, err)
+		}
+	}
+
+	want := []string{
+		"/v2/folders/123:getIamPolicy",
+		"/v2/folders/123:setIamPolicy",
+		"/v1/organizations/456:getIamPolicy",
+		"/v1/organizations/456:setIamPolicy",
+	}
+	if diff := cmp.Diff(want, paths); diff != "" {
+		t.Errorf("-want paths, +got paths:\n%s", diff)
+	}
+}
+
+func TestConversion(t *testing.T) {
+	in := &crmv2.Policy{
+		Etag:    "etag",
+		Version: 3,
+		Bindings: []*crmv2.Binding{
+			{Role: "roles/viewer", Members: []string{"user:a@example.com"}},
+			{Role: "roles/editor", Members: []string{"user:b@example.com"}, Condition: &crmv2.Expr{Title: "t", Expression: "e"}},
+		},
+	}
+	if diff := cmp.Diff(in, toFolderPolicy(fromFolderPolicy(in))); diff != "" {
+		t.Errorf("toFolderPolicy(fromFolderPolicy(...)): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hmackey
+
+import (
+	"google.golang.org/api/storage/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// Connection detail keys
+const (
+	ConnectionKeyAccessID = "accessId"
+	ConnectionKeySecret   = "secret"
+)
+
+// Client should be satisfied to conduct HMACKey operations.
+type Client interface {
+	Create(projectID string, serviceAccountEmail string) *storage.ProjectsHmacKeysCreateCall
+	Get(projectID string, accessID string) *storage.ProjectsHmacKeysGetCall
+	Update(projectID string, accessID string, hmackeymetadata *storage.HmacKeyMetadata) *storage.ProjectsHmacKeysUpdateCall
+	Delete(projectID string, accessID string) *storage.ProjectsHmacKeysDeleteCall
+}
+
+// GenerateHmacKeyMetadata generates *storage.HmacKeyMetadata instance from
+// HMACKeyParameters. Only the state of a key can be updated.
+func GenerateHmacKeyMetadata(in v1alpha1.HMACKeyParameters) *storage.HmacKeyMetadata {
+	return &storage.HmacKeyMetadata{
+		State: gcp.StringValue(in.State),
+	}
+}
+
+// GenerateObservation produces HMACKeyObservation object from
+// storage.HmacKeyMetadata object.
+func GenerateObservation(in storage.HmacKeyMetadata) v1alpha1.HMACKeyObservation {
+	return v1alpha1.HMACKeyObservation{
+		AccessID:            in.AccessId,
+		ID:                  in.Id,
+		ServiceAccountEmail: in.ServiceAccountEmail,
+		State:               in.State,
+		TimeCreated:         in.TimeCreated,
+		Updated:             in.Updated,
+		Etag:                in.Etag,
+		SelfLink:            in.SelfLink,
+	}
+}
+
+// GetConnectionDetails returns the connection details of the supplied key.
+// The secret is only returned by the API when the key is created.
+func GetConnectionDetails(in storage.HmacKey) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if in.Metadata != nil {
+		cd[ConnectionKeyAccessID] = []byte(in.Metadata.AccessId)
+	}
+	if in.Secret != "" {
+		cd[ConnectionKeySecret] = []byte(in.Secret)
+	}
+	return cd
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// storage.HmacKeyMetadata object.
+func LateInitializeSpec(spec *v1alpha1.HMACKeyParameters, in storage.HmacKeyMetadata) {
+	spec.ServiceAccountEmail = gcp.LateInitializeString(spec.ServiceAccountEmail, in.ServiceAccountEmail)
+	spec.State = gcp.LateInitializeString(spec.State, in.State)
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in v1alpha1.HMACKeyParameters, observed storage.HmacKeyMetadata) bool {
+	return in.State == nil || *in.State == observed.State
+}
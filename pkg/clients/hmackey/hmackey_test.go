@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hmackey
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/storage/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testAccessID = "GOOG1EXAMPLE"
+	testEmail    = "writer@my-project.iam.gserviceaccount.com"
+)
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in   storage.HmacKey
+		want managed.ConnectionDetails
+	}{
+		"Created": {
+			in: storage.HmacKey{
+				Metadata: &storage.HmacKeyMetadata{AccessId: testAccessID},
+				Secret:   "bPxRfiCYEXAMPLEKEY",
+			},
+			want: managed.ConnectionDetails{
+				ConnectionKeyAccessID: []byte(testAccessID),
+				ConnectionKeySecret:   []byte("bPxRfiCYEXAMPLEKEY"),
+			},
+		},
+		"NoSecret": {
+			in: storage.HmacKey{Metadata: &storage.HmacKeyMetadata{AccessId: testAccessID}},
+			want: managed.ConnectionDetails{
+				ConnectionKeyAccessID: []byte(testAccessID),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetConnectionDetails(tc.in)); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	spec := &v1alpha1.HMACKeyParameters{}
+	LateInitializeSpec(spec, storage.HmacKeyMetadata{
+		AccessId:            testAccessID,
+		ServiceAccountEmail: testEmail,
+		State:               v1alpha1.HMACKeyStateActive,
+	})
+	want := &v1alpha1.HMACKeyParameters{
+		ServiceAccountEmail: gcp.StringPtr(testEmail),
+		State:               gcp.StringPtr(v1alpha1.HMACKeyStateActive),
+	}
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.HMACKeyParameters
+		observed storage.HmacKeyMetadata
+		want     bool
+	}{
+		"UpToDate": {
+			in:       v1alpha1.HMACKeyParameters{State: gcp.StringPtr(v1alpha1.HMACKeyStateActive)},
+			observed: storage.HmacKeyMetadata{State: v1alpha1.HMACKeyStateActive},
+			want:     true,
+		},
+		"StateUnset": {
+			in:       v1alpha1.HMACKeyParameters{},
+			observed: storage.HmacKeyMetadata{State: v1alpha1.HMACKeyStateInactive},
+			want:     true,
+		},
+		"Deactivated": {
+			in:       v1alpha1.HMACKeyParameters{State: gcp.StringPtr(v1alpha1.HMACKeyStateInactive)},
+			observed: storage.HmacKeyMetadata{State: v1alpha1.HMACKeyStateActive},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat    = "projects/%s/locations/global"
+	routeNameFormat = "projects/%s/locations/global/httpRoutes/%s"
+)
+
+// UpdateMask lists the fields of a route that are patched on update.
+const UpdateMask = "description,labels,hostnames,meshes,gateways,rules"
+
+// Client should be satisfied to conduct HTTPRoute operations.
+type Client interface {
+	Create(parent string, route *networkservices.HttpRoute) *networkservices.ProjectsLocationsHttpRoutesCreateCall
+	Get(name string) *networkservices.ProjectsLocationsHttpRoutesGetCall
+	Patch(name string, route *networkservices.HttpRoute) *networkservices.ProjectsLocationsHttpRoutesPatchCall
+	Delete(name string) *networkservices.ProjectsLocationsHttpRoutesDeleteCall
+}
+
+// GetFullyQualifiedParent builds the fully qualified name of the parent of a
+// route.
+func GetFullyQualifiedParent(project string) string {
+	return fmt.Sprintf(parentFormat, project)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a route.
+func GetFullyQualifiedName(project, name string) string {
+	return fmt.Sprintf(routeNameFormat, project, name)
+}
+
+// GenerateHTTPRoute generates *networkservices.HttpRoute instance from
+// HTTPRouteParameters.
+func GenerateHTTPRoute(in v1alpha1.HTTPRouteParameters) *networkservices.HttpRoute {
+	r := &networkservices.HttpRoute{
+		Description: gcp.StringValue(in.Description),
+		Labels:      in.Labels,
+		Hostnames:   in.Hostnames,
+		Meshes:      in.Meshes,
+		Gateways:    in.Gateways,
+	}
+	for _, rule := range in.Rules {
+		rr := &networkservices.HttpRouteRouteRule{Action: generateAction(rule.Action)}
+		for _, m := range rule.Matches {
+			rr.Matches = append(rr.Matches, generateMatch(m))
+		}
+		r.Rules = append(r.Rules, rr)
+	}
+	return r
+}
+
+func generateMatch(in v1alpha1.HTTPRouteMatch) *networkservices.HttpRouteRouteMatch {
+	m := &networkservices.HttpRouteRouteMatch{
+		FullPathMatch: gcp.StringValue(in.FullPathMatch),
+		PrefixMatch:   gcp.StringValue(in.PrefixMatch),
+		RegexMatch:    gcp.StringValue(in.RegexMatch),
+		IgnoreCase:    gcp.BoolValue(in.IgnoreCase),
+	}
+	for _, h := range in.Headers {
+		m.Headers = append(m.Headers, &networkservices.HttpRouteHeaderMatch{
+			Header:       h.Header,
+			ExactMatch:   gcp.StringValue(h.ExactMatch),
+			PrefixMatch:  gcp.StringValue(h.PrefixMatch),
+			SuffixMatch:  gcp.StringValue(h.SuffixMatch),
+			RegexMatch:   gcp.StringValue(h.RegexMatch),
+			PresentMatch: gcp.BoolValue(h.PresentMatch),
+			InvertMatch:  gcp.BoolValue(h.InvertMatch),
+		})
+	}
+	return m
+}
+
+func generateAction(in v1alpha1.HTTPRouteAction) *networkservices.HttpRouteRouteAction {
+	a := &networkservices.HttpRouteRouteAction{Timeout: gcp.StringValue(in.Timeout)}
+	for _, d := range in.Destinations {
+		a.Destinations = append(a.Destinations, &networkservices.HttpRouteDestination{
+			ServiceName: d.ServiceName,
+			Weight:      gcp.Int64Value(d.Weight),
+		})
+	}
+	if p := in.RetryPolicy; p != nil {
+		a.RetryPolicy = &networkservices.HttpRouteRetryPolicy{
+			NumRetries:      gcp.Int64Value(p.NumRetries),
+			PerTryTimeout:   gcp.StringValue(p.PerTryTimeout),
+			RetryConditions: p.RetryConditions,
+		}
+	}
+	if u := in.URLRewrite; u != nil {
+		a.UrlRewrite = &networkservices.HttpRouteURLRewrite{
+			PathPrefixRewrite: gcp.StringValue(u.PathPrefixRewrite),
+			HostRewrite:       gcp.StringValue(u.HostRewrite),
+		}
+	}
+	return a
+}
+
+// GenerateObservation produces HTTPRouteObservation object from
+// networkservices.HttpRoute object.
+func GenerateObservation(in networkservices.HttpRoute) v1alpha1.HTTPRouteObservation {
+	return v1alpha1.HTTPRouteObservation{
+		Name:       in.Name,
+		SelfLink:   in.SelfLink,
+		CreateTime: in.CreateTime,
+		UpdateTime: in.UpdateTime,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// networkservices.HttpRoute object.
+func LateInitializeSpec(spec *v1alpha1.HTTPRouteParameters, in networkservices.HttpRoute) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+}
+
+// IsUpToDate returns true if the observed route matches the desired one.
+// Backend services are compared regardless of whether their URLs are fully
+// qualified.
+func IsUpToDate(in v1alpha1.HTTPRouteParameters, observed networkservices.HttpRoute) bool {
+	return cmp.Equal(GenerateHTTPRoute(in), &observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(networkservices.HttpRoute{}, "Name", "SelfLink", "CreateTime", "UpdateTime", "ServerResponse", "ForceSendFields", "NullFields"))
+}
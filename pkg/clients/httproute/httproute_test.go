@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httproute
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	meshName       = "projects/someProject/locations/global/meshes/mesh"
+	backendService = "projects/someProject/global/backendServices/service"
+)
+
+func params() v1alpha1.HTTPRouteParameters {
+	return v1alpha1.HTTPRouteParameters{
+		Hostnames: []string{"example.com"},
+		Meshes:    []string{meshName},
+		Rules: []v1alpha1.HTTPRouteRule{{
+			Matches: []v1alpha1.HTTPRouteMatch{{
+				PrefixMatch: gcp.StringPtr("/api"),
+				Headers:     []v1alpha1.HTTPRouteHeaderMatch{{Header: "x-canary", PresentMatch: gcp.BoolPtr(true)}},
+			}},
+			Action: v1alpha1.HTTPRouteAction{
+				Destinations: []v1alpha1.RouteDestination{{ServiceName: backendService, Weight: gcp.Int64Ptr(100)}},
+				Timeout:      gcp.StringPtr("5s"),
+				RetryPolicy:  &v1alpha1.HTTPRouteRetryPolicy{NumRetries: gcp.Int64Ptr(3), RetryConditions: []string{"5xx"}},
+				URLRewrite:   &v1alpha1.HTTPRouteURLRewrite{PathPrefixRewrite: gcp.StringPtr("/")},
+			},
+		}},
+	}
+}
+
+func route() *networkservices.HttpRoute {
+	return &networkservices.HttpRoute{
+		Hostnames: []string{"example.com"},
+		Meshes:    []string{meshName},
+		Rules: []*networkservices.HttpRouteRouteRule{{
+			Matches: []*networkservices.HttpRouteRouteMatch{{
+				PrefixMatch: "/api",
+				Headers:     []*networkservices.HttpRouteHeaderMatch{{Header: "x-canary", PresentMatch: true}},
+			}},
+			Action: &networkservices.HttpRouteRouteAction{
+				Destinations: []*networkservices.HttpRouteDestination{{ServiceName: backendService, Weight: 100}},
+				Timeout:      "5s",
+				RetryPolicy:  &networkservices.HttpRouteRetryPolicy{NumRetries: 3, RetryConditions: []string{"5xx"}},
+				UrlRewrite:   &networkservices.HttpRouteURLRewrite{PathPrefixRewrite: "/"},
+			},
+		}},
+	}
+}
+
+func TestGenerateHTTPRoute(t *testing.T) {
+	if diff := cmp.Diff(route(), GenerateHTTPRoute(params())); diff != "" {
+		t.Errorf("GenerateHTTPRoute(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		observed func(r *networkservices.HttpRoute)
+		want     bool
+	}{
+		"UpToDate": {
+			observed: func(r *networkservices.HttpRoute) { r.Name = GetFullyQualifiedName("someProject", "route") },
+			want:     true,
+		},
+		"FullyQualifiedBackendService": {
+			observed: func(r *networkservices.HttpRoute) {
+				r.Rules[0].Action.Destinations[0].ServiceName = "https://www.googleapis.com/compute/v1/" + backendService
+			},
+			want: true,
+		},
+		"WeightChanged": {
+			observed: func(r *networkservices.HttpRoute) { r.Rules[0].Action.Destinations[0].Weight = 50 },
+			want:     false,
+		},
+		"HostnameAdded": {
+			observed: func(r *networkservices.HttpRoute) { r.Hostnames = append(r.Hostnames, "example.org") },
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := route()
+			tc.observed(observed)
+			if diff := cmp.Diff(tc.want, IsUpToDate(params(), *observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idsendpoint
+
+import (
+	"fmt"
+
+	"google.golang.org/api/ids/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat       = "projects/%s/locations/%s"
+	endpointNameFormat = "projects/%s/locations/%s/endpoints/%s"
+)
+
+// Client should be satisfied to conduct Endpoint operations.
+type Client interface {
+	Create(parent string, endpoint *ids.Endpoint) *ids.ProjectsLocationsEndpointsCreateCall
+	Get(name string) *ids.ProjectsLocationsEndpointsGetCall
+	Delete(name string) *ids.ProjectsLocationsEndpointsDeleteCall
+}
+
+// GetFullyQualifiedParent builds the fully qualified name of the parent of
+// an endpoint.
+func GetFullyQualifiedParent(project string, p v1alpha1.EndpointParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of an endpoint.
+func GetFullyQualifiedName(project string, p v1alpha1.EndpointParameters, name string) string {
+	return fmt.Sprintf(endpointNameFormat, project, p.Location, name)
+}
+
+// GenerateEndpoint generates *ids.Endpoint instance from EndpointParameters.
+func GenerateEndpoint(in v1alpha1.EndpointParameters) *ids.Endpoint {
+	return &ids.Endpoint{
+		Network:     gcp.StringValue(in.Network),
+		Severity:    in.Severity,
+		Description: gcp.StringValue(in.Description),
+		Labels:      in.Labels,
+		TrafficLogs: gcp.BoolValue(in.TrafficLogs),
+	}
+}
+
+// GenerateObservation produces EndpointObservation object from ids.Endpoint
+// object.
+func GenerateObservation(in ids.Endpoint) v1alpha1.EndpointObservation {
+	return v1alpha1.EndpointObservation{
+		Name:                   in.Name,
+		CreateTime:             in.CreateTime,
+		UpdateTime:             in.UpdateTime,
+		EndpointForwardingRule: in.EndpointForwardingRule,
+		EndpointIP:             in.EndpointIp,
+		State:                  in.State,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in ids.Endpoint
+// object.
+func LateInitializeSpec(spec *v1alpha1.EndpointParameters, in ids.Endpoint) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+	spec.TrafficLogs = gcp.LateInitializeBool(spec.TrafficLogs, in.TrafficLogs)
+}
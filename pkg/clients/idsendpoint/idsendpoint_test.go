@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idsendpoint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/ids/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "cool-project"
+	testName    = "test-endpoint"
+	testNetwork = "projects/cool-project/global/networks/test-network"
+)
+
+func params() v1alpha1.EndpointParameters {
+	return v1alpha1.EndpointParameters{
+		Location:    "us-central1-a",
+		Network:     gcp.StringPtr(testNetwork),
+		Severity:    "INFORMATIONAL",
+		Description: gcp.StringPtr("ids for prod"),
+		Labels:      map[string]string{"env": "prod"},
+		TrafficLogs: gcp.BoolPtr(true),
+	}
+}
+
+func TestGetFullyQualifiedName(t *testing.T) {
+	want := "projects/cool-project/locations/us-central1-a/endpoints/test-endpoint"
+	if diff := cmp.Diff(want, GetFullyQualifiedName(testProject, params(), testName)); diff != "" {
+		t.Errorf("GetFullyQualifiedName(...): -want, +got:\n%s", diff)
+	}
+	want = "projects/cool-project/locations/us-central1-a"
+	if diff := cmp.Diff(want, GetFullyQualifiedParent(testProject, params())); diff != "" {
+		t.Errorf("GetFullyQualifiedParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.EndpointParameters
+		want *ids.Endpoint
+	}{
+		"Full": {
+			in: params(),
+			want: &ids.Endpoint{
+				Network:     testNetwork,
+				Severity:    "INFORMATIONAL",
+				Description: "ids for prod",
+				Labels:      map[string]string{"env": "prod"},
+				TrafficLogs: true,
+			},
+		},
+		"Minimal": {
+			in: v1alpha1.EndpointParameters{
+				Location: "us-central1-a",
+				Network:  gcp.StringPtr(testNetwork),
+				Severity: "HIGH",
+			},
+			want: &ids.Endpoint{
+				Network:  testNetwork,
+				Severity: "HIGH",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateEndpoint(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateEndpoint(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	in := ids.Endpoint{
+		Name:                   GetFullyQualifiedName(testProject, params(), testName),
+		CreateTime:             "2023-01-01T00:00:00Z",
+		UpdateTime:             "2023-01-01T00:10:00Z",
+		EndpointForwardingRule: "https://www.googleapis.com/compute/v1/projects/tenant/regions/us-central1/forwardingRules/ids-fr",
+		EndpointIp:             "10.0.0.5",
+		State:                  v1alpha1.EndpointStateReady,
+		Network:                testNetwork,
+	}
+	want := v1alpha1.EndpointObservation{
+		Name:                   in.Name,
+		CreateTime:             in.CreateTime,
+		UpdateTime:             in.UpdateTime,
+		EndpointForwardingRule: in.EndpointForwardingRule,
+		EndpointIP:             in.EndpointIp,
+		State:                  v1alpha1.EndpointStateReady,
+	}
+	if diff := cmp.Diff(want, GenerateObservation(in)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.EndpointParameters
+		in   ids.Endpoint
+		want *v1alpha1.EndpointParameters
+	}{
+		"AllFilled": {
+			spec: &v1alpha1.EndpointParameters{Severity: "LOW"},
+			in: ids.Endpoint{
+				Description: "from gcp",
+				Labels:      map[string]string{"team": "sec"},
+				TrafficLogs: true,
+			},
+			want: &v1alpha1.EndpointParameters{
+				Severity:    "LOW",
+				Description: gcp.StringPtr("from gcp"),
+				Labels:      map[string]string{"team": "sec"},
+				TrafficLogs: gcp.BoolPtr(true),
+			},
+		},
+		"KeepSpecValues": {
+			spec: func() *v1alpha1.EndpointParameters { p := params(); return &p }(),
+			in: ids.Endpoint{
+				Description: "from gcp",
+				Labels:      map[string]string{"team": "sec"},
+			},
+			want: func() *v1alpha1.EndpointParameters { p := params(); return &p }(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
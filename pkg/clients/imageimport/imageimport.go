@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageimport
+
+import (
+	"fmt"
+	"time"
+
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	// ImportToolImage is the container image of the image import tool that
+	// is run by the import build.
+	ImportToolImage = "gcr.io/compute-image-tools/gce_vm_image_import:release"
+
+	// DefaultTimeout is the maximum duration of an import if none is
+	// specified.
+	DefaultTimeout = 2 * time.Hour
+
+	// buildTimeoutBuffer leaves the import tool time to clean up its
+	// temporary resources before the build times out.
+	buildTimeoutBuffer = 3 * time.Minute
+
+	// TagImageImport tags all import builds created by this provider.
+	TagImageImport = "crossplane-image-import"
+
+	// Build statuses.
+	BuildStatusPending = "PENDING"
+	BuildStatusQueued  = "QUEUED"
+	BuildStatusWorking = "WORKING"
+	BuildStatusSuccess = "SUCCESS"
+
+	// ImageStatusReady is the status of an image that can be used.
+	ImageStatusReady = "READY"
+)
+
+// GenerateBuild produces a Cloud Build build that imports the supplied image
+// as configured via the supplied ImageImportParameters.
+func GenerateBuild(name string, in v1alpha1.ImageImportParameters) *cloudbuild.Build {
+	timeout := DefaultTimeout
+	if in.Timeout != nil {
+		timeout = in.Timeout.Duration
+	}
+	args := []string{
+		"-image_name=" + name,
+		"-source_file=" + in.SourceFile,
+		"-client_id=api",
+		"-timeout=" + timeout.String(),
+	}
+	if gcp.BoolValue(in.DataDisk) {
+		args = append(args, "-data_disk")
+	} else {
+		args = append(args, "-os="+gcp.StringValue(in.OS))
+	}
+	for _, f := range []struct {
+		name  string
+		value *string
+	}{
+		{name: "family", value: in.Family},
+		{name: "description", value: in.Description},
+		{name: "zone", value: in.Zone},
+		{name: "network", value: in.Network},
+		{name: "subnet", value: in.Subnet},
+	} {
+		if f.value != nil {
+			args = append(args, fmt.Sprintf("-%s=%s", f.name, *f.value))
+		}
+	}
+	return &cloudbuild.Build{
+		Steps: []*cloudbuild.BuildStep{{
+			Name: ImportToolImage,
+			Args: args,
+			Env:  []string{"BUILD_ID=$BUILD_ID"},
+		}},
+		Timeout: fmt.Sprintf("%ds", int64((timeout + buildTimeoutBuffer).Seconds())),
+		Tags:    []string{"gce-daisy", "gce-daisy-image-import", TagImageImport, name},
+	}
+}
+
+// BuildFilter returns the filter that lists the import builds of the
+// supplied image, most recent first.
+func BuildFilter(name string) string {
+	return fmt.Sprintf("tags=%q AND tags=%q", TagImageImport, name)
+}
+
+// IsBuildRunning returns true if the supplied build has not finished yet.
+func IsBuildRunning(b *cloudbuild.Build) bool {
+	switch b.Status {
+	case BuildStatusPending, BuildStatusQueued, BuildStatusWorking:
+		return true
+	}
+	return false
+}
+
+// IsBuildFailed returns true if the supplied build finished without
+// importing the image, e.g. because it failed, timed out or was cancelled.
+func IsBuildFailed(b *cloudbuild.Build) bool {
+	return !IsBuildRunning(b) && b.Status != BuildStatusSuccess
+}
+
+// UpdateBuildObservation updates the supplied ImageImportObservation with the
+// supplied import build.
+func UpdateBuildObservation(o *v1alpha1.ImageImportObservation, b *cloudbuild.Build) {
+	o.BuildID = b.Id
+	o.BuildStatus = b.Status
+	o.BuildStatusDetail = b.StatusDetail
+	o.LogURL = b.LogUrl
+	o.StartTime = b.StartTime
+	o.FinishTime = b.FinishTime
+}
+
+// UpdateImageObservation updates the supplied ImageImportObservation with the
+// supplied imported image.
+func UpdateImageObservation(o *v1alpha1.ImageImportObservation, i *compute.Image) {
+	o.SelfLink = i.SelfLink
+	o.Status = i.Status
+	o.DiskSizeGb = i.DiskSizeGb
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageimport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	imageName  = "cool-image"
+	sourceFile = "gs://cool-bucket/cool-image.vmdk"
+)
+
+func TestGenerateBuild(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.ImageImportParameters
+		args []string
+		out  *cloudbuild.Build
+	}{
+		"BootDisk": {
+			in: v1alpha1.ImageImportParameters{
+				SourceFile: sourceFile,
+				OS:         gcp.StringPtr("debian-11"),
+				Family:     gcp.StringPtr("cool-family"),
+				Zone:       gcp.StringPtr("us-central1-a"),
+			},
+			out: &cloudbuild.Build{
+				Steps: []*cloudbuild.BuildStep{{
+					Name: ImportToolImage,
+					Args: []string{
+						"-image_name=" + imageName,
+						"-source_file=" + sourceFile,
+						"-client_id=api",
+						"-timeout=2h0m0s",
+						"-os=debian-11",
+						"-family=cool-family",
+						"-zone=us-central1-a",
+					},
+					Env: []string{"BUILD_ID=$BUILD_ID"},
+				}},
+				Timeout: "7380s",
+				Tags:    []string{"gce-daisy", "gce-daisy-image-import", TagImageImport, imageName},
+			},
+		},
+		"DataDisk": {
+			in: v1alpha1.ImageImportParameters{
+				SourceFile: sourceFile,
+				DataDisk:   gcp.BoolPtr(true),
+				Timeout:    &metav1.Duration{Duration: 30 * time.Minute},
+			},
+			out: &cloudbuild.Build{
+				Steps: []*cloudbuild.BuildStep{{
+					Name: ImportToolImage,
+					Args: []string{
+						"-image_name=" + imageName,
+						"-source_file=" + sourceFile,
+						"-client_id=api",
+						"-timeout=30m0s",
+						"-data_disk",
+					},
+					Env: []string{"BUILD_ID=$BUILD_ID"},
+				}},
+				Timeout: "1980s",
+				Tags:    []string{"gce-daisy", "gce-daisy-image-import", TagImageImport, imageName},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateBuild(imageName, tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("GenerateBuild(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildState(t *testing.T) {
+	cases := map[string]struct {
+		status  string
+		running bool
+		failed  bool
+	}{
+		"Queued":    {status: BuildStatusQueued, running: true},
+		"Working":   {status: BuildStatusWorking, running: true},
+		"Succeeded": {status: BuildStatusSuccess},
+		"Failed":    {status: "FAILURE", failed: true},
+		"TimedOut":  {status: "TIMEOUT", failed: true},
+		"Cancelled": {status: "CANCELLED", failed: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := &cloudbuild.Build{Status: tc.status}
+			if got := IsBuildRunning(b); got != tc.running {
+				t.Errorf("IsBuildRunning(...): want %t, got %t", tc.running, got)
+			}
+			if got := IsBuildFailed(b); got != tc.failed {
+				t.Errorf("IsBuildFailed(...): want %t, got %t", tc.failed, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,356 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/instanceconfig"
+)
+
+const (
+	diskTypePersistent   = "PERSISTENT"
+	accessConfigOneToOne = "ONE_TO_ONE_NAT"
+	accessConfigIPv6     = "DIRECT_IPV6"
+	networkTierPremium   = "PREMIUM"
+
+	errGetSecret         = "cannot get Secret with disk encryption key"
+	errFmtKeyNotFound    = "key %q not found in Secret %s/%s"
+	errFmtDiskEncryption = "cannot get encryption keys of disk %d"
+)
+
+// ZonalURL returns the partially qualified URL of the supplied zonal
+// resource, e.g. zones/us-central1-a/machineTypes/e2-small for the machine
+// type e2-small. Values that are already URLs are returned unchanged.
+func ZonalURL(zone, collection, name string) string {
+	if name == "" || strings.Contains(name, "/") {
+		return name
+	}
+	return "zones/" + zone + "/" + collection + "/" + name
+}
+
+// SourceImageFamilies returns the image families that must be resolved when
+// the supplied instance is created, indexed like its disks. Existing disks,
+// disks with a source image or snapshot, and disks without a source image
+// family have an empty family.
+func SourceImageFamilies(in v1alpha1.InstanceParameters) []string {
+	families := make([]string, len(in.Disks))
+	for i, d := range in.Disks {
+		if d.Source == nil && d.SourceImage == nil && d.SourceSnapshot == nil {
+			families[i] = gcp.StringValue(d.SourceImageFamily)
+		}
+	}
+	return families
+}
+
+// DiskEncryptionKeys are the encryption keys of a disk of an instance, and
+// of the image or snapshot it is created from.
+type DiskEncryptionKeys struct {
+	Disk           *compute.CustomerEncryptionKey
+	SourceImage    *compute.CustomerEncryptionKey
+	SourceSnapshot *compute.CustomerEncryptionKey
+}
+
+// GetDiskEncryptionKeys returns the encryption keys of the disks of the
+// supplied instance, indexed like its disks. Customer-supplied keys are read
+// from their Secrets.
+func GetDiskEncryptionKeys(ctx context.Context, kube client.Reader, in v1alpha1.InstanceParameters) ([]DiskEncryptionKeys, error) {
+	keys := make([]DiskEncryptionKeys, len(in.Disks))
+	for i, d := range in.Disks {
+		var err error
+		if keys[i].Disk, err = getEncryptionKey(ctx, kube, d.DiskEncryptionKey); err != nil {
+			return nil, errors.Wrapf(err, errFmtDiskEncryption, i)
+		}
+		if keys[i].SourceImage, err = getEncryptionKey(ctx, kube, d.SourceImageEncryptionKey); err != nil {
+			return nil, errors.Wrapf(err, errFmtDiskEncryption, i)
+		}
+		if keys[i].SourceSnapshot, err = getEncryptionKey(ctx, kube, d.SourceSnapshotEncryptionKey); err != nil {
+			return nil, errors.Wrapf(err, errFmtDiskEncryption, i)
+		}
+	}
+	return keys, nil
+}
+
+func getEncryptionKey(ctx context.Context, kube client.Reader, in *v1alpha1.DiskEncryptionKey) (*compute.CustomerEncryptionKey, error) {
+	if in == nil {
+		return nil, nil
+	}
+	k := &compute.CustomerEncryptionKey{
+		KmsKeyName:           gcp.StringValue(in.KMSKeyName),
+		KmsKeyServiceAccount: gcp.StringValue(in.KMSKeyServiceAccount),
+	}
+	var err error
+	if in.RawKeySecretRef != nil {
+		if k.RawKey, err = getSecretValue(ctx, kube, *in.RawKeySecretRef); err != nil {
+			return nil, err
+		}
+	}
+	if in.RSAEncryptedKeySecretRef != nil {
+		if k.RsaEncryptedKey, err = getSecretValue(ctx, kube, *in.RSAEncryptedKeySecretRef); err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+func getSecretValue(ctx context.Context, kube client.Reader, ref xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", errors.Wrap(err, errGetSecret)
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errFmtKeyNotFound, ref.Key, ref.Namespace, ref.Name)
+	}
+	return strings.TrimSpace(string(v)), nil
+}
+
+// GenerateInstance returns the instance described by the supplied
+// parameters. The supplied images are the images that the source image
+// families of the disks were resolved to, and the supplied keys are the
+// encryption keys of the disks, both indexed like the disks.
+func GenerateInstance(name string, in v1alpha1.InstanceParameters, images []string, keys []DiskEncryptionKeys) *compute.Instance {
+	i := &compute.Instance{
+		Name:        name,
+		Description: gcp.StringValue(in.Description),
+		MachineType: ZonalURL(in.Zone, "machineTypes", in.MachineType),
+		Labels:      in.Labels,
+		Metadata:    GenerateMetadata(in.Metadata, ""),
+	}
+	for n, d := range in.Disks {
+		ad := &compute.AttachedDisk{
+			Type:       diskTypePersistent,
+			Boot:       gcp.BoolValue(d.Boot),
+			AutoDelete: gcp.BoolValue(d.AutoDelete),
+			DeviceName: gcp.StringValue(d.DeviceName),
+			Mode:       gcp.StringValue(d.Mode),
+			Source:     gcp.StringValue(d.Source),
+		}
+		var k DiskEncryptionKeys
+		if n < len(keys) {
+			k = keys[n]
+		}
+		ad.DiskEncryptionKey = k.Disk
+		if d.Source == nil {
+			ad.InitializeParams = &compute.AttachedDiskInitializeParams{
+				DiskSizeGb:     gcp.Int64Value(d.DiskSizeGb),
+				DiskType:       ZonalURL(in.Zone, "diskTypes", gcp.StringValue(d.DiskType)),
+				SourceImage:    gcp.StringValue(d.SourceImage),
+				SourceSnapshot: gcp.StringValue(d.SourceSnapshot),
+			}
+			if ad.InitializeParams.SourceImage == "" && n < len(images) {
+				ad.InitializeParams.SourceImage = images[n]
+			}
+			ad.InitializeParams.SourceImageEncryptionKey = k.SourceImage
+			ad.InitializeParams.SourceSnapshotEncryptionKey = k.SourceSnapshot
+		}
+		if d.AutoDelete != nil {
+			ad.ForceSendFields = []string{"AutoDelete"}
+		}
+		i.Disks = append(i.Disks, ad)
+	}
+	for _, n := range in.NetworkInterfaces {
+		ni := &compute.NetworkInterface{
+			Network:    gcp.StringValue(n.Network),
+			Subnetwork: gcp.StringValue(n.Subnetwork),
+			StackType:  gcp.StringValue(n.StackType),
+		}
+		for _, ac := range n.AccessConfigs {
+			ni.AccessConfigs = append(ni.AccessConfigs, &compute.AccessConfig{
+				Type:  accessConfigOneToOne,
+				Name:  gcp.StringValue(ac.Name),
+				NatIP: gcp.StringValue(ac.NatIP),
+			})
+		}
+		// External IPv6 ranges are only available in the premium tier.
+		for _, ac := range n.IPv6AccessConfigs {
+			ni.Ipv6AccessConfigs = append(ni.Ipv6AccessConfigs, &compute.AccessConfig{
+				Type:                     accessConfigIPv6,
+				Name:                     gcp.StringValue(ac.Name),
+				NetworkTier:              networkTierPremium,
+				ExternalIpv6:             gcp.StringValue(ac.ExternalIPv6),
+				ExternalIpv6PrefixLength: gcp.Int64Value(ac.ExternalIPv6PrefixLength),
+			})
+		}
+		i.NetworkInterfaces = append(i.NetworkInterfaces, ni)
+	}
+	for _, sa := range in.ServiceAccounts {
+		i.ServiceAccounts = append(i.ServiceAccounts, &compute.ServiceAccount{Email: sa.Email, Scopes: sa.Scopes})
+	}
+	if len(in.Tags) > 0 {
+		i.Tags = &compute.Tags{Items: in.Tags}
+	}
+	if s := in.Scheduling; s != nil {
+		i.Scheduling = &compute.Scheduling{
+			AutomaticRestart:  s.AutomaticRestart,
+			OnHostMaintenance: gcp.StringValue(s.OnHostMaintenance),
+			Preemptible:       gcp.BoolValue(s.Preemptible),
+			ProvisioningModel: gcp.StringValue(s.ProvisioningModel),
+		}
+	}
+	i.ConfidentialInstanceConfig = instanceconfig.GenerateConfidentialInstanceConfig(in.ConfidentialInstanceConfig)
+	i.ShieldedInstanceConfig = instanceconfig.GenerateShieldedInstanceConfig(in.ShieldedInstanceConfig)
+	return i
+}
+
+// GenerateMetadata returns the supplied metadata key/value pairs as instance
+// metadata, sorted by key. The fingerprint of the metadata the instance
+// currently has must be supplied in order to update it.
+func GenerateMetadata(in map[string]string, fingerprint string) *compute.Metadata {
+	if len(in) == 0 && fingerprint == "" {
+		return nil
+	}
+	m := &compute.Metadata{Fingerprint: fingerprint}
+	keys := make([]string, 0, len(in))
+	for k := range in {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		m.Items = append(m.Items, &compute.MetadataItems{Key: k, Value: gcp.StringPtr(in[k])})
+	}
+	return m
+}
+
+// Metadata returns the metadata key/value pairs of the supplied instance.
+func Metadata(in compute.Instance) map[string]string {
+	if in.Metadata == nil || len(in.Metadata.Items) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(in.Metadata.Items))
+	for _, i := range in.Metadata.Items {
+		m[i.Key] = gcp.StringValue(i.Value)
+	}
+	return m
+}
+
+// LateInitializeSpec updates any unset optional fields of the supplied
+// InstanceParameters that are set on the supplied instance.
+func LateInitializeSpec(p *v1alpha1.InstanceParameters, observed compute.Instance) {
+	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, observed.Labels)
+	p.Metadata = gcp.LateInitializeStringMap(p.Metadata, Metadata(observed))
+	p.ConfidentialInstanceConfig = instanceconfig.LateInitializeConfidentialInstanceConfig(p.ConfidentialInstanceConfig, observed.ConfidentialInstanceConfig)
+	p.ShieldedInstanceConfig = instanceconfig.LateInitializeShieldedInstanceConfig(p.ShieldedInstanceConfig, observed.ShieldedInstanceConfig)
+}
+
+// LabelsUpToDate returns true if the supplied instance has the desired
+// labels.
+func LabelsUpToDate(in v1alpha1.InstanceParameters, observed compute.Instance) bool {
+	return cmp.Equal(in.Labels, observed.Labels, cmpopts.EquateEmpty())
+}
+
+// MetadataUpToDate returns true if the supplied instance has the desired
+// metadata.
+func MetadataUpToDate(in v1alpha1.InstanceParameters, observed compute.Instance) bool {
+	return cmp.Equal(in.Metadata, Metadata(observed), cmpopts.EquateEmpty())
+}
+
+// MachineTypeUpToDate returns true if the supplied instance has the desired
+// machine type, which may be specified by name or by URL.
+func MachineTypeUpToDate(in v1alpha1.InstanceParameters, observed compute.Instance) bool {
+	return path.Base(in.MachineType) == path.Base(observed.MachineType)
+}
+
+// ValidateUnchanged returns an error if the desired confidential or shielded
+// VM configuration differs from that of the supplied instance, as neither can
+// be changed on an existing instance.
+func ValidateUnchanged(in v1alpha1.InstanceParameters, observed compute.Instance) error {
+	return instanceconfig.ValidateUnchanged(in.ConfidentialInstanceConfig, in.ShieldedInstanceConfig, observed.ConfidentialInstanceConfig, observed.ShieldedInstanceConfig)
+}
+
+// IsUpToDate returns true if the updatable fields of the supplied instance
+// match the supplied parameters, and its confidential and shielded VM
+// configuration is unchanged.
+func IsUpToDate(in v1alpha1.InstanceParameters, observed compute.Instance) bool {
+	return LabelsUpToDate(in, observed) && MetadataUpToDate(in, observed) && MachineTypeUpToDate(in, observed) && ValidateUnchanged(in, observed) == nil
+}
+
+// GenerateObservation returns the observation of the supplied instance of
+// the supplied project.
+func GenerateObservation(in compute.Instance, project string) v1alpha1.InstanceObservation {
+	o := v1alpha1.InstanceObservation{
+		ID:                in.Id,
+		CreationTimestamp: in.CreationTimestamp,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+		MachineType:       in.MachineType,
+		NetworkInterfaces: instanceconfig.GenerateNetworkInterfacesObservation(in.NetworkInterfaces),
+	}
+	if in.Name != "" && in.Zone != "" {
+		o.InternalDNSName = instanceconfig.ZonalDNSName(in.Name, in.Zone, project)
+	}
+	for _, d := range in.Disks {
+		do := v1alpha1.InstanceDiskObservation{DeviceName: d.DeviceName, Source: d.Source}
+		// Only the hash of a customer-supplied key is observed, never the
+		// key itself.
+		if k := d.DiskEncryptionKey; k != nil {
+			do.KMSKeyName = k.KmsKeyName
+			do.EncryptionKeySHA256 = k.Sha256
+		}
+		o.Disks = append(o.Disks, do)
+	}
+	if len(in.NetworkInterfaces) == 0 {
+		return o
+	}
+	ni := in.NetworkInterfaces[0]
+	o.InternalIP = ni.NetworkIP
+	if len(ni.AccessConfigs) > 0 {
+		o.ExternalIP = ni.AccessConfigs[0].NatIP
+	}
+	o.InternalIPv6 = ni.Ipv6Address
+	if len(ni.Ipv6AccessConfigs) > 0 {
+		o.ExternalIPv6 = ni.Ipv6AccessConfigs[0].ExternalIpv6
+	}
+	return o
+}
+
+// GetConnectionDetails returns the internal and, if there is one, the
+// external IP address of the supplied instance, as well as its IPv6
+// addresses if it is dual-stack.
+func GetConnectionDetails(o v1alpha1.InstanceObservation) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if o.InternalIP != "" {
+		cd[v1alpha1.InstanceInternalIPKey] = []byte(o.InternalIP)
+	}
+	if o.ExternalIP != "" {
+		cd[v1alpha1.InstanceExternalIPKey] = []byte(o.ExternalIP)
+	}
+	if o.InternalIPv6 != "" {
+		cd[v1alpha1.InstanceInternalIPv6Key] = []byte(o.InternalIPv6)
+	}
+	if o.ExternalIPv6 != "" {
+		cd[v1alpha1.InstanceExternalIPv6Key] = []byte(o.ExternalIPv6)
+	}
+	return cd
+}
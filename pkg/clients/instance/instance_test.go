@@ -0,0 +1,399 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "test-project"
+	testZone    = "us-central1-a"
+	testImage   = "https://www.googleapis.com/compute/v1/projects/test-project/global/images/test-v2"
+)
+
+func TestZonalURL(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		want string
+	}{
+		"Empty": {},
+		"Name": {
+			name: "e2-small",
+			want: "zones/us-central1-a/machineTypes/e2-small",
+		},
+		"URL": {
+			name: "zones/us-east1-b/machineTypes/e2-small",
+			want: "zones/us-east1-b/machineTypes/e2-small",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ZonalURL(testZone, "machineTypes", tc.name)); diff != "" {
+				t.Errorf("ZonalURL(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSourceImageFamilies(t *testing.T) {
+	in := v1alpha1.InstanceParameters{Disks: []v1alpha1.InstanceDisk{
+		{AttachedDisk: v1alpha1.AttachedDisk{SourceImageFamily: gcp.StringPtr("family")}},
+		{AttachedDisk: v1alpha1.AttachedDisk{SourceImage: gcp.StringPtr("image"), SourceImageFamily: gcp.StringPtr("ignored")}},
+		{AttachedDisk: v1alpha1.AttachedDisk{SourceImageFamily: gcp.StringPtr("ignored")}, Source: gcp.StringPtr("disk")},
+		{AttachedDisk: v1alpha1.AttachedDisk{SourceImageFamily: gcp.StringPtr("ignored")}, SourceSnapshot: gcp.StringPtr("snapshot")},
+		{},
+	}}
+	if diff := cmp.Diff([]string{"family", "", "", "", ""}, SourceImageFamilies(in)); diff != "" {
+		t.Errorf("SourceImageFamilies(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateInstance(t *testing.T) {
+	in := v1alpha1.InstanceParameters{
+		Zone:        testZone,
+		Description: gcp.StringPtr("desc"),
+		MachineType: "e2-small",
+		Disks: []v1alpha1.InstanceDisk{
+			{AttachedDisk: v1alpha1.AttachedDisk{Boot: gcp.BoolPtr(true), AutoDelete: gcp.BoolPtr(false), DiskSizeGb: gcp.Int64Ptr(20), DiskType: gcp.StringPtr("pd-ssd"), SourceImageFamily: gcp.StringPtr("family")}},
+			{AttachedDisk: v1alpha1.AttachedDisk{DeviceName: gcp.StringPtr("data")}, Source: gcp.StringPtr("zones/us-central1-a/disks/data"), Mode: gcp.StringPtr("READ_ONLY")},
+			{SourceSnapshot: gcp.StringPtr("global/snapshots/backup")},
+		},
+		NetworkInterfaces: []v1alpha1.NetworkInterface{{
+			Network:       gcp.StringPtr("network"),
+			AccessConfigs: []v1alpha1.AccessConfig{{Name: gcp.StringPtr("external")}},
+		}},
+		ServiceAccounts: []v1alpha1.InstanceServiceAccount{{Email: "sa@example.com", Scopes: []string{"scope"}}},
+		Labels:          map[string]string{"l": "v"},
+		Metadata:        map[string]string{"b": "2", "a": "1"},
+		Tags:            []string{"web"},
+		Scheduling: &v1alpha1.Scheduling{
+			AutomaticRestart:  gcp.BoolPtr(false),
+			OnHostMaintenance: gcp.StringPtr("TERMINATE"),
+			ProvisioningModel: gcp.StringPtr("SPOT"),
+		},
+	}
+	want := &compute.Instance{
+		Name:        "name",
+		Description: "desc",
+		MachineType: "zones/us-central1-a/machineTypes/e2-small",
+		Disks: []*compute.AttachedDisk{
+			{
+				Type:             diskTypePersistent,
+				Boot:             true,
+				InitializeParams: &compute.AttachedDiskInitializeParams{DiskSizeGb: 20, DiskType: "zones/us-central1-a/diskTypes/pd-ssd", SourceImage: testImage},
+				ForceSendFields:  []string{"AutoDelete"},
+			},
+			{
+				Type:              diskTypePersistent,
+				DeviceName:        "data",
+				Source:            "zones/us-central1-a/disks/data",
+				Mode:              "READ_ONLY",
+				DiskEncryptionKey: &compute.CustomerEncryptionKey{RawKey: "raw"},
+			},
+			{
+				Type: diskTypePersistent,
+				InitializeParams: &compute.AttachedDiskInitializeParams{
+					SourceSnapshot:              "global/snapshots/backup",
+					SourceSnapshotEncryptionKey: &compute.CustomerEncryptionKey{RsaEncryptedKey: "rsa"},
+				},
+				DiskEncryptionKey: &compute.CustomerEncryptionKey{KmsKeyName: "key"},
+			},
+		},
+		NetworkInterfaces: []*compute.NetworkInterface{{
+			Network:       "network",
+			AccessConfigs: []*compute.AccessConfig{{Name: "external", Type: accessConfigOneToOne}},
+		}},
+		ServiceAccounts: []*compute.ServiceAccount{{Email: "sa@example.com", Scopes: []string{"scope"}}},
+		Labels:          map[string]string{"l": "v"},
+		Metadata: &compute.Metadata{Items: []*compute.MetadataItems{
+			{Key: "a", Value: gcp.StringPtr("1")},
+			{Key: "b", Value: gcp.StringPtr("2")},
+		}},
+		Tags: &compute.Tags{Items: []string{"web"}},
+		Scheduling: &compute.Scheduling{
+			AutomaticRestart:  gcp.BoolPtr(false),
+			OnHostMaintenance: "TERMINATE",
+			ProvisioningModel: "SPOT",
+		},
+	}
+	keys := []DiskEncryptionKeys{
+		{},
+		{Disk: &compute.CustomerEncryptionKey{RawKey: "raw"}},
+		{Disk: &compute.CustomerEncryptionKey{KmsKeyName: "key"}, SourceSnapshot: &compute.CustomerEncryptionKey{RsaEncryptedKey: "rsa"}},
+	}
+	if diff := cmp.Diff(want, GenerateInstance("name", in, []string{testImage, "", ""}, keys)); diff != "" {
+		t.Errorf("GenerateInstance(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetDiskEncryptionKeys(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := func(key string) *xpv1.SecretKeySelector {
+		return &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "csek", Namespace: "default"}, Key: key}
+	}
+	secret := func(data map[string][]byte) client.Reader {
+		return &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = data
+			return nil
+		}}
+	}
+	withKeys := v1alpha1.InstanceParameters{Disks: []v1alpha1.InstanceDisk{
+		{},
+		{
+			DiskEncryptionKey:        &v1alpha1.DiskEncryptionKey{RawKeySecretRef: ref("raw")},
+			SourceImageEncryptionKey: &v1alpha1.DiskEncryptionKey{RSAEncryptedKeySecretRef: ref("rsa")},
+		},
+		{DiskEncryptionKey: &v1alpha1.DiskEncryptionKey{KMSKeyName: gcp.StringPtr("key"), KMSKeyServiceAccount: gcp.StringPtr("sa@example.com")}},
+	}}
+
+	type want struct {
+		keys []DiskEncryptionKeys
+		err  error
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		in   v1alpha1.InstanceParameters
+		want want
+	}{
+		"NoKeys": {
+			in:   v1alpha1.InstanceParameters{Disks: []v1alpha1.InstanceDisk{{}}},
+			want: want{keys: []DiskEncryptionKeys{{}}},
+		},
+		"Successful": {
+			kube: secret(map[string][]byte{"raw": []byte("cmF3\n"), "rsa": []byte("cnNh")}),
+			in:   withKeys,
+			want: want{keys: []DiskEncryptionKeys{
+				{},
+				{
+					Disk:        &compute.CustomerEncryptionKey{RawKey: "cmF3"},
+					SourceImage: &compute.CustomerEncryptionKey{RsaEncryptedKey: "cnNh"},
+				},
+				{Disk: &compute.CustomerEncryptionKey{KmsKeyName: "key", KmsKeyServiceAccount: "sa@example.com"}},
+			}},
+		},
+		"KeyNotFound": {
+			kube: secret(map[string][]byte{"raw": []byte("cmF3")}),
+			in:   withKeys,
+			want: want{err: errors.Wrapf(errors.Errorf(errFmtKeyNotFound, "rsa", "default", "csek"), errFmtDiskEncryption, 1)},
+		},
+		"GetSecretFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			in:   withKeys,
+			want: want{err: errors.Wrapf(errors.Wrap(errBoom, errGetSecret), errFmtDiskEncryption, 1)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			keys, err := GetDiskEncryptionKeys(context.Background(), tc.kube, tc.in)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetDiskEncryptionKeys(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.keys, keys); diff != "" {
+				t.Errorf("GetDiskEncryptionKeys(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateMetadata(t *testing.T) {
+	cases := map[string]struct {
+		in          map[string]string
+		fingerprint string
+		want        *compute.Metadata
+	}{
+		"None": {},
+		"Cleared": {
+			fingerprint: "fp",
+			want:        &compute.Metadata{Fingerprint: "fp"},
+		},
+		"Set": {
+			in:          map[string]string{"k": "v"},
+			fingerprint: "fp",
+			want:        &compute.Metadata{Fingerprint: "fp", Items: []*compute.MetadataItems{{Key: "k", Value: gcp.StringPtr("v")}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateMetadata(tc.in, tc.fingerprint)); diff != "" {
+				t.Errorf("GenerateMetadata(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	in := v1alpha1.InstanceParameters{
+		MachineType:            "e2-small",
+		Labels:                 map[string]string{"l": "v"},
+		Metadata:               map[string]string{"k": "v"},
+		ShieldedInstanceConfig: &v1alpha1.ShieldedInstanceConfig{EnableVtpm: gcp.BoolPtr(true)},
+	}
+	observed := compute.Instance{
+		MachineType:            "https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/machineTypes/e2-small",
+		Labels:                 map[string]string{"l": "v"},
+		Metadata:               &compute.Metadata{Fingerprint: "fp", Items: []*compute.MetadataItems{{Key: "k", Value: gcp.StringPtr("v")}}},
+		ShieldedInstanceConfig: &compute.ShieldedInstanceConfig{EnableVtpm: true},
+	}
+
+	cases := map[string]struct {
+		modify func(*compute.Instance)
+		want   bool
+	}{
+		"UpToDate": {
+			modify: func(*compute.Instance) {},
+			want:   true,
+		},
+		"LabelsChanged": {
+			modify: func(i *compute.Instance) { i.Labels = nil },
+			want:   false,
+		},
+		"MetadataChanged": {
+			modify: func(i *compute.Instance) { i.Metadata.Items[0].Value = gcp.StringPtr("other") },
+			want:   false,
+		},
+		"MachineTypeChanged": {
+			modify: func(i *compute.Instance) { i.MachineType = "e2-medium" },
+			want:   false,
+		},
+		"ShieldedInstanceConfigChanged": {
+			modify: func(i *compute.Instance) { i.ShieldedInstanceConfig = nil },
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := observed
+			o.Metadata = &compute.Metadata{Fingerprint: "fp", Items: []*compute.MetadataItems{{Key: "k", Value: gcp.StringPtr("v")}}}
+			tc.modify(&o)
+			if got := IsUpToDate(in, o); got != tc.want {
+				t.Errorf("IsUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerateObservationDisks(t *testing.T) {
+	in := compute.Instance{Disks: []*compute.AttachedDisk{
+		{DeviceName: "boot", Source: "disks/boot"},
+		{DeviceName: "csek", Source: "disks/csek", DiskEncryptionKey: &compute.CustomerEncryptionKey{RawKey: "secret", Sha256: "hash"}},
+		{DeviceName: "cmek", Source: "disks/cmek", DiskEncryptionKey: &compute.CustomerEncryptionKey{KmsKeyName: "key"}},
+	}}
+	want := []v1alpha1.InstanceDiskObservation{
+		{DeviceName: "boot", Source: "disks/boot"},
+		{DeviceName: "csek", Source: "disks/csek", EncryptionKeySHA256: "hash"},
+		{DeviceName: "cmek", Source: "disks/cmek", KMSKeyName: "key"},
+	}
+	if diff := cmp.Diff(want, GenerateObservation(in, testProject).Disks); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateObservationNetworkInterfaces(t *testing.T) {
+	in := compute.Instance{
+		Name: "vm",
+		Zone: "https://www.googleapis.com/compute/v1/projects/test-project/zones/" + testZone,
+		NetworkInterfaces: []*compute.NetworkInterface{
+			{
+				Name:          "nic0",
+				Network:       "global/networks/default",
+				NetworkIP:     "10.0.0.2",
+				AliasIpRanges: []*compute.AliasIpRange{{IpCidrRange: "10.1.0.0/24", SubnetworkRangeName: "pods"}},
+				AccessConfigs: []*compute.AccessConfig{{Name: "external", NatIP: "203.0.113.7", NetworkTier: "PREMIUM"}},
+			},
+			{Name: "nic1", Network: "global/networks/other", NetworkIP: "10.2.0.2"},
+		},
+	}
+	o := GenerateObservation(in, testProject)
+	want := []v1alpha1.NetworkInterfaceObservation{
+		{
+			Name:          "nic0",
+			Network:       "global/networks/default",
+			NetworkIP:     "10.0.0.2",
+			AliasIPRanges: []v1alpha1.AliasIPRange{{IPCidrRange: "10.1.0.0/24", SubnetworkRangeName: "pods"}},
+			AccessConfigs: []v1alpha1.AccessConfigObservation{{Name: "external", NatIP: "203.0.113.7", NetworkTier: "PREMIUM"}},
+		},
+		{Name: "nic1", Network: "global/networks/other", NetworkIP: "10.2.0.2"},
+	}
+	if diff := cmp.Diff(want, o.NetworkInterfaces); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("vm.us-central1-a.c.test-project.internal", o.InternalDNSName); diff != "" {
+		t.Errorf("GenerateObservation(...): -want DNS name, +got DNS name:\n%s", diff)
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in   compute.Instance
+		want managed.ConnectionDetails
+	}{
+		"NoInterfaces": {
+			want: managed.ConnectionDetails{},
+		},
+		"InternalOnly": {
+			in: compute.Instance{NetworkInterfaces: []*compute.NetworkInterface{{NetworkIP: "10.0.0.2"}}},
+			want: managed.ConnectionDetails{
+				v1alpha1.InstanceInternalIPKey: []byte("10.0.0.2"),
+			},
+		},
+		"External": {
+			in: compute.Instance{NetworkInterfaces: []*compute.NetworkInterface{{
+				NetworkIP:     "10.0.0.2",
+				AccessConfigs: []*compute.AccessConfig{{NatIP: "203.0.113.7"}},
+			}}},
+			want: managed.ConnectionDetails{
+				v1alpha1.InstanceInternalIPKey: []byte("10.0.0.2"),
+				v1alpha1.InstanceExternalIPKey: []byte("203.0.113.7"),
+			},
+		},
+		"DualStack": {
+			in: compute.Instance{NetworkInterfaces: []*compute.NetworkInterface{{
+				NetworkIP:         "10.0.0.2",
+				StackType:         "IPV4_IPV6",
+				Ipv6Address:       "fd20:0:0:1::2",
+				Ipv6AccessConfigs: []*compute.AccessConfig{{ExternalIpv6: "2600:1900:4000:1234:0:0:0:0"}},
+			}}},
+			want: managed.ConnectionDetails{
+				v1alpha1.InstanceInternalIPKey:   []byte("10.0.0.2"),
+				v1alpha1.InstanceInternalIPv6Key: []byte("fd20:0:0:1::2"),
+				v1alpha1.InstanceExternalIPv6Key: []byte("2600:1900:4000:1234:0:0:0:0"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetConnectionDetails(GenerateObservation(tc.in, testProject))); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
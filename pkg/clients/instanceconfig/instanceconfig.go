@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instanceconfig converts the VM configuration that is shared by
+// Compute Engine instances and instance templates.
+package instanceconfig
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// zonalDNSFormat is the format of the internal zonal DNS name of a VM, i.e.
+// VM_NAME.ZONE.c.PROJECT_ID.internal.
+const zonalDNSFormat = "%s.%s.c.%s.internal"
+
+// Error strings.
+const (
+	errConfidentialChanged = "confidentialInstanceConfig cannot be changed on an existing VM"
+	errShieldedChanged     = "shieldedInstanceConfig cannot be changed on an existing VM"
+)
+
+// GenerateConfidentialInstanceConfig produces the ConfidentialInstanceConfig
+// of a VM from the supplied ConfidentialInstanceConfig.
+func GenerateConfidentialInstanceConfig(in *v1alpha1.ConfidentialInstanceConfig) *compute.ConfidentialInstanceConfig {
+	if in == nil {
+		return nil
+	}
+	return &compute.ConfidentialInstanceConfig{
+		EnableConfidentialCompute: in.EnableConfidentialCompute,
+	}
+}
+
+// GenerateShieldedInstanceConfig produces the ShieldedInstanceConfig of a VM
+// from the supplied ShieldedInstanceConfig.
+func GenerateShieldedInstanceConfig(in *v1alpha1.ShieldedInstanceConfig) *compute.ShieldedInstanceConfig {
+	if in == nil {
+		return nil
+	}
+	out := &compute.ShieldedInstanceConfig{
+		EnableSecureBoot:          gcp.BoolValue(in.EnableSecureBoot),
+		EnableVtpm:                gcp.BoolValue(in.EnableVtpm),
+		EnableIntegrityMonitoring: gcp.BoolValue(in.EnableIntegrityMonitoring),
+	}
+	// vTPM and integrity monitoring are enabled by default, so they must be
+	// sent if they are explicitly disabled. Unset fields keep their default.
+	if in.EnableSecureBoot != nil {
+		out.ForceSendFields = append(out.ForceSendFields, "EnableSecureBoot")
+	}
+	if in.EnableVtpm != nil {
+		out.ForceSendFields = append(out.ForceSendFields, "EnableVtpm")
+	}
+	if in.EnableIntegrityMonitoring != nil {
+		out.ForceSendFields = append(out.ForceSendFields, "EnableIntegrityMonitoring")
+	}
+	return out
+}
+
+// LateInitializeConfidentialInstanceConfig returns the supplied
+// ConfidentialInstanceConfig, or the observed one if it is nil.
+func LateInitializeConfidentialInstanceConfig(in *v1alpha1.ConfidentialInstanceConfig, from *compute.ConfidentialInstanceConfig) *v1alpha1.ConfidentialInstanceConfig {
+	if in != nil || from == nil {
+		return in
+	}
+	return &v1alpha1.ConfidentialInstanceConfig{
+		EnableConfidentialCompute: from.EnableConfidentialCompute,
+	}
+}
+
+// LateInitializeShieldedInstanceConfig fills the unset fields of the supplied
+// ShieldedInstanceConfig with the observed values.
+func LateInitializeShieldedInstanceConfig(in *v1alpha1.ShieldedInstanceConfig, from *compute.ShieldedInstanceConfig) *v1alpha1.ShieldedInstanceConfig {
+	if from == nil {
+		return in
+	}
+	if in == nil {
+		in = &v1alpha1.ShieldedInstanceConfig{}
+	}
+	in.EnableSecureBoot = gcp.LateInitializeBool(in.EnableSecureBoot, from.EnableSecureBoot)
+	in.EnableVtpm = gcp.LateInitializeBool(in.EnableVtpm, from.EnableVtpm)
+	in.EnableIntegrityMonitoring = gcp.LateInitializeBool(in.EnableIntegrityMonitoring, from.EnableIntegrityMonitoring)
+	return in
+}
+
+// ValidateUnchanged returns an error if the supplied desired confidential or
+// shielded VM configuration differs from the observed one. Neither can be
+// changed in place, so controllers report the difference rather than trying
+// to update the VM.
+func ValidateUnchanged(conf *v1alpha1.ConfidentialInstanceConfig, shielded *v1alpha1.ShieldedInstanceConfig, observedConf *compute.ConfidentialInstanceConfig, observedShielded *compute.ShieldedInstanceConfig) error {
+	if conf != nil && conf.EnableConfidentialCompute != (observedConf != nil && observedConf.EnableConfidentialCompute) {
+		return errors.New(errConfidentialChanged)
+	}
+	if shielded == nil {
+		return nil
+	}
+	o := observedShielded
+	if o == nil {
+		o = &compute.ShieldedInstanceConfig{}
+	}
+	if changed(shielded.EnableSecureBoot, o.EnableSecureBoot) || changed(shielded.EnableVtpm, o.EnableVtpm) || changed(shielded.EnableIntegrityMonitoring, o.EnableIntegrityMonitoring) {
+		return errors.New(errShieldedChanged)
+	}
+	return nil
+}
+
+func changed(desired *bool, observed bool) bool {
+	return desired != nil && *desired != observed
+}
+
+// ZonalDNSName returns the internal zonal DNS name of the VM with the supplied
+// name in the supplied zone of the supplied project. The zone may be a name or
+// a URL. Other VMs of the VPC network can resolve the VM by this name.
+func ZonalDNSName(name, zone, project string) string {
+	return fmt.Sprintf(zonalDNSFormat, name, path.Base(zone), project)
+}
+
+// GenerateNetworkInterfacesObservation produces the observed state of the
+// supplied network interfaces of a VM.
+func GenerateNetworkInterfacesObservation(in []*compute.NetworkInterface) []v1alpha1.NetworkInterfaceObservation {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]v1alpha1.NetworkInterfaceObservation, 0, len(in))
+	for _, ni := range in {
+		if ni == nil {
+			continue
+		}
+		o := v1alpha1.NetworkInterfaceObservation{
+			Name:        ni.Name,
+			Network:     ni.Network,
+			Subnetwork:  ni.Subnetwork,
+			NetworkIP:   ni.NetworkIP,
+			IPv6Address: ni.Ipv6Address,
+			StackType:   ni.StackType,
+		}
+		for _, r := range ni.AliasIpRanges {
+			if r == nil {
+				continue
+			}
+			o.AliasIPRanges = append(o.AliasIPRanges, v1alpha1.AliasIPRange{
+				IPCidrRange:         r.IpCidrRange,
+				SubnetworkRangeName: r.SubnetworkRangeName,
+			})
+		}
+		for _, ac := range ni.AccessConfigs {
+			if ac == nil {
+				continue
+			}
+			o.AccessConfigs = append(o.AccessConfigs, v1alpha1.AccessConfigObservation{
+				Name:        ac.Name,
+				NatIP:       ac.NatIP,
+				NetworkTier: ac.NetworkTier,
+			})
+		}
+		out = append(out, o)
+	}
+	return out
+}
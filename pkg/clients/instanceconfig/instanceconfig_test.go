@@ -0,0 +1,219 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceconfig
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func TestGenerateShieldedInstanceConfig(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.ShieldedInstanceConfig
+		want *compute.ShieldedInstanceConfig
+	}{
+		"Nil": {},
+		"DisableVtpm": {
+			in: &v1alpha1.ShieldedInstanceConfig{
+				EnableSecureBoot: gcp.BoolPtr(true),
+				EnableVtpm:       gcp.BoolPtr(false),
+			},
+			want: &compute.ShieldedInstanceConfig{
+				EnableSecureBoot: true,
+				ForceSendFields:  []string{"EnableSecureBoot", "EnableVtpm"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateShieldedInstanceConfig(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateShieldedInstanceConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeShieldedInstanceConfig(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.ShieldedInstanceConfig
+		from *compute.ShieldedInstanceConfig
+		want *v1alpha1.ShieldedInstanceConfig
+	}{
+		"NotObserved": {},
+		"Unset": {
+			from: &compute.ShieldedInstanceConfig{EnableVtpm: true, EnableIntegrityMonitoring: true},
+			want: &v1alpha1.ShieldedInstanceConfig{
+				EnableVtpm:                gcp.BoolPtr(true),
+				EnableIntegrityMonitoring: gcp.BoolPtr(true),
+			},
+		},
+		"Set": {
+			in:   &v1alpha1.ShieldedInstanceConfig{EnableVtpm: gcp.BoolPtr(false)},
+			from: &compute.ShieldedInstanceConfig{EnableVtpm: true},
+			want: &v1alpha1.ShieldedInstanceConfig{EnableVtpm: gcp.BoolPtr(false)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LateInitializeShieldedInstanceConfig(tc.in, tc.from)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("LateInitializeShieldedInstanceConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateUnchanged(t *testing.T) {
+	type args struct {
+		conf             *v1alpha1.ConfidentialInstanceConfig
+		shielded         *v1alpha1.ShieldedInstanceConfig
+		observedConf     *compute.ConfidentialInstanceConfig
+		observedShielded *compute.ShieldedInstanceConfig
+	}
+	cases := map[string]struct {
+		args args
+		want error
+	}{
+		"Unset": {
+			args: args{
+				observedConf:     &compute.ConfidentialInstanceConfig{EnableConfidentialCompute: true},
+				observedShielded: &compute.ShieldedInstanceConfig{EnableVtpm: true},
+			},
+		},
+		"Unchanged": {
+			args: args{
+				conf:             &v1alpha1.ConfidentialInstanceConfig{EnableConfidentialCompute: true},
+				shielded:         &v1alpha1.ShieldedInstanceConfig{EnableVtpm: gcp.BoolPtr(true)},
+				observedConf:     &compute.ConfidentialInstanceConfig{EnableConfidentialCompute: true},
+				observedShielded: &compute.ShieldedInstanceConfig{EnableVtpm: true},
+			},
+		},
+		"ConfidentialChanged": {
+			args: args{
+				conf: &v1alpha1.ConfidentialInstanceConfig{EnableConfidentialCompute: true},
+			},
+			want: errors.New(errConfidentialChanged),
+		},
+		"ShieldedChanged": {
+			args: args{
+				shielded:         &v1alpha1.ShieldedInstanceConfig{EnableSecureBoot: gcp.BoolPtr(true)},
+				observedShielded: &compute.ShieldedInstanceConfig{EnableVtpm: true},
+			},
+			want: errors.New(errShieldedChanged),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateUnchanged(tc.args.conf, tc.args.shielded, tc.args.observedConf, tc.args.observedShielded)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateUnchanged(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestZonalDNSName(t *testing.T) {
+	cases := map[string]struct {
+		zone string
+		want string
+	}{
+		"Name": {
+			zone: "us-central1-a",
+			want: "vm.us-central1-a.c.some-project.internal",
+		},
+		"URL": {
+			zone: "https://www.googleapis.com/compute/v1/projects/some-project/zones/us-central1-a",
+			want: "vm.us-central1-a.c.some-project.internal",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ZonalDNSName("vm", tc.zone, "some-project")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ZonalDNSName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNetworkInterfacesObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   []*compute.NetworkInterface
+		want []v1alpha1.NetworkInterfaceObservation
+	}{
+		"Nil": {},
+		"Full": {
+			in: []*compute.NetworkInterface{
+				{
+					Name:       "nic0",
+					Network:    "global/networks/default",
+					Subnetwork: "regions/us-central1/subnetworks/default",
+					NetworkIP:  "10.128.0.2",
+					StackType:  "IPV4_ONLY",
+					AliasIpRanges: []*compute.AliasIpRange{
+						{IpCidrRange: "10.1.0.0/24", SubnetworkRangeName: "pods"},
+					},
+					AccessConfigs: []*compute.AccessConfig{
+						{Name: "External NAT", NatIP: "35.1.2.3", NetworkTier: "PREMIUM", Type: "ONE_TO_ONE_NAT"},
+					},
+				},
+				{
+					Name:      "nic1",
+					Network:   "global/networks/other",
+					NetworkIP: "10.0.0.2",
+				},
+			},
+			want: []v1alpha1.NetworkInterfaceObservation{
+				{
+					Name:       "nic0",
+					Network:    "global/networks/default",
+					Subnetwork: "regions/us-central1/subnetworks/default",
+					NetworkIP:  "10.128.0.2",
+					StackType:  "IPV4_ONLY",
+					AliasIPRanges: []v1alpha1.AliasIPRange{
+						{IPCidrRange: "10.1.0.0/24", SubnetworkRangeName: "pods"},
+					},
+					AccessConfigs: []v1alpha1.AccessConfigObservation{
+						{Name: "External NAT", NatIP: "35.1.2.3", NetworkTier: "PREMIUM"},
+					},
+				},
+				{
+					Name:      "nic1",
+					Network:   "global/networks/other",
+					NetworkIP: "10.0.0.2",
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateNetworkInterfacesObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateNetworkInterfacesObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroupmanager
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+
+	// Defaults applied by the API to preserved disks. They are sent
+	// explicitly so that omitting them does not register as drift.
+	defaultAutoDelete = "NEVER"
+	defaultDiskMode   = "READ_WRITE"
+
+	// defaultInitialDelaySec is the default initial delay of autohealing
+	// policies.
+	defaultInitialDelaySec = 300
+)
+
+// GenerateInstanceGroupManager takes a *InstanceGroupManagerParameters and
+// returns *compute.InstanceGroupManager. It assigns only the fields that are
+// writable, i.e. not labelled as [Output Only] in Google's reference.
+func GenerateInstanceGroupManager(name string, in v1alpha1.InstanceGroupManagerParameters, igm *compute.InstanceGroupManager) {
+	igm.Name = name
+	igm.Description = gcp.StringValue(in.Description)
+	igm.BaseInstanceName = in.BaseInstanceName
+	igm.InstanceTemplate = in.InstanceTemplate
+	igm.TargetSize = gcp.Int64Value(in.TargetSize)
+	igm.TargetPools = in.TargetPools
+	igm.Zone = gcp.StringValue(in.Zone)
+	igm.Region = gcp.StringValue(in.Region)
+	igm.NamedPorts = GenerateNamedPorts(in.NamedPorts)
+	igm.AutoHealingPolicies = GenerateAutoHealingPolicies(in.AutoHealingPolicies)
+	if in.UpdatePolicy != nil {
+		igm.UpdatePolicy = GenerateUpdatePolicy(in.UpdatePolicy)
+	}
+	igm.StatefulPolicy = GenerateStatefulPolicy(in.StatefulPolicy)
+	// The API always sends targetSize, zero is a valid size.
+	igm.ForceSendFields = []string{"TargetSize"}
+}
+
+// GenerateNamedPorts converts the supplied NamedPorts into their Google
+// Compute API representation.
+func GenerateNamedPorts(in []v1alpha1.NamedPort) []*compute.NamedPort {
+	if in == nil {
+		return nil
+	}
+	out := make([]*compute.NamedPort, len(in))
+	for i, p := range in {
+		out[i] = &compute.NamedPort{Name: p.Name, Port: p.Port}
+	}
+	return out
+}
+
+// GenerateAutoHealingPolicies converts the supplied AutoHealingPolicies into
+// their Google Compute API representation.
+func GenerateAutoHealingPolicies(in []v1alpha1.AutoHealingPolicy) []*compute.InstanceGroupManagerAutoHealingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := make([]*compute.InstanceGroupManagerAutoHealingPolicy, len(in))
+	for i, p := range in {
+		out[i] = &compute.InstanceGroupManagerAutoHealingPolicy{
+			HealthCheck:     p.HealthCheck,
+			InitialDelaySec: int64OrDefault(p.InitialDelaySec, defaultInitialDelaySec),
+		}
+	}
+	return out
+}
+
+// GenerateUpdatePolicy converts the supplied UpdatePolicy into its Google
+// Compute API representation.
+func GenerateUpdatePolicy(in *v1alpha1.UpdatePolicy) *compute.InstanceGroupManagerUpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	return &compute.InstanceGroupManagerUpdatePolicy{
+		Type:                        gcp.StringValue(in.Type),
+		InstanceRedistributionType:  gcp.StringValue(in.InstanceRedistributionType),
+		MinimalAction:               gcp.StringValue(in.MinimalAction),
+		MostDisruptiveAllowedAction: gcp.StringValue(in.MostDisruptiveAllowedAction),
+		ReplacementMethod:           gcp.StringValue(in.ReplacementMethod),
+		MaxSurge:                    generateFixedOrPercent(in.MaxSurge),
+		MaxUnavailable:              generateFixedOrPercent(in.MaxUnavailable),
+	}
+}
+
+func generateFixedOrPercent(in *v1alpha1.FixedOrPercent) *compute.FixedOrPercent {
+	if in == nil {
+		return nil
+	}
+	if in.Percent != nil {
+		return &compute.FixedOrPercent{Percent: *in.Percent, ForceSendFields: []string{"Percent"}}
+	}
+	// Zero is a valid fixed number of instances, e.g. to disable surging.
+	return &compute.FixedOrPercent{Fixed: gcp.Int64Value(in.Fixed), ForceSendFields: []string{"Fixed"}}
+}
+
+// GenerateStatefulPolicy converts the supplied StatefulPolicy into its Google
+// Compute API representation.
+func GenerateStatefulPolicy(in *v1alpha1.StatefulPolicy) *compute.StatefulPolicy {
+	if in == nil {
+		return nil
+	}
+	out := &compute.StatefulPolicy{}
+	if in.PreservedState != nil {
+		out.PreservedState = &compute.StatefulPolicyPreservedState{}
+		if in.PreservedState.Disks != nil {
+			out.PreservedState.Disks = make(map[string]compute.StatefulPolicyPreservedStateDiskDevice, len(in.PreservedState.Disks))
+			for name, d := range in.PreservedState.Disks {
+				out.PreservedState.Disks[name] = compute.StatefulPolicyPreservedStateDiskDevice{AutoDelete: stringOrDefault(d.AutoDelete, defaultAutoDelete)}
+			}
+		}
+	}
+	return out
+}
+
+// GeneratePerInstanceConfigs converts the supplied PerInstanceConfigs into
+// their Google Compute API representation.
+func GeneratePerInstanceConfigs(in []v1alpha1.PerInstanceConfig) []*compute.PerInstanceConfig {
+	if in == nil {
+		return nil
+	}
+	out := make([]*compute.PerInstanceConfig, len(in))
+	for i, c := range in {
+		out[i] = &compute.PerInstanceConfig{Name: c.Name}
+		if c.PreservedState == nil {
+			continue
+		}
+		ps := &compute.PreservedState{Metadata: c.PreservedState.Metadata}
+		if c.PreservedState.Disks != nil {
+			ps.Disks = make(map[string]compute.PreservedStatePreservedDisk, len(c.PreservedState.Disks))
+			for name, d := range c.PreservedState.Disks {
+				ps.Disks[name] = compute.PreservedStatePreservedDisk{
+					AutoDelete: stringOrDefault(d.AutoDelete, defaultAutoDelete),
+					Mode:       stringOrDefault(d.Mode, defaultDiskMode),
+					Source:     d.Source,
+				}
+			}
+		}
+		out[i].PreservedState = ps
+	}
+	return out
+}
+
+// GenerateInstanceGroupManagerObservation takes a
+// compute.InstanceGroupManager and the group's per-instance configs and
+// returns *InstanceGroupManagerObservation.
+func GenerateInstanceGroupManagerObservation(in compute.InstanceGroupManager, configs []*compute.PerInstanceConfig) v1alpha1.InstanceGroupManagerObservation {
+	o := v1alpha1.InstanceGroupManagerObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		Fingerprint:       in.Fingerprint,
+		ID:                in.Id,
+		InstanceGroup:     in.InstanceGroup,
+		SelfLink:          in.SelfLink,
+	}
+	if in.Status != nil {
+		o.IsStable = in.Status.IsStable
+		if s := in.Status.Stateful; s != nil {
+			o.Stateful = &v1alpha1.InstanceGroupManagerStatusStateful{HasStatefulConfig: s.HasStatefulConfig}
+			if s.PerInstanceConfigs != nil {
+				o.Stateful.PerInstanceConfigsEffective = s.PerInstanceConfigs.AllEffective
+			}
+		}
+	}
+	for _, c := range configs {
+		o.PerInstanceConfigs = append(o.PerInstanceConfigs, v1alpha1.PerInstanceConfigObservation{Name: c.Name, Status: c.Status})
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.InstanceGroupManager object.
+func LateInitializeSpec(spec *v1alpha1.InstanceGroupManagerParameters, in compute.InstanceGroupManager) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.TargetSize = gcp.LateInitializeInt64(spec.TargetSize, in.TargetSize)
+	spec.TargetPools = gcp.LateInitializeStringSlice(spec.TargetPools, in.TargetPools)
+	if spec.NamedPorts == nil && len(in.NamedPorts) > 0 {
+		spec.NamedPorts = make([]v1alpha1.NamedPort, len(in.NamedPorts))
+		for i, p := range in.NamedPorts {
+			spec.NamedPorts[i] = v1alpha1.NamedPort{Name: p.Name, Port: p.Port}
+		}
+	}
+	if spec.UpdatePolicy == nil && in.UpdatePolicy != nil {
+		spec.UpdatePolicy = &v1alpha1.UpdatePolicy{}
+	}
+	if p, o := spec.UpdatePolicy, in.UpdatePolicy; p != nil && o != nil {
+		p.Type = gcp.LateInitializeString(p.Type, o.Type)
+		p.InstanceRedistributionType = gcp.LateInitializeString(p.InstanceRedistributionType, o.InstanceRedistributionType)
+		p.MinimalAction = gcp.LateInitializeString(p.MinimalAction, o.MinimalAction)
+		p.MostDisruptiveAllowedAction = gcp.LateInitializeString(p.MostDisruptiveAllowedAction, o.MostDisruptiveAllowedAction)
+		p.ReplacementMethod = gcp.LateInitializeString(p.ReplacementMethod, o.ReplacementMethod)
+		p.MaxSurge = lateInitializeFixedOrPercent(p.MaxSurge, o.MaxSurge)
+		p.MaxUnavailable = lateInitializeFixedOrPercent(p.MaxUnavailable, o.MaxUnavailable)
+	}
+}
+
+func lateInitializeFixedOrPercent(in *v1alpha1.FixedOrPercent, from *compute.FixedOrPercent) *v1alpha1.FixedOrPercent {
+	if in != nil || from == nil {
+		return in
+	}
+	if from.Percent != 0 {
+		return &v1alpha1.FixedOrPercent{Percent: gcp.Int64Ptr(from.Percent)}
+	}
+	return &v1alpha1.FixedOrPercent{Fixed: gcp.Int64Ptr(from.Fixed)}
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(name string, in *v1alpha1.InstanceGroupManagerParameters, observed *compute.InstanceGroupManager) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.InstanceGroupManager)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateInstanceGroupManager(name, *in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.InstanceGroupManager{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.FixedOrPercent{}, "Calculated", "ForceSendFields", "NullFields")), nil
+}
+
+// DiffPerInstanceConfigs returns the per-instance configs that must be created
+// or updated, and the names of those that must be deleted, for the observed
+// configs to match the desired ones.
+func DiffPerInstanceConfigs(desired, observed []*compute.PerInstanceConfig) (upsert []*compute.PerInstanceConfig, remove []string) {
+	have := make(map[string]*compute.PerInstanceConfig, len(observed))
+	for _, c := range observed {
+		have[c.Name] = c
+	}
+	want := make(map[string]bool, len(desired))
+	for _, c := range desired {
+		want[c.Name] = true
+		o, ok := have[c.Name]
+		if !ok || !cmp.Equal(c.PreservedState, o.PreservedState, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+			cmpopts.IgnoreFields(compute.PreservedState{}, "ForceSendFields", "NullFields"),
+			cmpopts.IgnoreFields(compute.PreservedStatePreservedDisk{}, "ForceSendFields", "NullFields")) {
+			upsert = append(upsert, c)
+		}
+	}
+	for _, c := range observed {
+		if !want[c.Name] {
+			remove = append(remove, c.Name)
+		}
+	}
+	return upsert, remove
+}
+
+// PreemptedInstances returns the URLs of the supplied managed instances that
+// were stopped or terminated, e.g. because Compute Engine preempted them, and
+// that the group is not already acting upon.
+func PreemptedInstances(in []*compute.ManagedInstance) []string {
+	var preempted []string
+	for _, i := range in {
+		if i.CurrentAction != "NONE" {
+			continue
+		}
+		if i.InstanceStatus == "TERMINATED" || i.InstanceStatus == "STOPPED" {
+			preempted = append(preempted, i.Instance)
+		}
+	}
+	return preempted
+}
+
+func stringOrDefault(v *string, def string) string {
+	if v == nil {
+		return def
+	}
+	return *v
+}
+
+func int64OrDefault(v *int64, def int64) int64 {
+	if v == nil {
+		return def
+	}
+	return *v
+}
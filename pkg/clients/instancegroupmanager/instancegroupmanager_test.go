@@ -0,0 +1,299 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroupmanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName     = "some-igm"
+	testRegion   = "us-central1"
+	testTemplate = "global/instanceTemplates/some-template"
+	testDisk     = "projects/p/zones/us-central1-a/disks/data"
+)
+
+func params(m ...func(*v1alpha1.InstanceGroupManagerParameters)) *v1alpha1.InstanceGroupManagerParameters {
+	o := &v1alpha1.InstanceGroupManagerParameters{
+		Region:           gcp.StringPtr(testRegion),
+		BaseInstanceName: "vm",
+		InstanceTemplate: testTemplate,
+		TargetSize:       gcp.Int64Ptr(3),
+		StatefulPolicy: &v1alpha1.StatefulPolicy{
+			PreservedState: &v1alpha1.StatefulPolicyPreservedState{
+				Disks: map[string]v1alpha1.StatefulPolicyPreservedStateDiskDevice{"data": {}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func instanceGroupManager(m ...func(*compute.InstanceGroupManager)) *compute.InstanceGroupManager {
+	o := &compute.InstanceGroupManager{
+		Name:             testName,
+		Region:           "https://www.googleapis.com/compute/v1/projects/p/regions/" + testRegion,
+		BaseInstanceName: "vm",
+		InstanceTemplate: "https://www.googleapis.com/compute/v1/projects/p/" + testTemplate,
+		TargetSize:       3,
+		StatefulPolicy: &compute.StatefulPolicy{
+			PreservedState: &compute.StatefulPolicyPreservedState{
+				Disks: map[string]compute.StatefulPolicyPreservedStateDiskDevice{"data": {AutoDelete: "NEVER"}},
+			},
+		},
+		Status: &compute.InstanceGroupManagerStatus{IsStable: true},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.InstanceGroupManagerParameters
+		observed *compute.InstanceGroupManager
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: instanceGroupManager(),
+			want:     true,
+		},
+		"TargetSizeChanged": {
+			in:       params(func(p *v1alpha1.InstanceGroupManagerParameters) { p.TargetSize = gcp.Int64Ptr(5) }),
+			observed: instanceGroupManager(),
+			want:     false,
+		},
+		"StatefulDiskAutoDeleteChanged": {
+			in: params(func(p *v1alpha1.InstanceGroupManagerParameters) {
+				p.StatefulPolicy.PreservedState.Disks["data"] = v1alpha1.StatefulPolicyPreservedStateDiskDevice{AutoDelete: gcp.StringPtr("ON_PERMANENT_INSTANCE_DELETION")}
+			}),
+			observed: instanceGroupManager(),
+			want:     false,
+		},
+		"NamedPortsChanged": {
+			in: params(func(p *v1alpha1.InstanceGroupManagerParameters) {
+				p.NamedPorts = []v1alpha1.NamedPort{{Name: "http", Port: 8080}}
+			}),
+			observed: instanceGroupManager(func(m *compute.InstanceGroupManager) {
+				m.NamedPorts = []*compute.NamedPort{{Name: "http", Port: 80}}
+			}),
+			want: false,
+		},
+		"AutoHealingPolicyDefaultDelay": {
+			in: params(func(p *v1alpha1.InstanceGroupManagerParameters) {
+				p.AutoHealingPolicies = []v1alpha1.AutoHealingPolicy{{HealthCheck: "global/healthChecks/hc"}}
+			}),
+			observed: instanceGroupManager(func(m *compute.InstanceGroupManager) {
+				m.AutoHealingPolicies = []*compute.InstanceGroupManagerAutoHealingPolicy{{
+					HealthCheck:     "https://www.googleapis.com/compute/v1/projects/p/global/healthChecks/hc",
+					InitialDelaySec: 300,
+				}}
+			}),
+			want: true,
+		},
+		"UpdatePolicyUpToDate": {
+			in: params(func(p *v1alpha1.InstanceGroupManagerParameters) {
+				p.UpdatePolicy = &v1alpha1.UpdatePolicy{
+					Type:           gcp.StringPtr("PROACTIVE"),
+					MinimalAction:  gcp.StringPtr("REPLACE"),
+					MaxSurge:       &v1alpha1.FixedOrPercent{Fixed: gcp.Int64Ptr(3)},
+					MaxUnavailable: &v1alpha1.FixedOrPercent{Fixed: gcp.Int64Ptr(0)},
+				}
+			}),
+			observed: instanceGroupManager(func(m *compute.InstanceGroupManager) {
+				m.UpdatePolicy = &compute.InstanceGroupManagerUpdatePolicy{
+					Type:           "PROACTIVE",
+					MinimalAction:  "REPLACE",
+					MaxSurge:       &compute.FixedOrPercent{Fixed: 3, Calculated: 3},
+					MaxUnavailable: &compute.FixedOrPercent{},
+				}
+			}),
+			want: true,
+		},
+		"UpdatePolicyMaxSurgeChanged": {
+			in: params(func(p *v1alpha1.InstanceGroupManagerParameters) {
+				p.UpdatePolicy = &v1alpha1.UpdatePolicy{
+					Type:     gcp.StringPtr("PROACTIVE"),
+					MaxSurge: &v1alpha1.FixedOrPercent{Percent: gcp.Int64Ptr(50)},
+				}
+			}),
+			observed: instanceGroupManager(func(m *compute.InstanceGroupManager) {
+				m.UpdatePolicy = &compute.InstanceGroupManagerUpdatePolicy{
+					Type:     "PROACTIVE",
+					MaxSurge: &compute.FixedOrPercent{Fixed: 3, Calculated: 3},
+				}
+			}),
+			want: false,
+		},
+		"UpdatePolicyUnset": {
+			in: params(),
+			observed: instanceGroupManager(func(m *compute.InstanceGroupManager) {
+				m.UpdatePolicy = &compute.InstanceGroupManagerUpdatePolicy{Type: "OPPORTUNISTIC"}
+			}),
+			want: true,
+		},
+		"StatefulPolicyRemoved": {
+			in:       params(func(p *v1alpha1.InstanceGroupManagerParameters) { p.StatefulPolicy = nil }),
+			observed: instanceGroupManager(),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(testName, tc.in, tc.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	observed := instanceGroupManager(func(m *compute.InstanceGroupManager) {
+		m.NamedPorts = []*compute.NamedPort{{Name: "http", Port: 80}}
+		m.UpdatePolicy = &compute.InstanceGroupManagerUpdatePolicy{
+			Type:           "OPPORTUNISTIC",
+			MaxSurge:       &compute.FixedOrPercent{Percent: 20, Calculated: 1},
+			MaxUnavailable: &compute.FixedOrPercent{Fixed: 1, Calculated: 1},
+		}
+	})
+	want := params(func(p *v1alpha1.InstanceGroupManagerParameters) {
+		p.NamedPorts = []v1alpha1.NamedPort{{Name: "http", Port: 80}}
+		p.UpdatePolicy = &v1alpha1.UpdatePolicy{
+			Type:           gcp.StringPtr("OPPORTUNISTIC"),
+			MaxSurge:       &v1alpha1.FixedOrPercent{Percent: gcp.Int64Ptr(20)},
+			MaxUnavailable: &v1alpha1.FixedOrPercent{Fixed: gcp.Int64Ptr(1)},
+		}
+	})
+
+	got := params()
+	LateInitializeSpec(got, *observed)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+	if u, err := IsUpToDate(testName, got, observed); err != nil || !u {
+		t.Errorf("IsUpToDate(...): want late initialized spec to be up to date, got %t, %v", u, err)
+	}
+}
+
+func TestDiffPerInstanceConfigs(t *testing.T) {
+	vm1 := v1alpha1.PerInstanceConfig{
+		Name: "vm-1",
+		PreservedState: &v1alpha1.PreservedState{
+			Disks: map[string]v1alpha1.PreservedStatePreservedDisk{"data": {Source: testDisk}},
+		},
+	}
+	observedVM1 := &compute.PerInstanceConfig{
+		Name:   "vm-1",
+		Status: "EFFECTIVE",
+		PreservedState: &compute.PreservedState{
+			Disks: map[string]compute.PreservedStatePreservedDisk{"data": {AutoDelete: "NEVER", Mode: "READ_WRITE", Source: "https://www.googleapis.com/compute/v1/" + testDisk}},
+		},
+	}
+
+	type want struct {
+		upsert []string
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.PerInstanceConfig
+		observed []*compute.PerInstanceConfig
+		want     want
+	}{
+		"NoDiff": {
+			desired:  []v1alpha1.PerInstanceConfig{vm1},
+			observed: []*compute.PerInstanceConfig{observedVM1},
+		},
+		"Create": {
+			desired: []v1alpha1.PerInstanceConfig{vm1},
+			want:    want{upsert: []string{"vm-1"}},
+		},
+		"UpdateMetadata": {
+			desired: []v1alpha1.PerInstanceConfig{func() v1alpha1.PerInstanceConfig {
+				c := *vm1.DeepCopy()
+				c.PreservedState.Metadata = map[string]string{"role": "primary"}
+				return c
+			}()},
+			observed: []*compute.PerInstanceConfig{observedVM1},
+			want:     want{upsert: []string{"vm-1"}},
+		},
+		"Delete": {
+			desired:  []v1alpha1.PerInstanceConfig{},
+			observed: []*compute.PerInstanceConfig{observedVM1},
+			want:     want{remove: []string{"vm-1"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upsert, remove := DiffPerInstanceConfigs(GeneratePerInstanceConfigs(tc.desired), tc.observed)
+			var names []string
+			for _, c := range upsert {
+				names = append(names, c.Name)
+			}
+			if diff := cmp.Diff(tc.want.upsert, names); diff != "" {
+				t.Errorf("DiffPerInstanceConfigs(...): -want upsert, +got upsert:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("DiffPerInstanceConfigs(...): -want remove, +got remove:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreemptedInstances(t *testing.T) {
+	cases := map[string]struct {
+		in   []*compute.ManagedInstance
+		want []string
+	}{
+		"Running": {
+			in: []*compute.ManagedInstance{{Instance: "vm-1", InstanceStatus: "RUNNING", CurrentAction: "NONE"}},
+		},
+		"Terminated": {
+			in: []*compute.ManagedInstance{
+				{Instance: "vm-1", InstanceStatus: "TERMINATED", CurrentAction: "NONE"},
+				{Instance: "vm-2", InstanceStatus: "RUNNING", CurrentAction: "NONE"},
+			},
+			want: []string{"vm-1"},
+		},
+		"AlreadyRecreating": {
+			in: []*compute.ManagedInstance{{Instance: "vm-1", InstanceStatus: "TERMINATED", CurrentAction: "RECREATING"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, PreemptedInstances(tc.in)); diff != "" {
+				t.Errorf("PreemptedInstances(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetemplate
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/instanceconfig"
+)
+
+const (
+	diskTypePersistent   = "PERSISTENT"
+	accessConfigOneToOne = "ONE_TO_ONE_NAT"
+	accessConfigIPv6     = "DIRECT_IPV6"
+	networkTierPremium   = "PREMIUM"
+
+	msgFmtReplacementRequired = "instance templates cannot be changed once created; create a new InstanceTemplate to apply changes to %s"
+)
+
+const (
+	// TypeUpToDate indicates whether an instance template matches the
+	// desired state of its managed resource.
+	TypeUpToDate xpv1.ConditionType = "UpToDate"
+
+	// ReasonSpecApplied indicates that an instance template matches the
+	// desired state of its managed resource.
+	ReasonSpecApplied xpv1.ConditionReason = "SpecApplied"
+
+	// ReasonReplacementRequired indicates that the desired state of an
+	// instance template was changed after it was created, and can only be
+	// applied by creating a new template.
+	ReasonReplacementRequired xpv1.ConditionReason = "ReplacementRequired"
+)
+
+// ImageFamily returns the project and the name of the supplied image family,
+// which is either the name of a family of the supplied project or the
+// partially or fully qualified URL of a family, i.e.
+// projects/<project>/global/images/family/<family>.
+func ImageFamily(projectID, family string) (string, string) {
+	parts := strings.Split(strings.TrimPrefix(family, v1beta1.ComputeURIPrefix), "/")
+	if len(parts) == 6 && parts[0] == "projects" && parts[4] == "family" {
+		return parts[1], parts[5]
+	}
+	return projectID, family
+}
+
+// SourceImageFamilies returns the image families that must be resolved when
+// the supplied instance template is created, indexed like its disks. Disks
+// with a source image, or without a source image family, have an empty
+// family.
+func SourceImageFamilies(in v1alpha1.InstanceTemplateParameters) []string {
+	families := make([]string, len(in.Disks))
+	for i, d := range in.Disks {
+		if d.SourceImage == nil {
+			families[i] = gcp.StringValue(d.SourceImageFamily)
+		}
+	}
+	return families
+}
+
+// GenerateInstanceTemplate returns the instance template described by the
+// supplied parameters. The supplied images are the images that the source
+// image families of the disks were resolved to, indexed like the disks.
+func GenerateInstanceTemplate(name string, in v1alpha1.InstanceTemplateParameters, images []string) *compute.InstanceTemplate {
+	p := &compute.InstanceProperties{
+		MachineType: in.MachineType,
+		Labels:      in.Labels,
+	}
+	for i, d := range in.Disks {
+		ad := &compute.AttachedDisk{
+			Type:       diskTypePersistent,
+			Boot:       gcp.BoolValue(d.Boot),
+			AutoDelete: gcp.BoolValue(d.AutoDelete),
+			DeviceName: gcp.StringValue(d.DeviceName),
+			InitializeParams: &compute.AttachedDiskInitializeParams{
+				DiskSizeGb:  gcp.Int64Value(d.DiskSizeGb),
+				DiskType:    gcp.StringValue(d.DiskType),
+				SourceImage: gcp.StringValue(d.SourceImage),
+			},
+		}
+		if ad.InitializeParams.SourceImage == "" && i < len(images) {
+			ad.InitializeParams.SourceImage = images[i]
+		}
+		if d.AutoDelete != nil {
+			ad.ForceSendFields = []string{"AutoDelete"}
+		}
+		p.Disks = append(p.Disks, ad)
+	}
+	for _, n := range in.NetworkInterfaces {
+		ni := &compute.NetworkInterface{
+			Network:    gcp.StringValue(n.Network),
+			Subnetwork: gcp.StringValue(n.Subnetwork),
+			StackType:  gcp.StringValue(n.StackType),
+		}
+		for _, ac := range n.AccessConfigs {
+			ni.AccessConfigs = append(ni.AccessConfigs, &compute.AccessConfig{
+				Type:  accessConfigOneToOne,
+				Name:  gcp.StringValue(ac.Name),
+				NatIP: gcp.StringValue(ac.NatIP),
+			})
+		}
+		// External IPv6 ranges are only available in the premium tier.
+		for _, ac := range n.IPv6AccessConfigs {
+			ni.Ipv6AccessConfigs = append(ni.Ipv6AccessConfigs, &compute.AccessConfig{
+				Type:                     accessConfigIPv6,
+				Name:                     gcp.StringValue(ac.Name),
+				NetworkTier:              networkTierPremium,
+				ExternalIpv6:             gcp.StringValue(ac.ExternalIPv6),
+				ExternalIpv6PrefixLength: gcp.Int64Value(ac.ExternalIPv6PrefixLength),
+			})
+		}
+		p.NetworkInterfaces = append(p.NetworkInterfaces, ni)
+	}
+	for _, sa := range in.ServiceAccounts {
+		p.ServiceAccounts = append(p.ServiceAccounts, &compute.ServiceAccount{Email: sa.Email, Scopes: sa.Scopes})
+	}
+	if len(in.Metadata) > 0 {
+		p.Metadata = &compute.Metadata{}
+		keys := make([]string, 0, len(in.Metadata))
+		for k := range in.Metadata {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p.Metadata.Items = append(p.Metadata.Items, &compute.MetadataItems{Key: k, Value: gcp.StringPtr(in.Metadata[k])})
+		}
+	}
+	if len(in.Tags) > 0 {
+		p.Tags = &compute.Tags{Items: in.Tags}
+	}
+	if s := in.Scheduling; s != nil {
+		p.Scheduling = &compute.Scheduling{
+			AutomaticRestart:  s.AutomaticRestart,
+			OnHostMaintenance: gcp.StringValue(s.OnHostMaintenance),
+			Preemptible:       gcp.BoolValue(s.Preemptible),
+			ProvisioningModel: gcp.StringValue(s.ProvisioningModel),
+		}
+	}
+	p.ConfidentialInstanceConfig = instanceconfig.GenerateConfidentialInstanceConfig(in.ConfidentialInstanceConfig)
+	p.ShieldedInstanceConfig = instanceconfig.GenerateShieldedInstanceConfig(in.ShieldedInstanceConfig)
+	return &compute.InstanceTemplate{
+		Name:        name,
+		Description: gcp.StringValue(in.Description),
+		Properties:  p,
+	}
+}
+
+// LateInitializeSpec updates any unset optional fields of the supplied
+// InstanceTemplateParameters that are set on the supplied instance template.
+func LateInitializeSpec(in *v1alpha1.InstanceTemplateParameters, observed compute.InstanceTemplate) {
+	if observed.Properties == nil {
+		return
+	}
+	in.ConfidentialInstanceConfig = instanceconfig.LateInitializeConfidentialInstanceConfig(in.ConfidentialInstanceConfig, observed.Properties.ConfidentialInstanceConfig)
+	in.ShieldedInstanceConfig = instanceconfig.LateInitializeShieldedInstanceConfig(in.ShieldedInstanceConfig, observed.Properties.ShieldedInstanceConfig)
+}
+
+// GenerateObservation returns the observation of the supplied instance
+// template.
+func GenerateObservation(in compute.InstanceTemplate) v1alpha1.InstanceTemplateObservation {
+	o := v1alpha1.InstanceTemplateObservation{
+		ID:                in.Id,
+		CreationTimestamp: in.CreationTimestamp,
+		SelfLink:          in.SelfLink,
+	}
+	if in.Properties == nil {
+		return o
+	}
+	for _, d := range in.Properties.Disks {
+		do := v1alpha1.AttachedDiskObservation{DeviceName: d.DeviceName}
+		if d.InitializeParams != nil {
+			do.SourceImage = d.InitializeParams.SourceImage
+		}
+		o.Disks = append(o.Disks, do)
+	}
+	return o
+}
+
+// Changed returns the fields of the supplied parameters that do not match
+// the supplied instance template. Optional fields that are not set, and the
+// source image families of the disks, are not compared.
+func Changed(in v1alpha1.InstanceTemplateParameters, observed compute.InstanceTemplate) []string {
+	p := observed.Properties
+	if p == nil {
+		p = &compute.InstanceProperties{}
+	}
+	var changed []string
+	if in.Description != nil && *in.Description != observed.Description {
+		changed = append(changed, "description")
+	}
+	if path.Base(in.MachineType) != path.Base(p.MachineType) {
+		changed = append(changed, "machineType")
+	}
+	if !disksUpToDate(in.Disks, p.Disks) {
+		changed = append(changed, "disks")
+	}
+	if !networkInterfacesUpToDate(in.NetworkInterfaces, p.NetworkInterfaces) {
+		changed = append(changed, "networkInterfaces")
+	}
+	if !serviceAccountsUpToDate(in.ServiceAccounts, p.ServiceAccounts) {
+		changed = append(changed, "serviceAccounts")
+	}
+	if !cmp.Equal(in.Labels, p.Labels, cmpopts.EquateEmpty()) {
+		changed = append(changed, "labels")
+	}
+	if !cmp.Equal(in.Metadata, metadata(p.Metadata), cmpopts.EquateEmpty()) {
+		changed = append(changed, "metadata")
+	}
+	var tags []string
+	if p.Tags != nil {
+		tags = p.Tags.Items
+	}
+	if !cmp.Equal(in.Tags, tags, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+		changed = append(changed, "tags")
+	}
+	if !schedulingUpToDate(in.Scheduling, p.Scheduling) {
+		changed = append(changed, "scheduling")
+	}
+	if instanceconfig.ValidateUnchanged(in.ConfidentialInstanceConfig, nil, p.ConfidentialInstanceConfig, nil) != nil {
+		changed = append(changed, "confidentialInstanceConfig")
+	}
+	if instanceconfig.ValidateUnchanged(nil, in.ShieldedInstanceConfig, nil, p.ShieldedInstanceConfig) != nil {
+		changed = append(changed, "shieldedInstanceConfig")
+	}
+	return changed
+}
+
+func disksUpToDate(in []v1alpha1.AttachedDisk, observed []*compute.AttachedDisk) bool {
+	if len(in) != len(observed) {
+		return false
+	}
+	for i, d := range in {
+		o := observed[i]
+		ip := o.InitializeParams
+		if ip == nil {
+			ip = &compute.AttachedDiskInitializeParams{}
+		}
+		switch {
+		case gcp.BoolValue(d.Boot) != o.Boot,
+			d.AutoDelete != nil && *d.AutoDelete != o.AutoDelete,
+			d.DeviceName != nil && *d.DeviceName != o.DeviceName,
+			d.DiskSizeGb != nil && *d.DiskSizeGb != ip.DiskSizeGb,
+			d.DiskType != nil && path.Base(*d.DiskType) != path.Base(ip.DiskType),
+			d.SourceImage != nil && path.Base(*d.SourceImage) != path.Base(ip.SourceImage):
+			return false
+		}
+	}
+	return true
+}
+
+func networkInterfacesUpToDate(in []v1alpha1.NetworkInterface, observed []*compute.NetworkInterface) bool {
+	if len(in) != len(observed) {
+		return false
+	}
+	for i, n := range in {
+		o := observed[i]
+		switch {
+		case n.Network != nil && path.Base(*n.Network) != path.Base(o.Network),
+			n.Subnetwork != nil && path.Base(*n.Subnetwork) != path.Base(o.Subnetwork),
+			len(n.AccessConfigs) != len(o.AccessConfigs),
+			n.StackType != nil && *n.StackType != o.StackType,
+			len(n.IPv6AccessConfigs) != len(o.Ipv6AccessConfigs):
+			return false
+		}
+	}
+	return true
+}
+
+func serviceAccountsUpToDate(in []v1alpha1.InstanceServiceAccount, observed []*compute.ServiceAccount) bool {
+	if len(in) != len(observed) {
+		return false
+	}
+	for i, sa := range in {
+		if sa.Email != observed[i].Email || !cmp.Equal(sa.Scopes, observed[i].Scopes, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+			return false
+		}
+	}
+	return true
+}
+
+func schedulingUpToDate(in *v1alpha1.Scheduling, observed *compute.Scheduling) bool {
+	if in == nil {
+		return true
+	}
+	if observed == nil {
+		observed = &compute.Scheduling{}
+	}
+	switch {
+	case in.AutomaticRestart != nil && *in.AutomaticRestart != gcp.BoolValue(observed.AutomaticRestart),
+		in.OnHostMaintenance != nil && *in.OnHostMaintenance != observed.OnHostMaintenance,
+		in.Preemptible != nil && *in.Preemptible != observed.Preemptible,
+		in.ProvisioningModel != nil && *in.ProvisioningModel != observed.ProvisioningModel:
+		return false
+	}
+	return true
+}
+
+func metadata(in *compute.Metadata) map[string]string {
+	if in == nil || len(in.Items) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(in.Items))
+	for _, i := range in.Items {
+		m[i.Key] = gcp.StringValue(i.Value)
+	}
+	return m
+}
+
+// UpToDate returns a condition indicating that an instance template matches
+// the desired state of its managed resource.
+func UpToDate() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpToDate,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonSpecApplied,
+	}
+}
+
+// ReplacementRequired returns a condition indicating that the supplied fields
+// of an instance template were changed after it was created, and can only be
+// applied by creating a new template.
+func ReplacementRequired(changed []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpToDate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReplacementRequired,
+		Message:            fmt.Sprintf(msgFmtReplacementRequired, strings.Join(changed, ", ")),
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetemplate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "test-project"
+	testImage   = "https://www.googleapis.com/compute/v1/projects/test-project/global/images/test-v2"
+)
+
+func TestImageFamily(t *testing.T) {
+	type want struct {
+		project string
+		family  string
+	}
+	cases := map[string]struct {
+		family string
+		want   want
+	}{
+		"Name": {
+			family: "test-family",
+			want:   want{project: testProject, family: "test-family"},
+		},
+		"PartialURL": {
+			family: "projects/debian-cloud/global/images/family/debian-12",
+			want:   want{project: "debian-cloud", family: "debian-12"},
+		},
+		"FullURL": {
+			family: "https://www.googleapis.com/compute/v1/projects/debian-cloud/global/images/family/debian-12",
+			want:   want{project: "debian-cloud", family: "debian-12"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			project, family := ImageFamily(testProject, tc.family)
+			if diff := cmp.Diff(tc.want, want{project: project, family: family}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("ImageFamily(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSourceImageFamilies(t *testing.T) {
+	in := v1alpha1.InstanceTemplateParameters{Disks: []v1alpha1.AttachedDisk{
+		{SourceImageFamily: gcp.StringPtr("family")},
+		{SourceImage: gcp.StringPtr("image"), SourceImageFamily: gcp.StringPtr("ignored")},
+		{},
+	}}
+	if diff := cmp.Diff([]string{"family", "", ""}, SourceImageFamilies(in)); diff != "" {
+		t.Errorf("SourceImageFamilies(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateInstanceTemplate(t *testing.T) {
+	in := v1alpha1.InstanceTemplateParameters{
+		Description: gcp.StringPtr("desc"),
+		MachineType: "e2-small",
+		Disks: []v1alpha1.AttachedDisk{
+			{Boot: gcp.BoolPtr(true), AutoDelete: gcp.BoolPtr(false), DiskSizeGb: gcp.Int64Ptr(20), SourceImageFamily: gcp.StringPtr("family")},
+			{DiskType: gcp.StringPtr("pd-ssd"), SourceImage: gcp.StringPtr("image")},
+		},
+		NetworkInterfaces: []v1alpha1.NetworkInterface{{
+			Network:       gcp.StringPtr("network"),
+			Subnetwork:    gcp.StringPtr("subnetwork"),
+			AccessConfigs: []v1alpha1.AccessConfig{{Name: gcp.StringPtr("external")}},
+			StackType:     gcp.StringPtr("IPV4_IPV6"),
+			IPv6AccessConfigs: []v1alpha1.IPv6AccessConfig{{
+				Name: gcp.StringPtr("external-ipv6"),
+			}},
+		}},
+		ServiceAccounts: []v1alpha1.InstanceServiceAccount{{Email: "sa@example.com", Scopes: []string{"scope"}}},
+		Labels:          map[string]string{"l": "v"},
+		Metadata:        map[string]string{"b": "2", "a": "1"},
+		Tags:            []string{"web"},
+		Scheduling:      &v1alpha1.Scheduling{Preemptible: gcp.BoolPtr(true), AutomaticRestart: gcp.BoolPtr(false)},
+
+		ConfidentialInstanceConfig: &v1alpha1.ConfidentialInstanceConfig{EnableConfidentialCompute: true},
+		ShieldedInstanceConfig:     &v1alpha1.ShieldedInstanceConfig{EnableSecureBoot: gcp.BoolPtr(true)},
+	}
+	want := &compute.InstanceTemplate{
+		Name:        "name",
+		Description: "desc",
+		Properties: &compute.InstanceProperties{
+			MachineType: "e2-small",
+			Disks: []*compute.AttachedDisk{
+				{
+					Type:             diskTypePersistent,
+					Boot:             true,
+					InitializeParams: &compute.AttachedDiskInitializeParams{DiskSizeGb: 20, SourceImage: testImage},
+					ForceSendFields:  []string{"AutoDelete"},
+				},
+				{
+					Type:             diskTypePersistent,
+					InitializeParams: &compute.AttachedDiskInitializeParams{DiskType: "pd-ssd", SourceImage: "image"},
+				},
+			},
+			NetworkInterfaces: []*compute.NetworkInterface{{
+				Network:       "network",
+				Subnetwork:    "subnetwork",
+				AccessConfigs: []*compute.AccessConfig{{Name: "external", Type: accessConfigOneToOne}},
+				StackType:     "IPV4_IPV6",
+				Ipv6AccessConfigs: []*compute.AccessConfig{{
+					Name:        "external-ipv6",
+					Type:        accessConfigIPv6,
+					NetworkTier: networkTierPremium,
+				}},
+			}},
+			ServiceAccounts: []*compute.ServiceAccount{{Email: "sa@example.com", Scopes: []string{"scope"}}},
+			Labels:          map[string]string{"l": "v"},
+			Metadata: &compute.Metadata{Items: []*compute.MetadataItems{
+				{Key: "a", Value: gcp.StringPtr("1")},
+				{Key: "b", Value: gcp.StringPtr("2")},
+			}},
+			Tags:       &compute.Tags{Items: []string{"web"}},
+			Scheduling: &compute.Scheduling{Preemptible: true, AutomaticRestart: gcp.BoolPtr(false)},
+
+			ConfidentialInstanceConfig: &compute.ConfidentialInstanceConfig{EnableConfidentialCompute: true},
+			ShieldedInstanceConfig:     &compute.ShieldedInstanceConfig{EnableSecureBoot: true, ForceSendFields: []string{"EnableSecureBoot"}},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateInstanceTemplate("name", in, []string{testImage, ""})); diff != "" {
+		t.Errorf("GenerateInstanceTemplate(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestChanged(t *testing.T) {
+	in := v1alpha1.InstanceTemplateParameters{
+		MachineType: "e2-small",
+		Disks: []v1alpha1.AttachedDisk{
+			{Boot: gcp.BoolPtr(true), SourceImageFamily: gcp.StringPtr("family")},
+			{DiskType: gcp.StringPtr("pd-ssd"), SourceImage: gcp.StringPtr("projects/debian-cloud/global/images/image")},
+		},
+		NetworkInterfaces: []v1alpha1.NetworkInterface{{Network: gcp.StringPtr("global/networks/default")}},
+		Labels:            map[string]string{"l": "v"},
+		Metadata:          map[string]string{"k": "v"},
+		Tags:              []string{"b", "a"},
+
+		ConfidentialInstanceConfig: &v1alpha1.ConfidentialInstanceConfig{EnableConfidentialCompute: true},
+		ShieldedInstanceConfig:     &v1alpha1.ShieldedInstanceConfig{EnableSecureBoot: gcp.BoolPtr(true)},
+	}
+	observed := func() compute.InstanceTemplate {
+		return compute.InstanceTemplate{Properties: &compute.InstanceProperties{
+			MachineType: "e2-small",
+			Disks: []*compute.AttachedDisk{
+				{Boot: true, AutoDelete: true, DeviceName: "persistent-disk-0", InitializeParams: &compute.AttachedDiskInitializeParams{SourceImage: testImage, DiskSizeGb: 10}},
+				{DeviceName: "persistent-disk-1", InitializeParams: &compute.AttachedDiskInitializeParams{DiskType: "pd-ssd", SourceImage: "https://www.googleapis.com/compute/v1/projects/debian-cloud/global/images/image"}},
+			},
+			NetworkInterfaces: []*compute.NetworkInterface{{Network: "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/default"}},
+			Labels:            map[string]string{"l": "v"},
+			Metadata:          &compute.Metadata{Items: []*compute.MetadataItems{{Key: "k", Value: gcp.StringPtr("v")}}},
+			Tags:              &compute.Tags{Items: []string{"a", "b"}},
+
+			ConfidentialInstanceConfig: &compute.ConfidentialInstanceConfig{EnableConfidentialCompute: true},
+			ShieldedInstanceConfig:     &compute.ShieldedInstanceConfig{EnableSecureBoot: true, EnableVtpm: true, EnableIntegrityMonitoring: true},
+		}}
+	}
+
+	cases := map[string]struct {
+		modify func(*compute.InstanceTemplate)
+		want   []string
+	}{
+		"UpToDate": {
+			modify: func(*compute.InstanceTemplate) {},
+		},
+		"MachineTypeChanged": {
+			modify: func(t *compute.InstanceTemplate) { t.Properties.MachineType = "e2-medium" },
+			want:   []string{"machineType"},
+		},
+		"DiskAdded": {
+			modify: func(t *compute.InstanceTemplate) { t.Properties.Disks = t.Properties.Disks[:1] },
+			want:   []string{"disks"},
+		},
+		"SourceImageChanged": {
+			modify: func(t *compute.InstanceTemplate) { t.Properties.Disks[1].InitializeParams.SourceImage = "other" },
+			want:   []string{"disks"},
+		},
+		"LabelsAndMetadataChanged": {
+			modify: func(t *compute.InstanceTemplate) {
+				t.Properties.Labels = nil
+				t.Properties.Metadata = nil
+			},
+			want: []string{"labels", "metadata"},
+		},
+		"IPv6AccessConfigAdded": {
+			modify: func(t *compute.InstanceTemplate) {
+				t.Properties.NetworkInterfaces[0].Ipv6AccessConfigs = []*compute.AccessConfig{{Type: accessConfigIPv6}}
+			},
+			want: []string{"networkInterfaces"},
+		},
+		"ConfidentialComputeDisabled": {
+			modify: func(t *compute.InstanceTemplate) { t.Properties.ConfidentialInstanceConfig = nil },
+			want:   []string{"confidentialInstanceConfig"},
+		},
+		"SecureBootDisabled": {
+			modify: func(t *compute.InstanceTemplate) { t.Properties.ShieldedInstanceConfig.EnableSecureBoot = false },
+			want:   []string{"shieldedInstanceConfig"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := observed()
+			tc.modify(&o)
+			if diff := cmp.Diff(tc.want, Changed(in, o)); diff != "" {
+				t.Errorf("Changed(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecycle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+)
+
+const (
+	errListObjects = "cannot list objects of GCS bucket"
+
+	day = 24 * time.Hour
+
+	// DefaultSampleSize is the number of objects that are sampled when the
+	// sample size of a lifecycle simulation is not set.
+	DefaultSampleSize = 1000
+
+	actionAbortIncompleteMultipartUpload = "AbortIncompleteMultipartUpload"
+)
+
+// An ObjectIterator iterates over the objects of a bucket, e.g. a
+// *storage.ObjectIterator.
+type ObjectIterator interface {
+	Next() (*storage.ObjectAttrs, error)
+}
+
+// Query returns the query that lists the objects a lifecycle simulation is
+// evaluated against. Noncurrent versions are included, and only the
+// attributes lifecycle conditions depend on are requested.
+func Query() *storage.Query {
+	q := &storage.Query{Versions: true}
+	// SetAttrSelection only fails for unknown attributes.
+	_ = q.SetAttrSelection([]string{"Name", "Generation", "Created", "CustomTime", "Deleted", "StorageClass"})
+	return q
+}
+
+// SampleSize returns the number of objects the supplied simulation samples.
+func SampleSize(in *v1alpha3.LifecycleSimulation) int {
+	if in == nil || in.SampleSize == nil {
+		return DefaultSampleSize
+	}
+	return int(*in.SampleSize)
+}
+
+// Sample reads up to max objects from the supplied iterator. It returns true
+// if the iterator was exhausted, i.e. if all objects were sampled.
+func Sample(it ObjectIterator, max int) ([]*storage.ObjectAttrs, bool, error) {
+	objs := make([]*storage.ObjectAttrs, 0, max)
+	for {
+		o, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return objs, true, nil
+		}
+		if err != nil {
+			return nil, false, errors.Wrap(err, errListObjects)
+		}
+		if len(objs) == max {
+			return objs, false, nil
+		}
+		objs = append(objs, o)
+	}
+}
+
+// RulesHash returns a hash of the supplied lifecycle rules, which identifies
+// the rules a simulation was evaluated against.
+func RulesHash(rules []v1alpha3.LifecycleRule) string {
+	// Lifecycle rules only consist of plain values, so they always marshal.
+	b, _ := json.Marshal(rules)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// IsUpToDate returns true if the supplied simulation evaluated the supplied
+// lifecycle rules against a sample of the supplied size, and did not fail.
+func IsUpToDate(s *v1alpha3.LifecycleSimulationStatus, rules []v1alpha3.LifecycleRule, size int) bool {
+	return s != nil && s.Error == "" && s.SampleSize == int64(size) && s.RulesHash == RulesHash(rules)
+}
+
+// Failed returns the supplied simulation with the supplied error recorded,
+// keeping the results of the last successful simulation, if any.
+func Failed(s *v1alpha3.LifecycleSimulationStatus, err error) *v1alpha3.LifecycleSimulationStatus {
+	if s == nil {
+		s = &v1alpha3.LifecycleSimulationStatus{}
+	}
+	s.Error = err.Error()
+	return s
+}
+
+// Simulate evaluates the supplied lifecycle rules against the supplied
+// objects, a sample of the supplied size, at the supplied time, reporting how
+// many objects each rule matches.
+func Simulate(rules []v1alpha3.LifecycleRule, objs []*storage.ObjectAttrs, size int, complete bool, now time.Time) *v1alpha3.LifecycleSimulationStatus {
+	newer := newerVersions(objs)
+	s := &v1alpha3.LifecycleSimulationStatus{
+		SampledObjects: int64(len(objs)),
+		Complete:       complete,
+		Rules:          make([]v1alpha3.LifecycleRuleSimulation, len(rules)),
+		SampleSize:     int64(size),
+		RulesHash:      RulesHash(rules),
+	}
+	for i, r := range rules {
+		s.Rules[i] = v1alpha3.LifecycleRuleSimulation{Index: i, ActionType: r.Action.Type}
+		if r.Action.Type == actionAbortIncompleteMultipartUpload {
+			continue
+		}
+		for _, o := range objs {
+			if Matches(r.Condition, o, newer[o], now) {
+				s.Rules[i].MatchingObjects++
+			}
+		}
+	}
+	return s
+}
+
+// newerVersions returns the number of newer versions of each of the supplied
+// objects that are among the supplied objects too.
+func newerVersions(objs []*storage.ObjectAttrs) map[*storage.ObjectAttrs]int64 {
+	versions := map[string][]*storage.ObjectAttrs{}
+	for _, o := range objs {
+		versions[o.Name] = append(versions[o.Name], o)
+	}
+	newer := make(map[*storage.ObjectAttrs]int64, len(objs))
+	for _, v := range versions {
+		sort.Slice(v, func(i, j int) bool { return v[i].Generation > v[j].Generation })
+		for i, o := range v {
+			newer[o] = int64(i)
+		}
+	}
+	return newer
+}
+
+// Matches returns true if the supplied object, which has the supplied number
+// of newer versions, meets all conditions of a lifecycle rule at the supplied
+// time.
+func Matches(c v1alpha3.LifecycleCondition, o *storage.ObjectAttrs, newer int64, now time.Time) bool { // nolint:gocyclo
+	// Each condition is a simple comparison; splitting them up would not
+	// make them easier to follow.
+	live := o.Deleted.IsZero()
+	switch {
+	case c.AgeInDays > 0 && now.Sub(o.Created) < time.Duration(c.AgeInDays)*day:
+		return false
+	case c.CreatedBefore != nil && !o.Created.Before(midnight(c.CreatedBefore.Time)):
+		return false
+	case c.CustomTimeBefore != nil && (o.CustomTime.IsZero() || !o.CustomTime.Before(midnight(c.CustomTimeBefore.Time))):
+		return false
+	case c.DaysSinceCustomTime > 0 && (o.CustomTime.IsZero() || now.Sub(o.CustomTime) < time.Duration(c.DaysSinceCustomTime)*day):
+		return false
+	case c.DaysSinceNoncurrentTime > 0 && (live || now.Sub(o.Deleted) < time.Duration(c.DaysSinceNoncurrentTime)*day):
+		return false
+	case c.Liveness == storage.Live && !live, c.Liveness == storage.Archived && live:
+		return false
+	case len(c.MatchesPrefix) > 0 && !hasAny(o.Name, c.MatchesPrefix, strings.HasPrefix):
+		return false
+	case len(c.MatchesSuffix) > 0 && !hasAny(o.Name, c.MatchesSuffix, strings.HasSuffix):
+		return false
+	case len(c.MatchesStorageClasses) > 0 && !hasAny(o.StorageClass, c.MatchesStorageClasses, func(a, b string) bool { return a == b }):
+		return false
+	case c.NoncurrentTimeBefore != nil && (live || !o.Deleted.Before(midnight(c.NoncurrentTimeBefore.Time))):
+		return false
+	case c.NumNewerVersions > 0 && newer < c.NumNewerVersions:
+		return false
+	}
+	return true
+}
+
+// midnight returns midnight UTC of the date of the supplied time, which is
+// when date conditions of lifecycle rules start to be met.
+func midnight(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func hasAny(s string, values []string, fn func(s, v string) bool) bool {
+	for _, v := range values {
+		if fn(s, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecycle
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/iterator"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+)
+
+var (
+	now = time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	errBoom = errors.New("boom")
+)
+
+type objectIterator struct {
+	objs []*storage.ObjectAttrs
+	err  error
+}
+
+func (it *objectIterator) Next() (*storage.ObjectAttrs, error) {
+	if it.err != nil {
+		return nil, it.err
+	}
+	if len(it.objs) == 0 {
+		return nil, iterator.Done
+	}
+	o := it.objs[0]
+	it.objs = it.objs[1:]
+	return o, nil
+}
+
+func TestSampleSize(t *testing.T) {
+	size := int64(10)
+	cases := map[string]struct {
+		in   *v1alpha3.LifecycleSimulation
+		want int
+	}{
+		"Nil":     {want: DefaultSampleSize},
+		"Unset":   {in: &v1alpha3.LifecycleSimulation{}, want: DefaultSampleSize},
+		"Defined": {in: &v1alpha3.LifecycleSimulation{SampleSize: &size}, want: 10},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, SampleSize(tc.in)); diff != "" {
+				t.Errorf("SampleSize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSample(t *testing.T) {
+	a, b := &storage.ObjectAttrs{Name: "a"}, &storage.ObjectAttrs{Name: "b"}
+	type want struct {
+		objs     []*storage.ObjectAttrs
+		complete bool
+		err      error
+	}
+	cases := map[string]struct {
+		it   ObjectIterator
+		max  int
+		want want
+	}{
+		"AllObjects": {
+			it:   &objectIterator{objs: []*storage.ObjectAttrs{a, b}},
+			max:  2,
+			want: want{objs: []*storage.ObjectAttrs{a, b}, complete: true},
+		},
+		"Truncated": {
+			it:   &objectIterator{objs: []*storage.ObjectAttrs{a, b}},
+			max:  1,
+			want: want{objs: []*storage.ObjectAttrs{a}},
+		},
+		"Error": {
+			it:   &objectIterator{err: errBoom},
+			max:  1,
+			want: want{err: errors.Wrap(errBoom, errListObjects)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			objs, complete, err := Sample(tc.it, tc.max)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Sample(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.objs, objs); diff != "" {
+				t.Errorf("Sample(...): -want objects, +got objects:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.complete, complete); diff != "" {
+				t.Errorf("Sample(...): -want complete, +got complete:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	date := &metav1.Time{Time: time.Date(2023, 6, 1, 15, 0, 0, 0, time.UTC)}
+	type args struct {
+		c     v1alpha3.LifecycleCondition
+		o     *storage.ObjectAttrs
+		newer int64
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoConditions": {
+			args: args{o: &storage.ObjectAttrs{Created: now}},
+			want: true,
+		},
+		"OldEnough": {
+			args: args{c: v1alpha3.LifecycleCondition{AgeInDays: 30}, o: &storage.ObjectAttrs{Created: now.Add(-30 * day)}},
+			want: true,
+		},
+		"TooYoung": {
+			args: args{c: v1alpha3.LifecycleCondition{AgeInDays: 30}, o: &storage.ObjectAttrs{Created: now.Add(-29 * day)}},
+		},
+		"CreatedBeforeMidnight": {
+			args: args{c: v1alpha3.LifecycleCondition{CreatedBefore: date}, o: &storage.ObjectAttrs{Created: time.Date(2023, 5, 31, 23, 0, 0, 0, time.UTC)}},
+			want: true,
+		},
+		"CreatedOnDate": {
+			args: args{c: v1alpha3.LifecycleCondition{CreatedBefore: date}, o: &storage.ObjectAttrs{Created: time.Date(2023, 6, 1, 1, 0, 0, 0, time.UTC)}},
+		},
+		"NoCustomTime": {
+			args: args{c: v1alpha3.LifecycleCondition{DaysSinceCustomTime: 1}, o: &storage.ObjectAttrs{Created: now.Add(-10 * day)}},
+		},
+		"CustomTimeBefore": {
+			args: args{c: v1alpha3.LifecycleCondition{CustomTimeBefore: date}, o: &storage.ObjectAttrs{CustomTime: now.Add(-30 * day)}},
+			want: true,
+		},
+		"LiveOnly": {
+			args: args{c: v1alpha3.LifecycleCondition{Liveness: storage.Live}, o: &storage.ObjectAttrs{Deleted: now}},
+		},
+		"ArchivedOnly": {
+			args: args{c: v1alpha3.LifecycleCondition{Liveness: storage.Archived}, o: &storage.ObjectAttrs{Deleted: now}},
+			want: true,
+		},
+		"NoncurrentLongEnough": {
+			args: args{c: v1alpha3.LifecycleCondition{DaysSinceNoncurrentTime: 7}, o: &storage.ObjectAttrs{Deleted: now.Add(-8 * day)}},
+			want: true,
+		},
+		"NoncurrentTimeOfLiveObject": {
+			args: args{c: v1alpha3.LifecycleCondition{NoncurrentTimeBefore: date}, o: &storage.ObjectAttrs{}},
+		},
+		"PrefixAndSuffix": {
+			args: args{
+				c: v1alpha3.LifecycleCondition{MatchesPrefix: []string{"tmp/", "logs/"}, MatchesSuffix: []string{".log"}},
+				o: &storage.ObjectAttrs{Name: "logs/app.log"},
+			},
+			want: true,
+		},
+		"WrongSuffix": {
+			args: args{
+				c: v1alpha3.LifecycleCondition{MatchesPrefix: []string{"logs/"}, MatchesSuffix: []string{".log"}},
+				o: &storage.ObjectAttrs{Name: "logs/app.json"},
+			},
+		},
+		"WrongStorageClass": {
+			args: args{c: v1alpha3.LifecycleCondition{MatchesStorageClasses: []string{"NEARLINE"}}, o: &storage.ObjectAttrs{StorageClass: "STANDARD"}},
+		},
+		"EnoughNewerVersions": {
+			args: args{c: v1alpha3.LifecycleCondition{NumNewerVersions: 2}, o: &storage.ObjectAttrs{}, newer: 2},
+			want: true,
+		},
+		"TooFewNewerVersions": {
+			args: args{c: v1alpha3.LifecycleCondition{NumNewerVersions: 2}, o: &storage.ObjectAttrs{}, newer: 1},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Matches(tc.args.c, tc.args.o, tc.args.newer, now)); diff != "" {
+				t.Errorf("Matches(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	objs := []*storage.ObjectAttrs{
+		{Name: "logs/a.log", Generation: 1, Created: now.Add(-40 * day), Deleted: now.Add(-20 * day)},
+		{Name: "logs/a.log", Generation: 3, Created: now.Add(-5 * day)},
+		{Name: "logs/a.log", Generation: 2, Created: now.Add(-20 * day), Deleted: now.Add(-5 * day)},
+		{Name: "data/b.csv", Generation: 1, Created: now.Add(-100 * day)},
+	}
+	rules := []v1alpha3.LifecycleRule{
+		{Action: v1alpha3.LifecycleAction{Type: "Delete"}, Condition: v1alpha3.LifecycleCondition{AgeInDays: 30}},
+		{Action: v1alpha3.LifecycleAction{Type: "Delete"}, Condition: v1alpha3.LifecycleCondition{NumNewerVersions: 2}},
+		{Action: v1alpha3.LifecycleAction{Type: "SetStorageClass", StorageClass: "NEARLINE"}, Condition: v1alpha3.LifecycleCondition{MatchesPrefix: []string{"logs/"}}},
+		{Action: v1alpha3.LifecycleAction{Type: "AbortIncompleteMultipartUpload"}, Condition: v1alpha3.LifecycleCondition{AgeInDays: 1}},
+	}
+	want := &v1alpha3.LifecycleSimulationStatus{
+		SampledObjects: 4,
+		Rules: []v1alpha3.LifecycleRuleSimulation{
+			{Index: 0, ActionType: "Delete", MatchingObjects: 2},
+			{Index: 1, ActionType: "Delete", MatchingObjects: 1},
+			{Index: 2, ActionType: "SetStorageClass", MatchingObjects: 3},
+			{Index: 3, ActionType: "AbortIncompleteMultipartUpload"},
+		},
+		SampleSize: 10,
+		RulesHash:  RulesHash(rules),
+	}
+	if diff := cmp.Diff(want, Simulate(rules, objs, 10, false, now)); diff != "" {
+		t.Errorf("Simulate(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	rules := []v1alpha3.LifecycleRule{{Action: v1alpha3.LifecycleAction{Type: "Delete"}, Condition: v1alpha3.LifecycleCondition{AgeInDays: 30}}}
+	changed := []v1alpha3.LifecycleRule{{Action: v1alpha3.LifecycleAction{Type: "Delete"}, Condition: v1alpha3.LifecycleCondition{AgeInDays: 7}}}
+	sampled := &v1alpha3.LifecycleSimulationStatus{SampleSize: 10, RulesHash: RulesHash(rules)}
+	cases := map[string]struct {
+		s     *v1alpha3.LifecycleSimulationStatus
+		rules []v1alpha3.LifecycleRule
+		size  int
+		want  bool
+	}{
+		"NeverSampled": {rules: rules, size: 10},
+		"UpToDate":     {s: sampled, rules: rules, size: 10, want: true},
+		"RulesChanged": {s: sampled, rules: changed, size: 10},
+		"SizeChanged":  {s: sampled, rules: rules, size: 20},
+		"Failed": {
+			s:     &v1alpha3.LifecycleSimulationStatus{SampleSize: 10, RulesHash: RulesHash(rules), Error: "boom"},
+			rules: rules,
+			size:  10,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.s, tc.rules, tc.size)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesh
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat   = "projects/%s/locations/global"
+	meshNameFormat = "projects/%s/locations/global/meshes/%s"
+)
+
+// UpdateMask lists the fields of a mesh that are patched on update.
+const UpdateMask = "description,labels,interceptionPort,envoyHeaders"
+
+// Client should be satisfied to conduct Mesh operations.
+type Client interface {
+	Create(parent string, mesh *networkservices.Mesh) *networkservices.ProjectsLocationsMeshesCreateCall
+	Get(name string) *networkservices.ProjectsLocationsMeshesGetCall
+	Patch(name string, mesh *networkservices.Mesh) *networkservices.ProjectsLocationsMeshesPatchCall
+	Delete(name string) *networkservices.ProjectsLocationsMeshesDeleteCall
+}
+
+// GetFullyQualifiedParent builds the fully qualified name of the parent of a
+// mesh.
+func GetFullyQualifiedParent(project string) string {
+	return fmt.Sprintf(parentFormat, project)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a mesh.
+func GetFullyQualifiedName(project, name string) string {
+	return fmt.Sprintf(meshNameFormat, project, name)
+}
+
+// GenerateMesh generates *networkservices.Mesh instance from MeshParameters.
+func GenerateMesh(in v1alpha1.MeshParameters) *networkservices.Mesh {
+	return &networkservices.Mesh{
+		Description:      gcp.StringValue(in.Description),
+		Labels:           in.Labels,
+		InterceptionPort: gcp.Int64Value(in.InterceptionPort),
+		EnvoyHeaders:     gcp.StringValue(in.EnvoyHeaders),
+	}
+}
+
+// GenerateObservation produces MeshObservation object from
+// networkservices.Mesh object.
+func GenerateObservation(in networkservices.Mesh) v1alpha1.MeshObservation {
+	return v1alpha1.MeshObservation{
+		Name:       in.Name,
+		SelfLink:   in.SelfLink,
+		CreateTime: in.CreateTime,
+		UpdateTime: in.UpdateTime,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// networkservices.Mesh object.
+func LateInitializeSpec(spec *v1alpha1.MeshParameters, in networkservices.Mesh) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+	spec.InterceptionPort = gcp.LateInitializeInt64(spec.InterceptionPort, in.InterceptionPort)
+	spec.EnvoyHeaders = gcp.LateInitializeString(spec.EnvoyHeaders, in.EnvoyHeaders)
+}
+
+// IsUpToDate returns true if the observed mesh matches the desired one.
+func IsUpToDate(in v1alpha1.MeshParameters, observed networkservices.Mesh) bool {
+	return cmp.Equal(GenerateMesh(in), &observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(networkservices.Mesh{}, "Name", "SelfLink", "CreateTime", "UpdateTime", "ServerResponse", "ForceSendFields", "NullFields"))
+}
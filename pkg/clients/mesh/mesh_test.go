@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mesh
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func params() v1alpha1.MeshParameters {
+	return v1alpha1.MeshParameters{
+		Description:      gcp.StringPtr("traffic director mesh"),
+		Labels:           map[string]string{"team": "platform"},
+		InterceptionPort: gcp.Int64Ptr(15001),
+	}
+}
+
+func mesh() *networkservices.Mesh {
+	return &networkservices.Mesh{
+		Description:      "traffic director mesh",
+		Labels:           map[string]string{"team": "platform"},
+		InterceptionPort: 15001,
+	}
+}
+
+func TestGenerateMesh(t *testing.T) {
+	if diff := cmp.Diff(mesh(), GenerateMesh(params())); diff != "" {
+		t.Errorf("GenerateMesh(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	observed := mesh()
+	observed.EnvoyHeaders = "NONE"
+	got := v1alpha1.MeshParameters{}
+	LateInitializeSpec(&got, *observed)
+	want := params()
+	want.EnvoyHeaders = gcp.StringPtr("NONE")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		observed func(m *networkservices.Mesh)
+		want     bool
+	}{
+		"UpToDate": {
+			observed: func(m *networkservices.Mesh) {
+				m.Name = GetFullyQualifiedName("someProject", "mesh")
+				m.CreateTime = "2023-01-01T00:00:00Z"
+			},
+			want: true,
+		},
+		"PortChanged": {
+			observed: func(m *networkservices.Mesh) { m.InterceptionPort = 15002 },
+			want:     false,
+		},
+		"LabelRemoved": {
+			observed: func(m *networkservices.Mesh) { m.Labels = nil },
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := mesh()
+			tc.observed(observed)
+			if diff := cmp.Diff(tc.want, IsUpToDate(params(), *observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
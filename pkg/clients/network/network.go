@@ -43,6 +43,12 @@ func GenerateNetwork(name string, in v1beta1.NetworkParameters, network *compute
 			network.ForceSendFields = []string{"AutoCreateSubnetworks"}
 		}
 	}
+	if in.EnableULAInternalIPv6 != nil {
+		network.EnableUlaInternalIpv6 = *in.EnableULAInternalIPv6
+	}
+	if in.InternalIPv6Range != nil {
+		network.InternalIpv6Range = *in.InternalIPv6Range
+	}
 	if in.RoutingConfig != nil {
 		if network.RoutingConfig == nil {
 			network.RoutingConfig = &compute.NetworkRoutingConfig{}
@@ -84,6 +90,8 @@ func LateInitializeSpec(spec *v1beta1.NetworkParameters, in compute.Network) {
 	}
 
 	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.EnableULAInternalIPv6 = gcp.LateInitializeBool(spec.EnableULAInternalIPv6, in.EnableUlaInternalIpv6)
+	spec.InternalIPv6Range = gcp.LateInitializeString(spec.InternalIPv6Range, in.InternalIpv6Range)
 }
 
 // IsUpToDate checks whether current state is up-to-date compared to the given
@@ -0,0 +1,137 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkendpointgroup
+
+import (
+	"path"
+	"strconv"
+
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// GenerateNetworkEndpointGroup converts the supplied
+// NetworkEndpointGroupParameters into a NetworkEndpointGroup suitable for use
+// with the Google Compute API. Network endpoint groups cannot be updated, so
+// nil pointers are safely converted to their zero values.
+func GenerateNetworkEndpointGroup(name string, in v1alpha1.NetworkEndpointGroupParameters, neg *compute.NetworkEndpointGroup) {
+	neg.Name = name
+	neg.Description = gcp.StringValue(in.Description)
+	neg.NetworkEndpointType = in.NetworkEndpointType
+	neg.DefaultPort = gcp.Int64Value(in.DefaultPort)
+	neg.Network = gcp.StringValue(in.Network)
+	neg.Subnetwork = gcp.StringValue(in.Subnetwork)
+	neg.Zone = gcp.StringValue(in.Zone)
+	neg.Region = gcp.StringValue(in.Region)
+
+	if in.AppEngine != nil {
+		neg.AppEngine = &compute.NetworkEndpointGroupAppEngine{
+			Service: gcp.StringValue(in.AppEngine.Service),
+			UrlMask: gcp.StringValue(in.AppEngine.URLMask),
+			Version: gcp.StringValue(in.AppEngine.Version),
+		}
+	}
+	if in.CloudFunction != nil {
+		neg.CloudFunction = &compute.NetworkEndpointGroupCloudFunction{
+			Function: gcp.StringValue(in.CloudFunction.Function),
+			UrlMask:  gcp.StringValue(in.CloudFunction.URLMask),
+		}
+	}
+	if in.CloudRun != nil {
+		neg.CloudRun = &compute.NetworkEndpointGroupCloudRun{
+			Service: gcp.StringValue(in.CloudRun.Service),
+			Tag:     gcp.StringValue(in.CloudRun.Tag),
+			UrlMask: gcp.StringValue(in.CloudRun.URLMask),
+		}
+	}
+}
+
+// GenerateNetworkEndpoints converts the supplied NetworkEndpoints into the
+// representation used by the Google Compute API.
+func GenerateNetworkEndpoints(in []v1alpha1.NetworkEndpoint) []*compute.NetworkEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := make([]*compute.NetworkEndpoint, len(in))
+	for i, ep := range in {
+		out[i] = &compute.NetworkEndpoint{
+			Fqdn:      gcp.StringValue(ep.Fqdn),
+			Instance:  gcp.StringValue(ep.Instance),
+			IpAddress: gcp.StringValue(ep.IPAddress),
+			Port:      gcp.Int64Value(ep.Port),
+		}
+	}
+	return out
+}
+
+// LateInitializeSpec updates any unset (i.e. nil) optional fields of the
+// supplied NetworkEndpointGroupParameters that are set (i.e. non-zero) on the
+// supplied NetworkEndpointGroup.
+func LateInitializeSpec(p *v1alpha1.NetworkEndpointGroupParameters, observed compute.NetworkEndpointGroup) {
+	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
+	p.DefaultPort = gcp.LateInitializeInt64(p.DefaultPort, observed.DefaultPort)
+	p.Network = gcp.LateInitializeString(p.Network, observed.Network)
+	p.Subnetwork = gcp.LateInitializeString(p.Subnetwork, observed.Subnetwork)
+}
+
+// GenerateNetworkEndpointGroupObservation takes a
+// compute.NetworkEndpointGroup and returns *NetworkEndpointGroupObservation.
+func GenerateNetworkEndpointGroupObservation(observed compute.NetworkEndpointGroup) v1alpha1.NetworkEndpointGroupObservation {
+	return v1alpha1.NetworkEndpointGroupObservation{
+		CreationTimestamp: observed.CreationTimestamp,
+		ID:                observed.Id,
+		SelfLink:          observed.SelfLink,
+		Size:              observed.Size,
+	}
+}
+
+// DiffNetworkEndpoints returns the endpoints that must be attached to and
+// detached from a network endpoint group whose current endpoints are observed
+// in order for it to contain exactly the desired endpoints. The default port
+// is used for any desired endpoint that omits one, mirroring the API.
+func DiffNetworkEndpoints(defaultPort int64, desired, observed []*compute.NetworkEndpoint) (attach, detach []*compute.NetworkEndpoint) {
+	want := make(map[string]bool, len(desired))
+	for _, ep := range desired {
+		want[endpointKey(defaultPort, ep)] = true
+	}
+	have := make(map[string]bool, len(observed))
+	for _, ep := range observed {
+		k := endpointKey(defaultPort, ep)
+		have[k] = true
+		if !want[k] {
+			detach = append(detach, ep)
+		}
+	}
+	for _, ep := range desired {
+		if !have[endpointKey(defaultPort, ep)] {
+			attach = append(attach, ep)
+		}
+	}
+	return attach, detach
+}
+
+func endpointKey(defaultPort int64, ep *compute.NetworkEndpoint) string {
+	port := ep.Port
+	if port == 0 {
+		port = defaultPort
+	}
+	// The API returns instances as fully qualified URLs but accepts bare
+	// names.
+	return path.Base(ep.Instance) + "/" + ep.IpAddress + "/" + ep.Fqdn + "/" + strconv.FormatInt(port, 10)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkendpointgroup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName    = "some-neg"
+	testRegion  = "us-central1"
+	testService = "some-service"
+)
+
+func params(m ...func(*v1alpha1.NetworkEndpointGroupParameters)) *v1alpha1.NetworkEndpointGroupParameters {
+	o := &v1alpha1.NetworkEndpointGroupParameters{
+		Region:              gcp.StringPtr(testRegion),
+		NetworkEndpointType: v1alpha1.NetworkEndpointTypeServerless,
+		CloudRun: &v1alpha1.NetworkEndpointGroupCloudRun{
+			Service: gcp.StringPtr(testService),
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func networkEndpointGroup(m ...func(*compute.NetworkEndpointGroup)) *compute.NetworkEndpointGroup {
+	o := &compute.NetworkEndpointGroup{
+		Name:                testName,
+		Region:              testRegion,
+		NetworkEndpointType: v1alpha1.NetworkEndpointTypeServerless,
+		CloudRun: &compute.NetworkEndpointGroupCloudRun{
+			Service: testService,
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestGenerateNetworkEndpointGroup(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.NetworkEndpointGroupParameters
+		want *compute.NetworkEndpointGroup
+	}{
+		"Serverless": {
+			in:   *params(),
+			want: networkEndpointGroup(),
+		},
+		"Hybrid": {
+			in: *params(func(p *v1alpha1.NetworkEndpointGroupParameters) {
+				p.Region = nil
+				p.Zone = gcp.StringPtr("us-central1-a")
+				p.NetworkEndpointType = v1alpha1.NetworkEndpointTypeNonGCPPrivateIPPort
+				p.DefaultPort = gcp.Int64Ptr(443)
+				p.Network = gcp.StringPtr("global/networks/default")
+				p.CloudRun = nil
+			}),
+			want: networkEndpointGroup(func(n *compute.NetworkEndpointGroup) {
+				n.Region = ""
+				n.Zone = "us-central1-a"
+				n.NetworkEndpointType = v1alpha1.NetworkEndpointTypeNonGCPPrivateIPPort
+				n.DefaultPort = 443
+				n.Network = "global/networks/default"
+				n.CloudRun = nil
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &compute.NetworkEndpointGroup{}
+			GenerateNetworkEndpointGroup(testName, tc.in, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateNetworkEndpointGroup(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffNetworkEndpoints(t *testing.T) {
+	type args struct {
+		defaultPort int64
+		desired     []*compute.NetworkEndpoint
+		observed    []*compute.NetworkEndpoint
+	}
+	type want struct {
+		attach []*compute.NetworkEndpoint
+		detach []*compute.NetworkEndpoint
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoDiff": {
+			args: args{
+				defaultPort: 443,
+				desired:     []*compute.NetworkEndpoint{{IpAddress: "10.0.0.1"}},
+				observed:    []*compute.NetworkEndpoint{{IpAddress: "10.0.0.1", Port: 443}},
+			},
+			want: want{},
+		},
+		"QualifiedInstance": {
+			args: args{
+				desired:  []*compute.NetworkEndpoint{{Instance: "vm-1", IpAddress: "10.0.0.1", Port: 80}},
+				observed: []*compute.NetworkEndpoint{{Instance: "https://www.googleapis.com/compute/v1/projects/p/zones/z/instances/vm-1", IpAddress: "10.0.0.1", Port: 80}},
+			},
+			want: want{},
+		},
+		"AttachAndDetach": {
+			args: args{
+				defaultPort: 443,
+				desired:     []*compute.NetworkEndpoint{{IpAddress: "10.0.0.1"}, {IpAddress: "10.0.0.2", Port: 8443}},
+				observed:    []*compute.NetworkEndpoint{{IpAddress: "10.0.0.1", Port: 443}, {IpAddress: "10.0.0.3", Port: 443}},
+			},
+			want: want{
+				attach: []*compute.NetworkEndpoint{{IpAddress: "10.0.0.2", Port: 8443}},
+				detach: []*compute.NetworkEndpoint{{IpAddress: "10.0.0.3", Port: 443}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffNetworkEndpoints(tc.args.defaultPort, tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.attach, attach); diff != "" {
+				t.Errorf("DiffNetworkEndpoints(...): -want attach, +got attach:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detach, detach); diff != "" {
+				t.Errorf("DiffNetworkEndpoints(...): -want detach, +got detach:\n%s", diff)
+			}
+		})
+	}
+}
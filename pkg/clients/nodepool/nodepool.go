@@ -98,6 +98,16 @@ func GenerateConfig(in *v1beta1.NodeConfig, pool *container.NodePool) { // nolin
 		pool.Config.ServiceAccount = gcp.StringValue(in.ServiceAccount)
 		pool.Config.Tags = in.Tags
 
+		if in.ConfidentialNodes != nil {
+			pool.Config.ConfidentialNodes = &container.ConfidentialNodes{Enabled: in.ConfidentialNodes.Enabled}
+		}
+		if in.GcfsConfig != nil {
+			pool.Config.GcfsConfig = &container.GcfsConfig{Enabled: in.GcfsConfig.Enabled}
+		}
+		if in.Gvnic != nil {
+			pool.Config.Gvnic = &container.VirtualNIC{Enabled: in.Gvnic.Enabled}
+		}
+
 		if len(in.Accelerators) > 0 {
 			pool.Config.Accelerators = make([]*container.AcceleratorConfig, len(in.Accelerators))
 		}
@@ -256,6 +266,50 @@ func GenerateNodePoolUpdate(in *v1beta1.NodePoolParameters) *container.UpdateNod
 	return o
 }
 
+// GenerateNodeMetadataUpdate produces an UpdateNodePoolRequest that replaces
+// the Kubernetes labels and taints and the network tags of the nodes of a node
+// pool with those in the supplied NodeConfig. Labels and taints that GKE adds
+// for sandboxed node pools are kept.
+func GenerateNodeMetadataUpdate(in *v1beta1.NodeConfig, observed *container.NodeConfig) *container.UpdateNodePoolRequest {
+	pool := &container.NodePool{}
+	GenerateConfig(in, pool)
+	desired := pool.Config
+	if desired == nil {
+		desired = &container.NodeConfig{}
+	}
+	if observed == nil {
+		observed = &container.NodeConfig{}
+	}
+
+	labels := map[string]string{}
+	for k, v := range desired.Labels {
+		labels[k] = v
+	}
+	if v, ok := observed.Labels[runtimeKey]; ok {
+		labels[runtimeKey] = v
+	}
+
+	taints := make([]*container.NodeTaint, 0, len(desired.Taints))
+	for _, t := range desired.Taints {
+		if t != nil && t.Key != runtimeKey {
+			taints = append(taints, t)
+		}
+	}
+	for _, t := range observed.Taints {
+		if t != nil && t.Key == runtimeKey {
+			taints = append(taints, t)
+		}
+	}
+
+	// The labels, taints and tags replace the existing ones, so they are sent
+	// even if they are empty in order to remove all of them.
+	return &container.UpdateNodePoolRequest{
+		Labels: &container.NodeLabels{Labels: labels, ForceSendFields: []string{"Labels"}},
+		Taints: &container.NodeTaints{Taints: taints, ForceSendFields: []string{"Taints"}},
+		Tags:   &container.NetworkTags{Tags: desired.Tags, ForceSendFields: []string{"Tags"}},
+	}
+}
+
 // LateInitializeSpec fills unassigned fields with the values in container.NodePool object.
 func LateInitializeSpec(spec *v1beta1.NodePoolParameters, in container.NodePool) { // nolint:gocyclo
 	if in.Autoscaling != nil {
@@ -285,8 +339,17 @@ func LateInitializeSpec(spec *v1beta1.NodePoolParameters, in container.NodePool)
 		}
 
 		spec.Config.BootDiskKmsKey = gcp.LateInitializeString(spec.Config.BootDiskKmsKey, in.Config.BootDiskKmsKey)
+		if in.Config.ConfidentialNodes != nil && spec.Config.ConfidentialNodes == nil {
+			spec.Config.ConfidentialNodes = &v1beta2.ConfidentialNodes{Enabled: in.Config.ConfidentialNodes.Enabled}
+		}
 		spec.Config.DiskSizeGb = gcp.LateInitializeInt64(spec.Config.DiskSizeGb, in.Config.DiskSizeGb)
 		spec.Config.DiskType = gcp.LateInitializeString(spec.Config.DiskType, in.Config.DiskType)
+		if in.Config.GcfsConfig != nil && spec.Config.GcfsConfig == nil {
+			spec.Config.GcfsConfig = &v1beta2.GcfsConfig{Enabled: in.Config.GcfsConfig.Enabled}
+		}
+		if in.Config.Gvnic != nil && spec.Config.Gvnic == nil {
+			spec.Config.Gvnic = &v1beta2.VirtualNIC{Enabled: in.Config.Gvnic.Enabled}
+		}
 		spec.Config.ImageType = gcp.LateInitializeString(spec.Config.ImageType, in.Config.ImageType)
 		spec.Config.Labels = gcp.LateInitializeStringMap(spec.Config.Labels, in.Config.Labels)
 		spec.Config.LocalSsdCount = gcp.LateInitializeInt64(spec.Config.LocalSsdCount, in.Config.LocalSsdCount)
@@ -410,6 +473,36 @@ func newManagementUpdateFn(in *v1beta1.NodeManagementSpec) UpdateFn {
 	}
 }
 
+// newNodeMetadataUpdateFn returns a function that updates the labels, taints
+// and network tags of the nodes of a node pool in place.
+func newNodeMetadataUpdateFn(in *v1beta1.NodeConfig, observed *container.NodeConfig) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		return s.Projects.Locations.Clusters.NodePools.Update(name, GenerateNodeMetadataUpdate(in, observed)).Context(ctx).Do()
+	}
+}
+
+// newGcfsConfigUpdateFn returns a function that turns image streaming of a
+// node pool on or off.
+func newGcfsConfigUpdateFn(in *v1beta2.GcfsConfig) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		update := &container.UpdateNodePoolRequest{
+			GcfsConfig: &container.GcfsConfig{Enabled: in.Enabled, ForceSendFields: []string{"Enabled"}},
+		}
+		return s.Projects.Locations.Clusters.NodePools.Update(name, update).Context(ctx).Do()
+	}
+}
+
+// newGvnicUpdateFn returns a function that turns gVNIC of a node pool on or
+// off. GKE recreates the nodes of the node pool to apply the change.
+func newGvnicUpdateFn(in *v1beta2.VirtualNIC) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		update := &container.UpdateNodePoolRequest{
+			Gvnic: &container.VirtualNIC{Enabled: in.Enabled, ForceSendFields: []string{"Enabled"}},
+		}
+		return s.Projects.Locations.Clusters.NodePools.Update(name, update).Context(ctx).Do()
+	}
+}
+
 // newGeneralUpdateFn returns a function that updates a node pool.
 func newGeneralUpdateFn(in *v1beta1.NodePoolParameters) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -443,18 +536,61 @@ func IsUpToDate(name string, in *v1beta1.NodePoolParameters, observed *container
 		return false, newManagementUpdateFn(in.Management), nil
 	}
 
+	if !isNodeMetadataUpToDate(desired.Config, observed.Config) {
+		return false, newNodeMetadataUpdateFn(in.Config, observed.Config), nil
+	}
+
+	if in.Config != nil {
+		oc := observed.Config
+		if oc == nil {
+			oc = &container.NodeConfig{}
+		}
+		if in.Config.GcfsConfig != nil && !cmp.Equal(desired.Config.GcfsConfig, oc.GcfsConfig, cmpopts.EquateEmpty()) {
+			return false, newGcfsConfigUpdateFn(in.Config.GcfsConfig), nil
+		}
+		if in.Config.Gvnic != nil && !cmp.Equal(desired.Config.Gvnic, oc.Gvnic, cmpopts.EquateEmpty()) {
+			return false, newGvnicUpdateFn(in.Config.Gvnic), nil
+		}
+	}
+
+	// Confidential nodes cannot be changed once a node pool exists, so a
+	// difference is not something an update could resolve.
 	// TODO(hasheddan): remove manual ignore functions when resolution is
 	// reached on https://github.com/crossplane/crossplane-runtime/issues/120
-	if !cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreSliceElements(func(c *container.NodeTaint) bool {
-		return c.Key == runtimeKey
-	}), cmpopts.IgnoreMapEntries(func(key, _ string) bool {
-		return key == runtimeKey
-	}), cmp.Comparer(strings.EqualFold)) {
+	if !cmp.Equal(desired, observed, cmpopts.EquateEmpty(), ignoreRuntime(), cmp.Comparer(strings.EqualFold),
+		cmpopts.IgnoreFields(container.NodeConfig{}, "ConfidentialNodes")) {
 		return false, newGeneralUpdateFn(in), nil
 	}
 	return true, noOpUpdate, nil
 }
 
+// isNodeMetadataUpToDate returns whether the labels, taints and network tags
+// of the supplied desired and observed NodeConfigs are equal.
+func isNodeMetadataUpToDate(desired, observed *container.NodeConfig) bool {
+	if desired == nil {
+		desired = &container.NodeConfig{}
+	}
+	if observed == nil {
+		observed = &container.NodeConfig{}
+	}
+	return cmp.Equal(desired.Labels, observed.Labels, cmpopts.EquateEmpty(), ignoreRuntime()) &&
+		cmp.Equal(desired.Taints, observed.Taints, cmpopts.EquateEmpty(), ignoreRuntime()) &&
+		cmp.Equal(desired.Tags, observed.Tags, cmpopts.EquateEmpty())
+}
+
+// ignoreRuntime ignores the labels and taints that GKE adds to the nodes of
+// sandboxed node pools.
+func ignoreRuntime() cmp.Option {
+	return cmp.Options{
+		cmpopts.IgnoreSliceElements(func(c *container.NodeTaint) bool {
+			return c.Key == runtimeKey
+		}),
+		cmpopts.IgnoreMapEntries(func(key, _ string) bool {
+			return key == runtimeKey
+		}),
+	}
+}
+
 // GetFullyQualifiedName builds the fully qualified name of the cluster.
 func GetFullyQualifiedName(p v1beta1.NodePoolParameters, name string) string {
 	// Zonal clusters use /zones/ in their path instead of /locations/. We
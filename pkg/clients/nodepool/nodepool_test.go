@@ -361,17 +361,20 @@ func TestGenerateConfig(t *testing.T) {
 				nodePool: &container.NodePool{},
 				params: params(func(p *v1beta1.NodePoolParameters) {
 					p.Config = &v1beta1.NodeConfig{
-						Accelerators:   []*v1beta1.AcceleratorConfig{accConf},
-						DiskSizeGb:     gcp.Int64Ptr(diskSizeGb),
-						DiskType:       gcp.StringPtr(diskType),
-						ImageType:      gcp.StringPtr(imageType),
-						Labels:         labels,
-						LocalSsdCount:  gcp.Int64Ptr(localSsdCount),
-						MachineType:    gcp.StringPtr(machineType),
-						Metadata:       metadata,
-						MinCPUPlatform: gcp.StringPtr(minCPUPlatform),
-						OauthScopes:    oauthScopes,
-						Preemptible:    gcp.BoolPtr(preemptible),
+						Accelerators:      []*v1beta1.AcceleratorConfig{accConf},
+						ConfidentialNodes: &v1beta2.ConfidentialNodes{Enabled: true},
+						DiskSizeGb:        gcp.Int64Ptr(diskSizeGb),
+						GcfsConfig:        &v1beta2.GcfsConfig{Enabled: true},
+						Gvnic:             &v1beta2.VirtualNIC{Enabled: true},
+						DiskType:          gcp.StringPtr(diskType),
+						ImageType:         gcp.StringPtr(imageType),
+						Labels:            labels,
+						LocalSsdCount:     gcp.Int64Ptr(localSsdCount),
+						MachineType:       gcp.StringPtr(machineType),
+						Metadata:          metadata,
+						MinCPUPlatform:    gcp.StringPtr(minCPUPlatform),
+						OauthScopes:       oauthScopes,
+						Preemptible:       gcp.BoolPtr(preemptible),
 						SandboxConfig: &v1beta1.SandboxConfig{
 							Type: "gvisor",
 						},
@@ -388,17 +391,20 @@ func TestGenerateConfig(t *testing.T) {
 			},
 			want: nodePool(func(n *container.NodePool) {
 				n.Config = &container.NodeConfig{
-					Accelerators:   []*container.AcceleratorConfig{gcpAccConf},
-					DiskSizeGb:     diskSizeGb,
-					DiskType:       diskType,
-					ImageType:      imageType,
-					Labels:         labels,
-					LocalSsdCount:  localSsdCount,
-					MachineType:    machineType,
-					Metadata:       metadata,
-					MinCpuPlatform: minCPUPlatform,
-					OauthScopes:    oauthScopes,
-					Preemptible:    preemptible,
+					Accelerators:      []*container.AcceleratorConfig{gcpAccConf},
+					ConfidentialNodes: &container.ConfidentialNodes{Enabled: true},
+					DiskSizeGb:        diskSizeGb,
+					GcfsConfig:        &container.GcfsConfig{Enabled: true},
+					Gvnic:             &container.VirtualNIC{Enabled: true},
+					DiskType:          diskType,
+					ImageType:         imageType,
+					Labels:            labels,
+					LocalSsdCount:     localSsdCount,
+					MachineType:       machineType,
+					Metadata:          metadata,
+					MinCpuPlatform:    minCPUPlatform,
+					OauthScopes:       oauthScopes,
+					Preemptible:       preemptible,
 					SandboxConfig: &container.SandboxConfig{
 						Type: "gvisor",
 					},
@@ -557,6 +563,29 @@ func TestLateInitializeSpec(t *testing.T) {
 				}),
 			},
 		},
+		"NodeFeaturesFilled": {
+			args: args{
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{
+						ConfidentialNodes: &container.ConfidentialNodes{Enabled: true},
+						GcfsConfig:        &container.GcfsConfig{Enabled: true},
+						Gvnic:             &container.VirtualNIC{Enabled: true},
+					}
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.Config = &v1beta1.NodeConfig{Gvnic: &v1beta2.VirtualNIC{Enabled: false}}
+				}),
+			},
+			want: want{
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.Config = &v1beta1.NodeConfig{
+						ConfidentialNodes: &v1beta2.ConfidentialNodes{Enabled: true},
+						GcfsConfig:        &v1beta2.GcfsConfig{Enabled: true},
+						Gvnic:             &v1beta2.VirtualNIC{Enabled: false},
+					}
+				}),
+			},
+		},
 		"NoneFilled": {
 			args: args{
 				nodePool: nodePool(),
@@ -675,6 +704,68 @@ func TestIsUpToDate(t *testing.T) {
 				isErr:    false,
 			},
 		},
+		"NeedsGcfsConfigUpdate": {
+			args: args{
+				name: name,
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{GcfsConfig: &container.GcfsConfig{Enabled: false}}
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.Config = &v1beta1.NodeConfig{GcfsConfig: &v1beta2.GcfsConfig{Enabled: true}}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"NeedsGvnicUpdate": {
+			args: args{
+				name:     name,
+				nodePool: nodePool(),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.Config = &v1beta1.NodeConfig{Gvnic: &v1beta2.VirtualNIC{Enabled: true}}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"UpToDateIgnoreConfidentialNodes": {
+			args: args{
+				name: name,
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{ConfidentialNodes: &container.ConfidentialNodes{Enabled: false}}
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.Config = &v1beta1.NodeConfig{ConfidentialNodes: &v1beta2.ConfidentialNodes{Enabled: true}}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsNodeMetadataUpdate": {
+			args: args{
+				name: name,
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{
+						Labels: map[string]string{"cool-key": "cool-value"},
+					}
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.Config = &v1beta1.NodeConfig{
+						Labels: map[string]string{"cool-key": "cooler-value"},
+					}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -689,6 +780,67 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestGenerateNodeMetadataUpdate(t *testing.T) {
+	type args struct {
+		in       *v1beta1.NodeConfig
+		observed *container.NodeConfig
+	}
+	tests := map[string]struct {
+		args args
+		want *container.UpdateNodePoolRequest
+	}{
+		"RemoveAll": {
+			args: args{
+				observed: &container.NodeConfig{
+					Labels: map[string]string{"cool-key": "cool-value"},
+					Taints: []*container.NodeTaint{{Key: "cool-key", Value: "cool-value", Effect: "NO_SCHEDULE"}},
+					Tags:   []string{"cool-tag"},
+				},
+			},
+			want: &container.UpdateNodePoolRequest{
+				Labels: &container.NodeLabels{Labels: map[string]string{}, ForceSendFields: []string{"Labels"}},
+				Taints: &container.NodeTaints{Taints: []*container.NodeTaint{}, ForceSendFields: []string{"Taints"}},
+				Tags:   &container.NetworkTags{ForceSendFields: []string{"Tags"}},
+			},
+		},
+		"KeepGVisor": {
+			args: args{
+				in: &v1beta1.NodeConfig{
+					Labels: map[string]string{"cool-key": "cooler-value"},
+					Taints: []*v1beta1.NodeTaint{{Key: "cool-key", Value: "cooler-value", Effect: "NO_SCHEDULE"}},
+					Tags:   []string{"cooler-tag"},
+				},
+				observed: &container.NodeConfig{
+					Labels: map[string]string{"cool-key": "cool-value", runtimeKey: "gvisor"},
+					Taints: []*container.NodeTaint{{Key: runtimeKey, Value: "gvisor", Effect: "NO_SCHEDULE"}},
+				},
+			},
+			want: &container.UpdateNodePoolRequest{
+				Labels: &container.NodeLabels{
+					Labels:          map[string]string{"cool-key": "cooler-value", runtimeKey: "gvisor"},
+					ForceSendFields: []string{"Labels"},
+				},
+				Taints: &container.NodeTaints{
+					Taints: []*container.NodeTaint{
+						{Key: "cool-key", Value: "cooler-value", Effect: "NO_SCHEDULE"},
+						{Key: runtimeKey, Value: "gvisor", Effect: "NO_SCHEDULE"},
+					},
+					ForceSendFields: []string{"Taints"},
+				},
+				Tags: &container.NetworkTags{Tags: []string{"cooler-tag"}, ForceSendFields: []string{"Tags"}},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateNodeMetadataUpdate(tc.args.in, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateNodeMetadataUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGetFullyQualifiedName(t *testing.T) {
 	type args struct {
 		params v1beta1.NodePoolParameters
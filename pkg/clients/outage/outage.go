@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package outage distinguishes errors caused by an outage of the region or
+// zone a managed resource lives in from other errors, so that operators can
+// tell Google Cloud incidents apart from problems with the provider.
+package outage
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+)
+
+// ReasonRegionalOutage indicates that a managed resource could not be
+// reconciled because its region or zone is unavailable.
+const ReasonRegionalOutage xpv1.ConditionReason = "RegionalOutage"
+
+const errFmtOutage = "%s is unavailable, backing off"
+
+// ObserveBackoff bounds how often an observation that failed because of an
+// outage is retried before the error is returned. Only observations are
+// retried because they have no side effects.
+var ObserveBackoff = wait.Backoff{
+	Steps:    3,
+	Duration: 200 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+var outageErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "provider_gcp_regional_outage_errors_total",
+	Help: "Number of external calls of a controller that failed because a region or zone was unavailable.",
+}, []string{"controller", "location"})
+
+func init() {
+	metrics.Registry.MustRegister(outageErrors)
+}
+
+// RegionalOutage returns a condition indicating that the managed resource
+// could not be reconciled because the supplied location is unavailable.
+func RegionalOutage(location string, err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRegionalOutage,
+		Message:            errors.Wrapf(err, errFmtOutage, location).Error(),
+	}
+}
+
+// LocationOf returns the zone or, failing that, the region of the supplied
+// managed resource. It returns an empty string for global resources.
+func LocationOf(mg resource.Managed) string {
+	l := catalog.LocationOf(mg)
+	switch {
+	case l.Zone != "":
+		return l.Zone
+	case l.Region != "":
+		return l.Region
+	}
+	return l.Location
+}
+
+// WrapConnecter returns an ExternalConnecter whose external clients report
+// "service unavailable" errors of regional and zonal resources with a
+// RegionalOutage condition. The managed reconciler requeues the resource with
+// its usual exponential backoff.
+func WrapConnecter(name string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{name: name, wrapped: c}
+}
+
+type connecter struct {
+	name    string
+	wrapped managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.wrapped.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{name: c.name, wrapped: e}, nil
+}
+
+type external struct {
+	name    string
+	wrapped managed.ExternalClient
+}
+
+// classify sets a RegionalOutage condition on the supplied managed resource
+// if the supplied error was caused by an outage of its region or zone.
+func (e *external) classify(mg resource.Managed, err error) error {
+	if !gcp.IsErrorUnavailable(err) {
+		return err
+	}
+	loc := LocationOf(mg)
+	if loc == "" {
+		return err
+	}
+	outageErrors.WithLabelValues(e.name, loc).Inc()
+	mg.SetConditions(RegionalOutage(loc, err))
+	return errors.Wrapf(err, errFmtOutage, loc)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	var o managed.ExternalObservation
+	err := retry.OnError(ObserveBackoff, gcp.IsErrorUnavailable, func() error {
+		var err error
+		o, err = e.wrapped.Observe(ctx, mg)
+		return err
+	})
+	return o, e.classify(mg, err)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.wrapped.Create(ctx, mg)
+	return c, e.classify(mg, err)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.wrapped.Update(ctx, mg)
+	return u, e.classify(mg, err)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	return e.classify(mg, e.wrapped.Delete(ctx, mg))
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+)
+
+func TestExternal(t *testing.T) {
+	errUnavailable := &googleapi.Error{Code: http.StatusServiceUnavailable}
+	errBoom := errors.New("boom")
+
+	type want struct {
+		err   error
+		calls int
+		cond  *xpv1.Condition
+	}
+	cases := map[string]struct {
+		mg   resource.Managed
+		errs []error
+		want want
+	}{
+		"Success": {
+			mg:   &v1beta1.Address{Spec: v1beta1.AddressSpec{ForProvider: v1beta1.AddressParameters{Region: "us-central1"}}},
+			errs: []error{nil},
+			want: want{calls: 1},
+		},
+		"OtherError": {
+			mg:   &v1beta1.Address{Spec: v1beta1.AddressSpec{ForProvider: v1beta1.AddressParameters{Region: "us-central1"}}},
+			errs: []error{errBoom},
+			want: want{err: errBoom, calls: 1},
+		},
+		"RecoveredOnRetry": {
+			mg:   &v1beta1.Address{Spec: v1beta1.AddressSpec{ForProvider: v1beta1.AddressParameters{Region: "us-central1"}}},
+			errs: []error{errUnavailable, nil},
+			want: want{calls: 2},
+		},
+		"RegionalOutage": {
+			mg:   &v1beta1.Address{Spec: v1beta1.AddressSpec{ForProvider: v1beta1.AddressParameters{Region: "us-central1"}}},
+			errs: []error{errUnavailable, errUnavailable},
+			want: want{
+				err:   errors.Wrapf(errUnavailable, errFmtOutage, "us-central1"),
+				calls: 2,
+				cond:  func() *xpv1.Condition { c := RegionalOutage("us-central1", errUnavailable); return &c }(),
+			},
+		},
+		"ZonalOutage": {
+			mg: &v1alpha1.ImageImport{Spec: v1alpha1.ImageImportSpec{ForProvider: v1alpha1.ImageImportParameters{
+				Zone: func() *string { z := "us-central1-a"; return &z }(),
+			}}},
+			errs: []error{errUnavailable, errUnavailable},
+			want: want{
+				err:   errors.Wrapf(errUnavailable, errFmtOutage, "us-central1-a"),
+				calls: 2,
+				cond:  func() *xpv1.Condition { c := RegionalOutage("us-central1-a", errUnavailable); return &c }(),
+			},
+		},
+		"GlobalResource": {
+			mg:   &v1beta1.Network{},
+			errs: []error{errUnavailable, errUnavailable},
+			want: want{err: errUnavailable, calls: 2},
+		},
+	}
+
+	defer func(b wait.Backoff) { ObserveBackoff = b }(ObserveBackoff)
+	ObserveBackoff = wait.Backoff{Steps: 2}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						err := tc.errs[calls]
+						calls++
+						return managed.ExternalObservation{}, err
+					},
+				}, nil
+			})
+
+			e, err := WrapConnecter("test", c).Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect(...): unexpected error: %s", err)
+			}
+			_, err = e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Observe(...): -want calls, +got calls:\n%s", diff)
+			}
+			if tc.want.cond == nil {
+				if c := tc.mg.GetCondition(xpv1.TypeReady); c.Reason == ReasonRegionalOutage {
+					t.Errorf("Observe(...): unexpected %s condition", ReasonRegionalOutage)
+				}
+				return
+			}
+			if diff := cmp.Diff(*tc.want.cond, tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packetmirroring
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+
+	enableTrue  = "TRUE"
+	enableFalse = "FALSE"
+)
+
+// GeneratePacketMirroring takes a PacketMirroringParameters and populates the
+// supplied *compute.PacketMirroring. It assigns only the fields that are
+// writable, i.e. not labelled as [Output Only] in Google's reference.
+func GeneratePacketMirroring(name string, in v1alpha1.PacketMirroringParameters, pm *compute.PacketMirroring) {
+	pm.Name = name
+	pm.Description = gcp.StringValue(in.Description)
+	pm.Priority = gcp.Int64Value(in.Priority)
+	pm.Enable = ""
+	if in.Enable != nil {
+		pm.Enable = enableFalse
+		if *in.Enable {
+			pm.Enable = enableTrue
+		}
+	}
+	pm.Network = nil
+	if in.Network != nil {
+		pm.Network = &compute.PacketMirroringNetworkInfo{Url: *in.Network}
+	}
+	pm.CollectorIlb = nil
+	if in.CollectorILB != nil {
+		pm.CollectorIlb = &compute.PacketMirroringForwardingRuleInfo{Url: *in.CollectorILB}
+	}
+
+	// Mirrored resources are sent in full so that resources removed from
+	// the spec stop being mirrored.
+	mr := &compute.PacketMirroringMirroredResourceInfo{
+		Tags:            in.MirroredResources.Tags,
+		ForceSendFields: []string{"Instances", "Subnetworks", "Tags"},
+	}
+	for _, s := range in.MirroredResources.Subnetworks {
+		mr.Subnetworks = append(mr.Subnetworks, &compute.PacketMirroringMirroredResourceInfoSubnetInfo{Url: s})
+	}
+	for _, i := range in.MirroredResources.Instances {
+		mr.Instances = append(mr.Instances, &compute.PacketMirroringMirroredResourceInfoInstanceInfo{Url: i})
+	}
+	pm.MirroredResources = mr
+
+	pm.Filter = nil
+	if in.Filter != nil {
+		pm.Filter = &compute.PacketMirroringFilter{
+			IPProtocols:     in.Filter.IPProtocols,
+			CidrRanges:      in.Filter.CIDRRanges,
+			Direction:       gcp.StringValue(in.Filter.Direction),
+			ForceSendFields: []string{"IPProtocols", "CidrRanges"},
+		}
+	}
+	if in.Priority != nil {
+		// Zero is the highest priority.
+		pm.ForceSendFields = []string{"Priority"}
+	}
+}
+
+// GeneratePacketMirroringObservation takes a compute.PacketMirroring and
+// returns *PacketMirroringObservation.
+func GeneratePacketMirroringObservation(in compute.PacketMirroring) v1alpha1.PacketMirroringObservation {
+	return v1alpha1.PacketMirroringObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.PacketMirroring object.
+func LateInitializeSpec(spec *v1alpha1.PacketMirroringParameters, in compute.PacketMirroring) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Priority = gcp.LateInitializeInt64(spec.Priority, in.Priority)
+	if spec.Enable == nil && in.Enable != "" {
+		spec.Enable = gcp.BoolPtr(in.Enable == enableTrue)
+	}
+	if in.Filter != nil && in.Filter.Direction != "" {
+		if spec.Filter == nil {
+			spec.Filter = &v1alpha1.PacketMirroringFilter{}
+		}
+		spec.Filter.Direction = gcp.LateInitializeString(spec.Filter.Direction, in.Filter.Direction)
+	}
+}
+
+// IsUpToDate returns true if the supplied Kubernetes resource does not differ
+// from the supplied GCP resource.
+func IsUpToDate(name string, in *v1alpha1.PacketMirroringParameters, observed *compute.PacketMirroring) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.PacketMirroring)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GeneratePacketMirroring(name, *in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.PacketMirroring{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.PacketMirroringFilter{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.PacketMirroringMirroredResourceInfo{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.PacketMirroringNetworkInfo{}, "CanonicalUrl", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.PacketMirroringForwardingRuleInfo{}, "CanonicalUrl", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.PacketMirroringMirroredResourceInfoSubnetInfo{}, "CanonicalUrl", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.PacketMirroringMirroredResourceInfoInstanceInfo{}, "CanonicalUrl", "ForceSendFields", "NullFields")), nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packetmirroring
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName      = "test-mirroring"
+	testNetwork   = "projects/cool-project/global/networks/test-network"
+	testCollector = "projects/cool-project/regions/us-central1/forwardingRules/ids-ilb"
+	testSubnet    = "projects/cool-project/regions/us-central1/subnetworks/test-subnet"
+	testURIPrefix = "https://www.googleapis.com/compute/v1/"
+)
+
+func params(m ...func(*v1alpha1.PacketMirroringParameters)) *v1alpha1.PacketMirroringParameters {
+	p := &v1alpha1.PacketMirroringParameters{
+		Region:       "us-central1",
+		Description:  gcp.StringPtr("mirror to ids"),
+		Network:      gcp.StringPtr(testNetwork),
+		CollectorILB: gcp.StringPtr(testCollector),
+		MirroredResources: v1alpha1.PacketMirroringMirroredResources{
+			Subnetworks: []string{testSubnet},
+			Tags:        []string{"mirrored"},
+		},
+		Filter: &v1alpha1.PacketMirroringFilter{
+			IPProtocols: []string{"tcp"},
+			Direction:   gcp.StringPtr("BOTH"),
+		},
+		Enable:   gcp.BoolPtr(true),
+		Priority: gcp.Int64Ptr(0),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func packetMirroring(m ...func(*compute.PacketMirroring)) *compute.PacketMirroring {
+	pm := &compute.PacketMirroring{
+		Name:         testName,
+		Description:  "mirror to ids",
+		Network:      &compute.PacketMirroringNetworkInfo{Url: testNetwork},
+		CollectorIlb: &compute.PacketMirroringForwardingRuleInfo{Url: testCollector},
+		MirroredResources: &compute.PacketMirroringMirroredResourceInfo{
+			Subnetworks:     []*compute.PacketMirroringMirroredResourceInfoSubnetInfo{{Url: testSubnet}},
+			Tags:            []string{"mirrored"},
+			ForceSendFields: []string{"Instances", "Subnetworks", "Tags"},
+		},
+		Filter: &compute.PacketMirroringFilter{
+			IPProtocols:     []string{"tcp"},
+			Direction:       "BOTH",
+			ForceSendFields: []string{"IPProtocols", "CidrRanges"},
+		},
+		Enable:          "TRUE",
+		Priority:        0,
+		ForceSendFields: []string{"Priority"},
+	}
+	for _, f := range m {
+		f(pm)
+	}
+	return pm
+}
+
+func TestGeneratePacketMirroring(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.PacketMirroringParameters
+		want *compute.PacketMirroring
+	}{
+		"Full": {
+			in:   params(),
+			want: packetMirroring(),
+		},
+		"Minimal": {
+			in: &v1alpha1.PacketMirroringParameters{
+				Region:       "us-central1",
+				CollectorILB: gcp.StringPtr(testCollector),
+			},
+			want: &compute.PacketMirroring{
+				Name:         testName,
+				CollectorIlb: &compute.PacketMirroringForwardingRuleInfo{Url: testCollector},
+				MirroredResources: &compute.PacketMirroringMirroredResourceInfo{
+					ForceSendFields: []string{"Instances", "Subnetworks", "Tags"},
+				},
+			},
+		},
+		"Disabled": {
+			in:   params(func(p *v1alpha1.PacketMirroringParameters) { p.Enable = gcp.BoolPtr(false) }),
+			want: packetMirroring(func(pm *compute.PacketMirroring) { pm.Enable = "FALSE" }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &compute.PacketMirroring{}
+			GeneratePacketMirroring(testName, *tc.in, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GeneratePacketMirroring(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.PacketMirroringParameters
+		in   compute.PacketMirroring
+		want *v1alpha1.PacketMirroringParameters
+	}{
+		"Defaults": {
+			spec: &v1alpha1.PacketMirroringParameters{},
+			in: compute.PacketMirroring{
+				Enable:   "TRUE",
+				Priority: 1000,
+				Filter:   &compute.PacketMirroringFilter{Direction: "BOTH"},
+			},
+			want: &v1alpha1.PacketMirroringParameters{
+				Enable:   gcp.BoolPtr(true),
+				Priority: gcp.Int64Ptr(1000),
+				Filter:   &v1alpha1.PacketMirroringFilter{Direction: gcp.StringPtr("BOTH")},
+			},
+		},
+		"KeepSpecValues": {
+			spec: params(),
+			in: compute.PacketMirroring{
+				Description: "something else",
+				Enable:      "FALSE",
+				Priority:    1000,
+				Filter:      &compute.PacketMirroringFilter{Direction: "INGRESS"},
+			},
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.PacketMirroringParameters
+		observed *compute.PacketMirroring
+		want     bool
+	}{
+		"UpToDate": {
+			in: params(),
+			observed: packetMirroring(func(pm *compute.PacketMirroring) {
+				pm.Network = &compute.PacketMirroringNetworkInfo{Url: testURIPrefix + testNetwork, CanonicalUrl: "https://www.googleapis.com/compute/v1/projects/1234/global/networks/5678"}
+				pm.CollectorIlb = &compute.PacketMirroringForwardingRuleInfo{Url: testURIPrefix + testCollector}
+				pm.MirroredResources.Subnetworks = []*compute.PacketMirroringMirroredResourceInfoSubnetInfo{{Url: testURIPrefix + testSubnet}}
+				pm.SelfLink = testURIPrefix + "projects/cool-project/regions/us-central1/packetMirrorings/" + testName
+				pm.ForceSendFields = nil
+			}),
+			want: true,
+		},
+		"TagRemoved": {
+			in:       params(func(p *v1alpha1.PacketMirroringParameters) { p.MirroredResources.Tags = nil }),
+			observed: packetMirroring(),
+		},
+		"Disabled": {
+			in:       params(),
+			observed: packetMirroring(func(pm *compute.PacketMirroring) { pm.Enable = "FALSE" }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(testName, tc.in, tc.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
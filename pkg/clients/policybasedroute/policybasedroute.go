@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policybasedroute
+
+import (
+	"fmt"
+
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/global"
+	nameFormat   = "projects/%s/locations/global/policyBasedRoutes/%s"
+)
+
+// Client should be satisfied to conduct PolicyBasedRoute operations.
+type Client interface {
+	Create(parent string, policybasedroute *networkconnectivity.PolicyBasedRoute) *networkconnectivity.ProjectsLocationsGlobalPolicyBasedRoutesCreateCall
+	Get(name string) *networkconnectivity.ProjectsLocationsGlobalPolicyBasedRoutesGetCall
+	Delete(name string) *networkconnectivity.ProjectsLocationsGlobalPolicyBasedRoutesDeleteCall
+}
+
+// GetParent returns the parent of all policy-based routes of the supplied
+// project.
+func GetParent(project string) string {
+	return fmt.Sprintf(parentFormat, project)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a policy-based
+// route.
+func GetFullyQualifiedName(project, name string) string {
+	return fmt.Sprintf(nameFormat, project, name)
+}
+
+// GeneratePolicyBasedRoute converts the supplied PolicyBasedRouteParameters
+// into a PolicyBasedRoute suitable for use with the Google Cloud Network
+// Connectivity API. The PolicyBasedRoute API does not support updates, so any
+// nil pointer is safely converted to its zero value.
+func GeneratePolicyBasedRoute(in v1alpha1.PolicyBasedRouteParameters, route *networkconnectivity.PolicyBasedRoute) {
+	route.Description = gcp.StringValue(in.Description)
+	route.Labels = in.Labels
+	route.Network = gcp.StringValue(in.Network)
+	route.Priority = gcp.Int64Value(in.Priority)
+	route.NextHopIlbIp = gcp.StringValue(in.NextHopILBIP)
+	route.NextHopOtherRoutes = gcp.StringValue(in.NextHopOtherRoutes)
+	route.Filter = &networkconnectivity.Filter{
+		IpProtocol:      gcp.StringValue(in.Filter.IPProtocol),
+		SrcRange:        gcp.StringValue(in.Filter.SrcRange),
+		DestRange:       gcp.StringValue(in.Filter.DestRange),
+		ProtocolVersion: gcp.StringValue(in.Filter.ProtocolVersion),
+	}
+	if in.VirtualMachine != nil {
+		route.VirtualMachine = &networkconnectivity.VirtualMachine{Tags: in.VirtualMachine.Tags}
+	}
+	if in.InterconnectAttachment != nil {
+		route.InterconnectAttachment = &networkconnectivity.InterconnectAttachment{Region: in.InterconnectAttachment.Region}
+	}
+}
+
+// LateInitializeSpec updates any unset (i.e. nil) optional fields of the
+// supplied PolicyBasedRouteParameters that are set (i.e. non-zero) on the
+// supplied PolicyBasedRoute.
+func LateInitializeSpec(p *v1alpha1.PolicyBasedRouteParameters, observed networkconnectivity.PolicyBasedRoute) {
+	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, observed.Labels)
+	p.Network = gcp.LateInitializeString(p.Network, observed.Network)
+	p.Priority = gcp.LateInitializeInt64(p.Priority, observed.Priority)
+	if f := observed.Filter; f != nil {
+		p.Filter.IPProtocol = gcp.LateInitializeString(p.Filter.IPProtocol, f.IpProtocol)
+		p.Filter.SrcRange = gcp.LateInitializeString(p.Filter.SrcRange, f.SrcRange)
+		p.Filter.DestRange = gcp.LateInitializeString(p.Filter.DestRange, f.DestRange)
+		p.Filter.ProtocolVersion = gcp.LateInitializeString(p.Filter.ProtocolVersion, f.ProtocolVersion)
+	}
+}
+
+// GeneratePolicyBasedRouteObservation takes a
+// networkconnectivity.PolicyBasedRoute and returns
+// *PolicyBasedRouteObservation.
+func GeneratePolicyBasedRouteObservation(observed networkconnectivity.PolicyBasedRoute) v1alpha1.PolicyBasedRouteObservation {
+	o := v1alpha1.PolicyBasedRouteObservation{
+		CreateTime: observed.CreateTime,
+		UpdateTime: observed.UpdateTime,
+		SelfLink:   observed.SelfLink,
+	}
+	for _, w := range observed.Warnings {
+		o.Warnings = append(o.Warnings, w.Code+": "+w.WarningMessage)
+	}
+	return o
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policybasedroute
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+)
+
+var (
+	description        = "coolDescription"
+	labels             = map[string]string{"cool": "label"}
+	network            = "projects/coolProject/global/networks/coolNetwork"
+	priority     int64 = 100
+	nextHopILBIP       = "10.0.0.10"
+	ipProtocol         = "TCP"
+	srcRange           = "10.1.0.0/16"
+	destRange          = "0.0.0.0/0"
+	ipv4               = "IPV4"
+	tags               = []string{"appliance"}
+
+	timestamp = "coolTime"
+	link      = "coolLink"
+)
+
+func params(m ...func(*v1alpha1.PolicyBasedRouteParameters)) *v1alpha1.PolicyBasedRouteParameters {
+	o := &v1alpha1.PolicyBasedRouteParameters{
+		Description: &description,
+		Labels:      labels,
+		Network:     &network,
+		Priority:    &priority,
+		Filter: v1alpha1.PolicyBasedRouteFilter{
+			IPProtocol:      &ipProtocol,
+			SrcRange:        &srcRange,
+			DestRange:       &destRange,
+			ProtocolVersion: &ipv4,
+		},
+		VirtualMachine: &v1alpha1.PolicyBasedRouteVirtualMachine{Tags: tags},
+		NextHopILBIP:   &nextHopILBIP,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func policyBasedRoute(m ...func(*networkconnectivity.PolicyBasedRoute)) *networkconnectivity.PolicyBasedRoute {
+	o := &networkconnectivity.PolicyBasedRoute{
+		Description: description,
+		Labels:      labels,
+		Network:     network,
+		Priority:    priority,
+		Filter: &networkconnectivity.Filter{
+			IpProtocol:      ipProtocol,
+			SrcRange:        srcRange,
+			DestRange:       destRange,
+			ProtocolVersion: ipv4,
+		},
+		VirtualMachine: &networkconnectivity.VirtualMachine{Tags: tags},
+		NextHopIlbIp:   nextHopILBIP,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func addOutputFields(r *networkconnectivity.PolicyBasedRoute) {
+	r.CreateTime = timestamp
+	r.UpdateTime = timestamp
+	r.SelfLink = link
+}
+
+func observation(m ...func(*v1alpha1.PolicyBasedRouteObservation)) *v1alpha1.PolicyBasedRouteObservation {
+	o := &v1alpha1.PolicyBasedRouteObservation{
+		CreateTime: timestamp,
+		UpdateTime: timestamp,
+		SelfLink:   link,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGeneratePolicyBasedRoute(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.PolicyBasedRouteParameters
+		want *networkconnectivity.PolicyBasedRoute
+	}{
+		"AllFilled": {
+			in:   *params(),
+			want: policyBasedRoute(),
+		},
+		"OtherRoutesForInterconnects": {
+			in: *params(func(p *v1alpha1.PolicyBasedRouteParameters) {
+				other := "DEFAULT_ROUTING"
+				p.VirtualMachine = nil
+				p.InterconnectAttachment = &v1alpha1.PolicyBasedRouteInterconnectAttachment{Region: "all"}
+				p.NextHopILBIP = nil
+				p.NextHopOtherRoutes = &other
+			}),
+			want: policyBasedRoute(func(r *networkconnectivity.PolicyBasedRoute) {
+				r.VirtualMachine = nil
+				r.InterconnectAttachment = &networkconnectivity.InterconnectAttachment{Region: "all"}
+				r.NextHopIlbIp = ""
+				r.NextHopOtherRoutes = "DEFAULT_ROUTING"
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &networkconnectivity.PolicyBasedRoute{}
+			GeneratePolicyBasedRoute(tc.in, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GeneratePolicyBasedRoute(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePolicyBasedRouteObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  networkconnectivity.PolicyBasedRoute
+		out v1alpha1.PolicyBasedRouteObservation
+	}{
+		"AllFilled": {
+			in:  *policyBasedRoute(addOutputFields),
+			out: *observation(),
+		},
+		"Warnings": {
+			in: *policyBasedRoute(addOutputFields, func(r *networkconnectivity.PolicyBasedRoute) {
+				r.Warnings = []*networkconnectivity.Warnings{{Code: "RESOURCE_NOT_ACTIVE", WarningMessage: "next hop is not active"}}
+			}),
+			out: *observation(func(o *v1alpha1.PolicyBasedRouteObservation) {
+				o.Warnings = []string{"RESOURCE_NOT_ACTIVE: next hop is not active"}
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GeneratePolicyBasedRouteObservation(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GeneratePolicyBasedRouteObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.PolicyBasedRouteParameters
+		in   networkconnectivity.PolicyBasedRoute
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.PolicyBasedRouteParameters
+	}{
+		"AllFilledNoDiff": {
+			args: args{
+				spec: params(),
+				in:   *policyBasedRoute(),
+			},
+			want: params(),
+		},
+		"AllFilledExternalDiff": {
+			args: args{
+				spec: params(),
+				in: *policyBasedRoute(func(r *networkconnectivity.PolicyBasedRoute) {
+					r.Description = "some other description"
+				}),
+			},
+			want: params(),
+		},
+		"PartialFilled": {
+			args: args{
+				spec: params(func(p *v1alpha1.PolicyBasedRouteParameters) {
+					p.Priority = nil
+					p.Filter.SrcRange = nil
+				}),
+				in: *policyBasedRoute(),
+			},
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
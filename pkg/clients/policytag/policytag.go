@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policytag
+
+import (
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	nameInfix = "/policyTags/"
+
+	// UpdateMask lists the fields of a policy tag that are updated in place.
+	UpdateMask = "displayName,description,parentPolicyTag"
+)
+
+// Client should be satisfied to conduct PolicyTag operations.
+type Client interface {
+	Create(parent string, tag *datacatalog.GoogleCloudDatacatalogV1PolicyTag) *datacatalog.ProjectsLocationsTaxonomiesPolicyTagsCreateCall
+	Get(name string) *datacatalog.ProjectsLocationsTaxonomiesPolicyTagsGetCall
+	Patch(name string, tag *datacatalog.GoogleCloudDatacatalogV1PolicyTag) *datacatalog.ProjectsLocationsTaxonomiesPolicyTagsPatchCall
+	Delete(name string) *datacatalog.ProjectsLocationsTaxonomiesPolicyTagsDeleteCall
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a policy tag of
+// the supplied taxonomy.
+func GetFullyQualifiedName(taxonomy, id string) string {
+	return taxonomy + nameInfix + id
+}
+
+// GetID returns the ID Data Catalog assigned to the policy tag with the
+// supplied fully qualified name.
+func GetID(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// GeneratePolicyTag generates *datacatalog.GoogleCloudDatacatalogV1PolicyTag
+// instance from PolicyTagParameters.
+func GeneratePolicyTag(in v1alpha1.PolicyTagParameters) *datacatalog.GoogleCloudDatacatalogV1PolicyTag {
+	return &datacatalog.GoogleCloudDatacatalogV1PolicyTag{
+		DisplayName:     in.DisplayName,
+		Description:     gcp.StringValue(in.Description),
+		ParentPolicyTag: gcp.StringValue(in.ParentPolicyTag),
+	}
+}
+
+// GenerateObservation produces PolicyTagObservation object from
+// datacatalog.GoogleCloudDatacatalogV1PolicyTag object.
+func GenerateObservation(in datacatalog.GoogleCloudDatacatalogV1PolicyTag) v1alpha1.PolicyTagObservation {
+	return v1alpha1.PolicyTagObservation{
+		Name:            in.Name,
+		ChildPolicyTags: in.ChildPolicyTags,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// datacatalog.GoogleCloudDatacatalogV1PolicyTag object. The parent policy tag
+// is not late initialized so that a policy tag can be moved to the top of the
+// hierarchy by removing it.
+func LateInitializeSpec(spec *v1alpha1.PolicyTagParameters, in datacatalog.GoogleCloudDatacatalogV1PolicyTag) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+}
+
+// IsUpToDate checks whether the observed policy tag is up-to-date compared to
+// the given set of parameters.
+func IsUpToDate(in v1alpha1.PolicyTagParameters, observed datacatalog.GoogleCloudDatacatalogV1PolicyTag) bool {
+	return cmp.Equal(GeneratePolicyTag(in), &observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(datacatalog.GoogleCloudDatacatalogV1PolicyTag{}, "Name", "ChildPolicyTags"),
+		ignoreClientFields)
+}
+
+// ignoreClientFields ignores the fields of the API types that are only used
+// by the API client rather than sent to or returned by the API.
+var ignoreClientFields = cmp.FilterPath(func(p cmp.Path) bool {
+	switch p.Last().String() {
+	case ".ServerResponse", ".ForceSendFields", ".NullFields":
+		return true
+	}
+	return false
+}, cmp.Ignore())
@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policytag
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testTaxonomy = "projects/my-project/locations/us/taxonomies/123"
+	testParent   = testTaxonomy + "/policyTags/456"
+	testName     = testTaxonomy + "/policyTags/789"
+)
+
+func params(m ...func(*v1alpha1.PolicyTagParameters)) v1alpha1.PolicyTagParameters {
+	p := v1alpha1.PolicyTagParameters{
+		Taxonomy:        gcp.StringPtr(testTaxonomy),
+		ParentPolicyTag: gcp.StringPtr(testParent),
+		DisplayName:     "email",
+		Description:     gcp.StringPtr("Email addresses"),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func policyTag(m ...func(*datacatalog.GoogleCloudDatacatalogV1PolicyTag)) *datacatalog.GoogleCloudDatacatalogV1PolicyTag {
+	t := &datacatalog.GoogleCloudDatacatalogV1PolicyTag{
+		Name:            testName,
+		ParentPolicyTag: testParent,
+		DisplayName:     "email",
+		Description:     "Email addresses",
+		ChildPolicyTags: []string{testTaxonomy + "/policyTags/1011"},
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGetFullyQualifiedName(t *testing.T) {
+	got := GetFullyQualifiedName(testTaxonomy, GetID(testName))
+	if diff := cmp.Diff(testName, got); diff != "" {
+		t.Errorf("GetFullyQualifiedName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	got := params(func(p *v1alpha1.PolicyTagParameters) {
+		p.Description = nil
+		p.ParentPolicyTag = nil
+	})
+	LateInitializeSpec(&got, *policyTag())
+	want := params(func(p *v1alpha1.PolicyTagParameters) { p.ParentPolicyTag = nil })
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.PolicyTagParameters
+		observed *datacatalog.GoogleCloudDatacatalogV1PolicyTag
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: policyTag(),
+			want:     true,
+		},
+		"DescriptionChanged": {
+			in:       params(func(p *v1alpha1.PolicyTagParameters) { p.Description = gcp.StringPtr("Emails") }),
+			observed: policyTag(),
+		},
+		"MovedToTop": {
+			in:       params(func(p *v1alpha1.PolicyTagParameters) { p.ParentPolicyTag = nil }),
+			observed: policyTag(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.in, *tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
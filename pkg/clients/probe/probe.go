@@ -0,0 +1,246 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package probe implements the checks of the /healthz and /readyz endpoints
+// of the provider. The provider is only ready once its caches are synced, its
+// webhook certificates can be loaded and the Google Cloud APIs are reachable
+// with the credentials of every ProviderConfig, so that bad credentials or
+// egress problems surface as an unready pod rather than as errors of every
+// reconcile.
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	// DefaultInterval is the default minimum time between two probes of
+	// the reachability of the Google Cloud APIs.
+	DefaultInterval = time.Minute
+
+	// DefaultTimeout is the default time a probe of the reachability of
+	// the Google Cloud APIs may take, across all ProviderConfigs.
+	DefaultTimeout = 30 * time.Second
+
+	// cacheSyncTimeout is how long a readiness check waits for the caches
+	// to sync. It is shorter than the default timeout of kubelet probes.
+	cacheSyncTimeout = 500 * time.Millisecond
+
+	// Names of the files of a TLS certificate directory.
+	certFile = "tls.crt"
+	keyFile  = "tls.key"
+)
+
+const (
+	errCacheNotSynced = "caches are not synced"
+	errLoadCert       = "cannot load TLS certificate"
+	errParseCert      = "cannot parse TLS certificate"
+	errFmtCertExpired = "TLS certificate expired at %s"
+	errFmtCertNotYet  = "TLS certificate is not valid before %s"
+	errNotProbed      = "Google Cloud APIs were not probed yet"
+	errListPCs        = "cannot list ProviderConfigs"
+	errFmtUnreachable = "Google Cloud APIs are not reachable with the credentials of ProviderConfigs: %s"
+	errGetCredentials = "cannot get credentials"
+	errNewClient      = "cannot create client"
+)
+
+// A CacheSyncer waits for caches to sync, e.g. the cache of a controller
+// manager.
+type CacheSyncer interface {
+	WaitForCacheSync(ctx context.Context) bool
+}
+
+// CacheSynced returns a checker that fails until the supplied caches are
+// synced.
+func CacheSynced(c CacheSyncer) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), cacheSyncTimeout)
+		defer cancel()
+		if !c.WaitForCacheSync(ctx) {
+			return errors.New(errCacheNotSynced)
+		}
+		return nil
+	}
+}
+
+// Certificates returns a checker that fails unless the TLS certificate and
+// key in the supplied directory can be loaded and the certificate is valid at
+// the time returned by the supplied clock.
+func Certificates(dir string, now func() time.Time) healthz.Checker {
+	return func(_ *http.Request) error {
+		pair, err := tls.LoadX509KeyPair(filepath.Join(dir, certFile), filepath.Join(dir, keyFile))
+		if err != nil {
+			return errors.Wrap(err, errLoadCert)
+		}
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			return errors.Wrap(err, errParseCert)
+		}
+		t := now()
+		if t.After(cert.NotAfter) {
+			return errors.Errorf(errFmtCertExpired, cert.NotAfter.Format(time.RFC3339))
+		}
+		if t.Before(cert.NotBefore) {
+			return errors.Errorf(errFmtCertNotYet, cert.NotBefore.Format(time.RFC3339))
+		}
+		return nil
+	}
+}
+
+// A ProbeFn makes a lightweight call to the Google Cloud APIs using the
+// supplied project and client options. It returns an error if the APIs are
+// not reachable or the credentials are rejected.
+type ProbeFn func(ctx context.Context, projectID string, opts ...option.ClientOption) error
+
+// GetProject probes the Google Cloud APIs by getting the supplied project
+// from the Resource Manager API. The APIs are considered reachable if they
+// accept the credentials, even if the caller may not get the project.
+func GetProject(ctx context.Context, projectID string, opts ...option.ClientOption) error {
+	s, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return errors.Wrap(err, errNewClient)
+	}
+	_, err = s.Projects.Get(projectID).Context(ctx).Do()
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) && gerr.Code != http.StatusUnauthorized {
+		return nil
+	}
+	return err
+}
+
+// Reachability checks that the Google Cloud APIs are reachable with the
+// credentials of every ProviderConfig. The APIs are probed in the
+// background at most once per interval, so that the checker returns quickly
+// and the APIs are not called on every request to /readyz.
+type Reachability struct {
+	kube     client.Client
+	probe    ProbeFn
+	interval time.Duration
+	timeout  time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	probing bool
+	probed  time.Time
+	err     error
+}
+
+// An Option configures a Reachability.
+type Option func(*Reachability)
+
+// WithProbe configures the function a Reachability uses to probe the Google
+// Cloud APIs.
+func WithProbe(fn ProbeFn) Option {
+	return func(r *Reachability) {
+		r.probe = fn
+	}
+}
+
+// WithClock configures the function a Reachability uses to determine the
+// current time.
+func WithClock(now func() time.Time) Option {
+	return func(r *Reachability) {
+		r.now = now
+	}
+}
+
+// NewReachability returns a Reachability that probes the Google Cloud APIs
+// at most once per supplied interval.
+func NewReachability(kube client.Client, interval time.Duration, o ...Option) *Reachability {
+	r := &Reachability{
+		kube:     kube,
+		probe:    GetProject,
+		interval: interval,
+		timeout:  DefaultTimeout,
+		now:      time.Now,
+		err:      errors.New(errNotProbed),
+	}
+	for _, fn := range o {
+		fn(r)
+	}
+	return r
+}
+
+// Check returns the result of the last probe, and starts a new probe in the
+// background if the last one is older than the interval.
+func (r *Reachability) Check(_ *http.Request) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.probing && (r.probed.IsZero() || !r.now().Before(r.probed.Add(r.interval))) {
+		r.probing = true
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+			defer cancel()
+			r.refresh(ctx)
+		}()
+	}
+	return r.err
+}
+
+func (r *Reachability) refresh(ctx context.Context) {
+	err := r.probeAll(ctx)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.probing = false
+	r.probed = r.now()
+	r.err = err
+}
+
+func (r *Reachability) probeAll(ctx context.Context) error {
+	l := &v1beta1.ProviderConfigList{}
+	if err := r.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListPCs)
+	}
+	var failed []string
+	for i := range l.Items {
+		pc := &l.Items[i]
+		if err := r.probeOne(ctx, pc); err != nil {
+			failed = append(failed, pc.GetName()+": "+err.Error())
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	sort.Strings(failed)
+	return errors.Errorf(errFmtUnreachable, strings.Join(failed, "; "))
+}
+
+func (r *Reachability) probeOne(ctx context.Context, pc *v1beta1.ProviderConfig) error {
+	projectID, opts, err := gcp.ProviderConfigConnectionInfo(ctx, r.kube, pc)
+	if err != nil {
+		return errors.Wrap(err, errGetCredentials)
+	}
+	return r.probe(ctx, projectID, opts...)
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+var errBoom = errors.New("boom")
+
+type syncer bool
+
+func (s syncer) WaitForCacheSync(_ context.Context) bool { return bool(s) }
+
+func TestCacheSynced(t *testing.T) {
+	cases := map[string]struct {
+		c    CacheSyncer
+		want error
+	}{
+		"Synced":    {c: syncer(true)},
+		"NotSynced": {c: syncer(false), want: errors.New(errCacheNotSynced)},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CacheSynced(tc.c)(&http.Request{})
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("CacheSynced(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+// writeCert writes a self-signed certificate that is valid from notBefore
+// for a day to the supplied directory.
+func writeCert(t *testing.T, dir string, notBefore time.Time) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "provider-gcp"},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kder, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, certFile), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, keyFile), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kder}), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCertificates(t *testing.T) {
+	issued := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	dir := t.TempDir()
+	writeCert(t, dir, issued)
+
+	cases := map[string]struct {
+		dir     string
+		now     time.Time
+		wantErr bool
+	}{
+		"Valid":   {dir: dir, now: issued.Add(time.Hour)},
+		"Expired": {dir: dir, now: issued.Add(48 * time.Hour), wantErr: true},
+		"NotYet":  {dir: dir, now: issued.Add(-time.Hour), wantErr: true},
+		"Missing": {dir: t.TempDir(), now: issued.Add(time.Hour), wantErr: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Certificates(tc.dir, func() time.Time { return tc.now })(&http.Request{})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Certificates(...): unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestReachability(t *testing.T) {
+	pc := func(name string) v1beta1.ProviderConfig {
+		return v1beta1.ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1beta1.ProviderConfigSpec{
+				ProjectID: name,
+				Credentials: v1beta1.ProviderCredentials{
+					Source: xpv1.CredentialsSourceSecret,
+					CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+						SecretRef: &xpv1.SecretKeySelector{
+							SecretReference: xpv1.SecretReference{Name: name, Namespace: "crossplane-system"},
+							Key:             "credentials",
+						},
+					},
+				},
+			},
+		}
+	}
+	kube := func(err error, pcs ...v1beta1.ProviderConfig) client.Client {
+		return &test.MockClient{
+			MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+				obj.(*v1beta1.ProviderConfigList).Items = pcs
+				return err
+			},
+			MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+				obj.(*corev1.Secret).Data = map[string][]byte{"credentials": []byte(`{"type":"service_account"}`)}
+				return nil
+			},
+		}
+	}
+	// The probe fails for the project named bad.
+	probe := func(_ context.Context, projectID string, _ ...option.ClientOption) error {
+		if projectID == "bad" {
+			return errBoom
+		}
+		return nil
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		want error
+	}{
+		"NoProviderConfigs": {
+			kube: kube(nil),
+		},
+		"ListError": {
+			kube: kube(errBoom),
+			want: errors.Wrap(errBoom, errListPCs),
+		},
+		"Reachable": {
+			kube: kube(nil, pc("good"), pc("also-good")),
+		},
+		"Unreachable": {
+			kube: kube(nil, pc("good"), pc("bad")),
+			want: errors.Errorf(errFmtUnreachable, "bad: boom"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewReachability(tc.kube, DefaultInterval, WithProbe(probe))
+			if diff := cmp.Diff(errors.New(errNotProbed), r.err, test.EquateErrors()); diff != "" {
+				t.Errorf("NewReachability(...): -want error, +got error:\n%s", diff)
+			}
+			r.refresh(context.Background())
+			if diff := cmp.Diff(tc.want, r.err, test.EquateErrors()); diff != "" {
+				t.Errorf("refresh(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReachabilityCheck(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cases := map[string]struct {
+		probed time.Time
+		want   bool
+	}{
+		"NeverProbed": {want: true},
+		"ProbedRecently": {
+			probed: now.Add(-time.Second),
+			want:   false,
+		},
+		"IntervalElapsed": {
+			probed: now.Add(-DefaultInterval),
+			want:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			started := make(chan struct{}, 1)
+			probe := func(_ context.Context, _ string, _ ...option.ClientOption) error { return nil }
+			kube := &test.MockClient{MockList: func(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+				started <- struct{}{}
+				return nil
+			}}
+			r := NewReachability(kube, DefaultInterval, WithProbe(probe), WithClock(func() time.Time { return now }))
+			r.probed = tc.probed
+			_ = r.Check(&http.Request{})
+
+			got := false
+			select {
+			case <-started:
+				got = true
+			case <-time.After(100 * time.Millisecond):
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Check(...): -want probe started, +got probe started:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectpolicy
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// ConflictBackoff bounds how often a read-modify-write of a project IAM policy
+// is retried when the policy was changed concurrently.
+var ConflictBackoff = wait.Backoff{
+	Steps:    4,
+	Duration: 50 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+// IsErrorConflict returns true if the supplied error indicates that the etag
+// of a policy no longer matched the policy when it was set, i.e. that someone
+// else modified it since it was read.
+func IsErrorConflict(err error) bool {
+	return gcp.IsErrorPreconditionFailed(err) || gcp.IsErrorAlreadyExists(err)
+}
+
+// Client should be satisfied to conduct Project Policy operations.
+type Client interface {
+	GetIamPolicy(resource string, getiampolicyrequest *cloudresourcemanager.GetIamPolicyRequest) *cloudresourcemanager.ProjectsGetIamPolicyCall
+	SetIamPolicy(resource string, setiampolicyrequest *cloudresourcemanager.SetIamPolicyRequest) *cloudresourcemanager.ProjectsSetIamPolicyCall
+}
+
+// GetIamPolicyRequest returns a request for the IAM policy of a project that
+// returns conditional bindings as-is.
+func GetIamPolicyRequest() *cloudresourcemanager.GetIamPolicyRequest {
+	return &cloudresourcemanager.GetIamPolicyRequest{
+		Options: &cloudresourcemanager.GetPolicyOptions{RequestedPolicyVersion: iamv1alpha1.PolicyVersion},
+	}
+}
+
+// Project returns the supplied project, or the default project if it is not
+// set.
+func Project(project *string, defaultProject string) string {
+	if p := gcp.StringValue(project); p != "" {
+		return p
+	}
+	return defaultProject
+}
+
+// GeneratePolicy replaces the bindings of *cloudresourcemanager.Policy with
+// those declared in ProjectPolicyParameters. The etag and audit configs of p
+// are left untouched so that setting the generated policy fails if the policy
+// it was read from has been modified since.
+func GeneratePolicy(in v1alpha1.ProjectPolicyParameters, p *cloudresourcemanager.Policy) {
+	p.Bindings = make([]*cloudresourcemanager.Binding, len(in.Policy.Bindings))
+	for i, v := range in.Policy.Bindings {
+		p.Bindings[i] = &cloudresourcemanager.Binding{Condition: generateCondition(v.Condition), Role: v.Role}
+		p.Bindings[i].Members = make([]string, len(v.Members))
+		copy(p.Bindings[i].Members, v.Members)
+	}
+	p.Version = iamv1alpha1.PolicyVersion
+}
+
+// IsUpToDate checks whether the bindings of the observed policy are exactly
+// those declared in ProjectPolicyParameters.
+func IsUpToDate(in v1alpha1.ProjectPolicyParameters, observed *cloudresourcemanager.Policy) bool {
+	desired := &cloudresourcemanager.Policy{}
+	GeneratePolicy(in, desired)
+	return cmp.Equal(desired.Bindings, observed.Bindings, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(cloudresourcemanager.Binding{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudresourcemanager.Expr{}, "ForceSendFields", "NullFields"),
+		cmpopts.SortSlices(func(i, j *cloudresourcemanager.Binding) bool { return bindingKey(i) < bindingKey(j) }),
+		cmpopts.SortSlices(func(i, j string) bool { return i < j }))
+}
+
+// HasBindings returns true if any member of the bindings declared in
+// ProjectPolicyParameters is bound in *cloudresourcemanager.Policy.
+func HasBindings(in v1alpha1.ProjectPolicyParameters, p *cloudresourcemanager.Policy) bool {
+	for _, d := range in.Policy.Bindings {
+		cond := generateCondition(d.Condition)
+		for _, b := range p.Bindings {
+			if b.Role != d.Role || !sameCondition(b.Condition, cond) {
+				continue
+			}
+			for _, m := range b.Members {
+				if contains(d.Members, m) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// RemoveBindings removes the members of the bindings declared in
+// ProjectPolicyParameters from *cloudresourcemanager.Policy, dropping bindings
+// that are left without members. Bindings and members that are not declared
+// are kept.
+// returns true if policy changed
+func RemoveBindings(in v1alpha1.ProjectPolicyParameters, p *cloudresourcemanager.Policy) bool {
+	changed := false
+	for _, d := range in.Policy.Bindings {
+		cond := generateCondition(d.Condition)
+		for _, b := range p.Bindings {
+			if b.Role != d.Role || !sameCondition(b.Condition, cond) {
+				continue
+			}
+			members := b.Members[:0]
+			for _, m := range b.Members {
+				if !contains(d.Members, m) {
+					members = append(members, m)
+				}
+			}
+			changed = changed || len(members) != len(b.Members)
+			b.Members = members
+		}
+	}
+	bindings := p.Bindings[:0]
+	for _, b := range p.Bindings {
+		if len(b.Members) > 0 {
+			bindings = append(bindings, b)
+		}
+	}
+	p.Bindings = bindings
+	return changed
+}
+
+// Members returns the members declared by PolicyMember, i.e. the union of
+// Member and Members.
+func Members(in v1alpha1.PolicyMember) []string {
+	members := make([]string, 0, len(in.Members)+1)
+	if in.Member != nil {
+		members = append(members, *in.Member)
+	}
+	for _, m := range in.Members {
+		if !contains(members, m) {
+			members = append(members, m)
+		}
+	}
+	return members
+}
+
+// BindRoleToMember updates *cloudresourcemanager.Policy instance with
+// PolicyMember. The role is bound to every declared member that it is not
+// already bound to.
+// returns true if policy changed
+func BindRoleToMember(in v1alpha1.PolicyMember, p *cloudresourcemanager.Policy) bool {
+	p.Version = iamv1alpha1.PolicyVersion
+	cond := generateCondition(in.Condition)
+	changed := false
+	for _, m := range Members(in) {
+		changed = bindMember(p, in.Role, cond, m) || changed
+	}
+	return changed
+}
+
+// bindMember binds the supplied role with the supplied condition to member.
+// returns true if policy changed
+func bindMember(p *cloudresourcemanager.Policy, role string, cond *cloudresourcemanager.Expr, member string) bool {
+	for _, b := range p.Bindings {
+		if b.Role == role && sameCondition(b.Condition, cond) {
+			if contains(b.Members, member) {
+				// role already bound to member, no change
+				return false
+			}
+			// role already exist, add member
+			b.Members = append(b.Members, member)
+			return true
+		}
+	}
+	// role does not exist with this condition, add binding with role,
+	// condition and member
+	p.Bindings = append(p.Bindings, &cloudresourcemanager.Binding{
+		Role:      role,
+		Condition: cond,
+		Members:   []string{member},
+	})
+	return true
+}
+
+// StaleMembers returns the supplied members the role was bound to that are
+// no longer declared by PolicyMember.
+func StaleMembers(in v1alpha1.PolicyMember, bound []string) []string {
+	members := Members(in)
+	var stale []string
+	for _, m := range bound {
+		if !contains(members, m) {
+			stale = append(stale, m)
+		}
+	}
+	return stale
+}
+
+// OwnedMembers returns the members declared by PolicyMember followed by the
+// supplied members the role was bound to that are no longer declared. These
+// are the members a policy member resource must unbind when it is deleted.
+func OwnedMembers(in v1alpha1.PolicyMember, bound []string) []string {
+	return append(Members(in), StaleMembers(in, bound)...)
+}
+
+// IsRoleBound returns true if the role declared by PolicyMember is bound to
+// any of the supplied members.
+func IsRoleBound(in v1alpha1.PolicyMember, members []string, p *cloudresourcemanager.Policy) bool {
+	cond := generateCondition(in.Condition)
+	for _, b := range p.Bindings {
+		if b.Role != in.Role || !sameCondition(b.Condition, cond) {
+			continue
+		}
+		for _, m := range b.Members {
+			if contains(members, m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// UpdateMember binds the role declared by PolicyMember to every declared
+// member and unbinds it from the supplied bound members that are no longer
+// declared.
+// returns true if policy changed
+func UpdateMember(in v1alpha1.PolicyMember, bound []string, p *cloudresourcemanager.Policy) bool {
+	unbound := UnbindRoleFromMembers(in, StaleMembers(in, bound), p)
+	return BindRoleToMember(in, p) || unbound
+}
+
+// UnbindRoleFromMember removes every member declared by PolicyMember from the
+// binding of the role in *cloudresourcemanager.Policy, other members of the
+// binding are kept. The binding is dropped if it is left without members.
+// returns true if policy changed
+func UnbindRoleFromMember(in v1alpha1.PolicyMember, p *cloudresourcemanager.Policy) bool {
+	return UnbindRoleFromMembers(in, Members(in), p)
+}
+
+// UnbindRoleFromMembers removes the supplied members from the binding of the
+// role declared by PolicyMember, keeping its other members. The binding is
+// dropped if it is left without members.
+// returns true if policy changed
+func UnbindRoleFromMembers(in v1alpha1.PolicyMember, members []string, p *cloudresourcemanager.Policy) bool {
+	if len(members) == 0 {
+		return false
+	}
+	cond := generateCondition(in.Condition)
+	for i, b := range p.Bindings {
+		if b.Role != in.Role || !sameCondition(b.Condition, cond) {
+			continue
+		}
+		kept := b.Members[:0]
+		for _, m := range b.Members {
+			if !contains(members, m) {
+				kept = append(kept, m)
+			}
+		}
+		changed := len(kept) != len(b.Members)
+		b.Members = kept
+		if len(kept) == 0 {
+			p.Bindings = append(p.Bindings[:i], p.Bindings[i+1:]...)
+		}
+		return changed
+	}
+	return false
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func bindingKey(b *cloudresourcemanager.Binding) string {
+	if b.Condition == nil {
+		return b.Role
+	}
+	return b.Role + "/" + b.Condition.Title + "/" + b.Condition.Expression
+}
+
+func generateCondition(in *iamv1alpha1.Expr) *cloudresourcemanager.Expr {
+	if in == nil {
+		return nil
+	}
+	return &cloudresourcemanager.Expr{
+		Description: gcp.StringValue(in.Description),
+		Expression:  in.Expression,
+		Location:    gcp.StringValue(in.Location),
+		Title:       gcp.StringValue(in.Title),
+	}
+}
+
+// sameCondition reports whether the supplied binding conditions are the same.
+// Bindings without a condition only match each other.
+func sameCondition(a, b *cloudresourcemanager.Expr) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Expression == b.Expression && a.Title == b.Title && a.Description == b.Description && a.Location == b.Location
+}
@@ -0,0 +1,480 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectpolicy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+var (
+	testRole      = "roles/viewer"
+	testMember    = "serviceAccount:perfect-test-sa@wesaas-playground.iam.gserviceaccount.com"
+	testGroup     = "group:admins@example.com"
+	testUser      = "user:alice@example.com"
+	testTitle     = "expires"
+	testCondition = &iamv1alpha1.Expr{
+		Title:      &testTitle,
+		Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`,
+	}
+	testCRMCondition = &cloudresourcemanager.Expr{
+		Title:      testTitle,
+		Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`,
+	}
+)
+
+func TestProject(t *testing.T) {
+	project := "other-project"
+	cases := map[string]struct {
+		project *string
+		want    string
+	}{
+		"Default": {want: "default-project"},
+		"Set":     {project: &project, want: project},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Project(tc.project, "default-project")); diff != "" {
+				t.Errorf("Project(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBindRoleToMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *cloudresourcemanager.Policy
+	}
+	cases := map[string]struct {
+		in     v1alpha1.PolicyMember
+		policy *cloudresourcemanager.Policy
+		want   want
+	}{
+		"EmptyPolicy": {
+			in:     v1alpha1.PolicyMember{Role: testRole, Member: &testMember},
+			policy: &cloudresourcemanager.Policy{},
+			want: want{
+				changed: true,
+				policy: &cloudresourcemanager.Policy{
+					Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+					Version:  iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"AddToExistingBinding": {
+			in: v1alpha1.PolicyMember{Role: testRole, Member: &testMember, Members: []string{testGroup}},
+			policy: &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testUser}}},
+			},
+			want: want{
+				changed: true,
+				policy: &cloudresourcemanager.Policy{
+					Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testUser, testMember, testGroup}}},
+					Version:  iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"AlreadyBound": {
+			in: v1alpha1.PolicyMember{Role: testRole, Member: &testMember},
+			policy: &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+			},
+			want: want{
+				policy: &cloudresourcemanager.Policy{
+					Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+					Version:  iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"OtherCondition": {
+			in: v1alpha1.PolicyMember{Role: testRole, Member: &testMember, Condition: testCondition},
+			policy: &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+			},
+			want: want{
+				changed: true,
+				policy: &cloudresourcemanager.Policy{
+					Bindings: []*cloudresourcemanager.Binding{
+						{Role: testRole, Members: []string{testMember}},
+						{Role: testRole, Members: []string{testMember}, Condition: testCRMCondition},
+					},
+					Version: iamv1alpha1.PolicyVersion,
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := BindRoleToMember(tc.in, tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnbindRoleFromMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *cloudresourcemanager.Policy
+	}
+	cases := map[string]struct {
+		in     v1alpha1.PolicyMember
+		policy *cloudresourcemanager.Policy
+		want   want
+	}{
+		"KeepOtherMembers": {
+			in: v1alpha1.PolicyMember{Role: testRole, Member: &testMember, Members: []string{testGroup}},
+			policy: &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testUser, testMember, testGroup}}},
+			},
+			want: want{
+				changed: true,
+				policy: &cloudresourcemanager.Policy{
+					Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testUser}}},
+				},
+			},
+		},
+		"DropEmptyBinding": {
+			in: v1alpha1.PolicyMember{Role: testRole, Member: &testMember},
+			policy: &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{
+					{Role: "roles/editor", Members: []string{testUser}},
+					{Role: testRole, Members: []string{testMember}},
+				},
+			},
+			want: want{
+				changed: true,
+				policy: &cloudresourcemanager.Policy{
+					Bindings: []*cloudresourcemanager.Binding{{Role: "roles/editor", Members: []string{testUser}}},
+				},
+			},
+		},
+		"NotBound": {
+			in: v1alpha1.PolicyMember{Role: testRole, Member: &testMember, Condition: testCondition},
+			policy: &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+			},
+			want: want{
+				policy: &cloudresourcemanager.Policy{
+					Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := UnbindRoleFromMember(tc.in, tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMembers(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.PolicyMember
+		want []string
+	}{
+		"None": {
+			want: []string{},
+		},
+		"Union": {
+			in:   v1alpha1.PolicyMember{Member: &testMember, Members: []string{testGroup, testMember, testGroup}},
+			want: []string{testMember, testGroup},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Members(tc.in)); diff != "" {
+				t.Errorf("Members(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStaleMembers(t *testing.T) {
+	in := v1alpha1.PolicyMember{Member: &testMember, Members: []string{testGroup}}
+	cases := map[string]struct {
+		bound []string
+		want  []string
+	}{
+		"NoneBound": {},
+		"AllDeclared": {
+			bound: []string{testMember, testGroup},
+		},
+		"MemberRemoved": {
+			bound: []string{testMember, testUser, testGroup},
+			want:  []string{testUser},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, StaleMembers(in, tc.bound)); diff != "" {
+				t.Errorf("StaleMembers(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnedMembers(t *testing.T) {
+	in := v1alpha1.PolicyMember{Role: testRole, Member: &testMember, Members: []string{testGroup}}
+	got := OwnedMembers(in, []string{testMember, testUser})
+	if diff := cmp.Diff([]string{testMember, testGroup, testUser}, got); diff != "" {
+		t.Errorf("OwnedMembers(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsRoleBound(t *testing.T) {
+	in := v1alpha1.PolicyMember{Role: testRole, Member: &testMember}
+	cases := map[string]struct {
+		members []string
+		policy  *cloudresourcemanager.Policy
+		want    bool
+	}{
+		"Bound": {
+			members: []string{testMember, testUser},
+			policy: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testUser}},
+			}},
+			want: true,
+		},
+		"OtherRole": {
+			members: []string{testMember},
+			policy: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{testMember}},
+			}},
+			want: false,
+		},
+		"OtherCondition": {
+			members: []string{testMember},
+			policy: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testMember}, Condition: generateCondition(testCondition)},
+			}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsRoleBound(in, tc.members, tc.policy)); diff != "" {
+				t.Errorf("IsRoleBound(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnbindRoleFromMembers(t *testing.T) {
+	in := v1alpha1.PolicyMember{Role: testRole, Member: &testMember}
+	p := &cloudresourcemanager.Policy{
+		Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember, testUser, testGroup}}},
+	}
+	if !UnbindRoleFromMembers(in, []string{testMember, testUser}, p) {
+		t.Errorf("UnbindRoleFromMembers(...): want changed")
+	}
+	want := &cloudresourcemanager.Policy{
+		Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testGroup}}},
+	}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("UnbindRoleFromMembers(...): -want policy, +got policy:\n%s", diff)
+	}
+}
+
+func TestUpdateMember(t *testing.T) {
+	in := v1alpha1.PolicyMember{Role: testRole, Member: &testMember}
+	cases := map[string]struct {
+		bound  []string
+		policy *cloudresourcemanager.Policy
+		want   *cloudresourcemanager.Policy
+		change bool
+	}{
+		"UpToDate": {
+			bound:  []string{testMember},
+			policy: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}}},
+			want:   &cloudresourcemanager.Policy{Version: iamv1alpha1.PolicyVersion, Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}}},
+		},
+		"BindDeclaredUnbindRemoved": {
+			bound:  []string{testUser},
+			policy: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testGroup, testUser}}}},
+			want:   &cloudresourcemanager.Policy{Version: iamv1alpha1.PolicyVersion, Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testGroup, testMember}}}},
+			change: true,
+		},
+		"OnlyUnbindRemoved": {
+			bound:  []string{testMember, testUser},
+			policy: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember, testUser}}}},
+			want:   &cloudresourcemanager.Policy{Version: iamv1alpha1.PolicyVersion, Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}}},
+			change: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.change, UpdateMember(in, tc.bound, tc.policy)); diff != "" {
+				t.Errorf("UpdateMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.policy); diff != "" {
+				t.Errorf("UpdateMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsErrorConflict(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":                {},
+		"PreconditionFailed": {err: &googleapi.Error{Code: http.StatusPreconditionFailed}, want: true},
+		"Conflict":           {err: &googleapi.Error{Code: http.StatusConflict}, want: true},
+		"Wrapped":            {err: errors.Wrap(&googleapi.Error{Code: http.StatusConflict}, "boom"), want: true},
+		"Other":              {err: &googleapi.Error{Code: http.StatusInternalServerError}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsErrorConflict(tc.err)); diff != "" {
+				t.Errorf("IsErrorConflict(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePolicy(t *testing.T) {
+	in := v1alpha1.ProjectPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+		{Role: testRole, Members: []string{testMember}, Condition: testCondition},
+	}}}
+	audit := []*cloudresourcemanager.AuditConfig{{Service: "allServices"}}
+	p := &cloudresourcemanager.Policy{
+		AuditConfigs: audit,
+		Bindings:     []*cloudresourcemanager.Binding{{Role: "roles/owner", Members: []string{testUser}}},
+		Etag:         "etag",
+	}
+	GeneratePolicy(in, p)
+	want := &cloudresourcemanager.Policy{
+		AuditConfigs: audit,
+		Bindings:     []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}, Condition: testCRMCondition}},
+		Etag:         "etag",
+		Version:      iamv1alpha1.PolicyVersion,
+	}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("GeneratePolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	in := v1alpha1.ProjectPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+		{Role: testRole, Members: []string{testMember, testUser}},
+		{Role: "roles/editor", Members: []string{testGroup}},
+	}}}
+	cases := map[string]struct {
+		observed *cloudresourcemanager.Policy
+		want     bool
+	}{
+		"UpToDateInOtherOrder": {
+			observed: &cloudresourcemanager.Policy{Etag: "etag", Version: 1, Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{testGroup}},
+				{Role: testRole, Members: []string{testUser, testMember}},
+			}},
+			want: true,
+		},
+		"ForeignBinding": {
+			observed: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{testGroup}},
+				{Role: testRole, Members: []string{testUser, testMember}},
+				{Role: "roles/owner", Members: []string{testUser}},
+			}},
+		},
+		"MissingMember": {
+			observed: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/editor", Members: []string{testGroup}},
+				{Role: testRole, Members: []string{testMember}},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(in, tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasBindings(t *testing.T) {
+	in := v1alpha1.ProjectPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+		{Role: testRole, Members: []string{testMember}},
+	}}}
+	cases := map[string]struct {
+		policy *cloudresourcemanager.Policy
+		want   bool
+	}{
+		"Bound": {
+			policy: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testUser, testMember}}}},
+			want:   true,
+		},
+		"OtherCondition": {
+			policy: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}, Condition: testCRMCondition}}},
+		},
+		"NotBound": {
+			policy: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testUser}}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, HasBindings(in, tc.policy)); diff != "" {
+				t.Errorf("HasBindings(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveBindings(t *testing.T) {
+	in := v1alpha1.ProjectPolicyParameters{Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+		{Role: testRole, Members: []string{testMember}},
+	}}}
+	p := &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+		{Role: testRole, Members: []string{testMember, testUser}},
+		{Role: "roles/editor", Members: []string{testMember}},
+		{Role: testRole, Members: []string{testMember}, Condition: testCRMCondition},
+	}}
+	want := &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+		{Role: testRole, Members: []string{testUser}},
+		{Role: "roles/editor", Members: []string{testMember}},
+		{Role: testRole, Members: []string{testMember}, Condition: testCRMCondition},
+	}}
+	if !RemoveBindings(in, p) {
+		t.Errorf("RemoveBindings(...): want changed")
+	}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("RemoveBindings(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package publicaccess guards against IAM bindings that grant access to
+// allUsers or allAuthenticatedUsers, which for example make a bucket
+// readable by anyone on the internet.
+package publicaccess
+
+import (
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+// AnnotationKeyAllowPublicAccess marks a managed resource whose bindings may
+// grant public access when the provider runs in ModeRequireAnnotation.
+const AnnotationKeyAllowPublicAccess = "gcp.crossplane.io/allow-public-access"
+
+// A Mode determines whether bindings granting public access are set.
+type Mode string
+
+// Modes of the public access guardrail.
+const (
+	// ModeAllow sets bindings granting public access like any other.
+	ModeAllow Mode = "Allow"
+
+	// ModeBlock refuses to set bindings granting public access.
+	ModeBlock Mode = "Block"
+
+	// ModeRequireAnnotation sets bindings granting public access only for
+	// managed resources annotated with AnnotationKeyAllowPublicAccess set
+	// to "true".
+	ModeRequireAnnotation Mode = "RequireAnnotation"
+)
+
+const (
+	errFmtBlocked            = "member %q grants public access, which is blocked by the provider"
+	errFmtAnnotationRequired = "member %q grants public access, which requires annotation %s: \"true\""
+)
+
+var (
+	modeMu sync.RWMutex
+	mode   = ModeAllow
+)
+
+// SetMode configures the Mode used by subsequent calls to Check.
+func SetMode(m Mode) {
+	modeMu.Lock()
+	defer modeMu.Unlock()
+	mode = m
+}
+
+// IsPublic returns true if the supplied member identifies everyone, with or
+// without a Google account.
+func IsPublic(member string) bool {
+	return member == "allUsers" || member == "allAuthenticatedUsers"
+}
+
+// Members returns the members of all bindings of the supplied policy.
+func Members(p iamv1alpha1.Policy) []string {
+	var out []string
+	for _, b := range p.Bindings {
+		if b != nil {
+			out = append(out, b.Members...)
+		}
+	}
+	return out
+}
+
+// Check returns an error if any of the supplied members, which the supplied
+// object is about to bind, grants public access that is not allowed.
+func Check(o metav1.Object, members ...string) error {
+	modeMu.RLock()
+	m := mode
+	modeMu.RUnlock()
+
+	if m == ModeAllow {
+		return nil
+	}
+	for _, member := range members {
+		if !IsPublic(member) {
+			continue
+		}
+		if m == ModeBlock {
+			return errors.Errorf(errFmtBlocked, member)
+		}
+		if o.GetAnnotations()[AnnotationKeyAllowPublicAccess] != "true" {
+			return errors.Errorf(errFmtAnnotationRequired, member, AnnotationKeyAllowPublicAccess)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publicaccess
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+func TestCheck(t *testing.T) {
+	allowed := &metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyAllowPublicAccess: "true"}}
+
+	cases := map[string]struct {
+		mode    Mode
+		o       metav1.Object
+		members []string
+		want    error
+	}{
+		"Allow": {
+			mode:    ModeAllow,
+			o:       &metav1.ObjectMeta{},
+			members: []string{"allUsers"},
+		},
+		"BlockPrivate": {
+			mode:    ModeBlock,
+			o:       &metav1.ObjectMeta{},
+			members: []string{"user:jane@example.com", "group:admins@example.com"},
+		},
+		"BlockPublic": {
+			mode:    ModeBlock,
+			o:       allowed,
+			members: []string{"user:jane@example.com", "allAuthenticatedUsers"},
+			want:    errors.Errorf(errFmtBlocked, "allAuthenticatedUsers"),
+		},
+		"RequireAnnotationMissing": {
+			mode:    ModeRequireAnnotation,
+			o:       &metav1.ObjectMeta{},
+			members: []string{"allUsers"},
+			want:    errors.Errorf(errFmtAnnotationRequired, "allUsers", AnnotationKeyAllowPublicAccess),
+		},
+		"RequireAnnotationNotTrue": {
+			mode:    ModeRequireAnnotation,
+			o:       &metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyAllowPublicAccess: "yes"}},
+			members: []string{"allUsers"},
+			want:    errors.Errorf(errFmtAnnotationRequired, "allUsers", AnnotationKeyAllowPublicAccess),
+		},
+		"RequireAnnotationPresent": {
+			mode:    ModeRequireAnnotation,
+			o:       allowed,
+			members: []string{"allUsers"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			SetMode(tc.mode)
+			defer SetMode(ModeAllow)
+
+			err := Check(tc.o, tc.members...)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Check(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMembers(t *testing.T) {
+	p := iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+		{Role: "roles/storage.objectViewer", Members: []string{"allUsers", "user:jane@example.com"}},
+		nil,
+		{Role: "roles/storage.admin", Members: []string{"group:admins@example.com"}},
+	}}
+	want := []string{"allUsers", "user:jane@example.com", "group:admins@example.com"}
+	if diff := cmp.Diff(want, Members(p)); diff != "" {
+		t.Errorf("Members(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rediscluster
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	redis "google.golang.org/api/redis/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cache/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	clusterNameFormat = "projects/%s/locations/%s/clusters/%s"
+	parentFormat      = "projects/%s/locations/%s"
+)
+
+// Valid states for a Redis Cluster.
+const (
+	StateUnspecified = "STATE_UNSPECIFIED"
+	StateCreating    = "CREATING"
+	StateActive      = "ACTIVE"
+	StateUpdating    = "UPDATING"
+	StateDeleting    = "DELETING"
+)
+
+// GetFullyQualifiedParent builds the fully qualified name of the cluster
+// parent.
+func GetFullyQualifiedParent(project string, p v1alpha1.RedisClusterParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Region)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of the cluster.
+func GetFullyQualifiedName(project string, p v1alpha1.RedisClusterParameters, name string) string {
+	return fmt.Sprintf(clusterNameFormat, project, p.Region, name)
+}
+
+// GenerateCluster is used to convert Crossplane RedisClusterParameters to
+// GCP's Redis Cluster object. Name must be a fully qualified name for the
+// cluster.
+func GenerateCluster(name string, in v1alpha1.RedisClusterParameters, c *redis.Cluster) {
+	c.Name = name
+	c.ShardCount = in.ShardCount
+	c.ReplicaCount = gcp.Int64Value(in.ReplicaCount)
+	c.NodeType = gcp.StringValue(in.NodeType)
+	c.AuthorizationMode = gcp.StringValue(in.AuthorizationMode)
+	c.TransitEncryptionMode = gcp.StringValue(in.TransitEncryptionMode)
+	c.RedisConfigs = in.RedisConfigs
+	c.DeletionProtectionEnabled = gcp.BoolValue(in.DeletionProtectionEnabled)
+	c.PscConfigs = make([]*redis.PscConfig, len(in.PscConfigs))
+	for i, p := range in.PscConfigs {
+		c.PscConfigs[i] = &redis.PscConfig{Network: gcp.StringValue(p.Network)}
+	}
+	// Zero replicas and disabling deletion protection are meaningful, so
+	// they need to be sent even though they are the default values.
+	c.ForceSendFields = []string{"ReplicaCount", "DeletionProtectionEnabled"}
+}
+
+// GenerateObservation is used to produce an observation object from GCP's
+// Redis Cluster object.
+func GenerateObservation(c redis.Cluster) v1alpha1.RedisClusterObservation {
+	o := v1alpha1.RedisClusterObservation{
+		Name:   c.Name,
+		UID:    c.Uid,
+		State:  c.State,
+		SizeGB: c.SizeGb,
+	}
+	for _, e := range c.DiscoveryEndpoints {
+		de := v1alpha1.DiscoveryEndpoint{Address: e.Address, Port: e.Port}
+		if e.PscConfig != nil {
+			de.Network = e.PscConfig.Network
+		}
+		o.DiscoveryEndpoints = append(o.DiscoveryEndpoints, de)
+	}
+	for _, p := range c.PscConnections {
+		o.PscConnections = append(o.PscConnections, v1alpha1.PscConnection{
+			PscConnectionID: p.PscConnectionId,
+			Address:         p.Address,
+			ForwardingRule:  p.ForwardingRule,
+			ProjectID:       p.ProjectId,
+			Network:         p.Network,
+		})
+	}
+	t, err := time.Parse(time.RFC3339, c.CreateTime)
+	if err != nil {
+		return o
+	}
+	m := metav1.NewTime(t)
+	o.CreateTime = &m
+	return o
+}
+
+// LateInitializeSpec fills empty spec fields with the data retrieved from GCP.
+func LateInitializeSpec(spec *v1alpha1.RedisClusterParameters, c redis.Cluster) {
+	spec.ReplicaCount = gcp.LateInitializeInt64(spec.ReplicaCount, c.ReplicaCount)
+	spec.NodeType = gcp.LateInitializeString(spec.NodeType, c.NodeType)
+	spec.AuthorizationMode = gcp.LateInitializeString(spec.AuthorizationMode, c.AuthorizationMode)
+	spec.TransitEncryptionMode = gcp.LateInitializeString(spec.TransitEncryptionMode, c.TransitEncryptionMode)
+	spec.RedisConfigs = gcp.LateInitializeStringMap(spec.RedisConfigs, c.RedisConfigs)
+	spec.DeletionProtectionEnabled = gcp.LateInitializeBool(spec.DeletionProtectionEnabled, c.DeletionProtectionEnabled)
+}
+
+// UpdateMask returns the comma separated list of fields that can be updated
+// in place and differ between the supplied parameters and the observed
+// cluster. It is empty if the cluster is up to date.
+func UpdateMask(in v1alpha1.RedisClusterParameters, observed redis.Cluster) string {
+	var fields []string
+	if in.ShardCount != observed.ShardCount {
+		fields = append(fields, "shard_count")
+	}
+	if in.ReplicaCount != nil && *in.ReplicaCount != observed.ReplicaCount {
+		fields = append(fields, "replica_count")
+	}
+	if !cmp.Equal(in.RedisConfigs, observed.RedisConfigs, cmpopts.EquateEmpty()) {
+		fields = append(fields, "redis_configs")
+	}
+	if in.DeletionProtectionEnabled != nil && *in.DeletionProtectionEnabled != observed.DeletionProtectionEnabled {
+		fields = append(fields, "deletion_protection_enabled")
+	}
+	return strings.Join(fields, ",")
+}
+
+// IsUpToDate returns true if the supplied Kubernetes resource does not differ
+// from the supplied GCP resource. It considers only fields that can be
+// modified in place without deleting and recreating the cluster.
+func IsUpToDate(in v1alpha1.RedisClusterParameters, observed redis.Cluster) bool {
+	return UpdateMask(in, observed) == ""
+}
+
+// GetConnectionDetails returns the discovery endpoint of the cluster that
+// Redis clients connect to in order to discover the cluster topology.
+func GetConnectionDetails(o v1alpha1.RedisClusterObservation) managed.ConnectionDetails {
+	if len(o.DiscoveryEndpoints) == 0 || o.DiscoveryEndpoints[0].Address == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(o.DiscoveryEndpoints[0].Address),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(o.DiscoveryEndpoints[0].Port, 10)),
+	}
+}
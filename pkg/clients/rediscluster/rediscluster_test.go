@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rediscluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	redis "google.golang.org/api/redis/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cache/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	fullName = "projects/coolProject/locations/us-cool1/clusters/cool-cluster"
+	network  = "projects/coolProject/global/networks/default"
+)
+
+func params(m ...func(*v1alpha1.RedisClusterParameters)) v1alpha1.RedisClusterParameters {
+	p := v1alpha1.RedisClusterParameters{
+		Region:       "us-cool1",
+		ShardCount:   3,
+		ReplicaCount: gcp.Int64Ptr(1),
+		NodeType:     gcp.StringPtr("REDIS_HIGHMEM_MEDIUM"),
+		PscConfigs:   []v1alpha1.PscConfig{{Network: gcp.StringPtr(network)}},
+		RedisConfigs: map[string]string{"maxmemory-policy": "allkeys-lru"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func TestGetFullyQualifiedName(t *testing.T) {
+	if diff := cmp.Diff(fullName, GetFullyQualifiedName("coolProject", params(), "cool-cluster")); diff != "" {
+		t.Errorf("GetFullyQualifiedName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("projects/coolProject/locations/us-cool1", GetFullyQualifiedParent("coolProject", params())); diff != "" {
+		t.Errorf("GetFullyQualifiedParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateCluster(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.RedisClusterParameters
+		want *redis.Cluster
+	}{
+		"Full": {
+			in: params(),
+			want: &redis.Cluster{
+				Name:            fullName,
+				ShardCount:      3,
+				ReplicaCount:    1,
+				NodeType:        "REDIS_HIGHMEM_MEDIUM",
+				PscConfigs:      []*redis.PscConfig{{Network: network}},
+				RedisConfigs:    map[string]string{"maxmemory-policy": "allkeys-lru"},
+				ForceSendFields: []string{"ReplicaCount", "DeletionProtectionEnabled"},
+			},
+		},
+		"NoReplicas": {
+			in: v1alpha1.RedisClusterParameters{ShardCount: 1},
+			want: &redis.Cluster{
+				Name:            fullName,
+				ShardCount:      1,
+				PscConfigs:      []*redis.PscConfig{},
+				ForceSendFields: []string{"ReplicaCount", "DeletionProtectionEnabled"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &redis.Cluster{}
+			GenerateCluster(fullName, tc.in, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateCluster(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	created := metav1.NewTime(time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC))
+	in := redis.Cluster{
+		Name:       fullName,
+		Uid:        "uid",
+		State:      StateActive,
+		SizeGb:     39,
+		CreateTime: "2023-05-01T12:00:00Z",
+		DiscoveryEndpoints: []*redis.DiscoveryEndpoint{
+			{Address: "10.0.0.2", Port: 6379, PscConfig: &redis.PscConfig{Network: network}},
+		},
+		PscConnections: []*redis.PscConnection{
+			{PscConnectionId: "123", Address: "10.0.0.2", ForwardingRule: "fr", ProjectId: "coolProject", Network: network},
+		},
+	}
+	want := v1alpha1.RedisClusterObservation{
+		Name:               fullName,
+		UID:                "uid",
+		State:              StateActive,
+		SizeGB:             39,
+		CreateTime:         &created,
+		DiscoveryEndpoints: []v1alpha1.DiscoveryEndpoint{{Address: "10.0.0.2", Port: 6379, Network: network}},
+		PscConnections: []v1alpha1.PscConnection{
+			{PscConnectionID: "123", Address: "10.0.0.2", ForwardingRule: "fr", ProjectID: "coolProject", Network: network},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateObservation(in)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     v1alpha1.RedisClusterParameters
+		observed redis.Cluster
+		want     v1alpha1.RedisClusterParameters
+	}{
+		"AllFilled": {
+			spec:     params(),
+			observed: redis.Cluster{ReplicaCount: 2, NodeType: "REDIS_STANDARD_SMALL"},
+			want:     params(),
+		},
+		"Empty": {
+			spec: v1alpha1.RedisClusterParameters{ShardCount: 3},
+			observed: redis.Cluster{
+				ReplicaCount:              1,
+				NodeType:                  "REDIS_HIGHMEM_MEDIUM",
+				AuthorizationMode:         "AUTH_MODE_DISABLED",
+				DeletionProtectionEnabled: true,
+			},
+			want: v1alpha1.RedisClusterParameters{
+				ShardCount:                3,
+				ReplicaCount:              gcp.Int64Ptr(1),
+				NodeType:                  gcp.StringPtr("REDIS_HIGHMEM_MEDIUM"),
+				AuthorizationMode:         gcp.StringPtr("AUTH_MODE_DISABLED"),
+				DeletionProtectionEnabled: gcp.BoolPtr(true),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(&tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateMask(t *testing.T) {
+	observed := redis.Cluster{ShardCount: 3, ReplicaCount: 1, RedisConfigs: map[string]string{"maxmemory-policy": "allkeys-lru"}}
+	cases := map[string]struct {
+		in       v1alpha1.RedisClusterParameters
+		want     string
+		upToDate bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			upToDate: true,
+		},
+		"ShardsAndReplicas": {
+			in: params(func(p *v1alpha1.RedisClusterParameters) {
+				p.ShardCount = 6
+				p.ReplicaCount = gcp.Int64Ptr(0)
+			}),
+			want: "shard_count,replica_count",
+		},
+		"ConfigsAndDeletionProtection": {
+			in: params(func(p *v1alpha1.RedisClusterParameters) {
+				p.RedisConfigs = nil
+				p.DeletionProtectionEnabled = gcp.BoolPtr(true)
+			}),
+			want: "redis_configs,deletion_protection_enabled",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, UpdateMask(tc.in, observed)); diff != "" {
+				t.Errorf("UpdateMask(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.upToDate, IsUpToDate(tc.in, observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.RedisClusterObservation
+		want managed.ConnectionDetails
+	}{
+		"NoEndpoint": {},
+		"DiscoveryEndpoint": {
+			in: v1alpha1.RedisClusterObservation{
+				DiscoveryEndpoints: []v1alpha1.DiscoveryEndpoint{{Address: "10.0.0.2", Port: 6379}},
+			},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.0.0.2"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("6379"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetConnectionDetails(tc.in)); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reportconfig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+	nameFormat   = parentFormat + "/reportConfigs/%s"
+)
+
+// GetParent returns the location a report config is created in.
+func GetParent(project string, in v1alpha1.ReportConfigParameters) string {
+	return fmt.Sprintf(parentFormat, project, in.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of the report config.
+func GetFullyQualifiedName(project string, in v1alpha1.ReportConfigParameters, id string) string {
+	return fmt.Sprintf(nameFormat, project, in.Location, id)
+}
+
+// GenerateReportConfig produces a ReportConfig that is configured via the
+// supplied ReportConfigParameters.
+func GenerateReportConfig(in v1alpha1.ReportConfigParameters) *ReportConfig {
+	rc := &ReportConfig{
+		DisplayName: gcp.StringValue(in.DisplayName),
+		FrequencyOptions: &FrequencyOptions{
+			Frequency: in.FrequencyOptions.Frequency,
+			StartDate: generateDate(in.FrequencyOptions.StartDate),
+			EndDate:   generateDate(in.FrequencyOptions.EndDate),
+		},
+		ObjectMetadataReportOptions: &ObjectMetadataReportOptions{
+			MetadataFields: in.MetadataFields,
+			StorageFilters: &CloudStorageFilters{Bucket: gcp.StringValue(in.SourceBucket)},
+			StorageDestinationOptions: &CloudStorageDestinationOptions{
+				Bucket:          gcp.StringValue(in.DestinationBucket),
+				DestinationPath: gcp.StringValue(in.DestinationPath),
+			},
+		},
+		Labels: in.Labels,
+	}
+	if in.CSVOptions != nil {
+		rc.CsvOptions = &CsvOptions{
+			Delimiter:       gcp.StringValue(in.CSVOptions.Delimiter),
+			HeaderRequired:  gcp.BoolValue(in.CSVOptions.HeaderRequired),
+			RecordSeparator: gcp.StringValue(in.CSVOptions.RecordSeparator),
+		}
+	}
+	if in.ParquetOptions != nil {
+		rc.ParquetOptions = &ParquetOptions{}
+	}
+	return rc
+}
+
+func generateDate(d v1alpha1.Date) *Date {
+	return &Date{Year: d.Year, Month: d.Month, Day: d.Day}
+}
+
+// GenerateObservation produces a ReportConfigObservation from the supplied
+// ReportConfig.
+func GenerateObservation(rc ReportConfig) v1alpha1.ReportConfigObservation {
+	return v1alpha1.ReportConfigObservation{
+		Name:       rc.Name,
+		CreateTime: rc.CreateTime,
+		UpdateTime: rc.UpdateTime,
+	}
+}
+
+// LateInitialize fills the empty fields of ReportConfigParameters if the
+// corresponding fields are given in ReportConfig.
+func LateInitialize(in *v1alpha1.ReportConfigParameters, rc ReportConfig) {
+	in.DisplayName = gcp.LateInitializeString(in.DisplayName, rc.DisplayName)
+	in.Labels = gcp.LateInitializeStringMap(in.Labels, rc.Labels)
+	if o := rc.CsvOptions; o != nil && in.ParquetOptions == nil {
+		if in.CSVOptions == nil {
+			in.CSVOptions = &v1alpha1.CSVOptions{}
+		}
+		in.CSVOptions.Delimiter = gcp.LateInitializeString(in.CSVOptions.Delimiter, o.Delimiter)
+		in.CSVOptions.RecordSeparator = gcp.LateInitializeString(in.CSVOptions.RecordSeparator, o.RecordSeparator)
+		in.CSVOptions.HeaderRequired = gcp.LateInitializeBool(in.CSVOptions.HeaderRequired, o.HeaderRequired)
+	}
+	if o := rc.ObjectMetadataReportOptions; o != nil && o.StorageDestinationOptions != nil {
+		in.DestinationPath = gcp.LateInitializeString(in.DestinationPath, o.StorageDestinationOptions.DestinationPath)
+	}
+}
+
+// IsUpToDate checks whether ReportConfig is configured with given
+// ReportConfigParameters.
+func IsUpToDate(in v1alpha1.ReportConfigParameters, rc ReportConfig) bool {
+	return GenerateUpdateMask(in, rc) == ""
+}
+
+// GenerateUpdateMask returns the update mask of the fields of the supplied
+// ReportConfig that differ from the supplied ReportConfigParameters.
+func GenerateUpdateMask(in v1alpha1.ReportConfigParameters, rc ReportConfig) string {
+	desired := GenerateReportConfig(in)
+	mask := []string{}
+	if desired.DisplayName != rc.DisplayName {
+		mask = append(mask, "displayName")
+	}
+	if !cmp.Equal(desired.FrequencyOptions, rc.FrequencyOptions) {
+		mask = append(mask, "frequencyOptions")
+	}
+	if !cmp.Equal(desired.CsvOptions, rc.CsvOptions) {
+		mask = append(mask, "csvOptions")
+	}
+	if !cmp.Equal(desired.ParquetOptions, rc.ParquetOptions) {
+		mask = append(mask, "parquetOptions")
+	}
+	observed := rc.ObjectMetadataReportOptions
+	if observed == nil {
+		observed = &ObjectMetadataReportOptions{}
+	}
+	if !cmp.Equal(desired.ObjectMetadataReportOptions.MetadataFields, observed.MetadataFields, cmpopts.EquateEmpty()) {
+		mask = append(mask, "objectMetadataReportOptions.metadataFields")
+	}
+	if !cmp.Equal(desired.ObjectMetadataReportOptions.StorageDestinationOptions, observed.StorageDestinationOptions) {
+		mask = append(mask, "objectMetadataReportOptions.storageDestinationOptions")
+	}
+	if !cmp.Equal(desired.Labels, rc.Labels, cmpopts.EquateEmpty()) {
+		mask = append(mask, "labels")
+	}
+	return strings.Join(mask, ",")
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reportconfig
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func params(m ...func(*v1alpha1.ReportConfigParameters)) v1alpha1.ReportConfigParameters {
+	p := v1alpha1.ReportConfigParameters{
+		Location: "us-central1",
+		FrequencyOptions: v1alpha1.FrequencyOptions{
+			Frequency: "DAILY",
+			StartDate: v1alpha1.Date{Year: 2023, Month: 1, Day: 1},
+			EndDate:   v1alpha1.Date{Year: 2024, Month: 1, Day: 1},
+		},
+		CSVOptions:        &v1alpha1.CSVOptions{Delimiter: gcp.StringPtr(","), RecordSeparator: gcp.StringPtr("\n"), HeaderRequired: gcp.BoolPtr(true)},
+		MetadataFields:    []string{"project", "bucket", "name", "size"},
+		SourceBucket:      gcp.StringPtr("source"),
+		DestinationBucket: gcp.StringPtr("reports"),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func TestGenerateUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.ReportConfigParameters
+		observed ReportConfig
+		want     string
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: *GenerateReportConfig(params()),
+		},
+		"FrequencyAndFields": {
+			in: params(func(p *v1alpha1.ReportConfigParameters) {
+				p.FrequencyOptions.Frequency = "WEEKLY"
+				p.MetadataFields = append(p.MetadataFields, "storageClass")
+			}),
+			observed: *GenerateReportConfig(params()),
+			want:     "frequencyOptions,objectMetadataReportOptions.metadataFields",
+		},
+		"Destination": {
+			in: params(func(p *v1alpha1.ReportConfigParameters) {
+				p.DestinationPath = gcp.StringPtr("inventory/")
+				p.Labels = map[string]string{"team": "storage"}
+			}),
+			observed: *GenerateReportConfig(params()),
+			want:     "objectMetadataReportOptions.storageDestinationOptions,labels",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateMask(tc.in, tc.observed)); diff != "" {
+				t.Errorf("GenerateUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	in := params(func(p *v1alpha1.ReportConfigParameters) { p.CSVOptions = nil })
+	observed := *GenerateReportConfig(params())
+	observed.DisplayName = "inventory"
+
+	LateInitialize(&in, observed)
+
+	want := params(func(p *v1alpha1.ReportConfigParameters) { p.DisplayName = gcp.StringPtr("inventory") })
+	if diff := cmp.Diff(want, in); diff != "" {
+		t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+	}
+	if !IsUpToDate(in, observed) {
+		t.Errorf("IsUpToDate(...): want late initialized parameters to be up to date")
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reportconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/api/option/internaloption"
+	htransport "google.golang.org/api/transport/http"
+)
+
+// The Storage Insights API is not part of the google.golang.org/api release
+// this provider builds against, so its report configs are managed through a
+// minimal REST client.
+// https://cloud.google.com/storage/docs/insights/reference/rest/v1/projects.locations.reportConfigs
+const (
+	basePath           = "https://storageinsights.googleapis.com/"
+	scopeCloudPlatform = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// A ReportConfig is the Storage Insights API representation of an inventory
+// report config.
+type ReportConfig struct {
+	Name                        string                       `json:"name,omitempty"`
+	CreateTime                  string                       `json:"createTime,omitempty"`
+	UpdateTime                  string                       `json:"updateTime,omitempty"`
+	DisplayName                 string                       `json:"displayName,omitempty"`
+	FrequencyOptions            *FrequencyOptions            `json:"frequencyOptions,omitempty"`
+	CsvOptions                  *CsvOptions                  `json:"csvOptions,omitempty"`
+	ParquetOptions              *ParquetOptions              `json:"parquetOptions,omitempty"`
+	ObjectMetadataReportOptions *ObjectMetadataReportOptions `json:"objectMetadataReportOptions,omitempty"`
+	Labels                      map[string]string            `json:"labels,omitempty"`
+}
+
+// FrequencyOptions of a ReportConfig.
+type FrequencyOptions struct {
+	Frequency string `json:"frequency,omitempty"`
+	StartDate *Date  `json:"startDate,omitempty"`
+	EndDate   *Date  `json:"endDate,omitempty"`
+}
+
+// A Date of FrequencyOptions.
+type Date struct {
+	Year  int64 `json:"year,omitempty"`
+	Month int64 `json:"month,omitempty"`
+	Day   int64 `json:"day,omitempty"`
+}
+
+// CsvOptions of a ReportConfig.
+type CsvOptions struct {
+	Delimiter       string `json:"delimiter,omitempty"`
+	HeaderRequired  bool   `json:"headerRequired,omitempty"`
+	RecordSeparator string `json:"recordSeparator,omitempty"`
+}
+
+// ParquetOptions of a ReportConfig.
+type ParquetOptions struct{}
+
+// ObjectMetadataReportOptions of a ReportConfig.
+type ObjectMetadataReportOptions struct {
+	MetadataFields            []string                        `json:"metadataFields,omitempty"`
+	StorageFilters            *CloudStorageFilters            `json:"storageFilters,omitempty"`
+	StorageDestinationOptions *CloudStorageDestinationOptions `json:"storageDestinationOptions,omitempty"`
+}
+
+// CloudStorageFilters of ObjectMetadataReportOptions.
+type CloudStorageFilters struct {
+	Bucket string `json:"bucket,omitempty"`
+}
+
+// CloudStorageDestinationOptions of ObjectMetadataReportOptions.
+type CloudStorageDestinationOptions struct {
+	Bucket          string `json:"bucket,omitempty"`
+	DestinationPath string `json:"destinationPath,omitempty"`
+}
+
+// A Client manages Storage Insights report configs.
+type Client interface {
+	Get(ctx context.Context, name string) (*ReportConfig, error)
+	Create(ctx context.Context, parent string, rc *ReportConfig) (*ReportConfig, error)
+	Patch(ctx context.Context, name, updateMask string, rc *ReportConfig) (*ReportConfig, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// A Service is a Client of the Storage Insights REST API.
+type Service struct {
+	client   *http.Client
+	basePath string
+}
+
+// NewService returns a Service that uses the supplied client options.
+func NewService(ctx context.Context, opts ...option.ClientOption) (*Service, error) {
+	opts = append(opts,
+		internaloption.WithDefaultEndpoint(basePath),
+		internaloption.WithDefaultScopes(scopeCloudPlatform))
+	client, endpoint, err := htransport.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{client: client, basePath: endpoint}, nil
+}
+
+// Get the named report config.
+func (s *Service) Get(ctx context.Context, name string) (*ReportConfig, error) {
+	out := &ReportConfig{}
+	return out, s.do(ctx, http.MethodGet, name, nil, nil, out)
+}
+
+// Create a report config in the supplied parent location.
+func (s *Service) Create(ctx context.Context, parent string, rc *ReportConfig) (*ReportConfig, error) {
+	out := &ReportConfig{}
+	return out, s.do(ctx, http.MethodPost, parent+"/reportConfigs", nil, rc, out)
+}
+
+// Patch the fields of the named report config listed in the update mask.
+func (s *Service) Patch(ctx context.Context, name, updateMask string, rc *ReportConfig) (*ReportConfig, error) {
+	out := &ReportConfig{}
+	return out, s.do(ctx, http.MethodPatch, name, url.Values{"updateMask": {updateMask}}, rc, out)
+}
+
+// Delete the named report config.
+func (s *Service) Delete(ctx context.Context, name string) error {
+	return s.do(ctx, http.MethodDelete, name, nil, nil, nil)
+}
+
+func (s *Service) do(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	u := googleapi.ResolveRelative(s.basePath, "v1/"+path)
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer googleapi.CloseBody(rsp)
+	if err := googleapi.CheckResponse(rsp); err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(rsp.Body).Decode(out)
+}
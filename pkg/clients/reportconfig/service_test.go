@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reportconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/cool-project/locations/us-central1/reportConfigs/abc"
+
+func TestService(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/projects/cool-project/locations/us-central1/reportConfigs":
+			rc := &ReportConfig{}
+			_ = json.NewDecoder(r.Body).Decode(rc)
+			rc.Name = testName
+			_ = json.NewEncoder(w).Encode(rc)
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/"+testName && r.URL.Query().Get("updateMask") == "displayName":
+			_ = json.NewEncoder(w).Encode(&ReportConfig{Name: testName, DisplayName: "updated"})
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/"+testName:
+			_, _ = w.Write([]byte("{}"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	s, err := NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("NewService(...): %s", err)
+	}
+
+	got, err := s.Create(context.Background(), "projects/cool-project/locations/us-central1", &ReportConfig{DisplayName: "inventory"})
+	if err != nil {
+		t.Fatalf("Create(...): %s", err)
+	}
+	if diff := cmp.Diff(&ReportConfig{Name: testName, DisplayName: "inventory"}, got); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+
+	got, err = s.Patch(context.Background(), testName, "displayName", &ReportConfig{DisplayName: "updated"})
+	if err != nil {
+		t.Fatalf("Patch(...): %s", err)
+	}
+	if diff := cmp.Diff(&ReportConfig{Name: testName, DisplayName: "updated"}, got); diff != "" {
+		t.Errorf("Patch(...): -want, +got:\n%s", diff)
+	}
+
+	if err := s.Delete(context.Background(), testName); err != nil {
+		t.Errorf("Delete(...): %s", err)
+	}
+
+	if _, err := s.Get(context.Background(), testName); !gcp.IsErrorNotFound(err) {
+		t.Errorf("Get(...): want not found error, got %v", err)
+	}
+}
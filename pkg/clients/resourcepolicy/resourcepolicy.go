@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicy
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// GenerateResourcePolicy takes a ResourcePolicyParameters and returns
+// *compute.ResourcePolicy. It assigns only the fields that are writable, i.e.
+// not labelled as [Output Only] in Google's reference.
+func GenerateResourcePolicy(name string, in v1alpha1.ResourcePolicyParameters) *compute.ResourcePolicy {
+	return &compute.ResourcePolicy{
+		Name:                   name,
+		Description:            gcp.StringValue(in.Description),
+		SnapshotSchedulePolicy: GenerateSnapshotSchedulePolicy(in.SnapshotSchedulePolicy),
+	}
+}
+
+// GenerateSnapshotSchedulePolicy takes a SnapshotSchedulePolicy and returns
+// *compute.ResourcePolicySnapshotSchedulePolicy.
+func GenerateSnapshotSchedulePolicy(in v1alpha1.SnapshotSchedulePolicy) *compute.ResourcePolicySnapshotSchedulePolicy {
+	p := &compute.ResourcePolicySnapshotSchedulePolicy{
+		Schedule: &compute.ResourcePolicySnapshotSchedulePolicySchedule{},
+	}
+	if s := in.Schedule.HourlySchedule; s != nil {
+		p.Schedule.HourlySchedule = &compute.ResourcePolicyHourlyCycle{HoursInCycle: s.HoursInCycle, StartTime: s.StartTime}
+	}
+	if s := in.Schedule.DailySchedule; s != nil {
+		p.Schedule.DailySchedule = &compute.ResourcePolicyDailyCycle{DaysInCycle: s.DaysInCycle, StartTime: s.StartTime}
+	}
+	if s := in.Schedule.WeeklySchedule; s != nil {
+		p.Schedule.WeeklySchedule = &compute.ResourcePolicyWeeklyCycle{}
+		for _, d := range s.DayOfWeeks {
+			p.Schedule.WeeklySchedule.DayOfWeeks = append(p.Schedule.WeeklySchedule.DayOfWeeks, &compute.ResourcePolicyWeeklyCycleDayOfWeek{Day: d.Day, StartTime: d.StartTime})
+		}
+	}
+	if r := in.RetentionPolicy; r != nil {
+		p.RetentionPolicy = &compute.ResourcePolicySnapshotSchedulePolicyRetentionPolicy{
+			MaxRetentionDays:   r.MaxRetentionDays,
+			OnSourceDiskDelete: gcp.StringValue(r.OnSourceDiskDelete),
+		}
+	}
+	if sp := in.SnapshotProperties; sp != nil {
+		p.SnapshotProperties = &compute.ResourcePolicySnapshotSchedulePolicySnapshotProperties{
+			Labels:           sp.Labels,
+			StorageLocations: sp.StorageLocations,
+			GuestFlush:       gcp.BoolValue(sp.GuestFlush),
+		}
+		if sp.GuestFlush != nil {
+			p.SnapshotProperties.ForceSendFields = []string{"GuestFlush"}
+		}
+	}
+	return p
+}
+
+// GenerateObservation produces ResourcePolicyObservation object from
+// compute.ResourcePolicy object.
+func GenerateObservation(in compute.ResourcePolicy) v1alpha1.ResourcePolicyObservation {
+	return v1alpha1.ResourcePolicyObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.ResourcePolicy object.
+func LateInitializeSpec(p *v1alpha1.ResourcePolicyParameters, observed compute.ResourcePolicy) {
+	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
+	o := observed.SnapshotSchedulePolicy
+	if o == nil {
+		return
+	}
+	if r := o.RetentionPolicy; r != nil {
+		if p.SnapshotSchedulePolicy.RetentionPolicy == nil {
+			p.SnapshotSchedulePolicy.RetentionPolicy = &v1alpha1.SnapshotRetentionPolicy{MaxRetentionDays: r.MaxRetentionDays}
+		}
+		rp := p.SnapshotSchedulePolicy.RetentionPolicy
+		rp.OnSourceDiskDelete = gcp.LateInitializeString(rp.OnSourceDiskDelete, r.OnSourceDiskDelete)
+	}
+	if sp := o.SnapshotProperties; sp != nil {
+		if p.SnapshotSchedulePolicy.SnapshotProperties == nil {
+			p.SnapshotSchedulePolicy.SnapshotProperties = &v1alpha1.SnapshotProperties{}
+		}
+		pp := p.SnapshotSchedulePolicy.SnapshotProperties
+		pp.Labels = gcp.LateInitializeStringMap(pp.Labels, sp.Labels)
+		pp.StorageLocations = gcp.LateInitializeStringSlice(pp.StorageLocations, sp.StorageLocations)
+		pp.GuestFlush = gcp.LateInitializeBool(pp.GuestFlush, sp.GuestFlush)
+	}
+}
+
+// IsUpToDate returns true if the snapshot schedule of the observed resource
+// policy matches the desired one. The durations of schedules are chosen by
+// Google Cloud and are ignored.
+func IsUpToDate(in v1alpha1.ResourcePolicyParameters, observed compute.ResourcePolicy) bool {
+	return cmp.Equal(GenerateSnapshotSchedulePolicy(in.SnapshotSchedulePolicy), observed.SnapshotSchedulePolicy,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(compute.ResourcePolicyHourlyCycle{}, "Duration"),
+		cmpopts.IgnoreFields(compute.ResourcePolicyDailyCycle{}, "Duration"),
+		cmpopts.IgnoreFields(compute.ResourcePolicyWeeklyCycleDayOfWeek{}, "Duration"),
+		cmpopts.IgnoreFields(compute.ResourcePolicySnapshotSchedulePolicySnapshotProperties{}, "ChainName", "ForceSendFields"),
+	)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func params() v1alpha1.ResourcePolicyParameters {
+	return v1alpha1.ResourcePolicyParameters{
+		Region: "us-central1",
+		SnapshotSchedulePolicy: v1alpha1.SnapshotSchedulePolicy{
+			Schedule: v1alpha1.SnapshotSchedule{
+				DailySchedule: &v1alpha1.DailyCycle{DaysInCycle: 1, StartTime: "04:00"},
+			},
+			RetentionPolicy: &v1alpha1.SnapshotRetentionPolicy{MaxRetentionDays: 14},
+		},
+	}
+}
+
+func observed() compute.ResourcePolicy {
+	return compute.ResourcePolicy{
+		SnapshotSchedulePolicy: &compute.ResourcePolicySnapshotSchedulePolicy{
+			Schedule: &compute.ResourcePolicySnapshotSchedulePolicySchedule{
+				DailySchedule: &compute.ResourcePolicyDailyCycle{DaysInCycle: 1, StartTime: "04:00", Duration: "PT14400S"},
+			},
+			RetentionPolicy: &compute.ResourcePolicySnapshotSchedulePolicyRetentionPolicy{
+				MaxRetentionDays:   14,
+				OnSourceDiskDelete: "KEEP_AUTO_SNAPSHOTS",
+			},
+		},
+	}
+}
+
+func TestGenerateResourcePolicy(t *testing.T) {
+	in := params()
+	in.SnapshotSchedulePolicy.SnapshotProperties = &v1alpha1.SnapshotProperties{
+		Labels:     map[string]string{"team": "data"},
+		GuestFlush: gcp.BoolPtr(false),
+	}
+	want := &compute.ResourcePolicy{
+		Name: "rp",
+		SnapshotSchedulePolicy: &compute.ResourcePolicySnapshotSchedulePolicy{
+			Schedule: &compute.ResourcePolicySnapshotSchedulePolicySchedule{
+				DailySchedule: &compute.ResourcePolicyDailyCycle{DaysInCycle: 1, StartTime: "04:00"},
+			},
+			RetentionPolicy: &compute.ResourcePolicySnapshotSchedulePolicyRetentionPolicy{MaxRetentionDays: 14},
+			SnapshotProperties: &compute.ResourcePolicySnapshotSchedulePolicySnapshotProperties{
+				Labels:          map[string]string{"team": "data"},
+				ForceSendFields: []string{"GuestFlush"},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateResourcePolicy("rp", in)); diff != "" {
+		t.Errorf("GenerateResourcePolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	want := params()
+	want.SnapshotSchedulePolicy.RetentionPolicy.OnSourceDiskDelete = gcp.StringPtr("KEEP_AUTO_SNAPSHOTS")
+
+	got := params()
+	LateInitializeSpec(&got, observed())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in   func() v1alpha1.ResourcePolicyParameters
+		want bool
+	}{
+		"UpToDate": {
+			in: func() v1alpha1.ResourcePolicyParameters {
+				p := params()
+				p.SnapshotSchedulePolicy.RetentionPolicy.OnSourceDiskDelete = gcp.StringPtr("KEEP_AUTO_SNAPSHOTS")
+				return p
+			},
+			want: true,
+		},
+		"StartTimeChanged": {
+			in: func() v1alpha1.ResourcePolicyParameters {
+				p := params()
+				p.SnapshotSchedulePolicy.RetentionPolicy.OnSourceDiskDelete = gcp.StringPtr("KEEP_AUTO_SNAPSHOTS")
+				p.SnapshotSchedulePolicy.Schedule.DailySchedule.StartTime = "05:00"
+				return p
+			},
+			want: false,
+		},
+		"ScheduleKindChanged": {
+			in: func() v1alpha1.ResourcePolicyParameters {
+				p := params()
+				p.SnapshotSchedulePolicy.RetentionPolicy.OnSourceDiskDelete = gcp.StringPtr("KEEP_AUTO_SNAPSHOTS")
+				p.SnapshotSchedulePolicy.Schedule = v1alpha1.SnapshotSchedule{
+					HourlySchedule: &v1alpha1.HourlyCycle{HoursInCycle: 4, StartTime: "00:00"},
+				}
+				return p
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.in(), observed())); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
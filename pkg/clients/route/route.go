@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// Route statuses reported by the Google Compute API.
+const (
+	StatusActive  = "ACTIVE"
+	StatusDropped = "DROPPED"
+	StatusPending = "PENDING"
+)
+
+// GenerateRoute converts the supplied RouteParameters into a Route suitable
+// for use with the Google Compute API. The Route API does not support updates,
+// so any nil pointer is safely converted to its zero value.
+func GenerateRoute(name string, in v1alpha1.RouteParameters, route *compute.Route) {
+	route.Name = name
+	route.Description = gcp.StringValue(in.Description)
+	route.Network = gcp.StringValue(in.Network)
+	route.DestRange = in.DestRange
+	route.Priority = gcp.Int64Value(in.Priority)
+	route.Tags = in.Tags
+	route.NextHopGateway = gcp.StringValue(in.NextHopGateway)
+	route.NextHopInstance = gcp.StringValue(in.NextHopInstance)
+	route.NextHopIp = gcp.StringValue(in.NextHopIP)
+	route.NextHopIlb = gcp.StringValue(in.NextHopILB)
+	route.NextHopVpnTunnel = gcp.StringValue(in.NextHopVpnTunnel)
+	if in.Priority != nil {
+		// A priority of 0 is valid and must not be omitted.
+		route.ForceSendFields = []string{"Priority"}
+	}
+}
+
+// LateInitializeSpec updates any unset (i.e. nil) optional fields of the
+// supplied RouteParameters that are set (i.e. non-zero) on the supplied Route.
+func LateInitializeSpec(p *v1alpha1.RouteParameters, observed compute.Route) {
+	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
+	p.Network = gcp.LateInitializeString(p.Network, observed.Network)
+	p.Priority = gcp.LateInitializeInt64(p.Priority, observed.Priority)
+	p.Tags = gcp.LateInitializeStringSlice(p.Tags, observed.Tags)
+}
+
+// GenerateRouteObservation takes a compute.Route and returns
+// *RouteObservation.
+func GenerateRouteObservation(observed compute.Route) v1alpha1.RouteObservation {
+	o := v1alpha1.RouteObservation{
+		CreationTimestamp: observed.CreationTimestamp,
+		ID:                observed.Id,
+		SelfLink:          observed.SelfLink,
+		NextHopNetwork:    observed.NextHopNetwork,
+		RouteStatus:       observed.RouteStatus,
+		RouteType:         observed.RouteType,
+	}
+	for _, w := range observed.Warnings {
+		o.Warnings = append(o.Warnings, w.Code+": "+w.Message)
+	}
+	return o
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+)
+
+var (
+	name              = "coolName"
+	description       = "coolDescription"
+	network           = "coolNetwork"
+	destRange         = "10.0.0.0/8"
+	priority    int64 = 800
+	tags              = []string{"coolTag"}
+	nextHopIP         = "192.168.0.2"
+
+	timestamp        = "coolTime"
+	link             = "coolLink"
+	id        uint64 = 3001
+)
+
+func params(m ...func(*v1alpha1.RouteParameters)) *v1alpha1.RouteParameters {
+	o := &v1alpha1.RouteParameters{
+		Description: &description,
+		Network:     &network,
+		DestRange:   destRange,
+		Priority:    &priority,
+		Tags:        tags,
+		NextHopIP:   &nextHopIP,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func route(m ...func(*compute.Route)) *compute.Route {
+	o := &compute.Route{
+		Name:            name,
+		Description:     description,
+		Network:         network,
+		DestRange:       destRange,
+		Priority:        priority,
+		Tags:            tags,
+		NextHopIp:       nextHopIP,
+		ForceSendFields: []string{"Priority"},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func addOutputFields(r *compute.Route) {
+	r.CreationTimestamp = timestamp
+	r.Id = id
+	r.SelfLink = link
+	r.RouteStatus = StatusActive
+	r.RouteType = "STATIC"
+}
+
+func observation(m ...func(*v1alpha1.RouteObservation)) *v1alpha1.RouteObservation {
+	o := &v1alpha1.RouteObservation{
+		CreationTimestamp: timestamp,
+		ID:                id,
+		SelfLink:          link,
+		RouteStatus:       StatusActive,
+		RouteType:         "STATIC",
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateRoute(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.RouteParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.Route
+	}{
+		"AllFilled": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: route(),
+		},
+		"ZeroPriority": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.RouteParameters) {
+					var zero int64
+					p.Priority = &zero
+				}),
+			},
+			want: route(func(r *compute.Route) {
+				r.Priority = 0
+			}),
+		},
+		"PartialFilled": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.RouteParameters) {
+					p.Priority = nil
+					p.NextHopIP = nil
+					p.NextHopGateway = &network
+				}),
+			},
+			want: route(func(r *compute.Route) {
+				r.Priority = 0
+				r.NextHopIp = ""
+				r.NextHopGateway = network
+				r.ForceSendFields = nil
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.Route{}
+			GenerateRoute(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(r, tc.want); diff != "" {
+				t.Errorf("GenerateRoute(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRouteObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  compute.Route
+		out v1alpha1.RouteObservation
+	}{
+		"AllFilled": {
+			in:  *route(addOutputFields),
+			out: *observation(),
+		},
+		"Warnings": {
+			in: *route(addOutputFields, func(r *compute.Route) {
+				r.RouteStatus = StatusDropped
+				r.Warnings = []*compute.RouteWarnings{{Code: "NEXT_HOP_INSTANCE_NOT_FOUND", Message: "instance not found"}}
+			}),
+			out: *observation(func(o *v1alpha1.RouteObservation) {
+				o.RouteStatus = StatusDropped
+				o.Warnings = []string{"NEXT_HOP_INSTANCE_NOT_FOUND: instance not found"}
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateRouteObservation(tc.in)
+			if diff := cmp.Diff(r, tc.out); diff != "" {
+				t.Errorf("GenerateRouteObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.RouteParameters
+		in   compute.Route
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.RouteParameters
+	}{
+		"AllFilledNoDiff": {
+			args: args{
+				spec: params(),
+				in:   *route(),
+			},
+			want: params(),
+		},
+		"AllFilledExternalDiff": {
+			args: args{
+				spec: params(),
+				in: *route(func(r *compute.Route) {
+					r.Description = "some other description"
+				}),
+			},
+			want: params(),
+		},
+		"PartialFilled": {
+			args: args{
+				spec: params(func(p *v1alpha1.RouteParameters) {
+					p.Priority = nil
+				}),
+				in: *route(),
+			},
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.args.spec, tc.want); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
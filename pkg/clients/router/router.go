@@ -143,7 +143,9 @@ func LateInitializeSpec(spec *v1alpha1.RouterParameters, in compute.Router) { //
 	spec.EncryptedInterconnectRouter = gcp.LateInitializeBool(spec.EncryptedInterconnectRouter, in.EncryptedInterconnectRouter)
 
 	if in.Bgp != nil {
-		spec.Bgp = &v1alpha1.RouterBgp{}
+		if spec.Bgp == nil {
+			spec.Bgp = &v1alpha1.RouterBgp{}
+		}
 		spec.Bgp.AdvertiseMode = gcp.LateInitializeString(spec.Bgp.AdvertiseMode, in.Bgp.AdvertiseMode)
 		spec.Bgp.AdvertisedGroups = gcp.LateInitializeStringSlice(spec.Bgp.AdvertisedGroups, in.Bgp.AdvertisedGroups)
 		spec.Bgp.Asn = gcp.LateInitializeInt64(spec.Bgp.Asn, in.Bgp.Asn)
@@ -151,85 +153,12 @@ func LateInitializeSpec(spec *v1alpha1.RouterParameters, in compute.Router) { //
 			spec.Bgp.AdvertisedIpRanges = make([]*v1alpha1.RouterAdvertisedIpRange, len(in.Bgp.AdvertisedIpRanges))
 			for idx, ipRange := range in.Bgp.AdvertisedIpRanges {
 				spec.Bgp.AdvertisedIpRanges[idx] = &v1alpha1.RouterAdvertisedIpRange{
-					Description: &ipRange.Description,
+					Description: gcp.LateInitializeString(nil, ipRange.Description),
 					Range:       ipRange.Range,
 				}
 			}
 		}
 	}
-
-	if len(in.BgpPeers) != 0 && len(spec.BgpPeers) == 0 {
-		spec.BgpPeers = make([]*v1alpha1.RouterBgpPeer, len(in.BgpPeers))
-		for idx, peer := range in.BgpPeers {
-			spec.BgpPeers[idx] = &v1alpha1.RouterBgpPeer{
-				AdvertiseMode:           &peer.AdvertiseMode,
-				AdvertisedGroups:        peer.AdvertisedGroups,
-				AdvertisedRoutePriority: &peer.AdvertisedRoutePriority,
-				InterfaceName:           &peer.InterfaceName,
-				IpAddress:               &peer.IpAddress,
-				Name:                    peer.Name,
-				PeerAsn:                 peer.PeerAsn,
-				PeerIpAddress:           &peer.PeerIpAddress,
-			}
-			if len(peer.AdvertisedIpRanges) != 0 {
-				spec.BgpPeers[idx].AdvertisedIpRanges = make([]*v1alpha1.RouterAdvertisedIpRange, len(peer.AdvertisedIpRanges))
-				for ipIdx, ipRange := range peer.AdvertisedIpRanges {
-					spec.BgpPeers[idx].AdvertisedIpRanges[ipIdx] = &v1alpha1.RouterAdvertisedIpRange{
-						Description: &ipRange.Description,
-						Range:       ipRange.Range,
-					}
-				}
-			}
-		}
-	}
-
-	if len(in.Nats) != 0 && len(spec.Nats) == 0 {
-		spec.Nats = make([]*v1alpha1.RouterNat, len(in.Nats))
-		for idx, nat := range in.Nats {
-			spec.Nats[idx] = &v1alpha1.RouterNat{
-				DrainNatIps:                      nat.DrainNatIps,
-				EnableEndpointIndependentMapping: &nat.EnableEndpointIndependentMapping,
-				IcmpIdleTimeoutSec:               &nat.IcmpIdleTimeoutSec,
-				MinPortsPerVm:                    &nat.MinPortsPerVm,
-				Name:                             &nat.Name,
-				NatIpAllocateOption:              nat.NatIpAllocateOption,
-				NatIps:                           nat.NatIps,
-				SourceSubnetworkIpRangesToNat:    nat.SourceSubnetworkIpRangesToNat,
-				TcpEstablishedIdleTimeoutSec:     &nat.TcpEstablishedIdleTimeoutSec,
-				TcpTransitoryIdleTimeoutSec:      &nat.TcpTransitoryIdleTimeoutSec,
-				UdpIdleTimeoutSec:                &nat.UdpIdleTimeoutSec,
-			}
-			if nat.LogConfig != nil {
-				spec.Nats[idx].LogConfig = &v1alpha1.RouterNatLogConfig{
-					Enable: &nat.LogConfig.Enable,
-					Filter: &nat.LogConfig.Filter,
-				}
-			}
-
-			if nat.Subnetworks != nil {
-				spec.Nats[idx].Subnetworks = make([]*v1alpha1.RouterNatSubnetworkToNat, len(nat.Subnetworks))
-				for subnetIdx, subnet := range nat.Subnetworks {
-					spec.Nats[idx].Subnetworks[subnetIdx] = &v1alpha1.RouterNatSubnetworkToNat{
-						Name:                  &subnet.Name,
-						SecondaryIpRangeNames: subnet.SecondaryIpRangeNames,
-						SourceIpRangesToNat:   subnet.SourceIpRangesToNat,
-					}
-				}
-			}
-		}
-	}
-
-	if len(in.Interfaces) != 0 && len(spec.Interfaces) == 0 {
-		spec.Interfaces = make([]*v1alpha1.RouterInterface, len(in.Interfaces))
-		for idx, routerInterface := range in.Interfaces {
-			spec.Interfaces[idx] = &v1alpha1.RouterInterface{
-				IpRange:                      &routerInterface.IpRange,
-				LinkedInterconnectAttachment: &routerInterface.LinkedInterconnectAttachment,
-				LinkedVpnTunnel:              &routerInterface.LinkedVpnTunnel,
-				Name:                         routerInterface.Name,
-			}
-		}
-	}
 }
 
 // IsUpToDate checks whether current state is up-to-date compared to the given
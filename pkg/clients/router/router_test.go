@@ -199,6 +199,20 @@ func TestLateInitializeSpec(t *testing.T) {
 			},
 			want: params(),
 		},
+		"BgpNotOverwritten": {
+			args: args{
+				spec: params(func(p *v1alpha1.RouterParameters) {
+					p.Bgp.AdvertisedIpRanges = []*v1alpha1.RouterAdvertisedIpRange{{Range: "10.0.0.0/16"}}
+				}),
+				in: *router(func(n *compute.Router) {
+					n.Bgp.AdvertiseMode = "CUSTOM"
+					n.Bgp.AdvertisedIpRanges = []*compute.RouterAdvertisedIpRange{{Range: "10.1.0.0/16"}}
+				}),
+			},
+			want: params(func(p *v1alpha1.RouterParameters) {
+				p.Bgp.AdvertisedIpRanges = []*v1alpha1.RouterAdvertisedIpRange{{Range: "10.0.0.0/16"}}
+			}),
+		},
 		"PartialFilled": {
 			args: args{
 				spec: params(func(p *v1alpha1.RouterParameters) {
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routernat
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const errCheckUpToDate = "unable to determine if external resource is up to date"
+
+// GenerateRouterNAT takes a RouterNATParameters and assigns its values to the
+// supplied *compute.RouterNat. Fields of the NAT that are not part of the
+// parameters, e.g. its rules, are left untouched.
+func GenerateRouterNAT(name string, in v1alpha1.RouterNATParameters, nat *compute.RouterNat) {
+	nat.Name = name
+	nat.NatIpAllocateOption = in.NATIPAllocateOption
+	nat.NatIps = in.NATIPs
+	nat.DrainNatIps = in.DrainNATIPs
+	nat.SourceSubnetworkIpRangesToNat = in.SourceSubnetworkIPRangesToNAT
+	nat.MinPortsPerVm = gcp.Int64Value(in.MinPortsPerVM)
+	nat.EnableEndpointIndependentMapping = gcp.BoolValue(in.EnableEndpointIndependentMapping)
+	nat.IcmpIdleTimeoutSec = gcp.Int64Value(in.ICMPIdleTimeoutSec)
+	nat.UdpIdleTimeoutSec = gcp.Int64Value(in.UDPIdleTimeoutSec)
+	nat.TcpEstablishedIdleTimeoutSec = gcp.Int64Value(in.TCPEstablishedIdleTimeoutSec)
+	nat.TcpTransitoryIdleTimeoutSec = gcp.Int64Value(in.TCPTransitoryIdleTimeoutSec)
+
+	nat.Subnetworks = nil
+	for _, s := range in.Subnetworks {
+		nat.Subnetworks = append(nat.Subnetworks, &compute.RouterNatSubnetworkToNat{
+			Name:                  gcp.StringValue(s.Subnetwork),
+			SourceIpRangesToNat:   s.SourceIPRangesToNAT,
+			SecondaryIpRangeNames: s.SecondaryIPRangeNames,
+		})
+	}
+
+	nat.LogConfig = nil
+	if in.LogConfig != nil {
+		nat.LogConfig = &compute.RouterNatLogConfig{
+			Enable: gcp.BoolValue(in.LogConfig.Enable),
+			Filter: gcp.StringValue(in.LogConfig.Filter),
+		}
+	}
+}
+
+// GenerateObservation produces RouterNATObservation object from the status
+// Google Cloud reports for the NAT. The status may be nil if the router did
+// not report one yet.
+func GenerateObservation(in *compute.RouterStatusNatStatus) v1alpha1.RouterNATObservation {
+	if in == nil {
+		return v1alpha1.RouterNATObservation{}
+	}
+	return v1alpha1.RouterNATObservation{
+		AutoAllocatedNATIPs:           in.AutoAllocatedNatIps,
+		UserAllocatedNATIPs:           in.UserAllocatedNatIps,
+		MinExtraNATIPsNeeded:          in.MinExtraNatIpsNeeded,
+		NumVMEndpointsWithNATMappings: in.NumVmEndpointsWithNatMappings,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.RouterNat object.
+func LateInitializeSpec(p *v1alpha1.RouterNATParameters, observed compute.RouterNat) {
+	p.MinPortsPerVM = gcp.LateInitializeInt64(p.MinPortsPerVM, observed.MinPortsPerVm)
+	p.EnableEndpointIndependentMapping = gcp.LateInitializeBool(p.EnableEndpointIndependentMapping, observed.EnableEndpointIndependentMapping)
+	p.ICMPIdleTimeoutSec = gcp.LateInitializeInt64(p.ICMPIdleTimeoutSec, observed.IcmpIdleTimeoutSec)
+	p.UDPIdleTimeoutSec = gcp.LateInitializeInt64(p.UDPIdleTimeoutSec, observed.UdpIdleTimeoutSec)
+	p.TCPEstablishedIdleTimeoutSec = gcp.LateInitializeInt64(p.TCPEstablishedIdleTimeoutSec, observed.TcpEstablishedIdleTimeoutSec)
+	p.TCPTransitoryIdleTimeoutSec = gcp.LateInitializeInt64(p.TCPTransitoryIdleTimeoutSec, observed.TcpTransitoryIdleTimeoutSec)
+	if observed.LogConfig != nil && p.LogConfig == nil {
+		p.LogConfig = &v1alpha1.RouterNatLogConfig{
+			Enable: gcp.BoolPtr(observed.LogConfig.Enable),
+			Filter: gcp.LateInitializeString(nil, observed.LogConfig.Filter),
+		}
+	}
+}
+
+// IsUpToDate checks whether the observed NAT is up-to-date compared to the
+// given set of parameters.
+func IsUpToDate(name string, in v1alpha1.RouterNATParameters, observed *compute.RouterNat) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.RouterNat)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateRouterNAT(name, in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.RouterNat{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.RouterNatLogConfig{}, "ForceSendFields"),
+	), nil
+}
+
+// FindNAT returns the NAT with the supplied name from the supplied NATs of a
+// router, or nil if there is none.
+func FindNAT(nats []*compute.RouterNat, name string) *compute.RouterNat {
+	for _, n := range nats {
+		if n.Name == name {
+			return n
+		}
+	}
+	return nil
+}
+
+// FindNATStatus returns the status of the NAT with the supplied name from the
+// supplied status of a router, or nil if there is none.
+func FindNATStatus(status *compute.RouterStatus, name string) *compute.RouterStatusNatStatus {
+	if status == nil {
+		return nil
+	}
+	for _, s := range status.NatStatus {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// WithNAT returns the supplied NATs of a router with the supplied NAT added,
+// replacing any existing NAT with the same name.
+func WithNAT(nats []*compute.RouterNat, nat *compute.RouterNat) []*compute.RouterNat {
+	out := make([]*compute.RouterNat, 0, len(nats)+1)
+	found := false
+	for _, n := range nats {
+		if n.Name == nat.Name {
+			n, found = nat, true
+		}
+		out = append(out, n)
+	}
+	if !found {
+		out = append(out, nat)
+	}
+	return out
+}
+
+// WithoutNAT returns the supplied NATs of a router without the NAT with the
+// supplied name.
+func WithoutNAT(nats []*compute.RouterNat, name string) []*compute.RouterNat {
+	out := make([]*compute.RouterNat, 0, len(nats))
+	for _, n := range nats {
+		if n.Name != name {
+			out = append(out, n)
+		}
+	}
+	return out
+}
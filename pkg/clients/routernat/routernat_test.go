@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routernat
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	name      = "test-nat"
+	natIP     = "projects/test-project/regions/us-central1/addresses/nat-ip"
+	subnetURL = "projects/test-project/regions/us-central1/subnetworks/subnet"
+)
+
+func params() v1alpha1.RouterNATParameters {
+	return v1alpha1.RouterNATParameters{
+		Region:                        "us-central1",
+		Router:                        gcp.StringPtr("test-router"),
+		NATIPAllocateOption:           v1alpha1.NATIPAllocateManualOnly,
+		NATIPs:                        []string{natIP},
+		SourceSubnetworkIPRangesToNAT: "LIST_OF_SUBNETWORKS",
+		Subnetworks: []v1alpha1.RouterNATSubnetwork{{
+			Subnetwork:          gcp.StringPtr(subnetURL),
+			SourceIPRangesToNAT: []string{"ALL_IP_RANGES"},
+		}},
+		MinPortsPerVM: gcp.Int64Ptr(128),
+		LogConfig: &v1alpha1.RouterNatLogConfig{
+			Enable: gcp.BoolPtr(true),
+			Filter: gcp.StringPtr("ERRORS_ONLY"),
+		},
+	}
+}
+
+// observed returns the NAT that params describes, as returned by the Compute
+// API, i.e. with fully qualified URLs and defaulted timeouts.
+func observed() *compute.RouterNat {
+	return &compute.RouterNat{
+		Name:                          name,
+		NatIpAllocateOption:           v1alpha1.NATIPAllocateManualOnly,
+		NatIps:                        []string{"https://www.googleapis.com/compute/v1/" + natIP},
+		SourceSubnetworkIpRangesToNat: "LIST_OF_SUBNETWORKS",
+		Subnetworks: []*compute.RouterNatSubnetworkToNat{{
+			Name:                "https://www.googleapis.com/compute/v1/" + subnetURL,
+			SourceIpRangesToNat: []string{"ALL_IP_RANGES"},
+		}},
+		MinPortsPerVm:                128,
+		IcmpIdleTimeoutSec:           30,
+		UdpIdleTimeoutSec:            30,
+		TcpEstablishedIdleTimeoutSec: 1200,
+		TcpTransitoryIdleTimeoutSec:  30,
+		LogConfig:                    &compute.RouterNatLogConfig{Enable: true, Filter: "ERRORS_ONLY"},
+	}
+}
+
+func TestGenerateRouterNAT(t *testing.T) {
+	got := &compute.RouterNat{Rules: []*compute.RouterNatRule{{RuleNumber: 100}}}
+	GenerateRouterNAT(name, params(), got)
+
+	want := &compute.RouterNat{
+		Name:                          name,
+		NatIpAllocateOption:           v1alpha1.NATIPAllocateManualOnly,
+		NatIps:                        []string{natIP},
+		SourceSubnetworkIpRangesToNat: "LIST_OF_SUBNETWORKS",
+		Subnetworks: []*compute.RouterNatSubnetworkToNat{{
+			Name:                subnetURL,
+			SourceIpRangesToNat: []string{"ALL_IP_RANGES"},
+		}},
+		MinPortsPerVm: 128,
+		LogConfig:     &compute.RouterNatLogConfig{Enable: true, Filter: "ERRORS_ONLY"},
+		Rules:         []*compute.RouterNatRule{{RuleNumber: 100}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateRouterNAT(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	got := params()
+	got.LogConfig = nil
+	LateInitializeSpec(&got, *observed())
+
+	want := params()
+	want.ICMPIdleTimeoutSec = gcp.Int64Ptr(30)
+	want.UDPIdleTimeoutSec = gcp.Int64Ptr(30)
+	want.TCPEstablishedIdleTimeoutSec = gcp.Int64Ptr(1200)
+	want.TCPTransitoryIdleTimeoutSec = gcp.Int64Ptr(30)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in   func(*v1alpha1.RouterNATParameters)
+		want bool
+	}{
+		"UpToDate": {
+			in: func(p *v1alpha1.RouterNATParameters) {
+				p.ICMPIdleTimeoutSec = gcp.Int64Ptr(30)
+				p.UDPIdleTimeoutSec = gcp.Int64Ptr(30)
+				p.TCPEstablishedIdleTimeoutSec = gcp.Int64Ptr(1200)
+				p.TCPTransitoryIdleTimeoutSec = gcp.Int64Ptr(30)
+			},
+			want: true,
+		},
+		"DefaultsNotLateInitializedYet": {
+			in:   func(p *v1alpha1.RouterNATParameters) {},
+			want: false,
+		},
+		"NATIPAdded": {
+			in: func(p *v1alpha1.RouterNATParameters) {
+				p.ICMPIdleTimeoutSec = gcp.Int64Ptr(30)
+				p.UDPIdleTimeoutSec = gcp.Int64Ptr(30)
+				p.TCPEstablishedIdleTimeoutSec = gcp.Int64Ptr(1200)
+				p.TCPTransitoryIdleTimeoutSec = gcp.Int64Ptr(30)
+				p.NATIPs = append(p.NATIPs, "projects/test-project/regions/us-central1/addresses/nat-ip-2")
+			},
+			want: false,
+		},
+		"LoggingDisabled": {
+			in: func(p *v1alpha1.RouterNATParameters) {
+				p.ICMPIdleTimeoutSec = gcp.Int64Ptr(30)
+				p.UDPIdleTimeoutSec = gcp.Int64Ptr(30)
+				p.TCPEstablishedIdleTimeoutSec = gcp.Int64Ptr(1200)
+				p.TCPTransitoryIdleTimeoutSec = gcp.Int64Ptr(30)
+				p.LogConfig.Enable = gcp.BoolPtr(false)
+			},
+			want: false,
+		},
+	}
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			in := params()
+			tc.in(&in)
+			got, err := IsUpToDate(name, in, observed())
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithNAT(t *testing.T) {
+	nats := []*compute.RouterNat{{Name: "a"}, {Name: name, MinPortsPerVm: 64}, {Name: "b"}}
+
+	cases := map[string]struct {
+		nats []*compute.RouterNat
+		want []*compute.RouterNat
+	}{
+		"Added": {
+			nats: []*compute.RouterNat{{Name: "a"}},
+			want: []*compute.RouterNat{{Name: "a"}, {Name: name, MinPortsPerVm: 128}},
+		},
+		"Replaced": {
+			nats: nats,
+			want: []*compute.RouterNat{{Name: "a"}, {Name: name, MinPortsPerVm: 128}, {Name: "b"}},
+		},
+	}
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := WithNAT(tc.nats, &compute.RouterNat{Name: name, MinPortsPerVm: 128})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("WithNAT(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithoutNAT(t *testing.T) {
+	got := WithoutNAT([]*compute.RouterNat{{Name: "a"}, {Name: name}}, name)
+	if diff := cmp.Diff([]*compute.RouterNat{{Name: "a"}}, got); diff != "" {
+		t.Errorf("WithoutNAT(...): -want, +got:\n%s", diff)
+	}
+}
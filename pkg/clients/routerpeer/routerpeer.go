@@ -0,0 +1,228 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routerpeer
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const errCheckUpToDate = "unable to determine if external resource is up to date"
+
+// Values of the enable field of a BGP peer.
+const (
+	enableTrue  = "TRUE"
+	enableFalse = "FALSE"
+)
+
+// GenerateRouterPeer takes a RouterPeerParameters and assigns its values to
+// the supplied *compute.RouterBgpPeer. Fields of the peer that are not part of
+// the parameters, e.g. its BFD settings, are left untouched.
+func GenerateRouterPeer(name string, in v1alpha1.RouterPeerParameters, peer *compute.RouterBgpPeer) {
+	peer.Name = name
+	peer.InterfaceName = in.InterfaceName
+	peer.PeerAsn = in.PeerASN
+	peer.PeerIpAddress = gcp.StringValue(in.PeerIPAddress)
+	peer.IpAddress = gcp.StringValue(in.IPAddress)
+	peer.AdvertiseMode = gcp.StringValue(in.AdvertiseMode)
+	peer.AdvertisedGroups = in.AdvertisedGroups
+	peer.AdvertisedRoutePriority = gcp.Int64Value(in.AdvertisedRoutePriority)
+	peer.EnableIpv6 = gcp.BoolValue(in.EnableIPv6)
+
+	peer.AdvertisedIpRanges = nil
+	for _, r := range in.AdvertisedIPRanges {
+		peer.AdvertisedIpRanges = append(peer.AdvertisedIpRanges, &compute.RouterAdvertisedIpRange{
+			Description: gcp.StringValue(r.Description),
+			Range:       r.Range,
+		})
+	}
+
+	peer.Enable = ""
+	if in.Enable != nil {
+		peer.Enable = enableFalse
+		if *in.Enable {
+			peer.Enable = enableTrue
+		}
+	}
+}
+
+// GenerateRouterInterface takes a RouterPeerInterface and assigns its values
+// to the supplied *compute.RouterInterface.
+func GenerateRouterInterface(name string, in v1alpha1.RouterPeerInterface, iface *compute.RouterInterface) {
+	iface.Name = name
+	iface.IpRange = gcp.StringValue(in.IPRange)
+	iface.LinkedVpnTunnel = gcp.StringValue(in.LinkedVPNTunnel)
+	iface.LinkedInterconnectAttachment = gcp.StringValue(in.LinkedInterconnectAttachment)
+}
+
+// GenerateObservation produces RouterPeerObservation object from the status
+// Google Cloud reports for the BGP peer. The status may be nil if the router
+// did not report one yet.
+func GenerateObservation(in *compute.RouterStatusBgpPeerStatus) v1alpha1.RouterPeerObservation {
+	if in == nil {
+		return v1alpha1.RouterPeerObservation{}
+	}
+	return v1alpha1.RouterPeerObservation{
+		Status:           in.Status,
+		StatusReason:     in.StatusReason,
+		State:            in.State,
+		Uptime:           in.Uptime,
+		NumLearnedRoutes: in.NumLearnedRoutes,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.RouterBgpPeer object.
+func LateInitializeSpec(p *v1alpha1.RouterPeerParameters, observed compute.RouterBgpPeer) {
+	p.PeerIPAddress = gcp.LateInitializeString(p.PeerIPAddress, observed.PeerIpAddress)
+	p.IPAddress = gcp.LateInitializeString(p.IPAddress, observed.IpAddress)
+	p.AdvertiseMode = gcp.LateInitializeString(p.AdvertiseMode, observed.AdvertiseMode)
+	p.AdvertisedRoutePriority = gcp.LateInitializeInt64(p.AdvertisedRoutePriority, observed.AdvertisedRoutePriority)
+	if p.Enable == nil && observed.Enable != "" {
+		p.Enable = gcp.BoolPtr(observed.Enable == enableTrue)
+	}
+}
+
+// IsUpToDate checks whether the observed BGP peer, and the observed interface
+// if the parameters include one, are up-to-date compared to the given set of
+// parameters. The supplied interface may be nil if the router has none with
+// the interface name of the parameters.
+func IsUpToDate(name string, in v1alpha1.RouterPeerParameters, observed *compute.RouterBgpPeer, iface *compute.RouterInterface) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.RouterBgpPeer)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateRouterPeer(name, in, desired)
+	if !cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(compute.RouterBgpPeer{}, "ForceSendFields")) {
+		return false, nil
+	}
+	if in.Interface == nil {
+		return true, nil
+	}
+	if iface == nil {
+		return false, nil
+	}
+	desiredIface := &compute.RouterInterface{}
+	GenerateRouterInterface(in.InterfaceName, *in.Interface, desiredIface)
+	return cmp.Equal(desiredIface, iface, gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.RouterInterface{}, "IpVersion", "ManagementType", "PrivateIpAddress", "RedundantInterface", "Subnetwork", "ForceSendFields", "NullFields"),
+	), nil
+}
+
+// FindPeer returns the BGP peer with the supplied name from the supplied peers
+// of a router, or nil if there is none.
+func FindPeer(peers []*compute.RouterBgpPeer, name string) *compute.RouterBgpPeer {
+	for _, p := range peers {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// FindInterface returns the interface with the supplied name from the
+// supplied interfaces of a router, or nil if there is none.
+func FindInterface(ifaces []*compute.RouterInterface, name string) *compute.RouterInterface {
+	for _, i := range ifaces {
+		if i.Name == name {
+			return i
+		}
+	}
+	return nil
+}
+
+// FindPeerStatus returns the status of the BGP peer with the supplied name
+// from the supplied status of a router, or nil if there is none.
+func FindPeerStatus(status *compute.RouterStatus, name string) *compute.RouterStatusBgpPeerStatus {
+	if status == nil {
+		return nil
+	}
+	for _, s := range status.BgpPeerStatus {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// WithPeer returns the supplied BGP peers of a router with the supplied peer
+// added, replacing any existing peer with the same name.
+func WithPeer(peers []*compute.RouterBgpPeer, peer *compute.RouterBgpPeer) []*compute.RouterBgpPeer {
+	out := make([]*compute.RouterBgpPeer, 0, len(peers)+1)
+	found := false
+	for _, p := range peers {
+		if p.Name == peer.Name {
+			p, found = peer, true
+		}
+		out = append(out, p)
+	}
+	if !found {
+		out = append(out, peer)
+	}
+	return out
+}
+
+// WithoutPeer returns the supplied BGP peers of a router without the peer with
+// the supplied name.
+func WithoutPeer(peers []*compute.RouterBgpPeer, name string) []*compute.RouterBgpPeer {
+	out := make([]*compute.RouterBgpPeer, 0, len(peers))
+	for _, p := range peers {
+		if p.Name != name {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// WithInterface returns the supplied interfaces of a router with the supplied
+// interface added, replacing any existing interface with the same name.
+func WithInterface(ifaces []*compute.RouterInterface, iface *compute.RouterInterface) []*compute.RouterInterface {
+	out := make([]*compute.RouterInterface, 0, len(ifaces)+1)
+	found := false
+	for _, i := range ifaces {
+		if i.Name == iface.Name {
+			i, found = iface, true
+		}
+		out = append(out, i)
+	}
+	if !found {
+		out = append(out, iface)
+	}
+	return out
+}
+
+// WithoutInterface returns the supplied interfaces of a router without the
+// interface with the supplied name.
+func WithoutInterface(ifaces []*compute.RouterInterface, name string) []*compute.RouterInterface {
+	out := make([]*compute.RouterInterface, 0, len(ifaces))
+	for _, i := range ifaces {
+		if i.Name != name {
+			out = append(out, i)
+		}
+	}
+	return out
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routerpeer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testName      = "test-peer"
+	testInterface = "test-interface"
+	testTunnel    = "projects/test-project/regions/us-central1/vpnTunnels/test-tunnel"
+)
+
+func params(m ...func(*v1alpha1.RouterPeerParameters)) v1alpha1.RouterPeerParameters {
+	p := v1alpha1.RouterPeerParameters{
+		Region:        "us-central1",
+		InterfaceName: testInterface,
+		Interface: &v1alpha1.RouterPeerInterface{
+			IPRange:         gcp.StringPtr("169.254.0.1/30"),
+			LinkedVPNTunnel: gcp.StringPtr(testTunnel),
+		},
+		PeerASN:            65001,
+		PeerIPAddress:      gcp.StringPtr("169.254.0.2"),
+		AdvertiseMode:      gcp.StringPtr("CUSTOM"),
+		AdvertisedIPRanges: []v1alpha1.RouterAdvertisedIpRange{{Range: "10.0.0.0/16"}},
+		Enable:             gcp.BoolPtr(true),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func peer(m ...func(*compute.RouterBgpPeer)) *compute.RouterBgpPeer {
+	p := &compute.RouterBgpPeer{
+		Name:               testName,
+		InterfaceName:      testInterface,
+		PeerAsn:            65001,
+		PeerIpAddress:      "169.254.0.2",
+		AdvertiseMode:      "CUSTOM",
+		AdvertisedIpRanges: []*compute.RouterAdvertisedIpRange{{Range: "10.0.0.0/16"}},
+		Enable:             "TRUE",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func iface() *compute.RouterInterface {
+	return &compute.RouterInterface{
+		Name:            testInterface,
+		IpRange:         "169.254.0.1/30",
+		LinkedVpnTunnel: "https://www.googleapis.com/compute/v1/" + testTunnel,
+	}
+}
+
+func TestGenerateRouterPeer(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.RouterPeerParameters
+		want *compute.RouterBgpPeer
+	}{
+		"Enabled": {
+			in:   params(),
+			want: peer(),
+		},
+		"Disabled": {
+			in:   params(func(p *v1alpha1.RouterPeerParameters) { p.Enable = gcp.BoolPtr(false) }),
+			want: peer(func(p *compute.RouterBgpPeer) { p.Enable = "FALSE" }),
+		},
+		"EnableUnset": {
+			in:   params(func(p *v1alpha1.RouterPeerParameters) { p.Enable = nil }),
+			want: peer(func(p *compute.RouterBgpPeer) { p.Enable = "" }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &compute.RouterBgpPeer{}
+			GenerateRouterPeer(testName, tc.in, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateRouterPeer(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	got := params(func(p *v1alpha1.RouterPeerParameters) { p.Enable = nil })
+	LateInitializeSpec(&got, *peer(func(p *compute.RouterBgpPeer) {
+		p.IpAddress = "169.254.0.1"
+		p.AdvertisedRoutePriority = 100
+	}))
+	want := params(func(p *v1alpha1.RouterPeerParameters) {
+		p.IPAddress = gcp.StringPtr("169.254.0.1")
+		p.AdvertisedRoutePriority = gcp.Int64Ptr(100)
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.RouterPeerParameters
+		observed *compute.RouterBgpPeer
+		iface    *compute.RouterInterface
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: peer(),
+			iface:    iface(),
+			want:     true,
+		},
+		"UnmanagedFieldsIgnored": {
+			in:       params(),
+			observed: peer(func(p *compute.RouterBgpPeer) { p.Bfd = &compute.RouterBgpPeerBfd{SessionInitializationMode: "ACTIVE"} }),
+			iface:    iface(),
+			want:     true,
+		},
+		"PeerASNChanged": {
+			in:       params(func(p *v1alpha1.RouterPeerParameters) { p.PeerASN = 65002 }),
+			observed: peer(),
+			iface:    iface(),
+			want:     false,
+		},
+		"InterfaceMissing": {
+			in:       params(),
+			observed: peer(),
+			want:     false,
+		},
+		"InterfaceRangeChanged": {
+			in:       params(func(p *v1alpha1.RouterPeerParameters) { p.Interface.IPRange = gcp.StringPtr("169.254.0.5/30") }),
+			observed: peer(),
+			iface:    iface(),
+			want:     false,
+		},
+		"InterfaceNotManaged": {
+			in:       params(func(p *v1alpha1.RouterPeerParameters) { p.Interface = nil }),
+			observed: peer(),
+			want:     true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(testName, tc.in, tc.observed, tc.iface)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithPeer(t *testing.T) {
+	other := &compute.RouterBgpPeer{Name: "other-peer"}
+	cases := map[string]struct {
+		peers []*compute.RouterBgpPeer
+		want  []*compute.RouterBgpPeer
+	}{
+		"Added": {
+			peers: []*compute.RouterBgpPeer{other},
+			want:  []*compute.RouterBgpPeer{other, peer()},
+		},
+		"Replaced": {
+			peers: []*compute.RouterBgpPeer{peer(func(p *compute.RouterBgpPeer) { p.PeerAsn = 65002 }), other},
+			want:  []*compute.RouterBgpPeer{peer(), other},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, WithPeer(tc.peers, peer())); diff != "" {
+				t.Errorf("WithPeer(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -16,7 +16,26 @@ limitations under the License.
 
 package serviceaccount
 
-import "google.golang.org/api/iam/v1"
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"google.golang.org/api/iam/v1"
+	logging "google.golang.org/api/logging/v2"
+)
+
+const (
+	emailDomainSuffix = ".iam.gserviceaccount.com"
+
+	// UndeleteWindow is how long after its deletion a service account can be
+	// undeleted.
+	UndeleteWindow = 30 * 24 * time.Hour
+
+	deletionFilterFormat = `logName="projects/%s/logs/cloudaudit.googleapis.com%%2Factivity" AND protoPayload.methodName="google.iam.admin.v1.DeleteServiceAccount" AND resource.labels.email_id="%s" AND timestamp>="%s"`
+	labelUniqueID        = "unique_id"
+)
 
 // Client should be satisfied to conduct SA operations.
 type Client interface {
@@ -24,4 +43,42 @@ type Client interface {
 	Get(name string) *iam.ProjectsServiceAccountsGetCall
 	Patch(name string, patchserviceaccountrequest *iam.PatchServiceAccountRequest) *iam.ProjectsServiceAccountsPatchCall
 	Delete(name string) *iam.ProjectsServiceAccountsDeleteCall
+	Undelete(name string, undeleteserviceaccountrequest *iam.UndeleteServiceAccountRequest) *iam.ProjectsServiceAccountsUndeleteCall
+}
+
+// AccountIDFromExternalName converts an external name in the legacy email
+// (name@project.iam.gserviceaccount.com) or relative resource name
+// (projects/project/serviceAccounts/email) format to the account ID that is
+// used as the external name of a ServiceAccount. It returns false if the
+// supplied external name is already an account ID.
+func AccountIDFromExternalName(name string) (string, bool) {
+	email := path.Base(name)
+	id, domain, ok := strings.Cut(email, "@")
+	if !ok || !strings.HasSuffix(domain, emailDomainSuffix) {
+		return name, false
+	}
+	return id, true
+}
+
+// DeletionFilter returns the Cloud Logging filter that matches the Admin
+// Activity audit log entries of deletions of the service account with the
+// supplied email that may still be undone at the supplied time.
+func DeletionFilter(projectID, email string, now time.Time) string {
+	return fmt.Sprintf(deletionFilterFormat, projectID, email, now.Add(-UndeleteWindow).UTC().Format(time.RFC3339))
+}
+
+// DeletedUniqueID returns the unique ID of the service account whose deletion
+// is recorded by the supplied audit log entry. A deleted service account can
+// only be undeleted by its unique ID, as its email may be reused.
+func DeletedUniqueID(e *logging.LogEntry) string {
+	if e == nil || e.Resource == nil {
+		return ""
+	}
+	return e.Resource.Labels[labelUniqueID]
+}
+
+// UniqueIDName returns the relative resource name of the service account with
+// the supplied unique ID.
+func UniqueIDName(projectID, uniqueID string) string {
+	return fmt.Sprintf("projects/%s/serviceAccounts/%s", projectID, uniqueID)
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	logging "google.golang.org/api/logging/v2"
+)
+
+func TestAccountIDFromExternalName(t *testing.T) {
+	type want struct {
+		id     string
+		legacy bool
+	}
+
+	cases := map[string]struct {
+		name string
+		want want
+	}{
+		"AccountID": {
+			name: "my-sa",
+			want: want{id: "my-sa"},
+		},
+		"Email": {
+			name: "my-sa@my-project.iam.gserviceaccount.com",
+			want: want{id: "my-sa", legacy: true},
+		},
+		"RelativeResourceName": {
+			name: "projects/my-project/serviceAccounts/my-sa@my-project.iam.gserviceaccount.com",
+			want: want{id: "my-sa", legacy: true},
+		},
+		"OtherDomain": {
+			name: "my-project@appspot.gserviceaccount.com",
+			want: want{id: "my-project@appspot.gserviceaccount.com"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, legacy := AccountIDFromExternalName(tc.name)
+			if diff := cmp.Diff(tc.want, want{id: id, legacy: legacy}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("AccountIDFromExternalName(%q): -want, +got:\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+func TestDeletionFilter(t *testing.T) {
+	now := time.Date(2024, 5, 31, 12, 0, 0, 0, time.UTC)
+	want := `logName="projects/my-project/logs/cloudaudit.googleapis.com%2Factivity" AND protoPayload.methodName="google.iam.admin.v1.DeleteServiceAccount" AND resource.labels.email_id="my-sa@my-project.iam.gserviceaccount.com" AND timestamp>="2024-05-01T12:00:00Z"`
+	if diff := cmp.Diff(want, DeletionFilter("my-project", "my-sa@my-project.iam.gserviceaccount.com", now)); diff != "" {
+		t.Errorf("DeletionFilter(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestDeletedUniqueID(t *testing.T) {
+	cases := map[string]struct {
+		entry *logging.LogEntry
+		want  string
+	}{
+		"NoEntry": {},
+		"NoResource": {
+			entry: &logging.LogEntry{},
+		},
+		"UniqueID": {
+			entry: &logging.LogEntry{Resource: &logging.MonitoredResource{Labels: map[string]string{"unique_id": "123"}}},
+			want:  "123",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, DeletedUniqueID(tc.entry)); diff != "" {
+				t.Errorf("DeletedUniqueID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,12 +19,21 @@ package serviceaccountkey
 import (
 	"net/url"
 	"path"
+	"time"
 
 	"google.golang.org/api/iam/v1"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 )
 
+// DefaultRotationGracePeriod is how long the previous key is kept after a
+// rotation if no grace period is configured.
+const DefaultRotationGracePeriod = 24 * time.Hour
+
+// DefaultPrivateKeySecretKey is the key of the connection secret the private
+// key is written to if no key is configured.
+const DefaultPrivateKeySecretKey = "privateKey"
+
 // Client should be satisfied to conduct ServiceAccountKey operations.
 type Client interface {
 	Create(name string, createserviceaccountkeyrequest *iam.CreateServiceAccountKeyRequest) *iam.ProjectsServiceAccountsKeysCreateCall
@@ -63,3 +72,42 @@ func PopulateSaKey(cr *v1alpha1.ServiceAccountKey, fromProvider *iam.ServiceAcco
 
 	return nil
 }
+
+// RotationGracePeriod returns how long the previous key is kept after a
+// rotation of a key with the supplied parameters.
+func RotationGracePeriod(in v1alpha1.ServiceAccountKeyParameters) time.Duration {
+	if in.RotationGracePeriod == nil {
+		return DefaultRotationGracePeriod
+	}
+	return in.RotationGracePeriod.Duration
+}
+
+// PrivateKeySecretKey returns the key of the connection secret the private
+// key of a key with the supplied parameters is written to.
+func PrivateKeySecretKey(in v1alpha1.ServiceAccountKeyParameters) string {
+	if in.PrivateKeySecretKey == nil || *in.PrivateKeySecretKey == "" {
+		return DefaultPrivateKeySecretKey
+	}
+	return *in.PrivateKeySecretKey
+}
+
+// IsRotationDue returns true if the observed key is to be rotated at the
+// supplied time, i.e. if it is older than the rotation period or expires
+// within the grace period. Keys are not rotated while the previous key is
+// kept.
+func IsRotationDue(in v1alpha1.ServiceAccountKeyParameters, o v1alpha1.ServiceAccountKeyObservation, now time.Time) bool {
+	if in.RotationPeriod == nil || o.PreviousKeyID != "" {
+		return false
+	}
+	if t, err := time.Parse(time.RFC3339, o.ValidAfterTime); err == nil && !now.Before(t.Add(in.RotationPeriod.Duration)) {
+		return true
+	}
+	t, err := time.Parse(time.RFC3339, o.ValidBeforeTime)
+	return err == nil && !now.Before(t.Add(-RotationGracePeriod(in)))
+}
+
+// IsPreviousKeyDue returns true if the key replaced by the last rotation is
+// to be deleted at the supplied time.
+func IsPreviousKeyDue(o v1alpha1.ServiceAccountKeyObservation, now time.Time) bool {
+	return o.PreviousKeyID != "" && (o.PreviousKeyDeleteTime == nil || !now.Before(o.PreviousKeyDeleteTime.Time))
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccountkey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+var now = time.Date(2023, 6, 15, 12, 0, 0, 0, time.UTC)
+
+func TestIsRotationDue(t *testing.T) {
+	week := &metav1.Duration{Duration: 7 * 24 * time.Hour}
+	type args struct {
+		in v1alpha1.ServiceAccountKeyParameters
+		o  v1alpha1.ServiceAccountKeyObservation
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoRotationPeriod": {
+			args: args{o: v1alpha1.ServiceAccountKeyObservation{ValidAfterTime: "2020-01-01T00:00:00Z"}},
+		},
+		"Young": {
+			args: args{
+				in: v1alpha1.ServiceAccountKeyParameters{RotationPeriod: week},
+				o:  v1alpha1.ServiceAccountKeyObservation{ValidAfterTime: "2023-06-10T00:00:00Z", ValidBeforeTime: "9999-12-31T23:59:59Z"},
+			},
+		},
+		"Old": {
+			args: args{
+				in: v1alpha1.ServiceAccountKeyParameters{RotationPeriod: week},
+				o:  v1alpha1.ServiceAccountKeyObservation{ValidAfterTime: "2023-06-08T12:00:00Z", ValidBeforeTime: "9999-12-31T23:59:59Z"},
+			},
+			want: true,
+		},
+		"ExpiresWithinGracePeriod": {
+			args: args{
+				in: v1alpha1.ServiceAccountKeyParameters{RotationPeriod: week},
+				o:  v1alpha1.ServiceAccountKeyObservation{ValidAfterTime: "2023-06-14T00:00:00Z", ValidBeforeTime: "2023-06-16T00:00:00Z"},
+			},
+			want: true,
+		},
+		"PreviousKeyKept": {
+			args: args{
+				in: v1alpha1.ServiceAccountKeyParameters{RotationPeriod: week},
+				o:  v1alpha1.ServiceAccountKeyObservation{ValidAfterTime: "2023-01-01T00:00:00Z", PreviousKeyID: "previous"},
+			},
+		},
+		"NotObserved": {
+			args: args{
+				in: v1alpha1.ServiceAccountKeyParameters{RotationPeriod: week},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsRotationDue(tc.args.in, tc.args.o, now)); diff != "" {
+				t.Errorf("IsRotationDue(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPreviousKeyDue(t *testing.T) {
+	cases := map[string]struct {
+		o    v1alpha1.ServiceAccountKeyObservation
+		want bool
+	}{
+		"NoPreviousKey": {},
+		"InGracePeriod": {
+			o: v1alpha1.ServiceAccountKeyObservation{PreviousKeyID: "previous", PreviousKeyDeleteTime: &metav1.Time{Time: now.Add(time.Hour)}},
+		},
+		"GracePeriodPassed": {
+			o:    v1alpha1.ServiceAccountKeyObservation{PreviousKeyID: "previous", PreviousKeyDeleteTime: &metav1.Time{Time: now.Add(-time.Hour)}},
+			want: true,
+		},
+		"NoDeleteTime": {
+			o:    v1alpha1.ServiceAccountKeyObservation{PreviousKeyID: "previous"},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsPreviousKeyDue(tc.o, now)); diff != "" {
+				t.Errorf("IsPreviousKeyDue(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRotationGracePeriod(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.ServiceAccountKeyParameters
+		want time.Duration
+	}{
+		"Default": {want: DefaultRotationGracePeriod},
+		"Defined": {
+			in:   v1alpha1.ServiceAccountKeyParameters{RotationGracePeriod: &metav1.Duration{Duration: time.Hour}},
+			want: time.Hour,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, RotationGracePeriod(tc.in)); diff != "" {
+				t.Errorf("RotationGracePeriod(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPrivateKeySecretKey(t *testing.T) {
+	key := "credentials.json"
+	cases := map[string]struct {
+		in   v1alpha1.ServiceAccountKeyParameters
+		want string
+	}{
+		"Default": {want: DefaultPrivateKeySecretKey},
+		"Defined": {
+			in:   v1alpha1.ServiceAccountKeyParameters{PrivateKeySecretKey: &key},
+			want: key,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, PrivateKeySecretKey(tc.in)); diff != "" {
+				t.Errorf("PrivateKeySecretKey(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/takeover"
 )
 
 const errCheckUpToDate = "unable to determine if external resource is up to date"
@@ -41,15 +42,7 @@ type Client interface {
 func GenerateServiceAccountPolicyInstance(in v1alpha1.ServiceAccountPolicyParameters, p *iam.Policy) {
 	p.Bindings = make([]*iam.Binding, len(in.Policy.Bindings))
 	for i, v := range in.Policy.Bindings {
-		p.Bindings[i] = &iam.Binding{}
-		if v.Condition != nil {
-			p.Bindings[i].Condition = &iam.Expr{
-				Description: gcp.StringValue(v.Condition.Description),
-				Expression:  v.Condition.Expression,
-				Location:    gcp.StringValue(v.Condition.Location),
-				Title:       gcp.StringValue(v.Condition.Title),
-			}
-		}
+		p.Bindings[i] = &iam.Binding{Condition: generateCondition(v.Condition)}
 		p.Bindings[i].Members = make([]string, len(v.Members))
 		copy(p.Bindings[i].Members, v.Members)
 		p.Bindings[i].Role = v.Role
@@ -84,11 +77,13 @@ func IsUpToDate(in *v1alpha1.ServiceAccountPolicyParameters, observed *iam.Polic
 	return ArePoliciesSame(desired, observed), nil
 }
 
-// ArePoliciesSame compares and returns true if two policies are same
+// ArePoliciesSame compares and returns true if two policies are same.
+// Bindings are matched on their role and condition, since the same role may
+// be bound once per condition.
 func ArePoliciesSame(p1, p2 *iam.Policy) bool {
 	return cmp.Equal(p1, p2, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreFields(iam.Policy{}, "Version"),
-		cmpopts.SortSlices(func(i, j *iam.Binding) bool { return i.Role > j.Role }),
+		cmpopts.SortSlices(func(i, j *iam.Binding) bool { return bindingKey(i) > bindingKey(j) }),
 		cmpopts.SortSlices(func(i, j string) bool { return i > j }))
 }
 
@@ -96,3 +91,130 @@ func ArePoliciesSame(p1, p2 *iam.Policy) bool {
 func IsEmpty(in *iam.Policy) bool {
 	return in.Bindings == nil && in.AuditConfigs == nil
 }
+
+// Bindings returns the bindings of the supplied policy for takeover checks.
+func Bindings(in *iam.Policy) []takeover.Binding {
+	out := make([]takeover.Binding, 0, len(in.Bindings))
+	for _, b := range in.Bindings {
+		out = append(out, takeover.Binding{Role: b.Role, Members: b.Members})
+	}
+	return out
+}
+
+// Members returns the members declared by
+// ServiceAccountPolicyMemberParameters, i.e. the union of Member and Members.
+func Members(in v1alpha1.ServiceAccountPolicyMemberParameters) []string {
+	members := make([]string, 0, len(in.Members)+1)
+	if in.Member != nil {
+		members = append(members, *in.Member)
+	}
+	for _, m := range in.Members {
+		if !contains(members, m) {
+			members = append(members, m)
+		}
+	}
+	return members
+}
+
+// BindRoleToMember updates *iam.Policy instance with
+// ServiceAccountPolicyMemberParameters. The role is bound to every declared
+// member that it is not already bound to.
+// returns true if policy changed
+func BindRoleToMember(in v1alpha1.ServiceAccountPolicyMemberParameters, p *iam.Policy) bool {
+	p.Version = iamv1alpha1.PolicyVersion
+	cond := generateCondition(in.Condition)
+	changed := false
+	for _, m := range Members(in) {
+		changed = bindMember(p, in.Role, cond, m) || changed
+	}
+	return changed
+}
+
+// bindMember binds the supplied role with the supplied condition to member.
+// returns true if policy changed
+func bindMember(p *iam.Policy, role string, cond *iam.Expr, member string) bool {
+	for _, b := range p.Bindings {
+		if b.Role == role && sameCondition(b.Condition, cond) {
+			if contains(b.Members, member) {
+				// role already bound to member, no change
+				return false
+			}
+			// role already exist, add member
+			b.Members = append(b.Members, member)
+			return true
+		}
+	}
+	// role does not exist with this condition, add binding with role,
+	// condition and member
+	p.Bindings = append(p.Bindings, &iam.Binding{
+		Role:      role,
+		Condition: cond,
+		Members:   []string{member},
+	})
+	return true
+}
+
+// UnbindRoleFromMember removes every member declared by
+// ServiceAccountPolicyMemberParameters from the binding of the role in
+// *iam.Policy, other members of the binding are kept. The binding is dropped
+// if it is left without members.
+// returns true if policy changed
+func UnbindRoleFromMember(in v1alpha1.ServiceAccountPolicyMemberParameters, p *iam.Policy) bool {
+	cond := generateCondition(in.Condition)
+	members := Members(in)
+	for i, b := range p.Bindings {
+		if b.Role != in.Role || !sameCondition(b.Condition, cond) {
+			continue
+		}
+		kept := b.Members[:0]
+		for _, m := range b.Members {
+			if !contains(members, m) {
+				kept = append(kept, m)
+			}
+		}
+		changed := len(kept) != len(b.Members)
+		b.Members = kept
+		if len(kept) == 0 {
+			p.Bindings = append(p.Bindings[:i], p.Bindings[i+1:]...)
+		}
+		return changed
+	}
+	return false
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func bindingKey(b *iam.Binding) string {
+	if b.Condition == nil {
+		return b.Role
+	}
+	return b.Role + "/" + b.Condition.Title + "/" + b.Condition.Expression
+}
+
+func generateCondition(in *iamv1alpha1.Expr) *iam.Expr {
+	if in == nil {
+		return nil
+	}
+	return &iam.Expr{
+		Description: gcp.StringValue(in.Description),
+		Expression:  in.Expression,
+		Location:    gcp.StringValue(in.Location),
+		Title:       gcp.StringValue(in.Title),
+	}
+}
+
+// sameCondition reports whether the supplied binding conditions are the same.
+// Bindings without a condition only match each other.
+func sameCondition(a, b *iam.Expr) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Expression == b.Expression && a.Title == b.Title && a.Description == b.Description && a.Location == b.Location
+}
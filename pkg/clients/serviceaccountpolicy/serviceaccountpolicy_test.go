@@ -0,0 +1,249 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccountpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/iam/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+var (
+	testRole   = "roles/iam.workloadIdentityUser"
+	testMember = "serviceAccount:my-project.svc.id.goog[default/my-ksa]"
+	testOther  = "serviceAccount:my-project.svc.id.goog[default/other-ksa]"
+
+	testCondition    = &v1alpha1.Expr{Title: &testTitle, Expression: "request.time < timestamp('2030-01-01T00:00:00Z')"}
+	testIAMCondition = &iam.Expr{Title: testTitle, Expression: "request.time < timestamp('2030-01-01T00:00:00Z')"}
+	testTitle        = "expires"
+)
+
+func TestBindRoleToMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *iam.Policy
+	}
+	cases := map[string]struct {
+		in     v1alpha1.ServiceAccountPolicyMemberParameters
+		policy *iam.Policy
+		want   want
+	}{
+		"EmptyPolicy": {
+			in:     v1alpha1.ServiceAccountPolicyMemberParameters{Role: testRole, Member: &testMember},
+			policy: &iam.Policy{},
+			want: want{
+				changed: true,
+				policy: &iam.Policy{
+					Bindings: []*iam.Binding{{Role: testRole, Members: []string{testMember}}},
+					Version:  v1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"AddToExistingBinding": {
+			in: v1alpha1.ServiceAccountPolicyMemberParameters{Role: testRole, Member: &testMember},
+			policy: &iam.Policy{
+				Bindings: []*iam.Binding{{Role: testRole, Members: []string{testOther}}},
+			},
+			want: want{
+				changed: true,
+				policy: &iam.Policy{
+					Bindings: []*iam.Binding{{Role: testRole, Members: []string{testOther, testMember}}},
+					Version:  v1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"AlreadyBound": {
+			in: v1alpha1.ServiceAccountPolicyMemberParameters{Role: testRole, Member: &testMember},
+			policy: &iam.Policy{
+				Bindings: []*iam.Binding{{Role: testRole, Members: []string{testMember}}},
+			},
+			want: want{
+				policy: &iam.Policy{
+					Bindings: []*iam.Binding{{Role: testRole, Members: []string{testMember}}},
+					Version:  v1alpha1.PolicyVersion,
+				},
+			},
+		},
+		"OtherCondition": {
+			in: v1alpha1.ServiceAccountPolicyMemberParameters{Role: testRole, Member: &testMember, Condition: testCondition},
+			policy: &iam.Policy{
+				Bindings: []*iam.Binding{{Role: testRole, Members: []string{testMember}}},
+			},
+			want: want{
+				changed: true,
+				policy: &iam.Policy{
+					Bindings: []*iam.Binding{
+						{Role: testRole, Members: []string{testMember}},
+						{Role: testRole, Members: []string{testMember}, Condition: testIAMCondition},
+					},
+					Version: v1alpha1.PolicyVersion,
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := BindRoleToMember(tc.in, tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnbindRoleFromMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *iam.Policy
+	}
+	cases := map[string]struct {
+		in     v1alpha1.ServiceAccountPolicyMemberParameters
+		policy *iam.Policy
+		want   want
+	}{
+		"KeepOtherMembers": {
+			in: v1alpha1.ServiceAccountPolicyMemberParameters{Role: testRole, Member: &testMember},
+			policy: &iam.Policy{
+				Bindings: []*iam.Binding{{Role: testRole, Members: []string{testOther, testMember}}},
+			},
+			want: want{
+				changed: true,
+				policy: &iam.Policy{
+					Bindings: []*iam.Binding{{Role: testRole, Members: []string{testOther}}},
+				},
+			},
+		},
+		"DropEmptyBinding": {
+			in: v1alpha1.ServiceAccountPolicyMemberParameters{Role: testRole, Member: &testMember},
+			policy: &iam.Policy{
+				Bindings: []*iam.Binding{
+					{Role: "roles/iam.serviceAccountUser", Members: []string{testOther}},
+					{Role: testRole, Members: []string{testMember}},
+				},
+			},
+			want: want{
+				changed: true,
+				policy: &iam.Policy{
+					Bindings: []*iam.Binding{{Role: "roles/iam.serviceAccountUser", Members: []string{testOther}}},
+				},
+			},
+		},
+		"NotBound": {
+			in: v1alpha1.ServiceAccountPolicyMemberParameters{Role: testRole, Member: &testMember, Condition: testCondition},
+			policy: &iam.Policy{
+				Bindings: []*iam.Binding{{Role: testRole, Members: []string{testMember}}},
+			},
+			want: want{
+				policy: &iam.Policy{
+					Bindings: []*iam.Binding{{Role: testRole, Members: []string{testMember}}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := UnbindRoleFromMember(tc.in, tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMembers(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.ServiceAccountPolicyMemberParameters
+		want []string
+	}{
+		"None": {
+			want: []string{},
+		},
+		"Union": {
+			in:   v1alpha1.ServiceAccountPolicyMemberParameters{Member: &testMember, Members: []string{testOther, testMember, testOther}},
+			want: []string{testMember, testOther},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Members(tc.in)); diff != "" {
+				t.Errorf("Members(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.ServiceAccountPolicyParameters
+		observed *iam.Policy
+		want     bool
+	}{
+		"ConditionalBindingsInOtherOrder": {
+			in: v1alpha1.ServiceAccountPolicyParameters{Policy: v1alpha1.Policy{Bindings: []*v1alpha1.Binding{
+				{Role: testRole, Members: []string{testMember}},
+				{Role: testRole, Members: []string{testOther}, Condition: testCondition},
+			}}},
+			observed: &iam.Policy{
+				Version: v1alpha1.PolicyVersion,
+				Bindings: []*iam.Binding{
+					{Role: testRole, Members: []string{testOther}, Condition: testIAMCondition},
+					{Role: testRole, Members: []string{testMember}},
+				},
+			},
+			want: true,
+		},
+		"ConditionDiffers": {
+			in: v1alpha1.ServiceAccountPolicyParameters{Policy: v1alpha1.Policy{Bindings: []*v1alpha1.Binding{
+				{Role: testRole, Members: []string{testMember}, Condition: testCondition},
+			}}},
+			observed: &iam.Policy{
+				Version:  v1alpha1.PolicyVersion,
+				Bindings: []*iam.Binding{{Role: testRole, Members: []string{testMember}}},
+			},
+			want: false,
+		},
+		"MembersInOtherOrder": {
+			in: v1alpha1.ServiceAccountPolicyParameters{Policy: v1alpha1.Policy{Bindings: []*v1alpha1.Binding{
+				{Role: testRole, Members: []string{testMember, testOther}},
+			}}},
+			observed: &iam.Policy{
+				Bindings: []*iam.Binding{{Role: testRole, Members: []string{testOther, testMember}}},
+			},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(&tc.in, tc.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttoken
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"path"
+	"strings"
+	"time"
+
+	iamcredentials "google.golang.org/api/iamcredentials/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// DefaultRefreshBefore is how long before its expiry a token is replaced when
+// no refresh window is specified.
+const DefaultRefreshBefore = 10 * time.Minute
+
+const (
+	errMalformedIDToken = "ID token is not a well formed JWT"
+	errDecodeIDToken    = "cannot decode ID token claims"
+)
+
+// Client should be satisfied to generate service account tokens.
+type Client interface {
+	GenerateAccessToken(name string, generateaccesstokenrequest *iamcredentials.GenerateAccessTokenRequest) *iamcredentials.ProjectsServiceAccountsGenerateAccessTokenCall
+	GenerateIdToken(name string, generateidtokenrequest *iamcredentials.GenerateIdTokenRequest) *iamcredentials.ProjectsServiceAccountsGenerateIdTokenCall // nolint:golint
+}
+
+// ResourceName returns the IAM Credentials API resource name of the supplied
+// service account, which may be either an email address or the relative
+// resource name of a ServiceAccount.
+func ResourceName(serviceAccount string) string {
+	return "projects/-/serviceAccounts/" + path.Base(serviceAccount)
+}
+
+// IsIDToken returns true if the supplied parameters describe an ID token.
+func IsIDToken(in v1alpha1.ServiceAccountTokenParameters) bool {
+	return gcp.StringValue(in.TokenType) == v1alpha1.TokenTypeIDToken
+}
+
+// GenerateAccessTokenRequest returns the request for an access token
+// described by the supplied parameters.
+func GenerateAccessTokenRequest(in v1alpha1.ServiceAccountTokenParameters) *iamcredentials.GenerateAccessTokenRequest {
+	return &iamcredentials.GenerateAccessTokenRequest{
+		Delegates: in.Delegates,
+		Lifetime:  gcp.StringValue(in.Lifetime),
+		Scope:     in.Scopes,
+	}
+}
+
+// GenerateIDTokenRequest returns the request for an ID token described by the
+// supplied parameters.
+func GenerateIDTokenRequest(in v1alpha1.ServiceAccountTokenParameters) *iamcredentials.GenerateIdTokenRequest {
+	return &iamcredentials.GenerateIdTokenRequest{
+		Audience:     gcp.StringValue(in.Audience),
+		Delegates:    in.Delegates,
+		IncludeEmail: gcp.BoolValue(in.IncludeEmail),
+	}
+}
+
+// IDTokenExpiry returns the expiry time of the supplied ID token, which is
+// only available from its exp claim.
+func IDTokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New(errMalformedIDToken)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, errDecodeIDToken)
+	}
+	claims := struct {
+		Exp int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, errors.Wrap(err, errDecodeIDToken)
+	}
+	return time.Unix(claims.Exp, 0).UTC(), nil
+}
+
+// NeedsRefresh returns true if a token that expires at the supplied time must
+// be replaced by a new one at the supplied time. Tokens whose expiry is
+// unknown always need to be refreshed.
+func NeedsRefresh(in v1alpha1.ServiceAccountTokenParameters, expireTime string, now time.Time) bool {
+	exp, err := time.Parse(time.RFC3339, expireTime)
+	if err != nil {
+		return true
+	}
+	before := DefaultRefreshBefore
+	if in.RefreshBefore != nil {
+		before = in.RefreshBefore.Duration
+	}
+	return !now.Before(exp.Add(-before))
+}
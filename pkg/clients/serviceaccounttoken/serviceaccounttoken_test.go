@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttoken
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+func TestResourceName(t *testing.T) {
+	cases := map[string]struct {
+		in   string
+		want string
+	}{
+		"Email": {
+			in:   "sa@cool-project.iam.gserviceaccount.com",
+			want: "projects/-/serviceAccounts/sa@cool-project.iam.gserviceaccount.com",
+		},
+		"RelativeResourceName": {
+			in:   "projects/cool-project/serviceAccounts/sa@cool-project.iam.gserviceaccount.com",
+			want: "projects/-/serviceAccounts/sa@cool-project.iam.gserviceaccount.com",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ResourceName(tc.in)); diff != "" {
+				t.Errorf("ResourceName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIDTokenExpiry(t *testing.T) {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"aud":"https://example.org","exp":1700000000}`))
+
+	cases := map[string]struct {
+		token string
+		want  time.Time
+		err   bool
+	}{
+		"Valid": {
+			token: "header." + claims + ".signature",
+			want:  time.Unix(1700000000, 0).UTC(),
+		},
+		"Malformed": {
+			token: "not-a-jwt",
+			err:   true,
+		},
+		"BadClaims": {
+			token: "header.!!!.signature",
+			err:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IDTokenExpiry(tc.token)
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("IDTokenExpiry(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IDTokenExpiry(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeedsRefresh(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		in         v1alpha1.ServiceAccountTokenParameters
+		expireTime string
+		want       bool
+	}{
+		"NoToken": {
+			want: true,
+		},
+		"Fresh": {
+			expireTime: "2023-01-01T12:30:00Z",
+		},
+		"WithinDefaultWindow": {
+			expireTime: "2023-01-01T12:05:00Z",
+			want:       true,
+		},
+		"WithinCustomWindow": {
+			in:         v1alpha1.ServiceAccountTokenParameters{RefreshBefore: &metav1.Duration{Duration: time.Hour}},
+			expireTime: "2023-01-01T12:30:00Z",
+			want:       true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NeedsRefresh(tc.in, tc.expireTime, now)); diff != "" {
+				t.Errorf("NeedsRefresh(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signedurl
+
+import (
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/storage"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	// DefaultTTL is how long a URL is valid for when no TTL is specified.
+	DefaultTTL = time.Hour
+
+	// DefaultRefreshBefore is how long before its expiry a URL is replaced
+	// when no refresh window is specified.
+	DefaultRefreshBefore = 10 * time.Minute
+)
+
+// Method returns the HTTP method of the URL described by the supplied
+// parameters.
+func Method(in v1alpha1.SignedURLParameters) string {
+	if in.Method == nil {
+		return http.MethodGet
+	}
+	return *in.Method
+}
+
+// GenerateSignedURLOptions returns the options to sign a V4 URL described by
+// the supplied parameters at the supplied time.
+func GenerateSignedURLOptions(in v1alpha1.SignedURLParameters, now time.Time) *storage.SignedURLOptions {
+	ttl := DefaultTTL
+	if in.TTL != nil {
+		ttl = in.TTL.Duration
+	}
+	return &storage.SignedURLOptions{
+		Scheme:      storage.SigningSchemeV4,
+		Method:      Method(in),
+		ContentType: gcp.StringValue(in.ContentType),
+		Expires:     now.Add(ttl),
+	}
+}
+
+// NeedsRefresh returns true if a URL that expires at the supplied time must be
+// replaced by a new one at the supplied time. URLs whose expiry is unknown
+// always need to be refreshed.
+func NeedsRefresh(in v1alpha1.SignedURLParameters, expireTime string, now time.Time) bool {
+	exp, err := time.Parse(time.RFC3339, expireTime)
+	if err != nil {
+		return true
+	}
+	before := DefaultRefreshBefore
+	if in.RefreshBefore != nil {
+		before = in.RefreshBefore.Duration
+	}
+	return !now.Before(exp.Add(-before))
+}
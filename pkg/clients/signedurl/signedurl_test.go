@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signedurl
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var now = time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func TestGenerateSignedURLOptions(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.SignedURLParameters
+		want *storage.SignedURLOptions
+	}{
+		"Defaults": {
+			want: &storage.SignedURLOptions{
+				Scheme:  storage.SigningSchemeV4,
+				Method:  "GET",
+				Expires: now.Add(time.Hour),
+			},
+		},
+		"Upload": {
+			in: v1alpha1.SignedURLParameters{
+				Method:      gcp.StringPtr("PUT"),
+				ContentType: gcp.StringPtr("application/zip"),
+				TTL:         &metav1.Duration{Duration: 15 * time.Minute},
+			},
+			want: &storage.SignedURLOptions{
+				Scheme:      storage.SigningSchemeV4,
+				Method:      "PUT",
+				ContentType: "application/zip",
+				Expires:     now.Add(15 * time.Minute),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateSignedURLOptions(tc.in, now)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(storage.SignedURLOptions{}, "SignBytes")); diff != "" {
+				t.Errorf("GenerateSignedURLOptions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeedsRefresh(t *testing.T) {
+	cases := map[string]struct {
+		in         v1alpha1.SignedURLParameters
+		expireTime string
+		want       bool
+	}{
+		"NoURL": {
+			want: true,
+		},
+		"Fresh": {
+			expireTime: "2023-01-01T12:30:00Z",
+		},
+		"WithinDefaultWindow": {
+			expireTime: "2023-01-01T12:05:00Z",
+			want:       true,
+		},
+		"WithinCustomWindow": {
+			in:         v1alpha1.SignedURLParameters{RefreshBefore: &metav1.Duration{Duration: time.Hour}},
+			expireTime: "2023-01-01T12:30:00Z",
+			want:       true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NeedsRefresh(tc.in, tc.expireTime, now)); diff != "" {
+				t.Errorf("NeedsRefresh(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+// GenerateSnapshot takes a SnapshotParameters and returns *compute.Snapshot.
+// It assigns only the fields that are writable, i.e. not labelled as [Output
+// Only] in Google's reference.
+func GenerateSnapshot(name string, in v1alpha1.SnapshotParameters) *compute.Snapshot {
+	return &compute.Snapshot{
+		Name:             name,
+		Description:      gcp.StringValue(in.Description),
+		SourceDisk:       gcp.StringValue(in.SourceDisk),
+		SnapshotType:     gcp.StringValue(in.SnapshotType),
+		StorageLocations: in.StorageLocations,
+		Labels:           in.Labels,
+	}
+}
+
+// GenerateObservation produces SnapshotObservation object from
+// compute.Snapshot object.
+func GenerateObservation(in compute.Snapshot) v1alpha1.SnapshotObservation {
+	return v1alpha1.SnapshotObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+		SourceDiskID:      in.SourceDiskId,
+		DiskSizeGB:        in.DiskSizeGb,
+		StorageBytes:      in.StorageBytes,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.Snapshot object.
+func LateInitializeSpec(p *v1alpha1.SnapshotParameters, observed compute.Snapshot) {
+	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
+	p.SnapshotType = gcp.LateInitializeString(p.SnapshotType, observed.SnapshotType)
+	p.StorageLocations = gcp.LateInitializeStringSlice(p.StorageLocations, observed.StorageLocations)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, observed.Labels)
+}
+
+// IsUpToDate returns true if the labels of the observed snapshot match the
+// desired ones. Labels are the only mutable field of a snapshot.
+func IsUpToDate(in v1alpha1.SnapshotParameters, observed compute.Snapshot) bool {
+	return cmp.Equal(in.Labels, observed.Labels, cmpopts.EquateEmpty())
+}
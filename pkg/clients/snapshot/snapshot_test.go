@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+func TestGenerateSnapshot(t *testing.T) {
+	in := v1alpha1.SnapshotParameters{
+		SourceDisk:       gcp.StringPtr("projects/p/zones/us-central1-a/disks/d"),
+		SnapshotType:     gcp.StringPtr("ARCHIVE"),
+		StorageLocations: []string{"us"},
+		Labels:           map[string]string{"team": "data"},
+	}
+	want := &compute.Snapshot{
+		Name:             "s",
+		SourceDisk:       "projects/p/zones/us-central1-a/disks/d",
+		SnapshotType:     "ARCHIVE",
+		StorageLocations: []string{"us"},
+		Labels:           map[string]string{"team": "data"},
+	}
+	if diff := cmp.Diff(want, GenerateSnapshot("s", in)); diff != "" {
+		t.Errorf("GenerateSnapshot(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	observed := compute.Snapshot{
+		SnapshotType:     "STANDARD",
+		StorageLocations: []string{"us"},
+		Labels:           map[string]string{"l": "v"},
+	}
+	want := &v1alpha1.SnapshotParameters{
+		SourceDisk:       gcp.StringPtr("d"),
+		SnapshotType:     gcp.StringPtr("STANDARD"),
+		StorageLocations: []string{"us"},
+		Labels:           map[string]string{"l": "v"},
+	}
+	got := &v1alpha1.SnapshotParameters{SourceDisk: gcp.StringPtr("d")}
+	LateInitializeSpec(got, observed)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := compute.Snapshot{Labels: map[string]string{"l": "v"}}
+	cases := map[string]struct {
+		in   v1alpha1.SnapshotParameters
+		want bool
+	}{
+		"UpToDate": {
+			in:   v1alpha1.SnapshotParameters{Labels: map[string]string{"l": "v"}},
+			want: true,
+		},
+		"LabelsChanged": {
+			in:   v1alpha1.SnapshotParameters{Labels: map[string]string{"l": "w"}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.in, observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/utilization"
 )
 
 const errCheckUpToDate = "unable to determine if external resource is up to date"
@@ -40,6 +41,8 @@ func GenerateSubnetwork(name string, in v1beta1.SubnetworkParameters, subnet *co
 	subnet.Network = gcp.StringValue(in.Network)
 	subnet.PrivateIpGoogleAccess = gcp.BoolValue(in.PrivateIPGoogleAccess)
 	subnet.Region = in.Region
+	subnet.StackType = gcp.StringValue(in.StackType)
+	subnet.Ipv6AccessType = gcp.StringValue(in.IPv6AccessType)
 
 	if len(in.SecondaryIPRanges) > 0 {
 		subnet.SecondaryIpRanges = make([]*compute.SubnetworkSecondaryRange, len(in.SecondaryIPRanges))
@@ -65,6 +68,8 @@ func GenerateSubnetworkForUpdate(s v1beta1.Subnetwork, name string) *compute.Sub
 		EnableFlowLogs:        gcp.BoolValue(s.Spec.ForProvider.EnableFlowLogs),
 		IpCidrRange:           s.Spec.ForProvider.IPCidrRange,
 		PrivateIpGoogleAccess: gcp.BoolValue(s.Spec.ForProvider.PrivateIPGoogleAccess),
+		StackType:             gcp.StringValue(s.Spec.ForProvider.StackType),
+		Ipv6AccessType:        gcp.StringValue(s.Spec.ForProvider.IPv6AccessType),
 		Fingerprint:           s.Status.AtProvider.Fingerprint,
 	}
 	for _, val := range s.Spec.ForProvider.SecondaryIPRanges {
@@ -80,11 +85,14 @@ func GenerateSubnetworkForUpdate(s v1beta1.Subnetwork, name string) *compute.Sub
 // GenerateSubnetworkObservation creates a SubnetworkObservation object using *googlecompute.Subnetwork.
 func GenerateSubnetworkObservation(in compute.Subnetwork) v1beta1.SubnetworkObservation {
 	return v1beta1.SubnetworkObservation{
-		CreationTimestamp: in.CreationTimestamp,
-		Fingerprint:       in.Fingerprint,
-		GatewayAddress:    in.GatewayAddress,
-		ID:                in.Id,
-		SelfLink:          in.SelfLink,
+		CreationTimestamp:  in.CreationTimestamp,
+		Fingerprint:        in.Fingerprint,
+		GatewayAddress:     in.GatewayAddress,
+		ID:                 in.Id,
+		SelfLink:           in.SelfLink,
+		IPv6CidrRange:      in.Ipv6CidrRange,
+		ExternalIPv6Prefix: in.ExternalIpv6Prefix,
+		InternalIPv6Prefix: in.InternalIpv6Prefix,
 	}
 }
 
@@ -102,6 +110,8 @@ func LateInitializeSpec(spec *v1beta1.SubnetworkParameters, in compute.Subnetwor
 	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
 	spec.EnableFlowLogs = gcp.LateInitializeBool(spec.EnableFlowLogs, in.EnableFlowLogs)
 	spec.PrivateIPGoogleAccess = gcp.LateInitializeBool(spec.PrivateIPGoogleAccess, in.PrivateIpGoogleAccess)
+	spec.StackType = gcp.LateInitializeString(spec.StackType, in.StackType)
+	spec.IPv6AccessType = gcp.LateInitializeString(spec.IPv6AccessType, in.Ipv6AccessType)
 	if len(in.SecondaryIpRanges) != 0 && len(spec.SecondaryIPRanges) == 0 {
 		spec.SecondaryIPRanges = make([]*v1beta1.SubnetworkSecondaryRange, len(in.SecondaryIpRanges))
 		for i, r := range in.SecondaryIpRanges {
@@ -137,3 +147,46 @@ func IsUpToDate(name string, in *v1beta1.SubnetworkParameters, observed *compute
 func equateSecondaryRanges() cmp.Option {
 	return cmpopts.SortSlices(func(i, j *compute.SubnetworkSecondaryRange) bool { return i.RangeName > j.RangeName })
 }
+
+// reservedIPs is the number of addresses of the primary IPv4 range of every
+// subnetwork that Google Cloud reserves, i.e. the network, default gateway,
+// second-to-last and broadcast addresses.
+const reservedIPs = 4
+
+// Capacity returns the number of addresses of the primary IPv4 range of the
+// supplied subnetwork that can be assigned to resources.
+func Capacity(observed compute.Subnetwork) (int64, error) {
+	size, err := utilization.IPv4RangeSize(observed.IpCidrRange)
+	if err != nil {
+		return 0, err
+	}
+	if size < reservedIPs {
+		return 0, nil
+	}
+	return size - reservedIPs, nil
+}
+
+// UsedIPs returns the number of distinct addresses of the primary IPv4 range of
+// the supplied subnetwork that are used by the supplied internal addresses,
+// instances and forwarding rules. Resources of other subnetworks, and
+// addresses of secondary ranges, are not counted.
+func UsedIPs(observed compute.Subnetwork, addresses []*compute.Address, instances []*compute.Instance, rules []*compute.ForwardingRule) int64 {
+	used := map[string]bool{}
+	use := func(subnetwork, ip string) {
+		if subnetwork == observed.SelfLink && ip != "" && utilization.RangeContains(observed.IpCidrRange, ip) {
+			used[ip] = true
+		}
+	}
+	for _, a := range addresses {
+		use(a.Subnetwork, a.Address)
+	}
+	for _, i := range instances {
+		for _, ni := range i.NetworkInterfaces {
+			use(ni.Subnetwork, ni.NetworkIP)
+		}
+	}
+	for _, r := range rules {
+		use(r.Subnetwork, r.IPAddress)
+	}
+	return int64(len(used))
+}
@@ -292,6 +292,32 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: want{upToDate: false, privAcc: true},
 		},
+		"NotUpToDateDualStack": {
+			args: args{
+				name: testName,
+				in: params(func(p *v1beta1.SubnetworkParameters) {
+					p.StackType = gcp.StringPtr("IPV4_IPV6")
+					p.IPv6AccessType = gcp.StringPtr("EXTERNAL")
+				}),
+				current: subnetwork(),
+			},
+			want: want{upToDate: false, privAcc: false},
+		},
+		"UpToDateDualStack": {
+			args: args{
+				name: testName,
+				in: params(func(p *v1beta1.SubnetworkParameters) {
+					p.StackType = gcp.StringPtr("IPV4_IPV6")
+					p.IPv6AccessType = gcp.StringPtr("EXTERNAL")
+				}),
+				current: subnetwork(addOutputFields, func(s *compute.Subnetwork) {
+					s.StackType = "IPV4_IPV6"
+					s.Ipv6AccessType = "EXTERNAL"
+					s.ExternalIpv6Prefix = "2600:1900:4000:1234::/64"
+				}),
+			},
+			want: want{upToDate: true, privAcc: false},
+		},
 	}
 
 	for name, tc := range cases {
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
 
 	"github.com/google/go-cmp/cmp"
@@ -29,6 +30,16 @@ import (
 
 const (
 	subscriptionNameFormat = "projects/%s/subscriptions/%s"
+	serviceAgentFormat     = "serviceAccount:service-%d@gcp-sa-pubsub.iam.gserviceaccount.com"
+
+	// RolePublisher is the role the Pub/Sub service agent needs on a dead
+	// letter topic to forward undeliverable messages to it.
+	RolePublisher = "roles/pubsub.publisher"
+
+	// RoleSubscriber is the role the Pub/Sub service agent needs on a
+	// subscription to acknowledge messages it forwarded to a dead letter
+	// topic.
+	RoleSubscriber = "roles/pubsub.subscriber"
 )
 
 // GetFullyQualifiedName builds the fully qualified name of the subscription.
@@ -185,8 +196,17 @@ func IsUpToDate(projectID string, p v1alpha1.SubscriptionParameters, s pubsub.Su
 		p.Topic = topic.GetFullyQualifiedName(projectID, p.Topic)
 	}
 
-	if p.DeadLetterPolicy != nil && p.DeadLetterPolicy.DeadLetterTopic != "" {
-		p.DeadLetterPolicy.DeadLetterTopic = topic.GetFullyQualifiedName(projectID, p.DeadLetterPolicy.DeadLetterTopic)
+	if p.DeadLetterPolicy != nil {
+		// Copy the policy so that the supplied parameters are not modified,
+		// and drop the fields that have no counterpart in GCP.
+		dlp := *p.DeadLetterPolicy
+		dlp.DeadLetterTopicRef = nil
+		dlp.DeadLetterTopicSelector = nil
+		dlp.GrantServiceAgentPermissions = nil
+		if dlp.DeadLetterTopic != "" {
+			dlp.DeadLetterTopic = topic.GetFullyQualifiedName(projectID, dlp.DeadLetterTopic)
+		}
+		p.DeadLetterPolicy = &dlp
 	}
 
 	return cmp.Equal(observed, &p)
@@ -254,3 +274,39 @@ func GenerateUpdateRequest(name string, p v1alpha1.SubscriptionParameters, s pub
 
 	return us
 }
+
+// GrantsServiceAgentPermissions returns true if the permissions needed for
+// dead lettering should be granted to the Pub/Sub service agent, i.e. if
+// they were asked for and the dead letter topic is a referenced Topic.
+func GrantsServiceAgentPermissions(p v1alpha1.SubscriptionParameters) bool {
+	dlp := p.DeadLetterPolicy
+	if dlp == nil || dlp.DeadLetterTopic == "" || !gcp.BoolValue(dlp.GrantServiceAgentPermissions) {
+		return false
+	}
+	return dlp.DeadLetterTopicRef != nil || dlp.DeadLetterTopicSelector != nil
+}
+
+// ServiceAgentMember returns the IAM member of the Pub/Sub service agent of
+// the project with the supplied number.
+func ServiceAgentMember(projectNumber int64) string {
+	return fmt.Sprintf(serviceAgentFormat, projectNumber)
+}
+
+// BindRoleToMember adds the supplied member to the binding of the supplied
+// role in the policy. It returns true if the policy changed.
+func BindRoleToMember(role, member string, p *pubsub.Policy) bool {
+	for _, b := range p.Bindings {
+		if b.Role != role || b.Condition != nil {
+			continue
+		}
+		for _, m := range b.Members {
+			if m == member {
+				return false
+			}
+		}
+		b.Members = append(b.Members, member)
+		return true
+	}
+	p.Bindings = append(p.Bindings, &pubsub.Binding{Role: role, Members: []string{member}})
+	return true
+}
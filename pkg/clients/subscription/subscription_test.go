@@ -22,7 +22,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	pubsub "google.golang.org/api/pubsub/v1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
 	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 )
 
 const (
@@ -204,6 +207,18 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			result: true,
 		},
+		"UpToDateWithDeadLetterTopicReference": {
+			args: args{
+				obs: *subscription(),
+				param: func() v1alpha1.SubscriptionParameters {
+					p := params()
+					p.DeadLetterPolicy.DeadLetterTopicRef = &xpv1.Reference{Name: topicName}
+					p.DeadLetterPolicy.GrantServiceAgentPermissions = gcp.BoolPtr(true)
+					return *p
+				}(),
+			},
+			result: true,
+		},
 	}
 
 	IsUpToDate(projectID, *params(), *subscription())
@@ -257,3 +272,100 @@ func TestGenerateUpdateRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestGrantsServiceAgentPermissions(t *testing.T) {
+	cases := map[string]struct {
+		dlp  *v1alpha1.DeadLetterPolicy
+		want bool
+	}{
+		"NoDeadLetterPolicy": {},
+		"NotRequested": {
+			dlp: &v1alpha1.DeadLetterPolicy{
+				DeadLetterTopic:    topicName,
+				DeadLetterTopicRef: &xpv1.Reference{Name: topicName},
+			},
+		},
+		"TopicNotReferenced": {
+			dlp: &v1alpha1.DeadLetterPolicy{
+				DeadLetterTopic:              topicName,
+				GrantServiceAgentPermissions: gcp.BoolPtr(true),
+			},
+		},
+		"TopicReferenceUnresolved": {
+			dlp: &v1alpha1.DeadLetterPolicy{
+				DeadLetterTopicRef:           &xpv1.Reference{Name: topicName},
+				GrantServiceAgentPermissions: gcp.BoolPtr(true),
+			},
+		},
+		"Requested": {
+			dlp: &v1alpha1.DeadLetterPolicy{
+				DeadLetterTopic:              topicName,
+				DeadLetterTopicSelector:      &xpv1.Selector{MatchControllerRef: gcp.BoolPtr(true)},
+				GrantServiceAgentPermissions: gcp.BoolPtr(true),
+			},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GrantsServiceAgentPermissions(v1alpha1.SubscriptionParameters{DeadLetterPolicy: tc.dlp})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GrantsServiceAgentPermissions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBindRoleToMember(t *testing.T) {
+	member := ServiceAgentMember(1234)
+	type want struct {
+		changed bool
+		policy  *pubsub.Policy
+	}
+	cases := map[string]struct {
+		policy *pubsub.Policy
+		want   want
+	}{
+		"AddBinding": {
+			policy: &pubsub.Policy{Etag: "etag"},
+			want: want{
+				changed: true,
+				policy:  &pubsub.Policy{Etag: "etag", Bindings: []*pubsub.Binding{{Role: RolePublisher, Members: []string{member}}}},
+			},
+		},
+		"AddMember": {
+			policy: &pubsub.Policy{Bindings: []*pubsub.Binding{{Role: RolePublisher, Members: []string{"user:someone@example.com"}}}},
+			want: want{
+				changed: true,
+				policy:  &pubsub.Policy{Bindings: []*pubsub.Binding{{Role: RolePublisher, Members: []string{"user:someone@example.com", member}}}},
+			},
+		},
+		"IgnoreConditionalBinding": {
+			policy: &pubsub.Policy{Bindings: []*pubsub.Binding{{Role: RolePublisher, Members: []string{member}, Condition: &pubsub.Expr{Expression: "true"}}}},
+			want: want{
+				changed: true,
+				policy: &pubsub.Policy{Bindings: []*pubsub.Binding{
+					{Role: RolePublisher, Members: []string{member}, Condition: &pubsub.Expr{Expression: "true"}},
+					{Role: RolePublisher, Members: []string{member}},
+				}},
+			},
+		},
+		"AlreadyBound": {
+			policy: &pubsub.Policy{Bindings: []*pubsub.Binding{{Role: RolePublisher, Members: []string{member}}}},
+			want: want{
+				policy: &pubsub.Policy{Bindings: []*pubsub.Binding{{Role: RolePublisher, Members: []string{member}}}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := BindRoleToMember(RolePublisher, member, tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tagbinding binds Resource Manager tags to resources that are
+// located in a region, such as GCS buckets. The tag bindings of such
+// resources are served by the regional endpoints of the Cloud Resource
+// Manager API.
+package tagbinding
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	crmv3 "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+)
+
+const (
+	tagValuesPrefix = "tagValues/"
+
+	fmtEndpoint     = "https://%s-cloudresourcemanager.googleapis.com/"
+	fmtBucketParent = "//storage.googleapis.com/projects/_/buckets/%s"
+	fmtBindingName  = "tagBindings/%s/%s"
+)
+
+// A Client lists, creates and deletes the tag bindings of resources.
+type Client interface {
+	List(ctx context.Context, parent string) ([]*crmv3.TagBinding, error)
+	Create(ctx context.Context, b *crmv3.TagBinding) error
+	Delete(ctx context.Context, name string) error
+}
+
+// A ClientFn returns a Client for the tag bindings of resources in the
+// supplied location.
+type ClientFn func(ctx context.Context, location string) (Client, error)
+
+// NewClientFn returns a ClientFn that uses the regional endpoint of the
+// location. The supplied options take precedence, so that an endpoint
+// configured by the ProviderConfig is used for all locations.
+func NewClientFn(opts ...option.ClientOption) ClientFn {
+	return func(ctx context.Context, location string) (Client, error) {
+		hc, endpoint, err := htransport.NewClient(ctx, append([]option.ClientOption{option.WithEndpoint(Endpoint(location))}, opts...)...)
+		if err != nil {
+			return nil, err
+		}
+		s, err := crmv3.NewService(ctx, option.WithHTTPClient(hc), option.WithEndpoint(endpoint))
+		if err != nil {
+			return nil, err
+		}
+		return &client{http: hc, endpoint: endpoint, tags: s.EffectiveTags, bindings: s.TagBindings}, nil
+	}
+}
+
+type client struct {
+	http     *http.Client
+	endpoint string
+	tags     *crmv3.EffectiveTagsService
+	bindings *crmv3.TagBindingsService
+}
+
+// List returns the tag bindings of the supplied parent. They are read from
+// the effective tags of the parent, which unlike the tag bindings themselves
+// report the namespaced names of their tag values. Inherited tags are not
+// bound to the parent and thus omitted.
+func (c *client) List(ctx context.Context, parent string) ([]*crmv3.TagBinding, error) {
+	var bs []*crmv3.TagBinding
+	err := c.tags.List().Parent(parent).Pages(ctx, func(r *crmv3.ListEffectiveTagsResponse) error {
+		for _, t := range r.EffectiveTags {
+			if t.Inherited {
+				continue
+			}
+			bs = append(bs, &crmv3.TagBinding{
+				Name:                   BindingName(parent, t.TagValue),
+				Parent:                 parent,
+				TagValue:               t.TagValue,
+				TagValueNamespacedName: t.NamespacedTagValue,
+			})
+		}
+		return nil
+	})
+	return bs, err
+}
+
+func (c *client) Create(ctx context.Context, b *crmv3.TagBinding) error {
+	_, err := c.bindings.Create(b).Context(ctx).Do()
+	return err
+}
+
+// Delete deletes the named binding. The name of a binding contains the
+// escaped name of its parent, which the generated client would escape again,
+// so the request is made directly.
+func (c *client) Delete(ctx context.Context, name string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, strings.TrimSuffix(c.endpoint, "/")+"/v3/"+name, nil)
+	if err != nil {
+		return err
+	}
+	res, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return googleapi.CheckResponse(res)
+}
+
+// Endpoint returns the Cloud Resource Manager endpoint that serves the tag
+// bindings of resources in the supplied location, e.g. US or us-central1.
+func Endpoint(location string) string {
+	return fmt.Sprintf(fmtEndpoint, strings.ToLower(location))
+}
+
+// BucketParent returns the full resource name of the named GCS bucket, which
+// is the parent of its tag bindings.
+func BucketParent(bucket string) string {
+	return fmt.Sprintf(fmtBucketParent, bucket)
+}
+
+// BindingName returns the name of the binding of the supplied tag value,
+// i.e. tagValues/{id}, to the supplied parent.
+func BindingName(parent, tagValue string) string {
+	return fmt.Sprintf(fmtBindingName, url.PathEscape(parent), tagValue)
+}
+
+// NewTagBinding returns a binding of the supplied tag value to the supplied
+// parent. The tag value is either the name of a TagValue, i.e.
+// tagValues/{id}, or its namespaced name, i.e. {parent}/{key}/{value}.
+func NewTagBinding(parent, value string) *crmv3.TagBinding {
+	if strings.HasPrefix(value, tagValuesPrefix) {
+		return &crmv3.TagBinding{Parent: parent, TagValue: value}
+	}
+	return &crmv3.TagBinding{Parent: parent, TagValueNamespacedName: value}
+}
+
+// Find returns the binding of the supplied tag value, given either as the
+// name of a TagValue or its namespaced name, or nil if it is not bound.
+func Find(bs []*crmv3.TagBinding, value string) *crmv3.TagBinding {
+	for _, b := range bs {
+		if b.TagValue == value || b.TagValueNamespacedName == value {
+			return b
+		}
+	}
+	return nil
+}
+
+// Diff returns the desired tag values that are not bound, and the bindings
+// of the supplied owned tag values that are no longer desired. Tag values
+// that are bound but neither desired nor owned are left alone.
+func Diff(desired, owned []string, observed []*crmv3.TagBinding) (bind []string, unbind []*crmv3.TagBinding) {
+	want := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		want[v] = true
+		if Find(observed, v) == nil {
+			bind = append(bind, v)
+		}
+	}
+	for _, v := range owned {
+		if want[v] {
+			continue
+		}
+		if b := Find(observed, v); b != nil {
+			unbind = append(unbind, b)
+		}
+	}
+	return bind, unbind
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tagbinding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	crmv3 "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/option"
+)
+
+const parent = "//storage.googleapis.com/projects/_/buckets/cool-bucket"
+
+func TestNames(t *testing.T) {
+	if diff := cmp.Diff("https://us-central1-cloudresourcemanager.googleapis.com/", Endpoint("US-CENTRAL1")); diff != "" {
+		t.Errorf("Endpoint(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(parent, BucketParent("cool-bucket")); diff != "" {
+		t.Errorf("BucketParent(...): -want, +got:\n%s", diff)
+	}
+	want := "tagBindings/%2F%2Fstorage.googleapis.com%2Fprojects%2F_%2Fbuckets%2Fcool-bucket/tagValues/1"
+	if diff := cmp.Diff(want, BindingName(parent, "tagValues/1")); diff != "" {
+		t.Errorf("BindingName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestNewTagBinding(t *testing.T) {
+	cases := map[string]struct {
+		value string
+		want  *crmv3.TagBinding
+	}{
+		"Name": {
+			value: "tagValues/1",
+			want:  &crmv3.TagBinding{Parent: parent, TagValue: "tagValues/1"},
+		},
+		"NamespacedName": {
+			value: "123/env/prod",
+			want:  &crmv3.TagBinding{Parent: parent, TagValueNamespacedName: "123/env/prod"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NewTagBinding(parent, tc.value)); diff != "" {
+				t.Errorf("NewTagBinding(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	prod := &crmv3.TagBinding{Name: "prod", TagValue: "tagValues/1", TagValueNamespacedName: "123/env/prod"}
+	team := &crmv3.TagBinding{Name: "team", TagValue: "tagValues/2", TagValueNamespacedName: "123/team/a"}
+	other := &crmv3.TagBinding{Name: "other", TagValue: "tagValues/3", TagValueNamespacedName: "123/cost/x"}
+	observed := []*crmv3.TagBinding{prod, team, other}
+
+	type want struct {
+		bind   []string
+		unbind []*crmv3.TagBinding
+	}
+	cases := map[string]struct {
+		desired []string
+		owned   []string
+		want    want
+	}{
+		"UpToDate": {
+			desired: []string{"tagValues/1", "123/team/a"},
+			owned:   []string{"tagValues/1", "123/team/a"},
+		},
+		"Bind": {
+			desired: []string{"tagValues/1", "123/team/b"},
+			want:    want{bind: []string{"123/team/b"}},
+		},
+		"Unbind": {
+			desired: []string{"tagValues/1"},
+			owned:   []string{"tagValues/1", "123/team/a", "123/team/gone"},
+			want:    want{unbind: []*crmv3.TagBinding{team}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			bind, unbind := Diff(tc.desired, tc.owned, observed)
+			if diff := cmp.Diff(tc.want.bind, bind); diff != "" {
+				t.Errorf("Diff(...): -want bind, +got bind:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.unbind, unbind); diff != "" {
+				t.Errorf("Diff(...): -want unbind, +got unbind:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClient(t *testing.T) {
+	var created *crmv3.TagBinding
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/effectiveTags":
+			if got := r.URL.Query().Get("parent"); got != parent {
+				t.Errorf("List(...): want parent %q, got %q", parent, got)
+			}
+			_ = json.NewEncoder(w).Encode(&crmv3.ListEffectiveTagsResponse{EffectiveTags: []*crmv3.EffectiveTag{
+				{TagValue: "tagValues/1", NamespacedTagValue: "123/env/prod"},
+				{TagValue: "tagValues/2", NamespacedTagValue: "123/org/x", Inherited: true},
+			}})
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/tagBindings":
+			created = &crmv3.TagBinding{}
+			_ = json.NewDecoder(r.Body).Decode(created)
+			_ = json.NewEncoder(w).Encode(&crmv3.Operation{Done: true})
+		case r.Method == http.MethodDelete:
+			deleted = r.URL.EscapedPath()
+			_ = json.NewEncoder(w).Encode(&crmv3.Operation{Done: true})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClientFn(option.WithEndpoint(server.URL), option.WithoutAuthentication())(context.Background(), "US")
+	if err != nil {
+		t.Fatalf("NewClientFn(...): %s", err)
+	}
+
+	bs, err := c.List(context.Background(), parent)
+	if err != nil {
+		t.Errorf("List(...): %s", err)
+	}
+	want := []*crmv3.TagBinding{{Name: BindingName(parent, "tagValues/1"), Parent: parent, TagValue: "tagValues/1", TagValueNamespacedName: "123/env/prod"}}
+	if diff := cmp.Diff(want, bs); diff != "" {
+		t.Errorf("List(...): -want, +got:\n%s", diff)
+	}
+
+	if err := c.Create(context.Background(), NewTagBinding(parent, "123/team/a")); err != nil {
+		t.Errorf("Create(...): %s", err)
+	}
+	if diff := cmp.Diff(NewTagBinding(parent, "123/team/a"), created); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+
+	if err := c.Delete(context.Background(), BindingName(parent, "tagValues/1")); err != nil {
+		t.Errorf("Delete(...): %s", err)
+	}
+	if diff := cmp.Diff("/v3/"+BindingName(parent, "tagValues/1"), deleted); diff != "" {
+		t.Errorf("Delete(...): -want path, +got path:\n%s", diff)
+	}
+}
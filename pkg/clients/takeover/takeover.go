@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package takeover protects existing IAM policies from being replaced by
+// authoritative policy resources by accident. Replacing the policy of a
+// bucket or service account that is managed elsewhere can lock its users
+// out, so an authoritative policy resource must explicitly allow the
+// takeover of a policy whose bindings it would remove.
+package takeover
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+// ReasonTakeoverNotAllowed indicates that a policy is not set because it
+// would remove bindings of an existing policy that was not taken over.
+const ReasonTakeoverNotAllowed xpv1.ConditionReason = "PolicyTakeoverNotAllowed"
+
+const errFmtNotAllowed = "refusing to take over existing IAM policy, which would remove %s; set allowPolicyTakeover to replace it"
+
+// conveniencePrefixes are the prefixes of the members that GCS binds to the
+// legacy roles of every new bucket. They are not managed by anyone, so
+// removing them does not constitute a takeover.
+var conveniencePrefixes = []string{"projectOwner:", "projectEditor:", "projectViewer:"}
+
+// A Binding of an existing policy.
+type Binding struct {
+	Role    string
+	Members []string
+}
+
+// IsOwned returns true if the supplied policy resource already owns the
+// policy it manages, i.e. if it set the policy before or found it up to
+// date.
+func IsOwned(owned bool, mg resource.Managed) bool {
+	return owned || mg.GetCondition(xpv1.TypeReady).Reason == xpv1.ReasonAvailable
+}
+
+// Removed returns the role and member pairs of the supplied existing bindings
+// that are not declared by the supplied policy, and would thus be removed by
+// setting it. Members that GCS binds to every new bucket are omitted.
+func Removed(existing []Binding, declared iamv1alpha1.Policy) []string {
+	keep := map[string]bool{}
+	for _, b := range declared.Bindings {
+		if b == nil {
+			continue
+		}
+		for _, m := range b.Members {
+			keep[b.Role+" "+m] = true
+		}
+	}
+	var removed []string
+	for _, b := range existing {
+		for _, m := range b.Members {
+			if isConvenience(m) || keep[b.Role+" "+m] {
+				continue
+			}
+			removed = append(removed, b.Role+" "+m)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+func isConvenience(member string) bool {
+	for _, p := range conveniencePrefixes {
+		if strings.HasPrefix(member, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// NotAllowed returns an error indicating that taking over the existing policy
+// would remove the supplied role and member pairs.
+func NotAllowed(removed []string) error {
+	return errors.Errorf(errFmtNotAllowed, describe(removed))
+}
+
+// Blocked returns a condition indicating that the policy is not set because
+// taking over the existing policy would remove the supplied role and member
+// pairs.
+func Blocked(removed []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTakeoverNotAllowed,
+		Message:            NotAllowed(removed).Error(),
+	}
+}
+
+// describe lists the first few of the supplied role and member pairs.
+func describe(removed []string) string {
+	const max = 3
+	if len(removed) <= max {
+		return strings.Join(removed, ", ")
+	}
+	return fmt.Sprintf("%s and %d more bindings", strings.Join(removed[:max], ", "), len(removed)-max)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package takeover
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+func TestIsOwned(t *testing.T) {
+	cases := map[string]struct {
+		owned bool
+		cond  xpv1.Condition
+		want  bool
+	}{
+		"Owned": {
+			owned: true,
+			cond:  xpv1.Creating(),
+			want:  true,
+		},
+		"Available": {
+			cond: xpv1.Available(),
+			want: true,
+		},
+		"NotOwned": {
+			cond: xpv1.Creating(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetConditions(tc.cond)
+			if got := IsOwned(tc.owned, mg); got != tc.want {
+				t.Errorf("IsOwned(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRemoved(t *testing.T) {
+	declared := iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+		{Role: "roles/viewer", Members: []string{"user:a@example.com"}},
+	}}
+
+	cases := map[string]struct {
+		existing []Binding
+		want     []string
+	}{
+		"Empty": {},
+		"Declared": {
+			existing: []Binding{{Role: "roles/viewer", Members: []string{"user:a@example.com"}}},
+		},
+		"ConvenienceMembers": {
+			existing: []Binding{{Role: "roles/storage.legacyBucketOwner", Members: []string{"projectOwner:p", "projectEditor:p"}}},
+		},
+		"Foreign": {
+			existing: []Binding{
+				{Role: "roles/viewer", Members: []string{"user:b@example.com", "user:a@example.com"}},
+				{Role: "roles/admin", Members: []string{"user:a@example.com"}},
+			},
+			want: []string{"roles/admin user:a@example.com", "roles/viewer user:b@example.com"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Removed(tc.existing, declared)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Removed(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNotAllowed(t *testing.T) {
+	cases := map[string]struct {
+		removed []string
+		want    string
+	}{
+		"Few": {
+			removed: []string{"r a", "r b"},
+			want:    "refusing to take over existing IAM policy, which would remove r a, r b; set allowPolicyTakeover to replace it",
+		},
+		"Many": {
+			removed: []string{"r a", "r b", "r c", "r d", "r e"},
+			want:    "refusing to take over existing IAM policy, which would remove r a, r b, r c and 2 more bindings; set allowPolicyTakeover to replace it",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NotAllowed(tc.removed).Error()); diff != "" {
+				t.Errorf("NotAllowed(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
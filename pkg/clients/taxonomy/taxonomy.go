@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taxonomy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFmt = "projects/%s/locations/%s"
+	nameFmt   = parentFmt + "/taxonomies/%s"
+
+	// UpdateMask lists the fields of a taxonomy that are updated in place.
+	UpdateMask = "displayName,description,activatedPolicyTypes"
+)
+
+// Client should be satisfied to conduct Taxonomy operations.
+type Client interface {
+	Create(parent string, taxonomy *datacatalog.GoogleCloudDatacatalogV1Taxonomy) *datacatalog.ProjectsLocationsTaxonomiesCreateCall
+	Get(name string) *datacatalog.ProjectsLocationsTaxonomiesGetCall
+	Patch(name string, taxonomy *datacatalog.GoogleCloudDatacatalogV1Taxonomy) *datacatalog.ProjectsLocationsTaxonomiesPatchCall
+	Delete(name string) *datacatalog.ProjectsLocationsTaxonomiesDeleteCall
+}
+
+// GetParent builds the name of the location a taxonomy belongs to.
+func GetParent(projectID, location string) string {
+	return fmt.Sprintf(parentFmt, projectID, location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a taxonomy.
+func GetFullyQualifiedName(projectID, location, id string) string {
+	return fmt.Sprintf(nameFmt, projectID, location, id)
+}
+
+// GetID returns the ID Data Catalog assigned to the taxonomy with the
+// supplied fully qualified name.
+func GetID(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// GenerateTaxonomy generates *datacatalog.GoogleCloudDatacatalogV1Taxonomy
+// instance from TaxonomyParameters.
+func GenerateTaxonomy(in v1alpha1.TaxonomyParameters) *datacatalog.GoogleCloudDatacatalogV1Taxonomy {
+	t := &datacatalog.GoogleCloudDatacatalogV1Taxonomy{
+		DisplayName: in.DisplayName,
+		Description: gcp.StringValue(in.Description),
+	}
+	for _, pt := range in.ActivatedPolicyTypes {
+		t.ActivatedPolicyTypes = append(t.ActivatedPolicyTypes, string(pt))
+	}
+	return t
+}
+
+// GenerateObservation produces TaxonomyObservation object from
+// datacatalog.GoogleCloudDatacatalogV1Taxonomy object.
+func GenerateObservation(in datacatalog.GoogleCloudDatacatalogV1Taxonomy) v1alpha1.TaxonomyObservation {
+	return v1alpha1.TaxonomyObservation{
+		Name:           in.Name,
+		PolicyTagCount: in.PolicyTagCount,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// datacatalog.GoogleCloudDatacatalogV1Taxonomy object.
+func LateInitializeSpec(spec *v1alpha1.TaxonomyParameters, in datacatalog.GoogleCloudDatacatalogV1Taxonomy) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+}
+
+// IsUpToDate checks whether the observed taxonomy is up-to-date compared to
+// the given set of parameters.
+func IsUpToDate(in v1alpha1.TaxonomyParameters, observed datacatalog.GoogleCloudDatacatalogV1Taxonomy) bool {
+	return cmp.Equal(GenerateTaxonomy(in), &observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(datacatalog.GoogleCloudDatacatalogV1Taxonomy{}, "Name", "PolicyTagCount", "TaxonomyTimestamps"),
+		ignoreClientFields)
+}
+
+// ignoreClientFields ignores the fields of the API types that are only used
+// by the API client rather than sent to or returned by the API.
+var ignoreClientFields = cmp.FilterPath(func(p cmp.Path) bool {
+	switch p.Last().String() {
+	case ".ServerResponse", ".ForceSendFields", ".NullFields":
+		return true
+	}
+	return false
+}, cmp.Ignore())
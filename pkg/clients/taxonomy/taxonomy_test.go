@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taxonomy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const testName = "projects/my-project/locations/us/taxonomies/123"
+
+func params(m ...func(*v1alpha1.TaxonomyParameters)) v1alpha1.TaxonomyParameters {
+	p := v1alpha1.TaxonomyParameters{
+		Location:             "us",
+		DisplayName:          "pii",
+		Description:          gcp.StringPtr("Personally identifiable information"),
+		ActivatedPolicyTypes: []v1alpha1.TaxonomyPolicyType{v1alpha1.PolicyTypeFineGrainedAccessControl},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func taxonomy(m ...func(*datacatalog.GoogleCloudDatacatalogV1Taxonomy)) *datacatalog.GoogleCloudDatacatalogV1Taxonomy {
+	t := &datacatalog.GoogleCloudDatacatalogV1Taxonomy{
+		Name:                 testName,
+		DisplayName:          "pii",
+		Description:          "Personally identifiable information",
+		ActivatedPolicyTypes: []string{v1alpha1.PolicyTypeFineGrainedAccessControl},
+		PolicyTagCount:       2,
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGetID(t *testing.T) {
+	if diff := cmp.Diff("123", GetID(testName)); diff != "" {
+		t.Errorf("GetID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateTaxonomy(t *testing.T) {
+	want := taxonomy(func(t *datacatalog.GoogleCloudDatacatalogV1Taxonomy) {
+		t.Name = ""
+		t.PolicyTagCount = 0
+	})
+	if diff := cmp.Diff(want, GenerateTaxonomy(params())); diff != "" {
+		t.Errorf("GenerateTaxonomy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	got := params(func(p *v1alpha1.TaxonomyParameters) { p.Description = nil })
+	LateInitializeSpec(&got, *taxonomy())
+	if diff := cmp.Diff(params(), got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.TaxonomyParameters
+		observed *datacatalog.GoogleCloudDatacatalogV1Taxonomy
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: taxonomy(),
+			want:     true,
+		},
+		"DisplayNameChanged": {
+			in:       params(func(p *v1alpha1.TaxonomyParameters) { p.DisplayName = "sensitive" }),
+			observed: taxonomy(),
+		},
+		"PolicyTypeDeactivated": {
+			in:       params(func(p *v1alpha1.TaxonomyParameters) { p.ActivatedPolicyTypes = nil }),
+			observed: taxonomy(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.in, *tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,376 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package teardown coordinates the deletion of many managed resources at
+// once, e.g. when an environment is torn down. Resources are deleted after
+// the resources that depend on them, such as bucket policy members before
+// bucket policies before buckets, and deletions are rate limited across all
+// controllers.
+package teardown
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	crmv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	computev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	containerv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	containerv1beta2 "github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
+	dnsv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/dns/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	networkservicesv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	storagev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	storagev1alpha3 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	// DefaultRate is the default number of deletions per second admitted
+	// across all controllers.
+	DefaultRate = 1.0
+
+	// DefaultBurst is the default number of deletions admitted at once.
+	DefaultBurst = 5
+
+	// DefaultSettle is how long a resource waits after it started being
+	// deleted before it is deleted itself if it has dependents, so that
+	// dependents deleted at the same time are observed first.
+	DefaultSettle = 10 * time.Second
+
+	// DefaultStaleAfter is how long a resource that is being deleted is
+	// considered pending after it was last observed. Resources whose
+	// deletion stalls, or that are removed without being observed as gone,
+	// thus block others only temporarily.
+	DefaultStaleAfter = 10 * time.Minute
+
+	errFmtDependents = "deletion deferred until %d dependent resources are deleted, e.g. %s"
+	errFmtSettling   = "deletion deferred until %s to let dependent resources be deleted first"
+	errThrottled     = "deletion throttled to limit the rate of deletions; will retry"
+)
+
+// dependents are the controllers of the managed resources that are deleted
+// before the managed resources of a controller, if they use the same
+// ProviderConfig.
+var dependents = map[string][]string{
+	controllerName(storagev1alpha1.BucketPolicyGroupKind): {
+		controllerName(storagev1alpha1.BucketPolicyMemberGroupKind),
+	},
+	controllerName(storagev1alpha3.BucketGroupKind): {
+		controllerName(storagev1alpha1.BucketPolicyMemberGroupKind),
+		controllerName(storagev1alpha1.BucketPolicyGroupKind),
+		controllerName(storagev1alpha1.BucketACLGroupKind),
+		controllerName(storagev1alpha1.DefaultObjectACLGroupKind),
+		controllerName(storagev1alpha1.BucketObjectGroupKind),
+		controllerName(storagev1alpha1.BucketNotificationGroupKind),
+	},
+	controllerName(iamv1alpha1.ServiceAccountPolicyGroupKind): {
+		controllerName(iamv1alpha1.ServiceAccountPolicyMemberGroupKind),
+	},
+	controllerName(iamv1alpha1.ServiceAccountGroupKind): {
+		controllerName(iamv1alpha1.ServiceAccountPolicyMemberGroupKind),
+		controllerName(iamv1alpha1.ServiceAccountPolicyGroupKind),
+		controllerName(iamv1alpha1.ServiceAccountKeyGroupKind),
+	},
+	controllerName(crmv1alpha1.ProjectPolicyGroupKind): {
+		controllerName(crmv1alpha1.ProjectPolicyMemberGroupKind),
+	},
+	controllerName(containerv1beta2.ClusterGroupKind): {
+		controllerName(containerv1beta1.NodePoolGroupKind),
+	},
+	controllerName(dnsv1alpha1.ManagedZoneGroupKind): {
+		controllerName(dnsv1alpha1.ResourceRecordSetGroupKind),
+	},
+	controllerName(networkservicesv1alpha1.MeshGroupKind): {
+		controllerName(networkservicesv1alpha1.HTTPRouteGroupKind),
+		controllerName(networkservicesv1alpha1.GRPCRouteGroupKind),
+	},
+	controllerName(networkservicesv1alpha1.GatewayGroupKind): {
+		controllerName(networkservicesv1alpha1.HTTPRouteGroupKind),
+		controllerName(networkservicesv1alpha1.GRPCRouteGroupKind),
+	},
+	controllerName(computev1alpha1.RouterGroupKind): {
+		controllerName(computev1alpha1.RouterNATGroupKind),
+		controllerName(computev1alpha1.RouterPeerGroupKind),
+	},
+	controllerName(computev1alpha1.ResourcePolicyGroupKind): {
+		controllerName(computev1alpha1.DiskGroupKind),
+	},
+	controllerName(computev1alpha1.HealthCheckGroupKind): {
+		controllerName(computev1alpha1.BackendServiceGroupKind),
+	},
+	controllerName(computev1alpha1.InstanceGroupManagerGroupKind): {
+		controllerName(computev1alpha1.BackendServiceGroupKind),
+	},
+	controllerName(computev1alpha1.NetworkEndpointGroupGroupKind): {
+		controllerName(computev1alpha1.BackendServiceGroupKind),
+	},
+	controllerName(computev1alpha1.BackendServiceGroupKind): {
+		controllerName(networkservicesv1alpha1.HTTPRouteGroupKind),
+		controllerName(networkservicesv1alpha1.GRPCRouteGroupKind),
+	},
+}
+
+func controllerName(kind string) string {
+	return managed.ControllerName(kind)
+}
+
+var deferred = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "provider_gcp_teardown_deferred_deletions_total",
+	Help: "Number of deletions of a controller that were deferred, either until dependent resources were deleted or because deletions were throttled.",
+}, []string{"controller", "reason"})
+
+func init() {
+	metrics.Registry.MustRegister(deferred)
+}
+
+var (
+	defaultsMu sync.Mutex
+	defaults   = NewQueue(DefaultRate, DefaultBurst)
+)
+
+// SetDefaults configures the rate and burst of deletions admitted by the
+// Queue shared by connecters that are subsequently created by
+// WrapConnecter.
+func SetDefaults(r float64, burst int) {
+	defaultsMu.Lock()
+	defer defaultsMu.Unlock()
+	defaults = NewQueue(r, burst)
+}
+
+type key struct {
+	controller string
+	name       string
+}
+
+type pending struct {
+	providerConfig string
+	since          time.Time
+	seen           time.Time
+}
+
+// A Queue tracks the managed resources that are being deleted, and admits
+// the deletion of a resource once none of its dependents are pending and
+// the rate of deletions allows it.
+type Queue struct {
+	limiter    *rate.Limiter
+	settle     time.Duration
+	staleAfter time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	pending map[key]pending
+}
+
+// An Option configures a Queue.
+type Option func(*Queue)
+
+// WithClock configures the function a Queue uses to determine the current
+// time.
+func WithClock(now func() time.Time) Option {
+	return func(q *Queue) {
+		q.now = now
+	}
+}
+
+// WithSettle configures how long a resource with dependents waits after it
+// started being deleted before it is deleted itself.
+func WithSettle(d time.Duration) Option {
+	return func(q *Queue) {
+		q.settle = d
+	}
+}
+
+// NewQueue returns a Queue that admits the supplied rate of deletions per
+// second, and bursts of the supplied number of deletions.
+func NewQueue(r float64, burst int, o ...Option) *Queue {
+	q := &Queue{
+		limiter:    rate.NewLimiter(rate.Limit(r), burst),
+		settle:     DefaultSettle,
+		staleAfter: DefaultStaleAfter,
+		now:        time.Now,
+		pending:    map[key]pending{},
+	}
+	for _, fn := range o {
+		fn(q)
+	}
+	return q
+}
+
+// Track records that the supplied managed resource of the named controller
+// is being deleted.
+func (q *Queue) Track(controller string, mg resource.Managed) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	k := key{controller: controller, name: mg.GetName()}
+	now := q.now()
+	p, ok := q.pending[k]
+	if !ok {
+		p = pending{providerConfig: providerConfigOf(mg), since: now}
+	}
+	p.seen = now
+	q.pending[k] = p
+}
+
+// Done records that the supplied managed resource of the named controller
+// is no longer being deleted.
+func (q *Queue) Done(controller string, mg resource.Managed) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.pending, key{controller: controller, name: mg.GetName()})
+}
+
+// Admit returns an error if the supplied managed resource of the named
+// controller may not be deleted yet.
+func (q *Queue) Admit(controller string, mg resource.Managed) error {
+	if err := q.admitDependents(controller, mg); err != nil {
+		deferred.WithLabelValues(controller, "dependents").Inc()
+		return err
+	}
+	if !q.limiter.Allow() {
+		deferred.WithLabelValues(controller, "throttled").Inc()
+		return errors.New(errThrottled)
+	}
+	return nil
+}
+
+func (q *Queue) admitDependents(controller string, mg resource.Managed) error {
+	deps := dependents[controller]
+	if len(deps) == 0 {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := q.now()
+	pc := providerConfigOf(mg)
+
+	var blocking []string
+	for k, p := range q.pending {
+		if now.Sub(p.seen) > q.staleAfter {
+			delete(q.pending, k)
+			continue
+		}
+		if p.providerConfig != pc || !contains(deps, k.controller) {
+			continue
+		}
+		blocking = append(blocking, strings.TrimPrefix(k.controller, "managed/")+"/"+k.name)
+	}
+	if len(blocking) > 0 {
+		sort.Strings(blocking)
+		return errors.Errorf(errFmtDependents, len(blocking), blocking[0])
+	}
+
+	if p, ok := q.pending[key{controller: controller, name: mg.GetName()}]; ok && now.Sub(p.since) < q.settle {
+		return errors.Errorf(errFmtSettling, p.since.Add(q.settle).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func providerConfigOf(mg resource.Managed) string {
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// WrapConnecter returns an ExternalConnecter whose external clients delete
+// managed resources of the named controller through the shared Queue. The
+// supplied ExternalConnecter is returned unchanged unless the ordered
+// deletion feature is enabled.
+func WrapConnecter(name string, o controller.Options, c managed.ExternalConnecter) managed.ExternalConnecter {
+	if o.Features == nil || !o.Features.Enabled(features.EnableAlphaOrderedDeletion) {
+		return c
+	}
+	defaultsMu.Lock()
+	defer defaultsMu.Unlock()
+	return &connecter{name: name, queue: defaults, wrapped: c}
+}
+
+type connecter struct {
+	name    string
+	queue   *Queue
+	wrapped managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.wrapped.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{name: c.name, queue: c.queue, wrapped: e}, nil
+}
+
+type external struct {
+	name    string
+	queue   *Queue
+	wrapped managed.ExternalClient
+}
+
+// Observe tracks managed resources that are being deleted until they are
+// observed to be gone. Orphaned resources are not deleted, and thus never
+// tracked.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.wrapped.Observe(ctx, mg)
+	if mg.GetDeletionTimestamp() == nil || mg.GetDeletionPolicy() == xpv1.DeletionOrphan {
+		return o, err
+	}
+	if err == nil && !o.ResourceExists {
+		e.queue.Done(e.name, mg)
+		return o, err
+	}
+	e.queue.Track(e.name, mg)
+	return o, err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return e.wrapped.Create(ctx, mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return e.wrapped.Update(ctx, mg)
+}
+
+// Delete defers the deletion of the managed resource while its dependents
+// are being deleted, or while deletions are throttled. The returned error is
+// surfaced by the managed reconciler as the resource's Synced condition, and
+// the deletion is retried with its usual backoff.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if err := e.queue.Admit(e.name, mg); err != nil {
+		return err
+	}
+	return e.wrapped.Delete(ctx, mg)
+}
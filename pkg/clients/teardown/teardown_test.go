@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teardown
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	storagev1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	storagev1alpha3 "github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+var (
+	bucket = controllerName(storagev1alpha3.BucketGroupKind)
+	member = controllerName(storagev1alpha1.BucketPolicyMemberGroupKind)
+)
+
+func deleting(name, providerConfig string) *fake.Managed {
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: name, DeletionTimestamp: &metav1.Time{}}}
+	mg.SetProviderConfigReference(&xpv1.Reference{Name: providerConfig})
+	return mg
+}
+
+func TestQueueAdmit(t *testing.T) {
+	start := time.Unix(0, 0)
+
+	cases := map[string]struct {
+		pending map[string]*fake.Managed
+		elapsed time.Duration
+		admit   *fake.Managed
+		want    bool
+	}{
+		"NoDependents": {
+			pending: map[string]*fake.Managed{bucket: deleting("b", "default")},
+			elapsed: time.Minute,
+			admit:   deleting("b", "default"),
+			want:    true,
+		},
+		"DependentPending": {
+			pending: map[string]*fake.Managed{member: deleting("m", "default"), bucket: deleting("b", "default")},
+			elapsed: time.Minute,
+			admit:   deleting("b", "default"),
+			want:    false,
+		},
+		"DependentOfOtherProviderConfig": {
+			pending: map[string]*fake.Managed{member: deleting("m", "other"), bucket: deleting("b", "default")},
+			elapsed: time.Minute,
+			admit:   deleting("b", "default"),
+			want:    true,
+		},
+		"DependentStale": {
+			pending: map[string]*fake.Managed{member: deleting("m", "default"), bucket: deleting("b", "default")},
+			elapsed: DefaultStaleAfter + time.Minute,
+			admit:   deleting("b", "default"),
+			want:    true,
+		},
+		"Settling": {
+			pending: map[string]*fake.Managed{bucket: deleting("b", "default")},
+			admit:   deleting("b", "default"),
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := start
+			q := NewQueue(100, 100, WithClock(func() time.Time { return now }))
+			for c, mg := range tc.pending {
+				q.Track(c, mg)
+			}
+			now = now.Add(tc.elapsed)
+			err := q.Admit(bucket, tc.admit)
+			if got := err == nil; got != tc.want {
+				t.Errorf("Admit(...): want admitted %t, got error %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestQueueDone(t *testing.T) {
+	q := NewQueue(100, 100, WithSettle(0))
+	q.Track(member, deleting("m", "default"))
+	if err := q.Admit(bucket, deleting("b", "default")); err == nil {
+		t.Fatalf("Admit(...): want error while a dependent is pending")
+	}
+	q.Done(member, deleting("m", "default"))
+	if err := q.Admit(bucket, deleting("b", "default")); err != nil {
+		t.Errorf("Admit(...): unexpected error once dependents are done: %s", err)
+	}
+}
+
+func TestQueueThrottle(t *testing.T) {
+	q := NewQueue(0.001, 2)
+	for i := 0; i < 2; i++ {
+		if err := q.Admit(member, deleting("m", "default")); err != nil {
+			t.Fatalf("Admit(...): unexpected error within burst: %s", err)
+		}
+	}
+	if err := q.Admit(member, deleting("m", "default")); err == nil {
+		t.Errorf("Admit(...): want error once the burst is exhausted")
+	}
+}
+
+func TestWrapConnecter(t *testing.T) {
+	deleted := 0
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{ResourceExists: true}, nil
+			},
+			DeleteFn: func(_ context.Context, _ resource.Managed) error {
+				deleted++
+				return nil
+			},
+		}, nil
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		if _, ok := WrapConnecter(bucket, controller.Options{Features: &feature.Flags{}}, c).(managed.ExternalConnectorFn); !ok {
+			t.Errorf("WrapConnecter(...): want the supplied connecter when the feature is disabled")
+		}
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		f := &feature.Flags{}
+		f.Enable(features.EnableAlphaOrderedDeletion)
+		SetDefaults(100, 100)
+		defer SetDefaults(DefaultRate, DefaultBurst)
+
+		m, err := WrapConnecter(member, controller.Options{Features: f}, c).Connect(context.Background(), &fake.Managed{})
+		if err != nil {
+			t.Fatalf("Connect(...): unexpected error: %s", err)
+		}
+		if _, err := m.Observe(context.Background(), deleting("m", "default")); err != nil {
+			t.Fatalf("Observe(...): unexpected error: %s", err)
+		}
+
+		b, err := WrapConnecter(bucket, controller.Options{Features: f}, c).Connect(context.Background(), &fake.Managed{})
+		if err != nil {
+			t.Fatalf("Connect(...): unexpected error: %s", err)
+		}
+		if err := b.Delete(context.Background(), deleting("b", "default")); err == nil {
+			t.Errorf("Delete(...): want error while a dependent is being deleted")
+		}
+		if err := m.Delete(context.Background(), deleting("m", "default")); err != nil {
+			t.Errorf("Delete(...): unexpected error: %s", err)
+		}
+		if deleted != 1 {
+			t.Errorf("Delete(...): want 1 deletion, got %d", deleted)
+		}
+	})
+}
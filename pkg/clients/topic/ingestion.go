@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/api/option/internaloption"
+	htransport "google.golang.org/api/transport/http"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+// The ingestion settings of topics are not part of the google.golang.org/api
+// release this provider builds against, so they are read and written through
+// a minimal REST client.
+// https://cloud.google.com/pubsub/docs/reference/rest/v1/projects.topics
+const (
+	basePath           = "https://pubsub.googleapis.com/"
+	scopeCloudPlatform = "https://www.googleapis.com/auth/cloud-platform"
+
+	ingestionUpdateMask = "ingestionDataSourceSettings"
+)
+
+// An IngestionTopic is the Pub/Sub API representation of a topic, limited to
+// its ingestion related fields.
+type IngestionTopic struct {
+	Name                        string                       `json:"name,omitempty"`
+	State                       string                       `json:"state,omitempty"`
+	IngestionDataSourceSettings *IngestionDataSourceSettings `json:"ingestionDataSourceSettings,omitempty"`
+}
+
+// IngestionDataSourceSettings of an IngestionTopic.
+type IngestionDataSourceSettings struct {
+	AwsKinesis *AwsKinesis `json:"awsKinesis,omitempty"`
+	AwsMsk     *AwsMsk     `json:"awsMsk,omitempty"`
+}
+
+// AwsKinesis ingestion settings.
+type AwsKinesis struct {
+	State             string `json:"state,omitempty"`
+	StreamArn         string `json:"streamArn,omitempty"`
+	ConsumerArn       string `json:"consumerArn,omitempty"`
+	AwsRoleArn        string `json:"awsRoleArn,omitempty"`
+	GcpServiceAccount string `json:"gcpServiceAccount,omitempty"`
+}
+
+// AwsMsk ingestion settings.
+type AwsMsk struct {
+	State             string `json:"state,omitempty"`
+	ClusterArn        string `json:"clusterArn,omitempty"`
+	Topic             string `json:"topic,omitempty"`
+	AwsRoleArn        string `json:"awsRoleArn,omitempty"`
+	GcpServiceAccount string `json:"gcpServiceAccount,omitempty"`
+}
+
+type updateIngestionTopicRequest struct {
+	Topic      *IngestionTopic `json:"topic"`
+	UpdateMask string          `json:"updateMask"`
+}
+
+// An IngestionClient reads and writes the ingestion settings of topics.
+type IngestionClient interface {
+	Get(ctx context.Context, name string) (*IngestionTopic, error)
+	Patch(ctx context.Context, name string, t *IngestionTopic) (*IngestionTopic, error)
+}
+
+// An IngestionService is an IngestionClient of the Pub/Sub REST API.
+type IngestionService struct {
+	client   *http.Client
+	basePath string
+}
+
+// NewIngestionService returns an IngestionService that uses the supplied
+// client options.
+func NewIngestionService(ctx context.Context, opts ...option.ClientOption) (*IngestionService, error) {
+	opts = append(opts,
+		internaloption.WithDefaultEndpoint(basePath),
+		internaloption.WithDefaultScopes(scopeCloudPlatform))
+	client, endpoint, err := htransport.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &IngestionService{client: client, basePath: endpoint}, nil
+}
+
+// Get the ingestion settings of the named topic.
+func (s *IngestionService) Get(ctx context.Context, name string) (*IngestionTopic, error) {
+	out := &IngestionTopic{}
+	return out, s.do(ctx, http.MethodGet, name, nil, out)
+}
+
+// Patch the ingestion settings of the named topic. Settings that are nil
+// remove the ingestion from the topic.
+func (s *IngestionService) Patch(ctx context.Context, name string, t *IngestionTopic) (*IngestionTopic, error) {
+	out := &IngestionTopic{}
+	return out, s.do(ctx, http.MethodPatch, name, &updateIngestionTopicRequest{Topic: t, UpdateMask: ingestionUpdateMask}, out)
+}
+
+func (s *IngestionService) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, googleapi.ResolveRelative(s.basePath, "v1/"+path), body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer googleapi.CloseBody(rsp)
+	if err := googleapi.CheckResponse(rsp); err != nil {
+		return err
+	}
+	return json.NewDecoder(rsp.Body).Decode(out)
+}
+
+// HasIngestion returns true if the supplied TopicParameters configure an
+// ingestion data source, or if the supplied TopicObservation reports one,
+// i.e. if the ingestion settings of the topic need to be observed.
+func HasIngestion(s v1alpha1.TopicParameters, o v1alpha1.TopicObservation) bool {
+	return s.IngestionDataSourceSettings != nil || o.IngestionState != ""
+}
+
+// GenerateIngestionTopic produces an IngestionTopic that is configured via
+// the supplied TopicParameters.
+func GenerateIngestionTopic(name string, s v1alpha1.TopicParameters) *IngestionTopic {
+	t := &IngestionTopic{Name: name}
+	in := s.IngestionDataSourceSettings
+	if in == nil {
+		return t
+	}
+	t.IngestionDataSourceSettings = &IngestionDataSourceSettings{}
+	if k := in.AWSKinesis; k != nil {
+		t.IngestionDataSourceSettings.AwsKinesis = &AwsKinesis{
+			StreamArn:         k.StreamARN,
+			ConsumerArn:       k.ConsumerARN,
+			AwsRoleArn:        k.AWSRoleARN,
+			GcpServiceAccount: k.GCPServiceAccount,
+		}
+	}
+	if m := in.AWSMSK; m != nil {
+		t.IngestionDataSourceSettings.AwsMsk = &AwsMsk{
+			ClusterArn:        m.ClusterARN,
+			Topic:             m.Topic,
+			AwsRoleArn:        m.AWSRoleARN,
+			GcpServiceAccount: m.GCPServiceAccount,
+		}
+	}
+	return t
+}
+
+// UpdateIngestionObservation updates the supplied TopicObservation with the
+// states reported by the supplied IngestionTopic.
+func UpdateIngestionObservation(o *v1alpha1.TopicObservation, t IngestionTopic) {
+	o.State = t.State
+	o.IngestionState = ""
+	if in := t.IngestionDataSourceSettings; in != nil {
+		switch {
+		case in.AwsKinesis != nil:
+			o.IngestionState = in.AwsKinesis.State
+		case in.AwsMsk != nil:
+			o.IngestionState = in.AwsMsk.State
+		}
+	}
+}
+
+// IsIngestionUpToDate checks whether the ingestion settings of the supplied
+// IngestionTopic are configured with the supplied TopicParameters.
+func IsIngestionUpToDate(s v1alpha1.TopicParameters, t IngestionTopic) bool {
+	return cmp.Equal(GenerateIngestionTopic(t.Name, s).IngestionDataSourceSettings, t.IngestionDataSourceSettings,
+		cmpopts.IgnoreFields(AwsKinesis{}, "State"), cmpopts.IgnoreFields(AwsMsk{}, "State"))
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topic
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+const (
+	clusterARN        = "arn:aws:kafka:us-east-1:111111111111:cluster/cool-cluster/1"
+	awsRoleARN        = "arn:aws:iam::111111111111:role/cool-role"
+	gcpServiceAccount = "ingestion@fooproject.iam.gserviceaccount.com"
+)
+
+func ingestionParams() v1alpha1.TopicParameters {
+	return v1alpha1.TopicParameters{
+		IngestionDataSourceSettings: &v1alpha1.IngestionDataSourceSettings{
+			AWSMSK: &v1alpha1.AWSMSK{
+				ClusterARN:        clusterARN,
+				Topic:             "cool-topic",
+				AWSRoleARN:        awsRoleARN,
+				GCPServiceAccount: gcpServiceAccount,
+			},
+		},
+	}
+}
+
+func ingestionTopic(state string) *IngestionTopic {
+	return &IngestionTopic{
+		Name:  name,
+		State: "ACTIVE",
+		IngestionDataSourceSettings: &IngestionDataSourceSettings{
+			AwsMsk: &AwsMsk{
+				State:             state,
+				ClusterArn:        clusterARN,
+				Topic:             "cool-topic",
+				AwsRoleArn:        awsRoleARN,
+				GcpServiceAccount: gcpServiceAccount,
+			},
+		},
+	}
+}
+
+func TestGenerateIngestionTopic(t *testing.T) {
+	cases := map[string]struct {
+		s   v1alpha1.TopicParameters
+		out *IngestionTopic
+	}{
+		"NoIngestion": {
+			out: &IngestionTopic{Name: name},
+		},
+		"AWSMSK": {
+			s:   ingestionParams(),
+			out: &IngestionTopic{Name: name, IngestionDataSourceSettings: ingestionTopic("").IngestionDataSourceSettings},
+		},
+	}
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateIngestionTopic(name, tc.s)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("GenerateIngestionTopic(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateIngestionObservation(t *testing.T) {
+	cases := map[string]struct {
+		t   IngestionTopic
+		out v1alpha1.TopicObservation
+	}{
+		"NoIngestion": {
+			t:   IngestionTopic{Name: name},
+			out: v1alpha1.TopicObservation{},
+		},
+		"IngestionFailing": {
+			t: func() IngestionTopic {
+				t := ingestionTopic("MSK_PERMISSION_DENIED")
+				t.State = "INGESTION_RESOURCE_ERROR"
+				return *t
+			}(),
+			out: v1alpha1.TopicObservation{State: "INGESTION_RESOURCE_ERROR", IngestionState: "MSK_PERMISSION_DENIED"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := v1alpha1.TopicObservation{IngestionState: "ACTIVE"}
+			UpdateIngestionObservation(&got, tc.t)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("UpdateIngestionObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsIngestionUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		s   v1alpha1.TopicParameters
+		t   IngestionTopic
+		out bool
+	}{
+		"UpToDate": {
+			s:   ingestionParams(),
+			t:   *ingestionTopic("ACTIVE"),
+			out: true,
+		},
+		"NeedsUpdate": {
+			s: func() v1alpha1.TopicParameters {
+				s := ingestionParams()
+				s.IngestionDataSourceSettings.AWSMSK.Topic = "other-topic"
+				return s
+			}(),
+			t:   *ingestionTopic("ACTIVE"),
+			out: false,
+		},
+		"NeedsRemoval": {
+			s:   v1alpha1.TopicParameters{},
+			t:   *ingestionTopic("ACTIVE"),
+			out: false,
+		},
+		"NoIngestion": {
+			s:   v1alpha1.TopicParameters{},
+			t:   IngestionTopic{Name: name},
+			out: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsIngestionUpToDate(tc.s, tc.t); got != tc.out {
+				t.Errorf("IsIngestionUpToDate(...): want %t, got %t", tc.out, got)
+			}
+		})
+	}
+}
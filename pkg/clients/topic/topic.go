@@ -87,7 +87,9 @@ func IsUpToDate(s v1alpha1.TopicParameters, t pubsub.Topic) bool {
 		return false
 	}
 
-	return cmp.Equal(observed, &s, cmpopts.IgnoreFields(v1alpha1.TopicParameters{}, "MessageRetentionDuration"))
+	// Ingestion settings are not part of pubsub.Topic and are compared by
+	// IsIngestionUpToDate.
+	return cmp.Equal(observed, &s, cmpopts.IgnoreFields(v1alpha1.TopicParameters{}, "MessageRetentionDuration", "IngestionDataSourceSettings"))
 }
 
 func convertDuration(duration *string) time.Duration {
@@ -0,0 +1,223 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transferjob
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/storagetransfer/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/transfer/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	namePrefix = "transferJobs/"
+
+	// UpdateMask lists the fields of a transfer job that are updated in
+	// place. The schedule of a transfer job cannot be updated.
+	UpdateMask = "description,transferSpec,status"
+
+	errGetSecret      = "cannot get Secret with AWS access key"
+	errFmtKeyNotFound = "key %q not found in Secret %s/%s"
+)
+
+// Client should be satisfied to conduct TransferJob operations.
+type Client interface {
+	Create(job *storagetransfer.TransferJob) *storagetransfer.TransferJobsCreateCall
+	Get(jobName string, projectID string) *storagetransfer.TransferJobsGetCall
+	Patch(jobName string, req *storagetransfer.UpdateTransferJobRequest) *storagetransfer.TransferJobsPatchCall
+	Delete(jobName string, projectID string) *storagetransfer.TransferJobsDeleteCall
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a transfer job.
+func GetFullyQualifiedName(name string) string {
+	return namePrefix + name
+}
+
+// IsDeleted returns true if the transfer job has been deleted. Deleted jobs
+// are still returned by the API until they are garbage collected.
+func IsDeleted(in storagetransfer.TransferJob) bool {
+	return in.Status == v1alpha1.TransferJobStatusDeleted
+}
+
+// GetAWSAccessKey returns the AWS access key of the AWS S3 data source of the
+// supplied parameters, or nil if it has none.
+func GetAWSAccessKey(ctx context.Context, kube client.Reader, in v1alpha1.TransferJobParameters) (*storagetransfer.AwsAccessKey, error) {
+	src := in.TransferSpec.AWSS3DataSource
+	if src == nil || src.AWSAccessKey == nil {
+		return nil, nil
+	}
+	id, err := getSecretValue(ctx, kube, src.AWSAccessKey.AccessKeyIDSecretRef)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := getSecretValue(ctx, kube, src.AWSAccessKey.SecretAccessKeySecretRef)
+	if err != nil {
+		return nil, err
+	}
+	return &storagetransfer.AwsAccessKey{AccessKeyId: id, SecretAccessKey: secret}, nil
+}
+
+func getSecretValue(ctx context.Context, kube client.Reader, ref xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", errors.Wrap(err, errGetSecret)
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errFmtKeyNotFound, ref.Key, ref.Namespace, ref.Name)
+	}
+	return string(v), nil
+}
+
+// GenerateTransferJob generates *storagetransfer.TransferJob instance from
+// TransferJobParameters. The supplied AWS access key, if any, is used to read
+// from an AWS S3 data source.
+func GenerateTransferJob(projectID, name string, in v1alpha1.TransferJobParameters, key *storagetransfer.AwsAccessKey) *storagetransfer.TransferJob {
+	return &storagetransfer.TransferJob{
+		Name:         GetFullyQualifiedName(name),
+		ProjectId:    projectID,
+		Description:  gcp.StringValue(in.Description),
+		Status:       gcp.StringValue(in.Status),
+		TransferSpec: generateTransferSpec(in.TransferSpec, key),
+		Schedule:     generateSchedule(in.Schedule),
+	}
+}
+
+func generateTransferSpec(in v1alpha1.TransferSpec, key *storagetransfer.AwsAccessKey) *storagetransfer.TransferSpec {
+	ts := &storagetransfer.TransferSpec{
+		GcsDataSink:   generateGCSData(&in.GCSDataSink),
+		GcsDataSource: generateGCSData(in.GCSDataSource),
+	}
+	if s := in.AWSS3DataSource; s != nil {
+		ts.AwsS3DataSource = &storagetransfer.AwsS3Data{
+			BucketName:   s.BucketName,
+			Path:         gcp.StringValue(s.Path),
+			RoleArn:      gcp.StringValue(s.RoleArn),
+			AwsAccessKey: key,
+		}
+	}
+	if s := in.HTTPDataSource; s != nil {
+		ts.HttpDataSource = &storagetransfer.HttpData{ListUrl: s.ListURL}
+	}
+	if c := in.ObjectConditions; c != nil {
+		ts.ObjectConditions = &storagetransfer.ObjectConditions{
+			IncludePrefixes:                     c.IncludePrefixes,
+			ExcludePrefixes:                     c.ExcludePrefixes,
+			MinTimeElapsedSinceLastModification: gcp.StringValue(c.MinTimeElapsedSinceLastModification),
+			MaxTimeElapsedSinceLastModification: gcp.StringValue(c.MaxTimeElapsedSinceLastModification),
+			LastModifiedSince:                   gcp.StringValue(c.LastModifiedSince),
+			LastModifiedBefore:                  gcp.StringValue(c.LastModifiedBefore),
+		}
+	}
+	if o := in.TransferOptions; o != nil {
+		ts.TransferOptions = &storagetransfer.TransferOptions{
+			OverwriteObjectsAlreadyExistingInSink: gcp.BoolValue(o.OverwriteObjectsAlreadyExistingInSink),
+			OverwriteWhen:                         gcp.StringValue(o.OverwriteWhen),
+			DeleteObjectsUniqueInSink:             gcp.BoolValue(o.DeleteObjectsUniqueInSink),
+			DeleteObjectsFromSourceAfterTransfer:  gcp.BoolValue(o.DeleteObjectsFromSourceAfterTransfer),
+		}
+	}
+	return ts
+}
+
+func generateGCSData(in *v1alpha1.GCSData) *storagetransfer.GcsData {
+	if in == nil {
+		return nil
+	}
+	return &storagetransfer.GcsData{
+		BucketName: gcp.StringValue(in.BucketName),
+		Path:       gcp.StringValue(in.Path),
+	}
+}
+
+func generateSchedule(in *v1alpha1.Schedule) *storagetransfer.Schedule {
+	if in == nil {
+		return nil
+	}
+	return &storagetransfer.Schedule{
+		ScheduleStartDate: generateDate(&in.ScheduleStartDate),
+		ScheduleEndDate:   generateDate(in.ScheduleEndDate),
+		StartTimeOfDay:    generateTimeOfDay(in.StartTimeOfDay),
+		EndTimeOfDay:      generateTimeOfDay(in.EndTimeOfDay),
+		RepeatInterval:    gcp.StringValue(in.RepeatInterval),
+	}
+}
+
+func generateDate(in *v1alpha1.Date) *storagetransfer.Date {
+	if in == nil {
+		return nil
+	}
+	return &storagetransfer.Date{Year: in.Year, Month: in.Month, Day: in.Day}
+}
+
+func generateTimeOfDay(in *v1alpha1.TimeOfDay) *storagetransfer.TimeOfDay {
+	if in == nil {
+		return nil
+	}
+	return &storagetransfer.TimeOfDay{Hours: in.Hours, Minutes: in.Minutes, Seconds: in.Seconds}
+}
+
+// GenerateObservation produces TransferJobObservation object from
+// storagetransfer.TransferJob object.
+func GenerateObservation(in storagetransfer.TransferJob) v1alpha1.TransferJobObservation {
+	return v1alpha1.TransferJobObservation{
+		Name:                 in.Name,
+		CreationTime:         in.CreationTime,
+		LastModificationTime: in.LastModificationTime,
+		LatestOperationName:  in.LatestOperationName,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// storagetransfer.TransferJob object.
+func LateInitializeSpec(spec *v1alpha1.TransferJobParameters, in storagetransfer.TransferJob) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Status = gcp.LateInitializeString(spec.Status, in.Status)
+}
+
+// IsUpToDate checks whether the observed transfer job is up-to-date compared
+// to the given set of parameters. The schedule is not compared because it
+// cannot be updated, nor is the AWS access key because it is never returned.
+func IsUpToDate(projectID, name string, in v1alpha1.TransferJobParameters, observed storagetransfer.TransferJob) bool {
+	desired := GenerateTransferJob(projectID, name, in, nil)
+	return cmp.Equal(desired, &observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(storagetransfer.TransferJob{}, "Name", "ProjectId", "Schedule",
+			"CreationTime", "LastModificationTime", "DeletionTime", "LatestOperationName",
+			"LoggingConfig", "NotificationConfig"),
+		cmpopts.IgnoreFields(storagetransfer.AwsS3Data{}, "AwsAccessKey"),
+		ignoreClientFields)
+}
+
+// ignoreClientFields ignores the fields of the API types that are only used
+// by the API client rather than sent to or returned by the API.
+var ignoreClientFields = cmp.FilterPath(func(p cmp.Path) bool {
+	switch p.Last().String() {
+	case ".ServerResponse", ".ForceSendFields", ".NullFields":
+		return true
+	}
+	return false
+}, cmp.Ignore())
@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transferjob
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/storagetransfer/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/transfer/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "my-project"
+	testJob     = "my-job"
+)
+
+func params(m ...func(*v1alpha1.TransferJobParameters)) v1alpha1.TransferJobParameters {
+	p := v1alpha1.TransferJobParameters{
+		Description: gcp.StringPtr("nightly copy"),
+		Status:      gcp.StringPtr(v1alpha1.TransferJobStatusEnabled),
+		TransferSpec: v1alpha1.TransferSpec{
+			GCSDataSource: &v1alpha1.GCSData{BucketName: gcp.StringPtr("source"), Path: gcp.StringPtr("data/")},
+			GCSDataSink:   v1alpha1.GCSData{BucketName: gcp.StringPtr("sink")},
+			ObjectConditions: &v1alpha1.ObjectConditions{
+				IncludePrefixes:                     []string{"logs/"},
+				MaxTimeElapsedSinceLastModification: gcp.StringPtr("86400s"),
+			},
+			TransferOptions: &v1alpha1.TransferOptions{
+				OverwriteWhen:             gcp.StringPtr("DIFFERENT"),
+				DeleteObjectsUniqueInSink: gcp.BoolPtr(true),
+			},
+		},
+		Schedule: &v1alpha1.Schedule{
+			ScheduleStartDate: v1alpha1.Date{Year: 2023, Month: 1, Day: 1},
+			StartTimeOfDay:    &v1alpha1.TimeOfDay{Hours: 2},
+			RepeatInterval:    gcp.StringPtr("86400s"),
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func job(m ...func(*storagetransfer.TransferJob)) storagetransfer.TransferJob {
+	j := storagetransfer.TransferJob{
+		Name:         "transferJobs/" + testJob,
+		ProjectId:    testProject,
+		Description:  "nightly copy",
+		Status:       v1alpha1.TransferJobStatusEnabled,
+		CreationTime: "2023-01-01T00:00:00Z",
+		TransferSpec: &storagetransfer.TransferSpec{
+			GcsDataSource: &storagetransfer.GcsData{BucketName: "source", Path: "data/"},
+			GcsDataSink:   &storagetransfer.GcsData{BucketName: "sink"},
+			ObjectConditions: &storagetransfer.ObjectConditions{
+				IncludePrefixes:                     []string{"logs/"},
+				MaxTimeElapsedSinceLastModification: "86400s",
+			},
+			TransferOptions: &storagetransfer.TransferOptions{
+				OverwriteWhen:             "DIFFERENT",
+				DeleteObjectsUniqueInSink: true,
+			},
+		},
+		Schedule: &storagetransfer.Schedule{
+			ScheduleStartDate: &storagetransfer.Date{Year: 2023, Month: 1, Day: 1},
+			StartTimeOfDay:    &storagetransfer.TimeOfDay{Hours: 2},
+			RepeatInterval:    "86400s",
+		},
+	}
+	for _, f := range m {
+		f(&j)
+	}
+	return j
+}
+
+func TestGenerateTransferJob(t *testing.T) {
+	want := job(func(j *storagetransfer.TransferJob) { j.CreationTime = "" })
+	got := GenerateTransferJob(testProject, testJob, params(), nil)
+	if diff := cmp.Diff(&want, got); diff != "" {
+		t.Errorf("GenerateTransferJob(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	got := params(func(p *v1alpha1.TransferJobParameters) {
+		p.Description = nil
+		p.Status = nil
+	})
+	LateInitializeSpec(&got, job())
+	if diff := cmp.Diff(params(), got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.TransferJobParameters
+		observed storagetransfer.TransferJob
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: job(),
+			want:     true,
+		},
+		"ScheduleIgnored": {
+			in:       params(func(p *v1alpha1.TransferJobParameters) { p.Schedule.RepeatInterval = gcp.StringPtr("3600s") }),
+			observed: job(),
+			want:     true,
+		},
+		"AWSAccessKeyIgnored": {
+			in: params(func(p *v1alpha1.TransferJobParameters) {
+				p.TransferSpec.GCSDataSource = nil
+				p.TransferSpec.AWSS3DataSource = &v1alpha1.AWSS3Data{BucketName: "s3", AWSAccessKey: &v1alpha1.AWSAccessKey{}}
+			}),
+			observed: job(func(j *storagetransfer.TransferJob) {
+				j.TransferSpec.GcsDataSource = nil
+				j.TransferSpec.AwsS3DataSource = &storagetransfer.AwsS3Data{BucketName: "s3"}
+			}),
+			want: true,
+		},
+		"StatusChanged": {
+			in:       params(func(p *v1alpha1.TransferJobParameters) { p.Status = gcp.StringPtr(v1alpha1.TransferJobStatusDisabled) }),
+			observed: job(),
+		},
+		"SinkChanged": {
+			in:       params(func(p *v1alpha1.TransferJobParameters) { p.TransferSpec.GCSDataSink.Path = gcp.StringPtr("in/") }),
+			observed: job(),
+		},
+		"TransferOptionsChanged": {
+			in:       params(func(p *v1alpha1.TransferJobParameters) { p.TransferSpec.TransferOptions = nil }),
+			observed: job(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(testProject, testJob, tc.in, tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetAWSAccessKey(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := func(key string) xpv1.SecretKeySelector {
+		return xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "aws", Namespace: "default"}, Key: key}
+	}
+	withKey := func(p *v1alpha1.TransferJobParameters) {
+		p.TransferSpec.AWSS3DataSource = &v1alpha1.AWSS3Data{
+			BucketName:   "s3",
+			AWSAccessKey: &v1alpha1.AWSAccessKey{AccessKeyIDSecretRef: ref("id"), SecretAccessKeySecretRef: ref("secret")},
+		}
+	}
+	secret := func(data map[string][]byte) client.Reader {
+		return &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = data
+			return nil
+		}}
+	}
+
+	type want struct {
+		key *storagetransfer.AwsAccessKey
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		in   v1alpha1.TransferJobParameters
+		want want
+	}{
+		"NoAWSSource": {
+			in: params(),
+		},
+		"Successful": {
+			kube: secret(map[string][]byte{"id": []byte("AKIA"), "secret": []byte("s3cr3t")}),
+			in:   params(withKey),
+			want: want{key: &storagetransfer.AwsAccessKey{AccessKeyId: "AKIA", SecretAccessKey: "s3cr3t"}},
+		},
+		"KeyNotFound": {
+			kube: secret(map[string][]byte{"id": []byte("AKIA")}),
+			in:   params(withKey),
+			want: want{err: errors.Errorf(errFmtKeyNotFound, "secret", "default", "aws")},
+		},
+		"GetSecretFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			in:   params(withKey),
+			want: want{err: errors.Wrap(errBoom, errGetSecret)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			key, err := GetAWSAccessKey(context.Background(), tc.kube, tc.in)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetAWSAccessKey(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.key, key); diff != "" {
+				t.Errorf("GetAWSAccessKey(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package utilization records how much of the capacity of external resources,
+// such as the IP addresses of subnetworks and address ranges or the storage of
+// database instances, is used.
+package utilization
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+// DefaultInterval is the default minimum time between two recordings of the
+// utilization of a resource once recording is enabled. Recording the
+// utilization of some resources requires listing many other resources, so it
+// is not done on every observation.
+const DefaultInterval = 10 * time.Minute
+
+const (
+	errParseCIDR = "cannot parse CIDR range"
+	errNotIPv4   = "CIDR range is not an IPv4 range"
+)
+
+var (
+	intervalMu sync.RWMutex
+	interval   time.Duration
+)
+
+// SetInterval configures the minimum time between two recordings of the
+// utilization of a resource. Utilization is not recorded at all if the
+// interval is zero, which is the default because recording requires
+// permission to list additional resources.
+func SetInterval(d time.Duration) {
+	intervalMu.Lock()
+	defer intervalMu.Unlock()
+	interval = d
+}
+
+// Due returns true if the utilization of a resource that was last recorded as
+// u should be recorded again at the supplied time.
+func Due(u *v1beta1.Utilization, now time.Time) bool {
+	intervalMu.RLock()
+	defer intervalMu.RUnlock()
+	if interval <= 0 {
+		return false
+	}
+	return u == nil || !now.Before(u.ObservedTime.Add(interval))
+}
+
+// New returns a Utilization of the supplied used and total capacity, recorded
+// at the supplied time.
+func New(unit string, used, capacity int64, now time.Time) *v1beta1.Utilization {
+	u := &v1beta1.Utilization{
+		Unit:         unit,
+		Used:         used,
+		Capacity:     capacity,
+		ObservedTime: metav1.NewTime(now),
+	}
+	if capacity > 0 {
+		u.Percent = used * 100 / capacity
+	}
+	return u
+}
+
+// IPv4RangeSize returns the number of addresses in the supplied IPv4 CIDR
+// range, e.g. 256 for 10.0.0.0/24.
+func IPv4RangeSize(cidr string) (int64, error) {
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return 0, errors.Wrap(err, errParseCIDR)
+	}
+	ones, bits := n.Mask.Size()
+	if bits != 32 {
+		return 0, errors.New(errNotIPv4)
+	}
+	return int64(1) << (bits - ones), nil
+}
+
+// RangeContains returns true if the supplied IP address or CIDR range lies
+// entirely within the supplied CIDR range.
+func RangeContains(cidr, in string) bool {
+	_, outer, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+	if ip := net.ParseIP(in); ip != nil {
+		return outer.Contains(ip)
+	}
+	ip, inner, err := net.ParseCIDR(in)
+	if err != nil {
+		return false
+	}
+	innerOnes, innerBits := inner.Mask.Size()
+	outerOnes, outerBits := outer.Mask.Size()
+	return innerBits == outerBits && innerOnes >= outerOnes && outer.Contains(ip)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utilization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+)
+
+func TestDue(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	cases := map[string]struct {
+		interval time.Duration
+		u        *v1beta1.Utilization
+		want     bool
+	}{
+		"NeverRecorded": {
+			interval: DefaultInterval,
+			want:     true,
+		},
+		"RecordedRecently": {
+			interval: DefaultInterval,
+			u:        &v1beta1.Utilization{ObservedTime: metav1.NewTime(now.Add(-time.Minute))},
+			want:     false,
+		},
+		"IntervalElapsed": {
+			interval: DefaultInterval,
+			u:        &v1beta1.Utilization{ObservedTime: metav1.NewTime(now.Add(-DefaultInterval))},
+			want:     true,
+		},
+		"Disabled": {
+			interval: 0,
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			SetInterval(tc.interval)
+			defer SetInterval(0)
+			if diff := cmp.Diff(tc.want, Due(tc.u, now)); diff != "" {
+				t.Errorf("Due(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	cases := map[string]struct {
+		used     int64
+		capacity int64
+		want     *v1beta1.Utilization
+	}{
+		"Used": {
+			used:     63,
+			capacity: 252,
+			want:     &v1beta1.Utilization{Unit: v1beta1.UtilizationUnitIPAddresses, Used: 63, Capacity: 252, Percent: 25, ObservedTime: metav1.NewTime(now)},
+		},
+		"NoCapacity": {
+			used: 1,
+			want: &v1beta1.Utilization{Unit: v1beta1.UtilizationUnitIPAddresses, Used: 1, ObservedTime: metav1.NewTime(now)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := New(v1beta1.UtilizationUnitIPAddresses, tc.used, tc.capacity, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("New(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv4RangeSize(t *testing.T) {
+	cases := map[string]struct {
+		cidr    string
+		want    int64
+		wantErr bool
+	}{
+		"Slash24":  {cidr: "10.0.0.0/24", want: 256},
+		"Slash16":  {cidr: "10.10.0.0/16", want: 65536},
+		"IPv6":     {cidr: "fd20::/64", wantErr: true},
+		"NotACIDR": {cidr: "10.0.0.1", wantErr: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IPv4RangeSize(tc.cidr)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("IPv4RangeSize(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IPv4RangeSize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRangeContains(t *testing.T) {
+	cases := map[string]struct {
+		cidr string
+		in   string
+		want bool
+	}{
+		"AddressInside":  {cidr: "10.0.0.0/24", in: "10.0.0.7", want: true},
+		"AddressOutside": {cidr: "10.0.0.0/24", in: "10.0.1.7", want: false},
+		"RangeInside":    {cidr: "10.0.0.0/16", in: "10.0.4.0/22", want: true},
+		"RangeLarger":    {cidr: "10.0.0.0/16", in: "10.0.0.0/8", want: false},
+		"RangeOutside":   {cidr: "10.0.0.0/16", in: "10.1.0.0/24", want: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, RangeContains(tc.cidr, tc.in)); diff != "" {
+				t.Errorf("RangeContains(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigeev1 "google.golang.org/api/apigee/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const envGroupName = orgName + "/envgroups/public"
+
+var (
+	_ managed.ExternalConnecter = &envGroupConnector{}
+	_ managed.ExternalClient    = &envGroupExternal{}
+)
+
+type envGroupModifier func(*v1alpha1.EnvGroup)
+
+func envGroupWithState(state string, c xpv1.Condition) envGroupModifier {
+	return func(g *v1alpha1.EnvGroup) {
+		g.Status.AtProvider.State = state
+		g.SetConditions(c)
+	}
+}
+
+func envGroup(m ...envGroupModifier) *v1alpha1.EnvGroup {
+	g := &v1alpha1.EnvGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "public",
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: "public"},
+		},
+		Spec: v1alpha1.EnvGroupSpec{
+			ForProvider: v1alpha1.EnvGroupParameters{
+				Organization: gcp.StringPtr(project),
+				Hostnames:    []string{"api.example.com", "api.example.org"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(g)
+	}
+	return g
+}
+
+func TestEnvGroupObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Active": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+envGroupName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleCloudApigeeV1EnvironmentGroup{
+					Name:      "public",
+					Hostnames: []string{"api.example.org", "api.example.com"},
+					State:     v1alpha1.StateActive,
+				})
+			}),
+			mg: envGroup(),
+			want: want{
+				mg:  envGroup(envGroupWithState(v1alpha1.StateActive, xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleCloudApigeeV1EnvironmentGroup{
+					Name:      "public",
+					Hostnames: []string{"api.example.com"},
+					State:     v1alpha1.StateUpdating,
+				})
+			}),
+			mg: envGroup(),
+			want: want{
+				mg:  envGroup(envGroupWithState(v1alpha1.StateUpdating, xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: envGroup(),
+			want: want{
+				mg: envGroup(),
+			},
+		},
+		"NotEnvGroup": {
+			mg: &strange{},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotEnvGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &envGroupExternal{envGroups: apigeev1.NewOrganizationsEnvgroupsService(s)}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnvGroupCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+orgName+"/envgroups", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				if diff := cmp.Diff("public", r.URL.Query().Get("name")); diff != "" {
+					t.Errorf("r: -want name, +got name:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleLongrunningOperation{Name: "op"})
+			}),
+			mg: envGroup(),
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   envGroup(),
+			want: errors.Wrap(err500, errCreateEnvGroup),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &envGroupExternal{envGroups: apigeev1.NewOrganizationsEnvgroupsService(s)}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnvGroupUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("hostnames", r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("r: -want updateMask, +got updateMask:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleLongrunningOperation{Name: "op"})
+			}),
+			mg: envGroup(),
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   envGroup(),
+			want: errors.Wrap(err500, errUpdateEnvGroup),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &envGroupExternal{envGroups: apigeev1.NewOrganizationsEnvgroupsService(s)}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnvGroupDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleLongrunningOperation{Name: "op"})
+			}),
+			mg: envGroup(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: envGroup(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &envGroupExternal{envGroups: apigeev1.NewOrganizationsEnvgroupsService(s)}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigeev1 "google.golang.org/api/apigee/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const envName = orgName + "/environments/prod"
+
+var (
+	_ managed.ExternalConnecter = &environmentConnector{}
+	_ managed.ExternalClient    = &environmentExternal{}
+)
+
+type environmentModifier func(*v1alpha1.Environment)
+
+func envWithState(state string, c xpv1.Condition) environmentModifier {
+	return func(e *v1alpha1.Environment) {
+		e.Status.AtProvider.State = state
+		e.SetConditions(c)
+	}
+}
+
+func envWithDescription(d string) environmentModifier {
+	return func(e *v1alpha1.Environment) { e.Spec.ForProvider.Description = gcp.StringPtr(d) }
+}
+
+func environment(m ...environmentModifier) *v1alpha1.Environment {
+	e := &v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "prod",
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: "prod"},
+		},
+		Spec: v1alpha1.EnvironmentSpec{
+			ForProvider: v1alpha1.EnvironmentParameters{
+				Organization:   gcp.StringPtr(project),
+				DisplayName:    gcp.StringPtr("Production"),
+				DeploymentType: gcp.StringPtr("PROXY"),
+				APIProxyType:   gcp.StringPtr("PROGRAMMABLE"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+func environmentResponse(state string) *apigeev1.GoogleCloudApigeeV1Environment {
+	return &apigeev1.GoogleCloudApigeeV1Environment{
+		Name:           "prod",
+		DisplayName:    "Production",
+		DeploymentType: "PROXY",
+		ApiProxyType:   "PROGRAMMABLE",
+		State:          state,
+	}
+}
+
+func TestEnvironmentObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Active": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+envName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(environmentResponse(v1alpha1.StateActive))
+			}),
+			mg: environment(),
+			want: want{
+				mg:  environment(envWithState(v1alpha1.StateActive, xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(environmentResponse(v1alpha1.StateActive))
+			}),
+			mg: environment(envWithDescription("serves production traffic")),
+			want: want{
+				mg:  environment(envWithDescription("serves production traffic"), envWithState(v1alpha1.StateActive, xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: environment(),
+			want: want{
+				mg: environment(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: environment(),
+			want: want{
+				mg:  environment(),
+				err: errors.Wrap(err500, errGetEnvironment),
+			},
+		},
+		"NotEnvironment": {
+			mg: &strange{},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotEnvironment),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &environmentExternal{environments: apigeev1.NewOrganizationsEnvironmentsService(s)}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+orgName+"/environments", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				got := &apigeev1.GoogleCloudApigeeV1Environment{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(environmentResponse(""), got); diff != "" {
+					t.Errorf("r: -want body, +got body:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleLongrunningOperation{Name: "op"})
+			}),
+			mg: environment(),
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   environment(),
+			want: errors.Wrap(err500, errCreateEnvironment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &environmentExternal{environments: apigeev1.NewOrganizationsEnvironmentsService(s)}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+envName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(environmentResponse(v1alpha1.StateActive))
+			}),
+			mg: environment(envWithDescription("serves production traffic")),
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   environment(),
+			want: errors.Wrap(err500, errUpdateEnvironment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &environmentExternal{environments: apigeev1.NewOrganizationsEnvironmentsService(s)}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleLongrunningOperation{Name: "op"})
+			}),
+			mg: environment(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: environment(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &environmentExternal{environments: apigeev1.NewOrganizationsEnvironmentsService(s)}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	apigeev1 "google.golang.org/api/apigee/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/apigee"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotInstance    = "managed resource is not an Apigee Instance"
+	errGetInstance    = "cannot get external Apigee Instance"
+	errCreateInstance = "cannot create external Apigee Instance"
+	errUpdateInstance = "cannot update external Apigee Instance"
+	errDeleteInstance = "cannot delete external Apigee Instance"
+)
+
+// SetupInstance adds a controller that reconciles Apigee Instances.
+func SetupInstance(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &instanceConnector{kube: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.Instance{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type instanceConnector struct {
+	kube client.Client
+}
+
+func (c *instanceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigeev1.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &instanceExternal{instances: apigeev1.NewOrganizationsInstancesService(s)}, nil
+}
+
+type instanceExternal struct {
+	instances apigee.InstanceClient
+}
+
+func (e *instanceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstance)
+	}
+
+	observed, err := e.instances.Get(apigee.GetInstanceName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInstance)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	apigee.LateInitializeInstance(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = apigee.GenerateInstanceObservation(*observed)
+	cr.Status.SetConditions(stateCondition(cr.Status.AtProvider.State))
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        apigee.IsInstanceUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ConnectionDetails:       apigee.GetInstanceConnectionDetails(*observed),
+	}, nil
+}
+
+func (e *instanceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	parent := apigee.GetOrganizationName(gcp.StringValue(cr.Spec.ForProvider.Organization))
+	op, err := e.instances.Create(parent, apigee.GenerateInstance(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *instanceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInstance)
+	}
+
+	name := apigee.GetInstanceName(cr.Spec.ForProvider, meta.GetExternalName(cr))
+	op, err := e.instances.Patch(name, apigee.GenerateInstance(meta.GetExternalName(cr), cr.Spec.ForProvider)).
+		UpdateMask(apigee.InstanceUpdateMask).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *instanceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	op, err := e.instances.Delete(apigee.GetInstanceName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstance)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
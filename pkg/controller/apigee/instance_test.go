@@ -0,0 +1,316 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigeev1 "google.golang.org/api/apigee/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const instanceName = orgName + "/instances/us-central1"
+
+var (
+	_ managed.ExternalConnecter = &instanceConnector{}
+	_ managed.ExternalClient    = &instanceExternal{}
+)
+
+type instanceModifier func(*v1alpha1.Instance)
+
+func instanceWithObservation(state, host string, c xpv1.Condition) instanceModifier {
+	return func(i *v1alpha1.Instance) {
+		i.Status.AtProvider.State = state
+		i.Status.AtProvider.Host = host
+		if host != "" {
+			i.Status.AtProvider.Port = "443"
+		}
+		i.SetConditions(c)
+	}
+}
+
+func instance(m ...instanceModifier) *v1alpha1.Instance {
+	i := &v1alpha1.Instance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "us-central1",
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: "us-central1"},
+		},
+		Spec: v1alpha1.InstanceSpec{
+			ForProvider: v1alpha1.InstanceParameters{
+				Organization:       gcp.StringPtr(project),
+				Location:           "us-central1",
+				IPRange:            gcp.StringPtr("10.87.8.0/22,10.87.12.0/28"),
+				PeeringCIDRRange:   gcp.StringPtr("SLASH_22"),
+				ConsumerAcceptList: []string{project},
+			},
+		},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestInstanceObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Active": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+instanceName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleCloudApigeeV1Instance{
+					Name:               "us-central1",
+					Location:           "us-central1",
+					PeeringCidrRange:   "SLASH_22",
+					ConsumerAcceptList: []string{project},
+					Host:               "10.87.8.2",
+					Port:               "443",
+					State:              v1alpha1.StateActive,
+				})
+			}),
+			mg: instance(),
+			want: want{
+				mg: instance(instanceWithObservation(v1alpha1.StateActive, "10.87.8.2", xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.87.8.2"),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("443"),
+					},
+				},
+			},
+		},
+		"Creating": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleCloudApigeeV1Instance{
+					Name:             "us-central1",
+					PeeringCidrRange: "SLASH_22",
+					State:            v1alpha1.StateCreating,
+				})
+			}),
+			mg: instance(),
+			want: want{
+				mg:  instance(instanceWithObservation(v1alpha1.StateCreating, "", xpv1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: instance(),
+			want: want{
+				mg: instance(),
+			},
+		},
+		"NotInstance": {
+			mg: &strange{},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotInstance),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &instanceExternal{instances: apigeev1.NewOrganizationsInstancesService(s)}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff("/v1/"+orgName+"/instances", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				got := &apigeev1.GoogleCloudApigeeV1Instance{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Error(err)
+				}
+				want := &apigeev1.GoogleCloudApigeeV1Instance{
+					Name:               "us-central1",
+					Location:           "us-central1",
+					IpRange:            "10.87.8.0/22,10.87.12.0/28",
+					PeeringCidrRange:   "SLASH_22",
+					ConsumerAcceptList: []string{project},
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("r: -want body, +got body:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleLongrunningOperation{Name: "op"})
+			}),
+			mg: instance(),
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   instance(),
+			want: errors.Wrap(err500, errCreateInstance),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &instanceExternal{instances: apigeev1.NewOrganizationsInstancesService(s)}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("consumerAcceptList", r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("r: -want updateMask, +got updateMask:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleLongrunningOperation{Name: "op"})
+			}),
+			mg: instance(),
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   instance(),
+			want: errors.Wrap(err500, errUpdateInstance),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &instanceExternal{instances: apigeev1.NewOrganizationsInstancesService(s)}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleLongrunningOperation{Name: "op"})
+			}),
+			mg: instance(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   instance(),
+			want: errors.Wrap(err500, errDeleteInstance),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &instanceExternal{instances: apigeev1.NewOrganizationsInstancesService(s)}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
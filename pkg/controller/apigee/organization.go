@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	apigeev1 "google.golang.org/api/apigee/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/apigee"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNewClient          = "cannot create new Apigee API client"
+	errNotOrganization    = "managed resource is not an Apigee Organization"
+	errGetOrganization    = "cannot get external Apigee Organization"
+	errCreateOrganization = "cannot create external Apigee Organization"
+	errUpdateOrganization = "cannot update external Apigee Organization"
+	errDeleteOrganization = "cannot delete external Apigee Organization"
+)
+
+// stateCondition returns the Ready condition that corresponds to the state
+// of an Apigee resource.
+func stateCondition(state string) xpv1.Condition {
+	switch state {
+	case v1alpha1.StateActive, v1alpha1.StateUpdating:
+		return xpv1.Available()
+	case v1alpha1.StateCreating:
+		return xpv1.Creating()
+	case v1alpha1.StateDeleting:
+		return xpv1.Deleting()
+	default:
+		return xpv1.Unavailable()
+	}
+}
+
+// SetupOrganization adds a controller that reconciles Apigee Organizations.
+func SetupOrganization(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.OrganizationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OrganizationGroupVersionKind),
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &organizationConnector{kube: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.Organization{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type organizationConnector struct {
+	kube client.Client
+}
+
+func (c *organizationConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigeev1.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &organizationExternal{organizations: apigeev1.NewOrganizationsService(s), projectID: projectID}, nil
+}
+
+type organizationExternal struct {
+	organizations apigee.OrganizationClient
+	projectID     string
+}
+
+func (e *organizationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganization)
+	}
+
+	// The organization is named after the project it is attached to, which
+	// is only recorded once it has been created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	observed, err := e.organizations.Get(apigee.GetOrganizationName(meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetOrganization)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	apigee.LateInitializeOrganization(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = apigee.GenerateOrganizationObservation(*observed)
+	cr.Status.SetConditions(stateCondition(cr.Status.AtProvider.State))
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        apigee.IsOrganizationUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *organizationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganization)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	op, err := e.organizations.Create(apigee.GenerateOrganization(cr.Spec.ForProvider)).
+		Parent("projects/" + e.projectID).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateOrganization)
+	}
+	audit.RecordOperation(ctx, op.Name)
+
+	meta.SetExternalName(cr, e.projectID)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *organizationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrganization)
+	}
+
+	name := apigee.GetOrganizationName(meta.GetExternalName(cr))
+	if _, err := e.organizations.Update(name, apigee.GenerateOrganization(cr.Spec.ForProvider)).Context(ctx).Do(); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateOrganization)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *organizationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return errors.New(errNotOrganization)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	call := e.organizations.Delete(apigee.GetOrganizationName(meta.GetExternalName(cr)))
+	if cr.Spec.ForProvider.Retention != nil {
+		call = call.Retention(*cr.Spec.ForProvider.Retention)
+	}
+	op, err := call.Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteOrganization)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
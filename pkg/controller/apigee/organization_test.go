@@ -0,0 +1,378 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigeev1 "google.golang.org/api/apigee/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	project = "someProject"
+	orgName = "organizations/" + project
+)
+
+var (
+	_ managed.ExternalConnecter = &organizationConnector{}
+	_ managed.ExternalClient    = &organizationExternal{}
+
+	err500 = &googleapi.Error{Code: 500, Body: "{}\n"}
+)
+
+type strange struct {
+	resource.Managed
+}
+
+type organizationModifier func(*v1alpha1.Organization)
+
+func orgWithExternalName(n string) organizationModifier {
+	return func(o *v1alpha1.Organization) { meta.SetExternalName(o, n) }
+}
+
+func orgWithDisplayName(n string) organizationModifier {
+	return func(o *v1alpha1.Organization) { o.Spec.ForProvider.DisplayName = gcp.StringPtr(n) }
+}
+
+func orgWithRetention(r string) organizationModifier {
+	return func(o *v1alpha1.Organization) { o.Spec.ForProvider.Retention = gcp.StringPtr(r) }
+}
+
+func orgWithObservation(state string) organizationModifier {
+	return func(o *v1alpha1.Organization) {
+		o.Status.AtProvider = v1alpha1.OrganizationObservation{Name: project, ProjectID: project, State: state}
+	}
+}
+
+func orgWithCondition(c xpv1.Condition) organizationModifier {
+	return func(o *v1alpha1.Organization) { o.SetConditions(c) }
+}
+
+func organization(m ...organizationModifier) *v1alpha1.Organization {
+	o := &v1alpha1.Organization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-org"},
+		Spec: v1alpha1.OrganizationSpec{
+			ForProvider: v1alpha1.OrganizationParameters{
+				AnalyticsRegion:   gcp.StringPtr("us-central1"),
+				RuntimeType:       gcp.StringPtr("CLOUD"),
+				AuthorizedNetwork: gcp.StringPtr("default"),
+				BillingType:       gcp.StringPtr("EVALUATION"),
+				DisplayName:       gcp.StringPtr("Cool API platform"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func organizationResponse(state, displayName string) *apigeev1.GoogleCloudApigeeV1Organization {
+	return &apigeev1.GoogleCloudApigeeV1Organization{
+		Name:              project,
+		ProjectId:         project,
+		AnalyticsRegion:   "us-central1",
+		RuntimeType:       "CLOUD",
+		AuthorizedNetwork: "default",
+		BillingType:       "EVALUATION",
+		DisplayName:       displayName,
+		State:             state,
+	}
+}
+
+func TestOrganizationObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotYetCreated": {
+			mg: organization(),
+			want: want{
+				mg: organization(),
+			},
+		},
+		"Active": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+orgName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(organizationResponse(v1alpha1.StateActive, "Cool API platform"))
+			}),
+			mg: organization(orgWithExternalName(project)),
+			want: want{
+				mg:  organization(orgWithExternalName(project), orgWithObservation(v1alpha1.StateActive), orgWithCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Creating": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(organizationResponse(v1alpha1.StateCreating, "Cool API platform"))
+			}),
+			mg: organization(orgWithExternalName(project)),
+			want: want{
+				mg:  organization(orgWithExternalName(project), orgWithObservation(v1alpha1.StateCreating), orgWithCondition(xpv1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(organizationResponse(v1alpha1.StateActive, "Old name"))
+			}),
+			mg: organization(orgWithExternalName(project)),
+			want: want{
+				mg:  organization(orgWithExternalName(project), orgWithObservation(v1alpha1.StateActive), orgWithCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: organization(orgWithExternalName(project)),
+			want: want{
+				mg: organization(orgWithExternalName(project)),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: organization(orgWithExternalName(project)),
+			want: want{
+				mg:  organization(orgWithExternalName(project)),
+				err: errors.Wrap(err500, errGetOrganization),
+			},
+		},
+		"NotOrganization": {
+			mg: &strange{},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotOrganization),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &organizationExternal{organizations: apigeev1.NewOrganizationsService(s), projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOrganizationCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("projects/"+project, r.URL.Query().Get("parent")); diff != "" {
+					t.Errorf("r: -want parent, +got parent:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleLongrunningOperation{Name: "op"})
+			}),
+			mg: organization(),
+			want: want{
+				mg:  organization(orgWithExternalName(project), orgWithCondition(xpv1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: organization(),
+			want: want{
+				mg:  organization(orgWithCondition(xpv1.Creating())),
+				err: errors.Wrap(err500, errCreateOrganization),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &organizationExternal{organizations: apigeev1.NewOrganizationsService(s), projectID: project}
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOrganizationUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				got := &apigeev1.GoogleCloudApigeeV1Organization{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff("New name", got.DisplayName); diff != "" {
+					t.Errorf("r: -want displayName, +got displayName:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(got)
+			}),
+			mg: organization(orgWithExternalName(project), orgWithDisplayName("New name")),
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   organization(orgWithExternalName(project)),
+			want: errors.Wrap(err500, errUpdateOrganization),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &organizationExternal{organizations: apigeev1.NewOrganizationsService(s), projectID: project}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOrganizationDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"SuccessfulWithRetention": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("MINIMUM", r.URL.Query().Get("retention")); diff != "" {
+					t.Errorf("r: -want retention, +got retention:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&apigeev1.GoogleLongrunningOperation{Name: "op"})
+			}),
+			mg: organization(orgWithExternalName(project), orgWithRetention("MINIMUM")),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: organization(orgWithExternalName(project)),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   organization(orgWithExternalName(project)),
+			want: errors.Wrap(err500, errDeleteOrganization),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigeev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &organizationExternal{organizations: apigeev1.NewOrganizationsService(s), projectID: project}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
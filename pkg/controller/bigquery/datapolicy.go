@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+
+	datapolicies "cloud.google.com/go/bigquery/datapolicies/apiv1"
+	"cloud.google.com/go/bigquery/datapolicies/apiv1/datapoliciespb"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/datapolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNewClient        = "cannot create new BigQuery Data Policy API client"
+	errNotDataPolicy    = "managed resource is not a BigQuery DataPolicy"
+	errGetDataPolicy    = "cannot get external BigQuery data policy"
+	errCreateDataPolicy = "cannot create external BigQuery data policy"
+	errUpdateDataPolicy = "cannot update external BigQuery data policy"
+	errDeleteDataPolicy = "cannot delete external BigQuery data policy"
+)
+
+// SetupDataPolicy adds a controller that reconciles BigQuery DataPolicies.
+func SetupDataPolicy(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.DataPolicyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DataPolicyGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &dataPolicyConnector{kube: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.DataPolicy{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type dataPolicyConnector struct {
+	kube client.Client
+}
+
+func (c *dataPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	// The REST client is used rather than the gRPC one so that no connection
+	// is left open once the reconcile is done.
+	dc, err := datapolicies.NewDataPolicyRESTClient(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &dataPolicyExternal{policies: dc, projectID: projectID}, nil
+}
+
+type dataPolicyExternal struct {
+	policies  datapolicy.Client
+	projectID string
+}
+
+func (e *dataPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DataPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDataPolicy)
+	}
+
+	name := datapolicy.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	observed, err := e.policies.GetDataPolicy(ctx, &datapoliciespb.GetDataPolicyRequest{Name: name})
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetDataPolicy)
+	}
+
+	cr.Status.AtProvider = datapolicy.GenerateObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: datapolicy.IsUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *dataPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DataPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDataPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.policies.CreateDataPolicy(ctx, &datapoliciespb.CreateDataPolicyRequest{
+		Parent:     datapolicy.GetParent(e.projectID, cr.Spec.ForProvider.Location),
+		DataPolicy: datapolicy.GenerateDataPolicy(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider),
+	})
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateDataPolicy)
+}
+
+func (e *dataPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DataPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDataPolicy)
+	}
+
+	_, err := e.policies.UpdateDataPolicy(ctx, &datapoliciespb.UpdateDataPolicyRequest{
+		DataPolicy: datapolicy.GenerateDataPolicy(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider),
+		UpdateMask: datapolicy.GenerateUpdateMask(cr.Spec.ForProvider),
+	})
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDataPolicy)
+}
+
+func (e *dataPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DataPolicy)
+	if !ok {
+		return errors.New(errNotDataPolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	name := datapolicy.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	err := e.policies.DeleteDataPolicy(ctx, &datapoliciespb.DeleteDataPolicyRequest{Name: name})
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDataPolicy)
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"cloud.google.com/go/bigquery/datapolicies/apiv1/datapoliciespb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/protobuf/testing/protocmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	project      = "someProject"
+	location     = "us"
+	metadataName = "mask_email"
+	policyTag    = "projects/" + project + "/locations/" + location + "/taxonomies/123/policyTags/456"
+)
+
+var (
+	_ managed.ExternalConnecter = &dataPolicyConnector{}
+	_ managed.ExternalClient    = &dataPolicyExternal{}
+
+	errBoom     = errors.New("boom")
+	errNotFound = &googleapi.Error{Code: http.StatusNotFound}
+	fqName      = "projects/" + project + "/locations/" + location + "/dataPolicies/" + metadataName
+)
+
+type strange struct {
+	resource.Managed
+}
+
+type mockClient struct {
+	MockCreate func(req *datapoliciespb.CreateDataPolicyRequest) (*datapoliciespb.DataPolicy, error)
+	MockGet    func(req *datapoliciespb.GetDataPolicyRequest) (*datapoliciespb.DataPolicy, error)
+	MockUpdate func(req *datapoliciespb.UpdateDataPolicyRequest) (*datapoliciespb.DataPolicy, error)
+	MockDelete func(req *datapoliciespb.DeleteDataPolicyRequest) error
+}
+
+func (m *mockClient) CreateDataPolicy(_ context.Context, req *datapoliciespb.CreateDataPolicyRequest, _ ...gax.CallOption) (*datapoliciespb.DataPolicy, error) {
+	return m.MockCreate(req)
+}
+
+func (m *mockClient) GetDataPolicy(_ context.Context, req *datapoliciespb.GetDataPolicyRequest, _ ...gax.CallOption) (*datapoliciespb.DataPolicy, error) {
+	return m.MockGet(req)
+}
+
+func (m *mockClient) UpdateDataPolicy(_ context.Context, req *datapoliciespb.UpdateDataPolicyRequest, _ ...gax.CallOption) (*datapoliciespb.DataPolicy, error) {
+	return m.MockUpdate(req)
+}
+
+func (m *mockClient) DeleteDataPolicy(_ context.Context, req *datapoliciespb.DeleteDataPolicyRequest, _ ...gax.CallOption) error {
+	return m.MockDelete(req)
+}
+
+type dataPolicyModifier func(*v1alpha1.DataPolicy)
+
+func withCondition(c xpv1.Condition) dataPolicyModifier {
+	return func(p *v1alpha1.DataPolicy) { p.SetConditions(c) }
+}
+
+func withObservation() dataPolicyModifier {
+	return func(p *v1alpha1.DataPolicy) {
+		p.Status.AtProvider = v1alpha1.DataPolicyObservation{Name: fqName}
+	}
+}
+
+func dataPolicyCR(m ...dataPolicyModifier) *v1alpha1.DataPolicy {
+	p := &v1alpha1.DataPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        metadataName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: metadataName},
+		},
+		Spec: v1alpha1.DataPolicySpec{
+			ForProvider: v1alpha1.DataPolicyParameters{
+				Location:          location,
+				DataPolicyType:    v1alpha1.DataPolicyTypeDataMasking,
+				PolicyTag:         gcp.StringPtr(policyTag),
+				DataMaskingPolicy: &v1alpha1.DataMaskingPolicy{PredefinedExpression: "SHA256"},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(p)
+	}
+	return p
+}
+
+func dataPolicyResponse(expr datapoliciespb.DataMaskingPolicy_PredefinedExpression) *datapoliciespb.DataPolicy {
+	return &datapoliciespb.DataPolicy{
+		Name:           fqName,
+		DataPolicyId:   metadataName,
+		DataPolicyType: datapoliciespb.DataPolicy_DATA_MASKING_POLICY,
+		MatchingLabel:  &datapoliciespb.DataPolicy_PolicyTag{PolicyTag: policyTag},
+		Policy: &datapoliciespb.DataPolicy_DataMaskingPolicy{
+			DataMaskingPolicy: &datapoliciespb.DataMaskingPolicy{
+				MaskingExpression: &datapoliciespb.DataMaskingPolicy_PredefinedExpression_{PredefinedExpression: expr},
+			},
+		},
+	}
+}
+
+func TestDataPolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		client *mockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotDataPolicy": {
+			mg:   &strange{},
+			want: want{mg: &strange{}, err: errors.New(errNotDataPolicy)},
+		},
+		"UpToDate": {
+			client: &mockClient{MockGet: func(req *datapoliciespb.GetDataPolicyRequest) (*datapoliciespb.DataPolicy, error) {
+				if diff := cmp.Diff(fqName, req.GetName()); diff != "" {
+					t.Errorf("req: -want name, +got name:\n%s", diff)
+				}
+				return dataPolicyResponse(datapoliciespb.DataMaskingPolicy_SHA256), nil
+			}},
+			mg: dataPolicyCR(),
+			want: want{
+				mg:  dataPolicyCR(withObservation(), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			client: &mockClient{MockGet: func(_ *datapoliciespb.GetDataPolicyRequest) (*datapoliciespb.DataPolicy, error) {
+				return dataPolicyResponse(datapoliciespb.DataMaskingPolicy_ALWAYS_NULL), nil
+			}},
+			mg: dataPolicyCR(),
+			want: want{
+				mg:  dataPolicyCR(withObservation(), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			client: &mockClient{MockGet: func(_ *datapoliciespb.GetDataPolicyRequest) (*datapoliciespb.DataPolicy, error) {
+				return nil, errNotFound
+			}},
+			mg:   dataPolicyCR(),
+			want: want{mg: dataPolicyCR()},
+		},
+		"GetFailed": {
+			client: &mockClient{MockGet: func(_ *datapoliciespb.GetDataPolicyRequest) (*datapoliciespb.DataPolicy, error) {
+				return nil, errBoom
+			}},
+			mg:   dataPolicyCR(),
+			want: want{mg: dataPolicyCR(), err: errors.Wrap(errBoom, errGetDataPolicy)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &dataPolicyExternal{policies: tc.client, projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDataPolicyCreate(t *testing.T) {
+	cases := map[string]struct {
+		client *mockClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotDataPolicy": {
+			mg:   &strange{},
+			want: errors.New(errNotDataPolicy),
+		},
+		"Successful": {
+			client: &mockClient{MockCreate: func(req *datapoliciespb.CreateDataPolicyRequest) (*datapoliciespb.DataPolicy, error) {
+				if diff := cmp.Diff("projects/"+project+"/locations/"+location, req.GetParent()); diff != "" {
+					t.Errorf("req: -want parent, +got parent:\n%s", diff)
+				}
+				want := dataPolicyResponse(datapoliciespb.DataMaskingPolicy_SHA256)
+				if diff := cmp.Diff(want, req.GetDataPolicy(), protocmp.Transform()); diff != "" {
+					t.Errorf("req: -want data policy, +got data policy:\n%s", diff)
+				}
+				return want, nil
+			}},
+			mg: dataPolicyCR(),
+		},
+		"Failed": {
+			client: &mockClient{MockCreate: func(_ *datapoliciespb.CreateDataPolicyRequest) (*datapoliciespb.DataPolicy, error) {
+				return nil, errBoom
+			}},
+			mg:   dataPolicyCR(),
+			want: errors.Wrap(errBoom, errCreateDataPolicy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &dataPolicyExternal{policies: tc.client, projectID: project}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDataPolicyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client *mockClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotDataPolicy": {
+			mg:   &strange{},
+			want: errors.New(errNotDataPolicy),
+		},
+		"Successful": {
+			client: &mockClient{MockUpdate: func(req *datapoliciespb.UpdateDataPolicyRequest) (*datapoliciespb.DataPolicy, error) {
+				if diff := cmp.Diff(fqName, req.GetDataPolicy().GetName()); diff != "" {
+					t.Errorf("req: -want name, +got name:\n%s", diff)
+				}
+				if diff := cmp.Diff([]string{"policy_tag", "data_masking_policy"}, req.GetUpdateMask().GetPaths()); diff != "" {
+					t.Errorf("req: -want update mask, +got update mask:\n%s", diff)
+				}
+				return req.GetDataPolicy(), nil
+			}},
+			mg: dataPolicyCR(),
+		},
+		"Failed": {
+			client: &mockClient{MockUpdate: func(_ *datapoliciespb.UpdateDataPolicyRequest) (*datapoliciespb.DataPolicy, error) {
+				return nil, errBoom
+			}},
+			mg:   dataPolicyCR(),
+			want: errors.Wrap(errBoom, errUpdateDataPolicy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &dataPolicyExternal{policies: tc.client, projectID: project}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDataPolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *mockClient
+		mg     resource.Managed
+		want   error
+	}{
+		"NotDataPolicy": {
+			mg:   &strange{},
+			want: errors.New(errNotDataPolicy),
+		},
+		"Successful": {
+			client: &mockClient{MockDelete: func(req *datapoliciespb.DeleteDataPolicyRequest) error {
+				if diff := cmp.Diff(fqName, req.GetName()); diff != "" {
+					t.Errorf("req: -want name, +got name:\n%s", diff)
+				}
+				return nil
+			}},
+			mg: dataPolicyCR(),
+		},
+		"AlreadyGone": {
+			client: &mockClient{MockDelete: func(_ *datapoliciespb.DeleteDataPolicyRequest) error { return errNotFound }},
+			mg:     dataPolicyCR(),
+		},
+		"Failed": {
+			client: &mockClient{MockDelete: func(_ *datapoliciespb.DeleteDataPolicyRequest) error { return errBoom }},
+			mg:     dataPolicyCR(),
+			want:   errors.Wrap(errBoom, errDeleteDataPolicy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &dataPolicyExternal{policies: tc.client, projectID: project}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
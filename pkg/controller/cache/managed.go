@@ -39,7 +39,12 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/cache/v1beta1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cloudmemorystore"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -68,7 +73,7 @@ func SetupCloudMemorystoreInstance(mgr ctrl.Manager, o controller.Options) error
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.CloudMemorystoreInstanceGroupVersionKind),
-		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &connecter{client: mgr.GetClient()}))))),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -76,7 +81,7 @@ func SetupCloudMemorystoreInstance(mgr ctrl.Manager, o controller.Options) error
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1beta1.CloudMemorystoreInstance{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -174,8 +179,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	instance := &redis.Instance{}
 	cloudmemorystore.GenerateRedisInstance(cloudmemorystore.GetFullyQualifiedName(e.projectID, i.Spec.ForProvider, meta.GetExternalName(i)), i.Spec.ForProvider, instance)
 
-	_, err := e.cms.Projects.Locations.Instances.Create(cloudmemorystore.GetFullyQualifiedParent(e.projectID, i.Spec.ForProvider), instance).InstanceId(meta.GetExternalName(i)).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+	op, err := e.cms.Projects.Locations.Instances.Create(cloudmemorystore.GetFullyQualifiedParent(e.projectID, i.Spec.ForProvider), instance).InstanceId(meta.GetExternalName(i)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -188,8 +197,12 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	fqn := cloudmemorystore.GetFullyQualifiedName(e.projectID, i.Spec.ForProvider, meta.GetExternalName(i))
 	cloudmemorystore.GenerateRedisInstance(fqn, i.Spec.ForProvider, instance)
 	updateMask := strings.Join([]string{"display_name", "labels", "memory_size_gb", "redis_configs"}, ",")
-	_, err := e.cms.Projects.Locations.Instances.Patch(fqn, instance).UpdateMask(updateMask).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
+	op, err := e.cms.Projects.Locations.Instances.Patch(fqn, instance).UpdateMask(updateMask).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -199,6 +212,10 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 	i.SetConditions(xpv1.Deleting())
 
-	_, err := e.cms.Projects.Locations.Instances.Delete(cloudmemorystore.GetFullyQualifiedName(e.projectID, i.Spec.ForProvider, meta.GetExternalName(i))).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstance)
+	op, err := e.cms.Projects.Locations.Instances.Delete(cloudmemorystore.GetFullyQualifiedName(e.projectID, i.Spec.ForProvider, meta.GetExternalName(i))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstance)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
 }
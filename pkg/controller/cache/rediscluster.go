@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	redis "google.golang.org/api/redis/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cache/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/rediscluster"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotRedisCluster    = "managed resource is not a RedisCluster"
+	errUpdateRedisCR      = "cannot update RedisCluster custom resource"
+	errGetRedisCluster    = "cannot get Redis cluster"
+	errCreateRedisCluster = "cannot create Redis cluster"
+	errUpdateRedisCluster = "cannot update Redis cluster"
+	errDeleteRedisCluster = "cannot delete Redis cluster"
+)
+
+// SetupRedisCluster adds a controller that reconciles RedisClusters.
+func SetupRedisCluster(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RedisClusterGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RedisClusterGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &redisClusterConnecter{client: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.RedisCluster{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type redisClusterConnecter struct {
+	client client.Client
+}
+
+func (c *redisClusterConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := redis.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &redisClusterExternal{kube: c.client, clusters: s.Projects.Locations.Clusters, projectID: projectID}, nil
+}
+
+type redisClusterExternal struct {
+	kube      client.Client
+	clusters  *redis.ProjectsLocationsClustersService
+	projectID string
+}
+
+func (e *redisClusterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RedisCluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRedisCluster)
+	}
+
+	existing, err := e.clusters.Get(rediscluster.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetRedisCluster)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	rediscluster.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateRedisCR)
+		}
+	}
+	cr.Status.AtProvider = rediscluster.GenerateObservation(*existing)
+	var conn managed.ConnectionDetails
+	switch cr.Status.AtProvider.State {
+	case rediscluster.StateActive:
+		cr.Status.SetConditions(xpv1.Available())
+		conn = rediscluster.GetConnectionDetails(cr.Status.AtProvider)
+	case rediscluster.StateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case rediscluster.StateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		// The cluster rejects updates while it is being created or
+		// updated, so changes are only applied once it is active.
+		ResourceUpToDate:  cr.Status.AtProvider.State != rediscluster.StateActive || rediscluster.IsUpToDate(cr.Spec.ForProvider, *existing),
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *redisClusterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RedisCluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRedisCluster)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+
+	cluster := &redis.Cluster{}
+	rediscluster.GenerateCluster(rediscluster.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), cr.Spec.ForProvider, cluster)
+	op, err := e.clusters.Create(rediscluster.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), cluster).ClusterId(meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRedisCluster)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *redisClusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RedisCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRedisCluster)
+	}
+	fqn := rediscluster.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	existing, err := e.clusters.Get(fqn).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetRedisCluster)
+	}
+	mask := rediscluster.UpdateMask(cr.Spec.ForProvider, *existing)
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	cluster := &redis.Cluster{}
+	rediscluster.GenerateCluster(fqn, cr.Spec.ForProvider, cluster)
+	op, err := e.clusters.Patch(fqn, cluster).UpdateMask(mask).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRedisCluster)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *redisClusterExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RedisCluster)
+	if !ok {
+		return errors.New(errNotRedisCluster)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	op, err := e.clusters.Delete(rediscluster.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteRedisCluster)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
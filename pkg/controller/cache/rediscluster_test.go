@@ -0,0 +1,318 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	redis "google.golang.org/api/redis/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cache/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/rediscluster"
+)
+
+const (
+	clusterName          = "cool-cluster"
+	clusterQualifiedName = "projects/" + project + "/locations/" + region + "/clusters/" + clusterName
+	clusterNetwork       = "projects/" + project + "/global/networks/default"
+)
+
+var (
+	_ managed.ExternalClient    = &redisClusterExternal{}
+	_ managed.ExternalConnecter = &redisClusterConnecter{}
+
+	errBoom = errors.New("boom")
+)
+
+type clusterModifier func(*v1alpha1.RedisCluster)
+
+func withClusterConditions(c ...xpv1.Condition) clusterModifier {
+	return func(i *v1alpha1.RedisCluster) { i.Status.SetConditions(c...) }
+}
+
+func withClusterObservation(o v1alpha1.RedisClusterObservation) clusterModifier {
+	return func(i *v1alpha1.RedisCluster) { i.Status.AtProvider = o }
+}
+
+func withShardCount(n int64) clusterModifier {
+	return func(i *v1alpha1.RedisCluster) { i.Spec.ForProvider.ShardCount = n }
+}
+
+func redisCluster(m ...clusterModifier) *v1alpha1.RedisCluster {
+	i := &v1alpha1.RedisCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: clusterName,
+			},
+		},
+		Spec: v1alpha1.RedisClusterSpec{
+			ForProvider: v1alpha1.RedisClusterParameters{
+				Region:                    region,
+				ShardCount:                3,
+				ReplicaCount:              gcp.Int64Ptr(1),
+				DeletionProtectionEnabled: gcp.BoolPtr(false),
+				PscConfigs:                []v1alpha1.PscConfig{{Network: gcp.StringPtr(clusterNetwork)}},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(i)
+	}
+	return i
+}
+
+// clusterHandler serves the supplied cluster on get and records the update
+// mask of every patch.
+func clusterHandler(t *testing.T, c *redis.Cluster, status int, masks *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if status != 0 {
+			w.WriteHeader(status)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			if diff := cmp.Diff("/v1/"+clusterQualifiedName, r.URL.Path); diff != "" {
+				t.Errorf("r: -want path, +got path:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(c)
+		case http.MethodPatch:
+			*masks = append(*masks, r.URL.Query().Get("updateMask"))
+			_ = json.NewEncoder(w).Encode(&redis.Operation{Name: "op"})
+		default:
+			_ = json.NewEncoder(w).Encode(&redis.Operation{Name: "op"})
+		}
+	})
+}
+
+func TestRedisClusterObserve(t *testing.T) {
+	endpoints := []*redis.DiscoveryEndpoint{{Address: "10.0.0.2", Port: 6379, PscConfig: &redis.PscConfig{Network: clusterNetwork}}}
+
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		cluster *redis.Cluster
+		status  int
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotRedisCluster": {
+			mg:   &strange{},
+			want: want{mg: &strange{}, err: errors.New(errNotRedisCluster)},
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+			mg:     redisCluster(),
+			want:   want{mg: redisCluster()},
+		},
+		"GetFailed": {
+			status: http.StatusBadRequest,
+			mg:     redisCluster(),
+			want:   want{mg: redisCluster(), err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetRedisCluster)},
+		},
+		"Active": {
+			cluster: &redis.Cluster{Name: clusterQualifiedName, State: rediscluster.StateActive, ShardCount: 3, ReplicaCount: 1, DiscoveryEndpoints: endpoints},
+			mg:      redisCluster(),
+			want: want{
+				mg: redisCluster(
+					withClusterConditions(xpv1.Available()),
+					withClusterObservation(v1alpha1.RedisClusterObservation{
+						Name:               clusterQualifiedName,
+						State:              rediscluster.StateActive,
+						DiscoveryEndpoints: []v1alpha1.DiscoveryEndpoint{{Address: "10.0.0.2", Port: 6379, Network: clusterNetwork}},
+					})),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.0.0.2"),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("6379"),
+					},
+				},
+			},
+		},
+		"ActiveNeedsResharding": {
+			cluster: &redis.Cluster{Name: clusterQualifiedName, State: rediscluster.StateActive, ShardCount: 3, ReplicaCount: 1},
+			mg:      redisCluster(withShardCount(6)),
+			want: want{
+				mg: redisCluster(
+					withShardCount(6),
+					withClusterConditions(xpv1.Available()),
+					withClusterObservation(v1alpha1.RedisClusterObservation{Name: clusterQualifiedName, State: rediscluster.StateActive})),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"UpdatingIsNotPatchedAgain": {
+			cluster: &redis.Cluster{Name: clusterQualifiedName, State: rediscluster.StateUpdating, ShardCount: 3, ReplicaCount: 1},
+			mg:      redisCluster(withShardCount(6)),
+			want: want{
+				mg: redisCluster(
+					withShardCount(6),
+					withClusterConditions(xpv1.Unavailable()),
+					withClusterObservation(v1alpha1.RedisClusterObservation{Name: clusterQualifiedName, State: rediscluster.StateUpdating})),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitFailed": {
+			cluster: &redis.Cluster{Name: clusterQualifiedName, State: rediscluster.StateCreating, ShardCount: 3, ReplicaCount: 1, NodeType: "REDIS_HIGHMEM_MEDIUM"},
+			kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:      redisCluster(),
+			want: want{
+				mg: redisCluster(func(i *v1alpha1.RedisCluster) {
+					i.Spec.ForProvider.NodeType = gcp.StringPtr("REDIS_HIGHMEM_MEDIUM")
+				}),
+				err: errors.Wrap(errBoom, errUpdateRedisCR),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(clusterHandler(t, tc.cluster, tc.status, nil))
+			defer server.Close()
+			s, _ := redis.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &redisClusterExternal{kube: tc.kube, clusters: s.Projects.Locations.Clusters, projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRedisClusterUpdate(t *testing.T) {
+	type want struct {
+		masks []string
+		err   error
+	}
+	cases := map[string]struct {
+		cluster *redis.Cluster
+		status  int
+		mg      resource.Managed
+		want    want
+	}{
+		"NotRedisCluster": {
+			mg:   &strange{},
+			want: want{err: errors.New(errNotRedisCluster)},
+		},
+		"GetFailed": {
+			status: http.StatusBadRequest,
+			mg:     redisCluster(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetRedisCluster)},
+		},
+		"UpToDate": {
+			cluster: &redis.Cluster{ShardCount: 3, ReplicaCount: 1},
+			mg:      redisCluster(),
+		},
+		"Reshard": {
+			cluster: &redis.Cluster{ShardCount: 3, ReplicaCount: 1},
+			mg:      redisCluster(withShardCount(6)),
+			want:    want{masks: []string{"shard_count"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var masks []string
+			server := httptest.NewServer(clusterHandler(t, tc.cluster, tc.status, &masks))
+			defer server.Close()
+			s, _ := redis.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &redisClusterExternal{clusters: s.Projects.Locations.Clusters, projectID: project}
+			_, err := e.Update(context.Background(), tc.mg)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.masks, masks); diff != "" {
+				t.Errorf("Update(...): -want update masks, +got update masks:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRedisClusterDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		mg     resource.Managed
+		want   error
+	}{
+		"NotRedisCluster": {
+			mg:   &strange{},
+			want: errors.New(errNotRedisCluster),
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+			mg:     redisCluster(),
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			mg:     redisCluster(),
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteRedisCluster),
+		},
+		"Deleted": {
+			mg: redisCluster(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(clusterHandler(t, nil, tc.status, nil))
+			defer server.Close()
+			s, _ := redis.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &redisClusterExternal{clusters: s.Projects.Locations.Clusters, projectID: project}
+			err := e.Delete(context.Background(), tc.mg)
+			if tc.want != nil && err != nil {
+				if diff := cmp.Diff(tc.want.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
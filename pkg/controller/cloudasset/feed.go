@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudasset
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudasset/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudasset/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/feed"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNewClient  = "cannot create new Cloud Asset API client"
+	errNotFeed    = "managed resource is not a Cloud Asset Feed"
+	errGetFeed    = "cannot get external Cloud Asset Feed"
+	errCreateFeed = "cannot create external Cloud Asset Feed"
+	errUpdateFeed = "cannot update external Cloud Asset Feed"
+	errDeleteFeed = "cannot delete external Cloud Asset Feed"
+)
+
+// SetupFeed adds a controller that reconciles Cloud Asset Feeds.
+func SetupFeed(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.FeedGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.FeedGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &feedConnector{kube: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.Feed{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type feedConnector struct {
+	kube client.Client
+}
+
+func (c *feedConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudasset.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &feedExternal{feeds: cloudasset.NewFeedsService(s), projectID: projectID}, nil
+}
+
+type feedExternal struct {
+	feeds     feed.Client
+	projectID string
+}
+
+func (e *feedExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Feed)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFeed)
+	}
+
+	observed, err := e.feeds.Get(feed.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetFeed)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	feed.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = feed.GenerateObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        feed.IsUpToDate(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *feedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Feed)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFeed)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	req := &cloudasset.CreateFeedRequest{
+		FeedId: meta.GetExternalName(cr),
+		Feed:   feed.GenerateFeed(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider),
+	}
+	_, err := e.feeds.Create(feed.GetFullyQualifiedParent(e.projectID), req).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFeed)
+}
+
+func (e *feedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Feed)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFeed)
+	}
+
+	req := &cloudasset.UpdateFeedRequest{
+		Feed:       feed.GenerateFeed(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider),
+		UpdateMask: feed.UpdateMask,
+	}
+	_, err := e.feeds.Patch(feed.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)), req).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFeed)
+}
+
+func (e *feedExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Feed)
+	if !ok {
+		return errors.New(errNotFeed)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.feeds.Delete(feed.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteFeed)
+}
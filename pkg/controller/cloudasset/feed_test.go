@@ -0,0 +1,354 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudasset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudasset/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudasset/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/feed"
+)
+
+const (
+	project      = "someProject"
+	metadataName = "test-feed"
+	assetType    = "compute.googleapis.com/Instance"
+	topicName    = "asset-updates"
+)
+
+var (
+	_ managed.ExternalConnecter = &feedConnector{}
+	_ managed.ExternalClient    = &feedExternal{}
+
+	err500   = &googleapi.Error{Code: 500, Body: "{}\n"}
+	fqName   = fmt.Sprintf("projects/%s/feeds/%s", project, metadataName)
+	fqTopic  = fmt.Sprintf("projects/%s/topics/%s", project, topicName)
+	numbered = "projects/123456/feeds/" + metadataName
+)
+
+type strange struct {
+	resource.Managed
+}
+
+type feedModifier func(*v1alpha1.Feed)
+
+func withCondition(c xpv1.Condition) feedModifier {
+	return func(f *v1alpha1.Feed) { f.SetConditions(c) }
+}
+
+func withObservation() feedModifier {
+	return func(f *v1alpha1.Feed) { f.Status.AtProvider = v1alpha1.FeedObservation{Name: numbered} }
+}
+
+func withContentType(ct string) feedModifier {
+	return func(f *v1alpha1.Feed) { f.Spec.ForProvider.ContentType = gcp.StringPtr(ct) }
+}
+
+func feedObj(m ...feedModifier) *v1alpha1.Feed {
+	f := &v1alpha1.Feed{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        metadataName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: metadataName},
+		},
+		Spec: v1alpha1.FeedSpec{
+			ForProvider: v1alpha1.FeedParameters{
+				AssetTypes:  []string{assetType},
+				ContentType: gcp.StringPtr("RESOURCE"),
+				FeedOutputConfig: v1alpha1.FeedOutputConfig{
+					PubsubDestination: v1alpha1.PubsubDestination{Topic: gcp.StringPtr(topicName)},
+				},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(f)
+	}
+	return f
+}
+
+func feedResponse(contentType string) *cloudasset.Feed {
+	return &cloudasset.Feed{
+		Name:        numbered,
+		AssetTypes:  []string{assetType},
+		ContentType: contentType,
+		FeedOutputConfig: &cloudasset.FeedOutputConfig{
+			PubsubDestination: &cloudasset.PubsubDestination{Topic: fqTopic},
+		},
+	}
+}
+
+func TestFeedObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotFeed": {
+			mg:   &strange{},
+			want: want{mg: &strange{}, err: errors.New(errNotFeed)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+fqName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(feedResponse("RESOURCE"))
+			}),
+			mg: feedObj(),
+			want: want{
+				mg:  feedObj(withObservation(), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(feedResponse("RESOURCE"))
+			}),
+			mg: feedObj(withContentType("IAM_POLICY")),
+			want: want{
+				mg:  feedObj(withContentType("IAM_POLICY"), withObservation(), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg:   feedObj(),
+			want: want{mg: feedObj()},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   feedObj(),
+			want: want{mg: feedObj(), err: errors.Wrap(err500, errGetFeed)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := cloudasset.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &feedExternal{feeds: cloudasset.NewFeedsService(s), projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFeedCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotFeed": {
+			mg:   &strange{},
+			want: errors.New(errNotFeed),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/projects/"+project+"/feeds", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				req := &cloudasset.CreateFeedRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(metadataName, req.FeedId); diff != "" {
+					t.Errorf("feedId: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(fqTopic, req.Feed.FeedOutputConfig.PubsubDestination.Topic); diff != "" {
+					t.Errorf("topic: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(feedResponse("RESOURCE"))
+			}),
+			mg: feedObj(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   feedObj(),
+			want: errors.Wrap(err500, errCreateFeed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := cloudasset.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &feedExternal{feeds: cloudasset.NewFeedsService(s), projectID: project}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFeedUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotFeed": {
+			mg:   &strange{},
+			want: errors.New(errNotFeed),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+fqName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				req := &cloudasset.UpdateFeedRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(feed.UpdateMask, req.UpdateMask); diff != "" {
+					t.Errorf("updateMask: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("IAM_POLICY", req.Feed.ContentType); diff != "" {
+					t.Errorf("contentType: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(req.Feed)
+			}),
+			mg: feedObj(withContentType("IAM_POLICY")),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   feedObj(),
+			want: errors.Wrap(err500, errUpdateFeed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := cloudasset.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &feedExternal{feeds: cloudasset.NewFeedsService(s), projectID: project}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFeedDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotFeed": {
+			mg:   &strange{},
+			want: errors.New(errNotFeed),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: feedObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: feedObj(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   feedObj(),
+			want: errors.Wrap(err500, errDeleteFeed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := cloudasset.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &feedExternal{feeds: cloudasset.NewFeedsService(s), projectID: project}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
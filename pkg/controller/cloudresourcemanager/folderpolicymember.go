@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudresourcemanager
+
+import (
+	"context"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	crmv2 "google.golang.org/api/cloudresourcemanager/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/hierarchypolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/projectpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicaccess"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	errNotFolderPolicyMember = "managed resource is not a GCP FolderPolicyMember"
+)
+
+// SetupFolderPolicyMember adds a controller that reconciles
+// FolderPolicyMembers.
+func SetupFolderPolicyMember(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.FolderPolicyMemberGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.FolderPolicyMemberGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, consistency.WrapConnecter(&folderPolicyMemberConnecter{client: mgr.GetClient()})))))),
+		managed.WithCreationGracePeriod(consistency.GracePeriod()),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.FolderPolicyMember{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type folderPolicyMemberConnecter struct {
+	client client.Client
+}
+
+// Connect sets up the Cloud Resource Manager clients using credentials from
+// the provider.
+func (c *folderPolicyMemberConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	v1, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	v2, err := crmv2.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &folderPolicyMemberExternal{policies: hierarchypolicy.NewClient(v1, v2)}, nil
+}
+
+type folderPolicyMemberExternal struct {
+	policies hierarchypolicy.Client
+}
+
+func (e *folderPolicyMemberExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FolderPolicyMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFolderPolicyMember)
+	}
+
+	instance, err := e.policies.GetIamPolicy(ctx, hierarchypolicy.FolderName(cr.Spec.ForProvider.Folder))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+
+	// The FolderPolicyMember exists while the role is bound to any of its
+	// declared or recorded members, so that deleting a partially bound
+	// FolderPolicyMember still unbinds the members that are bound.
+	owned := projectpolicy.OwnedMembers(cr.Spec.ForProvider.PolicyMember, cr.Status.AtProvider.BoundMembers)
+	if !projectpolicy.IsRoleBound(cr.Spec.ForProvider.PolicyMember, owned, instance) {
+		return managed.ExternalObservation{}, nil
+	}
+	if projectpolicy.BindRoleToMember(cr.Spec.ForProvider.PolicyMember, instance) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	// Members that were removed from the spec are still bound until they
+	// are unbound by an update.
+	if len(projectpolicy.StaleMembers(cr.Spec.ForProvider.PolicyMember, cr.Status.AtProvider.BoundMembers)) > 0 {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	cr.Status.AtProvider.BoundMembers = projectpolicy.Members(cr.Spec.ForProvider.PolicyMember)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *folderPolicyMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FolderPolicyMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFolderPolicyMember)
+	}
+	if err := publicaccess.Check(cr, projectpolicy.Members(cr.Spec.ForProvider.PolicyMember)...); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := modifyHierarchyPolicy(ctx, e.policies, hierarchypolicy.FolderName(cr.Spec.ForProvider.Folder), func(p *cloudresourcemanager.Policy) bool {
+		return projectpolicy.UpdateMember(cr.Spec.ForProvider.PolicyMember, cr.Status.AtProvider.BoundMembers, p)
+	}); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cr.Status.AtProvider.BoundMembers = projectpolicy.Members(cr.Spec.ForProvider.PolicyMember)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *folderPolicyMemberExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	_, err := e.Create(ctx, mg)
+	return managed.ExternalUpdate{}, err
+}
+
+func (e *folderPolicyMemberExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FolderPolicyMember)
+	if !ok {
+		return errors.New(errNotFolderPolicyMember)
+	}
+	// Members that were removed from the spec but not unbound yet are
+	// unbound too.
+	owned := projectpolicy.OwnedMembers(cr.Spec.ForProvider.PolicyMember, cr.Status.AtProvider.BoundMembers)
+	err := modifyHierarchyPolicy(ctx, e.policies, hierarchypolicy.FolderName(cr.Spec.ForProvider.Folder), func(p *cloudresourcemanager.Policy) bool {
+		return projectpolicy.UnbindRoleFromMembers(cr.Spec.ForProvider.PolicyMember, owned, p)
+	})
+	return resource.Ignore(gcp.IsErrorNotFound, err)
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudresourcemanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/hierarchypolicy"
+)
+
+const (
+	testFolder = "1234567"
+)
+
+var (
+	_ managed.ExternalConnecter = &folderPolicyMemberConnecter{}
+	_ managed.ExternalClient    = &folderPolicyMemberExternal{}
+)
+
+// mockHierarchyPolicy serves the get policy and records the resources and
+// policies it is asked to set.
+type mockHierarchyPolicy struct {
+	get *cloudresourcemanager.Policy
+	err error
+
+	resources []string
+	set       []*cloudresourcemanager.Policy
+}
+
+var _ hierarchypolicy.Client = &mockHierarchyPolicy{}
+
+func (m *mockHierarchyPolicy) GetIamPolicy(_ context.Context, resource string) (*cloudresourcemanager.Policy, error) {
+	m.resources = append(m.resources, resource)
+	if m.err != nil {
+		return nil, m.err
+	}
+	p := &cloudresourcemanager.Policy{}
+	if m.get != nil {
+		*p = *m.get
+		p.Bindings = nil
+		for _, b := range m.get.Bindings {
+			bc := *b
+			bc.Members = append([]string(nil), b.Members...)
+			p.Bindings = append(p.Bindings, &bc)
+		}
+	}
+	return p, nil
+}
+
+func (m *mockHierarchyPolicy) SetIamPolicy(_ context.Context, resource string, p *cloudresourcemanager.Policy) error {
+	m.resources = append(m.resources, resource)
+	m.set = append(m.set, p)
+	return nil
+}
+
+type fpmModifier func(*v1alpha1.FolderPolicyMember)
+
+func fpmWithCondition(c xpv1.Condition) fpmModifier {
+	return func(p *v1alpha1.FolderPolicyMember) { p.SetConditions(c) }
+}
+
+func fpmWithFolder(f string) fpmModifier {
+	return func(p *v1alpha1.FolderPolicyMember) { p.Spec.ForProvider.Folder = f }
+}
+
+func fpmWithMembers(m ...string) fpmModifier {
+	return func(p *v1alpha1.FolderPolicyMember) { p.Spec.ForProvider.Members = m }
+}
+
+func fpmWithBoundMembers(m ...string) fpmModifier {
+	return func(p *v1alpha1.FolderPolicyMember) { p.Status.AtProvider.BoundMembers = m }
+}
+
+func folderPolicyMember(m ...fpmModifier) *v1alpha1.FolderPolicyMember {
+	p := &v1alpha1.FolderPolicyMember{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-folder-policy-member"},
+		Spec: v1alpha1.FolderPolicyMemberSpec{
+			ForProvider: v1alpha1.FolderPolicyMemberParameters{
+				Folder: testFolder,
+				PolicyMember: v1alpha1.PolicyMember{
+					Role:   testRole,
+					Member: gcp.StringPtr(testMember),
+				},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(p)
+	}
+	return p
+}
+
+func TestFolderPolicyMemberObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	type want struct {
+		mg        resource.Managed
+		obs       managed.ExternalObservation
+		resources []string
+		err       error
+	}
+	cases := map[string]struct {
+		policies *mockHierarchyPolicy
+		mg       resource.Managed
+		want     want
+	}{
+		"NotFolderPolicyMember": {
+			policies: &mockHierarchyPolicy{},
+			mg:       &strange{},
+			want:     want{mg: &strange{}, err: errors.New(errNotFolderPolicyMember)},
+		},
+		"GetFailed": {
+			policies: &mockHierarchyPolicy{err: errBoom},
+			mg:       folderPolicyMember(),
+			want: want{
+				mg:        folderPolicyMember(),
+				resources: []string{"folders/" + testFolder},
+				err:       errors.Wrap(errBoom, errGetPolicy),
+			},
+		},
+		"NotBound": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg:   folderPolicyMember(),
+			want: want{mg: folderPolicyMember(), resources: []string{"folders/" + testFolder}},
+		},
+		"BoundWithPrefixedFolder": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther, testMember}},
+			}}},
+			mg: folderPolicyMember(fpmWithFolder("folders/" + testFolder)),
+			want: want{
+				mg:        folderPolicyMember(fpmWithFolder("folders/"+testFolder), fpmWithCondition(xpv1.Available()), fpmWithBoundMembers(testMember)),
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				resources: []string{"folders/" + testFolder},
+			},
+		},
+		"PartiallyBound": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			mg: folderPolicyMember(fpmWithMembers(testOther)),
+			want: want{
+				mg:        folderPolicyMember(fpmWithMembers(testOther)),
+				obs:       managed.ExternalObservation{ResourceExists: true},
+				resources: []string{"folders/" + testFolder},
+			},
+		},
+		"OnlyRemovedMemberBound": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg: folderPolicyMember(fpmWithBoundMembers(testMember, testOther)),
+			want: want{
+				mg:        folderPolicyMember(fpmWithBoundMembers(testMember, testOther)),
+				obs:       managed.ExternalObservation{ResourceExists: true},
+				resources: []string{"folders/" + testFolder},
+			},
+		},
+		"MemberRemoved": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther, testMember}},
+			}}},
+			mg: folderPolicyMember(fpmWithBoundMembers(testMember, testOther)),
+			want: want{
+				mg:        folderPolicyMember(fpmWithCondition(xpv1.Available()), fpmWithBoundMembers(testMember, testOther)),
+				obs:       managed.ExternalObservation{ResourceExists: true},
+				resources: []string{"folders/" + testFolder},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &folderPolicyMemberExternal{policies: tc.policies}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.resources, tc.policies.resources); diff != "" {
+				t.Errorf("Observe(...): -want resources, +got resources:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFolderPolicyMemberCreate(t *testing.T) {
+	type want struct {
+		set []*cloudresourcemanager.Policy
+		err error
+	}
+	cases := map[string]struct {
+		policies *mockHierarchyPolicy
+		mg       resource.Managed
+		want     want
+	}{
+		"NotFolderPolicyMember": {
+			policies: &mockHierarchyPolicy{},
+			mg:       &strange{},
+			want:     want{err: errors.New(errNotFolderPolicyMember)},
+		},
+		"AlreadyBound": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			mg: folderPolicyMember(),
+		},
+		"BindKeepingOtherMembers": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Etag: "etag", Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg: folderPolicyMember(),
+			want: want{set: []*cloudresourcemanager.Policy{{
+				Etag:     "etag",
+				Version:  iamv1alpha1.PolicyVersion,
+				Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testOther, testMember}}},
+			}}},
+		},
+		"UnbindRemovedMembers": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Etag: "etag", Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg: folderPolicyMember(fpmWithBoundMembers(testOther)),
+			want: want{set: []*cloudresourcemanager.Policy{{
+				Etag:     "etag",
+				Version:  iamv1alpha1.PolicyVersion,
+				Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+			}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &folderPolicyMemberExternal{policies: tc.policies}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.set, tc.policies.set); diff != "" {
+				t.Errorf("Create(...): -want set policies, +got set policies:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFolderPolicyMemberDelete(t *testing.T) {
+	type want struct {
+		set []*cloudresourcemanager.Policy
+		err error
+	}
+	cases := map[string]struct {
+		policies *mockHierarchyPolicy
+		mg       resource.Managed
+		want     want
+	}{
+		"NotFolderPolicyMember": {
+			policies: &mockHierarchyPolicy{},
+			mg:       &strange{},
+			want:     want{err: errors.New(errNotFolderPolicyMember)},
+		},
+		"NotBound": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg: folderPolicyMember(),
+		},
+		"UnbindKeepingOtherMembers": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther, testMember}},
+			}}},
+			mg: folderPolicyMember(),
+			want: want{set: []*cloudresourcemanager.Policy{{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}}},
+		},
+		"UnbindRemovedMembers": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther, testMember, "user:bob@example.com"}},
+			}}},
+			mg: folderPolicyMember(fpmWithBoundMembers(testMember, "user:bob@example.com")),
+			want: want{set: []*cloudresourcemanager.Policy{{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &folderPolicyMemberExternal{policies: tc.policies}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.set, tc.policies.set); diff != "" {
+				t.Errorf("Delete(...): -want set policies, +got set policies:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudresourcemanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testOrganization = "7654321"
+)
+
+var (
+	_ managed.ExternalConnecter = &organizationPolicyMemberConnecter{}
+	_ managed.ExternalClient    = &organizationPolicyMemberExternal{}
+)
+
+type opmModifier func(*v1alpha1.OrganizationPolicyMember)
+
+func opmWithCondition(c xpv1.Condition) opmModifier {
+	return func(p *v1alpha1.OrganizationPolicyMember) { p.SetConditions(c) }
+}
+
+func opmWithMembers(m ...string) opmModifier {
+	return func(p *v1alpha1.OrganizationPolicyMember) { p.Spec.ForProvider.Members = m }
+}
+
+func opmWithBoundMembers(m ...string) opmModifier {
+	return func(p *v1alpha1.OrganizationPolicyMember) { p.Status.AtProvider.BoundMembers = m }
+}
+
+func organizationPolicyMember(m ...opmModifier) *v1alpha1.OrganizationPolicyMember {
+	p := &v1alpha1.OrganizationPolicyMember{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-organization-policy-member"},
+		Spec: v1alpha1.OrganizationPolicyMemberSpec{
+			ForProvider: v1alpha1.OrganizationPolicyMemberParameters{
+				Organization: testOrganization,
+				PolicyMember: v1alpha1.PolicyMember{
+					Role:   testRole,
+					Member: gcp.StringPtr(testMember),
+				},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(p)
+	}
+	return p
+}
+
+func TestOrganizationPolicyMemberObserve(t *testing.T) {
+	type want struct {
+		mg        resource.Managed
+		obs       managed.ExternalObservation
+		resources []string
+		err       error
+	}
+	cases := map[string]struct {
+		policies *mockHierarchyPolicy
+		mg       resource.Managed
+		want     want
+	}{
+		"NotOrganizationPolicyMember": {
+			policies: &mockHierarchyPolicy{},
+			mg:       &strange{},
+			want:     want{mg: &strange{}, err: errors.New(errNotOrganizationPolicyMember)},
+		},
+		"NotBound": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{}},
+			mg:       organizationPolicyMember(),
+			want:     want{mg: organizationPolicyMember(), resources: []string{"organizations/" + testOrganization}},
+		},
+		"Bound": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			mg: organizationPolicyMember(),
+			want: want{
+				mg:        organizationPolicyMember(opmWithCondition(xpv1.Available()), opmWithBoundMembers(testMember)),
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				resources: []string{"organizations/" + testOrganization},
+			},
+		},
+		"PartiallyBound": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			mg: organizationPolicyMember(opmWithMembers(testOther)),
+			want: want{
+				mg:        organizationPolicyMember(opmWithMembers(testOther)),
+				obs:       managed.ExternalObservation{ResourceExists: true},
+				resources: []string{"organizations/" + testOrganization},
+			},
+		},
+		"OnlyRemovedMemberBound": {
+			policies: &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg: organizationPolicyMember(opmWithBoundMembers(testMember, testOther)),
+			want: want{
+				mg:        organizationPolicyMember(opmWithBoundMembers(testMember, testOther)),
+				obs:       managed.ExternalObservation{ResourceExists: true},
+				resources: []string{"organizations/" + testOrganization},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &organizationPolicyMemberExternal{policies: tc.policies}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.resources, tc.policies.resources); diff != "" {
+				t.Errorf("Observe(...): -want resources, +got resources:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOrganizationPolicyMemberCreate(t *testing.T) {
+	policies := &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Etag: "etag"}}
+	e := &organizationPolicyMemberExternal{policies: policies}
+	if _, err := e.Create(context.Background(), organizationPolicyMember()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	want := []*cloudresourcemanager.Policy{{
+		Etag:     "etag",
+		Version:  iamv1alpha1.PolicyVersion,
+		Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+	}}
+	if diff := cmp.Diff(want, policies.set); diff != "" {
+		t.Errorf("Create(...): -want set policies, +got set policies:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"organizations/" + testOrganization, "organizations/" + testOrganization}, policies.resources); diff != "" {
+		t.Errorf("Create(...): -want resources, +got resources:\n%s", diff)
+	}
+}
+
+func TestOrganizationPolicyMemberUpdate(t *testing.T) {
+	policies := &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Etag: "etag", Bindings: []*cloudresourcemanager.Binding{
+		{Role: testRole, Members: []string{testOther, testMember}},
+	}}}
+	e := &organizationPolicyMemberExternal{policies: policies}
+	mg := organizationPolicyMember(opmWithBoundMembers(testMember, testOther))
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	want := []*cloudresourcemanager.Policy{{
+		Etag:     "etag",
+		Version:  iamv1alpha1.PolicyVersion,
+		Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+	}}
+	if diff := cmp.Diff(want, policies.set); diff != "" {
+		t.Errorf("Update(...): -want set policies, +got set policies:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{testMember}, mg.Status.AtProvider.BoundMembers); diff != "" {
+		t.Errorf("Update(...): -want bound members, +got bound members:\n%s", diff)
+	}
+}
+
+func TestOrganizationPolicyMemberDelete(t *testing.T) {
+	policies := &mockHierarchyPolicy{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+		{Role: testRole, Members: []string{testOther, testMember, "user:bob@example.com"}},
+	}}}
+	e := &organizationPolicyMemberExternal{policies: policies}
+	if err := e.Delete(context.Background(), organizationPolicyMember(opmWithBoundMembers(testMember, "user:bob@example.com"))); err != nil {
+		t.Fatalf("Delete(...): unexpected error: %v", err)
+	}
+	want := []*cloudresourcemanager.Policy{{Bindings: []*cloudresourcemanager.Binding{
+		{Role: testRole, Members: []string{testOther}},
+	}}}
+	if diff := cmp.Diff(want, policies.set); diff != "" {
+		t.Errorf("Delete(...): -want set policies, +got set policies:\n%s", diff)
+	}
+}
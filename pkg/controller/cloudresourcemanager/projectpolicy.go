@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudresourcemanager
+
+import (
+	"context"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/hierarchypolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/projectpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicaccess"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	errNotProjectPolicy = "managed resource is not a GCP ProjectPolicy"
+	errNewClient        = "cannot create new Cloud Resource Manager API client"
+	errGetPolicy        = "cannot get GCP project IAM policy via Cloud Resource Manager API"
+	errSetPolicy        = "cannot set GCP project IAM policy via Cloud Resource Manager API"
+)
+
+// SetupProjectPolicy adds a controller that reconciles ProjectPolicies.
+func SetupProjectPolicy(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectPolicyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProjectPolicyGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, consistency.WrapConnecter(&projectPolicyConnecter{client: mgr.GetClient()})))))),
+		managed.WithCreationGracePeriod(consistency.GracePeriod()),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.ProjectPolicy{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type projectPolicyConnecter struct {
+	client client.Client
+}
+
+// Connect sets up the Cloud Resource Manager client using credentials from
+// the provider.
+func (c *projectPolicyConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &projectPolicyExternal{projectID: projectID, projects: s.Projects}, nil
+}
+
+type projectPolicyExternal struct {
+	projectID string
+	projects  projectpolicy.Client
+}
+
+func (e *projectPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectPolicy)
+	}
+
+	instance, err := e.projects.GetIamPolicy(projectpolicy.Project(cr.Spec.ForProvider.Project, e.projectID), projectpolicy.GetIamPolicyRequest()).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	cr.Status.AtProvider.Version = instance.Version
+
+	// A project always has an IAM policy, so the ProjectPolicy is considered
+	// to exist only while any of its bindings are in place. Otherwise a
+	// deleted ProjectPolicy would never be observed as gone.
+	if !projectpolicy.HasBindings(cr.Spec.ForProvider, instance) {
+		return managed.ExternalObservation{}, nil
+	}
+	if !projectpolicy.IsUpToDate(cr.Spec.ForProvider, instance) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *projectPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.Update(ctx, mg)
+	return managed.ExternalCreation{}, err
+}
+
+func (e *projectPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProjectPolicy)
+	}
+	if err := publicaccess.Check(cr, publicaccess.Members(cr.Spec.ForProvider.Policy)...); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// The policy of a project also carries its audit configs, so it is
+	// always read and modified rather than replaced.
+	return managed.ExternalUpdate{}, modifyPolicy(ctx, e.projects, projectpolicy.Project(cr.Spec.ForProvider.Project, e.projectID), func(p *cloudresourcemanager.Policy) bool {
+		if projectpolicy.IsUpToDate(cr.Spec.ForProvider, p) {
+			return false
+		}
+		projectpolicy.GeneratePolicy(cr.Spec.ForProvider, p)
+		return true
+	})
+}
+
+func (e *projectPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProjectPolicy)
+	if !ok {
+		return errors.New(errNotProjectPolicy)
+	}
+	// Only the bindings declared in the spec are removed; wiping every
+	// binding of a project would lock everyone out of it.
+	err := modifyPolicy(ctx, e.projects, projectpolicy.Project(cr.Spec.ForProvider.Project, e.projectID), func(p *cloudresourcemanager.Policy) bool {
+		return projectpolicy.RemoveBindings(cr.Spec.ForProvider, p)
+	})
+	return resource.Ignore(gcp.IsErrorNotFound, err)
+}
+
+// modifyPolicy reads the IAM policy of the supplied project, applies fn to it
+// and sets it if fn reports a change. The policy is set with the etag it was
+// read with, so a concurrent change makes SetIamPolicy fail; the whole
+// read-modify-write is retried in that case.
+func modifyPolicy(ctx context.Context, c projectpolicy.Client, project string, fn func(*cloudresourcemanager.Policy) bool) error {
+	return retry.OnError(projectpolicy.ConflictBackoff, projectpolicy.IsErrorConflict, func() error {
+		instance, err := c.GetIamPolicy(project, projectpolicy.GetIamPolicyRequest()).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
+		if !fn(instance) {
+			return nil
+		}
+		_, err = c.SetIamPolicy(project, &cloudresourcemanager.SetIamPolicyRequest{Policy: instance}).Context(ctx).Do()
+		return errors.Wrap(err, errSetPolicy)
+	})
+}
+
+// modifyHierarchyPolicy is the equivalent of modifyPolicy for the IAM policy
+// of a folder or an organization.
+func modifyHierarchyPolicy(ctx context.Context, c hierarchypolicy.Client, resource string, fn func(*cloudresourcemanager.Policy) bool) error {
+	return retry.OnError(projectpolicy.ConflictBackoff, projectpolicy.IsErrorConflict, func() error {
+		instance, err := c.GetIamPolicy(ctx, resource)
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
+		if !fn(instance) {
+			return nil
+		}
+		return errors.Wrap(c.SetIamPolicy(ctx, resource, instance), errSetPolicy)
+	})
+}
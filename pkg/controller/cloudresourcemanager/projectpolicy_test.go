@@ -0,0 +1,307 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudresourcemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+)
+
+const (
+	project    = "someProject"
+	testRole   = "roles/viewer"
+	testMember = "user:alice@example.com"
+	testOther  = "group:admins@example.com"
+)
+
+var (
+	_ managed.ExternalConnecter = &projectPolicyConnecter{}
+	_ managed.ExternalClient    = &projectPolicyExternal{}
+
+	err500 = &googleapi.Error{Code: 500, Body: "{}\n"}
+)
+
+type strange struct {
+	resource.Managed
+}
+
+// policyServer serves get on the supplied policy and records every policy
+// that is set.
+type policyServer struct {
+	t      *testing.T
+	get    *cloudresourcemanager.Policy
+	status int
+	set    []*cloudresourcemanager.Policy
+}
+
+func (s *policyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+		s.t.Errorf("r: -want method, +got method:\n%s", diff)
+	}
+	if !strings.HasPrefix(r.URL.Path, "/v1/projects/"+project+":") {
+		s.t.Errorf("r: unexpected path %s", r.URL.Path)
+	}
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		_ = json.NewEncoder(w).Encode(struct{}{})
+		return
+	}
+	switch {
+	case strings.HasSuffix(r.URL.Path, ":getIamPolicy"):
+		_ = json.NewEncoder(w).Encode(s.get)
+	case strings.HasSuffix(r.URL.Path, ":setIamPolicy"):
+		req := &cloudresourcemanager.SetIamPolicyRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			s.t.Error(err)
+		}
+		s.set = append(s.set, req.Policy)
+		_ = json.NewEncoder(w).Encode(req.Policy)
+	}
+}
+
+func (s *policyServer) client() *cloudresourcemanager.ProjectsService {
+	server := httptest.NewServer(s)
+	s.t.Cleanup(server.Close)
+	crm, _ := cloudresourcemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return crm.Projects
+}
+
+type ppModifier func(*v1alpha1.ProjectPolicy)
+
+func ppWithCondition(c xpv1.Condition) ppModifier {
+	return func(p *v1alpha1.ProjectPolicy) { p.SetConditions(c) }
+}
+
+func ppWithVersion(v int64) ppModifier {
+	return func(p *v1alpha1.ProjectPolicy) { p.Status.AtProvider.Version = v }
+}
+
+func projectPolicy(m ...ppModifier) *v1alpha1.ProjectPolicy {
+	p := &v1alpha1.ProjectPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-project-policy"},
+		Spec: v1alpha1.ProjectPolicySpec{
+			ForProvider: v1alpha1.ProjectPolicyParameters{
+				Policy: iamv1alpha1.Policy{Bindings: []*iamv1alpha1.Binding{
+					{Role: testRole, Members: []string{testMember}},
+				}},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(p)
+	}
+	return p
+}
+
+func TestProjectPolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		server *policyServer
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProjectPolicy": {
+			server: &policyServer{},
+			mg:     &strange{},
+			want:   want{mg: &strange{}, err: errors.New(errNotProjectPolicy)},
+		},
+		"GetFailed": {
+			server: &policyServer{status: http.StatusInternalServerError},
+			mg:     projectPolicy(),
+			want:   want{mg: projectPolicy(), err: errors.Wrap(err500, errGetPolicy)},
+		},
+		"NotBound": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Version: 1, Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/owner", Members: []string{testOther}},
+			}}},
+			mg:   projectPolicy(),
+			want: want{mg: projectPolicy(ppWithVersion(1))},
+		},
+		"NeedsUpdate": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Version: 1, Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/owner", Members: []string{testOther}},
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			mg: projectPolicy(),
+			want: want{
+				mg:  projectPolicy(ppWithVersion(1)),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"UpToDate": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Version: 3, Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			mg: projectPolicy(),
+			want: want{
+				mg:  projectPolicy(ppWithVersion(3), ppWithCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			e := &projectPolicyExternal{projects: tc.server.client(), projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectPolicyUpdate(t *testing.T) {
+	audit := []*cloudresourcemanager.AuditConfig{{Service: "allServices"}}
+	type want struct {
+		set []*cloudresourcemanager.Policy
+		err error
+	}
+	cases := map[string]struct {
+		server *policyServer
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProjectPolicy": {
+			server: &policyServer{},
+			mg:     &strange{},
+			want:   want{err: errors.New(errNotProjectPolicy)},
+		},
+		"GetFailed": {
+			server: &policyServer{status: http.StatusInternalServerError},
+			mg:     projectPolicy(),
+			want:   want{err: errors.Wrap(err500, errGetPolicy)},
+		},
+		"UpToDate": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			mg: projectPolicy(),
+		},
+		"ReplaceBindingsKeepAuditConfigs": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{AuditConfigs: audit, Etag: "etag", Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/owner", Members: []string{testOther}},
+			}}},
+			mg: projectPolicy(),
+			want: want{set: []*cloudresourcemanager.Policy{{
+				AuditConfigs: audit,
+				Etag:         "etag",
+				Version:      iamv1alpha1.PolicyVersion,
+				Bindings:     []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+			}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			e := &projectPolicyExternal{projects: tc.server.client(), projectID: project}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.set, tc.server.set); diff != "" {
+				t.Errorf("Update(...): -want set policies, +got set policies:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectPolicyDelete(t *testing.T) {
+	type want struct {
+		set []*cloudresourcemanager.Policy
+		err error
+	}
+	cases := map[string]struct {
+		server *policyServer
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProjectPolicy": {
+			server: &policyServer{},
+			mg:     &strange{},
+			want:   want{err: errors.New(errNotProjectPolicy)},
+		},
+		"ProjectNotFound": {
+			server: &policyServer{status: http.StatusNotFound},
+			mg:     projectPolicy(),
+		},
+		"GetFailed": {
+			server: &policyServer{status: http.StatusInternalServerError},
+			mg:     projectPolicy(),
+			want:   want{err: errors.Wrap(err500, errGetPolicy)},
+		},
+		"AlreadyRemoved": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/owner", Members: []string{testOther}},
+			}}},
+			mg: projectPolicy(),
+		},
+		"RemoveDeclaredMembersOnly": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/owner", Members: []string{testOther}},
+				{Role: testRole, Members: []string{testMember, testOther}},
+			}}},
+			mg: projectPolicy(),
+			want: want{set: []*cloudresourcemanager.Policy{{Bindings: []*cloudresourcemanager.Binding{
+				{Role: "roles/owner", Members: []string{testOther}},
+				{Role: testRole, Members: []string{testOther}},
+			}}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			e := &projectPolicyExternal{projects: tc.server.client(), projectID: project}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.set, tc.server.set); diff != "" {
+				t.Errorf("Delete(...): -want set policies, +got set policies:\n%s", diff)
+			}
+		})
+	}
+}
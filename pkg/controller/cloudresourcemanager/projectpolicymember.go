@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudresourcemanager
+
+import (
+	"context"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/projectpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicaccess"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	errNotProjectPolicyMember = "managed resource is not a GCP ProjectPolicyMember"
+)
+
+// SetupProjectPolicyMember adds a controller that reconciles
+// ProjectPolicyMembers.
+func SetupProjectPolicyMember(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ProjectPolicyMemberGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ProjectPolicyMemberGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, consistency.WrapConnecter(&projectPolicyMemberConnecter{client: mgr.GetClient()})))))),
+		managed.WithCreationGracePeriod(consistency.GracePeriod()),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.ProjectPolicyMember{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type projectPolicyMemberConnecter struct {
+	client client.Client
+}
+
+// Connect sets up the Cloud Resource Manager client using credentials from
+// the provider.
+func (c *projectPolicyMemberConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &projectPolicyMemberExternal{projectID: projectID, projects: s.Projects}, nil
+}
+
+type projectPolicyMemberExternal struct {
+	projectID string
+	projects  projectpolicy.Client
+}
+
+func (e *projectPolicyMemberExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectPolicyMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectPolicyMember)
+	}
+
+	instance, err := e.projects.GetIamPolicy(projectpolicy.Project(cr.Spec.ForProvider.Project, e.projectID), projectpolicy.GetIamPolicyRequest()).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+
+	// The ProjectPolicyMember exists while the role is bound to any of its
+	// declared or recorded members, so that deleting a partially bound
+	// ProjectPolicyMember still unbinds the members that are bound.
+	owned := projectpolicy.OwnedMembers(cr.Spec.ForProvider.PolicyMember, cr.Status.AtProvider.BoundMembers)
+	if !projectpolicy.IsRoleBound(cr.Spec.ForProvider.PolicyMember, owned, instance) {
+		return managed.ExternalObservation{}, nil
+	}
+	if projectpolicy.BindRoleToMember(cr.Spec.ForProvider.PolicyMember, instance) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	// Members that were removed from the spec are still bound until they
+	// are unbound by an update.
+	if len(projectpolicy.StaleMembers(cr.Spec.ForProvider.PolicyMember, cr.Status.AtProvider.BoundMembers)) > 0 {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	cr.Status.AtProvider.BoundMembers = projectpolicy.Members(cr.Spec.ForProvider.PolicyMember)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *projectPolicyMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectPolicyMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectPolicyMember)
+	}
+	if err := publicaccess.Check(cr, projectpolicy.Members(cr.Spec.ForProvider.PolicyMember)...); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if err := modifyPolicy(ctx, e.projects, projectpolicy.Project(cr.Spec.ForProvider.Project, e.projectID), func(p *cloudresourcemanager.Policy) bool {
+		return projectpolicy.UpdateMember(cr.Spec.ForProvider.PolicyMember, cr.Status.AtProvider.BoundMembers, p)
+	}); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cr.Status.AtProvider.BoundMembers = projectpolicy.Members(cr.Spec.ForProvider.PolicyMember)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *projectPolicyMemberExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	_, err := e.Create(ctx, mg)
+	return managed.ExternalUpdate{}, err
+}
+
+func (e *projectPolicyMemberExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProjectPolicyMember)
+	if !ok {
+		return errors.New(errNotProjectPolicyMember)
+	}
+	// Members that were removed from the spec but not unbound yet are
+	// unbound too.
+	owned := projectpolicy.OwnedMembers(cr.Spec.ForProvider.PolicyMember, cr.Status.AtProvider.BoundMembers)
+	err := modifyPolicy(ctx, e.projects, projectpolicy.Project(cr.Spec.ForProvider.Project, e.projectID), func(p *cloudresourcemanager.Policy) bool {
+		return projectpolicy.UnbindRoleFromMembers(cr.Spec.ForProvider.PolicyMember, owned, p)
+	})
+	return resource.Ignore(gcp.IsErrorNotFound, err)
+}
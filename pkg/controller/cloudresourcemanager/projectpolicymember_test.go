@@ -0,0 +1,285 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudresourcemanager
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/cloudresourcemanager/v1alpha1"
+	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var (
+	_ managed.ExternalConnecter = &projectPolicyMemberConnecter{}
+	_ managed.ExternalClient    = &projectPolicyMemberExternal{}
+)
+
+type ppmModifier func(*v1alpha1.ProjectPolicyMember)
+
+func ppmWithCondition(c xpv1.Condition) ppmModifier {
+	return func(p *v1alpha1.ProjectPolicyMember) { p.SetConditions(c) }
+}
+
+func ppmWithMembers(m ...string) ppmModifier {
+	return func(p *v1alpha1.ProjectPolicyMember) { p.Spec.ForProvider.Members = m }
+}
+
+func ppmWithBoundMembers(m ...string) ppmModifier {
+	return func(p *v1alpha1.ProjectPolicyMember) { p.Status.AtProvider.BoundMembers = m }
+}
+
+func projectPolicyMember(m ...ppmModifier) *v1alpha1.ProjectPolicyMember {
+	p := &v1alpha1.ProjectPolicyMember{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-project-policy-member"},
+		Spec: v1alpha1.ProjectPolicyMemberSpec{
+			ForProvider: v1alpha1.ProjectPolicyMemberParameters{
+				Project: gcp.StringPtr(project),
+				PolicyMember: v1alpha1.PolicyMember{
+					Role:   testRole,
+					Member: gcp.StringPtr(testMember),
+				},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(p)
+	}
+	return p
+}
+
+func TestProjectPolicyMemberObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		server *policyServer
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProjectPolicyMember": {
+			server: &policyServer{},
+			mg:     &strange{},
+			want:   want{mg: &strange{}, err: errors.New(errNotProjectPolicyMember)},
+		},
+		"GetFailed": {
+			server: &policyServer{status: http.StatusInternalServerError},
+			mg:     projectPolicyMember(),
+			want:   want{mg: projectPolicyMember(), err: errors.Wrap(err500, errGetPolicy)},
+		},
+		"NotBound": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg:   projectPolicyMember(),
+			want: want{mg: projectPolicyMember()},
+		},
+		"PartiallyBound": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			mg: projectPolicyMember(ppmWithMembers(testOther)),
+			want: want{
+				mg:  projectPolicyMember(ppmWithMembers(testOther)),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"OnlyRemovedMemberBound": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg: projectPolicyMember(ppmWithBoundMembers(testMember, testOther)),
+			want: want{
+				mg:  projectPolicyMember(ppmWithBoundMembers(testMember, testOther)),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"MemberRemoved": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther, testMember}},
+			}}},
+			mg: projectPolicyMember(ppmWithBoundMembers(testMember, testOther)),
+			want: want{
+				mg:  projectPolicyMember(ppmWithCondition(xpv1.Available()), ppmWithBoundMembers(testMember, testOther)),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"Bound": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther, testMember}},
+			}}},
+			mg: projectPolicyMember(),
+			want: want{
+				mg:  projectPolicyMember(ppmWithCondition(xpv1.Available()), ppmWithBoundMembers(testMember)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			e := &projectPolicyMemberExternal{projects: tc.server.client(), projectID: "defaultProject"}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectPolicyMemberCreate(t *testing.T) {
+	type want struct {
+		set []*cloudresourcemanager.Policy
+		err error
+	}
+	cases := map[string]struct {
+		server *policyServer
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProjectPolicyMember": {
+			server: &policyServer{},
+			mg:     &strange{},
+			want:   want{err: errors.New(errNotProjectPolicyMember)},
+		},
+		"GetFailed": {
+			server: &policyServer{status: http.StatusInternalServerError},
+			mg:     projectPolicyMember(),
+			want:   want{err: errors.Wrap(err500, errGetPolicy)},
+		},
+		"AlreadyBound": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testMember}},
+			}}},
+			mg: projectPolicyMember(),
+		},
+		"BindKeepingOtherMembers": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Etag: "etag", Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg: projectPolicyMember(),
+			want: want{set: []*cloudresourcemanager.Policy{{
+				Etag:     "etag",
+				Version:  iamv1alpha1.PolicyVersion,
+				Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testOther, testMember}}},
+			}}},
+		},
+		"UnbindRemovedMembers": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Etag: "etag", Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg: projectPolicyMember(ppmWithBoundMembers(testOther)),
+			want: want{set: []*cloudresourcemanager.Policy{{
+				Etag:     "etag",
+				Version:  iamv1alpha1.PolicyVersion,
+				Bindings: []*cloudresourcemanager.Binding{{Role: testRole, Members: []string{testMember}}},
+			}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			e := &projectPolicyMemberExternal{projects: tc.server.client(), projectID: "defaultProject"}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.set, tc.server.set); diff != "" {
+				t.Errorf("Create(...): -want set policies, +got set policies:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectPolicyMemberDelete(t *testing.T) {
+	type want struct {
+		set []*cloudresourcemanager.Policy
+		err error
+	}
+	cases := map[string]struct {
+		server *policyServer
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProjectPolicyMember": {
+			server: &policyServer{},
+			mg:     &strange{},
+			want:   want{err: errors.New(errNotProjectPolicyMember)},
+		},
+		"ProjectNotFound": {
+			server: &policyServer{status: http.StatusNotFound},
+			mg:     projectPolicyMember(),
+		},
+		"NotBound": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}},
+			mg: projectPolicyMember(),
+		},
+		"UnbindKeepingOtherMembers": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther, testMember}},
+			}}},
+			mg: projectPolicyMember(),
+			want: want{set: []*cloudresourcemanager.Policy{{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}}},
+		},
+		"UnbindRemovedMembers": {
+			server: &policyServer{get: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther, testMember, "user:bob@example.com"}},
+			}}},
+			mg: projectPolicyMember(ppmWithBoundMembers(testMember, "user:bob@example.com")),
+			want: want{set: []*cloudresourcemanager.Policy{{Bindings: []*cloudresourcemanager.Binding{
+				{Role: testRole, Members: []string{testOther}},
+			}}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			e := &projectPolicyMemberExternal{projects: tc.server.client(), projectID: "defaultProject"}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.set, tc.server.set); diff != "" {
+				t.Errorf("Delete(...): -want set policies, +got set policies:\n%s", diff)
+			}
+		})
+	}
+}
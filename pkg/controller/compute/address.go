@@ -38,6 +38,13 @@ import (
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/address"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -61,7 +68,7 @@ func SetupAddress(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.AddressGroupVersionKind),
-		managed.WithExternalConnecter(&addressConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &addressConnector{kube: mgr.GetClient()}))))))),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -70,7 +77,7 @@ func SetupAddress(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1beta1.Address{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -117,6 +124,7 @@ func (e *addressExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	eo.ResourceLateInitialized = !cmp.Equal(currentSpec, &cr.Spec.ForProvider)
 
 	cr.Status.AtProvider = address.GenerateAddressObservation(*observed)
+	eo.ConnectionDetails = address.GetConnectionDetails(cr.Status.AtProvider)
 
 	switch cr.Status.AtProvider.Status {
 	case v1beta1.StatusReserving:
@@ -136,8 +144,12 @@ func (e *addressExternal) Create(ctx context.Context, mg resource.Managed) (mana
 
 	addr := &compute.Address{}
 	address.GenerateAddress(meta.GetExternalName(cr), cr.Spec.ForProvider, addr)
-	_, err := e.Addresses.Insert(e.projectID, addr.Region, addr).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAddress)
+	op, err := e.Addresses.Insert(e.projectID, addr.Region, addr).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAddress)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *addressExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
@@ -151,6 +163,10 @@ func (e *addressExternal) Delete(ctx context.Context, mg resource.Managed) error
 		return errors.New(errNotAddress)
 	}
 
-	_, err := e.Addresses.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAddress)
+	op, err := e.Addresses.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAddress)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
 }
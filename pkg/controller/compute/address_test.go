@@ -42,7 +42,8 @@ import (
 )
 
 const (
-	testName = "test-name"
+	testName      = "test-name"
+	testAddressIP = "10.0.0.7"
 )
 
 var _ managed.ExternalConnecter = &addressConnector{}
@@ -58,6 +59,13 @@ func addressWithStatus(status string) addressModifier {
 	return func(i *v1beta1.Address) { i.Status.AtProvider.Status = status }
 }
 
+func addressWithAddress(ip string) addressModifier {
+	return func(i *v1beta1.Address) {
+		i.Spec.ForProvider.Address = &ip
+		i.Status.AtProvider.Address = ip
+	}
+}
+
 func addressObj(im ...addressModifier) *v1beta1.Address {
 	i := &v1beta1.Address{
 		ObjectMeta: metav1.ObjectMeta{
@@ -165,8 +173,9 @@ func TestAddressObserve(t *testing.T) {
 			},
 			want: want{
 				obs: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 				mg: addressObj(
 					addressWithConditions(xpv1.Creating()),
@@ -184,6 +193,7 @@ func TestAddressObserve(t *testing.T) {
 				c := &compute.Address{}
 				address.GenerateAddress(testName, addressObj().Spec.ForProvider, c)
 				c.Status = v1beta1.StatusReserved
+				c.Address = testAddressIP
 				if err := json.NewEncoder(w).Encode(c); err != nil {
 					t.Error(err)
 				}
@@ -196,12 +206,17 @@ func TestAddressObserve(t *testing.T) {
 			},
 			want: want{
 				obs: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1beta1.AddressKey: []byte(testAddressIP),
+					},
 				},
 				mg: addressObj(
 					addressWithConditions(xpv1.Available()),
 					addressWithStatus(v1beta1.StatusReserved),
+					addressWithAddress(testAddressIP),
 				),
 			},
 		},
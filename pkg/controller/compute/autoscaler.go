@@ -0,0 +1,237 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/autoscaler"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotAutoscaler      = "managed resource is not an Autoscaler"
+	errAutoscalerLocation = "exactly one of zone or region must be set"
+	errGetAutoscaler      = "cannot get external Autoscaler resource"
+	errCreateAutoscaler   = "cannot create external Autoscaler resource"
+	errUpdateAutoscaler   = "cannot update external Autoscaler resource"
+	errDeleteAutoscaler   = "cannot delete external Autoscaler resource"
+	errCheckAutoscaler    = "cannot determine if external Autoscaler resource is up to date"
+	errGenerateAutoscaler = "cannot generate Autoscaler from its autoscaling policy"
+)
+
+// SetupAutoscaler adds a controller that reconciles Autoscaler managed
+// resources.
+func SetupAutoscaler(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AutoscalerGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AutoscalerGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &autoscalerConnector{kube: mgr.GetClient()}))))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.Autoscaler{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type autoscalerConnector struct {
+	kube client.Client
+}
+
+func (c *autoscalerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &autoscalerExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type autoscalerExternal struct {
+	kube      client.Client
+	projectID string
+	*compute.Service
+}
+
+func (e *autoscalerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAutoscaler)
+	}
+
+	var (
+		observed *compute.Autoscaler
+		err      error
+	)
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		observed, err = e.Autoscalers.Get(e.projectID, *p.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		observed, err = e.RegionAutoscalers.Get(e.projectID, *p.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	default:
+		return managed.ExternalObservation{}, errors.New(errAutoscalerLocation)
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAutoscaler)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	autoscaler.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = autoscaler.GenerateAutoscalerObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	u, err := autoscaler.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckAutoscaler)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        u,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *autoscalerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAutoscaler)
+	}
+
+	as := &compute.Autoscaler{}
+	if err := autoscaler.GenerateAutoscaler(meta.GetExternalName(cr), cr.Spec.ForProvider, as); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGenerateAutoscaler)
+	}
+
+	var (
+		op  *compute.Operation
+		err error
+	)
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		op, err = e.Autoscalers.Insert(e.projectID, *p.Zone, as).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		op, err = e.RegionAutoscalers.Insert(e.projectID, *p.Region, as).Context(ctx).Do()
+	default:
+		return managed.ExternalCreation{}, errors.New(errAutoscalerLocation)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAutoscaler)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *autoscalerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAutoscaler)
+	}
+
+	as := &compute.Autoscaler{}
+	if err := autoscaler.GenerateAutoscaler(meta.GetExternalName(cr), cr.Spec.ForProvider, as); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateAutoscaler)
+	}
+
+	// Update replaces the whole autoscaling policy, so that signals removed
+	// from the spec are removed from the autoscaler too.
+	var (
+		op  *compute.Operation
+		err error
+	)
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		op, err = e.Autoscalers.Update(e.projectID, *p.Zone, as).Autoscaler(meta.GetExternalName(cr)).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		op, err = e.RegionAutoscalers.Update(e.projectID, *p.Region, as).Autoscaler(meta.GetExternalName(cr)).Context(ctx).Do()
+	default:
+		return managed.ExternalUpdate{}, errors.New(errAutoscalerLocation)
+	}
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAutoscaler)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *autoscalerExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return errors.New(errNotAutoscaler)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	var (
+		op  *compute.Operation
+		err error
+	)
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		op, err = e.Autoscalers.Delete(e.projectID, *p.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		op, err = e.RegionAutoscalers.Delete(e.projectID, *p.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	default:
+		return errors.New(errAutoscalerLocation)
+	}
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAutoscaler)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
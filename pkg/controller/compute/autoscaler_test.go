@@ -0,0 +1,357 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &autoscalerConnector{}
+var _ managed.ExternalClient = &autoscalerExternal{}
+
+const (
+	testAutoscalerName   = "test-autoscaler"
+	testAutoscalerTarget = "projects/myproject-id-1234/zones/us-central1-a/instanceGroupManagers/test-mig"
+)
+
+type autoscalerModifier func(*v1alpha1.Autoscaler)
+
+func autoscalerWithConditions(c ...xpv1.Condition) autoscalerModifier {
+	return func(i *v1alpha1.Autoscaler) { i.Status.SetConditions(c...) }
+}
+
+func autoscalerWithObservation(o v1alpha1.AutoscalerObservation) autoscalerModifier {
+	return func(i *v1alpha1.Autoscaler) { i.Status.AtProvider = o }
+}
+
+func autoscalerObj(im ...autoscalerModifier) *v1alpha1.Autoscaler {
+	i := &v1alpha1.Autoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testAutoscalerName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testAutoscalerName,
+			},
+		},
+		Spec: v1alpha1.AutoscalerSpec{
+			ForProvider: v1alpha1.AutoscalerParameters{
+				Zone:   gcp.StringPtr("us-central1-a"),
+				Target: gcp.StringPtr(testAutoscalerTarget),
+				AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+					MinNumReplicas:    gcp.Int64Ptr(1),
+					MaxNumReplicas:    5,
+					CoolDownPeriodSec: gcp.Int64Ptr(60),
+					Mode:              gcp.StringPtr("ON"),
+					CPUUtilization:    &v1alpha1.AutoscalingPolicyCPUUtilization{UtilizationTarget: "0.6"},
+				},
+			},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func autoscalerResponse(maxReplicas int64) *compute.Autoscaler {
+	return &compute.Autoscaler{
+		Name:     testAutoscalerName,
+		Zone:     "us-central1-a",
+		Target:   v1beta1.ComputeURIPrefix + testAutoscalerTarget,
+		SelfLink: v1beta1.ComputeURIPrefix + "projects/myproject-id-1234/zones/us-central1-a/autoscalers/" + testAutoscalerName,
+		Status:   "ACTIVE",
+		AutoscalingPolicy: &compute.AutoscalingPolicy{
+			MinNumReplicas:    1,
+			MaxNumReplicas:    maxReplicas,
+			CoolDownPeriodSec: 60,
+			Mode:              "ON",
+			CpuUtilization:    &compute.AutoscalingPolicyCpuUtilization{UtilizationTarget: 0.6},
+		},
+	}
+}
+
+func TestAutoscalerObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	observation := v1alpha1.AutoscalerObservation{
+		SelfLink: v1beta1.ComputeURIPrefix + "projects/myproject-id-1234/zones/us-central1-a/autoscalers/" + testAutoscalerName,
+		Status:   "ACTIVE",
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotAutoscaler": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotAutoscaler),
+			},
+		},
+		"NoLocation": {
+			mg: autoscalerObj(func(i *v1alpha1.Autoscaler) { i.Spec.ForProvider.Zone = nil }),
+			want: want{
+				mg:  autoscalerObj(func(i *v1alpha1.Autoscaler) { i.Spec.ForProvider.Zone = nil }),
+				err: errors.New(errAutoscalerLocation),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Autoscaler{})
+			}),
+			mg: autoscalerObj(),
+			want: want{
+				mg: autoscalerObj(),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/zones/us-central1-a/autoscalers/"+testAutoscalerName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(autoscalerResponse(5))
+			}),
+			mg: autoscalerObj(),
+			want: want{
+				mg:  autoscalerObj(autoscalerWithObservation(observation), autoscalerWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(autoscalerResponse(3))
+			}),
+			mg: autoscalerObj(),
+			want: want{
+				mg:  autoscalerObj(autoscalerWithObservation(observation), autoscalerWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalerCreate(t *testing.T) {
+	_, errParse := strconv.ParseFloat("sixty", 64)
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Zonal": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/projects/"+projectID+"/zones/us-central1-a/autoscalers", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				got := &compute.Autoscaler{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(0.6, got.AutoscalingPolicy.CpuUtilization.UtilizationTarget); diff != "" {
+					t.Errorf("utilizationTarget: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: autoscalerObj(),
+		},
+		"Regional": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/regions/us-central1/autoscalers", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: autoscalerObj(func(i *v1alpha1.Autoscaler) {
+				i.Spec.ForProvider.Zone = nil
+				i.Spec.ForProvider.Region = gcp.StringPtr("us-central1")
+			}),
+		},
+		"InvalidUtilization": {
+			mg: autoscalerObj(func(i *v1alpha1.Autoscaler) {
+				i.Spec.ForProvider.AutoscalingPolicy.CPUUtilization.UtilizationTarget = "sixty"
+			}),
+			err: errors.Wrap(errors.Wrap(errParse, "cannot parse utilization target"), errGenerateAutoscaler),
+		},
+		"NoLocation": {
+			mg:  autoscalerObj(func(i *v1alpha1.Autoscaler) { i.Spec.ForProvider.Zone = nil }),
+			err: errors.New(errAutoscalerLocation),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalerUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(testAutoscalerName, r.URL.Query().Get("autoscaler")); diff != "" {
+					t.Errorf("r: -want autoscaler, +got autoscaler:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: autoscalerObj(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  autoscalerObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAutoscaler),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalerDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: autoscalerObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: autoscalerObj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/backendservice"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotBackendService           = "managed resource is not a BackendService"
+	errGetBackendService           = "cannot get external BackendService resource"
+	errCreateBackendService        = "cannot create external BackendService resource"
+	errUpdateBackendService        = "cannot update external BackendService resource"
+	errDeleteBackendService        = "cannot delete external BackendService resource"
+	errCheckBackendServiceUpToDate = "cannot determine if external BackendService resource is up to date"
+)
+
+// SetupBackendService adds a controller that reconciles BackendService managed
+// resources.
+func SetupBackendService(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BackendServiceGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BackendServiceGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &backendServiceConnector{kube: mgr.GetClient()})))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.BackendService{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type backendServiceConnector struct {
+	kube client.Client
+}
+
+func (c *backendServiceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &backendServiceExternal{Service: s, projectID: projectID}, nil
+}
+
+type backendServiceExternal struct {
+	*compute.Service
+	projectID string
+}
+
+func (e *backendServiceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BackendService)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBackendService)
+	}
+	observed, err := e.BackendServices.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBackendService)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	backendservice.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = backendservice.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	u, err := backendservice.IsUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckBackendServiceUpToDate)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        u,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *backendServiceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BackendService)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBackendService)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	bs := &compute.BackendService{}
+	if err := backendservice.GenerateBackendService(meta.GetExternalName(cr), cr.Spec.ForProvider, bs); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBackendService)
+	}
+	op, err := e.BackendServices.Insert(e.projectID, bs).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBackendService)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update replaces the backend service. The fingerprint of the observed
+// service is kept, so that concurrent changes are rejected.
+func (e *backendServiceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BackendService)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBackendService)
+	}
+	name := meta.GetExternalName(cr)
+	observed, err := e.BackendServices.Get(e.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetBackendService)
+	}
+	c, err := copystructure.Copy(observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBackendService)
+	}
+	bs := c.(*compute.BackendService)
+	if err := backendservice.GenerateBackendService(name, cr.Spec.ForProvider, bs); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBackendService)
+	}
+
+	op, err := e.BackendServices.Update(e.projectID, name, bs).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBackendService)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *backendServiceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BackendService)
+	if !ok {
+		return errors.New(errNotBackendService)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	op, err := e.BackendServices.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteBackendService)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
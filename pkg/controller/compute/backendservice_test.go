@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &backendServiceConnector{}
+var _ managed.ExternalClient = &backendServiceExternal{}
+
+const testBackendServiceName = "test-backend-service"
+
+func backendServiceObj(timeout int64) *v1alpha1.BackendService {
+	return &v1alpha1.BackendService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testBackendServiceName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testBackendServiceName,
+			},
+		},
+		Spec: v1alpha1.BackendServiceSpec{
+			ForProvider: v1alpha1.BackendServiceParameters{
+				LoadBalancingScheme: gcp.StringPtr("EXTERNAL_MANAGED"),
+				Protocol:            gcp.StringPtr("HTTP"),
+				TimeoutSec:          gcp.Int64Ptr(timeout),
+				HealthChecks:        []string{"projects/" + projectID + "/global/healthChecks/hc"},
+				Backends: []v1alpha1.Backend{{
+					Group:          gcp.StringPtr("projects/" + projectID + "/zones/us-central1-a/instanceGroups/ig"),
+					BalancingMode:  gcp.StringPtr("UTILIZATION"),
+					CapacityScaler: gcp.StringPtr("1"),
+				}},
+			},
+		},
+	}
+}
+
+// backendServiceGCE returns the backend service that backendServiceObj
+// describes with a timeout of 30 seconds, as returned by the Compute API.
+func backendServiceGCE() *compute.BackendService {
+	return &compute.BackendService{
+		Id:                  1,
+		Name:                testBackendServiceName,
+		Fingerprint:         "fp",
+		LoadBalancingScheme: "EXTERNAL_MANAGED",
+		Protocol:            "HTTP",
+		TimeoutSec:          30,
+		HealthChecks:        []string{"https://www.googleapis.com/compute/v1/projects/" + projectID + "/global/healthChecks/hc"},
+		Backends: []*compute.Backend{{
+			Group:          "https://www.googleapis.com/compute/v1/projects/" + projectID + "/zones/us-central1-a/instanceGroups/ig",
+			BalancingMode:  "UTILIZATION",
+			CapacityScaler: 1,
+		}},
+	}
+}
+
+func TestBackendServiceObserve(t *testing.T) {
+	cases := map[string]struct {
+		mg   *v1alpha1.BackendService
+		want managed.ExternalObservation
+	}{
+		"UpToDate": {
+			mg:   backendServiceObj(30),
+			want: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"TimeoutChanged": {
+			mg:   backendServiceObj(60),
+			want: managed.ExternalObservation{ResourceExists: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/global/backendServices/"+testBackendServiceName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(backendServiceGCE())
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := backendServiceExternal{Service: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Available(), tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackendServiceUpdate(t *testing.T) {
+	var rq *compute.BackendService
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(backendServiceGCE())
+			return
+		}
+		if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		rq = &compute.BackendService{}
+		_ = json.NewDecoder(r.Body).Decode(rq)
+		_ = json.NewEncoder(w).Encode(&compute.Operation{})
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := backendServiceExternal{Service: s, projectID: projectID}
+
+	if _, err := e.Update(context.Background(), backendServiceObj(60)); err != nil {
+		t.Fatalf("Update(...): unexpected error: %s", err)
+	}
+	// The fingerprint of the observed backend service is sent back.
+	want := backendServiceGCE()
+	want.TimeoutSec = 60
+	want.HealthChecks = []string{"projects/" + projectID + "/global/healthChecks/hc"}
+	want.Backends[0].Group = "projects/" + projectID + "/zones/us-central1-a/instanceGroups/ig"
+	if diff := cmp.Diff(want, rq); diff != "" {
+		t.Errorf("Update(...): -want request, +got request:\n%s", diff)
+	}
+}
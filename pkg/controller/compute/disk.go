@@ -0,0 +1,283 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/disk"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotDisk       = "managed resource is not a Disk"
+	errGetDisk       = "cannot get external Disk resource"
+	errCreateDisk    = "cannot create external Disk resource"
+	errDeleteDisk    = "cannot delete external Disk resource"
+	errSetDiskLabels = "cannot set labels of external Disk resource"
+	errResizeDisk    = "cannot resize external Disk resource"
+	errAttachPolicy  = "cannot attach resource policies to external Disk resource"
+	errDetachPolicy  = "cannot detach resource policies from external Disk resource"
+	errDiskLocation  = "exactly one of zone or region must be set"
+)
+
+// SetupDisk adds a controller that reconciles Disk managed resources.
+func SetupDisk(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.DiskGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DiskGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &diskConnector{kube: mgr.GetClient()}))))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.Disk{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type diskConnector struct {
+	kube client.Client
+}
+
+func (c *diskConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &diskExternal{Service: s, projectID: projectID}, nil
+}
+
+type diskExternal struct {
+	*compute.Service
+	projectID string
+}
+
+func (e *diskExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Disk)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDisk)
+	}
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDisk)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	disk.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = disk.GenerateObservation(*observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.DiskStatusCreating, v1alpha1.DiskStatusRestoring:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.DiskStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.DiskStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        disk.IsUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *diskExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Disk)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDisk)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	d := disk.GenerateDisk(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	var (
+		op  *compute.Operation
+		err error
+	)
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		op, err = e.Disks.Insert(e.projectID, *p.Zone, d).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		op, err = e.RegionDisks.Insert(e.projectID, *p.Region, d).Context(ctx).Do()
+	default:
+		return managed.ExternalCreation{}, errors.New(errDiskLocation)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateDisk)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update sets the labels of the disk, attaches and detaches resource policies,
+// and grows the disk if its desired size is larger than its current size.
+// Disks can be resized while they are attached to running instances; the file
+// system of the instance must be grown separately.
+func (e *diskExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Disk)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDisk)
+	}
+	p := cr.Spec.ForProvider
+	name := meta.GetExternalName(cr)
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetDisk)
+	}
+
+	if !disk.LabelsUpToDate(p, *observed) {
+		var op *compute.Operation
+		if p.Zone != nil {
+			rq := &compute.ZoneSetLabelsRequest{Labels: p.Labels, LabelFingerprint: observed.LabelFingerprint}
+			op, err = e.Disks.SetLabels(e.projectID, *p.Zone, name, rq).Context(ctx).Do()
+		} else {
+			rq := &compute.RegionSetLabelsRequest{Labels: p.Labels, LabelFingerprint: observed.LabelFingerprint}
+			op, err = e.RegionDisks.SetLabels(e.projectID, gcp.StringValue(p.Region), name, rq).Context(ctx).Do()
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetDiskLabels)
+		}
+		audit.RecordOperation(ctx, op.Name)
+	}
+
+	if add := disk.ResourcePoliciesToAttach(p, *observed); len(add) > 0 {
+		var op *compute.Operation
+		if p.Zone != nil {
+			rq := &compute.DisksAddResourcePoliciesRequest{ResourcePolicies: add}
+			op, err = e.Disks.AddResourcePolicies(e.projectID, *p.Zone, name, rq).Context(ctx).Do()
+		} else {
+			rq := &compute.RegionDisksAddResourcePoliciesRequest{ResourcePolicies: add}
+			op, err = e.RegionDisks.AddResourcePolicies(e.projectID, gcp.StringValue(p.Region), name, rq).Context(ctx).Do()
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttachPolicy)
+		}
+		audit.RecordOperation(ctx, op.Name)
+	}
+
+	if remove := disk.ResourcePoliciesToDetach(p, *observed); len(remove) > 0 {
+		var op *compute.Operation
+		if p.Zone != nil {
+			rq := &compute.DisksRemoveResourcePoliciesRequest{ResourcePolicies: remove}
+			op, err = e.Disks.RemoveResourcePolicies(e.projectID, *p.Zone, name, rq).Context(ctx).Do()
+		} else {
+			rq := &compute.RegionDisksRemoveResourcePoliciesRequest{ResourcePolicies: remove}
+			op, err = e.RegionDisks.RemoveResourcePolicies(e.projectID, gcp.StringValue(p.Region), name, rq).Context(ctx).Do()
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDetachPolicy)
+		}
+		audit.RecordOperation(ctx, op.Name)
+	}
+
+	if !disk.SizeUpToDate(p, *observed) {
+		var op *compute.Operation
+		if p.Zone != nil {
+			rq := &compute.DisksResizeRequest{SizeGb: gcp.Int64Value(p.SizeGB)}
+			op, err = e.Disks.Resize(e.projectID, *p.Zone, name, rq).Context(ctx).Do()
+		} else {
+			rq := &compute.RegionDisksResizeRequest{SizeGb: gcp.Int64Value(p.SizeGB)}
+			op, err = e.RegionDisks.Resize(e.projectID, gcp.StringValue(p.Region), name, rq).Context(ctx).Do()
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errResizeDisk)
+		}
+		audit.RecordOperation(ctx, op.Name)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *diskExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Disk)
+	if !ok {
+		return errors.New(errNotDisk)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	var (
+		op  *compute.Operation
+		err error
+	)
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		op, err = e.Disks.Delete(e.projectID, *p.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		op, err = e.RegionDisks.Delete(e.projectID, *p.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	default:
+		return errors.New(errDiskLocation)
+	}
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDisk)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+func (e *diskExternal) get(ctx context.Context, cr *v1alpha1.Disk) (*compute.Disk, error) {
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		return e.Disks.Get(e.projectID, *p.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		return e.RegionDisks.Get(e.projectID, *p.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	default:
+		return nil, errors.New(errDiskLocation)
+	}
+}
@@ -0,0 +1,432 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &diskConnector{}
+var _ managed.ExternalClient = &diskExternal{}
+
+const (
+	testDiskName   = "test-disk"
+	testDiskZone   = "us-central1-a"
+	testDiskRegion = "us-central1"
+)
+
+type diskModifier func(*v1alpha1.Disk)
+
+func diskWithConditions(c ...xpv1.Condition) diskModifier {
+	return func(d *v1alpha1.Disk) { d.Status.SetConditions(c...) }
+}
+
+func diskWithObservation(o v1alpha1.DiskObservation) diskModifier {
+	return func(d *v1alpha1.Disk) { d.Status.AtProvider = o }
+}
+
+func diskWithLabels(l map[string]string) diskModifier {
+	return func(d *v1alpha1.Disk) { d.Spec.ForProvider.Labels = l }
+}
+
+func diskWithSize(s int64) diskModifier {
+	return func(d *v1alpha1.Disk) { d.Spec.ForProvider.SizeGB = gcp.Int64Ptr(s) }
+}
+
+func diskRegional() diskModifier {
+	return func(d *v1alpha1.Disk) {
+		d.Spec.ForProvider.Zone = nil
+		d.Spec.ForProvider.Region = gcp.StringPtr(testDiskRegion)
+		d.Spec.ForProvider.ReplicaZones = []string{"us-central1-a", "us-central1-b"}
+	}
+}
+
+func diskObj(dm ...diskModifier) *v1alpha1.Disk {
+	d := &v1alpha1.Disk{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testDiskName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testDiskName,
+			},
+		},
+		Spec: v1alpha1.DiskSpec{
+			ForProvider: v1alpha1.DiskParameters{
+				Zone:   gcp.StringPtr(testDiskZone),
+				Type:   gcp.StringPtr("pd-balanced"),
+				SizeGB: gcp.Int64Ptr(10),
+			},
+		},
+	}
+	for _, m := range dm {
+		m(d)
+	}
+	return d
+}
+
+// diskGCE returns the disk that diskObj describes, as returned by the Compute
+// API.
+func diskGCE(status string) *compute.Disk {
+	return &compute.Disk{
+		Id:               1,
+		Name:             testDiskName,
+		Status:           status,
+		Type:             "https://www.googleapis.com/compute/v1/projects/" + projectID + "/zones/" + testDiskZone + "/diskTypes/pd-balanced",
+		SizeGb:           10,
+		LabelFingerprint: "lfp",
+	}
+}
+
+func TestDiskObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	ready := v1alpha1.DiskObservation{ID: 1, Status: v1alpha1.DiskStatusReady, SizeGB: 10}
+
+	cases := map[string]struct {
+		observed *compute.Disk
+		status   int
+		mg       resource.Managed
+		want     want
+	}{
+		"NotDisk": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotDisk),
+			},
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+			mg:     diskObj(),
+			want:   want{mg: diskObj()},
+		},
+		"GetFailed": {
+			status: http.StatusBadRequest,
+			mg:     diskObj(),
+			want: want{
+				mg:  diskObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetDisk),
+			},
+		},
+		"Ready": {
+			status:   http.StatusOK,
+			observed: diskGCE(v1alpha1.DiskStatusReady),
+			mg:       diskObj(),
+			want: want{
+				mg: diskObj(
+					diskWithConditions(xpv1.Available()),
+					diskWithObservation(ready),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Creating": {
+			status:   http.StatusOK,
+			observed: diskGCE(v1alpha1.DiskStatusCreating),
+			mg:       diskObj(),
+			want: want{
+				mg: diskObj(
+					diskWithConditions(xpv1.Creating()),
+					diskWithObservation(v1alpha1.DiskObservation{ID: 1, Status: v1alpha1.DiskStatusCreating, SizeGB: 10}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SizeLateInitialized": {
+			status:   http.StatusOK,
+			observed: diskGCE(v1alpha1.DiskStatusReady),
+			mg:       diskObj(func(d *v1alpha1.Disk) { d.Spec.ForProvider.SizeGB = nil }),
+			want: want{
+				mg: diskObj(
+					diskWithConditions(xpv1.Available()),
+					diskWithObservation(ready),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"Grown": {
+			status:   http.StatusOK,
+			observed: diskGCE(v1alpha1.DiskStatusReady),
+			mg:       diskObj(diskWithSize(20)),
+			want: want{
+				mg: diskObj(
+					diskWithSize(20),
+					diskWithConditions(xpv1.Available()),
+					diskWithObservation(ready),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/zones/"+testDiskZone+"/disks/"+testDiskName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.observed == nil {
+					_ = json.NewEncoder(w).Encode(&compute.Disk{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.observed)
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := diskExternal{Service: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiskCreate(t *testing.T) {
+	cases := map[string]struct {
+		mg       *v1alpha1.Disk
+		wantPath string
+		wantType string
+		err      error
+	}{
+		"Zonal": {
+			mg:       diskObj(),
+			wantPath: "/projects/" + projectID + "/zones/" + testDiskZone + "/disks",
+			wantType: "zones/" + testDiskZone + "/diskTypes/pd-balanced",
+		},
+		"Regional": {
+			mg:       diskObj(diskRegional()),
+			wantPath: "/projects/" + projectID + "/regions/" + testDiskRegion + "/disks",
+			wantType: "regions/" + testDiskRegion + "/diskTypes/pd-balanced",
+		},
+		"NoLocation": {
+			mg:  diskObj(func(d *v1alpha1.Disk) { d.Spec.ForProvider.Zone = nil }),
+			err: errors.New(errDiskLocation),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created *compute.Disk
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				if r.Method != http.MethodPost || r.URL.Path != tc.wantPath {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				created = &compute.Disk{}
+				_ = json.NewDecoder(r.Body).Decode(created)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := diskExternal{Service: s, projectID: projectID}
+
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if created == nil {
+				t.Fatal("Create(...): disk was not inserted")
+			}
+			if diff := cmp.Diff(tc.wantType, created.Type); diff != "" {
+				t.Errorf("Create(...): -want type, +got type:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiskUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	policy := func(name string) string {
+		return "projects/" + projectID + "/regions/" + testDiskRegion + "/resourcePolicies/" + name
+	}
+
+	cases := map[string]struct {
+		policies []string
+		mg       resource.Managed
+		want     want
+	}{
+		"NotDisk": {
+			mg:   &v1beta1.Subnetwork{},
+			want: want{err: errors.New(errNotDisk)},
+		},
+		"UpToDate": {
+			mg: diskObj(),
+		},
+		"Labels": {
+			mg:   diskObj(diskWithLabels(map[string]string{"l": "v"})),
+			want: want{calls: []string{"zones setLabels lfp"}},
+		},
+		"Grow": {
+			mg:   diskObj(diskWithSize(20)),
+			want: want{calls: []string{"zones resize 20"}},
+		},
+		"GrowRegional": {
+			mg:   diskObj(diskRegional(), diskWithSize(20)),
+			want: want{calls: []string{"regions resize 20"}},
+		},
+		"Shrink": {
+			mg: diskObj(diskWithSize(5)),
+		},
+		"AttachPolicy": {
+			mg: diskObj(func(d *v1alpha1.Disk) {
+				d.Spec.ForProvider.ResourcePolicies = []string{policy("daily")}
+			}),
+			want: want{calls: []string{"zones addResourcePolicies daily"}},
+		},
+		"ReplacePolicyRegional": {
+			policies: []string{v1beta1.ComputeURIPrefix + policy("daily")},
+			mg: diskObj(diskRegional(), func(d *v1alpha1.Disk) {
+				d.Spec.ForProvider.ResourcePolicies = []string{policy("weekly")}
+			}),
+			want: want{calls: []string{"regions addResourcePolicies weekly", "regions removeResourcePolicies daily"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				scope := strings.Split(strings.TrimPrefix(r.URL.Path, "/projects/"+projectID+"/"), "/")[0]
+				switch {
+				case r.Method == http.MethodGet:
+					observed := diskGCE(v1alpha1.DiskStatusReady)
+					observed.ResourcePolicies = tc.policies
+					_ = json.NewEncoder(w).Encode(observed)
+					return
+				case strings.HasSuffix(r.URL.Path, "/setLabels"):
+					rq := &compute.ZoneSetLabelsRequest{}
+					_ = json.NewDecoder(r.Body).Decode(rq)
+					calls = append(calls, scope+" setLabels "+rq.LabelFingerprint)
+				case strings.HasSuffix(r.URL.Path, "/resize"):
+					rq := &compute.DisksResizeRequest{}
+					_ = json.NewDecoder(r.Body).Decode(rq)
+					calls = append(calls, scope+" resize "+strconv.FormatInt(rq.SizeGb, 10))
+				case strings.HasSuffix(r.URL.Path, "/addResourcePolicies"):
+					rq := &compute.DisksAddResourcePoliciesRequest{}
+					_ = json.NewDecoder(r.Body).Decode(rq)
+					calls = append(calls, scope+" addResourcePolicies "+path.Base(strings.Join(rq.ResourcePolicies, ",")))
+				case strings.HasSuffix(r.URL.Path, "/removeResourcePolicies"):
+					rq := &compute.DisksRemoveResourcePoliciesRequest{}
+					_ = json.NewDecoder(r.Body).Decode(rq)
+					calls = append(calls, scope+" removeResourcePolicies "+path.Base(strings.Join(rq.ResourcePolicies, ",")))
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := diskExternal{Service: s, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiskDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		mg     resource.Managed
+		want   error
+	}{
+		"NotDisk": {
+			mg:   &v1beta1.Subnetwork{},
+			want: errors.New(errNotDisk),
+		},
+		"Deleted": {
+			status: http.StatusOK,
+			mg:     diskObj(),
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+			mg:     diskObj(),
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			mg:     diskObj(),
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDisk),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := diskExternal{Service: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,9 +18,11 @@ package compute
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/compute/v1"
+	logging "google.golang.org/api/logging/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -37,7 +39,12 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/firewall"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -50,6 +57,9 @@ const (
 	errFirewallCreateFailed  = "creation of Firewall resource has failed"
 	errFirewallDeleteFailed  = "deletion of Firewall resource has failed"
 	errCheckFirewallUpToDate = "cannot determine if GCP Firewall is up to date"
+
+	errNewLoggingClient = "cannot create new Cloud Logging client"
+	errListFirewallHits = "cannot list firewall rules log entries"
 )
 
 // SetupFirewall adds a controller that reconciles Firewall managed
@@ -64,7 +74,7 @@ func SetupFirewall(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.FirewallGroupVersionKind),
-		managed.WithExternalConnecter(&firewallConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &firewallConnector{kube: mgr.GetClient(), observeHits: o.Features.Enabled(features.EnableAlphaFirewallHitObservation)}))))),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -72,13 +82,14 @@ func SetupFirewall(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.Firewall{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
 
 type firewallConnector struct {
-	kube client.Client
+	kube        client.Client
+	observeHits bool
 }
 
 func (c *firewallConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -90,13 +101,24 @@ func (c *firewallConnector) Connect(ctx context.Context, mg resource.Managed) (m
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &firewallExternal{Service: s, kube: c.kube, projectID: projectID}, nil
+	e := &firewallExternal{Service: s, kube: c.kube, projectID: projectID}
+	if c.observeHits {
+		l, err := logging.NewService(ctx, opts...)
+		if err != nil {
+			return nil, errors.Wrap(err, errNewLoggingClient)
+		}
+		e.entries = l.Entries
+	}
+	return e, nil
 }
 
 type firewallExternal struct {
 	kube client.Client
 	*compute.Service
 	projectID string
+
+	// entries is only set when firewall hit observation is enabled.
+	entries *logging.EntriesService
 }
 
 func (c *firewallExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -116,7 +138,15 @@ func (c *firewallExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 		lateIntialized = true
 	}
 
+	prev := cr.Status.AtProvider
 	cr.Status.AtProvider = firewall.GenerateFirewallObservation(*observed)
+	cr.Status.AtProvider.LastHitTime = prev.LastHitTime
+	cr.Status.AtProvider.LastHitCheckTime = prev.LastHitCheckTime
+	if c.entries != nil && firewall.ReportsHits(*observed) && firewall.NeedsHitCheck(prev, time.Now()) {
+		if err := c.observeHits(ctx, cr, *observed); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
 
 	cr.Status.SetConditions(xpv1.Available())
 
@@ -132,6 +162,27 @@ func (c *firewallExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	}, nil
 }
 
+// observeHits records the time of the latest firewall rules log entry of the
+// supplied firewall written since the previous check.
+func (c *firewallExternal) observeHits(ctx context.Context, cr *v1alpha1.Firewall, observed compute.Firewall) error {
+	now := time.Now()
+	req := &logging.ListLogEntriesRequest{
+		ResourceNames: []string{"projects/" + c.projectID},
+		Filter:        firewall.HitFilter(c.projectID, observed, firewall.HitSince(cr.Status.AtProvider, now)),
+		OrderBy:       "timestamp desc",
+		PageSize:      1,
+	}
+	rsp, err := c.entries.List(req).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(err, errListFirewallHits)
+	}
+	if len(rsp.Entries) > 0 {
+		cr.Status.AtProvider.LastHitTime = rsp.Entries[0].Timestamp
+	}
+	cr.Status.AtProvider.LastHitCheckTime = now.UTC().Format(time.RFC3339)
+	return nil
+}
+
 func (c *firewallExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.Firewall)
 	if !ok {
@@ -140,10 +191,14 @@ func (c *firewallExternal) Create(ctx context.Context, mg resource.Managed) (man
 
 	fw := &compute.Firewall{}
 	firewall.GenerateFirewall(meta.GetExternalName(cr), cr.Spec.ForProvider, fw)
-	_, err := c.Firewalls.Insert(c.projectID, fw).
+	op, err := c.Firewalls.Insert(c.projectID, fw).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errFirewallCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errFirewallCreateFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *firewallExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -168,10 +223,14 @@ func (c *firewallExternal) Update(ctx context.Context, mg resource.Managed) (man
 	fw := &compute.Firewall{}
 	firewall.GenerateFirewall(meta.GetExternalName(cr), cr.Spec.ForProvider, fw)
 
-	_, err = c.Firewalls.Patch(c.projectID, meta.GetExternalName(cr), fw).
+	op, err := c.Firewalls.Patch(c.projectID, meta.GetExternalName(cr), fw).
 		Context(ctx).
 		Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errFirewallUpdateFailed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFirewallUpdateFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *firewallExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -181,8 +240,12 @@ func (c *firewallExternal) Delete(ctx context.Context, mg resource.Managed) erro
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Firewalls.Delete(c.projectID, meta.GetExternalName(cr)).
+	op, err := c.Firewalls.Delete(c.projectID, meta.GetExternalName(cr)).
 		Context(ctx).
 		Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errFirewallDeleteFailed)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errFirewallDeleteFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
 }
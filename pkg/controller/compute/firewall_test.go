@@ -22,10 +22,13 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	compute "google.golang.org/api/compute/v1"
+	logging "google.golang.org/api/logging/v2"
 	"google.golang.org/api/option"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -197,6 +200,115 @@ func TestFirewallObserve(t *testing.T) {
 	}
 }
 
+func TestFirewallObserveHits(t *testing.T) {
+	lastHit := "2023-03-01T10:00:00Z"
+	recent := time.Now().UTC().Format(time.RFC3339)
+	logged := &compute.Firewall{
+		Name:      testFirewallName,
+		Network:   "https://www.googleapis.com/compute/v1/projects/" + projectID + "/global/networks/default",
+		LogConfig: &compute.FirewallLogConfig{Enable: true},
+	}
+
+	type want struct {
+		lastHit string
+		checked bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		observed *compute.Firewall
+		logs     http.HandlerFunc
+		mg       *v1alpha1.Firewall
+		want     want
+	}{
+		"HitFound": {
+			observed: logged,
+			logs: func(w http.ResponseWriter, r *http.Request) {
+				req := &logging.ListLogEntriesRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Error(err)
+				}
+				if !strings.Contains(req.Filter, `jsonPayload.rule_details.reference="network:default/firewall:`+testFirewallName+`"`) {
+					t.Errorf("r: unexpected filter %q", req.Filter)
+				}
+				_ = json.NewEncoder(w).Encode(&logging.ListLogEntriesResponse{Entries: []*logging.LogEntry{{Timestamp: lastHit}}})
+			},
+			mg:   firewallObj(),
+			want: want{lastHit: lastHit, checked: true},
+		},
+		"NoNewHits": {
+			observed: logged,
+			logs: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(&logging.ListLogEntriesResponse{})
+			},
+			mg: firewallObj(func(i *v1alpha1.Firewall) {
+				i.Status.AtProvider.LastHitTime = lastHit
+				i.Status.AtProvider.LastHitCheckTime = "2023-03-02T10:00:00Z"
+			}),
+			want: want{lastHit: lastHit, checked: true},
+		},
+		"RecentlyChecked": {
+			observed: logged,
+			logs: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("r: unexpected request to list log entries")
+			},
+			mg: firewallObj(func(i *v1alpha1.Firewall) {
+				i.Status.AtProvider.LastHitTime = lastHit
+				i.Status.AtProvider.LastHitCheckTime = recent
+			}),
+			want: want{lastHit: lastHit, checked: true},
+		},
+		"LoggingDisabled": {
+			observed: &compute.Firewall{Name: testFirewallName},
+			logs: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("r: unexpected request to list log entries")
+			},
+			mg: firewallObj(),
+		},
+		"ListFailed": {
+			observed: logged,
+			logs: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			},
+			mg:   firewallObj(),
+			want: want{err: errors.Wrap(gError(http.StatusForbidden, ""), errListFirewallHits)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if r.URL.Path == "/v2/entries:list" {
+					tc.logs(w, r)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.observed)
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			l, _ := logging.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := firewallExternal{
+				kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				projectID: projectID,
+				Service:   s,
+				entries:   l.Entries,
+			}
+			_, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.lastHit, tc.mg.Status.AtProvider.LastHitTime); diff != "" {
+				t.Errorf("Observe(...): -want lastHitTime, +got lastHitTime:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.checked, tc.mg.Status.AtProvider.LastHitCheckTime != ""); diff != "" {
+				t.Errorf("Observe(...): -want checked, +got checked:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestFirewallCreate(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -477,6 +589,36 @@ func TestFirewallUpdate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"ReEnable": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(&compute.Firewall{Disabled: true}); err != nil {
+						t.Error(err)
+					}
+				case http.MethodPatch:
+					body, _ := ioutil.ReadAll(r.Body)
+					if !strings.Contains(string(body), `"disabled":false`) {
+						t.Errorf("PATCH body does not re-enable the firewall: %s", body)
+					}
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(&compute.Operation{}); err != nil {
+						t.Error(err)
+					}
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: firewallObj(func(f *v1alpha1.Firewall) { f.Spec.ForProvider.Disabled = new(bool) }),
+			},
+			want: want{
+				mg: firewallObj(func(f *v1alpha1.Firewall) { f.Spec.ForProvider.Disabled = new(bool) }),
+			},
+		},
 		"UpdateFails": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
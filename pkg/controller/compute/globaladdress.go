@@ -18,6 +18,8 @@ package compute
 
 import (
 	"context"
+	"path"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/compute/v1"
@@ -36,8 +38,15 @@ import (
 
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/globaladdress"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/utilization"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -48,6 +57,7 @@ const (
 	errCreateGlobalAddress        = "cannot create external Address resource"
 	errDeleteGlobalAddress        = "cannot delete external Address resource"
 	errManagedGlobalAddressUpdate = "cannot update managed GlobalAddress resource"
+	errGlobalAddressUtilization   = "cannot determine the IP address utilization of the range allocated by external Address resource"
 )
 
 // SetupGlobalAddress adds a controller that reconciles
@@ -62,7 +72,7 @@ func SetupGlobalAddress(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.GlobalAddressGroupVersionKind),
-		managed.WithExternalConnecter(&gaConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &gaConnector{kube: mgr.GetClient()}))))),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -71,7 +81,7 @@ func SetupGlobalAddress(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1beta1.GlobalAddress{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -119,7 +129,19 @@ func (e *gaExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 		}
 	}
 
+	last := cr.Status.AtProvider.Utilization
 	cr.Status.AtProvider = globaladdress.GenerateGlobalAddressObservation(*observed)
+	cr.Status.AtProvider.Utilization = last
+	eo.ConnectionDetails = globaladdress.GetConnectionDetails(cr.Status.AtProvider)
+	if allocated := globaladdress.AllocatedRange(*observed); allocated != "" {
+		if now := time.Now(); utilization.Due(last, now) {
+			u, err := e.utilization(ctx, allocated, *observed, now)
+			if err != nil {
+				return eo, errors.Wrap(err, errGlobalAddressUtilization)
+			}
+			cr.Status.AtProvider.Utilization = u
+		}
+	}
 
 	switch cr.Status.AtProvider.Status {
 	case v1beta1.StatusReserving:
@@ -140,8 +162,12 @@ func (e *gaExternal) Create(ctx context.Context, mg resource.Managed) (managed.E
 	cr.Status.SetConditions(xpv1.Creating())
 	address := &compute.Address{}
 	globaladdress.GenerateGlobalAddress(meta.GetExternalName(cr), cr.Spec.ForProvider, address)
-	_, err := e.GlobalAddresses.Insert(e.projectID, address).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateGlobalAddress)
+	op, err := e.GlobalAddresses.Insert(e.projectID, address).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateGlobalAddress)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *gaExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
@@ -156,6 +182,42 @@ func (e *gaExternal) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := e.GlobalAddresses.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteGlobalAddress)
+	op, err := e.GlobalAddresses.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteGlobalAddress)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+// utilization returns how many addresses of the supplied range, allocated by
+// the supplied address for private services access, are used by the
+// subnetworks of service producers. These subnetworks are the routes the
+// network of the address imports from its peerings.
+func (e *gaExternal) utilization(ctx context.Context, allocated string, observed compute.Address, now time.Time) (*gcpv1beta1.Utilization, error) {
+	capacity, err := utilization.IPv4RangeSize(allocated)
+	if err != nil {
+		return nil, err
+	}
+
+	network := path.Base(observed.Network)
+	n, err := e.Networks.Get(e.projectID, network).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	var routes []*compute.ExchangedPeeringRoute
+	for _, p := range n.Peerings {
+		if err := e.Networks.ListPeeringRoutes(e.projectID, network).Direction("INCOMING").PeeringName(p.Name).Pages(ctx, func(l *compute.ExchangedPeeringRoutesList) error {
+			routes = append(routes, l.Items...)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	used, err := globaladdress.UsedIPs(allocated, routes)
+	if err != nil {
+		return nil, err
+	}
+	return utilization.New(gcpv1beta1.UtilizationUnitIPAddresses, used, capacity, now), nil
 }
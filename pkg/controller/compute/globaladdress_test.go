@@ -66,6 +66,13 @@ func globalAddressWithStatus(status string) globalAddressModifier {
 	return func(i *v1beta1.GlobalAddress) { i.Status.AtProvider.Status = status }
 }
 
+func globalAddressWithAddress(ip string) globalAddressModifier {
+	return func(i *v1beta1.GlobalAddress) {
+		i.Spec.ForProvider.Address = &ip
+		i.Status.AtProvider.Address = ip
+	}
+}
+
 func globalAddressObj(im ...globalAddressModifier) *v1beta1.GlobalAddress {
 	i := &v1beta1.GlobalAddress{
 		ObjectMeta: metav1.ObjectMeta{
@@ -203,8 +210,9 @@ func TestGlobalAddressObserve(t *testing.T) {
 			},
 			want: want{
 				obs: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 				mg: globalAddressObj(
 					globalAddressWithConditions(xpv1.Creating()),
@@ -222,12 +230,14 @@ func TestGlobalAddressObserve(t *testing.T) {
 				c := &compute.Address{}
 				globaladdress.GenerateGlobalAddress(testGAName, globalAddressObj().Spec.ForProvider, c)
 				c.Status = v1beta1.StatusReserved
+				c.Address = testAddressIP
 				if err := json.NewEncoder(w).Encode(c); err != nil {
 					t.Error(err)
 				}
 			}),
 			kube: &test.MockClient{
-				MockGet: test.NewMockGetFn(nil),
+				MockGet:    test.NewMockGetFn(nil),
+				MockUpdate: test.NewMockUpdateFn(nil),
 			},
 			args: args{
 				mg: globalAddressObj(),
@@ -236,10 +246,14 @@ func TestGlobalAddressObserve(t *testing.T) {
 				obs: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1beta1.AddressKey: []byte(testAddressIP),
+					},
 				},
 				mg: globalAddressObj(
 					globalAddressWithConditions(xpv1.Available()),
 					globalAddressWithStatus(v1beta1.StatusReserved),
+					globalAddressWithAddress(testAddressIP),
 				),
 			},
 		},
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/healthcheck"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotHealthCheck           = "managed resource is not a HealthCheck"
+	errGetHealthCheck           = "cannot get external HealthCheck resource"
+	errCreateHealthCheck        = "cannot create external HealthCheck resource"
+	errUpdateHealthCheck        = "cannot update external HealthCheck resource"
+	errDeleteHealthCheck        = "cannot delete external HealthCheck resource"
+	errCheckHealthCheckUpToDate = "cannot determine if external HealthCheck resource is up to date"
+)
+
+// SetupHealthCheck adds a controller that reconciles HealthCheck managed
+// resources.
+func SetupHealthCheck(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.HealthCheckGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.HealthCheckGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &healthCheckConnector{kube: mgr.GetClient()})))))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.HealthCheck{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type healthCheckConnector struct {
+	kube client.Client
+}
+
+func (c *healthCheckConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &healthCheckExternal{Service: s, projectID: projectID}, nil
+}
+
+type healthCheckExternal struct {
+	*compute.Service
+	projectID string
+}
+
+func (e *healthCheckExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotHealthCheck)
+	}
+	observed, err := e.HealthChecks.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetHealthCheck)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	healthcheck.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = healthcheck.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	u, err := healthcheck.IsUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckHealthCheckUpToDate)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        u,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *healthCheckExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotHealthCheck)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	hc := &compute.HealthCheck{}
+	healthcheck.GenerateHealthCheck(meta.GetExternalName(cr), cr.Spec.ForProvider, hc)
+	op, err := e.HealthChecks.Insert(e.projectID, hc).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateHealthCheck)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update replaces the health check, so that its type can be changed too.
+func (e *healthCheckExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotHealthCheck)
+	}
+	name := meta.GetExternalName(cr)
+	observed, err := e.HealthChecks.Get(e.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetHealthCheck)
+	}
+	c, err := copystructure.Copy(observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateHealthCheck)
+	}
+	hc := c.(*compute.HealthCheck)
+	healthcheck.GenerateHealthCheck(name, cr.Spec.ForProvider, hc)
+
+	op, err := e.HealthChecks.Update(e.projectID, name, hc).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateHealthCheck)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *healthCheckExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return errors.New(errNotHealthCheck)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	op, err := e.HealthChecks.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteHealthCheck)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &healthCheckConnector{}
+var _ managed.ExternalClient = &healthCheckExternal{}
+
+const testHealthCheckName = "test-health-check"
+
+type healthCheckModifier func(*v1alpha1.HealthCheck)
+
+func healthCheckWithConditions(c ...xpv1.Condition) healthCheckModifier {
+	return func(h *v1alpha1.HealthCheck) { h.Status.SetConditions(c...) }
+}
+
+func healthCheckWithObservation(o v1alpha1.HealthCheckObservation) healthCheckModifier {
+	return func(h *v1alpha1.HealthCheck) { h.Status.AtProvider = o }
+}
+
+func healthCheckWithPort(p int64) healthCheckModifier {
+	return func(h *v1alpha1.HealthCheck) { h.Spec.ForProvider.TCPHealthCheck.Port = gcp.Int64Ptr(p) }
+}
+
+func healthCheckObj(m ...healthCheckModifier) *v1alpha1.HealthCheck {
+	h := &v1alpha1.HealthCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testHealthCheckName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testHealthCheckName,
+			},
+		},
+		Spec: v1alpha1.HealthCheckSpec{
+			ForProvider: v1alpha1.HealthCheckParameters{
+				CheckIntervalSec:   gcp.Int64Ptr(5),
+				TimeoutSec:         gcp.Int64Ptr(5),
+				HealthyThreshold:   gcp.Int64Ptr(2),
+				UnhealthyThreshold: gcp.Int64Ptr(2),
+				TCPHealthCheck: &v1alpha1.TCPHealthCheck{
+					HealthCheckPort: v1alpha1.HealthCheckPort{
+						Port:              gcp.Int64Ptr(80),
+						PortSpecification: gcp.StringPtr("USE_FIXED_PORT"),
+					},
+					ProxyHeader: gcp.StringPtr("NONE"),
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(h)
+	}
+	return h
+}
+
+// healthCheckGCE returns the health check that healthCheckObj describes, as
+// returned by the Compute API.
+func healthCheckGCE() *compute.HealthCheck {
+	return &compute.HealthCheck{
+		Id:                 1,
+		Name:               testHealthCheckName,
+		Type:               v1alpha1.HealthCheckTypeTCP,
+		CheckIntervalSec:   5,
+		TimeoutSec:         5,
+		HealthyThreshold:   2,
+		UnhealthyThreshold: 2,
+		TcpHealthCheck: &compute.TCPHealthCheck{
+			Port:              80,
+			PortSpecification: "USE_FIXED_PORT",
+			ProxyHeader:       "NONE",
+		},
+	}
+}
+
+func TestHealthCheckObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		observed *compute.HealthCheck
+		status   int
+		mg       resource.Managed
+		want     want
+	}{
+		"NotHealthCheck": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotHealthCheck),
+			},
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+			mg:     healthCheckObj(),
+			want:   want{mg: healthCheckObj()},
+		},
+		"GetFailed": {
+			status: http.StatusBadRequest,
+			mg:     healthCheckObj(),
+			want: want{
+				mg:  healthCheckObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetHealthCheck),
+			},
+		},
+		"UpToDate": {
+			status:   http.StatusOK,
+			observed: healthCheckGCE(),
+			mg:       healthCheckObj(),
+			want: want{
+				mg: healthCheckObj(
+					healthCheckWithConditions(xpv1.Available()),
+					healthCheckWithObservation(v1alpha1.HealthCheckObservation{ID: 1, Type: v1alpha1.HealthCheckTypeTCP}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PortChanged": {
+			status:   http.StatusOK,
+			observed: healthCheckGCE(),
+			mg:       healthCheckObj(healthCheckWithPort(8080)),
+			want: want{
+				mg: healthCheckObj(
+					healthCheckWithPort(8080),
+					healthCheckWithConditions(xpv1.Available()),
+					healthCheckWithObservation(v1alpha1.HealthCheckObservation{ID: 1, Type: v1alpha1.HealthCheckTypeTCP}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/global/healthChecks/"+testHealthCheckName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.observed == nil {
+					_ = json.NewEncoder(w).Encode(&compute.HealthCheck{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.observed)
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := healthCheckExternal{Service: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHealthCheckUpdate(t *testing.T) {
+	var rq *compute.HealthCheck
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(healthCheckGCE())
+			return
+		}
+		if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		rq = &compute.HealthCheck{}
+		_ = json.NewDecoder(r.Body).Decode(rq)
+		_ = json.NewEncoder(w).Encode(&compute.Operation{})
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := healthCheckExternal{Service: s, projectID: projectID}
+
+	if _, err := e.Update(context.Background(), healthCheckObj(healthCheckWithPort(8080))); err != nil {
+		t.Fatalf("Update(...): unexpected error: %s", err)
+	}
+	want := healthCheckGCE()
+	want.TcpHealthCheck.Port = 8080
+	if diff := cmp.Diff(want, rq); diff != "" {
+		t.Errorf("Update(...): -want request, +got request:\n%s", diff)
+	}
+}
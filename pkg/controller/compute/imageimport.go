@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/imageimport"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	// Error strings.
+	errNotImageImport         = "managed resource is not an ImageImport resource"
+	errGetImage               = "cannot get imported GCP Image"
+	errListImportBuilds       = "cannot list import builds of GCP Image"
+	errImageImportCreate      = "cannot start import build of GCP Image"
+	errImageImportCancel      = "cannot cancel import build of GCP Image"
+	errImageImportDelete      = "cannot delete imported GCP Image"
+	errImageImportBuildFailed = "import build of GCP Image finished with status %s: %s"
+)
+
+// SetupImageImport adds a controller that reconciles ImageImport managed
+// resources.
+func SetupImageImport(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ImageImportGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ImageImportGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &imageImportConnector{kube: mgr.GetClient()}))))))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.ImageImport{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type imageImportConnector struct {
+	kube client.Client
+}
+
+func (c *imageImportConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	b, err := cloudbuild.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &imageImportExternal{Service: s, builds: b.Projects.Builds, projectID: projectID}, nil
+}
+
+type imageImportExternal struct {
+	*compute.Service
+	builds    *cloudbuild.ProjectsBuildsService
+	projectID string
+}
+
+// Observe reports the imported image as existing once it has been created by
+// the import build. While the build runs, or after it failed, the build is
+// reported instead so that it is not started again.
+func (c *imageImportExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ImageImport)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotImageImport)
+	}
+	image, err := c.Images.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetImage)
+	}
+	if err == nil {
+		imageimport.UpdateImageObservation(&cr.Status.AtProvider, image)
+		switch image.Status {
+		case imageimport.ImageStatusReady:
+			cr.Status.SetConditions(xpv1.Available())
+		default:
+			cr.Status.SetConditions(xpv1.Creating())
+		}
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	builds, err := c.builds.List(c.projectID).Filter(imageimport.BuildFilter(meta.GetExternalName(cr))).PageSize(1).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListImportBuilds)
+	}
+	if len(builds.Builds) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	b := builds.Builds[0]
+	imageimport.UpdateBuildObservation(&cr.Status.AtProvider, b)
+	switch {
+	case imageimport.IsBuildRunning(b):
+		cr.Status.SetConditions(xpv1.Creating())
+	case imageimport.IsBuildFailed(b) && !meta.WasDeleted(cr):
+		// A failed import is not retried; it is unlikely to succeed without
+		// changes to the source file, which is immutable.
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(errImageImportBuildFailed, b.Status, b.LogUrl)))
+	default:
+		// The image was deleted after it was imported, or the failed import
+		// is being deleted.
+		return managed.ExternalObservation{}, nil
+	}
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (c *imageImportExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ImageImport)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotImageImport)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	op, err := c.builds.Create(c.projectID, imageimport.GenerateBuild(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errImageImportCreate)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update is a no-op, as all parameters of an ImageImport are immutable.
+func (c *imageImportExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete cancels a running import build, and deletes the imported image.
+func (c *imageImportExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ImageImport)
+	if !ok {
+		return errors.New(errNotImageImport)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	if o := cr.Status.AtProvider; o.BuildID != "" && imageimport.IsBuildRunning(&cloudbuild.Build{Status: o.BuildStatus}) {
+		_, err := c.builds.Cancel(c.projectID, o.BuildID, &cloudbuild.CancelBuildRequest{}).Context(ctx).Do()
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errImageImportCancel)
+	}
+	op, err := c.Images.Delete(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errImageImportDelete)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
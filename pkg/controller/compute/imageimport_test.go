@@ -0,0 +1,298 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &imageImportConnector{}
+var _ managed.ExternalClient = &imageImportExternal{}
+
+const (
+	testImageName = "test-image"
+	testBuildID   = "test-build"
+	testLogURL    = "https://console.cloud.google.com/cloud-build/builds/test-build"
+)
+
+type imageImportModifier func(*v1alpha1.ImageImport)
+
+func imageImportWithConditions(c ...xpv1.Condition) imageImportModifier {
+	return func(i *v1alpha1.ImageImport) { i.Status.SetConditions(c...) }
+}
+
+func imageImportWithObservation(o v1alpha1.ImageImportObservation) imageImportModifier {
+	return func(i *v1alpha1.ImageImport) { i.Status.AtProvider = o }
+}
+
+func imageImportDeleted(i *v1alpha1.ImageImport) {
+	now := metav1.Now()
+	i.SetDeletionTimestamp(&now)
+}
+
+func imageImportObj(im ...imageImportModifier) *v1alpha1.ImageImport {
+	i := &v1alpha1.ImageImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testImageName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testImageName,
+			},
+		},
+		Spec: v1alpha1.ImageImportSpec{
+			ForProvider: v1alpha1.ImageImportParameters{
+				SourceFile: "gs://test-bucket/test-image.vmdk",
+				DataDisk:   gcp.BoolPtr(true),
+			},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+// imageImportHandler serves the supplied image, or a 404 if it is nil, and
+// the supplied import builds.
+func imageImportHandler(t *testing.T, image *compute.Image, builds ...*cloudbuild.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if strings.Contains(r.URL.Path, "/builds") {
+			if diff := cmp.Diff(fmt.Sprintf("tags=%q AND tags=%q", "crossplane-image-import", testImageName), r.URL.Query().Get("filter")); diff != "" {
+				t.Errorf("r: -want filter, +got filter:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(&cloudbuild.ListBuildsResponse{Builds: builds})
+			return
+		}
+		if image == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(image)
+	})
+}
+
+func TestImageImportObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotImageImport": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotImageImport),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Image{})
+			}),
+			mg: imageImportObj(),
+			want: want{
+				mg:  imageImportObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetImage),
+			},
+		},
+		"NotImported": {
+			handler: imageImportHandler(t, nil),
+			mg:      imageImportObj(),
+			want: want{
+				mg: imageImportObj(),
+			},
+		},
+		"Importing": {
+			handler: imageImportHandler(t, nil, &cloudbuild.Build{Id: testBuildID, Status: "WORKING", LogUrl: testLogURL}),
+			mg:      imageImportObj(),
+			want: want{
+				mg: imageImportObj(
+					imageImportWithConditions(xpv1.Creating()),
+					imageImportWithObservation(v1alpha1.ImageImportObservation{BuildID: testBuildID, BuildStatus: "WORKING", LogURL: testLogURL}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ImportFailed": {
+			handler: imageImportHandler(t, nil, &cloudbuild.Build{Id: testBuildID, Status: "FAILURE", LogUrl: testLogURL}),
+			mg:      imageImportObj(),
+			want: want{
+				mg: imageImportObj(
+					imageImportWithConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(errImageImportBuildFailed, "FAILURE", testLogURL))),
+					imageImportWithObservation(v1alpha1.ImageImportObservation{BuildID: testBuildID, BuildStatus: "FAILURE", LogURL: testLogURL}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FailedImportDeleted": {
+			handler: imageImportHandler(t, nil, &cloudbuild.Build{Id: testBuildID, Status: "CANCELLED"}),
+			mg:      imageImportObj(imageImportDeleted),
+			want: want{
+				mg: imageImportObj(
+					imageImportDeleted,
+					imageImportWithObservation(v1alpha1.ImageImportObservation{BuildID: testBuildID, BuildStatus: "CANCELLED"}),
+				),
+			},
+		},
+		"Imported": {
+			handler: imageImportHandler(t, &compute.Image{Status: "READY", SelfLink: "self", DiskSizeGb: 10}),
+			mg:      imageImportObj(),
+			want: want{
+				mg: imageImportObj(
+					imageImportWithConditions(xpv1.Available()),
+					imageImportWithObservation(v1alpha1.ImageImportObservation{Status: "READY", SelfLink: "self", DiskSizeGb: 10}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			b, _ := cloudbuild.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := imageImportExternal{
+				Service:   s,
+				builds:    b.Projects.Builds,
+				projectID: projectID,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions(), cmpopts.IgnoreFields(metav1.ObjectMeta{}, "DeletionTimestamp")); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestImageImportCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b := &cloudbuild.Build{}
+				_ = json.NewDecoder(r.Body).Decode(b)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("-image_name="+testImageName, b.Steps[0].Args[0]); diff != "" {
+					t.Errorf("r: -want arg, +got arg:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&cloudbuild.Operation{Name: "operations/build"})
+			}),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&cloudbuild.Operation{})
+			}),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errImageImportCreate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			b, _ := cloudbuild.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := imageImportExternal{builds: b.Projects.Builds, projectID: projectID}
+			_, err := e.Create(context.Background(), imageImportObj())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestImageImportDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg       *v1alpha1.ImageImport
+		wantPath string
+		want     error
+	}{
+		"CancelRunningImport": {
+			mg:       imageImportObj(imageImportWithObservation(v1alpha1.ImageImportObservation{BuildID: testBuildID, BuildStatus: "QUEUED"})),
+			wantPath: "builds/" + testBuildID + ":cancel",
+		},
+		"DeleteImage": {
+			mg:       imageImportObj(imageImportWithObservation(v1alpha1.ImageImportObservation{BuildID: testBuildID, BuildStatus: "SUCCESS"})),
+			wantPath: "global/images/" + testImageName,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if !strings.HasSuffix(r.URL.Path, tc.wantPath) {
+					t.Errorf("r: want path with suffix %q, got %q", tc.wantPath, r.URL.Path)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			b, _ := cloudbuild.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := imageImportExternal{Service: s, builds: b.Projects.Builds, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/instance"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/instancetemplate"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotInstance             = "managed resource is not an Instance"
+	errGetInstance             = "cannot get external Instance resource"
+	errCreateInstance          = "cannot create external Instance resource"
+	errDeleteInstance          = "cannot delete external Instance resource"
+	errSetInstanceLabels       = "cannot set labels of external Instance resource"
+	errSetInstanceMetadata     = "cannot set metadata of external Instance resource"
+	errSetInstanceMachineType  = "cannot set machine type of external Instance resource"
+	errInstanceMachineTypeLive = "cannot change the machine type of an Instance that is not stopped"
+	errGetDiskEncryptionKeys   = "cannot get disk encryption keys of Instance"
+)
+
+// SetupInstance adds a controller that reconciles Instance managed resources.
+func SetupInstance(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &instanceConnector{kube: mgr.GetClient()}))))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.Instance{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type instanceConnector struct {
+	kube client.Client
+}
+
+func (c *instanceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &instanceExternal{Service: s, kube: c.kube, projectID: projectID}, nil
+}
+
+type instanceExternal struct {
+	*compute.Service
+	kube      client.Client
+	projectID string
+}
+
+func (e *instanceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstance)
+	}
+	observed, err := e.Instances.Get(e.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInstance)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	instance.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = instance.GenerateObservation(*observed, e.projectID)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.InstanceStatusProvisioning, v1alpha1.InstanceStatusStaging:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.InstanceStatusRunning:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        instance.IsUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       instance.GetConnectionDetails(cr.Status.AtProvider),
+	}, nil
+}
+
+// Create resolves the source image families of the new disks to their latest
+// images that are not deprecated, and creates the instance from them. The
+// customer-supplied encryption keys of the disks are read from their Secrets
+// and sent only with the create request.
+func (e *instanceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	families := instance.SourceImageFamilies(cr.Spec.ForProvider)
+	images := make([]string, len(families))
+	for i, f := range families {
+		if f == "" {
+			continue
+		}
+		project, family := instancetemplate.ImageFamily(e.projectID, f)
+		image, err := e.Images.GetFromFamily(project, family).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrapf(err, errResolveSourceImageFamily, f, i)
+		}
+		images[i] = image.SelfLink
+	}
+
+	keys, err := instance.GetDiskEncryptionKeys(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetDiskEncryptionKeys)
+	}
+
+	op, err := e.Instances.Insert(e.projectID, cr.Spec.ForProvider.Zone, instance.GenerateInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, images, keys)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update sets the labels and metadata of the instance, and its machine type
+// if the instance is stopped. GCE does not allow changing the machine type of
+// a running instance, so an outdated machine type is reported as an error
+// until the instance is stopped.
+func (e *instanceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInstance)
+	}
+	p := cr.Spec.ForProvider
+	name := meta.GetExternalName(cr)
+	observed, err := e.Instances.Get(e.projectID, p.Zone, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetInstance)
+	}
+	if err := instance.ValidateUnchanged(p, *observed); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if !instance.LabelsUpToDate(p, *observed) {
+		rq := &compute.InstancesSetLabelsRequest{Labels: p.Labels, LabelFingerprint: observed.LabelFingerprint}
+		op, err := e.Instances.SetLabels(e.projectID, p.Zone, name, rq).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetInstanceLabels)
+		}
+		audit.RecordOperation(ctx, op.Name)
+	}
+
+	if !instance.MetadataUpToDate(p, *observed) {
+		fingerprint := ""
+		if observed.Metadata != nil {
+			fingerprint = observed.Metadata.Fingerprint
+		}
+		op, err := e.Instances.SetMetadata(e.projectID, p.Zone, name, instance.GenerateMetadata(p.Metadata, fingerprint)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetInstanceMetadata)
+		}
+		audit.RecordOperation(ctx, op.Name)
+	}
+
+	if !instance.MachineTypeUpToDate(p, *observed) {
+		if observed.Status != v1alpha1.InstanceStatusTerminated {
+			return managed.ExternalUpdate{}, errors.New(errInstanceMachineTypeLive)
+		}
+		rq := &compute.InstancesSetMachineTypeRequest{MachineType: instance.ZonalURL(p.Zone, "machineTypes", p.MachineType)}
+		op, err := e.Instances.SetMachineType(e.projectID, p.Zone, name, rq).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetInstanceMachineType)
+		}
+		audit.RecordOperation(ctx, op.Name)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *instanceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	op, err := e.Instances.Delete(e.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstance)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
@@ -0,0 +1,479 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &instanceConnector{}
+var _ managed.ExternalClient = &instanceExternal{}
+
+const (
+	testInstanceName = "test-instance"
+	testInstanceZone = "us-central1-a"
+)
+
+type instanceModifier func(*v1alpha1.Instance)
+
+func instanceWithConditions(c ...xpv1.Condition) instanceModifier {
+	return func(i *v1alpha1.Instance) { i.Status.SetConditions(c...) }
+}
+
+func instanceWithObservation(o v1alpha1.InstanceObservation) instanceModifier {
+	return func(i *v1alpha1.Instance) { i.Status.AtProvider = o }
+}
+
+func instanceWithLabels(l map[string]string) instanceModifier {
+	return func(i *v1alpha1.Instance) { i.Spec.ForProvider.Labels = l }
+}
+
+func instanceWithMachineType(mt string) instanceModifier {
+	return func(i *v1alpha1.Instance) { i.Spec.ForProvider.MachineType = mt }
+}
+
+func instanceWithShieldedInstanceConfig(c *v1alpha1.ShieldedInstanceConfig) instanceModifier {
+	return func(i *v1alpha1.Instance) { i.Spec.ForProvider.ShieldedInstanceConfig = c }
+}
+
+func instanceObj(im ...instanceModifier) *v1alpha1.Instance {
+	i := &v1alpha1.Instance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testInstanceName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testInstanceName,
+			},
+		},
+		Spec: v1alpha1.InstanceSpec{
+			ForProvider: v1alpha1.InstanceParameters{
+				Zone:        testInstanceZone,
+				MachineType: "e2-small",
+				Disks: []v1alpha1.InstanceDisk{
+					{AttachedDisk: v1alpha1.AttachedDisk{Boot: gcp.BoolPtr(true), SourceImageFamily: gcp.StringPtr(testImageFamily)}},
+				},
+				NetworkInterfaces: []v1alpha1.NetworkInterface{{Network: gcp.StringPtr("global/networks/default")}},
+				Metadata:          map[string]string{"k": "v"},
+			},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+// instanceGCE returns the instance that instanceObj describes, as returned by
+// the Compute API.
+func instanceGCE(status string) *compute.Instance {
+	return &compute.Instance{
+		Id:               1,
+		Name:             testInstanceName,
+		Zone:             "https://www.googleapis.com/compute/v1/projects/" + projectID + "/zones/" + testInstanceZone,
+		Status:           status,
+		MachineType:      "https://www.googleapis.com/compute/v1/projects/" + projectID + "/zones/" + testInstanceZone + "/machineTypes/e2-small",
+		Metadata:         &compute.Metadata{Fingerprint: "mfp", Items: []*compute.MetadataItems{{Key: "k", Value: gcp.StringPtr("v")}}},
+		LabelFingerprint: "lfp",
+		NetworkInterfaces: []*compute.NetworkInterface{{
+			Name:          "nic0",
+			Network:       "https://www.googleapis.com/compute/v1/projects/" + projectID + "/global/networks/default",
+			NetworkIP:     "10.0.0.2",
+			AliasIpRanges: []*compute.AliasIpRange{{IpCidrRange: "10.1.0.0/24", SubnetworkRangeName: "pods"}},
+			AccessConfigs: []*compute.AccessConfig{{NatIP: "203.0.113.7"}},
+		}},
+	}
+}
+
+func TestInstanceObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	nic := v1alpha1.NetworkInterfaceObservation{
+		Name:          "nic0",
+		Network:       instanceGCE("").NetworkInterfaces[0].Network,
+		NetworkIP:     "10.0.0.2",
+		AliasIPRanges: []v1alpha1.AliasIPRange{{IPCidrRange: "10.1.0.0/24", SubnetworkRangeName: "pods"}},
+	}
+	externalNIC := nic
+	externalNIC.AccessConfigs = []v1alpha1.AccessConfigObservation{{NatIP: "203.0.113.7"}}
+	dnsName := testInstanceName + "." + testInstanceZone + ".c." + projectID + ".internal"
+	running := v1alpha1.InstanceObservation{
+		ID:                1,
+		Status:            v1alpha1.InstanceStatusRunning,
+		MachineType:       instanceGCE("").MachineType,
+		InternalIP:        "10.0.0.2",
+		ExternalIP:        "203.0.113.7",
+		NetworkInterfaces: []v1alpha1.NetworkInterfaceObservation{externalNIC},
+		InternalDNSName:   dnsName,
+	}
+	details := managed.ConnectionDetails{
+		v1alpha1.InstanceInternalIPKey: []byte("10.0.0.2"),
+		v1alpha1.InstanceExternalIPKey: []byte("203.0.113.7"),
+	}
+
+	cases := map[string]struct {
+		observed *compute.Instance
+		status   int
+		mg       resource.Managed
+		want     want
+	}{
+		"NotInstance": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotInstance),
+			},
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+			mg:     instanceObj(),
+			want:   want{mg: instanceObj()},
+		},
+		"GetFailed": {
+			status: http.StatusBadRequest,
+			mg:     instanceObj(),
+			want: want{
+				mg:  instanceObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetInstance),
+			},
+		},
+		"Running": {
+			status:   http.StatusOK,
+			observed: instanceGCE(v1alpha1.InstanceStatusRunning),
+			mg:       instanceObj(),
+			want: want{
+				mg: instanceObj(
+					instanceWithConditions(xpv1.Available()),
+					instanceWithObservation(running),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: details},
+			},
+		},
+		"LabelsLateInitialized": {
+			status: http.StatusOK,
+			observed: func() *compute.Instance {
+				i := instanceGCE(v1alpha1.InstanceStatusRunning)
+				i.Labels = map[string]string{"l": "v"}
+				return i
+			}(),
+			mg: instanceObj(),
+			want: want{
+				mg: instanceObj(
+					instanceWithLabels(map[string]string{"l": "v"}),
+					instanceWithConditions(xpv1.Available()),
+					instanceWithObservation(running),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true, ConnectionDetails: details},
+			},
+		},
+		"ShieldedInstanceConfigLateInitialized": {
+			status: http.StatusOK,
+			observed: func() *compute.Instance {
+				i := instanceGCE(v1alpha1.InstanceStatusRunning)
+				i.ShieldedInstanceConfig = &compute.ShieldedInstanceConfig{EnableVtpm: true, EnableIntegrityMonitoring: true}
+				return i
+			}(),
+			mg: instanceObj(),
+			want: want{
+				mg: instanceObj(
+					instanceWithShieldedInstanceConfig(&v1alpha1.ShieldedInstanceConfig{
+						EnableVtpm:                gcp.BoolPtr(true),
+						EnableIntegrityMonitoring: gcp.BoolPtr(true),
+					}),
+					instanceWithConditions(xpv1.Available()),
+					instanceWithObservation(running),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true, ConnectionDetails: details},
+			},
+		},
+		"ShieldedInstanceConfigChanged": {
+			status: http.StatusOK,
+			observed: func() *compute.Instance {
+				i := instanceGCE(v1alpha1.InstanceStatusRunning)
+				i.ShieldedInstanceConfig = &compute.ShieldedInstanceConfig{EnableVtpm: true, EnableIntegrityMonitoring: true}
+				return i
+			}(),
+			mg: instanceObj(instanceWithShieldedInstanceConfig(&v1alpha1.ShieldedInstanceConfig{
+				EnableSecureBoot:          gcp.BoolPtr(true),
+				EnableVtpm:                gcp.BoolPtr(true),
+				EnableIntegrityMonitoring: gcp.BoolPtr(true),
+			})),
+			want: want{
+				mg: instanceObj(
+					instanceWithShieldedInstanceConfig(&v1alpha1.ShieldedInstanceConfig{
+						EnableSecureBoot:          gcp.BoolPtr(true),
+						EnableVtpm:                gcp.BoolPtr(true),
+						EnableIntegrityMonitoring: gcp.BoolPtr(true),
+					}),
+					instanceWithConditions(xpv1.Available()),
+					instanceWithObservation(running),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: details},
+			},
+		},
+		"Stopped": {
+			status: http.StatusOK,
+			observed: func() *compute.Instance {
+				i := instanceGCE(v1alpha1.InstanceStatusTerminated)
+				i.NetworkInterfaces[0].AccessConfigs = nil
+				return i
+			}(),
+			mg: instanceObj(instanceWithMachineType("e2-medium")),
+			want: want{
+				mg: instanceObj(
+					instanceWithMachineType("e2-medium"),
+					instanceWithConditions(xpv1.Unavailable()),
+					instanceWithObservation(v1alpha1.InstanceObservation{
+						ID:                1,
+						Status:            v1alpha1.InstanceStatusTerminated,
+						MachineType:       instanceGCE("").MachineType,
+						InternalIP:        "10.0.0.2",
+						NetworkInterfaces: []v1alpha1.NetworkInterfaceObservation{nic},
+						InternalDNSName:   dnsName,
+					}),
+				),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: managed.ConnectionDetails{v1alpha1.InstanceInternalIPKey: []byte("10.0.0.2")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/zones/"+testInstanceZone+"/instances/"+testInstanceName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.observed == nil {
+					_ = json.NewEncoder(w).Encode(&compute.Instance{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.observed)
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceExternal{Service: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceCreate(t *testing.T) {
+	var created *compute.Instance
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/projects/"+projectID+"/global/images/family/"+testImageFamily):
+			_ = json.NewEncoder(w).Encode(&compute.Image{SelfLink: testFamilyImage})
+		case r.Method == http.MethodPost && r.URL.Path == "/projects/"+projectID+"/zones/"+testInstanceZone+"/instances":
+			created = &compute.Instance{}
+			_ = json.NewDecoder(r.Body).Decode(created)
+			_ = json.NewEncoder(w).Encode(&compute.Operation{})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := instanceExternal{Service: s, projectID: projectID}
+
+	cr := instanceObj(instanceWithShieldedInstanceConfig(&v1alpha1.ShieldedInstanceConfig{EnableSecureBoot: gcp.BoolPtr(true)}))
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(xpv1.Creating(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+		t.Errorf("Create(...): -want condition, +got condition:\n%s", diff)
+	}
+	if created == nil {
+		t.Fatal("Create(...): instance was not inserted")
+	}
+	if diff := cmp.Diff("zones/"+testInstanceZone+"/machineTypes/e2-small", created.MachineType); diff != "" {
+		t.Errorf("Create(...): -want machine type, +got machine type:\n%s", diff)
+	}
+	if diff := cmp.Diff(testFamilyImage, created.Disks[0].InitializeParams.SourceImage); diff != "" {
+		t.Errorf("Create(...): -want source image, +got source image:\n%s", diff)
+	}
+	if diff := cmp.Diff(&compute.ShieldedInstanceConfig{EnableSecureBoot: true}, created.ShieldedInstanceConfig); diff != "" {
+		t.Errorf("Create(...): -want shielded instance config, +got shielded instance config:\n%s", diff)
+	}
+}
+
+func TestInstanceUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		status string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotInstance": {
+			mg:   &v1beta1.Subnetwork{},
+			want: want{err: errors.New(errNotInstance)},
+		},
+		"UpToDate": {
+			status: v1alpha1.InstanceStatusRunning,
+			mg:     instanceObj(),
+		},
+		"Labels": {
+			status: v1alpha1.InstanceStatusRunning,
+			mg:     instanceObj(instanceWithLabels(map[string]string{"l": "v"})),
+			want:   want{calls: []string{"setLabels lfp"}},
+		},
+		"MachineTypeWhileRunning": {
+			status: v1alpha1.InstanceStatusRunning,
+			mg:     instanceObj(instanceWithMachineType("e2-medium")),
+			want:   want{err: errors.New(errInstanceMachineTypeLive)},
+		},
+		"MachineTypeWhileStopped": {
+			status: v1alpha1.InstanceStatusTerminated,
+			mg:     instanceObj(instanceWithMachineType("e2-medium")),
+			want:   want{calls: []string{"setMachineType zones/" + testInstanceZone + "/machineTypes/e2-medium"}},
+		},
+		"ShieldedInstanceConfigChanged": {
+			status: v1alpha1.InstanceStatusRunning,
+			mg: instanceObj(
+				instanceWithLabels(map[string]string{"l": "v"}),
+				instanceWithShieldedInstanceConfig(&v1alpha1.ShieldedInstanceConfig{EnableSecureBoot: gcp.BoolPtr(true)}),
+			),
+			want: want{err: errors.New("shieldedInstanceConfig cannot be changed on an existing VM")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				switch {
+				case r.Method == http.MethodGet:
+					_ = json.NewEncoder(w).Encode(instanceGCE(tc.status))
+					return
+				case strings.HasSuffix(r.URL.Path, "/setLabels"):
+					rq := &compute.InstancesSetLabelsRequest{}
+					_ = json.NewDecoder(r.Body).Decode(rq)
+					calls = append(calls, "setLabels "+rq.LabelFingerprint)
+				case strings.HasSuffix(r.URL.Path, "/setMetadata"):
+					rq := &compute.Metadata{}
+					_ = json.NewDecoder(r.Body).Decode(rq)
+					calls = append(calls, "setMetadata "+rq.Fingerprint)
+				case strings.HasSuffix(r.URL.Path, "/setMachineType"):
+					rq := &compute.InstancesSetMachineTypeRequest{}
+					_ = json.NewDecoder(r.Body).Decode(rq)
+					calls = append(calls, "setMachineType "+rq.MachineType)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceExternal{Service: s, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		mg     resource.Managed
+		want   error
+	}{
+		"NotInstance": {
+			mg:   &v1beta1.Subnetwork{},
+			want: errors.New(errNotInstance),
+		},
+		"Deleted": {
+			status: http.StatusOK,
+			mg:     instanceObj(),
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+			mg:     instanceObj(),
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			mg:     instanceObj(),
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteInstance),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceExternal{Service: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
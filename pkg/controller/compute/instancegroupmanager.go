@@ -0,0 +1,401 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	igm "github.com/crossplane-contrib/provider-gcp/pkg/clients/instancegroupmanager"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotInstanceGroupManager      = "managed resource is not an InstanceGroupManager"
+	errInstanceGroupManagerLocation = "exactly one of zone or region must be set"
+	errGetInstanceGroupManager      = "cannot get external InstanceGroupManager resource"
+	errCreateInstanceGroupManager   = "cannot create external InstanceGroupManager resource"
+	errUpdateInstanceGroupManager   = "cannot update external InstanceGroupManager resource"
+	errDeleteInstanceGroupManager   = "cannot delete external InstanceGroupManager resource"
+	errCheckInstanceGroupManager    = "cannot determine if external InstanceGroupManager resource is up to date"
+	errListPerInstanceConfigs       = "cannot list per-instance configs of InstanceGroupManager"
+	errUpdatePerInstanceConfigs     = "cannot update per-instance configs of InstanceGroupManager"
+	errDeletePerInstanceConfigs     = "cannot delete per-instance configs of InstanceGroupManager"
+	errListManagedInstances         = "cannot list managed instances of InstanceGroupManager"
+	errRecreatePreemptedInstances   = "cannot recreate preempted instances of InstanceGroupManager"
+)
+
+// SetupInstanceGroupManager adds a controller that reconciles
+// InstanceGroupManager managed resources.
+func SetupInstanceGroupManager(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupManagerGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceGroupManagerGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &igmConnector{kube: mgr.GetClient()}))))))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.InstanceGroupManager{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type igmConnector struct {
+	kube client.Client
+}
+
+func (c *igmConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &igmExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type igmExternal struct {
+	kube      client.Client
+	projectID string
+	*compute.Service
+}
+
+func (e *igmExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.InstanceGroupManager)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstanceGroupManager)
+	}
+
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInstanceGroupManager)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	igm.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	lateInitialized := !cmp.Equal(currentSpec, &cr.Spec.ForProvider)
+
+	var configs []*compute.PerInstanceConfig
+	if cr.Spec.ForProvider.PerInstanceConfigs != nil {
+		if configs, err = e.listPerInstanceConfigs(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errListPerInstanceConfigs)
+		}
+	}
+
+	var preempted []string
+	if cr.Spec.ForProvider.SpotTerminationPolicy != nil {
+		instances, err := e.listManagedInstances(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errListManagedInstances)
+		}
+		preempted = igm.PreemptedInstances(instances)
+	}
+
+	cr.Status.AtProvider = igm.GenerateInstanceGroupManagerObservation(*observed, configs)
+	cr.Status.AtProvider.PreemptedInstances = preempted
+	cr.SetConditions(xpv1.Available())
+
+	u, err := igm.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckInstanceGroupManager)
+	}
+	if cr.Spec.ForProvider.PerInstanceConfigs != nil {
+		upsert, remove := igm.DiffPerInstanceConfigs(igm.GeneratePerInstanceConfigs(cr.Spec.ForProvider.PerInstanceConfigs), configs)
+		u = u && len(upsert) == 0 && len(remove) == 0
+	}
+	if p := cr.Spec.ForProvider.SpotTerminationPolicy; p != nil && p.AutoRecreate {
+		u = u && len(preempted) == 0
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        u,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *igmExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.InstanceGroupManager)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstanceGroupManager)
+	}
+
+	m := &compute.InstanceGroupManager{}
+	igm.GenerateInstanceGroupManager(meta.GetExternalName(cr), cr.Spec.ForProvider, m)
+
+	var (
+		op  *compute.Operation
+		err error
+	)
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		op, err = e.InstanceGroupManagers.Insert(e.projectID, *p.Zone, m).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		op, err = e.RegionInstanceGroupManagers.Insert(e.projectID, *p.Region, m).Context(ctx).Do()
+	default:
+		return managed.ExternalCreation{}, errors.New(errInstanceGroupManagerLocation)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstanceGroupManager)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *igmExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.InstanceGroupManager)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInstanceGroupManager)
+	}
+
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetInstanceGroupManager)
+	}
+
+	u, err := igm.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckInstanceGroupManager)
+	}
+	if !u {
+		m := &compute.InstanceGroupManager{}
+		igm.GenerateInstanceGroupManager(meta.GetExternalName(cr), cr.Spec.ForProvider, m)
+		if m.StatefulPolicy == nil && observed.StatefulPolicy != nil {
+			// Removing the stateful policy requires explicitly nulling it.
+			m.NullFields = append(m.NullFields, "StatefulPolicy")
+		}
+		if len(m.AutoHealingPolicies) == 0 && len(observed.AutoHealingPolicies) > 0 {
+			// Likewise removing autohealing requires explicitly sending
+			// an empty list of policies.
+			m.AutoHealingPolicies = []*compute.InstanceGroupManagerAutoHealingPolicy{}
+			m.ForceSendFields = append(m.ForceSendFields, "AutoHealingPolicies")
+		}
+		if err := e.patch(ctx, cr, m); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstanceGroupManager)
+		}
+	}
+
+	if p := cr.Spec.ForProvider.SpotTerminationPolicy; p != nil && p.AutoRecreate && len(cr.Status.AtProvider.PreemptedInstances) > 0 {
+		if err := e.recreateInstances(ctx, cr, cr.Status.AtProvider.PreemptedInstances); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRecreatePreemptedInstances)
+		}
+	}
+
+	if cr.Spec.ForProvider.PerInstanceConfigs == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	configs, err := e.listPerInstanceConfigs(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListPerInstanceConfigs)
+	}
+	upsert, remove := igm.DiffPerInstanceConfigs(igm.GeneratePerInstanceConfigs(cr.Spec.ForProvider.PerInstanceConfigs), configs)
+	if len(remove) > 0 {
+		if err := e.deletePerInstanceConfigs(ctx, cr, remove); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeletePerInstanceConfigs)
+		}
+	}
+	if len(upsert) > 0 {
+		if err := e.updatePerInstanceConfigs(ctx, cr, upsert); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePerInstanceConfigs)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *igmExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.InstanceGroupManager)
+	if !ok {
+		return errors.New(errNotInstanceGroupManager)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	var (
+		op  *compute.Operation
+		err error
+	)
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		op, err = e.InstanceGroupManagers.Delete(e.projectID, *p.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		op, err = e.RegionInstanceGroupManagers.Delete(e.projectID, *p.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	default:
+		return errors.New(errInstanceGroupManagerLocation)
+	}
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstanceGroupManager)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+func (e *igmExternal) get(ctx context.Context, cr *v1alpha1.InstanceGroupManager) (*compute.InstanceGroupManager, error) {
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		return e.InstanceGroupManagers.Get(e.projectID, *p.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		return e.RegionInstanceGroupManagers.Get(e.projectID, *p.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	default:
+		return nil, errors.New(errInstanceGroupManagerLocation)
+	}
+}
+
+func (e *igmExternal) patch(ctx context.Context, cr *v1alpha1.InstanceGroupManager, m *compute.InstanceGroupManager) error {
+	var (
+		op  *compute.Operation
+		err error
+	)
+	if p := cr.Spec.ForProvider; p.Zone != nil {
+		op, err = e.InstanceGroupManagers.Patch(e.projectID, *p.Zone, meta.GetExternalName(cr), m).Context(ctx).Do()
+	} else {
+		op, err = e.RegionInstanceGroupManagers.Patch(e.projectID, gcp.StringValue(p.Region), meta.GetExternalName(cr), m).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+func (e *igmExternal) listPerInstanceConfigs(ctx context.Context, cr *v1alpha1.InstanceGroupManager) ([]*compute.PerInstanceConfig, error) {
+	var configs []*compute.PerInstanceConfig
+	if p := cr.Spec.ForProvider; p.Zone != nil {
+		err := e.InstanceGroupManagers.ListPerInstanceConfigs(e.projectID, *p.Zone, meta.GetExternalName(cr)).
+			Pages(ctx, func(page *compute.InstanceGroupManagersListPerInstanceConfigsResp) error {
+				configs = append(configs, page.Items...)
+				return nil
+			})
+		return configs, err
+	}
+	err := e.RegionInstanceGroupManagers.ListPerInstanceConfigs(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Region), meta.GetExternalName(cr)).
+		Pages(ctx, func(page *compute.RegionInstanceGroupManagersListInstanceConfigsResp) error {
+			configs = append(configs, page.Items...)
+			return nil
+		})
+	return configs, err
+}
+
+func (e *igmExternal) listManagedInstances(ctx context.Context, cr *v1alpha1.InstanceGroupManager) ([]*compute.ManagedInstance, error) {
+	var instances []*compute.ManagedInstance
+	if p := cr.Spec.ForProvider; p.Zone != nil {
+		err := e.InstanceGroupManagers.ListManagedInstances(e.projectID, *p.Zone, meta.GetExternalName(cr)).
+			Pages(ctx, func(page *compute.InstanceGroupManagersListManagedInstancesResponse) error {
+				instances = append(instances, page.ManagedInstances...)
+				return nil
+			})
+		return instances, err
+	}
+	err := e.RegionInstanceGroupManagers.ListManagedInstances(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Region), meta.GetExternalName(cr)).
+		Pages(ctx, func(page *compute.RegionInstanceGroupManagersListInstancesResponse) error {
+			instances = append(instances, page.ManagedInstances...)
+			return nil
+		})
+	return instances, err
+}
+
+func (e *igmExternal) recreateInstances(ctx context.Context, cr *v1alpha1.InstanceGroupManager, instances []string) error {
+	var (
+		op  *compute.Operation
+		err error
+	)
+	if p := cr.Spec.ForProvider; p.Zone != nil {
+		req := &compute.InstanceGroupManagersRecreateInstancesRequest{Instances: instances}
+		op, err = e.InstanceGroupManagers.RecreateInstances(e.projectID, *p.Zone, meta.GetExternalName(cr), req).Context(ctx).Do()
+	} else {
+		req := &compute.RegionInstanceGroupManagersRecreateRequest{Instances: instances}
+		op, err = e.RegionInstanceGroupManagers.RecreateInstances(e.projectID, gcp.StringValue(p.Region), meta.GetExternalName(cr), req).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+func (e *igmExternal) updatePerInstanceConfigs(ctx context.Context, cr *v1alpha1.InstanceGroupManager, configs []*compute.PerInstanceConfig) error {
+	var (
+		op  *compute.Operation
+		err error
+	)
+	if p := cr.Spec.ForProvider; p.Zone != nil {
+		req := &compute.InstanceGroupManagersUpdatePerInstanceConfigsReq{PerInstanceConfigs: configs}
+		op, err = e.InstanceGroupManagers.UpdatePerInstanceConfigs(e.projectID, *p.Zone, meta.GetExternalName(cr), req).Context(ctx).Do()
+	} else {
+		req := &compute.RegionInstanceGroupManagerUpdateInstanceConfigReq{PerInstanceConfigs: configs}
+		op, err = e.RegionInstanceGroupManagers.UpdatePerInstanceConfigs(e.projectID, gcp.StringValue(p.Region), meta.GetExternalName(cr), req).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+func (e *igmExternal) deletePerInstanceConfigs(ctx context.Context, cr *v1alpha1.InstanceGroupManager, names []string) error {
+	var (
+		op  *compute.Operation
+		err error
+	)
+	if p := cr.Spec.ForProvider; p.Zone != nil {
+		req := &compute.InstanceGroupManagersDeletePerInstanceConfigsReq{Names: names}
+		op, err = e.InstanceGroupManagers.DeletePerInstanceConfigs(e.projectID, *p.Zone, meta.GetExternalName(cr), req).Context(ctx).Do()
+	} else {
+		req := &compute.RegionInstanceGroupManagerDeleteInstanceConfigReq{Names: names}
+		op, err = e.RegionInstanceGroupManagers.DeletePerInstanceConfigs(e.projectID, gcp.StringValue(p.Region), meta.GetExternalName(cr), req).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/instancetemplate"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotInstanceTemplate      = "managed resource is not an InstanceTemplate"
+	errGetInstanceTemplate      = "cannot get external InstanceTemplate resource"
+	errCreateInstanceTemplate   = "cannot create external InstanceTemplate resource"
+	errDeleteInstanceTemplate   = "cannot delete external InstanceTemplate resource"
+	errResolveSourceImageFamily = "cannot resolve the latest image of image family %s of disk %d"
+)
+
+// SetupInstanceTemplate adds a controller that reconciles InstanceTemplate
+// managed resources.
+func SetupInstanceTemplate(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.InstanceTemplateGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.InstanceTemplateGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &instanceTemplateConnector{kube: mgr.GetClient()}))))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.InstanceTemplate{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type instanceTemplateConnector struct {
+	kube client.Client
+}
+
+func (c *instanceTemplateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &instanceTemplateExternal{Service: s, projectID: projectID}, nil
+}
+
+type instanceTemplateExternal struct {
+	*compute.Service
+	projectID string
+}
+
+// Observe never reports an instance template as outdated, as instance
+// templates cannot be changed once created. Fields changed after creation
+// are instead reported by the UpToDate condition, so that the change can be
+// rolled out by creating a new template. A template is not recreated when a
+// new image is added to the image family of one of its disks; the image the
+// family was resolved to is reported in its status.
+func (e *instanceTemplateExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.InstanceTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstanceTemplate)
+	}
+	observed, err := e.InstanceTemplates.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInstanceTemplate)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	instancetemplate.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = instancetemplate.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+	if changed := instancetemplate.Changed(cr.Spec.ForProvider, *observed); len(changed) > 0 {
+		cr.SetConditions(instancetemplate.ReplacementRequired(changed))
+	} else {
+		cr.SetConditions(instancetemplate.UpToDate())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// Create resolves the source image families of the disks to their latest
+// images that are not deprecated, and creates the instance template from
+// them.
+func (e *instanceTemplateExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.InstanceTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstanceTemplate)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	families := instancetemplate.SourceImageFamilies(cr.Spec.ForProvider)
+	images := make([]string, len(families))
+	for i, f := range families {
+		if f == "" {
+			continue
+		}
+		project, family := instancetemplate.ImageFamily(e.projectID, f)
+		image, err := e.Images.GetFromFamily(project, family).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrapf(err, errResolveSourceImageFamily, f, i)
+		}
+		images[i] = image.SelfLink
+	}
+
+	op, err := e.InstanceTemplates.Insert(e.projectID, instancetemplate.GenerateInstanceTemplate(meta.GetExternalName(cr), cr.Spec.ForProvider, images)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstanceTemplate)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update is a no-op, as instance templates cannot be changed once created.
+func (e *instanceTemplateExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *instanceTemplateExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.InstanceTemplate)
+	if !ok {
+		return errors.New(errNotInstanceTemplate)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	op, err := e.InstanceTemplates.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstanceTemplate)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/instancetemplate"
+)
+
+var _ managed.ExternalConnecter = &instanceTemplateConnector{}
+var _ managed.ExternalClient = &instanceTemplateExternal{}
+
+const (
+	testInstanceTemplateName = "test-template"
+	testImageFamily          = "test-family"
+	testFamilyImage          = "https://www.googleapis.com/compute/v1/projects/myproject-id-1234/global/images/test-family-v2"
+)
+
+type instanceTemplateModifier func(*v1alpha1.InstanceTemplate)
+
+func instanceTemplateWithConditions(c ...xpv1.Condition) instanceTemplateModifier {
+	return func(i *v1alpha1.InstanceTemplate) { i.Status.SetConditions(c...) }
+}
+
+func instanceTemplateWithObservation(o v1alpha1.InstanceTemplateObservation) instanceTemplateModifier {
+	return func(i *v1alpha1.InstanceTemplate) { i.Status.AtProvider = o }
+}
+
+func instanceTemplateWithShieldedInstanceConfig(c *v1alpha1.ShieldedInstanceConfig) instanceTemplateModifier {
+	return func(i *v1alpha1.InstanceTemplate) { i.Spec.ForProvider.ShieldedInstanceConfig = c }
+}
+
+func instanceTemplateObj(im ...instanceTemplateModifier) *v1alpha1.InstanceTemplate {
+	i := &v1alpha1.InstanceTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testInstanceTemplateName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testInstanceTemplateName,
+			},
+		},
+		Spec: v1alpha1.InstanceTemplateSpec{
+			ForProvider: v1alpha1.InstanceTemplateParameters{
+				MachineType: "e2-small",
+				Disks: []v1alpha1.AttachedDisk{
+					{Boot: gcp.BoolPtr(true), SourceImageFamily: gcp.StringPtr(testImageFamily)},
+					{SourceImage: gcp.StringPtr("projects/debian-cloud/global/images/debian-12")},
+				},
+				NetworkInterfaces: []v1alpha1.NetworkInterface{{Network: gcp.StringPtr("global/networks/default")}},
+			},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func observedInstanceTemplate() *compute.InstanceTemplate {
+	return &compute.InstanceTemplate{
+		Id:       1,
+		SelfLink: "self",
+		Properties: &compute.InstanceProperties{
+			MachineType: "e2-small",
+			Disks: []*compute.AttachedDisk{
+				{Boot: true, DeviceName: "persistent-disk-0", InitializeParams: &compute.AttachedDiskInitializeParams{SourceImage: testFamilyImage}},
+				{DeviceName: "persistent-disk-1", InitializeParams: &compute.AttachedDiskInitializeParams{SourceImage: "https://www.googleapis.com/compute/v1/projects/debian-cloud/global/images/debian-12"}},
+			},
+			NetworkInterfaces: []*compute.NetworkInterface{{Network: "https://www.googleapis.com/compute/v1/projects/myproject-id-1234/global/networks/default"}},
+		},
+	}
+}
+
+func TestInstanceTemplateObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	observation := v1alpha1.InstanceTemplateObservation{
+		ID:       1,
+		SelfLink: "self",
+		Disks: []v1alpha1.AttachedDiskObservation{
+			{DeviceName: "persistent-disk-0", SourceImage: testFamilyImage},
+			{DeviceName: "persistent-disk-1", SourceImage: "https://www.googleapis.com/compute/v1/projects/debian-cloud/global/images/debian-12"},
+		},
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotInstanceTemplate": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotInstanceTemplate),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg:   instanceTemplateObj(),
+			want: want{mg: instanceTemplateObj()},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.InstanceTemplate{})
+			}),
+			mg: instanceTemplateObj(),
+			want: want{
+				mg:  instanceTemplateObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetInstanceTemplate),
+			},
+		},
+		"Exists": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedInstanceTemplate())
+			}),
+			mg: instanceTemplateObj(),
+			want: want{
+				mg: instanceTemplateObj(
+					instanceTemplateWithConditions(xpv1.Available(), instancetemplate.UpToDate()),
+					instanceTemplateWithObservation(v1alpha1.InstanceTemplateObservation{
+						ID:       1,
+						SelfLink: "self",
+						Disks: []v1alpha1.AttachedDiskObservation{
+							{DeviceName: "persistent-disk-0", SourceImage: testFamilyImage},
+							{DeviceName: "persistent-disk-1", SourceImage: "https://www.googleapis.com/compute/v1/projects/debian-cloud/global/images/debian-12"},
+						},
+					}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ReplacementRequired": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				it := observedInstanceTemplate()
+				it.Properties.MachineType = "e2-medium"
+				_ = json.NewEncoder(w).Encode(it)
+			}),
+			mg: instanceTemplateObj(),
+			want: want{
+				mg: instanceTemplateObj(
+					instanceTemplateWithConditions(xpv1.Available(), instancetemplate.ReplacementRequired([]string{"machineType"})),
+					instanceTemplateWithObservation(v1alpha1.InstanceTemplateObservation{
+						ID:       1,
+						SelfLink: "self",
+						Disks: []v1alpha1.AttachedDiskObservation{
+							{DeviceName: "persistent-disk-0", SourceImage: testFamilyImage},
+							{DeviceName: "persistent-disk-1", SourceImage: "https://www.googleapis.com/compute/v1/projects/debian-cloud/global/images/debian-12"},
+						},
+					}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ShieldedInstanceConfigLateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				it := observedInstanceTemplate()
+				it.Properties.ShieldedInstanceConfig = &compute.ShieldedInstanceConfig{EnableSecureBoot: true}
+				_ = json.NewEncoder(w).Encode(it)
+			}),
+			mg: instanceTemplateObj(),
+			want: want{
+				mg: instanceTemplateObj(
+					instanceTemplateWithShieldedInstanceConfig(&v1alpha1.ShieldedInstanceConfig{EnableSecureBoot: gcp.BoolPtr(true)}),
+					instanceTemplateWithConditions(xpv1.Available(), instancetemplate.UpToDate()),
+					instanceTemplateWithObservation(observation),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"ShieldedInstanceConfigReplacementRequired": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedInstanceTemplate())
+			}),
+			mg: instanceTemplateObj(instanceTemplateWithShieldedInstanceConfig(&v1alpha1.ShieldedInstanceConfig{EnableSecureBoot: gcp.BoolPtr(true)})),
+			want: want{
+				mg: instanceTemplateObj(
+					instanceTemplateWithShieldedInstanceConfig(&v1alpha1.ShieldedInstanceConfig{EnableSecureBoot: gcp.BoolPtr(true)}),
+					instanceTemplateWithConditions(xpv1.Available(), instancetemplate.ReplacementRequired([]string{"shieldedInstanceConfig"})),
+					instanceTemplateWithObservation(observation),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceTemplateExternal{Service: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceTemplateCreate(t *testing.T) {
+	type want struct {
+		images []string
+		err    error
+	}
+
+	cases := map[string]struct {
+		family int
+		mg     resource.Managed
+		want   want
+	}{
+		"NotInstanceTemplate": {
+			mg:   &v1beta1.Subnetwork{},
+			want: want{err: errors.New(errNotInstanceTemplate)},
+		},
+		"FamilyNotFound": {
+			family: http.StatusNotFound,
+			mg:     instanceTemplateObj(),
+			want:   want{err: errors.Wrapf(gError(http.StatusNotFound, ""), errResolveSourceImageFamily, testImageFamily, 0)},
+		},
+		"Created": {
+			family: http.StatusOK,
+			mg:     instanceTemplateObj(),
+			want: want{images: []string{
+				testFamilyImage,
+				"projects/debian-cloud/global/images/debian-12",
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var images []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/projects/"+projectID+"/global/images/family/"+testImageFamily):
+					w.WriteHeader(tc.family)
+					if tc.family != http.StatusOK {
+						_ = json.NewEncoder(w).Encode(&compute.Image{})
+						return
+					}
+					_ = json.NewEncoder(w).Encode(&compute.Image{SelfLink: testFamilyImage})
+				case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/instanceTemplates"):
+					it := &compute.InstanceTemplate{}
+					_ = json.NewDecoder(r.Body).Decode(it)
+					for _, d := range it.Properties.Disks {
+						images = append(images, d.InitializeParams.SourceImage)
+					}
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceTemplateExternal{Service: s, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.images, images); diff != "" {
+				t.Errorf("Create(...): -want source images, +got source images:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceTemplateDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		mg     resource.Managed
+		want   error
+	}{
+		"NotInstanceTemplate": {
+			mg:   &v1beta1.Subnetwork{},
+			want: errors.New(errNotInstanceTemplate),
+		},
+		"Deleted": {
+			status: http.StatusOK,
+			mg:     instanceTemplateObj(),
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+			mg:     instanceTemplateObj(),
+		},
+		"DeleteFailed": {
+			status: http.StatusBadRequest,
+			mg:     instanceTemplateObj(),
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteInstanceTemplate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceTemplateExternal{Service: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
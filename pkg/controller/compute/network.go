@@ -37,7 +37,12 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/network"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -66,7 +71,7 @@ func SetupNetwork(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.NetworkGroupVersionKind),
-		managed.WithExternalConnecter(&networkConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &networkConnector{kube: mgr.GetClient()}))))),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -75,7 +80,7 @@ func SetupNetwork(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1beta1.Network{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -145,10 +150,14 @@ func (c *networkExternal) Create(ctx context.Context, mg resource.Managed) (mana
 
 	net := &compute.Network{}
 	network.GenerateNetwork(meta.GetExternalName(cr), cr.Spec.ForProvider, net)
-	_, err := c.Networks.Insert(c.projectID, net).
+	op, err := c.Networks.Insert(c.projectID, net).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errNetworkCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errNetworkCreateFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -170,8 +179,12 @@ func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalUpdate{}, nil
 	}
 	if switchToCustom {
-		_, err := c.Networks.SwitchToCustomMode(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
-		return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+		op, err := c.Networks.SwitchToCustomMode(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+		}
+		audit.RecordOperation(ctx, op.Name)
+		return managed.ExternalUpdate{}, nil
 	}
 
 	net := &compute.Network{}
@@ -179,10 +192,14 @@ func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (mana
 
 	// NOTE(muvaf): All parameters except routing config are
 	// immutable.
-	_, err = c.Networks.Patch(c.projectID, meta.GetExternalName(cr), net).
+	op, err := c.Networks.Patch(c.projectID, meta.GetExternalName(cr), net).
 		Context(ctx).
 		Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *networkExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -192,8 +209,12 @@ func (c *networkExternal) Delete(ctx context.Context, mg resource.Managed) error
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Networks.Delete(c.projectID, meta.GetExternalName(cr)).
+	op, err := c.Networks.Delete(c.projectID, meta.GetExternalName(cr)).
 		Context(ctx).
 		Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errNetworkDeleteFailed)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errNetworkDeleteFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
 }
@@ -0,0 +1,272 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	neg "github.com/crossplane-contrib/provider-gcp/pkg/clients/networkendpointgroup"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotNetworkEndpointGroup       = "managed resource is not a NetworkEndpointGroup"
+	errNetworkEndpointGroupLocation  = "exactly one of zone or region must be set"
+	errGetNetworkEndpointGroup       = "cannot get external NetworkEndpointGroup resource"
+	errCreateNetworkEndpointGroup    = "cannot create external NetworkEndpointGroup resource"
+	errDeleteNetworkEndpointGroup    = "cannot delete external NetworkEndpointGroup resource"
+	errListNetworkEndpoints          = "cannot list network endpoints of NetworkEndpointGroup"
+	errAttachNetworkEndpoints        = "cannot attach network endpoints to NetworkEndpointGroup"
+	errDetachNetworkEndpoints        = "cannot detach network endpoints from NetworkEndpointGroup"
+	errRegionalNetworkEndpointGroups = "network endpoints can only be managed on zonal NetworkEndpointGroups"
+)
+
+// SetupNetworkEndpointGroup adds a controller that reconciles
+// NetworkEndpointGroup managed resources.
+func SetupNetworkEndpointGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.NetworkEndpointGroupGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.NetworkEndpointGroupGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &negConnector{kube: mgr.GetClient()}))))))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.NetworkEndpointGroup{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type negConnector struct {
+	kube client.Client
+}
+
+func (c *negConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &negExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type negExternal struct {
+	kube      client.Client
+	projectID string
+	*compute.Service
+}
+
+func (e *negExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NetworkEndpointGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNetworkEndpointGroup)
+	}
+
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNetworkEndpointGroup)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	neg.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	lateInitialized := !cmp.Equal(currentSpec, &cr.Spec.ForProvider)
+
+	cr.Status.AtProvider = neg.GenerateNetworkEndpointGroupObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	// Network endpoint groups themselves are immutable; only the attached
+	// endpoints of a zonal group can drift.
+	attach, detach, err := e.diffEndpoints(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        len(attach) == 0 && len(detach) == 0,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *negExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NetworkEndpointGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNetworkEndpointGroup)
+	}
+
+	n := &compute.NetworkEndpointGroup{}
+	neg.GenerateNetworkEndpointGroup(meta.GetExternalName(cr), cr.Spec.ForProvider, n)
+
+	var (
+		op  *compute.Operation
+		err error
+	)
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		op, err = e.NetworkEndpointGroups.Insert(e.projectID, *p.Zone, n).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		op, err = e.RegionNetworkEndpointGroups.Insert(e.projectID, *p.Region, n).Context(ctx).Do()
+	default:
+		return managed.ExternalCreation{}, errors.New(errNetworkEndpointGroupLocation)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNetworkEndpointGroup)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *negExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NetworkEndpointGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNetworkEndpointGroup)
+	}
+
+	attach, detach, err := e.diffEndpoints(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if len(attach) == 0 && len(detach) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	if cr.Spec.ForProvider.Zone == nil {
+		return managed.ExternalUpdate{}, errors.New(errRegionalNetworkEndpointGroups)
+	}
+
+	zone, name := *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)
+	if len(detach) > 0 {
+		req := &compute.NetworkEndpointGroupsDetachEndpointsRequest{NetworkEndpoints: detach}
+		op, err := e.NetworkEndpointGroups.DetachNetworkEndpoints(e.projectID, zone, name, req).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDetachNetworkEndpoints)
+		}
+		audit.RecordOperation(ctx, op.Name)
+	}
+	if len(attach) > 0 {
+		req := &compute.NetworkEndpointGroupsAttachEndpointsRequest{NetworkEndpoints: attach}
+		op, err := e.NetworkEndpointGroups.AttachNetworkEndpoints(e.projectID, zone, name, req).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttachNetworkEndpoints)
+		}
+		audit.RecordOperation(ctx, op.Name)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *negExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NetworkEndpointGroup)
+	if !ok {
+		return errors.New(errNotNetworkEndpointGroup)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	var (
+		op  *compute.Operation
+		err error
+	)
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		op, err = e.NetworkEndpointGroups.Delete(e.projectID, *p.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		op, err = e.RegionNetworkEndpointGroups.Delete(e.projectID, *p.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	default:
+		return errors.New(errNetworkEndpointGroupLocation)
+	}
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNetworkEndpointGroup)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+func (e *negExternal) get(ctx context.Context, cr *v1alpha1.NetworkEndpointGroup) (*compute.NetworkEndpointGroup, error) {
+	switch p := cr.Spec.ForProvider; {
+	case p.Zone != nil && p.Region == nil:
+		return e.NetworkEndpointGroups.Get(e.projectID, *p.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	case p.Region != nil && p.Zone == nil:
+		return e.RegionNetworkEndpointGroups.Get(e.projectID, *p.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	default:
+		return nil, errors.New(errNetworkEndpointGroupLocation)
+	}
+}
+
+// diffEndpoints returns the endpoints that must be attached and detached for
+// the supplied group to match its spec. Endpoints are left alone when the spec
+// does not declare any.
+func (e *negExternal) diffEndpoints(ctx context.Context, cr *v1alpha1.NetworkEndpointGroup) (attach, detach []*compute.NetworkEndpoint, err error) {
+	if cr.Spec.ForProvider.NetworkEndpoints == nil {
+		return nil, nil, nil
+	}
+	if cr.Spec.ForProvider.Zone == nil {
+		return nil, nil, errors.New(errRegionalNetworkEndpointGroups)
+	}
+
+	var observed []*compute.NetworkEndpoint
+	err = e.NetworkEndpointGroups.ListNetworkEndpoints(e.projectID, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr), &compute.NetworkEndpointGroupsListEndpointsRequest{}).
+		Pages(ctx, func(page *compute.NetworkEndpointGroupsListNetworkEndpoints) error {
+			for _, item := range page.Items {
+				if item.NetworkEndpoint != nil {
+					observed = append(observed, item.NetworkEndpoint)
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errListNetworkEndpoints)
+	}
+
+	attach, detach = neg.DiffNetworkEndpoints(gcp.Int64Value(cr.Spec.ForProvider.DefaultPort), neg.GenerateNetworkEndpoints(cr.Spec.ForProvider.NetworkEndpoints), observed)
+	return attach, detach, nil
+}
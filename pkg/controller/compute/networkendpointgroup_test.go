@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &negConnector{}
+var _ managed.ExternalClient = &negExternal{}
+
+const testNEGName = "test-neg"
+
+type negModifier func(*v1alpha1.NetworkEndpointGroup)
+
+func negWithConditions(c ...xpv1.Condition) negModifier {
+	return func(i *v1alpha1.NetworkEndpointGroup) { i.Status.SetConditions(c...) }
+}
+
+func negWithEndpoints(eps ...v1alpha1.NetworkEndpoint) negModifier {
+	return func(i *v1alpha1.NetworkEndpointGroup) { i.Spec.ForProvider.NetworkEndpoints = eps }
+}
+
+func negObj(im ...negModifier) *v1alpha1.NetworkEndpointGroup {
+	i := &v1alpha1.NetworkEndpointGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testNEGName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testNEGName,
+			},
+		},
+		Spec: v1alpha1.NetworkEndpointGroupSpec{
+			ForProvider: v1alpha1.NetworkEndpointGroupParameters{
+				Zone:                gcp.StringPtr("us-central1-a"),
+				NetworkEndpointType: v1alpha1.NetworkEndpointTypeNonGCPPrivateIPPort,
+				DefaultPort:         gcp.Int64Ptr(443),
+				Network:             gcp.StringPtr("global/networks/default"),
+			},
+		},
+	}
+	for _, m := range im {
+		m(i)
+	}
+	return i
+}
+
+func TestNetworkEndpointGroupObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotNetworkEndpointGroup": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotNetworkEndpointGroup),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.NetworkEndpointGroup{})
+			}),
+			mg: negObj(),
+			want: want{
+				mg: negObj(),
+			},
+		},
+		"UpToDateWithoutEndpoints": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.NetworkEndpointGroup{Name: testNEGName, DefaultPort: 443, Network: "global/networks/default"})
+			}),
+			mg: negObj(),
+			want: want{
+				mg:  negObj(negWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"EndpointsNeedAttaching": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, "/listNetworkEndpoints") {
+					_ = json.NewEncoder(w).Encode(&compute.NetworkEndpointGroupsListNetworkEndpoints{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&compute.NetworkEndpointGroup{Name: testNEGName, DefaultPort: 443, Network: "global/networks/default"})
+			}),
+			mg: negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{IPAddress: gcp.StringPtr("10.0.0.1")})),
+			want: want{
+				mg:  negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{IPAddress: gcp.StringPtr("10.0.0.1")}), negWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := negExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNetworkEndpointGroupCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: negObj(),
+		},
+		"NoLocation": {
+			mg:  negObj(func(n *v1alpha1.NetworkEndpointGroup) { n.Spec.ForProvider.Zone = nil }),
+			err: errors.New(errNetworkEndpointGroupLocation),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := negExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
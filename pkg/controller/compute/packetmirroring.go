@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/packetmirroring"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotPacketMirroring    = "managed resource is not a PacketMirroring"
+	errGetPacketMirroring    = "cannot get external PacketMirroring resource"
+	errCreatePacketMirroring = "cannot create external PacketMirroring resource"
+	errUpdatePacketMirroring = "cannot update external PacketMirroring resource"
+	errDeletePacketMirroring = "cannot delete external PacketMirroring resource"
+	errCheckPacketMirroring  = "cannot determine if external PacketMirroring resource is up to date"
+)
+
+// SetupPacketMirroring adds a controller that reconciles PacketMirroring
+// managed resources.
+func SetupPacketMirroring(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PacketMirroringGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PacketMirroringGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &packetMirroringConnector{kube: mgr.GetClient()}))))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.PacketMirroring{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type packetMirroringConnector struct {
+	kube client.Client
+}
+
+func (c *packetMirroringConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &packetMirroringExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type packetMirroringExternal struct {
+	kube      client.Client
+	projectID string
+	*compute.Service
+}
+
+func (e *packetMirroringExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPacketMirroring)
+	}
+
+	observed, err := e.PacketMirrorings.Get(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPacketMirroring)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	packetmirroring.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = packetmirroring.GeneratePacketMirroringObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	u, err := packetmirroring.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckPacketMirroring)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        u,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *packetMirroringExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPacketMirroring)
+	}
+
+	pm := &compute.PacketMirroring{}
+	packetmirroring.GeneratePacketMirroring(meta.GetExternalName(cr), cr.Spec.ForProvider, pm)
+	op, err := e.PacketMirrorings.Insert(e.projectID, cr.Spec.ForProvider.Region, pm).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePacketMirroring)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *packetMirroringExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPacketMirroring)
+	}
+
+	pm := &compute.PacketMirroring{}
+	packetmirroring.GeneratePacketMirroring(meta.GetExternalName(cr), cr.Spec.ForProvider, pm)
+	op, err := e.PacketMirrorings.Patch(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), pm).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePacketMirroring)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *packetMirroringExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return errors.New(errNotPacketMirroring)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	op, err := e.PacketMirrorings.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeletePacketMirroring)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
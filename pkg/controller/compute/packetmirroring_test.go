@@ -0,0 +1,342 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &packetMirroringConnector{}
+var _ managed.ExternalClient = &packetMirroringExternal{}
+
+const (
+	testPacketMirroringName      = "test-mirroring"
+	testPacketMirroringNetwork   = "projects/myproject-id-1234/global/networks/test-network"
+	testPacketMirroringCollector = "projects/myproject-id-1234/regions/us-central1/forwardingRules/ids-ilb"
+	testPacketMirroringSubnet    = "projects/myproject-id-1234/regions/us-central1/subnetworks/test-subnet"
+)
+
+type packetMirroringModifier func(*v1alpha1.PacketMirroring)
+
+func packetMirroringObj(m ...packetMirroringModifier) *v1alpha1.PacketMirroring {
+	i := &v1alpha1.PacketMirroring{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testPacketMirroringName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testPacketMirroringName,
+			},
+		},
+		Spec: v1alpha1.PacketMirroringSpec{
+			ForProvider: v1alpha1.PacketMirroringParameters{
+				Region:       "us-central1",
+				Network:      gcp.StringPtr(testPacketMirroringNetwork),
+				CollectorILB: gcp.StringPtr(testPacketMirroringCollector),
+				MirroredResources: v1alpha1.PacketMirroringMirroredResources{
+					Subnetworks: []string{testPacketMirroringSubnet},
+				},
+				Enable:   gcp.BoolPtr(true),
+				Priority: gcp.Int64Ptr(1000),
+				Filter:   &v1alpha1.PacketMirroringFilter{Direction: gcp.StringPtr("BOTH")},
+			},
+		},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func packetMirroringResponse(enable string) *compute.PacketMirroring {
+	return &compute.PacketMirroring{
+		Name:         testPacketMirroringName,
+		Region:       v1beta1.ComputeURIPrefix + "projects/myproject-id-1234/regions/us-central1",
+		SelfLink:     v1beta1.ComputeURIPrefix + "projects/myproject-id-1234/regions/us-central1/packetMirrorings/" + testPacketMirroringName,
+		Network:      &compute.PacketMirroringNetworkInfo{Url: v1beta1.ComputeURIPrefix + testPacketMirroringNetwork, CanonicalUrl: "https://www.googleapis.com/compute/v1/projects/1234/global/networks/5678"},
+		CollectorIlb: &compute.PacketMirroringForwardingRuleInfo{Url: v1beta1.ComputeURIPrefix + testPacketMirroringCollector},
+		MirroredResources: &compute.PacketMirroringMirroredResourceInfo{
+			Subnetworks: []*compute.PacketMirroringMirroredResourceInfoSubnetInfo{{Url: v1beta1.ComputeURIPrefix + testPacketMirroringSubnet}},
+		},
+		Enable:   enable,
+		Priority: 1000,
+		Filter:   &compute.PacketMirroringFilter{Direction: "BOTH"},
+	}
+}
+
+func TestPacketMirroringObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPacketMirroring": {
+			mg:   &v1beta1.Network{},
+			want: want{err: errors.New(errNotPacketMirroring)},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.PacketMirroring{})
+			}),
+			mg: packetMirroringObj(),
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/regions/us-central1/packetMirrorings/"+testPacketMirroringName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(packetMirroringResponse("TRUE"))
+			}),
+			mg:   packetMirroringObj(),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(packetMirroringResponse("FALSE"))
+			}),
+			mg:   packetMirroringObj(),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(packetMirroringResponse("TRUE"))
+			}),
+			mg: packetMirroringObj(func(i *v1alpha1.PacketMirroring) {
+				i.Spec.ForProvider.Enable = nil
+				i.Spec.ForProvider.Priority = nil
+				i.Spec.ForProvider.Filter = nil
+			}),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.PacketMirroring{})
+			}),
+			mg:   packetMirroringObj(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPacketMirroring)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := packetMirroringExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if obs.ResourceExists {
+				if diff := cmp.Diff(xpv1.Available(), tc.mg.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPacketMirroringCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/projects/"+projectID+"/regions/us-central1/packetMirrorings", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				got := &compute.PacketMirroring{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(testPacketMirroringCollector, got.CollectorIlb.Url); diff != "" {
+					t.Errorf("collectorIlb: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: packetMirroringObj(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  packetMirroringObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreatePacketMirroring),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := packetMirroringExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPacketMirroringUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := map[string]interface{}{}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Error(err)
+				}
+				// Mirrored resources are sent in full so that removed
+				// entries are cleared.
+				want := map[string]interface{}{
+					"instances":   []interface{}{},
+					"subnetworks": []interface{}{map[string]interface{}{"url": testPacketMirroringSubnet}},
+					"tags":        []interface{}{},
+				}
+				if diff := cmp.Diff(want, got["mirroredResources"]); diff != "" {
+					t.Errorf("mirroredResources: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: packetMirroringObj(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  packetMirroringObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdatePacketMirroring),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := packetMirroringExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPacketMirroringDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: packetMirroringObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: packetMirroringObj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := packetMirroringExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/policybasedroute"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNewNetworkConnectivityClient = "cannot create new Network Connectivity API client"
+	errNotPolicyBasedRoute          = "managed resource is not a PolicyBasedRoute"
+	errGetPolicyBasedRoute          = "cannot get external PolicyBasedRoute resource"
+	errCreatePolicyBasedRoute       = "cannot create external PolicyBasedRoute resource"
+	errDeletePolicyBasedRoute       = "cannot delete external PolicyBasedRoute resource"
+)
+
+// SetupPolicyBasedRoute adds a controller that reconciles PolicyBasedRoute
+// managed resources.
+func SetupPolicyBasedRoute(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PolicyBasedRouteGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PolicyBasedRouteGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &pbrConnector{kube: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.PolicyBasedRoute{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type pbrConnector struct {
+	kube client.Client
+}
+
+func (c *pbrConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := networkconnectivity.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewNetworkConnectivityClient)
+	}
+	return &pbrExternal{routes: networkconnectivity.NewProjectsLocationsGlobalPolicyBasedRoutesService(s), projectID: projectID}, nil
+}
+
+type pbrExternal struct {
+	routes    policybasedroute.Client
+	projectID string
+}
+
+func (e *pbrExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PolicyBasedRoute)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicyBasedRoute)
+	}
+
+	observed, err := e.routes.Get(policybasedroute.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicyBasedRoute)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	policybasedroute.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = policybasedroute.GeneratePolicyBasedRouteObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	// Policy-based routes cannot be updated; every field of the spec is
+	// immutable.
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *pbrExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PolicyBasedRoute)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPolicyBasedRoute)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	r := &networkconnectivity.PolicyBasedRoute{}
+	policybasedroute.GeneratePolicyBasedRoute(cr.Spec.ForProvider, r)
+	op, err := e.routes.Create(policybasedroute.GetParent(e.projectID), r).
+		PolicyBasedRouteId(meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePolicyBasedRoute)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *pbrExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// Policy-based routes cannot be updated.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *pbrExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PolicyBasedRoute)
+	if !ok {
+		return errors.New(errNotPolicyBasedRoute)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	op, err := e.routes.Delete(policybasedroute.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeletePolicyBasedRoute)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
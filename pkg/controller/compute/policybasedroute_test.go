@@ -0,0 +1,346 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/policybasedroute"
+)
+
+const (
+	testPBRName = "test-pbr"
+)
+
+var _ managed.ExternalConnecter = &pbrConnector{}
+var _ managed.ExternalClient = &pbrExternal{}
+
+type pbrModifier func(*v1alpha1.PolicyBasedRoute)
+
+func pbrWithConditions(c ...xpv1.Condition) pbrModifier {
+	return func(i *v1alpha1.PolicyBasedRoute) { i.Status.SetConditions(c...) }
+}
+
+func pbrWithObservation(o v1alpha1.PolicyBasedRouteObservation) pbrModifier {
+	return func(i *v1alpha1.PolicyBasedRoute) { i.Status.AtProvider = o }
+}
+
+func pbrObj(im ...pbrModifier) *v1alpha1.PolicyBasedRoute {
+	i := &v1alpha1.PolicyBasedRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testPBRName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testPBRName,
+			},
+		},
+		Spec: v1alpha1.PolicyBasedRouteSpec{
+			ForProvider: v1alpha1.PolicyBasedRouteParameters{
+				Network:  gcp.StringPtr("projects/" + projectID + "/global/networks/test-network"),
+				Priority: gcp.Int64Ptr(100),
+				Filter: v1alpha1.PolicyBasedRouteFilter{
+					IPProtocol:      gcp.StringPtr("ALL"),
+					SrcRange:        gcp.StringPtr("10.1.0.0/16"),
+					DestRange:       gcp.StringPtr("0.0.0.0/0"),
+					ProtocolVersion: gcp.StringPtr("IPV4"),
+				},
+				VirtualMachine: &v1alpha1.PolicyBasedRouteVirtualMachine{Tags: []string{"appliance"}},
+				NextHopILBIP:   gcp.StringPtr("10.0.0.10"),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestPolicyBasedRouteObserve(t *testing.T) {
+	fqName := policybasedroute.GetFullyQualifiedName(projectID, testPBRName)
+
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicyBasedRoute": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotPolicyBasedRoute),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: pbrObj(),
+			want: want{
+				mg: pbrObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: pbrObj(),
+			want: want{
+				mg:  pbrObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicyBasedRoute),
+			},
+		},
+		"Available": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+fqName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				pbr := &networkconnectivity.PolicyBasedRoute{}
+				policybasedroute.GeneratePolicyBasedRoute(pbrObj().Spec.ForProvider, pbr)
+				pbr.SelfLink = fqName
+				pbr.Warnings = []*networkconnectivity.Warnings{{Code: "RESOURCE_NOT_ACTIVE", WarningMessage: "next hop is not active"}}
+				_ = json.NewEncoder(w).Encode(pbr)
+			}),
+			mg: pbrObj(),
+			want: want{
+				mg: pbrObj(
+					pbrWithObservation(v1alpha1.PolicyBasedRouteObservation{
+						SelfLink: fqName,
+						Warnings: []string{"RESOURCE_NOT_ACTIVE: next hop is not active"},
+					}),
+					pbrWithConditions(xpv1.Available()),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				pbr := &networkconnectivity.PolicyBasedRoute{}
+				policybasedroute.GeneratePolicyBasedRoute(pbrObj().Spec.ForProvider, pbr)
+				pbr.Priority = 1000
+				_ = json.NewEncoder(w).Encode(pbr)
+			}),
+			mg: pbrObj(func(i *v1alpha1.PolicyBasedRoute) { i.Spec.ForProvider.Priority = nil }),
+			want: want{
+				mg: pbrObj(
+					func(i *v1alpha1.PolicyBasedRoute) { i.Spec.ForProvider.Priority = gcp.Int64Ptr(1000) },
+					pbrWithConditions(xpv1.Available()),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := networkconnectivity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &pbrExternal{routes: networkconnectivity.NewProjectsLocationsGlobalPolicyBasedRoutesService(s), projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyBasedRouteCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicyBasedRoute": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotPolicyBasedRoute),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+policybasedroute.GetParent(projectID)+"/policyBasedRoutes", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				if diff := cmp.Diff(testPBRName, r.URL.Query().Get("policyBasedRouteId")); diff != "" {
+					t.Errorf("r: -want id, +got id:\n%s", diff)
+				}
+				got := &networkconnectivity.PolicyBasedRoute{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Error(err)
+				}
+				_ = r.Body.Close()
+				want := &networkconnectivity.PolicyBasedRoute{}
+				policybasedroute.GeneratePolicyBasedRoute(pbrObj().Spec.ForProvider, want)
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&networkconnectivity.GoogleLongrunningOperation{})
+			}),
+			mg: pbrObj(),
+			want: want{
+				mg: pbrObj(pbrWithConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: pbrObj(),
+			want: want{
+				mg:  pbrObj(pbrWithConditions(xpv1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreatePolicyBasedRoute),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := networkconnectivity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &pbrExternal{routes: networkconnectivity.NewProjectsLocationsGlobalPolicyBasedRoutesService(s), projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyBasedRouteDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicyBasedRoute": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotPolicyBasedRoute),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&networkconnectivity.GoogleLongrunningOperation{})
+			}),
+			mg: pbrObj(),
+			want: want{
+				mg: pbrObj(pbrWithConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: pbrObj(),
+			want: want{
+				mg: pbrObj(pbrWithConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: pbrObj(),
+			want: want{
+				mg:  pbrObj(pbrWithConditions(xpv1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeletePolicyBasedRoute),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := networkconnectivity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &pbrExternal{routes: networkconnectivity.NewProjectsLocationsGlobalPolicyBasedRoutesService(s), projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
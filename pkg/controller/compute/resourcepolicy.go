@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/resourcepolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotResourcePolicy    = "managed resource is not a ResourcePolicy"
+	errGetResourcePolicy    = "cannot get external ResourcePolicy resource"
+	errCreateResourcePolicy = "cannot create external ResourcePolicy resource"
+	errUpdateResourcePolicy = "cannot update external ResourcePolicy resource"
+	errDeleteResourcePolicy = "cannot delete external ResourcePolicy resource"
+)
+
+// SetupResourcePolicy adds a controller that reconciles ResourcePolicy managed resources.
+func SetupResourcePolicy(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ResourcePolicyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ResourcePolicyGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &resourcePolicyConnector{kube: mgr.GetClient()}))))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.ResourcePolicy{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type resourcePolicyConnector struct {
+	kube client.Client
+}
+
+func (c *resourcePolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &resourcePolicyExternal{Service: s, projectID: projectID}, nil
+}
+
+type resourcePolicyExternal struct {
+	*compute.Service
+	projectID string
+}
+
+func (e *resourcePolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ResourcePolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotResourcePolicy)
+	}
+	observed, err := e.ResourcePolicies.Get(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetResourcePolicy)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	resourcepolicy.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = resourcepolicy.GenerateObservation(*observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.ResourcePolicyStatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.ResourcePolicyStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.ResourcePolicyStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        resourcepolicy.IsUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *resourcePolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ResourcePolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotResourcePolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	rp := resourcepolicy.GenerateResourcePolicy(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	op, err := e.ResourcePolicies.Insert(e.projectID, cr.Spec.ForProvider.Region, rp).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourcePolicy)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update patches the snapshot schedule of the resource policy. The new
+// schedule applies to all disks the policy is attached to.
+func (e *resourcePolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ResourcePolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotResourcePolicy)
+	}
+
+	rp := &compute.ResourcePolicy{SnapshotSchedulePolicy: resourcepolicy.GenerateSnapshotSchedulePolicy(cr.Spec.ForProvider.SnapshotSchedulePolicy)}
+	op, err := e.ResourcePolicies.Patch(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), rp).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateResourcePolicy)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *resourcePolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ResourcePolicy)
+	if !ok {
+		return errors.New(errNotResourcePolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	op, err := e.ResourcePolicies.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteResourcePolicy)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
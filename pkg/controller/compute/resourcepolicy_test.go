@@ -0,0 +1,267 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+)
+
+var _ managed.ExternalConnecter = &resourcePolicyConnector{}
+var _ managed.ExternalClient = &resourcePolicyExternal{}
+
+const (
+	testResourcePolicyName   = "test-policy"
+	testResourcePolicyRegion = "us-central1"
+)
+
+type resourcePolicyModifier func(*v1alpha1.ResourcePolicy)
+
+func resourcePolicyWithConditions(c ...xpv1.Condition) resourcePolicyModifier {
+	return func(rp *v1alpha1.ResourcePolicy) { rp.Status.SetConditions(c...) }
+}
+
+func resourcePolicyWithObservation(o v1alpha1.ResourcePolicyObservation) resourcePolicyModifier {
+	return func(rp *v1alpha1.ResourcePolicy) { rp.Status.AtProvider = o }
+}
+
+func resourcePolicyWithStartTime(s string) resourcePolicyModifier {
+	return func(rp *v1alpha1.ResourcePolicy) {
+		rp.Spec.ForProvider.SnapshotSchedulePolicy.Schedule.DailySchedule.StartTime = s
+	}
+}
+
+func resourcePolicyObj(m ...resourcePolicyModifier) *v1alpha1.ResourcePolicy {
+	rp := &v1alpha1.ResourcePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testResourcePolicyName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testResourcePolicyName,
+			},
+		},
+		Spec: v1alpha1.ResourcePolicySpec{
+			ForProvider: v1alpha1.ResourcePolicyParameters{
+				Region: testResourcePolicyRegion,
+				SnapshotSchedulePolicy: v1alpha1.SnapshotSchedulePolicy{
+					Schedule: v1alpha1.SnapshotSchedule{
+						DailySchedule: &v1alpha1.DailyCycle{DaysInCycle: 1, StartTime: "04:00"},
+					},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(rp)
+	}
+	return rp
+}
+
+// resourcePolicyGCE returns the resource policy that resourcePolicyObj
+// describes, as returned by the Compute API.
+func resourcePolicyGCE(status string) *compute.ResourcePolicy {
+	return &compute.ResourcePolicy{
+		Id:     1,
+		Name:   testResourcePolicyName,
+		Status: status,
+		SnapshotSchedulePolicy: &compute.ResourcePolicySnapshotSchedulePolicy{
+			Schedule: &compute.ResourcePolicySnapshotSchedulePolicySchedule{
+				DailySchedule: &compute.ResourcePolicyDailyCycle{DaysInCycle: 1, StartTime: "04:00", Duration: "PT14400S"},
+			},
+		},
+	}
+}
+
+func TestResourcePolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		observed *compute.ResourcePolicy
+		status   int
+		mg       resource.Managed
+		want     want
+	}{
+		"NotResourcePolicy": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotResourcePolicy),
+			},
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+			mg:     resourcePolicyObj(),
+			want:   want{mg: resourcePolicyObj()},
+		},
+		"GetFailed": {
+			status: http.StatusBadRequest,
+			mg:     resourcePolicyObj(),
+			want: want{
+				mg:  resourcePolicyObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetResourcePolicy),
+			},
+		},
+		"Ready": {
+			status:   http.StatusOK,
+			observed: resourcePolicyGCE(v1alpha1.ResourcePolicyStatusReady),
+			mg:       resourcePolicyObj(),
+			want: want{
+				mg: resourcePolicyObj(
+					resourcePolicyWithConditions(xpv1.Available()),
+					resourcePolicyWithObservation(v1alpha1.ResourcePolicyObservation{ID: 1, Status: v1alpha1.ResourcePolicyStatusReady}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ScheduleChanged": {
+			status:   http.StatusOK,
+			observed: resourcePolicyGCE(v1alpha1.ResourcePolicyStatusReady),
+			mg:       resourcePolicyObj(resourcePolicyWithStartTime("05:00")),
+			want: want{
+				mg: resourcePolicyObj(
+					resourcePolicyWithStartTime("05:00"),
+					resourcePolicyWithConditions(xpv1.Available()),
+					resourcePolicyWithObservation(v1alpha1.ResourcePolicyObservation{ID: 1, Status: v1alpha1.ResourcePolicyStatusReady}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/regions/"+testResourcePolicyRegion+"/resourcePolicies/"+testResourcePolicyName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.observed == nil {
+					_ = json.NewEncoder(w).Encode(&compute.ResourcePolicy{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.observed)
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := resourcePolicyExternal{Service: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourcePolicyUpdate(t *testing.T) {
+	var patched *compute.ResourcePolicy
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if r.Method != http.MethodPatch {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		patched = &compute.ResourcePolicy{}
+		_ = json.NewDecoder(r.Body).Decode(patched)
+		_ = json.NewEncoder(w).Encode(&compute.Operation{})
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := resourcePolicyExternal{Service: s, projectID: projectID}
+
+	if _, err := e.Update(context.Background(), resourcePolicyObj(resourcePolicyWithStartTime("05:00"))); err != nil {
+		t.Fatalf("Update(...): unexpected error: %s", err)
+	}
+	if patched == nil || patched.SnapshotSchedulePolicy == nil {
+		t.Fatal("Update(...): snapshot schedule policy was not patched")
+	}
+	if diff := cmp.Diff("05:00", patched.SnapshotSchedulePolicy.Schedule.DailySchedule.StartTime); diff != "" {
+		t.Errorf("Update(...): -want start time, +got start time:\n%s", diff)
+	}
+}
+
+func TestResourcePolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		mg     resource.Managed
+		want   error
+	}{
+		"NotResourcePolicy": {
+			mg:   &v1beta1.Subnetwork{},
+			want: errors.New(errNotResourcePolicy),
+		},
+		"Deleted": {
+			status: http.StatusOK,
+			mg:     resourcePolicyObj(),
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+			mg:     resourcePolicyObj(),
+		},
+		"InUse": {
+			status: http.StatusBadRequest,
+			mg:     resourcePolicyObj(),
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteResourcePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := resourcePolicyExternal{Service: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -34,7 +34,14 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/router"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -62,7 +69,7 @@ func SetupRouter(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.RouterGroupVersionKind),
-		managed.WithExternalConnecter(&routerConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &routerConnector{kube: mgr.GetClient()}))))))),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
@@ -71,7 +78,7 @@ func SetupRouter(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.Router{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -139,10 +146,14 @@ func (c *routerExternal) Create(ctx context.Context, mg resource.Managed) (manag
 
 	rt := &compute.Router{}
 	router.GenerateRouter(meta.GetExternalName(cr), cr.Spec.ForProvider, rt)
-	_, err := c.Routers.Insert(c.projectID, cr.Spec.ForProvider.Region, rt).
+	op, err := c.Routers.Insert(c.projectID, cr.Spec.ForProvider.Region, rt).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errRouterCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRouterCreateFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *routerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -167,10 +178,14 @@ func (c *routerExternal) Update(ctx context.Context, mg resource.Managed) (manag
 	rt := &compute.Router{}
 	router.GenerateRouter(meta.GetExternalName(cr), cr.Spec.ForProvider, rt)
 
-	_, err = c.Routers.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), rt).
+	op, err := c.Routers.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), rt).
 		Context(ctx).
 		Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errRouterUpdateFailed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRouterUpdateFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *routerExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -180,8 +195,12 @@ func (c *routerExternal) Delete(ctx context.Context, mg resource.Managed) error
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Routers.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
+	op, err := c.Routers.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
 		Context(ctx).
 		Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errRouterDeleteFailed)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errRouterDeleteFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
 }
@@ -0,0 +1,229 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/routernat"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotRouterNAT           = "managed resource is not a RouterNAT"
+	errNoRouterNATRouter      = "router of RouterNAT is not set"
+	errGetRouterNATRouter     = "cannot get the Router of external RouterNAT resource"
+	errGetRouterNATStatus     = "cannot get the status of external RouterNAT resource"
+	errCheckRouterNATUpToDate = "cannot determine if external RouterNAT resource is up to date"
+	errCreateRouterNAT        = "cannot create external RouterNAT resource"
+	errUpdateRouterNAT        = "cannot update external RouterNAT resource"
+	errDeleteRouterNAT        = "cannot delete external RouterNAT resource"
+)
+
+// SetupRouterNAT adds a controller that reconciles RouterNAT managed resources.
+func SetupRouterNAT(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RouterNATGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RouterNATGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &routerNATConnector{kube: mgr.GetClient()}))))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.RouterNAT{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type routerNATConnector struct {
+	kube client.Client
+}
+
+func (c *routerNATConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &routerNATExternal{Service: s, projectID: projectID}, nil
+}
+
+// A routerNATExternal manages a single NAT of a Cloud Router. The NATs of a
+// router can only be changed by patching the router with its complete list of
+// NATs, so every operation reads the router first and only adds, replaces or
+// removes the NAT it manages.
+type routerNATExternal struct {
+	*compute.Service
+	projectID string
+}
+
+func (e *routerNATExternal) getRouter(ctx context.Context, cr *v1alpha1.RouterNAT) (*compute.Router, error) {
+	if cr.Spec.ForProvider.Router == nil {
+		return nil, errors.New(errNoRouterNATRouter)
+	}
+	rt, err := e.Routers.Get(e.projectID, cr.Spec.ForProvider.Region, *cr.Spec.ForProvider.Router).Context(ctx).Do()
+	return rt, errors.Wrap(err, errGetRouterNATRouter)
+}
+
+func (e *routerNATExternal) patchNATs(ctx context.Context, cr *v1alpha1.RouterNAT, nats []*compute.RouterNat) (*compute.Operation, error) {
+	// The NATs are always sent, so that removing the last NAT of a router
+	// clears its list.
+	rt := &compute.Router{Nats: nats, ForceSendFields: []string{"Nats"}}
+	return e.Routers.Patch(e.projectID, cr.Spec.ForProvider.Region, *cr.Spec.ForProvider.Router, rt).Context(ctx).Do()
+}
+
+func (e *routerNATExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RouterNAT)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRouterNAT)
+	}
+	rt, err := e.getRouter(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(gcp.IsErrorNotFound, err)
+	}
+	observed := routernat.FindNAT(rt.Nats, meta.GetExternalName(cr))
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	routernat.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	status, err := e.Routers.GetRouterStatus(e.projectID, cr.Spec.ForProvider.Region, rt.Name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRouterNATStatus)
+	}
+	cr.Status.AtProvider = routernat.GenerateObservation(routernat.FindNATStatus(status.Result, meta.GetExternalName(cr)))
+	cr.SetConditions(xpv1.Available())
+
+	upToDate, err := routernat.IsUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckRouterNATUpToDate)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *routerNATExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RouterNAT)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRouterNAT)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	rt, err := e.getRouter(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	nat := &compute.RouterNat{}
+	routernat.GenerateRouterNAT(meta.GetExternalName(cr), cr.Spec.ForProvider, nat)
+	op, err := e.patchNATs(ctx, cr, routernat.WithNAT(rt.Nats, nat))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRouterNAT)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update replaces the NAT on the router. Fields of the NAT that are not part
+// of the RouterNAT, e.g. its rules, are kept.
+func (e *routerNATExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RouterNAT)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRouterNAT)
+	}
+
+	rt, err := e.getRouter(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	nat := routernat.FindNAT(rt.Nats, meta.GetExternalName(cr))
+	if nat == nil {
+		nat = &compute.RouterNat{}
+	}
+	routernat.GenerateRouterNAT(meta.GetExternalName(cr), cr.Spec.ForProvider, nat)
+	op, err := e.patchNATs(ctx, cr, routernat.WithNAT(rt.Nats, nat))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRouterNAT)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *routerNATExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RouterNAT)
+	if !ok {
+		return errors.New(errNotRouterNAT)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	rt, err := e.getRouter(ctx, cr)
+	if err != nil {
+		return resource.Ignore(gcp.IsErrorNotFound, err)
+	}
+	if routernat.FindNAT(rt.Nats, meta.GetExternalName(cr)) == nil {
+		return nil
+	}
+	op, err := e.patchNATs(ctx, cr, routernat.WithoutNAT(rt.Nats, meta.GetExternalName(cr)))
+	if err != nil {
+		return errors.Wrap(err, errDeleteRouterNAT)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &routerNATConnector{}
+var _ managed.ExternalClient = &routerNATExternal{}
+
+const (
+	testRouterNATName   = "test-nat"
+	testRouterNATRouter = "test-router"
+	testRouterNATRegion = "us-central1"
+)
+
+type routerNATModifier func(*v1alpha1.RouterNAT)
+
+func routerNATWithConditions(c ...xpv1.Condition) routerNATModifier {
+	return func(n *v1alpha1.RouterNAT) { n.Status.SetConditions(c...) }
+}
+
+func routerNATWithObservation(o v1alpha1.RouterNATObservation) routerNATModifier {
+	return func(n *v1alpha1.RouterNAT) { n.Status.AtProvider = o }
+}
+
+func routerNATWithMinPortsPerVM(p int64) routerNATModifier {
+	return func(n *v1alpha1.RouterNAT) { n.Spec.ForProvider.MinPortsPerVM = &p }
+}
+
+func routerNATObj(m ...routerNATModifier) *v1alpha1.RouterNAT {
+	n := &v1alpha1.RouterNAT{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testRouterNATName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testRouterNATName,
+			},
+		},
+		Spec: v1alpha1.RouterNATSpec{
+			ForProvider: v1alpha1.RouterNATParameters{
+				Region:                        testRouterNATRegion,
+				Router:                        gcp.StringPtr(testRouterNATRouter),
+				NATIPAllocateOption:           v1alpha1.NATIPAllocateAutoOnly,
+				SourceSubnetworkIPRangesToNAT: "ALL_SUBNETWORKS_ALL_IP_RANGES",
+				MinPortsPerVM:                 gcp.Int64Ptr(64),
+			},
+		},
+	}
+	for _, f := range m {
+		f(n)
+	}
+	return n
+}
+
+// routerNATGCE returns a router with the NAT that routerNATObj describes, as
+// returned by the Compute API, next to another NAT not managed by it.
+func routerNATGCE() *compute.Router {
+	return &compute.Router{
+		Name: testRouterNATRouter,
+		Nats: []*compute.RouterNat{
+			{Name: "other-nat", NatIpAllocateOption: v1alpha1.NATIPAllocateAutoOnly, SourceSubnetworkIpRangesToNat: "LIST_OF_SUBNETWORKS"},
+			{Name: testRouterNATName, NatIpAllocateOption: v1alpha1.NATIPAllocateAutoOnly, SourceSubnetworkIpRangesToNat: "ALL_SUBNETWORKS_ALL_IP_RANGES", MinPortsPerVm: 64},
+		},
+	}
+}
+
+// routerNATServer serves the supplied router and records the NATs the router
+// is patched with.
+func routerNATServer(t *testing.T, status int, rt *compute.Router, patched *[]map[string]interface{}) *httptest.Server {
+	t.Helper()
+	routerPath := "/projects/" + projectID + "/regions/" + testRouterNATRegion + "/routers/" + testRouterNATRouter
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == routerPath:
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(rt)
+		case r.Method == http.MethodGet && r.URL.Path == routerPath+"/getRouterStatus":
+			_ = json.NewEncoder(w).Encode(&compute.RouterStatusResponse{Result: &compute.RouterStatus{
+				NatStatus: []*compute.RouterStatusNatStatus{
+					{Name: testRouterNATName, AutoAllocatedNatIps: []string{"203.0.113.1"}, NumVmEndpointsWithNatMappings: 2},
+				},
+			}})
+		case r.Method == http.MethodPatch && r.URL.Path == routerPath:
+			body := map[string]interface{}{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			*patched = append(*patched, body)
+			_ = json.NewEncoder(w).Encode(&compute.Operation{})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+// natNames returns the names of the NATs of a patched router.
+func natNames(body map[string]interface{}) []string {
+	nats, ok := body["nats"].([]interface{})
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(nats))
+	for _, n := range nats {
+		names = append(names, n.(map[string]interface{})["name"].(string))
+	}
+	return names
+}
+
+func TestRouterNATObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		status int
+		router *compute.Router
+		mg     resource.Managed
+		want   want
+	}{
+		"NotRouterNAT": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotRouterNAT),
+			},
+		},
+		"RouterNotFound": {
+			status: http.StatusNotFound,
+			router: &compute.Router{},
+			mg:     routerNATObj(),
+			want:   want{mg: routerNATObj()},
+		},
+		"NATNotFound": {
+			status: http.StatusOK,
+			router: &compute.Router{Name: testRouterNATRouter},
+			mg:     routerNATObj(),
+			want:   want{mg: routerNATObj()},
+		},
+		"UpToDate": {
+			status: http.StatusOK,
+			router: routerNATGCE(),
+			mg:     routerNATObj(),
+			want: want{
+				mg: routerNATObj(
+					routerNATWithConditions(xpv1.Available()),
+					routerNATWithObservation(v1alpha1.RouterNATObservation{AutoAllocatedNATIPs: []string{"203.0.113.1"}, NumVMEndpointsWithNATMappings: 2}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"MinPortsChanged": {
+			status: http.StatusOK,
+			router: routerNATGCE(),
+			mg:     routerNATObj(routerNATWithMinPortsPerVM(128)),
+			want: want{
+				mg: routerNATObj(
+					routerNATWithMinPortsPerVM(128),
+					routerNATWithConditions(xpv1.Available()),
+					routerNATWithObservation(v1alpha1.RouterNATObservation{AutoAllocatedNATIPs: []string{"203.0.113.1"}, NumVMEndpointsWithNATMappings: 2}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var patched []map[string]interface{}
+			server := routerNATServer(t, tc.status, tc.router, &patched)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := routerNATExternal{Service: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRouterNATCreate(t *testing.T) {
+	var patched []map[string]interface{}
+	rt := routerNATGCE()
+	rt.Nats = rt.Nats[:1]
+	server := routerNATServer(t, http.StatusOK, rt, &patched)
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := routerNATExternal{Service: s, projectID: projectID}
+
+	if _, err := e.Create(context.Background(), routerNATObj()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %s", err)
+	}
+	if len(patched) != 1 {
+		t.Fatalf("Create(...): want 1 patch, got %d", len(patched))
+	}
+	if diff := cmp.Diff([]string{"other-nat", testRouterNATName}, natNames(patched[0])); diff != "" {
+		t.Errorf("Create(...): -want NATs, +got NATs:\n%s", diff)
+	}
+}
+
+func TestRouterNATUpdate(t *testing.T) {
+	var patched []map[string]interface{}
+	rt := routerNATGCE()
+	rt.Nats[1].Rules = []*compute.RouterNatRule{{RuleNumber: 100, Match: "true"}}
+	server := routerNATServer(t, http.StatusOK, rt, &patched)
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := routerNATExternal{Service: s, projectID: projectID}
+
+	if _, err := e.Update(context.Background(), routerNATObj(routerNATWithMinPortsPerVM(128))); err != nil {
+		t.Fatalf("Update(...): unexpected error: %s", err)
+	}
+	if len(patched) != 1 {
+		t.Fatalf("Update(...): want 1 patch, got %d", len(patched))
+	}
+	if diff := cmp.Diff([]string{"other-nat", testRouterNATName}, natNames(patched[0])); diff != "" {
+		t.Errorf("Update(...): -want NATs, +got NATs:\n%s", diff)
+	}
+	nat := patched[0]["nats"].([]interface{})[1].(map[string]interface{})
+	if diff := cmp.Diff(float64(128), nat["minPortsPerVm"]); diff != "" {
+		t.Errorf("Update(...): -want min ports per VM, +got min ports per VM:\n%s", diff)
+	}
+	if _, ok := nat["rules"]; !ok {
+		t.Error("Update(...): rules of the NAT were not kept")
+	}
+}
+
+func TestRouterNATDelete(t *testing.T) {
+	cases := map[string]struct {
+		status    int
+		router    *compute.Router
+		mg        resource.Managed
+		wantNATs  [][]string
+		wantError error
+	}{
+		"NotRouterNAT": {
+			mg:        &v1beta1.Subnetwork{},
+			wantError: errors.New(errNotRouterNAT),
+		},
+		"RouterGone": {
+			status: http.StatusNotFound,
+			router: &compute.Router{},
+			mg:     routerNATObj(),
+		},
+		"NATGone": {
+			status: http.StatusOK,
+			router: &compute.Router{Name: testRouterNATRouter},
+			mg:     routerNATObj(),
+		},
+		"OtherNATKept": {
+			status:   http.StatusOK,
+			router:   routerNATGCE(),
+			mg:       routerNATObj(),
+			wantNATs: [][]string{{"other-nat"}},
+		},
+		"LastNAT": {
+			status:   http.StatusOK,
+			router:   &compute.Router{Name: testRouterNATRouter, Nats: []*compute.RouterNat{{Name: testRouterNATName}}},
+			mg:       routerNATObj(),
+			wantNATs: [][]string{{}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var patched []map[string]interface{}
+			server := routerNATServer(t, tc.status, tc.router, &patched)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := routerNATExternal{Service: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantError, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			got := make([][]string, 0, len(patched))
+			for _, p := range patched {
+				if _, ok := p["nats"]; !ok {
+					t.Error("Delete(...): NATs of the router were not sent")
+				}
+				got = append(got, natNames(p))
+			}
+			if diff := cmp.Diff(tc.wantNATs, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Delete(...): -want NATs, +got NATs:\n%s", diff)
+			}
+		})
+	}
+}
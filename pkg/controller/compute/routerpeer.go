@@ -0,0 +1,254 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/routerpeer"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotRouterPeer           = "managed resource is not a RouterPeer"
+	errNoRouterPeerRouter      = "router of RouterPeer is not set"
+	errGetRouterPeerRouter     = "cannot get the Router of external RouterPeer resource"
+	errGetRouterPeerStatus     = "cannot get the status of external RouterPeer resource"
+	errCheckRouterPeerUpToDate = "cannot determine if external RouterPeer resource is up to date"
+	errCreateRouterPeer        = "cannot create external RouterPeer resource"
+	errUpdateRouterPeer        = "cannot update external RouterPeer resource"
+	errDeleteRouterPeer        = "cannot delete external RouterPeer resource"
+)
+
+// SetupRouterPeer adds a controller that reconciles RouterPeer managed resources.
+func SetupRouterPeer(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RouterPeerGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RouterPeerGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &routerPeerConnector{kube: mgr.GetClient()}))))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.RouterPeer{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type routerPeerConnector struct {
+	kube client.Client
+}
+
+func (c *routerPeerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &routerPeerExternal{Service: s, projectID: projectID}, nil
+}
+
+// A routerPeerExternal manages a single BGP peer of a Cloud Router, and the
+// interface it uses if the RouterPeer configures one. Like the NATs of a
+// router, its BGP peers and interfaces can only be changed by patching the
+// router with their complete lists.
+type routerPeerExternal struct {
+	*compute.Service
+	projectID string
+}
+
+func (e *routerPeerExternal) getRouter(ctx context.Context, cr *v1alpha1.RouterPeer) (*compute.Router, error) {
+	if cr.Spec.ForProvider.Router == nil {
+		return nil, errors.New(errNoRouterPeerRouter)
+	}
+	rt, err := e.Routers.Get(e.projectID, cr.Spec.ForProvider.Region, *cr.Spec.ForProvider.Router).Context(ctx).Do()
+	return rt, errors.Wrap(err, errGetRouterPeerRouter)
+}
+
+func (e *routerPeerExternal) patch(ctx context.Context, cr *v1alpha1.RouterPeer, peers []*compute.RouterBgpPeer, ifaces []*compute.RouterInterface) (*compute.Operation, error) {
+	// The peers and interfaces are always sent, so that removing the last
+	// of them clears the list.
+	rt := &compute.Router{BgpPeers: peers, Interfaces: ifaces, ForceSendFields: []string{"BgpPeers", "Interfaces"}}
+	return e.Routers.Patch(e.projectID, cr.Spec.ForProvider.Region, *cr.Spec.ForProvider.Router, rt).Context(ctx).Do()
+}
+
+// apply adds or replaces the BGP peer, and its interface if configured, on
+// the supplied router and patches it.
+func (e *routerPeerExternal) apply(ctx context.Context, cr *v1alpha1.RouterPeer, rt *compute.Router) (*compute.Operation, error) {
+	ifaces := rt.Interfaces
+	if in := cr.Spec.ForProvider.Interface; in != nil {
+		iface := routerpeer.FindInterface(rt.Interfaces, cr.Spec.ForProvider.InterfaceName)
+		if iface == nil {
+			iface = &compute.RouterInterface{}
+		}
+		routerpeer.GenerateRouterInterface(cr.Spec.ForProvider.InterfaceName, *in, iface)
+		ifaces = routerpeer.WithInterface(rt.Interfaces, iface)
+	}
+	peer := routerpeer.FindPeer(rt.BgpPeers, meta.GetExternalName(cr))
+	if peer == nil {
+		peer = &compute.RouterBgpPeer{}
+	}
+	routerpeer.GenerateRouterPeer(meta.GetExternalName(cr), cr.Spec.ForProvider, peer)
+	return e.patch(ctx, cr, routerpeer.WithPeer(rt.BgpPeers, peer), ifaces)
+}
+
+func (e *routerPeerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RouterPeer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRouterPeer)
+	}
+	rt, err := e.getRouter(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(gcp.IsErrorNotFound, err)
+	}
+	observed := routerpeer.FindPeer(rt.BgpPeers, meta.GetExternalName(cr))
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	routerpeer.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	status, err := e.Routers.GetRouterStatus(e.projectID, cr.Spec.ForProvider.Region, rt.Name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRouterPeerStatus)
+	}
+	cr.Status.AtProvider = routerpeer.GenerateObservation(routerpeer.FindPeerStatus(status.Result, meta.GetExternalName(cr)))
+	cr.SetConditions(xpv1.Available())
+
+	iface := routerpeer.FindInterface(rt.Interfaces, cr.Spec.ForProvider.InterfaceName)
+	upToDate, err := routerpeer.IsUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, observed, iface)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckRouterPeerUpToDate)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *routerPeerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RouterPeer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRouterPeer)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	rt, err := e.getRouter(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	op, err := e.apply(ctx, cr, rt)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRouterPeer)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+// Update replaces the BGP peer, and its interface if configured, on the
+// router. Fields of the peer that are not part of the RouterPeer, e.g. its BFD
+// settings, are kept.
+func (e *routerPeerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RouterPeer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRouterPeer)
+	}
+
+	rt, err := e.getRouter(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	op, err := e.apply(ctx, cr, rt)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRouterPeer)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete removes the BGP peer from the router, and its interface if the
+// RouterPeer configures one.
+func (e *routerPeerExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RouterPeer)
+	if !ok {
+		return errors.New(errNotRouterPeer)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	rt, err := e.getRouter(ctx, cr)
+	if err != nil {
+		return resource.Ignore(gcp.IsErrorNotFound, err)
+	}
+	var iface *compute.RouterInterface
+	if cr.Spec.ForProvider.Interface != nil {
+		iface = routerpeer.FindInterface(rt.Interfaces, cr.Spec.ForProvider.InterfaceName)
+	}
+	if routerpeer.FindPeer(rt.BgpPeers, meta.GetExternalName(cr)) == nil && iface == nil {
+		return nil
+	}
+	ifaces := rt.Interfaces
+	if iface != nil {
+		ifaces = routerpeer.WithoutInterface(rt.Interfaces, iface.Name)
+	}
+	op, err := e.patch(ctx, cr, routerpeer.WithoutPeer(rt.BgpPeers, meta.GetExternalName(cr)), ifaces)
+	if err != nil {
+		return errors.Wrap(err, errDeleteRouterPeer)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
@@ -0,0 +1,386 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &routerPeerConnector{}
+var _ managed.ExternalClient = &routerPeerExternal{}
+
+const (
+	testRouterPeerName      = "test-peer"
+	testRouterPeerInterface = "test-interface"
+	testRouterPeerRouter    = "test-router"
+	testRouterPeerRegion    = "us-central1"
+	testRouterPeerTunnel    = "projects/" + projectID + "/regions/us-central1/vpnTunnels/test-tunnel"
+)
+
+type routerPeerModifier func(*v1alpha1.RouterPeer)
+
+func routerPeerWithConditions(c ...xpv1.Condition) routerPeerModifier {
+	return func(p *v1alpha1.RouterPeer) { p.Status.SetConditions(c...) }
+}
+
+func routerPeerWithObservation(o v1alpha1.RouterPeerObservation) routerPeerModifier {
+	return func(p *v1alpha1.RouterPeer) { p.Status.AtProvider = o }
+}
+
+func routerPeerWithPeerASN(asn int64) routerPeerModifier {
+	return func(p *v1alpha1.RouterPeer) { p.Spec.ForProvider.PeerASN = asn }
+}
+
+func routerPeerWithInterface(i *v1alpha1.RouterPeerInterface) routerPeerModifier {
+	return func(p *v1alpha1.RouterPeer) { p.Spec.ForProvider.Interface = i }
+}
+
+func routerPeerObj(m ...routerPeerModifier) *v1alpha1.RouterPeer {
+	p := &v1alpha1.RouterPeer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testRouterPeerName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testRouterPeerName,
+			},
+		},
+		Spec: v1alpha1.RouterPeerSpec{
+			ForProvider: v1alpha1.RouterPeerParameters{
+				Region:        testRouterPeerRegion,
+				Router:        gcp.StringPtr(testRouterPeerRouter),
+				InterfaceName: testRouterPeerInterface,
+				Interface: &v1alpha1.RouterPeerInterface{
+					IPRange:         gcp.StringPtr("169.254.0.1/30"),
+					LinkedVPNTunnel: gcp.StringPtr(testRouterPeerTunnel),
+				},
+				PeerASN:       65001,
+				PeerIPAddress: gcp.StringPtr("169.254.0.2"),
+				IPAddress:     gcp.StringPtr("169.254.0.1"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+// routerPeerGCE returns a router with the BGP peer and interface that
+// routerPeerObj describes, as returned by the Compute API, next to another
+// peer and interface not managed by it.
+func routerPeerGCE() *compute.Router {
+	return &compute.Router{
+		Name: testRouterPeerRouter,
+		Interfaces: []*compute.RouterInterface{
+			{Name: "other-interface", IpRange: "169.254.1.1/30"},
+			{Name: testRouterPeerInterface, IpRange: "169.254.0.1/30", LinkedVpnTunnel: "https://www.googleapis.com/compute/v1/" + testRouterPeerTunnel},
+		},
+		BgpPeers: []*compute.RouterBgpPeer{
+			{Name: "other-peer", InterfaceName: "other-interface", PeerAsn: 65002},
+			{Name: testRouterPeerName, InterfaceName: testRouterPeerInterface, PeerAsn: 65001, PeerIpAddress: "169.254.0.2", IpAddress: "169.254.0.1"},
+		},
+	}
+}
+
+// routerPeerServer serves the supplied router and records the bodies the
+// router is patched with.
+func routerPeerServer(t *testing.T, status int, rt *compute.Router, patched *[]map[string]interface{}) *httptest.Server {
+	t.Helper()
+	routerPath := "/projects/" + projectID + "/regions/" + testRouterPeerRegion + "/routers/" + testRouterPeerRouter
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == routerPath:
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(rt)
+		case r.Method == http.MethodGet && r.URL.Path == routerPath+"/getRouterStatus":
+			_ = json.NewEncoder(w).Encode(&compute.RouterStatusResponse{Result: &compute.RouterStatus{
+				BgpPeerStatus: []*compute.RouterStatusBgpPeerStatus{
+					{Name: testRouterPeerName, Status: v1alpha1.RouterPeerStatusUp, State: "Established", NumLearnedRoutes: 3},
+				},
+			}})
+		case r.Method == http.MethodPatch && r.URL.Path == routerPath:
+			body := map[string]interface{}{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			*patched = append(*patched, body)
+			_ = json.NewEncoder(w).Encode(&compute.Operation{})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+// routerListNames returns the names of the entries of the supplied list, e.g.
+// bgpPeers, of a patched router.
+func routerListNames(body map[string]interface{}, key string) []string {
+	l, ok := body[key].([]interface{})
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(l))
+	for _, e := range l {
+		names = append(names, e.(map[string]interface{})["name"].(string))
+	}
+	return names
+}
+
+func TestRouterPeerObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	up := v1alpha1.RouterPeerObservation{Status: v1alpha1.RouterPeerStatusUp, State: "Established", NumLearnedRoutes: 3}
+
+	cases := map[string]struct {
+		status int
+		router *compute.Router
+		mg     resource.Managed
+		want   want
+	}{
+		"NotRouterPeer": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotRouterPeer),
+			},
+		},
+		"RouterNotFound": {
+			status: http.StatusNotFound,
+			router: &compute.Router{},
+			mg:     routerPeerObj(),
+			want:   want{mg: routerPeerObj()},
+		},
+		"PeerNotFound": {
+			status: http.StatusOK,
+			router: &compute.Router{Name: testRouterPeerRouter},
+			mg:     routerPeerObj(),
+			want:   want{mg: routerPeerObj()},
+		},
+		"UpToDate": {
+			status: http.StatusOK,
+			router: routerPeerGCE(),
+			mg:     routerPeerObj(),
+			want: want{
+				mg:  routerPeerObj(routerPeerWithConditions(xpv1.Available()), routerPeerWithObservation(up)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PeerASNChanged": {
+			status: http.StatusOK,
+			router: routerPeerGCE(),
+			mg:     routerPeerObj(routerPeerWithPeerASN(65003)),
+			want: want{
+				mg:  routerPeerObj(routerPeerWithPeerASN(65003), routerPeerWithConditions(xpv1.Available()), routerPeerWithObservation(up)),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"InterfaceMissing": {
+			status: http.StatusOK,
+			router: func() *compute.Router {
+				rt := routerPeerGCE()
+				rt.Interfaces = rt.Interfaces[:1]
+				return rt
+			}(),
+			mg: routerPeerObj(),
+			want: want{
+				mg:  routerPeerObj(routerPeerWithConditions(xpv1.Available()), routerPeerWithObservation(up)),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"InterfaceNotManaged": {
+			status: http.StatusOK,
+			router: func() *compute.Router {
+				rt := routerPeerGCE()
+				rt.Interfaces = rt.Interfaces[:1]
+				return rt
+			}(),
+			mg: routerPeerObj(routerPeerWithInterface(nil)),
+			want: want{
+				mg:  routerPeerObj(routerPeerWithInterface(nil), routerPeerWithConditions(xpv1.Available()), routerPeerWithObservation(up)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var patched []map[string]interface{}
+			server := routerPeerServer(t, tc.status, tc.router, &patched)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := routerPeerExternal{Service: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRouterPeerCreate(t *testing.T) {
+	var patched []map[string]interface{}
+	rt := routerPeerGCE()
+	rt.Interfaces = rt.Interfaces[:1]
+	rt.BgpPeers = rt.BgpPeers[:1]
+	server := routerPeerServer(t, http.StatusOK, rt, &patched)
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := routerPeerExternal{Service: s, projectID: projectID}
+
+	if _, err := e.Create(context.Background(), routerPeerObj()); err != nil {
+		t.Fatalf("Create(...): unexpected error: %s", err)
+	}
+	if len(patched) != 1 {
+		t.Fatalf("Create(...): want 1 patch, got %d", len(patched))
+	}
+	if diff := cmp.Diff([]string{"other-peer", testRouterPeerName}, routerListNames(patched[0], "bgpPeers")); diff != "" {
+		t.Errorf("Create(...): -want peers, +got peers:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"other-interface", testRouterPeerInterface}, routerListNames(patched[0], "interfaces")); diff != "" {
+		t.Errorf("Create(...): -want interfaces, +got interfaces:\n%s", diff)
+	}
+}
+
+func TestRouterPeerUpdate(t *testing.T) {
+	var patched []map[string]interface{}
+	rt := routerPeerGCE()
+	rt.BgpPeers[1].Bfd = &compute.RouterBgpPeerBfd{SessionInitializationMode: "ACTIVE"}
+	server := routerPeerServer(t, http.StatusOK, rt, &patched)
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := routerPeerExternal{Service: s, projectID: projectID}
+
+	if _, err := e.Update(context.Background(), routerPeerObj(routerPeerWithPeerASN(65003))); err != nil {
+		t.Fatalf("Update(...): unexpected error: %s", err)
+	}
+	if len(patched) != 1 {
+		t.Fatalf("Update(...): want 1 patch, got %d", len(patched))
+	}
+	if diff := cmp.Diff([]string{"other-peer", testRouterPeerName}, routerListNames(patched[0], "bgpPeers")); diff != "" {
+		t.Errorf("Update(...): -want peers, +got peers:\n%s", diff)
+	}
+	peer := patched[0]["bgpPeers"].([]interface{})[1].(map[string]interface{})
+	if diff := cmp.Diff(float64(65003), peer["peerAsn"]); diff != "" {
+		t.Errorf("Update(...): -want peer ASN, +got peer ASN:\n%s", diff)
+	}
+	if _, ok := peer["bfd"]; !ok {
+		t.Error("Update(...): BFD settings of the peer were not kept")
+	}
+}
+
+func TestRouterPeerDelete(t *testing.T) {
+	type want struct {
+		peers      [][]string
+		interfaces [][]string
+		err        error
+	}
+
+	cases := map[string]struct {
+		status int
+		router *compute.Router
+		mg     resource.Managed
+		want   want
+	}{
+		"NotRouterPeer": {
+			mg:   &v1beta1.Subnetwork{},
+			want: want{err: errors.New(errNotRouterPeer)},
+		},
+		"RouterGone": {
+			status: http.StatusNotFound,
+			router: &compute.Router{},
+			mg:     routerPeerObj(),
+		},
+		"PeerGone": {
+			status: http.StatusOK,
+			router: &compute.Router{Name: testRouterPeerRouter},
+			mg:     routerPeerObj(),
+		},
+		"InterfaceRemoved": {
+			status: http.StatusOK,
+			router: routerPeerGCE(),
+			mg:     routerPeerObj(),
+			want: want{
+				peers:      [][]string{{"other-peer"}},
+				interfaces: [][]string{{"other-interface"}},
+			},
+		},
+		"InterfaceNotManaged": {
+			status: http.StatusOK,
+			router: routerPeerGCE(),
+			mg:     routerPeerObj(routerPeerWithInterface(nil)),
+			want: want{
+				peers:      [][]string{{"other-peer"}},
+				interfaces: [][]string{{"other-interface", testRouterPeerInterface}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var patched []map[string]interface{}
+			server := routerPeerServer(t, tc.status, tc.router, &patched)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := routerPeerExternal{Service: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			peers := make([][]string, 0, len(patched))
+			interfaces := make([][]string, 0, len(patched))
+			for _, p := range patched {
+				peers = append(peers, routerListNames(p, "bgpPeers"))
+				interfaces = append(interfaces, routerListNames(p, "interfaces"))
+			}
+			if diff := cmp.Diff(tc.want.peers, peers, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Delete(...): -want peers, +got peers:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.interfaces, interfaces, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Delete(...): -want interfaces, +got interfaces:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var _ managed.ExternalConnecter = &snapshotConnector{}
+var _ managed.ExternalClient = &snapshotExternal{}
+
+const testSnapshotName = "test-snapshot"
+
+type snapshotModifier func(*v1alpha1.Snapshot)
+
+func snapshotWithConditions(c ...xpv1.Condition) snapshotModifier {
+	return func(s *v1alpha1.Snapshot) { s.Status.SetConditions(c...) }
+}
+
+func snapshotWithObservation(o v1alpha1.SnapshotObservation) snapshotModifier {
+	return func(s *v1alpha1.Snapshot) { s.Status.AtProvider = o }
+}
+
+func snapshotWithLabels(l map[string]string) snapshotModifier {
+	return func(s *v1alpha1.Snapshot) { s.Spec.ForProvider.Labels = l }
+}
+
+func snapshotObj(m ...snapshotModifier) *v1alpha1.Snapshot {
+	s := &v1alpha1.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testSnapshotName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testSnapshotName,
+			},
+		},
+		Spec: v1alpha1.SnapshotSpec{
+			ForProvider: v1alpha1.SnapshotParameters{
+				SourceDisk:   gcp.StringPtr("projects/" + projectID + "/zones/us-central1-a/disks/d"),
+				SnapshotType: gcp.StringPtr("STANDARD"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+// snapshotGCE returns the snapshot that snapshotObj describes, as returned by
+// the Compute API.
+func snapshotGCE(status string) *compute.Snapshot {
+	return &compute.Snapshot{
+		Id:               1,
+		Name:             testSnapshotName,
+		Status:           status,
+		SnapshotType:     "STANDARD",
+		DiskSizeGb:       10,
+		LabelFingerprint: "lfp",
+	}
+}
+
+func TestSnapshotObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		observed *compute.Snapshot
+		status   int
+		mg       resource.Managed
+		want     want
+	}{
+		"NotSnapshot": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotSnapshot),
+			},
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+			mg:     snapshotObj(),
+			want:   want{mg: snapshotObj()},
+		},
+		"GetFailed": {
+			status: http.StatusBadRequest,
+			mg:     snapshotObj(),
+			want: want{
+				mg:  snapshotObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetSnapshot),
+			},
+		},
+		"Uploading": {
+			status:   http.StatusOK,
+			observed: snapshotGCE(v1alpha1.SnapshotStatusUploading),
+			mg:       snapshotObj(),
+			want: want{
+				mg: snapshotObj(
+					snapshotWithConditions(xpv1.Creating()),
+					snapshotWithObservation(v1alpha1.SnapshotObservation{ID: 1, Status: v1alpha1.SnapshotStatusUploading, DiskSizeGB: 10}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LabelsChanged": {
+			status:   http.StatusOK,
+			observed: snapshotGCE(v1alpha1.SnapshotStatusReady),
+			mg:       snapshotObj(snapshotWithLabels(map[string]string{"l": "v"})),
+			want: want{
+				mg: snapshotObj(
+					snapshotWithLabels(map[string]string{"l": "v"}),
+					snapshotWithConditions(xpv1.Available()),
+					snapshotWithObservation(v1alpha1.SnapshotObservation{ID: 1, Status: v1alpha1.SnapshotStatusReady, DiskSizeGB: 10}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/global/snapshots/"+testSnapshotName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.observed == nil {
+					_ = json.NewEncoder(w).Encode(&compute.Snapshot{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.observed)
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := snapshotExternal{Service: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSnapshotUpdate(t *testing.T) {
+	var rq *compute.GlobalSetLabelsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(snapshotGCE(v1alpha1.SnapshotStatusReady))
+			return
+		}
+		rq = &compute.GlobalSetLabelsRequest{}
+		_ = json.NewDecoder(r.Body).Decode(rq)
+		_ = json.NewEncoder(w).Encode(&compute.Operation{})
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := snapshotExternal{Service: s, projectID: projectID}
+
+	if _, err := e.Update(context.Background(), snapshotObj(snapshotWithLabels(map[string]string{"l": "v"}))); err != nil {
+		t.Fatalf("Update(...): unexpected error: %s", err)
+	}
+	want := &compute.GlobalSetLabelsRequest{Labels: map[string]string{"l": "v"}, LabelFingerprint: "lfp"}
+	if diff := cmp.Diff(want, rq); diff != "" {
+		t.Errorf("Update(...): -want request, +got request:\n%s", diff)
+	}
+}
@@ -18,6 +18,7 @@ package compute
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	googlecompute "google.golang.org/api/compute/v1"
@@ -36,8 +37,17 @@ import (
 
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/outage"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/subnetwork"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/utilization"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -51,6 +61,7 @@ const (
 	errUpdateSubnetworkPAFailed = "unable to update GCP Subnetwork Private IP Google Access"
 	errCreateSubnetworkFailed   = "creation of GCP Subnetwork resource has failed"
 	errDeleteSubnetworkFailed   = "deletion of GCP Subnetwork resource has failed"
+	errSubnetworkUtilization    = "cannot determine the IP address utilization of GCP Subnetwork"
 	errCheckSubnetworkUpToDate  = "cannot determine if GCP Subnetwork is up to date"
 )
 
@@ -66,7 +77,7 @@ func SetupSubnetwork(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.SubnetworkGroupVersionKind),
-		managed.WithExternalConnecter(&subnetworkConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, outage.WrapConnecter(name, &subnetworkConnector{kube: mgr.GetClient()}))))))),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -75,7 +86,7 @@ func SetupSubnetwork(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1beta1.Subnetwork{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -120,7 +131,16 @@ func (c *subnetworkExternal) Observe(ctx context.Context, mg resource.Managed) (
 		}
 	}
 
+	last := cr.Status.AtProvider.Utilization
 	cr.Status.AtProvider = subnetwork.GenerateSubnetworkObservation(*observed)
+	cr.Status.AtProvider.Utilization = last
+	if now := time.Now(); utilization.Due(last, now) {
+		u, err := c.utilization(ctx, cr.Spec.ForProvider.Region, *observed, now)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSubnetworkUtilization)
+		}
+		cr.Status.AtProvider.Utilization = u
+	}
 
 	u, _, err := subnetwork.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
 	if err != nil {
@@ -144,10 +164,14 @@ func (c *subnetworkExternal) Create(ctx context.Context, mg resource.Managed) (m
 
 	subnet := &googlecompute.Subnetwork{}
 	subnetwork.GenerateSubnetwork(meta.GetExternalName(cr), cr.Spec.ForProvider, subnet)
-	_, err := c.Subnetworks.Insert(c.projectID, cr.Spec.ForProvider.Region, subnet).
+	op, err := c.Subnetworks.Insert(c.projectID, cr.Spec.ForProvider.Region, subnet).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnetworkFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnetworkFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *subnetworkExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -170,13 +194,21 @@ func (c *subnetworkExternal) Update(ctx context.Context, mg resource.Managed) (m
 	}
 	if privateAccess {
 		update := &googlecompute.SubnetworksSetPrivateIpGoogleAccessRequest{PrivateIpGoogleAccess: *cr.Spec.ForProvider.PrivateIPGoogleAccess}
-		_, err = c.Subnetworks.SetPrivateIpGoogleAccess(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), update).Context(ctx).Do()
-		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkPAFailed)
+		op, err := c.Subnetworks.SetPrivateIpGoogleAccess(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), update).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkPAFailed)
+		}
+		audit.RecordOperation(ctx, op.Name)
+		return managed.ExternalUpdate{}, nil
 	}
 
 	subnetUpdate := subnetwork.GenerateSubnetworkForUpdate(*cr, meta.GetExternalName(cr))
-	_, err = c.Subnetworks.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), subnetUpdate).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkFailed)
+	op, err := c.Subnetworks.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), subnetUpdate).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *subnetworkExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -186,6 +218,49 @@ func (c *subnetworkExternal) Delete(ctx context.Context, mg resource.Managed) er
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Subnetworks.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSubnetworkFailed)
+	op, err := c.Subnetworks.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSubnetworkFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+// utilization returns how many addresses of the primary IPv4 range of the
+// supplied subnetwork are used by internal addresses, instances and forwarding
+// rules.
+func (c *subnetworkExternal) utilization(ctx context.Context, region string, observed googlecompute.Subnetwork, now time.Time) (*gcpv1beta1.Utilization, error) {
+	capacity, err := subnetwork.Capacity(observed)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []*googlecompute.Address
+	if err := c.Addresses.List(c.projectID, region).Pages(ctx, func(l *googlecompute.AddressList) error {
+		addresses = append(addresses, l.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var instances []*googlecompute.Instance
+	if err := c.Instances.AggregatedList(c.projectID).Pages(ctx, func(l *googlecompute.InstanceAggregatedList) error {
+		for _, scoped := range l.Items {
+			instances = append(instances, scoped.Instances...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var rules []*googlecompute.ForwardingRule
+	if err := c.ForwardingRules.List(c.projectID, region).Pages(ctx, func(l *googlecompute.ForwardingRuleList) error {
+		rules = append(rules, l.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	used := subnetwork.UsedIPs(observed, addresses, instances, rules)
+	return utilization.New(gcpv1beta1.UtilizationUnitIPAddresses, used, capacity, now), nil
 }
@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/option"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,7 +39,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/compute/v1beta1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/subnetwork"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/utilization"
 )
 
 const (
@@ -662,3 +665,65 @@ func TestSubnetworkUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestSubnetworkObserveUtilization(t *testing.T) {
+	selfLink := "https://www.googleapis.com/compute/v1/projects/" + projectID + "/regions/us-central1/subnetworks/" + testSubnetworkName
+	other := "https://www.googleapis.com/compute/v1/projects/" + projectID + "/regions/us-central1/subnetworks/other"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/projects/"+projectID+"/regions/us-central1/subnetworks/"+testSubnetworkName, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&compute.Subnetwork{Name: testSubnetworkName, IpCidrRange: "10.0.0.0/28", SelfLink: selfLink})
+	})
+	listed := 0
+	mux.HandleFunc("/projects/"+projectID+"/regions/us-central1/addresses", func(w http.ResponseWriter, r *http.Request) {
+		listed++
+		_ = json.NewEncoder(w).Encode(&compute.AddressList{Items: []*compute.Address{
+			{Address: "10.0.0.2", Subnetwork: selfLink},
+			{Address: "10.0.0.3", Subnetwork: selfLink},
+			{Address: "10.1.0.3", Subnetwork: other},
+		}})
+	})
+	mux.HandleFunc("/projects/"+projectID+"/aggregated/instances", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&compute.InstanceAggregatedList{Items: map[string]compute.InstancesScopedList{
+			"zones/us-central1-a": {Instances: []*compute.Instance{
+				{NetworkInterfaces: []*compute.NetworkInterface{{NetworkIP: "10.0.0.2", Subnetwork: selfLink}}},
+				{NetworkInterfaces: []*compute.NetworkInterface{{NetworkIP: "10.0.0.4", Subnetwork: selfLink}}},
+			}},
+		}})
+	})
+	mux.HandleFunc("/projects/"+projectID+"/regions/us-central1/forwardingRules", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&compute.ForwardingRuleList{Items: []*compute.ForwardingRule{
+			{IPAddress: "10.0.0.5", Subnetwork: selfLink},
+		}})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	utilization.SetInterval(utilization.DefaultInterval)
+	defer utilization.SetInterval(0)
+
+	cr := subnetworkObj(func(s *v1beta1.Subnetwork) {
+		s.Spec.ForProvider.Region = "us-central1"
+		s.Spec.ForProvider.IPCidrRange = "10.0.0.0/28"
+	})
+	e := subnetworkExternal{kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}, projectID: projectID, Service: s}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+
+	// 10.0.0.2 is both reserved and used by an instance, and 10.1.0.3 is an
+	// address of another subnetwork.
+	want := &gcpv1beta1.Utilization{Unit: gcpv1beta1.UtilizationUnitIPAddresses, Used: 4, Capacity: 12, Percent: 33}
+	if diff := cmp.Diff(want, cr.Status.AtProvider.Utilization, cmpopts.IgnoreFields(gcpv1beta1.Utilization{}, "ObservedTime")); diff != "" {
+		t.Errorf("Observe(...): -want, +got:\n%s", diff)
+	}
+
+	// The utilization is not recorded again until the interval elapsed.
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if diff := cmp.Diff(1, listed); diff != "" {
+		t.Errorf("Observe(...): -want address lists, +got:\n%s", diff)
+	}
+}
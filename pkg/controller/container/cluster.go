@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
 	container "google.golang.org/api/container/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -38,7 +39,13 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta2"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
 	gke "github.com/crossplane-contrib/provider-gcp/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -52,6 +59,9 @@ const (
 	errUpdateCluster        = "cannot update GKE cluster"
 	errDeleteCluster        = "cannot delete GKE cluster"
 	errCheckClusterUpToDate = "cannot determine if GKE cluster is up to date"
+	errNewProjectsClient    = "cannot create new Resource Manager client"
+	errGetProject           = "cannot get project"
+	errGetProjectIAMPolicy  = "cannot get project IAM policy"
 )
 
 // SetupCluster adds a controller that reconciles Cluster
@@ -64,23 +74,28 @@ func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
 	}
 
+	rec := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	c := &clusterConnector{kube: mgr.GetClient(), record: rec, reporter: gke.NewReporter()}
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta2.ClusterGroupVersionKind),
-		managed.WithExternalConnecter(&clusterConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, c)))))),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(rec),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1beta2.Cluster{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
 
 type clusterConnector struct {
-	kube client.Client
+	kube     client.Client
+	record   event.Recorder
+	reporter *gke.Reporter
 }
 
 func (c *clusterConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -92,13 +107,20 @@ func (c *clusterConnector) Connect(ctx context.Context, mg resource.Managed) (ma
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &clusterExternal{cluster: s, projectID: projectID, kube: c.kube}, errors.Wrap(err, errNewClient)
+	rm, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewProjectsClient)
+	}
+	return &clusterExternal{cluster: s, projects: rm.Projects, projectID: projectID, kube: c.kube, record: c.record, reporter: c.reporter}, nil
 }
 
 type clusterExternal struct {
 	kube      client.Client
 	cluster   *container.Service
+	projects  *cloudresourcemanager.ProjectsService
 	projectID string
+	record    event.Recorder
+	reporter  *gke.Reporter
 }
 
 func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -109,7 +131,16 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 
 	existing, err := e.cluster.Projects.Locations.Clusters.Get(gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
 	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCluster)
+		if !gcp.IsErrorNotFound(err) {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetCluster)
+		}
+		if e.reporter != nil {
+			e.reporter.Forget(string(cr.GetUID()))
+		}
+		if !gcp.BoolValue(cr.Spec.PermissionPreflight) || meta.WasDeleted(cr) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, checkPermissions(ctx, e.projects, e.projectID, cr, gke.ServiceAgentGrants)
 	}
 
 	cr.Status.AtProvider = gke.GenerateObservation(*existing)
@@ -130,6 +161,8 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
 
+	e.reportProblems(ctx, cr, existing)
+
 	u, _, err := gke.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckClusterUpToDate)
@@ -142,6 +175,24 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	}, nil
 }
 
+// reportProblems emits a warning event for each problem of the supplied
+// cluster, such as a failed operation or a node pool that cannot scale up,
+// that was not reported yet. Problems are reported on a best effort basis:
+// failing to list the operations of the cluster does not fail its
+// observation.
+func (e *clusterExternal) reportProblems(ctx context.Context, cr *v1beta2.Cluster, existing *container.Cluster) {
+	if e.record == nil || e.reporter == nil {
+		return
+	}
+	ps := gke.ConditionProblems(existing)
+	if ops, err := e.cluster.Projects.Locations.Operations.List(gke.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider)).Context(ctx).Do(); err == nil {
+		ps = append(ps, gke.OperationProblems(ops.Operations, meta.GetExternalName(cr))...)
+	}
+	for _, p := range e.reporter.Unreported(string(cr.GetUID()), ps) {
+		e.record.Event(cr, p.Event())
+	}
+}
+
 func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta2.Cluster)
 	if !ok {
@@ -171,8 +222,12 @@ func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (mana
 		Cluster: cluster,
 	}
 
-	_, err := e.cluster.Projects.Locations.Clusters.Create(gke.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), create).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	op, err := e.cluster.Projects.Locations.Clusters.Create(gke.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), create).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -218,8 +273,12 @@ func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error
 		return nil
 	}
 
-	_, err := e.cluster.Projects.Locations.Clusters.Delete(gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCluster)
+	op, err := e.cluster.Projects.Locations.Clusters.Delete(gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCluster)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
 }
 
 // connectionSecret return secret object for cluster instance
@@ -243,3 +302,26 @@ func connectionDetails(cluster *container.Cluster) managed.ConnectionDetails {
 	}
 	return cd
 }
+
+// checkPermissions sets a Blocked condition on the supplied managed resource
+// and returns an error if any of the grants returned by required are missing
+// from the IAM policy of the project.
+func checkPermissions(ctx context.Context, projects *cloudresourcemanager.ProjectsService, projectID string, mg resource.Managed, required func(projectNumber int64) []gke.Grant) error {
+	p, err := projects.Get(projectID).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(err, errGetProject)
+	}
+	req := &cloudresourcemanager.GetIamPolicyRequest{
+		Options: &cloudresourcemanager.GetPolicyOptions{RequestedPolicyVersion: gke.IAMPolicyVersion},
+	}
+	policy, err := projects.GetIamPolicy(projectID, req).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(err, errGetProjectIAMPolicy)
+	}
+	missing := gke.MissingGrants(policy, required(p.ProjectNumber))
+	if len(missing) == 0 {
+		return nil
+	}
+	mg.SetConditions(gke.Blocked(missing))
+	return errors.New(gke.MissingGrantsMessage(missing))
+}
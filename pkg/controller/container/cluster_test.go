@@ -27,14 +27,17 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
 	container "google.golang.org/api/container/v1"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -47,8 +50,9 @@ import (
 const (
 	name = "test-cluster"
 
-	projectID    = "myproject-id-1234"
-	providerName = "gcp-provider"
+	projectID     = "myproject-id-1234"
+	projectNumber = 1234
+	providerName  = "gcp-provider"
 )
 
 var errBoom = errors.New("boom")
@@ -86,6 +90,29 @@ func withUsername(u string) clusterModifier {
 	}
 }
 
+func withPermissionPreflight() clusterModifier {
+	return func(i *v1beta2.Cluster) { i.Spec.PermissionPreflight = &[]bool{true}[0] }
+}
+
+// preflightHandler serves GKE requests with a not found error and Resource
+// Manager requests with the supplied project IAM policy.
+func preflightHandler(t *testing.T, policy *cloudresourcemanager.Policy) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		switch r.URL.Path {
+		case "/v1/projects/" + projectID:
+			_ = json.NewEncoder(w).Encode(&cloudresourcemanager.Project{ProjectId: projectID, ProjectNumber: projectNumber})
+		case "/v1/projects/" + projectID + ":getIamPolicy":
+			if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(policy)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
 func cluster(im ...clusterModifier) *v1beta2.Cluster {
 	i := &v1beta2.Cluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -142,6 +169,36 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"PreflightPassed": {
+			handler: preflightHandler(t, &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{
+					{Role: gke.RoleGKEServiceAgent, Members: []string{"serviceAccount:service-1234@container-engine-robot.iam.gserviceaccount.com"}},
+					{Role: gke.RoleComputeServiceAgent, Members: []string{"serviceAccount:service-1234@compute-system.iam.gserviceaccount.com"}},
+				},
+			}),
+			args: args{
+				mg: cluster(withPermissionPreflight()),
+			},
+			want: want{
+				mg: cluster(withPermissionPreflight()),
+			},
+		},
+		"PreflightMissingGrants": {
+			handler: preflightHandler(t, &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{
+					{Role: gke.RoleGKEServiceAgent, Members: []string{"serviceAccount:service-1234@container-engine-robot.iam.gserviceaccount.com"}},
+				},
+			}),
+			args: args{
+				mg: cluster(withPermissionPreflight()),
+			},
+			want: want{
+				mg: cluster(withPermissionPreflight(), withConditions(gke.Blocked([]gke.Grant{
+					{Role: gke.RoleComputeServiceAgent, Member: "serviceAccount:service-1234@compute-system.iam.gserviceaccount.com"},
+				}))),
+				err: errors.New("missing IAM grants: roles/compute.serviceAgent for serviceAccount:service-1234@compute-system.iam.gserviceaccount.com"),
+			},
+		},
 		"GetFailed": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -320,10 +377,12 @@ func TestObserve(t *testing.T) {
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			rm, _ := cloudresourcemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			e := clusterExternal{
 				kube:      tc.kube,
 				projectID: projectID,
 				cluster:   s,
+				projects:  rm.Projects,
 			}
 			obs, err := e.Observe(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
@@ -346,6 +405,83 @@ func TestObserve(t *testing.T) {
 	}
 }
 
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) WithAnnotations(...string) event.Recorder {
+	return r
+}
+
+func TestObserveReportsProblems(t *testing.T) {
+	quota := "Insufficient regional quota to satisfy request: resource \"CPUS\""
+	ops := &container.ListOperationsResponse{Operations: []*container.Operation{
+		{
+			Name:          "operation-1",
+			OperationType: "UPDATE_CLUSTER",
+			Status:        "DONE",
+			TargetLink:    "https://container.googleapis.com/v1/projects/" + projectID + "/locations/us-central1/clusters/" + name,
+			Error:         &container.Status{Code: 8, Message: quota},
+		},
+		{
+			Name:               "operation-2",
+			OperationType:      "CREATE_NODE_POOL",
+			Status:             "RUNNING",
+			TargetLink:         "https://container.googleapis.com/v1/projects/" + projectID + "/locations/us-central1/clusters/" + name + "/nodePools/pool",
+			NodepoolConditions: []*container.StatusCondition{{Message: "IP space exhausted"}},
+		},
+		{
+			Name:          "operation-3",
+			OperationType: "UPDATE_CLUSTER",
+			Status:        "DONE",
+			TargetLink:    "https://container.googleapis.com/v1/projects/" + projectID + "/locations/us-central1/clusters/other-cluster",
+			Error:         &container.Status{Code: 8, Message: quota},
+		},
+	}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		switch r.URL.Path {
+		case "/v1/projects/" + projectID + "/locations/us-central1/operations":
+			_ = json.NewEncoder(w).Encode(ops)
+		default:
+			_ = json.NewEncoder(w).Encode(&container.Cluster{
+				Status:     v1beta2.ClusterStateRunning,
+				Conditions: []*container.StatusCondition{{Message: "Node pool pool cannot scale up"}},
+			})
+		}
+	}))
+	defer server.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	rec := &recorder{}
+	e := clusterExternal{
+		kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+		projectID: projectID,
+		cluster:   s,
+		record:    rec,
+		reporter:  gke.NewReporter(),
+	}
+	cr := cluster(func(c *v1beta2.Cluster) { c.Spec.ForProvider.Location = "us-central1" })
+	for i := 0; i < 2; i++ {
+		if _, err := e.Observe(context.Background(), cr); err != nil {
+			t.Fatalf("Observe(...): %s", err)
+		}
+	}
+
+	want := []event.Event{
+		event.Warning(gke.ReasonClusterCondition, errors.New("Node pool pool cannot scale up")),
+		event.Warning(gke.ReasonOperationFailed, errors.New("UPDATE_CLUSTER operation operation-1 failed: "+quota)),
+		event.Warning(gke.ReasonOperationWarning, errors.New("CREATE_NODE_POOL operation operation-2: IP space exhausted")),
+	}
+	if diff := cmp.Diff(want, rec.events); diff != "" {
+		t.Errorf("Observe(...): -want events, +got events:\n%s", diff)
+	}
+}
+
 func TestCreate(t *testing.T) {
 	wantRandom := "i-want-random-data-not-this-special-string"
 
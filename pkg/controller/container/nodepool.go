@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
 	container "google.golang.org/api/container/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,7 +38,14 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/catalog"
+	gke "github.com/crossplane-contrib/provider-gcp/pkg/clients/cluster"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
 	np "github.com/crossplane-contrib/provider-gcp/pkg/clients/nodepool"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -64,7 +72,7 @@ func SetupNodePool(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.NodePoolGroupVersionKind),
-		managed.WithExternalConnecter(&nodePoolConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), catalog.WrapConnecter(o, mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &nodePoolConnector{kube: mgr.GetClient()})))))),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -72,7 +80,7 @@ func SetupNodePool(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1beta1.NodePool{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -90,12 +98,17 @@ func (c *nodePoolConnector) Connect(ctx context.Context, mg resource.Managed) (m
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &nodePoolExternal{container: s, projectID: projectID, kube: c.kube}, errors.Wrap(err, errNewClient)
+	rm, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewProjectsClient)
+	}
+	return &nodePoolExternal{container: s, projects: rm.Projects, projectID: projectID, kube: c.kube}, nil
 }
 
 type nodePoolExternal struct {
 	kube      client.Client
 	container *container.Service
+	projects  *cloudresourcemanager.ProjectsService
 	projectID string
 }
 
@@ -107,7 +120,19 @@ func (e *nodePoolExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 
 	existing, err := e.container.Projects.Locations.Clusters.NodePools.Get(np.GetFullyQualifiedName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
 	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNodePool)
+		if !gcp.IsErrorNotFound(err) {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetNodePool)
+		}
+		if !gcp.BoolValue(cr.Spec.PermissionPreflight) || meta.WasDeleted(cr) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, checkPermissions(ctx, e.projects, e.projectID, cr, func(projectNumber int64) []gke.Grant {
+			sa := ""
+			if cr.Spec.ForProvider.Config != nil {
+				sa = gcp.StringValue(cr.Spec.ForProvider.Config.ServiceAccount)
+			}
+			return append(gke.ServiceAgentGrants(projectNumber), gke.NodeServiceAccountGrants(projectNumber, sa)...)
+		})
 	}
 
 	cr.Status.AtProvider = np.GenerateObservation(*existing)
@@ -159,8 +184,12 @@ func (e *nodePoolExternal) Create(ctx context.Context, mg resource.Managed) (man
 		NodePool: pool,
 	}
 
-	_, err := e.container.Projects.Locations.Clusters.NodePools.Create(cr.Spec.ForProvider.Cluster, create).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateNodePool)
+	op, err := e.container.Projects.Locations.Clusters.NodePools.Create(cr.Spec.ForProvider.Cluster, create).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNodePool)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *nodePoolExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -208,6 +237,10 @@ func (e *nodePoolExternal) Delete(ctx context.Context, mg resource.Managed) erro
 		return nil
 	}
 
-	_, err := e.container.Projects.Locations.Clusters.NodePools.Delete(np.GetFullyQualifiedName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNodePool)
+	op, err := e.container.Projects.Locations.Clusters.NodePools.Delete(np.GetFullyQualifiedName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNodePool)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
 }
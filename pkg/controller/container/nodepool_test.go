@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
 	container "google.golang.org/api/container/v1"
 	"google.golang.org/api/option"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,6 +39,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/container/v1beta1"
+	gke "github.com/crossplane-contrib/provider-gcp/pkg/clients/cluster"
 	np "github.com/crossplane-contrib/provider-gcp/pkg/clients/nodepool"
 )
 
@@ -55,6 +57,14 @@ func npWithLocations(l []string) nodePoolModifier {
 	return func(i *v1beta1.NodePool) { i.Spec.ForProvider.Locations = l }
 }
 
+func npWithPermissionPreflight() nodePoolModifier {
+	return func(i *v1beta1.NodePool) { i.Spec.PermissionPreflight = &[]bool{true}[0] }
+}
+
+func npWithServiceAccount(sa string) nodePoolModifier {
+	return func(i *v1beta1.NodePool) { i.Spec.ForProvider.Config = &v1beta1.NodeConfig{ServiceAccount: &sa} }
+}
+
 func nodePool(im ...nodePoolModifier) *v1beta1.NodePool {
 	i := &v1beta1.NodePool{
 		ObjectMeta: metav1.ObjectMeta{
@@ -114,6 +124,40 @@ func TestNodePoolObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"PreflightPassed": {
+			handler: preflightHandler(t, &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{
+					{Role: gke.RoleGKEServiceAgent, Members: []string{"serviceAccount:service-1234@container-engine-robot.iam.gserviceaccount.com"}},
+					{Role: gke.RoleComputeServiceAgent, Members: []string{"serviceAccount:service-1234@compute-system.iam.gserviceaccount.com"}},
+					{Role: gke.RoleDefaultNodeServiceAccount, Members: []string{"serviceAccount:nodes@myproject-id-1234.iam.gserviceaccount.com"}},
+				},
+			}),
+			args: args{
+				mg: nodePool(npWithPermissionPreflight(), npWithServiceAccount("nodes@myproject-id-1234.iam.gserviceaccount.com")),
+			},
+			want: want{
+				mg: nodePool(npWithPermissionPreflight(), npWithServiceAccount("nodes@myproject-id-1234.iam.gserviceaccount.com")),
+			},
+		},
+		"PreflightMissingGrants": {
+			handler: preflightHandler(t, &cloudresourcemanager.Policy{
+				Bindings: []*cloudresourcemanager.Binding{
+					{Role: gke.RoleGKEServiceAgent, Members: []string{"serviceAccount:service-1234@container-engine-robot.iam.gserviceaccount.com"}},
+					{Role: gke.RoleComputeServiceAgent, Members: []string{"serviceAccount:service-1234@compute-system.iam.gserviceaccount.com"}},
+					{Role: "roles/logging.logWriter", Members: []string{"serviceAccount:1234-compute@developer.gserviceaccount.com"}},
+				},
+			}),
+			args: args{
+				mg: nodePool(npWithPermissionPreflight()),
+			},
+			want: want{
+				mg: nodePool(npWithPermissionPreflight(), npWithConditions(gke.Blocked([]gke.Grant{
+					{Role: "roles/monitoring.metricWriter", Member: "serviceAccount:1234-compute@developer.gserviceaccount.com"},
+					{Role: "roles/monitoring.viewer", Member: "serviceAccount:1234-compute@developer.gserviceaccount.com"},
+				}))),
+				err: errors.New("missing IAM grants: roles/monitoring.metricWriter for serviceAccount:1234-compute@developer.gserviceaccount.com, roles/monitoring.viewer for serviceAccount:1234-compute@developer.gserviceaccount.com"),
+			},
+		},
 		"GetFailed": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -279,10 +323,12 @@ func TestNodePoolObserve(t *testing.T) {
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			rm, _ := cloudresourcemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			e := nodePoolExternal{
 				kube:      tc.kube,
 				projectID: projectID,
 				container: s,
+				projects:  rm.Projects,
 			}
 			obs, err := e.Observe(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
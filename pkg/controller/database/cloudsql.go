@@ -19,9 +19,12 @@ package database
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -39,7 +42,14 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cloudsql"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failover"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/utilization"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -52,9 +62,14 @@ const (
 	errNameInUse        = "cannot create new CloudSQL instance, resource name is unavailable because it is in use or was used recently"
 	errDeleteFailed     = "cannot delete the CloudSQL instance"
 	errUpdateFailed     = "cannot update the CloudSQL instance"
+	errPromoteFailed    = "cannot promote the CloudSQL instance"
 	errGetFailed        = "cannot get the CloudSQL instance"
 	errGeneratePassword = "cannot generate root password"
 	errCheckUpToDate    = "cannot determine if CloudSQL instance is up to date"
+	errListCerts        = "cannot list CloudSQL instance client certificates"
+	errCreateCert       = "cannot create CloudSQL instance client certificate"
+	errDeleteCert       = "cannot delete CloudSQL instance client certificate"
+	errGetSecret        = "cannot get CloudSQL instance connection secret"
 )
 
 // SetupCloudSQLInstance adds a controller that reconciles
@@ -69,7 +84,7 @@ func SetupCloudSQLInstance(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.CloudSQLInstanceGroupVersionKind),
-		managed.WithExternalConnecter(&cloudsqlConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &cloudsqlConnector{kube: mgr.GetClient()}))))),
 		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &cloudsqlTagger{kube: mgr.GetClient()}),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -78,7 +93,7 @@ func SetupCloudSQLInstance(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1beta1.CloudSQLInstance{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -96,12 +111,13 @@ func (c *cloudsqlConnector) Connect(ctx context.Context, mg resource.Managed) (m
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &cloudsqlExternal{kube: c.kube, db: s.Instances, projectID: projectID}, nil
+	return &cloudsqlExternal{kube: c.kube, db: s.Instances, certs: s.SslCerts, projectID: projectID}, nil
 }
 
 type cloudsqlExternal struct {
 	kube      client.Client
 	db        *sqladmin.InstancesService
+	certs     *sqladmin.SslCertsService
 	projectID string
 }
 
@@ -123,7 +139,13 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 		}
 	}
+	last := cr.Status.AtProvider.Utilization
 	cr.Status.AtProvider = cloudsql.GenerateObservation(*instance)
+	cr.Status.AtProvider.Utilization = last
+	if now := time.Now(); utilization.Due(last, now) {
+		cr.Status.AtProvider.Utilization = cloudsql.StorageUtilization(*instance, now)
+	}
+	cr.Status.AtProvider.Failover = failover.Observe(cr.Spec.ForProvider.Failover, cloudsql.FailoverRole(*instance), cloudsql.FailoverPeers(*instance))
 	switch cr.Status.AtProvider.State {
 	case v1beta1.StateRunnable:
 		cr.Status.SetConditions(xpv1.Available())
@@ -137,9 +159,19 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	}
+	// Unsupported settings are ignored when generating the instance, so the
+	// instance is reported as not up to date for Update to reject them.
+	upToDate = upToDate && cloudsql.ValidateIAMAuthentication(cr.Spec.ForProvider) == nil
+	if cloudsql.WantsClientCertificate(cr) && cr.Status.AtProvider.State == v1beta1.StateRunnable {
+		usable, err := c.hasUsableClientCertificate(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = upToDate && usable
+	}
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  upToDate,
+		ResourceUpToDate:  upToDate && !failover.NeedsPromotion(cr.Spec.ForProvider.Failover, cr.Status.AtProvider.Failover),
 		ConnectionDetails: getConnectionDetails(cr, instance),
 	}, nil
 }
@@ -149,6 +181,9 @@ func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (man
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotCloudSQL)
 	}
+	if err := cloudsql.ValidateIAMAuthentication(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	cr.SetConditions(xpv1.Creating())
 	instance := &sqladmin.DatabaseInstance{}
 	cloudsql.GenerateDatabaseInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, instance)
@@ -158,7 +193,8 @@ func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (man
 	}
 
 	instance.RootPassword = pw
-	if _, err := c.db.Insert(c.projectID, instance).Context(ctx).Do(); err != nil {
+	op, err := c.db.Insert(c.projectID, instance).Context(ctx).Do()
+	if err != nil {
 		// We don't want to return (and thus publish) our randomly generated
 		// password if we didn't actually successfully create a new instance.
 		if gcp.IsErrorAlreadyExists(err) {
@@ -166,6 +202,7 @@ func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (man
 		}
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
+	audit.RecordOperation(ctx, op.Name)
 
 	cd := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
@@ -178,15 +215,46 @@ func (c *cloudsqlExternal) Update(ctx context.Context, mg resource.Managed) (man
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotCloudSQL)
 	}
+	if err := cloudsql.ValidateIAMAuthentication(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	if cr.Status.AtProvider.State == v1beta1.StateCreating {
 		return managed.ExternalUpdate{}, nil
 	}
+	if failover.NeedsPromotion(cr.Spec.ForProvider.Failover, cr.Status.AtProvider.Failover) {
+		op, err := c.db.PromoteReplica(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPromoteFailed)
+		}
+		audit.RecordOperation(ctx, op.Name)
+		return managed.ExternalUpdate{}, nil
+	}
+	if cloudsql.WantsClientCertificate(cr) {
+		cert, err := c.getClientCertificate(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if cert == nil {
+			return c.createClientCertificate(ctx, cr)
+		}
+		published, err := c.isClientKeyPublished(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if !published {
+			return managed.ExternalUpdate{}, c.deleteClientCertificate(ctx, cr, cert)
+		}
+	}
 	instance := &sqladmin.DatabaseInstance{}
 	cloudsql.GenerateDatabaseInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, instance)
 	// TODO(muvaf): the returned operation handle could help us not to send Patch
 	// request aggressively.
-	_, err := c.db.Patch(c.projectID, meta.GetExternalName(cr), instance).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	op, err := c.db.Patch(c.projectID, meta.GetExternalName(cr), instance).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *cloudsqlExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -195,11 +263,76 @@ func (c *cloudsqlExternal) Delete(ctx context.Context, mg resource.Managed) erro
 		return errors.New(errNotCloudSQL)
 	}
 	cr.SetConditions(xpv1.Deleting())
-	_, err := c.db.Delete(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	op, err := c.db.Delete(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
 	if gcp.IsErrorNotFound(err) {
 		return nil
 	}
-	return errors.Wrap(err, errDeleteFailed)
+	if err != nil {
+		return errors.Wrap(err, errDeleteFailed)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+func (c *cloudsqlExternal) getClientCertificate(ctx context.Context, cr *v1beta1.CloudSQLInstance) (*sqladmin.SslCert, error) {
+	res, err := c.certs.List(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, errListCerts)
+	}
+	return cloudsql.FindClientCertificate(res.Items, cloudsql.ClientCertificateName(meta.GetExternalName(cr))), nil
+}
+
+// hasUsableClientCertificate returns true if the client certificate of the
+// instance exists and its private key was published.
+func (c *cloudsqlExternal) hasUsableClientCertificate(ctx context.Context, cr *v1beta1.CloudSQLInstance) (bool, error) {
+	cert, err := c.getClientCertificate(ctx, cr)
+	if err != nil || cert == nil {
+		return false, err
+	}
+	return c.isClientKeyPublished(ctx, cr)
+}
+
+// isClientKeyPublished returns true if the connection secret of the instance
+// contains the private key of its client certificate. It is assumed to be if
+// the instance has no connection secret to check.
+func (c *cloudsqlExternal) isClientKeyPublished(ctx context.Context, cr *v1beta1.CloudSQLInstance) (bool, error) {
+	ref := cr.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return true, nil
+	}
+	s := &corev1.Secret{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return false, errors.Wrap(resource.IgnoreNotFound(err), errGetSecret)
+	}
+	return len(s.Data[v1beta1.CloudSQLSecretClientKeyKey]) > 0, nil
+}
+
+// deleteClientCertificate deletes a client certificate whose private key was
+// lost, e.g. because it could not be published when the certificate was
+// created. The key is only ever returned when a certificate is created, so
+// it is replaced by a new certificate once the deletion has completed.
+func (c *cloudsqlExternal) deleteClientCertificate(ctx context.Context, cr *v1beta1.CloudSQLInstance, cert *sqladmin.SslCert) error {
+	op, err := c.certs.Delete(c.projectID, meta.GetExternalName(cr), cert.Sha1Fingerprint).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCert)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+// createClientCertificate creates a client certificate for the instance. The
+// private key is only returned once, so it is published as soon as the
+// certificate is created.
+func (c *cloudsqlExternal) createClientCertificate(ctx context.Context, cr *v1beta1.CloudSQLInstance) (managed.ExternalUpdate, error) {
+	req := &sqladmin.SslCertsInsertRequest{CommonName: cloudsql.ClientCertificateName(meta.GetExternalName(cr))}
+	res, err := c.certs.Insert(c.projectID, meta.GetExternalName(cr), req).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateCert)
+	}
+	if res.Operation != nil {
+		audit.RecordOperation(ctx, res.Operation.Name)
+	}
+	return managed.ExternalUpdate{ConnectionDetails: cloudsql.GetClientCertificate(res.ClientCert)}, nil
 }
 
 func getConnectionDetails(cr *v1beta1.CloudSQLInstance, instance *sqladmin.DatabaseInstance) managed.ConnectionDetails {
@@ -228,6 +361,11 @@ func getConnectionDetails(cr *v1beta1.CloudSQLInstance, instance *sqladmin.Datab
 	for k, v := range serverCACert {
 		m[k] = v
 	}
+	if cloudsql.UsesCloudSQLProxy(cr) {
+		for k, v := range cloudsql.GetProxyConnectionDetails(cr.Spec.ForProvider, *instance) {
+			m[k] = v
+		}
+	}
 
 	return m
 }
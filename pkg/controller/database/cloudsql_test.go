@@ -22,12 +22,14 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -39,6 +41,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/database/v1beta1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cloudsql"
 )
 
@@ -94,6 +98,46 @@ func withBackupConfigurationStartTime(h string) instanceModifier {
 	}
 }
 
+func withFailover(role string, promote bool) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ForProvider.Failover = &gcpv1beta1.FailoverSpec{LocationClass: gcpv1beta1.LocationClassSecondary, Promote: promote}
+		i.Status.AtProvider.Failover = &gcpv1beta1.FailoverStatus{LocationClass: gcpv1beta1.LocationClassSecondary, Role: role}
+	}
+}
+
+func withCloudSQLProxy(generateCert bool) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ConnectionDetails = &v1beta1.ConnectionDetailsConfig{
+			Format:                    gcp.StringPtr(v1beta1.ConnectionDetailsFormatCloudSQLProxy),
+			GenerateClientCertificate: &generateCert,
+		}
+	}
+}
+
+func withConnectionSecret() instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Namespace: "crossplane-system", Name: name}
+	}
+}
+
+// secretWithClientKey returns a MockGetFn that gets a connection secret,
+// which contains a client key if the supplied key is not empty.
+func secretWithClientKey(key string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		if s, ok := obj.(*corev1.Secret); ok && key != "" {
+			s.Data = map[string][]byte{v1beta1.CloudSQLSecretClientKeyKey: []byte(key)}
+		}
+		return nil
+	}
+}
+
+func withIAMAuthentication(databaseVersion string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ForProvider.DatabaseVersion = &databaseVersion
+		i.Spec.ForProvider.Settings.IAMAuthentication = gcp.BoolPtr(true)
+	}
+}
+
 func instance(im ...instanceModifier) *v1beta1.CloudSQLInstance {
 	i := &v1beta1.CloudSQLInstance{
 		ObjectMeta: metav1.ObjectMeta{
@@ -312,6 +356,95 @@ func TestObserve(t *testing.T) {
 					withConnectionName(connectionName)),
 			},
 		},
+		"RunnableMissingClientCertificate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				var res interface{} = &sqladmin.SslCertsListResponse{}
+				if !strings.HasSuffix(r.URL.Path, "/sslCerts") {
+					db := &sqladmin.DatabaseInstance{}
+					cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance()), instance().Spec.ForProvider, db)
+					db.ConnectionName = connectionName
+					db.State = v1beta1.StateRunnable
+					res = db
+				}
+				if err := json.NewEncoder(w).Encode(res); err != nil {
+					t.Error(err)
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: instance(withCloudSQLProxy(true)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: connDetails("", "", map[string][]byte{
+						v1beta1.CloudSQLSecretConnectionName:            []byte(connectionName),
+						v1beta1.CloudSQLSecretInstanceConnectionNameKey: []byte(connectionName),
+						xpv1.ResourceCredentialsSecretEndpointKey:       []byte(v1beta1.CloudSQLProxyEndpoint),
+						xpv1.ResourceCredentialsSecretPortKey:           []byte("3306"),
+					}),
+				},
+				mg: instance(
+					withCloudSQLProxy(true),
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available()),
+					withConnectionName(connectionName)),
+			},
+		},
+		"RunnableClientKeyNotPublished": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				var res interface{} = &sqladmin.SslCertsListResponse{
+					Items: []*sqladmin.SslCert{{CommonName: cloudsql.ClientCertificateName(name)}},
+				}
+				if !strings.HasSuffix(r.URL.Path, "/sslCerts") {
+					db := &sqladmin.DatabaseInstance{}
+					cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance()), instance().Spec.ForProvider, db)
+					db.ConnectionName = connectionName
+					db.State = v1beta1.StateRunnable
+					res = db
+				}
+				if err := json.NewEncoder(w).Encode(res); err != nil {
+					t.Error(err)
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: secretWithClientKey(""),
+			},
+			args: args{
+				mg: instance(withCloudSQLProxy(true), withConnectionSecret()),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: connDetails("", "", map[string][]byte{
+						v1beta1.CloudSQLSecretConnectionName:            []byte(connectionName),
+						v1beta1.CloudSQLSecretInstanceConnectionNameKey: []byte(connectionName),
+						xpv1.ResourceCredentialsSecretEndpointKey:       []byte(v1beta1.CloudSQLProxyEndpoint),
+						xpv1.ResourceCredentialsSecretPortKey:           []byte("3306"),
+					}),
+				},
+				mg: instance(
+					withCloudSQLProxy(true),
+					withConnectionSecret(),
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available()),
+					withConnectionName(connectionName)),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -323,6 +456,7 @@ func TestObserve(t *testing.T) {
 				kube:      tc.kube,
 				projectID: projectID,
 				db:        s.Instances,
+				certs:     s.SslCerts,
 			}
 			obs, err := e.Observe(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
@@ -436,6 +570,19 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateFailed),
 			},
 		},
+		"IAMAuthenticationUnsupported": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			args: args{
+				mg: instance(withIAMAuthentication("SQLSERVER_2019_STANDARD")),
+			},
+			want: want{
+				mg:  instance(withIAMAuthentication("SQLSERVER_2019_STANDARD")),
+				err: errors.New("iamAuthentication is only supported for MySQL and PostgreSQL instances"),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -628,6 +775,19 @@ func TestUpdate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"IAMAuthenticationUnsupported": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			args: args{
+				mg: instance(withIAMAuthentication("SQLSERVER_2019_STANDARD")),
+			},
+			want: want{
+				mg:  instance(withIAMAuthentication("SQLSERVER_2019_STANDARD")),
+				err: errors.New("iamAuthentication is only supported for MySQL and PostgreSQL instances"),
+			},
+		},
 		"NoUpdateNecessary": {
 			args: args{
 				mg: instance(withProviderState(v1beta1.StateCreating)),
@@ -637,6 +797,165 @@ func TestUpdate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"Promote": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if !strings.HasSuffix(r.URL.Path, "/promoteReplica") {
+					t.Errorf("r: unexpected path %q", r.URL.Path)
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(&sqladmin.Operation{}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				mg: instance(withFailover(gcpv1beta1.FailoverRoleStandby, true)),
+			},
+			want: want{
+				mg: instance(withFailover(gcpv1beta1.FailoverRoleStandby, true)),
+			},
+		},
+		"CreateClientCertificate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if !strings.HasSuffix(r.URL.Path, "/sslCerts") {
+					t.Errorf("r: unexpected path %q", r.URL.Path)
+				}
+				w.WriteHeader(http.StatusOK)
+				var res interface{} = &sqladmin.SslCertsListResponse{
+					Items: []*sqladmin.SslCert{{CommonName: "someone-else"}},
+				}
+				if r.Method == http.MethodPost {
+					res = &sqladmin.SslCertsInsertResponse{
+						ClientCert: &sqladmin.SslCertDetail{
+							CertInfo:       &sqladmin.SslCert{Cert: "client-cert"},
+							CertPrivateKey: "client-key",
+						},
+						Operation: &sqladmin.Operation{},
+					}
+				}
+				if err := json.NewEncoder(w).Encode(res); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				mg: instance(withCloudSQLProxy(true)),
+			},
+			want: want{
+				mg: instance(withCloudSQLProxy(true)),
+				upd: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						v1beta1.CloudSQLSecretClientCertificateKey: []byte("client-cert"),
+						v1beta1.CloudSQLSecretClientKeyKey:         []byte("client-key"),
+					},
+				},
+			},
+		},
+		"ClientCertificateExists": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				var res interface{} = &sqladmin.Operation{}
+				if r.Method == http.MethodGet {
+					res = &sqladmin.SslCertsListResponse{
+						Items: []*sqladmin.SslCert{{CommonName: cloudsql.ClientCertificateName(name)}},
+					}
+				}
+				if r.Method == http.MethodPost {
+					t.Errorf("r: unexpected %s %q", r.Method, r.URL.Path)
+				}
+				if err := json.NewEncoder(w).Encode(res); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				mg: instance(withCloudSQLProxy(true)),
+			},
+			want: want{
+				mg: instance(withCloudSQLProxy(true)),
+			},
+		},
+		"ClientKeyPublished": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				var res interface{} = &sqladmin.Operation{}
+				if r.Method == http.MethodGet {
+					res = &sqladmin.SslCertsListResponse{
+						Items: []*sqladmin.SslCert{{CommonName: cloudsql.ClientCertificateName(name), Sha1Fingerprint: "fingerprint"}},
+					}
+				}
+				if r.Method == http.MethodPost || r.Method == http.MethodDelete {
+					t.Errorf("r: unexpected %s %q", r.Method, r.URL.Path)
+				}
+				if err := json.NewEncoder(w).Encode(res); err != nil {
+					t.Error(err)
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: secretWithClientKey("client-key"),
+			},
+			args: args{
+				mg: instance(withCloudSQLProxy(true), withConnectionSecret()),
+			},
+			want: want{
+				mg: instance(withCloudSQLProxy(true), withConnectionSecret()),
+			},
+		},
+		"ClientKeyNotPublished": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				var res interface{} = &sqladmin.SslCertsListResponse{
+					Items: []*sqladmin.SslCert{{CommonName: cloudsql.ClientCertificateName(name), Sha1Fingerprint: "fingerprint"}},
+				}
+				switch r.Method {
+				case http.MethodDelete:
+					if !strings.HasSuffix(r.URL.Path, "/sslCerts/fingerprint") {
+						t.Errorf("r: unexpected path %q", r.URL.Path)
+					}
+					res = &sqladmin.Operation{}
+				case http.MethodGet:
+				default:
+					t.Errorf("r: unexpected %s %q", r.Method, r.URL.Path)
+				}
+				if err := json.NewEncoder(w).Encode(res); err != nil {
+					t.Error(err)
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: secretWithClientKey(""),
+			},
+			args: args{
+				mg: instance(withCloudSQLProxy(true), withConnectionSecret()),
+			},
+			want: want{
+				mg: instance(withCloudSQLProxy(true), withConnectionSecret()),
+			},
+		},
+		"CreateClientCertificateFails": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				var res interface{} = &sqladmin.SslCertsListResponse{}
+				if r.Method == http.MethodPost {
+					w.WriteHeader(http.StatusBadRequest)
+					res = &sqladmin.SslCertsInsertResponse{}
+				}
+				if err := json.NewEncoder(w).Encode(res); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				mg: instance(withCloudSQLProxy(true)),
+			},
+			want: want{
+				mg:  instance(withCloudSQLProxy(true)),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateCert),
+			},
+		},
 		"PatchFails": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -675,6 +994,7 @@ func TestUpdate(t *testing.T) {
 				kube:      tc.kube,
 				projectID: projectID,
 				db:        s.Instances,
+				certs:     s.SslCerts,
 			}
 			upd, err := e.Update(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
@@ -744,6 +1064,25 @@ func TestGetConnectionDetails(t *testing.T) {
 				}),
 			},
 		},
+		"CloudSQLProxy": {
+			args: args{
+				cr: instance(
+					withCloudSQLProxy(false),
+					withPrivateIP(privateIP),
+				),
+				i: &sqladmin.DatabaseInstance{
+					ConnectionName: connectionName,
+				},
+			},
+			want: want{
+				conn: connDetails(privateIP, "", map[string][]byte{
+					v1beta1.CloudSQLSecretConnectionName:            []byte(connectionName),
+					v1beta1.CloudSQLSecretInstanceConnectionNameKey: []byte(connectionName),
+					xpv1.ResourceCredentialsSecretEndpointKey:       []byte(v1beta1.CloudSQLProxyEndpoint),
+					xpv1.ResourceCredentialsSecretPortKey:           []byte("3306"),
+				}),
+			},
+		},
 	}
 
 	for name, tc := range cases {
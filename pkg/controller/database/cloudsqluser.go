@@ -0,0 +1,281 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cloudsqluser"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/projectpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	errNotCloudSQLUser = "managed resource is not a CloudSQLUser custom resource"
+
+	errNewCRMClient         = "cannot create new Cloud Resource Manager API client"
+	errGetUser              = "cannot get the CloudSQL user"
+	errCreateUser           = "cannot create the CloudSQL user"
+	errDeleteUser           = "cannot delete the CloudSQL user"
+	errGetUserInstance      = "cannot get the CloudSQL instance of the user"
+	errGenerateUserPassword = "cannot generate user password"
+	errGetPolicy            = "cannot get GCP project IAM policy via Cloud Resource Manager API"
+	errSetPolicy            = "cannot set GCP project IAM policy via Cloud Resource Manager API"
+)
+
+// SetupCloudSQLUser adds a controller that reconciles CloudSQLUser managed
+// resources.
+func SetupCloudSQLUser(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CloudSQLUserGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CloudSQLUserGroupVersionKind),
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &cloudsqlUserConnector{kube: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.CloudSQLUser{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type cloudsqlUserConnector struct {
+	kube client.Client
+}
+
+func (c *cloudsqlUserConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := sqladmin.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	crm, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewCRMClient)
+	}
+	return &cloudsqlUserExternal{users: s.Users, db: s.Instances, projects: crm.Projects, projectID: projectID}, nil
+}
+
+type cloudsqlUserExternal struct {
+	users     cloudsqluser.Client
+	db        *sqladmin.InstancesService
+	projects  projectpolicy.Client
+	projectID string
+}
+
+func (e *cloudsqlUserExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CloudSQLUser)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudSQLUser)
+	}
+
+	// The names of IAM users are derived from their email address when they
+	// are created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	call := e.users.Get(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), meta.GetExternalName(cr))
+	if h := gcp.StringValue(cr.Spec.ForProvider.Host); h != "" {
+		call = call.Host(h)
+	}
+	u, err := call.Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetUser)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	cloudsqluser.LateInitializeSpec(&cr.Spec.ForProvider, *u)
+
+	bound := cr.Status.AtProvider.BoundMembers
+	cr.Status.AtProvider = cloudsqluser.GenerateObservation(*u)
+	cr.Status.AtProvider.BoundMembers = bound
+	cr.SetConditions(xpv1.Available())
+
+	upToDate, err := e.isInstanceUserRoleUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ConnectionDetails:       cloudsqluser.GetConnectionDetails(u.Name, ""),
+	}, nil
+}
+
+// isInstanceUserRoleUpToDate returns true if the roles/cloudsql.instanceUser
+// role is bound to the IAM user or service account of the supplied
+// CloudSQLUser if, and only if, it should be. The IAM policy of the project
+// is only read if the role should be or was bound.
+func (e *cloudsqlUserExternal) isInstanceUserRoleUpToDate(ctx context.Context, cr *v1alpha1.CloudSQLUser) (bool, error) {
+	pm := cloudsqluser.InstanceUserPolicyMember(cr.Spec.ForProvider)
+	if len(projectpolicy.Members(pm)) == 0 && len(cr.Status.AtProvider.BoundMembers) == 0 {
+		return true, nil
+	}
+	p, err := e.projects.GetIamPolicy(e.projectID, projectpolicy.GetIamPolicyRequest()).Context(ctx).Do()
+	if err != nil {
+		return false, errors.Wrap(err, errGetPolicy)
+	}
+	if projectpolicy.UpdateMember(pm, cr.Status.AtProvider.BoundMembers, p) {
+		return false, nil
+	}
+	cr.Status.AtProvider.BoundMembers = projectpolicy.Members(pm)
+	return true, nil
+}
+
+func (e *cloudsqlUserExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CloudSQLUser)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudSQLUser)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	// The name of IAM service accounts depends on the database engine of the
+	// instance.
+	instance, err := e.db.Get(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetUserInstance)
+	}
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		name = cr.GetName()
+	}
+	name = cloudsqluser.UserName(name, cr.Spec.ForProvider, instance.DatabaseVersion)
+
+	// IAM users log in with their IAM credentials rather than a password.
+	pw := ""
+	if !cloudsqluser.IsIAMUser(cr.Spec.ForProvider) {
+		if pw, err = password.Generate(); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGenerateUserPassword)
+		}
+	}
+
+	op, err := e.users.Insert(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), cloudsqluser.GenerateUser(name, pw, cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUser)
+	}
+	audit.RecordOperation(ctx, op.Name)
+
+	meta.SetExternalName(cr, name)
+	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: cloudsqluser.GetConnectionDetails(name, pw)}, nil
+}
+
+// Update binds or unbinds the roles/cloudsql.instanceUser role, which is the
+// only thing about a CloudSQLUser that can change.
+func (e *cloudsqlUserExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CloudSQLUser)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCloudSQLUser)
+	}
+	pm := cloudsqluser.InstanceUserPolicyMember(cr.Spec.ForProvider)
+	if err := modifyProjectPolicy(ctx, e.projects, e.projectID, func(p *cloudresourcemanager.Policy) bool {
+		return projectpolicy.UpdateMember(pm, cr.Status.AtProvider.BoundMembers, p)
+	}); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	cr.Status.AtProvider.BoundMembers = projectpolicy.Members(pm)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *cloudsqlUserExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CloudSQLUser)
+	if !ok {
+		return errors.New(errNotCloudSQLUser)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	pm := cloudsqluser.InstanceUserPolicyMember(cr.Spec.ForProvider)
+	if owned := projectpolicy.OwnedMembers(pm, cr.Status.AtProvider.BoundMembers); len(owned) > 0 {
+		if err := modifyProjectPolicy(ctx, e.projects, e.projectID, func(p *cloudresourcemanager.Policy) bool {
+			return projectpolicy.UnbindRoleFromMembers(pm, owned, p)
+		}); err != nil {
+			return err
+		}
+	}
+
+	call := e.users.Delete(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance)).Name(meta.GetExternalName(cr))
+	if h := gcp.StringValue(cr.Spec.ForProvider.Host); h != "" {
+		call = call.Host(h)
+	}
+	op, err := call.Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errDeleteUser)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
+
+// modifyProjectPolicy applies the supplied function to the IAM policy of the
+// supplied project and sets it if the function changed it, retrying if the
+// policy was changed concurrently.
+func modifyProjectPolicy(ctx context.Context, c projectpolicy.Client, project string, fn func(*cloudresourcemanager.Policy) bool) error {
+	return retry.OnError(projectpolicy.ConflictBackoff, projectpolicy.IsErrorConflict, func() error {
+		p, err := c.GetIamPolicy(project, projectpolicy.GetIamPolicyRequest()).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
+		if !fn(p) {
+			return nil
+		}
+		_, err = c.SetIamPolicy(project, &cloudresourcemanager.SetIamPolicyRequest{Policy: p}).Context(ctx).Do()
+		return errors.Wrap(err, errSetPolicy)
+	})
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/database/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testUserInstance       = "example-instance"
+	testUserServiceAccount = "app@" + projectID + ".iam.gserviceaccount.com"
+	testUserName           = "app@" + projectID + ".iam"
+	testUserMember         = "serviceAccount:" + testUserServiceAccount
+	testUserOtherMember    = "user:jane@example.com"
+)
+
+var (
+	_ managed.ExternalConnecter = &cloudsqlUserConnector{}
+	_ managed.ExternalClient    = &cloudsqlUserExternal{}
+)
+
+type strange struct {
+	resource.Managed
+}
+
+// userServer serves the Cloud SQL users and instance and the project IAM
+// policy of a CloudSQLUser, recording every user that is inserted or deleted
+// and every policy that is set.
+type userServer struct {
+	t        *testing.T
+	user     *sqladmin.User
+	policy   *cloudresourcemanager.Policy
+	inserted []*sqladmin.User
+	deleted  []string
+	set      []*cloudresourcemanager.Policy
+}
+
+func (s *userServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	users := "/sql/v1beta4/projects/" + projectID + "/instances/" + testUserInstance + "/users"
+	switch {
+	case r.URL.Path == "/v1/projects/"+projectID+":getIamPolicy":
+		_ = json.NewEncoder(w).Encode(s.policy)
+	case r.URL.Path == "/v1/projects/"+projectID+":setIamPolicy":
+		req := &cloudresourcemanager.SetIamPolicyRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			s.t.Error(err)
+		}
+		s.set = append(s.set, req.Policy)
+		_ = json.NewEncoder(w).Encode(req.Policy)
+	case r.URL.Path == "/sql/v1beta4/projects/"+projectID+"/instances/"+testUserInstance:
+		_ = json.NewEncoder(w).Encode(&sqladmin.DatabaseInstance{DatabaseVersion: "POSTGRES_14"})
+	case r.URL.Path == users && r.Method == http.MethodPost:
+		u := &sqladmin.User{}
+		if err := json.NewDecoder(r.Body).Decode(u); err != nil {
+			s.t.Error(err)
+		}
+		s.inserted = append(s.inserted, u)
+		_ = json.NewEncoder(w).Encode(&sqladmin.Operation{Name: "insert"})
+	case r.URL.Path == users && r.Method == http.MethodDelete:
+		s.deleted = append(s.deleted, r.URL.Query().Get("name"))
+		_ = json.NewEncoder(w).Encode(&sqladmin.Operation{Name: "delete"})
+	case strings.HasPrefix(r.URL.Path, users+"/"):
+		if s.user == nil {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(struct{}{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(s.user)
+	default:
+		s.t.Errorf("r: unexpected request %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func (s *userServer) external() *cloudsqlUserExternal {
+	server := httptest.NewServer(s)
+	s.t.Cleanup(server.Close)
+	sql, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	crm, _ := cloudresourcemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return &cloudsqlUserExternal{users: sql.Users, db: sql.Instances, projects: crm.Projects, projectID: projectID}
+}
+
+type userModifier func(*v1alpha1.CloudSQLUser)
+
+func userWithExternalName(n string) userModifier {
+	return func(u *v1alpha1.CloudSQLUser) { meta.SetExternalName(u, n) }
+}
+
+func userWithConditions(c ...xpv1.Condition) userModifier {
+	return func(u *v1alpha1.CloudSQLUser) { u.Status.SetConditions(c...) }
+}
+
+func userWithGrant(grant bool) userModifier {
+	return func(u *v1alpha1.CloudSQLUser) { u.Spec.ForProvider.GrantInstanceUserRole = &grant }
+}
+
+func userWithBoundMembers(m ...string) userModifier {
+	return func(u *v1alpha1.CloudSQLUser) { u.Status.AtProvider.BoundMembers = m }
+}
+
+func userWithObservation() userModifier {
+	return func(u *v1alpha1.CloudSQLUser) {
+		u.Status.AtProvider.Name = testUserName
+		u.Status.AtProvider.Type = v1alpha1.CloudSQLUserTypeCloudIAMServiceAccount
+	}
+}
+
+func cloudsqlUser(m ...userModifier) *v1alpha1.CloudSQLUser {
+	u := &v1alpha1.CloudSQLUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-user"},
+		Spec: v1alpha1.CloudSQLUserSpec{
+			ForProvider: v1alpha1.CloudSQLUserParameters{
+				Instance: gcp.StringPtr(testUserInstance),
+				Type:     gcp.StringPtr(v1alpha1.CloudSQLUserTypeCloudIAMServiceAccount),
+				Email:    gcp.StringPtr(testUserServiceAccount),
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(u)
+	}
+	return u
+}
+
+func iamUser() *sqladmin.User {
+	return &sqladmin.User{Name: testUserName, Type: v1alpha1.CloudSQLUserTypeCloudIAMServiceAccount}
+}
+
+func instanceUserPolicy(members ...string) *cloudresourcemanager.Policy {
+	return &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+		{Role: v1alpha1.CloudSQLInstanceUserRole, Members: members},
+	}}
+}
+
+func TestCloudSQLUserObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		server *userServer
+		mg     resource.Managed
+		want   want
+	}{
+		"NotCloudSQLUser": {
+			server: &userServer{},
+			mg:     &strange{},
+			want:   want{mg: &strange{}, err: errors.New(errNotCloudSQLUser)},
+		},
+		"NotCreated": {
+			server: &userServer{},
+			mg:     cloudsqlUser(),
+			want:   want{mg: cloudsqlUser()},
+		},
+		"NotFound": {
+			server: &userServer{},
+			mg:     cloudsqlUser(userWithExternalName(testUserName)),
+			want:   want{mg: cloudsqlUser(userWithExternalName(testUserName))},
+		},
+		"NotGranted": {
+			server: &userServer{user: iamUser()},
+			mg:     cloudsqlUser(userWithExternalName(testUserName)),
+			want: want{
+				mg: cloudsqlUser(userWithExternalName(testUserName), userWithObservation(), userWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretUserKey: []byte(testUserName)},
+				},
+			},
+		},
+		"RoleNotBound": {
+			server: &userServer{user: iamUser(), policy: instanceUserPolicy(testUserOtherMember)},
+			mg:     cloudsqlUser(userWithExternalName(testUserName), userWithGrant(true)),
+			want: want{
+				mg: cloudsqlUser(userWithExternalName(testUserName), userWithGrant(true), userWithObservation(), userWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretUserKey: []byte(testUserName)},
+				},
+			},
+		},
+		"RoleBound": {
+			server: &userServer{user: iamUser(), policy: instanceUserPolicy(testUserOtherMember, testUserMember)},
+			mg:     cloudsqlUser(userWithExternalName(testUserName), userWithGrant(true)),
+			want: want{
+				mg: cloudsqlUser(userWithExternalName(testUserName), userWithGrant(true), userWithObservation(), userWithConditions(xpv1.Available()), userWithBoundMembers(testUserMember)),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretUserKey: []byte(testUserName)},
+				},
+			},
+		},
+		"GrantRevoked": {
+			server: &userServer{user: iamUser(), policy: instanceUserPolicy(testUserMember)},
+			mg:     cloudsqlUser(userWithExternalName(testUserName), userWithBoundMembers(testUserMember)),
+			want: want{
+				mg: cloudsqlUser(userWithExternalName(testUserName), userWithBoundMembers(testUserMember), userWithObservation(), userWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretUserKey: []byte(testUserName)},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			obs, err := tc.server.external().Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLUserCreate(t *testing.T) {
+	s := &userServer{t: t}
+	cr := cloudsqlUser(userWithGrant(true))
+	cre, err := s.external().Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): %s", err)
+	}
+	want := managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails:    managed.ConnectionDetails{xpv1.ResourceCredentialsSecretUserKey: []byte(testUserName)},
+	}
+	if diff := cmp.Diff(want, cre); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	// The user of a service account is named after its email address without
+	// the .gserviceaccount.com suffix for PostgreSQL, and has no password.
+	wantUsers := []*sqladmin.User{{Name: testUserName, Type: v1alpha1.CloudSQLUserTypeCloudIAMServiceAccount}}
+	if diff := cmp.Diff(wantUsers, s.inserted); diff != "" {
+		t.Errorf("Create(...): -want inserted, +got inserted:\n%s", diff)
+	}
+	if diff := cmp.Diff(cloudsqlUser(userWithGrant(true), userWithExternalName(testUserName), userWithConditions(xpv1.Creating())), cr, test.EquateConditions()); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestCloudSQLUserUpdate(t *testing.T) {
+	cases := map[string]struct {
+		server  *userServer
+		mg      *v1alpha1.CloudSQLUser
+		wantSet []*cloudresourcemanager.Policy
+		wantMg  *v1alpha1.CloudSQLUser
+	}{
+		"Grant": {
+			server:  &userServer{policy: instanceUserPolicy(testUserOtherMember)},
+			mg:      cloudsqlUser(userWithGrant(true)),
+			wantSet: []*cloudresourcemanager.Policy{{Version: 3, Bindings: instanceUserPolicy(testUserOtherMember, testUserMember).Bindings}},
+			wantMg:  cloudsqlUser(userWithGrant(true), userWithBoundMembers(testUserMember)),
+		},
+		"Revoke": {
+			server:  &userServer{policy: instanceUserPolicy(testUserOtherMember, testUserMember)},
+			mg:      cloudsqlUser(userWithGrant(false), userWithBoundMembers(testUserMember)),
+			wantSet: []*cloudresourcemanager.Policy{{Version: 3, Bindings: instanceUserPolicy(testUserOtherMember).Bindings}},
+			wantMg:  cloudsqlUser(userWithGrant(false), userWithBoundMembers([]string{}...)),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			if _, err := tc.server.external().Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Update(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.wantSet, tc.server.set); diff != "" {
+				t.Errorf("Update(...): -want set, +got set:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantMg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLUserDelete(t *testing.T) {
+	s := &userServer{t: t, policy: instanceUserPolicy(testUserOtherMember, testUserMember)}
+	cr := cloudsqlUser(userWithExternalName(testUserName), userWithGrant(true), userWithBoundMembers(testUserMember))
+	if err := s.external().Delete(context.Background(), cr); err != nil {
+		t.Fatalf("Delete(...): %s", err)
+	}
+	wantSet := []*cloudresourcemanager.Policy{instanceUserPolicy(testUserOtherMember)}
+	if diff := cmp.Diff(wantSet, s.set); diff != "" {
+		t.Errorf("Delete(...): -want set, +got set:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{testUserName}, s.deleted); diff != "" {
+		t.Errorf("Delete(...): -want deleted, +got deleted:\n%s", diff)
+	}
+}
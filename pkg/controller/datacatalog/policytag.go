@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/datacatalog/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datacatalog/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/policytag"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotPolicyTag    = "managed resource is not a Data Catalog PolicyTag"
+	errGetPolicyTag    = "cannot get external Data Catalog policy tag"
+	errCreatePolicyTag = "cannot create external Data Catalog policy tag"
+	errUpdatePolicyTag = "cannot update external Data Catalog policy tag"
+	errDeletePolicyTag = "cannot delete external Data Catalog policy tag"
+)
+
+// SetupPolicyTag adds a controller that reconciles Data Catalog PolicyTags.
+func SetupPolicyTag(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.PolicyTagGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PolicyTagGroupVersionKind),
+		// The ID of a policy tag is assigned by Data Catalog on creation.
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &policyTagConnector{kube: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.PolicyTag{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type policyTagConnector struct {
+	kube client.Client
+}
+
+func (c *policyTagConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := datacatalog.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &policyTagExternal{tags: datacatalog.NewProjectsLocationsTaxonomiesPolicyTagsService(s)}, nil
+}
+
+type policyTagExternal struct {
+	tags policytag.Client
+}
+
+func (e *policyTagExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PolicyTag)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicyTag)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Technically Taxonomy can be nil, but reference resolution should always
+	// make sure a value is set before we get to this point.
+	name := policytag.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Taxonomy), meta.GetExternalName(cr))
+	observed, err := e.tags.Get(name).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPolicyTag)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	policytag.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = policytag.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        policytag.IsUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *policyTagExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PolicyTag)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPolicyTag)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	created, err := e.tags.Create(gcp.StringValue(cr.Spec.ForProvider.Taxonomy), policytag.GeneratePolicyTag(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePolicyTag)
+	}
+	meta.SetExternalName(cr, policytag.GetID(created.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *policyTagExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PolicyTag)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPolicyTag)
+	}
+
+	name := policytag.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Taxonomy), meta.GetExternalName(cr))
+	_, err := e.tags.Patch(name, policytag.GeneratePolicyTag(cr.Spec.ForProvider)).UpdateMask(policytag.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePolicyTag)
+}
+
+func (e *policyTagExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PolicyTag)
+	if !ok {
+		return errors.New(errNotPolicyTag)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	// Deleting a policy tag deletes all of its child policy tags.
+	name := policytag.GetFullyQualifiedName(gcp.StringValue(cr.Spec.ForProvider.Taxonomy), meta.GetExternalName(cr))
+	_, err := e.tags.Delete(name).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeletePolicyTag)
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/datacatalog/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const policyTagID = "456"
+
+var (
+	_ managed.ExternalConnecter = &policyTagConnector{}
+	_ managed.ExternalClient    = &policyTagExternal{}
+
+	policyTagFQName = taxonomyFQName + "/policyTags/" + policyTagID
+)
+
+type policyTagModifier func(*v1alpha1.PolicyTag)
+
+func withPolicyTagCondition(c xpv1.Condition) policyTagModifier {
+	return func(t *v1alpha1.PolicyTag) { t.SetConditions(c) }
+}
+
+func withPolicyTagExternalName(n string) policyTagModifier {
+	return func(t *v1alpha1.PolicyTag) { meta.SetExternalName(t, n) }
+}
+
+func withPolicyTagDescription(d string) policyTagModifier {
+	return func(t *v1alpha1.PolicyTag) { t.Spec.ForProvider.Description = gcp.StringPtr(d) }
+}
+
+func policyTagCR(m ...policyTagModifier) *v1alpha1.PolicyTag {
+	t := &v1alpha1.PolicyTag{
+		ObjectMeta: metav1.ObjectMeta{Name: "email"},
+		Spec: v1alpha1.PolicyTagSpec{
+			ForProvider: v1alpha1.PolicyTagParameters{
+				Taxonomy:    gcp.StringPtr(taxonomyFQName),
+				DisplayName: "email",
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(t)
+	}
+	return t
+}
+
+func policyTagResponse() *datacatalog.GoogleCloudDatacatalogV1PolicyTag {
+	return &datacatalog.GoogleCloudDatacatalogV1PolicyTag{
+		Name:        policyTagFQName,
+		DisplayName: "email",
+		Description: "Email addresses",
+	}
+}
+
+func newPolicyTagExternal(url string) *policyTagExternal {
+	s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(url), option.WithoutAuthentication())
+	return &policyTagExternal{tags: datacatalog.NewProjectsLocationsTaxonomiesPolicyTagsService(s)}
+}
+
+func TestPolicyTagObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicyTag": {
+			mg:   &strange{},
+			want: want{mg: &strange{}, err: errors.New(errNotPolicyTag)},
+		},
+		"NotCreatedYet": {
+			mg:   policyTagCR(),
+			want: want{mg: policyTagCR()},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+policyTagFQName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(policyTagResponse())
+			}),
+			mg: policyTagCR(withPolicyTagExternalName(policyTagID)),
+			want: want{
+				mg: policyTagCR(withPolicyTagExternalName(policyTagID), withPolicyTagDescription("Email addresses"),
+					func(t *v1alpha1.PolicyTag) { t.Status.AtProvider.Name = policyTagFQName },
+					withPolicyTagCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg:   policyTagCR(withPolicyTagExternalName(policyTagID)),
+			want: want{mg: policyTagCR(withPolicyTagExternalName(policyTagID))},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   policyTagCR(withPolicyTagExternalName(policyTagID)),
+			want: want{mg: policyTagCR(withPolicyTagExternalName(policyTagID)), err: errors.Wrap(err500, errGetPolicyTag)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			obs, err := newPolicyTagExternal(server.URL).Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyTagCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicyTag": {
+			mg:   &strange{},
+			want: want{mg: &strange{}, err: errors.New(errNotPolicyTag)},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+taxonomyFQName+"/policyTags", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(policyTagResponse())
+			}),
+			mg: policyTagCR(),
+			want: want{
+				mg:  policyTagCR(withPolicyTagExternalName(policyTagID), withPolicyTagCondition(xpv1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   policyTagCR(),
+			want: want{mg: policyTagCR(withPolicyTagCondition(xpv1.Creating())), err: errors.Wrap(err500, errCreatePolicyTag)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			cre, err := newPolicyTagExternal(server.URL).Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyTagUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotPolicyTag": {
+			mg:   &strange{},
+			want: errors.New(errNotPolicyTag),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+policyTagFQName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				if diff := cmp.Diff("displayName,description,parentPolicyTag", r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("r: -want updateMask, +got updateMask:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(policyTagResponse())
+			}),
+			mg: policyTagCR(withPolicyTagExternalName(policyTagID)),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   policyTagCR(withPolicyTagExternalName(policyTagID)),
+			want: errors.Wrap(err500, errUpdatePolicyTag),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			_, err := newPolicyTagExternal(server.URL).Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyTagDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotPolicyTag": {
+			mg:   &strange{},
+			want: errors.New(errNotPolicyTag),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+policyTagFQName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&datacatalog.Empty{})
+			}),
+			mg: policyTagCR(withPolicyTagExternalName(policyTagID)),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: policyTagCR(withPolicyTagExternalName(policyTagID)),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   policyTagCR(withPolicyTagExternalName(policyTagID)),
+			want: errors.Wrap(err500, errDeletePolicyTag),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			err := newPolicyTagExternal(server.URL).Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/datacatalog/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datacatalog/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/taxonomy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNewClient      = "cannot create new Data Catalog API client"
+	errNotTaxonomy    = "managed resource is not a Data Catalog Taxonomy"
+	errGetTaxonomy    = "cannot get external Data Catalog taxonomy"
+	errCreateTaxonomy = "cannot create external Data Catalog taxonomy"
+	errUpdateTaxonomy = "cannot update external Data Catalog taxonomy"
+	errDeleteTaxonomy = "cannot delete external Data Catalog taxonomy"
+)
+
+// SetupTaxonomy adds a controller that reconciles Data Catalog Taxonomies.
+func SetupTaxonomy(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TaxonomyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TaxonomyGroupVersionKind),
+		// The ID of a taxonomy is assigned by Data Catalog on creation.
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &taxonomyConnector{kube: mgr.GetClient()}))))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.Taxonomy{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type taxonomyConnector struct {
+	kube client.Client
+}
+
+func (c *taxonomyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := datacatalog.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &taxonomyExternal{taxonomies: datacatalog.NewProjectsLocationsTaxonomiesService(s), projectID: projectID}, nil
+}
+
+type taxonomyExternal struct {
+	taxonomies taxonomy.Client
+	projectID  string
+}
+
+func (e *taxonomyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Taxonomy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTaxonomy)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	name := taxonomy.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	observed, err := e.taxonomies.Get(name).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTaxonomy)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	taxonomy.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = taxonomy.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        taxonomy.IsUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *taxonomyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Taxonomy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTaxonomy)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	created, err := e.taxonomies.Create(taxonomy.GetParent(e.projectID, cr.Spec.ForProvider.Location), taxonomy.GenerateTaxonomy(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTaxonomy)
+	}
+	meta.SetExternalName(cr, taxonomy.GetID(created.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *taxonomyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Taxonomy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTaxonomy)
+	}
+
+	name := taxonomy.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	_, err := e.taxonomies.Patch(name, taxonomy.GenerateTaxonomy(cr.Spec.ForProvider)).UpdateMask(taxonomy.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTaxonomy)
+}
+
+func (e *taxonomyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Taxonomy)
+	if !ok {
+		return errors.New(errNotTaxonomy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	// Deleting a taxonomy deletes all of its policy tags.
+	name := taxonomy.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+	_, err := e.taxonomies.Delete(name).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTaxonomy)
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/datacatalog/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/datacatalog/v1alpha1"
+)
+
+const (
+	project    = "someProject"
+	location   = "us"
+	taxonomyID = "123"
+)
+
+var (
+	_ managed.ExternalConnecter = &taxonomyConnector{}
+	_ managed.ExternalClient    = &taxonomyExternal{}
+
+	err500         = &googleapi.Error{Code: 500, Body: "{}\n"}
+	taxonomyFQName = "projects/" + project + "/locations/" + location + "/taxonomies/" + taxonomyID
+)
+
+type strange struct {
+	resource.Managed
+}
+
+type taxonomyModifier func(*v1alpha1.Taxonomy)
+
+func withTaxonomyCondition(c xpv1.Condition) taxonomyModifier {
+	return func(t *v1alpha1.Taxonomy) { t.SetConditions(c) }
+}
+
+func withTaxonomyExternalName(n string) taxonomyModifier {
+	return func(t *v1alpha1.Taxonomy) { meta.SetExternalName(t, n) }
+}
+
+func withTaxonomyObservation() taxonomyModifier {
+	return func(t *v1alpha1.Taxonomy) {
+		t.Status.AtProvider = v1alpha1.TaxonomyObservation{Name: taxonomyFQName, PolicyTagCount: 1}
+	}
+}
+
+func taxonomyCR(m ...taxonomyModifier) *v1alpha1.Taxonomy {
+	t := &v1alpha1.Taxonomy{
+		ObjectMeta: metav1.ObjectMeta{Name: "pii"},
+		Spec: v1alpha1.TaxonomySpec{
+			ForProvider: v1alpha1.TaxonomyParameters{
+				Location:             location,
+				DisplayName:          "pii",
+				ActivatedPolicyTypes: []v1alpha1.TaxonomyPolicyType{v1alpha1.PolicyTypeFineGrainedAccessControl},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(t)
+	}
+	return t
+}
+
+func taxonomyResponse() *datacatalog.GoogleCloudDatacatalogV1Taxonomy {
+	return &datacatalog.GoogleCloudDatacatalogV1Taxonomy{
+		Name:                 taxonomyFQName,
+		DisplayName:          "pii",
+		ActivatedPolicyTypes: []string{v1alpha1.PolicyTypeFineGrainedAccessControl},
+		PolicyTagCount:       1,
+	}
+}
+
+func newTaxonomyExternal(url string) *taxonomyExternal {
+	s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(url), option.WithoutAuthentication())
+	return &taxonomyExternal{taxonomies: datacatalog.NewProjectsLocationsTaxonomiesService(s), projectID: project}
+}
+
+func TestTaxonomyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTaxonomy": {
+			mg:   &strange{},
+			want: want{mg: &strange{}, err: errors.New(errNotTaxonomy)},
+		},
+		"NotCreatedYet": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Error("no request should be made without an external name")
+			}),
+			mg:   taxonomyCR(),
+			want: want{mg: taxonomyCR()},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+taxonomyFQName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(taxonomyResponse())
+			}),
+			mg: taxonomyCR(withTaxonomyExternalName(taxonomyID)),
+			want: want{
+				mg:  taxonomyCR(withTaxonomyExternalName(taxonomyID), withTaxonomyObservation(), withTaxonomyCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				resp := taxonomyResponse()
+				resp.ActivatedPolicyTypes = nil
+				_ = json.NewEncoder(w).Encode(resp)
+			}),
+			mg: taxonomyCR(withTaxonomyExternalName(taxonomyID)),
+			want: want{
+				mg:  taxonomyCR(withTaxonomyExternalName(taxonomyID), withTaxonomyObservation(), withTaxonomyCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg:   taxonomyCR(withTaxonomyExternalName(taxonomyID)),
+			want: want{mg: taxonomyCR(withTaxonomyExternalName(taxonomyID))},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   taxonomyCR(withTaxonomyExternalName(taxonomyID)),
+			want: want{mg: taxonomyCR(withTaxonomyExternalName(taxonomyID)), err: errors.Wrap(err500, errGetTaxonomy)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			obs, err := newTaxonomyExternal(server.URL).Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTaxonomyCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTaxonomy": {
+			mg:   &strange{},
+			want: want{mg: &strange{}, err: errors.New(errNotTaxonomy)},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/projects/"+project+"/locations/"+location+"/taxonomies", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(taxonomyResponse())
+			}),
+			mg: taxonomyCR(),
+			want: want{
+				mg:  taxonomyCR(withTaxonomyExternalName(taxonomyID), withTaxonomyCondition(xpv1.Creating())),
+				cre: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   taxonomyCR(),
+			want: want{mg: taxonomyCR(withTaxonomyCondition(xpv1.Creating())), err: errors.Wrap(err500, errCreateTaxonomy)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			cre, err := newTaxonomyExternal(server.URL).Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTaxonomyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotTaxonomy": {
+			mg:   &strange{},
+			want: errors.New(errNotTaxonomy),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+taxonomyFQName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				if diff := cmp.Diff("displayName,description,activatedPolicyTypes", r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("r: -want updateMask, +got updateMask:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(taxonomyResponse())
+			}),
+			mg: taxonomyCR(withTaxonomyExternalName(taxonomyID)),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   taxonomyCR(withTaxonomyExternalName(taxonomyID)),
+			want: errors.Wrap(err500, errUpdateTaxonomy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			_, err := newTaxonomyExternal(server.URL).Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTaxonomyDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotTaxonomy": {
+			mg:   &strange{},
+			want: errors.New(errNotTaxonomy),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+taxonomyFQName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&datacatalog.Empty{})
+			}),
+			mg: taxonomyCR(withTaxonomyExternalName(taxonomyID)),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: taxonomyCR(withTaxonomyExternalName(taxonomyID)),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   taxonomyCR(withTaxonomyExternalName(taxonomyID)),
+			want: errors.Wrap(err500, errDeleteTaxonomy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			err := newTaxonomyExternal(server.URL).Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataproc
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/dataproc/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/dataproc/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/autoscalingpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNewClient               = "cannot create new Dataproc API client"
+	errNotAutoscalingPolicy    = "managed resource is not a Dataproc AutoscalingPolicy"
+	errGetAutoscalingPolicy    = "cannot get external Dataproc autoscaling policy"
+	errCreateAutoscalingPolicy = "cannot create external Dataproc autoscaling policy"
+	errUpdateAutoscalingPolicy = "cannot update external Dataproc autoscaling policy"
+	errDeleteAutoscalingPolicy = "cannot delete external Dataproc autoscaling policy"
+	errCheckUpToDate           = "cannot determine if Dataproc autoscaling policy is up to date"
+)
+
+// SetupAutoscalingPolicy adds a controller that reconciles Dataproc
+// AutoscalingPolicies.
+func SetupAutoscalingPolicy(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AutoscalingPolicyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.AutoscalingPolicyGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &autoscalingPolicyConnector{kube: mgr.GetClient()}))))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.AutoscalingPolicy{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type autoscalingPolicyConnector struct {
+	kube client.Client
+}
+
+func (c *autoscalingPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := dataproc.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &autoscalingPolicyExternal{policies: dataproc.NewProjectsRegionsAutoscalingPoliciesService(s), projectID: projectID}, nil
+}
+
+type autoscalingPolicyExternal struct {
+	policies  autoscalingpolicy.Client
+	projectID string
+}
+
+func (e *autoscalingPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AutoscalingPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAutoscalingPolicy)
+	}
+
+	name := autoscalingpolicy.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr))
+	observed, err := e.policies.Get(name).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAutoscalingPolicy)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	autoscalingpolicy.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = autoscalingpolicy.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	upToDate, err := autoscalingpolicy.IsUpToDate(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, *observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *autoscalingPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AutoscalingPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAutoscalingPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	p, err := autoscalingpolicy.GenerateAutoscalingPolicy(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAutoscalingPolicy)
+	}
+	_, err = e.policies.Create(autoscalingpolicy.GetParent(e.projectID, cr.Spec.ForProvider.Region), p).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAutoscalingPolicy)
+}
+
+func (e *autoscalingPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AutoscalingPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAutoscalingPolicy)
+	}
+
+	p, err := autoscalingpolicy.GenerateAutoscalingPolicy(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAutoscalingPolicy)
+	}
+	_, err = e.policies.Update(p.Name, p).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAutoscalingPolicy)
+}
+
+func (e *autoscalingPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AutoscalingPolicy)
+	if !ok {
+		return errors.New(errNotAutoscalingPolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	// Policies that are still used by a cluster cannot be deleted. The error
+	// is reported until the clusters stop using the policy.
+	name := autoscalingpolicy.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr))
+	_, err := e.policies.Delete(name).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAutoscalingPolicy)
+}
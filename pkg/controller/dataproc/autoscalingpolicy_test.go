@@ -0,0 +1,386 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataproc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/dataproc/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/dataproc/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	project      = "someProject"
+	region       = "us-central1"
+	metadataName = "test-policy"
+)
+
+var (
+	_ managed.ExternalConnecter = &autoscalingPolicyConnector{}
+	_ managed.ExternalClient    = &autoscalingPolicyExternal{}
+
+	err500 = &googleapi.Error{Code: 500, Body: "{}\n"}
+	fqName = "projects/" + project + "/regions/" + region + "/autoscalingPolicies/" + metadataName
+)
+
+type strange struct {
+	resource.Managed
+}
+
+type policyModifier func(*v1alpha1.AutoscalingPolicy)
+
+func withCondition(c xpv1.Condition) policyModifier {
+	return func(p *v1alpha1.AutoscalingPolicy) { p.SetConditions(c) }
+}
+
+func withObservation() policyModifier {
+	return func(p *v1alpha1.AutoscalingPolicy) {
+		p.Status.AtProvider = v1alpha1.AutoscalingPolicyObservation{Name: fqName}
+	}
+}
+
+func withMaxInstances(n int64) policyModifier {
+	return func(p *v1alpha1.AutoscalingPolicy) { p.Spec.ForProvider.WorkerConfig.MaxInstances = n }
+}
+
+func policy(m ...policyModifier) *v1alpha1.AutoscalingPolicy {
+	p := &v1alpha1.AutoscalingPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        metadataName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: metadataName},
+		},
+		Spec: v1alpha1.AutoscalingPolicySpec{
+			ForProvider: v1alpha1.AutoscalingPolicyParameters{
+				Region: region,
+				BasicAlgorithm: v1alpha1.BasicAutoscalingAlgorithm{
+					CooldownPeriod: gcp.StringPtr("120s"),
+					YarnConfig: v1alpha1.BasicYarnAutoscalingConfig{
+						GracefulDecommissionTimeout: "3600s",
+						ScaleUpFactor:               "0.5",
+						ScaleDownFactor:             "1.0",
+					},
+				},
+				WorkerConfig: v1alpha1.InstanceGroupAutoscalingPolicyConfig{
+					MinInstances: gcp.Int64Ptr(2),
+					MaxInstances: 10,
+					Weight:       gcp.Int64Ptr(1),
+				},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(p)
+	}
+	return p
+}
+
+func policyResponse() *dataproc.AutoscalingPolicy {
+	return &dataproc.AutoscalingPolicy{
+		Id:   metadataName,
+		Name: fqName,
+		BasicAlgorithm: &dataproc.BasicAutoscalingAlgorithm{
+			CooldownPeriod: "120s",
+			YarnConfig: &dataproc.BasicYarnAutoscalingConfig{
+				GracefulDecommissionTimeout: "3600s",
+				ScaleUpFactor:               0.5,
+				ScaleDownFactor:             1,
+			},
+		},
+		WorkerConfig: &dataproc.InstanceGroupAutoscalingPolicyConfig{MinInstances: 2, MaxInstances: 10, Weight: 1},
+	}
+}
+
+func TestAutoscalingPolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotAutoscalingPolicy": {
+			mg:   &strange{},
+			want: want{mg: &strange{}, err: errors.New(errNotAutoscalingPolicy)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+fqName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(policyResponse())
+			}),
+			mg: policy(),
+			want: want{
+				mg:  policy(withObservation(), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(policyResponse())
+			}),
+			mg: policy(withMaxInstances(20)),
+			want: want{
+				mg:  policy(withMaxInstances(20), withObservation(), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(policyResponse())
+			}),
+			mg: policy(func(p *v1alpha1.AutoscalingPolicy) {
+				p.Spec.ForProvider.BasicAlgorithm.CooldownPeriod = nil
+				p.Spec.ForProvider.WorkerConfig.MinInstances = nil
+				p.Spec.ForProvider.WorkerConfig.Weight = nil
+			}),
+			want: want{
+				mg:  policy(withObservation(), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg:   policy(),
+			want: want{mg: policy()},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   policy(),
+			want: want{mg: policy(), err: errors.Wrap(err500, errGetAutoscalingPolicy)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataproc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &autoscalingPolicyExternal{policies: dataproc.NewProjectsRegionsAutoscalingPoliciesService(s), projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalingPolicyCreate(t *testing.T) {
+	_, errParse := strconv.ParseFloat("half", 64)
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotAutoscalingPolicy": {
+			mg:   &strange{},
+			want: errors.New(errNotAutoscalingPolicy),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/projects/"+project+"/regions/"+region+"/autoscalingPolicies", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				p := &dataproc.AutoscalingPolicy{}
+				if err := json.NewDecoder(r.Body).Decode(p); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(policyResponse(), p); diff != "" {
+					t.Errorf("policy: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(p)
+			}),
+			mg: policy(),
+		},
+		"InvalidFraction": {
+			mg: policy(func(p *v1alpha1.AutoscalingPolicy) {
+				p.Spec.ForProvider.BasicAlgorithm.YarnConfig.ScaleUpFactor = "half"
+			}),
+			want: errors.Wrap(errors.Wrap(errParse, "cannot parse scaleUpFactor"), errCreateAutoscalingPolicy),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   policy(),
+			want: errors.Wrap(err500, errCreateAutoscalingPolicy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataproc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &autoscalingPolicyExternal{policies: dataproc.NewProjectsRegionsAutoscalingPoliciesService(s), projectID: project}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalingPolicyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotAutoscalingPolicy": {
+			mg:   &strange{},
+			want: errors.New(errNotAutoscalingPolicy),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+fqName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				p := &dataproc.AutoscalingPolicy{}
+				if err := json.NewDecoder(r.Body).Decode(p); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(int64(20), p.WorkerConfig.MaxInstances); diff != "" {
+					t.Errorf("maxInstances: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(p)
+			}),
+			mg: policy(withMaxInstances(20)),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   policy(),
+			want: errors.Wrap(err500, errUpdateAutoscalingPolicy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataproc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &autoscalingPolicyExternal{policies: dataproc.NewProjectsRegionsAutoscalingPoliciesService(s), projectID: project}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalingPolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotAutoscalingPolicy": {
+			mg:   &strange{},
+			want: errors.New(errNotAutoscalingPolicy),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+fqName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&dataproc.Empty{})
+			}),
+			mg: policy(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: policy(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   policy(),
+			want: errors.Wrap(err500, errDeleteAutoscalingPolicy),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataproc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &autoscalingPolicyExternal{policies: dataproc.NewProjectsRegionsAutoscalingPoliciesService(s), projectID: project}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
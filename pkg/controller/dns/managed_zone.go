@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/dns/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	dnsclient "github.com/crossplane-contrib/provider-gcp/pkg/clients/dns"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	errNotManagedZone    = "managed resource is not a ManagedZone custom resource"
+	errGetManagedZone    = "cannot get the ManagedZone"
+	errListDNSKeys       = "cannot list the DNSSEC keys of the ManagedZone"
+	errCreateManagedZone = "cannot create ManagedZone"
+	errUpdateManagedZone = "cannot update ManagedZone"
+	errDeleteManagedZone = "cannot delete ManagedZone"
+)
+
+// SetupManagedZone adds a controller that reconciles ManagedZone managed
+// resources.
+func SetupManagedZone(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ManagedZoneGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ManagedZoneGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &managedZoneConnector{kube: mgr.GetClient()}))))),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.ManagedZone{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type managedZoneConnector struct {
+	kube client.Client
+}
+
+func (c *managedZoneConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	d, err := dns.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &managedZoneExternal{dns: d, projectID: projectID}, nil
+}
+
+type managedZoneExternal struct {
+	dns       *dns.Service
+	projectID string
+}
+
+func (e *managedZoneExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotManagedZone)
+	}
+
+	mz, err := e.dns.ManagedZones.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetManagedZone)
+	}
+
+	// Keys only exist while the zone is signed.
+	var keys []*dns.DnsKey
+	if mz.DnssecConfig != nil && mz.DnssecConfig.State != "" && mz.DnssecConfig.State != v1alpha1.DNSSECStateOff {
+		err := e.dns.DnsKeys.List(e.projectID, meta.GetExternalName(cr)).Pages(ctx, func(l *dns.DnsKeysListResponse) error {
+			keys = append(keys, l.DnsKeys...)
+			return nil
+		})
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errListDNSKeys)
+		}
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	dnsclient.LateInitializeManagedZone(&cr.Spec.ForProvider, *mz)
+
+	cr.Status.AtProvider = dnsclient.GenerateManagedZoneObservation(*mz, keys)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        dnsclient.IsManagedZoneUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, *mz),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       dnsclient.GetManagedZoneConnectionDetails(*mz, keys),
+	}, nil
+}
+
+func (e *managedZoneExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotManagedZone)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	mz := dnsclient.GenerateManagedZone(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.dns.ManagedZones.Create(e.projectID, mz).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateManagedZone)
+}
+
+// Update patches the description, labels, private visibility and DNSSEC
+// state of the managed zone. The key specs and denial-of-existence mechanism
+// are only sent while the zone is not signed, because they cannot be changed
+// otherwise.
+func (e *managedZoneExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotManagedZone)
+	}
+
+	observed, err := e.dns.ManagedZones.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetManagedZone)
+	}
+
+	mz := dnsclient.GenerateManagedZone(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if mz.DnssecConfig != nil && observed.DnssecConfig != nil && observed.DnssecConfig.State != v1alpha1.DNSSECStateOff {
+		mz.DnssecConfig = &dns.ManagedZoneDnsSecConfig{State: mz.DnssecConfig.State}
+	}
+	op, err := e.dns.ManagedZones.Patch(e.projectID, meta.GetExternalName(cr), mz).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateManagedZone)
+	}
+	audit.RecordOperation(ctx, op.Id)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *managedZoneExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return errors.New(errNotManagedZone)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	err := e.dns.ManagedZones.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteManagedZone)
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	dnsclient "github.com/crossplane-contrib/provider-gcp/pkg/clients/dns"
+)
+
+var _ managed.ExternalConnecter = &managedZoneConnector{}
+var _ managed.ExternalClient = &managedZoneExternal{}
+
+const testManagedZoneName = "example-zone"
+
+func managedZone(state string) *v1alpha1.ManagedZone {
+	return &v1alpha1.ManagedZone{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testManagedZoneName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: testManagedZoneName},
+		},
+		Spec: v1alpha1.ManagedZoneSpec{
+			ForProvider: v1alpha1.ManagedZoneParameters{
+				DNSName:      "example.com.",
+				DNSSECConfig: &v1alpha1.ManagedZoneDNSSECConfig{State: state},
+			},
+		},
+	}
+}
+
+func managedZoneGCE(state string) *dns.ManagedZone {
+	return &dns.ManagedZone{
+		Id:           1,
+		Name:         testManagedZoneName,
+		DnsName:      "example.com.",
+		Visibility:   "public",
+		NameServers:  []string{"ns-1.example."},
+		DnssecConfig: &dns.ManagedZoneDnsSecConfig{State: state, NonExistence: "nsec3"},
+	}
+}
+
+func TestManagedZoneObserve(t *testing.T) {
+	type want struct {
+		obs   managed.ExternalObservation
+		keys  []v1alpha1.DNSKeyObservation
+		calls []string
+		err   error
+	}
+
+	key := &dns.DnsKey{
+		Id:        "0",
+		Type:      v1alpha1.DNSKeyTypeKeySigning,
+		Algorithm: "ecdsap256sha256",
+		KeyTag:    2371,
+		IsActive:  true,
+		Digests:   []*dns.DnsKeyDigest{{Type: "sha256", Digest: "1f98"}},
+	}
+
+	cases := map[string]struct {
+		status   int
+		observed *dns.ManagedZone
+		mg       resource.Managed
+		want     want
+	}{
+		"NotManagedZone": {
+			mg:   newRrs(),
+			want: want{err: errors.New(errNotManagedZone)},
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+			mg:     managedZone(v1alpha1.DNSSECStateOff),
+			want:   want{calls: []string{"GET zone"}},
+		},
+		"GetFailed": {
+			status: http.StatusBadRequest,
+			mg:     managedZone(v1alpha1.DNSSECStateOff),
+			want: want{
+				calls: []string{"GET zone"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errGetManagedZone),
+			},
+		},
+		"Unsigned": {
+			status:   http.StatusOK,
+			observed: managedZoneGCE(v1alpha1.DNSSECStateOff),
+			mg:       managedZone(v1alpha1.DNSSECStateOff),
+			want: want{
+				calls: []string{"GET zone"},
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{dnsclient.ConnectionKeyNameServers: []byte("ns-1.example.")},
+				},
+			},
+		},
+		"Signed": {
+			status:   http.StatusOK,
+			observed: managedZoneGCE(v1alpha1.DNSSECStateOn),
+			mg:       managedZone(v1alpha1.DNSSECStateOn),
+			want: want{
+				calls: []string{"GET zone", "GET keys"},
+				keys: []v1alpha1.DNSKeyObservation{{
+					ID:        "0",
+					Type:      v1alpha1.DNSKeyTypeKeySigning,
+					Algorithm: "ecdsap256sha256",
+					KeyTag:    2371,
+					IsActive:  true,
+					DSRecords: []string{"2371 13 2 1F98"},
+				}},
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						dnsclient.ConnectionKeyNameServers: []byte("ns-1.example."),
+						dnsclient.ConnectionKeyDSRecord:    []byte("2371 13 2 1F98"),
+						dnsclient.ConnectionKeyDSRecords:   []byte("2371 13 2 1F98"),
+					},
+				},
+			},
+		},
+		"DNSSECDisabledExternally": {
+			status:   http.StatusOK,
+			observed: managedZoneGCE(v1alpha1.DNSSECStateOff),
+			mg:       managedZone(v1alpha1.DNSSECStateOn),
+			want: want{
+				calls: []string{"GET zone"},
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{dnsclient.ConnectionKeyNameServers: []byte("ns-1.example.")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, "/dnsKeys") {
+					calls = append(calls, r.Method+" keys")
+					_ = json.NewEncoder(w).Encode(&dns.DnsKeysListResponse{DnsKeys: []*dns.DnsKey{key}})
+					return
+				}
+				calls = append(calls, r.Method+" zone")
+				w.WriteHeader(tc.status)
+				if tc.observed == nil {
+					_ = json.NewEncoder(w).Encode(&dns.ManagedZone{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.observed)
+			}))
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := managedZoneExternal{dns: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Observe(...): -want calls, +got calls:\n%s", diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.ManagedZone); ok && tc.observed != nil {
+				if diff := cmp.Diff(tc.want.keys, cr.Status.AtProvider.DNSKeys); diff != "" {
+					t.Errorf("Observe(...): -want keys, +got keys:\n%s", diff)
+				}
+				if diff := cmp.Diff(xpv1.Available(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestManagedZoneUpdate(t *testing.T) {
+	cases := map[string]struct {
+		observed *dns.ManagedZone
+		mg       *v1alpha1.ManagedZone
+		want     *dns.ManagedZoneDnsSecConfig
+	}{
+		"EnableDNSSEC": {
+			observed: managedZoneGCE(v1alpha1.DNSSECStateOff),
+			mg: func() *v1alpha1.ManagedZone {
+				mz := managedZone(v1alpha1.DNSSECStateOn)
+				mz.Spec.ForProvider.DNSSECConfig.DefaultKeySpecs = []v1alpha1.DNSKeySpec{{KeyType: v1alpha1.DNSKeyTypeKeySigning, Algorithm: "rsasha256", KeyLength: 2048}}
+				return mz
+			}(),
+			want: &dns.ManagedZoneDnsSecConfig{
+				State:           v1alpha1.DNSSECStateOn,
+				DefaultKeySpecs: []*dns.DnsKeySpec{{KeyType: v1alpha1.DNSKeyTypeKeySigning, Algorithm: "rsasha256", KeyLength: 2048}},
+			},
+		},
+		"DisableDNSSEC": {
+			observed: managedZoneGCE(v1alpha1.DNSSECStateOn),
+			mg: func() *v1alpha1.ManagedZone {
+				mz := managedZone(v1alpha1.DNSSECStateOff)
+				mz.Spec.ForProvider.DNSSECConfig.NonExistence = gcp.StringPtr("nsec3")
+				return mz
+			}(),
+			want: &dns.ManagedZoneDnsSecConfig{State: v1alpha1.DNSSECStateOff},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var patched *dns.ManagedZone
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				patched = &dns.ManagedZone{}
+				_ = json.NewDecoder(r.Body).Decode(patched)
+				_ = json.NewEncoder(w).Encode(&dns.Operation{})
+			}))
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := managedZoneExternal{dns: s, projectID: projectID}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Update(...): unexpected error: %s", err)
+			}
+			if patched == nil {
+				t.Fatal("Update(...): managed zone was not patched")
+			}
+			if diff := cmp.Diff(tc.want, patched.DnssecConfig); diff != "" {
+				t.Errorf("Update(...): -want DNSSEC config, +got DNSSEC config:\n%s", diff)
+			}
+		})
+	}
+}
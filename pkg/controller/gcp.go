@@ -21,48 +21,106 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/apigee"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/bigquery"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/cache"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/cloudasset"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/cloudresourcemanager"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/compute"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/config"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/container"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/database"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/datacatalog"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/dataproc"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/dns"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/iam"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/ids"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/kms"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/networkservices"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/pubsub"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/registry"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/servicenetworking"
 	"github.com/crossplane-contrib/provider-gcp/pkg/controller/storage"
+	"github.com/crossplane-contrib/provider-gcp/pkg/controller/transfer"
 )
 
 // Setup creates all GCP controllers with the supplied logger and adds them to
 // the supplied manager.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+		apigee.SetupOrganization,
+		apigee.SetupEnvironment,
+		apigee.SetupEnvGroup,
+		apigee.SetupInstance,
+		bigquery.SetupDataPolicy,
 		cache.SetupCloudMemorystoreInstance,
+		cache.SetupRedisCluster,
+		cloudasset.SetupFeed,
+		cloudresourcemanager.SetupProjectPolicy,
+		cloudresourcemanager.SetupProjectPolicyMember,
+		cloudresourcemanager.SetupFolderPolicyMember,
+		cloudresourcemanager.SetupOrganizationPolicyMember,
 		compute.SetupGlobalAddress,
 		compute.SetupAddress,
 		compute.SetupNetwork,
 		compute.SetupSubnetwork,
 		compute.SetupFirewall,
 		compute.SetupRouter,
+		compute.SetupRouterNAT,
+		compute.SetupRouterPeer,
+		compute.SetupRoute,
+		compute.SetupPolicyBasedRoute,
+		compute.SetupNetworkEndpointGroup,
+		compute.SetupInstanceGroupManager,
+		compute.SetupAutoscaler,
+		compute.SetupPacketMirroring,
+		compute.SetupImageImport,
+		compute.SetupInstanceTemplate,
+		compute.SetupInstance,
+		compute.SetupDisk,
+		compute.SetupResourcePolicy,
+		compute.SetupSnapshot,
+		compute.SetupHealthCheck,
+		compute.SetupBackendService,
 		container.SetupCluster,
 		container.SetupNodePool,
 		database.SetupCloudSQLInstance,
+		database.SetupCloudSQLUser,
+		datacatalog.SetupTaxonomy,
+		datacatalog.SetupPolicyTag,
+		dataproc.SetupAutoscalingPolicy,
+		dns.SetupManagedZone,
 		dns.SetupPolicy,
 		dns.SetupResourceRecordSet,
+		iam.SetupCustomRole,
 		iam.SetupServiceAccount,
 		iam.SetupServiceAccountKey,
 		iam.SetupServiceAccountPolicy,
+		iam.SetupServiceAccountPolicyMember,
+		iam.SetupServiceAccountToken,
+		ids.SetupEndpoint,
 		kms.SetupKeyRing,
 		kms.SetupCryptoKey,
 		kms.SetupCryptoKeyPolicy,
+		kms.SetupEkmConnection,
+		networkservices.SetupMesh,
+		networkservices.SetupGateway,
+		networkservices.SetupHTTPRoute,
+		networkservices.SetupGRPCRoute,
 		pubsub.SetupSubscription,
 		pubsub.SetupTopic,
 		servicenetworking.SetupConnection,
 		storage.SetupBucket,
+		storage.SetupBucketACL,
+		storage.SetupBucketNotification,
 		storage.SetupBucketPolicy,
 		storage.SetupBucketPolicyMember,
+		storage.SetupDefaultObjectACL,
+		storage.SetupHMACKey,
+		storage.SetupBucketObject,
+		storage.SetupReportConfig,
+		storage.SetupSignedURL,
+		transfer.SetupTransferJob,
 		registry.SetupContainerRegistry,
 	} {
 		if err := setup(mgr, o); err != nil {
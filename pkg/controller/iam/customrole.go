@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	iamv1 "google.golang.org/api/iam/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/customrole"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotCustomRole      = "managed resource is not a GCP CustomRole"
+	errGetCustomRole      = "cannot get GCP CustomRole object via IAM API"
+	errCreateCustomRole   = "cannot create GCP CustomRole object via IAM API"
+	errUndeleteCustomRole = "cannot undelete GCP CustomRole object via IAM API"
+	errUpdateCustomRole   = "cannot update GCP CustomRole object via IAM API"
+	errDeleteCustomRole   = "cannot delete GCP CustomRole object via IAM API"
+	errDisableCustomRole  = "cannot disable GCP CustomRole object via IAM API"
+)
+
+// SetupCustomRole adds a controller that reconciles CustomRoles.
+func SetupCustomRole(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CustomRoleGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CustomRoleGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, consistency.WrapConnecter(&customRoleConnecter{client: mgr.GetClient()})))))),
+		managed.WithCreationGracePeriod(consistency.GracePeriod()),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.CustomRole{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type customRoleConnecter struct {
+	client client.Client
+}
+
+// Connect sets up iam client using credentials from the provider
+func (c *customRoleConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := iamv1.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &customRoleExternal{roles: customrole.NewClient(s), projectID: projectID}, nil
+}
+
+type customRoleExternal struct {
+	roles     customrole.Client
+	projectID string
+}
+
+func (e *customRoleExternal) name(cr *v1alpha1.CustomRole) string {
+	return customrole.GetName(customrole.GetParent(cr.Spec.ForProvider, e.projectID), meta.GetExternalName(cr))
+}
+
+func (e *customRoleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CustomRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCustomRole)
+	}
+
+	role, err := e.roles.Get(ctx, e.name(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCustomRole)
+	}
+	customrole.UpdateObservation(&cr.Status.AtProvider, role)
+
+	// A deleted role lingers for 7 days before it is purged. It counts as
+	// gone, so that deleting a CustomRole completes and recreating one
+	// undeletes the role rather than failing because its ID is taken.
+	if role.Deleted {
+		return managed.ExternalObservation{}, nil
+	}
+	// Likewise a CustomRole that disables rather than deletes its role is
+	// gone once the role is disabled.
+	if meta.WasDeleted(cr) && gcp.BoolValue(cr.Spec.ForProvider.DisableOnDelete) && role.Stage == v1alpha1.CustomRoleStageDisabled {
+		return managed.ExternalObservation{}, nil
+	}
+
+	li := customrole.LateInitializeSpec(&cr.Spec.ForProvider, role)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        customrole.IsUpToDate(cr.Spec.ForProvider, role),
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *customRoleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CustomRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCustomRole)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	// An undeleted role gets back the state it was deleted in, so it is
+	// patched to match the spec afterwards.
+	if cr.Status.AtProvider.Deleted {
+		role, err := e.roles.Undelete(ctx, e.name(cr), &iamv1.UndeleteRoleRequest{Etag: cr.Status.AtProvider.Etag})
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errUndeleteCustomRole)
+		}
+		customrole.UpdateObservation(&cr.Status.AtProvider, role)
+		_, err = e.Update(ctx, mg)
+		return managed.ExternalCreation{}, err
+	}
+
+	role, err := e.roles.Create(ctx, customrole.GetParent(cr.Spec.ForProvider, e.projectID), &iamv1.CreateRoleRequest{
+		RoleId: meta.GetExternalName(cr),
+		Role:   customrole.GenerateRole(cr.Spec.ForProvider),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCustomRole)
+	}
+	customrole.UpdateObservation(&cr.Status.AtProvider, role)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *customRoleExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CustomRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCustomRole)
+	}
+	_, err := e.roles.Patch(ctx, e.name(cr), customrole.GenerateRole(cr.Spec.ForProvider), customrole.UpdateMask)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCustomRole)
+}
+
+func (e *customRoleExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CustomRole)
+	if !ok {
+		return errors.New(errNotCustomRole)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if gcp.BoolValue(cr.Spec.ForProvider.DisableOnDelete) {
+		_, err := e.roles.Patch(ctx, e.name(cr), customrole.GenerateDisabledRole(), customrole.DisableMask)
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDisableCustomRole)
+	}
+	_, err := e.roles.Delete(ctx, e.name(cr))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCustomRole)
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	iamv1 "google.golang.org/api/iam/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	roleID   = "bucketReader"
+	roleName = "projects/" + project + "/roles/" + roleID
+)
+
+var (
+	_ managed.ExternalConnecter = &customRoleConnecter{}
+	_ managed.ExternalClient    = &customRoleExternal{}
+
+	errBoom = errors.New("boom")
+
+	deletionTimestamp = metav1.Now()
+	errNotFound       = &googleapi.Error{Code: 404}
+)
+
+type mockRoleClient struct {
+	MockGet      func(name string) (*iamv1.Role, error)
+	MockCreate   func(parent string, req *iamv1.CreateRoleRequest) (*iamv1.Role, error)
+	MockPatch    func(name string, role *iamv1.Role, updateMask string) (*iamv1.Role, error)
+	MockDelete   func(name string) (*iamv1.Role, error)
+	MockUndelete func(name string, req *iamv1.UndeleteRoleRequest) (*iamv1.Role, error)
+}
+
+func (m *mockRoleClient) Get(_ context.Context, name string) (*iamv1.Role, error) {
+	return m.MockGet(name)
+}
+
+func (m *mockRoleClient) Create(_ context.Context, parent string, req *iamv1.CreateRoleRequest) (*iamv1.Role, error) {
+	return m.MockCreate(parent, req)
+}
+
+func (m *mockRoleClient) Patch(_ context.Context, name string, role *iamv1.Role, updateMask string) (*iamv1.Role, error) {
+	return m.MockPatch(name, role, updateMask)
+}
+
+func (m *mockRoleClient) Delete(_ context.Context, name string) (*iamv1.Role, error) {
+	return m.MockDelete(name)
+}
+
+func (m *mockRoleClient) Undelete(_ context.Context, name string, req *iamv1.UndeleteRoleRequest) (*iamv1.Role, error) {
+	return m.MockUndelete(name, req)
+}
+
+type customRoleModifier func(*v1alpha1.CustomRole)
+
+func crWithCondition(c xpv1.Condition) customRoleModifier {
+	return func(r *v1alpha1.CustomRole) { r.SetConditions(c) }
+}
+
+func crWithObservation(deleted bool) customRoleModifier {
+	return func(r *v1alpha1.CustomRole) {
+		r.Status.AtProvider = v1alpha1.CustomRoleObservation{Name: roleName, Stage: v1alpha1.CustomRoleStageGA, Deleted: deleted, Etag: "etag"}
+	}
+}
+
+func crWithStage(s string) customRoleModifier {
+	return func(r *v1alpha1.CustomRole) { r.Spec.ForProvider.Stage = gcp.StringPtr(s) }
+}
+
+func crWithDisableOnDelete() customRoleModifier {
+	return func(r *v1alpha1.CustomRole) { r.Spec.ForProvider.DisableOnDelete = gcp.BoolPtr(true) }
+}
+
+func crWithDeletionTimestamp() customRoleModifier {
+	return func(r *v1alpha1.CustomRole) { r.SetDeletionTimestamp(&deletionTimestamp) }
+}
+
+func customRole(m ...customRoleModifier) *v1alpha1.CustomRole {
+	r := &v1alpha1.CustomRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "bucket-reader",
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: roleID},
+		},
+		Spec: v1alpha1.CustomRoleSpec{
+			ForProvider: v1alpha1.CustomRoleParameters{
+				Title:       gcp.StringPtr("Bucket Reader"),
+				Permissions: []string{"storage.buckets.get", "storage.buckets.list"},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(r)
+	}
+	return r
+}
+
+func roleResponse(deleted bool) *iamv1.Role {
+	return &iamv1.Role{
+		Name:                roleName,
+		Title:               "Bucket Reader",
+		IncludedPermissions: []string{"storage.buckets.list", "storage.buckets.get"},
+		Stage:               v1alpha1.CustomRoleStageGA,
+		Deleted:             deleted,
+		Etag:                "etag",
+	}
+}
+
+func TestCustomRoleObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		roles *mockRoleClient
+		mg    resource.Managed
+		want  want
+	}{
+		"NotCustomRole": {
+			roles: &mockRoleClient{},
+			mg:    &strange{},
+			want:  want{mg: &strange{}, err: errors.New(errNotCustomRole)},
+		},
+		"NotFound": {
+			roles: &mockRoleClient{MockGet: func(_ string) (*iamv1.Role, error) { return nil, errNotFound }},
+			mg:    customRole(),
+			want:  want{mg: customRole()},
+		},
+		"GetFailed": {
+			roles: &mockRoleClient{MockGet: func(_ string) (*iamv1.Role, error) { return nil, errBoom }},
+			mg:    customRole(),
+			want:  want{mg: customRole(), err: errors.Wrap(errBoom, errGetCustomRole)},
+		},
+		"Deleted": {
+			roles: &mockRoleClient{MockGet: func(_ string) (*iamv1.Role, error) { return roleResponse(true), nil }},
+			mg:    customRole(),
+			want:  want{mg: customRole(crWithObservation(true))},
+		},
+		"DisabledOnDelete": {
+			roles: &mockRoleClient{MockGet: func(_ string) (*iamv1.Role, error) {
+				r := roleResponse(false)
+				r.Stage = v1alpha1.CustomRoleStageDisabled
+				return r, nil
+			}},
+			mg: customRole(crWithDisableOnDelete(), crWithDeletionTimestamp()),
+			want: want{mg: customRole(crWithDisableOnDelete(), crWithDeletionTimestamp(), func(r *v1alpha1.CustomRole) {
+				r.Status.AtProvider = v1alpha1.CustomRoleObservation{Name: roleName, Stage: v1alpha1.CustomRoleStageDisabled, Etag: "etag"}
+			})},
+		},
+		"DisabledNotOnDelete": {
+			roles: &mockRoleClient{MockGet: func(_ string) (*iamv1.Role, error) {
+				r := roleResponse(false)
+				r.Stage = v1alpha1.CustomRoleStageDisabled
+				return r, nil
+			}},
+			mg: customRole(crWithDisableOnDelete(), crWithStage(v1alpha1.CustomRoleStageDisabled)),
+			want: want{
+				mg: customRole(crWithDisableOnDelete(), crWithStage(v1alpha1.CustomRoleStageDisabled), crWithCondition(xpv1.Available()), func(r *v1alpha1.CustomRole) {
+					r.Status.AtProvider = v1alpha1.CustomRoleObservation{Name: roleName, Stage: v1alpha1.CustomRoleStageDisabled, Etag: "etag"}
+				}),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UpToDateAndLateInitialized": {
+			roles: &mockRoleClient{MockGet: func(name string) (*iamv1.Role, error) {
+				if diff := cmp.Diff(roleName, name); diff != "" {
+					t.Errorf("Get(...): -want name, +got name:\n%s", diff)
+				}
+				return roleResponse(false), nil
+			}},
+			mg: customRole(),
+			want: want{
+				mg:  customRole(crWithStage(v1alpha1.CustomRoleStageGA), crWithObservation(false), crWithCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"NeedsUpdate": {
+			roles: &mockRoleClient{MockGet: func(_ string) (*iamv1.Role, error) { return roleResponse(false), nil }},
+			mg:    customRole(crWithStage(v1alpha1.CustomRoleStageDisabled)),
+			want: want{
+				mg:  customRole(crWithStage(v1alpha1.CustomRoleStageDisabled), crWithObservation(false), crWithCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &customRoleExternal{roles: tc.roles, projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomRoleCreate(t *testing.T) {
+	cases := map[string]struct {
+		roles *mockRoleClient
+		mg    resource.Managed
+		want  error
+	}{
+		"NotCustomRole": {
+			roles: &mockRoleClient{},
+			mg:    &strange{},
+			want:  errors.New(errNotCustomRole),
+		},
+		"Created": {
+			roles: &mockRoleClient{MockCreate: func(parent string, req *iamv1.CreateRoleRequest) (*iamv1.Role, error) {
+				if diff := cmp.Diff("projects/"+project, parent); diff != "" {
+					t.Errorf("Create(...): -want parent, +got parent:\n%s", diff)
+				}
+				if diff := cmp.Diff(roleID, req.RoleId); diff != "" {
+					t.Errorf("Create(...): -want role ID, +got role ID:\n%s", diff)
+				}
+				return roleResponse(false), nil
+			}},
+			mg: customRole(),
+		},
+		"CreateFailed": {
+			roles: &mockRoleClient{MockCreate: func(_ string, _ *iamv1.CreateRoleRequest) (*iamv1.Role, error) { return nil, errBoom }},
+			mg:    customRole(),
+			want:  errors.Wrap(errBoom, errCreateCustomRole),
+		},
+		"UndeletedAndPatched": {
+			roles: &mockRoleClient{
+				MockUndelete: func(name string, req *iamv1.UndeleteRoleRequest) (*iamv1.Role, error) {
+					if diff := cmp.Diff("etag", req.Etag); diff != "" {
+						t.Errorf("Undelete(...): -want etag, +got etag:\n%s", diff)
+					}
+					return roleResponse(false), nil
+				},
+				MockPatch: func(name string, _ *iamv1.Role, _ string) (*iamv1.Role, error) {
+					return roleResponse(false), nil
+				},
+			},
+			mg: customRole(crWithObservation(true)),
+		},
+		"UndeleteFailed": {
+			roles: &mockRoleClient{MockUndelete: func(_ string, _ *iamv1.UndeleteRoleRequest) (*iamv1.Role, error) { return nil, errBoom }},
+			mg:    customRole(crWithObservation(true)),
+			want:  errors.Wrap(errBoom, errUndeleteCustomRole),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &customRoleExternal{roles: tc.roles, projectID: project}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomRoleUpdate(t *testing.T) {
+	cases := map[string]struct {
+		roles *mockRoleClient
+		mg    resource.Managed
+		want  error
+	}{
+		"NotCustomRole": {
+			roles: &mockRoleClient{},
+			mg:    &strange{},
+			want:  errors.New(errNotCustomRole),
+		},
+		"Patched": {
+			roles: &mockRoleClient{MockPatch: func(name string, role *iamv1.Role, updateMask string) (*iamv1.Role, error) {
+				if diff := cmp.Diff(roleName, name); diff != "" {
+					t.Errorf("Patch(...): -want name, +got name:\n%s", diff)
+				}
+				if diff := cmp.Diff("title,description,includedPermissions,stage", updateMask); diff != "" {
+					t.Errorf("Patch(...): -want mask, +got mask:\n%s", diff)
+				}
+				return role, nil
+			}},
+			mg: customRole(),
+		},
+		"PatchFailed": {
+			roles: &mockRoleClient{MockPatch: func(_ string, _ *iamv1.Role, _ string) (*iamv1.Role, error) { return nil, errBoom }},
+			mg:    customRole(),
+			want:  errors.Wrap(errBoom, errUpdateCustomRole),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &customRoleExternal{roles: tc.roles, projectID: project}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCustomRoleDelete(t *testing.T) {
+	cases := map[string]struct {
+		roles *mockRoleClient
+		mg    resource.Managed
+		want  error
+	}{
+		"NotCustomRole": {
+			roles: &mockRoleClient{},
+			mg:    &strange{},
+			want:  errors.New(errNotCustomRole),
+		},
+		"Deleted": {
+			roles: &mockRoleClient{MockDelete: func(_ string) (*iamv1.Role, error) { return roleResponse(true), nil }},
+			mg:    customRole(),
+		},
+		"NotFound": {
+			roles: &mockRoleClient{MockDelete: func(_ string) (*iamv1.Role, error) { return nil, errNotFound }},
+			mg:    customRole(),
+		},
+		"DisabledInsteadOfDeleted": {
+			roles: &mockRoleClient{MockPatch: func(name string, role *iamv1.Role, updateMask string) (*iamv1.Role, error) {
+				if diff := cmp.Diff(&iamv1.Role{Stage: v1alpha1.CustomRoleStageDisabled}, role); diff != "" {
+					t.Errorf("Patch(...): -want role, +got role:\n%s", diff)
+				}
+				if diff := cmp.Diff("stage", updateMask); diff != "" {
+					t.Errorf("Patch(...): -want mask, +got mask:\n%s", diff)
+				}
+				return role, nil
+			}},
+			mg: customRole(crWithDisableOnDelete()),
+		},
+		"DisableFailed": {
+			roles: &mockRoleClient{MockPatch: func(_ string, _ *iamv1.Role, _ string) (*iamv1.Role, error) { return nil, errBoom }},
+			mg:    customRole(crWithDisableOnDelete()),
+			want:  errors.Wrap(errBoom, errDisableCustomRole),
+		},
+		"DeleteFailed": {
+			roles: &mockRoleClient{MockDelete: func(_ string) (*iamv1.Role, error) { return nil, errBoom }},
+			mg:    customRole(),
+			want:  errors.Wrap(errBoom, errDeleteCustomRole),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &customRoleExternal{roles: tc.roles, projectID: project}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,8 +19,11 @@ package iam
 import (
 	"context"
 	"fmt"
+	"path"
+	"time"
 
 	iamv1 "google.golang.org/api/iam/v1"
+	logging "google.golang.org/api/logging/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -37,7 +40,14 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/externalname"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceaccount"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -49,6 +59,8 @@ const (
 	errCreate            = "cannot create GCP ServiceAccount object via IAM API"
 	errUpdate            = "cannot update GCP ServiceAccount object via IAM API"
 	errDelete            = "cannot delete GCP ServiceAccount object via IAM API"
+	errUndelete          = "cannot undelete GCP ServiceAccount object via IAM API"
+	errListDeletions     = "cannot list audit logs of deletions of GCP ServiceAccount"
 )
 
 // SetupServiceAccount adds a controller that reconciles ServiceAccounts.
@@ -62,7 +74,11 @@ func SetupServiceAccount(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ServiceAccountGroupVersionKind),
-		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, consistency.WrapConnecter(&connecter{client: mgr.GetClient()})))))),
+		managed.WithCreationGracePeriod(consistency.GracePeriod()),
+		managed.WithInitializers(
+			managed.NewNameAsExternalName(mgr.GetClient()),
+			externalname.NewMigrator(mgr.GetClient(), serviceaccount.AccountIDFromExternalName)),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -70,7 +86,7 @@ func SetupServiceAccount(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.ServiceAccount{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -89,12 +105,17 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
+	l, err := logging.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
 	rrn := NewRelativeResourceNamer(projectID)
-	return &external{serviceAccounts: s.Projects.ServiceAccounts, rrn: rrn}, errors.Wrap(err, errNewClient)
+	return &external{serviceAccounts: s.Projects.ServiceAccounts, entries: l.Entries, rrn: rrn}, nil
 }
 
 type external struct {
 	serviceAccounts serviceaccount.Client
+	entries         *logging.EntriesService
 	rrn             RelativeResourceNamer
 }
 
@@ -146,6 +167,18 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	// where the service account should be created
 	req := e.serviceAccounts.Create(e.rrn.ProjectName(), csar)
 	fromProvider, err := req.Context(ctx).Do()
+	if gcp.IsErrorAlreadyExists(err) {
+		// A service account that was deleted less than 30 days ago may still
+		// hold the account ID. Undelete it rather than fail; its display name
+		// and description are updated by the next reconcile if they differ.
+		restored, uerr := e.undelete(ctx, cr)
+		if uerr != nil {
+			return managed.ExternalCreation{}, uerr
+		}
+		if restored != nil {
+			fromProvider, err = restored, nil
+		}
+	}
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
@@ -153,6 +186,41 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 }
 
+// undelete restores the most recently deleted service account with the email
+// of the supplied ServiceAccount, which is found in the Admin Activity audit
+// logs of the project. It returns nil if no such service account can be
+// undeleted.
+func (e *external) undelete(ctx context.Context, cr *v1alpha1.ServiceAccount) (*iamv1.ServiceAccount, error) {
+	if e.entries == nil {
+		return nil, nil
+	}
+	rsp, err := e.entries.List(&logging.ListLogEntriesRequest{
+		ResourceNames: []string{e.rrn.ProjectName()},
+		Filter:        serviceaccount.DeletionFilter(e.rrn.projectName, path.Base(e.rrn.ResourceName(cr)), time.Now()),
+		OrderBy:       "timestamp desc",
+		PageSize:      1,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, errListDeletions)
+	}
+	if len(rsp.Entries) == 0 || serviceaccount.DeletedUniqueID(rsp.Entries[0]) == "" {
+		return nil, nil
+	}
+	name := serviceaccount.UniqueIDName(e.rrn.projectName, serviceaccount.DeletedUniqueID(rsp.Entries[0]))
+	restored, err := e.serviceAccounts.Undelete(name, &iamv1.UndeleteServiceAccountRequest{}).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) || gcp.IsErrorBadRequest(err) {
+		// The account was purged, or its email is held by another account.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errUndelete)
+	}
+	if restored.RestoredAccount == nil {
+		return &iamv1.ServiceAccount{}, nil
+	}
+	return restored.RestoredAccount, nil
+}
+
 // https://cloud.google.com/iam/docs/reference/rest/v1/projects.serviceAccounts/patch
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
 	cr, ok := mg.(*v1alpha1.ServiceAccount)
@@ -28,6 +28,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/googleapi"
 	iamv1 "google.golang.org/api/iam/v1"
+	logging "google.golang.org/api/logging/v2"
 	"google.golang.org/api/option"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -450,6 +451,107 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreateUndelete(t *testing.T) {
+	const deletedID = "1234567890"
+	err409 := &googleapi.Error{Code: http.StatusConflict, Body: "{}\n"}
+	deletion := &logging.LogEntry{Resource: &logging.MonitoredResource{Labels: map[string]string{"unique_id": deletedID}}}
+	restored := &iamv1.ServiceAccount{Name: fqName, Email: accountEmail, UniqueId: deletedID}
+
+	type want struct {
+		mg       resource.Managed
+		err      error
+		undelete bool
+	}
+	cases := map[string]struct {
+		entries  []*logging.LogEntry
+		undelete int
+		want     want
+	}{
+		"Undeleted": {
+			entries:  []*logging.LogEntry{deletion},
+			undelete: http.StatusOK,
+			want: want{
+				mg:       serviceAccount(withExternalNameAnnotation(metadataName), withName(fqName), withEmail(accountEmail), withUniqueID(deletedID)),
+				undelete: true,
+			},
+		},
+		"NoDeletion": {
+			want: want{
+				mg:  serviceAccount(withExternalNameAnnotation(metadataName)),
+				err: errors.Wrap(err409, errCreate),
+			},
+		},
+		"Purged": {
+			entries:  []*logging.LogEntry{deletion},
+			undelete: http.StatusNotFound,
+			want: want{
+				mg:       serviceAccount(withExternalNameAnnotation(metadataName)),
+				err:      errors.Wrap(err409, errCreate),
+				undelete: true,
+			},
+		},
+		"UndeleteFailed": {
+			entries:  []*logging.LogEntry{deletion},
+			undelete: http.StatusInternalServerError,
+			want: want{
+				mg:       serviceAccount(withExternalNameAnnotation(metadataName)),
+				err:      errors.Wrap(err500, errUndelete),
+				undelete: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			undeleted := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.URL.Path {
+				case "/v1/projects/perfect-project/serviceAccounts":
+					w.WriteHeader(http.StatusConflict)
+					_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+				case "/v2/entries:list":
+					req := &logging.ListLogEntriesRequest{}
+					_ = json.NewDecoder(r.Body).Decode(req)
+					if diff := cmp.Diff([]string{"projects/perfect-project"}, req.ResourceNames); diff != "" {
+						t.Errorf("r: -want resource names, +got resource names:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(&logging.ListLogEntriesResponse{Entries: tc.entries})
+				case "/v1/projects/perfect-project/serviceAccounts/" + deletedID + ":undelete":
+					undeleted = true
+					w.WriteHeader(tc.undelete)
+					if tc.undelete != http.StatusOK {
+						_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+						return
+					}
+					_ = json.NewEncoder(w).Encode(&iamv1.UndeleteServiceAccountResponse{RestoredAccount: restored})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+			s, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			l, _ := logging.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &external{serviceAccounts: s.Projects.ServiceAccounts, entries: l.Entries, rrn: NewRelativeResourceNamer("perfect-project")}
+			mg := serviceAccount(withExternalNameAnnotation(metadataName))
+			_, err := e.Create(context.Background(), mg)
+			if tc.want.err != nil && err != nil {
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): want error string != got error string:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.undelete, undeleted); diff != "" {
+				t.Errorf("Create(...): -want undelete, +got undelete:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	type args struct {
 		ctx context.Context
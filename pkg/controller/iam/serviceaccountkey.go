@@ -20,8 +20,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"time"
 
 	iamv1 "google.golang.org/api/iam/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,7 +40,13 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceaccountkey"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -48,6 +56,9 @@ const (
 	errGetServiceAccountKey    = "cannot get GCP ServiceAccountKey object via IAM API"
 	errCreateServiceAccountKey = "cannot create GCP ServiceAccountKey object via IAM API"
 	errDeleteServiceAccountKey = "cannot delete GCP ServiceAccountKey object via IAM API"
+	errDeletePreviousKey       = "cannot delete previous GCP ServiceAccountKey after rotation"
+	errRotateServiceAccountKey = "cannot rotate GCP ServiceAccountKey"
+	errPersistExternalName     = "cannot persist external name of rotated ServiceAccountKey"
 	errDecodePrivateKey        = "cannot decode private key"
 	errDecodePublicKey         = "cannot decode public key"
 )
@@ -60,7 +71,6 @@ const (
 
 	// connection detail keys
 	keyPrivateKeyType = "privateKeyType"
-	keyPrivateKeyData = "privateKey"
 	keyPublicKeyType  = "publicKeyType"
 	keyPublicKeyData  = "publicKey"
 )
@@ -77,7 +87,8 @@ func SetupServiceAccountKey(mgr ctrl.Manager, o controller.Options) error {
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ServiceAccountKeyGroupVersionKind),
 		managed.WithInitializers(),
-		managed.WithExternalConnecter(&serviceAccountKeyServiceConnector{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, consistency.WrapConnecter(&serviceAccountKeyServiceConnector{client: mgr.GetClient()})))))),
+		managed.WithCreationGracePeriod(consistency.GracePeriod()),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -85,7 +96,7 @@ func SetupServiceAccountKey(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.ServiceAccountKey{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -109,12 +120,14 @@ func (c *serviceAccountKeyServiceConnector) Connect(ctx context.Context, mg reso
 	}
 
 	return &serviceAccountKeyExternalClient{
+			kube:                    c.client,
 			serviceAccountKeyClient: s.Projects.ServiceAccounts.Keys,
 		},
 		errors.Wrap(err, errNewClient)
 }
 
 type serviceAccountKeyExternalClient struct {
+	kube                    client.Client
 	serviceAccountKeyClient serviceaccountkey.Client
 }
 
@@ -150,15 +163,18 @@ func (s *serviceAccountKeyExternalClient) Observe(ctx context.Context, mg resour
 
 	cr.Status.SetConditions(xpv1.Available())
 
-	connDetails, err := getConnectionDetails(cr.Spec.ForProvider.PublicKeyType, fromProvider)
+	connDetails, err := getConnectionDetails(cr.Spec.ForProvider, fromProvider)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetServiceAccountKey)
 	}
 
+	// All service account key parameters are immutable, no update method
+	// exists in Google Cloud API for SA keys. A key is only out of date when
+	// it is to be rotated or the key it replaced is to be deleted.
+	now := time.Now()
 	return managed.ExternalObservation{
-		ResourceExists: true,
-		// all service account key parameters are immutable, no update method exists in Google Cloud API for SA keys
-		ResourceUpToDate:  true,
+		ResourceExists:    true,
+		ResourceUpToDate:  !serviceaccountkey.IsRotationDue(cr.Spec.ForProvider, cr.Status.AtProvider, now) && !serviceaccountkey.IsPreviousKeyDue(cr.Status.AtProvider, now),
 		ConnectionDetails: connDetails,
 	}, nil
 }
@@ -170,6 +186,18 @@ func (s *serviceAccountKeyExternalClient) Create(ctx context.Context, mg resourc
 		return managed.ExternalCreation{}, errors.New(errNotServiceAccountKey)
 	}
 
+	keyID, connDetails, err := s.createKey(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateServiceAccountKey)
+	}
+
+	meta.SetExternalName(cr, keyID) // set external name to key id parsing it from Google Cloud API relative resource name
+
+	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: connDetails}, nil
+}
+
+// createKey creates a new key and returns its id and connection details.
+func (s *serviceAccountKeyExternalClient) createKey(ctx context.Context, cr *v1alpha1.ServiceAccountKey) (string, managed.ConnectionDetails, error) {
 	// Technically ServiceAccount can be nil, but reference resolution
 	// should always make sure a value is set before we get to this point.
 	req := s.serviceAccountKeyClient.Create(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount), &iamv1.CreateServiceAccountKeyRequest{
@@ -179,26 +207,65 @@ func (s *serviceAccountKeyExternalClient) Create(ctx context.Context, mg resourc
 
 	fromProvider, err := req.Context(ctx).Do()
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateServiceAccountKey)
+		return "", nil, err
 	}
-	connDetails, err := getConnectionDetails(cr.Spec.ForProvider.PublicKeyType, fromProvider)
+	connDetails, err := getConnectionDetails(cr.Spec.ForProvider, fromProvider)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateServiceAccountKey)
+		return "", nil, err
 	}
 	keyID, err := serviceaccountkey.ParseKeyIDFromRrn(fromProvider.Name)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateServiceAccountKey)
+		return "", nil, err
 	}
+	return keyID, connDetails, nil
+}
 
-	meta.SetExternalName(cr, keyID) // set external name to key id parsing it from Google Cloud API relative resource name
+// Update rotates the key. ServiceAccountKeys are immutable, i.e. GCP IAM Rest
+// API does not provide an update method:
+// https://cloud.google.com/iam/docs/reference/rest/v1/projects.serviceAccounts.keys
+func (s *serviceAccountKeyExternalClient) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServiceAccountKey)
+	}
 
-	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: connDetails}, nil
-}
+	now := time.Now()
+	if serviceaccountkey.IsPreviousKeyDue(cr.Status.AtProvider, now) {
+		_, err := s.serviceAccountKeyClient.Delete(keyPath(cr, cr.Status.AtProvider.PreviousKeyID)).Context(ctx).Do()
+		if resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeletePreviousKey)
+		}
+		cr.Status.AtProvider.PreviousKeyID = ""
+		cr.Status.AtProvider.PreviousKeyDeleteTime = nil
+	}
+	if !serviceaccountkey.IsRotationDue(cr.Spec.ForProvider, cr.Status.AtProvider, now) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	keyID, connDetails, err := s.createKey(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotateServiceAccountKey)
+	}
 
-func (s *serviceAccountKeyExternalClient) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
-	// ServiceAccountKeys are immutable, i.e.,GCP IAM Rest API does not provide an update method:
-	// https://cloud.google.com/iam/docs/reference/rest/v1/projects.serviceAccounts.keys
-	return managed.ExternalUpdate{}, nil
+	// The managed reconciler only persists the status of a resource after
+	// it was updated, so the external name of the new key is persisted here.
+	// Updating the resource resets its status to the persisted one, which is
+	// why the previous key is recorded afterwards.
+	previous := meta.GetExternalName(cr)
+	meta.SetExternalName(cr, keyID)
+	if err := s.kube.Update(ctx, cr); err != nil {
+		// Nothing refers to the new key if its external name could not be
+		// persisted, so it is deleted rather than leaked.
+		meta.SetExternalName(cr, previous)
+		_, _ = s.serviceAccountKeyClient.Delete(keyPath(cr, keyID)).Context(ctx).Do()
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPersistExternalName)
+	}
+	cr.Status.AtProvider.PreviousKeyID = previous
+	cr.Status.AtProvider.PreviousKeyDeleteTime = &metav1.Time{Time: now.Add(serviceaccountkey.RotationGracePeriod(cr.Spec.ForProvider))}
+
+	// The connection details of the new key replace those of the previous
+	// key in a single update of the connection secret.
+	return managed.ExternalUpdate{ConnectionDetails: connDetails}, nil
 }
 
 func (s *serviceAccountKeyExternalClient) Delete(ctx context.Context, mg resource.Managed) error {
@@ -207,6 +274,13 @@ func (s *serviceAccountKeyExternalClient) Delete(ctx context.Context, mg resourc
 		return errors.New(errNotServiceAccountKey)
 	}
 
+	if id := cr.Status.AtProvider.PreviousKeyID; id != "" {
+		_, err := s.serviceAccountKeyClient.Delete(keyPath(cr, id)).Context(ctx).Do()
+		if resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+			return errors.Wrap(err, errDeletePreviousKey)
+		}
+	}
+
 	_, err := s.serviceAccountKeyClient.Delete(resourcePath(cr)).Context(ctx).Do()
 	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteServiceAccountKey)
 }
@@ -217,13 +291,21 @@ func resourcePath(saKey *v1alpha1.ServiceAccountKey) string {
 	// should always make sure a value is set before we get to this point.
 	// Similarly, we always make sure the external name is set before this
 	// function is called.
-	return fmt.Sprintf(fmtKeyRelativeResourceName, gcp.StringValue(saKey.Spec.ForProvider.ServiceAccount), meta.GetExternalName(saKey))
+	return keyPath(saKey, meta.GetExternalName(saKey))
+}
+
+// keyPath yields the Google Cloud API relative resource name of the supplied
+// key of the service account of the ServiceAccountKey resource
+func keyPath(saKey *v1alpha1.ServiceAccountKey, keyID string) string {
+	return fmt.Sprintf(fmtKeyRelativeResourceName, gcp.StringValue(saKey.Spec.ForProvider.ServiceAccount), keyID)
 }
 
-func getConnectionDetails(publicKeyType *string, fromProvider *iamv1.ServiceAccountKey) (managed.ConnectionDetails, error) {
+// getConnectionDetails returns the connection details of the supplied key,
+// laid out as configured by the supplied parameters.
+func getConnectionDetails(in v1alpha1.ServiceAccountKeyParameters, fromProvider *iamv1.ServiceAccountKey) (managed.ConnectionDetails, error) {
 	result := make(map[string][]byte, 4)
 
-	if fromProvider.PublicKeyData != "" {
+	if fromProvider.PublicKeyData != "" && !in.PrivateKeyOnly {
 		d, err := base64.StdEncoding.DecodeString(fromProvider.PublicKeyData)
 		if err != nil {
 			return nil, errors.Wrap(err, errDecodePublicKey)
@@ -231,8 +313,8 @@ func getConnectionDetails(publicKeyType *string, fromProvider *iamv1.ServiceAcco
 		result[keyPublicKeyData] = d
 
 		// only provided optionally in keys.get responses
-		if publicKeyType != nil {
-			result[keyPublicKeyType] = []byte(*publicKeyType)
+		if in.PublicKeyType != nil {
+			result[keyPublicKeyType] = []byte(*in.PublicKeyType)
 		}
 	}
 
@@ -242,10 +324,12 @@ func getConnectionDetails(publicKeyType *string, fromProvider *iamv1.ServiceAcco
 		if err != nil {
 			return nil, errors.Wrap(err, errDecodePrivateKey)
 		}
-		result[keyPrivateKeyData] = d
+		result[serviceaccountkey.PrivateKeySecretKey(in)] = d
 
 		// only provided in keys.create responses
-		result[keyPrivateKeyType] = []byte(fromProvider.PrivateKeyType)
+		if !in.PrivateKeyOnly {
+			result[keyPrivateKeyType] = []byte(fromProvider.PrivateKeyType)
+		}
 	}
 
 	return result, nil
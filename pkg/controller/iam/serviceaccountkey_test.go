@@ -25,10 +25,13 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp/cmpopts"
 	iamv1 "google.golang.org/api/iam/v1"
 	"google.golang.org/api/option"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
@@ -39,6 +42,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceaccountkey"
 )
 
 const (
@@ -377,7 +381,7 @@ func TestServiceAccountKeyCreate(t *testing.T) {
 						// private key data is available in iam.ServiceAccountKey.create response, and hence
 						// is expected to be available in connection details
 						keyPrivateKeyType: []byte(valIAMPrivateKeyType),
-						keyPrivateKeyData: []byte(valIAMPrivateKeyData),
+						serviceaccountkey.DefaultPrivateKeySecretKey: []byte(valIAMPrivateKeyData),
 					},
 				},
 				mg: newServiceAccountKey(
@@ -390,6 +394,38 @@ func TestServiceAccountKeyCreate(t *testing.T) {
 				),
 			},
 		},
+		"GoogleCloudAPIReadSuccessPrivateKeyOnly": {
+			reason: "Only the private key is written to the connection secret, under the configured key",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(getIAMSaKeyGetObjectWithEncodedKeyData(iamSaKeyCreateObject))
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setPublicKeyType(valIAMPublicKeyType),
+					setPrivateKeyType(valIAMPrivateKeyType),
+					setPrivateKeySecretKey("credentials.json", true),
+				),
+			},
+			want: want{
+				c: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails: map[string][]byte{
+						"credentials.json": []byte(valIAMPrivateKeyData),
+					},
+				},
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setAnnotations(map[string]string{
+						meta.AnnotationKeyExternalName: nameExternalServiceAccountKey,
+					}),
+					setPublicKeyType(valIAMPublicKeyType),
+					setPrivateKeyType(valIAMPrivateKeyType),
+					setPrivateKeySecretKey("credentials.json", true),
+				),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -418,6 +454,12 @@ func TestServiceAccountKeyCreate(t *testing.T) {
 }
 
 func TestServiceAccountKeyUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	day := metav1.Duration{Duration: 24 * time.Hour}
+	old := time.Now().Add(-48 * time.Hour).UTC().Format(time.RFC3339)
+	past := &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	rrnPreviousServiceAccountKey := rrnTestServiceAccount + "/keys/previous"
+
 	type args struct {
 		ctx context.Context
 		mg  resource.Managed
@@ -432,17 +474,163 @@ func TestServiceAccountKeyUpdate(t *testing.T) {
 	testCases := map[string]struct {
 		reason  string
 		handler http.Handler
+		kube    client.Client
 		args    args
 		want    want
 	}{
-		"NoOpUpdate": {
-			reason: "assert update is a no-op",
+		"NotServiceAccountKey": {
+			reason: "assert error if not reconciling on a valid v1alpha1.ServiceAccountKey object",
 			args: args{
 				ctx: context.Background(),
-				mg:  nil,
+				mg:  &strange{},
 			},
 			want: want{
-				err: nil,
+				mg:  &strange{},
+				err: errors.New(errNotServiceAccountKey),
+			},
+		},
+		"RotationNotDue": {
+			reason: "assert update is a no-op if the key is not to be rotated",
+			args: args{
+				ctx: context.Background(),
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setRotationPeriod(day),
+					setValidAfterTime(time.Now().UTC().Format(time.RFC3339)),
+				),
+			},
+			want: want{
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setRotationPeriod(day),
+					setValidAfterTime(time.Now().UTC().Format(time.RFC3339)),
+				),
+			},
+		},
+		"Rotated": {
+			reason: "assert a new key is created, its external name persisted and its connection details returned if the key is to be rotated",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+				}
+				if err := json.NewEncoder(w).Encode(getIAMSaKeyGetObjectWithEncodedKeyData(iamSaKeyCreateObject)); err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}),
+			kube: &test.MockClient{
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					if got := meta.GetExternalName(obj); got != nameExternalServiceAccountKey {
+						t.Errorf("Update(...): want external name %q, got %q", nameExternalServiceAccountKey, got)
+					}
+					return nil
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setAnnotations(map[string]string{meta.AnnotationKeyExternalName: "previous"}),
+					setRotationPeriod(day),
+					setValidAfterTime(old),
+				),
+			},
+			want: want{
+				u: managed.ExternalUpdate{
+					ConnectionDetails: map[string][]byte{
+						keyPublicKeyType:  []byte(valIAMPublicKeyType),
+						keyPublicKeyData:  []byte(valIAMPublicKeyData),
+						keyPrivateKeyType: []byte(valIAMPrivateKeyType),
+						serviceaccountkey.DefaultPrivateKeySecretKey: []byte(valIAMPrivateKeyData),
+					},
+				},
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setAnnotations(map[string]string{meta.AnnotationKeyExternalName: nameExternalServiceAccountKey}),
+					setRotationPeriod(day),
+					setValidAfterTime(old),
+					setPreviousKey("previous", nil),
+				),
+			},
+		},
+		"RotationNotPersisted": {
+			reason: "assert the new key is deleted if its external name cannot be persisted",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					if r.URL.Path != "/v1/"+rrnTestServiceAccountKey {
+						t.Errorf("unexpected deletion of %s", r.URL.Path)
+					}
+					_, _ = w.Write([]byte("{}"))
+					return
+				}
+				if err := json.NewEncoder(w).Encode(getIAMSaKeyGetObjectWithEncodedKeyData(iamSaKeyCreateObject)); err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setAnnotations(map[string]string{meta.AnnotationKeyExternalName: "previous"}),
+					setRotationPeriod(day),
+					setValidAfterTime(old),
+				),
+			},
+			want: want{
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setAnnotations(map[string]string{meta.AnnotationKeyExternalName: "previous"}),
+					setRotationPeriod(day),
+					setValidAfterTime(old),
+				),
+				err: errors.Wrap(errBoom, errPersistExternalName),
+			},
+		},
+		"PreviousKeyDeleted": {
+			reason: "assert the previous key is deleted once its grace period has passed",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/v1/"+rrnPreviousServiceAccountKey {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+				}
+				_, _ = w.Write([]byte("{}"))
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setRotationPeriod(day),
+					setValidAfterTime(time.Now().UTC().Format(time.RFC3339)),
+					setPreviousKey("previous", past),
+				),
+			},
+			want: want{
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setRotationPeriod(day),
+					setValidAfterTime(time.Now().UTC().Format(time.RFC3339)),
+				),
+			},
+		},
+		"PreviousKeyDeleteError": {
+			reason: "assert errors deleting the previous key are returned",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setPreviousKey("previous", past),
+				),
+			},
+			want: want{
+				mg: newServiceAccountKey(
+					setServiceAccount(rrnTestServiceAccount),
+					setPreviousKey("previous", past),
+				),
+				err: errors.Wrap(gError(http.StatusInternalServerError, ""), errDeletePreviousKey),
 			},
 		},
 	}
@@ -457,7 +645,7 @@ func TestServiceAccountKeyUpdate(t *testing.T) {
 				t.Fatalf("iam.NewService failed while running test case %q: %s", name, err)
 			}
 
-			c := &serviceAccountKeyExternalClient{serviceAccountKeyClient: iamv1.NewProjectsServiceAccountsKeysService(s)}
+			c := &serviceAccountKeyExternalClient{kube: tc.kube, serviceAccountKeyClient: iamv1.NewProjectsServiceAccountsKeysService(s)}
 			got, err := c.Update(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("%s\nc.Update(...): -want error, +got:\n%s", tc.reason, diff)
@@ -465,7 +653,10 @@ func TestServiceAccountKeyUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.u, got); diff != "" {
 				t.Errorf("%s\nc.Update(...): -want update, +got:\n%s", tc.reason, diff)
 			}
-			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+			// The time the previous key is deleted at depends on the time
+			// of the rotation.
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions(),
+				cmpopts.IgnoreFields(v1alpha1.ServiceAccountKeyObservation{}, "PreviousKeyDeleteTime")); diff != "" {
 				t.Errorf("%s\nc.Update(...): -want managed resource, +got:\n%s", tc.reason, diff)
 			}
 		})
@@ -646,6 +837,13 @@ func setPrivateKeyType(privateKeyType string) serviceAccountKeyModifier {
 	}
 }
 
+func setPrivateKeySecretKey(key string, only bool) serviceAccountKeyModifier {
+	return func(saKey *v1alpha1.ServiceAccountKey) {
+		saKey.Spec.ForProvider.PrivateKeySecretKey = &key
+		saKey.Spec.ForProvider.PrivateKeyOnly = only
+	}
+}
+
 func setObservedIAMServiceAccountKey(provider *iamv1.ServiceAccountKey, keyID string) serviceAccountKeyModifier {
 	return func(saKey *v1alpha1.ServiceAccountKey) {
 		saKey.Status.AtProvider.KeyID = keyID
@@ -659,6 +857,25 @@ func setObservedIAMServiceAccountKey(provider *iamv1.ServiceAccountKey, keyID st
 	}
 }
 
+func setRotationPeriod(d metav1.Duration) serviceAccountKeyModifier {
+	return func(saKey *v1alpha1.ServiceAccountKey) {
+		saKey.Spec.ForProvider.RotationPeriod = &d
+	}
+}
+
+func setValidAfterTime(t string) serviceAccountKeyModifier {
+	return func(saKey *v1alpha1.ServiceAccountKey) {
+		saKey.Status.AtProvider.ValidAfterTime = t
+	}
+}
+
+func setPreviousKey(keyID string, deleteTime *metav1.Time) serviceAccountKeyModifier {
+	return func(saKey *v1alpha1.ServiceAccountKey) {
+		saKey.Status.AtProvider.PreviousKeyID = keyID
+		saKey.Status.AtProvider.PreviousKeyDeleteTime = deleteTime
+	}
+}
+
 func setConditions(conditions ...v1.Condition) serviceAccountKeyModifier {
 	return func(saKey *v1alpha1.ServiceAccountKey) {
 		for _, c := range conditions {
@@ -28,6 +28,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -35,7 +36,15 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicaccess"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceaccountpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/takeover"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -45,6 +54,8 @@ const (
 
 	errGetPolicy = "cannot get policy of CryptoKey"
 	errSetPolicy = "cannot set policy of CryptoKey"
+
+	errTakeoverPolicy = "cannot set ServiceAccountPolicy"
 )
 
 // SetupServiceAccountPolicy adds a controller that reconciles ServiceAccountPolicys.
@@ -58,7 +69,8 @@ func SetupServiceAccountPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ServiceAccountPolicyGroupVersionKind),
-		managed.WithExternalConnecter(&serviceAccountPolicyConnecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, consistency.WrapConnecter(&serviceAccountPolicyConnecter{client: mgr.GetClient()})))))),
+		managed.WithCreationGracePeriod(consistency.GracePeriod()),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -66,7 +78,7 @@ func SetupServiceAccountPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.ServiceAccountPolicy{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -111,9 +123,15 @@ func (e *serviceAccountPolicyExternal) Observe(ctx context.Context, mg resource.
 	if upToDate, err := serviceaccountpolicy.IsUpToDate(&cr.Spec.ForProvider, instance); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	} else if !upToDate {
+		// A ServiceAccountPolicy must not silently replace a policy that it
+		// does not own yet, lest it lock users out of the service account.
+		if err := checkTakeover(cr, instance); err != nil {
+			return managed.ExternalObservation{}, err
+		}
 		return managed.ExternalObservation{ResourceExists: true}, nil
 	}
 
+	cr.Status.AtProvider.PolicyOwned = true
 	cr.Status.SetConditions(xpv1.Available())
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -126,6 +144,9 @@ func (e *serviceAccountPolicyExternal) Create(ctx context.Context, mg resource.M
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotServiceAccountPolicy)
 	}
+	if err := publicaccess.Check(cr, publicaccess.Members(cr.Spec.ForProvider.Policy)...); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	cr.SetConditions(xpv1.Creating())
 	instance := &iamv1.Policy{}
 	serviceaccountpolicy.GenerateServiceAccountPolicyInstance(cr.Spec.ForProvider, instance)
@@ -136,6 +157,7 @@ func (e *serviceAccountPolicyExternal) Create(ctx context.Context, mg resource.M
 		Context(ctx).Do(); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errSetPolicy)
 	}
+	cr.Status.AtProvider.PolicyOwned = true
 
 	return managed.ExternalCreation{}, nil
 }
@@ -145,6 +167,9 @@ func (e *serviceAccountPolicyExternal) Update(ctx context.Context, mg resource.M
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotServiceAccountPolicy)
 	}
+	if err := publicaccess.Check(cr, publicaccess.Members(cr.Spec.ForProvider.Policy)...); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	instance, err := e.serviceaccountspolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount)).OptionsRequestedPolicyVersion(v1alpha1.PolicyVersion).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPolicy)
@@ -165,6 +190,7 @@ func (e *serviceAccountPolicyExternal) Update(ctx context.Context, mg resource.M
 		Context(ctx).Do(); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errSetPolicy)
 	}
+	cr.Status.AtProvider.PolicyOwned = true
 
 	return managed.ExternalUpdate{}, nil
 }
@@ -181,3 +207,19 @@ func (e *serviceAccountPolicyExternal) Delete(ctx context.Context, mg resource.M
 	}
 	return nil
 }
+
+// checkTakeover returns an error and holds the supplied ServiceAccountPolicy
+// in a non-ready state if setting it would remove bindings of an existing
+// policy it does not own, unless it allows the takeover of that policy.
+func checkTakeover(cr *v1alpha1.ServiceAccountPolicy, instance *iamv1.Policy) error {
+	p := cr.Spec.ForProvider
+	if p.AllowPolicyTakeover || meta.WasDeleted(cr) || takeover.IsOwned(cr.Status.AtProvider.PolicyOwned, cr) {
+		return nil
+	}
+	removed := takeover.Removed(serviceaccountpolicy.Bindings(instance), p.Policy)
+	if len(removed) == 0 {
+		return nil
+	}
+	cr.SetConditions(takeover.Blocked(removed))
+	return errors.Wrap(takeover.NotAllowed(removed), errTakeoverPolicy)
+}
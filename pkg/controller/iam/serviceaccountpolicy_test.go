@@ -25,6 +25,7 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceaccountpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/takeover"
 )
 
 const (
@@ -58,6 +59,14 @@ func sapWithCondition(condition xpv1.Condition) sapValueModifier {
 	return func(i *v1alpha1.ServiceAccountPolicy) { i.SetConditions(condition) }
 }
 
+func sapWithPolicyOwned() sapValueModifier {
+	return func(i *v1alpha1.ServiceAccountPolicy) { i.Status.AtProvider.PolicyOwned = true }
+}
+
+func sapWithAllowPolicyTakeover() sapValueModifier {
+	return func(i *v1alpha1.ServiceAccountPolicy) { i.Spec.ForProvider.AllowPolicyTakeover = true }
+}
+
 func sapWithBinding(binding *iamv1alpha1.Binding) sapValueModifier {
 	return func(i *v1alpha1.ServiceAccountPolicy) {
 		i.Spec.ForProvider.Policy.Bindings = append(i.Spec.ForProvider.Policy.Bindings, binding)
@@ -185,11 +194,72 @@ func TestServiceAccountPolicyObserve(t *testing.T) {
 				ctx: context.Background(),
 				mg: ServiceAccountPolicy(
 					sapWithName(sapMetadataName),
+					sapWithPolicyOwned(),
 				),
 			},
 			want: want{
 				mg: ServiceAccountPolicy(
-					sapWithName(sapMetadataName)),
+					sapWithName(sapMetadataName),
+					sapWithPolicyOwned()),
+				observation: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"TakeoverNotAllowed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sap := &iamv1.Policy{
+					Bindings: []*iamv1.Binding{
+						{
+							Members: []string{"some-other-member"},
+							Role:    testRole,
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(sap); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: ServiceAccountPolicy(
+					sapWithName(sapMetadataName),
+				),
+			},
+			want: want{
+				mg: ServiceAccountPolicy(
+					sapWithName(sapMetadataName),
+					sapWithCondition(takeover.Blocked([]string{testRole + " some-other-member"}))),
+				err: errors.Wrap(takeover.NotAllowed([]string{testRole + " some-other-member"}), errTakeoverPolicy),
+			},
+		},
+		"TakeoverAllowed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sap := &iamv1.Policy{
+					Bindings: []*iamv1.Binding{
+						{
+							Members: []string{"some-other-member"},
+							Role:    testRole,
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(sap); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: ServiceAccountPolicy(
+					sapWithName(sapMetadataName),
+					sapWithAllowPolicyTakeover(),
+				),
+			},
+			want: want{
+				mg: ServiceAccountPolicy(
+					sapWithName(sapMetadataName),
+					sapWithAllowPolicyTakeover()),
 				observation: managed.ExternalObservation{
 					ResourceExists: true,
 				},
@@ -228,6 +298,7 @@ func TestServiceAccountPolicyObserve(t *testing.T) {
 			want: want{
 				mg: ServiceAccountPolicy(
 					sapWithCondition(xpv1.Available()),
+					sapWithPolicyOwned(),
 					sapWithName(sapMetadataName)),
 				observation: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -343,7 +414,8 @@ func TestServiceAccountPolicyCreate(t *testing.T) {
 				mg: ServiceAccountPolicy(
 					sapWithName(sapMetadataName),
 					sapWithExternalNameAnnotation(sapMetadataName),
-					sapWithCondition(xpv1.Creating())),
+					sapWithCondition(xpv1.Creating()),
+					sapWithPolicyOwned()),
 			},
 		},
 		"CreateFailed": {
@@ -490,6 +562,7 @@ func TestServiceAccountPolicyUpdate(t *testing.T) {
 					sapWithName(sapMetadataName),
 					sapWithExternalNameAnnotation(sapMetadataName),
 					sapWithCondition(xpv1.Available()),
+					sapWithPolicyOwned(),
 					sapWithBinding(&iamv1alpha1.Binding{
 						Members: []string{"another-member"},
 						Role:    "another-role",
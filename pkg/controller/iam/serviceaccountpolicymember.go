@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	iamv1 "google.golang.org/api/iam/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/projectpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicaccess"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceaccountpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	errNotServiceAccountPolicyMember = "managed resource is not a GCP ServiceAccountPolicyMember"
+)
+
+// SetupServiceAccountPolicyMember adds a controller that reconciles
+// ServiceAccountPolicyMembers.
+func SetupServiceAccountPolicyMember(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ServiceAccountPolicyMemberGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ServiceAccountPolicyMemberGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, consistency.WrapConnecter(&serviceAccountPolicyMemberConnecter{client: mgr.GetClient()})))))),
+		managed.WithCreationGracePeriod(consistency.GracePeriod()),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.ServiceAccountPolicyMember{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type serviceAccountPolicyMemberConnecter struct {
+	client client.Client
+}
+
+// Connect sets up iam client using credentials from the provider
+func (c *serviceAccountPolicyMemberConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := iamv1.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &serviceAccountPolicyMemberExternal{serviceaccountspolicy: iamv1.NewProjectsServiceAccountsService(s)}, nil
+}
+
+type serviceAccountPolicyMemberExternal struct {
+	serviceaccountspolicy serviceaccountpolicy.Client
+}
+
+func (e *serviceAccountPolicyMemberExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountPolicyMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceAccountPolicyMember)
+	}
+
+	instance, err := e.serviceaccountspolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount)).OptionsRequestedPolicyVersion(v1alpha1.PolicyVersion).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+
+	changed := serviceaccountpolicy.BindRoleToMember(cr.Spec.ForProvider, instance)
+	if !changed {
+		cr.Status.SetConditions(xpv1.Available())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	return managed.ExternalObservation{}, nil
+}
+
+func (e *serviceAccountPolicyMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountPolicyMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceAccountPolicyMember)
+	}
+	if err := publicaccess.Check(cr, serviceaccountpolicy.Members(cr.Spec.ForProvider)...); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return managed.ExternalCreation{}, e.modifyPolicy(ctx, gcp.StringValue(cr.Spec.ForProvider.ServiceAccount), func(p *iamv1.Policy) bool {
+		return serviceaccountpolicy.BindRoleToMember(cr.Spec.ForProvider, p)
+	})
+}
+
+func (e *serviceAccountPolicyMemberExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	_, err := e.Create(ctx, mg)
+	return managed.ExternalUpdate{}, err
+}
+
+func (e *serviceAccountPolicyMemberExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServiceAccountPolicyMember)
+	if !ok {
+		return errors.New(errNotServiceAccountPolicyMember)
+	}
+	err := e.modifyPolicy(ctx, gcp.StringValue(cr.Spec.ForProvider.ServiceAccount), func(p *iamv1.Policy) bool {
+		return serviceaccountpolicy.UnbindRoleFromMember(cr.Spec.ForProvider, p)
+	})
+	return resource.Ignore(gcp.IsErrorNotFound, err)
+}
+
+// modifyPolicy reads the IAM policy of the supplied ServiceAccount, applies fn
+// to it and sets it if fn reports a change. The policy is set with the etag it
+// was read with, so the read-modify-write is retried if someone else changed
+// the policy in the meantime.
+func (e *serviceAccountPolicyMemberExternal) modifyPolicy(ctx context.Context, sa string, fn func(*iamv1.Policy) bool) error {
+	return retry.OnError(projectpolicy.ConflictBackoff, projectpolicy.IsErrorConflict, func() error {
+		instance, err := e.serviceaccountspolicy.GetIamPolicy(sa).OptionsRequestedPolicyVersion(v1alpha1.PolicyVersion).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
+		if !fn(instance) {
+			return nil
+		}
+		_, err = e.serviceaccountspolicy.SetIamPolicy(sa, &iamv1.SetIamPolicyRequest{Policy: instance}).Context(ctx).Do()
+		return errors.Wrap(err, errSetPolicy)
+	})
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	iamv1 "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+var (
+	_ managed.ExternalConnecter = &serviceAccountPolicyMemberConnecter{}
+	_ managed.ExternalClient    = &serviceAccountPolicyMemberExternal{}
+
+	testWorkloadIdentityRole = "roles/iam.workloadIdentityUser"
+	testKSAMember            = "serviceAccount:my-project.svc.id.goog[default/my-ksa]"
+	testOtherKSAMember       = "serviceAccount:my-project.svc.id.goog[default/other-ksa]"
+)
+
+// saPolicyServer serves get on the supplied ServiceAccount policy and records
+// every policy that is set.
+type saPolicyServer struct {
+	t      *testing.T
+	get    *iamv1.Policy
+	status int
+	set    []*iamv1.Policy
+}
+
+func (s *saPolicyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+		s.t.Errorf("r: -want method, +got method:\n%s", diff)
+	}
+	if !strings.HasPrefix(r.URL.Path, "/v1/"+testServiceAccountRRN+":") {
+		s.t.Errorf("r: unexpected path %s", r.URL.Path)
+	}
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		_ = json.NewEncoder(w).Encode(struct{}{})
+		return
+	}
+	switch {
+	case strings.HasSuffix(r.URL.Path, ":getIamPolicy"):
+		_ = json.NewEncoder(w).Encode(s.get)
+	case strings.HasSuffix(r.URL.Path, ":setIamPolicy"):
+		req := &iamv1.SetIamPolicyRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			s.t.Error(err)
+		}
+		s.set = append(s.set, req.Policy)
+		_ = json.NewEncoder(w).Encode(req.Policy)
+	}
+}
+
+func (s *saPolicyServer) client() *iamv1.ProjectsServiceAccountsService {
+	server := httptest.NewServer(s)
+	s.t.Cleanup(server.Close)
+	i, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return iamv1.NewProjectsServiceAccountsService(i)
+}
+
+type sapmModifier func(*v1alpha1.ServiceAccountPolicyMember)
+
+func sapmWithCondition(c xpv1.Condition) sapmModifier {
+	return func(p *v1alpha1.ServiceAccountPolicyMember) { p.SetConditions(c) }
+}
+
+func sapmWithMembers(m ...string) sapmModifier {
+	return func(p *v1alpha1.ServiceAccountPolicyMember) { p.Spec.ForProvider.Members = m }
+}
+
+func serviceAccountPolicyMember(m ...sapmModifier) *v1alpha1.ServiceAccountPolicyMember {
+	p := &v1alpha1.ServiceAccountPolicyMember{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-service-account-policy-member"},
+		Spec: v1alpha1.ServiceAccountPolicyMemberSpec{
+			ForProvider: v1alpha1.ServiceAccountPolicyMemberParameters{
+				ServiceAccountReferer: v1alpha1.ServiceAccountReferer{
+					ServiceAccount: &testServiceAccountRRN,
+				},
+				Role:   testWorkloadIdentityRole,
+				Member: gcp.StringPtr(testKSAMember),
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(p)
+	}
+	return p
+}
+
+func TestServiceAccountPolicyMemberObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		server *saPolicyServer
+		mg     resource.Managed
+		want   want
+	}{
+		"NotServiceAccountPolicyMember": {
+			server: &saPolicyServer{},
+			mg:     &strange{},
+			want:   want{mg: &strange{}, err: errors.New(errNotServiceAccountPolicyMember)},
+		},
+		"GetFailed": {
+			server: &saPolicyServer{status: http.StatusInternalServerError},
+			mg:     serviceAccountPolicyMember(),
+			want:   want{mg: serviceAccountPolicyMember(), err: errors.Wrap(err500, errGetPolicy)},
+		},
+		"ServiceAccountNotFound": {
+			server: &saPolicyServer{status: http.StatusNotFound},
+			mg:     serviceAccountPolicyMember(),
+			want:   want{mg: serviceAccountPolicyMember()},
+		},
+		"NotBound": {
+			server: &saPolicyServer{get: &iamv1.Policy{Bindings: []*iamv1.Binding{
+				{Role: testWorkloadIdentityRole, Members: []string{testOtherKSAMember}},
+			}}},
+			mg:   serviceAccountPolicyMember(),
+			want: want{mg: serviceAccountPolicyMember()},
+		},
+		"PartiallyBound": {
+			server: &saPolicyServer{get: &iamv1.Policy{Bindings: []*iamv1.Binding{
+				{Role: testWorkloadIdentityRole, Members: []string{testKSAMember}},
+			}}},
+			mg:   serviceAccountPolicyMember(sapmWithMembers(testOtherKSAMember)),
+			want: want{mg: serviceAccountPolicyMember(sapmWithMembers(testOtherKSAMember))},
+		},
+		"Bound": {
+			server: &saPolicyServer{get: &iamv1.Policy{Bindings: []*iamv1.Binding{
+				{Role: testWorkloadIdentityRole, Members: []string{testOtherKSAMember, testKSAMember}},
+			}}},
+			mg: serviceAccountPolicyMember(),
+			want: want{
+				mg:  serviceAccountPolicyMember(sapmWithCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			e := &serviceAccountPolicyMemberExternal{serviceaccountspolicy: tc.server.client()}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccountPolicyMemberCreate(t *testing.T) {
+	type want struct {
+		set []*iamv1.Policy
+		err error
+	}
+	cases := map[string]struct {
+		server *saPolicyServer
+		mg     resource.Managed
+		want   want
+	}{
+		"NotServiceAccountPolicyMember": {
+			server: &saPolicyServer{},
+			mg:     &strange{},
+			want:   want{err: errors.New(errNotServiceAccountPolicyMember)},
+		},
+		"GetFailed": {
+			server: &saPolicyServer{status: http.StatusInternalServerError},
+			mg:     serviceAccountPolicyMember(),
+			want:   want{err: errors.Wrap(err500, errGetPolicy)},
+		},
+		"AlreadyBound": {
+			server: &saPolicyServer{get: &iamv1.Policy{Bindings: []*iamv1.Binding{
+				{Role: testWorkloadIdentityRole, Members: []string{testKSAMember}},
+			}}},
+			mg: serviceAccountPolicyMember(),
+		},
+		"BindKeepingOtherMembers": {
+			server: &saPolicyServer{get: &iamv1.Policy{Etag: "etag", Bindings: []*iamv1.Binding{
+				{Role: testWorkloadIdentityRole, Members: []string{testOtherKSAMember}},
+			}}},
+			mg: serviceAccountPolicyMember(),
+			want: want{set: []*iamv1.Policy{{
+				Etag:     "etag",
+				Version:  v1alpha1.PolicyVersion,
+				Bindings: []*iamv1.Binding{{Role: testWorkloadIdentityRole, Members: []string{testOtherKSAMember, testKSAMember}}},
+			}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			e := &serviceAccountPolicyMemberExternal{serviceaccountspolicy: tc.server.client()}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.set, tc.server.set); diff != "" {
+				t.Errorf("Create(...): -want set policies, +got set policies:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccountPolicyMemberDelete(t *testing.T) {
+	type want struct {
+		set []*iamv1.Policy
+		err error
+	}
+	cases := map[string]struct {
+		server *saPolicyServer
+		mg     resource.Managed
+		want   want
+	}{
+		"NotServiceAccountPolicyMember": {
+			server: &saPolicyServer{},
+			mg:     &strange{},
+			want:   want{err: errors.New(errNotServiceAccountPolicyMember)},
+		},
+		"ServiceAccountNotFound": {
+			server: &saPolicyServer{status: http.StatusNotFound},
+			mg:     serviceAccountPolicyMember(),
+		},
+		"NotBound": {
+			server: &saPolicyServer{get: &iamv1.Policy{Bindings: []*iamv1.Binding{
+				{Role: testWorkloadIdentityRole, Members: []string{testOtherKSAMember}},
+			}}},
+			mg: serviceAccountPolicyMember(),
+		},
+		"UnbindKeepingOtherMembers": {
+			server: &saPolicyServer{get: &iamv1.Policy{Bindings: []*iamv1.Binding{
+				{Role: testWorkloadIdentityRole, Members: []string{testOtherKSAMember, testKSAMember}},
+			}}},
+			mg: serviceAccountPolicyMember(),
+			want: want{set: []*iamv1.Policy{{Bindings: []*iamv1.Binding{
+				{Role: testWorkloadIdentityRole, Members: []string{testOtherKSAMember}},
+			}}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.server.t = t
+			e := &serviceAccountPolicyMemberExternal{serviceaccountspolicy: tc.server.client()}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.set, tc.server.set); diff != "" {
+				t.Errorf("Delete(...): -want set policies, +got set policies:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"time"
+
+	iamcredentials "google.golang.org/api/iamcredentials/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/serviceaccounttoken"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error messages
+const (
+	errNotServiceAccountToken      = "managed resource is not a GCP ServiceAccountToken"
+	errGenerateServiceAccountToken = "cannot generate GCP ServiceAccountToken via IAM Credentials API"
+)
+
+// connection detail keys
+const (
+	keyToken      = "token"
+	keyTokenType  = "tokenType"
+	keyExpireTime = "expireTime"
+)
+
+// SetupServiceAccountToken adds a controller that reconciles
+// ServiceAccountTokens.
+func SetupServiceAccountToken(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ServiceAccountTokenGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ServiceAccountTokenGroupVersionKind),
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &serviceAccountTokenConnector{client: mgr.GetClient()}))))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.ServiceAccountToken{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type serviceAccountTokenConnector struct {
+	client client.Client
+}
+
+// Connect sets up the IAM Credentials external client using credentials from
+// the provider
+func (c *serviceAccountTokenConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := iamcredentials.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &serviceAccountTokenExternal{tokens: s.Projects.ServiceAccounts, now: time.Now}, nil
+}
+
+type serviceAccountTokenExternal struct {
+	tokens serviceaccounttoken.Client
+	now    func() time.Time
+}
+
+// Observe reports a token as needing an update whenever it is about to
+// expire. Tokens only exist in the connection secret, so there is nothing to
+// read from the IAM Credentials API.
+func (e *serviceAccountTokenExternal) Observe(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountToken)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceAccountToken)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if serviceaccounttoken.NeedsRefresh(cr.Spec.ForProvider, cr.Status.AtProvider.ExpireTime, e.now()) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// Create only records the service account the token is generated for. The
+// managed reconciler discards status changes made during Create, so the token
+// is generated by the Update that immediately follows.
+func (e *serviceAccountTokenExternal) Create(_ context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountToken)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceAccountToken)
+	}
+
+	// Technically ServiceAccount can be nil, but reference resolution
+	// should always make sure a value is set before we get to this point.
+	meta.SetExternalName(cr, serviceaccounttoken.ResourceName(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount)))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update generates a new token and publishes it to the connection secret.
+// https://cloud.google.com/iam/docs/reference/credentials/rest/v1/projects.serviceAccounts/generateAccessToken
+// https://cloud.google.com/iam/docs/reference/credentials/rest/v1/projects.serviceAccounts/generateIdToken
+func (e *serviceAccountTokenExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServiceAccountToken)
+	}
+
+	var (
+		token     string
+		tokenType = v1alpha1.TokenTypeAccessToken
+		expiry    time.Time
+	)
+	if p := cr.Spec.ForProvider; serviceaccounttoken.IsIDToken(p) {
+		rsp, err := e.tokens.GenerateIdToken(meta.GetExternalName(cr), serviceaccounttoken.GenerateIDTokenRequest(p)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateServiceAccountToken)
+		}
+		if expiry, err = serviceaccounttoken.IDTokenExpiry(rsp.Token); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateServiceAccountToken)
+		}
+		token, tokenType = rsp.Token, v1alpha1.TokenTypeIDToken
+	} else {
+		rsp, err := e.tokens.GenerateAccessToken(meta.GetExternalName(cr), serviceaccounttoken.GenerateAccessTokenRequest(p)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateServiceAccountToken)
+		}
+		if expiry, err = time.Parse(time.RFC3339, rsp.ExpireTime); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateServiceAccountToken)
+		}
+		token = rsp.AccessToken
+	}
+
+	cr.Status.AtProvider.ExpireTime = expiry.UTC().Format(time.RFC3339)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+		keyToken:      []byte(token),
+		keyTokenType:  []byte(tokenType),
+		keyExpireTime: []byte(cr.Status.AtProvider.ExpireTime),
+	}}, nil
+}
+
+// Delete is a no-op. Short-lived tokens cannot be revoked; they expire on
+// their own.
+func (e *serviceAccountTokenExternal) Delete(_ context.Context, mg resource.Managed) error {
+	if _, ok := mg.(*v1alpha1.ServiceAccountToken); !ok {
+		return errors.New(errNotServiceAccountToken)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	iamcredentials "google.golang.org/api/iamcredentials/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	nameTokenServiceAccount = "projects/-/serviceAccounts/token-sa@cool-project.iam.gserviceaccount.com"
+	valAccessToken          = "ya29.access-token"
+	valExpireTime           = "2023-01-01T13:00:00Z"
+)
+
+var tokenNow = time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type serviceAccountTokenModifier func(*v1alpha1.ServiceAccountToken)
+
+func serviceAccountToken(im ...serviceAccountTokenModifier) *v1alpha1.ServiceAccountToken {
+	cr := &v1alpha1.ServiceAccountToken{}
+	cr.Spec.ForProvider.ServiceAccount = gcp.StringPtr("projects/cool-project/serviceAccounts/token-sa@cool-project.iam.gserviceaccount.com")
+	for _, m := range im {
+		m(cr)
+	}
+	return cr
+}
+
+func withTokenExternalName(cr *v1alpha1.ServiceAccountToken) {
+	meta.SetExternalName(cr, nameTokenServiceAccount)
+}
+
+func withExpireTime(t string) serviceAccountTokenModifier {
+	return func(cr *v1alpha1.ServiceAccountToken) { cr.Status.AtProvider.ExpireTime = t }
+}
+
+func TestServiceAccountTokenObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotServiceAccountToken": {
+			mg:   &strange{},
+			want: want{err: errors.New(errNotServiceAccountToken)},
+		},
+		"NotCreated": {
+			mg: serviceAccountToken(),
+		},
+		"NoToken": {
+			mg:   serviceAccountToken(withTokenExternalName),
+			want: want{o: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"Fresh": {
+			mg:   serviceAccountToken(withTokenExternalName, withExpireTime(valExpireTime)),
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"AboutToExpire": {
+			mg:   serviceAccountToken(withTokenExternalName, withExpireTime("2023-01-01T12:05:00Z")),
+			want: want{o: managed.ExternalObservation{ResourceExists: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &serviceAccountTokenExternal{now: func() time.Time { return tokenNow }}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccountTokenCreate(t *testing.T) {
+	cr := serviceAccountToken()
+	got, err := (&serviceAccountTokenExternal{}).Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, got); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(nameTokenServiceAccount, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+	}
+}
+
+func TestServiceAccountTokenUpdate(t *testing.T) {
+	idToken := "header." + base64.RawURLEncoding.EncodeToString([]byte(`{"exp":1672578000}`)) + ".signature"
+
+	type want struct {
+		u          managed.ExternalUpdate
+		expireTime string
+		err        error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      *v1alpha1.ServiceAccountToken
+		want    want
+	}{
+		"AccessToken": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !strings.HasSuffix(r.URL.Path, ":generateAccessToken") {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&iamcredentials.GenerateAccessTokenResponse{AccessToken: valAccessToken, ExpireTime: valExpireTime})
+			}),
+			mg: serviceAccountToken(withTokenExternalName),
+			want: want{
+				u: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					keyToken:      []byte(valAccessToken),
+					keyTokenType:  []byte(v1alpha1.TokenTypeAccessToken),
+					keyExpireTime: []byte(valExpireTime),
+				}},
+				expireTime: valExpireTime,
+			},
+		},
+		"IDToken": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !strings.HasSuffix(r.URL.Path, ":generateIdToken") {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&iamcredentials.GenerateIdTokenResponse{Token: idToken})
+			}),
+			mg: serviceAccountToken(withTokenExternalName, func(cr *v1alpha1.ServiceAccountToken) {
+				cr.Spec.ForProvider.TokenType = gcp.StringPtr(v1alpha1.TokenTypeIDToken)
+				cr.Spec.ForProvider.Audience = gcp.StringPtr("https://example.org")
+			}),
+			want: want{
+				u: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					keyToken:      []byte(idToken),
+					keyTokenType:  []byte(v1alpha1.TokenTypeIDToken),
+					keyExpireTime: []byte(valExpireTime),
+				}},
+				expireTime: valExpireTime,
+			},
+		},
+		"GenerateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			}),
+			mg: serviceAccountToken(withTokenExternalName),
+			want: want{
+				err: errors.Wrap(gError(http.StatusForbidden, ""), errGenerateServiceAccountToken),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+
+			s, err := iamcredentials.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("iamcredentials.NewService(...): %s", err)
+			}
+			e := &serviceAccountTokenExternal{tokens: s.Projects.ServiceAccounts, now: func() time.Time { return tokenNow }}
+			got, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.u, got); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.expireTime, tc.mg.Status.AtProvider.ExpireTime); diff != "" {
+				t.Errorf("Update(...): -want expireTime, +got expireTime:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ids
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/ids/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/idsendpoint"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNewClient      = "cannot create new Cloud IDS API client"
+	errNotEndpoint    = "managed resource is not a Cloud IDS Endpoint"
+	errGetEndpoint    = "cannot get external Cloud IDS Endpoint"
+	errCreateEndpoint = "cannot create external Cloud IDS Endpoint"
+	errDeleteEndpoint = "cannot delete external Cloud IDS Endpoint"
+)
+
+// SetupEndpoint adds a controller that reconciles Cloud IDS Endpoints.
+func SetupEndpoint(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.EndpointGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.EndpointGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &endpointConnector{kube: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.Endpoint{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type endpointConnector struct {
+	kube client.Client
+}
+
+func (c *endpointConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := ids.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &endpointExternal{endpoints: ids.NewProjectsLocationsEndpointsService(s), projectID: projectID}, nil
+}
+
+type endpointExternal struct {
+	endpoints idsendpoint.Client
+	projectID string
+}
+
+func (e *endpointExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEndpoint)
+	}
+
+	observed, err := e.endpoints.Get(idsendpoint.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetEndpoint)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	idsendpoint.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = idsendpoint.GenerateObservation(*observed)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.EndpointStateReady:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.EndpointStateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.EndpointStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	// Cloud IDS Endpoints cannot be updated; every field of the spec is
+	// immutable.
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *endpointExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEndpoint)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	op, err := e.endpoints.Create(idsendpoint.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), idsendpoint.GenerateEndpoint(cr.Spec.ForProvider)).
+		EndpointId(meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateEndpoint)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *endpointExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// Cloud IDS does not support updating an endpoint in place.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *endpointExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return errors.New(errNotEndpoint)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	op, err := e.endpoints.Delete(idsendpoint.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteEndpoint)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return nil
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ids
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/ids/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/ids/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	project      = "someProject"
+	location     = "us-central1-a"
+	metadataName = "test-endpoint"
+	network      = "projects/someProject/global/networks/test-network"
+	forwardRule  = "https://www.googleapis.com/compute/v1/projects/tenant/regions/us-central1/forwardingRules/ids-fr"
+)
+
+var (
+	_ managed.ExternalConnecter = &endpointConnector{}
+	_ managed.ExternalClient    = &endpointExternal{}
+
+	err500 = &googleapi.Error{Code: 500, Body: "{}\n"}
+	fqName = fmt.Sprintf("projects/%s/locations/%s/endpoints/%s", project, location, metadataName)
+)
+
+type strange struct {
+	resource.Managed
+}
+
+type endpointModifier func(*v1alpha1.Endpoint)
+
+func withCondition(c xpv1.Condition) endpointModifier {
+	return func(ep *v1alpha1.Endpoint) { ep.SetConditions(c) }
+}
+
+func withObservation(state string) endpointModifier {
+	return func(ep *v1alpha1.Endpoint) {
+		ep.Status.AtProvider = v1alpha1.EndpointObservation{
+			Name:                   fqName,
+			EndpointForwardingRule: forwardRule,
+			State:                  state,
+		}
+	}
+}
+
+func withTrafficLogs(b bool) endpointModifier {
+	return func(ep *v1alpha1.Endpoint) { ep.Spec.ForProvider.TrafficLogs = gcp.BoolPtr(b) }
+}
+
+func endpoint(m ...endpointModifier) *v1alpha1.Endpoint {
+	ep := &v1alpha1.Endpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        metadataName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: metadataName},
+		},
+		Spec: v1alpha1.EndpointSpec{
+			ForProvider: v1alpha1.EndpointParameters{
+				Location: location,
+				Network:  gcp.StringPtr(network),
+				Severity: "INFORMATIONAL",
+			},
+		},
+	}
+	for _, f := range m {
+		f(ep)
+	}
+	return ep
+}
+
+func endpointResponse(state string, trafficLogs bool) *ids.Endpoint {
+	return &ids.Endpoint{
+		Name:                   fqName,
+		Network:                network,
+		Severity:               "INFORMATIONAL",
+		EndpointForwardingRule: forwardRule,
+		State:                  state,
+		TrafficLogs:            trafficLogs,
+	}
+}
+
+func TestEndpointObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Ready": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+fqName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(endpointResponse(v1alpha1.EndpointStateReady, false))
+			}),
+			mg: endpoint(),
+			want: want{
+				mg:  endpoint(withObservation(v1alpha1.EndpointStateReady), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Creating": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(endpointResponse(v1alpha1.EndpointStateCreating, false))
+			}),
+			mg: endpoint(),
+			want: want{
+				mg:  endpoint(withObservation(v1alpha1.EndpointStateCreating), withCondition(xpv1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(endpointResponse(v1alpha1.EndpointStateReady, true))
+			}),
+			mg: endpoint(),
+			want: want{
+				mg:  endpoint(withTrafficLogs(true), withObservation(v1alpha1.EndpointStateReady), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: endpoint(),
+			want: want{
+				mg: endpoint(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: endpoint(),
+			want: want{
+				mg:  endpoint(),
+				err: errors.Wrap(err500, errGetEndpoint),
+			},
+		},
+		"NotEndpoint": {
+			mg: &strange{},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotEndpoint),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := ids.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &endpointExternal{endpoints: ids.NewProjectsLocationsEndpointsService(s), projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEndpointCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(fmt.Sprintf("/v1/projects/%s/locations/%s/endpoints", project, location), r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				if diff := cmp.Diff(metadataName, r.URL.Query().Get("endpointId")); diff != "" {
+					t.Errorf("r: -want endpointId, +got endpointId:\n%s", diff)
+				}
+				got := &ids.Endpoint{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(&ids.Endpoint{Network: network, Severity: "INFORMATIONAL"}, got); diff != "" {
+					t.Errorf("r: -want body, +got body:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&ids.Operation{Name: "op"})
+			}),
+			mg: endpoint(),
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   endpoint(),
+			want: errors.Wrap(err500, errCreateEndpoint),
+		},
+		"NotEndpoint": {
+			mg:   &strange{},
+			want: errors.New(errNotEndpoint),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := ids.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &endpointExternal{endpoints: ids.NewProjectsLocationsEndpointsService(s), projectID: project}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEndpointDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+fqName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&ids.Operation{Name: "op"})
+			}),
+			mg: endpoint(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: endpoint(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   endpoint(),
+			want: errors.Wrap(err500, errDeleteEndpoint),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := ids.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &endpointExternal{endpoints: ids.NewProjectsLocationsEndpointsService(s), projectID: project}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -38,7 +38,12 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cryptokey"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -58,7 +63,7 @@ func SetupCryptoKey(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.CryptoKeyGroupVersionKind),
-		managed.WithExternalConnecter(&cryptoKeyConnecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &cryptoKeyConnecter{client: mgr.GetClient()}))))),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -66,7 +71,7 @@ func SetupCryptoKey(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.CryptoKey{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -144,7 +149,8 @@ func (e *cryptoKeyExternal) Create(ctx context.Context, mg resource.Managed) (ma
 	cryptokey.GenerateCryptoKeyInstance(cr.Spec.ForProvider, instance)
 
 	if _, err := e.cryptokeys.Create(gcp.StringValue(cr.Spec.ForProvider.KeyRing), instance).
-		CryptoKeyId(meta.GetExternalName(cr)).Context(ctx).Do(); err != nil {
+		CryptoKeyId(meta.GetExternalName(cr)).
+		SkipInitialVersionCreation(cryptokey.IsExternal(cr.Spec.ForProvider)).Context(ctx).Do(); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
 
@@ -49,6 +49,12 @@ func ckWithRotationPeriod(s string) ckValueModifier {
 	return func(i *v1alpha1.CryptoKey) { i.Spec.ForProvider.RotationPeriod = &s }
 }
 
+func ckWithProtectionLevel(s string) ckValueModifier {
+	return func(i *v1alpha1.CryptoKey) {
+		i.Spec.ForProvider.VersionTemplate = &v1alpha1.CryptoKeyVersionTemplate{ProtectionLevel: &s}
+	}
+}
+
 func ckWithAtProviderName(s string) ckValueModifier {
 	return func(i *v1alpha1.CryptoKey) { i.Status.AtProvider.Name = s }
 }
@@ -339,6 +345,31 @@ func TestCryptoKeyCreate(t *testing.T) {
 					ckWithCondition(xpv1.Creating())),
 			},
 		},
+		"CreatedExternalCryptoKey": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("true", r.URL.Query().Get("skipInitialVersionCreation")); diff != "" {
+					t.Errorf("skipInitialVersionCreation: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(&kmsv1.CryptoKey{Name: keyRingRRN}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: cryptoKey(
+					ckWithName(ckMetadataName),
+					ckWithExternalNameAnnotation(ckMetadataName),
+					ckWithProtectionLevel("EXTERNAL_VPC")),
+			},
+			want: want{
+				mg: cryptoKey(
+					ckWithName(ckMetadataName),
+					ckWithExternalNameAnnotation(ckMetadataName),
+					ckWithProtectionLevel("EXTERNAL_VPC"),
+					ckWithCondition(xpv1.Creating())),
+			},
+		},
 		"NotCryptoKey": {
 			args: args{
 				ctx: context.Background(),
@@ -36,7 +36,13 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/cryptokeypolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicaccess"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -57,7 +63,7 @@ func SetupCryptoKeyPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.CryptoKeyPolicyGroupVersionKind),
-		managed.WithExternalConnecter(&cryptoKeyPolicyConnecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &cryptoKeyPolicyConnecter{client: mgr.GetClient()}))))),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -65,7 +71,7 @@ func SetupCryptoKeyPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.CryptoKeyPolicy{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -126,6 +132,9 @@ func (e *cryptoKeyPolicyExternal) Create(ctx context.Context, mg resource.Manage
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotCryptoKeyPolicy)
 	}
+	if err := publicaccess.Check(cr, publicaccess.Members(cr.Spec.ForProvider.Policy)...); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	cr.SetConditions(xpv1.Creating())
 	instance := &kmsv1.Policy{}
 	cryptokeypolicy.GenerateCryptoKeyPolicyInstance(cr.Spec.ForProvider, instance)
@@ -145,6 +154,9 @@ func (e *cryptoKeyPolicyExternal) Update(ctx context.Context, mg resource.Manage
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotCryptoKeyPolicy)
 	}
+	if err := publicaccess.Check(cr, publicaccess.Members(cr.Spec.ForProvider.Policy)...); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
 	instance, err := e.cryptokeyspolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.CryptoKey)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPolicy)
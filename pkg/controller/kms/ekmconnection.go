@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	kmsv1 "google.golang.org/api/cloudkms/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/ekmconnection"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+const (
+	errNotEkmConnection = "managed resource is not a GCP EkmConnection"
+)
+
+// SetupEkmConnection adds a controller that reconciles EkmConnections.
+func SetupEkmConnection(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.EkmConnectionGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.EkmConnectionGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &ekmConnectionConnecter{client: mgr.GetClient()}))))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.EkmConnection{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type ekmConnectionConnecter struct {
+	client client.Client
+}
+
+// Connect sets up kms client using credentials from the provider
+func (c *ekmConnectionConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := kmsv1.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &ekmConnectionExternal{kube: c.client, ekmconnections: kmsv1.NewProjectsLocationsEkmConnectionsService(s), projectID: projectID}, nil
+}
+
+type ekmConnectionExternal struct {
+	kube           client.Client
+	ekmconnections ekmconnection.Client
+	projectID      string
+}
+
+func (e *ekmConnectionExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.EkmConnection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEkmConnection)
+	}
+
+	// It is not possible to delete KMS EkmConnections, there is no "delete"
+	// method defined:
+	// https://cloud.google.com/kms/docs/reference/rest#rest-resource:-v1.projects.locations.ekmconnections
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	instance, err := e.ekmconnections.Get(e.resourceName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGet)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	ekmconnection.LateInitializeSpec(&cr.Spec.ForProvider, *instance)
+
+	cr.Status.AtProvider = ekmconnection.GenerateObservation(*instance)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ResourceUpToDate:        ekmconnection.IsUpToDate(&cr.Spec.ForProvider, instance),
+	}, nil
+}
+
+func (e *ekmConnectionExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.EkmConnection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEkmConnection)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	if _, err := e.ekmconnections.Create(e.locationName(cr), ekmconnection.GenerateEkmConnection(cr.Spec.ForProvider)).
+		EkmConnectionId(meta.GetExternalName(cr)).Context(ctx).Do(); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *ekmConnectionExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.EkmConnection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEkmConnection)
+	}
+
+	if _, err := e.ekmconnections.Patch(e.resourceName(cr), ekmconnection.GenerateEkmConnection(cr.Spec.ForProvider)).
+		UpdateMask(ekmconnection.UpdateMask).Context(ctx).Do(); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *ekmConnectionExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	// It is not possible to delete KMS EkmConnections, there is no "delete"
+	// method defined:
+	// https://cloud.google.com/kms/docs/reference/rest#rest-resource:-v1.projects.locations.ekmconnections
+	return nil
+}
+
+func (e *ekmConnectionExternal) locationName(cr *v1alpha1.EkmConnection) string {
+	return fmt.Sprintf("projects/%s/locations/%s", e.projectID, cr.Spec.ForProvider.Location)
+}
+
+func (e *ekmConnectionExternal) resourceName(cr *v1alpha1.EkmConnection) string {
+	return fmt.Sprintf("%s/ekmConnections/%s", e.locationName(cr), meta.GetExternalName(cr))
+}
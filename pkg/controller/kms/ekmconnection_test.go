@@ -0,0 +1,292 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kmsv1 "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/kms/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/ekmconnection"
+)
+
+const (
+	ecMetadataName = "test-ekm"
+	ecHostname     = "ekm.example.com"
+)
+
+var ecName = fmt.Sprintf("projects/%s/locations/%s/ekmConnections/%s", project, location, ecMetadataName)
+
+type ecModifier func(*v1alpha1.EkmConnection)
+
+func ecWithHostname(h string) ecModifier {
+	return func(ec *v1alpha1.EkmConnection) { ec.Spec.ForProvider.ServiceResolvers[0].Hostname = h }
+}
+
+func ecWithAtProviderName(n string) ecModifier {
+	return func(ec *v1alpha1.EkmConnection) { ec.Status.AtProvider.Name = n }
+}
+
+func ecWithCondition(c xpv1.Condition) ecModifier {
+	return func(ec *v1alpha1.EkmConnection) { ec.SetConditions(c) }
+}
+
+func ecWithDeletionTimestamp(ts metav1.Time) ecModifier {
+	return func(ec *v1alpha1.EkmConnection) { ec.SetDeletionTimestamp(&ts) }
+}
+
+func ekmConnection(m ...ecModifier) *v1alpha1.EkmConnection {
+	ec := &v1alpha1.EkmConnection{
+		ObjectMeta: metav1.ObjectMeta{Name: ecMetadataName},
+		Spec: v1alpha1.EkmConnectionSpec{
+			ForProvider: v1alpha1.EkmConnectionParameters{
+				Location: location,
+				ServiceResolvers: []v1alpha1.ServiceResolver{{
+					ServiceDirectoryService: "projects/someProject/locations/test-location/namespaces/ekm/services/ekm",
+					Hostname:                ecHostname,
+					ServerCertificates:      []v1alpha1.Certificate{{RawDer: "MIIB"}},
+				}},
+			},
+		},
+	}
+	meta.SetExternalName(ec, ecMetadataName)
+	for _, f := range m {
+		f(ec)
+	}
+	return ec
+}
+
+func ekmConnectionResponse(hostname string) *kmsv1.EkmConnection {
+	return &kmsv1.EkmConnection{
+		Name: ecName,
+		ServiceResolvers: []*kmsv1.ServiceResolver{{
+			ServiceDirectoryService: "projects/someProject/locations/test-location/namespaces/ekm/services/ekm",
+			Hostname:                hostname,
+			ServerCertificates:      []*kmsv1.Certificate{{RawDer: "MIIB", Parsed: true}},
+		}},
+	}
+}
+
+func TestEkmConnectionObserve(t *testing.T) {
+	now := metav1.Now()
+
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+ecName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(ekmConnectionResponse(ecHostname))
+			}),
+			mg: ekmConnection(),
+			want: want{
+				mg:  ekmConnection(ecWithAtProviderName(ecName), ecWithCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(ekmConnectionResponse("old.example.com"))
+			}),
+			mg: ekmConnection(),
+			want: want{
+				mg:  ekmConnection(ecWithAtProviderName(ecName), ecWithCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: ekmConnection(),
+			want: want{
+				mg: ekmConnection(),
+			},
+		},
+		"Deleted": {
+			mg: ekmConnection(ecWithDeletionTimestamp(now)),
+			want: want{
+				mg: ekmConnection(ecWithDeletionTimestamp(now)),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: ekmConnection(),
+			want: want{
+				mg:  ekmConnection(),
+				err: errors.Wrap(err500, errGet),
+			},
+		},
+		"NotEkmConnection": {
+			mg: &strange{},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotEkmConnection),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := kmsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &ekmConnectionExternal{ekmconnections: kmsv1.NewProjectsLocationsEkmConnectionsService(s), projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEkmConnectionCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(ecMetadataName, r.URL.Query().Get("ekmConnectionId")); diff != "" {
+					t.Errorf("ekmConnectionId: -want, +got:\n%s", diff)
+				}
+				got := &kmsv1.EkmConnection{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(ecHostname, got.ServiceResolvers[0].Hostname); diff != "" {
+					t.Errorf("hostname: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(ekmConnectionResponse(ecHostname))
+			}),
+			mg: ekmConnection(),
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   ekmConnection(),
+			want: errors.Wrap(err500, errCreate),
+		},
+		"NotEkmConnection": {
+			mg:   &strange{},
+			want: errors.New(errNotEkmConnection),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := kmsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &ekmConnectionExternal{ekmconnections: kmsv1.NewProjectsLocationsEkmConnectionsService(s), projectID: project}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEkmConnectionUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Patched": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(ekmconnection.UpdateMask, r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("updateMask: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(ekmConnectionResponse("new.example.com"))
+			}),
+			mg: ekmConnection(ecWithHostname("new.example.com")),
+		},
+		"PatchFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   ekmConnection(),
+			want: errors.Wrap(err500, errUpdate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := kmsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &ekmConnectionExternal{ekmconnections: kmsv1.NewProjectsLocationsEkmConnectionsService(s), projectID: project}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkservices
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/gateway"
+)
+
+const gatewayName = "test-gateway"
+
+var (
+	_ managed.ExternalConnecter = &gatewayConnector{}
+	_ managed.ExternalClient    = &gatewayExternal{}
+
+	gatewayFQName = fmt.Sprintf("projects/%s/locations/global/gateways/%s", project, gatewayName)
+)
+
+func gatewayObj(ports ...int64) *v1alpha1.Gateway {
+	return &v1alpha1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        gatewayName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: gatewayName},
+		},
+		Spec: v1alpha1.GatewaySpec{
+			ForProvider: v1alpha1.GatewayParameters{
+				Location: "global",
+				Type:     v1alpha1.GatewayTypeOpenMesh,
+				Scope:    gcp.StringPtr("ingress"),
+				Ports:    ports,
+			},
+		},
+	}
+}
+
+func TestGatewayObserve(t *testing.T) {
+	cases := map[string]struct {
+		ports []int64
+		want  managed.ExternalObservation
+	}{
+		"UpToDate": {
+			ports: []int64{80},
+			want:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"PortAdded": {
+			ports: []int64{80, 443},
+			want:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := newService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+gatewayFQName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&networkservices.Gateway{
+					Name:  gatewayFQName,
+					Type:  v1alpha1.GatewayTypeOpenMesh,
+					Scope: "ingress",
+					Ports: []int64{80},
+				})
+			}))
+			e := &gatewayExternal{gateways: networkservices.NewProjectsLocationsGatewaysService(s), projectID: project}
+			cr := gatewayObj(tc.ports...)
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(gatewayFQName, cr.Status.AtProvider.Name); diff != "" {
+				t.Errorf("Observe(...): -want name, +got name:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Available(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGatewayUpdate(t *testing.T) {
+	s := newService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff(gateway.UpdateMask, r.URL.Query().Get("updateMask")); diff != "" {
+			t.Errorf("r: -want updateMask, +got updateMask:\n%s", diff)
+		}
+		got := &networkservices.Gateway{}
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Error(err)
+		}
+		if diff := cmp.Diff([]int64{80, 443}, got.Ports); diff != "" {
+			t.Errorf("r: -want ports, +got ports:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{Name: "op"})
+	}))
+	e := &gatewayExternal{gateways: networkservices.NewProjectsLocationsGatewaysService(s), projectID: project}
+	if _, err := e.Update(context.Background(), gatewayObj(80, 443)); err != nil {
+		t.Errorf("Update(...): unexpected error: %s", err)
+	}
+}
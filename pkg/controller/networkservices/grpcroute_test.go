@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkservices
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/grpcroute"
+)
+
+const grpcRouteName = "test-grpc-route"
+
+var (
+	_ managed.ExternalConnecter = &grpcRouteConnector{}
+	_ managed.ExternalClient    = &grpcRouteExternal{}
+
+	grpcRouteFQName = fmt.Sprintf("projects/%s/locations/global/grpcRoutes/%s", project, grpcRouteName)
+)
+
+func grpcRouteObj(method string) *v1alpha1.GRPCRoute {
+	return &v1alpha1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        grpcRouteName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: grpcRouteName},
+		},
+		Spec: v1alpha1.GRPCRouteSpec{
+			ForProvider: v1alpha1.GRPCRouteParameters{
+				Hostnames: []string{"helloworld.example.com"},
+				Meshes:    []string{meshFQName},
+				Rules: []v1alpha1.GRPCRouteRule{{
+					Matches: []v1alpha1.GRPCRouteMatch{{Method: &v1alpha1.GRPCRouteMethodMatch{GRPCService: "helloworld.Greeter", GRPCMethod: method}}},
+					Action:  v1alpha1.GRPCRouteAction{Destinations: []v1alpha1.RouteDestination{{ServiceName: backendService}}},
+				}},
+			},
+		},
+	}
+}
+
+func grpcRouteResponse() *networkservices.GrpcRoute {
+	return &networkservices.GrpcRoute{
+		Name:      grpcRouteFQName,
+		Hostnames: []string{"helloworld.example.com"},
+		Meshes:    []string{meshFQName},
+		Rules: []*networkservices.GrpcRouteRouteRule{{
+			Matches: []*networkservices.GrpcRouteRouteMatch{{Method: &networkservices.GrpcRouteMethodMatch{GrpcService: "helloworld.Greeter", GrpcMethod: "SayHello"}}},
+			Action: &networkservices.GrpcRouteRouteAction{
+				Destinations: []*networkservices.GrpcRouteDestination{{ServiceName: backendService}},
+			},
+		}},
+	}
+}
+
+func TestGRPCRouteObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+grpcRouteFQName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(grpcRouteResponse())
+			}),
+			mg:   grpcRouteObj("SayHello"),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"MethodChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(grpcRouteResponse())
+			}),
+			mg:   grpcRouteObj("SayHelloAgain"),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: grpcRouteObj("SayHello"),
+		},
+		"NotGRPCRoute": {
+			mg:   &strange{},
+			want: want{err: errors.New(errNotGRPCRoute)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := newService(t, tc.handler)
+			e := &grpcRouteExternal{routes: networkservices.NewProjectsLocationsGrpcRoutesService(s), projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGRPCRouteUpdate(t *testing.T) {
+	s := newService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff(grpcroute.UpdateMask, r.URL.Query().Get("updateMask")); diff != "" {
+			t.Errorf("r: -want updateMask, +got updateMask:\n%s", diff)
+		}
+		got := &networkservices.GrpcRoute{}
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Error(err)
+		}
+		if diff := cmp.Diff("SayHelloAgain", got.Rules[0].Matches[0].Method.GrpcMethod); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{Name: "op"})
+	}))
+	e := &grpcRouteExternal{routes: networkservices.NewProjectsLocationsGrpcRoutesService(s), projectID: project}
+	if _, err := e.Update(context.Background(), grpcRouteObj("SayHelloAgain")); err != nil {
+		t.Errorf("Update(...): unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkservices
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkservices "google.golang.org/api/networkservices/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	httpRouteName  = "test-http-route"
+	backendService = "projects/someProject/global/backendServices/test-service"
+)
+
+var (
+	_ managed.ExternalConnecter = &httpRouteConnector{}
+	_ managed.ExternalClient    = &httpRouteExternal{}
+
+	httpRouteFQName = fmt.Sprintf("projects/%s/locations/global/httpRoutes/%s", project, httpRouteName)
+)
+
+func httpRouteObj(prefix string) *v1alpha1.HTTPRoute {
+	return &v1alpha1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        httpRouteName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: httpRouteName},
+		},
+		Spec: v1alpha1.HTTPRouteSpec{
+			ForProvider: v1alpha1.HTTPRouteParameters{
+				Hostnames: []string{"example.com"},
+				Meshes:    []string{meshFQName},
+				Rules: []v1alpha1.HTTPRouteRule{{
+					Matches: []v1alpha1.HTTPRouteMatch{{PrefixMatch: gcp.StringPtr(prefix)}},
+					Action:  v1alpha1.HTTPRouteAction{Destinations: []v1alpha1.RouteDestination{{ServiceName: backendService}}},
+				}},
+			},
+		},
+	}
+}
+
+// httpRouteResponse returns the route that httpRouteObj("/") describes, as
+// returned by the Network Services API.
+func httpRouteResponse() *networkservices.HttpRoute {
+	return &networkservices.HttpRoute{
+		Name:      httpRouteFQName,
+		Hostnames: []string{"example.com"},
+		Meshes:    []string{meshFQName},
+		Rules: []*networkservices.HttpRouteRouteRule{{
+			Matches: []*networkservices.HttpRouteRouteMatch{{PrefixMatch: "/"}},
+			Action: &networkservices.HttpRouteRouteAction{
+				Destinations: []*networkservices.HttpRouteDestination{{ServiceName: "https://www.googleapis.com/compute/v1/" + backendService}},
+			},
+		}},
+	}
+}
+
+func TestHTTPRouteObserve(t *testing.T) {
+	cases := map[string]struct {
+		prefix string
+		want   managed.ExternalObservation
+	}{
+		"UpToDate": {
+			prefix: "/",
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"RuleChanged": {
+			prefix: "/api",
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := newService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+httpRouteFQName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(httpRouteResponse())
+			}))
+			e := &httpRouteExternal{routes: networkservices.NewProjectsLocationsHttpRoutesService(s), projectID: project}
+			cr := httpRouteObj(tc.prefix)
+			obs, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(httpRouteFQName, cr.Status.AtProvider.Name); diff != "" {
+				t.Errorf("Observe(...): -want name, +got name:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPRouteCreate(t *testing.T) {
+	s := newService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		if diff := cmp.Diff(fmt.Sprintf("/v1/projects/%s/locations/global/httpRoutes", project), r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		if diff := cmp.Diff(httpRouteName, r.URL.Query().Get("httpRouteId")); diff != "" {
+			t.Errorf("r: -want httpRouteId, +got httpRouteId:\n%s", diff)
+		}
+		got := &networkservices.HttpRoute{}
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Error(err)
+		}
+		want := httpRouteResponse()
+		want.Name = ""
+		want.Rules[0].Action.Destinations[0].ServiceName = backendService
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("r: -want body, +got body:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{Name: "op"})
+	}))
+	e := &httpRouteExternal{routes: networkservices.NewProjectsLocationsHttpRoutesService(s), projectID: project}
+	if _, err := e.Create(context.Background(), httpRouteObj("/")); err != nil {
+		t.Errorf("Create(...): unexpected error: %s", err)
+	}
+}
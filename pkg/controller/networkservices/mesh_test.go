@@ -0,0 +1,299 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkservices
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	networkservices "google.golang.org/api/networkservices/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/networkservices/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/mesh"
+)
+
+const (
+	project  = "someProject"
+	meshName = "test-mesh"
+)
+
+var (
+	_ managed.ExternalConnecter = &meshConnector{}
+	_ managed.ExternalClient    = &meshExternal{}
+
+	err500     = &googleapi.Error{Code: 500, Body: "{}\n"}
+	meshFQName = fmt.Sprintf("projects/%s/locations/global/meshes/%s", project, meshName)
+)
+
+type strange struct {
+	resource.Managed
+}
+
+// newService returns a Network Services client that sends its requests to
+// the supplied handler.
+func newService(t *testing.T, h http.Handler) *networkservices.Service {
+	t.Helper()
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+	s, err := networkservices.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+type meshModifier func(*v1alpha1.Mesh)
+
+func withMeshCondition(c xpv1.Condition) meshModifier {
+	return func(m *v1alpha1.Mesh) { m.SetConditions(c) }
+}
+
+func withMeshObservation() meshModifier {
+	return func(m *v1alpha1.Mesh) { m.Status.AtProvider = v1alpha1.MeshObservation{Name: meshFQName} }
+}
+
+func withInterceptionPort(p int64) meshModifier {
+	return func(m *v1alpha1.Mesh) { m.Spec.ForProvider.InterceptionPort = &p }
+}
+
+func meshObj(m ...meshModifier) *v1alpha1.Mesh {
+	cr := &v1alpha1.Mesh{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        meshName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: meshName},
+		},
+		Spec: v1alpha1.MeshSpec{
+			ForProvider: v1alpha1.MeshParameters{
+				Description: gcp.StringPtr("test"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestMeshObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+meshFQName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&networkservices.Mesh{Name: meshFQName, Description: "test", InterceptionPort: 15001})
+			}),
+			mg: meshObj(withInterceptionPort(15001)),
+			want: want{
+				mg:  meshObj(withInterceptionPort(15001), withMeshObservation(), withMeshCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&networkservices.Mesh{Name: meshFQName, Description: "test", InterceptionPort: 15001})
+			}),
+			mg: meshObj(),
+			want: want{
+				mg:  meshObj(withInterceptionPort(15001), withMeshObservation(), withMeshCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"InterceptionPortChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&networkservices.Mesh{Name: meshFQName, Description: "test", InterceptionPort: 15001})
+			}),
+			mg: meshObj(withInterceptionPort(15002)),
+			want: want{
+				mg:  meshObj(withInterceptionPort(15002), withMeshObservation(), withMeshCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg:   meshObj(),
+			want: want{mg: meshObj()},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: meshObj(),
+			want: want{
+				mg:  meshObj(),
+				err: errors.Wrap(err500, errGetMesh),
+			},
+		},
+		"NotMesh": {
+			mg: &strange{},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotMesh),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := newService(t, tc.handler)
+			e := &meshExternal{meshes: networkservices.NewProjectsLocationsMeshesService(s), projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMeshCreate(t *testing.T) {
+	s := newService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff(fmt.Sprintf("/v1/projects/%s/locations/global/meshes", project), r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		if diff := cmp.Diff(meshName, r.URL.Query().Get("meshId")); diff != "" {
+			t.Errorf("r: -want meshId, +got meshId:\n%s", diff)
+		}
+		got := &networkservices.Mesh{}
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Error(err)
+		}
+		if diff := cmp.Diff(&networkservices.Mesh{Description: "test"}, got); diff != "" {
+			t.Errorf("r: -want body, +got body:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{Name: "op"})
+	}))
+	e := &meshExternal{meshes: networkservices.NewProjectsLocationsMeshesService(s), projectID: project}
+	if _, err := e.Create(context.Background(), meshObj()); err != nil {
+		t.Errorf("Create(...): unexpected error: %s", err)
+	}
+}
+
+func TestMeshUpdate(t *testing.T) {
+	s := newService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if diff := cmp.Diff("/v1/"+meshFQName, r.URL.Path); diff != "" {
+			t.Errorf("r: -want path, +got path:\n%s", diff)
+		}
+		if diff := cmp.Diff(mesh.UpdateMask, r.URL.Query().Get("updateMask")); diff != "" {
+			t.Errorf("r: -want updateMask, +got updateMask:\n%s", diff)
+		}
+		got := &networkservices.Mesh{}
+		if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+			t.Error(err)
+		}
+		if diff := cmp.Diff(&networkservices.Mesh{Description: "test", InterceptionPort: 15002}, got); diff != "" {
+			t.Errorf("r: -want body, +got body:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&networkservices.Operation{Name: "op"})
+	}))
+	e := &meshExternal{meshes: networkservices.NewProjectsLocationsMeshesService(s), projectID: project}
+	if _, err := e.Update(context.Background(), meshObj(withInterceptionPort(15002))); err != nil {
+		t.Errorf("Update(...): unexpected error: %s", err)
+	}
+}
+
+func TestMeshDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&networkservices.Operation{Name: "op"})
+			}),
+			mg: meshObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: meshObj(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   meshObj(),
+			want: errors.Wrap(err500, errDeleteMesh),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := newService(t, tc.handler)
+			e := &meshExternal{meshes: networkservices.NewProjectsLocationsMeshesService(s), projectID: project}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
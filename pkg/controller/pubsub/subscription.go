@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudresourcemanager/v1"
 	pubsub "google.golang.org/api/pubsub/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,7 +38,13 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/subscription"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -48,6 +55,9 @@ const (
 	errKubeUpdateSubscription = "cannot update Subscription custom resource"
 	errCreateSubscription     = "cannot create Subscription"
 	errDeleteSubscription     = "cannot delete Subscription"
+	errGetProject             = "cannot get project of Subscription"
+	errGetIAMPolicy           = "cannot get IAM policy for dead lettering"
+	errSetIAMPolicy           = "cannot set IAM policy for dead lettering"
 )
 
 // SetupSubscription adds a controller that reconciles Subscriptions.
@@ -61,7 +71,8 @@ func SetupSubscription(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.SubscriptionGroupVersionKind),
-		managed.WithExternalConnecter(&subscriptionConnector{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &subscriptionConnector{client: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -69,7 +80,7 @@ func SetupSubscription(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.Subscription{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -90,13 +101,19 @@ func (c *subscriptionConnector) Connect(ctx context.Context, mg resource.Managed
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &subscriptionExternal{projectID: projectID, client: c.client, ps: s}, nil
+	rm, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &subscriptionExternal{projectID: projectID, client: c.client, ps: s, projects: rm.Projects}, nil
 }
 
 type subscriptionExternal struct {
 	projectID string
 	client    client.Client
 	ps        *pubsub.Service
+	projects  *cloudresourcemanager.ProjectsService
 }
 
 // Observe makes observation about the external resource.
@@ -122,9 +139,16 @@ func (e *subscriptionExternal) Observe(ctx context.Context, mg resource.Managed)
 
 	cr.SetConditions(xpv1.Available())
 
+	upToDate := subscription.IsUpToDate(e.projectID, cr.Spec.ForProvider, *s)
+	if upToDate && subscription.GrantsServiceAgentPermissions(cr.Spec.ForProvider) {
+		if upToDate, err = e.serviceAgentPermissions(ctx, cr, false); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: subscription.IsUpToDate(e.projectID, cr.Spec.ForProvider, *s),
+		ResourceUpToDate: upToDate,
 	}, nil
 }
 
@@ -155,10 +179,18 @@ func (e *subscriptionExternal) Update(ctx context.Context, mg resource.Managed)
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetSubscription)
 	}
 
-	_, err = e.ps.Projects.Subscriptions.Patch(subscription.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)),
-		subscription.GenerateUpdateRequest(meta.GetExternalName(cr), cr.Spec.ForProvider, *s)).Context(ctx).Do()
+	if !subscription.IsUpToDate(e.projectID, cr.Spec.ForProvider, *s) {
+		_, err = e.ps.Projects.Subscriptions.Patch(subscription.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)),
+			subscription.GenerateUpdateRequest(meta.GetExternalName(cr), cr.Spec.ForProvider, *s)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubscription)
+		}
+	}
 
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubscription)
+	if subscription.GrantsServiceAgentPermissions(cr.Spec.ForProvider) {
+		_, err = e.serviceAgentPermissions(ctx, cr, true)
+	}
+	return managed.ExternalUpdate{}, err
 }
 
 // Delete initiates an deletion of the external resource.
@@ -173,3 +205,53 @@ func (e *subscriptionExternal) Delete(ctx context.Context, mg resource.Managed)
 
 	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSubscription)
 }
+
+// serviceAgentPermissions checks whether the Pub/Sub service agent has the
+// publisher role on the dead letter topic and the subscriber role on the
+// subscription, and grants the missing ones if grant is true. It returns true
+// if both roles were already bound.
+func (e *subscriptionExternal) serviceAgentPermissions(ctx context.Context, cr *v1alpha1.Subscription, grant bool) (bool, error) {
+	p, err := e.projects.Get(e.projectID).Context(ctx).Do()
+	if err != nil {
+		return false, errors.Wrap(err, errGetProject)
+	}
+	member := subscription.ServiceAgentMember(p.ProjectNumber)
+
+	topics := e.ps.Projects.Topics
+	dlt := topic.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider.DeadLetterPolicy.DeadLetterTopic)
+	bound, err := bindServiceAgent(subscription.RolePublisher, member, grant,
+		func() (*pubsub.Policy, error) { return topics.GetIamPolicy(dlt).Context(ctx).Do() },
+		func(p *pubsub.Policy) error {
+			_, err := topics.SetIamPolicy(dlt, &pubsub.SetIamPolicyRequest{Policy: p}).Context(ctx).Do()
+			return err
+		})
+	if err != nil || (!bound && !grant) {
+		return false, err
+	}
+
+	subs := e.ps.Projects.Subscriptions
+	sub := subscription.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))
+	subBound, err := bindServiceAgent(subscription.RoleSubscriber, member, grant,
+		func() (*pubsub.Policy, error) { return subs.GetIamPolicy(sub).Context(ctx).Do() },
+		func(p *pubsub.Policy) error {
+			_, err := subs.SetIamPolicy(sub, &pubsub.SetIamPolicyRequest{Policy: p}).Context(ctx).Do()
+			return err
+		})
+	return bound && subBound, err
+}
+
+// bindServiceAgent reports whether role is bound to member in the policy
+// returned by get, and binds it using set if it is not and grant is true.
+func bindServiceAgent(role, member string, grant bool, get func() (*pubsub.Policy, error), set func(*pubsub.Policy) error) (bool, error) {
+	p, err := get()
+	if err != nil {
+		return false, errors.Wrap(err, errGetIAMPolicy)
+	}
+	if !subscription.BindRoleToMember(role, member, p) {
+		return true, nil
+	}
+	if !grant {
+		return false, nil
+	}
+	return false, errors.Wrap(set(p), errSetIAMPolicy)
+}
@@ -21,20 +21,27 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"google.golang.org/api/cloudresourcemanager/v1"
 	"google.golang.org/api/option"
 	pubsub "google.golang.org/api/pubsub/v1"
 
 	"github.com/google/go-cmp/cmp"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/subscription"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
 )
 
 type SubscriptionOption func(subscription *v1alpha1.Subscription)
@@ -49,6 +56,52 @@ func newSubscription(opts ...SubscriptionOption) *v1alpha1.Subscription {
 	return t
 }
 
+func withDeadLetterServiceAgentGrant() SubscriptionOption {
+	return func(s *v1alpha1.Subscription) {
+		meta.SetExternalName(s, "my-subscription")
+		s.Spec.ForProvider.DeadLetterPolicy = &v1alpha1.DeadLetterPolicy{
+			DeadLetterTopic:              "my-dlq",
+			DeadLetterTopicRef:           &xpv1.Reference{Name: "my-dlq"},
+			GrantServiceAgentPermissions: gcp.BoolPtr(true),
+		}
+	}
+}
+
+// deadLetterHandler serves a subscription with a dead letter policy, the
+// project it belongs to, and the IAM policies of the subscription and its
+// dead letter topic. setIamPolicy requests are sent to set.
+func deadLetterHandler(t *testing.T, topicPolicy, subscriptionPolicy *pubsub.Policy, set func(path string, p *pubsub.Policy)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		var res interface{}
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/topics/my-dlq:getIamPolicy"):
+			res = topicPolicy
+		case strings.HasSuffix(r.URL.Path, "/subscriptions/my-subscription:getIamPolicy"):
+			res = subscriptionPolicy
+		case strings.HasSuffix(r.URL.Path, ":setIamPolicy"):
+			req := &pubsub.SetIamPolicyRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Error(err)
+			}
+			set(r.URL.Path, req.Policy)
+			res = req.Policy
+		case strings.HasSuffix(r.URL.Path, "/projects/"+projectID):
+			res = &cloudresourcemanager.Project{ProjectNumber: 1234}
+		case strings.HasSuffix(r.URL.Path, "/subscriptions/my-subscription") && r.Method == http.MethodGet:
+			res = &pubsub.Subscription{DeadLetterPolicy: &pubsub.DeadLetterPolicy{
+				DeadLetterTopic: topic.GetFullyQualifiedName(projectID, "my-dlq"),
+			}}
+		default:
+			t.Errorf("r: unexpected %s %q", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
 func TestSubscriptionObserve(t *testing.T) {
 	type args struct {
 		handler http.Handler
@@ -141,6 +194,38 @@ func TestSubscriptionObserve(t *testing.T) {
 				},
 			},
 		},
+		"ServiceAgentPermissionsMissing": {
+			reason: "Should not be up to date if the service agent cannot publish to the dead letter topic",
+			args: args{
+				handler: deadLetterHandler(t, &pubsub.Policy{}, &pubsub.Policy{}, func(path string, _ *pubsub.Policy) {
+					t.Errorf("r: unexpected setIamPolicy %q", path)
+				}),
+				mg: newSubscription(withDeadLetterServiceAgentGrant()),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ServiceAgentPermissionsGranted": {
+			reason: "Should be up to date if the service agent has both roles",
+			args: args{
+				handler: deadLetterHandler(t,
+					&pubsub.Policy{Bindings: []*pubsub.Binding{{Role: subscription.RolePublisher, Members: []string{subscription.ServiceAgentMember(1234)}}}},
+					&pubsub.Policy{Bindings: []*pubsub.Binding{{Role: subscription.RoleSubscriber, Members: []string{subscription.ServiceAgentMember(1234)}}}},
+					func(path string, _ *pubsub.Policy) {
+						t.Errorf("r: unexpected setIamPolicy %q", path)
+					}),
+				mg: newSubscription(withDeadLetterServiceAgentGrant()),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -148,10 +233,12 @@ func TestSubscriptionObserve(t *testing.T) {
 			server := httptest.NewServer(tc.args.handler)
 			defer server.Close()
 			s, _ := pubsub.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			rm, _ := cloudresourcemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			e := subscriptionExternal{
 				client:    tc.args.kube,
 				projectID: projectID,
 				ps:        s,
+				projects:  rm.Projects,
 			}
 			got, err := e.Observe(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
@@ -305,6 +392,24 @@ func TestSubscriptionUpdate(t *testing.T) {
 				mg: newSubscription(),
 			},
 		},
+		"GrantServiceAgentPermissions": {
+			reason: "Should bind the subscriber role the service agent is missing",
+			args: args{
+				handler: deadLetterHandler(t,
+					&pubsub.Policy{Bindings: []*pubsub.Binding{{Role: subscription.RolePublisher, Members: []string{subscription.ServiceAgentMember(1234)}}}},
+					&pubsub.Policy{Etag: "etag"},
+					func(path string, p *pubsub.Policy) {
+						if !strings.HasSuffix(path, "/subscriptions/my-subscription:setIamPolicy") {
+							t.Errorf("r: unexpected setIamPolicy %q", path)
+						}
+						want := &pubsub.Policy{Etag: "etag", Bindings: []*pubsub.Binding{{Role: subscription.RoleSubscriber, Members: []string{subscription.ServiceAgentMember(1234)}}}}
+						if diff := cmp.Diff(want, p); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+					}),
+				mg: newSubscription(withDeadLetterServiceAgentGrant()),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -312,10 +417,12 @@ func TestSubscriptionUpdate(t *testing.T) {
 			server := httptest.NewServer(tc.args.handler)
 			defer server.Close()
 			s, _ := pubsub.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			rm, _ := cloudresourcemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			e := subscriptionExternal{
 				client:    tc.args.kube,
 				projectID: projectID,
 				ps:        s,
+				projects:  rm.Projects,
 			}
 			got, err := e.Update(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
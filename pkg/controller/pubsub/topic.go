@@ -37,6 +37,11 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
@@ -49,6 +54,8 @@ const (
 	errKubeUpdateTopic = "cannot update Topic custom resource"
 	errCreateTopic     = "cannot create Topic"
 	errDeleteTopic     = "cannot delete Topic"
+	errGetIngestion    = "cannot get ingestion settings of Topic"
+	errUpdateIngestion = "cannot update ingestion settings of Topic"
 )
 
 // SetupTopic adds a controller that reconciles Topics.
@@ -62,7 +69,7 @@ func SetupTopic(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.TopicGroupVersionKind),
-		managed.WithExternalConnecter(&connector{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &connector{client: mgr.GetClient()}))))),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -70,7 +77,7 @@ func SetupTopic(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.Topic{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -89,13 +96,18 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &external{projectID: projectID, client: c.client, ps: s}, nil
+	is, err := topic.NewIngestionService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &external{projectID: projectID, client: c.client, ps: s, ingestion: is}, nil
 }
 
 type external struct {
 	projectID string
 	client    client.Client
 	ps        *pubsub.Service
+	ingestion topic.IngestionClient
 }
 
 // Observe makes observation about the external resource.
@@ -115,10 +127,19 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateTopic)
 		}
 	}
+	upToDate := topic.IsUpToDate(cr.Spec.ForProvider, *t)
+	if topic.HasIngestion(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		it, err := e.ingestion.Get(ctx, topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetIngestion)
+		}
+		topic.UpdateIngestionObservation(&cr.Status.AtProvider, *it)
+		upToDate = upToDate && topic.IsIngestionUpToDate(cr.Spec.ForProvider, *it)
+	}
 	cr.SetConditions(xpv1.Available())
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: topic.IsUpToDate(cr.Spec.ForProvider, *t),
+		ResourceUpToDate: upToDate,
 		ConnectionDetails: managed.ConnectionDetails{
 			v1alpha1.ConnectionSecretKeyTopic:       []byte(meta.GetExternalName(cr)),
 			v1alpha1.ConnectionSecretKeyProjectName: []byte(e.projectID),
@@ -144,12 +165,28 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotTopic)
 	}
 
-	t, err := e.ps.Projects.Topics.Get(topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	name := topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr))
+	t, err := e.ps.Projects.Topics.Get(name).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTopic)
 	}
-	_, err = e.ps.Projects.Topics.Patch(topic.GetFullyQualifiedName(e.projectID, meta.GetExternalName(cr)), topic.GenerateUpdateRequest(meta.GetExternalName(cr), cr.Spec.ForProvider, *t)).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTopic)
+	if ut := topic.GenerateUpdateRequest(meta.GetExternalName(cr), cr.Spec.ForProvider, *t); ut.UpdateMask != "" {
+		if _, err := e.ps.Projects.Topics.Patch(name, ut).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTopic)
+		}
+	}
+	if !topic.HasIngestion(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		return managed.ExternalUpdate{}, nil
+	}
+	it, err := e.ingestion.Get(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetIngestion)
+	}
+	if topic.IsIngestionUpToDate(cr.Spec.ForProvider, *it) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.ingestion.Patch(ctx, name, topic.GenerateIngestionTopic(name, cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateIngestion)
 }
 
 // Delete initiates an deletion of the external resource.
@@ -36,6 +36,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/pubsub/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/topic"
 )
 
 const (
@@ -57,6 +58,32 @@ func newTopic(opts ...TopicOption) *v1alpha1.Topic {
 	return t
 }
 
+func withKinesisIngestion(t *v1alpha1.Topic) {
+	t.Spec.ForProvider.IngestionDataSourceSettings = &v1alpha1.IngestionDataSourceSettings{
+		AWSKinesis: &v1alpha1.AWSKinesis{
+			StreamARN:         "arn:aws:kinesis:us-east-1:111111111111:stream/cool-stream",
+			ConsumerARN:       "arn:aws:kinesis:us-east-1:111111111111:stream/cool-stream/consumer/cool-consumer:1",
+			AWSRoleARN:        "arn:aws:iam::111111111111:role/cool-role",
+			GCPServiceAccount: "ingestion@fooproject.iam.gserviceaccount.com",
+		},
+	}
+}
+
+func kinesisIngestionTopic(state string) *topic.IngestionTopic {
+	return &topic.IngestionTopic{
+		State: "ACTIVE",
+		IngestionDataSourceSettings: &topic.IngestionDataSourceSettings{
+			AwsKinesis: &topic.AwsKinesis{
+				State:             state,
+				StreamArn:         "arn:aws:kinesis:us-east-1:111111111111:stream/cool-stream",
+				ConsumerArn:       "arn:aws:kinesis:us-east-1:111111111111:stream/cool-stream/consumer/cool-consumer:1",
+				AwsRoleArn:        "arn:aws:iam::111111111111:role/cool-role",
+				GcpServiceAccount: "ingestion@fooproject.iam.gserviceaccount.com",
+			},
+		},
+	}
+}
+
 func gError(code int, message string) *googleapi.Error {
 	return &googleapi.Error{
 		Code:    code,
@@ -135,6 +162,74 @@ func TestObserve(t *testing.T) {
 				err: errors.Wrap(errBoom, errKubeUpdateTopic),
 			},
 		},
+		"GetIngestionFailed": {
+			reason: "Should return error if getting the ingestion settings fails",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if r.URL.Query().Get("alt") != "json" {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					if err := json.NewEncoder(w).Encode(&pubsub.Topic{}); err != nil {
+						t.Error(err)
+					}
+				}),
+				mg: newTopic(withKinesisIngestion),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetIngestion),
+			},
+		},
+		"IngestionNotUpToDate": {
+			reason: "Should report the ingestion state and that the Topic is not up to date",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if r.URL.Query().Get("alt") == "json" {
+						_ = json.NewEncoder(w).Encode(&pubsub.Topic{})
+						return
+					}
+					it := kinesisIngestionTopic("KINESIS_PERMISSION_DENIED")
+					it.IngestionDataSourceSettings.AwsKinesis.AwsRoleArn = "arn:aws:iam::111111111111:role/old-role"
+					_ = json.NewEncoder(w).Encode(it)
+				}),
+				mg: newTopic(withKinesisIngestion),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ConnectionSecretKeyTopic:       []byte(""),
+						v1alpha1.ConnectionSecretKeyProjectName: []byte(projectID),
+					},
+				},
+			},
+		},
+		"IngestionUpToDate": {
+			reason: "Should succeed if the ingestion settings are up to date",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if r.URL.Query().Get("alt") == "json" {
+						_ = json.NewEncoder(w).Encode(&pubsub.Topic{})
+						return
+					}
+					_ = json.NewEncoder(w).Encode(kinesisIngestionTopic("ACTIVE"))
+				}),
+				mg: newTopic(withKinesisIngestion),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ConnectionSecretKeyTopic:       []byte(""),
+						v1alpha1.ConnectionSecretKeyProjectName: []byte(projectID),
+					},
+				},
+			},
+		},
 		"Success": {
 			reason: "Should succeed",
 			args: args{
@@ -168,10 +263,12 @@ func TestObserve(t *testing.T) {
 			server := httptest.NewServer(tc.args.handler)
 			defer server.Close()
 			s, _ := pubsub.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			is, _ := topic.NewIngestionService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			e := external{
 				client:    tc.args.kube,
 				projectID: projectID,
 				ps:        s,
+				ingestion: is,
 			}
 			got, err := e.Observe(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
@@ -304,12 +401,56 @@ func TestUpdate(t *testing.T) {
 						t.Error(err)
 					}
 				}),
-				mg: newTopic(),
+				mg: newTopic(func(t *v1alpha1.Topic) { t.Spec.ForProvider.Labels = map[string]string{"cool": "label"} }),
 			},
 			want: want{
 				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateTopic),
 			},
 		},
+		"UpdateIngestionFailed": {
+			reason: "Should return error if updating the ingestion settings fails",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					switch {
+					case r.Method == http.MethodPatch:
+						w.WriteHeader(http.StatusBadRequest)
+					case r.URL.Query().Get("alt") == "json":
+						_ = json.NewEncoder(w).Encode(&pubsub.Topic{})
+					default:
+						_ = json.NewEncoder(w).Encode(&topic.IngestionTopic{})
+					}
+				}),
+				mg: newTopic(withKinesisIngestion),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateIngestion),
+			},
+		},
+		"UpdateIngestion": {
+			reason: "Should only patch the ingestion settings if nothing else changed",
+			args: args{
+				handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					defer func() { _ = r.Body.Close() }()
+					switch {
+					case r.Method == http.MethodPatch && r.URL.Query().Get("alt") == "json":
+						t.Errorf("unexpected patch of the Topic")
+					case r.Method == http.MethodPatch:
+						req := map[string]interface{}{}
+						_ = json.NewDecoder(r.Body).Decode(&req)
+						if diff := cmp.Diff("ingestionDataSourceSettings", req["updateMask"]); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						_ = json.NewEncoder(w).Encode(kinesisIngestionTopic("ACTIVE"))
+					case r.URL.Query().Get("alt") == "json":
+						_ = json.NewEncoder(w).Encode(&pubsub.Topic{})
+					default:
+						_ = json.NewEncoder(w).Encode(&topic.IngestionTopic{})
+					}
+				}),
+				mg: newTopic(withKinesisIngestion),
+			},
+		},
 		"Success": {
 			reason: "Should not fail if all calls succeed",
 			args: args{
@@ -332,10 +473,12 @@ func TestUpdate(t *testing.T) {
 			server := httptest.NewServer(tc.args.handler)
 			defer server.Close()
 			s, _ := pubsub.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			is, _ := topic.NewIngestionService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			e := external{
 				client:    tc.args.kube,
 				projectID: projectID,
 				ps:        s,
+				ingestion: is,
 			}
 			got, err := e.Update(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
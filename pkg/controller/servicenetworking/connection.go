@@ -22,6 +22,11 @@ import (
 	"path"
 
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 
 	compute "google.golang.org/api/compute/v1"
@@ -86,7 +91,7 @@ func SetupConnection(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.ConnectionGroupVersionKind),
-		managed.WithExternalConnecter(&connector{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &connector{client: mgr.GetClient()}))))),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -95,7 +100,7 @@ func SetupConnection(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1beta1.Connection{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -175,8 +180,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	// if we're creating a connection in a VPC whose name had been used
 	// before. It doesn't return error either, so, we just use this hack
 	// found in https://github.com/terraform-providers/terraform-provider-google-beta/blob/67b258a/google-beta/resource_service_networking_connection.go#L86
-	_, err := e.sn.Services.Connections.Patch(cn.Spec.ForProvider.Parent+"/connections/-", conn).UpdateMask("reservedPeeringRanges").Force(true).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(resource.Ignore(gcp.IsErrorAlreadyExists, err), errCreateConnection)
+	op, err := e.sn.Services.Connections.Patch(cn.Spec.ForProvider.Parent+"/connections/-", conn).UpdateMask("reservedPeeringRanges").Force(true).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(resource.Ignore(gcp.IsErrorAlreadyExists, err), errCreateConnection)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -187,8 +196,12 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	name := fmt.Sprintf("%s/connections/%s", cn.Spec.ForProvider.Parent, connection.PeeringName)
 	conn := connection.FromParameters(cn.Spec.ForProvider)
-	_, err := e.sn.Services.Connections.Patch(name, conn).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConnection)
+	op, err := e.sn.Services.Connections.Patch(name, conn).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConnection)
+	}
+	audit.RecordOperation(ctx, op.Name)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -18,10 +18,14 @@ package storage
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/imdario/mergo"
+	crmv3 "google.golang.org/api/cloudresourcemanager/v3"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,6 +42,13 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/lifecycle"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/tagbinding"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -50,6 +61,12 @@ const (
 	errCreate    = "cannot create GCP bucket"
 	errUpdate    = "cannot update GCP bucket"
 	errDelete    = "cannot delete GCP bucket"
+	errSimulate  = "cannot simulate lifecycle rules of GCP bucket"
+	errTags      = "cannot get tag bindings of GCP bucket"
+	errBindTag   = "cannot bind tag value to GCP bucket"
+	errUnbindTag = "cannot unbind tag value from GCP bucket"
+
+	errManagedUpdateFailed = "cannot update Bucket custom resource"
 )
 
 // SetupBucket adds a controller that reconciles Buckets.
@@ -63,7 +80,9 @@ func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha3.BucketGroupVersionKind),
-		managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &connecter{client: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &labelPropagator{kube: mgr.GetClient()}),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -71,14 +90,15 @@ func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha3.Bucket{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
 
-// A BucketClient produces a BucketHandler for the named bucket.
+// A BucketClient produces a BucketHandler for the named bucket. Requests made
+// by the handler are billed to userProject, if it is not empty.
 type BucketClient interface {
-	Bucket(name string) BucketHandler
+	Bucket(name, userProject string) BucketHandler
 }
 
 // A GCSBucketClient wraps the GCS storage.Client as a BucketClient.
@@ -87,8 +107,27 @@ type GCSBucketClient struct {
 }
 
 // Bucket produces a BucketHandler for the named bucket.
-func (sbc *GCSBucketClient) Bucket(name string) BucketHandler {
-	return sbc.c.Bucket(name)
+func (sbc *GCSBucketClient) Bucket(name, userProject string) BucketHandler {
+	h := sbc.c.Bucket(name)
+	if userProject != "" {
+		h = h.UserProject(userProject)
+	}
+	return h
+}
+
+// Objects lists the objects of the named bucket that match the supplied
+// query. Requests are billed to userProject, if it is not empty.
+func (sbc *GCSBucketClient) Objects(ctx context.Context, name, userProject string, q *storage.Query) lifecycle.ObjectIterator {
+	h := sbc.c.Bucket(name)
+	if userProject != "" {
+		h = h.UserProject(userProject)
+	}
+	return h.Objects(ctx, q)
+}
+
+// An ObjectLister lists the objects of buckets.
+type ObjectLister interface {
+	Objects(ctx context.Context, name, userProject string, q *storage.Query) lifecycle.ObjectIterator
 }
 
 // A BucketHandler handles requests to interact with buckets.
@@ -115,11 +154,14 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	return &external{handle: &GCSBucketClient{c: s}, projectID: projectID, client: c.client}, errors.Wrap(err, errNewClient)
+	gcs := &GCSBucketClient{c: s}
+	return &external{handle: gcs, objects: gcs, tags: tagbinding.NewClientFn(opts...), projectID: projectID, client: c.client}, errors.Wrap(err, errNewClient)
 }
 
 type external struct {
 	handle    BucketClient
+	objects   ObjectLister
+	tags      tagbinding.ClientFn
 	projectID string
 	client    client.Client
 }
@@ -130,7 +172,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotBucket)
 	}
 
-	a, err := e.handle.Bucket(meta.GetExternalName(cr)).Attrs(ctx)
+	a, err := e.handle.Bucket(meta.GetExternalName(cr), gcp.StringValue(cr.Spec.UserProject)).Attrs(ctx)
 	// NOTE(negz): The storage client appears to intercept the typical GCP API
 	// error that we check for with gcp.IsErrorNotFound and return this error
 	// instead, but only when getting bucket attributes.
@@ -141,8 +183,16 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errAttrs)
 	}
 
+	observed := v1alpha3.NewBucketSpecAttrs(a)
+	observed.Logging = lateInitLogging(cr.Spec.Logging, observed.Logging)
+	observed.SoftDeletePolicy = lateInitSoftDeletePolicy(cr.Spec.SoftDeletePolicy, observed.SoftDeletePolicy)
+	observed.CORS = lateInitCORS(cr.Spec.CORS, observed.CORS)
+	observed.Lifecycle.Rules = lateInitLifecycleRules(cr.Spec.Lifecycle.Rules, observed.Lifecycle.Rules)
+	// The custom placement configuration can neither be added nor removed
+	// once the bucket exists, so it is never late initialized.
+	observed.CustomPlacementConfig = nil
 	proposed := cr.Spec.BucketSpecAttrs.DeepCopy()
-	if err := mergo.Merge(proposed, v1alpha3.NewBucketSpecAttrs(a)); err != nil {
+	if err := mergo.Merge(proposed, observed); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errLateInit)
 	}
 	if !cmp.Equal(*proposed, cr.Spec.BucketSpecAttrs) {
@@ -153,21 +203,165 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	cr.Status.BucketOutputAttrs = v1alpha3.NewBucketOutputAttrs(a)
+	e.simulateLifecycle(ctx, cr)
 	cr.SetConditions(xpv1.Available())
 
+	// An empty list of lifecycle rules or CORS configurations removes them,
+	// which GCS reports as no list, and lifecycle conditions omit empty lists
+	// when they are serialized, so nil and empty lists are equal. References only exist
+	// in the spec and are never observed. An empty logging configuration
+	// disables logging, which GCS reports as no logging configuration. The
+	// same is true of a soft delete policy with no retention.
+	desired := cr.Spec.BucketUpdatableAttrs.DeepCopy()
+	if loggingDisabled(desired.Logging) {
+		desired.Logging = nil
+	}
+	if softDeleteDisabled(desired.SoftDeletePolicy) && a.SoftDeletePolicy == nil {
+		desired.SoftDeletePolicy = nil
+	}
+	bind, unbind, err := e.diffTags(ctx, cr, a.Location)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errTags)
+	}
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: cmp.Equal(v1alpha3.NewBucketUpdatableAttrs(a), &cr.Spec.BucketUpdatableAttrs),
+		ResourceExists: true,
+		ResourceUpToDate: len(bind) == 0 && len(unbind) == 0 && cmp.Equal(v1alpha3.NewBucketUpdatableAttrs(a), desired, cmpopts.EquateEmpty(),
+			cmpopts.IgnoreFields(v1alpha3.BucketEncryption{}, "DefaultKMSKeyNameRef", "DefaultKMSKeyNameSelector"),
+			cmpopts.IgnoreFields(v1alpha3.BucketLogging{}, "LogBucketRef", "LogBucketSelector")),
 	}, nil
 }
 
+// simulateLifecycle samples the objects of the supplied Bucket and evaluates
+// its lifecycle rules against them if a simulation is requested and the rules
+// or the sample size changed since the last one. A failed simulation does not
+// fail the observation of the Bucket, but is reported in its status.
+func (e *external) simulateLifecycle(ctx context.Context, cr *v1alpha3.Bucket) {
+	if cr.Spec.LifecycleSimulation == nil {
+		cr.Status.AtProvider.LifecycleSimulation = nil
+		return
+	}
+	size := lifecycle.SampleSize(cr.Spec.LifecycleSimulation)
+	if lifecycle.IsUpToDate(cr.Status.AtProvider.LifecycleSimulation, cr.Spec.Lifecycle.Rules, size) {
+		return
+	}
+	it := e.objects.Objects(ctx, meta.GetExternalName(cr), gcp.StringValue(cr.Spec.UserProject), lifecycle.Query())
+	objs, complete, err := lifecycle.Sample(it, size)
+	if err != nil {
+		cr.Status.AtProvider.LifecycleSimulation = lifecycle.Failed(cr.Status.AtProvider.LifecycleSimulation, errors.Wrap(err, errSimulate))
+		return
+	}
+	cr.Status.AtProvider.LifecycleSimulation = lifecycle.Simulate(cr.Spec.Lifecycle.Rules, objs, size, complete, time.Now())
+}
+
+// diffTags returns the tag values of the supplied Bucket that are to be bound
+// to the bucket in the supplied location, and the bindings that are to be
+// deleted. Tag bindings are only read if the Bucket binds or bound tags, so
+// that Buckets that don't need not be allowed to read them.
+func (e *external) diffTags(ctx context.Context, cr *v1alpha3.Bucket, location string) ([]string, []*crmv3.TagBinding, error) {
+	if len(cr.Spec.TagBindings) == 0 && len(cr.Status.AtProvider.TagBindings) == 0 {
+		return nil, nil, nil
+	}
+	tc, err := e.tags(ctx, location)
+	if err != nil {
+		return nil, nil, err
+	}
+	bs, err := tc.List(ctx, tagbinding.BucketParent(meta.GetExternalName(cr)))
+	if err != nil {
+		return nil, nil, err
+	}
+	bind, unbind := tagbinding.Diff(cr.Spec.TagBindings, cr.Status.AtProvider.TagBindings, bs)
+	return bind, unbind, nil
+}
+
+// syncTags binds the tag values of the supplied Bucket to the bucket in the
+// supplied location and unbinds those that it no longer binds.
+func (e *external) syncTags(ctx context.Context, cr *v1alpha3.Bucket, location string) error {
+	bind, unbind, err := e.diffTags(ctx, cr, location)
+	if err != nil || len(bind) == 0 && len(unbind) == 0 {
+		return errors.Wrap(err, errTags)
+	}
+	tc, err := e.tags(ctx, location)
+	if err != nil {
+		return errors.Wrap(err, errTags)
+	}
+	for _, v := range bind {
+		if err := tc.Create(ctx, tagbinding.NewTagBinding(tagbinding.BucketParent(meta.GetExternalName(cr)), v)); resource.Ignore(gcp.IsErrorAlreadyExists, err) != nil {
+			return errors.Wrap(err, errBindTag)
+		}
+	}
+	for _, b := range unbind {
+		if err := tc.Delete(ctx, b.Name); resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+			return errors.Wrap(err, errUnbindTag)
+		}
+	}
+	cr.Status.AtProvider.TagBindings = append([]string(nil), cr.Spec.TagBindings...)
+	return nil
+}
+
+// lateInitLogging returns the observed logging configuration that may be used
+// to late initialize the desired one. The whole configuration is late
+// initialized when none is desired, but fields of a desired configuration are
+// only filled in when it targets the observed log bucket, so that an empty
+// configuration can be used to disable logging and changing the log bucket
+// does not inherit the log object prefix used for the previous one.
+func lateInitLogging(desired, observed *v1alpha3.BucketLogging) *v1alpha3.BucketLogging {
+	if desired == nil || observed == nil {
+		return observed
+	}
+	if loggingDisabled(desired) || desired.LogBucket != observed.LogBucket {
+		return nil
+	}
+	return observed
+}
+
+func loggingDisabled(l *v1alpha3.BucketLogging) bool {
+	return l != nil && l.LogBucket == "" && l.LogObjectPrefix == "" && l.LogBucketRef == nil && l.LogBucketSelector == nil
+}
+
+// lateInitSoftDeletePolicy returns the observed soft delete policy that may be
+// used to late initialize the desired one. GCS applies a default retention to
+// buckets created without a policy, which is adopted rather than reported as
+// drift. A desired policy is never late initialized, because a retention of 0
+// is how soft delete is disabled.
+func lateInitSoftDeletePolicy(desired, observed *v1alpha3.SoftDeletePolicy) *v1alpha3.SoftDeletePolicy {
+	if desired != nil {
+		return nil
+	}
+	return observed
+}
+
+// lateInitCORS returns the observed CORS configuration that may be used to
+// late initialize the desired one. Only an omitted configuration is late
+// initialized; an empty one is kept so that it removes the CORS configuration
+// of the bucket.
+func lateInitCORS(desired, observed []v1alpha3.CORS) []v1alpha3.CORS {
+	if desired != nil {
+		return nil
+	}
+	return observed
+}
+
+// lateInitLifecycleRules returns the observed lifecycle rules that may be used
+// to late initialize the desired ones. Like the CORS configuration, an empty
+// list of rules is kept so that it removes the rules of the bucket.
+func lateInitLifecycleRules(desired, observed []v1alpha3.LifecycleRule) []v1alpha3.LifecycleRule {
+	if desired != nil {
+		return nil
+	}
+	return observed
+}
+
+func softDeleteDisabled(p *v1alpha3.SoftDeletePolicy) bool {
+	return p != nil && p.RetentionDurationSeconds == 0
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha3.Bucket)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotBucket)
 	}
 
-	err := e.handle.Bucket(meta.GetExternalName(cr)).Create(ctx, e.projectID, v1alpha3.CopyBucketSpecAttrs(&cr.Spec.BucketSpecAttrs))
+	err := e.handle.Bucket(meta.GetExternalName(cr), gcp.StringValue(cr.Spec.UserProject)).Create(ctx, e.projectID, v1alpha3.CopyBucketSpecAttrs(&cr.Spec.BucketSpecAttrs))
 	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 }
 
@@ -177,14 +371,16 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotBucket)
 	}
 
-	current, err := e.handle.Bucket(meta.GetExternalName(cr)).Attrs(ctx)
+	current, err := e.handle.Bucket(meta.GetExternalName(cr), gcp.StringValue(cr.Spec.UserProject)).Attrs(ctx)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errAttrs)
 	}
 	ua := v1alpha3.CopyToBucketUpdateAttrs(cr.Spec.BucketUpdatableAttrs, current.Labels)
-	_, err = e.handle.Bucket(meta.GetExternalName(cr)).Update(ctx, ua)
+	if _, err := e.handle.Bucket(meta.GetExternalName(cr), gcp.StringValue(cr.Spec.UserProject)).Update(ctx, ua); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
 
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	return managed.ExternalUpdate{}, e.syncTags(ctx, cr, current.Location)
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -193,6 +389,69 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotBucket)
 	}
 
-	err := e.handle.Bucket(meta.GetExternalName(cr)).Delete(ctx)
+	err := e.handle.Bucket(meta.GetExternalName(cr), gcp.StringValue(cr.Spec.UserProject)).Delete(ctx)
 	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDelete)
 }
+
+// maxLabelLength is the maximum length of keys and values of GCS labels.
+const maxLabelLength = 63
+
+type labelPropagator struct {
+	kube client.Client
+}
+
+// Initialize copies the metadata labels listed in spec.propagateLabels to
+// spec.labels, and removes them from spec.labels once they are removed from
+// the metadata.
+func (p *labelPropagator) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.Bucket)
+	if !ok {
+		return errors.New(errNotBucket)
+	}
+	if !propagateLabels(cr) {
+		return nil
+	}
+	return errors.Wrap(p.kube.Update(ctx, cr), errManagedUpdateFailed)
+}
+
+// propagateLabels syncs the propagated metadata labels of the supplied Bucket
+// into its spec and reports whether the spec was changed.
+func propagateLabels(cr *v1alpha3.Bucket) bool {
+	changed := false
+	for _, k := range cr.Spec.PropagateLabels {
+		key := toLabel(k)
+		v, ok := cr.GetLabels()[k]
+		if !ok {
+			if _, exists := cr.Spec.Labels[key]; exists {
+				delete(cr.Spec.Labels, key)
+				changed = true
+			}
+			continue
+		}
+		if cur, exists := cr.Spec.Labels[key]; exists && cur == toLabel(v) {
+			continue
+		}
+		if cr.Spec.Labels == nil {
+			cr.Spec.Labels = map[string]string{}
+		}
+		cr.Spec.Labels[key] = toLabel(v)
+		changed = true
+	}
+	return changed
+}
+
+// toLabel converts the supplied string to meet the requirements of GCS label
+// keys and values, i.e. at most 63 lowercase letters, digits, underscores and
+// dashes. See https://cloud.google.com/storage/docs/tags-and-labels
+func toLabel(s string) string {
+	b := []rune(strings.ToLower(s))
+	for i, r := range b {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' || r == '-') {
+			b[i] = '_'
+		}
+	}
+	if len(b) > maxLabelLength {
+		b = b[:maxLabelLength]
+	}
+	return string(b)
+}
@@ -18,25 +18,39 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/google/go-cmp/cmp"
+	crmv3 "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+	storagev1 "google.golang.org/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha3"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/lifecycle"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/tagbinding"
 )
 
 type MockBucketClient struct {
 	h BucketHandler
 }
 
-func (c *MockBucketClient) Bucket(name string) BucketHandler {
+func (c *MockBucketClient) Bucket(name, userProject string) BucketHandler {
 	return c.h
 }
 
@@ -63,6 +77,31 @@ func (m *MockBucketHandler) Delete(ctx context.Context) error {
 	return m.MockDelete(ctx)
 }
 
+type MockObjectLister struct {
+	MockObjects func(ctx context.Context, name, userProject string, q *storage.Query) lifecycle.ObjectIterator
+}
+
+func (m *MockObjectLister) Objects(ctx context.Context, name, userProject string, q *storage.Query) lifecycle.ObjectIterator {
+	return m.MockObjects(ctx, name, userProject, q)
+}
+
+type MockObjectIterator struct {
+	objs []*storage.ObjectAttrs
+	err  error
+}
+
+func (m *MockObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if len(m.objs) == 0 {
+		return nil, iterator.Done
+	}
+	o := m.objs[0]
+	m.objs = m.objs[1:]
+	return o, nil
+}
+
 func TestObserve(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -163,6 +202,387 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"LifecycleUpToDate": {
+			reason: "Lifecycle rules without dates should be up to date with the observed rules",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{Lifecycle: storage.Lifecycle{Rules: []storage.LifecycleRule{{
+							Action:    storage.LifecycleAction{Type: storage.DeleteAction},
+							Condition: storage.LifecycleCondition{AgeInDays: 30, MatchesPrefix: []string{"logs/"}},
+						}}}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Lifecycle: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{{
+						Action:    v1alpha3.LifecycleAction{Type: storage.DeleteAction},
+						Condition: v1alpha3.LifecycleCondition{AgeInDays: 30, MatchesPrefix: []string{"logs/"}},
+					}}}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LifecycleChanged": {
+			reason: "Lifecycle rules that differ from the observed rules should not be up to date",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{Lifecycle: storage.Lifecycle{Rules: []storage.LifecycleRule{{
+							Action:    storage.LifecycleAction{Type: storage.DeleteAction},
+							Condition: storage.LifecycleCondition{AgeInDays: 30},
+						}}}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Lifecycle: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{{
+						Action:    v1alpha3.LifecycleAction{Type: storage.DeleteAction},
+						Condition: v1alpha3.LifecycleCondition{AgeInDays: 60},
+					}}}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"CORSChanged": {
+			reason: "A CORS configuration that differs from the observed one should not be up to date",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{CORS: []storage.CORS{{Origins: []string{"https://example.org"}, Methods: []string{"GET"}}}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{CORS: []v1alpha3.CORS{{Origins: []string{"https://example.org"}, Methods: []string{"GET", "HEAD"}}}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"CORSCleared": {
+			reason: "An empty CORS configuration should not be late initialized and should be out of date while the bucket has one",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{CORS: []storage.CORS{{Origins: []string{"https://example.org"}, Methods: []string{"GET"}}}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{CORS: []v1alpha3.CORS{}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LifecycleRulesCleared": {
+			reason: "An empty list of lifecycle rules should not be late initialized and should be out of date while the bucket has rules",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{Lifecycle: storage.Lifecycle{Rules: []storage.LifecycleRule{{
+							Action:    storage.LifecycleAction{Type: storage.DeleteAction},
+							Condition: storage.LifecycleCondition{AgeInDays: 30},
+						}}}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Lifecycle: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{}}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"CustomPlacementConfigNotLateInitialized": {
+			reason: "The custom placement configuration of the bucket should not be late initialized",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{CustomPlacementConfig: &storage.CustomPlacementConfig{DataLocations: []string{"US-EAST1", "US-WEST1"}}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"EncryptionReferenceUpToDate": {
+			reason: "A resolved KMS key reference should not make the bucket appear out of date",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{Encryption: &storage.BucketEncryption{DefaultKMSKeyName: "key"}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Encryption: &v1alpha3.BucketEncryption{
+						DefaultKMSKeyName:    "key",
+						DefaultKMSKeyNameRef: &xpv1.Reference{Name: "key"},
+					}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"EncryptionChanged": {
+			reason: "A default KMS key that differs from the observed one should not be up to date",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{Encryption: &storage.BucketEncryption{DefaultKMSKeyName: "old-key"}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Encryption: &v1alpha3.BucketEncryption{DefaultKMSKeyName: "new-key"}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LoggingReferenceUpToDate": {
+			reason: "A resolved log bucket reference should not make the bucket appear out of date",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{Logging: &storage.BucketLogging{LogBucket: "logs", LogObjectPrefix: "access"}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Logging: &v1alpha3.BucketLogging{
+						LogBucket:       "logs",
+						LogBucketRef:    &xpv1.Reference{Name: "logs"},
+						LogObjectPrefix: "access",
+					}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LoggingPrefixLateInitialized": {
+			reason: "An unset log object prefix should be late initialized when the observed log bucket is the desired one",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{Logging: &storage.BucketLogging{LogBucket: "logs", LogObjectPrefix: "access"}}, nil
+					},
+				}},
+				client: &test.MockClient{
+					MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						want := &v1alpha3.BucketLogging{LogBucket: "logs", LogObjectPrefix: "access"}
+						if diff := cmp.Diff(want, obj.(*v1alpha3.Bucket).Spec.Logging); diff != "" {
+							t.Errorf("Update(...): -want logging, +got logging:\n%s", diff)
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Logging: &v1alpha3.BucketLogging{LogBucket: "logs"}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LoggingBucketChanged": {
+			reason: "A log bucket that differs from the observed one should not be up to date nor inherit its log object prefix",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{Logging: &storage.BucketLogging{LogBucket: "old-logs", LogObjectPrefix: "access"}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Logging: &v1alpha3.BucketLogging{LogBucket: "new-logs"}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LoggingDisabled": {
+			reason: "An empty logging configuration should not be late initialized and should be out of date while logging is enabled",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{Logging: &storage.BucketLogging{LogBucket: "logs"}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Logging: &v1alpha3.BucketLogging{}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LoggingDisabledUpToDate": {
+			reason: "An empty logging configuration should be up to date with a bucket that has no logging configuration",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) { return &storage.BucketAttrs{}, nil },
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Logging: &v1alpha3.BucketLogging{}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SoftDeletePolicyLateInitialized": {
+			reason: "An unset soft delete policy should be late initialized with the default retention GCS applies",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{SoftDeletePolicy: &storage.SoftDeletePolicy{RetentionDuration: 7 * 24 * time.Hour}}, nil
+					},
+				}},
+				client: &test.MockClient{
+					MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						want := &v1alpha3.SoftDeletePolicy{RetentionDurationSeconds: 604800}
+						if diff := cmp.Diff(want, obj.(*v1alpha3.Bucket).Spec.SoftDeletePolicy); diff != "" {
+							t.Errorf("Update(...): -want soft delete policy, +got soft delete policy:\n%s", diff)
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SoftDeleteDisabled": {
+			reason: "A soft delete policy with no retention should not be late initialized and should be out of date while soft delete is enabled",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{SoftDeletePolicy: &storage.SoftDeletePolicy{RetentionDuration: 7 * 24 * time.Hour}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{SoftDeletePolicy: &v1alpha3.SoftDeletePolicy{}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"SoftDeleteDisabledUpToDate": {
+			reason: "A soft delete policy with no retention should be up to date with a bucket that has no soft delete policy",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) { return &storage.BucketAttrs{}, nil },
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{SoftDeletePolicy: &v1alpha3.SoftDeletePolicy{}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SoftDeleteRetentionChanged": {
+			reason: "A soft delete retention that differs from the observed one should not be up to date",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{SoftDeletePolicy: &storage.SoftDeletePolicy{RetentionDuration: 7 * 24 * time.Hour}}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{SoftDeletePolicy: &v1alpha3.SoftDeletePolicy{RetentionDurationSeconds: 1209600}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"IAMConfigurationLateInitialized": {
+			reason: "An unset IAM configuration should be late initialized from the observed bucket",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{
+							UniformBucketLevelAccess: storage.UniformBucketLevelAccess{Enabled: true},
+							PublicAccessPrevention:   storage.PublicAccessPreventionEnforced,
+						}, nil
+					},
+				}},
+				client: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UniformBucketLevelAccessChanged": {
+			reason: "A uniform bucket-level access setting that differs from the observed one should not be up to date",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{PublicAccessPrevention: storage.PublicAccessPreventionInherited}, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{IAMConfiguration: &v1alpha3.BucketIAMConfiguration{
+						UniformBucketLevelAccess: &v1alpha3.UniformBucketLevelAccess{Enabled: gcp.BoolPtr(true)},
+						PublicAccessPrevention:   gcp.StringPtr(v1alpha3.PublicAccessPreventionInherited),
+					}},
+				}}}},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -179,6 +599,284 @@ func TestObserve(t *testing.T) {
 	}
 }
 
+type MockTagBindingClient struct {
+	MockList   func(ctx context.Context, parent string) ([]*crmv3.TagBinding, error)
+	MockCreate func(ctx context.Context, b *crmv3.TagBinding) error
+	MockDelete func(ctx context.Context, name string) error
+}
+
+func (m *MockTagBindingClient) List(ctx context.Context, parent string) ([]*crmv3.TagBinding, error) {
+	return m.MockList(ctx, parent)
+}
+
+func (m *MockTagBindingClient) Create(ctx context.Context, b *crmv3.TagBinding) error {
+	return m.MockCreate(ctx, b)
+}
+
+func (m *MockTagBindingClient) Delete(ctx context.Context, name string) error {
+	return m.MockDelete(ctx, name)
+}
+
+func TestTagBindings(t *testing.T) {
+	errBoom := errors.New("boom")
+	parent := tagbinding.BucketParent("cool-bucket")
+	bucket := func(desired, owned []string) *v1alpha3.Bucket {
+		b := &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{TagBindings: desired}}}
+		b.SetAnnotations(map[string]string{"crossplane.io/external-name": "cool-bucket"})
+		b.Status.AtProvider.TagBindings = owned
+		return b
+	}
+	binding := func(value, namespaced string) *crmv3.TagBinding {
+		return &crmv3.TagBinding{Name: tagbinding.BindingName(parent, value), Parent: parent, TagValue: value, TagValueNamespacedName: namespaced}
+	}
+
+	type want struct {
+		upToDate bool
+		created  []*crmv3.TagBinding
+		deleted  []string
+		owned    []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		cr       *v1alpha3.Bucket
+		observed []*crmv3.TagBinding
+		listErr  error
+		create   error
+		want     want
+	}{
+		"Unmanaged": {
+			reason:   "Tag bindings should be left alone if the Bucket does not bind any",
+			cr:       bucket(nil, nil),
+			observed: []*crmv3.TagBinding{binding("tagValues/1", "123/env/prod")},
+			want:     want{upToDate: true},
+		},
+		"Bound": {
+			reason:   "A Bucket should be up to date if its tag values are bound, by name or namespaced name",
+			cr:       bucket([]string{"tagValues/1", "123/team/a"}, []string{"tagValues/1", "123/team/a"}),
+			observed: []*crmv3.TagBinding{binding("tagValues/1", "123/env/prod"), binding("tagValues/2", "123/team/a"), binding("tagValues/3", "123/cost/x")},
+			want:     want{upToDate: true, owned: []string{"tagValues/1", "123/team/a"}},
+		},
+		"BindAndUnbind": {
+			reason:   "Missing tag values should be bound and those this Bucket no longer binds should be unbound",
+			cr:       bucket([]string{"123/team/a"}, []string{"tagValues/1"}),
+			observed: []*crmv3.TagBinding{binding("tagValues/1", "123/env/prod"), binding("tagValues/3", "123/cost/x")},
+			want: want{
+				created: []*crmv3.TagBinding{{Parent: parent, TagValueNamespacedName: "123/team/a"}},
+				deleted: []string{tagbinding.BindingName(parent, "tagValues/1")},
+				owned:   []string{"123/team/a"},
+			},
+		},
+		"ListError": {
+			reason:  "Errors listing tag bindings should be returned",
+			cr:      bucket([]string{"tagValues/1"}, nil),
+			listErr: errBoom,
+			want:    want{err: errors.Wrap(errBoom, errTags)},
+		},
+		"BindError": {
+			reason: "Errors binding tag values should be returned and ownership should not be recorded",
+			cr:     bucket([]string{"tagValues/1"}, nil),
+			create: errBoom,
+			want: want{
+				created: []*crmv3.TagBinding{{Parent: parent, TagValue: "tagValues/1"}},
+				err:     errors.Wrap(errBoom, errBindTag),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created []*crmv3.TagBinding
+			var deleted []string
+			tags := &MockTagBindingClient{
+				MockList: func(_ context.Context, p string) ([]*crmv3.TagBinding, error) {
+					if p != parent {
+						t.Errorf("List(...): want parent %q, got %q", parent, p)
+					}
+					return tc.observed, tc.listErr
+				},
+				MockCreate: func(_ context.Context, b *crmv3.TagBinding) error {
+					created = append(created, b)
+					return tc.create
+				},
+				MockDelete: func(_ context.Context, name string) error {
+					deleted = append(deleted, name)
+					return nil
+				},
+			}
+			e := &external{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs:  func(context.Context) (*storage.BucketAttrs, error) { return &storage.BucketAttrs{Location: "US"}, nil },
+					MockUpdate: func(context.Context, storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) { return nil, nil },
+				}},
+				tags: func(_ context.Context, location string) (tagbinding.Client, error) {
+					if location != "US" {
+						t.Errorf("tags(...): want location US, got %q", location)
+					}
+					return tags, nil
+				},
+				client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if err == nil && !o.ResourceUpToDate {
+				_, err = e.Update(context.Background(), tc.cr)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.upToDate, o.ResourceUpToDate); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want up to date, +got up to date:\n%s\n", tc.reason, diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want created, +got created:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.owned, tc.cr.Status.AtProvider.TagBindings); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want owned, +got owned:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveLifecycleSimulation(t *testing.T) {
+	errBoom := errors.New("boom")
+	bucket := func(sim *v1alpha3.LifecycleSimulation, status *v1alpha3.LifecycleSimulationStatus) *v1alpha3.Bucket {
+		b := &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{
+			BucketSpecAttrs: v1alpha3.BucketSpecAttrs{BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{
+				Lifecycle: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{{
+					Action:    v1alpha3.LifecycleAction{Type: "Delete"},
+					Condition: v1alpha3.LifecycleCondition{AgeInDays: 30},
+				}}},
+			}},
+			LifecycleSimulation: sim,
+		}}}
+		b.Status.AtProvider.LifecycleSimulation = status
+		return b
+	}
+	attrs := &storage.BucketAttrs{Lifecycle: storage.Lifecycle{Rules: []storage.LifecycleRule{{
+		Action:    storage.LifecycleAction{Type: "Delete"},
+		Condition: storage.LifecycleCondition{AgeInDays: 30},
+	}}}}
+	objs := []*storage.ObjectAttrs{
+		{Name: "old", Created: time.Now().Add(-60 * 24 * time.Hour)},
+		{Name: "new", Created: time.Now()},
+	}
+	hash := lifecycle.RulesHash(bucket(nil, nil).Spec.Lifecycle.Rules)
+	simulated := &v1alpha3.LifecycleSimulationStatus{
+		SampledObjects: 2,
+		Complete:       true,
+		Rules:          []v1alpha3.LifecycleRuleSimulation{{Index: 0, ActionType: "Delete", MatchingObjects: 1}},
+		SampleSize:     lifecycle.DefaultSampleSize,
+		RulesHash:      hash,
+	}
+
+	type want struct {
+		cr  *v1alpha3.Bucket
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		objects ObjectLister
+		cr      *v1alpha3.Bucket
+		want    want
+	}{
+		"Simulated": {
+			reason: "The lifecycle rules should be evaluated against the sampled objects when a simulation is requested",
+			objects: &MockObjectLister{MockObjects: func(context.Context, string, string, *storage.Query) lifecycle.ObjectIterator {
+				return &MockObjectIterator{objs: objs}
+			}},
+			cr: bucket(&v1alpha3.LifecycleSimulation{}, nil),
+			want: want{
+				cr: bucket(&v1alpha3.LifecycleSimulation{}, simulated),
+			},
+		},
+		"UpToDate": {
+			reason: "The objects should not be sampled again while the lifecycle rules and the sample size are unchanged",
+			objects: &MockObjectLister{MockObjects: func(context.Context, string, string, *storage.Query) lifecycle.ObjectIterator {
+				t.Error("objects were sampled again")
+				return &MockObjectIterator{}
+			}},
+			cr: bucket(&v1alpha3.LifecycleSimulation{}, simulated.DeepCopy()),
+			want: want{
+				cr: bucket(&v1alpha3.LifecycleSimulation{}, simulated),
+			},
+		},
+		"SampleSizeChanged": {
+			reason: "The objects should be sampled again when the sample size changes",
+			objects: &MockObjectLister{MockObjects: func(context.Context, string, string, *storage.Query) lifecycle.ObjectIterator {
+				return &MockObjectIterator{objs: objs}
+			}},
+			cr: bucket(&v1alpha3.LifecycleSimulation{SampleSize: gcp.Int64Ptr(10)}, simulated.DeepCopy()),
+			want: want{
+				cr: bucket(&v1alpha3.LifecycleSimulation{SampleSize: gcp.Int64Ptr(10)}, &v1alpha3.LifecycleSimulationStatus{
+					SampledObjects: 2,
+					Complete:       true,
+					Rules:          []v1alpha3.LifecycleRuleSimulation{{Index: 0, ActionType: "Delete", MatchingObjects: 1}},
+					SampleSize:     10,
+					RulesHash:      hash,
+				}),
+			},
+		},
+		"NotRequested": {
+			reason: "A previous simulation should be removed from the status when a simulation is no longer requested",
+			cr:     bucket(nil, &v1alpha3.LifecycleSimulationStatus{SampledObjects: 2}),
+			want: want{
+				cr: bucket(nil, nil),
+			},
+		},
+		"ListFailed": {
+			reason: "Errors listing the objects of the bucket should be reported in the simulation rather than fail the observation",
+			objects: &MockObjectLister{MockObjects: func(context.Context, string, string, *storage.Query) lifecycle.ObjectIterator {
+				return &MockObjectIterator{err: errBoom}
+			}},
+			cr: bucket(&v1alpha3.LifecycleSimulation{}, nil),
+			want: want{
+				cr: bucket(&v1alpha3.LifecycleSimulation{}, &v1alpha3.LifecycleSimulationStatus{
+					Error: errors.Wrap(errors.Wrap(errBoom, "cannot list objects of GCS bucket"), errSimulate).Error(),
+				}),
+			},
+		},
+		"ListFailedKeepsResults": {
+			reason: "A failed simulation should keep the results of the last successful one",
+			objects: &MockObjectLister{MockObjects: func(context.Context, string, string, *storage.Query) lifecycle.ObjectIterator {
+				return &MockObjectIterator{err: errBoom}
+			}},
+			cr: bucket(&v1alpha3.LifecycleSimulation{SampleSize: gcp.Int64Ptr(10)}, simulated.DeepCopy()),
+			want: want{
+				cr: bucket(&v1alpha3.LifecycleSimulation{SampleSize: gcp.Int64Ptr(10)}, func() *v1alpha3.LifecycleSimulationStatus {
+					s := simulated.DeepCopy()
+					s.Error = errors.Wrap(errors.Wrap(errBoom, "cannot list objects of GCS bucket"), errSimulate).Error()
+					return s
+				}()),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) { return attrs, nil },
+				}},
+				objects: tc.objects,
+			}
+			_, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cr.Status.AtProvider, tc.cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestCreate(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -331,6 +1029,74 @@ func TestUpdate(t *testing.T) {
 			},
 			want: want{},
 		},
+		"UpdateCORS": {
+			reason: "The CORS configuration of the bucket should be replaced with the desired one",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) { return &storage.BucketAttrs{}, nil },
+					MockUpdate: func(_ context.Context, ua storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+						want := []storage.CORS{{MaxAge: time.Hour, Methods: []string{"GET"}, Origins: []string{"*"}}}
+						if diff := cmp.Diff(want, ua.CORS); diff != "" {
+							t.Errorf("Update(...): -want CORS, +got CORS:\n%s", diff)
+						}
+						return nil, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{CORS: []v1alpha3.CORS{{MaxAge: metav1.Duration{Duration: time.Hour}, Methods: []string{"GET"}, Origins: []string{"*"}}}},
+				}}}},
+			},
+			want: want{},
+		},
+		"ClearCORS": {
+			reason: "An empty CORS configuration should remove the CORS configuration of the bucket",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{CORS: []storage.CORS{{Origins: []string{"*"}, Methods: []string{"GET"}}}}, nil
+					},
+					MockUpdate: func(_ context.Context, ua storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+						if ua.CORS == nil || len(ua.CORS) != 0 {
+							t.Errorf("Update(...): want an empty CORS list, got %v", ua.CORS)
+						}
+						if ua.Lifecycle == nil || len(ua.Lifecycle.Rules) != 0 {
+							t.Errorf("Update(...): want no lifecycle rules, got %v", ua.Lifecycle)
+						}
+						return nil, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{CORS: []v1alpha3.CORS{}, Lifecycle: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{}}},
+				}}}},
+			},
+			want: want{},
+		},
+		"DisableLogging": {
+			reason: "An empty logging configuration should remove the logging configuration of the bucket",
+			fields: fields{
+				handle: &MockBucketClient{&MockBucketHandler{
+					MockAttrs: func(context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{Logging: &storage.BucketLogging{LogBucket: "logs"}}, nil
+					},
+					MockUpdate: func(_ context.Context, ua storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+						if diff := cmp.Diff(&storage.BucketLogging{}, ua.Logging); diff != "" {
+							t.Errorf("Update(...): -want logging, +got logging:\n%s", diff)
+						}
+						return nil, nil
+					},
+				}},
+			},
+			args: args{
+				mg: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{
+					BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Logging: &v1alpha3.BucketLogging{}},
+				}}}},
+			},
+			want: want{},
+		},
 	}
 
 	for name, tc := range cases {
@@ -410,3 +1176,143 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestGCSBucketClient(t *testing.T) {
+	cases := map[string]struct {
+		userProject string
+	}{
+		"NoUserProject":   {},
+		"WithUserProject": {userProject: "billing-project"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.userProject, r.URL.Query().Get("userProject")); diff != "" {
+					t.Errorf("r: -want userProject, +got userProject:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&storagev1.Bucket{Name: "test-bucket"})
+			}))
+			defer server.Close()
+			c, err := storage.NewClient(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			if err != nil {
+				t.Fatal(err)
+			}
+			sbc := &GCSBucketClient{c: c}
+			if _, err := sbc.Bucket("test-bucket", tc.userProject).Attrs(context.Background()); err != nil {
+				t.Errorf("Attrs(...): unexpected error %s", err)
+			}
+		})
+	}
+}
+
+func TestLabelPropagatorInitialize(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	bucket := func(labels, specLabels map[string]string, propagate ...string) *v1alpha3.Bucket {
+		b := &v1alpha3.Bucket{}
+		b.SetLabels(labels)
+		b.Spec.Labels = specLabels
+		b.Spec.PropagateLabels = propagate
+		return b
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"NotABucket": {
+			reason: "We should return an error if the supplied managed resource is not a bucket",
+			want: want{
+				err: errors.New(errNotBucket),
+			},
+		},
+		"NothingToPropagate": {
+			reason: "We should not update the Bucket if it does not propagate any labels",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     bucket(map[string]string{"team": "a"}, nil),
+			want: want{
+				mg: bucket(map[string]string{"team": "a"}, nil),
+			},
+		},
+		"AlreadyInSync": {
+			reason: "We should not update the Bucket if its propagated labels are in sync",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     bucket(map[string]string{"team": "A"}, map[string]string{"team": "a"}, "team"),
+			want: want{
+				mg: bucket(map[string]string{"team": "A"}, map[string]string{"team": "a"}, "team"),
+			},
+		},
+		"Propagate": {
+			reason: "Listed metadata labels should be converted and copied to the spec",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg: bucket(
+				map[string]string{"example.org/Cost-Center": "R&D", "other": "ignored"},
+				map[string]string{"keep": "me"},
+				"example.org/Cost-Center"),
+			want: want{
+				mg: bucket(
+					map[string]string{"example.org/Cost-Center": "R&D", "other": "ignored"},
+					map[string]string{"keep": "me", "example_org_cost-center": "r_d"},
+					"example.org/Cost-Center"),
+			},
+		},
+		"RemoveDeleted": {
+			reason: "Propagated labels that were removed from the metadata should be removed from the spec",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     bucket(nil, map[string]string{"team": "a", "keep": "me"}, "team"),
+			want: want{
+				mg: bucket(nil, map[string]string{"keep": "me"}, "team"),
+			},
+		},
+		"UpdateError": {
+			reason: "Errors updating the Bucket should be returned",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     bucket(map[string]string{"team": "a"}, nil, "team"),
+			want: want{
+				mg:  bucket(map[string]string{"team": "a"}, map[string]string{"team": "a"}, "team"),
+				err: errors.Wrap(errBoom, errManagedUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &labelPropagator{kube: tc.kube}
+			err := p.Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestToLabel(t *testing.T) {
+	cases := map[string]struct {
+		in   string
+		want string
+	}{
+		"Valid":     {in: "team-a_1", want: "team-a_1"},
+		"Converted": {in: "App.Kubernetes.io/Name", want: "app_kubernetes_io_name"},
+		"Truncated": {in: strings.Repeat("a", 70), want: strings.Repeat("a", 63)},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, toLabel(tc.in)); diff != "" {
+				t.Errorf("toLabel(%q): -want, +got:\n%s", tc.in, diff)
+			}
+		})
+	}
+}
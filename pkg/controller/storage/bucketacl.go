@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+
+	"google.golang.org/api/storage/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketacl"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotBucketACL    = "managed resource is not a GCP BucketACL"
+	errGetBucketACL    = "cannot get GCP bucket access control"
+	errCreateBucketACL = "cannot create GCP bucket access control"
+	errUpdateBucketACL = "cannot update GCP bucket access control"
+	errDeleteBucketACL = "cannot delete GCP bucket access control"
+)
+
+// SetupBucketACL adds a controller that reconciles BucketACLs.
+func SetupBucketACL(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BucketACLGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BucketACLGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &bucketACLConnecter{client: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.BucketACL{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type bucketACLConnecter struct {
+	client client.Client
+}
+
+// Connect sets up storage client using credentials from the provider
+func (c *bucketACLConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := storage.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &bucketACLExternal{acls: storage.NewBucketAccessControlsService(s)}, nil
+}
+
+type bucketACLExternal struct {
+	acls bucketacl.Client
+}
+
+func (e *bucketACLExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BucketACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBucketACL)
+	}
+
+	ac, err := e.acls.Get(gcp.StringValue(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.Entity).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBucketACL)
+	}
+
+	cr.Status.AtProvider = bucketacl.GenerateObservation(*ac)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: bucketacl.IsUpToDate(cr.Spec.ForProvider, *ac),
+	}, nil
+}
+
+func (e *bucketACLExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BucketACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBucketACL)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.acls.Insert(gcp.StringValue(cr.Spec.ForProvider.Bucket), bucketacl.GenerateBucketAccessControl(cr.Spec.ForProvider)).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateBucketACL)
+}
+
+func (e *bucketACLExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BucketACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBucketACL)
+	}
+
+	_, err := e.acls.Patch(gcp.StringValue(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.Entity, bucketacl.GenerateBucketAccessControl(cr.Spec.ForProvider)).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBucketACL)
+}
+
+func (e *bucketACLExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BucketACL)
+	if !ok {
+		return errors.New(errNotBucketACL)
+	}
+
+	err := e.acls.Delete(gcp.StringValue(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.Entity).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteBucketACL)
+}
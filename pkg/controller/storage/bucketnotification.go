@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	pubsub "google.golang.org/api/pubsub/v1"
+	"google.golang.org/api/storage/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketnotification"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotBucketNotification    = "managed resource is not a GCP BucketNotification"
+	errGetBucketNotification    = "cannot get GCP bucket notification configuration"
+	errCreateBucketNotification = "cannot create GCP bucket notification configuration"
+	errDeleteBucketNotification = "cannot delete GCP bucket notification configuration"
+	errGetServiceAgent          = "cannot get GCP Cloud Storage service agent"
+	errGetTopicPolicy           = "cannot get IAM policy of GCP Pub/Sub topic"
+	errSetTopicPolicy           = "cannot grant GCP Cloud Storage service agent permission to publish to GCP Pub/Sub topic"
+)
+
+// SetupBucketNotification adds a controller that reconciles
+// BucketNotifications.
+func SetupBucketNotification(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BucketNotificationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BucketNotificationGroupVersionKind),
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &bucketNotificationConnecter{client: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.BucketNotification{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type bucketNotificationConnecter struct {
+	client client.Client
+}
+
+// Connect sets up storage client using credentials from the provider
+func (c *bucketNotificationConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := storage.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	ps, err := pubsub.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &bucketNotificationExternal{
+		notifications:   storage.NewNotificationsService(s),
+		serviceAccounts: storage.NewProjectsServiceAccountService(s),
+		topics:          pubsub.NewProjectsTopicsService(ps),
+		projectID:       projectID,
+	}, nil
+}
+
+type bucketNotificationExternal struct {
+	notifications   bucketnotification.Client
+	serviceAccounts *storage.ProjectsServiceAccountService
+	topics          bucketnotification.TopicIAMClient
+	projectID       string
+}
+
+func (e *bucketNotificationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BucketNotification)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBucketNotification)
+	}
+
+	// Notification configuration IDs are generated by the API when they are
+	// created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	n, err := e.notifications.Get(gcp.StringValue(cr.Spec.ForProvider.Bucket), meta.GetExternalName(cr)).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBucketNotification)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	bucketnotification.LateInitializeSpec(&cr.Spec.ForProvider, *n)
+
+	cr.Status.AtProvider = bucketnotification.GenerateObservation(*n)
+
+	// Notification configurations cannot be updated, so only the publisher
+	// grant may need to be brought up to date.
+	upToDate := true
+	if gcp.BoolValue(cr.Spec.ForProvider.GrantPublisher) {
+		agent, p, err := e.getTopicPolicy(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		cr.Status.AtProvider.ServiceAgent = agent
+		cr.Status.AtProvider.PublisherGranted = bucketnotification.HasPublisher(p, bucketnotification.ServiceAgentMember(agent))
+		upToDate = cr.Status.AtProvider.PublisherGranted
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *bucketNotificationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BucketNotification)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBucketNotification)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	// The notifications API refuses to create notification configurations
+	// whose topic the service agent cannot publish to.
+	if gcp.BoolValue(cr.Spec.ForProvider.GrantPublisher) {
+		if err := e.grantPublisher(ctx, cr); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	n, err := e.notifications.Insert(gcp.StringValue(cr.Spec.ForProvider.Bucket), bucketnotification.GenerateNotification(e.projectID, cr.Spec.ForProvider)).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBucketNotification)
+	}
+
+	meta.SetExternalName(cr, n.Id)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *bucketNotificationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BucketNotification)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBucketNotification)
+	}
+	if !gcp.BoolValue(cr.Spec.ForProvider.GrantPublisher) {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{}, e.grantPublisher(ctx, cr)
+}
+
+func (e *bucketNotificationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BucketNotification)
+	if !ok {
+		return errors.New(errNotBucketNotification)
+	}
+
+	err := e.notifications.Delete(gcp.StringValue(cr.Spec.ForProvider.Bucket), meta.GetExternalName(cr)).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteBucketNotification)
+}
+
+// getTopicPolicy returns the email address of the Cloud Storage service agent
+// and the IAM policy of the topic of the supplied BucketNotification.
+func (e *bucketNotificationExternal) getTopicPolicy(ctx context.Context, cr *v1alpha1.BucketNotification) (string, *pubsub.Policy, error) {
+	sa, err := e.serviceAccounts.Get(e.projectID).Context(ctx).Do()
+	if err != nil {
+		return "", nil, errors.Wrap(err, errGetServiceAgent)
+	}
+	p, err := e.topics.GetIamPolicy(bucketnotification.GetTopicResource(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Topic))).Context(ctx).Do()
+	if err != nil {
+		return "", nil, errors.Wrap(err, errGetTopicPolicy)
+	}
+	return sa.EmailAddress, p, nil
+}
+
+// grantPublisher grants the Cloud Storage service agent the publisher role on
+// the topic of the supplied BucketNotification, unless it is already granted.
+func (e *bucketNotificationExternal) grantPublisher(ctx context.Context, cr *v1alpha1.BucketNotification) error {
+	agent, p, err := e.getTopicPolicy(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if !bucketnotification.AddPublisher(p, bucketnotification.ServiceAgentMember(agent)) {
+		return nil
+	}
+	req := &pubsub.SetIamPolicyRequest{Policy: p}
+	_, err = e.topics.SetIamPolicy(bucketnotification.GetTopicResource(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Topic)), req).Context(ctx).Do()
+	return errors.Wrap(err, errSetTopicPolicy)
+}
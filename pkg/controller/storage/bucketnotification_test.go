@@ -0,0 +1,376 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	pubsub "google.golang.org/api/pubsub/v1"
+	storagev1 "google.golang.org/api/storage/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testNotificationID    = "42"
+	testNotificationTopic = "//pubsub.googleapis.com/projects/cool-project/topics/my-topic"
+	testServiceAgent      = "service-123@gs-project-accounts.iam.gserviceaccount.com"
+)
+
+func bucketNotification(m ...func(*v1alpha1.BucketNotification)) *v1alpha1.BucketNotification {
+	cr := &v1alpha1.BucketNotification{}
+	cr.Spec.ForProvider = v1alpha1.BucketNotificationParameters{
+		Bucket:        gcp.StringPtr(testBucketName),
+		Topic:         gcp.StringPtr("my-topic"),
+		EventTypes:    []string{"OBJECT_FINALIZE"},
+		PayloadFormat: v1alpha1.PayloadFormatJSONAPIV1,
+	}
+	meta.SetExternalName(cr, testNotificationID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func newBucketNotificationExternal(url string) *bucketNotificationExternal {
+	s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(url), option.WithoutAuthentication())
+	ps, _ := pubsub.NewService(context.Background(), option.WithEndpoint(url), option.WithoutAuthentication())
+	return &bucketNotificationExternal{
+		notifications:   storagev1.NewNotificationsService(s),
+		serviceAccounts: storagev1.NewProjectsServiceAccountService(s),
+		topics:          pubsub.NewProjectsTopicsService(ps),
+		projectID:       "cool-project",
+	}
+}
+
+// topicPolicyHandler serves the Cloud Storage service agent and the IAM policy
+// of the notification topic, and passes all other requests to the supplied
+// handler. It records the policies that are set.
+func topicPolicyHandler(t *testing.T, policy *pubsub.Policy, set *[]*pubsub.Policy, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		switch r.URL.Path {
+		case "/projects/cool-project/serviceAccount":
+			_ = json.NewEncoder(w).Encode(&storagev1.ServiceAccount{EmailAddress: testServiceAgent})
+		case "/v1/projects/cool-project/topics/my-topic:getIamPolicy":
+			_ = json.NewEncoder(w).Encode(policy)
+		case "/v1/projects/cool-project/topics/my-topic:setIamPolicy":
+			req := &pubsub.SetIamPolicyRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				t.Error(err)
+			}
+			*set = append(*set, req.Policy)
+			_ = json.NewEncoder(w).Encode(req.Policy)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+func TestBucketNotificationObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotBucketNotification": {
+			mg:   &strange{},
+			want: want{err: errors.New(errNotBucketNotification)},
+		},
+		"NotCreated": {
+			mg: bucketNotification(func(cr *v1alpha1.BucketNotification) { meta.SetExternalName(cr, "") }),
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: bucketNotification(),
+		},
+		"Exists": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/b/"+testBucketName+"/notificationConfigs/"+testNotificationID, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&storagev1.Notification{
+					Id:            testNotificationID,
+					Topic:         testNotificationTopic,
+					EventTypes:    []string{"OBJECT_FINALIZE"},
+					PayloadFormat: v1alpha1.PayloadFormatJSONAPIV1,
+				})
+			}),
+			mg:   bucketNotification(),
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"RequesterPays": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("billing-project", r.URL.Query().Get("userProject")); diff != "" {
+					t.Errorf("r: -want userProject, +got userProject:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&storagev1.Notification{
+					Id:            testNotificationID,
+					Topic:         testNotificationTopic,
+					EventTypes:    []string{"OBJECT_FINALIZE"},
+					PayloadFormat: v1alpha1.PayloadFormatJSONAPIV1,
+				})
+			}),
+			mg: bucketNotification(func(cr *v1alpha1.BucketNotification) {
+				cr.Spec.ForProvider.UserProject = gcp.StringPtr("billing-project")
+			}),
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&storagev1.Notification{
+					Id:               testNotificationID,
+					Topic:            testNotificationTopic,
+					ObjectNamePrefix: "uploads/",
+					PayloadFormat:    v1alpha1.PayloadFormatJSONAPIV1,
+				})
+			}),
+			mg:   bucketNotification(),
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}},
+		},
+		"PublisherGranted": {
+			handler: topicPolicyHandler(t, &pubsub.Policy{Bindings: []*pubsub.Binding{{Role: "roles/pubsub.publisher", Members: []string{"serviceAccount:" + testServiceAgent}}}}, nil,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = json.NewEncoder(w).Encode(&storagev1.Notification{Id: testNotificationID, Topic: testNotificationTopic, PayloadFormat: v1alpha1.PayloadFormatJSONAPIV1})
+				})),
+			mg:   bucketNotification(func(cr *v1alpha1.BucketNotification) { cr.Spec.ForProvider.GrantPublisher = gcp.BoolPtr(true) }),
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"PublisherNotGranted": {
+			handler: topicPolicyHandler(t, &pubsub.Policy{}, nil,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = json.NewEncoder(w).Encode(&storagev1.Notification{Id: testNotificationID, Topic: testNotificationTopic, PayloadFormat: v1alpha1.PayloadFormatJSONAPIV1})
+				})),
+			mg:   bucketNotification(func(cr *v1alpha1.BucketNotification) { cr.Spec.ForProvider.GrantPublisher = gcp.BoolPtr(true) }),
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   bucketNotification(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetBucketNotification)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			e := newBucketNotificationExternal(server.URL)
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBucketNotificationCreate(t *testing.T) {
+	type want struct {
+		c            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotBucketNotification": {
+			mg:   &strange{},
+			want: want{err: errors.New(errNotBucketNotification)},
+		},
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				got := &storagev1.Notification{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(testNotificationTopic, got.Topic); diff != "" {
+					t.Errorf("topic: -want, +got:\n%s", diff)
+				}
+				got.Id = testNotificationID
+				_ = json.NewEncoder(w).Encode(got)
+			}),
+			mg:   bucketNotification(func(cr *v1alpha1.BucketNotification) { meta.SetExternalName(cr, "") }),
+			want: want{c: managed.ExternalCreation{ExternalNameAssigned: true}, externalName: testNotificationID},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   bucketNotification(func(cr *v1alpha1.BucketNotification) { meta.SetExternalName(cr, "") }),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateBucketNotification)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			e := newBucketNotificationExternal(server.URL)
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, got); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+				t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBucketNotificationGrantPublisher(t *testing.T) {
+	member := "serviceAccount:" + testServiceAgent
+	cases := map[string]struct {
+		policy *pubsub.Policy
+		want   []*pubsub.Policy
+	}{
+		"AddBinding": {
+			policy: &pubsub.Policy{Etag: "e", Bindings: []*pubsub.Binding{{Role: "roles/pubsub.viewer", Members: []string{"user:a@example.com"}}}},
+			want: []*pubsub.Policy{{Etag: "e", Bindings: []*pubsub.Binding{
+				{Role: "roles/pubsub.viewer", Members: []string{"user:a@example.com"}},
+				{Role: "roles/pubsub.publisher", Members: []string{member}},
+			}}},
+		},
+		"AddMember": {
+			policy: &pubsub.Policy{Etag: "e", Bindings: []*pubsub.Binding{{Role: "roles/pubsub.publisher", Members: []string{"user:a@example.com"}}}},
+			want: []*pubsub.Policy{{Etag: "e", Bindings: []*pubsub.Binding{
+				{Role: "roles/pubsub.publisher", Members: []string{"user:a@example.com", member}},
+			}}},
+		},
+		"AlreadyGranted": {
+			policy: &pubsub.Policy{Etag: "e", Bindings: []*pubsub.Binding{{Role: "roles/pubsub.publisher", Members: []string{member}}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set []*pubsub.Policy
+			created := false
+			server := httptest.NewServer(topicPolicyHandler(t, tc.policy, &set, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				created = true
+				_ = json.NewEncoder(w).Encode(&storagev1.Notification{Id: testNotificationID})
+			})))
+			defer server.Close()
+			cr := bucketNotification(func(cr *v1alpha1.BucketNotification) {
+				meta.SetExternalName(cr, "")
+				cr.Spec.ForProvider.GrantPublisher = gcp.BoolPtr(true)
+			})
+			if _, err := newBucketNotificationExternal(server.URL).Create(context.Background(), cr); err != nil {
+				t.Fatalf("Create(...): unexpected error: %s", err)
+			}
+			if !created {
+				t.Errorf("Create(...): want notification configuration to be created")
+			}
+			if diff := cmp.Diff(tc.want, set); diff != "" {
+				t.Errorf("Create(...): -want policies set, +got policies set:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBucketNotificationDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotBucketNotification": {
+			mg:   &strange{},
+			want: errors.New(errNotBucketNotification),
+		},
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+			mg: bucketNotification(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: bucketNotification(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   bucketNotification(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteBucketNotification),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			e := newBucketNotificationExternal(server.URL)
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
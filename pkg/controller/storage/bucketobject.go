@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/storage/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketobject"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotBucketObject        = "managed resource is not a GCP BucketObject"
+	errGetBucketObject        = "cannot get GCP bucket object"
+	errUploadBucketObject     = "cannot upload GCP bucket object"
+	errPatchBucketObject      = "cannot update metadata of GCP bucket object"
+	errDeleteBucketObject     = "cannot delete GCP bucket object"
+	errGetObjectContent       = "cannot get content of GCP bucket object"
+	errKubeUpdateBucketObject = "cannot update BucketObject custom resource"
+)
+
+// SetupBucketObject adds a controller that reconciles BucketObjects.
+func SetupBucketObject(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BucketObjectGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BucketObjectGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &bucketObjectConnecter{client: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.BucketObject{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type bucketObjectConnecter struct {
+	client client.Client
+}
+
+// Connect sets up storage client using credentials from the provider
+func (c *bucketObjectConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := storage.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &bucketObjectExternal{kube: c.client, objects: storage.NewObjectsService(s)}, nil
+}
+
+type bucketObjectExternal struct {
+	kube    client.Client
+	objects bucketobject.Client
+}
+
+func (e *bucketObjectExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BucketObject)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBucketObject)
+	}
+
+	o, err := e.objects.Get(gcp.StringValue(cr.Spec.ForProvider.Bucket), meta.GetExternalName(cr)).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBucketObject)
+	}
+
+	content, err := bucketobject.GetContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetObjectContent)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	bucketobject.LateInitializeSpec(&cr.Spec.ForProvider, *o)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateBucketObject)
+		}
+	}
+
+	cr.Status.AtProvider = bucketobject.GenerateObservation(*o)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: bucketobject.IsContentUpToDate(content, *o) && bucketobject.IsUpToDate(cr.Spec.ForProvider, *o),
+	}, nil
+}
+
+func (e *bucketObjectExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BucketObject)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBucketObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	content, err := bucketobject.GetContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetObjectContent)
+	}
+	return managed.ExternalCreation{}, e.upload(ctx, cr, content)
+}
+
+func (e *bucketObjectExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BucketObject)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBucketObject)
+	}
+
+	content, err := bucketobject.GetContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetObjectContent)
+	}
+
+	// A new upload replaces both the content and the metadata of the object.
+	if cr.Status.AtProvider.MD5Hash != bucketobject.MD5Hash(content) {
+		return managed.ExternalUpdate{}, e.upload(ctx, cr, content)
+	}
+
+	o := bucketobject.GenerateObject(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err = e.objects.Patch(gcp.StringValue(cr.Spec.ForProvider.Bucket), meta.GetExternalName(cr), o).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errPatchBucketObject)
+}
+
+func (e *bucketObjectExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BucketObject)
+	if !ok {
+		return errors.New(errNotBucketObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	err := e.objects.Delete(gcp.StringValue(cr.Spec.ForProvider.Bucket), meta.GetExternalName(cr)).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteBucketObject)
+}
+
+// upload writes the supplied content and the metadata of the supplied
+// BucketObject.
+func (e *bucketObjectExternal) upload(ctx context.Context, cr *v1alpha1.BucketObject, content []byte) error {
+	o := bucketobject.GenerateObject(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.objects.Insert(gcp.StringValue(cr.Spec.ForProvider.Bucket), o).Media(bytes.NewReader(content)).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	return errors.Wrap(err, errUploadBucketObject)
+}
@@ -0,0 +1,378 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	storagev1 "google.golang.org/api/storage/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcpv1beta1 "github.com/crossplane-contrib/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketobject"
+)
+
+const (
+	testObjectName    = "startup.sh"
+	testObjectContent = "#!/bin/sh\necho hello\n"
+)
+
+var _ gcpv1beta1.LastOperationRecorder = &v1alpha1.BucketObject{}
+var _ gcpv1beta1.FailureReasonRecorder = &v1alpha1.BucketObject{}
+
+var testObjectPath = "/b/" + testBucketName + "/o/" + testObjectName
+
+func bucketObject(m ...func(*v1alpha1.BucketObject)) *v1alpha1.BucketObject {
+	cr := &v1alpha1.BucketObject{}
+	cr.Spec.ForProvider = v1alpha1.BucketObjectParameters{
+		Bucket:      gcp.StringPtr(testBucketName),
+		Content:     gcp.StringPtr(testObjectContent),
+		ContentType: gcp.StringPtr("text/x-sh"),
+	}
+	meta.SetExternalName(cr, testObjectName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestBucketObjectObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotBucketObject": {
+			mg:   &strange{},
+			want: want{err: errors.New(errNotBucketObject)},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: bucketObject(),
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testObjectPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&storagev1.Object{
+					Name:        testObjectName,
+					ContentType: "text/x-sh",
+					Md5Hash:     bucketobject.MD5Hash([]byte(testObjectContent)),
+				})
+			}),
+			mg: bucketObject(),
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+			}},
+		},
+		"RequesterPays": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("billing-project", r.URL.Query().Get("userProject")); diff != "" {
+					t.Errorf("r: -want userProject, +got userProject:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&storagev1.Object{
+					Name:        testObjectName,
+					ContentType: "text/x-sh",
+					Md5Hash:     bucketobject.MD5Hash([]byte(testObjectContent)),
+				})
+			}),
+			mg: bucketObject(func(cr *v1alpha1.BucketObject) {
+				cr.Spec.ForProvider.UserProject = gcp.StringPtr("billing-project")
+			}),
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+			}},
+		},
+		"ContentChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&storagev1.Object{
+					Name:        testObjectName,
+					ContentType: "text/x-sh",
+					Md5Hash:     bucketobject.MD5Hash([]byte("old")),
+				})
+			}),
+			mg: bucketObject(),
+			want: want{o: managed.ExternalObservation{
+				ResourceExists: true,
+			}},
+		},
+		"ContentFromSecret": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&storagev1.Object{
+					Name:        testObjectName,
+					ContentType: "text/x-sh",
+					Md5Hash:     bucketobject.MD5Hash([]byte("secret-content")),
+				})
+			}),
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"script": []byte("secret-content")}
+					return nil
+				},
+			},
+			mg: bucketObject(func(cr *v1alpha1.BucketObject) {
+				cr.Spec.ForProvider.Content = nil
+				cr.Spec.ForProvider.ContentFrom = &v1alpha1.BucketObjectContentSource{
+					SecretKeyRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "scripts", Namespace: "default"},
+						Key:             "script",
+					},
+				}
+			}),
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+			}},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   bucketObject(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetBucketObject)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &bucketObjectExternal{kube: tc.kube, objects: storagev1.NewObjectsService(s)}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// uploadHandler expects the content of the object to be uploaded.
+func uploadHandler(t *testing.T, content string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want method, +got method:\n%s", diff)
+		}
+		if !strings.HasPrefix(r.URL.Path, "/upload/") {
+			t.Errorf("r: unexpected path %q", r.URL.Path)
+		}
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		if !strings.Contains(string(b), content) {
+			t.Errorf("r: upload does not contain %q", content)
+		}
+		_ = json.NewEncoder(w).Encode(&storagev1.Object{Name: testObjectName})
+	})
+}
+
+func TestBucketObjectCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotBucketObject": {
+			mg:   &strange{},
+			want: errors.New(errNotBucketObject),
+		},
+		"Uploaded": {
+			handler: uploadHandler(t, testObjectContent),
+			mg:      bucketObject(),
+		},
+		"NoContent": {
+			mg:   bucketObject(func(cr *v1alpha1.BucketObject) { cr.Spec.ForProvider.Content = nil }),
+			want: errors.Wrap(errors.New("exactly one of content and contentFrom must be set"), errGetObjectContent),
+		},
+		"UploadFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   bucketObject(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUploadBucketObject),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &bucketObjectExternal{objects: storagev1.NewObjectsService(s)}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBucketObjectUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotBucketObject": {
+			mg:   &strange{},
+			want: errors.New(errNotBucketObject),
+		},
+		"ContentChanged": {
+			handler: uploadHandler(t, testObjectContent),
+			mg: bucketObject(func(cr *v1alpha1.BucketObject) {
+				cr.Status.AtProvider.MD5Hash = bucketobject.MD5Hash([]byte("old"))
+			}),
+		},
+		"MetadataChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(testObjectPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				got := &storagev1.Object{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff("text/x-sh", got.ContentType); diff != "" {
+					t.Errorf("contentType: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(got)
+			}),
+			mg: bucketObject(func(cr *v1alpha1.BucketObject) {
+				cr.Status.AtProvider.MD5Hash = bucketobject.MD5Hash([]byte(testObjectContent))
+			}),
+		},
+		"PatchFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg: bucketObject(func(cr *v1alpha1.BucketObject) {
+				cr.Status.AtProvider.MD5Hash = bucketobject.MD5Hash([]byte(testObjectContent))
+			}),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errPatchBucketObject),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &bucketObjectExternal{objects: storagev1.NewObjectsService(s)}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBucketObjectDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotBucketObject": {
+			mg:   &strange{},
+			want: errors.New(errNotBucketObject),
+		},
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+			mg: bucketObject(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: bucketObject(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   bucketObject(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteBucketObject),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &bucketObjectExternal{objects: storagev1.NewObjectsService(s)}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
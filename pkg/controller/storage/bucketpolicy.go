@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"google.golang.org/api/storage/v1"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -36,7 +38,15 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicaccess"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/takeover"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -45,6 +55,8 @@ const (
 	errCheckUpToDate   = "cannot determine if BucketPolicy instance is up to date"
 	errGetPolicy       = "cannot get GCP BucketPolicy object via Storage API"
 	errSetPolicy       = "cannot set GCP BucketPolicy object via Storage API"
+	errInvalidBindings = "invalid BucketPolicy bindings"
+	errTakeover        = "cannot set BucketPolicy"
 )
 
 // SetupBucketPolicy adds a controller that reconciles BucketPolicys.
@@ -58,7 +70,8 @@ func SetupBucketPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.BucketPolicyGroupVersionKind),
-		managed.WithExternalConnecter(&bucketPolicyConnecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, consistency.WrapConnecter(&bucketPolicyConnecter{client: mgr.GetClient()})))))),
+		managed.WithCreationGracePeriod(consistency.GracePeriod()),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -67,7 +80,7 @@ func SetupBucketPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.BucketPolicy{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -100,7 +113,15 @@ func (e *bucketPolicyExternal) Observe(ctx context.Context, mg resource.Managed)
 		return managed.ExternalObservation{}, errors.New(errNotBucketPolicy)
 	}
 
-	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
+	// Malformed bindings are rejected by SetIamPolicy, so they are reported
+	// before the policy is read rather than on every attempt to set it. They
+	// must not block the deletion of a BucketPolicy though.
+	if err := bucketpolicy.ValidateBindings(cr.Spec.ForProvider); err != nil && !meta.WasDeleted(cr) {
+		cr.SetConditions(bucketpolicy.InvalidBindings(err))
+		return managed.ExternalObservation{}, errors.Wrap(err, errInvalidBindings)
+	}
+
+	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
 	}
@@ -112,9 +133,25 @@ func (e *bucketPolicyExternal) Observe(ctx context.Context, mg resource.Managed)
 	if upToDate, err := bucketpolicy.IsUpToDate(&cr.Spec.ForProvider, instance); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	} else if !upToDate {
+		// An Authoritative BucketPolicy must not silently replace a policy
+		// that it does not own yet, lest it lock users out of the bucket.
+		// A deleted BucketPolicy only removes its own bindings, so it never
+		// takes over a policy.
+		if !meta.WasDeleted(cr) {
+			if err := e.checkTakeover(cr, instance); err != nil {
+				return managed.ExternalObservation{}, err
+			}
+		}
+		// A bucket always has an IAM policy, so the BucketPolicy is
+		// considered to exist only while any of its bindings are in place.
+		// Otherwise a deleted BucketPolicy would never be observed as gone.
+		if !bucketpolicy.HasBindings(cr.Spec.ForProvider, instance) {
+			return managed.ExternalObservation{}, nil
+		}
 		return managed.ExternalObservation{ResourceExists: true}, nil
 	}
 
+	cr.Status.AtProvider.PolicyOwned = true
 	cr.Status.SetConditions(xpv1.Available())
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -127,14 +164,24 @@ func (e *bucketPolicyExternal) Create(ctx context.Context, mg resource.Managed)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotBucketPolicy)
 	}
+	if err := publicaccess.Check(cr, publicaccess.Members(cr.Spec.ForProvider.Policy)...); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	cr.SetConditions(xpv1.Creating())
+	// Bindings managed elsewhere must survive a merge, so the live policy is
+	// read and modified rather than replaced.
+	if bucketpolicy.IsMerge(cr.Spec.ForProvider) {
+		_, err := e.Update(ctx, mg)
+		return managed.ExternalCreation{}, err
+	}
 	instance := &storage.Policy{}
 	bucketpolicy.GenerateBucketPolicyInstance(cr.Spec.ForProvider, instance)
 
 	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).
-		Context(ctx).Do(); err != nil {
+		Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errSetPolicy)
 	}
+	cr.Status.AtProvider.PolicyOwned = true
 
 	return managed.ExternalCreation{}, nil
 }
@@ -144,26 +191,49 @@ func (e *bucketPolicyExternal) Update(ctx context.Context, mg resource.Managed)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotBucketPolicy)
 	}
-	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPolicy)
+	if err := publicaccess.Check(cr, publicaccess.Members(cr.Spec.ForProvider.Policy)...); err != nil {
+		return managed.ExternalUpdate{}, err
 	}
+	// The policy is set with the etag it was read with, so a concurrent
+	// change makes SetIamPolicy fail. Re-read and retry in that case.
+	err := retry.OnError(bucketpolicy.ConflictBackoff, bucketpolicy.IsErrorConflict, func() error {
+		instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
 
-	u, err := bucketpolicy.IsUpToDate(&cr.Spec.ForProvider, instance)
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckUpToDate)
-	}
-	if u {
-		return managed.ExternalUpdate{}, nil
-	}
+		u, err := bucketpolicy.IsUpToDate(&cr.Spec.ForProvider, instance)
+		if err != nil {
+			return errors.Wrap(err, errCheckUpToDate)
+		}
+		if u {
+			return nil
+		}
 
-	bucketpolicy.GenerateBucketPolicyInstance(cr.Spec.ForProvider, instance)
-	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).
-		Context(ctx).Do(); err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errSetPolicy)
+		bucketpolicy.GenerateBucketPolicyInstance(cr.Spec.ForProvider, instance)
+		_, err = e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+		return errors.Wrap(err, errSetPolicy)
+	})
+	if err == nil {
+		cr.Status.AtProvider.PolicyOwned = true
 	}
+	return managed.ExternalUpdate{}, err
+}
 
-	return managed.ExternalUpdate{}, nil
+// checkTakeover returns an error and holds the supplied BucketPolicy in a
+// non-ready state if setting it would remove bindings of an existing policy
+// it does not own, unless it allows the takeover of that policy.
+func (e *bucketPolicyExternal) checkTakeover(cr *v1alpha1.BucketPolicy, instance *storage.Policy) error {
+	p := cr.Spec.ForProvider
+	if p.AllowPolicyTakeover || bucketpolicy.IsMerge(p) || takeover.IsOwned(cr.Status.AtProvider.PolicyOwned, cr) {
+		return nil
+	}
+	removed := takeover.Removed(bucketpolicy.Bindings(instance), p.Policy)
+	if len(removed) == 0 {
+		return nil
+	}
+	cr.SetConditions(takeover.Blocked(removed))
+	return errors.Wrap(takeover.NotAllowed(removed), errTakeover)
 }
 
 func (e *bucketPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -171,9 +241,17 @@ func (e *bucketPolicyExternal) Delete(ctx context.Context, mg resource.Managed)
 	if !ok {
 		return errors.New(errNotBucketPolicy)
 	}
-	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), &storage.Policy{}).
-		Context(ctx).Do(); err != nil {
+	// Only the bindings declared in the spec are removed so that bindings
+	// managed by others survive the deletion of this BucketPolicy.
+	return retry.OnError(bucketpolicy.ConflictBackoff, bucketpolicy.IsErrorConflict, func() error {
+		instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+		if err != nil {
+			return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+		}
+		if !bucketpolicy.RemoveBindings(cr.Spec.ForProvider, instance) {
+			return nil
+		}
+		_, err = e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
 		return errors.Wrap(err, errSetPolicy)
-	}
-	return nil
+	})
 }
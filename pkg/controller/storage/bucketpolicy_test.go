@@ -21,10 +21,12 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	iamv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/iam/v1alpha1"
 	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/takeover"
 )
 
 const (
@@ -37,6 +39,8 @@ var (
 
 	testMember = "serviceAccount:perfect-test-sa@my-project.iam.gserviceaccount.com"
 	testRole   = "roles/crossplane.unitTester"
+
+	errInvalidRole = errors.New(`binding 1: role "storage.objectViewer" must be roles/*, projects/*/roles/* or organizations/*/roles/*`)
 )
 
 type strange struct {
@@ -69,6 +73,22 @@ func bpWithCondition(condition xpv1.Condition) bpValueModifier {
 	return func(i *v1alpha1.BucketPolicy) { i.SetConditions(condition) }
 }
 
+func bpWithUserProject(p string) bpValueModifier {
+	return func(bp *v1alpha1.BucketPolicy) { bp.Spec.ForProvider.UserProject = &p }
+}
+
+func bpWithMode(m string) bpValueModifier {
+	return func(bp *v1alpha1.BucketPolicy) { bp.Spec.ForProvider.Mode = &m }
+}
+
+func bpWithPolicyOwned() bpValueModifier {
+	return func(i *v1alpha1.BucketPolicy) { i.Status.AtProvider.PolicyOwned = true }
+}
+
+func bpWithDeletionTimestamp(ts metav1.Time) bpValueModifier {
+	return func(i *v1alpha1.BucketPolicy) { i.SetDeletionTimestamp(&ts) }
+}
+
 func bpWithBinding(binding *iamv1alpha1.Binding) bpValueModifier {
 	return func(i *v1alpha1.BucketPolicy) {
 		i.Spec.ForProvider.Policy.Bindings = append(i.Spec.ForProvider.Policy.Bindings, binding)
@@ -104,6 +124,8 @@ func BucketPolicy(im ...bpValueModifier) *v1alpha1.BucketPolicy {
 }
 
 func TestBucketPolicyObserve(t *testing.T) {
+	deleted := metav1.Now()
+
 	type args struct {
 		ctx context.Context
 		mg  resource.Managed
@@ -128,6 +150,23 @@ func TestBucketPolicyObserve(t *testing.T) {
 				err: errors.New(errNotBucketPolicy),
 			},
 		},
+		"InvalidBindings": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithBinding(&iamv1alpha1.Binding{Role: "storage.objectViewer", Members: []string{"allUsers"}}),
+				),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithBinding(&iamv1alpha1.Binding{Role: "storage.objectViewer", Members: []string{"allUsers"}}),
+					bpWithCondition(bucketpolicy.InvalidBindings(errInvalidRole))),
+				err: errors.Wrap(errInvalidRole, errInvalidBindings),
+			},
+		},
 		"FailedToObserve": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusInternalServerError)
@@ -168,12 +207,36 @@ func TestBucketPolicyObserve(t *testing.T) {
 				observation: managed.ExternalObservation{},
 			},
 		},
+		"RequesterPays": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("billing-project", r.URL.Query().Get("userProject")); diff != "" {
+					t.Errorf("r: -want userProject, +got userProject:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(&storagev1.Policy{}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithUserProject("billing-project"),
+				),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithUserProject("billing-project")),
+				observation: managed.ExternalObservation{},
+			},
+		},
 		"ObservedPolicyNeedsUpdate": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				bp := &storagev1.Policy{
 					Bindings: []*storagev1.PolicyBindings{
 						{
-							Members: []string{"some-other-member"},
+							Members: []string{testMember, "some-other-member"},
 							Role:    testRole,
 						},
 					},
@@ -187,16 +250,191 @@ func TestBucketPolicyObserve(t *testing.T) {
 				ctx: context.Background(),
 				mg: BucketPolicy(
 					bpWithName(bpMetadataName),
+					bpWithPolicyOwned(),
 				),
 			},
 			want: want{
 				mg: BucketPolicy(
-					bpWithName(bpMetadataName)),
+					bpWithName(bpMetadataName),
+					bpWithPolicyOwned()),
 				observation: managed.ExternalObservation{
 					ResourceExists: true,
 				},
 			},
 		},
+		"TakeoverNotAllowed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bp := &storagev1.Policy{
+					Bindings: []*storagev1.PolicyBindings{
+						{
+							Members: []string{"projectOwner:my-project", "user:someone@example.com"},
+							Role:    "roles/storage.legacyBucketOwner",
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(bp); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+				),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithCondition(takeover.Blocked([]string{"roles/storage.legacyBucketOwner user:someone@example.com"}))),
+				err: errors.Wrap(takeover.NotAllowed([]string{"roles/storage.legacyBucketOwner user:someone@example.com"}), errTakeover),
+			},
+		},
+		"NewBucketIsNotATakeover": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bp := &storagev1.Policy{
+					Bindings: []*storagev1.PolicyBindings{
+						{
+							Members: []string{"projectOwner:my-project", "projectEditor:my-project"},
+							Role:    "roles/storage.legacyBucketOwner",
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(bp); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+				),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName)),
+				observation: managed.ExternalObservation{},
+			},
+		},
+		"MergeIsNotATakeover": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bp := &storagev1.Policy{
+					Bindings: []*storagev1.PolicyBindings{
+						{
+							Members: []string{"user:someone@example.com"},
+							Role:    "roles/storage.legacyBucketOwner",
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(bp); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithMode(v1alpha1.PolicyModeMerge),
+				),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithMode(v1alpha1.PolicyModeMerge)),
+				observation: managed.ExternalObservation{},
+			},
+		},
+		"DeclaredBindingsRemoved": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bp := &storagev1.Policy{
+					Bindings: []*storagev1.PolicyBindings{
+						{
+							Members: []string{"projectOwner:my-project", "projectEditor:my-project"},
+							Role:    "roles/storage.legacyBucketOwner",
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(bp); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithPolicyOwned(),
+				),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithPolicyOwned()),
+				observation: managed.ExternalObservation{},
+			},
+		},
+		"DeletedMergeBindingsRemoved": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bp := &storagev1.Policy{
+					Bindings: []*storagev1.PolicyBindings{
+						{
+							Members: []string{"user:someone@example.com"},
+							Role:    testRole,
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(bp); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithMode(v1alpha1.PolicyModeMerge),
+					bpWithDeletionTimestamp(deleted),
+				),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithMode(v1alpha1.PolicyModeMerge),
+					bpWithDeletionTimestamp(deleted)),
+				observation: managed.ExternalObservation{},
+			},
+		},
+		"DeletedIsNotATakeover": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bp := &storagev1.Policy{
+					Bindings: []*storagev1.PolicyBindings{
+						{
+							Members: []string{testMember, "user:someone@example.com"},
+							Role:    testRole,
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(bp); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithDeletionTimestamp(deleted),
+				),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithDeletionTimestamp(deleted)),
+				observation: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
 		"ObservedPolicyUpToDate": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
@@ -229,6 +467,7 @@ func TestBucketPolicyObserve(t *testing.T) {
 			},
 			want: want{
 				mg: BucketPolicy(
+					bpWithPolicyOwned(),
 					bpWithCondition(xpv1.Available()),
 					bpWithName(bpMetadataName)),
 				observation: managed.ExternalObservation{
@@ -266,7 +505,7 @@ func TestBucketPolicyObserve(t *testing.T) {
 			if diff := cmp.Diff(tc.want.observation, obs); diff != "" {
 				t.Errorf("Observe(...): -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
 				t.Errorf("Observe(...): -want, +got:\n%s", diff)
 			}
 		})
@@ -343,11 +582,54 @@ func TestBucketPolicyCreate(t *testing.T) {
 			},
 			want: want{
 				mg: BucketPolicy(
+					bpWithPolicyOwned(),
 					bpWithName(bpMetadataName),
 					bpWithExternalNameAnnotation(bpMetadataName),
 					bpWithCondition(xpv1.Creating())),
 			},
 		},
+		"CreateMergeKeepsForeignBindings": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				foreign := &storagev1.PolicyBindings{
+					Members: []string{"projectOwner:my-project"},
+					Role:    "roles/storage.legacyBucketOwner",
+				}
+				exp := &storagev1.Policy{Bindings: []*storagev1.PolicyBindings{foreign}}
+				switch r.Method {
+				case http.MethodGet:
+				case http.MethodPut:
+					i := &storagev1.Policy{}
+					if err := json.NewDecoder(r.Body).Decode(i); err != nil {
+						t.Error(err)
+					}
+					exp.Bindings = append(exp.Bindings, &storagev1.PolicyBindings{Members: []string{testMember}, Role: testRole})
+					if !bucketpolicy.ArePoliciesSame(exp, i) {
+						t.Errorf("policy in setIamPolicyRequest not equal to expected, diff: %s", cmp.Diff(exp, i, cmpopts.IgnoreFields(storagev1.Policy{}, "Version")))
+					}
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(exp); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithMode(v1alpha1.PolicyModeMerge)),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithPolicyOwned(),
+					bpWithName(bpMetadataName),
+					bpWithMode(v1alpha1.PolicyModeMerge),
+					bpWithCondition(xpv1.Creating())),
+			},
+		},
 		"CreateFailed": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusInternalServerError)
@@ -428,6 +710,59 @@ func TestBucketPolicyUpdate(t *testing.T) {
 				err: errors.New(errNotBucketPolicy),
 			},
 		},
+		"RetryOnConcurrentModification": {
+			handler: func() http.Handler {
+				puts := 0
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					defer r.Body.Close()
+					bp := &storagev1.Policy{Etag: fmt.Sprintf("etag-%d", puts)}
+					switch r.Method {
+					case http.MethodGet:
+						w.WriteHeader(http.StatusOK)
+					case http.MethodPut:
+						i := &storagev1.Policy{}
+						if err := json.NewDecoder(r.Body).Decode(i); err != nil {
+							t.Error(err)
+						}
+						if diff := cmp.Diff(bp.Etag, i.Etag); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						puts++
+						if puts == 1 {
+							// Someone else set the policy since it was read.
+							w.WriteHeader(http.StatusPreconditionFailed)
+							break
+						}
+						w.WriteHeader(http.StatusOK)
+					default:
+						w.WriteHeader(http.StatusBadRequest)
+					}
+					if err := json.NewEncoder(w).Encode(bp); err != nil {
+						t.Error(err)
+					}
+				})
+			}(),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName),
+					bpWithBinding(&iamv1alpha1.Binding{
+						Members: []string{testMember},
+						Role:    testRole,
+					})),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithPolicyOwned(),
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName),
+					bpWithBinding(&iamv1alpha1.Binding{
+						Members: []string{testMember},
+						Role:    testRole,
+					})),
+			},
+		},
 		"UpdateSucceeded": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				var bp *storagev1.Policy
@@ -490,6 +825,7 @@ func TestBucketPolicyUpdate(t *testing.T) {
 			},
 			want: want{
 				mg: BucketPolicy(
+					bpWithPolicyOwned(),
 					bpWithName(bpMetadataName),
 					bpWithExternalNameAnnotation(bpMetadataName),
 					bpWithCondition(xpv1.Available()),
@@ -543,6 +879,7 @@ func TestBucketPolicyUpdate(t *testing.T) {
 			},
 			want: want{
 				mg: BucketPolicy(
+					bpWithPolicyOwned(),
 					bpWithName(bpMetadataName),
 					bpWithExternalNameAnnotation(bpMetadataName),
 					bpWithCondition(xpv1.Available())),
@@ -657,30 +994,111 @@ func TestBucketPolicyDelete(t *testing.T) {
 		"DeleteSucceeded": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				defer r.Body.Close()
-				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
-					t.Errorf("r: -want, +got:\n%s", diff)
-				}
 				// https://cloud.google.com/storage/docs/json_api/v1/buckets/setIamPolicy
 				expectedEp := fmt.Sprintf("/b/%s/iam", testBucketName)
 				if !strings.EqualFold(r.URL.Path, expectedEp) {
 					t.Errorf("requested URL.Path to get policy should end with: %s, got %s instead",
 						expectedEp, r.URL.Path)
 				}
-				i := &storagev1.Policy{}
-				b, err := ioutil.ReadAll(r.Body)
-				if diff := cmp.Diff(err, nil); diff != "" {
-					t.Errorf("r: -want, +got:\n%s", diff)
+				var bp *storagev1.Policy
+				switch r.Method {
+				case http.MethodGet:
+					bp = &storagev1.Policy{
+						Bindings: []*storagev1.PolicyBindings{
+							{
+								Members: []string{testMember, "another-member"},
+								Role:    testRole,
+							},
+							{
+								Members: []string{"another-member"},
+								Role:    "another-role",
+							},
+						},
+					}
+				case http.MethodPut:
+					i := &storagev1.Policy{}
+					if err := json.NewDecoder(r.Body).Decode(i); err != nil {
+						t.Error(err)
+					}
+					bp = &storagev1.Policy{
+						Bindings: []*storagev1.PolicyBindings{
+							{
+								Members: []string{"another-member"},
+								Role:    "another-role",
+							},
+						},
+					}
+					if !bucketpolicy.ArePoliciesSame(bp, i) {
+						t.Errorf("policy in setIamPolicyRequest not equal to expected, diff: %s", cmp.Diff(bp, i, cmpopts.IgnoreFields(storagev1.Policy{}, "Version")))
+					}
 				}
-				err = json.Unmarshal(b, i)
-				if diff := cmp.Diff(err, nil); diff != "" {
-					t.Errorf("r: -want, +got:\n%s", diff)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(bp); err != nil {
+					t.Error(err)
 				}
-				exp := &storagev1.Policy{}
-				if !bucketpolicy.ArePoliciesSame(exp, i) {
-					t.Errorf("policy in setIamPolicyRequest not equal to expected, diff: %s", cmp.Diff(exp, i, cmpopts.IgnoreFields(storagev1.Policy{}, "Version")))
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName),
+					bpWithBinding(&iamv1alpha1.Binding{
+						Members: []string{testMember, "another-member"},
+						Role:    testRole,
+					})),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName),
+					bpWithBinding(&iamv1alpha1.Binding{
+						Members: []string{testMember, "another-member"},
+						Role:    testRole,
+					})),
+			},
+		},
+		"NothingToRemove": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
 				}
 				w.WriteHeader(http.StatusOK)
-				if err := json.NewEncoder(w).Encode(exp); err != nil {
+				if err := json.NewEncoder(w).Encode(&storagev1.Policy{
+					Bindings: []*storagev1.PolicyBindings{
+						{
+							Members: []string{"another-member"},
+							Role:    "another-role",
+						},
+					},
+				}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName),
+					bpWithBinding(&iamv1alpha1.Binding{
+						Members: []string{testMember},
+						Role:    testRole,
+					})),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName),
+					bpWithBinding(&iamv1alpha1.Binding{
+						Members: []string{testMember},
+						Role:    testRole,
+					})),
+			},
+		},
+		"BucketGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				if err := json.NewEncoder(w).Encode(&storagev1.Policy{}); err != nil {
 					t.Error(err)
 				}
 			}),
@@ -696,7 +1114,7 @@ func TestBucketPolicyDelete(t *testing.T) {
 					bpWithExternalNameAnnotation(bpMetadataName)),
 			},
 		},
-		"CreateFailed": {
+		"GetFailed": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusInternalServerError)
 				if err := json.NewEncoder(w).Encode(&storagev1.Policy{}); err != nil {
@@ -713,6 +1131,41 @@ func TestBucketPolicyDelete(t *testing.T) {
 				mg: BucketPolicy(
 					bpWithName(bpMetadataName),
 					bpWithExternalNameAnnotation(bpMetadataName)),
+				err: errors.Wrap(gError(http.StatusInternalServerError, "{}\n"), errGetPolicy),
+			},
+		},
+		"SetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				bp := &storagev1.Policy{}
+				if r.Method == http.MethodGet {
+					bp.Bindings = []*storagev1.PolicyBindings{{Members: []string{testMember}, Role: testRole}}
+					w.WriteHeader(http.StatusOK)
+				} else {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+				if err := json.NewEncoder(w).Encode(bp); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName),
+					bpWithBinding(&iamv1alpha1.Binding{
+						Members: []string{testMember},
+						Role:    testRole,
+					})),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName),
+					bpWithBinding(&iamv1alpha1.Binding{
+						Members: []string{testMember},
+						Role:    testRole,
+					})),
 				err: errors.Wrap(gError(http.StatusInternalServerError, "{}\n"), errSetPolicy),
 			},
 		},
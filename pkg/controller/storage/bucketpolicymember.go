@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"google.golang.org/api/storage/v1"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,7 +37,14 @@ import (
 	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
 	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
 	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/consistency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicaccess"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
 	"github.com/crossplane-contrib/provider-gcp/pkg/features"
 )
 
@@ -55,7 +63,8 @@ func SetupBucketPolicyMember(mgr ctrl.Manager, o controller.Options) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.BucketPolicyMemberGroupVersionKind),
-		managed.WithExternalConnecter(&bucketPolicyMemberConnecter{client: mgr.GetClient()}),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, consistency.WrapConnecter(&bucketPolicyMemberConnecter{client: mgr.GetClient()})))))),
+		managed.WithCreationGracePeriod(consistency.GracePeriod()),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -63,7 +72,7 @@ func SetupBucketPolicyMember(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
 		For(&v1alpha1.BucketPolicyMember{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
@@ -96,21 +105,33 @@ func (e *bucketPolicyMemberExternal) Observe(ctx context.Context, mg resource.Ma
 		return managed.ExternalObservation{}, errors.New(errNotBucketPolicyMember)
 	}
 
-	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
+	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
 	}
 
-	changed := bucketpolicy.BindRoleToMember(cr.Spec.ForProvider, instance)
-	if !changed {
-		cr.Status.SetConditions(xpv1.Available())
-		return managed.ExternalObservation{
-			ResourceExists:   true,
-			ResourceUpToDate: true,
-		}, nil
+	// The BucketPolicyMember exists while the role is bound to any of its
+	// declared or recorded members, so that deleting a partially bound
+	// BucketPolicyMember still unbinds the members that are bound.
+	owned := bucketpolicy.OwnedMembers(cr.Spec.ForProvider, cr.Status.AtProvider.BoundMembers)
+	if !bucketpolicy.IsRoleBound(cr.Spec.ForProvider, owned, instance) {
+		return managed.ExternalObservation{}, nil
 	}
+	if bucketpolicy.BindRoleToMember(cr.Spec.ForProvider, instance) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	cr.Status.SetConditions(xpv1.Available())
 
-	return managed.ExternalObservation{}, nil
+	// Members that were removed from the spec are still bound until they
+	// are unbound by an update.
+	if len(bucketpolicy.StaleMembers(cr.Spec.ForProvider, cr.Status.AtProvider.BoundMembers)) > 0 {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+	cr.Status.AtProvider.BoundMembers = bucketpolicy.Members(cr.Spec.ForProvider)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
 }
 
 func (e *bucketPolicyMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -118,21 +139,17 @@ func (e *bucketPolicyMemberExternal) Create(ctx context.Context, mg resource.Man
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotBucketPolicyMember)
 	}
-	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errGetPolicy)
+	if err := publicaccess.Check(cr, bucketpolicy.Members(cr.Spec.ForProvider)...); err != nil {
+		return managed.ExternalCreation{}, err
 	}
-
-	changed := bucketpolicy.BindRoleToMember(cr.Spec.ForProvider, instance)
-	if !changed {
-		return managed.ExternalCreation{}, nil
+	stale := bucketpolicy.StaleMembers(cr.Spec.ForProvider, cr.Status.AtProvider.BoundMembers)
+	if err := e.modifyPolicy(ctx, gcp.StringValue(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.UserProject, func(p *storage.Policy) bool {
+		unbound := bucketpolicy.UnbindRoleFromMembers(cr.Spec.ForProvider, stale, p)
+		return bucketpolicy.BindRoleToMember(cr.Spec.ForProvider, p) || unbound
+	}); err != nil {
+		return managed.ExternalCreation{}, err
 	}
-
-	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).
-		Context(ctx).Do(); err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errSetPolicy)
-	}
-
+	cr.Status.AtProvider.BoundMembers = bucketpolicy.Members(cr.Spec.ForProvider)
 	return managed.ExternalCreation{}, nil
 }
 
@@ -146,19 +163,29 @@ func (e *bucketPolicyMemberExternal) Delete(ctx context.Context, mg resource.Man
 	if !ok {
 		return errors.New(errNotBucketPolicyMember)
 	}
-	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
-	if err != nil {
-		return errors.Wrap(err, errGetPolicy)
-	}
+	// Members that were removed from the spec but not unbound yet are
+	// unbound too.
+	owned := bucketpolicy.OwnedMembers(cr.Spec.ForProvider, cr.Status.AtProvider.BoundMembers)
+	return e.modifyPolicy(ctx, gcp.StringValue(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.UserProject, func(p *storage.Policy) bool {
+		return bucketpolicy.UnbindRoleFromMembers(cr.Spec.ForProvider, owned, p)
+	})
+}
 
-	changed := bucketpolicy.UnbindRoleFromMember(cr.Spec.ForProvider, instance)
-	if !changed {
-		return nil
-	}
-	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).
-		Context(ctx).Do(); err != nil {
+// modifyPolicy reads the IAM policy of the supplied bucket, applies fn to it
+// and sets it if fn reports a change. Requests are billed to userProject, if
+// it is set. The policy is set with the etag it was
+// read with, so a concurrent change makes SetIamPolicy fail; the whole
+// read-modify-write is retried in that case.
+func (e *bucketPolicyMemberExternal) modifyPolicy(ctx context.Context, bucket string, userProject *string, fn func(*storage.Policy) bool) error {
+	return retry.OnError(bucketpolicy.ConflictBackoff, bucketpolicy.IsErrorConflict, func() error {
+		instance, err := e.bucketpolicy.GetIamPolicy(bucket).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do(gcp.UserProject(userProject)...)
+		if err != nil {
+			return errors.Wrap(err, errGetPolicy)
+		}
+		if !fn(instance) {
+			return nil
+		}
+		_, err = e.bucketpolicy.SetIamPolicy(bucket, instance).Context(ctx).Do(gcp.UserProject(userProject)...)
 		return errors.Wrap(err, errSetPolicy)
-	}
-
-	return nil
+	})
 }
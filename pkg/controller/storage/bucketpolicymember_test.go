@@ -23,6 +23,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
 	"github.com/crossplane-contrib/provider-gcp/pkg/clients/bucketpolicy"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/publicaccess"
 )
 
 const (
@@ -44,6 +45,22 @@ func bpmWithExternalNameAnnotation(externalName string) bpmValueModifier {
 	}
 }
 
+func bpmWithMember(m string) bpmValueModifier {
+	return func(i *v1alpha1.BucketPolicyMember) { i.Spec.ForProvider.Member = &m }
+}
+
+func bpmWithMembers(m ...string) bpmValueModifier {
+	return func(i *v1alpha1.BucketPolicyMember) { i.Spec.ForProvider.Members = m }
+}
+
+func bpmWithBoundMembers(m ...string) bpmValueModifier {
+	return func(i *v1alpha1.BucketPolicyMember) { i.Status.AtProvider.BoundMembers = m }
+}
+
+func bpmWithUserProject(p string) bpmValueModifier {
+	return func(i *v1alpha1.BucketPolicyMember) { i.Spec.ForProvider.UserProject = &p }
+}
+
 func bpmWithCondition(condition xpv1.Condition) bpmValueModifier {
 	return func(i *v1alpha1.BucketPolicyMember) { i.SetConditions(condition) }
 }
@@ -179,6 +196,94 @@ func TestBucketPolicyMemberObserve(t *testing.T) {
 				observation: managed.ExternalObservation{},
 			},
 		},
+		"ObservedPartiallyBound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bpm := &storagev1.Policy{
+					Bindings: []*storagev1.PolicyBindings{
+						{
+							Members: []string{testMember},
+							Role:    testRole,
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(bpm); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicyMember(
+					bpmWithMembers("group:added@example.com"),
+				),
+			},
+			want: want{
+				mg: BucketPolicyMember(
+					bpmWithMembers("group:added@example.com")),
+				observation: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ObservedOnlyRemovedMemberBound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bpm := &storagev1.Policy{
+					Bindings: []*storagev1.PolicyBindings{
+						{
+							Members: []string{"group:removed@example.com"},
+							Role:    testRole,
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(bpm); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicyMember(
+					bpmWithBoundMembers(testMember, "group:removed@example.com"),
+				),
+			},
+			want: want{
+				mg: BucketPolicyMember(
+					bpmWithBoundMembers(testMember, "group:removed@example.com")),
+				observation: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ObservedMemberRemoved": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				bpm := &storagev1.Policy{
+					Bindings: []*storagev1.PolicyBindings{
+						{
+							Members: []string{testMember, "group:removed@example.com"},
+							Role:    testRole,
+						},
+					},
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(bpm); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicyMember(
+					bpmWithBoundMembers(testMember, "group:removed@example.com"),
+				),
+			},
+			want: want{
+				mg: BucketPolicyMember(
+					bpmWithCondition(xpv1.Available()),
+					bpmWithBoundMembers(testMember, "group:removed@example.com")),
+				observation: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
 		"ObservedPolicyUpToDate": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
@@ -212,7 +317,8 @@ func TestBucketPolicyMemberObserve(t *testing.T) {
 			want: want{
 				mg: BucketPolicyMember(
 					bpmWithCondition(xpv1.Available()),
-					bpmWithName(bpmMetadataName)),
+					bpmWithName(bpmMetadataName),
+					bpmWithBoundMembers(testMember)),
 				observation: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: true,
@@ -267,6 +373,7 @@ func TestBucketPolicyMemberUpdate(t *testing.T) {
 
 	cases := map[string]struct {
 		handler http.Handler
+		mode    publicaccess.Mode
 		args    args
 		want    want
 	}{
@@ -280,6 +387,51 @@ func TestBucketPolicyMemberUpdate(t *testing.T) {
 				err: errors.New(errNotBucketPolicyMember),
 			},
 		},
+		"RetryOnConcurrentModification": {
+			handler: func() http.Handler {
+				puts := 0
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					defer r.Body.Close()
+					bpm := &storagev1.Policy{Etag: fmt.Sprintf("etag-%d", puts)}
+					switch r.Method {
+					case http.MethodGet:
+						w.WriteHeader(http.StatusOK)
+					case http.MethodPut:
+						i := &storagev1.Policy{}
+						if err := json.NewDecoder(r.Body).Decode(i); err != nil {
+							t.Error(err)
+						}
+						if diff := cmp.Diff(bpm.Etag, i.Etag); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						puts++
+						if puts == 1 {
+							// Someone else set the policy since it was read.
+							w.WriteHeader(http.StatusPreconditionFailed)
+							break
+						}
+						w.WriteHeader(http.StatusOK)
+					default:
+						w.WriteHeader(http.StatusBadRequest)
+					}
+					if err := json.NewEncoder(w).Encode(bpm); err != nil {
+						t.Error(err)
+					}
+				})
+			}(),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicyMember(
+					bpmWithName(bpmMetadataName),
+					bpmWithExternalNameAnnotation(bpmMetadataName)),
+			},
+			want: want{
+				mg: BucketPolicyMember(
+					bpmWithName(bpmMetadataName),
+					bpmWithExternalNameAnnotation(bpmMetadataName),
+					bpmWithBoundMembers(testMember)),
+			},
+		},
 		"UpdateSucceeded": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				var bpm *storagev1.Policy
@@ -340,7 +492,90 @@ func TestBucketPolicyMemberUpdate(t *testing.T) {
 				mg: BucketPolicyMember(
 					bpmWithName(bpmMetadataName),
 					bpmWithExternalNameAnnotation(bpmMetadataName),
-					bpmWithCondition(xpv1.Available())),
+					bpmWithCondition(xpv1.Available()),
+					bpmWithBoundMembers(testMember)),
+			},
+		},
+		"RequesterPays": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("billing-project", r.URL.Query().Get("userProject")); diff != "" {
+					t.Errorf("r: -want userProject, +got userProject:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(&storagev1.Policy{}); err != nil {
+					t.Error(err)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicyMember(
+					bpmWithName(bpmMetadataName),
+					bpmWithExternalNameAnnotation(bpmMetadataName),
+					bpmWithUserProject("billing-project")),
+			},
+			want: want{
+				mg: BucketPolicyMember(
+					bpmWithName(bpmMetadataName),
+					bpmWithExternalNameAnnotation(bpmMetadataName),
+					bpmWithUserProject("billing-project"),
+					bpmWithBoundMembers(testMember)),
+			},
+		},
+		"RemovedMemberUnbound": {
+			handler: func() http.Handler {
+				put := false
+				t.Cleanup(func() {
+					if !put {
+						t.Errorf("RemovedMemberUnbound: policy was not set")
+					}
+				})
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					defer r.Body.Close()
+					bpm := &storagev1.Policy{
+						Bindings: []*storagev1.PolicyBindings{
+							{
+								Members: []string{testMember, "group:removed@example.com"},
+								Role:    testRole,
+							},
+						},
+					}
+					if r.Method == http.MethodPut {
+						i := &storagev1.Policy{}
+						if err := json.NewDecoder(r.Body).Decode(i); err != nil {
+							t.Error(err)
+						}
+						want := &storagev1.Policy{
+							Bindings: []*storagev1.PolicyBindings{
+								{
+									Members: []string{testMember},
+									Role:    testRole,
+								},
+							},
+						}
+						if !bucketpolicy.ArePoliciesSame(want, i) {
+							t.Errorf("policy in setIamPolicyRequest not equal to expected, diff: %s", cmp.Diff(want, i, cmpopts.IgnoreFields(storagev1.Policy{}, "Version")))
+						}
+						bpm, put = i, true
+					}
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(bpm); err != nil {
+						t.Error(err)
+					}
+				})
+			}(),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicyMember(
+					bpmWithName(bpmMetadataName),
+					bpmWithExternalNameAnnotation(bpmMetadataName),
+					bpmWithBoundMembers(testMember, "group:removed@example.com")),
+			},
+			want: want{
+				mg: BucketPolicyMember(
+					bpmWithName(bpmMetadataName),
+					bpmWithExternalNameAnnotation(bpmMetadataName),
+					bpmWithBoundMembers(testMember)),
 			},
 		},
 		"FailedToGet": {
@@ -389,7 +624,30 @@ func TestBucketPolicyMemberUpdate(t *testing.T) {
 				mg: BucketPolicyMember(
 					bpmWithName(bpmMetadataName),
 					bpmWithExternalNameAnnotation(bpmMetadataName),
-					bpmWithCondition(xpv1.Available())),
+					bpmWithCondition(xpv1.Available()),
+					bpmWithBoundMembers(testMember)),
+			},
+		},
+		"PublicAccessBlocked": {
+			mode: publicaccess.ModeBlock,
+			args: args{
+				ctx: context.Background(),
+				mg:  BucketPolicyMember(bpmWithMember("allUsers")),
+			},
+			want: want{
+				mg:  BucketPolicyMember(bpmWithMember("allUsers")),
+				err: errors.New(`member "allUsers" grants public access, which is blocked by the provider`),
+			},
+		},
+		"PublicAccessInMembersBlocked": {
+			mode: publicaccess.ModeBlock,
+			args: args{
+				ctx: context.Background(),
+				mg:  BucketPolicyMember(bpmWithMembers("group:admins@example.com", "allAuthenticatedUsers")),
+			},
+			want: want{
+				mg:  BucketPolicyMember(bpmWithMembers("group:admins@example.com", "allAuthenticatedUsers")),
+				err: errors.New(`member "allAuthenticatedUsers" grants public access, which is blocked by the provider`),
 			},
 		},
 		"FailedToUpdate": {
@@ -437,6 +695,10 @@ func TestBucketPolicyMemberUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			if tc.mode != "" {
+				publicaccess.SetMode(tc.mode)
+				defer publicaccess.SetMode(publicaccess.ModeAllow)
+			}
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
@@ -552,6 +814,59 @@ func TestBucketPolicyMemberDelete(t *testing.T) {
 					bpmWithExternalNameAnnotation(bpmMetadataName)),
 			},
 		},
+		"DeleteUnbindsRemovedMembers": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					i := &storagev1.Policy{
+						Bindings: []*storagev1.PolicyBindings{
+							{
+								Members: []string{testMember, "group:removed@example.com", "another-member"},
+								Role:    testRole,
+							},
+						},
+					}
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(i); err != nil {
+						t.Error(err)
+					}
+				case http.MethodPut:
+					i := &storagev1.Policy{}
+					if err := json.NewDecoder(r.Body).Decode(i); err != nil {
+						t.Error(err)
+					}
+					exp := &storagev1.Policy{
+						Bindings: []*storagev1.PolicyBindings{
+							{
+								Members: []string{"another-member"},
+								Role:    testRole,
+							},
+						},
+					}
+					if !bucketpolicy.ArePoliciesSame(exp, i) {
+						t.Errorf("policy in setIamPolicyRequest not equal to expected, diff: %s", cmp.Diff(exp, i, cmpopts.IgnoreFields(storagev1.Policy{}, "Version")))
+					}
+					w.WriteHeader(http.StatusOK)
+					if err := json.NewEncoder(w).Encode(exp); err != nil {
+						t.Error(err)
+					}
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicyMember(
+					bpmWithName(bpmMetadataName),
+					bpmWithBoundMembers(testMember, "group:removed@example.com")),
+			},
+			want: want{
+				mg: BucketPolicyMember(
+					bpmWithName(bpmMetadataName),
+					bpmWithBoundMembers(testMember, "group:removed@example.com")),
+			},
+		},
 		"DeleteFailedWhileGetting": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusInternalServerError)
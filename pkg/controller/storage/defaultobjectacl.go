@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+
+	"google.golang.org/api/storage/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/defaultobjectacl"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotDefaultObjectACL    = "managed resource is not a GCP DefaultObjectACL"
+	errGetDefaultObjectACL    = "cannot get GCP default object access control"
+	errCreateDefaultObjectACL = "cannot create GCP default object access control"
+	errUpdateDefaultObjectACL = "cannot update GCP default object access control"
+	errDeleteDefaultObjectACL = "cannot delete GCP default object access control"
+)
+
+// SetupDefaultObjectACL adds a controller that reconciles DefaultObjectACLs.
+func SetupDefaultObjectACL(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.DefaultObjectACLGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.DefaultObjectACLGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &defaultObjectACLConnecter{client: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.DefaultObjectACL{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type defaultObjectACLConnecter struct {
+	client client.Client
+}
+
+// Connect sets up storage client using credentials from the provider
+func (c *defaultObjectACLConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := storage.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &defaultObjectACLExternal{acls: storage.NewDefaultObjectAccessControlsService(s)}, nil
+}
+
+type defaultObjectACLExternal struct {
+	acls defaultobjectacl.Client
+}
+
+func (e *defaultObjectACLExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DefaultObjectACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDefaultObjectACL)
+	}
+
+	ac, err := e.acls.Get(gcp.StringValue(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.Entity).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDefaultObjectACL)
+	}
+
+	cr.Status.AtProvider = defaultobjectacl.GenerateObservation(*ac)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: defaultobjectacl.IsUpToDate(cr.Spec.ForProvider, *ac),
+	}, nil
+}
+
+func (e *defaultObjectACLExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DefaultObjectACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDefaultObjectACL)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.acls.Insert(gcp.StringValue(cr.Spec.ForProvider.Bucket), defaultobjectacl.GenerateObjectAccessControl(cr.Spec.ForProvider)).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateDefaultObjectACL)
+}
+
+func (e *defaultObjectACLExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DefaultObjectACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDefaultObjectACL)
+	}
+
+	_, err := e.acls.Patch(gcp.StringValue(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.Entity, defaultobjectacl.GenerateObjectAccessControl(cr.Spec.ForProvider)).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDefaultObjectACL)
+}
+
+func (e *defaultObjectACLExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DefaultObjectACL)
+	if !ok {
+		return errors.New(errNotDefaultObjectACL)
+	}
+
+	err := e.acls.Delete(gcp.StringValue(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.Entity).Context(ctx).Do(gcp.UserProject(cr.Spec.ForProvider.UserProject)...)
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDefaultObjectACL)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/storage/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/hmackey"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotHMACKey        = "managed resource is not a GCP HMACKey"
+	errGetHMACKey        = "cannot get GCP HMAC key"
+	errCreateHMACKey     = "cannot create GCP HMAC key"
+	errUpdateHMACKey     = "cannot update GCP HMAC key"
+	errDeactivateHMACKey = "cannot deactivate GCP HMAC key"
+	errDeleteHMACKey     = "cannot delete GCP HMAC key"
+)
+
+// SetupHMACKey adds a controller that reconciles HMACKeys.
+func SetupHMACKey(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.HMACKeyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.HMACKeyGroupVersionKind),
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &hmacKeyConnecter{client: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.HMACKey{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type hmacKeyConnecter struct {
+	client client.Client
+}
+
+// Connect sets up storage client using credentials from the provider
+func (c *hmacKeyConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := storage.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &hmacKeyExternal{keys: storage.NewProjectsHmacKeysService(s), projectID: projectID}, nil
+}
+
+type hmacKeyExternal struct {
+	keys      hmackey.Client
+	projectID string
+}
+
+func (e *hmacKeyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.HMACKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotHMACKey)
+	}
+
+	// Access IDs are generated by the API when keys are created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	k, err := e.keys.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetHMACKey)
+	}
+
+	// Deleted keys remain readable for a while but can no longer be used.
+	if k.State == v1alpha1.HMACKeyStateDeleted {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	hmackey.LateInitializeSpec(&cr.Spec.ForProvider, *k)
+
+	cr.Status.AtProvider = hmackey.GenerateObservation(*k)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        hmackey.IsUpToDate(cr.Spec.ForProvider, *k),
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ConnectionDetails:       hmackey.GetConnectionDetails(storage.HmacKey{Metadata: k}),
+	}, nil
+}
+
+func (e *hmacKeyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.HMACKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotHMACKey)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	// Technically ServiceAccountEmail can be nil, but reference resolution
+	// should always make sure a value is set before we get to this point.
+	k, err := e.keys.Create(e.projectID, gcp.StringValue(cr.Spec.ForProvider.ServiceAccountEmail)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateHMACKey)
+	}
+
+	meta.SetExternalName(cr, k.Metadata.AccessId)
+	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: hmackey.GetConnectionDetails(*k)}, nil
+}
+
+func (e *hmacKeyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.HMACKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotHMACKey)
+	}
+
+	_, err := e.keys.Update(e.projectID, meta.GetExternalName(cr), hmackey.GenerateHmacKeyMetadata(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateHMACKey)
+}
+
+func (e *hmacKeyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.HMACKey)
+	if !ok {
+		return errors.New(errNotHMACKey)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	// Only inactive keys can be deleted.
+	if cr.Status.AtProvider.State != v1alpha1.HMACKeyStateInactive {
+		_, err := e.keys.Update(e.projectID, meta.GetExternalName(cr), &storage.HmacKeyMetadata{State: v1alpha1.HMACKeyStateInactive}).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeactivateHMACKey)
+		}
+	}
+
+	err := e.keys.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteHMACKey)
+}
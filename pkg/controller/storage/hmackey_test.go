@@ -0,0 +1,311 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	storagev1 "google.golang.org/api/storage/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/hmackey"
+)
+
+const (
+	testHMACAccessID = "GOOG1EXAMPLE"
+	testHMACSecret   = "bPxRfiCYEXAMPLEKEY"
+	testHMACEmail    = "writer@cool-project.iam.gserviceaccount.com"
+	testHMACPath     = "/projects/cool-project/hmacKeys/" + testHMACAccessID
+)
+
+func hmacKey(m ...func(*v1alpha1.HMACKey)) *v1alpha1.HMACKey {
+	cr := &v1alpha1.HMACKey{}
+	cr.Spec.ForProvider = v1alpha1.HMACKeyParameters{
+		ServiceAccountEmail: gcp.StringPtr(testHMACEmail),
+		State:               gcp.StringPtr(v1alpha1.HMACKeyStateActive),
+	}
+	meta.SetExternalName(cr, testHMACAccessID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestHMACKeyObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotHMACKey": {
+			mg:   &strange{},
+			want: want{err: errors.New(errNotHMACKey)},
+		},
+		"NotCreated": {
+			mg: hmacKey(func(cr *v1alpha1.HMACKey) { meta.SetExternalName(cr, "") }),
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: hmacKey(),
+		},
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&storagev1.HmacKeyMetadata{
+					AccessId:            testHMACAccessID,
+					ServiceAccountEmail: testHMACEmail,
+					State:               v1alpha1.HMACKeyStateDeleted,
+				})
+			}),
+			mg: hmacKey(),
+		},
+		"Exists": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testHMACPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&storagev1.HmacKeyMetadata{
+					AccessId:            testHMACAccessID,
+					ServiceAccountEmail: testHMACEmail,
+					State:               v1alpha1.HMACKeyStateActive,
+				})
+			}),
+			mg: hmacKey(),
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{hmackey.ConnectionKeyAccessID: []byte(testHMACAccessID)},
+			}},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&storagev1.HmacKeyMetadata{
+					AccessId:            testHMACAccessID,
+					ServiceAccountEmail: testHMACEmail,
+					State:               v1alpha1.HMACKeyStateInactive,
+				})
+			}),
+			mg: hmacKey(),
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ConnectionDetails: managed.ConnectionDetails{hmackey.ConnectionKeyAccessID: []byte(testHMACAccessID)},
+			}},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   hmacKey(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetHMACKey)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &hmacKeyExternal{keys: storagev1.NewProjectsHmacKeysService(s), projectID: "cool-project"}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHMACKeyCreate(t *testing.T) {
+	type want struct {
+		c            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotHMACKey": {
+			mg:   &strange{},
+			want: want{err: errors.New(errNotHMACKey)},
+		},
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(testHMACEmail, r.URL.Query().Get("serviceAccountEmail")); diff != "" {
+					t.Errorf("r: -want service account, +got service account:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&storagev1.HmacKey{
+					Metadata: &storagev1.HmacKeyMetadata{AccessId: testHMACAccessID, State: v1alpha1.HMACKeyStateActive},
+					Secret:   testHMACSecret,
+				})
+			}),
+			mg: hmacKey(func(cr *v1alpha1.HMACKey) { meta.SetExternalName(cr, "") }),
+			want: want{
+				c: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						hmackey.ConnectionKeyAccessID: []byte(testHMACAccessID),
+						hmackey.ConnectionKeySecret:   []byte(testHMACSecret),
+					},
+				},
+				externalName: testHMACAccessID,
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   hmacKey(func(cr *v1alpha1.HMACKey) { meta.SetExternalName(cr, "") }),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateHMACKey)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &hmacKeyExternal{keys: storagev1.NewProjectsHmacKeysService(s), projectID: "cool-project"}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, got); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+				t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHMACKeyDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotHMACKey": {
+			mg:   &strange{},
+			want: errors.New(errNotHMACKey),
+		},
+		"DeactivatedAndDeleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.Method {
+				case http.MethodPut:
+					got := &storagev1.HmacKeyMetadata{}
+					if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+						t.Error(err)
+					}
+					if diff := cmp.Diff(v1alpha1.HMACKeyStateInactive, got.State); diff != "" {
+						t.Errorf("state: -want, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(got)
+				case http.MethodDelete:
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected method %s", r.Method)
+				}
+			}),
+			mg: hmacKey(func(cr *v1alpha1.HMACKey) { cr.Status.AtProvider.State = v1alpha1.HMACKeyStateActive }),
+		},
+		"AlreadyInactive": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+			mg: hmacKey(func(cr *v1alpha1.HMACKey) { cr.Status.AtProvider.State = v1alpha1.HMACKeyStateInactive }),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: hmacKey(),
+		},
+		"DeactivateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   hmacKey(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeactivateHMACKey),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   hmacKey(func(cr *v1alpha1.HMACKey) { cr.Status.AtProvider.State = v1alpha1.HMACKeyStateInactive }),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteHMACKey),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagev1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &hmacKeyExternal{keys: storagev1.NewProjectsHmacKeysService(s), projectID: "cool-project"}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
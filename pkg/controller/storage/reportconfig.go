@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"path"
+
+	"github.com/google/go-cmp/cmp"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/reportconfig"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotReportConfig    = "managed resource is not a GCP storage insights report config"
+	errGetReportConfig    = "cannot get GCP storage insights report config"
+	errCreateReportConfig = "cannot create GCP storage insights report config"
+	errUpdateReportConfig = "cannot update GCP storage insights report config"
+	errDeleteReportConfig = "cannot delete GCP storage insights report config"
+)
+
+// SetupReportConfig adds a controller that reconciles ReportConfigs.
+func SetupReportConfig(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ReportConfigGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ReportConfigGroupVersionKind),
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &reportConfigConnecter{client: mgr.GetClient()}))))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.ReportConfig{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type reportConfigConnecter struct {
+	client client.Client
+}
+
+// Connect sets up storage insights client using credentials from the provider
+func (c *reportConfigConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := reportconfig.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &reportConfigExternal{configs: s, projectID: projectID}, nil
+}
+
+type reportConfigExternal struct {
+	configs   reportconfig.Client
+	projectID string
+}
+
+func (e *reportConfigExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ReportConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotReportConfig)
+	}
+
+	// Report config IDs are generated by the API when they are created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rc, err := e.configs.Get(ctx, reportconfig.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetReportConfig)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	reportconfig.LateInitialize(&cr.Spec.ForProvider, *rc)
+
+	cr.Status.AtProvider = reportconfig.GenerateObservation(*rc)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        reportconfig.IsUpToDate(cr.Spec.ForProvider, *rc),
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *reportConfigExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ReportConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotReportConfig)
+	}
+
+	rc, err := e.configs.Create(ctx, reportconfig.GetParent(e.projectID, cr.Spec.ForProvider), reportconfig.GenerateReportConfig(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateReportConfig)
+	}
+
+	meta.SetExternalName(cr, path.Base(rc.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *reportConfigExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ReportConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotReportConfig)
+	}
+
+	name := reportconfig.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	rc, err := e.configs.Get(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetReportConfig)
+	}
+
+	mask := reportconfig.GenerateUpdateMask(cr.Spec.ForProvider, *rc)
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.configs.Patch(ctx, name, mask, reportconfig.GenerateReportConfig(cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateReportConfig)
+}
+
+func (e *reportConfigExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ReportConfig)
+	if !ok {
+		return errors.New(errNotReportConfig)
+	}
+
+	err := e.configs.Delete(ctx, reportconfig.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteReportConfig)
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/reportconfig"
+)
+
+const (
+	testReportConfigID   = "0a1b2c"
+	testReportConfigName = "projects/cool-project/locations/us-central1/reportConfigs/" + testReportConfigID
+)
+
+var errBoom = errors.New("boom")
+
+type mockReportConfigClient struct {
+	MockGet    func(ctx context.Context, name string) (*reportconfig.ReportConfig, error)
+	MockCreate func(ctx context.Context, parent string, rc *reportconfig.ReportConfig) (*reportconfig.ReportConfig, error)
+	MockPatch  func(ctx context.Context, name, updateMask string, rc *reportconfig.ReportConfig) (*reportconfig.ReportConfig, error)
+	MockDelete func(ctx context.Context, name string) error
+}
+
+func (m *mockReportConfigClient) Get(ctx context.Context, name string) (*reportconfig.ReportConfig, error) {
+	return m.MockGet(ctx, name)
+}
+
+func (m *mockReportConfigClient) Create(ctx context.Context, parent string, rc *reportconfig.ReportConfig) (*reportconfig.ReportConfig, error) {
+	return m.MockCreate(ctx, parent, rc)
+}
+
+func (m *mockReportConfigClient) Patch(ctx context.Context, name, updateMask string, rc *reportconfig.ReportConfig) (*reportconfig.ReportConfig, error) {
+	return m.MockPatch(ctx, name, updateMask, rc)
+}
+
+func (m *mockReportConfigClient) Delete(ctx context.Context, name string) error {
+	return m.MockDelete(ctx, name)
+}
+
+func reportConfig(m ...func(*v1alpha1.ReportConfig)) *v1alpha1.ReportConfig {
+	cr := &v1alpha1.ReportConfig{}
+	cr.Spec.ForProvider = v1alpha1.ReportConfigParameters{
+		Location: "us-central1",
+		FrequencyOptions: v1alpha1.FrequencyOptions{
+			Frequency: "DAILY",
+			StartDate: v1alpha1.Date{Year: 2023, Month: 1, Day: 1},
+			EndDate:   v1alpha1.Date{Year: 2024, Month: 1, Day: 1},
+		},
+		ParquetOptions:    &v1alpha1.ParquetOptions{},
+		MetadataFields:    []string{"bucket", "name"},
+		SourceBucket:      gcp.StringPtr("source"),
+		DestinationBucket: gcp.StringPtr("reports"),
+	}
+	meta.SetExternalName(cr, testReportConfigID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestReportConfigObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		client reportconfig.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"NotReportConfig": {
+			mg:   &strange{},
+			want: want{err: errors.New(errNotReportConfig)},
+		},
+		"NotCreated": {
+			mg: reportConfig(func(cr *v1alpha1.ReportConfig) { meta.SetExternalName(cr, "") }),
+		},
+		"NotFound": {
+			client: &mockReportConfigClient{MockGet: func(_ context.Context, _ string) (*reportconfig.ReportConfig, error) {
+				return nil, &googleapi.Error{Code: http.StatusNotFound}
+			}},
+			mg: reportConfig(),
+		},
+		"UpToDate": {
+			client: &mockReportConfigClient{MockGet: func(_ context.Context, name string) (*reportconfig.ReportConfig, error) {
+				if name != testReportConfigName {
+					return nil, errBoom
+				}
+				rc := reportconfig.GenerateReportConfig(reportConfig().Spec.ForProvider)
+				rc.Name = testReportConfigName
+				return rc, nil
+			}},
+			mg:   reportConfig(),
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"NeedsUpdate": {
+			client: &mockReportConfigClient{MockGet: func(_ context.Context, _ string) (*reportconfig.ReportConfig, error) {
+				rc := reportconfig.GenerateReportConfig(reportConfig().Spec.ForProvider)
+				rc.FrequencyOptions.Frequency = "WEEKLY"
+				return rc, nil
+			}},
+			mg:   reportConfig(),
+			want: want{o: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"GetFailed": {
+			client: &mockReportConfigClient{MockGet: func(_ context.Context, _ string) (*reportconfig.ReportConfig, error) {
+				return nil, errBoom
+			}},
+			mg:   reportConfig(),
+			want: want{err: errors.Wrap(errBoom, errGetReportConfig)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &reportConfigExternal{configs: tc.client, projectID: "cool-project"}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReportConfigCreate(t *testing.T) {
+	cr := reportConfig(func(cr *v1alpha1.ReportConfig) { meta.SetExternalName(cr, "") })
+	e := &reportConfigExternal{projectID: "cool-project", configs: &mockReportConfigClient{
+		MockCreate: func(_ context.Context, parent string, rc *reportconfig.ReportConfig) (*reportconfig.ReportConfig, error) {
+			if parent != "projects/cool-project/locations/us-central1" {
+				return nil, errBoom
+			}
+			rc.Name = testReportConfigName
+			return rc, nil
+		},
+	}}
+	got, err := e.Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, got); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(testReportConfigID, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+	}
+}
+
+func TestReportConfigUpdate(t *testing.T) {
+	var mask string
+	e := &reportConfigExternal{projectID: "cool-project", configs: &mockReportConfigClient{
+		MockGet: func(_ context.Context, _ string) (*reportconfig.ReportConfig, error) {
+			rc := reportconfig.GenerateReportConfig(reportConfig().Spec.ForProvider)
+			rc.DisplayName = "stale"
+			return rc, nil
+		},
+		MockPatch: func(_ context.Context, _, updateMask string, rc *reportconfig.ReportConfig) (*reportconfig.ReportConfig, error) {
+			mask = updateMask
+			return rc, nil
+		},
+	}}
+	if _, err := e.Update(context.Background(), reportConfig()); err != nil {
+		t.Fatalf("Update(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff("displayName", mask); diff != "" {
+		t.Errorf("Update(...): -want update mask, +got update mask:\n%s", diff)
+	}
+}
+
+func TestReportConfigDelete(t *testing.T) {
+	e := &reportConfigExternal{projectID: "cool-project", configs: &mockReportConfigClient{
+		MockDelete: func(_ context.Context, _ string) error {
+			return &googleapi.Error{Code: http.StatusNotFound}
+		},
+	}}
+	if err := e.Delete(context.Background(), reportConfig()); err != nil {
+		t.Errorf("Delete(...): want not found error to be ignored, got %s", err)
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"cloud.google.com/go/storage"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/signedurl"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNotSignedURL = "managed resource is not a GCP signed URL"
+	errSignURL      = "cannot sign GCP storage URL"
+)
+
+// Connection detail keys.
+const (
+	keySignedURL  = "url"
+	keyMethod     = "method"
+	keyExpireTime = "expireTime"
+)
+
+// SetupSignedURL adds a controller that reconciles SignedURLs.
+func SetupSignedURL(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SignedURLGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SignedURLGroupVersionKind),
+		managed.WithInitializers(),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &signedURLConnecter{client: mgr.GetClient()}))))),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.SignedURL{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// A URLSigner signs URLs for objects of a bucket.
+type URLSigner interface {
+	SignedURL(bucket, object string, opts *storage.SignedURLOptions) (string, error)
+}
+
+// A GCSURLSigner wraps the GCS storage.Client as a URLSigner. It signs URLs
+// with the private key of the provider's credentials if they have one, or
+// else using the IAM Credentials signBlob API.
+type GCSURLSigner struct {
+	c *storage.Client
+}
+
+// SignedURL returns a signed URL for the supplied object.
+func (s *GCSURLSigner) SignedURL(bucket, object string, opts *storage.SignedURLOptions) (string, error) {
+	return s.c.Bucket(bucket).SignedURL(object, opts)
+}
+
+type signedURLConnecter struct {
+	client client.Client
+}
+
+// Connect sets up storage client using credentials from the provider
+func (c *signedURLConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetConnectionInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &signedURLExternal{signer: &GCSURLSigner{c: s}, now: time.Now}, nil
+}
+
+type signedURLExternal struct {
+	signer URLSigner
+	now    func() time.Time
+}
+
+// Observe reports a URL as needing an update whenever it is about to expire.
+// Signed URLs only exist in the connection secret, so there is nothing to
+// read from the storage API.
+func (e *signedURLExternal) Observe(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SignedURL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSignedURL)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if signedurl.NeedsRefresh(cr.Spec.ForProvider, cr.Status.AtProvider.ExpireTime, e.now()) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// Create only records the object the URL is signed for. The managed
+// reconciler discards status changes made during Create, so the URL is signed
+// by the Update that immediately follows.
+func (e *signedURLExternal) Create(_ context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SignedURL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSignedURL)
+	}
+
+	// Technically Bucket can be nil, but reference resolution should always
+	// make sure a value is set before we get to this point.
+	meta.SetExternalName(cr, path.Join(gcp.StringValue(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.Object))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update signs a new URL and publishes it to the connection secret.
+func (e *signedURLExternal) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SignedURL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSignedURL)
+	}
+
+	opts := signedurl.GenerateSignedURLOptions(cr.Spec.ForProvider, e.now())
+	u, err := e.signer.SignedURL(gcp.StringValue(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider.Object, opts)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSignURL)
+	}
+
+	cr.Status.AtProvider.ExpireTime = opts.Expires.UTC().Format(time.RFC3339)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+		keySignedURL:  []byte(u),
+		keyMethod:     []byte(opts.Method),
+		keyExpireTime: []byte(cr.Status.AtProvider.ExpireTime),
+	}}, nil
+}
+
+// Delete is a no-op. Signed URLs cannot be revoked; they expire on their own.
+func (e *signedURLExternal) Delete(_ context.Context, mg resource.Managed) error {
+	if _, ok := mg.(*v1alpha1.SignedURL); !ok {
+		return errors.New(errNotSignedURL)
+	}
+	return nil
+}
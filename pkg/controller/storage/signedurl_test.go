@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+)
+
+const (
+	testSignedURL  = "https://storage.googleapis.com/cool-bucket/uploads/report.csv?X-Goog-Signature=abc"
+	testExpireTime = "2023-01-01T13:00:00Z"
+)
+
+var signedURLNow = time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type signerFn func(bucket, object string, opts *storage.SignedURLOptions) (string, error)
+
+func (fn signerFn) SignedURL(bucket, object string, opts *storage.SignedURLOptions) (string, error) {
+	return fn(bucket, object, opts)
+}
+
+type signedURLModifier func(*v1alpha1.SignedURL)
+
+func signedURL(m ...signedURLModifier) *v1alpha1.SignedURL {
+	cr := &v1alpha1.SignedURL{}
+	cr.Spec.ForProvider.Bucket = gcp.StringPtr("cool-bucket")
+	cr.Spec.ForProvider.Object = "uploads/report.csv"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withSignedURLExternalName(cr *v1alpha1.SignedURL) {
+	meta.SetExternalName(cr, "cool-bucket/uploads/report.csv")
+}
+
+func TestSignedURLObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"NotSignedURL": {
+			mg:   &strange{},
+			want: want{err: errors.New(errNotSignedURL)},
+		},
+		"NotCreated": {
+			mg: signedURL(),
+		},
+		"NotSigned": {
+			mg:   signedURL(withSignedURLExternalName),
+			want: want{o: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"Fresh": {
+			mg: signedURL(withSignedURLExternalName, func(cr *v1alpha1.SignedURL) {
+				cr.Status.AtProvider.ExpireTime = testExpireTime
+			}),
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &signedURLExternal{now: func() time.Time { return signedURLNow }}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSignedURLCreate(t *testing.T) {
+	cr := signedURL()
+	got, err := (&signedURLExternal{}).Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, got); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("cool-bucket/uploads/report.csv", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+	}
+}
+
+func TestSignedURLUpdate(t *testing.T) {
+	type want struct {
+		u          managed.ExternalUpdate
+		expireTime string
+		err        error
+	}
+
+	cases := map[string]struct {
+		signer URLSigner
+		mg     *v1alpha1.SignedURL
+		want   want
+	}{
+		"Signed": {
+			signer: signerFn(func(bucket, object string, opts *storage.SignedURLOptions) (string, error) {
+				if bucket != "cool-bucket" || object != "uploads/report.csv" || opts.Scheme != storage.SigningSchemeV4 {
+					return "", errBoom
+				}
+				return testSignedURL, nil
+			}),
+			mg: signedURL(withSignedURLExternalName),
+			want: want{
+				u: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					keySignedURL:  []byte(testSignedURL),
+					keyMethod:     []byte("GET"),
+					keyExpireTime: []byte(testExpireTime),
+				}},
+				expireTime: testExpireTime,
+			},
+		},
+		"SignFailed": {
+			signer: signerFn(func(_, _ string, _ *storage.SignedURLOptions) (string, error) {
+				return "", errBoom
+			}),
+			mg:   signedURL(withSignedURLExternalName),
+			want: want{err: errors.Wrap(errBoom, errSignURL)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &signedURLExternal{signer: tc.signer, now: func() time.Time { return signedURLNow }}
+			got, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.u, got); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.expireTime, tc.mg.Status.AtProvider.ExpireTime); diff != "" {
+				t.Errorf("Update(...): -want expireTime, +got expireTime:\n%s", diff)
+			}
+		})
+	}
+}
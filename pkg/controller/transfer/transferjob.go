@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/storagetransfer/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/transfer/v1alpha1"
+	scv1alpha1 "github.com/crossplane-contrib/provider-gcp/apis/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/audit"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/breaker"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/concurrency"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/failure"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/teardown"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/transferjob"
+	"github.com/crossplane-contrib/provider-gcp/pkg/features"
+)
+
+// Error strings.
+const (
+	errNewClient         = "cannot create new Storage Transfer API client"
+	errNotTransferJob    = "managed resource is not a Storage Transfer TransferJob"
+	errGetTransferJob    = "cannot get external Storage Transfer job"
+	errCreateTransferJob = "cannot create external Storage Transfer job"
+	errUpdateTransferJob = "cannot update external Storage Transfer job"
+	errDeleteTransferJob = "cannot delete external Storage Transfer job"
+)
+
+// SetupTransferJob adds a controller that reconciles Storage Transfer
+// TransferJobs.
+func SetupTransferJob(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TransferJobGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), scv1alpha1.StoreConfigGroupVersionKind, connection.WithTLSConfig(o.ESSOptions.TLSConfig)))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TransferJobGroupVersionKind),
+		managed.WithExternalConnecter(failure.WrapConnecter(audit.WrapConnecter(mgr.GetClient(), breaker.WrapConnecter(name, o, teardown.WrapConnecter(name, o, &transferJobConnector{kube: mgr.GetClient()}))))),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(concurrency.ForControllerRuntime(name, o)).
+		For(&v1alpha1.TransferJob{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+type transferJobConnector struct {
+	kube client.Client
+}
+
+func (c *transferJobConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetConnectionInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := storagetransfer.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &transferJobExternal{kube: c.kube, jobs: storagetransfer.NewTransferJobsService(s), projectID: projectID}, nil
+}
+
+type transferJobExternal struct {
+	kube      client.Client
+	jobs      transferjob.Client
+	projectID string
+}
+
+func (e *transferJobExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TransferJob)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTransferJob)
+	}
+
+	observed, err := e.jobs.Get(transferjob.GetFullyQualifiedName(meta.GetExternalName(cr)), e.projectID).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTransferJob)
+	}
+	// Deleted jobs are returned until they are garbage collected.
+	if transferjob.IsDeleted(*observed) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	transferjob.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = transferjob.GenerateObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        transferjob.IsUpToDate(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *transferJobExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TransferJob)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTransferJob)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	key, err := transferjob.GetAWSAccessKey(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTransferJob)
+	}
+	_, err = e.jobs.Create(transferjob.GenerateTransferJob(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, key)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTransferJob)
+}
+
+func (e *transferJobExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TransferJob)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTransferJob)
+	}
+
+	// The transfer spec is replaced as a whole, so the AWS access key must be
+	// sent again.
+	key, err := transferjob.GetAWSAccessKey(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTransferJob)
+	}
+	req := &storagetransfer.UpdateTransferJobRequest{
+		ProjectId:                  e.projectID,
+		TransferJob:                transferjob.GenerateTransferJob(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, key),
+		UpdateTransferJobFieldMask: transferjob.UpdateMask,
+	}
+	_, err = e.jobs.Patch(transferjob.GetFullyQualifiedName(meta.GetExternalName(cr)), req).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTransferJob)
+}
+
+func (e *transferJobExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TransferJob)
+	if !ok {
+		return errors.New(errNotTransferJob)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.jobs.Delete(transferjob.GetFullyQualifiedName(meta.GetExternalName(cr)), e.projectID).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTransferJob)
+}
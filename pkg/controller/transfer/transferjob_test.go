@@ -0,0 +1,432 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/api/storagetransfer/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-gcp/apis/transfer/v1alpha1"
+	gcp "github.com/crossplane-contrib/provider-gcp/pkg/clients"
+	"github.com/crossplane-contrib/provider-gcp/pkg/clients/transferjob"
+)
+
+const (
+	project      = "someProject"
+	metadataName = "test-job"
+	sinkBucket   = "sink-bucket"
+	s3Bucket     = "s3-bucket"
+)
+
+var (
+	_ managed.ExternalConnecter = &transferJobConnector{}
+	_ managed.ExternalClient    = &transferJobExternal{}
+
+	err500 = &googleapi.Error{Code: 500, Body: "{}\n"}
+	fqName = "transferJobs/" + metadataName
+)
+
+type strange struct {
+	resource.Managed
+}
+
+type jobModifier func(*v1alpha1.TransferJob)
+
+func withCondition(c xpv1.Condition) jobModifier {
+	return func(j *v1alpha1.TransferJob) { j.SetConditions(c) }
+}
+
+func withObservation() jobModifier {
+	return func(j *v1alpha1.TransferJob) {
+		j.Status.AtProvider = v1alpha1.TransferJobObservation{Name: fqName, CreationTime: "2023-01-01T00:00:00Z"}
+	}
+}
+
+func withStatus(s string) jobModifier {
+	return func(j *v1alpha1.TransferJob) { j.Spec.ForProvider.Status = gcp.StringPtr(s) }
+}
+
+func withS3Source() jobModifier {
+	return func(j *v1alpha1.TransferJob) {
+		j.Spec.ForProvider.TransferSpec.AWSS3DataSource = &v1alpha1.AWSS3Data{
+			BucketName: s3Bucket,
+			AWSAccessKey: &v1alpha1.AWSAccessKey{
+				AccessKeyIDSecretRef:     xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "aws", Namespace: "default"}, Key: "id"},
+				SecretAccessKeySecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "aws", Namespace: "default"}, Key: "secret"},
+			},
+		}
+	}
+}
+
+func job(m ...jobModifier) *v1alpha1.TransferJob {
+	j := &v1alpha1.TransferJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        metadataName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: metadataName},
+		},
+		Spec: v1alpha1.TransferJobSpec{
+			ForProvider: v1alpha1.TransferJobParameters{
+				Status: gcp.StringPtr(v1alpha1.TransferJobStatusEnabled),
+				TransferSpec: v1alpha1.TransferSpec{
+					HTTPDataSource: &v1alpha1.HTTPData{ListURL: "https://example.org/list.tsv"},
+					GCSDataSink:    v1alpha1.GCSData{BucketName: gcp.StringPtr(sinkBucket)},
+				},
+			},
+		},
+	}
+	for _, fn := range m {
+		fn(j)
+	}
+	return j
+}
+
+func jobResponse(status string) *storagetransfer.TransferJob {
+	return &storagetransfer.TransferJob{
+		Name:         fqName,
+		ProjectId:    project,
+		Status:       status,
+		CreationTime: "2023-01-01T00:00:00Z",
+		TransferSpec: &storagetransfer.TransferSpec{
+			HttpDataSource: &storagetransfer.HttpData{ListUrl: "https://example.org/list.tsv"},
+			GcsDataSink:    &storagetransfer.GcsData{BucketName: sinkBucket},
+		},
+	}
+}
+
+func awsSecret() *test.MockClient {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"id": []byte("AKIA"), "secret": []byte("s3cr3t")}
+			return nil
+		},
+	}
+}
+
+func TestTransferJobObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTransferJob": {
+			mg:   &strange{},
+			want: want{mg: &strange{}, err: errors.New(errNotTransferJob)},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/"+fqName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				if diff := cmp.Diff(project, r.URL.Query().Get("projectId")); diff != "" {
+					t.Errorf("r: -want projectId, +got projectId:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(jobResponse(v1alpha1.TransferJobStatusEnabled))
+			}),
+			mg: job(),
+			want: want{
+				mg:  job(withObservation(), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(jobResponse(v1alpha1.TransferJobStatusEnabled))
+			}),
+			mg: job(withStatus(v1alpha1.TransferJobStatusDisabled)),
+			want: want{
+				mg:  job(withStatus(v1alpha1.TransferJobStatusDisabled), withObservation(), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(jobResponse(v1alpha1.TransferJobStatusEnabled))
+			}),
+			mg: job(func(j *v1alpha1.TransferJob) { j.Spec.ForProvider.Status = nil }),
+			want: want{
+				mg:  job(withObservation(), withCondition(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(jobResponse(v1alpha1.TransferJobStatusDeleted))
+			}),
+			mg:   job(),
+			want: want{mg: job()},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg:   job(),
+			want: want{mg: job()},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   job(),
+			want: want{mg: job(), err: errors.Wrap(err500, errGetTransferJob)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagetransfer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &transferJobExternal{jobs: storagetransfer.NewTransferJobsService(s), projectID: project}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransferJobCreate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    error
+	}{
+		"NotTransferJob": {
+			mg:   &strange{},
+			want: errors.New(errNotTransferJob),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/transferJobs", r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				j := &storagetransfer.TransferJob{}
+				if err := json.NewDecoder(r.Body).Decode(j); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(fqName, j.Name); diff != "" {
+					t.Errorf("name: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(project, j.ProjectId); diff != "" {
+					t.Errorf("projectId: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(j)
+			}),
+			mg: job(),
+		},
+		"AWSAccessKey": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				j := &storagetransfer.TransferJob{}
+				if err := json.NewDecoder(r.Body).Decode(j); err != nil {
+					t.Error(err)
+				}
+				want := &storagetransfer.AwsAccessKey{AccessKeyId: "AKIA", SecretAccessKey: "s3cr3t"}
+				if diff := cmp.Diff(want, j.TransferSpec.AwsS3DataSource.AwsAccessKey); diff != "" {
+					t.Errorf("awsAccessKey: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(j)
+			}),
+			kube: awsSecret(),
+			mg:   job(withS3Source()),
+		},
+		"GetSecretFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:   job(withS3Source()),
+			want: errors.Wrap(errors.Wrap(errBoom, "cannot get Secret with AWS access key"), errCreateTransferJob),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   job(),
+			want: errors.Wrap(err500, errCreateTransferJob),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagetransfer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &transferJobExternal{kube: tc.kube, jobs: storagetransfer.NewTransferJobsService(s), projectID: project}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransferJobUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    error
+	}{
+		"NotTransferJob": {
+			mg:   &strange{},
+			want: errors.New(errNotTransferJob),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+fqName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want path, +got path:\n%s", diff)
+				}
+				req := &storagetransfer.UpdateTransferJobRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Error(err)
+				}
+				if diff := cmp.Diff(transferjob.UpdateMask, req.UpdateTransferJobFieldMask); diff != "" {
+					t.Errorf("updateTransferJobFieldMask: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(v1alpha1.TransferJobStatusDisabled, req.TransferJob.Status); diff != "" {
+					t.Errorf("status: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("AKIA", req.TransferJob.TransferSpec.AwsS3DataSource.AwsAccessKey.AccessKeyId); diff != "" {
+					t.Errorf("awsAccessKey: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(req.TransferJob)
+			}),
+			kube: awsSecret(),
+			mg:   job(withStatus(v1alpha1.TransferJobStatusDisabled), withS3Source()),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   job(),
+			want: errors.Wrap(err500, errUpdateTransferJob),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagetransfer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &transferJobExternal{kube: tc.kube, jobs: storagetransfer.NewTransferJobsService(s), projectID: project}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransferJobDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotTransferJob": {
+			mg:   &strange{},
+			want: errors.New(errNotTransferJob),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want method, +got method:\n%s", diff)
+				}
+				if diff := cmp.Diff(project, r.URL.Query().Get("projectId")); diff != "" {
+					t.Errorf("r: -want projectId, +got projectId:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: job(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: job(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   job(),
+			want: errors.Wrap(err500, errDeleteTransferJob),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagetransfer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &transferJobExternal{jobs: storagetransfer.NewTransferJobsService(s), projectID: project}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
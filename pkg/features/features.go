@@ -24,4 +24,24 @@ const (
 	// External Secret Stores. See the below design for more details.
 	// https://github.com/crossplane/crossplane/blob/390ddd/design/design-doc-external-secret-stores.md
 	EnableAlphaExternalSecretStores feature.Flag = "EnableAlphaExternalSecretStores"
+
+	// EnableAlphaQuotaCircuitBreaker enables alpha support for pausing a
+	// controller's calls to the Google API after it has repeatedly been
+	// told that its quota is exhausted.
+	EnableAlphaQuotaCircuitBreaker feature.Flag = "EnableAlphaQuotaCircuitBreaker"
+
+	// EnableAlphaCatalogValidation enables alpha support for validating
+	// regions, zones and machine types against the live Google Compute
+	// Engine catalog before a resource is created.
+	EnableAlphaCatalogValidation feature.Flag = "EnableAlphaCatalogValidation"
+
+	// EnableAlphaFirewallHitObservation enables alpha support for reporting
+	// when a Firewall rule last matched traffic, derived from its firewall
+	// rules logs in Cloud Logging.
+	EnableAlphaFirewallHitObservation feature.Flag = "EnableAlphaFirewallHitObservation"
+
+	// EnableAlphaOrderedDeletion enables alpha support for deleting managed
+	// resources after the resources that depend on them, and for rate
+	// limiting deletions, e.g. when an environment is torn down.
+	EnableAlphaOrderedDeletion feature.Flag = "EnableAlphaOrderedDeletion"
 )
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/yaml"
+)
+
+// Files of a golden case.
+const (
+	GoldenParametersFile = "parameters.yaml"
+	GoldenObservedFile   = "observed.json"
+	GoldenWantFile       = "want.yaml"
+	GoldenGeneratedFile  = "generated.json"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "Update the generated.json files of golden cases.")
+
+// GoldenWant is the expected outcome of a golden case, read from its
+// want.yaml file.
+type GoldenWant struct {
+	// UpToDate is the expected result of IsUpToDate.
+	UpToDate bool `json:"upToDate"`
+}
+
+// GoldenFuncs are the functions of a clients package that golden cases are
+// run against, adapted to a common signature. Any of them may be nil.
+type GoldenFuncs[P, O any] struct {
+	// Generate returns the external resource generated from the supplied
+	// parameters, e.g. by calling GenerateFirewall.
+	Generate func(p *P) any
+
+	// LateInitialize the supplied parameters from the supplied observed
+	// external resource. It is called before IsUpToDate, like controllers
+	// do in Observe.
+	LateInitialize func(p *P, o *O)
+
+	// IsUpToDate returns whether the supplied observed external resource is
+	// up to date with the supplied parameters.
+	IsUpToDate func(p *P, o *O) (bool, error)
+}
+
+// RunGoldenCases runs the golden cases in the subdirectories of the supplied
+// directory, usually testdata/golden, as subtests. Each case directory
+// contains:
+//
+//   - parameters.yaml: the spec.forProvider of the managed resource, as P.
+//   - observed.json: the external resource as returned by the GCP API, as O.
+//   - want.yaml: the expected outcome, as GoldenWant.
+//   - generated.json: optionally, the external resource expected to be
+//     generated from parameters.yaml. Run the tests with -update-golden to
+//     write it.
+//
+// Add a case when fixing a normalization bug: copy the parameters and the
+// observed resource of the affected managed resource into a new directory.
+func RunGoldenCases[P, O any](t *testing.T, dir string, f GoldenFuncs[P, O]) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("cannot read golden cases: %s", err)
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		c := filepath.Join(dir, e.Name())
+		t.Run(e.Name(), func(t *testing.T) {
+			p := new(P)
+			readGolden(t, filepath.Join(c, GoldenParametersFile), p, true)
+
+			if f.Generate != nil {
+				checkGenerated(t, filepath.Join(c, GoldenGeneratedFile), f.Generate(p))
+			}
+
+			if f.IsUpToDate != nil {
+				o := new(O)
+				// Observed resources are not read strictly, so that they can
+				// be copied from API responses with fields the Go client of the
+				// API does not know yet.
+				readGolden(t, filepath.Join(c, GoldenObservedFile), o, false)
+				want := &GoldenWant{}
+				readGolden(t, filepath.Join(c, GoldenWantFile), want, true)
+				if f.LateInitialize != nil {
+					f.LateInitialize(p, o)
+				}
+				got, err := f.IsUpToDate(p, o)
+				if err != nil {
+					t.Fatalf("IsUpToDate(...): %s", err)
+				}
+				if diff := cmp.Diff(want.UpToDate, got); diff != "" {
+					t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+// readGolden reads the supplied YAML or JSON file into the supplied object.
+// Unknown fields are an error if strict is true.
+func readGolden(t *testing.T, file string, into any, strict bool) {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Clean(file))
+	if err != nil {
+		t.Fatalf("cannot read golden file: %s", err)
+	}
+	unmarshal := yaml.Unmarshal
+	if strict {
+		unmarshal = yaml.UnmarshalStrict
+	}
+	if err := unmarshal(b, into); err != nil {
+		t.Fatalf("cannot parse golden file %s: %s", file, err)
+	}
+}
+
+// checkGenerated compares the supplied generated resource with the supplied
+// golden file, if it exists, or writes it if -update-golden is set.
+func checkGenerated(t *testing.T, file string, generated any) {
+	t.Helper()
+	got, err := json.MarshalIndent(generated, "", "  ")
+	if err != nil {
+		t.Fatalf("cannot marshal generated resource: %s", err)
+	}
+	got = append(got, '\n')
+	if *updateGolden {
+		if err := os.WriteFile(file, got, 0o600); err != nil {
+			t.Fatalf("cannot update golden file: %s", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(filepath.Clean(file))
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		t.Fatalf("cannot read golden file: %s", err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("Generate(...): -want, +got:\n%s", diff)
+	}
+}